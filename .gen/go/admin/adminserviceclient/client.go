@@ -47,6 +47,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	CheckWorkflowBuild(
+		ctx context.Context,
+		Request *admin.CheckWorkflowBuildRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.CheckWorkflowBuildResponse, error)
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
@@ -76,12 +82,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeShardDistributionResponse, error)
 
+	DescribeTaskListDLQEntry(
+		ctx context.Context,
+		Request *admin.DescribeTaskListDLQEntryRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeTaskListDLQEntryResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	FailTaskListDLQEntry(
+		ctx context.Context,
+		Request *admin.FailTaskListDLQEntryRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -100,6 +118,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetDomainReplicationMessagesResponse, error)
 
+	GetDynamicConfig(
+		ctx context.Context,
+		Request *admin.GetDynamicConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetDynamicConfigResponse, error)
+
 	GetReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetReplicationMessagesRequest,
@@ -112,12 +136,42 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	ListDynamicConfig(
+		ctx context.Context,
+		Request *admin.ListDynamicConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListDynamicConfigResponse, error)
+
+	ListQuarantinedWorkflows(
+		ctx context.Context,
+		Request *admin.ListQuarantinedWorkflowsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListQuarantinedWorkflowsResponse, error)
+
 	MergeDLQMessages(
 		ctx context.Context,
 		Request *replicator.MergeDLQMessagesRequest,
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateTaskList(
+		ctx context.Context,
+		Request *admin.MigrateTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	MoveShard(
+		ctx context.Context,
+		Request *admin.MoveShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *admin.PauseTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -130,18 +184,36 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.ReadDLQMessagesResponse, error)
 
+	ReadTaskListDLQ(
+		ctx context.Context,
+		Request *admin.ReadTaskListDLQRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ReadTaskListDLQResponse, error)
+
 	ReapplyEvents(
 		ctx context.Context,
 		ReapplyEventsRequest *shared.ReapplyEventsRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	RedriveTaskListDLQEntry(
+		ctx context.Context,
+		Request *admin.RedriveTaskListDLQEntryRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	ReleaseQuarantinedWorkflow(
+		ctx context.Context,
+		Request *admin.ReleaseQuarantinedWorkflowRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
@@ -159,6 +231,30 @@ type Interface interface {
 		Request *shared.ResetQueueRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	RestoreDynamicConfig(
+		ctx context.Context,
+		Request *admin.RestoreDynamicConfigRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *admin.ResumeTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UnpinShard(
+		ctx context.Context,
+		Request *admin.UnpinShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateDynamicConfig(
+		ctx context.Context,
+		Request *admin.UpdateDynamicConfigRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -208,6 +304,29 @@ func (c client) AddSearchAttribute(
 	return
 }
 
+func (c client) CheckWorkflowBuild(
+	ctx context.Context,
+	_Request *admin.CheckWorkflowBuildRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.CheckWorkflowBuildResponse, err error) {
+
+	args := admin.AdminService_CheckWorkflowBuild_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_CheckWorkflowBuild_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_CheckWorkflowBuild_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -322,6 +441,29 @@ func (c client) DescribeShardDistribution(
 	return
 }
 
+func (c client) DescribeTaskListDLQEntry(
+	ctx context.Context,
+	_Request *admin.DescribeTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeTaskListDLQEntryResponse, err error) {
+
+	args := admin.AdminService_DescribeTaskListDLQEntry_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeTaskListDLQEntry_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeTaskListDLQEntry_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -345,6 +487,29 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) FailTaskListDLQEntry(
+	ctx context.Context,
+	_Request *admin.FailTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_FailTaskListDLQEntry_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_FailTaskListDLQEntry_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_FailTaskListDLQEntry_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetCrossClusterTasks(
 	ctx context.Context,
 	_Request *shared.GetCrossClusterTasksRequest,
@@ -414,6 +579,29 @@ func (c client) GetDomainReplicationMessages(
 	return
 }
 
+func (c client) GetDynamicConfig(
+	ctx context.Context,
+	_Request *admin.GetDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetDynamicConfigResponse, err error) {
+
+	args := admin.AdminService_GetDynamicConfig_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetDynamicConfig_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetDynamicConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetReplicationMessages(
 	ctx context.Context,
 	_Request *replicator.GetReplicationMessagesRequest,
@@ -460,6 +648,52 @@ func (c client) GetWorkflowExecutionRawHistoryV2(
 	return
 }
 
+func (c client) ListDynamicConfig(
+	ctx context.Context,
+	_Request *admin.ListDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListDynamicConfigResponse, err error) {
+
+	args := admin.AdminService_ListDynamicConfig_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListDynamicConfig_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListDynamicConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListQuarantinedWorkflows(
+	ctx context.Context,
+	_Request *admin.ListQuarantinedWorkflowsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListQuarantinedWorkflowsResponse, err error) {
+
+	args := admin.AdminService_ListQuarantinedWorkflows_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListQuarantinedWorkflows_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListQuarantinedWorkflows_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MergeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.MergeDLQMessagesRequest,
@@ -483,6 +717,75 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) MigrateTaskList(
+	ctx context.Context,
+	_Request *admin.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_MigrateTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MigrateTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_MigrateTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) MoveShard(
+	ctx context.Context,
+	_Request *admin.MoveShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_MoveShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MoveShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_MoveShard_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseTaskList(
+	ctx context.Context,
+	_Request *admin.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PauseTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PauseTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PauseTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PurgeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.PurgeDLQMessagesRequest,
@@ -529,6 +832,29 @@ func (c client) ReadDLQMessages(
 	return
 }
 
+func (c client) ReadTaskListDLQ(
+	ctx context.Context,
+	_Request *admin.ReadTaskListDLQRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ReadTaskListDLQResponse, err error) {
+
+	args := admin.AdminService_ReadTaskListDLQ_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReadTaskListDLQ_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ReadTaskListDLQ_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReapplyEvents(
 	ctx context.Context,
 	_ReapplyEventsRequest *shared.ReapplyEventsRequest,
@@ -552,6 +878,29 @@ func (c client) ReapplyEvents(
 	return
 }
 
+func (c client) RedriveTaskListDLQEntry(
+	ctx context.Context,
+	_Request *admin.RedriveTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RedriveTaskListDLQEntry_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RedriveTaskListDLQEntry_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RedriveTaskListDLQEntry_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RefreshWorkflowTasks(
 	ctx context.Context,
 	_Request *shared.RefreshWorkflowTasksRequest,
@@ -575,6 +924,29 @@ func (c client) RefreshWorkflowTasks(
 	return
 }
 
+func (c client) ReleaseQuarantinedWorkflow(
+	ctx context.Context,
+	_Request *admin.ReleaseQuarantinedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ReleaseQuarantinedWorkflow_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReleaseQuarantinedWorkflow_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ReleaseQuarantinedWorkflow_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -643,3 +1015,95 @@ func (c client) ResetQueue(
 	err = admin.AdminService_ResetQueue_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) RestoreDynamicConfig(
+	ctx context.Context,
+	_Request *admin.RestoreDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RestoreDynamicConfig_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RestoreDynamicConfig_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RestoreDynamicConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ResumeTaskList(
+	ctx context.Context,
+	_Request *admin.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResumeTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResumeTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResumeTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UnpinShard(
+	ctx context.Context,
+	_Request *admin.UnpinShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UnpinShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UnpinShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UnpinShard_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateDynamicConfig(
+	ctx context.Context,
+	_Request *admin.UpdateDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UpdateDynamicConfig_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateDynamicConfig_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UpdateDynamicConfig_Helper.UnwrapResponse(&result)
+	return
+}
@@ -45,6 +45,11 @@ type Interface interface {
 		Request *admin.AddSearchAttributeRequest,
 	) error
 
+	CheckWorkflowBuild(
+		ctx context.Context,
+		Request *admin.CheckWorkflowBuildRequest,
+	) (*admin.CheckWorkflowBuildResponse, error)
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
@@ -69,11 +74,21 @@ type Interface interface {
 		Request *shared.DescribeShardDistributionRequest,
 	) (*shared.DescribeShardDistributionResponse, error)
 
+	DescribeTaskListDLQEntry(
+		ctx context.Context,
+		Request *admin.DescribeTaskListDLQEntryRequest,
+	) (*admin.DescribeTaskListDLQEntryResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	FailTaskListDLQEntry(
+		ctx context.Context,
+		Request *admin.FailTaskListDLQEntryRequest,
+	) error
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -89,6 +104,11 @@ type Interface interface {
 		Request *replicator.GetDomainReplicationMessagesRequest,
 	) (*replicator.GetDomainReplicationMessagesResponse, error)
 
+	GetDynamicConfig(
+		ctx context.Context,
+		Request *admin.GetDynamicConfigRequest,
+	) (*admin.GetDynamicConfigResponse, error)
+
 	GetReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetReplicationMessagesRequest,
@@ -99,11 +119,36 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	ListDynamicConfig(
+		ctx context.Context,
+		Request *admin.ListDynamicConfigRequest,
+	) (*admin.ListDynamicConfigResponse, error)
+
+	ListQuarantinedWorkflows(
+		ctx context.Context,
+		Request *admin.ListQuarantinedWorkflowsRequest,
+	) (*admin.ListQuarantinedWorkflowsResponse, error)
+
 	MergeDLQMessages(
 		ctx context.Context,
 		Request *replicator.MergeDLQMessagesRequest,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateTaskList(
+		ctx context.Context,
+		Request *admin.MigrateTaskListRequest,
+	) error
+
+	MoveShard(
+		ctx context.Context,
+		Request *admin.MoveShardRequest,
+	) error
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *admin.PauseTaskListRequest,
+	) error
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
@@ -114,16 +159,31 @@ type Interface interface {
 		Request *replicator.ReadDLQMessagesRequest,
 	) (*replicator.ReadDLQMessagesResponse, error)
 
+	ReadTaskListDLQ(
+		ctx context.Context,
+		Request *admin.ReadTaskListDLQRequest,
+	) (*admin.ReadTaskListDLQResponse, error)
+
 	ReapplyEvents(
 		ctx context.Context,
 		ReapplyEventsRequest *shared.ReapplyEventsRequest,
 	) error
 
+	RedriveTaskListDLQEntry(
+		ctx context.Context,
+		Request *admin.RedriveTaskListDLQEntryRequest,
+	) error
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
 	) error
 
+	ReleaseQuarantinedWorkflow(
+		ctx context.Context,
+		Request *admin.ReleaseQuarantinedWorkflowRequest,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
@@ -138,6 +198,26 @@ type Interface interface {
 		ctx context.Context,
 		Request *shared.ResetQueueRequest,
 	) error
+
+	RestoreDynamicConfig(
+		ctx context.Context,
+		Request *admin.RestoreDynamicConfigRequest,
+	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *admin.ResumeTaskListRequest,
+	) error
+
+	UnpinShard(
+		ctx context.Context,
+		Request *admin.UnpinShardRequest,
+	) error
+
+	UpdateDynamicConfig(
+		ctx context.Context,
+		Request *admin.UpdateDynamicConfigRequest,
+	) error
 }
 
 // New prepares an implementation of the AdminService service for
@@ -162,6 +242,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "CheckWorkflowBuild",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CheckWorkflowBuild),
+				},
+				Signature:    "CheckWorkflowBuild(Request *admin.CheckWorkflowBuildRequest) (*admin.CheckWorkflowBuildResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CloseShard",
 				HandlerSpec: thrift.HandlerSpec{
@@ -217,6 +308,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeTaskListDLQEntry",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeTaskListDLQEntry),
+				},
+				Signature:    "DescribeTaskListDLQEntry(Request *admin.DescribeTaskListDLQEntryRequest) (*admin.DescribeTaskListDLQEntryResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -228,6 +330,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "FailTaskListDLQEntry",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.FailTaskListDLQEntry),
+				},
+				Signature:    "FailTaskListDLQEntry(Request *admin.FailTaskListDLQEntryRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetCrossClusterTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -261,6 +374,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetDynamicConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetDynamicConfig),
+				},
+				Signature:    "GetDynamicConfig(Request *admin.GetDynamicConfigRequest) (*admin.GetDynamicConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetReplicationMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -283,6 +407,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListDynamicConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListDynamicConfig),
+				},
+				Signature:    "ListDynamicConfig(Request *admin.ListDynamicConfigRequest) (*admin.ListDynamicConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListQuarantinedWorkflows",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListQuarantinedWorkflows),
+				},
+				Signature:    "ListQuarantinedWorkflows(Request *admin.ListQuarantinedWorkflowsRequest) (*admin.ListQuarantinedWorkflowsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "MergeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -294,6 +440,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MigrateTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MigrateTaskList),
+				},
+				Signature:    "MigrateTaskList(Request *admin.MigrateTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "MoveShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MoveShard),
+				},
+				Signature:    "MoveShard(Request *admin.MoveShardRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseTaskList),
+				},
+				Signature:    "PauseTaskList(Request *admin.PauseTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PurgeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -316,6 +495,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReadTaskListDLQ",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReadTaskListDLQ),
+				},
+				Signature:    "ReadTaskListDLQ(Request *admin.ReadTaskListDLQRequest) (*admin.ReadTaskListDLQResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReapplyEvents",
 				HandlerSpec: thrift.HandlerSpec{
@@ -327,6 +517,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RedriveTaskListDLQEntry",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RedriveTaskListDLQEntry),
+				},
+				Signature:    "RedriveTaskListDLQEntry(Request *admin.RedriveTaskListDLQEntryRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RefreshWorkflowTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -338,6 +539,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReleaseQuarantinedWorkflow",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReleaseQuarantinedWorkflow),
+				},
+				Signature:    "ReleaseQuarantinedWorkflow(Request *admin.ReleaseQuarantinedWorkflowRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RemoveTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -370,10 +582,54 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "ResetQueue(Request *shared.ResetQueueRequest)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "RestoreDynamicConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RestoreDynamicConfig),
+				},
+				Signature:    "RestoreDynamicConfig(Request *admin.RestoreDynamicConfigRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ResumeTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeTaskList),
+				},
+				Signature:    "ResumeTaskList(Request *admin.ResumeTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpinShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpinShard),
+				},
+				Signature:    "UnpinShard(Request *admin.UnpinShardRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateDynamicConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateDynamicConfig),
+				},
+				Signature:    "UpdateDynamicConfig(Request *admin.UpdateDynamicConfigRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 20)
+	procedures := make([]transport.Procedure, 0, 36)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -414,6 +670,36 @@ func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrif
 	return response, err
 }
 
+func (h handler) CheckWorkflowBuild(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_CheckWorkflowBuild_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'CheckWorkflowBuild': %w", err)
+	}
+
+	success, appErr := h.impl.CheckWorkflowBuild(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_CheckWorkflowBuild_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_CloseShard_Args
 	if err := args.FromWire(body); err != nil {
@@ -564,17 +850,17 @@ func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_DescribeWorkflowExecution_Args
+func (h handler) DescribeTaskListDLQEntry(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeTaskListDLQEntry_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'DescribeWorkflowExecution': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeTaskListDLQEntry': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.Request)
+	success, appErr := h.impl.DescribeTaskListDLQEntry(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_DescribeTaskListDLQEntry_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -594,17 +880,17 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetCrossClusterTasks_Args
+func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetCrossClusterTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeWorkflowExecution': %w", err)
 	}
 
-	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
+	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -624,17 +910,17 @@ func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetDLQReplicationMessages_Args
+func (h handler) FailTaskListDLQEntry(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_FailTaskListDLQEntry_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetDLQReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'FailTaskListDLQEntry': %w", err)
 	}
 
-	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
+	appErr := h.impl.FailTaskListDLQEntry(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_FailTaskListDLQEntry_Helper.WrapResponse(appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -654,17 +940,17 @@ func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetDomainReplicationMessages_Args
+func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetCrossClusterTasks_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetCrossClusterTasks': %w", err)
 	}
 
-	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -684,17 +970,17 @@ func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetReplicationMessages_Args
+func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDLQReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDLQReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -714,17 +1000,17 @@ func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
+func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDomainReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
+	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -744,17 +1030,17 @@ func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire
 	return response, err
 }
 
-func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_MergeDLQMessages_Args
+func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
 	}
 
-	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -774,11 +1060,251 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_PurgeDLQMessages_Args
+func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
+	}
+
+	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
+	}
+
+	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
+	}
+
+	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ListQuarantinedWorkflows(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListQuarantinedWorkflows_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ListQuarantinedWorkflows': %w", err)
+	}
+
+	success, appErr := h.impl.ListQuarantinedWorkflows(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ListQuarantinedWorkflows_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MergeDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) MigrateTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MigrateTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'MigrateTaskList': %w", err)
+	}
+
+	appErr := h.impl.MigrateTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MigrateTaskList_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) MoveShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MoveShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'MoveShard': %w", err)
+	}
+
+	appErr := h.impl.MoveShard(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MoveShard_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PauseTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PauseTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'PauseTaskList': %w", err)
+	}
+
+	appErr := h.impl.PauseTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PauseTaskList_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
 	}
 
 	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
@@ -834,6 +1360,36 @@ func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) ReadTaskListDLQ(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadTaskListDLQ_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReadTaskListDLQ': %w", err)
+	}
+
+	success, appErr := h.impl.ReadTaskListDLQ(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReadTaskListDLQ_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_ReapplyEvents_Args
 	if err := args.FromWire(body); err != nil {
@@ -864,6 +1420,36 @@ func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) RedriveTaskListDLQEntry(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RedriveTaskListDLQEntry_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RedriveTaskListDLQEntry': %w", err)
+	}
+
+	appErr := h.impl.RedriveTaskListDLQEntry(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RedriveTaskListDLQEntry_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RefreshWorkflowTasks_Args
 	if err := args.FromWire(body); err != nil {
@@ -894,6 +1480,36 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) ReleaseQuarantinedWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReleaseQuarantinedWorkflow_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReleaseQuarantinedWorkflow': %w", err)
+	}
+
+	appErr := h.impl.ReleaseQuarantinedWorkflow(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReleaseQuarantinedWorkflow_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RemoveTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -983,3 +1599,123 @@ func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Respon
 
 	return response, err
 }
+
+func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RestoreDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RestoreDynamicConfig': %w", err)
+	}
+
+	appErr := h.impl.RestoreDynamicConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RestoreDynamicConfig_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ResumeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResumeTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResumeTaskList': %w", err)
+	}
+
+	appErr := h.impl.ResumeTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResumeTaskList_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UnpinShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UnpinShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UnpinShard': %w", err)
+	}
+
+	appErr := h.impl.UnpinShard(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UnpinShard_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateDynamicConfig': %w", err)
+	}
+
+	appErr := h.impl.UpdateDynamicConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateDynamicConfig_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
@@ -70,6 +70,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	ExpediteWorkflowExecutionStart(
+		ctx context.Context,
+		ExpediteRequest *shared.ExpediteWorkflowExecutionStartRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetClusterInfo(
 		ctx context.Context,
 		opts ...yarpc.CallOption,
@@ -86,6 +92,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.GetTaskListsByDomainResponse, error)
 
+	GetWorkerBuildIDCompatibility(
+		ctx context.Context,
+		Request *shared.GetWorkerBuildIDCompatibilityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetWorkerBuildIDCompatibilityResponse, error)
+
 	GetWorkflowExecutionHistory(
 		ctx context.Context,
 		GetRequest *shared.GetWorkflowExecutionHistoryRequest,
@@ -271,6 +283,12 @@ type Interface interface {
 		UpdateRequest *shared.UpdateDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateWorkerBuildIDCompatibility(
+		ctx context.Context,
+		Request *shared.UpdateWorkerBuildIDCompatibilityRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the WorkflowService service.
@@ -412,6 +430,29 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	_ExpediteRequest *shared.ExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_ExpediteWorkflowExecutionStart_Helper.Args(_ExpediteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ExpediteWorkflowExecutionStart_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_ExpediteWorkflowExecutionStart_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetClusterInfo(
 	ctx context.Context,
 	opts ...yarpc.CallOption,
@@ -479,6 +520,29 @@ func (c client) GetTaskListsByDomain(
 	return
 }
 
+func (c client) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	_Request *shared.GetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetWorkerBuildIDCompatibilityResponse, err error) {
+
+	args := cadence.WorkflowService_GetWorkerBuildIDCompatibility_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_GetWorkerBuildIDCompatibility_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_GetWorkerBuildIDCompatibility_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	_GetRequest *shared.GetWorkflowExecutionHistoryRequest,
@@ -1191,3 +1255,26 @@ func (c client) UpdateDomain(
 	success, err = cadence.WorkflowService_UpdateDomain_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	_Request *shared.UpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UpdateWorkerBuildIDCompatibility_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UpdateWorkerBuildIDCompatibility_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UpdateWorkerBuildIDCompatibility_Helper.UnwrapResponse(&result)
+	return
+}
@@ -64,6 +64,11 @@ type Interface interface {
 		DescribeRequest *shared.DescribeWorkflowExecutionRequest,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	ExpediteWorkflowExecutionStart(
+		ctx context.Context,
+		ExpediteRequest *shared.ExpediteWorkflowExecutionStartRequest,
+	) error
+
 	GetClusterInfo(
 		ctx context.Context,
 	) (*shared.ClusterInfo, error)
@@ -77,6 +82,11 @@ type Interface interface {
 		Request *shared.GetTaskListsByDomainRequest,
 	) (*shared.GetTaskListsByDomainResponse, error)
 
+	GetWorkerBuildIDCompatibility(
+		ctx context.Context,
+		Request *shared.GetWorkerBuildIDCompatibilityRequest,
+	) (*shared.GetWorkerBuildIDCompatibilityResponse, error)
+
 	GetWorkflowExecutionHistory(
 		ctx context.Context,
 		GetRequest *shared.GetWorkflowExecutionHistoryRequest,
@@ -231,6 +241,11 @@ type Interface interface {
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateWorkerBuildIDCompatibility(
+		ctx context.Context,
+		Request *shared.UpdateWorkerBuildIDCompatibilityRequest,
+	) error
 }
 
 // New prepares an implementation of the WorkflowService service for
@@ -299,6 +314,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ExpediteWorkflowExecutionStart",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ExpediteWorkflowExecutionStart),
+				},
+				Signature:    "ExpediteWorkflowExecutionStart(ExpediteRequest *shared.ExpediteWorkflowExecutionStartRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetClusterInfo",
 				HandlerSpec: thrift.HandlerSpec{
@@ -332,6 +358,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetWorkerBuildIDCompatibility",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetWorkerBuildIDCompatibility),
+				},
+				Signature:    "GetWorkerBuildIDCompatibility(Request *shared.GetWorkerBuildIDCompatibilityRequest) (*shared.GetWorkerBuildIDCompatibilityResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetWorkflowExecutionHistory",
 				HandlerSpec: thrift.HandlerSpec{
@@ -672,10 +709,21 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateDomain(UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse)",
 				ThriftModule: cadence.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateWorkerBuildIDCompatibility",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkerBuildIDCompatibility),
+				},
+				Signature:    "UpdateWorkerBuildIDCompatibility(Request *shared.UpdateWorkerBuildIDCompatibilityRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 39)
+	procedures := make([]transport.Procedure, 0, 42)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -836,6 +884,36 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) ExpediteWorkflowExecutionStart(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ExpediteWorkflowExecutionStart_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ExpediteWorkflowExecutionStart': %w", err)
+	}
+
+	appErr := h.impl.ExpediteWorkflowExecutionStart(ctx, args.ExpediteRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ExpediteWorkflowExecutionStart_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetClusterInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_GetClusterInfo_Args
 	if err := args.FromWire(body); err != nil {
@@ -926,6 +1004,36 @@ func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) GetWorkerBuildIDCompatibility(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetWorkerBuildIDCompatibility_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetWorkerBuildIDCompatibility': %w", err)
+	}
+
+	success, appErr := h.impl.GetWorkerBuildIDCompatibility(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_GetWorkerBuildIDCompatibility_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_GetWorkflowExecutionHistory_Args
 	if err := args.FromWire(body); err != nil {
@@ -1855,3 +1963,33 @@ func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Resp
 
 	return response, err
 }
+
+func (h handler) UpdateWorkerBuildIDCompatibility(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateWorkerBuildIDCompatibility_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateWorkerBuildIDCompatibility': %w", err)
+	}
+
+	appErr := h.impl.UpdateWorkerBuildIDCompatibility(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateWorkerBuildIDCompatibility_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
@@ -229,6 +229,37 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// ExpediteWorkflowExecutionStart responds to a ExpediteWorkflowExecutionStart call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ExpediteWorkflowExecutionStart(gomock.Any(), ...).Return(...)
+// 	... := client.ExpediteWorkflowExecutionStart(...)
+func (m *MockClient) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	_ExpediteRequest *shared.ExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ExpediteRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ExpediteWorkflowExecutionStart", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ExpediteWorkflowExecutionStart(
+	ctx interface{},
+	_ExpediteRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ExpediteRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ExpediteWorkflowExecutionStart", args...)
+}
+
 // GetClusterInfo responds to a GetClusterInfo call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -324,6 +355,39 @@ func (mr *_MockClientRecorder) GetTaskListsByDomain(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListsByDomain", args...)
 }
 
+// GetWorkerBuildIDCompatibility responds to a GetWorkerBuildIDCompatibility call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().GetWorkerBuildIDCompatibility(gomock.Any(), ...).Return(...)
+// 	... := client.GetWorkerBuildIDCompatibility(...)
+func (m *MockClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	_Request *shared.GetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetWorkerBuildIDCompatibilityResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetWorkerBuildIDCompatibility", args...)
+	success, _ = ret[i].(*shared.GetWorkerBuildIDCompatibilityResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetWorkerBuildIDCompatibility(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkerBuildIDCompatibility", args...)
+}
+
 // GetWorkflowExecutionHistory responds to a GetWorkflowExecutionHistory call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1322,3 +1386,34 @@ func (mr *_MockClientRecorder) UpdateDomain(
 	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDomain", args...)
 }
+
+// UpdateWorkerBuildIDCompatibility responds to a UpdateWorkerBuildIDCompatibility call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateWorkerBuildIDCompatibility(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateWorkerBuildIDCompatibility(...)
+func (m *MockClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	_Request *shared.UpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkerBuildIDCompatibility", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkerBuildIDCompatibility(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkerBuildIDCompatibility", args...)
+}
@@ -71,6 +71,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	ExpediteWorkflowExecutionStart(
+		ctx context.Context,
+		ExpediteRequest *history.ExpediteWorkflowExecutionStartRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -427,6 +433,29 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	_ExpediteRequest *history.ExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ExpediteWorkflowExecutionStart_Helper.Args(_ExpediteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ExpediteWorkflowExecutionStart_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ExpediteWorkflowExecutionStart_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetCrossClusterTasks(
 	ctx context.Context,
 	_Request *shared.GetCrossClusterTasksRequest,
@@ -231,6 +231,37 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// ExpediteWorkflowExecutionStart responds to a ExpediteWorkflowExecutionStart call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ExpediteWorkflowExecutionStart(gomock.Any(), ...).Return(...)
+// 	... := client.ExpediteWorkflowExecutionStart(...)
+func (m *MockClient) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	_ExpediteRequest *history.ExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ExpediteRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ExpediteWorkflowExecutionStart", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ExpediteWorkflowExecutionStart(
+	ctx interface{},
+	_ExpediteRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ExpediteRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ExpediteWorkflowExecutionStart", args...)
+}
+
 // GetCrossClusterTasks responds to a GetCrossClusterTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -64,12 +64,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	GetTaskListState(
+		ctx context.Context,
+		Request *matching.GetTaskListStateRequest,
+		opts ...yarpc.CallOption,
+	) (*matching.TaskListState, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.GetTaskListsByDomainResponse, error)
 
+	GetWorkerBuildIDCompatibility(
+		ctx context.Context,
+		Request *matching.GetWorkerBuildIDCompatibilityRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetWorkerBuildIDCompatibilityResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *matching.ListTaskListPartitionsRequest,
@@ -99,6 +111,18 @@ type Interface interface {
 		Request *matching.RespondQueryTaskCompletedRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateTaskListState(
+		ctx context.Context,
+		Request *matching.UpdateTaskListStateRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateWorkerBuildIDCompatibility(
+		ctx context.Context,
+		Request *matching.UpdateWorkerBuildIDCompatibilityRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the MatchingService service.
@@ -217,6 +241,29 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) GetTaskListState(
+	ctx context.Context,
+	_Request *matching.GetTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.TaskListState, err error) {
+
+	args := matching.MatchingService_GetTaskListState_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_GetTaskListState_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = matching.MatchingService_GetTaskListState_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetTaskListsByDomain(
 	ctx context.Context,
 	_Request *shared.GetTaskListsByDomainRequest,
@@ -240,6 +287,29 @@ func (c client) GetTaskListsByDomain(
 	return
 }
 
+func (c client) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	_Request *matching.GetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetWorkerBuildIDCompatibilityResponse, err error) {
+
+	args := matching.MatchingService_GetWorkerBuildIDCompatibility_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_GetWorkerBuildIDCompatibility_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = matching.MatchingService_GetWorkerBuildIDCompatibility_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListTaskListPartitions(
 	ctx context.Context,
 	_Request *matching.ListTaskListPartitionsRequest,
@@ -354,3 +424,49 @@ func (c client) RespondQueryTaskCompleted(
 	err = matching.MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateTaskListState(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_UpdateTaskListState_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_UpdateTaskListState_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_UpdateTaskListState_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	_Request *matching.UpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_UpdateWorkerBuildIDCompatibility_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_UpdateWorkerBuildIDCompatibility_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_UpdateWorkerBuildIDCompatibility_Helper.UnwrapResponse(&result)
+	return
+}
@@ -59,11 +59,21 @@ type Interface interface {
 		Request *matching.DescribeTaskListRequest,
 	) (*shared.DescribeTaskListResponse, error)
 
+	GetTaskListState(
+		ctx context.Context,
+		Request *matching.GetTaskListStateRequest,
+	) (*matching.TaskListState, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
 	) (*shared.GetTaskListsByDomainResponse, error)
 
+	GetWorkerBuildIDCompatibility(
+		ctx context.Context,
+		Request *matching.GetWorkerBuildIDCompatibilityRequest,
+	) (*shared.GetWorkerBuildIDCompatibilityResponse, error)
+
 	ListTaskListPartitions(
 		ctx context.Context,
 		Request *matching.ListTaskListPartitionsRequest,
@@ -88,6 +98,16 @@ type Interface interface {
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
 	) error
+
+	UpdateTaskListState(
+		ctx context.Context,
+		Request *matching.UpdateTaskListStateRequest,
+	) error
+
+	UpdateWorkerBuildIDCompatibility(
+		ctx context.Context,
+		Request *matching.UpdateWorkerBuildIDCompatibilityRequest,
+	) error
 }
 
 // New prepares an implementation of the MatchingService service for
@@ -145,6 +165,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetTaskListState",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetTaskListState),
+				},
+				Signature:    "GetTaskListState(Request *matching.GetTaskListStateRequest) (*matching.TaskListState)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetTaskListsByDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -156,6 +187,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetWorkerBuildIDCompatibility",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetWorkerBuildIDCompatibility),
+				},
+				Signature:    "GetWorkerBuildIDCompatibility(Request *matching.GetWorkerBuildIDCompatibilityRequest) (*shared.GetWorkerBuildIDCompatibilityResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListTaskListPartitions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -210,10 +252,32 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "RespondQueryTaskCompleted(Request *matching.RespondQueryTaskCompletedRequest)",
 				ThriftModule: matching.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateTaskListState",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateTaskListState),
+				},
+				Signature:    "UpdateTaskListState(Request *matching.UpdateTaskListStateRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateWorkerBuildIDCompatibility",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkerBuildIDCompatibility),
+				},
+				Signature:    "UpdateWorkerBuildIDCompatibility(Request *matching.UpdateWorkerBuildIDCompatibilityRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 10)
+	procedures := make([]transport.Procedure, 0, 14)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -344,6 +408,36 @@ func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) GetTaskListState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_GetTaskListState_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'GetTaskListState': %w", err)
+	}
+
+	success, appErr := h.impl.GetTaskListState(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_GetTaskListState_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_GetTaskListsByDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -374,6 +468,36 @@ func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) GetWorkerBuildIDCompatibility(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_GetWorkerBuildIDCompatibility_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'GetWorkerBuildIDCompatibility': %w", err)
+	}
+
+	success, appErr := h.impl.GetWorkerBuildIDCompatibility(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_GetWorkerBuildIDCompatibility_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_ListTaskListPartitions_Args
 	if err := args.FromWire(body); err != nil {
@@ -523,3 +647,63 @@ func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value)
 
 	return response, err
 }
+
+func (h handler) UpdateTaskListState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_UpdateTaskListState_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'UpdateTaskListState': %w", err)
+	}
+
+	appErr := h.impl.UpdateTaskListState(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_UpdateTaskListState_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateWorkerBuildIDCompatibility(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_UpdateWorkerBuildIDCompatibility_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'MatchingService' procedure 'UpdateWorkerBuildIDCompatibility': %w", err)
+	}
+
+	appErr := h.impl.UpdateWorkerBuildIDCompatibility(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := matching.MatchingService_UpdateWorkerBuildIDCompatibility_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
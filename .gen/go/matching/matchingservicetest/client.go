@@ -193,6 +193,39 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// GetTaskListState responds to a GetTaskListState call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().GetTaskListState(gomock.Any(), ...).Return(...)
+// 	... := client.GetTaskListState(...)
+func (m *MockClient) GetTaskListState(
+	ctx context.Context,
+	_Request *matching.GetTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.TaskListState, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetTaskListState", args...)
+	success, _ = ret[i].(*matching.TaskListState)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetTaskListState(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListState", args...)
+}
+
 // GetTaskListsByDomain responds to a GetTaskListsByDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -226,6 +259,39 @@ func (mr *_MockClientRecorder) GetTaskListsByDomain(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListsByDomain", args...)
 }
 
+// GetWorkerBuildIDCompatibility responds to a GetWorkerBuildIDCompatibility call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().GetWorkerBuildIDCompatibility(gomock.Any(), ...).Return(...)
+// 	... := client.GetWorkerBuildIDCompatibility(...)
+func (m *MockClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	_Request *matching.GetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetWorkerBuildIDCompatibilityResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetWorkerBuildIDCompatibility", args...)
+	success, _ = ret[i].(*shared.GetWorkerBuildIDCompatibilityResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetWorkerBuildIDCompatibility(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkerBuildIDCompatibility", args...)
+}
+
 // ListTaskListPartitions responds to a ListTaskListPartitions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -388,3 +454,65 @@ func (mr *_MockClientRecorder) RespondQueryTaskCompleted(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondQueryTaskCompleted", args...)
 }
+
+// UpdateTaskListState responds to a UpdateTaskListState call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateTaskListState(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateTaskListState(...)
+func (m *MockClient) UpdateTaskListState(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListState", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListState(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListState", args...)
+}
+
+// UpdateWorkerBuildIDCompatibility responds to a UpdateWorkerBuildIDCompatibility call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateWorkerBuildIDCompatibility(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateWorkerBuildIDCompatibility(...)
+func (m *MockClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	_Request *matching.UpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkerBuildIDCompatibility", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkerBuildIDCompatibility(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkerBuildIDCompatibility", args...)
+}
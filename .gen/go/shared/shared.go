@@ -1601,6 +1601,8 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventId  *int64        `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
+	FairnessKey                   *string       `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a ActivityTaskScheduledEventAttributes struct into a Thrift-level intermediate
@@ -1620,7 +1622,7 @@ type ActivityTaskScheduledEventAttributes struct {
 //   }
 func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1722,6 +1724,22 @@ func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1881,6 +1899,26 @@ func (v *ActivityTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 130:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1895,7 +1933,7 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [14]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -1945,6 +1983,14 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2005,6 +2051,12 @@ func (v *ActivityTaskScheduledEventAttributes) Equals(rhs *ActivityTaskScheduled
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -2051,6 +2103,12 @@ func (v *ActivityTaskScheduledEventAttributes) MarshalLogObject(enc zapcore.Obje
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -2234,6 +2292,36 @@ func (v *ActivityTaskScheduledEventAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ActivityTaskScheduledEventAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskScheduledEventAttributes) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *ActivityTaskScheduledEventAttributes) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventId   *int64  `json:"scheduledEventId,omitempty"`
 	Identity           *string `json:"identity,omitempty"`
@@ -12380,6 +12468,7 @@ type Decision struct {
 	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
 	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
+	UpsertWorkflowMemoDecisionAttributes                     *UpsertWorkflowMemoDecisionAttributes                     `json:"upsertWorkflowMemoDecisionAttributes,omitempty"`
 }
 
 // ToWire translates a Decision struct into a Thrift-level intermediate
@@ -12399,7 +12488,7 @@ type Decision struct {
 //   }
 func (v *Decision) ToWire() (wire.Value, error) {
 	var (
-		fields [14]wire.Field
+		fields [15]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -12517,6 +12606,14 @@ func (v *Decision) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.UpsertWorkflowMemoDecisionAttributes != nil {
+		w, err = v.UpsertWorkflowMemoDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -12605,6 +12702,12 @@ func _UpsertWorkflowSearchAttributesDecisionAttributes_Read(w wire.Value) (*Upse
 	return &v, err
 }
 
+func _UpsertWorkflowMemoDecisionAttributes_Read(w wire.Value) (*UpsertWorkflowMemoDecisionAttributes, error) {
+	var v UpsertWorkflowMemoDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a Decision struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -12740,6 +12843,14 @@ func (v *Decision) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 130:
+			if field.Value.Type() == wire.TStruct {
+				v.UpsertWorkflowMemoDecisionAttributes, err = _UpsertWorkflowMemoDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -12754,7 +12865,7 @@ func (v *Decision) String() string {
 		return "<nil>"
 	}
 
-	var fields [14]string
+	var fields [15]string
 	i := 0
 	if v.DecisionType != nil {
 		fields[i] = fmt.Sprintf("DecisionType: %v", *(v.DecisionType))
@@ -12812,6 +12923,10 @@ func (v *Decision) String() string {
 		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesDecisionAttributes: %v", v.UpsertWorkflowSearchAttributesDecisionAttributes)
 		i++
 	}
+	if v.UpsertWorkflowMemoDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("UpsertWorkflowMemoDecisionAttributes: %v", v.UpsertWorkflowMemoDecisionAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("Decision{%v}", strings.Join(fields[:i], ", "))
 }
@@ -12878,6 +12993,9 @@ func (v *Decision) Equals(rhs *Decision) bool {
 	if !((v.UpsertWorkflowSearchAttributesDecisionAttributes == nil && rhs.UpsertWorkflowSearchAttributesDecisionAttributes == nil) || (v.UpsertWorkflowSearchAttributesDecisionAttributes != nil && rhs.UpsertWorkflowSearchAttributesDecisionAttributes != nil && v.UpsertWorkflowSearchAttributesDecisionAttributes.Equals(rhs.UpsertWorkflowSearchAttributesDecisionAttributes))) {
 		return false
 	}
+	if !((v.UpsertWorkflowMemoDecisionAttributes == nil && rhs.UpsertWorkflowMemoDecisionAttributes == nil) || (v.UpsertWorkflowMemoDecisionAttributes != nil && rhs.UpsertWorkflowMemoDecisionAttributes != nil && v.UpsertWorkflowMemoDecisionAttributes.Equals(rhs.UpsertWorkflowMemoDecisionAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -12930,6 +13048,9 @@ func (v *Decision) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.UpsertWorkflowSearchAttributesDecisionAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("upsertWorkflowSearchAttributesDecisionAttributes", v.UpsertWorkflowSearchAttributesDecisionAttributes))
 	}
+	if v.UpsertWorkflowMemoDecisionAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("upsertWorkflowMemoDecisionAttributes", v.UpsertWorkflowMemoDecisionAttributes))
+	}
 	return err
 }
 
@@ -13143,6 +13264,21 @@ func (v *Decision) IsSetUpsertWorkflowSearchAttributesDecisionAttributes() bool
 	return v != nil && v.UpsertWorkflowSearchAttributesDecisionAttributes != nil
 }
 
+// GetUpsertWorkflowMemoDecisionAttributes returns the value of UpsertWorkflowMemoDecisionAttributes if it is set or its
+// zero value if it is unset.
+func (v *Decision) GetUpsertWorkflowMemoDecisionAttributes() (o *UpsertWorkflowMemoDecisionAttributes) {
+	if v != nil && v.UpsertWorkflowMemoDecisionAttributes != nil {
+		return v.UpsertWorkflowMemoDecisionAttributes
+	}
+
+	return
+}
+
+// IsSetUpsertWorkflowMemoDecisionAttributes returns true if UpsertWorkflowMemoDecisionAttributes is not nil.
+func (v *Decision) IsSetUpsertWorkflowMemoDecisionAttributes() bool {
+	return v != nil && v.UpsertWorkflowMemoDecisionAttributes != nil
+}
+
 type DecisionTaskCompletedEventAttributes struct {
 	ExecutionContext []byte  `json:"executionContext,omitempty"`
 	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
@@ -13481,6 +13617,7 @@ const (
 	DecisionTaskFailedCauseBadBinary                                           DecisionTaskFailedCause = 20
 	DecisionTaskFailedCauseScheduleActivityDuplicateID                         DecisionTaskFailedCause = 21
 	DecisionTaskFailedCauseBadSearchAttributes                                 DecisionTaskFailedCause = 22
+	DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes                     DecisionTaskFailedCause = 23
 )
 
 // DecisionTaskFailedCause_Values returns all recognized values of DecisionTaskFailedCause.
@@ -13509,6 +13646,7 @@ func DecisionTaskFailedCause_Values() []DecisionTaskFailedCause {
 		DecisionTaskFailedCauseBadBinary,
 		DecisionTaskFailedCauseScheduleActivityDuplicateID,
 		DecisionTaskFailedCauseBadSearchAttributes,
+		DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes,
 	}
 }
 
@@ -13588,6 +13726,9 @@ func (v *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*v = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -13652,6 +13793,8 @@ func (v DecisionTaskFailedCause) MarshalText() ([]byte, error) {
 		return []byte("SCHEDULE_ACTIVITY_DUPLICATE_ID"), nil
 	case 22:
 		return []byte("BAD_SEARCH_ATTRIBUTES"), nil
+	case 23:
+		return []byte("BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -13709,6 +13852,8 @@ func (v DecisionTaskFailedCause) MarshalLogObject(enc zapcore.ObjectEncoder) err
 		enc.AddString("name", "SCHEDULE_ACTIVITY_DUPLICATE_ID")
 	case 22:
 		enc.AddString("name", "BAD_SEARCH_ATTRIBUTES")
+	case 23:
+		enc.AddString("name", "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES")
 	}
 	return nil
 }
@@ -13795,6 +13940,8 @@ func (v DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_ID"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -13859,6 +14006,8 @@ func (v DecisionTaskFailedCause) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"SCHEDULE_ACTIVITY_DUPLICATE_ID\""), nil
 	case 22:
 		return ([]byte)("\"BAD_SEARCH_ATTRIBUTES\""), nil
+	case 23:
+		return ([]byte)("\"BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -15546,6 +15695,7 @@ const (
 	DecisionTypeStartChildWorkflowExecution            DecisionType = 10
 	DecisionTypeSignalExternalWorkflowExecution        DecisionType = 11
 	DecisionTypeUpsertWorkflowSearchAttributes         DecisionType = 12
+	DecisionTypeUpsertWorkflowMemo                     DecisionType = 13
 )
 
 // DecisionType_Values returns all recognized values of DecisionType.
@@ -15564,6 +15714,7 @@ func DecisionType_Values() []DecisionType {
 		DecisionTypeStartChildWorkflowExecution,
 		DecisionTypeSignalExternalWorkflowExecution,
 		DecisionTypeUpsertWorkflowSearchAttributes,
+		DecisionTypeUpsertWorkflowMemo,
 	}
 }
 
@@ -15613,6 +15764,9 @@ func (v *DecisionType) UnmarshalText(value []byte) error {
 	case "UpsertWorkflowSearchAttributes":
 		*v = DecisionTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "UpsertWorkflowMemo":
+		*v = DecisionTypeUpsertWorkflowMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -15657,6 +15811,8 @@ func (v DecisionType) MarshalText() ([]byte, error) {
 		return []byte("SignalExternalWorkflowExecution"), nil
 	case 12:
 		return []byte("UpsertWorkflowSearchAttributes"), nil
+	case 13:
+		return []byte("UpsertWorkflowMemo"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -15694,6 +15850,8 @@ func (v DecisionType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "SignalExternalWorkflowExecution")
 	case 12:
 		enc.AddString("name", "UpsertWorkflowSearchAttributes")
+	case 13:
+		enc.AddString("name", "UpsertWorkflowMemo")
 	}
 	return nil
 }
@@ -15760,6 +15918,8 @@ func (v DecisionType) String() string {
 		return "SignalExternalWorkflowExecution"
 	case 12:
 		return "UpsertWorkflowSearchAttributes"
+	case 13:
+		return "UpsertWorkflowMemo"
 	}
 	return fmt.Sprintf("DecisionType(%d)", w)
 }
@@ -15804,6 +15964,8 @@ func (v DecisionType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"SignalExternalWorkflowExecution\""), nil
 	case 12:
 		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
+	case 13:
+		return ([]byte)("\"UpsertWorkflowMemo\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -16759,11 +16921,12 @@ func (v *DescribeHistoryHostRequest) IsSetExecutionForHost() bool {
 }
 
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        *int32           `json:"numberOfShards,omitempty"`
-	ShardIDs              []int32          `json:"shardIDs,omitempty"`
-	DomainCache           *DomainCacheInfo `json:"domainCache,omitempty"`
-	ShardControllerStatus *string          `json:"shardControllerStatus,omitempty"`
-	Address               *string          `json:"address,omitempty"`
+	NumberOfShards             *int32                           `json:"numberOfShards,omitempty"`
+	ShardIDs                   []int32                          `json:"shardIDs,omitempty"`
+	DomainCache                *DomainCacheInfo                 `json:"domainCache,omitempty"`
+	ShardControllerStatus      *string                          `json:"shardControllerStatus,omitempty"`
+	Address                    *string                          `json:"address,omitempty"`
+	PersistenceCircuitBreakers []*PersistenceCircuitBreakerInfo `json:"persistenceCircuitBreakers,omitempty"`
 }
 
 type _List_I32_ValueList []int32
@@ -16792,6 +16955,35 @@ func (_List_I32_ValueList) ValueType() wire.Type {
 
 func (_List_I32_ValueList) Close() {}
 
+type _List_PersistenceCircuitBreakerInfo_ValueList []*PersistenceCircuitBreakerInfo
+
+func (v _List_PersistenceCircuitBreakerInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_PersistenceCircuitBreakerInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_PersistenceCircuitBreakerInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PersistenceCircuitBreakerInfo_ValueList) Close() {}
+
 // ToWire translates a DescribeHistoryHostResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -16809,7 +17001,7 @@ func (_List_I32_ValueList) Close() {}
 //   }
 func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -16855,6 +17047,14 @@ func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.PersistenceCircuitBreakers != nil {
+		w, err = wire.NewValueList(_List_PersistenceCircuitBreakerInfo_ValueList(v.PersistenceCircuitBreakers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -16883,6 +17083,30 @@ func _DomainCacheInfo_Read(w wire.Value) (*DomainCacheInfo, error) {
 	return &v, err
 }
 
+func _PersistenceCircuitBreakerInfo_Read(w wire.Value) (*PersistenceCircuitBreakerInfo, error) {
+	var v PersistenceCircuitBreakerInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PersistenceCircuitBreakerInfo_Read(l wire.ValueList) ([]*PersistenceCircuitBreakerInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*PersistenceCircuitBreakerInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PersistenceCircuitBreakerInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeHistoryHostResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -16950,6 +17174,14 @@ func (v *DescribeHistoryHostResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.PersistenceCircuitBreakers, err = _List_PersistenceCircuitBreakerInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -16964,7 +17196,7 @@ func (v *DescribeHistoryHostResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.NumberOfShards != nil {
 		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
@@ -16986,6 +17218,10 @@ func (v *DescribeHistoryHostResponse) String() string {
 		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
 		i++
 	}
+	if v.PersistenceCircuitBreakers != nil {
+		fields[i] = fmt.Sprintf("PersistenceCircuitBreakers: %v", v.PersistenceCircuitBreakers)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -17005,6 +17241,21 @@ func _List_I32_Equals(lhs, rhs []int32) bool {
 	return true
 }
 
+func _List_PersistenceCircuitBreakerInfo_Equals(lhs, rhs []*PersistenceCircuitBreakerInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeHistoryHostResponse match the
 // provided DescribeHistoryHostResponse.
 //
@@ -17030,6 +17281,9 @@ func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) b
 	if !_String_EqualsPtr(v.Address, rhs.Address) {
 		return false
 	}
+	if !((v.PersistenceCircuitBreakers == nil && rhs.PersistenceCircuitBreakers == nil) || (v.PersistenceCircuitBreakers != nil && rhs.PersistenceCircuitBreakers != nil && _List_PersistenceCircuitBreakerInfo_Equals(v.PersistenceCircuitBreakers, rhs.PersistenceCircuitBreakers))) {
+		return false
+	}
 
 	return true
 }
@@ -17045,6 +17299,17 @@ func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error)
 	return err
 }
 
+type _List_PersistenceCircuitBreakerInfo_Zapper []*PersistenceCircuitBreakerInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_PersistenceCircuitBreakerInfo_Zapper.
+func (l _List_PersistenceCircuitBreakerInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeHistoryHostResponse.
 func (v *DescribeHistoryHostResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -17066,6 +17331,9 @@ func (v *DescribeHistoryHostResponse) MarshalLogObject(enc zapcore.ObjectEncoder
 	if v.Address != nil {
 		enc.AddString("address", *v.Address)
 	}
+	if v.PersistenceCircuitBreakers != nil {
+		err = multierr.Append(err, enc.AddArray("persistenceCircuitBreakers", (_List_PersistenceCircuitBreakerInfo_Zapper)(v.PersistenceCircuitBreakers)))
+	}
 	return err
 }
 
@@ -17144,6 +17412,21 @@ func (v *DescribeHistoryHostResponse) IsSetAddress() bool {
 	return v != nil && v.Address != nil
 }
 
+// GetPersistenceCircuitBreakers returns the value of PersistenceCircuitBreakers if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetPersistenceCircuitBreakers() (o []*PersistenceCircuitBreakerInfo) {
+	if v != nil && v.PersistenceCircuitBreakers != nil {
+		return v.PersistenceCircuitBreakers
+	}
+
+	return
+}
+
+// IsSetPersistenceCircuitBreakers returns true if PersistenceCircuitBreakers is not nil.
+func (v *DescribeHistoryHostResponse) IsSetPersistenceCircuitBreakers() bool {
+	return v != nil && v.PersistenceCircuitBreakers != nil
+}
+
 type DescribeQueueRequest struct {
 	ShardID     *int32  `json:"shardID,omitempty"`
 	ClusterName *string `json:"clusterName,omitempty"`
@@ -17759,8 +18042,10 @@ func (v *DescribeShardDistributionRequest) IsSetPageID() bool {
 }
 
 type DescribeShardDistributionResponse struct {
-	NumberOfShards *int32           `json:"numberOfShards,omitempty"`
-	Shards         map[int32]string `json:"shards,omitempty"`
+	NumberOfShards *int32                        `json:"numberOfShards,omitempty"`
+	Shards         map[int32]string              `json:"shards,omitempty"`
+	PlacementMode  *string                       `json:"placementMode,omitempty"`
+	Placements     map[int32]*ShardPlacementInfo `json:"placements,omitempty"`
 }
 
 type _Map_I32_String_MapItemList map[int32]string
@@ -17798,6 +18083,44 @@ func (_Map_I32_String_MapItemList) ValueType() wire.Type {
 
 func (_Map_I32_String_MapItemList) Close() {}
 
+type _Map_I32_ShardPlacementInfo_MapItemList map[int32]*ShardPlacementInfo
+
+func (m _Map_I32_ShardPlacementInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_ShardPlacementInfo_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_ShardPlacementInfo_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_ShardPlacementInfo_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_I32_ShardPlacementInfo_MapItemList) Close() {}
+
 // ToWire translates a DescribeShardDistributionResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -17815,7 +18138,7 @@ func (_Map_I32_String_MapItemList) Close() {}
 //   }
 func (v *DescribeShardDistributionResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -17837,6 +18160,22 @@ func (v *DescribeShardDistributionResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.PlacementMode != nil {
+		w, err = wire.NewValueString(*(v.PlacementMode)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Placements != nil {
+		w, err = wire.NewValueMap(_Map_I32_ShardPlacementInfo_MapItemList(v.Placements)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -17869,6 +18208,40 @@ func _Map_I32_String_Read(m wire.MapItemList) (map[int32]string, error) {
 	return o, err
 }
 
+func _ShardPlacementInfo_Read(w wire.Value) (*ShardPlacementInfo, error) {
+	var v ShardPlacementInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_I32_ShardPlacementInfo_Read(m wire.MapItemList) (map[int32]*ShardPlacementInfo, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[int32]*ShardPlacementInfo, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ShardPlacementInfo_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeShardDistributionResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -17908,6 +18281,24 @@ func (v *DescribeShardDistributionResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PlacementMode = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TMap {
+				v.Placements, err = _Map_I32_ShardPlacementInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -17922,7 +18313,7 @@ func (v *DescribeShardDistributionResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
 	if v.NumberOfShards != nil {
 		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
@@ -17932,6 +18323,14 @@ func (v *DescribeShardDistributionResponse) String() string {
 		fields[i] = fmt.Sprintf("Shards: %v", v.Shards)
 		i++
 	}
+	if v.PlacementMode != nil {
+		fields[i] = fmt.Sprintf("PlacementMode: %v", *(v.PlacementMode))
+		i++
+	}
+	if v.Placements != nil {
+		fields[i] = fmt.Sprintf("Placements: %v", v.Placements)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeShardDistributionResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -17953,6 +18352,23 @@ func _Map_I32_String_Equals(lhs, rhs map[int32]string) bool {
 	return true
 }
 
+func _Map_I32_ShardPlacementInfo_Equals(lhs, rhs map[int32]*ShardPlacementInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeShardDistributionResponse match the
 // provided DescribeShardDistributionResponse.
 //
@@ -17969,6 +18385,12 @@ func (v *DescribeShardDistributionResponse) Equals(rhs *DescribeShardDistributio
 	if !((v.Shards == nil && rhs.Shards == nil) || (v.Shards != nil && rhs.Shards != nil && _Map_I32_String_Equals(v.Shards, rhs.Shards))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.PlacementMode, rhs.PlacementMode) {
+		return false
+	}
+	if !((v.Placements == nil && rhs.Placements == nil) || (v.Placements != nil && rhs.Placements != nil && _Map_I32_ShardPlacementInfo_Equals(v.Placements, rhs.Placements))) {
+		return false
+	}
 
 	return true
 }
@@ -17997,6 +18419,30 @@ func (m _Map_I32_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err e
 	return err
 }
 
+type _Map_I32_ShardPlacementInfo_Item_Zapper struct {
+	Key   int32
+	Value *ShardPlacementInfo
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ShardPlacementInfo_Item_Zapper.
+func (v _Map_I32_ShardPlacementInfo_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
+}
+
+type _Map_I32_ShardPlacementInfo_Zapper map[int32]*ShardPlacementInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ShardPlacementInfo_Zapper.
+func (m _Map_I32_ShardPlacementInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_ShardPlacementInfo_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeShardDistributionResponse.
 func (v *DescribeShardDistributionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -18009,6 +18455,12 @@ func (v *DescribeShardDistributionResponse) MarshalLogObject(enc zapcore.ObjectE
 	if v.Shards != nil {
 		err = multierr.Append(err, enc.AddArray("shards", (_Map_I32_String_Zapper)(v.Shards)))
 	}
+	if v.PlacementMode != nil {
+		enc.AddString("placementMode", *v.PlacementMode)
+	}
+	if v.Placements != nil {
+		err = multierr.Append(err, enc.AddArray("placements", (_Map_I32_ShardPlacementInfo_Zapper)(v.Placements)))
+	}
 	return err
 }
 
@@ -18042,11 +18494,42 @@ func (v *DescribeShardDistributionResponse) IsSetShards() bool {
 	return v != nil && v.Shards != nil
 }
 
+// GetPlacementMode returns the value of PlacementMode if it is set or its
+// zero value if it is unset.
+func (v *DescribeShardDistributionResponse) GetPlacementMode() (o string) {
+	if v != nil && v.PlacementMode != nil {
+		return *v.PlacementMode
+	}
+
+	return
+}
+
+// IsSetPlacementMode returns true if PlacementMode is not nil.
+func (v *DescribeShardDistributionResponse) IsSetPlacementMode() bool {
+	return v != nil && v.PlacementMode != nil
+}
+
+// GetPlacements returns the value of Placements if it is set or its
+// zero value if it is unset.
+func (v *DescribeShardDistributionResponse) GetPlacements() (o map[int32]*ShardPlacementInfo) {
+	if v != nil && v.Placements != nil {
+		return v.Placements
+	}
+
+	return
+}
+
+// IsSetPlacements returns true if Placements is not nil.
+func (v *DescribeShardDistributionResponse) IsSetPlacements() bool {
+	return v != nil && v.Placements != nil
+}
+
 type DescribeTaskListRequest struct {
 	Domain                *string       `json:"domain,omitempty"`
 	TaskList              *TaskList     `json:"taskList,omitempty"`
 	TaskListType          *TaskListType `json:"taskListType,omitempty"`
 	IncludeTaskListStatus *bool         `json:"includeTaskListStatus,omitempty"`
+	IncludePartitions     *bool         `json:"includePartitions,omitempty"`
 }
 
 // ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
@@ -18066,7 +18549,7 @@ type DescribeTaskListRequest struct {
 //   }
 func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -18104,6 +18587,14 @@ func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.IncludePartitions != nil {
+		w, err = wire.NewValueBool(*(v.IncludePartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -18173,6 +18664,16 @@ func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IncludePartitions = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -18187,7 +18688,7 @@ func (v *DescribeTaskListRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -18205,6 +18706,10 @@ func (v *DescribeTaskListRequest) String() string {
 		fields[i] = fmt.Sprintf("IncludeTaskListStatus: %v", *(v.IncludeTaskListStatus))
 		i++
 	}
+	if v.IncludePartitions != nil {
+		fields[i] = fmt.Sprintf("IncludePartitions: %v", *(v.IncludePartitions))
+		i++
+	}
 
 	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -18241,6 +18746,9 @@ func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
 	if !_Bool_EqualsPtr(v.IncludeTaskListStatus, rhs.IncludeTaskListStatus) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.IncludePartitions, rhs.IncludePartitions) {
+		return false
+	}
 
 	return true
 }
@@ -18263,6 +18771,9 @@ func (v *DescribeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.IncludeTaskListStatus != nil {
 		enc.AddBool("includeTaskListStatus", *v.IncludeTaskListStatus)
 	}
+	if v.IncludePartitions != nil {
+		enc.AddBool("includePartitions", *v.IncludePartitions)
+	}
 	return err
 }
 
@@ -18326,9 +18837,27 @@ func (v *DescribeTaskListRequest) IsSetIncludeTaskListStatus() bool {
 	return v != nil && v.IncludeTaskListStatus != nil
 }
 
+// GetIncludePartitions returns the value of IncludePartitions if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListRequest) GetIncludePartitions() (o bool) {
+	if v != nil && v.IncludePartitions != nil {
+		return *v.IncludePartitions
+	}
+
+	return
+}
+
+// IsSetIncludePartitions returns true if IncludePartitions is not nil.
+func (v *DescribeTaskListRequest) IsSetIncludePartitions() bool {
+	return v != nil && v.IncludePartitions != nil
+}
+
 type DescribeTaskListResponse struct {
-	Pollers        []*PollerInfo   `json:"pollers,omitempty"`
-	TaskListStatus *TaskListStatus `json:"taskListStatus,omitempty"`
+	Pollers          []*PollerInfo              `json:"pollers,omitempty"`
+	TaskListStatus   *TaskListStatus            `json:"taskListStatus,omitempty"`
+	PartitionConfig  *TaskListPartitionConfig   `json:"partitionConfig,omitempty"`
+	PollersByBuildId map[string]int32           `json:"pollersByBuildId,omitempty"`
+	PartitionStatus  map[string]*TaskListStatus `json:"partitionStatus,omitempty"`
 }
 
 type _List_PollerInfo_ValueList []*PollerInfo
@@ -18360,6 +18889,79 @@ func (_List_PollerInfo_ValueList) ValueType() wire.Type {
 
 func (_List_PollerInfo_ValueList) Close() {}
 
+type _Map_String_I32_MapItemList map[string]int32
+
+func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_MapItemList) Close() {}
+
+type _Map_String_TaskListStatus_MapItemList map[string]*TaskListStatus
+
+func (m _Map_String_TaskListStatus_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_TaskListStatus_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_TaskListStatus_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_TaskListStatus_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_TaskListStatus_MapItemList) Close() {}
+
 // ToWire translates a DescribeTaskListResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -18377,7 +18979,7 @@ func (_List_PollerInfo_ValueList) Close() {}
 //   }
 func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -18399,6 +19001,30 @@ func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.PartitionConfig != nil {
+		w, err = v.PartitionConfig.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PollersByBuildId != nil {
+		w, err = wire.NewValueMap(_Map_String_I32_MapItemList(v.PollersByBuildId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.PartitionStatus != nil {
+		w, err = wire.NewValueMap(_Map_String_TaskListStatus_MapItemList(v.PartitionStatus)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -18433,6 +19059,68 @@ func _TaskListStatus_Read(w wire.Value) (*TaskListStatus, error) {
 	return &v, err
 }
 
+func _TaskListPartitionConfig_Read(w wire.Value) (*TaskListPartitionConfig, error) {
+	var v TaskListPartitionConfig
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_TaskListStatus_Read(m wire.MapItemList) (map[string]*TaskListStatus, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*TaskListStatus, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _TaskListStatus_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeTaskListResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -18470,6 +19158,30 @@ func (v *DescribeTaskListResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.PartitionConfig, err = _TaskListPartitionConfig_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TMap {
+				v.PollersByBuildId, err = _Map_String_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TMap {
+				v.PartitionStatus, err = _Map_String_TaskListStatus_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -18484,7 +19196,7 @@ func (v *DescribeTaskListResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [5]string
 	i := 0
 	if v.Pollers != nil {
 		fields[i] = fmt.Sprintf("Pollers: %v", v.Pollers)
@@ -18494,6 +19206,18 @@ func (v *DescribeTaskListResponse) String() string {
 		fields[i] = fmt.Sprintf("TaskListStatus: %v", v.TaskListStatus)
 		i++
 	}
+	if v.PartitionConfig != nil {
+		fields[i] = fmt.Sprintf("PartitionConfig: %v", v.PartitionConfig)
+		i++
+	}
+	if v.PollersByBuildId != nil {
+		fields[i] = fmt.Sprintf("PollersByBuildId: %v", v.PollersByBuildId)
+		i++
+	}
+	if v.PartitionStatus != nil {
+		fields[i] = fmt.Sprintf("PartitionStatus: %v", v.PartitionStatus)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeTaskListResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -18513,6 +19237,40 @@ func _List_PollerInfo_Equals(lhs, rhs []*PollerInfo) bool {
 	return true
 }
 
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_TaskListStatus_Equals(lhs, rhs map[string]*TaskListStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeTaskListResponse match the
 // provided DescribeTaskListResponse.
 //
@@ -18529,6 +19287,15 @@ func (v *DescribeTaskListResponse) Equals(rhs *DescribeTaskListResponse) bool {
 	if !((v.TaskListStatus == nil && rhs.TaskListStatus == nil) || (v.TaskListStatus != nil && rhs.TaskListStatus != nil && v.TaskListStatus.Equals(rhs.TaskListStatus))) {
 		return false
 	}
+	if !((v.PartitionConfig == nil && rhs.PartitionConfig == nil) || (v.PartitionConfig != nil && rhs.PartitionConfig != nil && v.PartitionConfig.Equals(rhs.PartitionConfig))) {
+		return false
+	}
+	if !((v.PollersByBuildId == nil && rhs.PollersByBuildId == nil) || (v.PollersByBuildId != nil && rhs.PollersByBuildId != nil && _Map_String_I32_Equals(v.PollersByBuildId, rhs.PollersByBuildId))) {
+		return false
+	}
+	if !((v.PartitionStatus == nil && rhs.PartitionStatus == nil) || (v.PartitionStatus != nil && rhs.PartitionStatus != nil && _Map_String_TaskListStatus_Equals(v.PartitionStatus, rhs.PartitionStatus))) {
+		return false
+	}
 
 	return true
 }
@@ -18544,6 +19311,28 @@ func (l _List_PollerInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err
 	return err
 }
 
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return err
+}
+
+type _Map_String_TaskListStatus_Zapper map[string]*TaskListStatus
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_TaskListStatus_Zapper.
+func (m _Map_String_TaskListStatus_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeTaskListResponse.
 func (v *DescribeTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -18556,6 +19345,15 @@ func (v *DescribeTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (
 	if v.TaskListStatus != nil {
 		err = multierr.Append(err, enc.AddObject("taskListStatus", v.TaskListStatus))
 	}
+	if v.PartitionConfig != nil {
+		err = multierr.Append(err, enc.AddObject("partitionConfig", v.PartitionConfig))
+	}
+	if v.PollersByBuildId != nil {
+		err = multierr.Append(err, enc.AddObject("pollersByBuildId", (_Map_String_I32_Zapper)(v.PollersByBuildId)))
+	}
+	if v.PartitionStatus != nil {
+		err = multierr.Append(err, enc.AddObject("partitionStatus", (_Map_String_TaskListStatus_Zapper)(v.PartitionStatus)))
+	}
 	return err
 }
 
@@ -18589,6 +19387,51 @@ func (v *DescribeTaskListResponse) IsSetTaskListStatus() bool {
 	return v != nil && v.TaskListStatus != nil
 }
 
+// GetPartitionConfig returns the value of PartitionConfig if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListResponse) GetPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
+	}
+
+	return
+}
+
+// IsSetPartitionConfig returns true if PartitionConfig is not nil.
+func (v *DescribeTaskListResponse) IsSetPartitionConfig() bool {
+	return v != nil && v.PartitionConfig != nil
+}
+
+// GetPollersByBuildId returns the value of PollersByBuildId if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListResponse) GetPollersByBuildId() (o map[string]int32) {
+	if v != nil && v.PollersByBuildId != nil {
+		return v.PollersByBuildId
+	}
+
+	return
+}
+
+// IsSetPollersByBuildId returns true if PollersByBuildId is not nil.
+func (v *DescribeTaskListResponse) IsSetPollersByBuildId() bool {
+	return v != nil && v.PollersByBuildId != nil
+}
+
+// GetPartitionStatus returns the value of PartitionStatus if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListResponse) GetPartitionStatus() (o map[string]*TaskListStatus) {
+	if v != nil && v.PartitionStatus != nil {
+		return v.PartitionStatus
+	}
+
+	return
+}
+
+// IsSetPartitionStatus returns true if PartitionStatus is not nil.
+func (v *DescribeTaskListResponse) IsSetPartitionStatus() bool {
+	return v != nil && v.PartitionStatus != nil
+}
+
 type DescribeWorkflowExecutionRequest struct {
 	Domain    *string            `json:"domain,omitempty"`
 	Execution *WorkflowExecution `json:"execution,omitempty"`
@@ -19579,6 +20422,7 @@ type DomainConfiguration struct {
 	HistoryArchivalURI                     *string         `json:"historyArchivalURI,omitempty"`
 	VisibilityArchivalStatus               *ArchivalStatus `json:"visibilityArchivalStatus,omitempty"`
 	VisibilityArchivalURI                  *string         `json:"visibilityArchivalURI,omitempty"`
+	DrainedIsolationGroups                 []string        `json:"drainedIsolationGroups,omitempty"`
 }
 
 // ToWire translates a DomainConfiguration struct into a Thrift-level intermediate
@@ -19598,7 +20442,7 @@ type DomainConfiguration struct {
 //   }
 func (v *DomainConfiguration) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -19660,6 +20504,14 @@ func (v *DomainConfiguration) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.DrainedIsolationGroups != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.DrainedIsolationGroups)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -19765,6 +20617,14 @@ func (v *DomainConfiguration) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TList {
+				v.DrainedIsolationGroups, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -19779,7 +20639,7 @@ func (v *DomainConfiguration) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.WorkflowExecutionRetentionPeriodInDays != nil {
 		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
@@ -19809,6 +20669,10 @@ func (v *DomainConfiguration) String() string {
 		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
 		i++
 	}
+	if v.DrainedIsolationGroups != nil {
+		fields[i] = fmt.Sprintf("DrainedIsolationGroups: %v", v.DrainedIsolationGroups)
+		i++
+	}
 
 	return fmt.Sprintf("DomainConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
@@ -19854,6 +20718,9 @@ func (v *DomainConfiguration) Equals(rhs *DomainConfiguration) bool {
 	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
 		return false
 	}
+	if !((v.DrainedIsolationGroups == nil && rhs.DrainedIsolationGroups == nil) || (v.DrainedIsolationGroups != nil && rhs.DrainedIsolationGroups != nil && _List_String_Equals(v.DrainedIsolationGroups, rhs.DrainedIsolationGroups))) {
+		return false
+	}
 
 	return true
 }
@@ -19885,6 +20752,9 @@ func (v *DomainConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.VisibilityArchivalURI != nil {
 		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
 	}
+	if v.DrainedIsolationGroups != nil {
+		err = multierr.Append(err, enc.AddArray("drainedIsolationGroups", (_List_String_Zapper)(v.DrainedIsolationGroups)))
+	}
 	return err
 }
 
@@ -19993,6 +20863,21 @@ func (v *DomainConfiguration) IsSetVisibilityArchivalURI() bool {
 	return v != nil && v.VisibilityArchivalURI != nil
 }
 
+// GetDrainedIsolationGroups returns the value of DrainedIsolationGroups if it is set or its
+// zero value if it is unset.
+func (v *DomainConfiguration) GetDrainedIsolationGroups() (o []string) {
+	if v != nil && v.DrainedIsolationGroups != nil {
+		return v.DrainedIsolationGroups
+	}
+
+	return
+}
+
+// IsSetDrainedIsolationGroups returns true if DrainedIsolationGroups is not nil.
+func (v *DomainConfiguration) IsSetDrainedIsolationGroups() bool {
+	return v != nil && v.DrainedIsolationGroups != nil
+}
+
 type DomainInfo struct {
 	Name        *string           `json:"name,omitempty"`
 	Status      *DomainStatus     `json:"status,omitempty"`
@@ -21591,6 +22476,7 @@ const (
 	EventTypeSignalExternalWorkflowExecutionFailed           EventType = 39
 	EventTypeExternalWorkflowExecutionSignaled               EventType = 40
 	EventTypeUpsertWorkflowSearchAttributes                  EventType = 41
+	EventTypeUpsertWorkflowMemo                              EventType = 42
 )
 
 // EventType_Values returns all recognized values of EventType.
@@ -21638,6 +22524,7 @@ func EventType_Values() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeUpsertWorkflowMemo,
 	}
 }
 
@@ -21774,6 +22661,9 @@ func (v *EventType) UnmarshalText(value []byte) error {
 	case "UpsertWorkflowSearchAttributes":
 		*v = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "UpsertWorkflowMemo":
+		*v = EventTypeUpsertWorkflowMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -21876,6 +22766,8 @@ func (v EventType) MarshalText() ([]byte, error) {
 		return []byte("ExternalWorkflowExecutionSignaled"), nil
 	case 41:
 		return []byte("UpsertWorkflowSearchAttributes"), nil
+	case 42:
+		return []byte("UpsertWorkflowMemo"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -21971,6 +22863,8 @@ func (v EventType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "ExternalWorkflowExecutionSignaled")
 	case 41:
 		enc.AddString("name", "UpsertWorkflowSearchAttributes")
+	case 42:
+		enc.AddString("name", "UpsertWorkflowMemo")
 	}
 	return nil
 }
@@ -22095,6 +22989,8 @@ func (v EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "UpsertWorkflowMemo"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -22197,6 +23093,8 @@ func (v EventType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"ExternalWorkflowExecutionSignaled\""), nil
 	case 41:
 		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
+	case 42:
+		return ([]byte)("\"UpsertWorkflowMemo\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -22237,6 +23135,230 @@ func (v *EventType) UnmarshalJSON(text []byte) error {
 	}
 }
 
+type ExpediteWorkflowExecutionStartRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+}
+
+// ToWire translates a ExpediteWorkflowExecutionStartRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ExpediteWorkflowExecutionStartRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ExpediteWorkflowExecutionStartRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ExpediteWorkflowExecutionStartRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ExpediteWorkflowExecutionStartRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ExpediteWorkflowExecutionStartRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ExpediteWorkflowExecutionStartRequest
+// struct.
+func (v *ExpediteWorkflowExecutionStartRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("ExpediteWorkflowExecutionStartRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ExpediteWorkflowExecutionStartRequest match the
+// provided ExpediteWorkflowExecutionStartRequest.
+//
+// This function performs a deep comparison.
+func (v *ExpediteWorkflowExecutionStartRequest) Equals(rhs *ExpediteWorkflowExecutionStartRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ExpediteWorkflowExecutionStartRequest.
+func (v *ExpediteWorkflowExecutionStartRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ExpediteWorkflowExecutionStartRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ExpediteWorkflowExecutionStartRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *ExpediteWorkflowExecutionStartRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ExpediteWorkflowExecutionStartRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *ExpediteWorkflowExecutionStartRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *ExpediteWorkflowExecutionStartRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
 type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
 	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
 	Domain            *string            `json:"domain,omitempty"`
@@ -24543,6 +25665,401 @@ func (v *GetTaskListsByDomainResponse) IsSetTaskListNames() bool {
 	return v != nil && v.TaskListNames != nil
 }
 
+type GetWorkerBuildIDCompatibilityRequest struct {
+	Domain   *string   `json:"domain,omitempty"`
+	TaskList *TaskList `json:"taskList,omitempty"`
+}
+
+// ToWire translates a GetWorkerBuildIDCompatibilityRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkerBuildIDCompatibilityRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetWorkerBuildIDCompatibilityRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkerBuildIDCompatibilityRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkerBuildIDCompatibilityRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkerBuildIDCompatibilityRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkerBuildIDCompatibilityRequest
+// struct.
+func (v *GetWorkerBuildIDCompatibilityRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkerBuildIDCompatibilityRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetWorkerBuildIDCompatibilityRequest match the
+// provided GetWorkerBuildIDCompatibilityRequest.
+//
+// This function performs a deep comparison.
+func (v *GetWorkerBuildIDCompatibilityRequest) Equals(rhs *GetWorkerBuildIDCompatibilityRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkerBuildIDCompatibilityRequest.
+func (v *GetWorkerBuildIDCompatibilityRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *GetWorkerBuildIDCompatibilityRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *GetWorkerBuildIDCompatibilityRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *GetWorkerBuildIDCompatibilityRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *GetWorkerBuildIDCompatibilityRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+type GetWorkerBuildIDCompatibilityResponse struct {
+	VersionSets []*WorkerVersionSet `json:"versionSets,omitempty"`
+}
+
+type _List_WorkerVersionSet_ValueList []*WorkerVersionSet
+
+func (v _List_WorkerVersionSet_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_WorkerVersionSet_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_WorkerVersionSet_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkerVersionSet_ValueList) Close() {}
+
+// ToWire translates a GetWorkerBuildIDCompatibilityResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkerBuildIDCompatibilityResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.VersionSets != nil {
+		w, err = wire.NewValueList(_List_WorkerVersionSet_ValueList(v.VersionSets)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkerVersionSet_Read(w wire.Value) (*WorkerVersionSet, error) {
+	var v WorkerVersionSet
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_WorkerVersionSet_Read(l wire.ValueList) ([]*WorkerVersionSet, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*WorkerVersionSet, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkerVersionSet_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetWorkerBuildIDCompatibilityResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkerBuildIDCompatibilityResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkerBuildIDCompatibilityResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkerBuildIDCompatibilityResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.VersionSets, err = _List_WorkerVersionSet_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkerBuildIDCompatibilityResponse
+// struct.
+func (v *GetWorkerBuildIDCompatibilityResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.VersionSets != nil {
+		fields[i] = fmt.Sprintf("VersionSets: %v", v.VersionSets)
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkerBuildIDCompatibilityResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_WorkerVersionSet_Equals(lhs, rhs []*WorkerVersionSet) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetWorkerBuildIDCompatibilityResponse match the
+// provided GetWorkerBuildIDCompatibilityResponse.
+//
+// This function performs a deep comparison.
+func (v *GetWorkerBuildIDCompatibilityResponse) Equals(rhs *GetWorkerBuildIDCompatibilityResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.VersionSets == nil && rhs.VersionSets == nil) || (v.VersionSets != nil && rhs.VersionSets != nil && _List_WorkerVersionSet_Equals(v.VersionSets, rhs.VersionSets))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_WorkerVersionSet_Zapper []*WorkerVersionSet
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkerVersionSet_Zapper.
+func (l _List_WorkerVersionSet_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkerBuildIDCompatibilityResponse.
+func (v *GetWorkerBuildIDCompatibilityResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.VersionSets != nil {
+		err = multierr.Append(err, enc.AddArray("versionSets", (_List_WorkerVersionSet_Zapper)(v.VersionSets)))
+	}
+	return err
+}
+
+// GetVersionSets returns the value of VersionSets if it is set or its
+// zero value if it is unset.
+func (v *GetWorkerBuildIDCompatibilityResponse) GetVersionSets() (o []*WorkerVersionSet) {
+	if v != nil && v.VersionSets != nil {
+		return v.VersionSets
+	}
+
+	return
+}
+
+// IsSetVersionSets returns true if VersionSets is not nil.
+func (v *GetWorkerBuildIDCompatibilityResponse) IsSetVersionSets() bool {
+	return v != nil && v.VersionSets != nil
+}
+
 type GetWorkflowExecutionHistoryRequest struct {
 	Domain                 *string                 `json:"domain,omitempty"`
 	Execution              *WorkflowExecution      `json:"execution,omitempty"`
@@ -26328,6 +27845,7 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	UpsertWorkflowMemoEventAttributes                              *UpsertWorkflowMemoEventAttributes                              `json:"upsertWorkflowMemoEventAttributes,omitempty"`
 }
 
 // ToWire translates a HistoryEvent struct into a Thrift-level intermediate
@@ -26347,7 +27865,7 @@ type HistoryEvent struct {
 //   }
 func (v *HistoryEvent) ToWire() (wire.Value, error) {
 	var (
-		fields [47]wire.Field
+		fields [48]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -26729,6 +28247,14 @@ func (v *HistoryEvent) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 450, Value: w}
 		i++
 	}
+	if v.UpsertWorkflowMemoEventAttributes != nil {
+		w, err = v.UpsertWorkflowMemoEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 460, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -26985,6 +28511,12 @@ func _UpsertWorkflowSearchAttributesEventAttributes_Read(w wire.Value) (*UpsertW
 	return &v, err
 }
 
+func _UpsertWorkflowMemoEventAttributes_Read(w wire.Value) (*UpsertWorkflowMemoEventAttributes, error) {
+	var v UpsertWorkflowMemoEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a HistoryEvent struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -27392,6 +28924,14 @@ func (v *HistoryEvent) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 460:
+			if field.Value.Type() == wire.TStruct {
+				v.UpsertWorkflowMemoEventAttributes, err = _UpsertWorkflowMemoEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -27406,7 +28946,7 @@ func (v *HistoryEvent) String() string {
 		return "<nil>"
 	}
 
-	var fields [47]string
+	var fields [48]string
 	i := 0
 	if v.EventId != nil {
 		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
@@ -27596,6 +29136,10 @@ func (v *HistoryEvent) String() string {
 		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes: %v", v.UpsertWorkflowSearchAttributesEventAttributes)
 		i++
 	}
+	if v.UpsertWorkflowMemoEventAttributes != nil {
+		fields[i] = fmt.Sprintf("UpsertWorkflowMemoEventAttributes: %v", v.UpsertWorkflowMemoEventAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
 }
@@ -27761,6 +29305,9 @@ func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
 	if !((v.UpsertWorkflowSearchAttributesEventAttributes == nil && rhs.UpsertWorkflowSearchAttributesEventAttributes == nil) || (v.UpsertWorkflowSearchAttributesEventAttributes != nil && rhs.UpsertWorkflowSearchAttributesEventAttributes != nil && v.UpsertWorkflowSearchAttributesEventAttributes.Equals(rhs.UpsertWorkflowSearchAttributesEventAttributes))) {
 		return false
 	}
+	if !((v.UpsertWorkflowMemoEventAttributes == nil && rhs.UpsertWorkflowMemoEventAttributes == nil) || (v.UpsertWorkflowMemoEventAttributes != nil && rhs.UpsertWorkflowMemoEventAttributes != nil && v.UpsertWorkflowMemoEventAttributes.Equals(rhs.UpsertWorkflowMemoEventAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -27912,6 +29459,9 @@ func (v *HistoryEvent) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("upsertWorkflowSearchAttributesEventAttributes", v.UpsertWorkflowSearchAttributesEventAttributes))
 	}
+	if v.UpsertWorkflowMemoEventAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("upsertWorkflowMemoEventAttributes", v.UpsertWorkflowMemoEventAttributes))
+	}
 	return err
 }
 
@@ -28620,6 +30170,21 @@ func (v *HistoryEvent) IsSetUpsertWorkflowSearchAttributesEventAttributes() bool
 	return v != nil && v.UpsertWorkflowSearchAttributesEventAttributes != nil
 }
 
+// GetUpsertWorkflowMemoEventAttributes returns the value of UpsertWorkflowMemoEventAttributes if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetUpsertWorkflowMemoEventAttributes() (o *UpsertWorkflowMemoEventAttributes) {
+	if v != nil && v.UpsertWorkflowMemoEventAttributes != nil {
+		return v.UpsertWorkflowMemoEventAttributes
+	}
+
+	return
+}
+
+// IsSetUpsertWorkflowMemoEventAttributes returns true if UpsertWorkflowMemoEventAttributes is not nil.
+func (v *HistoryEvent) IsSetUpsertWorkflowMemoEventAttributes() bool {
+	return v != nil && v.UpsertWorkflowMemoEventAttributes != nil
+}
+
 type HistoryEventFilterType int32
 
 const (
@@ -31705,8 +33270,10 @@ func (v *ListTaskListPartitionsRequest) IsSetTaskList() bool {
 }
 
 type ListTaskListPartitionsResponse struct {
-	ActivityTaskListPartitions []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
-	DecisionTaskListPartitions []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
+	ActivityTaskListPartitions      []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
+	DecisionTaskListPartitions      []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
+	ActivityTaskListPartitionConfig *TaskListPartitionConfig     `json:"activityTaskListPartitionConfig,omitempty"`
+	DecisionTaskListPartitionConfig *TaskListPartitionConfig     `json:"decisionTaskListPartitionConfig,omitempty"`
 }
 
 type _List_TaskListPartitionMetadata_ValueList []*TaskListPartitionMetadata
@@ -31755,7 +33322,7 @@ func (_List_TaskListPartitionMetadata_ValueList) Close() {}
 //   }
 func (v *ListTaskListPartitionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -31777,6 +33344,22 @@ func (v *ListTaskListPartitionsResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ActivityTaskListPartitionConfig != nil {
+		w, err = v.ActivityTaskListPartitionConfig.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.DecisionTaskListPartitionConfig != nil {
+		w, err = v.DecisionTaskListPartitionConfig.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -31842,6 +33425,22 @@ func (v *ListTaskListPartitionsResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityTaskListPartitionConfig, err = _TaskListPartitionConfig_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionTaskListPartitionConfig, err = _TaskListPartitionConfig_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -31856,7 +33455,7 @@ func (v *ListTaskListPartitionsResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
 	if v.ActivityTaskListPartitions != nil {
 		fields[i] = fmt.Sprintf("ActivityTaskListPartitions: %v", v.ActivityTaskListPartitions)
@@ -31866,6 +33465,14 @@ func (v *ListTaskListPartitionsResponse) String() string {
 		fields[i] = fmt.Sprintf("DecisionTaskListPartitions: %v", v.DecisionTaskListPartitions)
 		i++
 	}
+	if v.ActivityTaskListPartitionConfig != nil {
+		fields[i] = fmt.Sprintf("ActivityTaskListPartitionConfig: %v", v.ActivityTaskListPartitionConfig)
+		i++
+	}
+	if v.DecisionTaskListPartitionConfig != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskListPartitionConfig: %v", v.DecisionTaskListPartitionConfig)
+		i++
+	}
 
 	return fmt.Sprintf("ListTaskListPartitionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -31901,6 +33508,12 @@ func (v *ListTaskListPartitionsResponse) Equals(rhs *ListTaskListPartitionsRespo
 	if !((v.DecisionTaskListPartitions == nil && rhs.DecisionTaskListPartitions == nil) || (v.DecisionTaskListPartitions != nil && rhs.DecisionTaskListPartitions != nil && _List_TaskListPartitionMetadata_Equals(v.DecisionTaskListPartitions, rhs.DecisionTaskListPartitions))) {
 		return false
 	}
+	if !((v.ActivityTaskListPartitionConfig == nil && rhs.ActivityTaskListPartitionConfig == nil) || (v.ActivityTaskListPartitionConfig != nil && rhs.ActivityTaskListPartitionConfig != nil && v.ActivityTaskListPartitionConfig.Equals(rhs.ActivityTaskListPartitionConfig))) {
+		return false
+	}
+	if !((v.DecisionTaskListPartitionConfig == nil && rhs.DecisionTaskListPartitionConfig == nil) || (v.DecisionTaskListPartitionConfig != nil && rhs.DecisionTaskListPartitionConfig != nil && v.DecisionTaskListPartitionConfig.Equals(rhs.DecisionTaskListPartitionConfig))) {
+		return false
+	}
 
 	return true
 }
@@ -31928,6 +33541,12 @@ func (v *ListTaskListPartitionsResponse) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.DecisionTaskListPartitions != nil {
 		err = multierr.Append(err, enc.AddArray("decisionTaskListPartitions", (_List_TaskListPartitionMetadata_Zapper)(v.DecisionTaskListPartitions)))
 	}
+	if v.ActivityTaskListPartitionConfig != nil {
+		err = multierr.Append(err, enc.AddObject("activityTaskListPartitionConfig", v.ActivityTaskListPartitionConfig))
+	}
+	if v.DecisionTaskListPartitionConfig != nil {
+		err = multierr.Append(err, enc.AddObject("decisionTaskListPartitionConfig", v.DecisionTaskListPartitionConfig))
+	}
 	return err
 }
 
@@ -31961,6 +33580,36 @@ func (v *ListTaskListPartitionsResponse) IsSetDecisionTaskListPartitions() bool
 	return v != nil && v.DecisionTaskListPartitions != nil
 }
 
+// GetActivityTaskListPartitionConfig returns the value of ActivityTaskListPartitionConfig if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListPartitionsResponse) GetActivityTaskListPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.ActivityTaskListPartitionConfig != nil {
+		return v.ActivityTaskListPartitionConfig
+	}
+
+	return
+}
+
+// IsSetActivityTaskListPartitionConfig returns true if ActivityTaskListPartitionConfig is not nil.
+func (v *ListTaskListPartitionsResponse) IsSetActivityTaskListPartitionConfig() bool {
+	return v != nil && v.ActivityTaskListPartitionConfig != nil
+}
+
+// GetDecisionTaskListPartitionConfig returns the value of DecisionTaskListPartitionConfig if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListPartitionsResponse) GetDecisionTaskListPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.DecisionTaskListPartitionConfig != nil {
+		return v.DecisionTaskListPartitionConfig
+	}
+
+	return
+}
+
+// IsSetDecisionTaskListPartitionConfig returns true if DecisionTaskListPartitionConfig is not nil.
+func (v *ListTaskListPartitionsResponse) IsSetDecisionTaskListPartitionConfig() bool {
+	return v != nil && v.DecisionTaskListPartitionConfig != nil
+}
+
 type ListWorkflowExecutionsRequest struct {
 	Domain        *string `json:"domain,omitempty"`
 	PageSize      *int32  `json:"pageSize,omitempty"`
@@ -33007,6 +34656,7 @@ type PendingActivityInfo struct {
 	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
 	LastWorkerIdentity     *string               `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
+	NextRetryTimestamp     *int64                `json:"nextRetryTimestamp,omitempty"`
 }
 
 // ToWire translates a PendingActivityInfo struct into a Thrift-level intermediate
@@ -33026,7 +34676,7 @@ type PendingActivityInfo struct {
 //   }
 func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -33136,6 +34786,14 @@ func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
+	if v.NextRetryTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.NextRetryTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -33291,6 +34949,16 @@ func (v *PendingActivityInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 140:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextRetryTimestamp = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -33305,7 +34973,7 @@ func (v *PendingActivityInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [14]string
 	i := 0
 	if v.ActivityID != nil {
 		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
@@ -33359,6 +35027,10 @@ func (v *PendingActivityInfo) String() string {
 		fields[i] = fmt.Sprintf("LastFailureDetails: %v", v.LastFailureDetails)
 		i++
 	}
+	if v.NextRetryTimestamp != nil {
+		fields[i] = fmt.Sprintf("NextRetryTimestamp: %v", *(v.NextRetryTimestamp))
+		i++
+	}
 
 	return fmt.Sprintf("PendingActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -33422,6 +35094,9 @@ func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
 	if !((v.LastFailureDetails == nil && rhs.LastFailureDetails == nil) || (v.LastFailureDetails != nil && rhs.LastFailureDetails != nil && bytes.Equal(v.LastFailureDetails, rhs.LastFailureDetails))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.NextRetryTimestamp, rhs.NextRetryTimestamp) {
+		return false
+	}
 
 	return true
 }
@@ -33471,6 +35146,9 @@ func (v *PendingActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.LastFailureDetails != nil {
 		enc.AddString("lastFailureDetails", base64.StdEncoding.EncodeToString(v.LastFailureDetails))
 	}
+	if v.NextRetryTimestamp != nil {
+		enc.AddInt64("nextRetryTimestamp", *v.NextRetryTimestamp)
+	}
 	return err
 }
 
@@ -33669,6 +35347,21 @@ func (v *PendingActivityInfo) IsSetLastFailureDetails() bool {
 	return v != nil && v.LastFailureDetails != nil
 }
 
+// GetNextRetryTimestamp returns the value of NextRetryTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetNextRetryTimestamp() (o int64) {
+	if v != nil && v.NextRetryTimestamp != nil {
+		return *v.NextRetryTimestamp
+	}
+
+	return
+}
+
+// IsSetNextRetryTimestamp returns true if NextRetryTimestamp is not nil.
+func (v *PendingActivityInfo) IsSetNextRetryTimestamp() bool {
+	return v != nil && v.NextRetryTimestamp != nil
+}
+
 type PendingActivityState int32
 
 const (
@@ -34684,11 +36377,283 @@ func (v *PendingDecisionState) UnmarshalJSON(text []byte) error {
 	}
 }
 
+type PersistenceCircuitBreakerInfo struct {
+	StoreName                *string `json:"storeName,omitempty"`
+	OperationClass           *string `json:"operationClass,omitempty"`
+	State                    *string `json:"state,omitempty"`
+	LastStateChangeTimestamp *int64  `json:"lastStateChangeTimestamp,omitempty"`
+}
+
+// ToWire translates a PersistenceCircuitBreakerInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PersistenceCircuitBreakerInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.StoreName != nil {
+		w, err = wire.NewValueString(*(v.StoreName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.OperationClass != nil {
+		w, err = wire.NewValueString(*(v.OperationClass)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.State != nil {
+		w, err = wire.NewValueString(*(v.State)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.LastStateChangeTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastStateChangeTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PersistenceCircuitBreakerInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PersistenceCircuitBreakerInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PersistenceCircuitBreakerInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PersistenceCircuitBreakerInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StoreName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OperationClass = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.State = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastStateChangeTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PersistenceCircuitBreakerInfo
+// struct.
+func (v *PersistenceCircuitBreakerInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.StoreName != nil {
+		fields[i] = fmt.Sprintf("StoreName: %v", *(v.StoreName))
+		i++
+	}
+	if v.OperationClass != nil {
+		fields[i] = fmt.Sprintf("OperationClass: %v", *(v.OperationClass))
+		i++
+	}
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+		i++
+	}
+	if v.LastStateChangeTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastStateChangeTimestamp: %v", *(v.LastStateChangeTimestamp))
+		i++
+	}
+
+	return fmt.Sprintf("PersistenceCircuitBreakerInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PersistenceCircuitBreakerInfo match the
+// provided PersistenceCircuitBreakerInfo.
+//
+// This function performs a deep comparison.
+func (v *PersistenceCircuitBreakerInfo) Equals(rhs *PersistenceCircuitBreakerInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.StoreName, rhs.StoreName) {
+		return false
+	}
+	if !_String_EqualsPtr(v.OperationClass, rhs.OperationClass) {
+		return false
+	}
+	if !_String_EqualsPtr(v.State, rhs.State) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastStateChangeTimestamp, rhs.LastStateChangeTimestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PersistenceCircuitBreakerInfo.
+func (v *PersistenceCircuitBreakerInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.StoreName != nil {
+		enc.AddString("storeName", *v.StoreName)
+	}
+	if v.OperationClass != nil {
+		enc.AddString("operationClass", *v.OperationClass)
+	}
+	if v.State != nil {
+		enc.AddString("state", *v.State)
+	}
+	if v.LastStateChangeTimestamp != nil {
+		enc.AddInt64("lastStateChangeTimestamp", *v.LastStateChangeTimestamp)
+	}
+	return err
+}
+
+// GetStoreName returns the value of StoreName if it is set or its
+// zero value if it is unset.
+func (v *PersistenceCircuitBreakerInfo) GetStoreName() (o string) {
+	if v != nil && v.StoreName != nil {
+		return *v.StoreName
+	}
+
+	return
+}
+
+// IsSetStoreName returns true if StoreName is not nil.
+func (v *PersistenceCircuitBreakerInfo) IsSetStoreName() bool {
+	return v != nil && v.StoreName != nil
+}
+
+// GetOperationClass returns the value of OperationClass if it is set or its
+// zero value if it is unset.
+func (v *PersistenceCircuitBreakerInfo) GetOperationClass() (o string) {
+	if v != nil && v.OperationClass != nil {
+		return *v.OperationClass
+	}
+
+	return
+}
+
+// IsSetOperationClass returns true if OperationClass is not nil.
+func (v *PersistenceCircuitBreakerInfo) IsSetOperationClass() bool {
+	return v != nil && v.OperationClass != nil
+}
+
+// GetState returns the value of State if it is set or its
+// zero value if it is unset.
+func (v *PersistenceCircuitBreakerInfo) GetState() (o string) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+
+	return
+}
+
+// IsSetState returns true if State is not nil.
+func (v *PersistenceCircuitBreakerInfo) IsSetState() bool {
+	return v != nil && v.State != nil
+}
+
+// GetLastStateChangeTimestamp returns the value of LastStateChangeTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PersistenceCircuitBreakerInfo) GetLastStateChangeTimestamp() (o int64) {
+	if v != nil && v.LastStateChangeTimestamp != nil {
+		return *v.LastStateChangeTimestamp
+	}
+
+	return
+}
+
+// IsSetLastStateChangeTimestamp returns true if LastStateChangeTimestamp is not nil.
+func (v *PersistenceCircuitBreakerInfo) IsSetLastStateChangeTimestamp() bool {
+	return v != nil && v.LastStateChangeTimestamp != nil
+}
+
 type PollForActivityTaskRequest struct {
 	Domain           *string           `json:"domain,omitempty"`
 	TaskList         *TaskList         `json:"taskList,omitempty"`
 	Identity         *string           `json:"identity,omitempty"`
 	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+	BinaryChecksum   *string           `json:"binaryChecksum,omitempty"`
+	IsolationGroup   *string           `json:"isolationGroup,omitempty"`
 }
 
 // ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
@@ -34708,7 +36673,7 @@ type PollForActivityTaskRequest struct {
 //   }
 func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -34746,6 +36711,22 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -34813,6 +36794,26 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -34827,7 +36828,7 @@ func (v *PollForActivityTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -34845,6 +36846,14 @@ func (v *PollForActivityTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("TaskListMetadata: %v", v.TaskListMetadata)
 		i++
 	}
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+		i++
+	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
 
 	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -34871,6 +36880,12 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 	if !((v.TaskListMetadata == nil && rhs.TaskListMetadata == nil) || (v.TaskListMetadata != nil && rhs.TaskListMetadata != nil && v.TaskListMetadata.Equals(rhs.TaskListMetadata))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+		return false
+	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
 
 	return true
 }
@@ -34893,6 +36908,12 @@ func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.TaskListMetadata != nil {
 		err = multierr.Append(err, enc.AddObject("taskListMetadata", v.TaskListMetadata))
 	}
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
 	return err
 }
 
@@ -34956,6 +36977,36 @@ func (v *PollForActivityTaskRequest) IsSetTaskListMetadata() bool {
 	return v != nil && v.TaskListMetadata != nil
 }
 
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetBinaryChecksum() (o string) {
+	if v != nil && v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
+	}
+
+	return
+}
+
+// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
+func (v *PollForActivityTaskRequest) IsSetBinaryChecksum() bool {
+	return v != nil && v.BinaryChecksum != nil
+}
+
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *PollForActivityTaskRequest) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
 type PollForActivityTaskResponse struct {
 	TaskToken                       []byte             `json:"taskToken,omitempty"`
 	WorkflowExecution               *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -35745,6 +37796,7 @@ type PollForDecisionTaskRequest struct {
 	TaskList       *TaskList `json:"taskList,omitempty"`
 	Identity       *string   `json:"identity,omitempty"`
 	BinaryChecksum *string   `json:"binaryChecksum,omitempty"`
+	IsolationGroup *string   `json:"isolationGroup,omitempty"`
 }
 
 // ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
@@ -35764,7 +37816,7 @@ type PollForDecisionTaskRequest struct {
 //   }
 func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -35802,6 +37854,14 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -35865,6 +37925,16 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -35879,7 +37949,7 @@ func (v *PollForDecisionTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -35897,6 +37967,10 @@ func (v *PollForDecisionTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
 		i++
 	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
 
 	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -35923,6 +37997,9 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
 		return false
 	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
 
 	return true
 }
@@ -35945,6 +38022,9 @@ func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.BinaryChecksum != nil {
 		enc.AddString("binaryChecksum", *v.BinaryChecksum)
 	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
 	return err
 }
 
@@ -36008,6 +38088,21 @@ func (v *PollForDecisionTaskRequest) IsSetBinaryChecksum() bool {
 	return v != nil && v.BinaryChecksum != nil
 }
 
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *PollForDecisionTaskRequest) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
 type PollForDecisionTaskResponse struct {
 	TaskToken                 []byte                    `json:"taskToken,omitempty"`
 	WorkflowExecution         *WorkflowExecution        `json:"workflowExecution,omitempty"`
@@ -36850,6 +38945,8 @@ type PollerInfo struct {
 	LastAccessTime *int64   `json:"lastAccessTime,omitempty"`
 	Identity       *string  `json:"identity,omitempty"`
 	RatePerSecond  *float64 `json:"ratePerSecond,omitempty"`
+	BuildId        *string  `json:"buildId,omitempty"`
+	IsolationGroup *string  `json:"isolationGroup,omitempty"`
 }
 
 // ToWire translates a PollerInfo struct into a Thrift-level intermediate
@@ -36869,7 +38966,7 @@ type PollerInfo struct {
 //   }
 func (v *PollerInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -36899,6 +38996,22 @@ func (v *PollerInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.BuildId != nil {
+		w, err = wire.NewValueString(*(v.BuildId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -36954,6 +39067,26 @@ func (v *PollerInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BuildId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -36968,7 +39101,7 @@ func (v *PollerInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
 	if v.LastAccessTime != nil {
 		fields[i] = fmt.Sprintf("LastAccessTime: %v", *(v.LastAccessTime))
@@ -36982,6 +39115,14 @@ func (v *PollerInfo) String() string {
 		fields[i] = fmt.Sprintf("RatePerSecond: %v", *(v.RatePerSecond))
 		i++
 	}
+	if v.BuildId != nil {
+		fields[i] = fmt.Sprintf("BuildId: %v", *(v.BuildId))
+		i++
+	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
 
 	return fmt.Sprintf("PollerInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -37015,6 +39156,12 @@ func (v *PollerInfo) Equals(rhs *PollerInfo) bool {
 	if !_Double_EqualsPtr(v.RatePerSecond, rhs.RatePerSecond) {
 		return false
 	}
+	if !_String_EqualsPtr(v.BuildId, rhs.BuildId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
 
 	return true
 }
@@ -37034,6 +39181,12 @@ func (v *PollerInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.RatePerSecond != nil {
 		enc.AddFloat64("ratePerSecond", *v.RatePerSecond)
 	}
+	if v.BuildId != nil {
+		enc.AddString("buildId", *v.BuildId)
+	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
 	return err
 }
 
@@ -37082,6 +39235,36 @@ func (v *PollerInfo) IsSetRatePerSecond() bool {
 	return v != nil && v.RatePerSecond != nil
 }
 
+// GetBuildId returns the value of BuildId if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetBuildId() (o string) {
+	if v != nil && v.BuildId != nil {
+		return *v.BuildId
+	}
+
+	return
+}
+
+// IsSetBuildId returns true if BuildId is not nil.
+func (v *PollerInfo) IsSetBuildId() bool {
+	return v != nil && v.BuildId != nil
+}
+
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *PollerInfo) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
 type QueryConsistencyLevel int32
 
 const (
@@ -48123,15 +50306,232 @@ func (v *RespondQueryTaskCompletedRequest) IsSetWorkerVersionInfo() bool {
 	return v != nil && v.WorkerVersionInfo != nil
 }
 
+type RetryJitterMode int32
+
+const (
+	RetryJitterModeNone  RetryJitterMode = 0
+	RetryJitterModeFull  RetryJitterMode = 1
+	RetryJitterModeEqual RetryJitterMode = 2
+)
+
+// RetryJitterMode_Values returns all recognized values of RetryJitterMode.
+func RetryJitterMode_Values() []RetryJitterMode {
+	return []RetryJitterMode{
+		RetryJitterModeNone,
+		RetryJitterModeFull,
+		RetryJitterModeEqual,
+	}
+}
+
+// UnmarshalText tries to decode RetryJitterMode from a byte slice
+// containing its name.
+//
+//   var v RetryJitterMode
+//   err := v.UnmarshalText([]byte("NONE"))
+func (v *RetryJitterMode) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "NONE":
+		*v = RetryJitterModeNone
+		return nil
+	case "FULL":
+		*v = RetryJitterModeFull
+		return nil
+	case "EQUAL":
+		*v = RetryJitterModeEqual
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "RetryJitterMode", err)
+		}
+		*v = RetryJitterMode(val)
+		return nil
+	}
+}
+
+// MarshalText encodes RetryJitterMode to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v RetryJitterMode) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("NONE"), nil
+	case 1:
+		return []byte("FULL"), nil
+	case 2:
+		return []byte("EQUAL"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RetryJitterMode.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v RetryJitterMode) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "NONE")
+	case 1:
+		enc.AddString("name", "FULL")
+	case 2:
+		enc.AddString("name", "EQUAL")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v RetryJitterMode) Ptr() *RetryJitterMode {
+	return &v
+}
+
+// ToWire translates RetryJitterMode into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v RetryJitterMode) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes RetryJitterMode from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return RetryJitterMode(0), err
+//   }
+//
+//   var v RetryJitterMode
+//   if err := v.FromWire(x); err != nil {
+//     return RetryJitterMode(0), err
+//   }
+//   return v, nil
+func (v *RetryJitterMode) FromWire(w wire.Value) error {
+	*v = (RetryJitterMode)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of RetryJitterMode.
+func (v RetryJitterMode) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "NONE"
+	case 1:
+		return "FULL"
+	case 2:
+		return "EQUAL"
+	}
+	return fmt.Sprintf("RetryJitterMode(%d)", w)
+}
+
+// Equals returns true if this RetryJitterMode value matches the provided
+// value.
+func (v RetryJitterMode) Equals(rhs RetryJitterMode) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes RetryJitterMode into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v RetryJitterMode) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"NONE\""), nil
+	case 1:
+		return ([]byte)("\"FULL\""), nil
+	case 2:
+		return ([]byte)("\"EQUAL\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode RetryJitterMode from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *RetryJitterMode) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "RetryJitterMode")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "RetryJitterMode")
+		}
+		*v = (RetryJitterMode)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "RetryJitterMode")
+	}
+}
+
 type RetryPolicy struct {
-	InitialIntervalInSeconds    *int32   `json:"initialIntervalInSeconds,omitempty"`
-	BackoffCoefficient          *float64 `json:"backoffCoefficient,omitempty"`
-	MaximumIntervalInSeconds    *int32   `json:"maximumIntervalInSeconds,omitempty"`
-	MaximumAttempts             *int32   `json:"maximumAttempts,omitempty"`
-	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
-	ExpirationIntervalInSeconds *int32   `json:"expirationIntervalInSeconds,omitempty"`
+	InitialIntervalInSeconds         *int32               `json:"initialIntervalInSeconds,omitempty"`
+	BackoffCoefficient               *float64             `json:"backoffCoefficient,omitempty"`
+	MaximumIntervalInSeconds         *int32               `json:"maximumIntervalInSeconds,omitempty"`
+	MaximumAttempts                  *int32               `json:"maximumAttempts,omitempty"`
+	NonRetriableErrorReasons         []string             `json:"nonRetriableErrorReasons,omitempty"`
+	ExpirationIntervalInSeconds      *int32               `json:"expirationIntervalInSeconds,omitempty"`
+	BackoffScheduleInSeconds         []int32              `json:"backoffScheduleInSeconds,omitempty"`
+	JitterMode                       *RetryJitterMode     `json:"jitterMode,omitempty"`
+	ReasonPolicies                   []*RetryReasonPolicy `json:"reasonPolicies,omitempty"`
+	MaximumTotalElapsedTimeInSeconds *int32               `json:"maximumTotalElapsedTimeInSeconds,omitempty"`
 }
 
+type _List_RetryReasonPolicy_ValueList []*RetryReasonPolicy
+
+func (v _List_RetryReasonPolicy_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_RetryReasonPolicy_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_RetryReasonPolicy_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_RetryReasonPolicy_ValueList) Close() {}
+
 // ToWire translates a RetryPolicy struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -48149,7 +50549,7 @@ type RetryPolicy struct {
 //   }
 func (v *RetryPolicy) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -48203,10 +50603,72 @@ func (v *RetryPolicy) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.BackoffScheduleInSeconds != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.BackoffScheduleInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.JitterMode != nil {
+		w, err = v.JitterMode.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.ReasonPolicies != nil {
+		w, err = wire.NewValueList(_List_RetryReasonPolicy_ValueList(v.ReasonPolicies)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.MaximumTotalElapsedTimeInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.MaximumTotalElapsedTimeInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _RetryJitterMode_Read(w wire.Value) (RetryJitterMode, error) {
+	var v RetryJitterMode
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _RetryReasonPolicy_Read(w wire.Value) (*RetryReasonPolicy, error) {
+	var v RetryReasonPolicy
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_RetryReasonPolicy_Read(l wire.ValueList) ([]*RetryReasonPolicy, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*RetryReasonPolicy, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _RetryReasonPolicy_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a RetryPolicy struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -48286,6 +50748,42 @@ func (v *RetryPolicy) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TList {
+				v.BackoffScheduleInSeconds, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x RetryJitterMode
+				x, err = _RetryJitterMode_Read(field.Value)
+				v.JitterMode = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TList {
+				v.ReasonPolicies, err = _List_RetryReasonPolicy_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumTotalElapsedTimeInSeconds = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -48300,7 +50798,7 @@ func (v *RetryPolicy) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [10]string
 	i := 0
 	if v.InitialIntervalInSeconds != nil {
 		fields[i] = fmt.Sprintf("InitialIntervalInSeconds: %v", *(v.InitialIntervalInSeconds))
@@ -48326,10 +50824,51 @@ func (v *RetryPolicy) String() string {
 		fields[i] = fmt.Sprintf("ExpirationIntervalInSeconds: %v", *(v.ExpirationIntervalInSeconds))
 		i++
 	}
+	if v.BackoffScheduleInSeconds != nil {
+		fields[i] = fmt.Sprintf("BackoffScheduleInSeconds: %v", v.BackoffScheduleInSeconds)
+		i++
+	}
+	if v.JitterMode != nil {
+		fields[i] = fmt.Sprintf("JitterMode: %v", *(v.JitterMode))
+		i++
+	}
+	if v.ReasonPolicies != nil {
+		fields[i] = fmt.Sprintf("ReasonPolicies: %v", v.ReasonPolicies)
+		i++
+	}
+	if v.MaximumTotalElapsedTimeInSeconds != nil {
+		fields[i] = fmt.Sprintf("MaximumTotalElapsedTimeInSeconds: %v", *(v.MaximumTotalElapsedTimeInSeconds))
+		i++
+	}
 
 	return fmt.Sprintf("RetryPolicy{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _RetryJitterMode_EqualsPtr(lhs, rhs *RetryJitterMode) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_RetryReasonPolicy_Equals(lhs, rhs []*RetryReasonPolicy) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this RetryPolicy match the
 // provided RetryPolicy.
 //
@@ -48358,10 +50897,33 @@ func (v *RetryPolicy) Equals(rhs *RetryPolicy) bool {
 	if !_I32_EqualsPtr(v.ExpirationIntervalInSeconds, rhs.ExpirationIntervalInSeconds) {
 		return false
 	}
+	if !((v.BackoffScheduleInSeconds == nil && rhs.BackoffScheduleInSeconds == nil) || (v.BackoffScheduleInSeconds != nil && rhs.BackoffScheduleInSeconds != nil && _List_I32_Equals(v.BackoffScheduleInSeconds, rhs.BackoffScheduleInSeconds))) {
+		return false
+	}
+	if !_RetryJitterMode_EqualsPtr(v.JitterMode, rhs.JitterMode) {
+		return false
+	}
+	if !((v.ReasonPolicies == nil && rhs.ReasonPolicies == nil) || (v.ReasonPolicies != nil && rhs.ReasonPolicies != nil && _List_RetryReasonPolicy_Equals(v.ReasonPolicies, rhs.ReasonPolicies))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumTotalElapsedTimeInSeconds, rhs.MaximumTotalElapsedTimeInSeconds) {
+		return false
+	}
 
 	return true
 }
 
+type _List_RetryReasonPolicy_Zapper []*RetryReasonPolicy
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_RetryReasonPolicy_Zapper.
+func (l _List_RetryReasonPolicy_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of RetryPolicy.
 func (v *RetryPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -48386,6 +50948,18 @@ func (v *RetryPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.ExpirationIntervalInSeconds != nil {
 		enc.AddInt32("expirationIntervalInSeconds", *v.ExpirationIntervalInSeconds)
 	}
+	if v.BackoffScheduleInSeconds != nil {
+		err = multierr.Append(err, enc.AddArray("backoffScheduleInSeconds", (_List_I32_Zapper)(v.BackoffScheduleInSeconds)))
+	}
+	if v.JitterMode != nil {
+		err = multierr.Append(err, enc.AddObject("jitterMode", *v.JitterMode))
+	}
+	if v.ReasonPolicies != nil {
+		err = multierr.Append(err, enc.AddArray("reasonPolicies", (_List_RetryReasonPolicy_Zapper)(v.ReasonPolicies)))
+	}
+	if v.MaximumTotalElapsedTimeInSeconds != nil {
+		enc.AddInt32("maximumTotalElapsedTimeInSeconds", *v.MaximumTotalElapsedTimeInSeconds)
+	}
 	return err
 }
 
@@ -48479,6 +51053,246 @@ func (v *RetryPolicy) IsSetExpirationIntervalInSeconds() bool {
 	return v != nil && v.ExpirationIntervalInSeconds != nil
 }
 
+// GetBackoffScheduleInSeconds returns the value of BackoffScheduleInSeconds if it is set or its
+// zero value if it is unset.
+func (v *RetryPolicy) GetBackoffScheduleInSeconds() (o []int32) {
+	if v != nil && v.BackoffScheduleInSeconds != nil {
+		return v.BackoffScheduleInSeconds
+	}
+
+	return
+}
+
+// IsSetBackoffScheduleInSeconds returns true if BackoffScheduleInSeconds is not nil.
+func (v *RetryPolicy) IsSetBackoffScheduleInSeconds() bool {
+	return v != nil && v.BackoffScheduleInSeconds != nil
+}
+
+// GetJitterMode returns the value of JitterMode if it is set or its
+// zero value if it is unset.
+func (v *RetryPolicy) GetJitterMode() (o RetryJitterMode) {
+	if v != nil && v.JitterMode != nil {
+		return *v.JitterMode
+	}
+
+	return
+}
+
+// IsSetJitterMode returns true if JitterMode is not nil.
+func (v *RetryPolicy) IsSetJitterMode() bool {
+	return v != nil && v.JitterMode != nil
+}
+
+// GetReasonPolicies returns the value of ReasonPolicies if it is set or its
+// zero value if it is unset.
+func (v *RetryPolicy) GetReasonPolicies() (o []*RetryReasonPolicy) {
+	if v != nil && v.ReasonPolicies != nil {
+		return v.ReasonPolicies
+	}
+
+	return
+}
+
+// IsSetReasonPolicies returns true if ReasonPolicies is not nil.
+func (v *RetryPolicy) IsSetReasonPolicies() bool {
+	return v != nil && v.ReasonPolicies != nil
+}
+
+// GetMaximumTotalElapsedTimeInSeconds returns the value of MaximumTotalElapsedTimeInSeconds if it is set or its
+// zero value if it is unset.
+func (v *RetryPolicy) GetMaximumTotalElapsedTimeInSeconds() (o int32) {
+	if v != nil && v.MaximumTotalElapsedTimeInSeconds != nil {
+		return *v.MaximumTotalElapsedTimeInSeconds
+	}
+
+	return
+}
+
+// IsSetMaximumTotalElapsedTimeInSeconds returns true if MaximumTotalElapsedTimeInSeconds is not nil.
+func (v *RetryPolicy) IsSetMaximumTotalElapsedTimeInSeconds() bool {
+	return v != nil && v.MaximumTotalElapsedTimeInSeconds != nil
+}
+
+type RetryReasonPolicy struct {
+	ReasonPattern *string      `json:"reasonPattern,omitempty"`
+	RetryPolicy   *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// ToWire translates a RetryReasonPolicy struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RetryReasonPolicy) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ReasonPattern != nil {
+		w, err = wire.NewValueString(*(v.ReasonPattern)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RetryReasonPolicy struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RetryReasonPolicy struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RetryReasonPolicy
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RetryReasonPolicy) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ReasonPattern = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RetryReasonPolicy
+// struct.
+func (v *RetryReasonPolicy) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.ReasonPattern != nil {
+		fields[i] = fmt.Sprintf("ReasonPattern: %v", *(v.ReasonPattern))
+		i++
+	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+
+	return fmt.Sprintf("RetryReasonPolicy{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RetryReasonPolicy match the
+// provided RetryReasonPolicy.
+//
+// This function performs a deep comparison.
+func (v *RetryReasonPolicy) Equals(rhs *RetryReasonPolicy) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ReasonPattern, rhs.ReasonPattern) {
+		return false
+	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RetryReasonPolicy.
+func (v *RetryReasonPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ReasonPattern != nil {
+		enc.AddString("reasonPattern", *v.ReasonPattern)
+	}
+	if v.RetryPolicy != nil {
+		err = multierr.Append(err, enc.AddObject("retryPolicy", v.RetryPolicy))
+	}
+	return err
+}
+
+// GetReasonPattern returns the value of ReasonPattern if it is set or its
+// zero value if it is unset.
+func (v *RetryReasonPolicy) GetReasonPattern() (o string) {
+	if v != nil && v.ReasonPattern != nil {
+		return *v.ReasonPattern
+	}
+
+	return
+}
+
+// IsSetReasonPattern returns true if ReasonPattern is not nil.
+func (v *RetryReasonPolicy) IsSetReasonPattern() bool {
+	return v != nil && v.ReasonPattern != nil
+}
+
+// GetRetryPolicy returns the value of RetryPolicy if it is set or its
+// zero value if it is unset.
+func (v *RetryReasonPolicy) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+
+	return
+}
+
+// IsSetRetryPolicy returns true if RetryPolicy is not nil.
+func (v *RetryReasonPolicy) IsSetRetryPolicy() bool {
+	return v != nil && v.RetryPolicy != nil
+}
+
 type RetryTaskV2Error struct {
 	Message           string  `json:"message,required"`
 	DomainId          *string `json:"domainId,omitempty"`
@@ -48940,6 +51754,8 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          *bool         `json:"requestLocalDispatch,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
+	FairnessKey                   *string       `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a ScheduleActivityTaskDecisionAttributes struct into a Thrift-level intermediate
@@ -48959,7 +51775,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 //   }
 func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -49061,6 +51877,22 @@ func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -49196,6 +52028,26 @@ func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -49210,7 +52062,7 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [14]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -49260,6 +52112,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("RequestLocalDispatch: %v", *(v.RequestLocalDispatch))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("ScheduleActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -49310,6 +52170,12 @@ func (v *ScheduleActivityTaskDecisionAttributes) Equals(rhs *ScheduleActivityTas
 	if !_Bool_EqualsPtr(v.RequestLocalDispatch, rhs.RequestLocalDispatch) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -49356,6 +52222,12 @@ func (v *ScheduleActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.Ob
 	if v.RequestLocalDispatch != nil {
 		enc.AddBool("requestLocalDispatch", *v.RequestLocalDispatch)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -49539,6 +52411,36 @@ func (v *ScheduleActivityTaskDecisionAttributes) IsSetRequestLocalDispatch() boo
 	return v != nil && v.RequestLocalDispatch != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ScheduleActivityTaskDecisionAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *ScheduleActivityTaskDecisionAttributes) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *ScheduleActivityTaskDecisionAttributes) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
 }
@@ -49676,7 +52578,8 @@ func (v *SearchAttributes) IsSetIndexedFields() bool {
 }
 
 type ServiceBusyError struct {
-	Message string `json:"message,required"`
+	Message                string `json:"message,required"`
+	RetryAfterMilliseconds *int64 `json:"retryAfterMilliseconds,omitempty"`
 }
 
 // ToWire translates a ServiceBusyError struct into a Thrift-level intermediate
@@ -49696,7 +52599,7 @@ type ServiceBusyError struct {
 //   }
 func (v *ServiceBusyError) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -49708,6 +52611,14 @@ func (v *ServiceBusyError) ToWire() (wire.Value, error) {
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
+	if v.RetryAfterMilliseconds != nil {
+		w, err = wire.NewValueI64(*(v.RetryAfterMilliseconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -49744,6 +52655,16 @@ func (v *ServiceBusyError) FromWire(w wire.Value) error {
 				}
 				messageIsSet = true
 			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RetryAfterMilliseconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
@@ -49761,10 +52682,14 @@ func (v *ServiceBusyError) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	fields[i] = fmt.Sprintf("Message: %v", v.Message)
 	i++
+	if v.RetryAfterMilliseconds != nil {
+		fields[i] = fmt.Sprintf("RetryAfterMilliseconds: %v", *(v.RetryAfterMilliseconds))
+		i++
+	}
 
 	return fmt.Sprintf("ServiceBusyError{%v}", strings.Join(fields[:i], ", "))
 }
@@ -49788,6 +52713,9 @@ func (v *ServiceBusyError) Equals(rhs *ServiceBusyError) bool {
 	if !(v.Message == rhs.Message) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.RetryAfterMilliseconds, rhs.RetryAfterMilliseconds) {
+		return false
+	}
 
 	return true
 }
@@ -49799,6 +52727,9 @@ func (v *ServiceBusyError) MarshalLogObject(enc zapcore.ObjectEncoder) (err erro
 		return nil
 	}
 	enc.AddString("message", v.Message)
+	if v.RetryAfterMilliseconds != nil {
+		enc.AddInt64("retryAfterMilliseconds", *v.RetryAfterMilliseconds)
+	}
 	return err
 }
 
@@ -49811,10 +52742,339 @@ func (v *ServiceBusyError) GetMessage() (o string) {
 	return
 }
 
+// GetRetryAfterMilliseconds returns the value of RetryAfterMilliseconds if it is set or its
+// zero value if it is unset.
+func (v *ServiceBusyError) GetRetryAfterMilliseconds() (o int64) {
+	if v != nil && v.RetryAfterMilliseconds != nil {
+		return *v.RetryAfterMilliseconds
+	}
+
+	return
+}
+
+// IsSetRetryAfterMilliseconds returns true if RetryAfterMilliseconds is not nil.
+func (v *ServiceBusyError) IsSetRetryAfterMilliseconds() bool {
+	return v != nil && v.RetryAfterMilliseconds != nil
+}
+
 func (v *ServiceBusyError) Error() string {
 	return v.String()
 }
 
+type ShardPlacementInfo struct {
+	AssignedHost *string  `json:"assignedHost,omitempty"`
+	Pinned       *bool    `json:"pinned,omitempty"`
+	Rps          *float64 `json:"rps,omitempty"`
+	QueueBacklog *int64   `json:"queueBacklog,omitempty"`
+	CacheSize    *int64   `json:"cacheSize,omitempty"`
+}
+
+// ToWire translates a ShardPlacementInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ShardPlacementInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.AssignedHost != nil {
+		w, err = wire.NewValueString(*(v.AssignedHost)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Pinned != nil {
+		w, err = wire.NewValueBool(*(v.Pinned)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Rps != nil {
+		w, err = wire.NewValueDouble(*(v.Rps)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.QueueBacklog != nil {
+		w, err = wire.NewValueI64(*(v.QueueBacklog)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.CacheSize != nil {
+		w, err = wire.NewValueI64(*(v.CacheSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ShardPlacementInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ShardPlacementInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ShardPlacementInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ShardPlacementInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AssignedHost = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Pinned = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.Rps = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.QueueBacklog = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CacheSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ShardPlacementInfo
+// struct.
+func (v *ShardPlacementInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.AssignedHost != nil {
+		fields[i] = fmt.Sprintf("AssignedHost: %v", *(v.AssignedHost))
+		i++
+	}
+	if v.Pinned != nil {
+		fields[i] = fmt.Sprintf("Pinned: %v", *(v.Pinned))
+		i++
+	}
+	if v.Rps != nil {
+		fields[i] = fmt.Sprintf("Rps: %v", *(v.Rps))
+		i++
+	}
+	if v.QueueBacklog != nil {
+		fields[i] = fmt.Sprintf("QueueBacklog: %v", *(v.QueueBacklog))
+		i++
+	}
+	if v.CacheSize != nil {
+		fields[i] = fmt.Sprintf("CacheSize: %v", *(v.CacheSize))
+		i++
+	}
+
+	return fmt.Sprintf("ShardPlacementInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ShardPlacementInfo match the
+// provided ShardPlacementInfo.
+//
+// This function performs a deep comparison.
+func (v *ShardPlacementInfo) Equals(rhs *ShardPlacementInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.AssignedHost, rhs.AssignedHost) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Pinned, rhs.Pinned) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.Rps, rhs.Rps) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.QueueBacklog, rhs.QueueBacklog) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CacheSize, rhs.CacheSize) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ShardPlacementInfo.
+func (v *ShardPlacementInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.AssignedHost != nil {
+		enc.AddString("assignedHost", *v.AssignedHost)
+	}
+	if v.Pinned != nil {
+		enc.AddBool("pinned", *v.Pinned)
+	}
+	if v.Rps != nil {
+		enc.AddFloat64("rps", *v.Rps)
+	}
+	if v.QueueBacklog != nil {
+		enc.AddInt64("queueBacklog", *v.QueueBacklog)
+	}
+	if v.CacheSize != nil {
+		enc.AddInt64("cacheSize", *v.CacheSize)
+	}
+	return err
+}
+
+// GetAssignedHost returns the value of AssignedHost if it is set or its
+// zero value if it is unset.
+func (v *ShardPlacementInfo) GetAssignedHost() (o string) {
+	if v != nil && v.AssignedHost != nil {
+		return *v.AssignedHost
+	}
+
+	return
+}
+
+// IsSetAssignedHost returns true if AssignedHost is not nil.
+func (v *ShardPlacementInfo) IsSetAssignedHost() bool {
+	return v != nil && v.AssignedHost != nil
+}
+
+// GetPinned returns the value of Pinned if it is set or its
+// zero value if it is unset.
+func (v *ShardPlacementInfo) GetPinned() (o bool) {
+	if v != nil && v.Pinned != nil {
+		return *v.Pinned
+	}
+
+	return
+}
+
+// IsSetPinned returns true if Pinned is not nil.
+func (v *ShardPlacementInfo) IsSetPinned() bool {
+	return v != nil && v.Pinned != nil
+}
+
+// GetRps returns the value of Rps if it is set or its
+// zero value if it is unset.
+func (v *ShardPlacementInfo) GetRps() (o float64) {
+	if v != nil && v.Rps != nil {
+		return *v.Rps
+	}
+
+	return
+}
+
+// IsSetRps returns true if Rps is not nil.
+func (v *ShardPlacementInfo) IsSetRps() bool {
+	return v != nil && v.Rps != nil
+}
+
+// GetQueueBacklog returns the value of QueueBacklog if it is set or its
+// zero value if it is unset.
+func (v *ShardPlacementInfo) GetQueueBacklog() (o int64) {
+	if v != nil && v.QueueBacklog != nil {
+		return *v.QueueBacklog
+	}
+
+	return
+}
+
+// IsSetQueueBacklog returns true if QueueBacklog is not nil.
+func (v *ShardPlacementInfo) IsSetQueueBacklog() bool {
+	return v != nil && v.QueueBacklog != nil
+}
+
+// GetCacheSize returns the value of CacheSize if it is set or its
+// zero value if it is unset.
+func (v *ShardPlacementInfo) GetCacheSize() (o int64) {
+	if v != nil && v.CacheSize != nil {
+		return *v.CacheSize
+	}
+
+	return
+}
+
+// IsSetCacheSize returns true if CacheSize is not nil.
+func (v *ShardPlacementInfo) IsSetCacheSize() bool {
+	return v != nil && v.CacheSize != nil
+}
+
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	Domain            *string            `json:"domain,omitempty"`
 	Execution         *WorkflowExecution `json:"execution,omitempty"`
@@ -51092,25 +54352,27 @@ func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) IsSetChildWork
 }
 
 type SignalWithStartWorkflowExecutionRequest struct {
-	Domain                              *string                `json:"domain,omitempty"`
-	WorkflowId                          *string                `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            *string                `json:"identity,omitempty"`
-	RequestId                           *string                `json:"requestId,omitempty"`
-	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	SignalName                          *string                `json:"signalName,omitempty"`
-	SignalInput                         []byte                 `json:"signalInput,omitempty"`
-	Control                             []byte                 `json:"control,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Domain                              *string                   `json:"domain,omitempty"`
+	WorkflowId                          *string                   `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            *string                   `json:"identity,omitempty"`
+	RequestId                           *string                   `json:"requestId,omitempty"`
+	WorkflowIdReusePolicy               *WorkflowIdReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	SignalName                          *string                   `json:"signalName,omitempty"`
+	SignalInput                         []byte                    `json:"signalInput,omitempty"`
+	Control                             []byte                    `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        *string                   `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	IsolationGroup                      *string                   `json:"isolationGroup,omitempty"`
+	WorkflowIdConflictPolicy            *WorkflowIdConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -51130,7 +54392,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [19]wire.Field
+		fields [21]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -51288,6 +54550,22 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 180, Value: w}
 		i++
 	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
+	if v.WorkflowIdConflictPolicy != nil {
+		w, err = v.WorkflowIdConflictPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 200, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -51298,6 +54576,12 @@ func _WorkflowIdReusePolicy_Read(w wire.Value) (WorkflowIdReusePolicy, error) {
 	return v, err
 }
 
+func _WorkflowIdConflictPolicy_Read(w wire.Value) (WorkflowIdConflictPolicy, error) {
+	var v WorkflowIdConflictPolicy
+	err := v.FromWire(w)
+	return v, err
+}
+
 // FromWire deserializes a SignalWithStartWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -51491,6 +54775,26 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 190:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 200:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowIdConflictPolicy
+				x, err = _WorkflowIdConflictPolicy_Read(field.Value)
+				v.WorkflowIdConflictPolicy = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -51505,7 +54809,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [19]string
+	var fields [21]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -51583,6 +54887,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
 		i++
 	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
+	if v.WorkflowIdConflictPolicy != nil {
+		fields[i] = fmt.Sprintf("WorkflowIdConflictPolicy: %v", *(v.WorkflowIdConflictPolicy))
+		i++
+	}
 
 	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -51597,6 +54909,16 @@ func _WorkflowIdReusePolicy_EqualsPtr(lhs, rhs *WorkflowIdReusePolicy) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _WorkflowIdConflictPolicy_EqualsPtr(lhs, rhs *WorkflowIdConflictPolicy) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this SignalWithStartWorkflowExecutionRequest match the
 // provided SignalWithStartWorkflowExecutionRequest.
 //
@@ -51664,6 +54986,12 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
 		return false
 	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
+	if !_WorkflowIdConflictPolicy_EqualsPtr(v.WorkflowIdConflictPolicy, rhs.WorkflowIdConflictPolicy) {
+		return false
+	}
 
 	return true
 }
@@ -51731,6 +55059,12 @@ func (v *SignalWithStartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.O
 	if v.DelayStartSeconds != nil {
 		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
 	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
+	if v.WorkflowIdConflictPolicy != nil {
+		err = multierr.Append(err, enc.AddObject("workflowIdConflictPolicy", *v.WorkflowIdConflictPolicy))
+	}
 	return err
 }
 
@@ -52019,6 +55353,36 @@ func (v *SignalWithStartWorkflowExecutionRequest) IsSetDelayStartSeconds() bool
 	return v != nil && v.DelayStartSeconds != nil
 }
 
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
+// GetWorkflowIdConflictPolicy returns the value of WorkflowIdConflictPolicy if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() (o WorkflowIdConflictPolicy) {
+	if v != nil && v.WorkflowIdConflictPolicy != nil {
+		return *v.WorkflowIdConflictPolicy
+	}
+
+	return
+}
+
+// IsSetWorkflowIdConflictPolicy returns true if WorkflowIdConflictPolicy is not nil.
+func (v *SignalWithStartWorkflowExecutionRequest) IsSetWorkflowIdConflictPolicy() bool {
+	return v != nil && v.WorkflowIdConflictPolicy != nil
+}
+
 type SignalWorkflowExecutionRequest struct {
 	Domain            *string            `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -52431,6 +55795,8 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	Header                              *Header                `json:"header,omitempty"`
 	Memo                                *Memo                  `json:"memo,omitempty"`
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
+	Priority                            *int32                 `json:"priority,omitempty"`
+	FairnessKey                         *string                `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a StartChildWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
@@ -52450,7 +55816,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 //   }
 func (v *StartChildWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [17]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -52576,6 +55942,22 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 150, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -52735,6 +56117,26 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) e
 					return err
 				}
 
+			}
+		case 160:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 170:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -52749,7 +56151,7 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [15]string
+	var fields [17]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -52811,6 +56213,14 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("StartChildWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -52870,6 +56280,12 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) Equals(rhs *StartChildWo
 	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -52925,6 +56341,12 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) MarshalLogObject(enc zap
 	if v.SearchAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -53153,6 +56575,36 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) IsSetSearchAttributes()
 	return v != nil && v.SearchAttributes != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *StartChildWorkflowExecutionDecisionAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *StartChildWorkflowExecutionDecisionAttributes) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type StartChildWorkflowExecutionFailedEventAttributes struct {
 	Domain                       *string                            `json:"domain,omitempty"`
 	WorkflowId                   *string                            `json:"workflowId,omitempty"`
@@ -53585,6 +57037,8 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	Memo                                *Memo                  `json:"memo,omitempty"`
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Priority                            *int32                 `json:"priority,omitempty"`
+	FairnessKey                         *string                `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a StartChildWorkflowExecutionInitiatedEventAttributes struct into a Thrift-level intermediate
@@ -53604,7 +57058,7 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 //   }
 func (v *StartChildWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [17]wire.Field
+		fields [19]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -53746,6 +57200,22 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Val
 		fields[i] = wire.Field{ID: 170, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -53925,6 +57395,26 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Va
 					return err
 				}
 
+			}
+		case 180:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 190:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -53939,7 +57429,7 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [17]string
+	var fields [19]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -54009,6 +57499,14 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("StartChildWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -54074,6 +57572,12 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) Equals(rhs *StartC
 	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -54135,6 +57639,12 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalLogObject(e
 	if v.DelayStartSeconds != nil {
 		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -54393,6 +57903,36 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetDelayStartSec
 	return v != nil && v.DelayStartSeconds != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type StartTimeFilter struct {
 	EarliestTime *int64 `json:"earliestTime,omitempty"`
 	LatestTime   *int64 `json:"latestTime,omitempty"`
@@ -54758,22 +58298,26 @@ func (v *StartTimerDecisionAttributes) IsSetStartToFireTimeoutSeconds() bool {
 }
 
 type StartWorkflowExecutionRequest struct {
-	Domain                              *string                `json:"domain,omitempty"`
-	WorkflowId                          *string                `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            *string                `json:"identity,omitempty"`
-	RequestId                           *string                `json:"requestId,omitempty"`
-	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Domain                              *string                   `json:"domain,omitempty"`
+	WorkflowId                          *string                   `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            *string                   `json:"identity,omitempty"`
+	RequestId                           *string                   `json:"requestId,omitempty"`
+	WorkflowIdReusePolicy               *WorkflowIdReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        *string                   `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	Priority                            *int32                    `json:"priority,omitempty"`
+	FairnessKey                         *string                   `json:"fairnessKey,omitempty"`
+	IsolationGroup                      *string                   `json:"isolationGroup,omitempty"`
+	WorkflowIdConflictPolicy            *WorkflowIdConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -54793,7 +58337,7 @@ type StartWorkflowExecutionRequest struct {
 //   }
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [16]wire.Field
+		fields [20]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -54927,6 +58471,38 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 160, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
+	if v.WorkflowIdConflictPolicy != nil {
+		w, err = v.WorkflowIdConflictPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 200, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -55098,6 +58674,46 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 170:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 180:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 190:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 200:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowIdConflictPolicy
+				x, err = _WorkflowIdConflictPolicy_Read(field.Value)
+				v.WorkflowIdConflictPolicy = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -55112,7 +58728,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [16]string
+	var fields [20]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -55178,6 +58794,22 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
+	if v.WorkflowIdConflictPolicy != nil {
+		fields[i] = fmt.Sprintf("WorkflowIdConflictPolicy: %v", *(v.WorkflowIdConflictPolicy))
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -55240,6 +58872,18 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
+	if !_WorkflowIdConflictPolicy_EqualsPtr(v.WorkflowIdConflictPolicy, rhs.WorkflowIdConflictPolicy) {
+		return false
+	}
 
 	return true
 }
@@ -55298,6 +58942,18 @@ func (v *StartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncod
 	if v.DelayStartSeconds != nil {
 		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
+	if v.WorkflowIdConflictPolicy != nil {
+		err = multierr.Append(err, enc.AddObject("workflowIdConflictPolicy", *v.WorkflowIdConflictPolicy))
+	}
 	return err
 }
 
@@ -55541,6 +59197,66 @@ func (v *StartWorkflowExecutionRequest) IsSetDelayStartSeconds() bool {
 	return v != nil && v.DelayStartSeconds != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
+// GetWorkflowIdConflictPolicy returns the value of WorkflowIdConflictPolicy if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() (o WorkflowIdConflictPolicy) {
+	if v != nil && v.WorkflowIdConflictPolicy != nil {
+		return *v.WorkflowIdConflictPolicy
+	}
+
+	return
+}
+
+// IsSetWorkflowIdConflictPolicy returns true if WorkflowIdConflictPolicy is not nil.
+func (v *StartWorkflowExecutionRequest) IsSetWorkflowIdConflictPolicy() bool {
+	return v != nil && v.WorkflowIdConflictPolicy != nil
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId *string `json:"runId,omitempty"`
 }
@@ -56730,6 +60446,232 @@ func (v *TaskListMetadata) IsSetMaxTasksPerSecond() bool {
 	return v != nil && v.MaxTasksPerSecond != nil
 }
 
+type TaskListPartitionConfig struct {
+	NumReadPartitions  *int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions *int32 `json:"numWritePartitions,omitempty"`
+	ScalingEnabled     *bool  `json:"scalingEnabled,omitempty"`
+}
+
+// ToWire translates a TaskListPartitionConfig struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TaskListPartitionConfig) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.NumReadPartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumReadPartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NumWritePartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumWritePartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ScalingEnabled != nil {
+		w, err = wire.NewValueBool(*(v.ScalingEnabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskListPartitionConfig struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskListPartitionConfig struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TaskListPartitionConfig
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TaskListPartitionConfig) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumReadPartitions = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumWritePartitions = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ScalingEnabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskListPartitionConfig
+// struct.
+func (v *TaskListPartitionConfig) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.NumReadPartitions != nil {
+		fields[i] = fmt.Sprintf("NumReadPartitions: %v", *(v.NumReadPartitions))
+		i++
+	}
+	if v.NumWritePartitions != nil {
+		fields[i] = fmt.Sprintf("NumWritePartitions: %v", *(v.NumWritePartitions))
+		i++
+	}
+	if v.ScalingEnabled != nil {
+		fields[i] = fmt.Sprintf("ScalingEnabled: %v", *(v.ScalingEnabled))
+		i++
+	}
+
+	return fmt.Sprintf("TaskListPartitionConfig{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TaskListPartitionConfig match the
+// provided TaskListPartitionConfig.
+//
+// This function performs a deep comparison.
+func (v *TaskListPartitionConfig) Equals(rhs *TaskListPartitionConfig) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumReadPartitions, rhs.NumReadPartitions) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumWritePartitions, rhs.NumWritePartitions) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ScalingEnabled, rhs.ScalingEnabled) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskListPartitionConfig.
+func (v *TaskListPartitionConfig) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NumReadPartitions != nil {
+		enc.AddInt32("numReadPartitions", *v.NumReadPartitions)
+	}
+	if v.NumWritePartitions != nil {
+		enc.AddInt32("numWritePartitions", *v.NumWritePartitions)
+	}
+	if v.ScalingEnabled != nil {
+		enc.AddBool("scalingEnabled", *v.ScalingEnabled)
+	}
+	return err
+}
+
+// GetNumReadPartitions returns the value of NumReadPartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetNumReadPartitions() (o int32) {
+	if v != nil && v.NumReadPartitions != nil {
+		return *v.NumReadPartitions
+	}
+
+	return
+}
+
+// IsSetNumReadPartitions returns true if NumReadPartitions is not nil.
+func (v *TaskListPartitionConfig) IsSetNumReadPartitions() bool {
+	return v != nil && v.NumReadPartitions != nil
+}
+
+// GetNumWritePartitions returns the value of NumWritePartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetNumWritePartitions() (o int32) {
+	if v != nil && v.NumWritePartitions != nil {
+		return *v.NumWritePartitions
+	}
+
+	return
+}
+
+// IsSetNumWritePartitions returns true if NumWritePartitions is not nil.
+func (v *TaskListPartitionConfig) IsSetNumWritePartitions() bool {
+	return v != nil && v.NumWritePartitions != nil
+}
+
+// GetScalingEnabled returns the value of ScalingEnabled if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetScalingEnabled() (o bool) {
+	if v != nil && v.ScalingEnabled != nil {
+		return *v.ScalingEnabled
+	}
+
+	return
+}
+
+// IsSetScalingEnabled returns true if ScalingEnabled is not nil.
+func (v *TaskListPartitionConfig) IsSetScalingEnabled() bool {
+	return v != nil && v.ScalingEnabled != nil
+}
+
 type TaskListPartitionMetadata struct {
 	Key           *string `json:"key,omitempty"`
 	OwnerHostName *string `json:"ownerHostName,omitempty"`
@@ -56913,11 +60855,20 @@ func (v *TaskListPartitionMetadata) IsSetOwnerHostName() bool {
 }
 
 type TaskListStatus struct {
-	BacklogCountHint *int64       `json:"backlogCountHint,omitempty"`
-	ReadLevel        *int64       `json:"readLevel,omitempty"`
-	AckLevel         *int64       `json:"ackLevel,omitempty"`
-	RatePerSecond    *float64     `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint               *int64       `json:"backlogCountHint,omitempty"`
+	ReadLevel                      *int64       `json:"readLevel,omitempty"`
+	AckLevel                       *int64       `json:"ackLevel,omitempty"`
+	RatePerSecond                  *float64     `json:"ratePerSecond,omitempty"`
+	TaskIDBlock                    *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	AddRatePerSecond               *float64     `json:"addRatePerSecond,omitempty"`
+	DispatchRatePerSecond          *float64     `json:"dispatchRatePerSecond,omitempty"`
+	SyncMatchRatio                 *float64     `json:"syncMatchRatio,omitempty"`
+	OldestTaskAgeSeconds           *float64     `json:"oldestTaskAgeSeconds,omitempty"`
+	IngestionRPSLimit              *float64     `json:"ingestionRPSLimit,omitempty"`
+	DomainIngestionRPSLimit        *float64     `json:"domainIngestionRPSLimit,omitempty"`
+	DomainDispatchRPSLimit         *float64     `json:"domainDispatchRPSLimit,omitempty"`
+	ThrottledAddRatePerSecond      *float64     `json:"throttledAddRatePerSecond,omitempty"`
+	ThrottledDispatchRatePerSecond *float64     `json:"throttledDispatchRatePerSecond,omitempty"`
 }
 
 // ToWire translates a TaskListStatus struct into a Thrift-level intermediate
@@ -56937,7 +60888,7 @@ type TaskListStatus struct {
 //   }
 func (v *TaskListStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -56983,6 +60934,78 @@ func (v *TaskListStatus) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.AddRatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.AddRatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.DispatchRatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.DispatchRatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.SyncMatchRatio != nil {
+		w, err = wire.NewValueDouble(*(v.SyncMatchRatio)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.OldestTaskAgeSeconds != nil {
+		w, err = wire.NewValueDouble(*(v.OldestTaskAgeSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.IngestionRPSLimit != nil {
+		w, err = wire.NewValueDouble(*(v.IngestionRPSLimit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.DomainIngestionRPSLimit != nil {
+		w, err = wire.NewValueDouble(*(v.DomainIngestionRPSLimit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.DomainDispatchRPSLimit != nil {
+		w, err = wire.NewValueDouble(*(v.DomainDispatchRPSLimit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.ThrottledAddRatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.ThrottledAddRatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ThrottledDispatchRatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.ThrottledDispatchRatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -57062,6 +61085,96 @@ func (v *TaskListStatus) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.AddRatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.DispatchRatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.SyncMatchRatio = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.OldestTaskAgeSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.IngestionRPSLimit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.DomainIngestionRPSLimit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.DomainDispatchRPSLimit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.ThrottledAddRatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.ThrottledDispatchRatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -57076,7 +61189,7 @@ func (v *TaskListStatus) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [14]string
 	i := 0
 	if v.BacklogCountHint != nil {
 		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
@@ -57098,6 +61211,42 @@ func (v *TaskListStatus) String() string {
 		fields[i] = fmt.Sprintf("TaskIDBlock: %v", v.TaskIDBlock)
 		i++
 	}
+	if v.AddRatePerSecond != nil {
+		fields[i] = fmt.Sprintf("AddRatePerSecond: %v", *(v.AddRatePerSecond))
+		i++
+	}
+	if v.DispatchRatePerSecond != nil {
+		fields[i] = fmt.Sprintf("DispatchRatePerSecond: %v", *(v.DispatchRatePerSecond))
+		i++
+	}
+	if v.SyncMatchRatio != nil {
+		fields[i] = fmt.Sprintf("SyncMatchRatio: %v", *(v.SyncMatchRatio))
+		i++
+	}
+	if v.OldestTaskAgeSeconds != nil {
+		fields[i] = fmt.Sprintf("OldestTaskAgeSeconds: %v", *(v.OldestTaskAgeSeconds))
+		i++
+	}
+	if v.IngestionRPSLimit != nil {
+		fields[i] = fmt.Sprintf("IngestionRPSLimit: %v", *(v.IngestionRPSLimit))
+		i++
+	}
+	if v.DomainIngestionRPSLimit != nil {
+		fields[i] = fmt.Sprintf("DomainIngestionRPSLimit: %v", *(v.DomainIngestionRPSLimit))
+		i++
+	}
+	if v.DomainDispatchRPSLimit != nil {
+		fields[i] = fmt.Sprintf("DomainDispatchRPSLimit: %v", *(v.DomainDispatchRPSLimit))
+		i++
+	}
+	if v.ThrottledAddRatePerSecond != nil {
+		fields[i] = fmt.Sprintf("ThrottledAddRatePerSecond: %v", *(v.ThrottledAddRatePerSecond))
+		i++
+	}
+	if v.ThrottledDispatchRatePerSecond != nil {
+		fields[i] = fmt.Sprintf("ThrottledDispatchRatePerSecond: %v", *(v.ThrottledDispatchRatePerSecond))
+		i++
+	}
 
 	return fmt.Sprintf("TaskListStatus{%v}", strings.Join(fields[:i], ", "))
 }
@@ -57127,6 +61276,33 @@ func (v *TaskListStatus) Equals(rhs *TaskListStatus) bool {
 	if !((v.TaskIDBlock == nil && rhs.TaskIDBlock == nil) || (v.TaskIDBlock != nil && rhs.TaskIDBlock != nil && v.TaskIDBlock.Equals(rhs.TaskIDBlock))) {
 		return false
 	}
+	if !_Double_EqualsPtr(v.AddRatePerSecond, rhs.AddRatePerSecond) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.DispatchRatePerSecond, rhs.DispatchRatePerSecond) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.SyncMatchRatio, rhs.SyncMatchRatio) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.OldestTaskAgeSeconds, rhs.OldestTaskAgeSeconds) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.IngestionRPSLimit, rhs.IngestionRPSLimit) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.DomainIngestionRPSLimit, rhs.DomainIngestionRPSLimit) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.DomainDispatchRPSLimit, rhs.DomainDispatchRPSLimit) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.ThrottledAddRatePerSecond, rhs.ThrottledAddRatePerSecond) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.ThrottledDispatchRatePerSecond, rhs.ThrottledDispatchRatePerSecond) {
+		return false
+	}
 
 	return true
 }
@@ -57152,6 +61328,33 @@ func (v *TaskListStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	if v.TaskIDBlock != nil {
 		err = multierr.Append(err, enc.AddObject("taskIDBlock", v.TaskIDBlock))
 	}
+	if v.AddRatePerSecond != nil {
+		enc.AddFloat64("addRatePerSecond", *v.AddRatePerSecond)
+	}
+	if v.DispatchRatePerSecond != nil {
+		enc.AddFloat64("dispatchRatePerSecond", *v.DispatchRatePerSecond)
+	}
+	if v.SyncMatchRatio != nil {
+		enc.AddFloat64("syncMatchRatio", *v.SyncMatchRatio)
+	}
+	if v.OldestTaskAgeSeconds != nil {
+		enc.AddFloat64("oldestTaskAgeSeconds", *v.OldestTaskAgeSeconds)
+	}
+	if v.IngestionRPSLimit != nil {
+		enc.AddFloat64("ingestionRPSLimit", *v.IngestionRPSLimit)
+	}
+	if v.DomainIngestionRPSLimit != nil {
+		enc.AddFloat64("domainIngestionRPSLimit", *v.DomainIngestionRPSLimit)
+	}
+	if v.DomainDispatchRPSLimit != nil {
+		enc.AddFloat64("domainDispatchRPSLimit", *v.DomainDispatchRPSLimit)
+	}
+	if v.ThrottledAddRatePerSecond != nil {
+		enc.AddFloat64("throttledAddRatePerSecond", *v.ThrottledAddRatePerSecond)
+	}
+	if v.ThrottledDispatchRatePerSecond != nil {
+		enc.AddFloat64("throttledDispatchRatePerSecond", *v.ThrottledDispatchRatePerSecond)
+	}
 	return err
 }
 
@@ -57230,6 +61433,141 @@ func (v *TaskListStatus) IsSetTaskIDBlock() bool {
 	return v != nil && v.TaskIDBlock != nil
 }
 
+// GetAddRatePerSecond returns the value of AddRatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetAddRatePerSecond() (o float64) {
+	if v != nil && v.AddRatePerSecond != nil {
+		return *v.AddRatePerSecond
+	}
+
+	return
+}
+
+// IsSetAddRatePerSecond returns true if AddRatePerSecond is not nil.
+func (v *TaskListStatus) IsSetAddRatePerSecond() bool {
+	return v != nil && v.AddRatePerSecond != nil
+}
+
+// GetDispatchRatePerSecond returns the value of DispatchRatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetDispatchRatePerSecond() (o float64) {
+	if v != nil && v.DispatchRatePerSecond != nil {
+		return *v.DispatchRatePerSecond
+	}
+
+	return
+}
+
+// IsSetDispatchRatePerSecond returns true if DispatchRatePerSecond is not nil.
+func (v *TaskListStatus) IsSetDispatchRatePerSecond() bool {
+	return v != nil && v.DispatchRatePerSecond != nil
+}
+
+// GetSyncMatchRatio returns the value of SyncMatchRatio if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetSyncMatchRatio() (o float64) {
+	if v != nil && v.SyncMatchRatio != nil {
+		return *v.SyncMatchRatio
+	}
+
+	return
+}
+
+// IsSetSyncMatchRatio returns true if SyncMatchRatio is not nil.
+func (v *TaskListStatus) IsSetSyncMatchRatio() bool {
+	return v != nil && v.SyncMatchRatio != nil
+}
+
+// GetOldestTaskAgeSeconds returns the value of OldestTaskAgeSeconds if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetOldestTaskAgeSeconds() (o float64) {
+	if v != nil && v.OldestTaskAgeSeconds != nil {
+		return *v.OldestTaskAgeSeconds
+	}
+
+	return
+}
+
+// IsSetOldestTaskAgeSeconds returns true if OldestTaskAgeSeconds is not nil.
+func (v *TaskListStatus) IsSetOldestTaskAgeSeconds() bool {
+	return v != nil && v.OldestTaskAgeSeconds != nil
+}
+
+// GetIngestionRPSLimit returns the value of IngestionRPSLimit if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetIngestionRPSLimit() (o float64) {
+	if v != nil && v.IngestionRPSLimit != nil {
+		return *v.IngestionRPSLimit
+	}
+
+	return
+}
+
+// IsSetIngestionRPSLimit returns true if IngestionRPSLimit is not nil.
+func (v *TaskListStatus) IsSetIngestionRPSLimit() bool {
+	return v != nil && v.IngestionRPSLimit != nil
+}
+
+// GetDomainIngestionRPSLimit returns the value of DomainIngestionRPSLimit if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetDomainIngestionRPSLimit() (o float64) {
+	if v != nil && v.DomainIngestionRPSLimit != nil {
+		return *v.DomainIngestionRPSLimit
+	}
+
+	return
+}
+
+// IsSetDomainIngestionRPSLimit returns true if DomainIngestionRPSLimit is not nil.
+func (v *TaskListStatus) IsSetDomainIngestionRPSLimit() bool {
+	return v != nil && v.DomainIngestionRPSLimit != nil
+}
+
+// GetDomainDispatchRPSLimit returns the value of DomainDispatchRPSLimit if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetDomainDispatchRPSLimit() (o float64) {
+	if v != nil && v.DomainDispatchRPSLimit != nil {
+		return *v.DomainDispatchRPSLimit
+	}
+
+	return
+}
+
+// IsSetDomainDispatchRPSLimit returns true if DomainDispatchRPSLimit is not nil.
+func (v *TaskListStatus) IsSetDomainDispatchRPSLimit() bool {
+	return v != nil && v.DomainDispatchRPSLimit != nil
+}
+
+// GetThrottledAddRatePerSecond returns the value of ThrottledAddRatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetThrottledAddRatePerSecond() (o float64) {
+	if v != nil && v.ThrottledAddRatePerSecond != nil {
+		return *v.ThrottledAddRatePerSecond
+	}
+
+	return
+}
+
+// IsSetThrottledAddRatePerSecond returns true if ThrottledAddRatePerSecond is not nil.
+func (v *TaskListStatus) IsSetThrottledAddRatePerSecond() bool {
+	return v != nil && v.ThrottledAddRatePerSecond != nil
+}
+
+// GetThrottledDispatchRatePerSecond returns the value of ThrottledDispatchRatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetThrottledDispatchRatePerSecond() (o float64) {
+	if v != nil && v.ThrottledDispatchRatePerSecond != nil {
+		return *v.ThrottledDispatchRatePerSecond
+	}
+
+	return
+}
+
+// IsSetThrottledDispatchRatePerSecond returns true if ThrottledDispatchRatePerSecond is not nil.
+func (v *TaskListStatus) IsSetThrottledDispatchRatePerSecond() bool {
+	return v != nil && v.ThrottledDispatchRatePerSecond != nil
+}
+
 type TaskListType int32
 
 const (
@@ -57407,6 +61745,7 @@ type TerminateWorkflowExecutionRequest struct {
 	Reason            *string            `json:"reason,omitempty"`
 	Details           []byte             `json:"details,omitempty"`
 	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
 }
 
 // ToWire translates a TerminateWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -57426,7 +61765,7 @@ type TerminateWorkflowExecutionRequest struct {
 //   }
 func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -57472,6 +61811,14 @@ func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -57543,6 +61890,16 @@ func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -57557,7 +61914,7 @@ func (v *TerminateWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -57579,6 +61936,10 @@ func (v *TerminateWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
 
 	return fmt.Sprintf("TerminateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -57608,6 +61969,9 @@ func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecuti
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 
 	return true
 }
@@ -57633,6 +61997,9 @@ func (v *TerminateWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectE
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
 	return err
 }
 
@@ -57711,6 +62078,21 @@ func (v *TerminateWorkflowExecutionRequest) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *TerminateWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *TerminateWorkflowExecutionRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
 type TimeoutType int32
 
 const (
@@ -59698,6 +64080,722 @@ func (v *UpdateDomainResponse) IsSetIsGlobalDomain() bool {
 	return v != nil && v.IsGlobalDomain != nil
 }
 
+type UpdateWorkerBuildIDCompatibilityRequest struct {
+	Domain                       *string   `json:"domain,omitempty"`
+	TaskList                     *TaskList `json:"taskList,omitempty"`
+	AddNewBuildIdInNewDefaultSet *string   `json:"addNewBuildIdInNewDefaultSet,omitempty"`
+	AddNewCompatibleBuildId      *string   `json:"addNewCompatibleBuildId,omitempty"`
+	ExistingCompatibleBuildId    *string   `json:"existingCompatibleBuildId,omitempty"`
+	MakeSetDefault               *bool     `json:"makeSetDefault,omitempty"`
+	PromoteSetByBuildId          *string   `json:"promoteSetByBuildId,omitempty"`
+}
+
+// ToWire translates a UpdateWorkerBuildIDCompatibilityRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UpdateWorkerBuildIDCompatibilityRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.AddNewBuildIdInNewDefaultSet != nil {
+		w, err = wire.NewValueString(*(v.AddNewBuildIdInNewDefaultSet)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.AddNewCompatibleBuildId != nil {
+		w, err = wire.NewValueString(*(v.AddNewCompatibleBuildId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ExistingCompatibleBuildId != nil {
+		w, err = wire.NewValueString(*(v.ExistingCompatibleBuildId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.MakeSetDefault != nil {
+		w, err = wire.NewValueBool(*(v.MakeSetDefault)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.PromoteSetByBuildId != nil {
+		w, err = wire.NewValueString(*(v.PromoteSetByBuildId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UpdateWorkerBuildIDCompatibilityRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpdateWorkerBuildIDCompatibilityRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UpdateWorkerBuildIDCompatibilityRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UpdateWorkerBuildIDCompatibilityRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AddNewBuildIdInNewDefaultSet = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AddNewCompatibleBuildId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ExistingCompatibleBuildId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.MakeSetDefault = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PromoteSetByBuildId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UpdateWorkerBuildIDCompatibilityRequest
+// struct.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.AddNewBuildIdInNewDefaultSet != nil {
+		fields[i] = fmt.Sprintf("AddNewBuildIdInNewDefaultSet: %v", *(v.AddNewBuildIdInNewDefaultSet))
+		i++
+	}
+	if v.AddNewCompatibleBuildId != nil {
+		fields[i] = fmt.Sprintf("AddNewCompatibleBuildId: %v", *(v.AddNewCompatibleBuildId))
+		i++
+	}
+	if v.ExistingCompatibleBuildId != nil {
+		fields[i] = fmt.Sprintf("ExistingCompatibleBuildId: %v", *(v.ExistingCompatibleBuildId))
+		i++
+	}
+	if v.MakeSetDefault != nil {
+		fields[i] = fmt.Sprintf("MakeSetDefault: %v", *(v.MakeSetDefault))
+		i++
+	}
+	if v.PromoteSetByBuildId != nil {
+		fields[i] = fmt.Sprintf("PromoteSetByBuildId: %v", *(v.PromoteSetByBuildId))
+		i++
+	}
+
+	return fmt.Sprintf("UpdateWorkerBuildIDCompatibilityRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UpdateWorkerBuildIDCompatibilityRequest match the
+// provided UpdateWorkerBuildIDCompatibilityRequest.
+//
+// This function performs a deep comparison.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) Equals(rhs *UpdateWorkerBuildIDCompatibilityRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.AddNewBuildIdInNewDefaultSet, rhs.AddNewBuildIdInNewDefaultSet) {
+		return false
+	}
+	if !_String_EqualsPtr(v.AddNewCompatibleBuildId, rhs.AddNewCompatibleBuildId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ExistingCompatibleBuildId, rhs.ExistingCompatibleBuildId) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.MakeSetDefault, rhs.MakeSetDefault) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PromoteSetByBuildId, rhs.PromoteSetByBuildId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpdateWorkerBuildIDCompatibilityRequest.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.AddNewBuildIdInNewDefaultSet != nil {
+		enc.AddString("addNewBuildIdInNewDefaultSet", *v.AddNewBuildIdInNewDefaultSet)
+	}
+	if v.AddNewCompatibleBuildId != nil {
+		enc.AddString("addNewCompatibleBuildId", *v.AddNewCompatibleBuildId)
+	}
+	if v.ExistingCompatibleBuildId != nil {
+		enc.AddString("existingCompatibleBuildId", *v.ExistingCompatibleBuildId)
+	}
+	if v.MakeSetDefault != nil {
+		enc.AddBool("makeSetDefault", *v.MakeSetDefault)
+	}
+	if v.PromoteSetByBuildId != nil {
+		enc.AddString("promoteSetByBuildId", *v.PromoteSetByBuildId)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetAddNewBuildIdInNewDefaultSet returns the value of AddNewBuildIdInNewDefaultSet if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetAddNewBuildIdInNewDefaultSet() (o string) {
+	if v != nil && v.AddNewBuildIdInNewDefaultSet != nil {
+		return *v.AddNewBuildIdInNewDefaultSet
+	}
+
+	return
+}
+
+// IsSetAddNewBuildIdInNewDefaultSet returns true if AddNewBuildIdInNewDefaultSet is not nil.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) IsSetAddNewBuildIdInNewDefaultSet() bool {
+	return v != nil && v.AddNewBuildIdInNewDefaultSet != nil
+}
+
+// GetAddNewCompatibleBuildId returns the value of AddNewCompatibleBuildId if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetAddNewCompatibleBuildId() (o string) {
+	if v != nil && v.AddNewCompatibleBuildId != nil {
+		return *v.AddNewCompatibleBuildId
+	}
+
+	return
+}
+
+// IsSetAddNewCompatibleBuildId returns true if AddNewCompatibleBuildId is not nil.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) IsSetAddNewCompatibleBuildId() bool {
+	return v != nil && v.AddNewCompatibleBuildId != nil
+}
+
+// GetExistingCompatibleBuildId returns the value of ExistingCompatibleBuildId if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetExistingCompatibleBuildId() (o string) {
+	if v != nil && v.ExistingCompatibleBuildId != nil {
+		return *v.ExistingCompatibleBuildId
+	}
+
+	return
+}
+
+// IsSetExistingCompatibleBuildId returns true if ExistingCompatibleBuildId is not nil.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) IsSetExistingCompatibleBuildId() bool {
+	return v != nil && v.ExistingCompatibleBuildId != nil
+}
+
+// GetMakeSetDefault returns the value of MakeSetDefault if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetMakeSetDefault() (o bool) {
+	if v != nil && v.MakeSetDefault != nil {
+		return *v.MakeSetDefault
+	}
+
+	return
+}
+
+// IsSetMakeSetDefault returns true if MakeSetDefault is not nil.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) IsSetMakeSetDefault() bool {
+	return v != nil && v.MakeSetDefault != nil
+}
+
+// GetPromoteSetByBuildId returns the value of PromoteSetByBuildId if it is set or its
+// zero value if it is unset.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetPromoteSetByBuildId() (o string) {
+	if v != nil && v.PromoteSetByBuildId != nil {
+		return *v.PromoteSetByBuildId
+	}
+
+	return
+}
+
+// IsSetPromoteSetByBuildId returns true if PromoteSetByBuildId is not nil.
+func (v *UpdateWorkerBuildIDCompatibilityRequest) IsSetPromoteSetByBuildId() bool {
+	return v != nil && v.PromoteSetByBuildId != nil
+}
+
+type UpsertWorkflowMemoDecisionAttributes struct {
+	Memo *Memo `json:"memo,omitempty"`
+}
+
+// ToWire translates a UpsertWorkflowMemoDecisionAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UpsertWorkflowMemoDecisionAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Memo != nil {
+		w, err = v.Memo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UpsertWorkflowMemoDecisionAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpsertWorkflowMemoDecisionAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UpsertWorkflowMemoDecisionAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UpsertWorkflowMemoDecisionAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.Memo, err = _Memo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UpsertWorkflowMemoDecisionAttributes
+// struct.
+func (v *UpsertWorkflowMemoDecisionAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Memo != nil {
+		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
+		i++
+	}
+
+	return fmt.Sprintf("UpsertWorkflowMemoDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UpsertWorkflowMemoDecisionAttributes match the
+// provided UpsertWorkflowMemoDecisionAttributes.
+//
+// This function performs a deep comparison.
+func (v *UpsertWorkflowMemoDecisionAttributes) Equals(rhs *UpsertWorkflowMemoDecisionAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && v.Memo.Equals(rhs.Memo))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpsertWorkflowMemoDecisionAttributes.
+func (v *UpsertWorkflowMemoDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Memo != nil {
+		err = multierr.Append(err, enc.AddObject("memo", v.Memo))
+	}
+	return err
+}
+
+// GetMemo returns the value of Memo if it is set or its
+// zero value if it is unset.
+func (v *UpsertWorkflowMemoDecisionAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+
+	return
+}
+
+// IsSetMemo returns true if Memo is not nil.
+func (v *UpsertWorkflowMemoDecisionAttributes) IsSetMemo() bool {
+	return v != nil && v.Memo != nil
+}
+
+type UpsertWorkflowMemoEventAttributes struct {
+	DecisionTaskCompletedEventId *int64 `json:"decisionTaskCompletedEventId,omitempty"`
+	Memo                         *Memo  `json:"memo,omitempty"`
+}
+
+// ToWire translates a UpsertWorkflowMemoEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UpsertWorkflowMemoEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Memo != nil {
+		w, err = v.Memo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UpsertWorkflowMemoEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpsertWorkflowMemoEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UpsertWorkflowMemoEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UpsertWorkflowMemoEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Memo, err = _Memo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UpsertWorkflowMemoEventAttributes
+// struct.
+func (v *UpsertWorkflowMemoEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
+	}
+	if v.Memo != nil {
+		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
+		i++
+	}
+
+	return fmt.Sprintf("UpsertWorkflowMemoEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UpsertWorkflowMemoEventAttributes match the
+// provided UpsertWorkflowMemoEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *UpsertWorkflowMemoEventAttributes) Equals(rhs *UpsertWorkflowMemoEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && v.Memo.Equals(rhs.Memo))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpsertWorkflowMemoEventAttributes.
+func (v *UpsertWorkflowMemoEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	}
+	if v.Memo != nil {
+		err = multierr.Append(err, enc.AddObject("memo", v.Memo))
+	}
+	return err
+}
+
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *UpsertWorkflowMemoEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
+}
+
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *UpsertWorkflowMemoEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
+}
+
+// GetMemo returns the value of Memo if it is set or its
+// zero value if it is unset.
+func (v *UpsertWorkflowMemoEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+
+	return
+}
+
+// IsSetMemo returns true if Memo is not nil.
+func (v *UpsertWorkflowMemoEventAttributes) IsSetMemo() bool {
+	return v != nil && v.Memo != nil
+}
+
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
 }
@@ -60894,6 +65992,142 @@ func (v *WorkerVersionInfo) IsSetFeatureVersion() bool {
 	return v != nil && v.FeatureVersion != nil
 }
 
+type WorkerVersionSet struct {
+	BuildIds []string `json:"buildIds,omitempty"`
+}
+
+// ToWire translates a WorkerVersionSet struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *WorkerVersionSet) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BuildIds != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.BuildIds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkerVersionSet struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkerVersionSet struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v WorkerVersionSet
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *WorkerVersionSet) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.BuildIds, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkerVersionSet
+// struct.
+func (v *WorkerVersionSet) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.BuildIds != nil {
+		fields[i] = fmt.Sprintf("BuildIds: %v", v.BuildIds)
+		i++
+	}
+
+	return fmt.Sprintf("WorkerVersionSet{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkerVersionSet match the
+// provided WorkerVersionSet.
+//
+// This function performs a deep comparison.
+func (v *WorkerVersionSet) Equals(rhs *WorkerVersionSet) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BuildIds == nil && rhs.BuildIds == nil) || (v.BuildIds != nil && rhs.BuildIds != nil && _List_String_Equals(v.BuildIds, rhs.BuildIds))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkerVersionSet.
+func (v *WorkerVersionSet) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BuildIds != nil {
+		err = multierr.Append(err, enc.AddArray("buildIds", (_List_String_Zapper)(v.BuildIds)))
+	}
+	return err
+}
+
+// GetBuildIds returns the value of BuildIds if it is set or its
+// zero value if it is unset.
+func (v *WorkerVersionSet) GetBuildIds() (o []string) {
+	if v != nil && v.BuildIds != nil {
+		return v.BuildIds
+	}
+
+	return
+}
+
+// IsSetBuildIds returns true if BuildIds is not nil.
+func (v *WorkerVersionSet) IsSetBuildIds() bool {
+	return v != nil && v.BuildIds != nil
+}
+
 type WorkflowExecution struct {
 	WorkflowId *string `json:"workflowId,omitempty"`
 	RunId      *string `json:"runId,omitempty"`
@@ -61457,6 +66691,7 @@ type WorkflowExecutionCancelRequestedEventAttributes struct {
 	ExternalInitiatedEventId  *int64             `json:"externalInitiatedEventId,omitempty"`
 	ExternalWorkflowExecution *WorkflowExecution `json:"externalWorkflowExecution,omitempty"`
 	Identity                  *string            `json:"identity,omitempty"`
+	RequestId                 *string            `json:"requestId,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionCancelRequestedEventAttributes struct into a Thrift-level intermediate
@@ -61476,7 +66711,7 @@ type WorkflowExecutionCancelRequestedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -61514,6 +66749,14 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -61577,6 +66820,16 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) FromWire(w wire.Value)
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -61591,7 +66844,7 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Cause != nil {
 		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
@@ -61609,6 +66862,10 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionCancelRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -61635,6 +66892,9 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) Equals(rhs *WorkflowEx
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 
 	return true
 }
@@ -61657,6 +66917,9 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) MarshalLogObject(enc z
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
 	return err
 }
 
@@ -61720,6 +66983,21 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *WorkflowExecutionCancelRequestedEventAttributes) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
 type WorkflowExecutionCanceledEventAttributes struct {
 	DecisionTaskCompletedEventId *int64 `json:"decisionTaskCompletedEventId,omitempty"`
 	Details                      []byte `json:"details,omitempty"`
@@ -63672,20 +68950,21 @@ func (v *WorkflowExecutionFilter) IsSetRunId() bool {
 }
 
 type WorkflowExecutionInfo struct {
-	Execution        *WorkflowExecution            `json:"execution,omitempty"`
-	Type             *WorkflowType                 `json:"type,omitempty"`
-	StartTime        *int64                        `json:"startTime,omitempty"`
-	CloseTime        *int64                        `json:"closeTime,omitempty"`
-	CloseStatus      *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-	HistoryLength    *int64                        `json:"historyLength,omitempty"`
-	ParentDomainId   *string                       `json:"parentDomainId,omitempty"`
-	ParentExecution  *WorkflowExecution            `json:"parentExecution,omitempty"`
-	ExecutionTime    *int64                        `json:"executionTime,omitempty"`
-	Memo             *Memo                         `json:"memo,omitempty"`
-	SearchAttributes *SearchAttributes             `json:"searchAttributes,omitempty"`
-	AutoResetPoints  *ResetPoints                  `json:"autoResetPoints,omitempty"`
-	TaskList         *string                       `json:"taskList,omitempty"`
-	IsCron           *bool                         `json:"isCron,omitempty"`
+	Execution          *WorkflowExecution            `json:"execution,omitempty"`
+	Type               *WorkflowType                 `json:"type,omitempty"`
+	StartTime          *int64                        `json:"startTime,omitempty"`
+	CloseTime          *int64                        `json:"closeTime,omitempty"`
+	CloseStatus        *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	HistoryLength      *int64                        `json:"historyLength,omitempty"`
+	ParentDomainId     *string                       `json:"parentDomainId,omitempty"`
+	ParentExecution    *WorkflowExecution            `json:"parentExecution,omitempty"`
+	ExecutionTime      *int64                        `json:"executionTime,omitempty"`
+	Memo               *Memo                         `json:"memo,omitempty"`
+	SearchAttributes   *SearchAttributes             `json:"searchAttributes,omitempty"`
+	AutoResetPoints    *ResetPoints                  `json:"autoResetPoints,omitempty"`
+	TaskList           *string                       `json:"taskList,omitempty"`
+	IsCron             *bool                         `json:"isCron,omitempty"`
+	NextRetryTimestamp *int64                        `json:"nextRetryTimestamp,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
@@ -63705,7 +68984,7 @@ type WorkflowExecutionInfo struct {
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [14]wire.Field
+		fields [15]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -63823,6 +69102,14 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
+	if v.NextRetryTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.NextRetryTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -63982,6 +69269,16 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 140:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextRetryTimestamp = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -63996,7 +69293,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [14]string
+	var fields [15]string
 	i := 0
 	if v.Execution != nil {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
@@ -64054,6 +69351,10 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("IsCron: %v", *(v.IsCron))
 		i++
 	}
+	if v.NextRetryTimestamp != nil {
+		fields[i] = fmt.Sprintf("NextRetryTimestamp: %v", *(v.NextRetryTimestamp))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -64110,6 +69411,9 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_Bool_EqualsPtr(v.IsCron, rhs.IsCron) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.NextRetryTimestamp, rhs.NextRetryTimestamp) {
+		return false
+	}
 
 	return true
 }
@@ -64162,6 +69466,9 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.IsCron != nil {
 		enc.AddBool("isCron", *v.IsCron)
 	}
+	if v.NextRetryTimestamp != nil {
+		enc.AddInt64("nextRetryTimestamp", *v.NextRetryTimestamp)
+	}
 	return err
 }
 
@@ -64375,10 +69682,26 @@ func (v *WorkflowExecutionInfo) IsSetIsCron() bool {
 	return v != nil && v.IsCron != nil
 }
 
+// GetNextRetryTimestamp returns the value of NextRetryTimestamp if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetNextRetryTimestamp() (o int64) {
+	if v != nil && v.NextRetryTimestamp != nil {
+		return *v.NextRetryTimestamp
+	}
+
+	return
+}
+
+// IsSetNextRetryTimestamp returns true if NextRetryTimestamp is not nil.
+func (v *WorkflowExecutionInfo) IsSetNextRetryTimestamp() bool {
+	return v != nil && v.NextRetryTimestamp != nil
+}
+
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName *string `json:"signalName,omitempty"`
 	Input      []byte  `json:"input,omitempty"`
 	Identity   *string `json:"identity,omitempty"`
+	RequestId  *string `json:"requestId,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionSignaledEventAttributes struct into a Thrift-level intermediate
@@ -64398,7 +69721,7 @@ type WorkflowExecutionSignaledEventAttributes struct {
 //   }
 func (v *WorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -64428,6 +69751,14 @@ func (v *WorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value, error)
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -64481,6 +69812,16 @@ func (v *WorkflowExecutionSignaledEventAttributes) FromWire(w wire.Value) error
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -64495,7 +69836,7 @@ func (v *WorkflowExecutionSignaledEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.SignalName != nil {
 		fields[i] = fmt.Sprintf("SignalName: %v", *(v.SignalName))
@@ -64509,6 +69850,10 @@ func (v *WorkflowExecutionSignaledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionSignaledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -64532,6 +69877,9 @@ func (v *WorkflowExecutionSignaledEventAttributes) Equals(rhs *WorkflowExecution
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 
 	return true
 }
@@ -64551,6 +69899,9 @@ func (v *WorkflowExecutionSignaledEventAttributes) MarshalLogObject(enc zapcore.
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
 	return err
 }
 
@@ -64599,6 +69950,21 @@ func (v *WorkflowExecutionSignaledEventAttributes) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionSignaledEventAttributes) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *WorkflowExecutionSignaledEventAttributes) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
 type WorkflowExecutionStartedEventAttributes struct {
 	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
 	ParentWorkflowDomain                *string                 `json:"parentWorkflowDomain,omitempty"`
@@ -64625,6 +69991,11 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
+	Priority                            *int32                  `json:"priority,omitempty"`
+	FairnessKey                         *string                 `json:"fairnessKey,omitempty"`
+	IsolationGroup                      *string                 `json:"isolationGroup,omitempty"`
+	RetryElapsedExpirationTimestamp     *int64                  `json:"retryElapsedExpirationTimestamp,omitempty"`
+	DelayStartSeconds                   *int32                  `json:"delayStartSeconds,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionStartedEventAttributes struct into a Thrift-level intermediate
@@ -64644,7 +70015,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [25]wire.Field
+		fields [30]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -64850,6 +70221,46 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 140, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
+	if v.RetryElapsedExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.RetryElapsedExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+	if v.DelayStartSeconds != nil {
+		w, err = wire.NewValueI32(*(v.DelayStartSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -65103,6 +70514,56 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 150:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 170:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 180:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RetryElapsedExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 190:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.DelayStartSeconds = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -65117,7 +70578,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [25]string
+	var fields [30]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -65219,6 +70680,26 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
+	if v.RetryElapsedExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("RetryElapsedExpirationTimestamp: %v", *(v.RetryElapsedExpirationTimestamp))
+		i++
+	}
+	if v.DelayStartSeconds != nil {
+		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -65308,6 +70789,21 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RetryElapsedExpirationTimestamp, rhs.RetryElapsedExpirationTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
+		return false
+	}
 
 	return true
 }
@@ -65393,6 +70889,21 @@ func (v *WorkflowExecutionStartedEventAttributes) MarshalLogObject(enc zapcore.O
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
+	if v.RetryElapsedExpirationTimestamp != nil {
+		enc.AddInt64("retryElapsedExpirationTimestamp", *v.RetryElapsedExpirationTimestamp)
+	}
+	if v.DelayStartSeconds != nil {
+		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
+	}
 	return err
 }
 
@@ -65771,10 +71282,86 @@ func (v *WorkflowExecutionStartedEventAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
+// GetRetryElapsedExpirationTimestamp returns the value of RetryElapsedExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetRetryElapsedExpirationTimestamp() (o int64) {
+	if v != nil && v.RetryElapsedExpirationTimestamp != nil {
+		return *v.RetryElapsedExpirationTimestamp
+	}
+
+	return
+}
+
+// IsSetRetryElapsedExpirationTimestamp returns true if RetryElapsedExpirationTimestamp is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetRetryElapsedExpirationTimestamp() bool {
+	return v != nil && v.RetryElapsedExpirationTimestamp != nil
+}
+
+// GetDelayStartSeconds returns the value of DelayStartSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetDelayStartSeconds() (o int32) {
+	if v != nil && v.DelayStartSeconds != nil {
+		return *v.DelayStartSeconds
+	}
+
+	return
+}
+
+// IsSetDelayStartSeconds returns true if DelayStartSeconds is not nil.
+func (v *WorkflowExecutionStartedEventAttributes) IsSetDelayStartSeconds() bool {
+	return v != nil && v.DelayStartSeconds != nil
+}
+
 type WorkflowExecutionTerminatedEventAttributes struct {
-	Reason   *string `json:"reason,omitempty"`
-	Details  []byte  `json:"details,omitempty"`
-	Identity *string `json:"identity,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+	Details   []byte  `json:"details,omitempty"`
+	Identity  *string `json:"identity,omitempty"`
+	RequestId *string `json:"requestId,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionTerminatedEventAttributes struct into a Thrift-level intermediate
@@ -65794,7 +71381,7 @@ type WorkflowExecutionTerminatedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionTerminatedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -65824,6 +71411,14 @@ func (v *WorkflowExecutionTerminatedEventAttributes) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -65877,6 +71472,16 @@ func (v *WorkflowExecutionTerminatedEventAttributes) FromWire(w wire.Value) erro
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -65891,7 +71496,7 @@ func (v *WorkflowExecutionTerminatedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.Reason != nil {
 		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
@@ -65905,6 +71510,10 @@ func (v *WorkflowExecutionTerminatedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionTerminatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -65928,6 +71537,9 @@ func (v *WorkflowExecutionTerminatedEventAttributes) Equals(rhs *WorkflowExecuti
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 
 	return true
 }
@@ -65947,6 +71559,9 @@ func (v *WorkflowExecutionTerminatedEventAttributes) MarshalLogObject(enc zapcor
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
 	return err
 }
 
@@ -65995,6 +71610,21 @@ func (v *WorkflowExecutionTerminatedEventAttributes) IsSetIdentity() bool {
 	return v != nil && v.Identity != nil
 }
 
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionTerminatedEventAttributes) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *WorkflowExecutionTerminatedEventAttributes) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
 type WorkflowExecutionTimedOutEventAttributes struct {
 	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
 }
@@ -66133,6 +71763,190 @@ func (v *WorkflowExecutionTimedOutEventAttributes) IsSetTimeoutType() bool {
 	return v != nil && v.TimeoutType != nil
 }
 
+type WorkflowIdConflictPolicy int32
+
+const (
+	WorkflowIdConflictPolicyFail              WorkflowIdConflictPolicy = 0
+	WorkflowIdConflictPolicyUseExisting       WorkflowIdConflictPolicy = 1
+	WorkflowIdConflictPolicyTerminateExisting WorkflowIdConflictPolicy = 2
+)
+
+// WorkflowIdConflictPolicy_Values returns all recognized values of WorkflowIdConflictPolicy.
+func WorkflowIdConflictPolicy_Values() []WorkflowIdConflictPolicy {
+	return []WorkflowIdConflictPolicy{
+		WorkflowIdConflictPolicyFail,
+		WorkflowIdConflictPolicyUseExisting,
+		WorkflowIdConflictPolicyTerminateExisting,
+	}
+}
+
+// UnmarshalText tries to decode WorkflowIdConflictPolicy from a byte slice
+// containing its name.
+//
+//   var v WorkflowIdConflictPolicy
+//   err := v.UnmarshalText([]byte("Fail"))
+func (v *WorkflowIdConflictPolicy) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Fail":
+		*v = WorkflowIdConflictPolicyFail
+		return nil
+	case "UseExisting":
+		*v = WorkflowIdConflictPolicyUseExisting
+		return nil
+	case "TerminateExisting":
+		*v = WorkflowIdConflictPolicyTerminateExisting
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowIdConflictPolicy", err)
+		}
+		*v = WorkflowIdConflictPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes WorkflowIdConflictPolicy to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v WorkflowIdConflictPolicy) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Fail"), nil
+	case 1:
+		return []byte("UseExisting"), nil
+	case 2:
+		return []byte("TerminateExisting"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowIdConflictPolicy.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v WorkflowIdConflictPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Fail")
+	case 1:
+		enc.AddString("name", "UseExisting")
+	case 2:
+		enc.AddString("name", "TerminateExisting")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v WorkflowIdConflictPolicy) Ptr() *WorkflowIdConflictPolicy {
+	return &v
+}
+
+// ToWire translates WorkflowIdConflictPolicy into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v WorkflowIdConflictPolicy) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes WorkflowIdConflictPolicy from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return WorkflowIdConflictPolicy(0), err
+//   }
+//
+//   var v WorkflowIdConflictPolicy
+//   if err := v.FromWire(x); err != nil {
+//     return WorkflowIdConflictPolicy(0), err
+//   }
+//   return v, nil
+func (v *WorkflowIdConflictPolicy) FromWire(w wire.Value) error {
+	*v = (WorkflowIdConflictPolicy)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of WorkflowIdConflictPolicy.
+func (v WorkflowIdConflictPolicy) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Fail"
+	case 1:
+		return "UseExisting"
+	case 2:
+		return "TerminateExisting"
+	}
+	return fmt.Sprintf("WorkflowIdConflictPolicy(%d)", w)
+}
+
+// Equals returns true if this WorkflowIdConflictPolicy value matches the provided
+// value.
+func (v WorkflowIdConflictPolicy) Equals(rhs WorkflowIdConflictPolicy) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes WorkflowIdConflictPolicy into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v WorkflowIdConflictPolicy) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Fail\""), nil
+	case 1:
+		return ([]byte)("\"UseExisting\""), nil
+	case 2:
+		return ([]byte)("\"TerminateExisting\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode WorkflowIdConflictPolicy from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *WorkflowIdConflictPolicy) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "WorkflowIdConflictPolicy")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "WorkflowIdConflictPolicy")
+		}
+		*v = (WorkflowIdConflictPolicy)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "WorkflowIdConflictPolicy")
+	}
+}
+
 type WorkflowIdReusePolicy int32
 
 const (
@@ -67031,8 +72845,8 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "shared",
 	Package:  "github.com/uber/cadence/.gen/go/shared",
 	FilePath: "shared.thrift",
-	SHA1:     "63bd1a696b39ffe14118403361f17952ce198a8f",
+	SHA1:     "5ca98b774cb1974f39b674f08057f84c616599b5",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception InternalDataInconsistencyError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n}\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n}\n\nexception ServiceBusyError {\n  1: required string message\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nexception RemoteSyncMatchedError {\n  10: required string message\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  130: optional bool isCron\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n}\n\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n}\n\nstruct PendingChildExecutionInfo {\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional list<string> taskListNames\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct FeatureFlags {\n\t10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task \n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID \n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes { \n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional CrossClusterTaskFailedCause failedCause\n  40: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  50: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  60: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception InternalDataInconsistencyError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n}\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n}\n\nexception ServiceBusyError {\n  1: required string message\n  2: optional i64 retryAfterMilliseconds\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nexception RemoteSyncMatchedError {\n  10: required string message\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum WorkflowIdConflictPolicy {\n  /*\n   * fail the request if a workflow is running using the same workflow ID\n   */\n  Fail,\n  /*\n   * if a workflow is running using the same workflow ID, return its run ID instead of starting a new one\n   */\n  UseExisting,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateExisting,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n  UpsertWorkflowMemo,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n  UpsertWorkflowMemo,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n  BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum RetryJitterMode {\n  NONE,\n  FULL,\n  EQUAL,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  130: optional bool isCron\n  140: optional i64 (js.type = \"Long\") nextRetryTimestamp\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n  100: optional i32 priority\n  110: optional string fairnessKey\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct UpsertWorkflowMemoDecisionAttributes {\n  10: optional Memo memo\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional i32 priority\n  170: optional string fairnessKey\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n  130: optional UpsertWorkflowMemoDecisionAttributes upsertWorkflowMemoDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n  150: optional i32 priority\n  160: optional string fairnessKey\n  170: optional string isolationGroup\n  180: optional i64 (js.type = \"Long\") retryElapsedExpirationTimestamp\n  // Set on the first run of a workflow started with a delay, the delay is part of firstDecisionTaskBackoffSeconds.\n  190: optional i32 delayStartSeconds\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n  130: optional i32 priority\n  140: optional string fairnessKey\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n  50: optional string requestId\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct UpsertWorkflowMemoEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional Memo memo\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n  180: optional i32 priority\n  190: optional string fairnessKey\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n  460: optional UpsertWorkflowMemoEventAttributes upsertWorkflowMemoEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n  120: optional list<string> drainedIsolationGroups\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n  170: optional i32 priority\n  180: optional string fairnessKey\n  190: optional string isolationGroup\n  200: optional WorkflowIdConflictPolicy workflowIdConflictPolicy\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n  50: optional string isolationGroup\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n  50: optional string binaryChecksum\n  60: optional string isolationGroup\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n  190: optional string isolationGroup\n  200: optional WorkflowIdConflictPolicy workflowIdConflictPolicy\n}\n\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n  60: optional string requestId\n}\n\nstruct ExpediteWorkflowExecutionStartRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n  140: optional i64 (js.type = \"Long\") nextRetryTimestamp\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n}\n\nstruct PendingChildExecutionInfo {\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n  50: optional bool includePartitions\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n  30: optional TaskListPartitionConfig partitionConfig\n  40: optional map<string, i32> pollersByBuildId\n  50: optional map<string, TaskListStatus> partitionStatus\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional list<string> taskListNames\n}\n\n// WorkerVersionSet is a set of worker build IDs which are compatible with each other\nstruct WorkerVersionSet {\n  10: optional list<string> buildIds\n}\n\nstruct UpdateWorkerBuildIDCompatibilityRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  // exactly one of addNewBuildIdInNewDefaultSet, addNewCompatibleBuildId and promoteSetByBuildId must be set\n  30: optional string addNewBuildIdInNewDefaultSet\n  40: optional string addNewCompatibleBuildId\n  50: optional string existingCompatibleBuildId\n  60: optional bool makeSetDefault\n  70: optional string promoteSetByBuildId\n}\n\nstruct GetWorkerBuildIDCompatibilityRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct GetWorkerBuildIDCompatibilityResponse {\n  // the last version set is the default set\n  10: optional list<WorkerVersionSet> versionSets\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct TaskListPartitionConfig {\n  10: optional i32 numReadPartitions\n  20: optional i32 numWritePartitions\n  30: optional bool scalingEnabled\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n  30: optional TaskListPartitionConfig activityTaskListPartitionConfig\n  40: optional TaskListPartitionConfig decisionTaskListPartitionConfig\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n  50: optional double addRatePerSecond\n  60: optional double dispatchRatePerSecond\n  70: optional double syncMatchRatio\n  80: optional double oldestTaskAgeSeconds\n  90: optional double ingestionRPSLimit\n  100: optional double domainIngestionRPSLimit\n  110: optional double domainDispatchRPSLimit\n  120: optional double throttledAddRatePerSecond\n  130: optional double throttledDispatchRatePerSecond\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n\n  // hash or load\n  30: optional string placementMode\n  // ShardID to placement, only set in the load placement mode\n  40: optional map<i32, ShardPlacementInfo> placements\n}\n\nstruct ShardPlacementInfo {\n  10: optional string assignedHost\n  20: optional bool pinned\n  30: optional double rps\n  40: optional i64 (js.type = \"Long\") queueBacklog\n  50: optional i64 (js.type = \"Long\") cacheSize\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n  60: optional list<PersistenceCircuitBreakerInfo> persistenceCircuitBreakers\n}\n\nstruct PersistenceCircuitBreakerInfo{\n  10: optional string storeName\n  20: optional string operationClass\n  30: optional string state\n  40: optional i64    lastStateChangeTimestamp\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n  40: optional string buildId\n  50: optional string isolationGroup\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n\n  // Explicit intervals between retries, replaces the exponential backoff.\n  // The last interval is used for the remaining retries.\n  70: optional list<i32> backoffScheduleInSeconds\n\n  // Randomization of the retry intervals.\n  80: optional RetryJitterMode jitterMode\n\n  // Policies overriding the backoff fields they set for failure reasons matching their pattern, the first match is used.\n  90: optional list<RetryReasonPolicy> reasonPolicies\n\n  // Maximum time of all retries of a workflow, including the runs continued as new.\n  100: optional i32 maximumTotalElapsedTimeInSeconds\n}\n\nstruct RetryReasonPolicy {\n  // Regular expression matched against the failure reason.\n  10: optional string reasonPattern\n  20: optional RetryPolicy retryPolicy\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct FeatureFlags {\n\t10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task \n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID \n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes { \n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional CrossClusterTaskFailedCause failedCause\n  40: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  50: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  60: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n"
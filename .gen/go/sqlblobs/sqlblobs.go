@@ -71,6 +71,12 @@ type ActivityInfo struct {
 	RetryLastFailureReason        *string  `json:"retryLastFailureReason,omitempty"`
 	RetryLastWorkerIdentity       *string  `json:"retryLastWorkerIdentity,omitempty"`
 	RetryLastFailureDetails       []byte   `json:"retryLastFailureDetails,omitempty"`
+	Priority                      *int32   `json:"priority,omitempty"`
+	FairnessKey                   *string  `json:"fairnessKey,omitempty"`
+	RetryBackoffScheduleSeconds   []int32  `json:"retryBackoffScheduleSeconds,omitempty"`
+	RetryJitterMode               *int32   `json:"retryJitterMode,omitempty"`
+	RetryReasonPolicies           []byte   `json:"retryReasonPolicies,omitempty"`
+	RetryReasonPoliciesEncoding   *string  `json:"retryReasonPoliciesEncoding,omitempty"`
 }
 
 type _List_String_ValueList []string
@@ -99,6 +105,32 @@ func (_List_String_ValueList) ValueType() wire.Type {
 
 func (_List_String_ValueList) Close() {}
 
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
 // ToWire translates a ActivityInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -116,7 +148,7 @@ func (_List_String_ValueList) Close() {}
 //   }
 func (v *ActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [31]wire.Field
+		fields [37]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -370,6 +402,54 @@ func (v *ActivityInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 72, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 74, Value: w}
+		i++
+	}
+	if v.RetryBackoffScheduleSeconds != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.RetryBackoffScheduleSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 76, Value: w}
+		i++
+	}
+	if v.RetryJitterMode != nil {
+		w, err = wire.NewValueI32(*(v.RetryJitterMode)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 78, Value: w}
+		i++
+	}
+	if v.RetryReasonPolicies != nil {
+		w, err = wire.NewValueBinary(v.RetryReasonPolicies), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.RetryReasonPoliciesEncoding != nil {
+		w, err = wire.NewValueString(*(v.RetryReasonPoliciesEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 82, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -392,6 +472,24 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 	return o, err
 }
 
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a ActivityInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -715,6 +813,62 @@ func (v *ActivityInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 72:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 74:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 76:
+			if field.Value.Type() == wire.TList {
+				v.RetryBackoffScheduleSeconds, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 78:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetryJitterMode = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				v.RetryReasonPolicies, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 82:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RetryReasonPoliciesEncoding = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -729,7 +883,7 @@ func (v *ActivityInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [31]string
+	var fields [37]string
 	i := 0
 	if v.Version != nil {
 		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
@@ -855,6 +1009,30 @@ func (v *ActivityInfo) String() string {
 		fields[i] = fmt.Sprintf("RetryLastFailureDetails: %v", v.RetryLastFailureDetails)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
+	if v.RetryBackoffScheduleSeconds != nil {
+		fields[i] = fmt.Sprintf("RetryBackoffScheduleSeconds: %v", v.RetryBackoffScheduleSeconds)
+		i++
+	}
+	if v.RetryJitterMode != nil {
+		fields[i] = fmt.Sprintf("RetryJitterMode: %v", *(v.RetryJitterMode))
+		i++
+	}
+	if v.RetryReasonPolicies != nil {
+		fields[i] = fmt.Sprintf("RetryReasonPolicies: %v", v.RetryReasonPolicies)
+		i++
+	}
+	if v.RetryReasonPoliciesEncoding != nil {
+		fields[i] = fmt.Sprintf("RetryReasonPoliciesEncoding: %v", *(v.RetryReasonPoliciesEncoding))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -924,6 +1102,21 @@ func _List_String_Equals(lhs, rhs []string) bool {
 	return true
 }
 
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this ActivityInfo match the
 // provided ActivityInfo.
 //
@@ -1027,6 +1220,24 @@ func (v *ActivityInfo) Equals(rhs *ActivityInfo) bool {
 	if !((v.RetryLastFailureDetails == nil && rhs.RetryLastFailureDetails == nil) || (v.RetryLastFailureDetails != nil && rhs.RetryLastFailureDetails != nil && bytes.Equal(v.RetryLastFailureDetails, rhs.RetryLastFailureDetails))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
+	if !((v.RetryBackoffScheduleSeconds == nil && rhs.RetryBackoffScheduleSeconds == nil) || (v.RetryBackoffScheduleSeconds != nil && rhs.RetryBackoffScheduleSeconds != nil && _List_I32_Equals(v.RetryBackoffScheduleSeconds, rhs.RetryBackoffScheduleSeconds))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.RetryJitterMode, rhs.RetryJitterMode) {
+		return false
+	}
+	if !((v.RetryReasonPolicies == nil && rhs.RetryReasonPolicies == nil) || (v.RetryReasonPolicies != nil && rhs.RetryReasonPolicies != nil && bytes.Equal(v.RetryReasonPolicies, rhs.RetryReasonPolicies))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RetryReasonPoliciesEncoding, rhs.RetryReasonPoliciesEncoding) {
+		return false
+	}
 
 	return true
 }
@@ -1042,6 +1253,17 @@ func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err erro
 	return err
 }
 
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of ActivityInfo.
 func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -1141,6 +1363,24 @@ func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.RetryLastFailureDetails != nil {
 		enc.AddString("retryLastFailureDetails", base64.StdEncoding.EncodeToString(v.RetryLastFailureDetails))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
+	if v.RetryBackoffScheduleSeconds != nil {
+		err = multierr.Append(err, enc.AddArray("retryBackoffScheduleSeconds", (_List_I32_Zapper)(v.RetryBackoffScheduleSeconds)))
+	}
+	if v.RetryJitterMode != nil {
+		enc.AddInt32("retryJitterMode", *v.RetryJitterMode)
+	}
+	if v.RetryReasonPolicies != nil {
+		enc.AddString("retryReasonPolicies", base64.StdEncoding.EncodeToString(v.RetryReasonPolicies))
+	}
+	if v.RetryReasonPoliciesEncoding != nil {
+		enc.AddString("retryReasonPoliciesEncoding", *v.RetryReasonPoliciesEncoding)
+	}
 	return err
 }
 
@@ -1609,6 +1849,96 @@ func (v *ActivityInfo) IsSetRetryLastFailureDetails() bool {
 	return v != nil && v.RetryLastFailureDetails != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ActivityInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *ActivityInfo) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
+// GetRetryBackoffScheduleSeconds returns the value of RetryBackoffScheduleSeconds if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryBackoffScheduleSeconds() (o []int32) {
+	if v != nil && v.RetryBackoffScheduleSeconds != nil {
+		return v.RetryBackoffScheduleSeconds
+	}
+
+	return
+}
+
+// IsSetRetryBackoffScheduleSeconds returns true if RetryBackoffScheduleSeconds is not nil.
+func (v *ActivityInfo) IsSetRetryBackoffScheduleSeconds() bool {
+	return v != nil && v.RetryBackoffScheduleSeconds != nil
+}
+
+// GetRetryJitterMode returns the value of RetryJitterMode if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryJitterMode() (o int32) {
+	if v != nil && v.RetryJitterMode != nil {
+		return *v.RetryJitterMode
+	}
+
+	return
+}
+
+// IsSetRetryJitterMode returns true if RetryJitterMode is not nil.
+func (v *ActivityInfo) IsSetRetryJitterMode() bool {
+	return v != nil && v.RetryJitterMode != nil
+}
+
+// GetRetryReasonPolicies returns the value of RetryReasonPolicies if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryReasonPolicies() (o []byte) {
+	if v != nil && v.RetryReasonPolicies != nil {
+		return v.RetryReasonPolicies
+	}
+
+	return
+}
+
+// IsSetRetryReasonPolicies returns true if RetryReasonPolicies is not nil.
+func (v *ActivityInfo) IsSetRetryReasonPolicies() bool {
+	return v != nil && v.RetryReasonPolicies != nil
+}
+
+// GetRetryReasonPoliciesEncoding returns the value of RetryReasonPoliciesEncoding if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetRetryReasonPoliciesEncoding() (o string) {
+	if v != nil && v.RetryReasonPoliciesEncoding != nil {
+		return *v.RetryReasonPoliciesEncoding
+	}
+
+	return
+}
+
+// IsSetRetryReasonPoliciesEncoding returns true if RetryReasonPoliciesEncoding is not nil.
+func (v *ActivityInfo) IsSetRetryReasonPoliciesEncoding() bool {
+	return v != nil && v.RetryReasonPoliciesEncoding != nil
+}
+
 type ChildExecutionInfo struct {
 	Version                *int64  `json:"version,omitempty"`
 	InitiatedEventBatchID  *int64  `json:"initiatedEventBatchID,omitempty"`
@@ -2294,6 +2624,7 @@ type DomainInfo struct {
 	FailoverEndTime             *int64            `json:"failoverEndTime,omitempty"`
 	PreviousFailoverVersion     *int64            `json:"previousFailoverVersion,omitempty"`
 	LastUpdatedTime             *int64            `json:"lastUpdatedTime,omitempty"`
+	DrainedIsolationGroups      []string          `json:"drainedIsolationGroups,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -2348,7 +2679,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //   }
 func (v *DomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [24]wire.Field
+		fields [25]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2546,6 +2877,14 @@ func (v *DomainInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 54, Value: w}
 		i++
 	}
+	if v.DrainedIsolationGroups != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.DrainedIsolationGroups)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 56, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2833,6 +3172,14 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 56:
+			if field.Value.Type() == wire.TList {
+				v.DrainedIsolationGroups, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2847,7 +3194,7 @@ func (v *DomainInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [24]string
+	var fields [25]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -2945,6 +3292,10 @@ func (v *DomainInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedTime: %v", *(v.LastUpdatedTime))
 		i++
 	}
+	if v.DrainedIsolationGroups != nil {
+		fields[i] = fmt.Sprintf("DrainedIsolationGroups: %v", v.DrainedIsolationGroups)
+		i++
+	}
 
 	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -3058,6 +3409,9 @@ func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedTime, rhs.LastUpdatedTime) {
 		return false
 	}
+	if !((v.DrainedIsolationGroups == nil && rhs.DrainedIsolationGroups == nil) || (v.DrainedIsolationGroups != nil && rhs.DrainedIsolationGroups != nil && _List_String_Equals(v.DrainedIsolationGroups, rhs.DrainedIsolationGroups))) {
+		return false
+	}
 
 	return true
 }
@@ -3151,6 +3505,9 @@ func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedTime != nil {
 		enc.AddInt64("lastUpdatedTime", *v.LastUpdatedTime)
 	}
+	if v.DrainedIsolationGroups != nil {
+		err = multierr.Append(err, enc.AddArray("drainedIsolationGroups", (_List_String_Zapper)(v.DrainedIsolationGroups)))
+	}
 	return err
 }
 
@@ -3514,6 +3871,21 @@ func (v *DomainInfo) IsSetLastUpdatedTime() bool {
 	return v != nil && v.LastUpdatedTime != nil
 }
 
+// GetDrainedIsolationGroups returns the value of DrainedIsolationGroups if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetDrainedIsolationGroups() (o []string) {
+	if v != nil && v.DrainedIsolationGroups != nil {
+		return v.DrainedIsolationGroups
+	}
+
+	return
+}
+
+// IsSetDrainedIsolationGroups returns true if DrainedIsolationGroups is not nil.
+func (v *DomainInfo) IsSetDrainedIsolationGroups() bool {
+	return v != nil && v.DrainedIsolationGroups != nil
+}
+
 type HistoryTreeInfo struct {
 	CreatedTimeNanos *int64                       `json:"createdTimeNanos,omitempty"`
 	Ancestors        []*shared.HistoryBranchRange `json:"ancestors,omitempty"`
@@ -6066,6 +6438,9 @@ type TaskInfo struct {
 	ScheduleID       *int64  `json:"scheduleID,omitempty"`
 	ExpiryTimeNanos  *int64  `json:"expiryTimeNanos,omitempty"`
 	CreatedTimeNanos *int64  `json:"createdTimeNanos,omitempty"`
+	Priority         *int32  `json:"priority,omitempty"`
+	FairnessKey      *string `json:"fairnessKey,omitempty"`
+	IsolationGroup   *string `json:"isolationGroup,omitempty"`
 }
 
 // ToWire translates a TaskInfo struct into a Thrift-level intermediate
@@ -6085,7 +6460,7 @@ type TaskInfo struct {
 //   }
 func (v *TaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6131,17 +6506,41 @@ func (v *TaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 15, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a TaskInfo struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a TaskInfo struct
-// from the provided intermediate representation.
-//
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 17, Value: w}
+		i++
+	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskInfo struct
+// from the provided intermediate representation.
+//
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
 //   if err != nil {
 //     return nil, err
@@ -6204,6 +6603,36 @@ func (v *TaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 16:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 17:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 18:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6218,7 +6647,7 @@ func (v *TaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [8]string
 	i := 0
 	if v.WorkflowID != nil {
 		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
@@ -6240,6 +6669,18 @@ func (v *TaskInfo) String() string {
 		fields[i] = fmt.Sprintf("CreatedTimeNanos: %v", *(v.CreatedTimeNanos))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
 
 	return fmt.Sprintf("TaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -6269,6 +6710,15 @@ func (v *TaskInfo) Equals(rhs *TaskInfo) bool {
 	if !_I64_EqualsPtr(v.CreatedTimeNanos, rhs.CreatedTimeNanos) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
 
 	return true
 }
@@ -6294,6 +6744,15 @@ func (v *TaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.CreatedTimeNanos != nil {
 		enc.AddInt64("createdTimeNanos", *v.CreatedTimeNanos)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
 	return err
 }
 
@@ -6372,13 +6831,90 @@ func (v *TaskInfo) IsSetCreatedTimeNanos() bool {
 	return v != nil && v.CreatedTimeNanos != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *TaskInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *TaskInfo) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *TaskInfo) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
 type TaskListInfo struct {
-	Kind             *int16 `json:"kind,omitempty"`
-	AckLevel         *int64 `json:"ackLevel,omitempty"`
-	ExpiryTimeNanos  *int64 `json:"expiryTimeNanos,omitempty"`
-	LastUpdatedNanos *int64 `json:"lastUpdatedNanos,omitempty"`
+	Kind             *int16                     `json:"kind,omitempty"`
+	AckLevel         *int64                     `json:"ackLevel,omitempty"`
+	ExpiryTimeNanos  *int64                     `json:"expiryTimeNanos,omitempty"`
+	LastUpdatedNanos *int64                     `json:"lastUpdatedNanos,omitempty"`
+	VersionSets      []*shared.WorkerVersionSet `json:"versionSets,omitempty"`
+	Paused           *bool                      `json:"paused,omitempty"`
+	MigrateTo        *string                    `json:"migrateTo,omitempty"`
 }
 
+type _List_WorkerVersionSet_ValueList []*shared.WorkerVersionSet
+
+func (v _List_WorkerVersionSet_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_WorkerVersionSet_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_WorkerVersionSet_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkerVersionSet_ValueList) Close() {}
+
 // ToWire translates a TaskListInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -6396,7 +6932,7 @@ type TaskListInfo struct {
 //   }
 func (v *TaskListInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6434,10 +6970,58 @@ func (v *TaskListInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 16, Value: w}
 		i++
 	}
+	if v.VersionSets != nil {
+		w, err = wire.NewValueList(_List_WorkerVersionSet_ValueList(v.VersionSets)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MigrateTo != nil {
+		w, err = wire.NewValueString(*(v.MigrateTo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _WorkerVersionSet_Read(w wire.Value) (*shared.WorkerVersionSet, error) {
+	var v shared.WorkerVersionSet
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_WorkerVersionSet_Read(l wire.ValueList) ([]*shared.WorkerVersionSet, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.WorkerVersionSet, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkerVersionSet_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a TaskListInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -6499,6 +7083,34 @@ func (v *TaskListInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 18:
+			if field.Value.Type() == wire.TList {
+				v.VersionSets, err = _List_WorkerVersionSet_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 22:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MigrateTo = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6513,7 +7125,7 @@ func (v *TaskListInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [7]string
 	i := 0
 	if v.Kind != nil {
 		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
@@ -6531,10 +7143,37 @@ func (v *TaskListInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedNanos: %v", *(v.LastUpdatedNanos))
 		i++
 	}
+	if v.VersionSets != nil {
+		fields[i] = fmt.Sprintf("VersionSets: %v", v.VersionSets)
+		i++
+	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
+	if v.MigrateTo != nil {
+		fields[i] = fmt.Sprintf("MigrateTo: %v", *(v.MigrateTo))
+		i++
+	}
 
 	return fmt.Sprintf("TaskListInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _List_WorkerVersionSet_Equals(lhs, rhs []*shared.WorkerVersionSet) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this TaskListInfo match the
 // provided TaskListInfo.
 //
@@ -6557,10 +7196,30 @@ func (v *TaskListInfo) Equals(rhs *TaskListInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedNanos, rhs.LastUpdatedNanos) {
 		return false
 	}
+	if !((v.VersionSets == nil && rhs.VersionSets == nil) || (v.VersionSets != nil && rhs.VersionSets != nil && _List_WorkerVersionSet_Equals(v.VersionSets, rhs.VersionSets))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
+	if !_String_EqualsPtr(v.MigrateTo, rhs.MigrateTo) {
+		return false
+	}
 
 	return true
 }
 
+type _List_WorkerVersionSet_Zapper []*shared.WorkerVersionSet
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkerVersionSet_Zapper.
+func (l _List_WorkerVersionSet_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of TaskListInfo.
 func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -6579,6 +7238,15 @@ func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedNanos != nil {
 		enc.AddInt64("lastUpdatedNanos", *v.LastUpdatedNanos)
 	}
+	if v.VersionSets != nil {
+		err = multierr.Append(err, enc.AddArray("versionSets", (_List_WorkerVersionSet_Zapper)(v.VersionSets)))
+	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
+	if v.MigrateTo != nil {
+		enc.AddString("migrateTo", *v.MigrateTo)
+	}
 	return err
 }
 
@@ -6642,6 +7310,51 @@ func (v *TaskListInfo) IsSetLastUpdatedNanos() bool {
 	return v != nil && v.LastUpdatedNanos != nil
 }
 
+// GetVersionSets returns the value of VersionSets if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetVersionSets() (o []*shared.WorkerVersionSet) {
+	if v != nil && v.VersionSets != nil {
+		return v.VersionSets
+	}
+
+	return
+}
+
+// IsSetVersionSets returns true if VersionSets is not nil.
+func (v *TaskListInfo) IsSetVersionSets() bool {
+	return v != nil && v.VersionSets != nil
+}
+
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *TaskListInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
+// GetMigrateTo returns the value of MigrateTo if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetMigrateTo() (o string) {
+	if v != nil && v.MigrateTo != nil {
+		return *v.MigrateTo
+	}
+
+	return
+}
+
+// IsSetMigrateTo returns true if MigrateTo is not nil.
+func (v *TaskListInfo) IsSetMigrateTo() bool {
+	return v != nil && v.MigrateTo != nil
+}
+
 type TimerInfo struct {
 	Version         *int64 `json:"version,omitempty"`
 	StartedID       *int64 `json:"startedID,omitempty"`
@@ -8027,6 +8740,16 @@ type WorkflowExecutionInfo struct {
 	Memo                                    map[string][]byte `json:"memo,omitempty"`
 	VersionHistories                        []byte            `json:"versionHistories,omitempty"`
 	VersionHistoriesEncoding                *string           `json:"versionHistoriesEncoding,omitempty"`
+	TaskPriority                            *int32            `json:"taskPriority,omitempty"`
+	TaskFairnessKey                         *string           `json:"taskFairnessKey,omitempty"`
+	IsolationGroup                          *string           `json:"isolationGroup,omitempty"`
+	RetryBackoffScheduleSeconds             []int32           `json:"retryBackoffScheduleSeconds,omitempty"`
+	RetryJitterMode                         *int32            `json:"retryJitterMode,omitempty"`
+	RetryReasonPolicies                     []byte            `json:"retryReasonPolicies,omitempty"`
+	RetryReasonPoliciesEncoding             *string           `json:"retryReasonPoliciesEncoding,omitempty"`
+	RetryElapsedExpirationTimeNanos         *int64            `json:"retryElapsedExpirationTimeNanos,omitempty"`
+	NextRetryTimeNanos                      *int64            `json:"nextRetryTimeNanos,omitempty"`
+	DedupRequestIDTimeNanos                 map[string]int64  `json:"dedupRequestIDTimeNanos,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -8084,7 +8807,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [58]wire.Field
+		fields [68]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8554,6 +9277,86 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 124, Value: w}
 		i++
 	}
+	if v.TaskPriority != nil {
+		w, err = wire.NewValueI32(*(v.TaskPriority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 126, Value: w}
+		i++
+	}
+	if v.TaskFairnessKey != nil {
+		w, err = wire.NewValueString(*(v.TaskFairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 128, Value: w}
+		i++
+	}
+	if v.IsolationGroup != nil {
+		w, err = wire.NewValueString(*(v.IsolationGroup)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.RetryBackoffScheduleSeconds != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.RetryBackoffScheduleSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 132, Value: w}
+		i++
+	}
+	if v.RetryJitterMode != nil {
+		w, err = wire.NewValueI32(*(v.RetryJitterMode)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 134, Value: w}
+		i++
+	}
+	if v.RetryReasonPolicies != nil {
+		w, err = wire.NewValueBinary(v.RetryReasonPolicies), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 136, Value: w}
+		i++
+	}
+	if v.RetryReasonPoliciesEncoding != nil {
+		w, err = wire.NewValueString(*(v.RetryReasonPoliciesEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 138, Value: w}
+		i++
+	}
+	if v.RetryElapsedExpirationTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.RetryElapsedExpirationTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.NextRetryTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.NextRetryTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 142, Value: w}
+		i++
+	}
+	if v.DedupRequestIDTimeNanos != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.DedupRequestIDTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 144, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -9167,6 +9970,100 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 126:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskPriority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 128:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskFairnessKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IsolationGroup = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 132:
+			if field.Value.Type() == wire.TList {
+				v.RetryBackoffScheduleSeconds, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 134:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.RetryJitterMode = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 136:
+			if field.Value.Type() == wire.TBinary {
+				v.RetryReasonPolicies, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 138:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RetryReasonPoliciesEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RetryElapsedExpirationTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 142:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextRetryTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 144:
+			if field.Value.Type() == wire.TMap {
+				v.DedupRequestIDTimeNanos, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -9181,7 +10078,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [58]string
+	var fields [68]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -9415,6 +10312,46 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("VersionHistoriesEncoding: %v", *(v.VersionHistoriesEncoding))
 		i++
 	}
+	if v.TaskPriority != nil {
+		fields[i] = fmt.Sprintf("TaskPriority: %v", *(v.TaskPriority))
+		i++
+	}
+	if v.TaskFairnessKey != nil {
+		fields[i] = fmt.Sprintf("TaskFairnessKey: %v", *(v.TaskFairnessKey))
+		i++
+	}
+	if v.IsolationGroup != nil {
+		fields[i] = fmt.Sprintf("IsolationGroup: %v", *(v.IsolationGroup))
+		i++
+	}
+	if v.RetryBackoffScheduleSeconds != nil {
+		fields[i] = fmt.Sprintf("RetryBackoffScheduleSeconds: %v", v.RetryBackoffScheduleSeconds)
+		i++
+	}
+	if v.RetryJitterMode != nil {
+		fields[i] = fmt.Sprintf("RetryJitterMode: %v", *(v.RetryJitterMode))
+		i++
+	}
+	if v.RetryReasonPolicies != nil {
+		fields[i] = fmt.Sprintf("RetryReasonPolicies: %v", v.RetryReasonPolicies)
+		i++
+	}
+	if v.RetryReasonPoliciesEncoding != nil {
+		fields[i] = fmt.Sprintf("RetryReasonPoliciesEncoding: %v", *(v.RetryReasonPoliciesEncoding))
+		i++
+	}
+	if v.RetryElapsedExpirationTimeNanos != nil {
+		fields[i] = fmt.Sprintf("RetryElapsedExpirationTimeNanos: %v", *(v.RetryElapsedExpirationTimeNanos))
+		i++
+	}
+	if v.NextRetryTimeNanos != nil {
+		fields[i] = fmt.Sprintf("NextRetryTimeNanos: %v", *(v.NextRetryTimeNanos))
+		i++
+	}
+	if v.DedupRequestIDTimeNanos != nil {
+		fields[i] = fmt.Sprintf("DedupRequestIDTimeNanos: %v", v.DedupRequestIDTimeNanos)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -9620,6 +10557,36 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.VersionHistoriesEncoding, rhs.VersionHistoriesEncoding) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.TaskPriority, rhs.TaskPriority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskFairnessKey, rhs.TaskFairnessKey) {
+		return false
+	}
+	if !_String_EqualsPtr(v.IsolationGroup, rhs.IsolationGroup) {
+		return false
+	}
+	if !((v.RetryBackoffScheduleSeconds == nil && rhs.RetryBackoffScheduleSeconds == nil) || (v.RetryBackoffScheduleSeconds != nil && rhs.RetryBackoffScheduleSeconds != nil && _List_I32_Equals(v.RetryBackoffScheduleSeconds, rhs.RetryBackoffScheduleSeconds))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.RetryJitterMode, rhs.RetryJitterMode) {
+		return false
+	}
+	if !((v.RetryReasonPolicies == nil && rhs.RetryReasonPolicies == nil) || (v.RetryReasonPolicies != nil && rhs.RetryReasonPolicies != nil && bytes.Equal(v.RetryReasonPolicies, rhs.RetryReasonPolicies))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RetryReasonPoliciesEncoding, rhs.RetryReasonPoliciesEncoding) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RetryElapsedExpirationTimeNanos, rhs.RetryElapsedExpirationTimeNanos) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextRetryTimeNanos, rhs.NextRetryTimeNanos) {
+		return false
+	}
+	if !((v.DedupRequestIDTimeNanos == nil && rhs.DedupRequestIDTimeNanos == nil) || (v.DedupRequestIDTimeNanos != nil && rhs.DedupRequestIDTimeNanos != nil && _Map_String_I64_Equals(v.DedupRequestIDTimeNanos, rhs.DedupRequestIDTimeNanos))) {
+		return false
+	}
 
 	return true
 }
@@ -9815,6 +10782,36 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.VersionHistoriesEncoding != nil {
 		enc.AddString("versionHistoriesEncoding", *v.VersionHistoriesEncoding)
 	}
+	if v.TaskPriority != nil {
+		enc.AddInt32("taskPriority", *v.TaskPriority)
+	}
+	if v.TaskFairnessKey != nil {
+		enc.AddString("taskFairnessKey", *v.TaskFairnessKey)
+	}
+	if v.IsolationGroup != nil {
+		enc.AddString("isolationGroup", *v.IsolationGroup)
+	}
+	if v.RetryBackoffScheduleSeconds != nil {
+		err = multierr.Append(err, enc.AddArray("retryBackoffScheduleSeconds", (_List_I32_Zapper)(v.RetryBackoffScheduleSeconds)))
+	}
+	if v.RetryJitterMode != nil {
+		enc.AddInt32("retryJitterMode", *v.RetryJitterMode)
+	}
+	if v.RetryReasonPolicies != nil {
+		enc.AddString("retryReasonPolicies", base64.StdEncoding.EncodeToString(v.RetryReasonPolicies))
+	}
+	if v.RetryReasonPoliciesEncoding != nil {
+		enc.AddString("retryReasonPoliciesEncoding", *v.RetryReasonPoliciesEncoding)
+	}
+	if v.RetryElapsedExpirationTimeNanos != nil {
+		enc.AddInt64("retryElapsedExpirationTimeNanos", *v.RetryElapsedExpirationTimeNanos)
+	}
+	if v.NextRetryTimeNanos != nil {
+		enc.AddInt64("nextRetryTimeNanos", *v.NextRetryTimeNanos)
+	}
+	if v.DedupRequestIDTimeNanos != nil {
+		err = multierr.Append(err, enc.AddObject("dedupRequestIDTimeNanos", (_Map_String_I64_Zapper)(v.DedupRequestIDTimeNanos)))
+	}
 	return err
 }
 
@@ -10688,16 +11685,166 @@ func (v *WorkflowExecutionInfo) IsSetVersionHistoriesEncoding() bool {
 	return v != nil && v.VersionHistoriesEncoding != nil
 }
 
+// GetTaskPriority returns the value of TaskPriority if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetTaskPriority() (o int32) {
+	if v != nil && v.TaskPriority != nil {
+		return *v.TaskPriority
+	}
+
+	return
+}
+
+// IsSetTaskPriority returns true if TaskPriority is not nil.
+func (v *WorkflowExecutionInfo) IsSetTaskPriority() bool {
+	return v != nil && v.TaskPriority != nil
+}
+
+// GetTaskFairnessKey returns the value of TaskFairnessKey if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetTaskFairnessKey() (o string) {
+	if v != nil && v.TaskFairnessKey != nil {
+		return *v.TaskFairnessKey
+	}
+
+	return
+}
+
+// IsSetTaskFairnessKey returns true if TaskFairnessKey is not nil.
+func (v *WorkflowExecutionInfo) IsSetTaskFairnessKey() bool {
+	return v != nil && v.TaskFairnessKey != nil
+}
+
+// GetIsolationGroup returns the value of IsolationGroup if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetIsolationGroup() (o string) {
+	if v != nil && v.IsolationGroup != nil {
+		return *v.IsolationGroup
+	}
+
+	return
+}
+
+// IsSetIsolationGroup returns true if IsolationGroup is not nil.
+func (v *WorkflowExecutionInfo) IsSetIsolationGroup() bool {
+	return v != nil && v.IsolationGroup != nil
+}
+
+// GetRetryBackoffScheduleSeconds returns the value of RetryBackoffScheduleSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetryBackoffScheduleSeconds() (o []int32) {
+	if v != nil && v.RetryBackoffScheduleSeconds != nil {
+		return v.RetryBackoffScheduleSeconds
+	}
+
+	return
+}
+
+// IsSetRetryBackoffScheduleSeconds returns true if RetryBackoffScheduleSeconds is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetryBackoffScheduleSeconds() bool {
+	return v != nil && v.RetryBackoffScheduleSeconds != nil
+}
+
+// GetRetryJitterMode returns the value of RetryJitterMode if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetryJitterMode() (o int32) {
+	if v != nil && v.RetryJitterMode != nil {
+		return *v.RetryJitterMode
+	}
+
+	return
+}
+
+// IsSetRetryJitterMode returns true if RetryJitterMode is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetryJitterMode() bool {
+	return v != nil && v.RetryJitterMode != nil
+}
+
+// GetRetryReasonPolicies returns the value of RetryReasonPolicies if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetryReasonPolicies() (o []byte) {
+	if v != nil && v.RetryReasonPolicies != nil {
+		return v.RetryReasonPolicies
+	}
+
+	return
+}
+
+// IsSetRetryReasonPolicies returns true if RetryReasonPolicies is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetryReasonPolicies() bool {
+	return v != nil && v.RetryReasonPolicies != nil
+}
+
+// GetRetryReasonPoliciesEncoding returns the value of RetryReasonPoliciesEncoding if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetryReasonPoliciesEncoding() (o string) {
+	if v != nil && v.RetryReasonPoliciesEncoding != nil {
+		return *v.RetryReasonPoliciesEncoding
+	}
+
+	return
+}
+
+// IsSetRetryReasonPoliciesEncoding returns true if RetryReasonPoliciesEncoding is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetryReasonPoliciesEncoding() bool {
+	return v != nil && v.RetryReasonPoliciesEncoding != nil
+}
+
+// GetRetryElapsedExpirationTimeNanos returns the value of RetryElapsedExpirationTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetRetryElapsedExpirationTimeNanos() (o int64) {
+	if v != nil && v.RetryElapsedExpirationTimeNanos != nil {
+		return *v.RetryElapsedExpirationTimeNanos
+	}
+
+	return
+}
+
+// IsSetRetryElapsedExpirationTimeNanos returns true if RetryElapsedExpirationTimeNanos is not nil.
+func (v *WorkflowExecutionInfo) IsSetRetryElapsedExpirationTimeNanos() bool {
+	return v != nil && v.RetryElapsedExpirationTimeNanos != nil
+}
+
+// GetNextRetryTimeNanos returns the value of NextRetryTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetNextRetryTimeNanos() (o int64) {
+	if v != nil && v.NextRetryTimeNanos != nil {
+		return *v.NextRetryTimeNanos
+	}
+
+	return
+}
+
+// IsSetNextRetryTimeNanos returns true if NextRetryTimeNanos is not nil.
+func (v *WorkflowExecutionInfo) IsSetNextRetryTimeNanos() bool {
+	return v != nil && v.NextRetryTimeNanos != nil
+}
+
+// GetDedupRequestIDTimeNanos returns the value of DedupRequestIDTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetDedupRequestIDTimeNanos() (o map[string]int64) {
+	if v != nil && v.DedupRequestIDTimeNanos != nil {
+		return v.DedupRequestIDTimeNanos
+	}
+
+	return
+}
+
+// IsSetDedupRequestIDTimeNanos returns true if DedupRequestIDTimeNanos is not nil.
+func (v *WorkflowExecutionInfo) IsSetDedupRequestIDTimeNanos() bool {
+	return v != nil && v.DedupRequestIDTimeNanos != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "cc9d9ebd900b26264bd1f303605fdd1d3eabb6a4",
+	SHA1:     "bc04b849f90c7154b7e70bdb71a3832cbd556f27",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  30: optional string domainName\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional list<string> drainedIsolationGroups\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional i32 taskPriority\n  128: optional string taskFairnessKey\n  130: optional string isolationGroup\n  132: optional list<i32> retryBackoffScheduleSeconds\n  134: optional i32 retryJitterMode\n  136: optional binary retryReasonPolicies\n  138: optional string retryReasonPoliciesEncoding\n  140: optional i64 (js.type = \"Long\") retryElapsedExpirationTimeNanos\n  142: optional i64 (js.type = \"Long\") nextRetryTimeNanos\n  144: optional map<string, i64> dedupRequestIDTimeNanos\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n  72: optional i32 priority\n  74: optional string fairnessKey\n  76: optional list<i32> retryBackoffScheduleSeconds\n  78: optional i32 retryJitterMode\n  80: optional binary retryReasonPolicies\n  82: optional string retryReasonPoliciesEncoding\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  30: optional string domainName\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  16: optional i32 priority\n  17: optional string fairnessKey\n  18: optional string isolationGroup\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional list<shared.WorkerVersionSet> versionSets\n  20: optional bool paused\n  22: optional string migrateTo\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}"
@@ -47,6 +47,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type RetryJitterMode int32
+
+const (
+	RetryJitterMode_RETRY_JITTER_MODE_INVALID RetryJitterMode = 0
+	RetryJitterMode_RETRY_JITTER_MODE_NONE    RetryJitterMode = 1
+	RetryJitterMode_RETRY_JITTER_MODE_FULL    RetryJitterMode = 2
+	RetryJitterMode_RETRY_JITTER_MODE_EQUAL   RetryJitterMode = 3
+)
+
+var RetryJitterMode_name = map[int32]string{
+	0: "RETRY_JITTER_MODE_INVALID",
+	1: "RETRY_JITTER_MODE_NONE",
+	2: "RETRY_JITTER_MODE_FULL",
+	3: "RETRY_JITTER_MODE_EQUAL",
+}
+
+var RetryJitterMode_value = map[string]int32{
+	"RETRY_JITTER_MODE_INVALID": 0,
+	"RETRY_JITTER_MODE_NONE":    1,
+	"RETRY_JITTER_MODE_FULL":    2,
+	"RETRY_JITTER_MODE_EQUAL":   3,
+}
+
+func (x RetryJitterMode) String() string {
+	return proto.EnumName(RetryJitterMode_name, int32(x))
+}
+
+func (RetryJitterMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0ff151d4a308b356, []int{0}
+}
+
 type EncodingType int32
 
 const (
@@ -75,7 +106,7 @@ func (x EncodingType) String() string {
 }
 
 func (EncodingType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0ff151d4a308b356, []int{0}
+	return fileDescriptor_0ff151d4a308b356, []int{1}
 }
 
 type WorkflowExecution struct {
@@ -653,10 +684,19 @@ type RetryPolicy struct {
 	// Non-Retryable errors. Will stop retrying if error type matches this list.
 	NonRetryableErrorReasons []string `protobuf:"bytes,5,rep,name=non_retryable_error_reasons,json=nonRetryableErrorReasons,proto3" json:"non_retryable_error_reasons,omitempty"`
 	// Expiration time for the whole retry process.
-	ExpirationInterval   *types.Duration `protobuf:"bytes,6,opt,name=expiration_interval,json=expirationInterval,proto3" json:"expiration_interval,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	ExpirationInterval *types.Duration `protobuf:"bytes,6,opt,name=expiration_interval,json=expirationInterval,proto3" json:"expiration_interval,omitempty"`
+	// Explicit intervals between retries, replaces the exponential backoff.
+	// The last interval is used for the remaining retries.
+	BackoffSchedule []*types.Duration `protobuf:"bytes,7,rep,name=backoff_schedule,json=backoffSchedule,proto3" json:"backoff_schedule,omitempty"`
+	// Randomization of the retry intervals.
+	JitterMode RetryJitterMode `protobuf:"varint,8,opt,name=jitter_mode,json=jitterMode,proto3,enum=uber.cadence.api.v1.RetryJitterMode" json:"jitter_mode,omitempty"`
+	// Policies overriding the backoff fields they set for failure reasons matching their pattern, the first match is used.
+	ReasonPolicies []*RetryReasonPolicy `protobuf:"bytes,9,rep,name=reason_policies,json=reasonPolicies,proto3" json:"reason_policies,omitempty"`
+	// Maximum time of all retries of a workflow, including the runs continued as new.
+	MaximumTotalElapsedTime *types.Duration `protobuf:"bytes,10,opt,name=maximum_total_elapsed_time,json=maximumTotalElapsedTime,proto3" json:"maximum_total_elapsed_time,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}        `json:"-"`
+	XXX_unrecognized        []byte          `json:"-"`
+	XXX_sizecache           int32           `json:"-"`
 }
 
 func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
@@ -734,7 +774,92 @@ func (m *RetryPolicy) GetExpirationInterval() *types.Duration {
 	return nil
 }
 
+func (m *RetryPolicy) GetBackoffSchedule() []*types.Duration {
+	if m != nil {
+		return m.BackoffSchedule
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetJitterMode() RetryJitterMode {
+	if m != nil {
+		return m.JitterMode
+	}
+	return RetryJitterMode_RETRY_JITTER_MODE_INVALID
+}
+
+func (m *RetryPolicy) GetReasonPolicies() []*RetryReasonPolicy {
+	if m != nil {
+		return m.ReasonPolicies
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetMaximumTotalElapsedTime() *types.Duration {
+	if m != nil {
+		return m.MaximumTotalElapsedTime
+	}
+	return nil
+}
+
+type RetryReasonPolicy struct {
+	// Regular expression matched against the failure reason.
+	ReasonPattern        string       `protobuf:"bytes,1,opt,name=reason_pattern,json=reasonPattern,proto3" json:"reason_pattern,omitempty"`
+	RetryPolicy          *RetryPolicy `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RetryReasonPolicy) Reset()         { *m = RetryReasonPolicy{} }
+func (m *RetryReasonPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryReasonPolicy) ProtoMessage()    {}
+func (*RetryReasonPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff151d4a308b356, []int{12}
+}
+func (m *RetryReasonPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryReasonPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryReasonPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryReasonPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryReasonPolicy.Merge(m, src)
+}
+func (m *RetryReasonPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryReasonPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryReasonPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryReasonPolicy proto.InternalMessageInfo
+
+func (m *RetryReasonPolicy) GetReasonPattern() string {
+	if m != nil {
+		return m.ReasonPattern
+	}
+	return ""
+}
+
+func (m *RetryReasonPolicy) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("uber.cadence.api.v1.RetryJitterMode", RetryJitterMode_name, RetryJitterMode_value)
 	proto.RegisterEnum("uber.cadence.api.v1.EncodingType", EncodingType_name, EncodingType_value)
 	proto.RegisterType((*WorkflowExecution)(nil), "uber.cadence.api.v1.WorkflowExecution")
 	proto.RegisterType((*WorkflowType)(nil), "uber.cadence.api.v1.WorkflowType")
@@ -751,64 +876,79 @@ func init() {
 	proto.RegisterType((*WorkerVersionInfo)(nil), "uber.cadence.api.v1.WorkerVersionInfo")
 	proto.RegisterType((*SupportedClientVersions)(nil), "uber.cadence.api.v1.SupportedClientVersions")
 	proto.RegisterType((*RetryPolicy)(nil), "uber.cadence.api.v1.RetryPolicy")
+	proto.RegisterType((*RetryReasonPolicy)(nil), "uber.cadence.api.v1.RetryReasonPolicy")
 }
 
 func init() { proto.RegisterFile("uber/cadence/api/v1/common.proto", fileDescriptor_0ff151d4a308b356) }
 
 var fileDescriptor_0ff151d4a308b356 = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xcd, 0x26, 0xed, 0xbe, 0x64, 0xbb, 0x61, 0xca, 0x76, 0xd3, 0x02, 0x25, 0x44, 0x42,
-	0x5b, 0x38, 0xd8, 0x6a, 0xf6, 0xb2, 0x62, 0xb5, 0x42, 0x69, 0x93, 0xb2, 0xde, 0x85, 0x36, 0x72,
-	0xa2, 0xad, 0xe0, 0x80, 0x35, 0xb6, 0x9f, 0xb3, 0x43, 0xec, 0x19, 0x6b, 0x3c, 0x4e, 0x9b, 0x1b,
-	0x9f, 0x84, 0x03, 0x9f, 0x86, 0x23, 0x12, 0x5f, 0x00, 0xf5, 0x93, 0x20, 0xdb, 0x93, 0x26, 0x59,
-	0x82, 0xf6, 0x82, 0xb8, 0xcd, 0xbc, 0xdf, 0x9f, 0xf9, 0x3d, 0xeb, 0xcd, 0x18, 0xda, 0x99, 0x87,
-	0xd2, 0xf2, 0x69, 0x80, 0xdc, 0x47, 0x8b, 0x26, 0xcc, 0x9a, 0x9d, 0x58, 0xbe, 0x88, 0x63, 0xc1,
-	0xcd, 0x44, 0x0a, 0x25, 0xc8, 0x5e, 0xce, 0x30, 0x35, 0xc3, 0xa4, 0x09, 0x33, 0x67, 0x27, 0x87,
-	0x47, 0x13, 0x21, 0x26, 0x11, 0x5a, 0x05, 0xc5, 0xcb, 0x42, 0x2b, 0xc8, 0x24, 0x55, 0x6c, 0x21,
-	0xea, 0xbc, 0x86, 0x0f, 0xaf, 0x84, 0x9c, 0x86, 0x91, 0xb8, 0x1e, 0xdc, 0xa0, 0x9f, 0xe5, 0x10,
-	0xf9, 0x0c, 0xea, 0xd7, 0xba, 0xe8, 0xb2, 0xa0, 0x65, 0xb4, 0x8d, 0xe3, 0xfb, 0x0e, 0x2c, 0x4a,
-	0x76, 0x40, 0x1e, 0x41, 0x4d, 0x66, 0x3c, 0xc7, 0xb6, 0x0a, 0xac, 0x2a, 0x33, 0x6e, 0x07, 0x9d,
-	0x0e, 0x34, 0x16, 0x66, 0xe3, 0x79, 0x82, 0x84, 0xc0, 0x3d, 0x4e, 0x63, 0xd4, 0x06, 0xc5, 0x3a,
-	0xe7, 0xf4, 0x7c, 0xc5, 0x66, 0x4c, 0xcd, 0xff, 0x95, 0xf3, 0x29, 0x6c, 0x0f, 0xe9, 0x3c, 0x12,
-	0x34, 0xc8, 0xe1, 0x80, 0x2a, 0x5a, 0xc0, 0x0d, 0xa7, 0x58, 0x77, 0x9e, 0xc3, 0xf6, 0x39, 0x65,
-	0x51, 0x26, 0x91, 0xec, 0x43, 0x4d, 0x22, 0x4d, 0x05, 0xd7, 0x7a, 0xbd, 0x23, 0x2d, 0xd8, 0x0e,
-	0x50, 0x51, 0x16, 0xa5, 0x45, 0xc2, 0x86, 0xb3, 0xd8, 0x76, 0x7e, 0x35, 0xe0, 0xde, 0xf7, 0x18,
-	0x0b, 0xf2, 0x02, 0x6a, 0x21, 0xc3, 0x28, 0x48, 0x5b, 0x46, 0xbb, 0x72, 0x5c, 0xef, 0x7e, 0x61,
-	0x6e, 0xf8, 0x7e, 0x66, 0x4e, 0x35, 0xcf, 0x0b, 0xde, 0x80, 0x2b, 0x39, 0x77, 0xb4, 0xe8, 0xf0,
-	0x0a, 0xea, 0x2b, 0x65, 0xd2, 0x84, 0xca, 0x14, 0xe7, 0x3a, 0x45, 0xbe, 0x24, 0x5d, 0xa8, 0xce,
-	0x68, 0x94, 0x61, 0x11, 0xa0, 0xde, 0xfd, 0x64, 0xa3, 0xbd, 0x6e, 0xd3, 0x29, 0xa9, 0x5f, 0x6f,
-	0x3d, 0x33, 0x3a, 0xbf, 0x19, 0x50, 0x7b, 0x89, 0x34, 0x40, 0x49, 0xbe, 0x79, 0x27, 0xe2, 0x93,
-	0x8d, 0x1e, 0x25, 0xf9, 0xff, 0x0d, 0xf9, 0xa7, 0x01, 0xcd, 0x11, 0x52, 0xe9, 0xbf, 0xed, 0x29,
-	0x25, 0x99, 0x97, 0x29, 0x4c, 0x89, 0x0b, 0xbb, 0x8c, 0x07, 0x78, 0x83, 0x81, 0xbb, 0x16, 0xfb,
-	0xd9, 0x46, 0xd7, 0x77, 0xe5, 0xa6, 0x5d, 0x6a, 0x57, 0xfb, 0x78, 0xc0, 0x56, 0x6b, 0x87, 0x3f,
-	0x01, 0xf9, 0x27, 0xe9, 0x3f, 0xec, 0x2a, 0x84, 0x9d, 0x3e, 0x55, 0xf4, 0x34, 0x12, 0x1e, 0x39,
-	0x87, 0x07, 0xc8, 0x7d, 0x11, 0x30, 0x3e, 0x71, 0xd5, 0x3c, 0x29, 0x07, 0x74, 0xb7, 0xfb, 0xf9,
-	0x46, 0xaf, 0x81, 0x66, 0xe6, 0x13, 0xed, 0x34, 0x70, 0x65, 0x77, 0x37, 0xc0, 0x5b, 0x2b, 0x03,
-	0x3c, 0x2c, 0x2f, 0x1d, 0xca, 0x37, 0x28, 0x53, 0x26, 0xb8, 0xcd, 0x43, 0x91, 0x13, 0x59, 0x9c,
-	0x44, 0x8b, 0x8b, 0x90, 0xaf, 0xc9, 0x13, 0x78, 0x18, 0x22, 0x55, 0x99, 0x44, 0x77, 0x56, 0x52,
-	0xf5, 0x85, 0xdb, 0xd5, 0x65, 0x6d, 0xd0, 0x79, 0x0d, 0x8f, 0x47, 0x59, 0x92, 0x08, 0xa9, 0x30,
-	0x38, 0x8b, 0x18, 0x72, 0xa5, 0x91, 0x34, 0xbf, 0xab, 0x13, 0xe1, 0xa6, 0xc1, 0x54, 0x3b, 0x57,
-	0x27, 0x62, 0x14, 0x4c, 0xc9, 0x01, 0xec, 0xfc, 0x4c, 0x67, 0xb4, 0x00, 0x4a, 0xcf, 0xed, 0x7c,
-	0x3f, 0x0a, 0xa6, 0x9d, 0x5f, 0x2a, 0x50, 0x77, 0x50, 0xc9, 0xf9, 0x50, 0x44, 0xcc, 0x9f, 0x93,
-	0x3e, 0x34, 0x19, 0x67, 0x8a, 0xd1, 0xc8, 0x65, 0x5c, 0xa1, 0x9c, 0xd1, 0x32, 0x65, 0xbd, 0x7b,
-	0x60, 0x96, 0xcf, 0x8b, 0xb9, 0x78, 0x5e, 0xcc, 0xbe, 0x7e, 0x5e, 0x9c, 0x87, 0x5a, 0x62, 0x6b,
-	0x05, 0xb1, 0x60, 0xcf, 0xa3, 0xfe, 0x54, 0x84, 0xa1, 0xeb, 0x0b, 0x0c, 0x43, 0xe6, 0xe7, 0x31,
-	0x8b, 0xb3, 0x0d, 0x87, 0x68, 0xe8, 0x6c, 0x89, 0xe4, 0xc7, 0xc6, 0xf4, 0x86, 0xc5, 0x59, 0xbc,
-	0x3c, 0xb6, 0xf2, 0xde, 0x63, 0xb5, 0xe4, 0xee, 0xd8, 0x2f, 0x97, 0x2e, 0x54, 0x29, 0x8c, 0x13,
-	0x95, 0xb6, 0xee, 0xb5, 0x8d, 0xe3, 0xea, 0x1d, 0xb5, 0xa7, 0xcb, 0xe4, 0x05, 0x7c, 0xcc, 0x05,
-	0x77, 0x65, 0xde, 0x3a, 0xf5, 0x22, 0x74, 0x51, 0x4a, 0x21, 0xdd, 0xf2, 0x49, 0x49, 0x5b, 0xd5,
-	0x76, 0xe5, 0xf8, 0xbe, 0xd3, 0xe2, 0x82, 0x3b, 0x0b, 0xc6, 0x20, 0x27, 0x38, 0x25, 0x4e, 0x5e,
-	0xc1, 0x1e, 0xde, 0x24, 0xac, 0x0c, 0xb2, 0x8c, 0x5c, 0x7b, 0x5f, 0x64, 0xb2, 0x54, 0x2d, 0x52,
-	0x7f, 0x75, 0x0d, 0x8d, 0xd5, 0x99, 0x22, 0x07, 0xf0, 0x68, 0x70, 0x71, 0x76, 0xd9, 0xb7, 0x2f,
-	0xbe, 0x75, 0xc7, 0x3f, 0x0c, 0x07, 0xae, 0x7d, 0xf1, 0xa6, 0xf7, 0x9d, 0xdd, 0x6f, 0x7e, 0x40,
-	0x0e, 0x61, 0x7f, 0x1d, 0x1a, 0xbf, 0x74, 0xec, 0xf3, 0xb1, 0x73, 0xd5, 0x34, 0xc8, 0x3e, 0x90,
-	0x75, 0xec, 0xd5, 0xe8, 0xf2, 0xa2, 0xb9, 0x45, 0x5a, 0xf0, 0xd1, 0x7a, 0x7d, 0xe8, 0x5c, 0x8e,
-	0x2f, 0x9f, 0x36, 0x2b, 0xa7, 0xde, 0xef, 0xb7, 0x47, 0xc6, 0x1f, 0xb7, 0x47, 0xc6, 0x5f, 0xb7,
-	0x47, 0x06, 0x3c, 0xf6, 0x45, 0xbc, 0x69, 0xe0, 0x4f, 0x77, 0x7a, 0x09, 0x1b, 0xe6, 0x9d, 0x0c,
-	0x8d, 0x1f, 0xad, 0x09, 0x53, 0x6f, 0x33, 0xcf, 0xf4, 0x45, 0x6c, 0xad, 0xfd, 0xa4, 0xcc, 0x09,
-	0xf2, 0xf2, 0xc7, 0xa3, 0xff, 0x57, 0xcf, 0x69, 0xc2, 0x66, 0x27, 0x5e, 0xad, 0xa8, 0x3d, 0xfd,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x8a, 0xf4, 0x9b, 0xd3, 0x06, 0x00, 0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xd1, 0x52, 0xdb, 0x46,
+	0x17, 0xfe, 0x85, 0x63, 0x43, 0x8e, 0x1d, 0x70, 0x36, 0x7f, 0xc0, 0x90, 0x86, 0xba, 0x9e, 0xa6,
+	0xa1, 0xb9, 0x90, 0x07, 0x72, 0x93, 0x69, 0x26, 0xd3, 0x31, 0x58, 0x34, 0x26, 0x60, 0xbb, 0xb2,
+	0x03, 0x93, 0x5e, 0x54, 0xb3, 0x96, 0x8e, 0xcd, 0x62, 0x49, 0xab, 0x59, 0xad, 0x0c, 0xbe, 0xea,
+	0x55, 0x5f, 0xa3, 0x17, 0x7d, 0x88, 0x3e, 0x43, 0x2f, 0x3b, 0xd3, 0x17, 0xe8, 0xf0, 0x24, 0x1d,
+	0x49, 0x6b, 0x6c, 0xc0, 0x94, 0x9b, 0x4e, 0xef, 0x76, 0xcf, 0xf7, 0x7d, 0x67, 0xbf, 0x73, 0x7c,
+	0x76, 0x2d, 0x28, 0x47, 0x3d, 0x14, 0x55, 0x9b, 0x3a, 0xe8, 0xdb, 0x58, 0xa5, 0x01, 0xab, 0x8e,
+	0xb6, 0xab, 0x36, 0xf7, 0x3c, 0xee, 0xeb, 0x81, 0xe0, 0x92, 0x93, 0x27, 0x31, 0x43, 0x57, 0x0c,
+	0x9d, 0x06, 0x4c, 0x1f, 0x6d, 0x6f, 0x6c, 0x0e, 0x38, 0x1f, 0xb8, 0x58, 0x4d, 0x28, 0xbd, 0xa8,
+	0x5f, 0x75, 0x22, 0x41, 0x25, 0x9b, 0x88, 0x2a, 0x1f, 0xe0, 0xf1, 0x09, 0x17, 0xc3, 0xbe, 0xcb,
+	0xcf, 0x8d, 0x0b, 0xb4, 0xa3, 0x18, 0x22, 0x9f, 0x43, 0xfe, 0x5c, 0x05, 0x2d, 0xe6, 0x94, 0xb4,
+	0xb2, 0xb6, 0xf5, 0xd0, 0x84, 0x49, 0xa8, 0xe1, 0x90, 0xa7, 0x90, 0x13, 0x91, 0x1f, 0x63, 0x0b,
+	0x09, 0x96, 0x15, 0x91, 0xdf, 0x70, 0x2a, 0x15, 0x28, 0x4c, 0x92, 0x75, 0xc7, 0x01, 0x12, 0x02,
+	0x0f, 0x7c, 0xea, 0xa1, 0x4a, 0x90, 0xac, 0x63, 0x4e, 0xcd, 0x96, 0x6c, 0xc4, 0xe4, 0xf8, 0x4e,
+	0xce, 0x73, 0x58, 0x6c, 0xd3, 0xb1, 0xcb, 0xa9, 0x13, 0xc3, 0x0e, 0x95, 0x34, 0x81, 0x0b, 0x66,
+	0xb2, 0xae, 0xbc, 0x85, 0xc5, 0x7d, 0xca, 0xdc, 0x48, 0x20, 0x59, 0x85, 0x9c, 0x40, 0x1a, 0x72,
+	0x5f, 0xe9, 0xd5, 0x8e, 0x94, 0x60, 0xd1, 0x41, 0x49, 0x99, 0x1b, 0x26, 0x0e, 0x0b, 0xe6, 0x64,
+	0x5b, 0xf9, 0x45, 0x83, 0x07, 0x47, 0xe8, 0x71, 0xf2, 0x0e, 0x72, 0x7d, 0x86, 0xae, 0x13, 0x96,
+	0xb4, 0x72, 0x66, 0x2b, 0xbf, 0xf3, 0x42, 0x9f, 0xd3, 0x3f, 0x3d, 0xa6, 0xea, 0xfb, 0x09, 0xcf,
+	0xf0, 0xa5, 0x18, 0x9b, 0x4a, 0xb4, 0x71, 0x02, 0xf9, 0x99, 0x30, 0x29, 0x42, 0x66, 0x88, 0x63,
+	0xe5, 0x22, 0x5e, 0x92, 0x1d, 0xc8, 0x8e, 0xa8, 0x1b, 0x61, 0x62, 0x20, 0xbf, 0xf3, 0xd9, 0xdc,
+	0xf4, 0xaa, 0x4c, 0x33, 0xa5, 0x7e, 0xb3, 0xf0, 0x46, 0xab, 0xfc, 0xaa, 0x41, 0xee, 0x3d, 0x52,
+	0x07, 0x05, 0xf9, 0xf6, 0x86, 0xc5, 0x97, 0x73, 0x73, 0xa4, 0xe4, 0xff, 0xd6, 0xe4, 0x9f, 0x1a,
+	0x14, 0x3b, 0x48, 0x85, 0x7d, 0x5a, 0x93, 0x52, 0xb0, 0x5e, 0x24, 0x31, 0x24, 0x16, 0x2c, 0x33,
+	0xdf, 0xc1, 0x0b, 0x74, 0xac, 0x6b, 0xb6, 0xdf, 0xcc, 0xcd, 0x7a, 0x53, 0xae, 0x37, 0x52, 0xed,
+	0x6c, 0x1d, 0x8f, 0xd8, 0x6c, 0x6c, 0xe3, 0x47, 0x20, 0xb7, 0x49, 0xff, 0x62, 0x55, 0x7d, 0x58,
+	0xaa, 0x53, 0x49, 0x77, 0x5d, 0xde, 0x23, 0xfb, 0xf0, 0x08, 0x7d, 0x9b, 0x3b, 0xcc, 0x1f, 0x58,
+	0x72, 0x1c, 0xa4, 0x03, 0xba, 0xbc, 0xf3, 0xc5, 0xdc, 0x5c, 0x86, 0x62, 0xc6, 0x13, 0x6d, 0x16,
+	0x70, 0x66, 0x77, 0x35, 0xc0, 0x0b, 0x33, 0x03, 0xdc, 0x4e, 0x2f, 0x1d, 0x8a, 0x63, 0x14, 0x21,
+	0xe3, 0x7e, 0xc3, 0xef, 0xf3, 0x98, 0xc8, 0xbc, 0xc0, 0x9d, 0x5c, 0x84, 0x78, 0x4d, 0x5e, 0xc2,
+	0x4a, 0x1f, 0xa9, 0x8c, 0x04, 0x5a, 0xa3, 0x94, 0xaa, 0x2e, 0xdc, 0xb2, 0x0a, 0xab, 0x04, 0x95,
+	0x0f, 0xb0, 0xd6, 0x89, 0x82, 0x80, 0x0b, 0x89, 0xce, 0x9e, 0xcb, 0xd0, 0x97, 0x0a, 0x09, 0xe3,
+	0xbb, 0x3a, 0xe0, 0x56, 0xe8, 0x0c, 0x55, 0xe6, 0xec, 0x80, 0x77, 0x9c, 0x21, 0x59, 0x87, 0xa5,
+	0x33, 0x3a, 0xa2, 0x09, 0x90, 0xe6, 0x5c, 0x8c, 0xf7, 0x1d, 0x67, 0x58, 0xf9, 0x2d, 0x0b, 0x79,
+	0x13, 0xa5, 0x18, 0xb7, 0xb9, 0xcb, 0xec, 0x31, 0xa9, 0x43, 0x91, 0xf9, 0x4c, 0x32, 0xea, 0x5a,
+	0xcc, 0x97, 0x28, 0x46, 0x34, 0x75, 0x99, 0xdf, 0x59, 0xd7, 0xd3, 0xe7, 0x45, 0x9f, 0x3c, 0x2f,
+	0x7a, 0x5d, 0x3d, 0x2f, 0xe6, 0x8a, 0x92, 0x34, 0x94, 0x82, 0x54, 0xe1, 0x49, 0x8f, 0xda, 0x43,
+	0xde, 0xef, 0x5b, 0x36, 0xc7, 0x7e, 0x9f, 0xd9, 0xb1, 0xcd, 0xe4, 0x6c, 0xcd, 0x24, 0x0a, 0xda,
+	0x9b, 0x22, 0xf1, 0xb1, 0x1e, 0xbd, 0x60, 0x5e, 0xe4, 0x4d, 0x8f, 0xcd, 0xdc, 0x7b, 0xac, 0x92,
+	0x5c, 0x1d, 0xfb, 0xf5, 0x34, 0x0b, 0x95, 0x12, 0xbd, 0x40, 0x86, 0xa5, 0x07, 0x65, 0x6d, 0x2b,
+	0x7b, 0x45, 0xad, 0xa9, 0x30, 0x79, 0x07, 0xcf, 0x7c, 0xee, 0x5b, 0x22, 0x2e, 0x9d, 0xf6, 0x5c,
+	0xb4, 0x50, 0x08, 0x2e, 0xac, 0xf4, 0x49, 0x09, 0x4b, 0xd9, 0x72, 0x66, 0xeb, 0xa1, 0x59, 0xf2,
+	0xb9, 0x6f, 0x4e, 0x18, 0x46, 0x4c, 0x30, 0x53, 0x9c, 0x1c, 0xc0, 0x13, 0xbc, 0x08, 0x58, 0x6a,
+	0x64, 0x6a, 0x39, 0x77, 0x9f, 0x65, 0x32, 0x55, 0x5d, 0xb9, 0xae, 0x43, 0x71, 0xd2, 0xac, 0xd0,
+	0x3e, 0x45, 0x27, 0x72, 0xb1, 0xb4, 0x58, 0xce, 0xfc, 0x73, 0xa2, 0x15, 0x25, 0xe9, 0x28, 0x05,
+	0x31, 0x20, 0x7f, 0xc6, 0xa4, 0x44, 0x61, 0x79, 0xdc, 0xc1, 0xd2, 0x52, 0x32, 0xc1, 0x5f, 0xce,
+	0x9d, 0xe0, 0xa4, 0xa4, 0x83, 0x84, 0x7c, 0xc4, 0x1d, 0x34, 0xe1, 0xec, 0x6a, 0x4d, 0x5a, 0xb0,
+	0x92, 0xf6, 0xc0, 0x0a, 0xe2, 0x81, 0x60, 0x18, 0x96, 0x1e, 0x26, 0x5e, 0xbe, 0xba, 0x3b, 0x55,
+	0xda, 0x94, 0x74, 0x80, 0xcc, 0x65, 0x31, 0xdd, 0x31, 0x0c, 0xc9, 0x31, 0x6c, 0x4c, 0x7e, 0x13,
+	0xc9, 0x25, 0x75, 0x2d, 0x74, 0x69, 0x10, 0xa2, 0x63, 0x49, 0xe6, 0x61, 0x09, 0xee, 0x6b, 0xd8,
+	0x9a, 0x12, 0x77, 0x63, 0xad, 0x91, 0x4a, 0xbb, 0xcc, 0xc3, 0xca, 0x4f, 0xf0, 0xf8, 0xd6, 0xe1,
+	0xe4, 0x05, 0x2c, 0x4f, 0xdc, 0xc7, 0x03, 0x20, 0x26, 0x7f, 0x15, 0x8f, 0x94, 0xa9, 0x34, 0x48,
+	0xf6, 0xa0, 0x90, 0xfc, 0xf0, 0x69, 0x8d, 0x63, 0xf5, 0x74, 0x94, 0xef, 0xae, 0x50, 0xd5, 0x96,
+	0x17, 0xd3, 0xcd, 0xab, 0x9f, 0x35, 0x58, 0xb9, 0xd1, 0x49, 0xf2, 0x1c, 0xd6, 0x4d, 0xa3, 0x6b,
+	0x7e, 0xb2, 0x0e, 0x1a, 0xdd, 0xae, 0x61, 0x5a, 0x47, 0xad, 0xba, 0x61, 0x35, 0x9a, 0xc7, 0xb5,
+	0xc3, 0x46, 0xbd, 0xf8, 0x3f, 0xb2, 0x01, 0xab, 0xb7, 0xe1, 0x66, 0xab, 0x69, 0x14, 0xb5, 0xf9,
+	0xd8, 0xfe, 0xc7, 0xc3, 0xc3, 0xe2, 0x02, 0x79, 0x06, 0x6b, 0xb7, 0x31, 0xe3, 0xfb, 0x8f, 0xb5,
+	0xc3, 0x62, 0xe6, 0xd5, 0x39, 0x14, 0x66, 0x9f, 0x24, 0xb2, 0x0e, 0x4f, 0x8d, 0xe6, 0x5e, 0xab,
+	0xde, 0x68, 0x7e, 0x67, 0x75, 0x3f, 0xb5, 0x6f, 0x9c, 0x7f, 0x1d, 0xea, 0xbe, 0x37, 0x1b, 0xfb,
+	0x5d, 0xf3, 0xa4, 0xa8, 0x91, 0x55, 0x20, 0xd7, 0xb1, 0x83, 0x4e, 0xab, 0x59, 0x5c, 0x20, 0x25,
+	0xf8, 0xff, 0xf5, 0x78, 0xdb, 0x6c, 0x75, 0x5b, 0xaf, 0x8b, 0x99, 0xdd, 0xde, 0xef, 0x97, 0x9b,
+	0xda, 0x1f, 0x97, 0x9b, 0xda, 0x5f, 0x97, 0x9b, 0x1a, 0xac, 0xd9, 0xdc, 0x9b, 0xd7, 0xc0, 0xdd,
+	0xa5, 0x5a, 0xc0, 0xda, 0xf1, 0xef, 0xda, 0xd6, 0x7e, 0xa8, 0x0e, 0x98, 0x3c, 0x8d, 0x7a, 0xba,
+	0xcd, 0xbd, 0xea, 0xb5, 0x6f, 0x1c, 0x7d, 0x80, 0x7e, 0xfa, 0xdd, 0xa2, 0x3e, 0x77, 0xde, 0xd2,
+	0x80, 0x8d, 0xb6, 0x7b, 0xb9, 0x24, 0xf6, 0xfa, 0xef, 0x01, 0x00, 0x49, 0xdd, 0x03, 0x81, 0x12,
+	0x09, 0x00, 0x00,
 }
 
 func (m *WorkflowExecution) Marshal() (dAtA []byte, err error) {
@@ -1299,6 +1439,51 @@ func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MaximumTotalElapsedTime != nil {
+		{
+			size, err := m.MaximumTotalElapsedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ReasonPolicies) > 0 {
+		for iNdEx := len(m.ReasonPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReasonPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.JitterMode != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.JitterMode))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BackoffSchedule) > 0 {
+		for iNdEx := len(m.BackoffSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackoffSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.ExpirationInterval != nil {
 		{
 			size, err := m.ExpirationInterval.MarshalToSizedBuffer(dAtA[:i])
@@ -1358,6 +1543,52 @@ func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryReasonPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryReasonPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryReasonPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReasonPattern) > 0 {
+		i -= len(m.ReasonPattern)
+		copy(dAtA[i:], m.ReasonPattern)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.ReasonPattern)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommon(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommon(v)
 	base := offset
@@ -1621,6 +1852,45 @@ func (m *RetryPolicy) Size() (n int) {
 		l = m.ExpirationInterval.Size()
 		n += 1 + l + sovCommon(uint64(l))
 	}
+	if len(m.BackoffSchedule) > 0 {
+		for _, e := range m.BackoffSchedule {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	if m.JitterMode != 0 {
+		n += 1 + sovCommon(uint64(m.JitterMode))
+	}
+	if len(m.ReasonPolicies) > 0 {
+		for _, e := range m.ReasonPolicies {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	if m.MaximumTotalElapsedTime != nil {
+		l = m.MaximumTotalElapsedTime.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetryReasonPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReasonPattern)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3222,6 +3492,251 @@ func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackoffSchedule = append(m.BackoffSchedule, &types.Duration{})
+			if err := m.BackoffSchedule[len(m.BackoffSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JitterMode", wireType)
+			}
+			m.JitterMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JitterMode |= RetryJitterMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonPolicies = append(m.ReasonPolicies, &RetryReasonPolicy{})
+			if err := m.ReasonPolicies[len(m.ReasonPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumTotalElapsedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaximumTotalElapsedTime == nil {
+				m.MaximumTotalElapsedTime = &types.Duration{}
+			}
+			if err := m.MaximumTotalElapsedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryReasonPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryReasonPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryReasonPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
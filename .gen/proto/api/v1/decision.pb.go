@@ -61,6 +61,7 @@ type Decision struct {
 	//	*Decision_StartChildWorkflowExecutionDecisionAttributes
 	//	*Decision_SignalExternalWorkflowExecutionDecisionAttributes
 	//	*Decision_UpsertWorkflowSearchAttributesDecisionAttributes
+	//	*Decision_UpsertWorkflowMemoDecisionAttributes
 	Attributes           isDecision_Attributes `protobuf_oneof:"attributes"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -145,6 +146,9 @@ type Decision_SignalExternalWorkflowExecutionDecisionAttributes struct {
 type Decision_UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	UpsertWorkflowSearchAttributesDecisionAttributes *UpsertWorkflowSearchAttributesDecisionAttributes `protobuf:"bytes,13,opt,name=upsert_workflow_search_attributes_decision_attributes,json=upsertWorkflowSearchAttributesDecisionAttributes,proto3,oneof" json:"upsert_workflow_search_attributes_decision_attributes,omitempty"`
 }
+type Decision_UpsertWorkflowMemoDecisionAttributes struct {
+	UpsertWorkflowMemoDecisionAttributes *UpsertWorkflowMemoDecisionAttributes `protobuf:"bytes,14,opt,name=upsert_workflow_memo_decision_attributes,json=upsertWorkflowMemoDecisionAttributes,proto3,oneof" json:"upsert_workflow_memo_decision_attributes,omitempty"`
+}
 
 func (*Decision_ScheduleActivityTaskDecisionAttributes) isDecision_Attributes()                   {}
 func (*Decision_StartTimerDecisionAttributes) isDecision_Attributes()                             {}
@@ -159,6 +163,7 @@ func (*Decision_ContinueAsNewWorkflowExecutionDecisionAttributes) isDecision_Att
 func (*Decision_StartChildWorkflowExecutionDecisionAttributes) isDecision_Attributes()            {}
 func (*Decision_SignalExternalWorkflowExecutionDecisionAttributes) isDecision_Attributes()        {}
 func (*Decision_UpsertWorkflowSearchAttributesDecisionAttributes) isDecision_Attributes()         {}
+func (*Decision_UpsertWorkflowMemoDecisionAttributes) isDecision_Attributes()                     {}
 
 func (m *Decision) GetAttributes() isDecision_Attributes {
 	if m != nil {
@@ -258,6 +263,13 @@ func (m *Decision) GetUpsertWorkflowSearchAttributesDecisionAttributes() *Upsert
 	return nil
 }
 
+func (m *Decision) GetUpsertWorkflowMemoDecisionAttributes() *UpsertWorkflowMemoDecisionAttributes {
+	if x, ok := m.GetAttributes().(*Decision_UpsertWorkflowMemoDecisionAttributes); ok {
+		return x.UpsertWorkflowMemoDecisionAttributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Decision) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -274,6 +286,7 @@ func (*Decision) XXX_OneofWrappers() []interface{} {
 		(*Decision_StartChildWorkflowExecutionDecisionAttributes)(nil),
 		(*Decision_SignalExternalWorkflowExecutionDecisionAttributes)(nil),
 		(*Decision_UpsertWorkflowSearchAttributesDecisionAttributes)(nil),
+		(*Decision_UpsertWorkflowMemoDecisionAttributes)(nil),
 	}
 }
 
@@ -290,6 +303,8 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy            *RetryPolicy    `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 	Header                 *Header         `protobuf:"bytes,12,opt,name=header,proto3" json:"header,omitempty"`
 	RequestLocalDispatch   bool            `protobuf:"varint,13,opt,name=request_local_dispatch,json=requestLocalDispatch,proto3" json:"request_local_dispatch,omitempty"`
+	Priority               int32           `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey            string          `protobuf:"bytes,15,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
 	XXX_unrecognized       []byte          `json:"-"`
 	XXX_sizecache          int32           `json:"-"`
@@ -414,6 +429,20 @@ func (m *ScheduleActivityTaskDecisionAttributes) GetRequestLocalDispatch() bool
 	return false
 }
 
+func (m *ScheduleActivityTaskDecisionAttributes) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ScheduleActivityTaskDecisionAttributes) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type StartTimerDecisionAttributes struct {
 	TimerId              string          `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
 	StartToFireTimeout   *types.Duration `protobuf:"bytes,2,opt,name=start_to_fire_timeout,json=startToFireTimeout,proto3" json:"start_to_fire_timeout,omitempty"`
@@ -1027,6 +1056,8 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	Header                       *Header               `protobuf:"bytes,13,opt,name=header,proto3" json:"header,omitempty"`
 	Memo                         *Memo                 `protobuf:"bytes,14,opt,name=memo,proto3" json:"memo,omitempty"`
 	SearchAttributes             *SearchAttributes     `protobuf:"bytes,15,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	Priority                     int32                 `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey                  string                `protobuf:"bytes,17,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{}              `json:"-"`
 	XXX_unrecognized             []byte                `json:"-"`
 	XXX_sizecache                int32                 `json:"-"`
@@ -1174,6 +1205,20 @@ func (m *StartChildWorkflowExecutionDecisionAttributes) GetSearchAttributes() *S
 	return nil
 }
 
+func (m *StartChildWorkflowExecutionDecisionAttributes) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *StartChildWorkflowExecutionDecisionAttributes) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -1316,6 +1361,53 @@ func (m *UpsertWorkflowSearchAttributesDecisionAttributes) GetSearchAttributes()
 	return nil
 }
 
+type UpsertWorkflowMemoDecisionAttributes struct {
+	Memo                 *Memo    `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertWorkflowMemoDecisionAttributes) Reset()         { *m = UpsertWorkflowMemoDecisionAttributes{} }
+func (m *UpsertWorkflowMemoDecisionAttributes) String() string { return proto.CompactTextString(m) }
+func (*UpsertWorkflowMemoDecisionAttributes) ProtoMessage()    {}
+func (*UpsertWorkflowMemoDecisionAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb529b236ea74dc2, []int{14}
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowMemoDecisionAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowMemoDecisionAttributes.Merge(m, src)
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowMemoDecisionAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowMemoDecisionAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowMemoDecisionAttributes proto.InternalMessageInfo
+
+func (m *UpsertWorkflowMemoDecisionAttributes) GetMemo() *Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Decision)(nil), "uber.cadence.api.v1.Decision")
 	proto.RegisterType((*ScheduleActivityTaskDecisionAttributes)(nil), "uber.cadence.api.v1.ScheduleActivityTaskDecisionAttributes")
@@ -1331,6 +1423,7 @@ func init() {
 	proto.RegisterType((*StartChildWorkflowExecutionDecisionAttributes)(nil), "uber.cadence.api.v1.StartChildWorkflowExecutionDecisionAttributes")
 	proto.RegisterType((*SignalExternalWorkflowExecutionDecisionAttributes)(nil), "uber.cadence.api.v1.SignalExternalWorkflowExecutionDecisionAttributes")
 	proto.RegisterType((*UpsertWorkflowSearchAttributesDecisionAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowSearchAttributesDecisionAttributes")
+	proto.RegisterType((*UpsertWorkflowMemoDecisionAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowMemoDecisionAttributes")
 }
 
 func init() {
@@ -1338,105 +1431,110 @@ func init() {
 }
 
 var fileDescriptor_fb529b236ea74dc2 = []byte{
-	// 1564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x4f, 0x1c, 0xc7,
-	0x16, 0x7d, 0xcd, 0xc7, 0x7c, 0x5c, 0xc0, 0xcf, 0x14, 0x36, 0x06, 0x1b, 0x03, 0x9e, 0xa7, 0x87,
-	0x9f, 0x8d, 0x98, 0x01, 0xec, 0x67, 0x59, 0xf6, 0x93, 0xf5, 0x00, 0x1b, 0x19, 0xc9, 0xc6, 0xa8,
-	0x8d, 0x63, 0x29, 0x9b, 0x56, 0x4d, 0x75, 0x01, 0x25, 0x7a, 0xba, 0x27, 0xd5, 0xd5, 0xe0, 0x89,
-	0x14, 0x29, 0xab, 0x24, 0x9b, 0xfc, 0x80, 0x48, 0x59, 0x65, 0x95, 0x6c, 0x92, 0x6d, 0xa2, 0xac,
-	0xb2, 0xcb, 0x32, 0x59, 0x64, 0x1f, 0xf9, 0x17, 0x44, 0xca, 0x1f, 0x88, 0xaa, 0xba, 0xba, 0x67,
-	0x18, 0x7a, 0x7a, 0xba, 0xb1, 0xe3, 0x1d, 0x5d, 0x7d, 0xef, 0xa9, 0x53, 0x75, 0xab, 0xee, 0x39,
-	0xcd, 0x40, 0x25, 0xa8, 0x53, 0x5e, 0x23, 0xd8, 0xa6, 0x2e, 0xa1, 0x35, 0xdc, 0x64, 0xb5, 0xa3,
-	0x95, 0x9a, 0x4d, 0x09, 0xf3, 0x99, 0xe7, 0x56, 0x9b, 0xdc, 0x13, 0x1e, 0x9a, 0x90, 0x31, 0x55,
-	0x1d, 0x53, 0xc5, 0x4d, 0x56, 0x3d, 0x5a, 0xb9, 0x3c, 0xbb, 0xef, 0x79, 0xfb, 0x0e, 0xad, 0xa9,
-	0x90, 0x7a, 0xb0, 0x57, 0xb3, 0x03, 0x8e, 0x45, 0x9c, 0x74, 0x79, 0x3e, 0x09, 0x98, 0x78, 0x8d,
-	0x46, 0x1c, 0x91, 0x38, 0xb5, 0xc0, 0xfe, 0xa1, 0xc3, 0x7c, 0x91, 0x16, 0x73, 0xec, 0xf1, 0xc3,
-	0x3d, 0xc7, 0x3b, 0x0e, 0x63, 0x2a, 0x9f, 0x8d, 0x43, 0xe9, 0xa1, 0x66, 0x8c, 0xbe, 0x30, 0xe0,
-	0xa6, 0x4f, 0x0e, 0xa8, 0x1d, 0x38, 0xd4, 0xc2, 0x44, 0xb0, 0x23, 0x26, 0x5a, 0x96, 0x44, 0xb5,
-	0xa2, 0x55, 0x59, 0x58, 0x08, 0xce, 0xea, 0x81, 0xa0, 0xfe, 0x94, 0x31, 0x6f, 0xfc, 0x67, 0x64,
-	0xf5, 0x7e, 0x35, 0x61, 0x85, 0xd5, 0xe7, 0x1a, 0x66, 0x4d, 0xa3, 0xec, 0x62, 0xff, 0x30, 0x9a,
-	0x67, 0x2d, 0x86, 0x78, 0xfc, 0x0f, 0x73, 0xc1, 0xcf, 0x14, 0x89, 0x3e, 0x84, 0x39, 0x5f, 0x60,
-	0x2e, 0x2c, 0xc1, 0x1a, 0x94, 0x27, 0xf2, 0x19, 0x50, 0x7c, 0x56, 0x92, 0xf9, 0xc8, 0xdc, 0x5d,
-	0x99, 0x9a, 0xc8, 0x62, 0xc6, 0x4f, 0x79, 0x8f, 0xbe, 0x36, 0x40, 0xee, 0x7e, 0xd3, 0xa1, 0x82,
-	0x5a, 0xd1, 0x06, 0x5a, 0xf4, 0x15, 0x25, 0x81, 0x2c, 0x5a, 0x22, 0x99, 0x41, 0x45, 0xe6, 0xff,
-	0x89, 0x64, 0x36, 0x34, 0xd6, 0x4b, 0x0d, 0xf5, 0x28, 0x42, 0x4a, 0xe4, 0xb6, 0x48, 0xb2, 0x87,
-	0xa3, 0x2f, 0x0d, 0x58, 0xdc, 0xc3, 0xcc, 0xc9, 0x4a, 0x73, 0x48, 0xd1, 0xfc, 0x5f, 0x22, 0xcd,
-	0x4d, 0xcc, 0x9c, 0x6c, 0x14, 0xaf, 0xef, 0x65, 0x0b, 0x45, 0xdf, 0x18, 0xb0, 0xcc, 0xe9, 0x07,
-	0x01, 0xf5, 0x85, 0x45, 0xb0, 0x4b, 0xa8, 0x93, 0xe1, 0x9c, 0x0d, 0xa7, 0x6c, 0xa5, 0x19, 0x82,
-	0x6d, 0x28, 0xac, 0xbe, 0x87, 0x6d, 0x91, 0x67, 0x0f, 0x47, 0x1f, 0xc1, 0xbc, 0xa6, 0xd8, 0xfb,
-	0xc8, 0x15, 0x14, 0xb5, 0xd5, 0xe4, 0x2a, 0xab, 0xe4, 0xde, 0x67, 0xee, 0x2a, 0x49, 0x0b, 0x40,
-	0x5f, 0x19, 0xb0, 0xa4, 0xe7, 0xcf, 0x58, 0xcb, 0xa2, 0x22, 0xf3, 0x20, 0x85, 0x4c, 0xb6, 0x6a,
-	0xde, 0x20, 0x59, 0x83, 0xd1, 0xaf, 0x06, 0x3c, 0xe8, 0xaa, 0x27, 0x7d, 0x25, 0x28, 0x77, 0x71,
-	0x66, 0xd6, 0x25, 0xc5, 0xfa, 0x69, 0xff, 0xea, 0x3e, 0xd2, 0xc0, 0xd9, 0x16, 0x71, 0x97, 0x9f,
-	0x31, 0x17, 0x7d, 0x6c, 0xc0, 0x35, 0x4e, 0x89, 0xc7, 0x6d, 0xab, 0x81, 0xf9, 0x61, 0x8f, 0xca,
-	0x97, 0x15, 0xed, 0x5b, 0x3d, 0x68, 0xcb, 0xec, 0xa7, 0x2a, 0x39, 0x91, 0xdc, 0x2c, 0x4f, 0x8d,
-	0x40, 0x3f, 0x18, 0x70, 0x87, 0x78, 0xae, 0x60, 0x6e, 0x40, 0x2d, 0xec, 0x5b, 0x2e, 0x3d, 0xce,
-	0xba, 0x9d, 0xa0, 0x78, 0x3d, 0xea, 0xd1, 0x77, 0x42, 0xc8, 0x35, 0x7f, 0x9b, 0x1e, 0x67, 0xdb,
-	0xc6, 0x65, 0x92, 0x33, 0x07, 0x7d, 0x67, 0xc0, 0x6a, 0xd8, 0xa9, 0xc9, 0x01, 0x73, 0xec, 0xac,
-	0xbc, 0x47, 0x14, 0xef, 0xf5, 0xde, 0xcd, 0x7b, 0x43, 0xa2, 0x65, 0x23, 0xbd, 0xe4, 0xe7, 0x49,
-	0x40, 0x3f, 0x1a, 0x70, 0xc7, 0x67, 0xfb, 0xf2, 0xcc, 0xe6, 0x3d, 0xbc, 0xa3, 0x8a, 0xf5, 0x66,
-	0x32, 0x6b, 0x05, 0x99, 0xef, 0xd4, 0xae, 0xf8, 0x79, 0x93, 0xd0, 0xf7, 0x06, 0xfc, 0x37, 0x68,
-	0xfa, 0x94, 0x8b, 0x36, 0x69, 0x9f, 0x62, 0x4e, 0x0e, 0x3a, 0x88, 0x26, 0x92, 0x1f, 0x4b, 0x39,
-	0x2a, 0x2f, 0x14, 0x62, 0x34, 0xff, 0x73, 0x85, 0xd7, 0x9e, 0x34, 0xf9, 0xa8, 0x04, 0x39, 0x73,
-	0xd6, 0x47, 0x01, 0xda, 0x74, 0x2a, 0x9f, 0x17, 0x60, 0x21, 0x9b, 0x6d, 0x40, 0x73, 0x30, 0x12,
-	0xcb, 0x06, 0xb3, 0x95, 0x11, 0x29, 0x9b, 0x10, 0x0d, 0x6d, 0xd9, 0x68, 0x13, 0xc6, 0xda, 0xba,
-	0xd2, 0x6a, 0x52, 0xed, 0x0d, 0xae, 0x25, 0xae, 0x35, 0x9e, 0xac, 0xd5, 0xa4, 0xe6, 0x28, 0xee,
-	0x78, 0x42, 0x93, 0x50, 0xb0, 0xbd, 0x06, 0x66, 0xae, 0xd2, 0xf3, 0xb2, 0xa9, 0x9f, 0xd0, 0x3d,
-	0x28, 0x2b, 0xb9, 0x92, 0x6e, 0x4b, 0x6b, 0xe8, 0xd5, 0x44, 0x6c, 0xb9, 0x80, 0x27, 0xcc, 0x17,
-	0x66, 0x49, 0xe8, 0xbf, 0xd0, 0x2a, 0x0c, 0x33, 0xb7, 0x19, 0x08, 0xad, 0x6b, 0x33, 0x89, 0x79,
-	0x3b, 0xb8, 0xe5, 0x78, 0xd8, 0x36, 0xc3, 0x50, 0xb4, 0x0b, 0xd3, 0xb1, 0x31, 0x13, 0x9e, 0x45,
-	0x1c, 0xcf, 0xa7, 0x4a, 0x96, 0xbc, 0x40, 0x68, 0x11, 0x9a, 0xae, 0x86, 0xa6, 0xb2, 0x1a, 0x99,
-	0xca, 0xea, 0x43, 0x6d, 0x2a, 0xcd, 0xc9, 0x28, 0x77, 0xd7, 0xdb, 0x90, 0x99, 0xbb, 0x61, 0x62,
-	0x37, 0x6a, 0xdb, 0x5f, 0x49, 0xd4, 0x62, 0x0e, 0xd4, 0xd8, 0x5d, 0x49, 0xd4, 0x6d, 0x98, 0xd4,
-	0x48, 0xdd, 0x44, 0x4b, 0xfd, 0x20, 0x27, 0x42, 0x1b, 0x76, 0x92, 0xe5, 0x26, 0x8c, 0x1f, 0x50,
-	0xcc, 0x45, 0x9d, 0xe2, 0x36, 0xbb, 0x72, 0x3f, 0xa8, 0xf3, 0x71, 0x4e, 0x84, 0xb3, 0x01, 0xa3,
-	0x9c, 0x0a, 0xde, 0xb2, 0x9a, 0x9e, 0xc3, 0x48, 0x4b, 0x77, 0x9c, 0xf9, 0x1e, 0x1d, 0x5c, 0xf0,
-	0xd6, 0x8e, 0x8a, 0x33, 0x47, 0x78, 0xfb, 0x01, 0xdd, 0x82, 0xc2, 0x01, 0xc5, 0x36, 0xe5, 0xfa,
-	0xea, 0x5f, 0x49, 0x4c, 0x7f, 0xac, 0x42, 0x4c, 0x1d, 0x8a, 0x6e, 0xc3, 0x64, 0x24, 0x92, 0x8e,
-	0x47, 0xb0, 0x63, 0xd9, 0xcc, 0x6f, 0x62, 0x41, 0x0e, 0xd4, 0x15, 0x2c, 0x99, 0x17, 0xf4, 0xdb,
-	0x27, 0xf2, 0xe5, 0x43, 0xfd, 0xae, 0xf2, 0xa9, 0x01, 0x33, 0x69, 0xb6, 0x15, 0x4d, 0x43, 0x29,
-	0x74, 0x26, 0xf1, 0x15, 0x28, 0xaa, 0xe7, 0x2d, 0x1b, 0x3d, 0x81, 0x8b, 0x71, 0x0d, 0xf6, 0x18,
-	0x6f, 0x97, 0x60, 0xa0, 0xdf, 0xbe, 0x21, 0x5d, 0x82, 0x4d, 0xc6, 0xa3, 0x0a, 0x54, 0x08, 0x2c,
-	0xe6, 0xb0, 0xac, 0xe8, 0x36, 0x14, 0x38, 0xf5, 0x03, 0x47, 0xe8, 0x2f, 0x84, 0xf4, 0x13, 0xae,
-	0x63, 0x2b, 0x18, 0xae, 0x67, 0x34, 0x9c, 0xe8, 0x0e, 0x14, 0xa5, 0xe1, 0x0c, 0x38, 0x4d, 0x9d,
-	0x61, 0x33, 0x8c, 0x31, 0xa3, 0xe0, 0xca, 0x36, 0x2c, 0xe6, 0xf0, 0x8b, 0x7d, 0xbb, 0x4c, 0xe5,
-	0x1e, 0x5c, 0x4d, 0x35, 0x79, 0x29, 0x15, 0xaa, 0x10, 0xb8, 0x91, 0xd9, 0x93, 0xc9, 0x05, 0xdb,
-	0x54, 0x60, 0xe6, 0xf8, 0x99, 0xb6, 0x34, 0x0a, 0xae, 0xfc, 0x69, 0xc0, 0xdd, 0xb3, 0x7a, 0xa8,
-	0x8e, 0xde, 0x67, 0x9c, 0xe8, 0x7d, 0x2f, 0x00, 0x9d, 0x56, 0x47, 0x7d, 0xb0, 0x16, 0x12, 0x79,
-	0x9d, 0x9a, 0xcd, 0x1c, 0x3f, 0xee, 0x1e, 0x42, 0x53, 0x50, 0x94, 0x5e, 0x83, 0x7b, 0x8e, 0xea,
-	0xb5, 0xa3, 0x66, 0xf4, 0x88, 0xaa, 0x30, 0xd1, 0x65, 0x25, 0x3c, 0xd7, 0x69, 0xa9, 0xb6, 0x5b,
-	0x32, 0xc7, 0x49, 0xa7, 0xcc, 0x3f, 0x73, 0x9d, 0x56, 0xe5, 0x5b, 0x03, 0x66, 0xd3, 0x2d, 0x98,
-	0x2c, 0xad, 0xf6, 0x76, 0x2e, 0x6e, 0xd0, 0xa8, 0xb4, 0xe1, 0xd0, 0x36, 0x6e, 0xd0, 0xce, 0x1d,
-	0x1f, 0xc8, 0xb1, 0xe3, 0x1d, 0xfd, 0x61, 0x30, 0x73, 0x7f, 0xa8, 0xfc, 0x51, 0x84, 0xe5, 0xbc,
-	0xde, 0x4c, 0x4a, 0x5c, 0xbc, 0x1f, 0x4a, 0xe2, 0x8c, 0x14, 0x89, 0x8b, 0x00, 0x43, 0x89, 0x3b,
-	0xee, 0x78, 0x3a, 0x29, 0x65, 0x03, 0x67, 0x94, 0xb2, 0xc1, 0xec, 0x52, 0x86, 0x61, 0xbe, 0xed,
-	0xa9, 0x7a, 0x08, 0xc5, 0x50, 0xbf, 0x2e, 0x35, 0x13, 0x43, 0x3c, 0x4f, 0x50, 0x8c, 0x97, 0x70,
-	0x45, 0x2d, 0xa9, 0x07, 0xfa, 0x70, 0x3f, 0xf4, 0x4b, 0x32, 0x3b, 0x09, 0xf8, 0x19, 0x4c, 0xd6,
-	0x31, 0x39, 0xf4, 0xf6, 0xf6, 0x34, 0x36, 0x73, 0x05, 0xe5, 0x47, 0xd8, 0xe9, 0xaf, 0xc1, 0x17,
-	0x74, 0xa2, 0x82, 0xdd, 0xd2, 0x69, 0xa7, 0x34, 0xa9, 0x78, 0x16, 0x4d, 0xda, 0x82, 0x32, 0x73,
-	0x99, 0x60, 0x58, 0x78, 0x5c, 0x69, 0xec, 0xb9, 0xd5, 0xc5, 0xfe, 0xfe, 0x7f, 0x2b, 0x4a, 0x31,
-	0xdb, 0xd9, 0x9d, 0x9d, 0xb5, 0x9c, 0xa3, 0xb3, 0x22, 0x13, 0x26, 0x1d, 0x2c, 0xbf, 0x01, 0x43,
-	0x99, 0x90, 0xa5, 0xd5, 0x12, 0x00, 0x19, 0x4e, 0xc6, 0x05, 0x99, 0xbb, 0x11, 0xa7, 0x9a, 0x2a,
-	0x13, 0xfd, 0x0b, 0xc6, 0x08, 0x97, 0x67, 0x44, 0xdb, 0x0c, 0x25, 0xd8, 0x65, 0x73, 0x54, 0x0e,
-	0x46, 0x3e, 0xf1, 0x6c, 0x7a, 0xbc, 0x04, 0x43, 0x0d, 0xda, 0xf0, 0xb4, 0x01, 0x9e, 0x4e, 0x4c,
-	0x79, 0x4a, 0x1b, 0x9e, 0xa9, 0xc2, 0x90, 0x09, 0xe3, 0xa7, 0x0c, 0xf5, 0xd4, 0x39, 0x95, 0xfb,
-	0xef, 0x64, 0xe7, 0xdf, 0x65, 0x7d, 0xcd, 0xf3, 0x7e, 0xd7, 0x48, 0xe5, 0xb7, 0x22, 0x2c, 0xe5,
-	0xfa, 0xac, 0xe9, 0xd9, 0x8e, 0xe7, 0x60, 0x24, 0xee, 0x03, 0xcc, 0x56, 0x37, 0xb8, 0x6c, 0x42,
-	0x34, 0x14, 0x7a, 0xe1, 0x93, 0x8d, 0x62, 0xf0, 0x2d, 0x34, 0x8a, 0x77, 0xe0, 0x79, 0xb3, 0x34,
-	0x8a, 0xc2, 0xdf, 0xda, 0x28, 0x8a, 0x67, 0x6e, 0x14, 0xef, 0xc1, 0x44, 0x13, 0x73, 0xea, 0x0a,
-	0x8d, 0xa8, 0xaf, 0x77, 0x78, 0x39, 0x17, 0x7a, 0xac, 0x5e, 0xc6, 0x2b, 0x14, 0x7d, 0xc9, 0xc7,
-	0x9b, 0xdd, 0x43, 0x9d, 0x22, 0x59, 0x3e, 0x29, 0x92, 0x04, 0xa6, 0x3a, 0x8e, 0x81, 0xc5, 0x69,
-	0xd0, 0x9e, 0x16, 0xd4, 0xb4, 0x37, 0x53, 0x0b, 0xbe, 0x65, 0x9b, 0x32, 0x45, 0x4f, 0x7d, 0xf1,
-	0x38, 0x69, 0xf8, 0xed, 0x58, 0xe8, 0x53, 0xf7, 0x7a, 0x34, 0xf5, 0x5e, 0x8f, 0xe5, 0xbf, 0xd7,
-	0xe7, 0xde, 0xe0, 0x5e, 0xff, 0xf3, 0xcd, 0xee, 0xf5, 0x4f, 0x03, 0xb0, 0x92, 0xfb, 0xc3, 0xff,
-	0x5d, 0x5b, 0xad, 0x39, 0x18, 0xd1, 0xff, 0xef, 0x50, 0xee, 0x27, 0xfc, 0xb4, 0x85, 0x70, 0x48,
-	0xb9, 0x9f, 0xf8, 0xba, 0x0e, 0x65, 0xbf, 0xae, 0x1d, 0x47, 0x73, 0x38, 0x93, 0x7f, 0x2b, 0xf4,
-	0xf2, 0x6f, 0x9f, 0x18, 0xb0, 0x9c, 0xf7, 0xff, 0x0f, 0xc9, 0xc5, 0x34, 0xde, 0xa8, 0x98, 0xeb,
-	0xf5, 0x9f, 0x5f, 0xcf, 0x1a, 0xbf, 0xbc, 0x9e, 0x35, 0x7e, 0x7f, 0x3d, 0x6b, 0xc0, 0x25, 0xe2,
-	0x35, 0x92, 0x90, 0xd6, 0x4b, 0x6b, 0x4d, 0xb6, 0x23, 0x7b, 0xc3, 0x8e, 0xf1, 0x7e, 0x6d, 0x9f,
-	0x89, 0x83, 0xa0, 0x5e, 0x25, 0x5e, 0xa3, 0x76, 0xe2, 0xe7, 0x97, 0xea, 0x3e, 0x75, 0xc3, 0xdf,
-	0x7b, 0xf4, 0x2f, 0x31, 0xf7, 0x71, 0x93, 0x1d, 0xad, 0xd4, 0x0b, 0x6a, 0xec, 0xd6, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xdf, 0xda, 0xbb, 0xfb, 0x4c, 0x1a, 0x00, 0x00,
+	// 1647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0xbe, 0xf4, 0x8f, 0x7e, 0x8e, 0xe5, 0x24, 0x1e, 0x27, 0x8e, 0x9d, 0x38, 0xb6, 0xa3, 0x9b,
+	0xeb, 0xfc, 0x18, 0x96, 0x6c, 0x27, 0x37, 0xc8, 0x4d, 0x2e, 0x82, 0xda, 0x4e, 0x8c, 0x18, 0x4d,
+	0x1c, 0x83, 0x71, 0x1a, 0xa0, 0x1b, 0x62, 0x44, 0x8e, 0xec, 0x81, 0x29, 0x52, 0x1d, 0x0e, 0xed,
+	0xa8, 0x40, 0x81, 0xae, 0xda, 0x07, 0x68, 0x37, 0x05, 0x8a, 0x2e, 0xba, 0x6a, 0x37, 0xed, 0xb6,
+	0x45, 0x57, 0xdd, 0x75, 0xd1, 0x45, 0xfb, 0x06, 0x45, 0x9e, 0xa0, 0x40, 0x5f, 0xa0, 0xe0, 0x70,
+	0x48, 0xc9, 0x32, 0x45, 0x0d, 0x9d, 0x34, 0x3b, 0xcd, 0xf0, 0x9c, 0x6f, 0xbe, 0x99, 0x73, 0xe6,
+	0x9c, 0x8f, 0x14, 0x94, 0xfd, 0x1a, 0x61, 0x55, 0x13, 0x5b, 0xc4, 0x31, 0x49, 0x15, 0x37, 0x69,
+	0xf5, 0x60, 0xb9, 0x6a, 0x11, 0x93, 0x7a, 0xd4, 0x75, 0x2a, 0x4d, 0xe6, 0x72, 0x17, 0x8d, 0x07,
+	0x36, 0x15, 0x69, 0x53, 0xc1, 0x4d, 0x5a, 0x39, 0x58, 0xbe, 0x30, 0xb3, 0xeb, 0xba, 0xbb, 0x36,
+	0xa9, 0x0a, 0x93, 0x9a, 0x5f, 0xaf, 0x5a, 0x3e, 0xc3, 0x3c, 0x76, 0xba, 0x30, 0x97, 0x04, 0x6c,
+	0xba, 0x8d, 0x46, 0x6c, 0x91, 0xb8, 0x34, 0xc7, 0xde, 0xbe, 0x4d, 0x3d, 0x9e, 0x66, 0x73, 0xe8,
+	0xb2, 0xfd, 0xba, 0xed, 0x1e, 0x86, 0x36, 0xe5, 0xaf, 0x10, 0x14, 0x1e, 0x48, 0xc6, 0xe8, 0x0b,
+	0x0d, 0x6e, 0x78, 0xe6, 0x1e, 0xb1, 0x7c, 0x9b, 0x18, 0xd8, 0xe4, 0xf4, 0x80, 0xf2, 0x96, 0x11,
+	0xa0, 0x1a, 0xd1, 0xae, 0x0c, 0xcc, 0x39, 0xa3, 0x35, 0x9f, 0x13, 0x6f, 0x52, 0x9b, 0xd3, 0xae,
+	0x8d, 0xac, 0xdc, 0xab, 0x24, 0xec, 0xb0, 0xf2, 0x4c, 0xc2, 0xac, 0x4a, 0x94, 0x1d, 0xec, 0xed,
+	0x47, 0xeb, 0xac, 0xc6, 0x10, 0x8f, 0xfe, 0xa5, 0xcf, 0x7b, 0x4a, 0x96, 0xe8, 0x43, 0x98, 0xf5,
+	0x38, 0x66, 0xdc, 0xe0, 0xb4, 0x41, 0x58, 0x22, 0x9f, 0x01, 0xc1, 0x67, 0x39, 0x99, 0x4f, 0xe0,
+	0xbb, 0x13, 0xb8, 0x26, 0xb2, 0x98, 0xf6, 0x52, 0x9e, 0xa3, 0x6f, 0x34, 0x08, 0x4e, 0xbf, 0x69,
+	0x13, 0x4e, 0x8c, 0xe8, 0x00, 0x0d, 0xf2, 0x92, 0x98, 0x7e, 0x10, 0xb4, 0x44, 0x32, 0x83, 0x82,
+	0xcc, 0x3b, 0x89, 0x64, 0xd6, 0x25, 0xd6, 0x0b, 0x09, 0xf5, 0x30, 0x42, 0x4a, 0xe4, 0xb6, 0x60,
+	0xaa, 0x9b, 0xa3, 0x2f, 0x35, 0x58, 0xa8, 0x63, 0x6a, 0xab, 0xd2, 0x1c, 0x12, 0x34, 0xff, 0x9f,
+	0x48, 0x73, 0x03, 0x53, 0x5b, 0x8d, 0xe2, 0xd5, 0xba, 0x9a, 0x29, 0xfa, 0x56, 0x83, 0x25, 0x46,
+	0x3e, 0xf0, 0x89, 0xc7, 0x0d, 0x13, 0x3b, 0x26, 0xb1, 0x15, 0xf2, 0x6c, 0x38, 0xe5, 0x28, 0xf5,
+	0x10, 0x6c, 0x5d, 0x60, 0xf5, 0x4d, 0xb6, 0x05, 0xa6, 0x6e, 0x8e, 0x3e, 0x82, 0x39, 0x49, 0xb1,
+	0x77, 0xca, 0xe5, 0x04, 0xb5, 0x95, 0xe4, 0x28, 0x0b, 0xe7, 0xde, 0x39, 0x77, 0xc9, 0x4c, 0x33,
+	0x40, 0x5f, 0x6b, 0xb0, 0x28, 0xd7, 0x57, 0x8c, 0x65, 0x5e, 0x90, 0xb9, 0x9f, 0x42, 0x46, 0x2d,
+	0x9a, 0xd7, 0x4d, 0x55, 0x63, 0xf4, 0xbb, 0x06, 0xf7, 0xbb, 0xe2, 0x49, 0x5e, 0x72, 0xc2, 0x1c,
+	0xac, 0xcc, 0xba, 0x20, 0x58, 0x3f, 0xe9, 0x1f, 0xdd, 0x87, 0x12, 0x58, 0x6d, 0x13, 0x77, 0xd8,
+	0x09, 0x7d, 0xd1, 0xc7, 0x1a, 0x5c, 0x66, 0xc4, 0x74, 0x99, 0x65, 0x34, 0x30, 0xdb, 0xef, 0x11,
+	0xf9, 0xa2, 0xa0, 0x7d, 0xb3, 0x07, 0xed, 0xc0, 0xfb, 0x89, 0x70, 0x4e, 0x24, 0x37, 0xc3, 0x52,
+	0x2d, 0xd0, 0x8f, 0x1a, 0xdc, 0x36, 0x5d, 0x87, 0x53, 0xc7, 0x27, 0x06, 0xf6, 0x0c, 0x87, 0x1c,
+	0xaa, 0x1e, 0x27, 0x08, 0x5e, 0x0f, 0x7b, 0xd4, 0x9d, 0x10, 0x72, 0xd5, 0xdb, 0x22, 0x87, 0x6a,
+	0xc7, 0xb8, 0x64, 0x66, 0xf4, 0x41, 0xdf, 0x6b, 0xb0, 0x12, 0x56, 0x6a, 0x73, 0x8f, 0xda, 0x96,
+	0x2a, 0xef, 0x11, 0xc1, 0x7b, 0xad, 0x77, 0xf1, 0x5e, 0x0f, 0xd0, 0xd4, 0x48, 0x2f, 0x7a, 0x59,
+	0x1c, 0xd0, 0x4f, 0x1a, 0xdc, 0xf6, 0xe8, 0xae, 0x83, 0xb3, 0x27, 0x6f, 0x49, 0xb0, 0xde, 0x48,
+	0x66, 0x2d, 0x20, 0xb3, 0x65, 0xed, 0xb2, 0x97, 0xd5, 0x09, 0xfd, 0xa0, 0xc1, 0x7f, 0xfd, 0xa6,
+	0x47, 0x18, 0x6f, 0x93, 0xf6, 0x08, 0x66, 0xe6, 0x5e, 0x07, 0xd1, 0x44, 0xf2, 0xa3, 0x29, 0xa9,
+	0xf2, 0x5c, 0x20, 0x46, 0xeb, 0x3f, 0x13, 0x78, 0xed, 0x45, 0x93, 0x53, 0xc5, 0xcf, 0xe8, 0x83,
+	0x3e, 0xd3, 0xe0, 0x5a, 0x37, 0xf5, 0x06, 0x69, 0xb8, 0x89, 0x6c, 0x4f, 0x09, 0xb6, 0xff, 0x53,
+	0x60, 0xfb, 0x84, 0x34, 0xdc, 0x44, 0x86, 0x57, 0x7c, 0x05, 0xbb, 0xb5, 0x12, 0x40, 0x7b, 0xd9,
+	0xf2, 0xaf, 0x39, 0x98, 0x57, 0x13, 0x33, 0x68, 0x16, 0x46, 0xe2, 0x66, 0x46, 0x2d, 0x21, 0x8f,
+	0x8a, 0x3a, 0x44, 0x53, 0x9b, 0x16, 0xda, 0x80, 0xd1, 0xd8, 0x80, 0xb7, 0x9a, 0x44, 0x2a, 0x96,
+	0xcb, 0x89, 0x7b, 0x8a, 0x17, 0x6b, 0x35, 0x89, 0x5e, 0xc2, 0x1d, 0x23, 0x34, 0x01, 0x39, 0xcb,
+	0x6d, 0x60, 0xea, 0x08, 0x95, 0x51, 0xd4, 0xe5, 0x08, 0xdd, 0x85, 0xa2, 0x68, 0xa2, 0x81, 0x06,
+	0x94, 0x9d, 0xfd, 0x52, 0x22, 0x76, 0xb0, 0x81, 0xc7, 0xd4, 0xe3, 0x7a, 0x81, 0xcb, 0x5f, 0x68,
+	0x05, 0x86, 0xa9, 0xd3, 0xf4, 0xb9, 0xec, 0xb6, 0xd3, 0x89, 0x7e, 0xdb, 0xb8, 0x65, 0xbb, 0xd8,
+	0xd2, 0x43, 0x53, 0xb4, 0x03, 0x53, 0xb1, 0x5c, 0xe4, 0xae, 0x61, 0xda, 0xae, 0x47, 0x44, 0xb3,
+	0x74, 0x7d, 0x2e, 0x5b, 0xe3, 0x54, 0x25, 0x94, 0xba, 0x95, 0x48, 0xea, 0x56, 0x1e, 0x48, 0xa9,
+	0xab, 0x4f, 0x44, 0xbe, 0x3b, 0xee, 0x7a, 0xe0, 0xb9, 0x13, 0x3a, 0x76, 0xa3, 0xb6, 0x55, 0x5f,
+	0x80, 0x9a, 0xcf, 0x80, 0x1a, 0x6b, 0xbe, 0x00, 0x75, 0x0b, 0x26, 0x24, 0x52, 0x37, 0xd1, 0x42,
+	0x3f, 0xc8, 0xf1, 0x50, 0x1c, 0x1e, 0x65, 0xb9, 0x01, 0x63, 0x7b, 0x04, 0x33, 0x5e, 0x23, 0xb8,
+	0xcd, 0xae, 0xd8, 0x0f, 0xea, 0x4c, 0xec, 0x13, 0xe1, 0xac, 0x43, 0x89, 0x11, 0xce, 0x5a, 0x46,
+	0xd3, 0xb5, 0xa9, 0xd9, 0x92, 0x75, 0x70, 0xae, 0x47, 0x5f, 0xe1, 0xac, 0xb5, 0x2d, 0xec, 0xf4,
+	0x11, 0xd6, 0x1e, 0xa0, 0x9b, 0x90, 0xdb, 0x23, 0xd8, 0x22, 0x4c, 0x16, 0xa4, 0x8b, 0x89, 0xee,
+	0x8f, 0x84, 0x89, 0x2e, 0x4d, 0xd1, 0x2d, 0x98, 0x88, 0x5a, 0xb7, 0xed, 0x9a, 0xd8, 0x36, 0x2c,
+	0xea, 0x35, 0x31, 0x37, 0xf7, 0x44, 0x61, 0x28, 0xe8, 0x67, 0xe5, 0xd3, 0xc7, 0xc1, 0xc3, 0x07,
+	0xf2, 0x19, 0xba, 0x00, 0x85, 0x26, 0xa3, 0x2e, 0xa3, 0xbc, 0x25, 0xae, 0xe4, 0xb0, 0x1e, 0x8f,
+	0xd1, 0x65, 0x28, 0xd5, 0x31, 0x65, 0x0e, 0xf1, 0x3c, 0x63, 0x9f, 0xb4, 0x26, 0x4f, 0x8b, 0xec,
+	0x1c, 0x89, 0xe6, 0xde, 0x25, 0xad, 0xf2, 0xa7, 0x1a, 0x4c, 0xa7, 0x69, 0x71, 0x34, 0x05, 0x85,
+	0x50, 0x6e, 0xc5, 0x37, 0x28, 0x2f, 0xc6, 0x9b, 0x16, 0x7a, 0x0c, 0xe7, 0xe2, 0x10, 0xd6, 0x29,
+	0x6b, 0x47, 0x70, 0xa0, 0xdf, 0xb1, 0x23, 0x19, 0xc1, 0x0d, 0xca, 0xa2, 0x00, 0x96, 0x4d, 0x58,
+	0xc8, 0xa0, 0xc3, 0xd1, 0x2d, 0xc8, 0x31, 0xe2, 0xf9, 0x36, 0x9f, 0xd4, 0x14, 0x2e, 0x88, 0xb4,
+	0x2d, 0x63, 0xb8, 0xaa, 0xa8, 0xa2, 0xd1, 0x6d, 0xc8, 0x07, 0x2a, 0xda, 0x67, 0x24, 0x75, 0x85,
+	0x8d, 0xd0, 0x46, 0x8f, 0x8c, 0xcb, 0x5b, 0xb0, 0x90, 0x41, 0x04, 0xf7, 0x2d, 0x52, 0xe5, 0xbb,
+	0x70, 0x29, 0x55, 0xb9, 0xa6, 0x44, 0xa8, 0x6c, 0xc2, 0x75, 0x65, 0xa1, 0x19, 0x6c, 0xd8, 0x22,
+	0x1c, 0x53, 0xdb, 0x53, 0x3a, 0xd2, 0xc8, 0xb8, 0xfc, 0x97, 0x06, 0x77, 0x4e, 0x2a, 0x0c, 0x3b,
+	0x4a, 0xa7, 0x76, 0xa4, 0x74, 0x3e, 0x07, 0x74, 0xbc, 0xe5, 0xcb, 0xc4, 0x9a, 0x4f, 0xe4, 0x75,
+	0x6c, 0x35, 0x7d, 0xec, 0xb0, 0x7b, 0x0a, 0x4d, 0x42, 0xde, 0x74, 0x1d, 0xce, 0x5c, 0x5b, 0x94,
+	0xea, 0x92, 0x1e, 0x0d, 0x51, 0x05, 0xc6, 0xbb, 0xf4, 0x91, 0xeb, 0xd8, 0x2d, 0x51, 0xb5, 0x0b,
+	0xfa, 0x98, 0xd9, 0xa9, 0x5d, 0x9e, 0x3a, 0x76, 0xab, 0xfc, 0x9d, 0x06, 0x33, 0xe9, 0xba, 0x32,
+	0x08, 0xad, 0x14, 0xac, 0x0e, 0x6e, 0x90, 0x28, 0xb4, 0xe1, 0xd4, 0x16, 0x6e, 0x90, 0xce, 0x13,
+	0x1f, 0xc8, 0x70, 0xe2, 0x1d, 0xe5, 0x65, 0x50, 0xb9, 0xbc, 0x94, 0xff, 0xcc, 0xc3, 0x52, 0x56,
+	0xc1, 0x19, 0x74, 0xc8, 0xf8, 0x3c, 0x44, 0x87, 0xd4, 0x52, 0x3a, 0x64, 0x04, 0x18, 0x76, 0xc8,
+	0xc3, 0x8e, 0xd1, 0xd1, 0x4e, 0x38, 0x70, 0xc2, 0x4e, 0x38, 0xa8, 0xde, 0x09, 0x31, 0xcc, 0xb5,
+	0x85, 0x62, 0x8f, 0x3e, 0x33, 0xd4, 0xaf, 0x4a, 0x4d, 0xc7, 0x10, 0xcf, 0x12, 0x1a, 0xce, 0x0b,
+	0xb8, 0x28, 0xb6, 0xd4, 0x03, 0x7d, 0xb8, 0x1f, 0xfa, 0xf9, 0xc0, 0x3b, 0x09, 0xf8, 0x29, 0x4c,
+	0xd4, 0xb0, 0xb9, 0xef, 0xd6, 0xeb, 0x12, 0x9b, 0x3a, 0x9c, 0xb0, 0x03, 0x6c, 0xf7, 0x6f, 0xe1,
+	0x67, 0xa5, 0xa3, 0x80, 0xdd, 0x94, 0x6e, 0xc7, 0x5a, 0x5a, 0xfe, 0x24, 0x2d, 0x6d, 0x13, 0x8a,
+	0xd4, 0xa1, 0x9c, 0x62, 0xee, 0x32, 0xd1, 0xa2, 0x4f, 0xad, 0x2c, 0xf4, 0x7f, 0xa9, 0xd9, 0x8c,
+	0x5c, 0xf4, 0xb6, 0x77, 0x67, 0x65, 0x2d, 0x66, 0xa8, 0xac, 0x48, 0x87, 0x09, 0x1b, 0x07, 0x2f,
+	0xb6, 0x61, 0x9b, 0x08, 0x42, 0x2b, 0x5b, 0x00, 0x28, 0x64, 0xc6, 0xd9, 0xc0, 0x77, 0x3d, 0x76,
+	0xd5, 0x85, 0x27, 0xfa, 0x37, 0x8c, 0x9a, 0x2c, 0xc8, 0x11, 0xa9, 0x52, 0x44, 0xbf, 0x2f, 0xea,
+	0xa5, 0x60, 0x32, 0x92, 0x99, 0x27, 0x6b, 0xe7, 0x8b, 0x30, 0x14, 0x68, 0x67, 0xa9, 0xea, 0xa7,
+	0x12, 0x5d, 0x02, 0xc5, 0xab, 0x0b, 0x33, 0xa4, 0xc3, 0xd8, 0xb1, 0xb7, 0x04, 0xa9, 0xb1, 0xff,
+	0x93, 0xfc, 0x3a, 0xd3, 0xa5, 0xe7, 0xf5, 0x33, 0x5e, 0xd7, 0x4c, 0xf9, 0xf3, 0x02, 0x2c, 0x66,
+	0x7a, 0x57, 0xeb, 0x59, 0x8e, 0x67, 0x61, 0x24, 0xae, 0x03, 0xd4, 0x12, 0x37, 0xb8, 0xa8, 0x43,
+	0x34, 0x15, 0x4a, 0xe9, 0xa3, 0x85, 0x62, 0xf0, 0x0d, 0x14, 0x8a, 0xb7, 0x20, 0x99, 0x55, 0x0a,
+	0x45, 0xee, 0x1f, 0x2d, 0x14, 0xf9, 0x13, 0x17, 0x8a, 0xf7, 0x60, 0xbc, 0x89, 0x19, 0x71, 0xb8,
+	0x44, 0x94, 0xd7, 0x3b, 0xbc, 0x9c, 0xf3, 0x3d, 0x76, 0x1f, 0xd8, 0x0b, 0x14, 0x79, 0xc9, 0xc7,
+	0x9a, 0xdd, 0x53, 0x9d, 0x4d, 0xb2, 0x78, 0xb4, 0x49, 0x9a, 0x30, 0xd9, 0x91, 0x06, 0x06, 0x23,
+	0x7e, 0x7b, 0x59, 0x10, 0xcb, 0xde, 0x48, 0x0d, 0xf8, 0xa6, 0xa5, 0x13, 0x3f, 0x5a, 0x47, 0x3f,
+	0x77, 0x98, 0x34, 0xfd, 0x66, 0x14, 0xf8, 0xb1, 0x7b, 0x5d, 0x4a, 0xbd, 0xd7, 0xa3, 0xd9, 0xef,
+	0xf5, 0xa9, 0xd7, 0xb8, 0xd7, 0xa7, 0x5f, 0xeb, 0x5e, 0x1f, 0xd1, 0xfc, 0x67, 0xfa, 0x68, 0xfe,
+	0xb1, 0xe3, 0x9a, 0xff, 0xe7, 0x01, 0x58, 0xce, 0xfc, 0x31, 0xe4, 0x6d, 0x2b, 0xb5, 0x59, 0x18,
+	0x91, 0xdf, 0x80, 0x84, 0x78, 0x0a, 0x5f, 0xac, 0x21, 0x9c, 0x12, 0xe2, 0x29, 0xbe, 0xed, 0x43,
+	0xea, 0xb7, 0xbd, 0x23, 0xb3, 0x87, 0x95, 0xe4, 0x5f, 0xae, 0x97, 0xfc, 0xfb, 0x44, 0x83, 0xa5,
+	0xac, 0xdf, 0x64, 0x92, 0x73, 0x41, 0x7b, 0xbd, 0x1a, 0xff, 0x1c, 0xae, 0xa8, 0x7c, 0x6d, 0x89,
+	0xd3, 0x56, 0x53, 0x4a, 0xdb, 0xb5, 0xda, 0x2f, 0xaf, 0x66, 0xb4, 0xdf, 0x5e, 0xcd, 0x68, 0x7f,
+	0xbc, 0x9a, 0xd1, 0xe0, 0xbc, 0xe9, 0x36, 0x92, 0x3c, 0xd6, 0x0a, 0xab, 0x4d, 0xba, 0xcd, 0x5c,
+	0xee, 0x6e, 0x6b, 0xef, 0x57, 0x77, 0x29, 0xdf, 0xf3, 0x6b, 0x15, 0xd3, 0x6d, 0x54, 0x8f, 0xfc,
+	0xd3, 0x55, 0xd9, 0x25, 0x4e, 0xf8, 0xd7, 0x9a, 0xfc, 0xd3, 0xeb, 0x1e, 0x6e, 0xd2, 0x83, 0xe5,
+	0x5a, 0x4e, 0xcc, 0xdd, 0xfc, 0x7b, 0x00, 0xf4, 0x7a, 0xe9, 0xa5, 0xb7, 0x1b, 0x00, 0x00,
 }
 
 func (m *Decision) Marshal() (dAtA []byte, err error) {
@@ -1748,6 +1846,27 @@ func (m *Decision_UpsertWorkflowSearchAttributesDecisionAttributes) MarshalToSiz
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Decision_UpsertWorkflowMemoDecisionAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Decision_UpsertWorkflowMemoDecisionAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpsertWorkflowMemoDecisionAttributes != nil {
+		{
+			size, err := m.UpsertWorkflowMemoDecisionAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDecision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ScheduleActivityTaskDecisionAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1772,6 +1891,18 @@ func (m *ScheduleActivityTaskDecisionAttributes) MarshalToSizedBuffer(dAtA []byt
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintDecision(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintDecision(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.RequestLocalDispatch {
 		i--
 		if m.RequestLocalDispatch {
@@ -2466,6 +2597,22 @@ func (m *StartChildWorkflowExecutionDecisionAttributes) MarshalToSizedBuffer(dAt
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintDecision(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintDecision(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.SearchAttributes != nil {
 		{
 			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
@@ -2736,6 +2883,45 @@ func (m *UpsertWorkflowSearchAttributesDecisionAttributes) MarshalToSizedBuffer(
 	return len(dAtA) - i, nil
 }
 
+func (m *UpsertWorkflowMemoDecisionAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowMemoDecisionAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowMemoDecisionAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDecision(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDecision(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDecision(v)
 	base := offset
@@ -2918,6 +3104,18 @@ func (m *Decision_UpsertWorkflowSearchAttributesDecisionAttributes) Size() (n in
 	}
 	return n
 }
+func (m *Decision_UpsertWorkflowMemoDecisionAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpsertWorkflowMemoDecisionAttributes != nil {
+		l = m.UpsertWorkflowMemoDecisionAttributes.Size()
+		n += 1 + l + sovDecision(uint64(l))
+	}
+	return n
+}
 func (m *ScheduleActivityTaskDecisionAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2971,6 +3169,13 @@ func (m *ScheduleActivityTaskDecisionAttributes) Size() (n int) {
 	if m.RequestLocalDispatch {
 		n += 2
 	}
+	if m.Priority != 0 {
+		n += 1 + sovDecision(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovDecision(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3259,6 +3464,13 @@ func (m *StartChildWorkflowExecutionDecisionAttributes) Size() (n int) {
 		l = m.SearchAttributes.Size()
 		n += 1 + l + sovDecision(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 2 + sovDecision(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 2 + l + sovDecision(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3316,6 +3528,22 @@ func (m *UpsertWorkflowSearchAttributesDecisionAttributes) Size() (n int) {
 	return n
 }
 
+func (m *UpsertWorkflowMemoDecisionAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovDecision(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovDecision(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3806,6 +4034,41 @@ func (m *Decision) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes = &Decision_UpsertWorkflowSearchAttributesDecisionAttributes{v}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpsertWorkflowMemoDecisionAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpsertWorkflowMemoDecisionAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &Decision_UpsertWorkflowMemoDecisionAttributes{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDecision(dAtA[iNdEx:])
@@ -4268,6 +4531,57 @@ func (m *ScheduleActivityTaskDecisionAttributes) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequestLocalDispatch = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDecision(dAtA[iNdEx:])
@@ -6249,6 +6563,57 @@ func (m *StartChildWorkflowExecutionDecisionAttributes) Unmarshal(dAtA []byte) e
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDecision(dAtA[iNdEx:])
@@ -6608,6 +6973,96 @@ func (m *UpsertWorkflowSearchAttributesDecisionAttributes) Unmarshal(dAtA []byte
 	}
 	return nil
 }
+func (m *UpsertWorkflowMemoDecisionAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDecision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowMemoDecisionAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowMemoDecisionAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDecision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDecision
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDecision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDecision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDecision(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
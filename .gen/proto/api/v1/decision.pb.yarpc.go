@@ -28,103 +28,106 @@ package apiv1
 var yarpcFileDescriptorClosurefb529b236ea74dc2 = [][]byte{
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5d, 0x4f, 0xdc, 0x46,
-		0x17, 0x7e, 0xcd, 0xc7, 0x7e, 0x1c, 0x20, 0x6f, 0x18, 0x12, 0x02, 0x09, 0x09, 0x64, 0x5f, 0xbd,
-		0xa4, 0x09, 0x62, 0x17, 0x48, 0x1a, 0x45, 0x49, 0x15, 0x15, 0x48, 0x50, 0x90, 0x12, 0x82, 0x1c,
-		0x92, 0x48, 0xbd, 0xb1, 0x86, 0xf1, 0x00, 0x23, 0xbc, 0xb6, 0x3b, 0x1e, 0x43, 0xb6, 0x52, 0xa5,
-		0x5e, 0xb5, 0xbd, 0xe9, 0x0f, 0xa8, 0xd4, 0xab, 0x5e, 0xb5, 0x37, 0xed, 0x6d, 0xab, 0x5e, 0xf5,
-		0x27, 0xf4, 0xa2, 0xff, 0xa3, 0x52, 0xff, 0x40, 0x35, 0xe3, 0xb1, 0x77, 0x59, 0xbc, 0x5e, 0x9b,
-		0xa4, 0xb9, 0xc3, 0xe3, 0x73, 0x9e, 0x79, 0x66, 0xce, 0xcc, 0x79, 0x1e, 0xb3, 0x50, 0x0b, 0x77,
-		0x29, 0x6f, 0x10, 0x6c, 0x53, 0x97, 0xd0, 0x06, 0xf6, 0x59, 0xe3, 0x68, 0xb9, 0x61, 0x53, 0xc2,
-		0x02, 0xe6, 0xb9, 0x75, 0x9f, 0x7b, 0xc2, 0x43, 0x13, 0x32, 0xa6, 0xae, 0x63, 0xea, 0xd8, 0x67,
-		0xf5, 0xa3, 0xe5, 0xcb, 0xd7, 0xf6, 0x3d, 0x6f, 0xdf, 0xa1, 0x0d, 0x15, 0xb2, 0x1b, 0xee, 0x35,
-		0xec, 0x90, 0x63, 0x91, 0x24, 0x5d, 0x9e, 0x4b, 0x03, 0x26, 0x5e, 0xb3, 0x99, 0x44, 0xa4, 0x4e,
-		0x2d, 0x70, 0x70, 0xe8, 0xb0, 0x40, 0x64, 0xc5, 0x1c, 0x7b, 0xfc, 0x70, 0xcf, 0xf1, 0x8e, 0xa3,
-		0x98, 0xda, 0xd7, 0xe3, 0x50, 0x79, 0xa4, 0x19, 0xa3, 0x6f, 0x0d, 0xb8, 0x15, 0x90, 0x03, 0x6a,
-		0x87, 0x0e, 0xb5, 0x30, 0x11, 0xec, 0x88, 0x89, 0x96, 0x25, 0x51, 0xad, 0x78, 0x55, 0x16, 0x16,
-		0x82, 0xb3, 0xdd, 0x50, 0xd0, 0x60, 0xca, 0x98, 0x33, 0x3e, 0x18, 0x59, 0x79, 0x50, 0x4f, 0x59,
-		0x61, 0xfd, 0x85, 0x86, 0x59, 0xd5, 0x28, 0x3b, 0x38, 0x38, 0x8c, 0xe7, 0x59, 0x4d, 0x20, 0x9e,
-		0xfc, 0xc7, 0x9c, 0x0f, 0x72, 0x45, 0xa2, 0xcf, 0x60, 0x36, 0x10, 0x98, 0x0b, 0x4b, 0xb0, 0x26,
-		0xe5, 0xa9, 0x7c, 0x06, 0x14, 0x9f, 0xe5, 0x74, 0x3e, 0x32, 0x77, 0x47, 0xa6, 0xa6, 0xb2, 0x98,
-		0x09, 0x32, 0xde, 0xa3, 0x1f, 0x0c, 0x90, 0xbb, 0xef, 0x3b, 0x54, 0x50, 0x2b, 0xde, 0x40, 0x8b,
-		0xbe, 0xa1, 0x24, 0x94, 0x45, 0x4b, 0x25, 0x33, 0xa8, 0xc8, 0x7c, 0x9c, 0x4a, 0x66, 0x5d, 0x63,
-		0xbd, 0xd6, 0x50, 0x8f, 0x63, 0xa4, 0x54, 0x6e, 0x0b, 0x24, 0x7f, 0x38, 0xfa, 0xce, 0x80, 0x85,
-		0x3d, 0xcc, 0x9c, 0xbc, 0x34, 0x87, 0x14, 0xcd, 0x8f, 0x52, 0x69, 0x6e, 0x60, 0xe6, 0xe4, 0xa3,
-		0x78, 0x63, 0x2f, 0x5f, 0x28, 0xfa, 0xd1, 0x80, 0x25, 0x4e, 0x3f, 0x0d, 0x69, 0x20, 0x2c, 0x82,
-		0x5d, 0x42, 0x9d, 0x1c, 0xe7, 0x6c, 0x38, 0x63, 0x2b, 0xcd, 0x08, 0x6c, 0x5d, 0x61, 0xf5, 0x3d,
-		0x6c, 0x0b, 0x3c, 0x7f, 0x38, 0xfa, 0x1c, 0xe6, 0x34, 0xc5, 0xde, 0x47, 0xae, 0xa4, 0xa8, 0xad,
-		0xa4, 0x57, 0x59, 0x25, 0xf7, 0x3e, 0x73, 0x57, 0x49, 0x56, 0x00, 0xfa, 0xde, 0x80, 0x45, 0x3d,
-		0x7f, 0xce, 0x5a, 0x96, 0x15, 0x99, 0x87, 0x19, 0x64, 0xf2, 0x55, 0xf3, 0x26, 0xc9, 0x1b, 0x8c,
-		0xfe, 0x30, 0xe0, 0x61, 0x57, 0x3d, 0xe9, 0x1b, 0x41, 0xb9, 0x8b, 0x73, 0xb3, 0xae, 0x28, 0xd6,
-		0xcf, 0xfa, 0x57, 0xf7, 0xb1, 0x06, 0xce, 0xb7, 0x88, 0x7b, 0xfc, 0x8c, 0xb9, 0xe8, 0x0b, 0x03,
-		0xae, 0x73, 0x4a, 0x3c, 0x6e, 0x5b, 0x4d, 0xcc, 0x0f, 0x7b, 0x54, 0xbe, 0xaa, 0x68, 0xdf, 0xee,
-		0x41, 0x5b, 0x66, 0x3f, 0x53, 0xc9, 0xa9, 0xe4, 0xae, 0xf1, 0xcc, 0x08, 0xf4, 0xab, 0x01, 0x77,
-		0x89, 0xe7, 0x0a, 0xe6, 0x86, 0xd4, 0xc2, 0x81, 0xe5, 0xd2, 0xe3, 0xbc, 0xdb, 0x09, 0x8a, 0xd7,
-		0xe3, 0x1e, 0x7d, 0x27, 0x82, 0x5c, 0x0d, 0xb6, 0xe8, 0x71, 0xbe, 0x6d, 0x5c, 0x22, 0x05, 0x73,
-		0xd0, 0xcf, 0x06, 0xac, 0x44, 0x9d, 0x9a, 0x1c, 0x30, 0xc7, 0xce, 0xcb, 0x7b, 0x44, 0xf1, 0x5e,
-		0xeb, 0xdd, 0xbc, 0xd7, 0x25, 0x5a, 0x3e, 0xd2, 0x8b, 0x41, 0x91, 0x04, 0xf4, 0x9b, 0x01, 0x77,
-		0x03, 0xb6, 0x2f, 0xcf, 0x6c, 0xd1, 0xc3, 0x3b, 0xaa, 0x58, 0x6f, 0xa4, 0xb3, 0x56, 0x90, 0xc5,
-		0x4e, 0xed, 0x72, 0x50, 0x34, 0x09, 0xfd, 0x62, 0xc0, 0x87, 0xa1, 0x1f, 0x50, 0x2e, 0xda, 0xa4,
-		0x03, 0x8a, 0x39, 0x39, 0xe8, 0x20, 0x9a, 0x4a, 0x7e, 0x2c, 0xe3, 0xa8, 0xbc, 0x54, 0x88, 0xf1,
-		0xfc, 0x2f, 0x14, 0x5e, 0x7b, 0xd2, 0xf4, 0xa3, 0x12, 0x16, 0xcc, 0x59, 0x1b, 0x05, 0x68, 0xd3,
-		0xa9, 0x7d, 0x53, 0x82, 0xf9, 0x7c, 0xb6, 0x01, 0xcd, 0xc2, 0x48, 0x22, 0x1b, 0xcc, 0x56, 0x46,
-		0xa4, 0x6a, 0x42, 0x3c, 0xb4, 0x69, 0xa3, 0x0d, 0x18, 0x6b, 0xeb, 0x4a, 0xcb, 0xa7, 0xda, 0x1b,
-		0x5c, 0x4f, 0x5d, 0x6b, 0x32, 0x59, 0xcb, 0xa7, 0xe6, 0x28, 0xee, 0x78, 0x42, 0x93, 0x50, 0xb2,
-		0xbd, 0x26, 0x66, 0xae, 0xd2, 0xf3, 0xaa, 0xa9, 0x9f, 0xd0, 0x7d, 0xa8, 0x2a, 0xb9, 0x92, 0x6e,
-		0x4b, 0x6b, 0xe8, 0xd5, 0x54, 0x6c, 0xb9, 0x80, 0xa7, 0x2c, 0x10, 0x66, 0x45, 0xe8, 0xbf, 0xd0,
-		0x0a, 0x0c, 0x33, 0xd7, 0x0f, 0x85, 0xd6, 0xb5, 0x99, 0xd4, 0xbc, 0x6d, 0xdc, 0x72, 0x3c, 0x6c,
-		0x9b, 0x51, 0x28, 0xda, 0x81, 0xe9, 0xc4, 0x98, 0x09, 0xcf, 0x22, 0x8e, 0x17, 0x50, 0x25, 0x4b,
-		0x5e, 0x28, 0xb4, 0x08, 0x4d, 0xd7, 0x23, 0x53, 0x59, 0x8f, 0x4d, 0x65, 0xfd, 0x91, 0x36, 0x95,
-		0xe6, 0x64, 0x9c, 0xbb, 0xe3, 0xad, 0xcb, 0xcc, 0x9d, 0x28, 0xb1, 0x1b, 0xb5, 0xed, 0xaf, 0x24,
-		0x6a, 0xb9, 0x00, 0x6a, 0xe2, 0xae, 0x24, 0xea, 0x16, 0x4c, 0x6a, 0xa4, 0x6e, 0xa2, 0x95, 0x7e,
-		0x90, 0x13, 0x91, 0x0d, 0x3b, 0xc9, 0x72, 0x03, 0xc6, 0x0f, 0x28, 0xe6, 0x62, 0x97, 0xe2, 0x36,
-		0xbb, 0x6a, 0x3f, 0xa8, 0xf3, 0x49, 0x4e, 0x8c, 0xb3, 0x0e, 0xa3, 0x9c, 0x0a, 0xde, 0xb2, 0x7c,
-		0xcf, 0x61, 0xa4, 0xa5, 0x3b, 0xce, 0x5c, 0x8f, 0x0e, 0x2e, 0x78, 0x6b, 0x5b, 0xc5, 0x99, 0x23,
-		0xbc, 0xfd, 0x80, 0x6e, 0x43, 0xe9, 0x80, 0x62, 0x9b, 0x72, 0x7d, 0xf5, 0xaf, 0xa4, 0xa6, 0x3f,
-		0x51, 0x21, 0xa6, 0x0e, 0x45, 0x77, 0x60, 0x32, 0x16, 0x49, 0xc7, 0x23, 0xd8, 0xb1, 0x6c, 0x16,
-		0xf8, 0x58, 0x90, 0x03, 0x75, 0x05, 0x2b, 0xe6, 0x05, 0xfd, 0xf6, 0xa9, 0x7c, 0xf9, 0x48, 0xbf,
-		0xab, 0x7d, 0x65, 0xc0, 0x4c, 0x96, 0x6d, 0x45, 0xd3, 0x50, 0x89, 0x9c, 0x49, 0x72, 0x05, 0xca,
-		0xea, 0x79, 0xd3, 0x46, 0x4f, 0xe1, 0x62, 0x52, 0x83, 0x3d, 0xc6, 0xdb, 0x25, 0x18, 0xe8, 0xb7,
-		0x6f, 0x48, 0x97, 0x60, 0x83, 0xf1, 0xb8, 0x02, 0x35, 0x02, 0x0b, 0x05, 0x2c, 0x2b, 0xba, 0x03,
-		0x25, 0x4e, 0x83, 0xd0, 0x11, 0xfa, 0x0b, 0x21, 0xfb, 0x84, 0xeb, 0xd8, 0x1a, 0x86, 0x1b, 0x39,
-		0x0d, 0x27, 0xba, 0x0b, 0x65, 0x69, 0x38, 0x43, 0x4e, 0x33, 0x67, 0xd8, 0x88, 0x62, 0xcc, 0x38,
-		0xb8, 0xb6, 0x05, 0x0b, 0x05, 0xfc, 0x62, 0xdf, 0x2e, 0x53, 0xbb, 0x0f, 0x57, 0x33, 0x4d, 0x5e,
-		0x46, 0x85, 0x6a, 0x04, 0x6e, 0xe6, 0xf6, 0x64, 0x72, 0xc1, 0x36, 0x15, 0x98, 0x39, 0x41, 0xae,
-		0x2d, 0x8d, 0x83, 0x6b, 0x7f, 0x1b, 0x70, 0xef, 0xac, 0x1e, 0xaa, 0xa3, 0xf7, 0x19, 0x27, 0x7a,
-		0xdf, 0x4b, 0x40, 0xa7, 0xd5, 0x51, 0x1f, 0xac, 0xf9, 0x54, 0x5e, 0xa7, 0x66, 0x33, 0xc7, 0x8f,
-		0xbb, 0x87, 0xd0, 0x14, 0x94, 0xa5, 0xd7, 0xe0, 0x9e, 0xa3, 0x7a, 0xed, 0xa8, 0x19, 0x3f, 0xa2,
-		0x3a, 0x4c, 0x74, 0x59, 0x09, 0xcf, 0x75, 0x5a, 0xaa, 0xed, 0x56, 0xcc, 0x71, 0xd2, 0x29, 0xf3,
-		0xcf, 0x5d, 0xa7, 0x55, 0xfb, 0xc9, 0x80, 0x6b, 0xd9, 0x16, 0x4c, 0x96, 0x56, 0x7b, 0x3b, 0x17,
-		0x37, 0x69, 0x5c, 0xda, 0x68, 0x68, 0x0b, 0x37, 0x69, 0xe7, 0x8e, 0x0f, 0x14, 0xd8, 0xf1, 0x8e,
-		0xfe, 0x30, 0x98, 0xbb, 0x3f, 0xd4, 0xfe, 0x2a, 0xc3, 0x52, 0x51, 0x6f, 0x26, 0x25, 0x2e, 0xd9,
-		0x0f, 0x25, 0x71, 0x46, 0x86, 0xc4, 0xc5, 0x80, 0x91, 0xc4, 0x1d, 0x77, 0x3c, 0x9d, 0x94, 0xb2,
-		0x81, 0x33, 0x4a, 0xd9, 0x60, 0x7e, 0x29, 0xc3, 0x30, 0xd7, 0xf6, 0x54, 0x3d, 0x84, 0x62, 0xa8,
-		0x5f, 0x97, 0x9a, 0x49, 0x20, 0x5e, 0xa4, 0x28, 0xc6, 0x6b, 0xb8, 0xa2, 0x96, 0xd4, 0x03, 0x7d,
-		0xb8, 0x1f, 0xfa, 0x25, 0x99, 0x9d, 0x06, 0xfc, 0x1c, 0x26, 0x77, 0x31, 0x39, 0xf4, 0xf6, 0xf6,
-		0x34, 0x36, 0x73, 0x05, 0xe5, 0x47, 0xd8, 0xe9, 0xaf, 0xc1, 0x17, 0x74, 0xa2, 0x82, 0xdd, 0xd4,
-		0x69, 0xa7, 0x34, 0xa9, 0x7c, 0x16, 0x4d, 0xda, 0x84, 0x2a, 0x73, 0x99, 0x60, 0x58, 0x78, 0x5c,
-		0x69, 0xec, 0xb9, 0x95, 0x85, 0xfe, 0xfe, 0x7f, 0x33, 0x4e, 0x31, 0xdb, 0xd9, 0x9d, 0x9d, 0xb5,
-		0x5a, 0xa0, 0xb3, 0x22, 0x13, 0x26, 0x1d, 0x2c, 0xbf, 0x01, 0x23, 0x99, 0x90, 0xa5, 0xd5, 0x12,
-		0x00, 0x39, 0x4e, 0xc6, 0x05, 0x99, 0xbb, 0x9e, 0xa4, 0x9a, 0x2a, 0x13, 0xfd, 0x0f, 0xc6, 0x08,
-		0x97, 0x67, 0x44, 0xdb, 0x0c, 0x25, 0xd8, 0x55, 0x73, 0x54, 0x0e, 0xc6, 0x3e, 0xf1, 0x6c, 0x7a,
-		0xbc, 0x08, 0x43, 0x4d, 0xda, 0xf4, 0xb4, 0x01, 0x9e, 0x4e, 0x4d, 0x79, 0x46, 0x9b, 0x9e, 0xa9,
-		0xc2, 0x90, 0x09, 0xe3, 0xa7, 0x0c, 0xf5, 0xd4, 0x39, 0x95, 0xfb, 0xff, 0x74, 0xe7, 0xdf, 0x65,
-		0x7d, 0xcd, 0xf3, 0x41, 0xd7, 0x48, 0xed, 0xcf, 0x32, 0x2c, 0x16, 0xfa, 0xac, 0xe9, 0xd9, 0x8e,
-		0x67, 0x61, 0x24, 0xe9, 0x03, 0xcc, 0x56, 0x37, 0xb8, 0x6a, 0x42, 0x3c, 0x14, 0x79, 0xe1, 0x93,
-		0x8d, 0x62, 0xf0, 0x1d, 0x34, 0x8a, 0xf7, 0xe0, 0x79, 0xf3, 0x34, 0x8a, 0xd2, 0xbf, 0xda, 0x28,
-		0xca, 0x67, 0x6e, 0x14, 0xaf, 0x60, 0xc2, 0xc7, 0x9c, 0xba, 0x42, 0x23, 0xea, 0xeb, 0x1d, 0x5d,
-		0xce, 0xf9, 0x1e, 0xab, 0x97, 0xf1, 0x0a, 0x45, 0x5f, 0xf2, 0x71, 0xbf, 0x7b, 0xa8, 0x53, 0x24,
-		0xab, 0x27, 0x45, 0x92, 0xc0, 0x54, 0xc7, 0x31, 0xb0, 0x38, 0x0d, 0xdb, 0xd3, 0x82, 0x9a, 0xf6,
-		0x56, 0x66, 0xc1, 0x37, 0x6d, 0x53, 0xa6, 0xe8, 0xa9, 0x2f, 0x1e, 0xa7, 0x0d, 0xbf, 0x1b, 0x0b,
-		0x7d, 0xea, 0x5e, 0x8f, 0x66, 0xde, 0xeb, 0xb1, 0xe2, 0xf7, 0xfa, 0xdc, 0x5b, 0xdc, 0xeb, 0xff,
-		0xbe, 0xdd, 0xbd, 0xfe, 0x7d, 0x00, 0x96, 0x0b, 0x7f, 0xf8, 0xbf, 0x6f, 0xab, 0x35, 0x0b, 0x23,
-		0xfa, 0xff, 0x1d, 0xca, 0xfd, 0x44, 0x9f, 0xb6, 0x10, 0x0d, 0x29, 0xf7, 0x93, 0x5c, 0xd7, 0xa1,
-		0xfc, 0xd7, 0xb5, 0xe3, 0x68, 0x0e, 0xe7, 0xf2, 0x6f, 0xa5, 0x5e, 0xfe, 0xed, 0x4b, 0x03, 0x96,
-		0x8a, 0xfe, 0xff, 0x21, 0xbd, 0x98, 0xc6, 0x5b, 0x15, 0x73, 0xed, 0x15, 0x5c, 0x22, 0x5e, 0x33,
-		0x2d, 0x7b, 0xad, 0xb2, 0xea, 0xb3, 0x6d, 0xd9, 0x0f, 0xb6, 0x8d, 0x4f, 0x1a, 0xfb, 0x4c, 0x1c,
-		0x84, 0xbb, 0x75, 0xe2, 0x35, 0x1b, 0x27, 0x7e, 0x72, 0xa9, 0xef, 0x53, 0x37, 0xfa, 0x8d, 0x47,
-		0xff, 0xfa, 0xf2, 0x00, 0xfb, 0xec, 0x68, 0x79, 0xb7, 0xa4, 0xc6, 0x6e, 0xff, 0x13, 0x00, 0x00,
-		0xff, 0xff, 0xbc, 0x58, 0xcc, 0x6b, 0x40, 0x1a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x4f, 0xdc, 0xc6,
+		0x1b, 0xff, 0x9b, 0x97, 0x7d, 0x79, 0x76, 0x49, 0xc2, 0x90, 0x10, 0x48, 0x48, 0x20, 0xfb, 0x57,
+		0xc9, 0x0b, 0x62, 0x17, 0x48, 0x1a, 0x45, 0x49, 0x15, 0x15, 0x48, 0x50, 0x50, 0x13, 0x82, 0x1c,
+		0x92, 0x48, 0xbd, 0x58, 0xc3, 0x78, 0x16, 0x46, 0x78, 0x3d, 0xdb, 0xf1, 0x18, 0xb2, 0x95, 0x2a,
+		0xf5, 0xd4, 0xf6, 0x03, 0xf4, 0x52, 0xa9, 0xa7, 0x9e, 0xda, 0x4b, 0x7b, 0x6d, 0xd5, 0x53, 0xef,
+		0xbd, 0xf4, 0x9b, 0x54, 0xea, 0x17, 0xa8, 0x3c, 0x1e, 0x7b, 0x97, 0xc5, 0xbb, 0x6b, 0x93, 0x34,
+		0x37, 0x3c, 0x7e, 0x9e, 0xdf, 0xfc, 0x66, 0x9e, 0xb7, 0x9f, 0x59, 0xa8, 0xf8, 0xbb, 0x54, 0xd4,
+		0x08, 0xb6, 0xa9, 0x4b, 0x68, 0x0d, 0x37, 0x59, 0xed, 0x70, 0xb9, 0x66, 0x53, 0xc2, 0x3c, 0xc6,
+		0xdd, 0x6a, 0x53, 0x70, 0xc9, 0xd1, 0x44, 0x60, 0x53, 0xd5, 0x36, 0x55, 0xdc, 0x64, 0xd5, 0xc3,
+		0xe5, 0x4b, 0x57, 0xf7, 0x38, 0xdf, 0x73, 0x68, 0x4d, 0x99, 0xec, 0xfa, 0xf5, 0x9a, 0xed, 0x0b,
+		0x2c, 0x63, 0xa7, 0x4b, 0x73, 0x49, 0xc0, 0x84, 0x37, 0x1a, 0xb1, 0x45, 0xe2, 0xd6, 0x12, 0x7b,
+		0x07, 0x0e, 0xf3, 0x64, 0x3f, 0x9b, 0x23, 0x2e, 0x0e, 0xea, 0x0e, 0x3f, 0x0a, 0x6d, 0x2a, 0xdf,
+		0x8c, 0x43, 0xe1, 0x91, 0x66, 0x8c, 0xbe, 0x33, 0xe0, 0x96, 0x47, 0xf6, 0xa9, 0xed, 0x3b, 0xd4,
+		0xc2, 0x44, 0xb2, 0x43, 0x26, 0x5b, 0x56, 0x80, 0x6a, 0x45, 0xa7, 0xb2, 0xb0, 0x94, 0x82, 0xed,
+		0xfa, 0x92, 0x7a, 0x53, 0xc6, 0x9c, 0x71, 0xa3, 0xb4, 0xf2, 0xa0, 0x9a, 0x70, 0xc2, 0xea, 0x0b,
+		0x0d, 0xb3, 0xaa, 0x51, 0x76, 0xb0, 0x77, 0x10, 0xed, 0xb3, 0x1a, 0x43, 0x3c, 0xf9, 0x9f, 0x39,
+		0xef, 0xa5, 0xb2, 0x44, 0x9f, 0xc3, 0xac, 0x27, 0xb1, 0x90, 0x96, 0x64, 0x0d, 0x2a, 0x12, 0xf9,
+		0x0c, 0x29, 0x3e, 0xcb, 0xc9, 0x7c, 0x02, 0xdf, 0x9d, 0xc0, 0x35, 0x91, 0xc5, 0x8c, 0xd7, 0xe7,
+		0x3d, 0xfa, 0xd1, 0x80, 0xe0, 0xf6, 0x9b, 0x0e, 0x95, 0xd4, 0x8a, 0x2e, 0xd0, 0xa2, 0x6f, 0x28,
+		0xf1, 0x83, 0xa0, 0x25, 0x92, 0x19, 0x56, 0x64, 0x3e, 0x4e, 0x24, 0xb3, 0xae, 0xb1, 0x5e, 0x6b,
+		0xa8, 0xc7, 0x11, 0x52, 0x22, 0xb7, 0x05, 0x92, 0xde, 0x1c, 0x7d, 0x6f, 0xc0, 0x42, 0x1d, 0x33,
+		0x27, 0x2d, 0xcd, 0x11, 0x45, 0xf3, 0xa3, 0x44, 0x9a, 0x1b, 0x98, 0x39, 0xe9, 0x28, 0x5e, 0xaf,
+		0xa7, 0x33, 0x45, 0x3f, 0x19, 0xb0, 0x24, 0xe8, 0x67, 0x3e, 0xf5, 0xa4, 0x45, 0xb0, 0x4b, 0xa8,
+		0x93, 0x22, 0xcf, 0x46, 0xfb, 0x5c, 0xa5, 0x19, 0x82, 0xad, 0x2b, 0xac, 0x81, 0xc9, 0xb6, 0x20,
+		0xd2, 0x9b, 0xa3, 0x2f, 0x60, 0x4e, 0x53, 0xec, 0x9d, 0x72, 0x39, 0x45, 0x6d, 0x25, 0x39, 0xca,
+		0xca, 0xb9, 0x77, 0xce, 0x5d, 0x21, 0xfd, 0x0c, 0xd0, 0x0f, 0x06, 0x2c, 0xea, 0xfd, 0x53, 0xc6,
+		0x32, 0xaf, 0xc8, 0x3c, 0xec, 0x43, 0x26, 0x5d, 0x34, 0x6f, 0x92, 0xb4, 0xc6, 0xe8, 0x2f, 0x03,
+		0x1e, 0x76, 0xc5, 0x93, 0xbe, 0x91, 0x54, 0xb8, 0x38, 0x35, 0xeb, 0x82, 0x62, 0xfd, 0x6c, 0x70,
+		0x74, 0x1f, 0x6b, 0xe0, 0x74, 0x87, 0xb8, 0x27, 0x4e, 0xe9, 0x8b, 0xbe, 0x34, 0xe0, 0x9a, 0xa0,
+		0x84, 0x0b, 0xdb, 0x6a, 0x60, 0x71, 0xd0, 0x23, 0xf2, 0x45, 0x45, 0xfb, 0x76, 0x0f, 0xda, 0x81,
+		0xf7, 0x33, 0xe5, 0x9c, 0x48, 0xee, 0xaa, 0xe8, 0x6b, 0x81, 0x7e, 0x33, 0xe0, 0x2e, 0xe1, 0xae,
+		0x64, 0xae, 0x4f, 0x2d, 0xec, 0x59, 0x2e, 0x3d, 0x4a, 0x7b, 0x9d, 0xa0, 0x78, 0x3d, 0xee, 0xd1,
+		0x77, 0x42, 0xc8, 0x55, 0x6f, 0x8b, 0x1e, 0xa5, 0xbb, 0xc6, 0x25, 0x92, 0xd1, 0x07, 0xfd, 0x62,
+		0xc0, 0x4a, 0xd8, 0xa9, 0xc9, 0x3e, 0x73, 0xec, 0xb4, 0xbc, 0x4b, 0x8a, 0xf7, 0x5a, 0xef, 0xe6,
+		0xbd, 0x1e, 0xa0, 0xa5, 0x23, 0xbd, 0xe8, 0x65, 0x71, 0x40, 0xbf, 0x1b, 0x70, 0xd7, 0x63, 0x7b,
+		0x2e, 0xce, 0x9e, 0xbc, 0x65, 0xc5, 0x7a, 0x23, 0x99, 0xb5, 0x82, 0xcc, 0x96, 0xb5, 0xcb, 0x5e,
+		0x56, 0x27, 0xf4, 0xab, 0x01, 0x1f, 0xfa, 0x4d, 0x8f, 0x0a, 0xd9, 0x26, 0xed, 0x51, 0x2c, 0xc8,
+		0x7e, 0x07, 0xd1, 0x44, 0xf2, 0x63, 0x7d, 0x52, 0xe5, 0xa5, 0x42, 0x8c, 0xf6, 0x7f, 0xa1, 0xf0,
+		0xda, 0x9b, 0x26, 0xa7, 0x8a, 0x9f, 0xd1, 0x67, 0xad, 0x0c, 0xd0, 0xa6, 0x53, 0xf9, 0x33, 0x07,
+		0xf3, 0xe9, 0x64, 0x03, 0x9a, 0x85, 0x52, 0x3c, 0x36, 0x98, 0xad, 0x84, 0x48, 0xd1, 0x84, 0x68,
+		0x69, 0xd3, 0x46, 0x1b, 0x30, 0x16, 0x1b, 0xc8, 0x56, 0x93, 0x6a, 0x6d, 0x70, 0x2d, 0xf1, 0xac,
+		0xf1, 0x66, 0xad, 0x26, 0x35, 0xcb, 0xb8, 0xe3, 0x09, 0x4d, 0x42, 0xce, 0xe6, 0x0d, 0xcc, 0x5c,
+		0x35, 0xcf, 0x8b, 0xa6, 0x7e, 0x42, 0xf7, 0xa1, 0xa8, 0xc6, 0x55, 0xa0, 0xb6, 0xf4, 0x0c, 0xbd,
+		0x92, 0x88, 0x1d, 0x1c, 0xe0, 0x29, 0xf3, 0xa4, 0x59, 0x90, 0xfa, 0x2f, 0xb4, 0x02, 0xa3, 0xcc,
+		0x6d, 0xfa, 0x52, 0xcf, 0xb5, 0x99, 0x44, 0xbf, 0x6d, 0xdc, 0x72, 0x38, 0xb6, 0xcd, 0xd0, 0x14,
+		0xed, 0xc0, 0x74, 0x2c, 0xcc, 0x24, 0xb7, 0x88, 0xc3, 0x3d, 0xaa, 0xc6, 0x12, 0xf7, 0xa5, 0x1e,
+		0x42, 0xd3, 0xd5, 0x50, 0x54, 0x56, 0x23, 0x51, 0x59, 0x7d, 0xa4, 0x45, 0xa5, 0x39, 0x19, 0xf9,
+		0xee, 0xf0, 0xf5, 0xc0, 0x73, 0x27, 0x74, 0xec, 0x46, 0x6d, 0xeb, 0xab, 0x00, 0x35, 0x9f, 0x01,
+		0x35, 0x56, 0x57, 0x01, 0xea, 0x16, 0x4c, 0x6a, 0xa4, 0x6e, 0xa2, 0x85, 0x41, 0x90, 0x13, 0xa1,
+		0x0c, 0x3b, 0xce, 0x72, 0x03, 0xc6, 0xf7, 0x29, 0x16, 0x72, 0x97, 0xe2, 0x36, 0xbb, 0xe2, 0x20,
+		0xa8, 0x73, 0xb1, 0x4f, 0x84, 0xb3, 0x0e, 0x65, 0x41, 0xa5, 0x68, 0x59, 0x4d, 0xee, 0x30, 0xd2,
+		0xd2, 0x1d, 0x67, 0xae, 0x47, 0x07, 0x97, 0xa2, 0xb5, 0xad, 0xec, 0xcc, 0x92, 0x68, 0x3f, 0xa0,
+		0xdb, 0x90, 0xdb, 0xa7, 0xd8, 0xa6, 0x42, 0x97, 0xfe, 0xe5, 0x44, 0xf7, 0x27, 0xca, 0xc4, 0xd4,
+		0xa6, 0xe8, 0x0e, 0x4c, 0x46, 0x43, 0xd2, 0xe1, 0x04, 0x3b, 0x96, 0xcd, 0xbc, 0x26, 0x96, 0x64,
+		0x5f, 0x95, 0x60, 0xc1, 0x3c, 0xaf, 0xdf, 0x3e, 0x0d, 0x5e, 0x3e, 0xd2, 0xef, 0xd0, 0x25, 0x28,
+		0x34, 0x05, 0xe3, 0x82, 0xc9, 0xd6, 0xd4, 0x99, 0x39, 0xe3, 0xc6, 0xa8, 0x19, 0x3f, 0xa3, 0x6b,
+		0x50, 0xae, 0x63, 0x26, 0x5c, 0xea, 0x79, 0xd6, 0x01, 0x6d, 0x4d, 0x9d, 0x55, 0xd9, 0x59, 0x8a,
+		0xd6, 0x3e, 0xa1, 0xad, 0xca, 0xd7, 0x06, 0xcc, 0xf4, 0x53, 0xbd, 0x68, 0x1a, 0x0a, 0xa1, 0xb0,
+		0x89, 0x2b, 0x28, 0xaf, 0x9e, 0x37, 0x6d, 0xf4, 0x14, 0x2e, 0xc4, 0x21, 0xac, 0x33, 0xd1, 0x8e,
+		0xe0, 0xd0, 0xa0, 0x6b, 0x47, 0x3a, 0x82, 0x1b, 0x4c, 0x44, 0x01, 0xac, 0x10, 0x58, 0xc8, 0xa0,
+		0x78, 0xd1, 0x1d, 0xc8, 0x09, 0xea, 0xf9, 0x8e, 0x9c, 0x32, 0x52, 0x14, 0x88, 0xb6, 0xad, 0x60,
+		0xb8, 0x9e, 0x52, 0xaf, 0xa2, 0xbb, 0x90, 0x0f, 0xf4, 0xaa, 0x2f, 0x68, 0xdf, 0x1d, 0x36, 0x42,
+		0x1b, 0x33, 0x32, 0xae, 0x6c, 0xc1, 0x42, 0x06, 0xb9, 0x39, 0xb0, 0x49, 0x55, 0xee, 0xc3, 0x95,
+		0xbe, 0x1a, 0xb1, 0x4f, 0x84, 0x2a, 0x04, 0x6e, 0xa6, 0x96, 0x74, 0xc1, 0x81, 0x6d, 0x2a, 0x31,
+		0x73, 0xbc, 0x54, 0x57, 0x1a, 0x19, 0x57, 0xfe, 0x31, 0xe0, 0xde, 0x69, 0x25, 0x58, 0x47, 0xeb,
+		0x34, 0x8e, 0xb5, 0xce, 0x97, 0x80, 0x4e, 0x0e, 0x57, 0x9d, 0x58, 0xf3, 0x89, 0xbc, 0x4e, 0xec,
+		0x66, 0x8e, 0x1f, 0x75, 0x2f, 0xa1, 0x29, 0xc8, 0x13, 0xee, 0x4a, 0xc1, 0x1d, 0xd5, 0xaa, 0xcb,
+		0x66, 0xf4, 0x88, 0xaa, 0x30, 0xd1, 0xa5, 0x44, 0xb8, 0xeb, 0xb4, 0x54, 0xd7, 0x2e, 0x98, 0xe3,
+		0xa4, 0x53, 0x25, 0x3c, 0x77, 0x9d, 0x56, 0xe5, 0x67, 0x03, 0xae, 0xf6, 0x57, 0x70, 0x41, 0x68,
+		0xb5, 0x34, 0x74, 0x71, 0x83, 0x46, 0xa1, 0x0d, 0x97, 0xb6, 0x70, 0x83, 0x76, 0xde, 0xf8, 0x50,
+		0x86, 0x1b, 0xef, 0x68, 0x2f, 0xc3, 0xa9, 0xdb, 0x4b, 0xe5, 0xef, 0x3c, 0x2c, 0x65, 0x95, 0x76,
+		0xc1, 0x84, 0x8c, 0xef, 0x43, 0x4d, 0x48, 0xa3, 0xcf, 0x84, 0x8c, 0x00, 0xc3, 0x09, 0x79, 0xd4,
+		0xf1, 0x74, 0x7c, 0x12, 0x0e, 0x9d, 0x72, 0x12, 0x0e, 0xa7, 0x9f, 0x84, 0x18, 0xe6, 0xda, 0x92,
+		0xac, 0xc7, 0x9c, 0x19, 0x19, 0xd4, 0xa5, 0x66, 0x62, 0x88, 0x17, 0x09, 0x03, 0xe7, 0x35, 0x5c,
+		0x56, 0x47, 0xea, 0x81, 0x3e, 0x3a, 0x08, 0xfd, 0x62, 0xe0, 0x9d, 0x04, 0xfc, 0x1c, 0x26, 0x77,
+		0x31, 0x39, 0xe0, 0xf5, 0xba, 0xc6, 0x66, 0xae, 0xa4, 0xe2, 0x10, 0x3b, 0x83, 0x47, 0xf8, 0x79,
+		0xed, 0xa8, 0x60, 0x37, 0xb5, 0xdb, 0x89, 0x91, 0x96, 0x3f, 0xcd, 0x48, 0xdb, 0x84, 0x22, 0x73,
+		0x99, 0x64, 0x58, 0x72, 0xa1, 0x46, 0xf4, 0x99, 0x95, 0x85, 0xc1, 0x9f, 0x0f, 0x9b, 0x91, 0x8b,
+		0xd9, 0xf6, 0xee, 0xec, 0xac, 0xc5, 0x0c, 0x9d, 0x15, 0x99, 0x30, 0xe9, 0xe0, 0xe0, 0x13, 0x32,
+		0x1c, 0x13, 0x41, 0x68, 0xf5, 0x08, 0x80, 0x14, 0x99, 0x71, 0x3e, 0xf0, 0x5d, 0x8f, 0x5d, 0x4d,
+		0xe5, 0x89, 0xfe, 0x0f, 0x63, 0x44, 0x04, 0x39, 0xa2, 0x55, 0x8a, 0x9a, 0xf7, 0x45, 0xb3, 0x1c,
+		0x2c, 0x46, 0x32, 0xf3, 0x74, 0xe3, 0x7c, 0x11, 0x46, 0x1a, 0xb4, 0xc1, 0xb5, 0x7e, 0x9e, 0x4e,
+		0x74, 0x79, 0x46, 0x1b, 0xdc, 0x54, 0x66, 0xc8, 0x84, 0xf1, 0x13, 0x7a, 0x5c, 0x0d, 0xf4, 0xd2,
+		0xca, 0x07, 0xc9, 0x1f, 0x0e, 0x5d, 0xca, 0xd9, 0x3c, 0xe7, 0x75, 0xad, 0x54, 0xbe, 0x2d, 0xc0,
+		0x62, 0xa6, 0xaf, 0xa2, 0x9e, 0xed, 0x78, 0x16, 0x4a, 0x71, 0x1f, 0x60, 0xb6, 0xaa, 0xe0, 0xa2,
+		0x09, 0xd1, 0x52, 0x28, 0xa5, 0x8f, 0x37, 0x8a, 0xe1, 0x77, 0xd0, 0x28, 0xde, 0x83, 0x64, 0x4e,
+		0xd3, 0x28, 0x72, 0xff, 0x69, 0xa3, 0xc8, 0x9f, 0xba, 0x51, 0xbc, 0x82, 0x89, 0x26, 0x16, 0xd4,
+		0x95, 0x1a, 0x51, 0x97, 0x77, 0x58, 0x9c, 0xf3, 0x3d, 0x4e, 0x1f, 0xd8, 0x2b, 0x14, 0x5d, 0xe4,
+		0xe3, 0xcd, 0xee, 0xa5, 0xce, 0x21, 0x59, 0x3c, 0x3e, 0x24, 0x09, 0x4c, 0x75, 0xa4, 0x81, 0x25,
+		0xa8, 0xdf, 0xde, 0x16, 0xd4, 0xb6, 0xb7, 0xfa, 0x06, 0x7c, 0xd3, 0x36, 0xa9, 0x1f, 0xed, 0x63,
+		0x5e, 0x38, 0x4a, 0x5a, 0x7e, 0x37, 0x0a, 0xfc, 0x44, 0x5d, 0x97, 0xfb, 0xd6, 0xf5, 0x58, 0xf6,
+		0xba, 0x3e, 0xf3, 0x16, 0x75, 0x7d, 0xf6, 0xad, 0xea, 0xfa, 0x98, 0xe6, 0x3f, 0x37, 0x40, 0xf3,
+		0x8f, 0x9f, 0xd4, 0xfc, 0x7f, 0x0c, 0xc1, 0x72, 0xe6, 0x7f, 0x3b, 0xbc, 0x6f, 0xa5, 0x36, 0x0b,
+		0x25, 0xfd, 0xdf, 0x16, 0x25, 0x9e, 0xc2, 0x0f, 0x6b, 0x08, 0x97, 0x94, 0x78, 0x8a, 0xab, 0x7d,
+		0x24, 0x7d, 0xb5, 0x77, 0x64, 0xf6, 0x68, 0x2a, 0xf9, 0x97, 0xeb, 0x25, 0xff, 0xbe, 0x32, 0x60,
+		0x29, 0xeb, 0x7f, 0x3f, 0x92, 0x73, 0xc1, 0x78, 0xab, 0x5c, 0x58, 0x7b, 0x05, 0x17, 0x09, 0x6f,
+		0x24, 0x79, 0xaf, 0x15, 0x56, 0x9b, 0x6c, 0x5b, 0x70, 0xc9, 0xb7, 0x8d, 0x4f, 0x6b, 0x7b, 0x4c,
+		0xee, 0xfb, 0xbb, 0x55, 0xc2, 0x1b, 0xb5, 0x63, 0x3f, 0xf8, 0x54, 0xf7, 0xa8, 0x1b, 0xfe, 0xc2,
+		0xa4, 0x7f, 0xfb, 0x79, 0x80, 0x9b, 0xec, 0x70, 0x79, 0x37, 0xa7, 0xd6, 0x6e, 0xff, 0x3b, 0x00,
+		0x9b, 0x11, 0x48, 0x62, 0xbe, 0x1a, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -197,53 +200,58 @@ var yarpcFileDescriptorClosurefb529b236ea74dc2 = [][]byte{
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x73, 0x98, 0x25, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xcb, 0x74, 0x51,
-		0x04, 0xc5, 0x20, 0x21, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0x89, 0x83, 0x55, 0x88, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0xbb, 0xe1, 0x28, 0xf1, 0xd4, 0x21, 0xf4, 0x43, 0x81, 0xa4, 0x92, 0xf8, 0x45, 0xf6,
-		0x30, 0x7b, 0xa2, 0x3d, 0xc6, 0x40, 0x4a, 0xf6, 0xdc, 0xc4, 0xeb, 0x1d, 0x79, 0xbe, 0xf3, 0x9d,
-		0x9f, 0x8f, 0xe7, 0x10, 0x79, 0x4d, 0x0a, 0x32, 0xc8, 0x28, 0x83, 0x2a, 0x83, 0x80, 0xd6, 0x3c,
-		0xb8, 0x3d, 0x0e, 0x34, 0x55, 0x79, 0xc1, 0x95, 0xf6, 0x6b, 0x29, 0xb4, 0xc0, 0xdf, 0x18, 0x1f,
-		0xbf, 0xf3, 0xf1, 0x69, 0xcd, 0xfd, 0xdb, 0xe3, 0xe1, 0xf7, 0x73, 0x21, 0xe6, 0x05, 0x04, 0xd6,
-		0x25, 0x6d, 0x3e, 0x06, 0xac, 0x91, 0x54, 0x73, 0x51, 0xb5, 0xa4, 0xe1, 0x0f, 0x0f, 0x71, 0xcd,
-		0x4b, 0x50, 0x9a, 0x96, 0x75, 0xe7, 0xf0, 0x28, 0xc0, 0x9d, 0xa4, 0x75, 0x0d, 0x52, 0xb5, 0xb8,
-		0xf7, 0x01, 0xf5, 0x13, 0xaa, 0xf2, 0x09, 0x57, 0x1a, 0x63, 0xb4, 0x5d, 0xd1, 0x12, 0x0e, 0x9c,
-		0x43, 0xe7, 0x68, 0x27, 0xb2, 0x67, 0xfc, 0x0b, 0xda, 0xce, 0x79, 0xc5, 0x0e, 0xb6, 0x0e, 0x9d,
-		0xa3, 0xfd, 0x93, 0x1f, 0xfd, 0x0d, 0x45, 0xfa, 0xcb, 0x00, 0x17, 0xbc, 0x62, 0x91, 0x75, 0xf7,
-		0x28, 0x72, 0x97, 0xd6, 0x4b, 0xd0, 0x94, 0x51, 0x4d, 0xf1, 0x25, 0xfa, 0xb6, 0xa4, 0xf7, 0xc4,
-		0xb4, 0xad, 0x48, 0x0d, 0x92, 0x28, 0xc8, 0x44, 0xc5, 0x6c, 0xba, 0xdd, 0x93, 0xef, 0xfc, 0xb6,
-		0x52, 0x7f, 0x59, 0xa9, 0x3f, 0x16, 0x4d, 0x5a, 0xc0, 0x15, 0x2d, 0x1a, 0x88, 0xbe, 0x2e, 0xe9,
-		0xbd, 0x09, 0xa8, 0x66, 0x20, 0x63, 0x4b, 0xf3, 0x3e, 0xa0, 0xc1, 0x32, 0xc5, 0x8c, 0x4a, 0xcd,
-		0x8d, 0x2a, 0xab, 0x5c, 0x2e, 0xea, 0xe5, 0xb0, 0xe8, 0x3a, 0x31, 0x47, 0xfc, 0x06, 0x3d, 0x13,
-		0x77, 0x15, 0x48, 0x72, 0x23, 0x94, 0x26, 0xb6, 0xcf, 0x2d, 0x8b, 0xee, 0x59, 0xf3, 0x3b, 0xa1,
-		0xf4, 0x94, 0x96, 0xe0, 0xfd, 0xe3, 0xa0, 0xfd, 0x65, 0xdc, 0x58, 0x53, 0xdd, 0x28, 0xfc, 0x13,
-		0xc2, 0x29, 0xcd, 0xf2, 0x42, 0xcc, 0x49, 0x26, 0x9a, 0x4a, 0x93, 0x1b, 0x5e, 0x69, 0x1b, 0xbb,
-		0x17, 0xb9, 0x1d, 0x72, 0x66, 0x80, 0x77, 0xbc, 0xd2, 0xf8, 0x35, 0x42, 0x12, 0x28, 0x23, 0x05,
-		0xdc, 0x42, 0x61, 0x73, 0xf4, 0xa2, 0x1d, 0x63, 0x99, 0x18, 0x03, 0x7e, 0x85, 0x76, 0x68, 0x96,
-		0x77, 0x68, 0xcf, 0xa2, 0x7d, 0x9a, 0xe5, 0x2d, 0xf8, 0x06, 0x3d, 0x93, 0x54, 0xc3, 0xba, 0x3a,
-		0xdb, 0x87, 0xce, 0x91, 0x13, 0xed, 0x19, 0xf3, 0xaa, 0x77, 0x3c, 0x46, 0x7b, 0x46, 0x46, 0xc2,
-		0x19, 0x49, 0x0b, 0x91, 0xe5, 0x07, 0x4f, 0xac, 0x86, 0x87, 0xff, 0xfb, 0x3c, 0xe1, 0xf8, 0xd4,
-		0xf8, 0x45, 0xbb, 0x86, 0x16, 0x32, 0x7b, 0xf1, 0x7e, 0x43, 0xbb, 0x6b, 0x18, 0x1e, 0xa0, 0xbe,
-		0xd2, 0x54, 0x6a, 0xc2, 0x59, 0xd7, 0xdc, 0x97, 0xf6, 0x1e, 0x32, 0xfc, 0x1c, 0x3d, 0x85, 0x8a,
-		0x19, 0xa0, 0xed, 0xe7, 0x09, 0x54, 0x2c, 0x64, 0xde, 0x5f, 0x0e, 0x42, 0x33, 0x51, 0x14, 0x20,
-		0xc3, 0xea, 0xa3, 0xc0, 0x63, 0xe4, 0x16, 0x54, 0x69, 0x42, 0xb3, 0x0c, 0x94, 0x22, 0x66, 0x14,
-		0xbb, 0xc7, 0x1d, 0x3e, 0x7a, 0xdc, 0x64, 0x39, 0xa7, 0xd1, 0xbe, 0xe1, 0x8c, 0x2c, 0xc5, 0x18,
-		0xf1, 0x10, 0xf5, 0x39, 0x83, 0x4a, 0x73, 0xbd, 0xe8, 0x5e, 0x68, 0x75, 0xdf, 0xa4, 0x4f, 0x6f,
-		0x83, 0x3e, 0xde, 0xdf, 0x0e, 0x1a, 0xc4, 0x9a, 0x67, 0xf9, 0xe2, 0xfc, 0x1e, 0xb2, 0xc6, 0x8c,
-		0xc6, 0x48, 0x6b, 0xc9, 0xd3, 0x46, 0x83, 0xc2, 0xbf, 0x23, 0xf7, 0x4e, 0xc8, 0x1c, 0xa4, 0x9d,
-		0x45, 0x62, 0x76, 0xb0, 0xab, 0xf3, 0xf5, 0x67, 0xe7, 0x3b, 0xda, 0x6f, 0x69, 0xab, 0x85, 0x49,
-		0xd0, 0x40, 0x65, 0x37, 0xc0, 0x9a, 0x02, 0x88, 0x16, 0xa4, 0x55, 0xcf, 0xb4, 0x2d, 0x1a, 0x6d,
-		0x6b, 0xdf, 0x3d, 0x19, 0x3c, 0x1e, 0xeb, 0x6e, 0x83, 0xa3, 0x17, 0x4b, 0x6e, 0x22, 0x62, 0xc3,
-		0x4c, 0x5a, 0xe2, 0xdb, 0x3f, 0xd1, 0x57, 0xeb, 0x1b, 0x85, 0x87, 0xe8, 0x45, 0x32, 0x8a, 0x2f,
-		0xc8, 0x24, 0x8c, 0x13, 0x72, 0x11, 0x4e, 0xc7, 0x24, 0x9c, 0x5e, 0x8d, 0x26, 0xe1, 0xd8, 0xfd,
-		0x02, 0x0f, 0xd0, 0xf3, 0x07, 0xd8, 0xf4, 0x7d, 0x74, 0x39, 0x9a, 0xb8, 0xce, 0x06, 0x28, 0x4e,
-		0xc2, 0xb3, 0x8b, 0x6b, 0x77, 0xeb, 0x2d, 0xfb, 0x2f, 0x43, 0xb2, 0xa8, 0xe1, 0xd3, 0x0c, 0xc9,
-		0xf5, 0xec, 0x7c, 0x2d, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36, 0x3e, 0x3f, 0x0b, 0xe3, 0xf0, 0xfd,
-		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
-		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
-		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x72, 0xe3, 0x34,
+		0x14, 0xc6, 0xc9, 0x76, 0x49, 0x55, 0x9a, 0x7a, 0xb5, 0x3f, 0x6d, 0xb2, 0x2c, 0x84, 0x5c, 0x2c,
+		0x9d, 0x1d, 0xc6, 0xa1, 0x65, 0xb8, 0xe2, 0x82, 0x49, 0x93, 0x0e, 0xeb, 0x69, 0x9a, 0xcd, 0x38,
+		0xde, 0x32, 0xe5, 0x46, 0xc8, 0xd6, 0x69, 0xaa, 0xb1, 0x2d, 0x79, 0x2c, 0xb9, 0x3f, 0x2f, 0xc2,
+		0x13, 0xf0, 0x14, 0x3c, 0x11, 0x8f, 0xc1, 0x48, 0x76, 0x42, 0xb6, 0x0d, 0xdc, 0x49, 0xdf, 0x77,
+		0x3e, 0x9d, 0x1f, 0x9d, 0x73, 0x50, 0xbf, 0x8c, 0xa0, 0x18, 0xc4, 0x94, 0x81, 0x88, 0x61, 0x40,
+		0x73, 0x3e, 0xb8, 0x39, 0x1a, 0x68, 0xaa, 0x92, 0x94, 0x2b, 0xed, 0xe5, 0x85, 0xd4, 0x12, 0x3f,
+		0x37, 0x36, 0x5e, 0x6d, 0xe3, 0xd1, 0x9c, 0x7b, 0x37, 0x47, 0xdd, 0xaf, 0x16, 0x52, 0x2e, 0x52,
+		0x18, 0x58, 0x93, 0xa8, 0xbc, 0x1a, 0xb0, 0xb2, 0xa0, 0x9a, 0x4b, 0x51, 0x89, 0xba, 0x5f, 0x3f,
+		0xe4, 0x35, 0xcf, 0x40, 0x69, 0x9a, 0xe5, 0xb5, 0xc1, 0xa3, 0x07, 0x6e, 0x0b, 0x9a, 0xe7, 0x50,
+		0xa8, 0x8a, 0xef, 0x7f, 0x44, 0xad, 0x90, 0xaa, 0x64, 0xc2, 0x95, 0xc6, 0x18, 0x3d, 0x11, 0x34,
+		0x83, 0x03, 0xa7, 0xe7, 0x1c, 0x6e, 0x07, 0xf6, 0x8c, 0x7f, 0x44, 0x4f, 0x12, 0x2e, 0xd8, 0x41,
+		0xa3, 0xe7, 0x1c, 0xb6, 0x8f, 0xbf, 0xf1, 0x36, 0x04, 0xe9, 0x2d, 0x1f, 0x38, 0xe3, 0x82, 0x05,
+		0xd6, 0xbc, 0x4f, 0x91, 0xbb, 0x44, 0xcf, 0x41, 0x53, 0x46, 0x35, 0xc5, 0xe7, 0xe8, 0x45, 0x46,
+		0xef, 0x88, 0x49, 0x5b, 0x91, 0x1c, 0x0a, 0xa2, 0x20, 0x96, 0x82, 0x59, 0x77, 0x3b, 0xc7, 0x5f,
+		0x7a, 0x55, 0xa4, 0xde, 0x32, 0x52, 0x6f, 0x2c, 0xcb, 0x28, 0x85, 0x0b, 0x9a, 0x96, 0x10, 0x3c,
+		0xcb, 0xe8, 0x9d, 0x79, 0x50, 0xcd, 0xa0, 0x98, 0x5b, 0x59, 0xff, 0x23, 0xea, 0x2c, 0x5d, 0xcc,
+		0x68, 0xa1, 0xb9, 0xa9, 0xca, 0xca, 0x97, 0x8b, 0x9a, 0x09, 0xdc, 0xd7, 0x99, 0x98, 0x23, 0x7e,
+		0x8b, 0xf6, 0xe4, 0xad, 0x80, 0x82, 0x5c, 0x4b, 0xa5, 0x89, 0xcd, 0xb3, 0x61, 0xd9, 0x5d, 0x0b,
+		0xbf, 0x97, 0x4a, 0x4f, 0x69, 0x06, 0xfd, 0x3f, 0x1d, 0xb4, 0xff, 0xe8, 0xdd, 0x91, 0x14, 0x57,
+		0x7c, 0x81, 0x3d, 0xf4, 0x5c, 0x94, 0x19, 0x29, 0x80, 0x32, 0x92, 0x2f, 0x39, 0x65, 0xbd, 0x6c,
+		0x05, 0xcf, 0x44, 0x99, 0x05, 0x40, 0xd9, 0x4a, 0xa4, 0xf0, 0xf7, 0xe8, 0x85, 0xb1, 0xbf, 0x2d,
+		0xb8, 0x86, 0x75, 0x41, 0xc3, 0x0a, 0xb0, 0x28, 0xb3, 0x5f, 0x0d, 0xb5, 0xa6, 0xf8, 0x16, 0xed,
+		0xa9, 0x98, 0xa6, 0x5c, 0x2c, 0x08, 0x08, 0x1a, 0xa5, 0xc0, 0x0e, 0x9a, 0x3d, 0xe7, 0xb0, 0x15,
+		0xb4, 0x6b, 0xf8, 0xb4, 0x42, 0xfb, 0x7f, 0x3b, 0xa8, 0xbd, 0x0c, 0x73, 0xae, 0xa9, 0x2e, 0x15,
+		0xfe, 0x0e, 0xe1, 0x88, 0xc6, 0x49, 0x2a, 0x17, 0x24, 0x96, 0xa5, 0xd0, 0xe4, 0x9a, 0x0b, 0x6d,
+		0x83, 0x6b, 0x06, 0x6e, 0xcd, 0x8c, 0x0c, 0xf1, 0x9e, 0x0b, 0x8d, 0xdf, 0x20, 0x64, 0xf3, 0x48,
+		0xe1, 0x06, 0x52, 0x1b, 0x51, 0x33, 0xd8, 0x36, 0xc8, 0xc4, 0x00, 0xf8, 0x35, 0xda, 0xa6, 0x71,
+		0x52, 0xb3, 0x4d, 0xcb, 0xb6, 0x68, 0x9c, 0x54, 0xe4, 0x5b, 0xb4, 0x57, 0x50, 0x0d, 0xeb, 0x9f,
+		0xf8, 0xa4, 0xe7, 0x1c, 0x3a, 0xc1, 0xae, 0x81, 0x57, 0x5f, 0x84, 0xc7, 0x68, 0xd7, 0xfc, 0x36,
+		0xe1, 0x8c, 0x44, 0xa9, 0x8c, 0x93, 0x83, 0x2d, 0xfb, 0xd5, 0xbd, 0xff, 0xec, 0x22, 0x7f, 0x7c,
+		0x62, 0xec, 0x82, 0x1d, 0x23, 0xf3, 0x99, 0xbd, 0xf4, 0x7f, 0x46, 0x3b, 0x6b, 0x1c, 0xee, 0xa0,
+		0x96, 0xd2, 0xb4, 0xd0, 0x84, 0xb3, 0x3a, 0xb9, 0xcf, 0xed, 0xdd, 0x67, 0xf8, 0x25, 0x7a, 0x0a,
+		0x82, 0x19, 0xa2, 0xca, 0x67, 0x0b, 0x04, 0xf3, 0x59, 0xff, 0x0f, 0x07, 0xa1, 0x99, 0x4c, 0x53,
+		0x28, 0x7c, 0x71, 0x25, 0xf1, 0x18, 0xb9, 0x29, 0x55, 0x9a, 0xd0, 0x38, 0x06, 0xa5, 0x88, 0x99,
+		0x98, 0xba, 0x07, 0xbb, 0x8f, 0x7a, 0x30, 0x5c, 0x8e, 0x53, 0xd0, 0x36, 0x9a, 0xa1, 0x95, 0x18,
+		0x10, 0x77, 0x51, 0x8b, 0x33, 0x10, 0x9a, 0xeb, 0xfb, 0xba, 0x91, 0x56, 0xf7, 0x4d, 0xf5, 0x69,
+		0x6e, 0xa8, 0x4f, 0xff, 0x2f, 0x07, 0x75, 0xe6, 0x9a, 0xc7, 0xc9, 0xfd, 0xe9, 0x1d, 0xc4, 0xa5,
+		0x69, 0x81, 0xa1, 0xd6, 0x05, 0x8f, 0x4a, 0x0d, 0x0a, 0xff, 0x82, 0xdc, 0x5b, 0x59, 0x24, 0x50,
+		0xd8, 0x91, 0x21, 0x66, 0x55, 0xd4, 0x71, 0xbe, 0xf9, 0xdf, 0x31, 0x0c, 0xda, 0x95, 0x6c, 0x35,
+		0xd7, 0x21, 0xea, 0xa8, 0xf8, 0x1a, 0x58, 0x99, 0x02, 0xd1, 0x92, 0x54, 0xd5, 0x33, 0x69, 0xcb,
+		0x52, 0xdb, 0xd8, 0x77, 0x8e, 0x3b, 0x8f, 0xa7, 0xaf, 0x5e, 0x34, 0xc1, 0xab, 0xa5, 0x36, 0x94,
+		0x73, 0xa3, 0x0c, 0x2b, 0xe1, 0xbb, 0xdf, 0xd1, 0x17, 0xeb, 0x83, 0x8f, 0xbb, 0xe8, 0x55, 0x38,
+		0x9c, 0x9f, 0x91, 0x89, 0x3f, 0x0f, 0xc9, 0x99, 0x3f, 0x1d, 0x13, 0x7f, 0x7a, 0x31, 0x9c, 0xf8,
+		0x63, 0xf7, 0x33, 0xdc, 0x41, 0x2f, 0x1f, 0x70, 0xd3, 0x0f, 0xc1, 0xf9, 0x70, 0xe2, 0x3a, 0x1b,
+		0xa8, 0x79, 0xe8, 0x8f, 0xce, 0x2e, 0xdd, 0xc6, 0x3b, 0xf6, 0xaf, 0x87, 0xf0, 0x3e, 0x87, 0x4f,
+		0x3d, 0x84, 0x97, 0xb3, 0xd3, 0x35, 0x0f, 0xaf, 0xd1, 0xfe, 0x03, 0x6e, 0x7c, 0x3a, 0xf2, 0xe7,
+		0xfe, 0x87, 0xa9, 0xeb, 0x6c, 0x20, 0x87, 0xa3, 0xd0, 0xbf, 0xf0, 0xc3, 0x4b, 0xb7, 0x71, 0x72,
+		0x81, 0xf6, 0x63, 0x99, 0x6d, 0xaa, 0xe8, 0x49, 0x6b, 0x98, 0xf3, 0x99, 0x29, 0xc8, 0xcc, 0xf9,
+		0x6d, 0xb0, 0xe0, 0xfa, 0xba, 0x8c, 0xbc, 0x58, 0x66, 0x83, 0x4f, 0xb6, 0xb9, 0xb7, 0x00, 0x51,
+		0xad, 0xd7, 0x7a, 0xb1, 0xff, 0x44, 0x73, 0x7e, 0x73, 0x14, 0x3d, 0xb5, 0xd8, 0x0f, 0xff, 0x0c,
+		0x00, 0x27, 0xcf, 0x98, 0xcc, 0xfc, 0x05, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -122,6 +122,7 @@ type Domain struct {
 	Clusters                         []*ClusterReplicationConfiguration `protobuf:"bytes,14,rep,name=clusters,proto3" json:"clusters,omitempty"`
 	FailoverVersion                  int64                              `protobuf:"varint,15,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	IsGlobalDomain                   bool                               `protobuf:"varint,16,opt,name=is_global_domain,json=isGlobalDomain,proto3" json:"is_global_domain,omitempty"`
+	DrainedIsolationGroups           []string                           `protobuf:"bytes,17,rep,name=drained_isolation_groups,json=drainedIsolationGroups,proto3" json:"drained_isolation_groups,omitempty"`
 	XXX_NoUnkeyedLiteral             struct{}                           `json:"-"`
 	XXX_unrecognized                 []byte                             `json:"-"`
 	XXX_sizecache                    int32                              `json:"-"`
@@ -272,6 +273,13 @@ func (m *Domain) GetIsGlobalDomain() bool {
 	return false
 }
 
+func (m *Domain) GetDrainedIsolationGroups() []string {
+	if m != nil {
+		return m.DrainedIsolationGroups
+	}
+	return nil
+}
+
 type ClusterReplicationConfiguration struct {
 	ClusterName          string   `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -443,61 +451,63 @@ func init() {
 func init() { proto.RegisterFile("uber/cadence/api/v1/domain.proto", fileDescriptor_824795d6ae7d8e2f) }
 
 var fileDescriptor_824795d6ae7d8e2f = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x18, 0xc5, 0xc9, 0x36, 0xcd, 0x7e, 0xce, 0xa6, 0x61, 0xda, 0x65, 0xdd, 0x80, 0x76, 0xdd, 0xad,
-	0x90, 0x42, 0x2f, 0x1c, 0x36, 0x20, 0x68, 0x41, 0x5c, 0x38, 0xb1, 0x55, 0x82, 0x96, 0x25, 0x72,
-	0xd2, 0xbd, 0x80, 0x0b, 0x6b, 0x6c, 0x4f, 0x92, 0x51, 0x1d, 0x8f, 0x35, 0xfe, 0x59, 0xf6, 0x0e,
-	0xf1, 0x58, 0x3c, 0x01, 0x57, 0x88, 0x47, 0x40, 0xfb, 0x24, 0xc8, 0x63, 0x3b, 0x9b, 0x1f, 0xab,
-	0xed, 0xdd, 0xcc, 0xf7, 0x73, 0xbe, 0xe3, 0xe3, 0x33, 0x33, 0xa0, 0x26, 0x0e, 0xe1, 0x7d, 0x17,
-	0x7b, 0x24, 0x70, 0x49, 0x1f, 0x87, 0xb4, 0x9f, 0x5e, 0xf4, 0x3d, 0xb6, 0xc2, 0x34, 0xd0, 0x42,
-	0xce, 0x62, 0x86, 0x1e, 0x67, 0x15, 0x5a, 0x51, 0xa1, 0xe1, 0x90, 0x6a, 0xe9, 0x45, 0xf7, 0x74,
-	0xc1, 0xd8, 0xc2, 0x27, 0x7d, 0x51, 0xe2, 0x24, 0xf3, 0xbe, 0x97, 0x70, 0x1c, 0x53, 0x56, 0x34,
-	0x75, 0xcf, 0x76, 0xf3, 0x31, 0x5d, 0x91, 0x28, 0xc6, 0xab, 0x30, 0x2f, 0x38, 0xff, 0xe7, 0x21,
-	0x34, 0x0c, 0x31, 0x06, 0xb5, 0xa1, 0x46, 0x3d, 0x45, 0x52, 0xa5, 0xde, 0xa1, 0x55, 0xa3, 0x1e,
-	0x42, 0x70, 0x10, 0xe0, 0x15, 0x51, 0x6a, 0x22, 0x22, 0xd6, 0xe8, 0x15, 0x34, 0xa2, 0x18, 0xc7,
-	0x49, 0xa4, 0xd4, 0x55, 0xa9, 0xd7, 0x1e, 0x3c, 0xd3, 0x2a, 0x58, 0x69, 0x39, 0xe0, 0x54, 0x14,
-	0x5a, 0x45, 0x03, 0x52, 0x41, 0xf6, 0x48, 0xe4, 0x72, 0x1a, 0x66, 0xfc, 0x94, 0x03, 0x81, 0xba,
-	0x19, 0x42, 0x67, 0x20, 0xb3, 0x9b, 0x80, 0x70, 0x9b, 0xac, 0x30, 0xf5, 0x95, 0x07, 0xa2, 0x02,
-	0x44, 0xc8, 0xcc, 0x22, 0xe8, 0x15, 0x1c, 0x78, 0x38, 0xc6, 0x4a, 0x43, 0xad, 0xf7, 0xe4, 0xc1,
-	0xe7, 0xef, 0x98, 0xad, 0x19, 0x38, 0xc6, 0x66, 0x10, 0xf3, 0x5b, 0x4b, 0xb4, 0xa0, 0x25, 0x3c,
-	0xbf, 0x61, 0xfc, 0xed, 0xdc, 0x67, 0x37, 0x36, 0xf9, 0x9d, 0xb8, 0x49, 0x36, 0xd1, 0xe6, 0x24,
-	0x26, 0x81, 0x58, 0x85, 0x84, 0x53, 0xe6, 0x29, 0x0f, 0x55, 0xa9, 0x27, 0x0f, 0x9e, 0x6a, 0xb9,
-	0x6c, 0x5a, 0x29, 0x9b, 0x66, 0x14, 0xb2, 0x5a, 0x6a, 0x89, 0x62, 0x96, 0x20, 0x56, 0x89, 0x31,
-	0x11, 0x10, 0x68, 0x04, 0x2d, 0x07, 0x7b, 0xb6, 0x43, 0x03, 0xcc, 0x29, 0x89, 0x94, 0xa6, 0x80,
-	0x54, 0x2b, 0xc9, 0x0e, 0xb1, 0x37, 0x2c, 0xea, 0x2c, 0xd9, 0xb9, 0xdf, 0xa0, 0xdf, 0xe0, 0x64,
-	0x49, 0xa3, 0x98, 0xf1, 0x5b, 0x1b, 0x73, 0x77, 0x49, 0x53, 0xec, 0xdb, 0x85, 0xf0, 0x87, 0x42,
-	0xf8, 0xe7, 0x95, 0x78, 0x7a, 0x51, 0x5b, 0x48, 0x7f, 0x5c, 0x60, 0x6c, 0x87, 0xd1, 0x97, 0xf0,
-	0x64, 0x0f, 0x3c, 0xe1, 0x54, 0x01, 0x21, 0x38, 0xda, 0x69, 0x7a, 0xc3, 0x29, 0xc2, 0xd0, 0x4d,
-	0x69, 0x44, 0x1d, 0xea, 0xd3, 0x78, 0x9f, 0x91, 0xfc, 0xe1, 0x8c, 0x94, 0x7b, 0x98, 0x1d, 0x52,
-	0xdf, 0xc0, 0x49, 0xd5, 0x88, 0x8c, 0x57, 0x4b, 0xf0, 0x3a, 0xde, 0x6f, 0xcd, 0xa8, 0x69, 0xf0,
-	0x18, 0xbb, 0x31, 0x4d, 0x89, 0xed, 0xfa, 0x49, 0x14, 0x13, 0x6e, 0x0b, 0xd3, 0x1e, 0x89, 0x9e,
-	0x8f, 0xf3, 0xd4, 0x28, 0xcf, 0x5c, 0x65, 0x0e, 0x9e, 0x40, 0xb3, 0x28, 0x8c, 0x94, 0xb6, 0xf0,
-	0xd1, 0xd7, 0x95, 0xc4, 0x8b, 0x1e, 0x8b, 0x84, 0x3e, 0x75, 0xc5, 0xbf, 0x1f, 0xb1, 0x60, 0x4e,
-	0x17, 0xa5, 0x11, 0xd6, 0x28, 0xe8, 0x0b, 0xe8, 0xcc, 0x31, 0xf5, 0x59, 0x4a, 0xb8, 0x9d, 0x12,
-	0x1e, 0x65, 0xee, 0x7e, 0xa4, 0x4a, 0xbd, 0xba, 0xf5, 0xa8, 0x8c, 0x5f, 0xe7, 0x61, 0xd4, 0x83,
-	0x0e, 0x8d, 0xec, 0x85, 0xcf, 0x1c, 0xec, 0xdb, 0xf9, 0xe9, 0x56, 0x3a, 0xaa, 0xd4, 0x6b, 0x5a,
-	0x6d, 0x1a, 0xbd, 0x16, 0xe1, 0xdc, 0xbf, 0xdd, 0x6f, 0xe1, 0x70, 0x6d, 0x61, 0xd4, 0x81, 0xfa,
-	0x5b, 0x72, 0x5b, 0x1c, 0xcd, 0x6c, 0x89, 0x9e, 0xc0, 0x83, 0x14, 0xfb, 0x49, 0x79, 0x38, 0xf3,
-	0xcd, 0x77, 0xb5, 0x97, 0xd2, 0xb9, 0x01, 0x67, 0xef, 0xa1, 0x8e, 0x9e, 0x41, 0x6b, 0x4b, 0xab,
-	0x1c, 0x57, 0x76, 0xef, 0x55, 0x3a, 0xff, 0x4b, 0x02, 0x79, 0xc3, 0x9c, 0xe8, 0x27, 0x68, 0xae,
-	0x0d, 0x2d, 0x09, 0xd5, 0xb4, 0xf7, 0x19, 0x5a, 0x2b, 0x17, 0xf9, 0x31, 0x5c, 0xf7, 0x77, 0x6d,
-	0x38, 0xda, 0x4a, 0x55, 0x7c, 0xde, 0xcb, 0xcd, 0xcf, 0x93, 0x07, 0xe7, 0xef, 0x9c, 0x75, 0x3b,
-	0x0e, 0xe6, 0x6c, 0x53, 0x82, 0x3f, 0x25, 0x38, 0xda, 0x4a, 0xa2, 0x4f, 0xa0, 0xc1, 0x09, 0x8e,
-	0x58, 0x50, 0x0c, 0x29, 0x76, 0xa8, 0x0b, 0x4d, 0x16, 0x12, 0x8e, 0x63, 0xc6, 0x0b, 0x25, 0xd7,
-	0x7b, 0xf4, 0x03, 0xb4, 0x5c, 0x4e, 0x70, 0x4c, 0x3c, 0x3b, 0xbb, 0x34, 0xc5, 0x85, 0x27, 0x0f,
-	0xba, 0x7b, 0x57, 0xc3, 0xac, 0xbc, 0x51, 0x2d, 0xb9, 0xa8, 0xcf, 0x22, 0x2f, 0xfe, 0x90, 0xa0,
-	0xb5, 0x79, 0x0f, 0xa2, 0xa7, 0x70, 0x6c, 0xfc, 0xf2, 0xb3, 0x3e, 0xbe, 0xb2, 0xa7, 0x33, 0x7d,
-	0xf6, 0x66, 0x6a, 0x8f, 0xaf, 0xae, 0xf5, 0xcb, 0xb1, 0xd1, 0xf9, 0x08, 0x7d, 0x06, 0xca, 0x76,
-	0xca, 0x32, 0x5f, 0x8f, 0xa7, 0x33, 0xd3, 0x32, 0x8d, 0x8e, 0xb4, 0x9f, 0x35, 0xcc, 0x89, 0x65,
-	0x8e, 0xf4, 0x99, 0x69, 0x74, 0x6a, 0xfb, 0xb0, 0x86, 0x79, 0x69, 0x66, 0xa9, 0xfa, 0x8b, 0x25,
-	0xb4, 0x77, 0x0e, 0xd9, 0xa7, 0x70, 0xa2, 0x5b, 0xa3, 0x1f, 0xc7, 0xd7, 0xfa, 0x65, 0x25, 0x8b,
-	0xdd, 0xa4, 0x31, 0x9e, 0xea, 0xc3, 0x4b, 0xc1, 0xa2, 0xa2, 0xd5, 0xbc, 0xca, 0x93, 0xb5, 0xa1,
-	0xf3, 0xf7, 0xdd, 0xa9, 0xf4, 0xef, 0xdd, 0xa9, 0xf4, 0xdf, 0xdd, 0xa9, 0x04, 0x27, 0x2e, 0x5b,
-	0x55, 0xfd, 0xb1, 0x61, 0x53, 0x0f, 0xe9, 0x24, 0xd3, 0x6d, 0x22, 0xfd, 0xda, 0x5f, 0xd0, 0x78,
-	0x99, 0x38, 0x9a, 0xcb, 0x56, 0xfd, 0xad, 0xb7, 0x4f, 0x5b, 0x90, 0x20, 0x7f, 0xaf, 0x8a, 0x67,
-	0xf0, 0x7b, 0x1c, 0xd2, 0xf4, 0xc2, 0x69, 0x88, 0xd8, 0x57, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0xb8, 0xf9, 0x4d, 0xfa, 0x2a, 0x07, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0x49, 0xb7, 0xa4, 0xc7, 0x69, 0x36, 0x3b, 0xbb, 0xdd, 0x7a, 0x03, 0x6a, 0xbd, 0x5d,
+	0x21, 0x85, 0xbd, 0x70, 0x68, 0x40, 0xd0, 0x05, 0x71, 0x91, 0xc4, 0x56, 0x09, 0x2a, 0x25, 0x72,
+	0xb2, 0xbd, 0x80, 0x0b, 0x6b, 0x6c, 0x4f, 0x92, 0xd1, 0x3a, 0x1e, 0x6b, 0x6c, 0xa7, 0xf4, 0x0e,
+	0x71, 0xc5, 0x33, 0xf1, 0x04, 0x5c, 0xf2, 0x08, 0xa8, 0x4f, 0x82, 0x3c, 0x1e, 0xa7, 0xf9, 0xb1,
+	0x76, 0xb9, 0xf3, 0x9c, 0xef, 0x9c, 0xef, 0x7c, 0xfe, 0x7c, 0xce, 0x18, 0xf4, 0xd4, 0x25, 0xbc,
+	0xe3, 0x61, 0x9f, 0x84, 0x1e, 0xe9, 0xe0, 0x88, 0x76, 0x96, 0xe7, 0x1d, 0x9f, 0x2d, 0x30, 0x0d,
+	0x8d, 0x88, 0xb3, 0x84, 0xa1, 0xa7, 0x59, 0x86, 0x21, 0x33, 0x0c, 0x1c, 0x51, 0x63, 0x79, 0xde,
+	0x3a, 0x99, 0x31, 0x36, 0x0b, 0x48, 0x47, 0xa4, 0xb8, 0xe9, 0xb4, 0xe3, 0xa7, 0x1c, 0x27, 0x94,
+	0xc9, 0xa2, 0xd6, 0xe9, 0x36, 0x9e, 0xd0, 0x05, 0x89, 0x13, 0xbc, 0x88, 0xf2, 0x84, 0xb3, 0x3f,
+	0x6b, 0xb0, 0x6f, 0x8a, 0x36, 0xa8, 0x01, 0x15, 0xea, 0x6b, 0x8a, 0xae, 0xb4, 0x0f, 0xec, 0x0a,
+	0xf5, 0x11, 0x82, 0xbd, 0x10, 0x2f, 0x88, 0x56, 0x11, 0x11, 0xf1, 0x8c, 0xde, 0xc0, 0x7e, 0x9c,
+	0xe0, 0x24, 0x8d, 0xb5, 0xaa, 0xae, 0xb4, 0x1b, 0xdd, 0x97, 0x46, 0x89, 0x2a, 0x23, 0x27, 0x1c,
+	0x8b, 0x44, 0x5b, 0x16, 0x20, 0x1d, 0x54, 0x9f, 0xc4, 0x1e, 0xa7, 0x51, 0xa6, 0x4f, 0xdb, 0x13,
+	0xac, 0xeb, 0x21, 0x74, 0x0a, 0x2a, 0xbb, 0x0d, 0x09, 0x77, 0xc8, 0x02, 0xd3, 0x40, 0x7b, 0x24,
+	0x32, 0x40, 0x84, 0xac, 0x2c, 0x82, 0xde, 0xc0, 0x9e, 0x8f, 0x13, 0xac, 0xed, 0xeb, 0xd5, 0xb6,
+	0xda, 0xfd, 0xec, 0x3d, 0xbd, 0x0d, 0x13, 0x27, 0xd8, 0x0a, 0x13, 0x7e, 0x67, 0x8b, 0x12, 0x34,
+	0x87, 0x57, 0xb7, 0x8c, 0xbf, 0x9b, 0x06, 0xec, 0xd6, 0x21, 0xbf, 0x11, 0x2f, 0xcd, 0x3a, 0x3a,
+	0x9c, 0x24, 0x24, 0x14, 0x4f, 0x11, 0xe1, 0x94, 0xf9, 0xda, 0xc7, 0xba, 0xd2, 0x56, 0xbb, 0x2f,
+	0x8c, 0xdc, 0x36, 0xa3, 0xb0, 0xcd, 0x30, 0xa5, 0xad, 0xb6, 0x5e, 0xb0, 0x58, 0x05, 0x89, 0x5d,
+	0x70, 0x8c, 0x04, 0x05, 0x1a, 0x40, 0xdd, 0xc5, 0xbe, 0xe3, 0xd2, 0x10, 0x73, 0x4a, 0x62, 0xad,
+	0x26, 0x28, 0xf5, 0x52, 0xb1, 0x7d, 0xec, 0xf7, 0x65, 0x9e, 0xad, 0xba, 0x0f, 0x07, 0xf4, 0x2b,
+	0x1c, 0xcf, 0x69, 0x9c, 0x30, 0x7e, 0xe7, 0x60, 0xee, 0xcd, 0xe9, 0x12, 0x07, 0x8e, 0x34, 0xfe,
+	0x40, 0x18, 0xff, 0xaa, 0x94, 0xaf, 0x27, 0x73, 0xa5, 0xf5, 0x47, 0x92, 0x63, 0x33, 0x8c, 0xbe,
+	0x80, 0x67, 0x3b, 0xe4, 0x29, 0xa7, 0x1a, 0x08, 0xc3, 0xd1, 0x56, 0xd1, 0x5b, 0x4e, 0x11, 0x86,
+	0xd6, 0x92, 0xc6, 0xd4, 0xa5, 0x01, 0x4d, 0x76, 0x15, 0xa9, 0xff, 0x5f, 0x91, 0xf6, 0x40, 0xb3,
+	0x25, 0xea, 0x6b, 0x38, 0x2e, 0x6b, 0x91, 0xe9, 0xaa, 0x0b, 0x5d, 0x47, 0xbb, 0xa5, 0x99, 0x34,
+	0x03, 0x9e, 0x62, 0x2f, 0xa1, 0x4b, 0xe2, 0x78, 0x41, 0x1a, 0x27, 0x84, 0x3b, 0x62, 0x68, 0x0f,
+	0x45, 0xcd, 0x93, 0x1c, 0x1a, 0xe4, 0xc8, 0x75, 0x36, 0xc1, 0x23, 0xa8, 0xc9, 0xc4, 0x58, 0x6b,
+	0x88, 0x39, 0xfa, 0xaa, 0x54, 0xb8, 0xac, 0xb1, 0x49, 0x14, 0x50, 0x4f, 0x7c, 0xfb, 0x01, 0x0b,
+	0xa7, 0x74, 0x56, 0x0c, 0xc2, 0x8a, 0x05, 0x7d, 0x0e, 0xcd, 0x29, 0xa6, 0x01, 0x5b, 0x12, 0xee,
+	0x2c, 0x09, 0x8f, 0xb3, 0xe9, 0x7e, 0xac, 0x2b, 0xed, 0xaa, 0xfd, 0xb8, 0x88, 0xdf, 0xe4, 0x61,
+	0xd4, 0x86, 0x26, 0x8d, 0x9d, 0x59, 0xc0, 0x5c, 0x1c, 0x38, 0xf9, 0x76, 0x6b, 0x4d, 0x5d, 0x69,
+	0xd7, 0xec, 0x06, 0x8d, 0x2f, 0x45, 0x58, 0x2e, 0xe3, 0x05, 0x68, 0x3e, 0xc7, 0x34, 0x24, 0xbe,
+	0x43, 0x63, 0x16, 0x88, 0x9e, 0xce, 0x8c, 0xb3, 0x34, 0x8a, 0xb5, 0x27, 0x7a, 0xb5, 0x7d, 0x60,
+	0x3f, 0x97, 0xf8, 0xb0, 0x80, 0x2f, 0x05, 0xda, 0xfa, 0x06, 0x0e, 0x56, 0xc3, 0x8f, 0x9a, 0x50,
+	0x7d, 0x47, 0xee, 0xe4, 0x52, 0x67, 0x8f, 0xe8, 0x19, 0x3c, 0x5a, 0xe2, 0x20, 0x2d, 0xd6, 0x3a,
+	0x3f, 0x7c, 0x5b, 0xb9, 0x50, 0xce, 0x4c, 0x38, 0xfd, 0xc0, 0x4b, 0xa3, 0x97, 0x50, 0xdf, 0x70,
+	0x39, 0xe7, 0x55, 0xbd, 0x07, 0x7f, 0xcf, 0xfe, 0x52, 0x40, 0x5d, 0x1b, 0x6b, 0xf4, 0x23, 0xd4,
+	0x56, 0xab, 0xa0, 0x08, 0xbf, 0x8d, 0x0f, 0xad, 0x82, 0x51, 0x3c, 0xe4, 0x0b, 0xbc, 0xaa, 0x6f,
+	0x39, 0x70, 0xb8, 0x01, 0x95, 0xbc, 0xde, 0xc5, 0xfa, 0xeb, 0xa9, 0xdd, 0xb3, 0xf7, 0xf6, 0xba,
+	0x1b, 0x86, 0x53, 0xb6, 0x6e, 0xc1, 0x1f, 0x0a, 0x1c, 0x6e, 0x80, 0xe8, 0x39, 0xec, 0x73, 0x82,
+	0x63, 0x16, 0xca, 0x26, 0xf2, 0x84, 0x5a, 0x50, 0x63, 0x11, 0xe1, 0x38, 0x61, 0x5c, 0x3a, 0xb9,
+	0x3a, 0xa3, 0xef, 0xa1, 0xee, 0x71, 0x82, 0x13, 0xe2, 0x3b, 0xd9, 0x75, 0x2b, 0xae, 0x4a, 0xb5,
+	0xdb, 0xda, 0xb9, 0x54, 0x26, 0xc5, 0x5d, 0x6c, 0xab, 0x32, 0x3f, 0x8b, 0xbc, 0xfe, 0x5d, 0x81,
+	0xfa, 0xfa, 0x0d, 0x8a, 0x5e, 0xc0, 0x91, 0xf9, 0xf3, 0x4f, 0xbd, 0xe1, 0xb5, 0x33, 0x9e, 0xf4,
+	0x26, 0x6f, 0xc7, 0xce, 0xf0, 0xfa, 0xa6, 0x77, 0x35, 0x34, 0x9b, 0x1f, 0xa1, 0x4f, 0x41, 0xdb,
+	0x84, 0x6c, 0xeb, 0x72, 0x38, 0x9e, 0x58, 0xb6, 0x65, 0x36, 0x95, 0x5d, 0xd4, 0xb4, 0x46, 0xb6,
+	0x35, 0xe8, 0x4d, 0x2c, 0xb3, 0x59, 0xd9, 0xa5, 0x35, 0xad, 0x2b, 0x2b, 0x83, 0xaa, 0xaf, 0xe7,
+	0xd0, 0xd8, 0x5a, 0xcf, 0x4f, 0xe0, 0xb8, 0x67, 0x0f, 0x7e, 0x18, 0xde, 0xf4, 0xae, 0x4a, 0x55,
+	0x6c, 0x83, 0xe6, 0x70, 0xdc, 0xeb, 0x5f, 0x09, 0x15, 0x25, 0xa5, 0xd6, 0x75, 0x0e, 0x56, 0xfa,
+	0xee, 0xdf, 0xf7, 0x27, 0xca, 0x3f, 0xf7, 0x27, 0xca, 0xbf, 0xf7, 0x27, 0x0a, 0x1c, 0x7b, 0x6c,
+	0x51, 0xf6, 0xc5, 0xfa, 0xb5, 0x5e, 0x44, 0x47, 0x99, 0x6f, 0x23, 0xe5, 0x97, 0xce, 0x8c, 0x26,
+	0xf3, 0xd4, 0x35, 0x3c, 0xb6, 0xe8, 0x6c, 0xfc, 0x35, 0x8d, 0x19, 0x09, 0xf3, 0x3f, 0x9d, 0xfc,
+	0x81, 0x7e, 0x87, 0x23, 0xba, 0x3c, 0x77, 0xf7, 0x45, 0xec, 0xcb, 0xff, 0x06, 0x00, 0x25, 0x23,
+	0xaf, 0x1e, 0x64, 0x07, 0x00, 0x00,
 }
 
 func (m *Domain) Marshal() (dAtA []byte, err error) {
@@ -524,6 +534,17 @@ func (m *Domain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DrainedIsolationGroups) > 0 {
+		for iNdEx := len(m.DrainedIsolationGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DrainedIsolationGroups[iNdEx])
+			copy(dAtA[i:], m.DrainedIsolationGroups[iNdEx])
+			i = encodeVarintDomain(dAtA, i, uint64(len(m.DrainedIsolationGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if m.IsGlobalDomain {
 		i--
 		if m.IsGlobalDomain {
@@ -887,6 +908,12 @@ func (m *Domain) Size() (n int) {
 	if m.IsGlobalDomain {
 		n += 3
 	}
+	if len(m.DrainedIsolationGroups) > 0 {
+		for _, s := range m.DrainedIsolationGroups {
+			l = len(s)
+			n += 2 + l + sovDomain(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1546,6 +1573,38 @@ func (m *Domain) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsGlobalDomain = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainedIsolationGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrainedIsolationGroups = append(m.DrainedIsolationGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDomain(dAtA[iNdEx:])
@@ -32,6 +32,7 @@ import (
 	math_bits "math/bits"
 
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -526,9 +527,10 @@ func (m *QueryFailedError) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryFailedError proto.InternalMessageInfo
 
 type ServiceBusyError struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RetryAfter           *types.Duration `protobuf:"bytes,1,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *ServiceBusyError) Reset()         { *m = ServiceBusyError{} }
@@ -564,6 +566,13 @@ func (m *ServiceBusyError) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ServiceBusyError proto.InternalMessageInfo
 
+func (m *ServiceBusyError) GetRetryAfter() *types.Duration {
+	if m != nil {
+		return m.RetryAfter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WorkflowExecutionAlreadyStartedError)(nil), "uber.cadence.api.v1.WorkflowExecutionAlreadyStartedError")
 	proto.RegisterType((*EntityNotExistsError)(nil), "uber.cadence.api.v1.EntityNotExistsError")
@@ -581,38 +590,41 @@ func init() {
 func init() { proto.RegisterFile("uber/cadence/api/v1/error.proto", fileDescriptor_c8f91786c9aff272) }
 
 var fileDescriptor_c8f91786c9aff272 = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe5, 0x22, 0x22, 0x98, 0x42, 0x5b, 0x4c, 0x28, 0x15, 0x87, 0x94, 0x9a, 0x3f, 0xcd,
-	0x05, 0x5b, 0x11, 0xc7, 0x9e, 0x92, 0x90, 0x48, 0x91, 0x50, 0x55, 0x1a, 0x09, 0x24, 0x2e, 0xd1,
-	0x66, 0x3d, 0x09, 0x2b, 0xd6, 0xbb, 0xcb, 0xfe, 0x31, 0xc9, 0x85, 0xf7, 0xe0, 0x8d, 0x38, 0xf2,
-	0x08, 0x28, 0x4f, 0x82, 0xec, 0x5d, 0x97, 0x20, 0x05, 0x89, 0xe3, 0xfe, 0x66, 0xf6, 0xf3, 0xb7,
-	0x9f, 0x67, 0xe0, 0xd4, 0xcd, 0x51, 0x67, 0x94, 0xe4, 0x28, 0x28, 0x66, 0x44, 0xb1, 0xac, 0xec,
-	0x65, 0xa8, 0xb5, 0xd4, 0xa9, 0xd2, 0xd2, 0xca, 0xf8, 0x61, 0xd5, 0x90, 0x86, 0x86, 0x94, 0x28,
-	0x96, 0x96, 0xbd, 0x64, 0x09, 0xcf, 0x3f, 0x48, 0xfd, 0x79, 0xc1, 0xe5, 0xd7, 0xd1, 0x0a, 0xa9,
-	0xb3, 0x4c, 0x8a, 0x3e, 0xd7, 0x48, 0xf2, 0xf5, 0xd4, 0x12, 0x6d, 0x31, 0x1f, 0x55, 0x12, 0x71,
-	0x17, 0x8e, 0x4c, 0x75, 0x9e, 0x69, 0xfc, 0xe2, 0xd0, 0xd8, 0x19, 0xcb, 0x4f, 0xa2, 0xa7, 0x51,
-	0xf7, 0xee, 0xf5, 0x41, 0xcd, 0xaf, 0x3d, 0x9e, 0xe4, 0xf1, 0x23, 0x68, 0x69, 0x27, 0xaa, 0xfa,
-	0x5e, 0x5d, 0xbf, 0xad, 0x9d, 0x98, 0xe4, 0xc9, 0x02, 0xda, 0x23, 0x61, 0x99, 0x5d, 0x5f, 0x4a,
-	0x3b, 0x5a, 0x31, 0x63, 0x8d, 0x17, 0x3e, 0x87, 0x43, 0xea, 0xb4, 0x46, 0x61, 0x67, 0x94, 0x3b,
-	0x63, 0x51, 0x37, 0xba, 0x01, 0x0f, 0x3d, 0x8d, 0x5f, 0xc0, 0x01, 0xa1, 0x96, 0x95, 0x78, 0xd3,
-	0xe7, 0xf5, 0xef, 0x7b, 0x1a, 0xda, 0x92, 0x2e, 0xbc, 0xfc, 0xd7, 0x83, 0x86, 0xb2, 0x50, 0x1c,
-	0x9b, 0x27, 0x25, 0xdf, 0xa0, 0xfd, 0x46, 0x16, 0x84, 0x89, 0x4b, 0x69, 0xfb, 0xb5, 0x86, 0x77,
-	0x74, 0x0c, 0xad, 0xbc, 0xe6, 0xc1, 0x48, 0x38, 0xed, 0x72, 0xba, 0xf7, 0x9f, 0x4e, 0x6f, 0xed,
-	0x72, 0xfa, 0x3d, 0x82, 0xce, 0x90, 0x33, 0x14, 0xf6, 0x3d, 0x6a, 0xc3, 0x64, 0xe5, 0x63, 0xea,
-	0x94, 0x92, 0x7f, 0x52, 0x3f, 0x87, 0xc3, 0x05, 0x12, 0xeb, 0x34, 0xce, 0x4a, 0xdf, 0xd3, 0x84,
-	0x13, 0x70, 0xb8, 0x19, 0x9f, 0xc2, 0x3e, 0xad, 0xa5, 0x66, 0xac, 0x50, 0x3c, 0xf8, 0x02, 0x8f,
-	0x26, 0x85, 0xe2, 0xf1, 0x2b, 0x88, 0x4d, 0xa3, 0xdd, 0x68, 0x99, 0xe0, 0xeb, 0xc1, 0x4d, 0x25,
-	0xc8, 0x99, 0xe4, 0x02, 0x8e, 0xc7, 0xfe, 0x0b, 0xd5, 0xef, 0x12, 0x64, 0xce, 0x1b, 0x4b, 0x67,
-	0x70, 0xaf, 0xb1, 0xb4, 0xe0, 0x64, 0x19, 0xfc, 0xec, 0x07, 0x36, 0xe6, 0x64, 0x99, 0x3c, 0x83,
-	0xb3, 0x21, 0x11, 0x14, 0x39, 0x27, 0x5b, 0xe9, 0x87, 0x09, 0x69, 0xd2, 0x7f, 0x02, 0x27, 0x3e,
-	0xfd, 0x50, 0xde, 0x9a, 0x89, 0xa4, 0x0d, 0xf1, 0x5b, 0x56, 0x30, 0x3b, 0x5a, 0x51, 0xc4, 0xbc,
-	0xb9, 0x11, 0xc3, 0xd1, 0x3b, 0x87, 0x7a, 0x3d, 0x26, 0x8c, 0x6f, 0xb1, 0x29, 0xea, 0x92, 0x51,
-	0x1c, 0x38, 0xb3, 0xae, 0xd9, 0x60, 0xfe, 0x63, 0xd3, 0x89, 0x7e, 0x6e, 0x3a, 0xd1, 0xaf, 0x4d,
-	0x27, 0x82, 0xc7, 0x54, 0x16, 0xe9, 0x8e, 0xc9, 0x1f, 0xdc, 0xe9, 0x2b, 0x76, 0x55, 0x2d, 0xc6,
-	0x55, 0xf4, 0x31, 0x5b, 0x32, 0xfb, 0xc9, 0xcd, 0x53, 0x2a, 0x8b, 0xec, 0xaf, 0x35, 0x4a, 0x97,
-	0x28, 0xb2, 0x7a, 0x7d, 0xc2, 0x46, 0x5d, 0x10, 0xc5, 0xca, 0xde, 0xbc, 0x55, 0xb3, 0xd7, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x4f, 0x15, 0x9e, 0x75, 0x03, 0x00, 0x00,
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe5, 0x7e, 0xfa, 0x2a, 0xb8, 0x81, 0xb6, 0x98, 0x52, 0x4a, 0x17, 0x29, 0x35, 0x7f,
+	0xda, 0x0d, 0xb6, 0x0a, 0x3b, 0xba, 0x4a, 0xd2, 0x44, 0x8a, 0x84, 0xa2, 0x92, 0x48, 0x20, 0xb1,
+	0xb1, 0xc6, 0xe3, 0x6b, 0x33, 0x62, 0xec, 0x31, 0xe3, 0x19, 0x93, 0x6c, 0x78, 0x0f, 0xde, 0x88,
+	0x25, 0x8f, 0x80, 0xf2, 0x24, 0xc8, 0x9e, 0x99, 0x52, 0xa4, 0x20, 0xb1, 0xf4, 0xb9, 0x67, 0x8e,
+	0x7f, 0xbe, 0x9e, 0x03, 0xc7, 0x3a, 0x41, 0x19, 0x51, 0x92, 0x62, 0x49, 0x31, 0x22, 0x15, 0x8b,
+	0x9a, 0xf3, 0x08, 0xa5, 0x14, 0x32, 0xac, 0xa4, 0x50, 0xc2, 0xbf, 0xdf, 0x1a, 0x42, 0x6b, 0x08,
+	0x49, 0xc5, 0xc2, 0xe6, 0xfc, 0xa8, 0x9f, 0x0b, 0x91, 0x73, 0x8c, 0x3a, 0x4b, 0xa2, 0xb3, 0x28,
+	0xd5, 0x92, 0x28, 0x26, 0x4a, 0x73, 0x28, 0xc8, 0xe1, 0xe9, 0x7b, 0x21, 0x3f, 0x65, 0x5c, 0x7c,
+	0x19, 0x2f, 0x91, 0xea, 0x76, 0x34, 0xe0, 0x12, 0x49, 0xba, 0x5a, 0x28, 0x22, 0x15, 0xa6, 0xe3,
+	0xf6, 0x15, 0xfe, 0x19, 0xec, 0xd5, 0xed, 0x73, 0x2c, 0xf1, 0xb3, 0xc6, 0x5a, 0xc5, 0x2c, 0x3d,
+	0xf4, 0x1e, 0x7b, 0x67, 0xb7, 0xe7, 0x3b, 0x9d, 0x3e, 0x37, 0xf2, 0x34, 0xf5, 0x1f, 0xc0, 0xb6,
+	0xd4, 0x65, 0x3b, 0xdf, 0xea, 0xe6, 0xff, 0x4b, 0x5d, 0x4e, 0xd3, 0x20, 0x83, 0xfd, 0x71, 0xa9,
+	0x98, 0x5a, 0xcd, 0x84, 0x1a, 0x2f, 0x59, 0xad, 0x6a, 0x13, 0x7c, 0x0a, 0xbb, 0x54, 0x4b, 0x89,
+	0xa5, 0x8a, 0x29, 0xd7, 0xb5, 0x42, 0xe9, 0x72, 0xad, 0x3c, 0x32, 0xaa, 0xff, 0x0c, 0x76, 0x08,
+	0x55, 0xac, 0xc1, 0x6b, 0x9f, 0xc9, 0xbf, 0x6b, 0x54, 0x6b, 0x0b, 0xce, 0xe0, 0xf9, 0xdf, 0x3e,
+	0x68, 0x24, 0x8a, 0x8a, 0xa3, 0xfb, 0xa4, 0xe0, 0x2b, 0xec, 0x5f, 0x8a, 0x82, 0xb0, 0x72, 0x26,
+	0xd4, 0xa0, 0xcb, 0x30, 0x44, 0x07, 0xb0, 0x9d, 0x76, 0xba, 0x05, 0xb1, 0x4f, 0x9b, 0x48, 0xb7,
+	0xfe, 0x91, 0xf4, 0xbf, 0x4d, 0xa4, 0xdf, 0x3c, 0xe8, 0x8f, 0x38, 0xc3, 0x52, 0xbd, 0x43, 0x59,
+	0x33, 0xd1, 0x72, 0x2c, 0x74, 0x55, 0x89, 0xdf, 0x5b, 0x3f, 0x85, 0xdd, 0x0c, 0x89, 0xd2, 0x12,
+	0xe3, 0xc6, 0x78, 0xdc, 0x72, 0xac, 0x6c, 0x4f, 0xfa, 0xc7, 0xd0, 0xa3, 0x5d, 0x54, 0xcc, 0x8a,
+	0x8a, 0x5b, 0x2e, 0x30, 0xd2, 0xb4, 0xa8, 0xb8, 0xff, 0x02, 0xfc, 0xda, 0x65, 0xbb, 0xac, 0xda,
+	0x72, 0xdd, 0xbb, 0x9e, 0xd8, 0xb8, 0x3a, 0xb8, 0x80, 0x83, 0x89, 0x79, 0x43, 0xfb, 0xbb, 0x4a,
+	0x92, 0x70, 0x87, 0x74, 0x02, 0x77, 0x1c, 0x52, 0xc6, 0x49, 0x6e, 0x79, 0x7a, 0x56, 0x9b, 0x70,
+	0x92, 0x07, 0x4f, 0xe0, 0x64, 0x44, 0x4a, 0x8a, 0x9c, 0x93, 0x1b, 0xdb, 0xb7, 0x37, 0xc4, 0x6d,
+	0xff, 0x08, 0x0e, 0xcd, 0xf6, 0xed, 0xf8, 0xc6, 0x9d, 0x08, 0xf6, 0xc1, 0x7f, 0xc3, 0x0a, 0xa6,
+	0xc6, 0x4b, 0x8a, 0x98, 0xba, 0x13, 0x3e, 0xec, 0xbd, 0xd5, 0x28, 0x57, 0x13, 0xc2, 0x1c, 0x4d,
+	0x30, 0x83, 0xbd, 0x05, 0xca, 0x86, 0x51, 0x1c, 0xea, 0x7a, 0x65, 0x08, 0x5f, 0x43, 0x4f, 0xa2,
+	0x92, 0xab, 0x98, 0x64, 0xee, 0x36, 0xf5, 0x5e, 0x3e, 0x0a, 0x4d, 0x11, 0x42, 0x57, 0x84, 0xf0,
+	0xd2, 0x16, 0x61, 0x0e, 0x9d, 0x7b, 0xd0, 0x9a, 0x87, 0xc9, 0xf7, 0x75, 0xdf, 0xfb, 0xb1, 0xee,
+	0x7b, 0x3f, 0xd7, 0x7d, 0x0f, 0x1e, 0x52, 0x51, 0x84, 0x1b, 0x5a, 0x35, 0xbc, 0x35, 0xa8, 0xd8,
+	0x55, 0x1b, 0x74, 0xe5, 0x7d, 0x88, 0x72, 0xa6, 0x3e, 0xea, 0x24, 0xa4, 0xa2, 0x88, 0xfe, 0xa8,
+	0x68, 0x98, 0x63, 0x69, 0x7a, 0x67, 0xdb, 0x7a, 0x41, 0x2a, 0xd6, 0x9c, 0x27, 0xdb, 0x9d, 0xf6,
+	0xea, 0xd7, 0x00, 0x0e, 0x51, 0x15, 0x0a, 0xd1, 0x03, 0x00, 0x00,
 }
 
 func (m *WorkflowExecutionAlreadyStartedError) Marshal() (dAtA []byte, err error) {
@@ -986,6 +998,18 @@ func (m *ServiceBusyError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetryAfter != nil {
+		{
+			size, err := m.RetryAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintError(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1170,6 +1194,10 @@ func (m *ServiceBusyError) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.RetryAfter != nil {
+		l = m.RetryAfter.Size()
+		n += 1 + l + sovError(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2103,6 +2131,42 @@ func (m *ServiceBusyError) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ServiceBusyError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowError
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthError
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthError
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryAfter == nil {
+				m.RetryAfter = &types.Duration{}
+			}
+			if err := m.RetryAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipError(dAtA[iNdEx:])
@@ -28,35 +28,53 @@ package apiv1
 var yarpcFileDescriptorClosurec8f91786c9aff272 = [][]byte{
 	// uber/cadence/api/v1/error.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x5f, 0x6f, 0xd3, 0x30,
-		0x14, 0xc5, 0xd5, 0x21, 0x2a, 0xb8, 0x83, 0x6d, 0x84, 0x32, 0x26, 0x1e, 0x18, 0x0b, 0x7f, 0xd6,
-		0x17, 0x12, 0x55, 0x3c, 0xee, 0xa9, 0x2d, 0xa9, 0x54, 0x09, 0x4d, 0x63, 0x95, 0x86, 0xc4, 0x4b,
-		0xe4, 0x3a, 0xb7, 0xc1, 0xc2, 0xb1, 0x8d, 0xff, 0x84, 0xf6, 0x85, 0xef, 0xc1, 0xb7, 0x45, 0x89,
-		0x9d, 0x51, 0xa4, 0x22, 0xed, 0xd1, 0xbf, 0x7b, 0x7d, 0x72, 0x7c, 0x72, 0x2f, 0x9c, 0xba, 0x25,
-		0xea, 0x94, 0x92, 0x02, 0x05, 0xc5, 0x94, 0x28, 0x96, 0xd6, 0xa3, 0x14, 0xb5, 0x96, 0x3a, 0x51,
-		0x5a, 0x5a, 0x19, 0x3d, 0x6d, 0x1a, 0x92, 0xd0, 0x90, 0x10, 0xc5, 0x92, 0x7a, 0x14, 0x97, 0xf0,
-		0xe6, 0x8b, 0xd4, 0xdf, 0x57, 0x5c, 0xfe, 0xcc, 0xd6, 0x48, 0x9d, 0x65, 0x52, 0x8c, 0xb9, 0x46,
-		0x52, 0x6c, 0x16, 0x96, 0x68, 0x8b, 0x45, 0xd6, 0x48, 0x44, 0x43, 0x38, 0x32, 0xcd, 0x39, 0xd7,
-		0xf8, 0xc3, 0xa1, 0xb1, 0x39, 0x2b, 0x4e, 0x7a, 0xaf, 0x7a, 0xc3, 0x87, 0xd7, 0x07, 0x2d, 0xbf,
-		0xf6, 0x78, 0x5e, 0x44, 0xcf, 0xa0, 0xaf, 0x9d, 0x68, 0xea, 0x7b, 0x6d, 0xfd, 0xbe, 0x76, 0x62,
-		0x5e, 0xc4, 0x2b, 0x18, 0x64, 0xc2, 0x32, 0xbb, 0xb9, 0x94, 0x36, 0x5b, 0x33, 0x63, 0x8d, 0x17,
-		0x3e, 0x87, 0x43, 0xea, 0xb4, 0x46, 0x61, 0x73, 0xca, 0x9d, 0xb1, 0xa8, 0x3b, 0xdd, 0x80, 0xa7,
-		0x9e, 0x46, 0x6f, 0xe1, 0x80, 0x50, 0xcb, 0x6a, 0xbc, 0xed, 0xf3, 0xfa, 0x8f, 0x3d, 0x0d, 0x6d,
-		0xf1, 0x10, 0xde, 0xfd, 0xef, 0x41, 0x53, 0x59, 0x29, 0x8e, 0xdd, 0x93, 0xe2, 0x5f, 0x30, 0xf8,
-		0x28, 0x2b, 0xc2, 0xc4, 0xa5, 0xb4, 0xe3, 0x56, 0xc3, 0x3b, 0x3a, 0x86, 0x7e, 0xd1, 0xf2, 0x60,
-		0x24, 0x9c, 0x76, 0x39, 0xdd, 0xbb, 0xa3, 0xd3, 0x7b, 0xbb, 0x9c, 0xfe, 0xee, 0xc1, 0xcb, 0x29,
-		0x67, 0x28, 0xec, 0x0d, 0x6a, 0xc3, 0x64, 0xe3, 0x63, 0xe1, 0x94, 0x92, 0x7f, 0x53, 0x3f, 0x87,
-		0xc3, 0x15, 0x12, 0xeb, 0x34, 0xe6, 0xb5, 0xef, 0xe9, 0xc2, 0x09, 0x38, 0xdc, 0x8c, 0x4e, 0x61,
-		0x9f, 0xb6, 0x52, 0x39, 0xab, 0x14, 0x0f, 0xbe, 0xc0, 0xa3, 0x79, 0xa5, 0x78, 0xf4, 0x1e, 0x22,
-		0xd3, 0x69, 0x77, 0x5a, 0x26, 0xf8, 0x7a, 0x72, 0x5b, 0x09, 0x72, 0x26, 0xbe, 0x80, 0xe3, 0x99,
-		0xff, 0x42, 0xf3, 0xbb, 0x04, 0x59, 0xf2, 0xce, 0xd2, 0x19, 0x3c, 0xea, 0x2c, 0xad, 0x38, 0x29,
-		0x83, 0x9f, 0xfd, 0xc0, 0x66, 0x9c, 0x94, 0xf1, 0x6b, 0x38, 0x9b, 0x12, 0x41, 0x91, 0x73, 0xb2,
-		0x95, 0x7e, 0x98, 0x90, 0x2e, 0xfd, 0x17, 0x70, 0xe2, 0xd3, 0x0f, 0xe5, 0xad, 0x99, 0x88, 0x07,
-		0x10, 0x7d, 0x62, 0x15, 0xb3, 0xd9, 0x9a, 0x22, 0x16, 0xdd, 0x8d, 0x08, 0x8e, 0x3e, 0x3b, 0xd4,
-		0x9b, 0x19, 0x61, 0x7c, 0x8b, 0x2d, 0x50, 0xd7, 0x8c, 0xe2, 0xc4, 0x99, 0x4d, 0xcb, 0x26, 0x37,
-		0xf0, 0x9c, 0xca, 0x2a, 0xd9, 0x31, 0xed, 0x93, 0x07, 0x63, 0xc5, 0xae, 0x9a, 0x65, 0xb8, 0xea,
-		0x7d, 0x4d, 0x4b, 0x66, 0xbf, 0xb9, 0x65, 0x42, 0x65, 0x95, 0xfe, 0xb3, 0x3a, 0x49, 0x89, 0x22,
-		0x6d, 0x57, 0x26, 0x6c, 0xd1, 0x05, 0x51, 0xac, 0x1e, 0x2d, 0xfb, 0x2d, 0xfb, 0xf0, 0x27, 0x00,
-		0x00, 0xff, 0xff, 0x0c, 0x25, 0xe5, 0xcd, 0x69, 0x03, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x5f, 0x6f, 0xd3, 0x3c,
+		0x14, 0xc6, 0xd5, 0xbd, 0x7a, 0x27, 0x38, 0x85, 0xad, 0x84, 0x32, 0xc6, 0x2e, 0x36, 0x16, 0xfe,
+		0xac, 0x37, 0x24, 0x2a, 0xdc, 0xb1, 0xab, 0xb6, 0x6b, 0xa5, 0x4a, 0xa8, 0x1a, 0xad, 0x34, 0x24,
+		0x6e, 0x22, 0xd7, 0x39, 0x09, 0x16, 0x8e, 0x1d, 0x1c, 0x3b, 0xb4, 0x37, 0x7c, 0x0f, 0xbe, 0x2d,
+		0x4a, 0x6c, 0x8f, 0x21, 0x15, 0x89, 0xcb, 0x3c, 0xe7, 0x39, 0x8f, 0x7f, 0x3e, 0xf1, 0x81, 0x33,
+		0xb3, 0x46, 0x15, 0x53, 0x92, 0xa2, 0xa0, 0x18, 0x93, 0x92, 0xc5, 0xf5, 0x30, 0x46, 0xa5, 0xa4,
+		0x8a, 0x4a, 0x25, 0xb5, 0x0c, 0x1e, 0x37, 0x86, 0xc8, 0x19, 0x22, 0x52, 0xb2, 0xa8, 0x1e, 0x9e,
+		0x9c, 0xe6, 0x52, 0xe6, 0x1c, 0xe3, 0xd6, 0xb2, 0x36, 0x59, 0x9c, 0x1a, 0x45, 0x34, 0x93, 0xc2,
+		0x36, 0x85, 0x39, 0xbc, 0xfc, 0x24, 0xd5, 0xd7, 0x8c, 0xcb, 0xef, 0xd3, 0x0d, 0x52, 0xd3, 0x94,
+		0x46, 0x5c, 0x21, 0x49, 0xb7, 0x2b, 0x4d, 0x94, 0xc6, 0x74, 0xda, 0x1c, 0x11, 0x0c, 0xa0, 0x57,
+		0x35, 0xdf, 0x89, 0xc2, 0x6f, 0x06, 0x2b, 0x9d, 0xb0, 0xf4, 0xb8, 0xf3, 0xbc, 0x33, 0xb8, 0xbf,
+		0x3c, 0x68, 0xf5, 0xa5, 0x95, 0xe7, 0x69, 0xf0, 0x04, 0xf6, 0x95, 0x11, 0x4d, 0x7d, 0xaf, 0xad,
+		0xff, 0xaf, 0x8c, 0x98, 0xa7, 0x61, 0x06, 0xfd, 0xa9, 0xd0, 0x4c, 0x6f, 0x17, 0x52, 0x4f, 0x37,
+		0xac, 0xd2, 0x95, 0x0d, 0xbe, 0x80, 0x43, 0x6a, 0x94, 0x42, 0xa1, 0x13, 0xca, 0x4d, 0xa5, 0x51,
+		0xf9, 0x5c, 0x27, 0x4f, 0xac, 0x1a, 0xbc, 0x82, 0x03, 0x42, 0x35, 0xab, 0xf1, 0xd6, 0x67, 0xf3,
+		0x1f, 0x5a, 0xd5, 0xd9, 0xc2, 0x01, 0xbc, 0xfe, 0xdb, 0x85, 0x26, 0xb2, 0x28, 0x39, 0xfa, 0x2b,
+		0x85, 0x3f, 0xa0, 0x7f, 0x25, 0x0b, 0xc2, 0xc4, 0x42, 0xea, 0x51, 0x9b, 0x61, 0x89, 0x8e, 0x60,
+		0x3f, 0x6d, 0x75, 0x07, 0xe2, 0xbe, 0x76, 0x91, 0xee, 0xfd, 0x23, 0xe9, 0x7f, 0xbb, 0x48, 0x7f,
+		0x76, 0xe0, 0x74, 0xc2, 0x19, 0x0a, 0x7d, 0x83, 0xaa, 0x62, 0xb2, 0xe1, 0x58, 0x99, 0xb2, 0x94,
+		0xbf, 0xa7, 0x7e, 0x01, 0x87, 0x19, 0x12, 0x6d, 0x14, 0x26, 0xb5, 0xf5, 0xf8, 0xe1, 0x38, 0xd9,
+		0x75, 0x06, 0x67, 0xd0, 0xa5, 0x6d, 0x54, 0xc2, 0x8a, 0x92, 0x3b, 0x2e, 0xb0, 0xd2, 0xbc, 0x28,
+		0x79, 0xf0, 0x06, 0x82, 0xca, 0x67, 0xfb, 0xac, 0xca, 0x71, 0x3d, 0xba, 0xad, 0xb8, 0xb8, 0x2a,
+		0xbc, 0x84, 0xa3, 0x99, 0x3d, 0xa1, 0xf9, 0x5d, 0x82, 0xac, 0xb9, 0x47, 0x3a, 0x87, 0x07, 0x1e,
+		0x29, 0xe3, 0x24, 0x77, 0x3c, 0x5d, 0xa7, 0xcd, 0x38, 0xc9, 0xc3, 0x17, 0x70, 0x3e, 0x21, 0x82,
+		0x22, 0xe7, 0xe4, 0xce, 0xf4, 0xdd, 0x0b, 0xf1, 0xd3, 0x3f, 0x81, 0x63, 0x3b, 0x7d, 0x57, 0xbe,
+		0xf3, 0x26, 0xc2, 0x3e, 0x04, 0x1f, 0x58, 0xc1, 0xf4, 0x74, 0x43, 0x11, 0x53, 0xdf, 0x11, 0x40,
+		0xef, 0xa3, 0x41, 0xb5, 0x9d, 0x11, 0xe6, 0x69, 0xc2, 0x05, 0xf4, 0x56, 0xa8, 0x6a, 0x46, 0x71,
+		0x6c, 0xaa, 0xad, 0x25, 0x7c, 0x0f, 0x5d, 0x85, 0x5a, 0x6d, 0x13, 0x92, 0xf9, 0xd7, 0xd4, 0x7d,
+		0xfb, 0x2c, 0xb2, 0x8b, 0x10, 0xf9, 0x45, 0x88, 0xae, 0xdc, 0x22, 0x2c, 0xa1, 0x75, 0x8f, 0x1a,
+		0xf3, 0xf8, 0x06, 0x9e, 0x52, 0x59, 0x44, 0x3b, 0x36, 0x69, 0x7c, 0x6f, 0x54, 0xb2, 0xeb, 0xa6,
+		0xf9, 0xba, 0xf3, 0x39, 0xce, 0x99, 0xfe, 0x62, 0xd6, 0x11, 0x95, 0x45, 0xfc, 0xc7, 0x5a, 0x46,
+		0x39, 0x0a, 0xbb, 0x6b, 0x6e, 0x43, 0x2f, 0x49, 0xc9, 0xea, 0xe1, 0x7a, 0xbf, 0xd5, 0xde, 0xfd,
+		0x1a, 0x00, 0x3e, 0x26, 0x38, 0x7c, 0xc5, 0x03, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 }
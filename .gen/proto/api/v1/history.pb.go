@@ -169,6 +169,7 @@ type HistoryEvent struct {
 	//	*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes
 	//	*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes
 	//	*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes
+	//	*HistoryEvent_UpsertWorkflowMemoEventAttributes
 	Attributes           isHistoryEvent_Attributes `protobuf_oneof:"attributes"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -340,6 +341,9 @@ type HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes struct {
 type HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes struct {
 	UpsertWorkflowSearchAttributesEventAttributes *UpsertWorkflowSearchAttributesEventAttributes `protobuf:"bytes,46,opt,name=upsert_workflow_search_attributes_event_attributes,json=upsertWorkflowSearchAttributesEventAttributes,proto3,oneof" json:"upsert_workflow_search_attributes_event_attributes,omitempty"`
 }
+type HistoryEvent_UpsertWorkflowMemoEventAttributes struct {
+	UpsertWorkflowMemoEventAttributes *UpsertWorkflowMemoEventAttributes `protobuf:"bytes,47,opt,name=upsert_workflow_memo_event_attributes,json=upsertWorkflowMemoEventAttributes,proto3,oneof" json:"upsert_workflow_memo_event_attributes,omitempty"`
+}
 
 func (*HistoryEvent_WorkflowExecutionStartedEventAttributes) isHistoryEvent_Attributes()         {}
 func (*HistoryEvent_WorkflowExecutionCompletedEventAttributes) isHistoryEvent_Attributes()       {}
@@ -389,6 +393,7 @@ func (*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes) isHist
 }
 func (*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes) isHistoryEvent_Attributes() {}
 func (*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) isHistoryEvent_Attributes()    {}
+func (*HistoryEvent_UpsertWorkflowMemoEventAttributes) isHistoryEvent_Attributes()                {}
 
 func (m *HistoryEvent) GetAttributes() isHistoryEvent_Attributes {
 	if m != nil {
@@ -719,6 +724,13 @@ func (m *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() *Upser
 	return nil
 }
 
+func (m *HistoryEvent) GetUpsertWorkflowMemoEventAttributes() *UpsertWorkflowMemoEventAttributes {
+	if x, ok := m.GetAttributes().(*HistoryEvent_UpsertWorkflowMemoEventAttributes); ok {
+		return x.UpsertWorkflowMemoEventAttributes
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*HistoryEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -764,6 +776,7 @@ func (*HistoryEvent) XXX_OneofWrappers() []interface{} {
 		(*HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes)(nil),
 		(*HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes)(nil),
 		(*HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes)(nil),
+		(*HistoryEvent_UpsertWorkflowMemoEventAttributes)(nil),
 	}
 }
 
@@ -782,19 +795,25 @@ type WorkflowExecutionStartedEventAttributes struct {
 	OriginalExecutionRunId string `protobuf:"bytes,11,opt,name=original_execution_run_id,json=originalExecutionRunId,proto3" json:"original_execution_run_id,omitempty"`
 	Identity               string `protobuf:"bytes,12,opt,name=identity,proto3" json:"identity,omitempty"`
 	// This is the very first runID along the chain of ContinueAsNew and Reset.
-	FirstExecutionRunId      string            `protobuf:"bytes,13,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
-	RetryPolicy              *RetryPolicy      `protobuf:"bytes,14,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
-	Attempt                  int32             `protobuf:"varint,15,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	ExpirationTime           *types.Timestamp  `protobuf:"bytes,16,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
-	CronSchedule             string            `protobuf:"bytes,17,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
-	FirstDecisionTaskBackoff *types.Duration   `protobuf:"bytes,18,opt,name=first_decision_task_backoff,json=firstDecisionTaskBackoff,proto3" json:"first_decision_task_backoff,omitempty"`
-	Memo                     *Memo             `protobuf:"bytes,19,opt,name=memo,proto3" json:"memo,omitempty"`
-	SearchAttributes         *SearchAttributes `protobuf:"bytes,20,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
-	PrevAutoResetPoints      *ResetPoints      `protobuf:"bytes,21,opt,name=prev_auto_reset_points,json=prevAutoResetPoints,proto3" json:"prev_auto_reset_points,omitempty"`
-	Header                   *Header           `protobuf:"bytes,22,opt,name=header,proto3" json:"header,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}          `json:"-"`
-	XXX_unrecognized         []byte            `json:"-"`
-	XXX_sizecache            int32             `json:"-"`
+	FirstExecutionRunId        string            `protobuf:"bytes,13,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
+	RetryPolicy                *RetryPolicy      `protobuf:"bytes,14,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	Attempt                    int32             `protobuf:"varint,15,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ExpirationTime             *types.Timestamp  `protobuf:"bytes,16,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	CronSchedule               string            `protobuf:"bytes,17,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	FirstDecisionTaskBackoff   *types.Duration   `protobuf:"bytes,18,opt,name=first_decision_task_backoff,json=firstDecisionTaskBackoff,proto3" json:"first_decision_task_backoff,omitempty"`
+	Memo                       *Memo             `protobuf:"bytes,19,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes           *SearchAttributes `protobuf:"bytes,20,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	PrevAutoResetPoints        *ResetPoints      `protobuf:"bytes,21,opt,name=prev_auto_reset_points,json=prevAutoResetPoints,proto3" json:"prev_auto_reset_points,omitempty"`
+	Header                     *Header           `protobuf:"bytes,22,opt,name=header,proto3" json:"header,omitempty"`
+	Priority                   int32             `protobuf:"varint,23,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey                string            `protobuf:"bytes,24,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	IsolationGroup             string            `protobuf:"bytes,25,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
+	RetryElapsedExpirationTime *types.Timestamp  `protobuf:"bytes,26,opt,name=retry_elapsed_expiration_time,json=retryElapsedExpirationTime,proto3" json:"retry_elapsed_expiration_time,omitempty"`
+	// Set on the first run of a workflow started with a delay, the delay is part of first_decision_task_backoff.
+	DelayStart           *types.Duration `protobuf:"bytes,27,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *WorkflowExecutionStartedEventAttributes) Reset() {
@@ -986,6 +1005,41 @@ func (m *WorkflowExecutionStartedEventAttributes) GetHeader() *Header {
 	return nil
 }
 
+func (m *WorkflowExecutionStartedEventAttributes) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetRetryElapsedExpirationTime() *types.Timestamp {
+	if m != nil {
+		return m.RetryElapsedExpirationTime
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionStartedEventAttributes) GetDelayStart() *types.Duration {
+	if m != nil {
+		return m.DelayStart
+	}
+	return nil
+}
+
 type WorkflowExecutionCompletedEventAttributes struct {
 	Result                       *Payload `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	DecisionTaskCompletedEventId int64    `protobuf:"varint,2,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
@@ -1585,6 +1639,8 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventId int64           `protobuf:"varint,11,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
 	RetryPolicy                  *RetryPolicy    `protobuf:"bytes,12,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 	Header                       *Header         `protobuf:"bytes,13,opt,name=header,proto3" json:"header,omitempty"`
+	Priority                     int32           `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey                  string          `protobuf:"bytes,15,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
 	XXX_unrecognized             []byte          `json:"-"`
 	XXX_sizecache                int32           `json:"-"`
@@ -1707,6 +1763,20 @@ func (m *ActivityTaskScheduledEventAttributes) GetHeader() *Header {
 	return nil
 }
 
+func (m *ActivityTaskScheduledEventAttributes) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ActivityTaskScheduledEventAttributes) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventId     int64    `protobuf:"varint,1,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
 	Identity             string   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
@@ -2633,6 +2703,7 @@ type WorkflowExecutionCancelRequestedEventAttributes struct {
 	Cause                 string                 `protobuf:"bytes,1,opt,name=cause,proto3" json:"cause,omitempty"`
 	Identity              string                 `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 	ExternalExecutionInfo *ExternalExecutionInfo `protobuf:"bytes,3,opt,name=external_execution_info,json=externalExecutionInfo,proto3" json:"external_execution_info,omitempty"`
+	RequestId             string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}               `json:"-"`
 	XXX_unrecognized      []byte                 `json:"-"`
 	XXX_sizecache         int32                  `json:"-"`
@@ -2696,6 +2767,13 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) GetExternalExecutionIn
 	return nil
 }
 
+func (m *WorkflowExecutionCancelRequestedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
 type WorkflowExecutionCanceledEventAttributes struct {
 	DecisionTaskCompletedEventId int64    `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
 	Details                      *Payload `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
@@ -2828,6 +2906,7 @@ type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName           string   `protobuf:"bytes,1,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
 	Input                *Payload `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
 	Identity             string   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2889,10 +2968,18 @@ func (m *WorkflowExecutionSignaledEventAttributes) GetIdentity() string {
 	return ""
 }
 
+func (m *WorkflowExecutionSignaledEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 	Details              *Payload `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
 	Identity             string   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2956,6 +3043,13 @@ func (m *WorkflowExecutionTerminatedEventAttributes) GetIdentity() string {
 	return ""
 }
 
+func (m *WorkflowExecutionTerminatedEventAttributes) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
 type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventId int64              `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
 	Domain                       string             `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -3521,6 +3615,61 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) GetSearchAttributes() *S
 	return nil
 }
 
+type UpsertWorkflowMemoEventAttributes struct {
+	DecisionTaskCompletedEventId int64    `protobuf:"varint,1,opt,name=decision_task_completed_event_id,json=decisionTaskCompletedEventId,proto3" json:"decision_task_completed_event_id,omitempty"`
+	Memo                         *Memo    `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *UpsertWorkflowMemoEventAttributes) Reset()         { *m = UpsertWorkflowMemoEventAttributes{} }
+func (m *UpsertWorkflowMemoEventAttributes) String() string { return proto.CompactTextString(m) }
+func (*UpsertWorkflowMemoEventAttributes) ProtoMessage()    {}
+func (*UpsertWorkflowMemoEventAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8237ca6511ad6c62, []int{36}
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowMemoEventAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowMemoEventAttributes.Merge(m, src)
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowMemoEventAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowMemoEventAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowMemoEventAttributes proto.InternalMessageInfo
+
+func (m *UpsertWorkflowMemoEventAttributes) GetDecisionTaskCompletedEventId() int64 {
+	if m != nil {
+		return m.DecisionTaskCompletedEventId
+	}
+	return 0
+}
+
+func (m *UpsertWorkflowMemoEventAttributes) GetMemo() *Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
 type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	Domain                       string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowId                   string                `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -3539,6 +3688,8 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	Memo                         *Memo                 `protobuf:"bytes,16,opt,name=memo,proto3" json:"memo,omitempty"`
 	SearchAttributes             *SearchAttributes     `protobuf:"bytes,17,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
 	DelayStart                   *types.Duration       `protobuf:"bytes,18,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
+	Priority                     int32                 `protobuf:"varint,19,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey                  string                `protobuf:"bytes,20,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	XXX_NoUnkeyedLiteral         struct{}              `json:"-"`
 	XXX_unrecognized             []byte                `json:"-"`
 	XXX_sizecache                int32                 `json:"-"`
@@ -3552,7 +3703,7 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
 }
 func (*StartChildWorkflowExecutionInitiatedEventAttributes) ProtoMessage() {}
 func (*StartChildWorkflowExecutionInitiatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{36}
+	return fileDescriptor_8237ca6511ad6c62, []int{37}
 }
 func (m *StartChildWorkflowExecutionInitiatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3700,6 +3851,20 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetDelayStart() *t
 	return nil
 }
 
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type StartChildWorkflowExecutionFailedEventAttributes struct {
 	Domain                       string                            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowId                   string                            `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -3721,7 +3886,7 @@ func (m *StartChildWorkflowExecutionFailedEventAttributes) String() string {
 }
 func (*StartChildWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*StartChildWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{37}
+	return fileDescriptor_8237ca6511ad6c62, []int{38}
 }
 func (m *StartChildWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3818,7 +3983,7 @@ func (m *ChildWorkflowExecutionStartedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionStartedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionStartedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{38}
+	return fileDescriptor_8237ca6511ad6c62, []int{39}
 }
 func (m *ChildWorkflowExecutionStartedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3902,7 +4067,7 @@ func (m *ChildWorkflowExecutionCompletedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionCompletedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionCompletedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{39}
+	return fileDescriptor_8237ca6511ad6c62, []int{40}
 }
 func (m *ChildWorkflowExecutionCompletedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3993,7 +4158,7 @@ func (m *ChildWorkflowExecutionFailedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionFailedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionFailedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{40}
+	return fileDescriptor_8237ca6511ad6c62, []int{41}
 }
 func (m *ChildWorkflowExecutionFailedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4084,7 +4249,7 @@ func (m *ChildWorkflowExecutionCanceledEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionCanceledEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionCanceledEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{41}
+	return fileDescriptor_8237ca6511ad6c62, []int{42}
 }
 func (m *ChildWorkflowExecutionCanceledEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4175,7 +4340,7 @@ func (m *ChildWorkflowExecutionTimedOutEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionTimedOutEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionTimedOutEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{42}
+	return fileDescriptor_8237ca6511ad6c62, []int{43}
 }
 func (m *ChildWorkflowExecutionTimedOutEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4265,7 +4430,7 @@ func (m *ChildWorkflowExecutionTerminatedEventAttributes) String() string {
 }
 func (*ChildWorkflowExecutionTerminatedEventAttributes) ProtoMessage() {}
 func (*ChildWorkflowExecutionTerminatedEventAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8237ca6511ad6c62, []int{43}
+	return fileDescriptor_8237ca6511ad6c62, []int{44}
 }
 func (m *ChildWorkflowExecutionTerminatedEventAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4367,6 +4532,7 @@ func init() {
 	proto.RegisterType((*SignalExternalWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.SignalExternalWorkflowExecutionFailedEventAttributes")
 	proto.RegisterType((*ExternalWorkflowExecutionSignaledEventAttributes)(nil), "uber.cadence.api.v1.ExternalWorkflowExecutionSignaledEventAttributes")
 	proto.RegisterType((*UpsertWorkflowSearchAttributesEventAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowSearchAttributesEventAttributes")
+	proto.RegisterType((*UpsertWorkflowMemoEventAttributes)(nil), "uber.cadence.api.v1.UpsertWorkflowMemoEventAttributes")
 	proto.RegisterType((*StartChildWorkflowExecutionInitiatedEventAttributes)(nil), "uber.cadence.api.v1.StartChildWorkflowExecutionInitiatedEventAttributes")
 	proto.RegisterType((*StartChildWorkflowExecutionFailedEventAttributes)(nil), "uber.cadence.api.v1.StartChildWorkflowExecutionFailedEventAttributes")
 	proto.RegisterType((*ChildWorkflowExecutionStartedEventAttributes)(nil), "uber.cadence.api.v1.ChildWorkflowExecutionStartedEventAttributes")
@@ -4380,233 +4546,243 @@ func init() {
 func init() { proto.RegisterFile("uber/cadence/api/v1/history.proto", fileDescriptor_8237ca6511ad6c62) }
 
 var fileDescriptor_8237ca6511ad6c62 = []byte{
-	// 3609 bytes of a gzipped FileDescriptorProto
+	// 3774 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-	0xd5, 0xef, 0xdd, 0xb5, 0x77, 0xbd, 0x67, 0x1d, 0xc7, 0xbe, 0x49, 0x1c, 0x3b, 0x7f, 0x9d, 0x49,
-	0x9a, 0xb8, 0x8e, 0xb3, 0x4e, 0x9c, 0x34, 0xf9, 0xd2, 0x7c, 0x6d, 0x3f, 0xc7, 0xb1, 0xd5, 0x95,
-	0xfc, 0x25, 0xd6, 0xc4, 0x49, 0x01, 0x21, 0x2d, 0xe3, 0x9d, 0xeb, 0x78, 0xe4, 0xdd, 0x9d, 0xed,
-	0xcc, 0xac, 0x37, 0x46, 0xe2, 0x89, 0x07, 0x24, 0xd4, 0x0a, 0xaa, 0x0a, 0x89, 0x0a, 0x10, 0x08,
-	0x09, 0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x2a, 0x81, 0x4a, 0x9f, 0x78,
-	0xe1, 0x81, 0xaa, 0xe2, 0x81, 0xbe, 0xf1, 0x40, 0x79, 0x43, 0x42, 0x73, 0xe7, 0xce, 0xee, 0xce,
-	0xcc, 0xbd, 0x33, 0x77, 0xd6, 0x4e, 0x0b, 0x6a, 0xde, 0x3c, 0x77, 0xce, 0x3d, 0xf3, 0xbb, 0xe7,
-	0x9e, 0x73, 0xee, 0xb9, 0xe7, 0x9c, 0x35, 0x9c, 0x6a, 0xad, 0x13, 0x6b, 0xae, 0xaa, 0xe9, 0xa4,
-	0x51, 0x25, 0x73, 0x5a, 0xd3, 0x98, 0xdb, 0xbe, 0x34, 0xb7, 0x69, 0xd8, 0x8e, 0x69, 0xed, 0x94,
-	0x9a, 0x96, 0xe9, 0x98, 0xf8, 0x80, 0x4b, 0x52, 0x62, 0x24, 0x25, 0xad, 0x69, 0x94, 0xb6, 0x2f,
-	0x1d, 0x39, 0xf1, 0xc0, 0x34, 0x1f, 0xd4, 0xc8, 0x1c, 0x25, 0x59, 0x6f, 0x6d, 0xcc, 0xe9, 0x2d,
-	0x4b, 0x73, 0x0c, 0xb3, 0xe1, 0x4d, 0x3a, 0x72, 0x32, 0xfc, 0xde, 0x31, 0xea, 0xc4, 0x76, 0xb4,
-	0x7a, 0x93, 0x11, 0x4c, 0xf1, 0x3e, 0x5c, 0x35, 0xeb, 0xf5, 0x0e, 0x0b, 0x85, 0x47, 0xe1, 0x68,
-	0xf6, 0x56, 0xcd, 0xb0, 0x9d, 0x38, 0x9a, 0xb6, 0x69, 0x6d, 0x6d, 0xd4, 0xcc, 0xb6, 0x47, 0xa3,
-	0xdc, 0x82, 0xfc, 0x0b, 0xde, 0x82, 0xf0, 0x75, 0xc8, 0x91, 0x6d, 0xd2, 0x70, 0xec, 0x09, 0x34,
-	0x95, 0x9d, 0x2e, 0xce, 0x9f, 0x2a, 0x71, 0xd6, 0x56, 0x62, 0xd4, 0x4b, 0x2e, 0xa5, 0xca, 0x26,
-	0x28, 0x1f, 0x5c, 0x83, 0xe1, 0xde, 0x17, 0x78, 0x12, 0x86, 0xe8, 0xab, 0x8a, 0xa1, 0x4f, 0xa0,
-	0x29, 0x34, 0x9d, 0x55, 0xf3, 0xf4, 0xb9, 0xac, 0xe3, 0xeb, 0x00, 0xde, 0x2b, 0x77, 0xd1, 0x13,
-	0x99, 0x29, 0x34, 0x5d, 0x9c, 0x3f, 0x52, 0xf2, 0x24, 0x52, 0xf2, 0x25, 0x52, 0x5a, 0xf3, 0x25,
-	0xa2, 0x16, 0x28, 0xb5, 0xfb, 0x8c, 0x27, 0x20, 0xbf, 0x4d, 0x2c, 0xdb, 0x30, 0x1b, 0x13, 0x59,
-	0x8f, 0x29, 0x7b, 0xc4, 0x87, 0x21, 0xef, 0x2e, 0xde, 0xfd, 0xdc, 0x00, 0x7d, 0x93, 0x73, 0x1f,
-	0xcb, 0x3a, 0xfe, 0x26, 0x82, 0xf3, 0xfe, 0x92, 0x2b, 0xe4, 0x21, 0xa9, 0xb6, 0xdc, 0x7d, 0xa8,
-	0xd8, 0x8e, 0x66, 0x39, 0x44, 0xaf, 0x78, 0x48, 0x34, 0xc7, 0xb1, 0x8c, 0xf5, 0x96, 0x43, 0xec,
-	0x89, 0x41, 0x8a, 0xe7, 0x7f, 0xb9, 0x4b, 0x7f, 0x91, 0xf1, 0x59, 0xf2, 0xd9, 0xdc, 0xf5, 0xb8,
-	0xd0, 0x25, 0x2f, 0x74, 0x78, 0xbc, 0xf0, 0x84, 0x7a, 0xae, 0x2d, 0x47, 0x8a, 0xbf, 0x8b, 0xe0,
-	0x02, 0x07, 0x5e, 0xd5, 0xac, 0x37, 0x6b, 0x84, 0x0b, 0x30, 0x47, 0x01, 0x3e, 0x27, 0x07, 0x70,
-	0xd1, 0xe7, 0x13, 0x85, 0xf8, 0x54, 0x5b, 0x96, 0x18, 0xbf, 0x8e, 0x60, 0x86, 0x03, 0x72, 0x43,
-	0x33, 0x6a, 0x3c, 0x84, 0x79, 0x8a, 0xf0, 0x86, 0x1c, 0xc2, 0x65, 0xca, 0x24, 0x0a, 0xef, 0x6c,
-	0x5b, 0x8a, 0x12, 0x7f, 0x87, 0x2f, 0x40, 0x57, 0xb7, 0xf4, 0x8a, 0xd9, 0x72, 0xa2, 0xf0, 0x86,
-	0x28, 0xbc, 0x67, 0xe5, 0xe0, 0xb9, 0x6a, 0xa7, 0xdf, 0x69, 0x39, 0x51, 0x80, 0xd3, 0x6d, 0x49,
-	0x5a, 0xfc, 0x1a, 0x82, 0x69, 0x9d, 0x54, 0x0d, 0x9b, 0x02, 0x73, 0xb5, 0xd4, 0xae, 0x6e, 0x12,
-	0xbd, 0xc5, 0x15, 0x5e, 0x81, 0xa2, 0xbb, 0xce, 0x45, 0x77, 0x8b, 0x31, 0x59, 0xd3, 0xec, 0xad,
-	0xbb, 0x3e, 0x8b, 0x28, 0xb2, 0x33, 0xba, 0x04, 0x1d, 0x7e, 0x05, 0xc1, 0xd9, 0x10, 0x2a, 0x91,
-	0x4d, 0x00, 0xc5, 0x74, 0x2d, 0x19, 0x93, 0xc8, 0x1c, 0x14, 0x3d, 0x91, 0x8a, 0x23, 0xa5, 0x18,
-	0x23, 0x28, 0x4a, 0x4a, 0x29, 0x46, 0xff, 0x03, 0x52, 0x12, 0xaa, 0xfe, 0xab, 0x11, 0x54, 0x31,
-	0x9a, 0x35, 0x4c, 0x51, 0xfd, 0x4f, 0x22, 0x2a, 0xb1, 0x52, 0x9d, 0xd6, 0x93, 0xc9, 0xf0, 0x97,
-	0x11, 0x3c, 0x19, 0xc4, 0x24, 0xb2, 0xc4, 0x7d, 0x14, 0xd0, 0xd5, 0x44, 0x40, 0x22, 0x23, 0x3c,
-	0xa5, 0x27, 0x11, 0xd1, 0x6d, 0xd3, 0xaa, 0x8e, 0xb1, 0x6d, 0x38, 0x3b, 0x89, 0xca, 0x3d, 0x12,
-	0xb3, 0x6d, 0x0b, 0x8c, 0x49, 0x92, 0x72, 0x6b, 0x12, 0x74, 0x54, 0xb9, 0x43, 0xa8, 0x44, 0xca,
-	0xbd, 0x3f, 0x46, 0xb9, 0x03, 0x98, 0x84, 0xca, 0xad, 0x25, 0x52, 0x71, 0xa4, 0x14, 0xa3, 0xdc,
-	0xa3, 0x92, 0x52, 0x8a, 0x53, 0x6e, 0x4d, 0x82, 0x8e, 0x2a, 0x52, 0x10, 0x95, 0x48, 0x91, 0xc6,
-	0x62, 0x14, 0xa9, 0x17, 0x92, 0x50, 0x91, 0xb4, 0x24, 0x22, 0x6a, 0x69, 0x41, 0x30, 0x31, 0x96,
-	0x86, 0x63, 0x2c, 0xad, 0x17, 0x4f, 0x8c, 0xa5, 0x69, 0xc9, 0x64, 0xb8, 0x0d, 0x27, 0x5c, 0x10,
-	0x96, 0x58, 0x7b, 0x0e, 0x50, 0x20, 0x17, 0xb9, 0x40, 0x5c, 0xae, 0x96, 0x50, 0x6d, 0x8e, 0x3a,
-	0xe2, 0xd7, 0xf8, 0x25, 0x38, 0xe6, 0x7d, 0x78, 0xc3, 0xb0, 0x78, 0x9f, 0x3d, 0x48, 0x3f, 0x5b,
-	0x12, 0x7f, 0x76, 0xd9, 0x9d, 0x17, 0xfd, 0xe8, 0xa4, 0x23, 0x7a, 0x89, 0xbf, 0x8f, 0x60, 0x2e,
-	0xa4, 0xa2, 0x5a, 0xa3, 0x4a, 0x6a, 0x15, 0x8b, 0xbc, 0xd4, 0x22, 0x36, 0x77, 0xf5, 0x87, 0x28,
-	0x8c, 0xe7, 0x93, 0x35, 0x95, 0x72, 0x52, 0x7d, 0x46, 0x51, 0x5c, 0x33, 0x9a, 0x34, 0x35, 0xfe,
-	0x09, 0x82, 0x2b, 0x0c, 0x93, 0x0f, 0x51, 0x4e, 0x89, 0xc7, 0x29, 0xda, 0x45, 0x2e, 0x5a, 0xf6,
-	0x35, 0xef, 0xd3, 0x32, 0x1a, 0x5d, 0xb2, 0x52, 0xcd, 0xc0, 0x5f, 0x45, 0x70, 0x8e, 0x27, 0x5e,
-	0x1e, 0xd0, 0xc3, 0x92, 0xda, 0xbd, 0xc8, 0x38, 0x24, 0x68, 0xb7, 0x80, 0x0c, 0x7f, 0x1e, 0x4e,
-	0x7a, 0x4a, 0x26, 0x46, 0x32, 0x41, 0x91, 0x5c, 0x12, 0xeb, 0x99, 0x18, 0x82, 0xa7, 0xc0, 0xa2,
-	0x6f, 0x7f, 0x09, 0xc1, 0x19, 0xb6, 0x79, 0x4c, 0xd1, 0x05, 0x9b, 0x36, 0x49, 0x11, 0x3c, 0xcd,
-	0x45, 0xe0, 0x31, 0xf7, 0xf4, 0x5d, 0xb0, 0x4d, 0x53, 0xd5, 0x04, 0x1a, 0xfc, 0x05, 0x98, 0xaa,
-	0x6b, 0xd6, 0x16, 0xb1, 0x2a, 0x16, 0xa9, 0x9a, 0x96, 0xce, 0x03, 0x71, 0x84, 0x82, 0x98, 0xe7,
-	0x82, 0xf8, 0x7f, 0x3a, 0x59, 0x65, 0x73, 0xa3, 0x08, 0x8e, 0xd7, 0xe3, 0x08, 0xf0, 0xb7, 0x11,
-	0xcc, 0xf2, 0xee, 0x27, 0xc6, 0x83, 0x86, 0xc6, 0x15, 0xc8, 0xd1, 0x34, 0xe1, 0xeb, 0x5d, 0xc6,
-	0x46, 0x26, 0x7c, 0x15, 0xd0, 0xe2, 0xef, 0x21, 0x28, 0xf1, 0x22, 0x6c, 0x62, 0xd5, 0x8d, 0x86,
-	0xc6, 0xf5, 0x0b, 0xc7, 0x62, 0xfc, 0x42, 0x34, 0xc4, 0xee, 0x30, 0xe2, 0xf8, 0x85, 0xb6, 0x34,
-	0x35, 0xfe, 0x29, 0x82, 0x2b, 0xbc, 0xab, 0x54, 0xa2, 0x17, 0x3b, 0x4e, 0xd1, 0xde, 0x92, 0xbc,
-	0x51, 0x25, 0xb9, 0xb2, 0xb9, 0x76, 0xba, 0x29, 0x22, 0x0d, 0x10, 0x1b, 0xe5, 0x89, 0x34, 0x1a,
-	0x20, 0x36, 0xd0, 0xe9, 0xb6, 0x24, 0x2d, 0xfe, 0x2b, 0x82, 0xa5, 0x90, 0xc7, 0x25, 0x0f, 0x1d,
-	0x62, 0x35, 0xb4, 0x5a, 0x85, 0x83, 0xdc, 0x68, 0x18, 0x8e, 0xc1, 0x57, 0x8c, 0x93, 0x14, 0xfa,
-	0xdd, 0x64, 0x17, 0xbc, 0xc4, 0xf8, 0x47, 0xd6, 0x53, 0xf6, 0x99, 0x47, 0x17, 0xf4, 0x9c, 0xb5,
-	0x2b, 0x0e, 0xf8, 0xcf, 0x08, 0x6e, 0xa6, 0x58, 0xa6, 0xc8, 0x63, 0x4d, 0xd1, 0x35, 0xae, 0xee,
-	0x62, 0x8d, 0x22, 0x67, 0x76, 0xc3, 0xea, 0x7f, 0x3a, 0x7e, 0x07, 0xc1, 0xb3, 0x71, 0xcb, 0x49,
-	0xb6, 0x93, 0x53, 0x74, 0x61, 0x2b, 0xdc, 0x85, 0x09, 0xc1, 0x24, 0xda, 0xcb, 0x35, 0xd2, 0xdf,
-	0x54, 0x1a, 0x07, 0x70, 0x53, 0x27, 0x0d, 0xc7, 0x68, 0xb4, 0x88, 0x5e, 0xd1, 0xec, 0x4a, 0x83,
-	0xb4, 0xa3, 0xeb, 0x50, 0x62, 0xe2, 0x00, 0x4e, 0x06, 0x85, 0xb1, 0x5b, 0xb0, 0x6f, 0x93, 0x36,
-	0x27, 0x0e, 0x68, 0xa7, 0x9a, 0x81, 0x7f, 0x83, 0xe0, 0x3a, 0x8d, 0x26, 0x2b, 0xd5, 0x4d, 0xa3,
-	0xa6, 0xa7, 0xb4, 0x9f, 0xd3, 0x14, 0xfa, 0x0b, 0x5c, 0xe8, 0x34, 0x94, 0x5c, 0x74, 0x99, 0xa6,
-	0x31, 0x9a, 0xcb, 0x76, 0xfa, 0x69, 0xf8, 0x17, 0x08, 0xae, 0x26, 0x2c, 0x42, 0x64, 0x1d, 0x67,
-	0xe8, 0x0a, 0x96, 0xd2, 0xae, 0x40, 0x64, 0x12, 0x17, 0xed, 0x94, 0x73, 0xf0, 0x0f, 0x11, 0x5c,
-	0x12, 0xa2, 0x16, 0xc6, 0xf9, 0x4f, 0x52, 0xd8, 0x0b, 0xfc, 0x30, 0x84, 0xfb, 0x75, 0x61, 0xe0,
-	0x3f, 0x5b, 0x4d, 0x41, 0x8f, 0x7f, 0x8c, 0xe0, 0xb2, 0x10, 0x6e, 0xcc, 0x25, 0xf2, 0x6c, 0x8c,
-	0x92, 0xf3, 0x01, 0xc7, 0x5c, 0x27, 0x4b, 0xd5, 0x54, 0x33, 0xf0, 0x9b, 0x08, 0x2e, 0xa6, 0xd6,
-	0x8c, 0x73, 0x14, 0xf1, 0xff, 0xa5, 0x40, 0x2c, 0x52, 0x8a, 0xf3, 0xd5, 0x14, 0xfa, 0xf0, 0x16,
-	0x82, 0x79, 0xb1, 0x80, 0x85, 0x87, 0xf0, 0x34, 0x45, 0x7b, 0x33, 0x8d, 0x7c, 0x85, 0x27, 0xf1,
-	0x85, 0x6a, 0x9a, 0x09, 0xf8, 0x47, 0x71, 0x2a, 0x11, 0x73, 0x69, 0x7e, 0x2a, 0x35, 0x64, 0xf1,
-	0xf5, 0x59, 0x00, 0x59, 0x74, 0x91, 0x76, 0x63, 0x33, 0x31, 0xe4, 0x98, 0x48, 0x72, 0x26, 0x26,
-	0x36, 0x13, 0x60, 0x8e, 0x09, 0x27, 0xe7, 0xaa, 0xe9, 0xa6, 0xd0, 0x43, 0xd3, 0x0b, 0xc5, 0xfb,
-	0x8d, 0x78, 0xce, 0xc7, 0x1c, 0x9a, 0x5e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xee, 0x6f, 0x2a,
-	0xfe, 0x2d, 0x82, 0x67, 0x24, 0x16, 0x24, 0xb2, 0xd1, 0x59, 0xba, 0x9a, 0x72, 0x3f, 0xab, 0x11,
-	0x19, 0xeb, 0x15, 0xbb, 0x8f, 0x79, 0xf8, 0xe7, 0x08, 0x9e, 0x8e, 0x5b, 0x80, 0xf8, 0xfe, 0x74,
-	0x21, 0xe6, 0x00, 0x12, 0x82, 0x10, 0xdf, 0xa3, 0x2e, 0x92, 0x94, 0x73, 0xa8, 0xc3, 0x69, 0x35,
-	0x6d, 0x62, 0x39, 0x5d, 0xe0, 0x36, 0xd1, 0xac, 0xea, 0x66, 0x0f, 0xcc, 0x28, 0xee, 0x52, 0x8c,
-	0xf5, 0xde, 0xa3, 0xec, 0x7c, 0x04, 0x77, 0x29, 0xb3, 0xee, 0x17, 0x39, 0xd6, 0xdb, 0x4a, 0x33,
-	0xe1, 0xe6, 0x30, 0x40, 0x17, 0x88, 0xf2, 0x5e, 0x11, 0xce, 0xc9, 0x9e, 0x5e, 0xcb, 0xb0, 0xaf,
-	0xb3, 0x46, 0x67, 0xa7, 0x49, 0x68, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x99, 0xae, 0xed, 0x34, 0x89,
-	0x3a, 0xdc, 0xee, 0x79, 0xc2, 0x9f, 0x85, 0x43, 0x4d, 0xcd, 0x72, 0x25, 0xd2, 0x6b, 0x74, 0x1b,
-	0x26, 0x2b, 0x1f, 0x4e, 0x73, 0xf9, 0xad, 0xd2, 0x19, 0x3d, 0x36, 0xb1, 0x61, 0xaa, 0x07, 0x9a,
-	0xd1, 0x41, 0xfc, 0x0c, 0x14, 0x68, 0x46, 0xa6, 0x66, 0xd8, 0x0e, 0x2d, 0x2c, 0x16, 0xe7, 0x8f,
-	0xf3, 0x53, 0x1e, 0x9a, 0xbd, 0xb5, 0x62, 0xd8, 0x8e, 0x3a, 0xe4, 0xb0, 0xbf, 0xf0, 0x3c, 0x0c,
-	0x1a, 0x8d, 0x66, 0xcb, 0xa1, 0x65, 0xc7, 0xe2, 0xfc, 0x31, 0x01, 0x92, 0x9d, 0x9a, 0xa9, 0xe9,
-	0xaa, 0x47, 0x8a, 0x35, 0x98, 0x0a, 0x85, 0x1c, 0x15, 0xc7, 0xac, 0x54, 0x6b, 0xa6, 0x4d, 0xa8,
-	0xff, 0x36, 0x5b, 0x0e, 0xab, 0x43, 0x4e, 0x46, 0xea, 0xa2, 0xb7, 0x58, 0x25, 0x59, 0x3d, 0x46,
-	0x02, 0xb2, 0x5f, 0x33, 0x17, 0xdd, 0xf9, 0x6b, 0xde, 0x74, 0xfc, 0x22, 0x1c, 0xed, 0xa6, 0xbd,
-	0xa3, 0xdc, 0x73, 0x49, 0xdc, 0x0f, 0x3b, 0x7e, 0x32, 0x3b, 0xc4, 0xf8, 0x06, 0x1c, 0xe9, 0x46,
-	0xd8, 0xdd, 0x55, 0x58, 0xad, 0x46, 0xc5, 0xd0, 0x69, 0xe9, 0xaf, 0xa0, 0x1e, 0xee, 0x50, 0x74,
-	0xe4, 0xac, 0xb6, 0x1a, 0x65, 0x1d, 0x97, 0xa1, 0xc0, 0x5c, 0xa5, 0x69, 0xd1, 0x3a, 0xdc, 0xc8,
-	0xfc, 0x79, 0xbe, 0x6b, 0x67, 0x0c, 0x68, 0x08, 0x5d, 0xf6, 0xa7, 0xa8, 0xdd, 0xd9, 0xb8, 0x0c,
-	0x63, 0x5d, 0x1c, 0xae, 0xbb, 0x6a, 0x59, 0x84, 0x15, 0xcf, 0xf8, 0x7b, 0xb0, 0xec, 0xd1, 0xa8,
-	0xa3, 0x9d, 0x69, 0x6c, 0x04, 0xab, 0x30, 0x5e, 0xd3, 0xdc, 0x3b, 0x9f, 0x17, 0xce, 0xd0, 0xe5,
-	0x10, 0xbb, 0x55, 0x73, 0x58, 0xe1, 0x2b, 0x7e, 0x4f, 0x0f, 0xba, 0x73, 0x17, 0x3b, 0x53, 0x55,
-	0x3a, 0x13, 0x5f, 0x87, 0x49, 0xd3, 0x32, 0x1e, 0x18, 0x9e, 0xa3, 0x0d, 0x49, 0xa9, 0x48, 0xa5,
-	0x34, 0xee, 0x13, 0x84, 0x84, 0x74, 0x04, 0x86, 0x0c, 0x9d, 0x34, 0x1c, 0xc3, 0xd9, 0xa1, 0x15,
-	0xa5, 0x82, 0xda, 0x79, 0xc6, 0x97, 0x61, 0x7c, 0xc3, 0xb0, 0x6c, 0x27, 0xca, 0x73, 0x1f, 0xa5,
-	0x3c, 0x40, 0xdf, 0x86, 0x18, 0x2e, 0xc2, 0xb0, 0x45, 0x1c, 0x6b, 0xa7, 0xd2, 0x34, 0x6b, 0x46,
-	0x75, 0x87, 0x55, 0x61, 0xa6, 0x04, 0x17, 0x54, 0xc7, 0xda, 0x59, 0xa5, 0x74, 0x6a, 0xd1, 0xea,
-	0x3e, 0xe0, 0x09, 0xc8, 0x6b, 0x8e, 0x43, 0xea, 0x4d, 0x87, 0x56, 0x4c, 0x06, 0x55, 0xff, 0x11,
-	0x2f, 0xc2, 0x7e, 0xf2, 0xb0, 0x69, 0x78, 0x8a, 0xe3, 0x15, 0xf5, 0x47, 0x13, 0x8b, 0xfa, 0x23,
-	0xdd, 0x29, 0xb4, 0xb2, 0x7f, 0x1a, 0xf6, 0x55, 0x2d, 0xd7, 0x1a, 0x58, 0x45, 0x87, 0x56, 0x1c,
-	0x0a, 0xea, 0xb0, 0x3b, 0xe8, 0x57, 0x79, 0xf0, 0xa7, 0xe0, 0xa8, 0xb7, 0xfa, 0x60, 0xf5, 0x6b,
-	0x5d, 0xab, 0x6e, 0x99, 0x1b, 0x1b, 0xac, 0x28, 0x10, 0xa3, 0xd4, 0x13, 0x74, 0x76, 0x6f, 0xe1,
-	0xeb, 0xa6, 0x37, 0x15, 0x5f, 0x80, 0x81, 0x3a, 0xa9, 0x9b, 0x2c, 0x9d, 0x3f, 0xc9, 0x4f, 0xf4,
-	0x91, 0xba, 0xa9, 0x52, 0x32, 0xac, 0xc2, 0x58, 0xc4, 0x63, 0xb3, 0x9c, 0xfc, 0x93, 0xfc, 0xb3,
-	0x31, 0xe4, 0x61, 0xd5, 0x51, 0x3b, 0x34, 0x82, 0xef, 0xc1, 0x78, 0xd3, 0x22, 0xdb, 0x15, 0xad,
-	0xe5, 0x98, 0xae, 0xfe, 0x11, 0xa7, 0xd2, 0x34, 0x8d, 0x86, 0xe3, 0x67, 0xd9, 0x45, 0xfb, 0x65,
-	0x13, 0x67, 0x95, 0xd2, 0xa9, 0x07, 0xdc, 0xf9, 0x0b, 0x2d, 0xc7, 0xec, 0x19, 0xc4, 0x97, 0x21,
-	0xb7, 0x49, 0x34, 0x9d, 0x58, 0x2c, 0xfd, 0x7d, 0x94, 0xdf, 0xd4, 0x41, 0x49, 0x54, 0x46, 0xaa,
-	0xbc, 0x89, 0xe0, 0x29, 0xf9, 0x68, 0xff, 0x0a, 0xe4, 0x98, 0xbd, 0x20, 0x09, 0x7b, 0x61, 0xb4,
-	0x78, 0x19, 0xa6, 0xe2, 0xcb, 0xbd, 0x86, 0x4e, 0xbd, 0x7b, 0x56, 0x3d, 0x26, 0xae, 0xd4, 0x96,
-	0x75, 0xe5, 0x0d, 0x04, 0x67, 0x25, 0x83, 0x86, 0xab, 0x90, 0xf7, 0x3d, 0x05, 0x92, 0xf0, 0x14,
-	0x3e, 0xf1, 0x9e, 0x41, 0x35, 0x61, 0x5a, 0x3a, 0x62, 0x5e, 0x84, 0x61, 0xe6, 0xac, 0xbb, 0x07,
-	0xe7, 0x88, 0x40, 0x09, 0x98, 0x6f, 0xa6, 0xe7, 0x66, 0xd1, 0xe9, 0x3e, 0x28, 0x7f, 0x40, 0x70,
-	0x46, 0xa6, 0x69, 0x20, 0x78, 0x02, 0xa2, 0x74, 0x27, 0xe0, 0x6d, 0x18, 0x17, 0x9c, 0x32, 0x99,
-	0x24, 0x83, 0x3c, 0x60, 0x73, 0x4e, 0x98, 0x1e, 0x4f, 0x93, 0x0d, 0x78, 0x1a, 0xe5, 0x15, 0x04,
-	0x4a, 0x72, 0xbf, 0x01, 0x9e, 0x05, 0x1c, 0xae, 0x41, 0x77, 0xba, 0x90, 0x46, 0xed, 0x80, 0x08,
-	0x42, 0xee, 0x36, 0x13, 0x72, 0xb7, 0xc7, 0x01, 0xfc, 0x84, 0xa0, 0xa1, 0x53, 0x34, 0x05, 0xb5,
-	0xc0, 0x46, 0xca, 0xba, 0xf2, 0xf7, 0x90, 0x78, 0x85, 0x16, 0x92, 0x0e, 0xd1, 0x34, 0x8c, 0x06,
-	0xf3, 0x10, 0x1d, 0xf5, 0x1a, 0xb1, 0x7b, 0x56, 0x1c, 0xc2, 0x9e, 0x0d, 0x61, 0x3f, 0x07, 0xfb,
-	0xd7, 0x8d, 0x86, 0x66, 0xed, 0x54, 0xaa, 0x9b, 0xa4, 0xba, 0x65, 0xb7, 0xea, 0x34, 0x44, 0x29,
-	0xa8, 0x23, 0xde, 0xf0, 0x22, 0x1b, 0xc5, 0xe7, 0x61, 0x2c, 0x98, 0x3d, 0x23, 0x0f, 0xbd, 0xf0,
-	0x63, 0x58, 0x1d, 0x25, 0xbd, 0x49, 0x2d, 0xf2, 0xd0, 0x51, 0x5e, 0xce, 0xc2, 0x69, 0x89, 0x56,
-	0x86, 0x47, 0xb6, 0xe2, 0xb0, 0x59, 0x64, 0xfb, 0x30, 0x0b, 0x7c, 0x02, 0x8a, 0xeb, 0x9a, 0x4d,
-	0xfc, 0xa3, 0xd3, 0x13, 0x4b, 0xc1, 0x1d, 0xf2, 0x0e, 0xcc, 0x63, 0x00, 0x0d, 0xd2, 0xf6, 0x5f,
-	0x0f, 0x7a, 0x82, 0x6d, 0x90, 0xb6, 0xf7, 0x76, 0x16, 0xf0, 0x86, 0x69, 0x6d, 0x31, 0xa4, 0x7e,
-	0x3f, 0x5a, 0xce, 0x5b, 0x9a, 0xfb, 0x86, 0x62, 0xbd, 0xcf, 0x1a, 0xd3, 0xc6, 0x5d, 0xe7, 0xa8,
-	0xd9, 0x66, 0x83, 0xc5, 0x46, 0xec, 0x09, 0xdf, 0x82, 0xc1, 0xaa, 0xd6, 0xb2, 0x09, 0x0b, 0x83,
-	0x4a, 0xd2, 0x4d, 0x23, 0x8b, 0xee, 0x2c, 0xd5, 0x9b, 0xac, 0xbc, 0x91, 0x85, 0x53, 0x89, 0x8d,
-	0x1c, 0x8f, 0x6c, 0x33, 0x6e, 0xfa, 0x6b, 0xf0, 0x76, 0x61, 0x56, 0xb2, 0xcf, 0xa4, 0x77, 0x05,
-	0xbd, 0x3e, 0x79, 0x20, 0x8d, 0x4f, 0xee, 0x55, 0xfd, 0xc1, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17,
-	0xbf, 0xbf, 0x79, 0xa9, 0xfd, 0x1d, 0x12, 0xec, 0x2f, 0xc7, 0xcc, 0x0a, 0x3c, 0x33, 0x53, 0xbe,
-	0x95, 0x83, 0x33, 0x32, 0x3d, 0x2e, 0xf8, 0x24, 0x14, 0x3b, 0x85, 0x62, 0xb6, 0x4d, 0x05, 0x15,
-	0xfc, 0xa1, 0xb2, 0xee, 0x5e, 0xaa, 0xba, 0x95, 0x64, 0xd7, 0x08, 0x32, 0x31, 0x97, 0xaa, 0xce,
-	0x27, 0xe9, 0xa5, 0x4a, 0xeb, 0x79, 0x72, 0x55, 0x53, 0x37, 0xeb, 0x9a, 0xd1, 0x60, 0xbe, 0x83,
-	0x3d, 0x05, 0x0f, 0x83, 0x81, 0x3e, 0xaf, 0x43, 0x39, 0xf9, 0xeb, 0xd0, 0x1a, 0x4c, 0xfa, 0x4a,
-	0x18, 0x3d, 0x43, 0xf2, 0x49, 0x67, 0xc8, 0xb8, 0x3f, 0x37, 0x74, 0x8c, 0x84, 0xb8, 0xb2, 0x23,
-	0x8a, 0x71, 0x1d, 0x4a, 0xc1, 0xd5, 0xbb, 0x05, 0x31, 0xae, 0xe2, 0xc3, 0xae, 0xd0, 0xd7, 0x61,
-	0xb7, 0x0c, 0x63, 0x9b, 0x44, 0xb3, 0x9c, 0x75, 0xa2, 0x75, 0xd1, 0x41, 0x12, 0xab, 0xd1, 0xce,
-	0x9c, 0x2e, 0x9f, 0xe4, 0x10, 0xa5, 0x98, 0x1c, 0xa2, 0x44, 0xee, 0x0a, 0xc3, 0xfd, 0xdc, 0x15,
-	0xba, 0x31, 0xe7, 0x3e, 0xf9, 0x98, 0xf3, 0x6f, 0x08, 0x94, 0xe4, 0x7e, 0xab, 0x8f, 0xec, 0x70,
-	0xef, 0x0d, 0x43, 0x06, 0x82, 0x17, 0x9e, 0xe7, 0x61, 0x98, 0xde, 0x17, 0x7d, 0xbf, 0x35, 0x28,
-	0xe1, 0xb7, 0x8a, 0xee, 0x0c, 0xf6, 0xa0, 0xbc, 0x8b, 0x82, 0xae, 0x60, 0x8f, 0x23, 0x6b, 0xbe,
-	0x88, 0x32, 0x29, 0xdc, 0x7d, 0x36, 0x31, 0xda, 0x18, 0x08, 0x0a, 0x53, 0xf9, 0x23, 0x82, 0x53,
-	0xc9, 0x4d, 0x30, 0xfd, 0x06, 0xe0, 0x1f, 0xc7, 0x8a, 0x7e, 0x99, 0x81, 0xd3, 0x12, 0xad, 0x64,
-	0xee, 0x9a, 0x74, 0xe2, 0x68, 0x46, 0xcd, 0x96, 0xda, 0x24, 0x9f, 0xf8, 0x91, 0xad, 0x29, 0x1c,
-	0x21, 0x0d, 0xf4, 0x13, 0x21, 0xed, 0x5a, 0xc5, 0xbf, 0x86, 0x60, 0x46, 0xbe, 0x03, 0x4c, 0xe6,
-	0xcc, 0xdb, 0x9b, 0x2b, 0xd8, 0x5b, 0x08, 0x52, 0xf6, 0x7a, 0x25, 0x63, 0x3b, 0xe8, 0x87, 0x41,
-	0x9e, 0x87, 0x61, 0x81, 0x8d, 0x0c, 0xe2, 0xac, 0x04, 0xe2, 0xd7, 0x43, 0x7a, 0x28, 0xaa, 0x0a,
-	0xf5, 0xab, 0x87, 0xcb, 0x30, 0x55, 0xd3, 0x9c, 0x9e, 0x9e, 0x87, 0x70, 0x07, 0x40, 0x57, 0xb2,
-	0x1e, 0x1d, 0x6f, 0x2b, 0xbd, 0xb0, 0x89, 0xa3, 0xcf, 0xd9, 0x14, 0xfa, 0x3c, 0x90, 0x68, 0xa3,
-	0xa1, 0x40, 0x4f, 0x79, 0x07, 0xc1, 0xd1, 0x98, 0x2e, 0x4b, 0x3c, 0x09, 0x43, 0x5e, 0x77, 0x59,
-	0x67, 0xdf, 0xf2, 0xf4, 0xb9, 0xac, 0xe3, 0x15, 0x38, 0xd4, 0x39, 0xc8, 0x37, 0x0c, 0x2b, 0xc5,
-	0xa5, 0x15, 0xb3, 0x73, 0x7c, 0xd9, 0xb0, 0x48, 0x9a, 0xe3, 0x57, 0x66, 0xb3, 0x3f, 0x07, 0x93,
-	0xc2, 0xf6, 0xcd, 0xb8, 0xd5, 0x48, 0xc7, 0xec, 0xca, 0xef, 0x10, 0x1c, 0x8b, 0xeb, 0xdc, 0xdb,
-	0x93, 0xaf, 0xec, 0x95, 0x3c, 0x62, 0x1d, 0xf4, 0xcf, 0x10, 0x4c, 0x25, 0x75, 0x00, 0xc6, 0xad,
-	0xe6, 0x91, 0x9a, 0x6d, 0x2c, 0xf2, 0x7f, 0xe5, 0x21, 0x65, 0xa3, 0x09, 0x9e, 0x83, 0x83, 0xb4,
-	0x97, 0x25, 0x9c, 0xf6, 0xf5, 0xd6, 0x34, 0xd6, 0x20, 0xed, 0x50, 0xd2, 0x37, 0x52, 0x79, 0xc9,
-	0xf4, 0x57, 0x79, 0x79, 0x5c, 0x1b, 0x91, 0xaf, 0x8d, 0xc8, 0xe8, 0x4e, 0x5e, 0x42, 0x77, 0xee,
-	0xc0, 0x38, 0xcb, 0x69, 0x33, 0x8c, 0x46, 0xc3, 0x21, 0xd6, 0xb6, 0x56, 0x4b, 0xbe, 0xb7, 0x1c,
-	0x64, 0x13, 0x29, 0xbc, 0x32, 0x9b, 0x16, 0xac, 0xbb, 0x14, 0x76, 0x55, 0x77, 0xe9, 0x09, 0xe1,
-	0x20, 0x4d, 0x08, 0x27, 0x2e, 0xb2, 0x14, 0xfb, 0x2e, 0xb2, 0x74, 0xef, 0x19, 0xc3, 0xd2, 0xf7,
-	0x8c, 0x4e, 0xaa, 0x7f, 0xdf, 0x2e, 0x52, 0xfd, 0x23, 0xbb, 0x4a, 0xf5, 0xbb, 0x3e, 0x78, 0x2e,
-	0x6d, 0xb7, 0x5b, 0xc7, 0x5b, 0xa1, 0x5e, 0x6f, 0x15, 0x77, 0xbf, 0x59, 0x87, 0xc3, 0x9d, 0x0a,
-	0x79, 0xa8, 0x6a, 0xea, 0xd9, 0xf1, 0x4c, 0x6c, 0x0d, 0x3c, 0x58, 0x37, 0x3d, 0x44, 0x78, 0xc3,
-	0xca, 0x0f, 0x10, 0x27, 0xa5, 0x2d, 0x3a, 0x59, 0x64, 0xcc, 0x03, 0x49, 0x98, 0x47, 0x4f, 0xa4,
-	0x93, 0x49, 0x11, 0xe9, 0x28, 0x1f, 0x22, 0x38, 0x1e, 0xdb, 0xad, 0xed, 0x86, 0x7a, 0xac, 0x17,
-	0xbc, 0xa1, 0xd5, 0x7d, 0x51, 0x83, 0x37, 0x74, 0x5b, 0xab, 0x93, 0x7e, 0x3f, 0xbd, 0x67, 0xa7,
-	0x4a, 0x57, 0xe3, 0x07, 0xe4, 0x6f, 0xd6, 0xdf, 0xe0, 0x6d, 0x92, 0xa8, 0x3b, 0xe1, 0x24, 0x14,
-	0x59, 0x7f, 0x48, 0xaf, 0x08, 0xbc, 0x21, 0x2a, 0x82, 0x8e, 0x53, 0xcf, 0xc8, 0x3b, 0xf5, 0x98,
-	0x3c, 0xb5, 0xf2, 0x75, 0x04, 0x33, 0x29, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x14, 0xc8, 0xa7, 0xf6,
-	0xbb, 0x33, 0x71, 0xd0, 0x7e, 0x9d, 0x81, 0xe7, 0x76, 0xd7, 0x95, 0xbc, 0x67, 0x3a, 0xdf, 0xcd,
-	0xd5, 0x65, 0x02, 0xb9, 0xba, 0x7b, 0x80, 0xa3, 0xdd, 0x2f, 0xcc, 0xbe, 0xcf, 0xca, 0x75, 0xb8,
-	0xaa, 0x63, 0x91, 0x16, 0x56, 0x3c, 0x01, 0xf9, 0xaa, 0xd9, 0x70, 0x2c, 0xb3, 0x46, 0x15, 0x6d,
-	0x58, 0xf5, 0x1f, 0x71, 0x09, 0x0e, 0x84, 0x1a, 0xb9, 0xcc, 0x46, 0xcd, 0x8b, 0xcc, 0x87, 0xd4,
-	0xb1, 0x40, 0x7f, 0xd5, 0x9d, 0x46, 0x6d, 0x47, 0x79, 0x2d, 0x0b, 0x37, 0x76, 0xd1, 0xf5, 0x8c,
-	0xef, 0xf5, 0xfa, 0xbd, 0x11, 0xc1, 0x6f, 0x0a, 0xa4, 0x38, 0x07, 0xd2, 0xce, 0x7b, 0x74, 0x9f,
-	0x14, 0xe6, 0x50, 0xf9, 0xfb, 0x32, 0xb0, 0xdb, 0x7d, 0x99, 0x05, 0x1c, 0xee, 0x35, 0x63, 0x15,
-	0x8a, 0xac, 0x3a, 0x6a, 0x04, 0x94, 0xd0, 0x4b, 0x61, 0xf9, 0xbb, 0x98, 0x0b, 0xec, 0xa2, 0xf2,
-	0x27, 0x04, 0xd7, 0xfa, 0x6c, 0xd9, 0x16, 0x60, 0x40, 0x02, 0x0c, 0x1f, 0xad, 0xe2, 0x2a, 0x5f,
-	0xc9, 0xc2, 0xb5, 0x3e, 0xdb, 0xea, 0xfe, 0x5b, 0x6d, 0x35, 0xe4, 0xb1, 0x07, 0xc4, 0x1e, 0x7b,
-	0x50, 0xde, 0x63, 0x0b, 0x55, 0x47, 0xe4, 0x00, 0xf2, 0x22, 0x07, 0xf0, 0x72, 0x16, 0xae, 0xf4,
-	0xd3, 0x1a, 0x28, 0x67, 0xf9, 0x52, 0x9c, 0x1f, 0x5b, 0x7e, 0xd7, 0xf2, 0x3f, 0x40, 0x70, 0x31,
-	0x6d, 0x9b, 0xe3, 0x7f, 0xb4, 0xc9, 0x8b, 0xcf, 0x2a, 0xe5, 0xf7, 0x08, 0x2e, 0xa4, 0x6a, 0x8d,
-	0xdc, 0x33, 0x17, 0xc0, 0xbd, 0x35, 0x64, 0x76, 0x77, 0x6b, 0xf8, 0xcb, 0x10, 0x5c, 0xee, 0xe3,
-	0x37, 0x1e, 0x3d, 0xdb, 0x81, 0x02, 0xdb, 0x71, 0x12, 0x8a, 0x9d, 0xed, 0x60, 0x3a, 0x5f, 0x50,
-	0xc1, 0x1f, 0xe2, 0xa5, 0x10, 0xb2, 0x7b, 0x90, 0x42, 0xe8, 0xb7, 0x9e, 0x38, 0xb8, 0xb7, 0x29,
-	0x84, 0xdc, 0x23, 0x4d, 0x21, 0xe4, 0xfb, 0x4e, 0x21, 0xdc, 0x07, 0xd6, 0xa1, 0xca, 0x38, 0xb2,
-	0x32, 0x9c, 0xd7, 0x24, 0x70, 0x36, 0xa6, 0xcd, 0x95, 0x72, 0x61, 0xc5, 0xb8, 0xb1, 0x66, 0x78,
-	0xa8, 0xd7, 0x48, 0x0a, 0x41, 0x7f, 0x2e, 0xa3, 0xf2, 0x20, 0xa1, 0xf2, 0x55, 0x98, 0xe8, 0x51,
-	0xa7, 0x8a, 0x45, 0x5a, 0x5d, 0xf8, 0x45, 0x0a, 0x7f, 0x26, 0x56, 0x71, 0xca, 0xba, 0xea, 0x4e,
-	0x61, 0x4b, 0x38, 0xd4, 0xe6, 0x0d, 0x47, 0xca, 0x93, 0xfb, 0xfa, 0x29, 0x4f, 0x46, 0x7a, 0x0d,
-	0x47, 0x38, 0xbd, 0x86, 0xdd, 0x9b, 0xd6, 0xfe, 0xf4, 0xb9, 0x85, 0xd1, 0x5d, 0xe4, 0x16, 0xc6,
-	0x76, 0xd7, 0x46, 0xf8, 0x0c, 0x14, 0x75, 0x52, 0xd3, 0x76, 0x3c, 0xd5, 0x4c, 0xee, 0x89, 0x04,
-	0x4a, 0x4d, 0x55, 0x51, 0x79, 0x35, 0x0b, 0x17, 0xd3, 0xfe, 0x06, 0xeb, 0xe3, 0x77, 0x2f, 0x2b,
-	0x7e, 0x9c, 0xe0, 0x55, 0xba, 0xae, 0xa6, 0xfe, 0x01, 0x51, 0x20, 0x3c, 0xe8, 0x31, 0x94, 0xc1,
-	0xa0, 0xa1, 0xf0, 0x0f, 0xc1, 0x9c, 0xe0, 0x10, 0xdc, 0xa3, 0x5c, 0xa0, 0xf2, 0x76, 0x06, 0x66,
-	0xd3, 0xfc, 0xc0, 0x4c, 0xb8, 0x1f, 0xfc, 0xd3, 0x37, 0xb3, 0xdb, 0xd3, 0x77, 0xaf, 0x76, 0x91,
-	0x2f, 0xdd, 0x01, 0x81, 0x74, 0xbb, 0xd6, 0x39, 0x28, 0x9f, 0x07, 0xf9, 0x30, 0x03, 0x29, 0x7f,
-	0xfa, 0xf6, 0xc9, 0x10, 0x26, 0xaf, 0xac, 0x33, 0xc8, 0x2d, 0xeb, 0x74, 0xfb, 0x11, 0x72, 0xf2,
-	0xfd, 0x08, 0xca, 0x3f, 0x32, 0x70, 0x7e, 0x2f, 0x3c, 0xca, 0x27, 0x54, 0xe8, 0x3d, 0x19, 0xf7,
-	0x5c, 0x8a, 0x8c, 0xbb, 0xf2, 0xcf, 0x0c, 0x5c, 0x48, 0xf5, 0x4b, 0xc4, 0xc7, 0x82, 0x8f, 0x08,
-	0xde, 0x4f, 0x29, 0xe6, 0xd2, 0xe4, 0x99, 0xbf, 0x98, 0x15, 0x09, 0x5e, 0xd4, 0x43, 0xf2, 0x58,
-	0xf0, 0xb1, 0x2d, 0x2c, 0xb9, 0x7e, 0x7a, 0xdf, 0x7f, 0x95, 0x81, 0xb9, 0x94, 0xbf, 0x10, 0x7d,
-	0xbc, 0x0f, 0x81, 0x7d, 0x98, 0x71, 0x60, 0x3f, 0xfd, 0x73, 0xd9, 0xa8, 0x39, 0xc4, 0xa2, 0x9f,
-	0x3a, 0x0e, 0x93, 0x4b, 0xf7, 0x97, 0x6e, 0xaf, 0x55, 0x96, 0xcb, 0x2b, 0x6b, 0x4b, 0x6a, 0x65,
-	0xed, 0xd3, 0xab, 0x4b, 0x95, 0xf2, 0xed, 0xfb, 0x0b, 0x2b, 0xe5, 0x5b, 0xa3, 0x4f, 0xe0, 0x93,
-	0x70, 0x34, 0xfa, 0x7a, 0x61, 0x65, 0xa5, 0x42, 0x47, 0x47, 0x11, 0x3e, 0x05, 0xc7, 0xa3, 0x04,
-	0x8b, 0x2b, 0x77, 0xee, 0x2e, 0x31, 0x92, 0xcc, 0xcd, 0xf5, 0xb7, 0xdf, 0x3f, 0x81, 0xde, 0x7d,
-	0xff, 0x04, 0x7a, 0xef, 0xfd, 0x13, 0x08, 0x0e, 0x57, 0xcd, 0x3a, 0x4f, 0x1e, 0x37, 0x87, 0x16,
-	0x9a, 0xc6, 0xaa, 0x1b, 0xce, 0xae, 0xa2, 0xcf, 0xcc, 0x3d, 0x30, 0x9c, 0xcd, 0xd6, 0x7a, 0xa9,
-	0x6a, 0xd6, 0xe7, 0x02, 0xff, 0xf3, 0xb4, 0xf4, 0x80, 0x34, 0xbc, 0xff, 0xb2, 0xca, 0xfe, 0xfd,
-	0xe9, 0x0d, 0xad, 0x69, 0x6c, 0x5f, 0x5a, 0xcf, 0xd1, 0xb1, 0xcb, 0xff, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0x23, 0x07, 0xbe, 0xe1, 0x55, 0x00, 0x00,
+	0xd5, 0xef, 0xec, 0xda, 0x6b, 0xef, 0x59, 0xdb, 0xb1, 0xaf, 0x13, 0xc7, 0x7f, 0x13, 0x7b, 0x92,
+	0x26, 0xae, 0xe3, 0xac, 0x13, 0x27, 0x4d, 0x9a, 0xe6, 0x6b, 0xfb, 0x39, 0x8e, 0xfd, 0x65, 0xf5,
+	0xb9, 0x49, 0x34, 0x71, 0xd2, 0xef, 0x43, 0xa0, 0x65, 0xbc, 0x73, 0x1d, 0x8f, 0xbc, 0xbb, 0xb3,
+	0x9d, 0x99, 0xf5, 0xc6, 0x48, 0x3c, 0xf1, 0x80, 0x84, 0x5a, 0x41, 0x55, 0xf1, 0x50, 0x84, 0x00,
+	0x21, 0x81, 0x5a, 0x84, 0x54, 0x04, 0x42, 0xe5, 0xcf, 0x0b, 0x20, 0x21, 0x2a, 0x81, 0x4a, 0x9f,
+	0x78, 0x81, 0x07, 0x54, 0x10, 0x82, 0x37, 0x1e, 0x68, 0xdf, 0x90, 0xd0, 0xdc, 0xb9, 0xb3, 0xbb,
+	0x33, 0x73, 0xef, 0xcc, 0x9d, 0xf5, 0xa6, 0x05, 0x35, 0x6f, 0x9e, 0x3b, 0xe7, 0x9e, 0xf9, 0x9d,
+	0x7b, 0xcf, 0x39, 0xf7, 0xdc, 0x73, 0xce, 0x1a, 0xe6, 0xea, 0x5b, 0xd8, 0x5c, 0x2a, 0xa9, 0x1a,
+	0xae, 0x96, 0xf0, 0x92, 0x5a, 0xd3, 0x97, 0xf6, 0xce, 0x2f, 0xed, 0xe8, 0x96, 0x6d, 0x98, 0xfb,
+	0xf9, 0x9a, 0x69, 0xd8, 0x06, 0x1a, 0x75, 0x48, 0xf2, 0x94, 0x24, 0xaf, 0xd6, 0xf4, 0xfc, 0xde,
+	0xf9, 0xc9, 0x63, 0xf7, 0x0d, 0xe3, 0x7e, 0x19, 0x2f, 0x11, 0x92, 0xad, 0xfa, 0xf6, 0x92, 0x56,
+	0x37, 0x55, 0x5b, 0x37, 0xaa, 0xee, 0xa4, 0xc9, 0xe3, 0xc1, 0xf7, 0xb6, 0x5e, 0xc1, 0x96, 0xad,
+	0x56, 0x6a, 0x94, 0x60, 0x96, 0xf5, 0xe1, 0x92, 0x51, 0xa9, 0x34, 0x59, 0xc8, 0x2c, 0x0a, 0x5b,
+	0xb5, 0x76, 0xcb, 0xba, 0x65, 0x47, 0xd1, 0x34, 0x0c, 0x73, 0x77, 0xbb, 0x6c, 0x34, 0x5c, 0x1a,
+	0xf9, 0x3a, 0xf4, 0xdd, 0x70, 0x05, 0x42, 0x57, 0x20, 0x83, 0xf7, 0x70, 0xd5, 0xb6, 0xc6, 0xa5,
+	0xd9, 0xf4, 0x7c, 0x6e, 0x79, 0x2e, 0xcf, 0x90, 0x2d, 0x4f, 0xa9, 0xd7, 0x1c, 0x4a, 0x85, 0x4e,
+	0x90, 0x3f, 0x78, 0x0a, 0x06, 0xda, 0x5f, 0xa0, 0x09, 0xe8, 0x27, 0xaf, 0x8a, 0xba, 0x36, 0x2e,
+	0xcd, 0x4a, 0xf3, 0x69, 0xa5, 0x8f, 0x3c, 0x17, 0x34, 0x74, 0x05, 0xc0, 0x7d, 0xe5, 0x08, 0x3d,
+	0x9e, 0x9a, 0x95, 0xe6, 0x73, 0xcb, 0x93, 0x79, 0x77, 0x45, 0xf2, 0xde, 0x8a, 0xe4, 0x37, 0xbd,
+	0x15, 0x51, 0xb2, 0x84, 0xda, 0x79, 0x46, 0xe3, 0xd0, 0xb7, 0x87, 0x4d, 0x4b, 0x37, 0xaa, 0xe3,
+	0x69, 0x97, 0x29, 0x7d, 0x44, 0x47, 0xa1, 0xcf, 0x11, 0xde, 0xf9, 0x5c, 0x0f, 0x79, 0x93, 0x71,
+	0x1e, 0x0b, 0x1a, 0xfa, 0xaa, 0x04, 0x67, 0x3c, 0x91, 0x8b, 0xf8, 0x01, 0x2e, 0xd5, 0x9d, 0x7d,
+	0x28, 0x5a, 0xb6, 0x6a, 0xda, 0x58, 0x2b, 0xba, 0x48, 0x54, 0xdb, 0x36, 0xf5, 0xad, 0xba, 0x8d,
+	0xad, 0xf1, 0x5e, 0x82, 0xe7, 0xbf, 0x98, 0xa2, 0xbf, 0x40, 0xf9, 0xac, 0x79, 0x6c, 0xee, 0xb8,
+	0x5c, 0x88, 0xc8, 0x2b, 0x4d, 0x1e, 0x37, 0x1e, 0x53, 0x4e, 0x37, 0xc4, 0x48, 0xd1, 0x37, 0x25,
+	0x38, 0xcb, 0x80, 0x57, 0x32, 0x2a, 0xb5, 0x32, 0x66, 0x02, 0xcc, 0x10, 0x80, 0xcf, 0x8a, 0x01,
+	0x5c, 0xf5, 0xf8, 0x84, 0x21, 0x3e, 0xd1, 0x10, 0x25, 0x46, 0xaf, 0x49, 0xb0, 0xc0, 0x00, 0xb9,
+	0xad, 0xea, 0x65, 0x16, 0xc2, 0x3e, 0x82, 0xf0, 0xaa, 0x18, 0xc2, 0x75, 0xc2, 0x24, 0x0c, 0xef,
+	0x54, 0x43, 0x88, 0x12, 0x7d, 0x83, 0xbd, 0x80, 0x8e, 0x6e, 0x69, 0x45, 0xa3, 0x6e, 0x87, 0xe1,
+	0xf5, 0x13, 0x78, 0xcf, 0x88, 0xc1, 0x73, 0xd4, 0x4e, 0xbb, 0x55, 0xb7, 0xc3, 0x00, 0xe7, 0x1b,
+	0x82, 0xb4, 0xe8, 0x55, 0x09, 0xe6, 0x35, 0x5c, 0xd2, 0x2d, 0x02, 0xcc, 0xd1, 0x52, 0xab, 0xb4,
+	0x83, 0xb5, 0x3a, 0x73, 0xf1, 0xb2, 0x04, 0xdd, 0x15, 0x26, 0xba, 0xeb, 0x94, 0xc9, 0xa6, 0x6a,
+	0xed, 0xde, 0xf1, 0x58, 0x84, 0x91, 0x9d, 0xd4, 0x04, 0xe8, 0xd0, 0xcb, 0x12, 0x9c, 0x0a, 0xa0,
+	0xe2, 0xd9, 0x04, 0x10, 0x4c, 0x97, 0xe3, 0x31, 0xf1, 0xcc, 0x41, 0xd6, 0x62, 0xa9, 0x18, 0xab,
+	0x14, 0x61, 0x04, 0x39, 0xc1, 0x55, 0x8a, 0xd0, 0xff, 0x93, 0x9a, 0x00, 0x1d, 0x7a, 0x25, 0x84,
+	0x2a, 0x42, 0xb3, 0x06, 0x08, 0xaa, 0xa7, 0x62, 0x51, 0xf1, 0x95, 0xea, 0x84, 0x16, 0x4f, 0x86,
+	0xbe, 0x20, 0xc1, 0xe3, 0x7e, 0x4c, 0x3c, 0x4b, 0x1c, 0x24, 0x80, 0x2e, 0xc5, 0x02, 0xe2, 0x19,
+	0xe1, 0x9c, 0x16, 0x47, 0x44, 0xb6, 0x4d, 0x2d, 0xd9, 0xfa, 0x9e, 0x6e, 0xef, 0xc7, 0x2a, 0xf7,
+	0x50, 0xc4, 0xb6, 0xad, 0x50, 0x26, 0x71, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0xe5, 0x0e, 0xa0, 0xe2,
+	0x29, 0xf7, 0xa1, 0x08, 0xe5, 0xf6, 0x61, 0xe2, 0x2a, 0xb7, 0x1a, 0x4b, 0xc5, 0x58, 0xa5, 0x08,
+	0xe5, 0x1e, 0x16, 0x5c, 0xa5, 0x28, 0xe5, 0x56, 0x05, 0xe8, 0x88, 0x22, 0xf9, 0x51, 0xf1, 0x14,
+	0x69, 0x24, 0x42, 0x91, 0xda, 0x21, 0x71, 0x15, 0x49, 0x8d, 0x23, 0x22, 0x96, 0xe6, 0x07, 0x13,
+	0x61, 0x69, 0x28, 0xc2, 0xd2, 0xda, 0xf1, 0x44, 0x58, 0x9a, 0x1a, 0x4f, 0x86, 0x1a, 0x70, 0xcc,
+	0x01, 0x61, 0xf2, 0xb5, 0x67, 0x94, 0x00, 0x39, 0xc7, 0x04, 0xe2, 0x70, 0x35, 0xb9, 0x6a, 0x33,
+	0x65, 0xf3, 0x5f, 0xa3, 0x17, 0x61, 0xda, 0xfd, 0xf0, 0xb6, 0x6e, 0xb2, 0x3e, 0x7b, 0x98, 0x7c,
+	0x36, 0xcf, 0xff, 0xec, 0xba, 0x6e, 0x86, 0xb8, 0xde, 0x78, 0x4c, 0x99, 0xb0, 0x79, 0x2f, 0xd1,
+	0xb7, 0x25, 0x58, 0x0a, 0xa8, 0xa8, 0x5a, 0x2d, 0xe1, 0x72, 0xd1, 0xc4, 0x2f, 0xd6, 0xb1, 0xc5,
+	0x94, 0xfe, 0x08, 0x81, 0xf1, 0x5c, 0xbc, 0xa6, 0x12, 0x4e, 0x8a, 0xc7, 0x28, 0x8c, 0x6b, 0x41,
+	0x15, 0xa6, 0x46, 0x3f, 0x90, 0xe0, 0x22, 0xc5, 0xe4, 0x41, 0x14, 0x53, 0xe2, 0x31, 0x82, 0x76,
+	0x95, 0x89, 0x96, 0x7e, 0xcd, 0xfd, 0xb4, 0x88, 0x46, 0xe7, 0xcd, 0x44, 0x33, 0xd0, 0x97, 0x24,
+	0x38, 0xcd, 0x5a, 0x5e, 0x16, 0xd0, 0xa3, 0x82, 0xda, 0xbd, 0x4a, 0x39, 0xc4, 0x68, 0x37, 0x87,
+	0x0c, 0x7d, 0x06, 0x8e, 0xbb, 0x4a, 0xc6, 0x47, 0x32, 0x4e, 0x90, 0x9c, 0xe7, 0xeb, 0x19, 0x1f,
+	0xc2, 0xb4, 0x1d, 0xf1, 0x1e, 0x7d, 0x5e, 0x82, 0x93, 0x74, 0xf3, 0xa8, 0xa2, 0x73, 0x36, 0x6d,
+	0x82, 0x20, 0x78, 0x92, 0x89, 0xc0, 0x65, 0xee, 0xea, 0x3b, 0x67, 0x9b, 0x66, 0x4b, 0x31, 0x34,
+	0xe8, 0xb3, 0x30, 0x5b, 0x51, 0xcd, 0x5d, 0x6c, 0x16, 0x4d, 0x5c, 0x32, 0x4c, 0x8d, 0x05, 0x62,
+	0x92, 0x80, 0x58, 0x66, 0x82, 0x78, 0x9e, 0x4c, 0x56, 0xe8, 0xdc, 0x30, 0x82, 0x99, 0x4a, 0x14,
+	0x01, 0xfa, 0xba, 0x04, 0x8b, 0xac, 0xfb, 0x89, 0x7e, 0xbf, 0xaa, 0x32, 0x17, 0x64, 0x2a, 0x49,
+	0xf8, 0x7a, 0x87, 0xb2, 0x11, 0x09, 0x5f, 0x39, 0xb4, 0xe8, 0x5b, 0x12, 0xe4, 0x19, 0x08, 0x6d,
+	0x6c, 0x56, 0xf4, 0xaa, 0xca, 0xf4, 0x0b, 0xd3, 0x11, 0x7e, 0x21, 0x1c, 0x62, 0x37, 0x19, 0x31,
+	0xfc, 0x42, 0x43, 0x98, 0x1a, 0xfd, 0x50, 0x82, 0x8b, 0xac, 0xab, 0x54, 0xac, 0x17, 0x9b, 0x21,
+	0x68, 0xaf, 0x0b, 0xde, 0xa8, 0xe2, 0x5c, 0xd9, 0x52, 0x23, 0xd9, 0x14, 0x9e, 0x06, 0xf0, 0x8d,
+	0xf2, 0x58, 0x12, 0x0d, 0xe0, 0x1b, 0xe8, 0x7c, 0x43, 0x90, 0x16, 0xfd, 0x59, 0x82, 0xb5, 0x80,
+	0xc7, 0xc5, 0x0f, 0x6c, 0x6c, 0x56, 0xd5, 0x72, 0x91, 0x81, 0x5c, 0xaf, 0xea, 0xb6, 0xce, 0x56,
+	0x8c, 0xe3, 0x04, 0xfa, 0x9d, 0x78, 0x17, 0xbc, 0x46, 0xf9, 0x87, 0xe4, 0x29, 0x78, 0xcc, 0xc3,
+	0x02, 0x3d, 0x6b, 0x1e, 0x88, 0x03, 0xfa, 0xbd, 0x04, 0xd7, 0x12, 0x88, 0xc9, 0xf3, 0x58, 0xb3,
+	0x44, 0xc6, 0xdb, 0x07, 0x90, 0x91, 0xe7, 0xcc, 0xae, 0x9a, 0x9d, 0x4f, 0x47, 0xef, 0x48, 0xf0,
+	0x4c, 0x94, 0x38, 0xf1, 0x76, 0x32, 0x47, 0x04, 0xdb, 0x60, 0x0a, 0xc6, 0x05, 0x13, 0x6b, 0x2f,
+	0x97, 0x71, 0x67, 0x53, 0x49, 0x1c, 0xc0, 0x92, 0xc3, 0xa8, 0xda, 0x7a, 0xb5, 0x8e, 0xb5, 0xa2,
+	0x6a, 0x15, 0xab, 0xb8, 0x11, 0x96, 0x43, 0x8e, 0x88, 0x03, 0xc2, 0x20, 0x3c, 0x76, 0x2b, 0xd6,
+	0x4d, 0xdc, 0x08, 0xc3, 0xcf, 0x37, 0x12, 0xcd, 0x40, 0xbf, 0x90, 0xe0, 0x0a, 0x89, 0x26, 0x8b,
+	0xa5, 0x1d, 0xbd, 0xac, 0x25, 0xb4, 0x9f, 0x13, 0x04, 0xfa, 0x0d, 0x26, 0x74, 0x12, 0x4a, 0xae,
+	0x3a, 0x4c, 0x93, 0x18, 0xcd, 0x05, 0x2b, 0xf9, 0x34, 0xf4, 0x63, 0x09, 0x2e, 0xc5, 0x08, 0xc1,
+	0xb3, 0x8e, 0x93, 0x44, 0x82, 0xb5, 0xa4, 0x12, 0xf0, 0x4c, 0xe2, 0x9c, 0x95, 0x70, 0x0e, 0xfa,
+	0xae, 0x04, 0xe7, 0xb9, 0xa8, 0xb9, 0x71, 0xfe, 0xe3, 0x04, 0xf6, 0x0a, 0x3b, 0x0c, 0x61, 0x7e,
+	0x9d, 0x1b, 0xf8, 0x2f, 0x96, 0x12, 0xd0, 0xa3, 0xef, 0x4b, 0x70, 0x81, 0x0b, 0x37, 0xe2, 0x12,
+	0x79, 0x2a, 0x42, 0xc9, 0xd9, 0x80, 0x23, 0xae, 0x93, 0xf9, 0x52, 0xa2, 0x19, 0xe8, 0x0d, 0x09,
+	0xce, 0x25, 0xd6, 0x8c, 0xd3, 0x04, 0xf1, 0x7f, 0x27, 0x40, 0xcc, 0x53, 0x8a, 0x33, 0xa5, 0x04,
+	0xfa, 0xf0, 0xa6, 0x04, 0xcb, 0xfc, 0x05, 0xe6, 0x1e, 0xc2, 0xf3, 0x04, 0xed, 0xb5, 0x24, 0xeb,
+	0xcb, 0x3d, 0x89, 0xcf, 0x96, 0x92, 0x4c, 0x40, 0xdf, 0x8b, 0x52, 0x89, 0x88, 0x4b, 0xf3, 0x13,
+	0x89, 0x21, 0xf3, 0xaf, 0xcf, 0x67, 0x4b, 0x49, 0x26, 0x90, 0xd8, 0x8c, 0x0f, 0x39, 0x22, 0x92,
+	0x5c, 0x88, 0x88, 0xcd, 0x38, 0x98, 0x23, 0xc2, 0xc9, 0xa5, 0x52, 0xb2, 0x29, 0xe4, 0xd0, 0x74,
+	0x43, 0xf1, 0x4e, 0x23, 0x9e, 0x33, 0x11, 0x87, 0xa6, 0x1b, 0x71, 0x77, 0x12, 0xea, 0x5c, 0xb6,
+	0x3a, 0x9b, 0x8a, 0x7e, 0x29, 0xc1, 0xd3, 0x02, 0x02, 0xf1, 0x6c, 0x74, 0x91, 0x48, 0x53, 0xe8,
+	0x44, 0x1a, 0x9e, 0xb1, 0x5e, 0xb4, 0x3a, 0x98, 0x87, 0x7e, 0x24, 0xc1, 0x93, 0x51, 0x02, 0xf0,
+	0xef, 0x4f, 0x67, 0x23, 0x0e, 0x20, 0x2e, 0x08, 0xfe, 0x3d, 0xea, 0x1c, 0x4e, 0x38, 0x87, 0x38,
+	0x9c, 0x7a, 0xcd, 0xc2, 0xa6, 0xdd, 0x02, 0x6e, 0x61, 0xd5, 0x2c, 0xed, 0xb4, 0xc1, 0x0c, 0xe3,
+	0xce, 0x47, 0x58, 0xef, 0x5d, 0xc2, 0xce, 0x43, 0x70, 0x87, 0x30, 0x6b, 0x7d, 0x91, 0x61, 0xbd,
+	0xf5, 0x24, 0x13, 0x48, 0x9e, 0x30, 0x88, 0xb8, 0x82, 0x2b, 0x46, 0x18, 0xe4, 0x52, 0x44, 0x9e,
+	0xd0, 0x0f, 0xf2, 0x79, 0x5c, 0x31, 0x18, 0x79, 0xc2, 0x7a, 0x1c, 0xd1, 0xb5, 0x01, 0x80, 0xd6,
+	0x07, 0xe5, 0x3f, 0x0d, 0xc2, 0x69, 0xd1, 0xa3, 0x74, 0x1d, 0x06, 0x9b, 0xf0, 0xed, 0xfd, 0x1a,
+	0x26, 0x85, 0x49, 0x5e, 0x99, 0xd3, 0x63, 0xba, 0xb9, 0x5f, 0xc3, 0xca, 0x40, 0xa3, 0xed, 0x09,
+	0x7d, 0x12, 0x8e, 0xd4, 0x54, 0xd3, 0x91, 0xbc, 0xdd, 0x03, 0x6c, 0x1b, 0xb4, 0x96, 0x39, 0xcf,
+	0xe4, 0x77, 0x9b, 0xcc, 0x68, 0x33, 0xd0, 0x6d, 0x43, 0x19, 0xad, 0x85, 0x07, 0xd1, 0xd3, 0x90,
+	0x25, 0xe9, 0xa1, 0xb2, 0x6e, 0xd9, 0xa4, 0xca, 0x99, 0x5b, 0x9e, 0x61, 0xe7, 0x5f, 0x54, 0x6b,
+	0x77, 0x43, 0xb7, 0x6c, 0xa5, 0xdf, 0xa6, 0x7f, 0xa1, 0x65, 0xe8, 0xd5, 0xab, 0xb5, 0xba, 0x4d,
+	0x6a, 0xa0, 0xb9, 0xe5, 0x69, 0x0e, 0x92, 0xfd, 0xb2, 0xa1, 0x6a, 0x8a, 0x4b, 0x8a, 0x54, 0x98,
+	0x0d, 0xc4, 0x3f, 0x45, 0xdb, 0x28, 0x96, 0xca, 0x86, 0x85, 0xc9, 0x61, 0x62, 0xd4, 0x6d, 0x5a,
+	0x14, 0x9d, 0x08, 0x15, 0x69, 0xaf, 0xd3, 0xb2, 0xb6, 0x32, 0x8d, 0x7d, 0x6b, 0xbf, 0x69, 0xac,
+	0x3a, 0xf3, 0x37, 0xdd, 0xe9, 0xe8, 0x05, 0x98, 0x6a, 0xe5, 0xe0, 0xc3, 0xdc, 0x33, 0x71, 0xdc,
+	0x8f, 0xda, 0x5e, 0x66, 0x3d, 0xc0, 0xf8, 0x2a, 0x4c, 0xb6, 0xc2, 0xfd, 0x96, 0x14, 0x66, 0xbd,
+	0xea, 0x14, 0x82, 0x9d, 0x3a, 0x64, 0x56, 0x39, 0xda, 0xa4, 0x68, 0xae, 0xb3, 0x52, 0xaf, 0x16,
+	0x34, 0x54, 0x80, 0x2c, 0xf5, 0xdb, 0x86, 0x49, 0x8a, 0x82, 0x43, 0xcb, 0x67, 0xd8, 0xe7, 0x0c,
+	0x65, 0x40, 0xe2, 0xf9, 0x82, 0x37, 0x45, 0x69, 0xcd, 0x46, 0x05, 0x18, 0x69, 0xe1, 0x70, 0x7c,
+	0x67, 0xdd, 0xc4, 0xe3, 0xd9, 0x88, 0x3d, 0x58, 0x77, 0x69, 0x94, 0xe1, 0xe6, 0x34, 0x3a, 0x82,
+	0x14, 0x18, 0x2b, 0xab, 0xce, 0x05, 0xd4, 0x8d, 0xad, 0x88, 0x38, 0xd8, 0xaa, 0x97, 0xed, 0x71,
+	0x88, 0xe0, 0xe7, 0xed, 0xe9, 0x61, 0x67, 0xee, 0x6a, 0x73, 0xaa, 0x42, 0x66, 0xa2, 0x2b, 0x30,
+	0x61, 0x98, 0xfa, 0x7d, 0xdd, 0xf5, 0xfa, 0x81, 0x55, 0xca, 0x91, 0x55, 0x1a, 0xf3, 0x08, 0x02,
+	0x8b, 0x34, 0x09, 0xfd, 0xba, 0x86, 0xab, 0xb6, 0x6e, 0xef, 0x93, 0xf2, 0x56, 0x56, 0x69, 0x3e,
+	0xa3, 0x0b, 0x30, 0xb6, 0xad, 0x9b, 0x96, 0x1d, 0xe6, 0x39, 0x48, 0x28, 0x47, 0xc9, 0xdb, 0x00,
+	0xc3, 0x55, 0x18, 0x30, 0xb1, 0x6d, 0xee, 0x17, 0x6b, 0x46, 0x59, 0x2f, 0xed, 0xd3, 0x92, 0xd0,
+	0x2c, 0xe7, 0xb6, 0x6c, 0x9b, 0xfb, 0xb7, 0x09, 0x9d, 0x92, 0x33, 0x5b, 0x0f, 0x4e, 0x1f, 0x80,
+	0x6a, 0xdb, 0xb8, 0x52, 0xb3, 0x49, 0xf9, 0xa6, 0x57, 0xf1, 0x1e, 0xd1, 0x2a, 0x1c, 0xc2, 0x0f,
+	0x6a, 0xba, 0xab, 0x38, 0x6e, 0x87, 0xc1, 0x70, 0x6c, 0x87, 0xc1, 0x50, 0x6b, 0x8a, 0x33, 0x88,
+	0x4e, 0xc0, 0x60, 0xc9, 0x74, 0xac, 0x81, 0x96, 0x97, 0x48, 0xf9, 0x23, 0xab, 0x0c, 0x38, 0x83,
+	0x5e, 0xc9, 0x09, 0xfd, 0x1f, 0x4c, 0xb9, 0xd2, 0xfb, 0x4b, 0x71, 0x5b, 0x6a, 0x69, 0xd7, 0xd8,
+	0xde, 0x1e, 0x47, 0x71, 0x4a, 0x3d, 0x4e, 0x66, 0xb7, 0x57, 0xe1, 0xae, 0xb9, 0x53, 0xd1, 0x59,
+	0xe8, 0x71, 0xbc, 0x2b, 0xad, 0x2d, 0x4c, 0xb0, 0xb3, 0x8e, 0xb8, 0x62, 0x28, 0x84, 0x0c, 0x29,
+	0x30, 0x12, 0x3a, 0x3e, 0x68, 0x81, 0xe0, 0x71, 0xf6, 0x41, 0x1d, 0x70, 0xf7, 0xca, 0xb0, 0x15,
+	0x18, 0x41, 0x77, 0x61, 0xac, 0x66, 0xe2, 0xbd, 0xa2, 0x5a, 0xb7, 0x0d, 0x47, 0xff, 0xb0, 0x5d,
+	0xac, 0x19, 0x7a, 0xd5, 0xf6, 0x52, 0xfe, 0xbc, 0xfd, 0xb2, 0xb0, 0x7d, 0x9b, 0xd0, 0x29, 0xa3,
+	0xce, 0xfc, 0x95, 0xba, 0x6d, 0xb4, 0x0d, 0xa2, 0x0b, 0x90, 0xd9, 0xc1, 0xaa, 0x86, 0x4d, 0x9a,
+	0x8b, 0x9f, 0x62, 0x77, 0x98, 0x10, 0x12, 0x85, 0x92, 0x3a, 0x2a, 0x58, 0x33, 0x75, 0xc3, 0x74,
+	0x54, 0xf0, 0x28, 0xd9, 0xed, 0xe6, 0x33, 0x9a, 0x83, 0x81, 0x6d, 0x55, 0x37, 0xab, 0xd8, 0xb2,
+	0x8a, 0xbb, 0x78, 0x9f, 0xe4, 0xab, 0xb3, 0x4a, 0xce, 0x1b, 0xfb, 0x5f, 0xbc, 0x8f, 0x4e, 0xc3,
+	0x21, 0xdd, 0x32, 0xca, 0xae, 0x42, 0xdc, 0x37, 0x8d, 0x7a, 0x8d, 0xe4, 0x94, 0xb3, 0xca, 0x50,
+	0x73, 0xf8, 0x7f, 0x9c, 0x51, 0xf4, 0x29, 0x98, 0x71, 0x35, 0x13, 0x97, 0xd5, 0x9a, 0x45, 0x1c,
+	0x8a, 0x5f, 0x91, 0x26, 0x63, 0x15, 0x69, 0x92, 0x30, 0x58, 0x73, 0xe7, 0xaf, 0xf9, 0x95, 0xea,
+	0x69, 0xc8, 0x69, 0xb8, 0xac, 0xee, 0xbb, 0x5e, 0x70, 0x7c, 0x2a, 0x4e, 0x3f, 0x80, 0x50, 0x13,
+	0xaf, 0x27, 0xbf, 0x21, 0xc1, 0x13, 0xe2, 0xb7, 0xaf, 0x8b, 0x90, 0xa1, 0x2e, 0x43, 0x12, 0x70,
+	0x19, 0x94, 0x16, 0xad, 0xc3, 0x6c, 0x74, 0xf9, 0x5d, 0xd7, 0xc8, 0x01, 0x97, 0x56, 0xa6, 0xf9,
+	0x95, 0xf3, 0x82, 0x26, 0xbf, 0x2e, 0xc1, 0x29, 0xc1, 0x20, 0xee, 0x12, 0xf4, 0x79, 0xce, 0x52,
+	0x12, 0x70, 0x96, 0x1e, 0x71, 0xd7, 0xa0, 0x1a, 0x30, 0x2f, 0x7c, 0x83, 0x59, 0x85, 0x01, 0x7a,
+	0x5e, 0xb5, 0x62, 0x87, 0x21, 0x8e, 0x1d, 0xd0, 0xe3, 0x89, 0x84, 0x0e, 0x39, 0xbb, 0xf5, 0x20,
+	0xff, 0x46, 0x82, 0x93, 0x22, 0x4d, 0x1c, 0xfe, 0x20, 0x40, 0x4a, 0x16, 0x04, 0xdc, 0x84, 0x31,
+	0xce, 0x41, 0x9b, 0x8a, 0xd3, 0xb9, 0x51, 0x8b, 0x71, 0xc8, 0xb6, 0x39, 0xdb, 0xb4, 0xcf, 0xd9,
+	0xca, 0x2f, 0x4b, 0x20, 0xc7, 0xf7, 0x7f, 0xa0, 0x45, 0x40, 0xc1, 0x9e, 0x80, 0x66, 0x57, 0xd8,
+	0xb0, 0xe5, 0x5b, 0x82, 0xc0, 0x89, 0x93, 0x0a, 0x9c, 0x38, 0x33, 0x00, 0x5e, 0x82, 0x56, 0xd7,
+	0x08, 0x9a, 0xac, 0x92, 0xa5, 0x23, 0x05, 0x4d, 0xfe, 0x7b, 0x60, 0x79, 0xb9, 0x16, 0x92, 0x0c,
+	0xd1, 0x3c, 0x0c, 0xfb, 0xf3, 0x42, 0x4d, 0xf5, 0x1a, 0xb2, 0xda, 0x24, 0x0e, 0x60, 0x4f, 0x07,
+	0xb0, 0x9f, 0x86, 0x43, 0x5b, 0x7a, 0x55, 0x35, 0xf7, 0x8b, 0xa5, 0x1d, 0x5c, 0xda, 0xb5, 0xea,
+	0x15, 0x12, 0xa5, 0x65, 0x95, 0x21, 0x77, 0x78, 0x95, 0x8e, 0xa2, 0x33, 0x30, 0xe2, 0xcf, 0x66,
+	0xe2, 0x07, 0x6e, 0x04, 0x36, 0xa0, 0x0c, 0xe3, 0xf6, 0x24, 0x23, 0x7e, 0x60, 0xcb, 0x2f, 0xa5,
+	0xe1, 0x84, 0x40, 0x6b, 0xc9, 0x43, 0x93, 0x38, 0x68, 0x16, 0xe9, 0x0e, 0xcc, 0x02, 0x1d, 0x83,
+	0xdc, 0x96, 0x6a, 0x61, 0x2f, 0x7a, 0x70, 0x97, 0x25, 0xeb, 0x0c, 0xb9, 0x31, 0xc3, 0x34, 0x80,
+	0x93, 0xc8, 0xa5, 0xaf, 0x7b, 0xdd, 0x85, 0xad, 0xe2, 0x86, 0xfb, 0x76, 0x11, 0xd0, 0xb6, 0x61,
+	0xee, 0x52, 0xa4, 0x5e, 0x7f, 0x60, 0xc6, 0x15, 0xcd, 0x79, 0x43, 0xb0, 0xde, 0x73, 0xc7, 0xd1,
+	0x98, 0xe3, 0x1c, 0x55, 0xcb, 0xa8, 0xd2, 0xf0, 0x90, 0x3e, 0xa1, 0xeb, 0xd0, 0x5b, 0x52, 0xeb,
+	0x16, 0xa6, 0x91, 0x60, 0x5e, 0xb8, 0x89, 0x67, 0xd5, 0x99, 0xa5, 0xb8, 0x93, 0xe5, 0xd7, 0xd3,
+	0x30, 0x17, 0xdb, 0x58, 0xf3, 0xd0, 0x36, 0xe3, 0x9a, 0x27, 0x83, 0xbb, 0x0b, 0x8b, 0x82, 0x7d,
+	0x3f, 0xed, 0x12, 0xb4, 0xfb, 0xe4, 0x9e, 0x24, 0x3e, 0xb9, 0x5d, 0xf5, 0x7b, 0x03, 0xaa, 0x1f,
+	0xd8, 0xdf, 0x4c, 0xf4, 0xfe, 0xf6, 0x09, 0xed, 0x6f, 0x3f, 0x67, 0x7f, 0x19, 0x66, 0x96, 0x65,
+	0x99, 0x99, 0xfc, 0x87, 0x0c, 0x9c, 0x14, 0xe9, 0x39, 0x42, 0xc7, 0x21, 0xd7, 0x2c, 0xdc, 0xd3,
+	0x6d, 0xca, 0x2a, 0xe0, 0x0d, 0x15, 0x34, 0xe7, 0x5e, 0xd9, 0x24, 0x20, 0x46, 0x90, 0x8a, 0xb8,
+	0x57, 0x36, 0x3f, 0x49, 0xee, 0x95, 0x6a, 0xdb, 0x93, 0xa3, 0x9a, 0x9a, 0x51, 0x51, 0xf5, 0x2a,
+	0xf5, 0x1d, 0xf4, 0xc9, 0x7f, 0x18, 0xf4, 0x74, 0x78, 0x23, 0xcc, 0x88, 0xdf, 0x08, 0x37, 0x61,
+	0xc2, 0x53, 0xc2, 0xf0, 0x19, 0xd2, 0x17, 0x77, 0x86, 0x8c, 0x79, 0x73, 0x03, 0xc7, 0x48, 0x80,
+	0x2b, 0x3d, 0xa2, 0x28, 0xd7, 0xfe, 0x04, 0x5c, 0xdd, 0x8b, 0x20, 0xe5, 0xca, 0x3f, 0xec, 0xb2,
+	0x1d, 0x1d, 0x76, 0xeb, 0x30, 0xb2, 0x83, 0x55, 0xd3, 0xde, 0xc2, 0x6a, 0x0b, 0x1d, 0xc4, 0xb1,
+	0x1a, 0x6e, 0xce, 0x69, 0xf1, 0x89, 0x0f, 0x51, 0x72, 0xf1, 0x21, 0x4a, 0xe8, 0xba, 0x34, 0xd0,
+	0xc9, 0x75, 0xa9, 0x15, 0x76, 0x0f, 0x76, 0x16, 0x76, 0x0f, 0xc5, 0x84, 0xdd, 0x87, 0x42, 0x61,
+	0xb7, 0xfc, 0x57, 0x09, 0xe4, 0xf8, 0xf6, 0xb9, 0x0f, 0x2d, 0x36, 0x68, 0x8f, 0x62, 0x7a, 0xfc,
+	0x57, 0xc6, 0xe7, 0x60, 0x80, 0xdc, 0xb8, 0x3d, 0xb7, 0xd7, 0x2b, 0xe0, 0xf6, 0x72, 0xce, 0x0c,
+	0xfa, 0x20, 0xbf, 0x2b, 0xf9, 0x3d, 0x49, 0x97, 0x03, 0x73, 0xf6, 0x12, 0xa5, 0x12, 0x9c, 0x16,
+	0xe9, 0xd8, 0x60, 0xa5, 0xc7, 0xbf, 0x98, 0xf2, 0x6f, 0x25, 0x98, 0x8b, 0xef, 0x69, 0xea, 0x34,
+	0x7e, 0xff, 0x28, 0x24, 0xfa, 0x69, 0x0a, 0x4e, 0x08, 0x74, 0x06, 0x3a, 0x32, 0x69, 0xd8, 0x56,
+	0xf5, 0xb2, 0x25, 0xb4, 0x49, 0x1e, 0xf1, 0x43, 0x93, 0x29, 0x18, 0x60, 0xf5, 0x74, 0x12, 0x60,
+	0x1d, 0x58, 0xc5, 0xbf, 0x2c, 0xc1, 0x82, 0x78, 0x43, 0x9f, 0xc8, 0x91, 0xd9, 0x9d, 0x1b, 0xdc,
+	0x9b, 0x12, 0x24, 0x6c, 0xdd, 0x8b, 0xc7, 0x76, 0xd8, 0x8b, 0xa2, 0x5c, 0x0f, 0xe3, 0x3e, 0x08,
+	0x21, 0x4e, 0x0b, 0x20, 0x7e, 0x2d, 0xa0, 0x87, 0xbc, 0x22, 0x5f, 0xa7, 0x7a, 0xb8, 0x0e, 0xb3,
+	0x65, 0xd5, 0x6e, 0x6b, 0x61, 0x09, 0x36, 0x74, 0xb4, 0x56, 0xd6, 0xa5, 0x63, 0x6d, 0xa5, 0x1b,
+	0x75, 0x31, 0xf4, 0x39, 0x9d, 0x40, 0x9f, 0x7b, 0x62, 0x6d, 0x34, 0x10, 0x27, 0xca, 0xef, 0x48,
+	0x30, 0x15, 0xd1, 0x34, 0xeb, 0xfc, 0xa8, 0xc8, 0x6d, 0x16, 0x6c, 0xee, 0x5b, 0x1f, 0x79, 0x2e,
+	0x68, 0x68, 0x03, 0x8e, 0x34, 0xe3, 0x80, 0x6d, 0xdd, 0x4c, 0x70, 0xe7, 0x45, 0x34, 0x0c, 0x70,
+	0x9a, 0x62, 0x93, 0x9c, 0xde, 0x22, 0x9b, 0xfd, 0x69, 0x98, 0xe0, 0x76, 0xe3, 0x46, 0x49, 0x23,
+	0x1c, 0xf2, 0xcb, 0xbf, 0x92, 0x60, 0x3a, 0xaa, 0x11, 0xb3, 0x2b, 0x5f, 0xe9, 0xd6, 0x7a, 0x44,
+	0x3a, 0xe8, 0xb7, 0x24, 0x98, 0x8d, 0x6b, 0xe8, 0x8c, 0x92, 0xe6, 0xa1, 0x9a, 0x6d, 0x24, 0xf2,
+	0x7f, 0xf6, 0x41, 0xc2, 0xbe, 0x21, 0xb4, 0x04, 0x87, 0x49, 0x6b, 0x52, 0x30, 0x71, 0xee, 0xca,
+	0x34, 0x52, 0xc5, 0x8d, 0x40, 0xda, 0x3c, 0x54, 0xbb, 0x4a, 0x75, 0x56, 0xbb, 0x7a, 0x54, 0x5d,
+	0x12, 0xaf, 0x2e, 0x89, 0xe8, 0x4e, 0x9f, 0x80, 0xee, 0xdc, 0x82, 0x31, 0x5a, 0x15, 0xa0, 0x18,
+	0xf5, 0xaa, 0x8d, 0xcd, 0x3d, 0xb5, 0x1c, 0x7f, 0xed, 0x39, 0x4c, 0x27, 0x12, 0x78, 0x05, 0x3a,
+	0xcd, 0x5f, 0xb9, 0xca, 0x1e, 0xa8, 0x72, 0xd5, 0x16, 0xc2, 0x41, 0x92, 0x10, 0x8e, 0x5f, 0xa6,
+	0xca, 0x75, 0x5c, 0xa6, 0x6a, 0x5d, 0x53, 0x06, 0xc4, 0xaf, 0x29, 0x5e, 0xb1, 0x64, 0xf0, 0x00,
+	0xc5, 0x92, 0xa1, 0x03, 0x15, 0x4b, 0xe4, 0xbf, 0x48, 0xb0, 0x94, 0xb4, 0x79, 0xb1, 0xe9, 0xad,
+	0xa4, 0x76, 0x6f, 0x15, 0x75, 0xbf, 0xd9, 0x82, 0xa3, 0xcd, 0x86, 0x87, 0x40, 0xdd, 0xd9, 0xb5,
+	0xe3, 0x85, 0xc8, 0x96, 0x06, 0x7f, 0xe5, 0xf9, 0x08, 0x66, 0x0d, 0x07, 0xee, 0x50, 0x3d, 0xc1,
+	0xfc, 0xea, 0x77, 0x24, 0x98, 0xe7, 0x08, 0xca, 0xaa, 0xb6, 0xc7, 0x5b, 0x8f, 0x24, 0x60, 0x3d,
+	0x6d, 0x81, 0x50, 0x2a, 0x41, 0x20, 0x24, 0xbf, 0x2f, 0xc1, 0x4c, 0x64, 0x6f, 0xbe, 0x13, 0x09,
+	0xd2, 0xce, 0xff, 0xaa, 0x5a, 0xf1, 0x76, 0x02, 0xdc, 0xa1, 0x9b, 0x6a, 0x05, 0x77, 0xfa, 0xe9,
+	0xae, 0x1d, 0x3a, 0x2d, 0x83, 0xe8, 0x11, 0x36, 0x08, 0xf9, 0x27, 0xac, 0x4d, 0xe2, 0xf5, 0xa2,
+	0x1c, 0x87, 0x1c, 0xed, 0x06, 0x6a, 0x5f, 0x02, 0x77, 0x88, 0x2c, 0x41, 0xd3, 0xe7, 0xa7, 0xc4,
+	0x7d, 0x7e, 0x54, 0x16, 0x3c, 0x46, 0xc3, 0xde, 0x92, 0x60, 0x21, 0x41, 0x7b, 0x56, 0x2b, 0x99,
+	0x2b, 0xf9, 0x92, 0xb9, 0x9d, 0x6e, 0xdc, 0x01, 0x90, 0xff, 0x3c, 0x05, 0xcf, 0x1e, 0xac, 0x83,
+	0xbd, 0x6b, 0x16, 0xd3, 0xca, 0x23, 0xa6, 0x7c, 0x79, 0xc4, 0xbb, 0x80, 0xc2, 0x9d, 0x52, 0xd4,
+	0x79, 0x9c, 0x12, 0xeb, 0x86, 0x56, 0x46, 0x42, 0xed, 0xce, 0x4e, 0x66, 0xa5, 0x64, 0x54, 0x6d,
+	0xd3, 0x28, 0x93, 0x55, 0x19, 0x50, 0xbc, 0x47, 0x94, 0x87, 0xd1, 0x40, 0xd3, 0x9f, 0x51, 0x2d,
+	0xbb, 0x61, 0x7f, 0xbf, 0x32, 0xe2, 0xeb, 0xc5, 0xbb, 0x55, 0x2d, 0xef, 0xcb, 0xaf, 0xa6, 0xe1,
+	0xea, 0x01, 0x3a, 0xe4, 0xd1, 0xdd, 0x76, 0xa7, 0x3a, 0xc4, 0xf9, 0xfd, 0x89, 0x10, 0x67, 0x5f,
+	0x4a, 0xbc, 0x4b, 0x97, 0x55, 0x6e, 0x7e, 0x97, 0xbd, 0x2f, 0x3d, 0x07, 0xdd, 0x97, 0x45, 0x40,
+	0xc1, 0xbe, 0x44, 0x5a, 0x3d, 0x49, 0x2b, 0xc3, 0xba, 0x4f, 0x09, 0xdd, 0xfc, 0x98, 0xb7, 0x8b,
+	0x19, 0xdf, 0x2e, 0xca, 0xbf, 0x93, 0xe0, 0x72, 0x87, 0xed, 0xfd, 0x1c, 0x0c, 0x12, 0x07, 0xc3,
+	0x87, 0xab, 0xb8, 0xf2, 0x17, 0xd3, 0x70, 0xb9, 0xc3, 0x16, 0xcc, 0xff, 0x54, 0x5b, 0x0d, 0xf8,
+	0xfb, 0x1e, 0xbe, 0xbf, 0xef, 0x15, 0xf7, 0xf7, 0x5c, 0xd5, 0xe1, 0x39, 0x80, 0x3e, 0x9e, 0x03,
+	0x78, 0x29, 0x0d, 0x17, 0x3b, 0x69, 0x23, 0x15, 0xb3, 0x7c, 0x21, 0xce, 0x8f, 0x2c, 0xbf, 0x65,
+	0xf9, 0x7f, 0x93, 0xe0, 0x5c, 0xd2, 0x96, 0xd8, 0x7f, 0x6b, 0x93, 0xe7, 0x9f, 0x55, 0xf2, 0xaf,
+	0x25, 0x38, 0x9b, 0xa8, 0x8d, 0xb6, 0x6b, 0x2e, 0x80, 0x79, 0x25, 0x49, 0x1d, 0xec, 0x4a, 0xf2,
+	0x15, 0x09, 0xe6, 0x62, 0xfb, 0x6d, 0xbb, 0x26, 0x81, 0x77, 0x07, 0x4b, 0x09, 0xdd, 0xc1, 0xe4,
+	0xaf, 0x65, 0xe1, 0x42, 0x07, 0x3f, 0x56, 0x6a, 0xd3, 0x15, 0xc9, 0xa7, 0x2b, 0xc7, 0x21, 0xd7,
+	0xd4, 0x15, 0x6a, 0x90, 0x59, 0x05, 0xbc, 0x21, 0x56, 0xf2, 0x24, 0xdd, 0x85, 0xe4, 0x49, 0xa7,
+	0x85, 0xd8, 0xde, 0xee, 0x26, 0x4f, 0x32, 0x0f, 0x35, 0x79, 0xd2, 0xd7, 0x71, 0xf2, 0xe4, 0x1e,
+	0xd0, 0xee, 0x66, 0xca, 0x91, 0xd6, 0x2f, 0xdd, 0xee, 0x8a, 0x53, 0x11, 0x2d, 0xd2, 0x84, 0x0b,
+	0xad, 0x62, 0x8e, 0xd4, 0x82, 0x43, 0xed, 0x16, 0x9c, 0xf5, 0x1f, 0x36, 0x22, 0xda, 0x0c, 0x02,
+	0xda, 0x5c, 0x82, 0xf1, 0x36, 0x75, 0x2a, 0x9a, 0xb8, 0xde, 0x82, 0x9f, 0x23, 0xf0, 0x17, 0x22,
+	0x15, 0xa7, 0xa0, 0x29, 0xb8, 0xee, 0xe1, 0x55, 0x8e, 0x34, 0x58, 0xc3, 0xa1, 0xba, 0xee, 0x60,
+	0x27, 0x75, 0xdd, 0x50, 0x9f, 0xea, 0x10, 0xa3, 0x4f, 0xb5, 0x75, 0x89, 0x3c, 0x94, 0x3c, 0xab,
+	0x32, 0x7c, 0x80, 0xac, 0xca, 0xc8, 0xc1, 0x5a, 0x50, 0x03, 0xfd, 0x92, 0x28, 0x41, 0xbf, 0xa4,
+	0xaf, 0x76, 0x3d, 0x1a, 0x53, 0xbb, 0x3e, 0x1c, 0xae, 0x5d, 0xbf, 0x92, 0x86, 0x73, 0x49, 0x7f,
+	0x8b, 0xf8, 0xd1, 0x7b, 0xa7, 0x0d, 0x2f, 0x06, 0x72, 0x4b, 0x84, 0x97, 0x12, 0xff, 0x90, 0xce,
+	0x17, 0xfa, 0xb4, 0xd9, 0x59, 0xaf, 0xdf, 0xce, 0xd8, 0x07, 0x7c, 0x86, 0x73, 0xc0, 0x77, 0x29,
+	0x89, 0x2a, 0xbf, 0x9d, 0x82, 0xc5, 0x24, 0x3f, 0xb4, 0xe4, 0xee, 0x07, 0x3b, 0xb2, 0x48, 0x1d,
+	0x34, 0xb2, 0xe8, 0xd6, 0x2e, 0xb2, 0x57, 0xb7, 0x87, 0xb3, 0xba, 0x2d, 0xe3, 0xee, 0x15, 0xcf,
+	0x10, 0xbd, 0x9f, 0x82, 0x84, 0x3f, 0x01, 0xfd, 0x78, 0x2c, 0x26, 0xab, 0x1e, 0xd6, 0xcb, 0xac,
+	0x87, 0xb5, 0x1a, 0x39, 0x32, 0xe2, 0x8d, 0x1c, 0xf2, 0x3f, 0x52, 0x70, 0xa6, 0x1b, 0x1e, 0xe5,
+	0x63, 0xba, 0xe8, 0x6d, 0xa5, 0x8a, 0x4c, 0x82, 0x52, 0x85, 0xfc, 0x41, 0x0a, 0xce, 0x26, 0xfa,
+	0x45, 0xee, 0xa3, 0x85, 0x0f, 0x2d, 0xbc, 0x97, 0x4d, 0xcd, 0x24, 0xc9, 0xc0, 0x7f, 0x2e, 0xcd,
+	0x5b, 0x78, 0x5e, 0xf3, 0xcd, 0xa3, 0x85, 0x8f, 0xec, 0xfd, 0xc9, 0x74, 0xf2, 0x9b, 0x83, 0x9f,
+	0xa5, 0x60, 0x29, 0xe1, 0x2f, 0xa5, 0x1f, 0xed, 0x83, 0x6f, 0x1f, 0x16, 0x6c, 0x38, 0x44, 0xfe,
+	0x5c, 0xd7, 0xcb, 0x36, 0x36, 0xc9, 0xa7, 0x66, 0x60, 0x62, 0xed, 0xde, 0xda, 0xcd, 0xcd, 0xe2,
+	0x7a, 0x61, 0x63, 0x73, 0x4d, 0x29, 0x6e, 0xfe, 0xff, 0xed, 0xb5, 0x62, 0xe1, 0xe6, 0xbd, 0x95,
+	0x8d, 0xc2, 0xf5, 0xe1, 0xc7, 0xd0, 0x71, 0x98, 0x0a, 0xbf, 0x5e, 0xd9, 0xd8, 0x28, 0x92, 0xd1,
+	0x61, 0x09, 0xcd, 0xc1, 0x4c, 0x98, 0x60, 0x75, 0xe3, 0xd6, 0x9d, 0x35, 0x4a, 0x92, 0xba, 0xb6,
+	0xf5, 0xf6, 0x7b, 0xc7, 0xa4, 0x77, 0xdf, 0x3b, 0x26, 0xfd, 0xf1, 0xbd, 0x63, 0x12, 0x1c, 0x2d,
+	0x19, 0x15, 0xd6, 0x7a, 0x5c, 0xeb, 0x5f, 0xa9, 0xe9, 0xb7, 0x4d, 0xc3, 0x36, 0x6e, 0x4b, 0x9f,
+	0x58, 0xba, 0xaf, 0xdb, 0x3b, 0xf5, 0xad, 0x7c, 0xc9, 0xa8, 0x2c, 0xf9, 0xfe, 0xf7, 0x6f, 0xfe,
+	0x3e, 0xae, 0xba, 0xff, 0x6d, 0x98, 0xfe, 0x1b, 0xe0, 0xab, 0x6a, 0x4d, 0xdf, 0x3b, 0xbf, 0x95,
+	0x21, 0x63, 0x17, 0xfe, 0x35, 0x00, 0xfa, 0xc2, 0x43, 0x93, 0xe9, 0x58, 0x00, 0x00,
 }
 
 func (m *History) Marshal() (dAtA []byte, err error) {
@@ -5657,6 +5833,29 @@ func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) MarshalToSi
 	}
 	return len(dAtA) - i, nil
 }
+func (m *HistoryEvent_UpsertWorkflowMemoEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEvent_UpsertWorkflowMemoEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpsertWorkflowMemoEventAttributes != nil {
+		{
+			size, err := m.UpsertWorkflowMemoEventAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	return len(dAtA) - i, nil
+}
 func (m *WorkflowExecutionStartedEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5681,6 +5880,59 @@ func (m *WorkflowExecutionStartedEventAttributes) MarshalToSizedBuffer(dAtA []by
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DelayStart != nil {
+		{
+			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.RetryElapsedExpirationTime != nil {
+		{
+			size, err := m.RetryElapsedExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.Priority != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
 	if m.Header != nil {
 		{
 			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
@@ -6387,6 +6639,18 @@ func (m *ActivityTaskScheduledEventAttributes) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.Header != nil {
 		{
 			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
@@ -7298,6 +7562,13 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) MarshalToSizedBuffer(d
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ExternalExecutionInfo != nil {
 		{
 			size, err := m.ExternalExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -7458,6 +7729,13 @@ func (m *WorkflowExecutionSignaledEventAttributes) MarshalToSizedBuffer(dAtA []b
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Identity) > 0 {
 		i -= len(m.Identity)
 		copy(dAtA[i:], m.Identity)
@@ -7511,6 +7789,13 @@ func (m *WorkflowExecutionTerminatedEventAttributes) MarshalToSizedBuffer(dAtA [
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Identity) > 0 {
 		i -= len(m.Identity)
 		copy(dAtA[i:], m.Identity)
@@ -7984,7 +8269,7 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+func (m *UpsertWorkflowMemoEventAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7994,12 +8279,12 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []
 	return dAtA[:n], nil
 }
 
-func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpsertWorkflowMemoEventAttributes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpsertWorkflowMemoEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8008,34 +8293,6 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuff
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DelayStart != nil {
-		{
-			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintHistory(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
-	}
-	if m.SearchAttributes != nil {
-		{
-			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintHistory(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
 	if m.Memo != nil {
 		{
 			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
@@ -8046,19 +8303,107 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuff
 			i = encodeVarintHistory(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x12
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintHistory(dAtA, i, uint64(size))
-		}
+	if m.DecisionTaskCompletedEventId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.DecisionTaskCompletedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartChildWorkflowExecutionInitiatedEventAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Priority != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DelayStart != nil {
+		{
+			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x7a
 	}
@@ -9272,6 +9617,18 @@ func (m *HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes) Size() (n i
 	}
 	return n
 }
+func (m *HistoryEvent_UpsertWorkflowMemoEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpsertWorkflowMemoEventAttributes != nil {
+		l = m.UpsertWorkflowMemoEventAttributes.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	return n
+}
 func (m *WorkflowExecutionStartedEventAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9364,6 +9721,25 @@ func (m *WorkflowExecutionStartedEventAttributes) Size() (n int) {
 		l = m.Header.Size()
 		n += 2 + l + sovHistory(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 2 + sovHistory(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.RetryElapsedExpirationTime != nil {
+		l = m.RetryElapsedExpirationTime.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
+	if m.DelayStart != nil {
+		l = m.DelayStart.Size()
+		n += 2 + l + sovHistory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9635,6 +10011,13 @@ func (m *ActivityTaskScheduledEventAttributes) Size() (n int) {
 		l = m.Header.Size()
 		n += 1 + l + sovHistory(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovHistory(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10002,6 +10385,10 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) Size() (n int) {
 		l = m.ExternalExecutionInfo.Size()
 		n += 1 + l + sovHistory(uint64(l))
 	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10072,6 +10459,10 @@ func (m *WorkflowExecutionSignaledEventAttributes) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHistory(uint64(l))
 	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10096,6 +10487,10 @@ func (m *WorkflowExecutionTerminatedEventAttributes) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHistory(uint64(l))
 	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10305,6 +10700,25 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) Size() (n int) {
 	return n
 }
 
+func (m *UpsertWorkflowMemoEventAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionTaskCompletedEventId != 0 {
+		n += 1 + sovHistory(uint64(m.DecisionTaskCompletedEventId))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10376,6 +10790,13 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Size() (n int) {
 		l = m.DelayStart.Size()
 		n += 2 + l + sovHistory(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 2 + sovHistory(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 2 + l + sovHistory(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12301,6 +12722,41 @@ func (m *HistoryEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes = &HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{v}
 			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpsertWorkflowMemoEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpsertWorkflowMemoEventAttributes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Attributes = &HistoryEvent_UpsertWorkflowMemoEventAttributes{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -13093,65 +13549,30 @@ func (m *WorkflowExecutionStartedEventAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHistory(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthHistory
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthHistory
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkflowExecutionCompletedEventAttributes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHistory
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowExecutionCompletedEventAttributes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowExecutionCompletedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHistory
@@ -13161,13 +13582,203 @@ func (m *WorkflowExecutionCompletedEventAttributes) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthHistory
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryElapsedExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryElapsedExpirationTime == nil {
+				m.RetryElapsedExpirationTime = &types.Timestamp{}
+			}
+			if err := m.RetryElapsedExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelayStart == nil {
+				m.DelayStart = &types.Duration{}
+			}
+			if err := m.DelayStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionCompletedEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionCompletedEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionCompletedEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -14856,6 +15467,57 @@ func (m *ActivityTaskScheduledEventAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -17217,6 +17879,38 @@ func (m *WorkflowExecutionCancelRequestedEventAttributes) Unmarshal(dAtA []byte)
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -17657,6 +18351,38 @@ func (m *WorkflowExecutionSignaledEventAttributes) Unmarshal(dAtA []byte) error
 			}
 			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -17811,6 +18537,38 @@ func (m *WorkflowExecutionTerminatedEventAttributes) Unmarshal(dAtA []byte) erro
 			}
 			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -19145,6 +19903,115 @@ func (m *UpsertWorkflowSearchAttributesEventAttributes) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
+func (m *UpsertWorkflowMemoEventAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowMemoEventAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowMemoEventAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskCompletedEventId", wireType)
+			}
+			m.DecisionTaskCompletedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskCompletedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19721,6 +20588,57 @@ func (m *StartChildWorkflowExecutionInitiatedEventAttributes) Unmarshal(dAtA []b
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHistory(dAtA[iNdEx:])
@@ -246,6 +246,7 @@ type UpdateDomainRequest struct {
 	Clusters                         []*ClusterReplicationConfiguration `protobuf:"bytes,21,rep,name=clusters,proto3" json:"clusters,omitempty"`
 	DeleteBadBinary                  string                             `protobuf:"bytes,22,opt,name=delete_bad_binary,json=deleteBadBinary,proto3" json:"delete_bad_binary,omitempty"`
 	FailoverTimeout                  *types.Duration                    `protobuf:"bytes,23,opt,name=failover_timeout,json=failoverTimeout,proto3" json:"failover_timeout,omitempty"`
+	DrainedIsolationGroups           []string                           `protobuf:"bytes,24,rep,name=drained_isolation_groups,json=drainedIsolationGroups,proto3" json:"drained_isolation_groups,omitempty"`
 	XXX_NoUnkeyedLiteral             struct{}                           `json:"-"`
 	XXX_unrecognized                 []byte                             `json:"-"`
 	XXX_sizecache                    int32                              `json:"-"`
@@ -396,6 +397,13 @@ func (m *UpdateDomainRequest) GetFailoverTimeout() *types.Duration {
 	return nil
 }
 
+func (m *UpdateDomainRequest) GetDrainedIsolationGroups() []string {
+	if m != nil {
+		return m.DrainedIsolationGroups
+	}
+	return nil
+}
+
 type UpdateDomainResponse struct {
 	Domain               *Domain  `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -802,72 +810,74 @@ func init() {
 }
 
 var fileDescriptor_2e37d15268893114 = []byte{
-	// 1033 bytes of a gzipped FileDescriptorProto
+	// 1062 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0x1d, 0xdb, 0x91, 0x47, 0xb6, 0x6c, 0xaf, 0xff, 0x18, 0x05, 0x70, 0x05, 0x05, 0x6d,
-	0xd5, 0xb4, 0xa0, 0x6a, 0xa5, 0x7f, 0x68, 0x4e, 0xb6, 0xe5, 0xd4, 0x45, 0x9b, 0x40, 0xa0, 0xe3,
-	0x43, 0xdb, 0x03, 0xbb, 0x24, 0xc7, 0xf2, 0xc2, 0x14, 0xc9, 0xee, 0x2e, 0xe5, 0x28, 0x0f, 0xd2,
-	0x63, 0x9f, 0xa7, 0xc7, 0x1e, 0x7b, 0x2c, 0xfc, 0x24, 0x05, 0x97, 0x4b, 0x47, 0x3f, 0x94, 0x2d,
-	0x24, 0xce, 0x8d, 0x9a, 0xfd, 0xe6, 0x9b, 0xd9, 0x99, 0xf9, 0x86, 0x22, 0x34, 0x12, 0x17, 0x79,
-	0xd3, 0xa3, 0x3e, 0x86, 0x1e, 0x36, 0x69, 0xcc, 0x9a, 0xfd, 0xbd, 0xa6, 0x40, 0xde, 0x67, 0x1e,
-	0x3a, 0x7e, 0xd4, 0xa3, 0x2c, 0xb4, 0x62, 0x1e, 0xc9, 0x88, 0x6c, 0xa4, 0x48, 0x4b, 0x23, 0x2d,
-	0x1a, 0x33, 0xab, 0xbf, 0x57, 0xdd, 0xed, 0x46, 0x51, 0x37, 0xc0, 0xa6, 0x82, 0xb8, 0xc9, 0x59,
-	0xd3, 0x4f, 0x38, 0x95, 0x2c, 0xd2, 0x4e, 0xd5, 0xda, 0xf8, 0xf9, 0x19, 0xc3, 0xc0, 0x77, 0x7a,
-	0x54, 0x5c, 0xe4, 0x88, 0xa2, 0x04, 0x86, 0x03, 0xd7, 0xff, 0x5d, 0x84, 0x2d, 0x1b, 0xbb, 0x4c,
-	0x48, 0xe4, 0x6d, 0x75, 0x60, 0xe3, 0x1f, 0x09, 0x0a, 0x49, 0x3e, 0x86, 0x8a, 0x40, 0x2f, 0xe1,
-	0x4c, 0x0e, 0x1c, 0x19, 0x5d, 0x60, 0x68, 0x1a, 0x35, 0xa3, 0xb1, 0x64, 0xaf, 0xe4, 0xd6, 0x57,
-	0xa9, 0x91, 0x10, 0x98, 0x0f, 0x69, 0x0f, 0xcd, 0x39, 0x75, 0xa8, 0x9e, 0x49, 0x0d, 0xca, 0x3e,
-	0x0a, 0x8f, 0xb3, 0x38, 0xcd, 0xd6, 0xbc, 0xaf, 0x8e, 0x86, 0x4d, 0xe4, 0x23, 0x28, 0x47, 0x97,
-	0x21, 0x72, 0x07, 0x7b, 0x94, 0x05, 0xe6, 0xbc, 0x42, 0x80, 0x32, 0x1d, 0xa5, 0x16, 0x72, 0x0e,
-	0x8f, 0x2f, 0x23, 0x7e, 0x71, 0x16, 0x44, 0x97, 0x0e, 0xbe, 0x46, 0x2f, 0x49, 0xdd, 0x1c, 0x8e,
-	0x12, 0x43, 0xf5, 0x14, 0x23, 0x67, 0x91, 0x6f, 0x2e, 0xd4, 0x8c, 0x46, 0xb9, 0xf5, 0xd0, 0xca,
-	0x2a, 0x61, 0xe5, 0x95, 0xb0, 0xda, 0xba, 0x52, 0x76, 0x2d, 0x67, 0x39, 0xca, 0x49, 0xec, 0x9c,
-	0xa3, 0xa3, 0x28, 0x48, 0x07, 0x4a, 0x5e, 0x90, 0xa4, 0xf7, 0x17, 0xe6, 0x62, 0xed, 0x7e, 0xa3,
-	0xdc, 0xfa, 0xca, 0x2a, 0xe8, 0x86, 0x75, 0x98, 0x81, 0x6c, 0x8c, 0x03, 0xe6, 0x29, 0xf2, 0xc3,
-	0x28, 0x3c, 0x63, 0xdd, 0x3c, 0xd2, 0x35, 0x0b, 0xb1, 0x60, 0x83, 0x7a, 0x92, 0xf5, 0xd1, 0xd1,
-	0x26, 0x47, 0x55, 0xe8, 0x81, 0xba, 0xe4, 0x7a, 0x76, 0xa4, 0xd9, 0x5e, 0xa6, 0xe5, 0x3a, 0x86,
-	0x79, 0x9f, 0x4a, 0x6a, 0x96, 0x6e, 0x88, 0x5e, 0xd8, 0x23, 0xab, 0x4d, 0x25, 0x3d, 0x0a, 0x25,
-	0x1f, 0xd8, 0x8a, 0x81, 0x34, 0x60, 0x8d, 0x09, 0xa7, 0x1b, 0x44, 0x2e, 0x0d, 0xf4, 0x80, 0x99,
-	0x4b, 0x35, 0xa3, 0x51, 0xb2, 0x2b, 0x4c, 0xfc, 0xa0, 0xcc, 0x19, 0x01, 0xf9, 0x0d, 0x76, 0xce,
-	0x99, 0x90, 0x11, 0x1f, 0x38, 0x94, 0x7b, 0xe7, 0xac, 0x4f, 0x03, 0x47, 0x48, 0x2a, 0x13, 0x61,
-	0x42, 0xcd, 0x68, 0x54, 0x5a, 0x8f, 0x0b, 0xd3, 0xd8, 0xd7, 0xd8, 0x13, 0x05, 0xb5, 0xb7, 0x34,
-	0xc7, 0xa8, 0x99, 0x7c, 0x09, 0x9b, 0x13, 0xe4, 0x09, 0x67, 0x66, 0x59, 0x55, 0x80, 0x8c, 0x39,
-	0x9d, 0x72, 0x46, 0x28, 0x54, 0xfb, 0x4c, 0x30, 0x97, 0x05, 0xe9, 0xb8, 0x8d, 0x67, 0xb4, 0x3c,
-	0x7b, 0x46, 0xe6, 0x5b, 0x9a, 0xb1, 0xa4, 0xbe, 0x81, 0x9d, 0xa2, 0x10, 0x69, 0x5e, 0x2b, 0x2a,
-	0xaf, 0xad, 0x49, 0xd7, 0x53, 0xce, 0xaa, 0xdf, 0xc2, 0xd2, 0x75, 0x99, 0xc9, 0x1a, 0xdc, 0xbf,
-	0xc0, 0x81, 0x56, 0x42, 0xfa, 0x48, 0x36, 0x61, 0xa1, 0x4f, 0x83, 0x24, 0x17, 0x40, 0xf6, 0xe3,
-	0xfb, 0xb9, 0xef, 0x8c, 0xba, 0x09, 0xdb, 0xe3, 0x5d, 0x13, 0x71, 0x14, 0x0a, 0xac, 0xff, 0x55,
-	0x82, 0x8d, 0xd3, 0xd8, 0xa7, 0x12, 0xef, 0x4c, 0x72, 0xcf, 0xa0, 0x9c, 0x28, 0x46, 0x25, 0x7f,
-	0xd5, 0xc3, 0x72, 0xab, 0x3a, 0xa1, 0x8b, 0xe7, 0xe9, 0x86, 0x78, 0x41, 0xc5, 0x85, 0x0d, 0x19,
-	0x3c, 0x7d, 0x1e, 0xd7, 0x6b, 0xf9, 0x56, 0xbd, 0x2e, 0x4f, 0xe8, 0xf5, 0xb9, 0x9e, 0xe1, 0x15,
-	0x35, 0xc3, 0xad, 0xc2, 0x56, 0x15, 0x5c, 0x79, 0x62, 0x82, 0x67, 0xd4, 0x7d, 0xe5, 0xfd, 0x75,
-	0x7f, 0x08, 0xcb, 0x2e, 0xf5, 0x1d, 0x97, 0x85, 0x94, 0x33, 0x14, 0xe6, 0xaa, 0xa2, 0xac, 0x15,
-	0x66, 0x7e, 0x40, 0xfd, 0x03, 0x8d, 0xb3, 0xcb, 0xee, 0xdb, 0x1f, 0x37, 0xc9, 0x68, 0xed, 0x83,
-	0xc9, 0x68, 0xfd, 0x1d, 0x65, 0x44, 0x3e, 0xb0, 0x8c, 0x36, 0x6e, 0x90, 0xd1, 0xb4, 0xa5, 0xb8,
-	0x39, 0x6d, 0x29, 0x0e, 0xaf, 0xe5, 0xad, 0x3b, 0x59, 0xcb, 0x4f, 0x60, 0xdd, 0xc7, 0x00, 0x25,
-	0x3a, 0xd7, 0x7d, 0x1f, 0x98, 0xdb, 0x2a, 0xfe, 0x6a, 0x76, 0x90, 0xb7, 0x79, 0x40, 0xda, 0xb0,
-	0x76, 0x46, 0x59, 0x10, 0xf5, 0x91, 0x3b, 0x92, 0xf5, 0x30, 0x4a, 0xa4, 0xb9, 0x73, 0xdb, 0xcc,
-	0xad, 0xe6, 0x2e, 0xaf, 0x32, 0x8f, 0x77, 0x5f, 0x1d, 0x3f, 0xc1, 0xe6, 0xa8, 0x58, 0xb2, 0xc5,
-	0x41, 0x9e, 0xc2, 0xa2, 0xde, 0xea, 0x86, 0x4a, 0xe6, 0x51, 0x61, 0x49, 0xb4, 0x93, 0x86, 0xd6,
-	0x4f, 0x60, 0xbb, 0x8d, 0x31, 0x47, 0xef, 0x0e, 0xf7, 0x4d, 0xfd, 0x21, 0xec, 0x4c, 0x90, 0xea,
-	0xed, 0xf6, 0x12, 0xb6, 0xda, 0x6a, 0x75, 0xb8, 0x63, 0xe1, 0xd6, 0x60, 0x8e, 0xf9, 0x59, 0x88,
-	0xe3, 0x7b, 0xf6, 0x1c, 0xf3, 0xc9, 0xe6, 0x30, 0xf3, 0xf1, 0xbd, 0x8c, 0xfb, 0x60, 0x25, 0x5f,
-	0x47, 0x2e, 0x3a, 0xee, 0xa0, 0xfe, 0x22, 0xcd, 0x7f, 0x94, 0xef, 0x7d, 0xca, 0xf1, 0x0b, 0x90,
-	0x9f, 0x99, 0x90, 0x99, 0x55, 0xe4, 0xb9, 0x3d, 0x82, 0xa5, 0x98, 0x76, 0xd1, 0x11, 0xec, 0x0d,
-	0x2a, 0xb6, 0x05, 0xbb, 0x94, 0x1a, 0x4e, 0xd8, 0x1b, 0x24, 0x9f, 0xc0, 0x6a, 0x88, 0xaf, 0xa5,
-	0xa3, 0x10, 0x59, 0xa1, 0xd2, 0x8c, 0x97, 0xed, 0x95, 0xd4, 0xdc, 0xa1, 0x5d, 0x54, 0x85, 0xaa,
-	0x4b, 0xd8, 0x18, 0xa1, 0xd6, 0x69, 0x7e, 0x0d, 0x0f, 0xb2, 0xd8, 0xc2, 0x34, 0xd4, 0x24, 0xdf,
-	0x98, 0x67, 0x8e, 0x9d, 0x35, 0x6a, 0xeb, 0xcf, 0x79, 0x58, 0xca, 0x7c, 0xf7, 0x3b, 0x3f, 0x12,
-	0x06, 0x95, 0xd1, 0xb7, 0x0e, 0x79, 0x32, 0xfb, 0x1f, 0x8a, 0xea, 0xe7, 0x33, 0x61, 0xf5, 0xbd,
-	0x18, 0x54, 0x46, 0x1b, 0x33, 0x25, 0x54, 0xe1, 0x34, 0x4c, 0x09, 0x35, 0xa5, 0xd3, 0xbf, 0x43,
-	0x79, 0xa8, 0xb2, 0xe4, 0xd3, 0x42, 0xdf, 0xc9, 0xb6, 0x56, 0x1b, 0xb7, 0x03, 0x75, 0x04, 0x0f,
-	0x96, 0x87, 0x25, 0x47, 0x1a, 0xb3, 0xbe, 0xc2, 0xaa, 0x9f, 0xcd, 0x80, 0xd4, 0x41, 0x02, 0x58,
-	0x1d, 0x53, 0x0d, 0x99, 0x56, 0x86, 0x22, 0xc1, 0x56, 0xbf, 0x98, 0x0d, 0x9c, 0x45, 0x3b, 0x70,
-	0xff, 0xbe, 0xda, 0x35, 0xfe, 0xb9, 0xda, 0x35, 0xfe, 0xbb, 0xda, 0x35, 0x60, 0xc7, 0x8b, 0x7a,
-	0x45, 0xee, 0x07, 0xa5, 0xfd, 0x98, 0x75, 0xd2, 0x65, 0xd6, 0x31, 0x7e, 0x6d, 0x76, 0x99, 0x3c,
-	0x4f, 0x5c, 0xcb, 0x8b, 0x7a, 0xcd, 0x91, 0x6f, 0x07, 0xab, 0x8b, 0x61, 0xf6, 0xa1, 0xa1, 0x3f,
-	0x23, 0x9e, 0xd1, 0x98, 0xf5, 0xf7, 0xdc, 0x45, 0x65, 0x7b, 0xfa, 0x7f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xd3, 0xdf, 0xea, 0xd2, 0xeb, 0x0c, 0x00, 0x00,
+	0x10, 0x0e, 0x6d, 0xc7, 0x91, 0x46, 0xb6, 0x6c, 0xaf, 0xff, 0x18, 0x05, 0x70, 0x09, 0x05, 0x6d,
+	0xd5, 0xa4, 0xa0, 0x6a, 0xa5, 0x3f, 0x41, 0x73, 0xb2, 0x2d, 0x27, 0x0e, 0xda, 0x04, 0x02, 0x1d,
+	0x1f, 0xda, 0x1e, 0xd8, 0x25, 0xb9, 0x96, 0x17, 0xa2, 0xb8, 0xec, 0xee, 0x52, 0x8e, 0xf2, 0x20,
+	0x7d, 0xa6, 0x1e, 0x7b, 0xcc, 0xb1, 0xf0, 0x93, 0x14, 0x5c, 0x2e, 0x1d, 0xfd, 0xd0, 0xb6, 0x90,
+	0x38, 0x37, 0x6a, 0xe6, 0x9b, 0x6f, 0x66, 0x67, 0xf6, 0x1b, 0x8a, 0xd0, 0x48, 0x3c, 0xc2, 0x9b,
+	0x3e, 0x0e, 0x48, 0xe4, 0x93, 0x26, 0x8e, 0x69, 0x73, 0xb0, 0xdb, 0x14, 0x84, 0x0f, 0xa8, 0x4f,
+	0xdc, 0x80, 0xf5, 0x31, 0x8d, 0xec, 0x98, 0x33, 0xc9, 0xd0, 0x7a, 0x8a, 0xb4, 0x35, 0xd2, 0xc6,
+	0x31, 0xb5, 0x07, 0xbb, 0xb5, 0x9d, 0x2e, 0x63, 0xdd, 0x90, 0x34, 0x15, 0xc4, 0x4b, 0x4e, 0x9b,
+	0x41, 0xc2, 0xb1, 0xa4, 0x4c, 0x07, 0xd5, 0xac, 0x49, 0xff, 0x29, 0x25, 0x61, 0xe0, 0xf6, 0xb1,
+	0xe8, 0xe5, 0x88, 0xa2, 0x02, 0x46, 0x13, 0xd7, 0xdf, 0x2f, 0xc2, 0xa6, 0x43, 0xba, 0x54, 0x48,
+	0xc2, 0xdb, 0xca, 0xe1, 0x90, 0xbf, 0x12, 0x22, 0x24, 0xfa, 0x12, 0xaa, 0x82, 0xf8, 0x09, 0xa7,
+	0x72, 0xe8, 0x4a, 0xd6, 0x23, 0x91, 0x69, 0x58, 0x46, 0xa3, 0xec, 0x2c, 0xe7, 0xd6, 0x37, 0xa9,
+	0x11, 0x21, 0x58, 0x88, 0x70, 0x9f, 0x98, 0x73, 0xca, 0xa9, 0x9e, 0x91, 0x05, 0x95, 0x80, 0x08,
+	0x9f, 0xd3, 0x38, 0xad, 0xd6, 0x9c, 0x57, 0xae, 0x51, 0x13, 0xfa, 0x02, 0x2a, 0xec, 0x3c, 0x22,
+	0xdc, 0x25, 0x7d, 0x4c, 0x43, 0x73, 0x41, 0x21, 0x40, 0x99, 0x0e, 0x53, 0x0b, 0x3a, 0x83, 0x87,
+	0xe7, 0x8c, 0xf7, 0x4e, 0x43, 0x76, 0xee, 0x92, 0xb7, 0xc4, 0x4f, 0xd2, 0x30, 0x97, 0x13, 0x49,
+	0x22, 0xf5, 0x14, 0x13, 0x4e, 0x59, 0x60, 0xde, 0xb5, 0x8c, 0x46, 0xa5, 0x75, 0xdf, 0xce, 0x3a,
+	0x61, 0xe7, 0x9d, 0xb0, 0xdb, 0xba, 0x53, 0x8e, 0x95, 0xb3, 0x1c, 0xe6, 0x24, 0x4e, 0xce, 0xd1,
+	0x51, 0x14, 0xa8, 0x03, 0x25, 0x3f, 0x4c, 0xd2, 0xf3, 0x0b, 0x73, 0xd1, 0x9a, 0x6f, 0x54, 0x5a,
+	0xdf, 0xdb, 0x05, 0xd3, 0xb0, 0x0f, 0x32, 0x90, 0x43, 0xe2, 0x90, 0xfa, 0x8a, 0xfc, 0x80, 0x45,
+	0xa7, 0xb4, 0x9b, 0x67, 0xba, 0x64, 0x41, 0x36, 0xac, 0x63, 0x5f, 0xd2, 0x01, 0x71, 0xb5, 0xc9,
+	0x55, 0x1d, 0xba, 0xa7, 0x0e, 0xb9, 0x96, 0xb9, 0x34, 0xdb, 0xeb, 0xb4, 0x5d, 0x47, 0xb0, 0x10,
+	0x60, 0x89, 0xcd, 0xd2, 0x35, 0xd9, 0x0b, 0x67, 0x64, 0xb7, 0xb1, 0xc4, 0x87, 0x91, 0xe4, 0x43,
+	0x47, 0x31, 0xa0, 0x06, 0xac, 0x52, 0xe1, 0x76, 0x43, 0xe6, 0xe1, 0x50, 0x5f, 0x30, 0xb3, 0x6c,
+	0x19, 0x8d, 0x92, 0x53, 0xa5, 0xe2, 0x85, 0x32, 0x67, 0x04, 0xe8, 0x0f, 0xd8, 0x3e, 0xa3, 0x42,
+	0x32, 0x3e, 0x74, 0x31, 0xf7, 0xcf, 0xe8, 0x00, 0x87, 0xae, 0x90, 0x58, 0x26, 0xc2, 0x04, 0xcb,
+	0x68, 0x54, 0x5b, 0x0f, 0x0b, 0xcb, 0xd8, 0xd3, 0xd8, 0x63, 0x05, 0x75, 0x36, 0x35, 0xc7, 0xb8,
+	0x19, 0x7d, 0x07, 0x1b, 0x53, 0xe4, 0x09, 0xa7, 0x66, 0x45, 0x75, 0x00, 0x4d, 0x04, 0x9d, 0x70,
+	0x8a, 0x30, 0xd4, 0x06, 0x54, 0x50, 0x8f, 0x86, 0x54, 0x8e, 0x04, 0xe9, 0x8a, 0x96, 0x66, 0xaf,
+	0xc8, 0xfc, 0x40, 0x33, 0x51, 0xd4, 0x8f, 0xb0, 0x5d, 0x94, 0x22, 0xad, 0x6b, 0x59, 0xd5, 0xb5,
+	0x39, 0x1d, 0x7a, 0xc2, 0x69, 0xed, 0x27, 0x28, 0x5f, 0xb6, 0x19, 0xad, 0xc2, 0x7c, 0x8f, 0x0c,
+	0xb5, 0x12, 0xd2, 0x47, 0xb4, 0x01, 0x77, 0x07, 0x38, 0x4c, 0x72, 0x01, 0x64, 0x3f, 0x7e, 0x9e,
+	0x7b, 0x6a, 0xd4, 0x4d, 0xd8, 0x9a, 0x9c, 0x9a, 0x88, 0x59, 0x24, 0x48, 0xfd, 0x7d, 0x09, 0xd6,
+	0x4f, 0xe2, 0x00, 0x4b, 0x72, 0x6b, 0x92, 0x7b, 0x06, 0x95, 0x44, 0x31, 0x2a, 0xf9, 0xab, 0x19,
+	0x56, 0x5a, 0xb5, 0x29, 0x5d, 0x3c, 0x4f, 0x37, 0xc4, 0x2b, 0x2c, 0x7a, 0x0e, 0x64, 0xf0, 0xf4,
+	0x79, 0x52, 0xaf, 0x95, 0x1b, 0xf5, 0xba, 0x34, 0xa5, 0xd7, 0xe7, 0xfa, 0x0e, 0x2f, 0xab, 0x3b,
+	0xdc, 0x2a, 0x1c, 0x55, 0xc1, 0x91, 0xa7, 0x6e, 0xf0, 0x8c, 0xba, 0xaf, 0x7e, 0xba, 0xee, 0x0f,
+	0x60, 0xc9, 0xc3, 0x81, 0xeb, 0xd1, 0x08, 0x73, 0x4a, 0x84, 0xb9, 0xa2, 0x28, 0xad, 0xc2, 0xca,
+	0xf7, 0x71, 0xb0, 0xaf, 0x71, 0x4e, 0xc5, 0xfb, 0xf0, 0xe3, 0x3a, 0x19, 0xad, 0x7e, 0x36, 0x19,
+	0xad, 0x7d, 0xa4, 0x8c, 0xd0, 0x67, 0x96, 0xd1, 0xfa, 0x35, 0x32, 0xba, 0x6a, 0x29, 0x6e, 0x5c,
+	0xb5, 0x14, 0x47, 0xd7, 0xf2, 0xe6, 0xad, 0xac, 0xe5, 0x47, 0xb0, 0x16, 0x90, 0x90, 0x48, 0xe2,
+	0x5e, 0xce, 0x7d, 0x68, 0x6e, 0xa9, 0xfc, 0x2b, 0x99, 0x23, 0x1f, 0xf3, 0x10, 0xb5, 0x61, 0xf5,
+	0x14, 0xd3, 0x90, 0x0d, 0x08, 0x77, 0x25, 0xed, 0x13, 0x96, 0x48, 0x73, 0xfb, 0xa6, 0x3b, 0xb7,
+	0x92, 0x87, 0xbc, 0xc9, 0x22, 0xd0, 0x53, 0x30, 0x03, 0x8e, 0x69, 0x44, 0x02, 0x97, 0x0a, 0x16,
+	0x2a, 0x94, 0xdb, 0xe5, 0x2c, 0x89, 0x85, 0x69, 0x5a, 0xf3, 0x8d, 0xb2, 0xb3, 0xa5, 0xfd, 0x2f,
+	0x73, 0xf7, 0x0b, 0xe5, 0xfd, 0xf8, 0xa5, 0xf3, 0x0b, 0x6c, 0x8c, 0xcb, 0x2c, 0x5b, 0x39, 0xe8,
+	0x09, 0x2c, 0xea, 0xf7, 0x81, 0xa1, 0x8e, 0xf1, 0xa0, 0xb0, 0x99, 0x3a, 0x48, 0x43, 0xeb, 0xc7,
+	0xb0, 0xd5, 0x26, 0x31, 0x27, 0xfe, 0x2d, 0x6e, 0xaa, 0xfa, 0x7d, 0xd8, 0x9e, 0x22, 0xd5, 0x7b,
+	0xf1, 0x35, 0x6c, 0xb6, 0xd5, 0xd2, 0xf1, 0x26, 0xd2, 0xad, 0xc2, 0x1c, 0x0d, 0xb2, 0x14, 0x47,
+	0x77, 0x9c, 0x39, 0x1a, 0xa0, 0x8d, 0x51, 0xe6, 0xa3, 0x3b, 0x19, 0xf7, 0xfe, 0x72, 0xbe, 0xc8,
+	0x3c, 0xe2, 0x7a, 0xc3, 0xfa, 0xab, 0xb4, 0xfe, 0x71, 0xbe, 0x4f, 0x69, 0xc7, 0x6f, 0x80, 0x7e,
+	0xa5, 0x42, 0x66, 0x56, 0x91, 0xd7, 0xf6, 0x00, 0xca, 0x31, 0xee, 0x12, 0x57, 0xd0, 0x77, 0x44,
+	0xb1, 0xdd, 0x75, 0x4a, 0xa9, 0xe1, 0x98, 0xbe, 0x23, 0xe8, 0x2b, 0x58, 0x89, 0xc8, 0x5b, 0xe9,
+	0x2a, 0x44, 0xd6, 0xa8, 0xb4, 0xe2, 0x25, 0x67, 0x39, 0x35, 0x77, 0x70, 0x97, 0xa8, 0x46, 0xd5,
+	0x25, 0xac, 0x8f, 0x51, 0xeb, 0x32, 0x7f, 0x80, 0x7b, 0x59, 0x6e, 0x61, 0x1a, 0xd6, 0xfc, 0x4d,
+	0x75, 0xe6, 0xd8, 0x59, 0xb3, 0xb6, 0xfe, 0x5e, 0x80, 0x72, 0x16, 0xbb, 0xd7, 0x79, 0x89, 0x28,
+	0x54, 0xc7, 0xdf, 0x57, 0xe8, 0xd1, 0xec, 0x7f, 0x45, 0x6a, 0x8f, 0x67, 0xc2, 0xea, 0x73, 0x51,
+	0xa8, 0x8e, 0x0f, 0xe6, 0x8a, 0x54, 0x85, 0xb7, 0xa1, 0xf6, 0x78, 0x26, 0xac, 0x4e, 0xf5, 0x27,
+	0x54, 0x46, 0x3a, 0x8b, 0xbe, 0x2e, 0x8c, 0x9d, 0x1e, 0x6b, 0xad, 0x71, 0x33, 0x50, 0x67, 0xf0,
+	0x61, 0x69, 0x54, 0x72, 0xa8, 0x31, 0xeb, 0xcb, 0xaf, 0xf6, 0xcd, 0x0c, 0x48, 0x9d, 0x24, 0x84,
+	0x95, 0x09, 0xd5, 0xa0, 0xab, 0xda, 0x50, 0x24, 0xd8, 0xda, 0xb7, 0xb3, 0x81, 0xb3, 0x6c, 0xfb,
+	0xde, 0x3f, 0x17, 0x3b, 0xc6, 0xbf, 0x17, 0x3b, 0xc6, 0x7f, 0x17, 0x3b, 0x06, 0x6c, 0xfb, 0xac,
+	0x5f, 0x14, 0xbe, 0x5f, 0xda, 0x8b, 0x69, 0x87, 0x33, 0xc9, 0x3a, 0xc6, 0xef, 0xcd, 0x2e, 0x95,
+	0x67, 0x89, 0x67, 0xfb, 0xac, 0xdf, 0x1c, 0xfb, 0xea, 0xb0, 0xbb, 0x24, 0xca, 0x3e, 0x51, 0xf4,
+	0x07, 0xc8, 0x33, 0x1c, 0xd3, 0xc1, 0xae, 0xb7, 0xa8, 0x6c, 0x4f, 0xfe, 0x1f, 0x00, 0xff, 0x7a,
+	0x2b, 0x17, 0x25, 0x0d, 0x00, 0x00,
 }
 
 func (m *RegisterDomainRequest) Marshal() (dAtA []byte, err error) {
@@ -1062,6 +1072,17 @@ func (m *UpdateDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DrainedIsolationGroups) > 0 {
+		for iNdEx := len(m.DrainedIsolationGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DrainedIsolationGroups[iNdEx])
+			copy(dAtA[i:], m.DrainedIsolationGroups[iNdEx])
+			i = encodeVarintServiceDomain(dAtA, i, uint64(len(m.DrainedIsolationGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
 	if m.FailoverTimeout != nil {
 		{
 			size, err := m.FailoverTimeout.MarshalToSizedBuffer(dAtA[:i])
@@ -1689,6 +1710,12 @@ func (m *UpdateDomainRequest) Size() (n int) {
 		l = m.FailoverTimeout.Size()
 		n += 2 + l + sovServiceDomain(uint64(l))
 	}
+	if len(m.DrainedIsolationGroups) > 0 {
+		for _, s := range m.DrainedIsolationGroups {
+			l = len(s)
+			n += 2 + l + sovServiceDomain(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3056,6 +3083,38 @@ func (m *UpdateDomainRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainedIsolationGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrainedIsolationGroups = append(m.DrainedIsolationGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceDomain(dAtA[iNdEx:])
@@ -865,53 +865,58 @@ var yarpcFileDescriptorClosurea7341dc69cef4364 = [][]byte{
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x73, 0x98, 0x25, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xcb, 0x74, 0x51,
-		0x04, 0xc5, 0x20, 0x21, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0x89, 0x83, 0x55, 0x88, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0xbb, 0xe1, 0x28, 0xf1, 0xd4, 0x21, 0xf4, 0x43, 0x81, 0xa4, 0x92, 0xf8, 0x45, 0xf6,
-		0x30, 0x7b, 0xa2, 0x3d, 0xc6, 0x40, 0x4a, 0xf6, 0xdc, 0xc4, 0xeb, 0x1d, 0x79, 0xbe, 0xf3, 0x9d,
-		0x9f, 0x8f, 0xe7, 0x10, 0x79, 0x4d, 0x0a, 0x32, 0xc8, 0x28, 0x83, 0x2a, 0x83, 0x80, 0xd6, 0x3c,
-		0xb8, 0x3d, 0x0e, 0x34, 0x55, 0x79, 0xc1, 0x95, 0xf6, 0x6b, 0x29, 0xb4, 0xc0, 0xdf, 0x18, 0x1f,
-		0xbf, 0xf3, 0xf1, 0x69, 0xcd, 0xfd, 0xdb, 0xe3, 0xe1, 0xf7, 0x73, 0x21, 0xe6, 0x05, 0x04, 0xd6,
-		0x25, 0x6d, 0x3e, 0x06, 0xac, 0x91, 0x54, 0x73, 0x51, 0xb5, 0xa4, 0xe1, 0x0f, 0x0f, 0x71, 0xcd,
-		0x4b, 0x50, 0x9a, 0x96, 0x75, 0xe7, 0xf0, 0x28, 0xc0, 0x9d, 0xa4, 0x75, 0x0d, 0x52, 0xb5, 0xb8,
-		0xf7, 0x01, 0xf5, 0x13, 0xaa, 0xf2, 0x09, 0x57, 0x1a, 0x63, 0xb4, 0x5d, 0xd1, 0x12, 0x0e, 0x9c,
-		0x43, 0xe7, 0x68, 0x27, 0xb2, 0x67, 0xfc, 0x0b, 0xda, 0xce, 0x79, 0xc5, 0x0e, 0xb6, 0x0e, 0x9d,
-		0xa3, 0xfd, 0x93, 0x1f, 0xfd, 0x0d, 0x45, 0xfa, 0xcb, 0x00, 0x17, 0xbc, 0x62, 0x91, 0x75, 0xf7,
-		0x28, 0x72, 0x97, 0xd6, 0x4b, 0xd0, 0x94, 0x51, 0x4d, 0xf1, 0x25, 0xfa, 0xb6, 0xa4, 0xf7, 0xc4,
-		0xb4, 0xad, 0x48, 0x0d, 0x92, 0x28, 0xc8, 0x44, 0xc5, 0x6c, 0xba, 0xdd, 0x93, 0xef, 0xfc, 0xb6,
-		0x52, 0x7f, 0x59, 0xa9, 0x3f, 0x16, 0x4d, 0x5a, 0xc0, 0x15, 0x2d, 0x1a, 0x88, 0xbe, 0x2e, 0xe9,
-		0xbd, 0x09, 0xa8, 0x66, 0x20, 0x63, 0x4b, 0xf3, 0x3e, 0xa0, 0xc1, 0x32, 0xc5, 0x8c, 0x4a, 0xcd,
-		0x8d, 0x2a, 0xab, 0x5c, 0x2e, 0xea, 0xe5, 0xb0, 0xe8, 0x3a, 0x31, 0x47, 0xfc, 0x06, 0x3d, 0x13,
-		0x77, 0x15, 0x48, 0x72, 0x23, 0x94, 0x26, 0xb6, 0xcf, 0x2d, 0x8b, 0xee, 0x59, 0xf3, 0x3b, 0xa1,
-		0xf4, 0x94, 0x96, 0xe0, 0xfd, 0xe3, 0xa0, 0xfd, 0x65, 0xdc, 0x58, 0x53, 0xdd, 0x28, 0xfc, 0x13,
-		0xc2, 0x29, 0xcd, 0xf2, 0x42, 0xcc, 0x49, 0x26, 0x9a, 0x4a, 0x93, 0x1b, 0x5e, 0x69, 0x1b, 0xbb,
-		0x17, 0xb9, 0x1d, 0x72, 0x66, 0x80, 0x77, 0xbc, 0xd2, 0xf8, 0x35, 0x42, 0x12, 0x28, 0x23, 0x05,
-		0xdc, 0x42, 0x61, 0x73, 0xf4, 0xa2, 0x1d, 0x63, 0x99, 0x18, 0x03, 0x7e, 0x85, 0x76, 0x68, 0x96,
-		0x77, 0x68, 0xcf, 0xa2, 0x7d, 0x9a, 0xe5, 0x2d, 0xf8, 0x06, 0x3d, 0x93, 0x54, 0xc3, 0xba, 0x3a,
-		0xdb, 0x87, 0xce, 0x91, 0x13, 0xed, 0x19, 0xf3, 0xaa, 0x77, 0x3c, 0x46, 0x7b, 0x46, 0x46, 0xc2,
-		0x19, 0x49, 0x0b, 0x91, 0xe5, 0x07, 0x4f, 0xac, 0x86, 0x87, 0xff, 0xfb, 0x3c, 0xe1, 0xf8, 0xd4,
-		0xf8, 0x45, 0xbb, 0x86, 0x16, 0x32, 0x7b, 0xf1, 0x7e, 0x43, 0xbb, 0x6b, 0x18, 0x1e, 0xa0, 0xbe,
-		0xd2, 0x54, 0x6a, 0xc2, 0x59, 0xd7, 0xdc, 0x97, 0xf6, 0x1e, 0x32, 0xfc, 0x1c, 0x3d, 0x85, 0x8a,
-		0x19, 0xa0, 0xed, 0xe7, 0x09, 0x54, 0x2c, 0x64, 0xde, 0x5f, 0x0e, 0x42, 0x33, 0x51, 0x14, 0x20,
-		0xc3, 0xea, 0xa3, 0xc0, 0x63, 0xe4, 0x16, 0x54, 0x69, 0x42, 0xb3, 0x0c, 0x94, 0x22, 0x66, 0x14,
-		0xbb, 0xc7, 0x1d, 0x3e, 0x7a, 0xdc, 0x64, 0x39, 0xa7, 0xd1, 0xbe, 0xe1, 0x8c, 0x2c, 0xc5, 0x18,
-		0xf1, 0x10, 0xf5, 0x39, 0x83, 0x4a, 0x73, 0xbd, 0xe8, 0x5e, 0x68, 0x75, 0xdf, 0xa4, 0x4f, 0x6f,
-		0x83, 0x3e, 0xde, 0xdf, 0x0e, 0x1a, 0xc4, 0x9a, 0x67, 0xf9, 0xe2, 0xfc, 0x1e, 0xb2, 0xc6, 0x8c,
-		0xc6, 0x48, 0x6b, 0xc9, 0xd3, 0x46, 0x83, 0xc2, 0xbf, 0x23, 0xf7, 0x4e, 0xc8, 0x1c, 0xa4, 0x9d,
-		0x45, 0x62, 0x76, 0xb0, 0xab, 0xf3, 0xf5, 0x67, 0xe7, 0x3b, 0xda, 0x6f, 0x69, 0xab, 0x85, 0x49,
-		0xd0, 0x40, 0x65, 0x37, 0xc0, 0x9a, 0x02, 0x88, 0x16, 0xa4, 0x55, 0xcf, 0xb4, 0x2d, 0x1a, 0x6d,
-		0x6b, 0xdf, 0x3d, 0x19, 0x3c, 0x1e, 0xeb, 0x6e, 0x83, 0xa3, 0x17, 0x4b, 0x6e, 0x22, 0x62, 0xc3,
-		0x4c, 0x5a, 0xe2, 0xdb, 0x3f, 0xd1, 0x57, 0xeb, 0x1b, 0x85, 0x87, 0xe8, 0x45, 0x32, 0x8a, 0x2f,
-		0xc8, 0x24, 0x8c, 0x13, 0x72, 0x11, 0x4e, 0xc7, 0x24, 0x9c, 0x5e, 0x8d, 0x26, 0xe1, 0xd8, 0xfd,
-		0x02, 0x0f, 0xd0, 0xf3, 0x07, 0xd8, 0xf4, 0x7d, 0x74, 0x39, 0x9a, 0xb8, 0xce, 0x06, 0x28, 0x4e,
-		0xc2, 0xb3, 0x8b, 0x6b, 0x77, 0xeb, 0x2d, 0xfb, 0x2f, 0x43, 0xb2, 0xa8, 0xe1, 0xd3, 0x0c, 0xc9,
-		0xf5, 0xec, 0x7c, 0x2d, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36, 0x3e, 0x3f, 0x0b, 0xe3, 0xf0, 0xfd,
-		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
-		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
-		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x72, 0xe3, 0x34,
+		0x14, 0xc6, 0xc9, 0x76, 0x49, 0x55, 0x9a, 0x7a, 0xb5, 0x3f, 0x6d, 0xb2, 0x2c, 0x84, 0x5c, 0x2c,
+		0x9d, 0x1d, 0xc6, 0xa1, 0x65, 0xb8, 0xe2, 0x82, 0x49, 0x93, 0x0e, 0xeb, 0x69, 0x9a, 0xcd, 0x38,
+		0xde, 0x32, 0xe5, 0x46, 0xc8, 0xd6, 0x69, 0xaa, 0xb1, 0x2d, 0x79, 0x2c, 0xb9, 0x3f, 0x2f, 0xc2,
+		0x13, 0xf0, 0x14, 0x3c, 0x11, 0x8f, 0xc1, 0x48, 0x76, 0x42, 0xb6, 0x0d, 0xdc, 0x49, 0xdf, 0x77,
+		0x3e, 0x9d, 0x1f, 0x9d, 0x73, 0x50, 0xbf, 0x8c, 0xa0, 0x18, 0xc4, 0x94, 0x81, 0x88, 0x61, 0x40,
+		0x73, 0x3e, 0xb8, 0x39, 0x1a, 0x68, 0xaa, 0x92, 0x94, 0x2b, 0xed, 0xe5, 0x85, 0xd4, 0x12, 0x3f,
+		0x37, 0x36, 0x5e, 0x6d, 0xe3, 0xd1, 0x9c, 0x7b, 0x37, 0x47, 0xdd, 0xaf, 0x16, 0x52, 0x2e, 0x52,
+		0x18, 0x58, 0x93, 0xa8, 0xbc, 0x1a, 0xb0, 0xb2, 0xa0, 0x9a, 0x4b, 0x51, 0x89, 0xba, 0x5f, 0x3f,
+		0xe4, 0x35, 0xcf, 0x40, 0x69, 0x9a, 0xe5, 0xb5, 0xc1, 0xa3, 0x07, 0x6e, 0x0b, 0x9a, 0xe7, 0x50,
+		0xa8, 0x8a, 0xef, 0x7f, 0x44, 0xad, 0x90, 0xaa, 0x64, 0xc2, 0x95, 0xc6, 0x18, 0x3d, 0x11, 0x34,
+		0x83, 0x03, 0xa7, 0xe7, 0x1c, 0x6e, 0x07, 0xf6, 0x8c, 0x7f, 0x44, 0x4f, 0x12, 0x2e, 0xd8, 0x41,
+		0xa3, 0xe7, 0x1c, 0xb6, 0x8f, 0xbf, 0xf1, 0x36, 0x04, 0xe9, 0x2d, 0x1f, 0x38, 0xe3, 0x82, 0x05,
+		0xd6, 0xbc, 0x4f, 0x91, 0xbb, 0x44, 0xcf, 0x41, 0x53, 0x46, 0x35, 0xc5, 0xe7, 0xe8, 0x45, 0x46,
+		0xef, 0x88, 0x49, 0x5b, 0x91, 0x1c, 0x0a, 0xa2, 0x20, 0x96, 0x82, 0x59, 0x77, 0x3b, 0xc7, 0x5f,
+		0x7a, 0x55, 0xa4, 0xde, 0x32, 0x52, 0x6f, 0x2c, 0xcb, 0x28, 0x85, 0x0b, 0x9a, 0x96, 0x10, 0x3c,
+		0xcb, 0xe8, 0x9d, 0x79, 0x50, 0xcd, 0xa0, 0x98, 0x5b, 0x59, 0xff, 0x23, 0xea, 0x2c, 0x5d, 0xcc,
+		0x68, 0xa1, 0xb9, 0xa9, 0xca, 0xca, 0x97, 0x8b, 0x9a, 0x09, 0xdc, 0xd7, 0x99, 0x98, 0x23, 0x7e,
+		0x8b, 0xf6, 0xe4, 0xad, 0x80, 0x82, 0x5c, 0x4b, 0xa5, 0x89, 0xcd, 0xb3, 0x61, 0xd9, 0x5d, 0x0b,
+		0xbf, 0x97, 0x4a, 0x4f, 0x69, 0x06, 0xfd, 0x3f, 0x1d, 0xb4, 0xff, 0xe8, 0xdd, 0x91, 0x14, 0x57,
+		0x7c, 0x81, 0x3d, 0xf4, 0x5c, 0x94, 0x19, 0x29, 0x80, 0x32, 0x92, 0x2f, 0x39, 0x65, 0xbd, 0x6c,
+		0x05, 0xcf, 0x44, 0x99, 0x05, 0x40, 0xd9, 0x4a, 0xa4, 0xf0, 0xf7, 0xe8, 0x85, 0xb1, 0xbf, 0x2d,
+		0xb8, 0x86, 0x75, 0x41, 0xc3, 0x0a, 0xb0, 0x28, 0xb3, 0x5f, 0x0d, 0xb5, 0xa6, 0xf8, 0x16, 0xed,
+		0xa9, 0x98, 0xa6, 0x5c, 0x2c, 0x08, 0x08, 0x1a, 0xa5, 0xc0, 0x0e, 0x9a, 0x3d, 0xe7, 0xb0, 0x15,
+		0xb4, 0x6b, 0xf8, 0xb4, 0x42, 0xfb, 0x7f, 0x3b, 0xa8, 0xbd, 0x0c, 0x73, 0xae, 0xa9, 0x2e, 0x15,
+		0xfe, 0x0e, 0xe1, 0x88, 0xc6, 0x49, 0x2a, 0x17, 0x24, 0x96, 0xa5, 0xd0, 0xe4, 0x9a, 0x0b, 0x6d,
+		0x83, 0x6b, 0x06, 0x6e, 0xcd, 0x8c, 0x0c, 0xf1, 0x9e, 0x0b, 0x8d, 0xdf, 0x20, 0x64, 0xf3, 0x48,
+		0xe1, 0x06, 0x52, 0x1b, 0x51, 0x33, 0xd8, 0x36, 0xc8, 0xc4, 0x00, 0xf8, 0x35, 0xda, 0xa6, 0x71,
+		0x52, 0xb3, 0x4d, 0xcb, 0xb6, 0x68, 0x9c, 0x54, 0xe4, 0x5b, 0xb4, 0x57, 0x50, 0x0d, 0xeb, 0x9f,
+		0xf8, 0xa4, 0xe7, 0x1c, 0x3a, 0xc1, 0xae, 0x81, 0x57, 0x5f, 0x84, 0xc7, 0x68, 0xd7, 0xfc, 0x36,
+		0xe1, 0x8c, 0x44, 0xa9, 0x8c, 0x93, 0x83, 0x2d, 0xfb, 0xd5, 0xbd, 0xff, 0xec, 0x22, 0x7f, 0x7c,
+		0x62, 0xec, 0x82, 0x1d, 0x23, 0xf3, 0x99, 0xbd, 0xf4, 0x7f, 0x46, 0x3b, 0x6b, 0x1c, 0xee, 0xa0,
+		0x96, 0xd2, 0xb4, 0xd0, 0x84, 0xb3, 0x3a, 0xb9, 0xcf, 0xed, 0xdd, 0x67, 0xf8, 0x25, 0x7a, 0x0a,
+		0x82, 0x19, 0xa2, 0xca, 0x67, 0x0b, 0x04, 0xf3, 0x59, 0xff, 0x0f, 0x07, 0xa1, 0x99, 0x4c, 0x53,
+		0x28, 0x7c, 0x71, 0x25, 0xf1, 0x18, 0xb9, 0x29, 0x55, 0x9a, 0xd0, 0x38, 0x06, 0xa5, 0x88, 0x99,
+		0x98, 0xba, 0x07, 0xbb, 0x8f, 0x7a, 0x30, 0x5c, 0x8e, 0x53, 0xd0, 0x36, 0x9a, 0xa1, 0x95, 0x18,
+		0x10, 0x77, 0x51, 0x8b, 0x33, 0x10, 0x9a, 0xeb, 0xfb, 0xba, 0x91, 0x56, 0xf7, 0x4d, 0xf5, 0x69,
+		0x6e, 0xa8, 0x4f, 0xff, 0x2f, 0x07, 0x75, 0xe6, 0x9a, 0xc7, 0xc9, 0xfd, 0xe9, 0x1d, 0xc4, 0xa5,
+		0x69, 0x81, 0xa1, 0xd6, 0x05, 0x8f, 0x4a, 0x0d, 0x0a, 0xff, 0x82, 0xdc, 0x5b, 0x59, 0x24, 0x50,
+		0xd8, 0x91, 0x21, 0x66, 0x55, 0xd4, 0x71, 0xbe, 0xf9, 0xdf, 0x31, 0x0c, 0xda, 0x95, 0x6c, 0x35,
+		0xd7, 0x21, 0xea, 0xa8, 0xf8, 0x1a, 0x58, 0x99, 0x02, 0xd1, 0x92, 0x54, 0xd5, 0x33, 0x69, 0xcb,
+		0x52, 0xdb, 0xd8, 0x77, 0x8e, 0x3b, 0x8f, 0xa7, 0xaf, 0x5e, 0x34, 0xc1, 0xab, 0xa5, 0x36, 0x94,
+		0x73, 0xa3, 0x0c, 0x2b, 0xe1, 0xbb, 0xdf, 0xd1, 0x17, 0xeb, 0x83, 0x8f, 0xbb, 0xe8, 0x55, 0x38,
+		0x9c, 0x9f, 0x91, 0x89, 0x3f, 0x0f, 0xc9, 0x99, 0x3f, 0x1d, 0x13, 0x7f, 0x7a, 0x31, 0x9c, 0xf8,
+		0x63, 0xf7, 0x33, 0xdc, 0x41, 0x2f, 0x1f, 0x70, 0xd3, 0x0f, 0xc1, 0xf9, 0x70, 0xe2, 0x3a, 0x1b,
+		0xa8, 0x79, 0xe8, 0x8f, 0xce, 0x2e, 0xdd, 0xc6, 0x3b, 0xf6, 0xaf, 0x87, 0xf0, 0x3e, 0x87, 0x4f,
+		0x3d, 0x84, 0x97, 0xb3, 0xd3, 0x35, 0x0f, 0xaf, 0xd1, 0xfe, 0x03, 0x6e, 0x7c, 0x3a, 0xf2, 0xe7,
+		0xfe, 0x87, 0xa9, 0xeb, 0x6c, 0x20, 0x87, 0xa3, 0xd0, 0xbf, 0xf0, 0xc3, 0x4b, 0xb7, 0x71, 0x72,
+		0x81, 0xf6, 0x63, 0x99, 0x6d, 0xaa, 0xe8, 0x49, 0x6b, 0x98, 0xf3, 0x99, 0x29, 0xc8, 0xcc, 0xf9,
+		0x6d, 0xb0, 0xe0, 0xfa, 0xba, 0x8c, 0xbc, 0x58, 0x66, 0x83, 0x4f, 0xb6, 0xb9, 0xb7, 0x00, 0x51,
+		0xad, 0xd7, 0x7a, 0xb1, 0xff, 0x44, 0x73, 0x7e, 0x73, 0x14, 0x3d, 0xb5, 0xd8, 0x0f, 0xff, 0x0c,
+		0x00, 0x27, 0xcf, 0x98, 0xcc, 0xfc, 0x05, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -51,6 +51,7 @@ type PollForDecisionTaskRequest struct {
 	TaskList             *TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
 	Identity             string    `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
 	BinaryChecksum       string    `protobuf:"bytes,4,opt,name=binary_checksum,json=binaryChecksum,proto3" json:"binary_checksum,omitempty"`
+	IsolationGroup       string    `protobuf:"bytes,5,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -117,6 +118,13 @@ func (m *PollForDecisionTaskRequest) GetBinaryChecksum() string {
 	return ""
 }
 
+func (m *PollForDecisionTaskRequest) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
 type PollForDecisionTaskResponse struct {
 	TaskToken                 []byte                    `protobuf:"bytes,1,opt,name=task_token,json=taskToken,proto3" json:"task_token,omitempty"`
 	WorkflowExecution         *WorkflowExecution        `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -565,6 +573,8 @@ type PollForActivityTaskRequest struct {
 	TaskList             *TaskList         `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
 	Identity             string            `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
 	TaskListMetadata     *TaskListMetadata `protobuf:"bytes,4,opt,name=task_list_metadata,json=taskListMetadata,proto3" json:"task_list_metadata,omitempty"`
+	BinaryChecksum       string            `protobuf:"bytes,5,opt,name=binary_checksum,json=binaryChecksum,proto3" json:"binary_checksum,omitempty"`
+	IsolationGroup       string            `protobuf:"bytes,6,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -631,6 +641,20 @@ func (m *PollForActivityTaskRequest) GetTaskListMetadata() *TaskListMetadata {
 	return nil
 }
 
+func (m *PollForActivityTaskRequest) GetBinaryChecksum() string {
+	if m != nil {
+		return m.BinaryChecksum
+	}
+	return ""
+}
+
+func (m *PollForActivityTaskRequest) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
 type PollForActivityTaskResponse struct {
 	TaskToken                  []byte             `protobuf:"bytes,1,opt,name=task_token,json=taskToken,proto3" json:"task_token,omitempty"`
 	WorkflowExecution          *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -1941,132 +1965,132 @@ func init() {
 }
 
 var fileDescriptor_00684e887839a48c = []byte{
-	// 1986 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x07, 0xfd, 0xed, 0x27, 0x7f, 0xc8, 0x13, 0x34, 0x95, 0x95, 0xc4, 0x71, 0xb4, 0x4d, 0xe2,
-	0x6d, 0x17, 0x52, 0xe3, 0xdd, 0x66, 0xb3, 0xc9, 0xa6, 0xa8, 0x3f, 0x62, 0xc4, 0xc5, 0x76, 0xeb,
-	0x65, 0xb4, 0x59, 0x60, 0x0b, 0x84, 0x18, 0x91, 0x63, 0x6b, 0x60, 0x8a, 0xa3, 0x90, 0x43, 0xd9,
-	0xba, 0xf4, 0xd0, 0xe3, 0x6e, 0x0f, 0x05, 0x8a, 0x6e, 0x2f, 0x05, 0x72, 0xee, 0x1f, 0xd0, 0xff,
-	0xa1, 0xc7, 0xde, 0x7b, 0x69, 0x73, 0xed, 0xff, 0x50, 0x14, 0x9c, 0x19, 0x52, 0x94, 0x34, 0xa4,
-	0x3e, 0x7a, 0x70, 0x80, 0xbd, 0x99, 0xc3, 0xdf, 0xfb, 0xf1, 0xcd, 0x7b, 0x6f, 0xde, 0xfc, 0x66,
-	0x2c, 0xd8, 0x09, 0x1b, 0xc4, 0xaf, 0xd9, 0xd8, 0x21, 0x9e, 0x4d, 0x6a, 0xb8, 0x4d, 0x6b, 0x9d,
-	0x07, 0xb5, 0x80, 0xf8, 0x1d, 0x6a, 0x13, 0xeb, 0x82, 0xf9, 0xe7, 0xc4, 0xaf, 0xb6, 0x7d, 0xc6,
-	0x19, 0xba, 0x16, 0x21, 0xab, 0x0a, 0x59, 0xc5, 0x6d, 0x5a, 0xed, 0x3c, 0x28, 0x6f, 0x9d, 0x31,
-	0x76, 0xe6, 0x92, 0x9a, 0x80, 0x34, 0xc2, 0xd3, 0x9a, 0x13, 0xfa, 0x98, 0x53, 0xe6, 0x49, 0xa3,
-	0xf2, 0xed, 0xc1, 0xf7, 0x9c, 0xb6, 0x48, 0xc0, 0x71, 0xab, 0xad, 0x00, 0x43, 0x04, 0x17, 0x3e,
-	0x6e, 0xb7, 0x89, 0x1f, 0xa8, 0xf7, 0xdb, 0x3a, 0xff, 0x6c, 0xd6, 0x6a, 0x25, 0x9f, 0xa8, 0xe8,
-	0x10, 0x0e, 0xb1, 0x69, 0xd0, 0x73, 0xe3, 0x8e, 0x0e, 0xd3, 0xa4, 0x01, 0x67, 0x7e, 0x37, 0xf6,
-	0x54, 0x07, 0x79, 0x1d, 0x92, 0x04, 0xa0, 0xfd, 0x0e, 0xc7, 0xc1, 0xb9, 0x4b, 0x03, 0x9e, 0x87,
-	0x89, 0xa2, 0x78, 0xea, 0xb2, 0x0b, 0x89, 0xa9, 0xfc, 0xcd, 0x80, 0xf2, 0x09, 0x73, 0xdd, 0x23,
-	0xe6, 0x1f, 0x2a, 0x2f, 0xeb, 0x38, 0x38, 0x37, 0xc9, 0xeb, 0x90, 0x04, 0x1c, 0x5d, 0x87, 0x05,
-	0x87, 0xb5, 0x30, 0xf5, 0x4a, 0xc6, 0xb6, 0xb1, 0xb3, 0x6c, 0xaa, 0x27, 0xf4, 0x18, 0x96, 0xa3,
-	0x8f, 0x59, 0xd1, 0xd7, 0x4a, 0x33, 0xdb, 0xc6, 0x4e, 0x61, 0xf7, 0x56, 0x55, 0x93, 0x92, 0x6a,
-	0x44, 0xf6, 0x19, 0x0d, 0xb8, 0xb9, 0xc4, 0xd5, 0x5f, 0xa8, 0x0c, 0x4b, 0xd4, 0x21, 0x1e, 0xa7,
-	0xbc, 0x5b, 0x9a, 0x15, 0xac, 0xc9, 0x33, 0xba, 0x0f, 0xeb, 0x0d, 0xea, 0x61, 0xbf, 0x6b, 0xd9,
-	0x4d, 0x62, 0x9f, 0x07, 0x61, 0xab, 0x34, 0x27, 0x20, 0x6b, 0x72, 0xf8, 0x40, 0x8d, 0x56, 0xfe,
-	0xbb, 0x08, 0x37, 0xb4, 0x7e, 0x07, 0x6d, 0xe6, 0x05, 0x04, 0xdd, 0x02, 0x10, 0x0e, 0x72, 0x76,
-	0x4e, 0xa4, 0xf3, 0x2b, 0xa6, 0x70, 0xb9, 0x1e, 0x0d, 0xa0, 0x2f, 0x01, 0xc5, 0x81, 0xb0, 0xc8,
-	0x25, 0xb1, 0xc3, 0xa8, 0x4a, 0xd4, 0x44, 0xee, 0x69, 0x27, 0xf2, 0x95, 0x82, 0x3f, 0x8b, 0xd1,
-	0xe6, 0xc6, 0xc5, 0xe0, 0x10, 0x3a, 0x82, 0xd5, 0x84, 0x96, 0x77, 0xdb, 0x44, 0xcc, 0xaf, 0xb0,
-	0x7b, 0x27, 0x97, 0xb1, 0xde, 0x6d, 0x13, 0x73, 0xe5, 0x22, 0xf5, 0x84, 0x5e, 0xc2, 0x66, 0xdb,
-	0x27, 0x1d, 0xca, 0xc2, 0xc0, 0x0a, 0x38, 0xf6, 0x39, 0x71, 0x2c, 0xd2, 0x21, 0x1e, 0xb7, 0xa8,
-	0x23, 0x02, 0x52, 0xd8, 0xbd, 0x51, 0x95, 0xb5, 0x5a, 0x8d, 0x6b, 0xb5, 0x7a, 0xec, 0xf1, 0x87,
-	0x1f, 0xbd, 0xc4, 0x6e, 0x48, 0xcc, 0xeb, 0xb1, 0xf5, 0x0b, 0x69, 0xfc, 0x2c, 0xb2, 0x3d, 0x76,
-	0xd0, 0x0e, 0x14, 0x87, 0xe8, 0xe6, 0xb7, 0x8d, 0x9d, 0x59, 0x73, 0x2d, 0xe8, 0x47, 0x96, 0x60,
-	0x11, 0x73, 0x4e, 0x5a, 0x6d, 0x5e, 0x5a, 0x10, 0x80, 0xf8, 0x11, 0x7d, 0x00, 0xa8, 0x81, 0xed,
-	0x73, 0x97, 0x9d, 0x59, 0x36, 0x0b, 0x3d, 0x6e, 0x35, 0xa9, 0xc7, 0x4b, 0x8b, 0x02, 0x54, 0x54,
-	0x6f, 0x0e, 0xa2, 0x17, 0xcf, 0xa9, 0xc7, 0xd1, 0x43, 0x58, 0x54, 0x95, 0x5d, 0x5a, 0x12, 0x7e,
-	0xdf, 0xd4, 0xc6, 0xe2, 0xb9, 0xc4, 0x98, 0x31, 0x18, 0xdd, 0x83, 0x75, 0x8f, 0x5c, 0x72, 0xab,
-	0x8d, 0xcf, 0x88, 0x4a, 0xe2, 0xb2, 0x48, 0xe2, 0x6a, 0x34, 0x7c, 0x82, 0xcf, 0x88, 0x4c, 0xe4,
-	0x23, 0x98, 0x17, 0xcb, 0xa2, 0x04, 0x82, 0xbd, 0x92, 0x1b, 0xe9, 0x2f, 0x22, 0xa4, 0x29, 0x0d,
-	0xd0, 0x2b, 0xb8, 0x39, 0x5c, 0x02, 0x56, 0xaf, 0xaa, 0x0b, 0xe3, 0x54, 0xf5, 0xe6, 0x50, 0x0d,
-	0xc4, 0xaf, 0xd0, 0x1e, 0xac, 0x05, 0x76, 0x93, 0x38, 0xa1, 0x4b, 0x1c, 0x2b, 0x6a, 0x34, 0xa5,
-	0x15, 0xc1, 0x58, 0x1e, 0x4a, 0x5c, 0x3d, 0xee, 0x42, 0xe6, 0x6a, 0x62, 0x11, 0x8d, 0xa1, 0xa7,
-	0xb0, 0x12, 0xa7, 0x4b, 0x10, 0xac, 0x8e, 0x24, 0x28, 0x28, 0xbc, 0x30, 0xff, 0x0a, 0x16, 0xa3,
-	0xa9, 0x52, 0x12, 0x94, 0xd6, 0xb6, 0x67, 0x77, 0x0a, 0xbb, 0x4f, 0xb5, 0x93, 0xc9, 0x59, 0x46,
-	0xd5, 0x2f, 0xa4, 0xfd, 0x33, 0x8f, 0x47, 0xc9, 0x51, 0x6c, 0xa8, 0x02, 0x22, 0x0b, 0xbd, 0x1a,
-	0x5a, 0x17, 0xd9, 0x2f, 0x44, 0x83, 0xaa, 0x80, 0xca, 0xaf, 0x60, 0x25, 0x6d, 0x8c, 0x8a, 0x30,
-	0x7b, 0x4e, 0xba, 0xaa, 0x8d, 0x44, 0x7f, 0x46, 0xa9, 0xeb, 0x44, 0xd5, 0xaa, 0x96, 0xdd, 0x58,
-	0xa9, 0x13, 0x06, 0x8f, 0x67, 0x1e, 0x19, 0x95, 0xbf, 0xce, 0xc3, 0x7b, 0xd2, 0x4d, 0x27, 0xed,
-	0xf9, 0x01, 0x6b, 0xb5, 0x5d, 0xc2, 0x89, 0x13, 0x77, 0xb0, 0x11, 0x8d, 0xe0, 0x09, 0x2c, 0xc7,
-	0xdd, 0x39, 0x28, 0xcd, 0x88, 0x28, 0xe9, 0x53, 0x1e, 0x7f, 0xc4, 0xec, 0xe1, 0xd1, 0x4f, 0x60,
-	0xa3, 0x57, 0x39, 0x36, 0xf3, 0x38, 0xb9, 0xe4, 0x62, 0xc9, 0xaf, 0x98, 0xc5, 0xe4, 0xc5, 0x81,
-	0x1c, 0xef, 0x6b, 0x7b, 0x73, 0x03, 0x6d, 0xef, 0x37, 0xb0, 0x11, 0x70, 0x6a, 0x9f, 0x77, 0x2d,
-	0xcc, 0xb9, 0x4f, 0x1b, 0x21, 0x27, 0x81, 0x58, 0x98, 0x85, 0xdd, 0xaa, 0xd6, 0x9b, 0x17, 0x02,
-	0x9d, 0x14, 0xdd, 0x5e, 0x62, 0x65, 0x16, 0x25, 0x51, 0x6f, 0x04, 0x7d, 0x0c, 0x25, 0x9f, 0xf0,
-	0xd0, 0xf7, 0x2c, 0x8f, 0x5c, 0x58, 0xb1, 0xf7, 0xa2, 0xd2, 0xc5, 0xda, 0x5e, 0x32, 0x7f, 0x20,
-	0xdf, 0x7f, 0x4e, 0x2e, 0xd2, 0xa1, 0x44, 0xfb, 0xb0, 0x75, 0xca, 0x7c, 0x9b, 0x58, 0xb6, 0x4f,
-	0x30, 0x27, 0x1a, 0xf3, 0x45, 0x61, 0x5e, 0x16, 0xa8, 0x03, 0x01, 0x1a, 0xe4, 0xd0, 0x34, 0xf4,
-	0x25, 0x5d, 0x43, 0x47, 0x0c, 0x56, 0xc5, 0xba, 0xb4, 0x7c, 0x12, 0x84, 0x2e, 0x0f, 0x4a, 0xcb,
-	0x22, 0x19, 0xbf, 0xd4, 0x4e, 0x7f, 0x8c, 0xc4, 0x57, 0x65, 0xc5, 0x48, 0x32, 0x59, 0xbf, 0x2b,
-	0xaf, 0x53, 0x43, 0x65, 0x0a, 0x1b, 0x43, 0x10, 0x4d, 0x95, 0xfe, 0xbc, 0xbf, 0x4a, 0x77, 0xc6,
-	0xa8, 0x52, 0x41, 0x98, 0xae, 0xd5, 0x37, 0xb3, 0xf0, 0xa3, 0x7c, 0x97, 0xd5, 0xae, 0xf5, 0x25,
-	0xac, 0xf6, 0x07, 0xd8, 0x10, 0x1f, 0xfd, 0xe9, 0xa4, 0xeb, 0xd6, 0x5c, 0x71, 0xd2, 0x49, 0x78,
-	0x63, 0xc0, 0x16, 0xb6, 0x39, 0xed, 0x50, 0x4e, 0x49, 0x60, 0x71, 0x66, 0x39, 0x34, 0x68, 0x63,
-	0x6e, 0x37, 0x2d, 0x97, 0xd9, 0xd8, 0x75, 0xbb, 0xaa, 0xf4, 0xbf, 0x9e, 0x22, 0xda, 0xaa, 0x53,
-	0xec, 0x25, 0xfc, 0x75, 0x76, 0xa8, 0xd8, 0x3f, 0x93, 0xe4, 0x32, 0xfa, 0x37, 0x70, 0x36, 0xa2,
-	0xfc, 0x5b, 0xd8, 0x1e, 0x45, 0xa0, 0xc9, 0xcd, 0x61, 0x7f, 0x6e, 0xf4, 0x4b, 0x45, 0xf1, 0x76,
-	0x05, 0x57, 0x4c, 0x7c, 0xec, 0x9d, 0xb2, 0x74, 0x86, 0x7e, 0x37, 0x03, 0xdb, 0x9a, 0x69, 0x1e,
-	0x61, 0xea, 0x8e, 0xdd, 0x4a, 0xf6, 0x61, 0xde, 0xc6, 0x61, 0x20, 0xbd, 0x59, 0xdb, 0xfd, 0x20,
-	0xb7, 0x8d, 0xf4, 0xd8, 0x0f, 0x22, 0x1b, 0x53, 0x9a, 0x46, 0xdb, 0xa5, 0x43, 0x38, 0xa6, 0x6e,
-	0xa0, 0xa4, 0x83, 0x7e, 0xbb, 0x3c, 0xc1, 0x5d, 0x97, 0x61, 0xc7, 0x8c, 0xc1, 0xb9, 0xcd, 0x45,
-	0xb3, 0x04, 0xe7, 0xb5, 0x9a, 0xea, 0x3d, 0xb8, 0x93, 0x13, 0x03, 0x99, 0xe7, 0xca, 0xbf, 0x7b,
-	0x82, 0x31, 0x8e, 0xec, 0x55, 0x0a, 0xc6, 0x17, 0x80, 0x12, 0x5e, 0xab, 0x45, 0x38, 0x76, 0x30,
-	0xc7, 0x4a, 0x22, 0xdd, 0xcd, 0xfd, 0xc0, 0xaf, 0x14, 0xd8, 0x2c, 0xf2, 0x81, 0x91, 0xca, 0xb7,
-	0x4b, 0x89, 0xb8, 0xec, 0x9f, 0xe3, 0x95, 0x8a, 0xcb, 0xdb, 0x50, 0x50, 0x4b, 0xa8, 0x1b, 0xed,
-	0xb9, 0x32, 0x12, 0x10, 0x0f, 0x1d, 0x3b, 0x91, 0xfa, 0x4c, 0x00, 0x42, 0x7d, 0xce, 0xe5, 0xa8,
-	0xcf, 0x64, 0x62, 0x42, 0x7d, 0xe2, 0xd4, 0x13, 0xda, 0x85, 0x79, 0xea, 0xb5, 0x43, 0xae, 0x76,
-	0xa0, 0xfc, 0x12, 0x94, 0x50, 0x8d, 0xda, 0x59, 0xf8, 0x7f, 0xd5, 0xce, 0xe2, 0x64, 0x6a, 0xa7,
-	0x0e, 0x9b, 0x31, 0x5f, 0xd4, 0xe1, 0x6c, 0x97, 0x05, 0x44, 0x10, 0xb1, 0x90, 0x2b, 0xed, 0xb9,
-	0x39, 0xc4, 0x75, 0xa8, 0x0e, 0x88, 0xe6, 0xf5, 0xd8, 0xb6, 0xce, 0x0e, 0x22, 0xcb, 0xba, 0x34,
-	0x44, 0x9f, 0xc3, 0x75, 0xf1, 0x91, 0x61, 0xca, 0xe5, 0x51, 0x94, 0xd7, 0x84, 0xe1, 0x00, 0xdf,
-	0x11, 0x6c, 0x34, 0x09, 0xf6, 0x79, 0x83, 0x60, 0x9e, 0x50, 0xc1, 0x28, 0xaa, 0x62, 0x62, 0x13,
-	0xf3, 0xa4, 0xf4, 0x79, 0x24, 0x54, 0xe7, 0x7b, 0xfa, 0xfc, 0x15, 0x6c, 0xf5, 0x67, 0xc2, 0x62,
-	0xa7, 0x16, 0x6f, 0xd2, 0xc0, 0x8a, 0x0d, 0x46, 0xeb, 0xd0, 0x72, 0x5f, 0x66, 0x7e, 0x7d, 0x5a,
-	0x6f, 0xd2, 0x60, 0x4f, 0xf1, 0x1f, 0xa7, 0x67, 0x10, 0x37, 0xab, 0xd5, 0x31, 0x2a, 0xa5, 0x37,
-	0x89, 0x43, 0xd5, 0xb5, 0x86, 0x8e, 0x4b, 0x6b, 0xd3, 0x1d, 0x97, 0xee, 0xc3, 0x7a, 0xc2, 0xa3,
-	0xba, 0xcf, 0xba, 0xec, 0x70, 0xf1, 0xf0, 0xa1, 0xec, 0x42, 0x1f, 0xc2, 0x42, 0x93, 0x60, 0x87,
-	0xf8, 0xa5, 0xa2, 0x3a, 0x44, 0x69, 0x0f, 0x23, 0x02, 0x62, 0x2a, 0x68, 0xe5, 0x3b, 0x23, 0x51,
-	0x9a, 0xe9, 0x6e, 0x30, 0xa9, 0xd2, 0xfc, 0x08, 0x16, 0xa4, 0xb4, 0x51, 0x9d, 0x20, 0x3f, 0x58,
-	0x0a, 0x9b, 0xd7, 0xfb, 0x2a, 0xf7, 0x12, 0x55, 0x91, 0xe1, 0x97, 0x6a, 0xd9, 0xdf, 0xce, 0xc0,
-	0xfd, 0x3c, 0xe0, 0x7e, 0xf7, 0xf8, 0x70, 0x54, 0xff, 0xbe, 0xaa, 0x9e, 0xd6, 0x8b, 0xda, 0xdc,
-	0x94, 0x51, 0x9b, 0x1f, 0x88, 0xda, 0x8f, 0x61, 0x67, 0x74, 0x30, 0x54, 0xe4, 0xfe, 0x64, 0x24,
-	0xb2, 0x20, 0x0d, 0x9e, 0x48, 0x16, 0x3c, 0x84, 0xc5, 0x53, 0x4c, 0xdd, 0xd0, 0x27, 0xb9, 0x89,
-	0x3f, 0x92, 0x18, 0x33, 0x06, 0xe7, 0x66, 0xbe, 0xb7, 0x53, 0xeb, 0xdc, 0x52, 0xce, 0x7f, 0x33,
-	0xa3, 0xad, 0x0f, 0x89, 0x7a, 0x97, 0x73, 0x9e, 0x8a, 0xd8, 0xdc, 0xb4, 0x11, 0x1b, 0xcc, 0xfa,
-	0x7d, 0xb8, 0x3b, 0x22, 0x16, 0x2a, 0x6a, 0x7f, 0x36, 0xa0, 0xa2, 0xab, 0x0f, 0xec, 0xd9, 0x64,
-	0xa2, 0xa4, 0xc7, 0xad, 0x71, 0x66, 0x5a, 0x1d, 0x37, 0x98, 0xf4, 0xbb, 0xfa, 0x36, 0x94, 0x38,
-	0xa6, 0x26, 0xf0, 0xfb, 0x19, 0xb8, 0x97, 0x83, 0x7b, 0xc7, 0x13, 0x1f, 0x47, 0x6d, 0x6e, 0xda,
-	0xa8, 0x0d, 0x26, 0xfe, 0x7d, 0x7d, 0xef, 0xeb, 0x8b, 0x46, 0x5f, 0xea, 0x6d, 0xe6, 0xf7, 0x41,
-	0x9f, 0xc7, 0xbb, 0xd6, 0x15, 0xa6, 0xfe, 0x24, 0x4a, 0x7d, 0x8e, 0x63, 0x4a, 0x97, 0xbe, 0x0f,
-	0x45, 0x5b, 0x4c, 0xcc, 0xf2, 0xa5, 0xaf, 0xc4, 0x11, 0xfe, 0x2d, 0x99, 0xeb, 0x72, 0xdc, 0x8c,
-	0x87, 0x55, 0x95, 0x64, 0x52, 0x7e, 0xdf, 0xaa, 0xa4, 0x1e, 0x55, 0xc9, 0x88, 0x68, 0x4c, 0x1e,
-	0xe4, 0x7f, 0xf6, 0xb6, 0x0f, 0x71, 0x33, 0x30, 0x8d, 0x6c, 0xf8, 0xc5, 0x80, 0x6c, 0x18, 0xff,
-	0x02, 0x22, 0xde, 0x0c, 0x5f, 0xc2, 0x35, 0xf9, 0xaf, 0x13, 0xab, 0x43, 0x7c, 0x71, 0xb5, 0x40,
-	0xbd, 0x53, 0xa6, 0xce, 0x97, 0xd9, 0x89, 0x22, 0xfe, 0x4b, 0x09, 0x17, 0x67, 0xe5, 0x8d, 0x8b,
-	0xc1, 0xa1, 0xd4, 0x26, 0xa4, 0x9b, 0x5c, 0xac, 0x3d, 0x0c, 0x28, 0x9b, 0x24, 0x20, 0x5c, 0xde,
-	0x58, 0x25, 0xa7, 0xbb, 0x2b, 0xa9, 0xad, 0xca, 0x2d, 0xb8, 0xa1, 0x75, 0x46, 0x3a, 0xbb, 0xfb,
-	0x9f, 0x75, 0x58, 0x96, 0x53, 0xdf, 0x3b, 0x39, 0x46, 0x97, 0x70, 0x4d, 0x73, 0xc5, 0x82, 0x6a,
-	0xe3, 0x5f, 0xc6, 0x88, 0x39, 0x96, 0x27, 0xbe, 0xbd, 0x41, 0x7f, 0x34, 0xe0, 0x66, 0xde, 0xa5,
-	0x0b, 0x7a, 0x34, 0xed, 0xad, 0x58, 0xf9, 0x93, 0xa9, 0x6f, 0x78, 0xd0, 0x37, 0x06, 0x6c, 0x66,
-	0xde, 0x0f, 0xa0, 0x9f, 0x8d, 0x4b, 0xdc, 0x27, 0x9e, 0xca, 0x0f, 0x27, 0x35, 0x53, 0xce, 0xf4,
-	0x92, 0x93, 0x5e, 0xb1, 0xf9, 0xc9, 0xd1, 0xdc, 0x57, 0xe4, 0x27, 0x47, 0x7b, 0xf8, 0x4f, 0x25,
-	0x47, 0x2b, 0x20, 0xf3, 0x93, 0x93, 0x77, 0x82, 0xc8, 0x4f, 0x4e, 0xae, 0xc6, 0x47, 0x6f, 0xf4,
-	0x4a, 0xb5, 0x4f, 0xd6, 0xa2, 0x4f, 0x27, 0xe6, 0x4f, 0xed, 0x03, 0xe5, 0xa7, 0x53, 0x5a, 0x0f,
-	0x97, 0xcf, 0xb0, 0x04, 0xcb, 0x2f, 0x9f, 0x4c, 0xed, 0x9d, 0x5f, 0x3e, 0xd9, 0xda, 0x18, 0x7d,
-	0x67, 0xc0, 0xad, 0x5c, 0x3d, 0x88, 0x3e, 0x99, 0x8c, 0x39, 0x1d, 0xa8, 0xc7, 0xd3, 0x98, 0x2a,
-	0xc7, 0xfe, 0x60, 0x88, 0x16, 0x95, 0xa5, 0x57, 0xd0, 0xc7, 0x63, 0x27, 0xa1, 0x5f, 0xb0, 0x96,
-	0x1f, 0x4d, 0x6e, 0xa8, 0x5c, 0xfa, 0x8b, 0x01, 0xb7, 0x47, 0x48, 0x28, 0xf4, 0x64, 0x52, 0xf6,
-	0x74, 0xbc, 0x3e, 0x9d, 0xce, 0xb8, 0x2f, 0x62, 0x99, 0x7b, 0x77, 0x66, 0xc4, 0x46, 0xe9, 0xbc,
-	0xcc, 0x88, 0x8d, 0xd6, 0x61, 0x32, 0x62, 0xb9, 0x72, 0x22, 0x33, 0x62, 0xe3, 0x48, 0xb2, 0xcc,
-	0x88, 0x8d, 0xa7, 0x60, 0x52, 0x2b, 0x71, 0x78, 0xe7, 0xce, 0x5f, 0x89, 0x99, 0x32, 0x26, 0x7f,
-	0x25, 0x66, 0x0b, 0x84, 0xa8, 0x91, 0x6b, 0xb6, 0xe4, 0x8c, 0x46, 0x9e, 0xad, 0x24, 0x32, 0x1a,
-	0x79, 0xce, 0x6e, 0xbf, 0xdf, 0xf8, 0xfb, 0xdb, 0x2d, 0xe3, 0x1f, 0x6f, 0xb7, 0x8c, 0x7f, 0xbd,
-	0xdd, 0x32, 0xe0, 0x87, 0x36, 0x6b, 0xe9, 0x28, 0xf6, 0x97, 0xf6, 0xda, 0xf4, 0xc4, 0x67, 0x9c,
-	0x9d, 0x18, 0x5f, 0xd7, 0xce, 0x28, 0x6f, 0x86, 0x8d, 0xaa, 0xcd, 0x5a, 0xb5, 0xbe, 0x1f, 0x57,
-	0x54, 0xcf, 0x88, 0x27, 0x7f, 0x35, 0xa2, 0x7e, 0x67, 0xf1, 0x04, 0xb7, 0x69, 0xe7, 0x41, 0x63,
-	0x41, 0x8c, 0x7d, 0xf8, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45, 0x4a, 0x43, 0x05, 0xd9, 0x22,
-	0x00, 0x00,
+	// 1998 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4b, 0x6f, 0xdc, 0xc8,
+	0x11, 0x06, 0x47, 0xef, 0xd2, 0xbb, 0x8d, 0x38, 0x14, 0x65, 0xcb, 0xf2, 0xf8, 0x21, 0x6d, 0xb2,
+	0x18, 0xd9, 0xda, 0x8d, 0x56, 0x7e, 0x05, 0xd1, 0xc3, 0x8a, 0x15, 0x6c, 0x36, 0x5a, 0x7a, 0xd6,
+	0x0b, 0x6c, 0x00, 0x13, 0x2d, 0xb2, 0xa5, 0x69, 0x88, 0xc3, 0x1e, 0x93, 0xcd, 0x91, 0xe7, 0x92,
+	0x43, 0x8e, 0xbb, 0x39, 0x04, 0x08, 0xb2, 0xb9, 0x04, 0xf0, 0x39, 0xe7, 0xfc, 0x89, 0x1c, 0x73,
+	0x0f, 0x10, 0x04, 0xbe, 0xe6, 0x3f, 0x04, 0x01, 0xbb, 0x9b, 0x33, 0x9c, 0x99, 0x1e, 0xce, 0x23,
+	0x07, 0x19, 0xc8, 0xcd, 0x53, 0xfc, 0xea, 0x63, 0x75, 0x55, 0x75, 0xf5, 0xc7, 0xb6, 0x60, 0x33,
+	0x3e, 0x25, 0xe1, 0x96, 0x8b, 0x3d, 0x12, 0xb8, 0x64, 0x0b, 0xd7, 0xe8, 0x56, 0xfd, 0xe1, 0x56,
+	0x44, 0xc2, 0x3a, 0x75, 0x89, 0x73, 0xc9, 0xc2, 0x0b, 0x12, 0x96, 0x6a, 0x21, 0xe3, 0x0c, 0x5d,
+	0x4b, 0x90, 0x25, 0x85, 0x2c, 0xe1, 0x1a, 0x2d, 0xd5, 0x1f, 0x5a, 0x6b, 0xe7, 0x8c, 0x9d, 0xfb,
+	0x64, 0x4b, 0x40, 0x4e, 0xe3, 0xb3, 0x2d, 0x2f, 0x0e, 0x31, 0xa7, 0x2c, 0x90, 0x4e, 0xd6, 0xad,
+	0xce, 0xe7, 0x9c, 0x56, 0x49, 0xc4, 0x71, 0xb5, 0xa6, 0x00, 0x5d, 0x04, 0x97, 0x21, 0xae, 0xd5,
+	0x48, 0x18, 0xa9, 0xe7, 0xeb, 0xba, 0xf8, 0x5c, 0x56, 0xad, 0x36, 0x5f, 0x51, 0xd4, 0x21, 0x3c,
+	0xe2, 0xd2, 0xa8, 0x15, 0xc6, 0x6d, 0x1d, 0xa6, 0x42, 0x23, 0xce, 0xc2, 0x46, 0x1a, 0xa9, 0x0e,
+	0xf2, 0x26, 0x26, 0x61, 0x23, 0xef, 0x3d, 0x1c, 0x47, 0x17, 0x3e, 0x8d, 0x78, 0x1e, 0x26, 0xc9,
+	0xe2, 0x99, 0xcf, 0x2e, 0x25, 0xa6, 0xf8, 0x4f, 0x03, 0xac, 0x13, 0xe6, 0xfb, 0x47, 0x2c, 0x3c,
+	0x54, 0x51, 0x96, 0x71, 0x74, 0x61, 0x93, 0x37, 0x31, 0x89, 0x38, 0xba, 0x0e, 0x93, 0x1e, 0xab,
+	0x62, 0x1a, 0x98, 0xc6, 0xba, 0xb1, 0x39, 0x63, 0xab, 0x5f, 0xe8, 0x31, 0xcc, 0x24, 0x2f, 0x73,
+	0x92, 0xb7, 0x99, 0x85, 0x75, 0x63, 0x73, 0x76, 0xfb, 0x66, 0x49, 0x53, 0x92, 0x52, 0x42, 0xf6,
+	0x39, 0x8d, 0xb8, 0x3d, 0xcd, 0xd5, 0xbf, 0x90, 0x05, 0xd3, 0xd4, 0x23, 0x01, 0xa7, 0xbc, 0x61,
+	0x8e, 0x09, 0xd6, 0xe6, 0x6f, 0xb4, 0x01, 0x8b, 0xa7, 0x34, 0xc0, 0x61, 0xc3, 0x71, 0x2b, 0xc4,
+	0xbd, 0x88, 0xe2, 0xaa, 0x39, 0x2e, 0x20, 0x0b, 0xd2, 0x7c, 0xa0, 0xac, 0x09, 0x90, 0x46, 0xcc,
+	0x17, 0xd5, 0x75, 0xce, 0x43, 0x16, 0xd7, 0xcc, 0x09, 0x09, 0x6c, 0x9a, 0x7f, 0x9e, 0x58, 0x8b,
+	0xff, 0x99, 0x82, 0x55, 0xed, 0x02, 0xa3, 0x1a, 0x0b, 0x22, 0x82, 0x6e, 0x02, 0x88, 0x95, 0x70,
+	0x76, 0x41, 0xe4, 0x2a, 0xe7, 0x6c, 0xb1, 0xb6, 0x72, 0x62, 0x40, 0x5f, 0x01, 0x4a, 0x33, 0xe6,
+	0x90, 0xb7, 0xc4, 0x8d, 0x13, 0x66, 0xb5, 0xe2, 0xfb, 0xda, 0x15, 0x7f, 0xad, 0xe0, 0xcf, 0x53,
+	0xb4, 0xbd, 0x7c, 0xd9, 0x69, 0x42, 0x47, 0x30, 0xdf, 0xa4, 0xe5, 0x8d, 0x1a, 0x11, 0x89, 0x98,
+	0xdd, 0xbe, 0x9d, 0xcb, 0x58, 0x6e, 0xd4, 0x88, 0x3d, 0x77, 0x99, 0xf9, 0x85, 0x5e, 0xc1, 0x4a,
+	0x2d, 0x24, 0x75, 0xca, 0xe2, 0xc8, 0x89, 0x38, 0x0e, 0x39, 0xf1, 0x1c, 0x52, 0x27, 0x01, 0x77,
+	0xa8, 0x27, 0x32, 0x37, 0xbb, 0xbd, 0x5a, 0x92, 0x4d, 0x5d, 0x4a, 0x9b, 0xba, 0x74, 0x1c, 0xf0,
+	0x9d, 0x4f, 0x5f, 0x61, 0x3f, 0x26, 0xf6, 0xf5, 0xd4, 0xfb, 0xa5, 0x74, 0x7e, 0x9e, 0xf8, 0x1e,
+	0x7b, 0x68, 0x13, 0x96, 0xba, 0xe8, 0x92, 0xfc, 0x8e, 0xd9, 0x0b, 0x51, 0x3b, 0xd2, 0x84, 0x29,
+	0xcc, 0x39, 0xa9, 0xd6, 0xb8, 0x39, 0x29, 0x00, 0xe9, 0x4f, 0xf4, 0x31, 0xa0, 0x53, 0xec, 0x5e,
+	0xf8, 0xec, 0xdc, 0x71, 0x59, 0x1c, 0x70, 0xa7, 0x42, 0x03, 0x6e, 0x4e, 0x09, 0xd0, 0x92, 0x7a,
+	0x72, 0x90, 0x3c, 0x78, 0x41, 0x03, 0x8e, 0x76, 0x60, 0x4a, 0x6d, 0x01, 0x73, 0x5a, 0xc4, 0x7d,
+	0x43, 0x9b, 0x8b, 0x17, 0x12, 0x63, 0xa7, 0x60, 0x74, 0x1f, 0x16, 0x03, 0xf2, 0x96, 0x3b, 0x35,
+	0x7c, 0x4e, 0x54, 0x11, 0x67, 0x44, 0x11, 0xe7, 0x13, 0xf3, 0x09, 0x3e, 0x27, 0xb2, 0x90, 0xbb,
+	0x30, 0x21, 0xf6, 0x8f, 0x09, 0x82, 0xbd, 0x98, 0x9b, 0xe9, 0x2f, 0x13, 0xa4, 0x2d, 0x1d, 0xd0,
+	0x6b, 0xb8, 0xd1, 0xdd, 0x02, 0x4e, 0xab, 0xfd, 0x67, 0x07, 0x69, 0xff, 0x95, 0xae, 0x1e, 0x48,
+	0x1f, 0xa1, 0x3d, 0x58, 0x88, 0xdc, 0x0a, 0xf1, 0x62, 0x9f, 0x78, 0x4e, 0x32, 0x91, 0xcc, 0x39,
+	0xc1, 0x68, 0x75, 0x15, 0xae, 0x9c, 0x8e, 0x2b, 0x7b, 0xbe, 0xe9, 0x91, 0xd8, 0xd0, 0x33, 0x98,
+	0x4b, 0xcb, 0x25, 0x08, 0xe6, 0xfb, 0x12, 0xcc, 0x2a, 0xbc, 0x70, 0xff, 0x1a, 0xa6, 0x92, 0xa5,
+	0x52, 0x12, 0x99, 0x0b, 0xeb, 0x63, 0x9b, 0xb3, 0xdb, 0xcf, 0xb4, 0x8b, 0xc9, 0xd9, 0x46, 0xa5,
+	0x2f, 0xa5, 0xff, 0xf3, 0x80, 0x27, 0xc5, 0x51, 0x6c, 0xa8, 0x08, 0xa2, 0x0a, 0xad, 0x1e, 0x5a,
+	0x14, 0xd5, 0x9f, 0x4d, 0x8c, 0xaa, 0x81, 0xac, 0xd7, 0x30, 0x97, 0x75, 0x46, 0x4b, 0x30, 0x76,
+	0x41, 0x1a, 0x6a, 0xde, 0x24, 0xff, 0x4c, 0x4a, 0x57, 0x4f, 0xba, 0xd5, 0x2c, 0x0c, 0x5e, 0x3a,
+	0xe1, 0xf0, 0xb8, 0xb0, 0x6b, 0x14, 0xff, 0x32, 0x01, 0x77, 0x64, 0x98, 0x5e, 0x36, 0xf2, 0x03,
+	0x56, 0xad, 0xf9, 0x84, 0x13, 0x2f, 0x1d, 0x75, 0x7d, 0x06, 0xc1, 0x13, 0x98, 0x49, 0xc7, 0x78,
+	0x64, 0x16, 0xd6, 0xc7, 0x7a, 0x96, 0x3c, 0x7d, 0x89, 0xdd, 0xc2, 0xa3, 0x1f, 0xc3, 0x72, 0xab,
+	0x73, 0x5c, 0x16, 0x70, 0xf2, 0x96, 0x8b, 0x2d, 0x3f, 0x67, 0x2f, 0x35, 0x1f, 0x1c, 0x48, 0x7b,
+	0xdb, 0x7c, 0x1c, 0xef, 0x98, 0x8f, 0xbf, 0x86, 0xe5, 0x88, 0x53, 0xf7, 0xa2, 0xe1, 0x60, 0xce,
+	0x43, 0x7a, 0x1a, 0x73, 0x12, 0x89, 0x8d, 0x39, 0xbb, 0x5d, 0xd2, 0x46, 0xf3, 0x52, 0xa0, 0x9b,
+	0x4d, 0xb7, 0xd7, 0xf4, 0xb2, 0x97, 0x24, 0x51, 0xcb, 0x82, 0x3e, 0x03, 0x33, 0x24, 0x3c, 0x0e,
+	0x03, 0x27, 0x20, 0x97, 0x4e, 0x1a, 0xbd, 0xe8, 0x74, 0xb1, 0xb7, 0xa7, 0xed, 0x1f, 0xc8, 0xe7,
+	0x5f, 0x90, 0xcb, 0x6c, 0x2a, 0xd1, 0x3e, 0xac, 0x9d, 0xb1, 0xd0, 0x25, 0x8e, 0x1b, 0x12, 0xcc,
+	0x89, 0xc6, 0x7d, 0x4a, 0xb8, 0x5b, 0x02, 0x75, 0x20, 0x40, 0x9d, 0x1c, 0x9a, 0xc9, 0x3f, 0xad,
+	0x9d, 0xfc, 0x0c, 0xe6, 0xc5, 0xbe, 0x74, 0x42, 0x12, 0xc5, 0x3e, 0x8f, 0xcc, 0x19, 0x51, 0x8c,
+	0x5f, 0x68, 0x97, 0x3f, 0x40, 0xe1, 0x4b, 0xb2, 0x63, 0x24, 0x99, 0xec, 0xdf, 0xb9, 0x37, 0x19,
+	0x93, 0x45, 0x61, 0xb9, 0x0b, 0xa2, 0xe9, 0xd2, 0x9f, 0xb6, 0x77, 0xe9, 0xe6, 0x00, 0x5d, 0x2a,
+	0x08, 0xb3, 0xbd, 0xfa, 0x6e, 0x0c, 0xee, 0xe6, 0x87, 0xac, 0x4e, 0xad, 0xaf, 0x60, 0xbe, 0x3d,
+	0xc1, 0x86, 0x78, 0xe9, 0x83, 0x61, 0xf7, 0xad, 0x3d, 0xe7, 0x65, 0x8b, 0xf0, 0xce, 0x80, 0x35,
+	0xec, 0x72, 0x5a, 0xa7, 0x9c, 0x92, 0xc8, 0xe1, 0xcc, 0xf1, 0x68, 0x54, 0xc3, 0xdc, 0xad, 0x38,
+	0x3e, 0x73, 0xb1, 0xef, 0x37, 0x54, 0xeb, 0x7f, 0x33, 0x42, 0xb6, 0xd5, 0xa4, 0xd8, 0x6b, 0xf2,
+	0x97, 0xd9, 0xa1, 0x62, 0xff, 0x5c, 0x92, 0xcb, 0xec, 0xaf, 0xe2, 0xde, 0x08, 0xeb, 0x37, 0xb0,
+	0xde, 0x8f, 0x40, 0x53, 0x9b, 0xc3, 0xf6, 0xda, 0xe8, 0xb7, 0x8a, 0xe2, 0x6d, 0x08, 0xae, 0x94,
+	0xf8, 0x38, 0x38, 0x63, 0xd9, 0x0a, 0xfd, 0xb6, 0x00, 0xeb, 0x9a, 0x65, 0x1e, 0x61, 0xea, 0x0f,
+	0x3c, 0x4a, 0xf6, 0x61, 0xc2, 0xc5, 0x71, 0x24, 0xa3, 0x59, 0xd8, 0xfe, 0x38, 0x77, 0x8c, 0xb4,
+	0xd8, 0x0f, 0x12, 0x1f, 0x5b, 0xba, 0x26, 0xc7, 0xa5, 0x47, 0x38, 0xa6, 0x7e, 0x64, 0x8e, 0xe5,
+	0x1c, 0x97, 0x27, 0xb8, 0xe1, 0x33, 0xec, 0xd9, 0x29, 0x38, 0x77, 0xb8, 0x68, 0xb6, 0xe0, 0x84,
+	0x6e, 0x0b, 0x16, 0xef, 0xc0, 0xed, 0x9c, 0x1c, 0xc8, 0x3a, 0x17, 0xff, 0x5a, 0x68, 0x2a, 0xcb,
+	0x34, 0xb3, 0x57, 0xa9, 0x2c, 0x5f, 0x02, 0x6a, 0xf2, 0x3a, 0x55, 0xc2, 0xb1, 0x87, 0x39, 0x56,
+	0x12, 0xe9, 0x5e, 0xee, 0x0b, 0x7e, 0xa9, 0xc0, 0xf6, 0x12, 0xef, 0xb0, 0x0c, 0x9c, 0x31, 0x9d,
+	0x5c, 0x9d, 0xd4, 0xca, 0xd5, 0xef, 0xa6, 0x61, 0x55, 0x9b, 0xb5, 0x2b, 0x95, 0xab, 0xb7, 0x60,
+	0x56, 0x6d, 0xca, 0x46, 0x72, 0x8a, 0xcb, 0xdc, 0x42, 0x6a, 0x3a, 0xf6, 0x12, 0x3d, 0xdb, 0x04,
+	0x08, 0x3d, 0x3b, 0x9e, 0xa3, 0x67, 0x9b, 0x0b, 0x13, 0x7a, 0x16, 0x67, 0x7e, 0xa1, 0x6d, 0x98,
+	0xa0, 0x41, 0x2d, 0xe6, 0xe6, 0xc4, 0x00, 0x4d, 0x2d, 0xa1, 0x1a, 0xfd, 0x34, 0xf9, 0xbf, 0xea,
+	0xa7, 0xa9, 0xe1, 0xf4, 0x53, 0x19, 0x56, 0x52, 0xbe, 0x64, 0x66, 0xba, 0x3e, 0x8b, 0x88, 0x20,
+	0x62, 0x31, 0x57, 0x6a, 0x76, 0xa5, 0x8b, 0xeb, 0x50, 0x7d, 0x9b, 0xda, 0xd7, 0x53, 0xdf, 0x32,
+	0x3b, 0x48, 0x3c, 0xcb, 0xd2, 0x11, 0x7d, 0x01, 0xd7, 0xc5, 0x4b, 0xba, 0x29, 0x67, 0xfa, 0x51,
+	0x5e, 0x13, 0x8e, 0x1d, 0x7c, 0x47, 0xb0, 0x5c, 0x21, 0x38, 0xe4, 0xa7, 0x04, 0xf3, 0x26, 0x15,
+	0xf4, 0xa3, 0x5a, 0x6a, 0xfa, 0xa4, 0x3c, 0x19, 0xc5, 0x9f, 0x48, 0xdf, 0x89, 0x96, 0xe2, 0x7f,
+	0x0d, 0x6b, 0xed, 0x95, 0x70, 0xd8, 0x99, 0xc3, 0x2b, 0x34, 0x72, 0x52, 0x87, 0xfe, 0xca, 0xd6,
+	0x6a, 0xab, 0xcc, 0xaf, 0xce, 0xca, 0x15, 0x1a, 0xed, 0x29, 0xfe, 0xe3, 0xec, 0x0a, 0xd2, 0xf1,
+	0x37, 0x3f, 0x40, 0xa7, 0xb4, 0x16, 0x71, 0xa8, 0xe6, 0x60, 0xd7, 0x07, 0xd8, 0xc2, 0x68, 0x1f,
+	0x60, 0x1b, 0xb0, 0xd8, 0xe4, 0x51, 0xf3, 0x6c, 0x51, 0x6e, 0xec, 0xd4, 0x7c, 0x28, 0xac, 0xe8,
+	0x13, 0x98, 0xac, 0x10, 0xec, 0x91, 0xd0, 0x5c, 0x52, 0x9f, 0x65, 0xda, 0xcf, 0x1b, 0x01, 0xb1,
+	0x15, 0xb4, 0xf8, 0xbd, 0xd1, 0xd4, 0xae, 0xd9, 0x69, 0x30, 0xac, 0x76, 0xfd, 0x14, 0x26, 0xa5,
+	0x58, 0x32, 0x0b, 0x03, 0x24, 0x4b, 0x61, 0xf3, 0xa6, 0x69, 0xf1, 0x3e, 0xdc, 0xcd, 0x8f, 0x4b,
+	0x1d, 0x02, 0xdf, 0x15, 0x60, 0x23, 0x0f, 0xb8, 0xdf, 0x38, 0x3e, 0xec, 0x77, 0x22, 0x5c, 0xd5,
+	0x4c, 0x6b, 0x65, 0x6d, 0x7c, 0xc4, 0xac, 0x4d, 0x74, 0x64, 0xed, 0x47, 0xb0, 0xd9, 0x3f, 0x19,
+	0x2a, 0x73, 0x7f, 0x34, 0x60, 0x5d, 0x03, 0x1e, 0x4a, 0x68, 0xec, 0xc0, 0xd4, 0x19, 0xa6, 0x7e,
+	0x1c, 0x92, 0xdc, 0xc2, 0x1f, 0x49, 0x8c, 0x9d, 0x82, 0x73, 0x2b, 0xdf, 0x3a, 0xfb, 0x75, 0x61,
+	0xa9, 0xe0, 0xbf, 0x2d, 0xc0, 0xdd, 0x9e, 0xa8, 0x0f, 0xb9, 0xe6, 0x99, 0x8c, 0x8d, 0x8f, 0x9a,
+	0xb1, 0xce, 0xaa, 0x6f, 0xc0, 0xbd, 0x3e, 0xb9, 0x50, 0x59, 0xfb, 0x93, 0x01, 0x45, 0x5d, 0x7f,
+	0xe0, 0xc0, 0x25, 0x43, 0x15, 0x3d, 0x1d, 0x8d, 0x85, 0x51, 0x95, 0x61, 0x67, 0xd1, 0xef, 0xc1,
+	0x9d, 0xdc, 0xc0, 0xd4, 0x02, 0x7e, 0x57, 0x80, 0xfb, 0x39, 0xb8, 0x0f, 0xbc, 0xf0, 0x69, 0xd6,
+	0xc6, 0x47, 0xcd, 0x5a, 0x67, 0xe1, 0x3f, 0x82, 0x8d, 0xbe, 0xd9, 0x68, 0x2b, 0xbd, 0xcb, 0xc2,
+	0x36, 0xe8, 0x8b, 0xf4, 0xd4, 0xba, 0xc2, 0xd2, 0x9f, 0xc0, 0x9d, 0xdc, 0xc0, 0x94, 0x2e, 0xfd,
+	0x08, 0x96, 0x5c, 0xb1, 0x30, 0x27, 0x94, 0xb1, 0x12, 0x4f, 0xc4, 0x37, 0x6d, 0x2f, 0x4a, 0xbb,
+	0x9d, 0x9a, 0x55, 0x97, 0xf4, 0xa4, 0xfc, 0x7f, 0xeb, 0x92, 0x32, 0x6c, 0xf4, 0xcd, 0xc6, 0xf0,
+	0x49, 0xfe, 0x47, 0xeb, 0xf8, 0x10, 0x77, 0x0d, 0xa3, 0xc8, 0x86, 0x9f, 0x75, 0xc8, 0x86, 0xc1,
+	0xaf, 0x34, 0xd2, 0xc3, 0xf0, 0x15, 0x5c, 0x93, 0xff, 0x6b, 0xe3, 0xd4, 0x49, 0x28, 0x2e, 0x2b,
+	0x68, 0x70, 0xc6, 0xcc, 0xb1, 0x3e, 0x85, 0x22, 0xe1, 0x2b, 0x09, 0x17, 0x5f, 0xdf, 0xcb, 0x97,
+	0x9d, 0xa6, 0xcc, 0x21, 0xa4, 0x5b, 0x5c, 0xaa, 0x3d, 0x0c, 0xb0, 0x6c, 0x12, 0x11, 0x2e, 0xef,
+	0xc0, 0x9a, 0xdf, 0x8b, 0x57, 0xd2, 0x5b, 0xc5, 0x9b, 0xb0, 0xaa, 0x0d, 0x46, 0x06, 0xbb, 0xfd,
+	0xef, 0x45, 0x98, 0x91, 0x4b, 0xdf, 0x3b, 0x39, 0x46, 0x6f, 0xe1, 0x9a, 0xe6, 0xd2, 0x06, 0x6d,
+	0x0d, 0x7e, 0xbd, 0x23, 0xd6, 0x68, 0x0d, 0x7d, 0x1f, 0x84, 0xfe, 0x60, 0xc0, 0x8d, 0xbc, 0x6b,
+	0x1c, 0xb4, 0x3b, 0xea, 0x3d, 0x9b, 0xf5, 0x68, 0xe4, 0x3b, 0x23, 0xf4, 0xad, 0x01, 0x2b, 0x3d,
+	0x6f, 0x1c, 0xd0, 0x4f, 0x06, 0x25, 0x6e, 0x13, 0x4f, 0xd6, 0xce, 0xb0, 0x6e, 0x2a, 0x98, 0x56,
+	0x71, 0xb2, 0x3b, 0x36, 0xbf, 0x38, 0x9a, 0x1b, 0x10, 0xeb, 0xc1, 0xe0, 0x0e, 0xdd, 0xc5, 0xd1,
+	0x0a, 0xc8, 0xfc, 0xe2, 0xe4, 0x7d, 0x41, 0x58, 0x8f, 0x46, 0xf0, 0x54, 0x51, 0xbd, 0xd3, 0x2b,
+	0xd5, 0x36, 0x59, 0x8b, 0x9e, 0x0e, 0xcd, 0x9f, 0x39, 0x07, 0xac, 0x67, 0x23, 0x7a, 0x77, 0xb7,
+	0x4f, 0xb7, 0x04, 0xcb, 0x6f, 0x9f, 0x9e, 0xda, 0xdb, 0xda, 0x19, 0xd6, 0x4d, 0x05, 0xf3, 0xbd,
+	0x01, 0x37, 0x73, 0xf5, 0x20, 0x7a, 0x34, 0x1c, 0x73, 0x36, 0x51, 0x8f, 0x47, 0x71, 0x55, 0x81,
+	0xfd, 0xde, 0x80, 0x55, 0x0d, 0x32, 0xd5, 0x2b, 0xe8, 0xb3, 0x81, 0x8b, 0xd0, 0x2e, 0x58, 0xad,
+	0xdd, 0xe1, 0x1d, 0x55, 0x48, 0x7f, 0x36, 0xe0, 0x56, 0x1f, 0x09, 0x85, 0x9e, 0x0c, 0xcb, 0x9e,
+	0xcd, 0xd7, 0xd3, 0xd1, 0x9c, 0xdb, 0x32, 0xd6, 0xf3, 0xec, 0xee, 0x99, 0xb1, 0x7e, 0x3a, 0xcf,
+	0xda, 0x1d, 0xde, 0xb1, 0x2d, 0x63, 0xb9, 0x72, 0xa2, 0x67, 0xc6, 0x06, 0x91, 0x64, 0xd6, 0xd3,
+	0xd1, 0x9c, 0xbb, 0x77, 0x62, 0xf7, 0xc9, 0x9d, 0xbf, 0x13, 0x7b, 0xca, 0x18, 0x6b, 0x67, 0x58,
+	0xb7, 0xd6, 0x20, 0xd7, 0x1c, 0xc9, 0x3d, 0x06, 0x79, 0x6f, 0x25, 0x61, 0x3d, 0x18, 0xdc, 0x41,
+	0xbe, 0x79, 0xff, 0xf4, 0x6f, 0xef, 0xd7, 0x8c, 0xbf, 0xbf, 0x5f, 0x33, 0xfe, 0xf5, 0x7e, 0xcd,
+	0x80, 0x1f, 0xba, 0xac, 0xaa, 0xa3, 0xd8, 0x9f, 0xde, 0xab, 0xd1, 0x93, 0x90, 0x71, 0x76, 0x62,
+	0x7c, 0xb3, 0x75, 0x4e, 0x79, 0x25, 0x3e, 0x2d, 0xb9, 0xac, 0xba, 0xd5, 0xf6, 0x77, 0x1d, 0xa5,
+	0x73, 0x12, 0xc8, 0x3f, 0x58, 0x51, 0x7f, 0xe2, 0xf1, 0x04, 0xd7, 0x68, 0xfd, 0xe1, 0xe9, 0xa4,
+	0xb0, 0x7d, 0xf2, 0xdf, 0x01, 0x00, 0xd5, 0x6f, 0xf4, 0x23, 0x54, 0x23, 0x00, 0x00,
 }
 
 func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
@@ -2093,6 +2117,13 @@ func (m *PollForDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintServiceWorker(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.BinaryChecksum) > 0 {
 		i -= len(m.BinaryChecksum)
 		copy(dAtA[i:], m.BinaryChecksum)
@@ -2620,6 +2651,20 @@ func (m *PollForActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintServiceWorker(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BinaryChecksum) > 0 {
+		i -= len(m.BinaryChecksum)
+		copy(dAtA[i:], m.BinaryChecksum)
+		i = encodeVarintServiceWorker(dAtA, i, uint64(len(m.BinaryChecksum)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.TaskListMetadata != nil {
 		{
 			size, err := m.TaskListMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -3787,6 +3832,10 @@ func (m *PollForDecisionTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovServiceWorker(uint64(l))
 	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovServiceWorker(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4021,6 +4070,14 @@ func (m *PollForActivityTaskRequest) Size() (n int) {
 		l = m.TaskListMetadata.Size()
 		n += 1 + l + sovServiceWorker(uint64(l))
 	}
+	l = len(m.BinaryChecksum)
+	if l > 0 {
+		n += 1 + l + sovServiceWorker(uint64(l))
+	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovServiceWorker(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4663,6 +4720,38 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BinaryChecksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorker(dAtA[iNdEx:])
@@ -6373,6 +6462,70 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryChecksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorker(dAtA[iNdEx:])
@@ -47,25 +47,29 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StartWorkflowExecutionRequest struct {
-	Domain                       string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	WorkflowId                   string                `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	WorkflowType                 *WorkflowType         `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
-	TaskList                     *TaskList             `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	Input                        *Payload              `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
-	ExecutionStartToCloseTimeout *types.Duration       `protobuf:"bytes,6,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
-	TaskStartToCloseTimeout      *types.Duration       `protobuf:"bytes,7,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
-	Identity                     string                `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
-	RequestId                    string                `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	WorkflowIdReusePolicy        WorkflowIdReusePolicy `protobuf:"varint,10,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=uber.cadence.api.v1.WorkflowIdReusePolicy" json:"workflow_id_reuse_policy,omitempty"`
-	RetryPolicy                  *RetryPolicy          `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
-	CronSchedule                 string                `protobuf:"bytes,12,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
-	Memo                         *Memo                 `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
-	SearchAttributes             *SearchAttributes     `protobuf:"bytes,14,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
-	Header                       *Header               `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
-	DelayStart                   *types.Duration       `protobuf:"bytes,16,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
-	XXX_NoUnkeyedLiteral         struct{}              `json:"-"`
-	XXX_unrecognized             []byte                `json:"-"`
-	XXX_sizecache                int32                 `json:"-"`
+	Domain                       string                   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowId                   string                   `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	WorkflowType                 *WorkflowType            `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskList                     *TaskList                `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	Input                        *Payload                 `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	ExecutionStartToCloseTimeout *types.Duration          `protobuf:"bytes,6,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
+	TaskStartToCloseTimeout      *types.Duration          `protobuf:"bytes,7,opt,name=task_start_to_close_timeout,json=taskStartToCloseTimeout,proto3" json:"task_start_to_close_timeout,omitempty"`
+	Identity                     string                   `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId                    string                   `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	WorkflowIdReusePolicy        WorkflowIdReusePolicy    `protobuf:"varint,10,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=uber.cadence.api.v1.WorkflowIdReusePolicy" json:"workflow_id_reuse_policy,omitempty"`
+	RetryPolicy                  *RetryPolicy             `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	CronSchedule                 string                   `protobuf:"bytes,12,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	Memo                         *Memo                    `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes             *SearchAttributes        `protobuf:"bytes,14,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	Header                       *Header                  `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	DelayStart                   *types.Duration          `protobuf:"bytes,16,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
+	Priority                     int32                    `protobuf:"varint,17,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey                  string                   `protobuf:"bytes,18,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	IsolationGroup               string                   `protobuf:"bytes,19,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
+	WorkflowIdConflictPolicy     WorkflowIdConflictPolicy `protobuf:"varint,20,opt,name=workflow_id_conflict_policy,json=workflowIdConflictPolicy,proto3,enum=uber.cadence.api.v1.WorkflowIdConflictPolicy" json:"workflow_id_conflict_policy,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                 `json:"-"`
+	XXX_unrecognized             []byte                   `json:"-"`
+	XXX_sizecache                int32                    `json:"-"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()         { *m = StartWorkflowExecutionRequest{} }
@@ -213,6 +217,34 @@ func (m *StartWorkflowExecutionRequest) GetDelayStart() *types.Duration {
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *StartWorkflowExecutionRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *StartWorkflowExecutionRequest) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
+func (m *StartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() WorkflowIdConflictPolicy {
+	if m != nil {
+		return m.WorkflowIdConflictPolicy
+	}
+	return WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -768,6 +800,7 @@ type TerminateWorkflowExecutionRequest struct {
 	Reason               string             `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	Details              *Payload           `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
 	Identity             string             `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string             `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -841,6 +874,13 @@ func (m *TerminateWorkflowExecutionRequest) GetIdentity() string {
 	return ""
 }
 
+func (m *TerminateWorkflowExecutionRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
 type TerminateWorkflowExecutionResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -880,6 +920,110 @@ func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
 
+type ExpediteWorkflowExecutionStartRequest struct {
+	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Identity             string             `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExpediteWorkflowExecutionStartRequest) Reset()         { *m = ExpediteWorkflowExecutionStartRequest{} }
+func (m *ExpediteWorkflowExecutionStartRequest) String() string { return proto.CompactTextString(m) }
+func (*ExpediteWorkflowExecutionStartRequest) ProtoMessage()    {}
+func (*ExpediteWorkflowExecutionStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{12}
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpediteWorkflowExecutionStartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpediteWorkflowExecutionStartRequest.Merge(m, src)
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpediteWorkflowExecutionStartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpediteWorkflowExecutionStartRequest proto.InternalMessageInfo
+
+func (m *ExpediteWorkflowExecutionStartRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ExpediteWorkflowExecutionStartRequest) GetWorkflowExecution() *WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ExpediteWorkflowExecutionStartRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type ExpediteWorkflowExecutionStartResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExpediteWorkflowExecutionStartResponse) Reset() {
+	*m = ExpediteWorkflowExecutionStartResponse{}
+}
+func (m *ExpediteWorkflowExecutionStartResponse) String() string { return proto.CompactTextString(m) }
+func (*ExpediteWorkflowExecutionStartResponse) ProtoMessage()    {}
+func (*ExpediteWorkflowExecutionStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{13}
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpediteWorkflowExecutionStartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpediteWorkflowExecutionStartResponse.Merge(m, src)
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpediteWorkflowExecutionStartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpediteWorkflowExecutionStartResponse proto.InternalMessageInfo
+
 type DescribeWorkflowExecutionRequest struct {
 	Domain               string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -892,7 +1036,7 @@ func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkfl
 func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{12}
+	return fileDescriptor_674d14d2fee4e473, []int{14}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,7 +1094,7 @@ func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkf
 func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{13}
+	return fileDescriptor_674d14d2fee4e473, []int{15}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1173,7 @@ func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
 func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowRequest) ProtoMessage()    {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{14}
+	return fileDescriptor_674d14d2fee4e473, []int{16}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,7 +1249,7 @@ func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
 func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowResponse) ProtoMessage()    {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{15}
+	return fileDescriptor_674d14d2fee4e473, []int{17}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,6 +1297,7 @@ type DescribeTaskListRequest struct {
 	TaskList              *TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
 	TaskListType          TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
 	IncludeTaskListStatus bool         `protobuf:"varint,4,opt,name=include_task_list_status,json=includeTaskListStatus,proto3" json:"include_task_list_status,omitempty"`
+	IncludePartitions     bool         `protobuf:"varint,5,opt,name=include_partitions,json=includePartitions,proto3" json:"include_partitions,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}     `json:"-"`
 	XXX_unrecognized      []byte       `json:"-"`
 	XXX_sizecache         int32        `json:"-"`
@@ -1162,7 +1307,7 @@ func (m *DescribeTaskListRequest) Reset()         { *m = DescribeTaskListRequest
 func (m *DescribeTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListRequest) ProtoMessage()    {}
 func (*DescribeTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{16}
+	return fileDescriptor_674d14d2fee4e473, []int{18}
 }
 func (m *DescribeTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1219,19 +1364,29 @@ func (m *DescribeTaskListRequest) GetIncludeTaskListStatus() bool {
 	return false
 }
 
+func (m *DescribeTaskListRequest) GetIncludePartitions() bool {
+	if m != nil {
+		return m.IncludePartitions
+	}
+	return false
+}
+
 type DescribeTaskListResponse struct {
-	Pollers              []*PollerInfo   `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
-	TaskListStatus       *TaskListStatus `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Pollers              []*PollerInfo              `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus       *TaskListStatus            `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	PartitionConfig      *TaskListPartitionConfig   `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	PollersByBuildId     map[string]int32           `protobuf:"bytes,4,rep,name=pollers_by_build_id,json=pollersByBuildId,proto3" json:"pollers_by_build_id,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	PartitionStatus      map[string]*TaskListStatus `protobuf:"bytes,5,rep,name=partition_status,json=partitionStatus,proto3" json:"partition_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
 func (m *DescribeTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListResponse) ProtoMessage()    {}
 func (*DescribeTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{17}
+	return fileDescriptor_674d14d2fee4e473, []int{19}
 }
 func (m *DescribeTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1274,6 +1429,27 @@ func (m *DescribeTaskListResponse) GetTaskListStatus() *TaskListStatus {
 	return nil
 }
 
+func (m *DescribeTaskListResponse) GetPartitionConfig() *TaskListPartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetPollersByBuildId() map[string]int32 {
+	if m != nil {
+		return m.PollersByBuildId
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetPartitionStatus() map[string]*TaskListStatus {
+	if m != nil {
+		return m.PartitionStatus
+	}
+	return nil
+}
+
 type GetTaskListsByDomainRequest struct {
 	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1285,7 +1461,7 @@ func (m *GetTaskListsByDomainRequest) Reset()         { *m = GetTaskListsByDomai
 func (m *GetTaskListsByDomainRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainRequest) ProtoMessage()    {}
 func (*GetTaskListsByDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{18}
+	return fileDescriptor_674d14d2fee4e473, []int{20}
 }
 func (m *GetTaskListsByDomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1508,7 @@ func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDoma
 func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
 func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{19}
+	return fileDescriptor_674d14d2fee4e473, []int{21}
 }
 func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1556,7 @@ func (m *ListTaskListPartitionsRequest) Reset()         { *m = ListTaskListParti
 func (m *ListTaskListPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsRequest) ProtoMessage()    {}
 func (*ListTaskListPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{20}
+	return fileDescriptor_674d14d2fee4e473, []int{22}
 }
 func (m *ListTaskListPartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1424,18 +1600,20 @@ func (m *ListTaskListPartitionsRequest) GetTaskList() *TaskList {
 }
 
 type ListTaskListPartitionsResponse struct {
-	ActivityTaskListPartitions []*TaskListPartitionMetadata `protobuf:"bytes,1,rep,name=activity_task_list_partitions,json=activityTaskListPartitions,proto3" json:"activity_task_list_partitions,omitempty"`
-	DecisionTaskListPartitions []*TaskListPartitionMetadata `protobuf:"bytes,2,rep,name=decision_task_list_partitions,json=decisionTaskListPartitions,proto3" json:"decision_task_list_partitions,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                     `json:"-"`
-	XXX_unrecognized           []byte                       `json:"-"`
-	XXX_sizecache              int32                        `json:"-"`
+	ActivityTaskListPartitions      []*TaskListPartitionMetadata `protobuf:"bytes,1,rep,name=activity_task_list_partitions,json=activityTaskListPartitions,proto3" json:"activity_task_list_partitions,omitempty"`
+	DecisionTaskListPartitions      []*TaskListPartitionMetadata `protobuf:"bytes,2,rep,name=decision_task_list_partitions,json=decisionTaskListPartitions,proto3" json:"decision_task_list_partitions,omitempty"`
+	ActivityTaskListPartitionConfig *TaskListPartitionConfig     `protobuf:"bytes,3,opt,name=activity_task_list_partition_config,json=activityTaskListPartitionConfig,proto3" json:"activity_task_list_partition_config,omitempty"`
+	DecisionTaskListPartitionConfig *TaskListPartitionConfig     `protobuf:"bytes,4,opt,name=decision_task_list_partition_config,json=decisionTaskListPartitionConfig,proto3" json:"decision_task_list_partition_config,omitempty"`
+	XXX_NoUnkeyedLiteral            struct{}                     `json:"-"`
+	XXX_unrecognized                []byte                       `json:"-"`
+	XXX_sizecache                   int32                        `json:"-"`
 }
 
 func (m *ListTaskListPartitionsResponse) Reset()         { *m = ListTaskListPartitionsResponse{} }
 func (m *ListTaskListPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsResponse) ProtoMessage()    {}
 func (*ListTaskListPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{21}
+	return fileDescriptor_674d14d2fee4e473, []int{23}
 }
 func (m *ListTaskListPartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1478,24 +1656,49 @@ func (m *ListTaskListPartitionsResponse) GetDecisionTaskListPartitions() []*Task
 	return nil
 }
 
-type GetClusterInfoRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListTaskListPartitionsResponse) GetActivityTaskListPartitionConfig() *TaskListPartitionConfig {
+	if m != nil {
+		return m.ActivityTaskListPartitionConfig
+	}
+	return nil
 }
 
-func (m *GetClusterInfoRequest) Reset()         { *m = GetClusterInfoRequest{} }
-func (m *GetClusterInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetClusterInfoRequest) ProtoMessage()    {}
-func (*GetClusterInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{22}
+func (m *ListTaskListPartitionsResponse) GetDecisionTaskListPartitionConfig() *TaskListPartitionConfig {
+	if m != nil {
+		return m.DecisionTaskListPartitionConfig
+	}
+	return nil
 }
-func (m *GetClusterInfoRequest) XXX_Unmarshal(b []byte) error {
+
+type UpdateWorkerBuildIDCompatibilityRequest struct {
+	Domain   string    `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList *TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	// Exactly one of add_new_build_id_in_new_default_set, add_new_compatible_build_id
+	// and promote_set_by_build_id must be set.
+	AddNewBuildIdInNewDefaultSet string   `protobuf:"bytes,3,opt,name=add_new_build_id_in_new_default_set,json=addNewBuildIdInNewDefaultSet,proto3" json:"add_new_build_id_in_new_default_set,omitempty"`
+	AddNewCompatibleBuildId      string   `protobuf:"bytes,4,opt,name=add_new_compatible_build_id,json=addNewCompatibleBuildId,proto3" json:"add_new_compatible_build_id,omitempty"`
+	ExistingCompatibleBuildId    string   `protobuf:"bytes,5,opt,name=existing_compatible_build_id,json=existingCompatibleBuildId,proto3" json:"existing_compatible_build_id,omitempty"`
+	MakeSetDefault               bool     `protobuf:"varint,6,opt,name=make_set_default,json=makeSetDefault,proto3" json:"make_set_default,omitempty"`
+	PromoteSetByBuildId          string   `protobuf:"bytes,7,opt,name=promote_set_by_build_id,json=promoteSetByBuildId,proto3" json:"promote_set_by_build_id,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *UpdateWorkerBuildIDCompatibilityRequest) Reset() {
+	*m = UpdateWorkerBuildIDCompatibilityRequest{}
+}
+func (m *UpdateWorkerBuildIDCompatibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateWorkerBuildIDCompatibilityRequest) ProtoMessage()    {}
+func (*UpdateWorkerBuildIDCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{24}
+}
+func (m *UpdateWorkerBuildIDCompatibilityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetClusterInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateWorkerBuildIDCompatibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetClusterInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateWorkerBuildIDCompatibilityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1505,90 +1708,87 @@ func (m *GetClusterInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetClusterInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterInfoRequest.Merge(m, src)
+func (m *UpdateWorkerBuildIDCompatibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIDCompatibilityRequest.Merge(m, src)
 }
-func (m *GetClusterInfoRequest) XXX_Size() int {
+func (m *UpdateWorkerBuildIDCompatibilityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetClusterInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterInfoRequest.DiscardUnknown(m)
+func (m *UpdateWorkerBuildIDCompatibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIDCompatibilityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClusterInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateWorkerBuildIDCompatibilityRequest proto.InternalMessageInfo
 
-type GetClusterInfoResponse struct {
-	SupportedClientVersions *SupportedClientVersions `protobuf:"bytes,1,opt,name=supported_client_versions,json=supportedClientVersions,proto3" json:"supported_client_versions,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                 `json:"-"`
-	XXX_unrecognized        []byte                   `json:"-"`
-	XXX_sizecache           int32                    `json:"-"`
+func (m *UpdateWorkerBuildIDCompatibilityRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
 }
 
-func (m *GetClusterInfoResponse) Reset()         { *m = GetClusterInfoResponse{} }
-func (m *GetClusterInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetClusterInfoResponse) ProtoMessage()    {}
-func (*GetClusterInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{23}
-}
-func (m *GetClusterInfoResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetClusterInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetClusterInfoResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *UpdateWorkerBuildIDCompatibilityRequest) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
 	}
+	return nil
 }
-func (m *GetClusterInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClusterInfoResponse.Merge(m, src)
+
+func (m *UpdateWorkerBuildIDCompatibilityRequest) GetAddNewBuildIdInNewDefaultSet() string {
+	if m != nil {
+		return m.AddNewBuildIdInNewDefaultSet
+	}
+	return ""
 }
-func (m *GetClusterInfoResponse) XXX_Size() int {
-	return m.Size()
+
+func (m *UpdateWorkerBuildIDCompatibilityRequest) GetAddNewCompatibleBuildId() string {
+	if m != nil {
+		return m.AddNewCompatibleBuildId
+	}
+	return ""
 }
-func (m *GetClusterInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClusterInfoResponse.DiscardUnknown(m)
+
+func (m *UpdateWorkerBuildIDCompatibilityRequest) GetExistingCompatibleBuildId() string {
+	if m != nil {
+		return m.ExistingCompatibleBuildId
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetClusterInfoResponse proto.InternalMessageInfo
+func (m *UpdateWorkerBuildIDCompatibilityRequest) GetMakeSetDefault() bool {
+	if m != nil {
+		return m.MakeSetDefault
+	}
+	return false
+}
 
-func (m *GetClusterInfoResponse) GetSupportedClientVersions() *SupportedClientVersions {
+func (m *UpdateWorkerBuildIDCompatibilityRequest) GetPromoteSetByBuildId() string {
 	if m != nil {
-		return m.SupportedClientVersions
+		return m.PromoteSetByBuildId
 	}
-	return nil
+	return ""
 }
 
-type GetWorkflowExecutionHistoryRequest struct {
-	Domain                 string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	WorkflowExecution      *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	PageSize               int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken          []byte             `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	WaitForNewEvent        bool               `protobuf:"varint,5,opt,name=wait_for_new_event,json=waitForNewEvent,proto3" json:"wait_for_new_event,omitempty"`
-	HistoryEventFilterType EventFilterType    `protobuf:"varint,6,opt,name=history_event_filter_type,json=historyEventFilterType,proto3,enum=uber.cadence.api.v1.EventFilterType" json:"history_event_filter_type,omitempty"`
-	SkipArchival           bool               `protobuf:"varint,7,opt,name=skip_archival,json=skipArchival,proto3" json:"skip_archival,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
-	XXX_unrecognized       []byte             `json:"-"`
-	XXX_sizecache          int32              `json:"-"`
+type UpdateWorkerBuildIDCompatibilityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) Reset()         { *m = GetWorkflowExecutionHistoryRequest{} }
-func (m *GetWorkflowExecutionHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*GetWorkflowExecutionHistoryRequest) ProtoMessage()    {}
-func (*GetWorkflowExecutionHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{24}
+func (m *UpdateWorkerBuildIDCompatibilityResponse) Reset() {
+	*m = UpdateWorkerBuildIDCompatibilityResponse{}
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateWorkerBuildIDCompatibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateWorkerBuildIDCompatibilityResponse) ProtoMessage()    {}
+func (*UpdateWorkerBuildIDCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{25}
+}
+func (m *UpdateWorkerBuildIDCompatibilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateWorkerBuildIDCompatibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWorkflowExecutionHistoryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateWorkerBuildIDCompatibilityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1598,19 +1798,254 @@ func (m *GetWorkflowExecutionHistoryRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWorkflowExecutionHistoryRequest.Merge(m, src)
+func (m *UpdateWorkerBuildIDCompatibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIDCompatibilityResponse.Merge(m, src)
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_Size() int {
+func (m *UpdateWorkerBuildIDCompatibilityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWorkflowExecutionHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWorkflowExecutionHistoryRequest.DiscardUnknown(m)
+func (m *UpdateWorkerBuildIDCompatibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIDCompatibilityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWorkflowExecutionHistoryRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateWorkerBuildIDCompatibilityResponse proto.InternalMessageInfo
 
-func (m *GetWorkflowExecutionHistoryRequest) GetDomain() string {
+type GetWorkerBuildIDCompatibilityRequest struct {
+	Domain               string    `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList             *TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetWorkerBuildIDCompatibilityRequest) Reset()         { *m = GetWorkerBuildIDCompatibilityRequest{} }
+func (m *GetWorkerBuildIDCompatibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWorkerBuildIDCompatibilityRequest) ProtoMessage()    {}
+func (*GetWorkerBuildIDCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{26}
+}
+func (m *GetWorkerBuildIDCompatibilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIDCompatibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIDCompatibilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetWorkerBuildIDCompatibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIDCompatibilityRequest.Merge(m, src)
+}
+func (m *GetWorkerBuildIDCompatibilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIDCompatibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIDCompatibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIDCompatibilityRequest proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIDCompatibilityRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *GetWorkerBuildIDCompatibilityRequest) GetTaskList() *TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+type GetWorkerBuildIDCompatibilityResponse struct {
+	// The last version set is the default set.
+	VersionSets          []*WorkerVersionSet `protobuf:"bytes,1,rep,name=version_sets,json=versionSets,proto3" json:"version_sets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetWorkerBuildIDCompatibilityResponse) Reset()         { *m = GetWorkerBuildIDCompatibilityResponse{} }
+func (m *GetWorkerBuildIDCompatibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWorkerBuildIDCompatibilityResponse) ProtoMessage()    {}
+func (*GetWorkerBuildIDCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{27}
+}
+func (m *GetWorkerBuildIDCompatibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIDCompatibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIDCompatibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetWorkerBuildIDCompatibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIDCompatibilityResponse.Merge(m, src)
+}
+func (m *GetWorkerBuildIDCompatibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIDCompatibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIDCompatibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIDCompatibilityResponse proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIDCompatibilityResponse) GetVersionSets() []*WorkerVersionSet {
+	if m != nil {
+		return m.VersionSets
+	}
+	return nil
+}
+
+type GetClusterInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClusterInfoRequest) Reset()         { *m = GetClusterInfoRequest{} }
+func (m *GetClusterInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetClusterInfoRequest) ProtoMessage()    {}
+func (*GetClusterInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{28}
+}
+func (m *GetClusterInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetClusterInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetClusterInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetClusterInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterInfoRequest.Merge(m, src)
+}
+func (m *GetClusterInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetClusterInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterInfoRequest proto.InternalMessageInfo
+
+type GetClusterInfoResponse struct {
+	SupportedClientVersions *SupportedClientVersions `protobuf:"bytes,1,opt,name=supported_client_versions,json=supportedClientVersions,proto3" json:"supported_client_versions,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                 `json:"-"`
+	XXX_unrecognized        []byte                   `json:"-"`
+	XXX_sizecache           int32                    `json:"-"`
+}
+
+func (m *GetClusterInfoResponse) Reset()         { *m = GetClusterInfoResponse{} }
+func (m *GetClusterInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetClusterInfoResponse) ProtoMessage()    {}
+func (*GetClusterInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{29}
+}
+func (m *GetClusterInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetClusterInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetClusterInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetClusterInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterInfoResponse.Merge(m, src)
+}
+func (m *GetClusterInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetClusterInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterInfoResponse proto.InternalMessageInfo
+
+func (m *GetClusterInfoResponse) GetSupportedClientVersions() *SupportedClientVersions {
+	if m != nil {
+		return m.SupportedClientVersions
+	}
+	return nil
+}
+
+type GetWorkflowExecutionHistoryRequest struct {
+	Domain                 string             `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution      *WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	PageSize               int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken          []byte             `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	WaitForNewEvent        bool               `protobuf:"varint,5,opt,name=wait_for_new_event,json=waitForNewEvent,proto3" json:"wait_for_new_event,omitempty"`
+	HistoryEventFilterType EventFilterType    `protobuf:"varint,6,opt,name=history_event_filter_type,json=historyEventFilterType,proto3,enum=uber.cadence.api.v1.EventFilterType" json:"history_event_filter_type,omitempty"`
+	SkipArchival           bool               `protobuf:"varint,7,opt,name=skip_archival,json=skipArchival,proto3" json:"skip_archival,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
+	XXX_unrecognized       []byte             `json:"-"`
+	XXX_sizecache          int32              `json:"-"`
+}
+
+func (m *GetWorkflowExecutionHistoryRequest) Reset()         { *m = GetWorkflowExecutionHistoryRequest{} }
+func (m *GetWorkflowExecutionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowExecutionHistoryRequest) ProtoMessage()    {}
+func (*GetWorkflowExecutionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{30}
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkflowExecutionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowExecutionHistoryRequest.Merge(m, src)
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkflowExecutionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowExecutionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkflowExecutionHistoryRequest proto.InternalMessageInfo
+
+func (m *GetWorkflowExecutionHistoryRequest) GetDomain() string {
 	if m != nil {
 		return m.Domain
 	}
@@ -1673,7 +2108,7 @@ func (m *GetWorkflowExecutionHistoryResponse) Reset()         { *m = GetWorkflow
 func (m *GetWorkflowExecutionHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowExecutionHistoryResponse) ProtoMessage()    {}
 func (*GetWorkflowExecutionHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{25}
+	return fileDescriptor_674d14d2fee4e473, []int{31}
 }
 func (m *GetWorkflowExecutionHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1741,7 +2176,7 @@ func (m *FeatureFlags) Reset()         { *m = FeatureFlags{} }
 func (m *FeatureFlags) String() string { return proto.CompactTextString(m) }
 func (*FeatureFlags) ProtoMessage()    {}
 func (*FeatureFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_674d14d2fee4e473, []int{26}
+	return fileDescriptor_674d14d2fee4e473, []int{32}
 }
 func (m *FeatureFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1790,16 +2225,24 @@ func init() {
 	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionResponse")
 	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionRequest")
 	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*ExpediteWorkflowExecutionStartRequest)(nil), "uber.cadence.api.v1.ExpediteWorkflowExecutionStartRequest")
+	proto.RegisterType((*ExpediteWorkflowExecutionStartResponse)(nil), "uber.cadence.api.v1.ExpediteWorkflowExecutionStartResponse")
 	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.api.v1.DescribeWorkflowExecutionRequest")
 	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.DescribeWorkflowExecutionResponse")
 	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.api.v1.QueryWorkflowRequest")
 	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.api.v1.QueryWorkflowResponse")
 	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.api.v1.DescribeTaskListRequest")
 	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.api.v1.DescribeTaskListResponse")
+	proto.RegisterMapType((map[string]*TaskListStatus)(nil), "uber.cadence.api.v1.DescribeTaskListResponse.PartitionStatusEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "uber.cadence.api.v1.DescribeTaskListResponse.PollersByBuildIdEntry")
 	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.api.v1.GetTaskListsByDomainRequest")
 	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.api.v1.GetTaskListsByDomainResponse")
 	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.api.v1.ListTaskListPartitionsRequest")
 	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.api.v1.ListTaskListPartitionsResponse")
+	proto.RegisterType((*UpdateWorkerBuildIDCompatibilityRequest)(nil), "uber.cadence.api.v1.UpdateWorkerBuildIDCompatibilityRequest")
+	proto.RegisterType((*UpdateWorkerBuildIDCompatibilityResponse)(nil), "uber.cadence.api.v1.UpdateWorkerBuildIDCompatibilityResponse")
+	proto.RegisterType((*GetWorkerBuildIDCompatibilityRequest)(nil), "uber.cadence.api.v1.GetWorkerBuildIDCompatibilityRequest")
+	proto.RegisterType((*GetWorkerBuildIDCompatibilityResponse)(nil), "uber.cadence.api.v1.GetWorkerBuildIDCompatibilityResponse")
 	proto.RegisterType((*GetClusterInfoRequest)(nil), "uber.cadence.api.v1.GetClusterInfoRequest")
 	proto.RegisterType((*GetClusterInfoResponse)(nil), "uber.cadence.api.v1.GetClusterInfoResponse")
 	proto.RegisterType((*GetWorkflowExecutionHistoryRequest)(nil), "uber.cadence.api.v1.GetWorkflowExecutionHistoryRequest")
@@ -1812,136 +2255,170 @@ func init() {
 }
 
 var fileDescriptor_674d14d2fee4e473 = []byte{
-	// 2063 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x5f, 0x73, 0x1b, 0x49,
-	0x11, 0xaf, 0xf5, 0x5f, 0xb9, 0x25, 0x39, 0xc9, 0x5c, 0x6c, 0x6f, 0x94, 0xd8, 0x71, 0x36, 0x77,
-	0xc1, 0xe4, 0x2e, 0x32, 0x71, 0xb8, 0xe4, 0xc8, 0x01, 0x57, 0x8e, 0x12, 0xe7, 0x5c, 0x75, 0xb9,
-	0x32, 0x6b, 0x43, 0x0a, 0x5e, 0xb6, 0x46, 0xbb, 0x6d, 0x69, 0xf0, 0x6a, 0x77, 0x3d, 0x33, 0x6b,
-	0x9d, 0x8e, 0x27, 0xa8, 0x2b, 0x1e, 0xa0, 0xa8, 0x82, 0x47, 0x9e, 0x78, 0x80, 0x67, 0x3e, 0x06,
-	0x45, 0xf1, 0x02, 0x7c, 0x03, 0xc8, 0x17, 0xe0, 0x1b, 0x5c, 0x51, 0x3b, 0x3b, 0x2b, 0x59, 0xf2,
-	0x6a, 0x65, 0x87, 0xa2, 0x2e, 0x75, 0x6f, 0xda, 0x9e, 0xee, 0x5f, 0x4f, 0xff, 0x99, 0x9e, 0xee,
-	0x11, 0xdc, 0x8d, 0x9b, 0xc8, 0x37, 0x5d, 0xea, 0x61, 0xe0, 0xe2, 0x26, 0x8d, 0xd8, 0xe6, 0xc9,
-	0xfd, 0x4d, 0x81, 0xfc, 0x84, 0xb9, 0xe8, 0x74, 0x43, 0x7e, 0x74, 0xe8, 0x87, 0xdd, 0x7a, 0xc4,
-	0x43, 0x19, 0x92, 0xb7, 0x12, 0xde, 0xba, 0xe6, 0xad, 0xd3, 0x88, 0xd5, 0x4f, 0xee, 0xd7, 0xd6,
-	0x5a, 0x61, 0xd8, 0xf2, 0x71, 0x53, 0xb1, 0x34, 0xe3, 0xc3, 0x4d, 0x2f, 0xe6, 0x54, 0xb2, 0x30,
-	0x48, 0x85, 0x6a, 0xeb, 0x79, 0x0a, 0xdc, 0xb0, 0xd3, 0xe9, 0x73, 0xdc, 0xca, 0xe3, 0x68, 0x33,
-	0x21, 0x43, 0xde, 0xd3, 0x2c, 0x37, 0xf3, 0x58, 0x8e, 0x63, 0xec, 0x33, 0x58, 0x79, 0x0c, 0x92,
-	0x8a, 0x23, 0x9f, 0x09, 0x59, 0xc4, 0x33, 0x6c, 0xa2, 0xf5, 0xb7, 0x79, 0x58, 0xdd, 0x97, 0x94,
-	0xcb, 0x97, 0x9a, 0xfe, 0xec, 0x33, 0x74, 0xe3, 0xc4, 0x1c, 0x1b, 0x8f, 0x63, 0x14, 0x92, 0x2c,
-	0xc3, 0x9c, 0x17, 0x76, 0x28, 0x0b, 0x4c, 0x63, 0xdd, 0xd8, 0x58, 0xb0, 0xf5, 0x17, 0xb9, 0x09,
-	0xe5, 0x0c, 0xcb, 0x61, 0x9e, 0x39, 0xa5, 0x16, 0x21, 0x23, 0xed, 0x7a, 0x64, 0x07, 0xaa, 0x7d,
-	0x06, 0xd9, 0x8b, 0xd0, 0x9c, 0x5e, 0x37, 0x36, 0xca, 0x5b, 0xb7, 0xea, 0x39, 0x5e, 0xad, 0x67,
-	0xea, 0x0f, 0x7a, 0x11, 0xda, 0x95, 0xee, 0xa9, 0x2f, 0xf2, 0x18, 0x16, 0x12, 0xc3, 0x9c, 0xc4,
-	0x32, 0x73, 0x46, 0x61, 0xac, 0xe6, 0x62, 0x1c, 0x50, 0x71, 0xf4, 0x09, 0x13, 0xd2, 0x2e, 0x49,
-	0xfd, 0x8b, 0x6c, 0xc1, 0x2c, 0x0b, 0xa2, 0x58, 0x9a, 0xb3, 0x4a, 0xee, 0x46, 0xae, 0xdc, 0x1e,
-	0xed, 0xf9, 0x21, 0xf5, 0xec, 0x94, 0x95, 0x50, 0x58, 0xc7, 0xcc, 0x09, 0x8e, 0x48, 0x7c, 0xe3,
-	0xc8, 0xd0, 0x71, 0xfd, 0x50, 0xa0, 0x23, 0x59, 0x07, 0xc3, 0x58, 0x9a, 0x73, 0x0a, 0xee, 0x5a,
-	0x3d, 0xcd, 0x85, 0x7a, 0x96, 0x0b, 0xf5, 0xa7, 0x3a, 0x17, 0xec, 0x1b, 0x7d, 0x08, 0xe5, 0xdd,
-	0x83, 0xb0, 0x91, 0xc8, 0x1f, 0xa4, 0xe2, 0xe4, 0x25, 0x5c, 0x57, 0x26, 0x8d, 0x41, 0x9f, 0x9f,
-	0x84, 0xbe, 0x92, 0x48, 0xe7, 0x01, 0xd7, 0xa0, 0xc4, 0x3c, 0x0c, 0x24, 0x93, 0x3d, 0xb3, 0xa4,
-	0x22, 0xd2, 0xff, 0x26, 0xab, 0x00, 0x3c, 0x8d, 0x69, 0x12, 0xaf, 0x05, 0xb5, 0xba, 0xa0, 0x29,
-	0xbb, 0x1e, 0x71, 0xc1, 0x3c, 0x15, 0x4f, 0x87, 0x63, 0x2c, 0xd0, 0x89, 0x42, 0x9f, 0xb9, 0x3d,
-	0x13, 0xd6, 0x8d, 0x8d, 0xc5, 0xad, 0xbb, 0x85, 0x91, 0xdb, 0xf5, 0xec, 0x44, 0x64, 0x4f, 0x49,
-	0xd8, 0x4b, 0xdd, 0x3c, 0x32, 0x69, 0x40, 0x85, 0xa3, 0xe4, 0xbd, 0x0c, 0xb8, 0xac, 0x2c, 0x5d,
-	0xcf, 0x05, 0xb6, 0x13, 0x46, 0x0d, 0x57, 0xe6, 0x83, 0x0f, 0x72, 0x1b, 0xaa, 0x2e, 0x4f, 0x62,
-	0xe3, 0xb6, 0xd1, 0x8b, 0x7d, 0x34, 0x2b, 0xca, 0x96, 0x4a, 0x42, 0xdc, 0xd7, 0x34, 0x72, 0x0f,
-	0x66, 0x3a, 0xd8, 0x09, 0xcd, 0xaa, 0xf6, 0x65, 0x9e, 0x86, 0x17, 0xd8, 0x09, 0x6d, 0xc5, 0x46,
-	0x6c, 0xb8, 0x22, 0x90, 0x72, 0xb7, 0xed, 0x50, 0x29, 0x39, 0x6b, 0xc6, 0x12, 0x85, 0xb9, 0xa8,
-	0x64, 0xdf, 0xc9, 0x95, 0xdd, 0x57, 0xdc, 0xdb, 0x7d, 0x66, 0xfb, 0xb2, 0x18, 0xa1, 0x90, 0x07,
-	0x30, 0xd7, 0x46, 0xea, 0x21, 0x37, 0x2f, 0x29, 0xa0, 0xeb, 0xb9, 0x40, 0x1f, 0x2b, 0x16, 0x5b,
-	0xb3, 0x92, 0xc7, 0x50, 0xf6, 0xd0, 0xa7, 0xbd, 0x34, 0x37, 0xcc, 0xcb, 0x93, 0x52, 0x01, 0x14,
-	0xb7, 0xca, 0x05, 0xeb, 0x11, 0xac, 0x8d, 0x3b, 0xcb, 0x22, 0x0a, 0x03, 0x81, 0x64, 0x09, 0xe6,
-	0x78, 0x1c, 0x24, 0xf1, 0x4f, 0x0f, 0xf3, 0x2c, 0x8f, 0x83, 0x5d, 0xcf, 0xfa, 0xcb, 0x14, 0xac,
-	0xed, 0xb3, 0x56, 0x40, 0xfd, 0x0b, 0x97, 0x81, 0x1f, 0x02, 0xe9, 0xa7, 0x4d, 0x3f, 0xe7, 0x55,
-	0x35, 0x28, 0x6f, 0xdd, 0x29, 0x4c, 0x98, 0x81, 0x8a, 0x2b, 0xdd, 0x51, 0xd2, 0x50, 0x22, 0x4f,
-	0x17, 0x26, 0xf2, 0xcc, 0x68, 0x22, 0xdf, 0x84, 0xb2, 0x50, 0xb6, 0x38, 0x01, 0xed, 0xa0, 0x3a,
-	0xf9, 0x0b, 0x36, 0xa4, 0xa4, 0x4f, 0x69, 0x07, 0xc9, 0x47, 0x50, 0xd1, 0x0c, 0x69, 0x6d, 0x98,
-	0x3b, 0x47, 0x6d, 0xd0, 0x90, 0xbb, 0xaa, 0x42, 0x98, 0x30, 0xef, 0x86, 0x81, 0xe4, 0xa1, 0xaf,
-	0x8e, 0x6a, 0xc5, 0xce, 0x3e, 0xad, 0x5b, 0x70, 0x73, 0xac, 0x1f, 0xd3, 0x10, 0x58, 0x5f, 0x1a,
-	0xf0, 0x0d, 0xcd, 0xc3, 0x64, 0xbb, 0xb8, 0xf6, 0xbe, 0x84, 0x6a, 0x5a, 0x22, 0xb4, 0x75, 0xca,
-	0xf7, 0xe5, 0xad, 0xad, 0xfc, 0x8c, 0x2c, 0x82, 0xb2, 0x2b, 0x0a, 0x28, 0x03, 0x1e, 0xf1, 0xd1,
-	0xd4, 0x44, 0x1f, 0x4d, 0xff, 0x0f, 0x3e, 0x9a, 0x19, 0xf6, 0xd1, 0x36, 0x6c, 0x4c, 0xb6, 0xbf,
-	0x38, 0x5f, 0xff, 0x3c, 0x05, 0xab, 0x36, 0x0a, 0x94, 0x6f, 0x4a, 0xba, 0x2e, 0xc3, 0x1c, 0x47,
-	0x2a, 0xc2, 0x40, 0x27, 0xab, 0xfe, 0x22, 0x8f, 0xc0, 0xf4, 0xd0, 0x65, 0x22, 0xb9, 0x4a, 0x0e,
-	0x59, 0xc0, 0x44, 0xdb, 0xc1, 0x13, 0x0c, 0xfa, 0x89, 0x3b, 0x6d, 0x2f, 0x65, 0xeb, 0x3b, 0x6a,
-	0xf9, 0x59, 0xb2, 0xba, 0xeb, 0x8d, 0xe4, 0xf8, 0xec, 0x68, 0x8e, 0xd7, 0xe1, 0x2d, 0x71, 0xc4,
-	0x22, 0x47, 0xc7, 0x88, 0x23, 0x8d, 0x22, 0xbf, 0xa7, 0x32, 0xb9, 0x64, 0x5f, 0x49, 0x96, 0x52,
-	0x17, 0xdb, 0xe9, 0x42, 0x52, 0x19, 0xc6, 0xf9, 0xab, 0xd8, 0xd3, 0xff, 0x34, 0xe0, 0x1d, 0xed,
-	0xd3, 0x06, 0x0d, 0x5c, 0xfc, 0x1a, 0x14, 0x08, 0x6b, 0x03, 0xee, 0x4c, 0x32, 0x69, 0x70, 0x56,
-	0x6f, 0x1d, 0x20, 0xef, 0xb0, 0x80, 0x4a, 0x7c, 0xd3, 0x73, 0xed, 0x21, 0xcc, 0x7b, 0x28, 0x29,
-	0xf3, 0x85, 0xee, 0x92, 0x8a, 0x4f, 0x6b, 0xc6, 0x3c, 0xe4, 0xc9, 0xd9, 0x61, 0x4f, 0x5a, 0x6f,
-	0x83, 0x55, 0x64, 0xbf, 0x76, 0xd3, 0xef, 0x0c, 0x58, 0x7f, 0x8a, 0xc2, 0xe5, 0xac, 0xf9, 0xa6,
-	0x78, 0xc9, 0xfa, 0x72, 0x1a, 0x6e, 0x15, 0xec, 0x49, 0x67, 0xbd, 0x0f, 0x2b, 0x83, 0x5e, 0xcf,
-	0x0d, 0x83, 0x43, 0xd6, 0xd2, 0x17, 0xab, 0x2e, 0xb5, 0x0f, 0xce, 0xb7, 0x83, 0xc6, 0x69, 0x51,
-	0x7b, 0x19, 0x73, 0xe9, 0xa4, 0x09, 0x2b, 0x67, 0x4d, 0x75, 0x58, 0x70, 0x18, 0x6a, 0x7b, 0xef,
-	0x9e, 0x4f, 0xdb, 0x6e, 0x70, 0x18, 0x0e, 0x3a, 0xac, 0x21, 0x32, 0x79, 0x09, 0x24, 0xc2, 0xc0,
-	0x63, 0x41, 0xcb, 0xa1, 0xae, 0x64, 0x27, 0x4c, 0x32, 0x14, 0xe6, 0xf4, 0xfa, 0xf4, 0x46, 0x79,
-	0x6b, 0x23, 0x3f, 0x21, 0x52, 0xf6, 0xed, 0x94, 0xbb, 0xa7, 0xc0, 0xaf, 0x44, 0x43, 0x44, 0x86,
-	0x82, 0xfc, 0x18, 0x2e, 0x67, 0xc0, 0x6e, 0x9b, 0xf9, 0x1e, 0xc7, 0xc0, 0x9c, 0x51, 0xb0, 0xf5,
-	0x22, 0xd8, 0x46, 0xc2, 0x3b, 0xbc, 0xf3, 0x4b, 0xd1, 0xa9, 0x25, 0x8e, 0x01, 0xd9, 0x1f, 0x40,
-	0x67, 0xd5, 0x50, 0x37, 0xec, 0x85, 0x3b, 0x7e, 0xaa, 0x79, 0x87, 0x40, 0x33, 0xa2, 0xf5, 0xc5,
-	0x34, 0x5c, 0xfd, 0x41, 0x32, 0x31, 0x65, 0xee, 0xfb, 0x8a, 0x8e, 0xeb, 0x07, 0x30, 0xab, 0x06,
-	0x37, 0x7d, 0x85, 0x5a, 0x85, 0x48, 0x6a, 0xc3, 0x76, 0x2a, 0x40, 0x1c, 0x58, 0x56, 0x3f, 0x1c,
-	0x8e, 0x3f, 0x45, 0x57, 0x26, 0xf9, 0xe9, 0x31, 0xb5, 0xa9, 0x19, 0xd5, 0x8f, 0x7f, 0x33, 0x17,
-	0x2a, 0x85, 0x50, 0x12, 0x8d, 0x4c, 0xc0, 0xbe, 0x7a, 0x9c, 0x43, 0x4d, 0xf2, 0x31, 0x55, 0xe0,
-	0x86, 0x81, 0x60, 0x42, 0x62, 0xe0, 0xf6, 0x1c, 0x1f, 0x4f, 0xd0, 0x57, 0xee, 0x1f, 0xd7, 0xf1,
-	0x2b, 0x0d, 0x8d, 0x81, 0xc8, 0x27, 0x89, 0x84, 0xbd, 0x74, 0x9c, 0x47, 0xb6, 0xfe, 0x68, 0xc0,
-	0xd2, 0x48, 0x18, 0xf4, 0xd9, 0xfb, 0x08, 0x2a, 0x99, 0x79, 0x22, 0xf6, 0xb3, 0xde, 0x66, 0x42,
-	0x8b, 0xa1, 0xed, 0x48, 0x04, 0xc8, 0x2e, 0x2c, 0x9e, 0xf6, 0x0f, 0x7a, 0x3a, 0x58, 0xd6, 0x24,
-	0xbf, 0xa0, 0x67, 0x57, 0x8f, 0x4f, 0x7f, 0x5a, 0xff, 0x31, 0x60, 0x25, 0xab, 0x16, 0xfd, 0x31,
-	0x72, 0x42, 0xbe, 0x0c, 0xcd, 0xa5, 0x53, 0x17, 0x9b, 0x4b, 0x9f, 0xc3, 0x62, 0x5f, 0x76, 0x30,
-	0x1c, 0x2f, 0x8e, 0x19, 0x8e, 0x33, 0x80, 0x74, 0x38, 0x96, 0xa7, 0xbe, 0x92, 0x06, 0x83, 0x05,
-	0xae, 0x1f, 0x7b, 0xe8, 0x0c, 0x00, 0x85, 0xa4, 0x32, 0x4e, 0x6f, 0x81, 0x92, 0xbd, 0xa4, 0xd7,
-	0x33, 0x90, 0x7d, 0xb5, 0x68, 0xfd, 0xc9, 0x00, 0xf3, 0xac, 0xc5, 0x3a, 0x34, 0xdf, 0x81, 0xf9,
-	0x28, 0xf4, 0x7d, 0xe4, 0xc2, 0x34, 0xd4, 0x11, 0xbf, 0x99, 0x1f, 0x15, 0xc5, 0xa3, 0x8e, 0x5f,
-	0xc6, 0x4f, 0x5e, 0xc0, 0xe5, 0x33, 0x1b, 0x49, 0x9d, 0x73, 0xbb, 0xd0, 0xb6, 0x74, 0x5b, 0xf6,
-	0xa2, 0x1c, 0xde, 0xe6, 0xfb, 0x70, 0xfd, 0x39, 0xca, 0x8c, 0x49, 0x3c, 0xe9, 0x3d, 0x55, 0xce,
-	0x9f, 0x10, 0x1b, 0x6b, 0x07, 0x6e, 0xe4, 0x8b, 0x69, 0x03, 0xef, 0xc0, 0xa5, 0xc1, 0x2e, 0x93,
-	0x16, 0x38, 0x35, 0x74, 0xc1, 0xae, 0x66, 0xfa, 0x93, 0x2e, 0x58, 0x58, 0x02, 0x56, 0x95, 0xab,
-	0x35, 0x71, 0x8f, 0x72, 0xa9, 0x8e, 0x8e, 0xf8, 0x3f, 0x26, 0x87, 0xf5, 0xcb, 0x29, 0x58, 0x1b,
-	0xa7, 0x55, 0xef, 0xff, 0x18, 0x56, 0x75, 0x75, 0xef, 0x9d, 0x8a, 0x7b, 0xd4, 0x67, 0xd4, 0x61,
-	0xab, 0x17, 0xaa, 0xec, 0xe3, 0xbe, 0x40, 0x49, 0x3d, 0x2a, 0xa9, 0x5d, 0xcb, 0x40, 0xcf, 0xaa,
-	0x4e, 0x54, 0xf6, 0x5b, 0xd9, 0x5c, 0x95, 0x53, 0xaf, 0xa7, 0x32, 0x03, 0x3d, 0xab, 0xd2, 0x5a,
-	0x81, 0xa5, 0xe7, 0x28, 0x1b, 0x7e, 0x2c, 0xa4, 0x4e, 0xb3, 0xd4, 0xeb, 0xd6, 0x2f, 0x0c, 0x58,
-	0x1e, 0x5d, 0xd1, 0x9e, 0x69, 0xc3, 0x35, 0x11, 0x47, 0x51, 0xc8, 0x25, 0x7a, 0x8e, 0xeb, 0xb3,
-	0xa4, 0xd9, 0x3e, 0x41, 0x2e, 0xb4, 0x57, 0x92, 0x40, 0xbc, 0x97, 0x3f, 0x3e, 0x65, 0x52, 0x0d,
-	0x25, 0xf4, 0x23, 0x2d, 0x63, 0xaf, 0x88, 0xfc, 0x05, 0xeb, 0xd7, 0xd3, 0x60, 0x3d, 0xcf, 0x69,
-	0xa9, 0x3f, 0x4e, 0x5f, 0xf2, 0xbe, 0xa2, 0xeb, 0xe6, 0x3a, 0x2c, 0x44, 0xb4, 0x85, 0x8e, 0x60,
-	0x9f, 0xa7, 0x45, 0x65, 0xd6, 0x2e, 0x25, 0x84, 0x7d, 0xf6, 0xb9, 0x4a, 0xfb, 0x00, 0x3f, 0x4b,
-	0xa2, 0xd6, 0x42, 0x47, 0x86, 0x47, 0x18, 0xe8, 0xe1, 0xac, 0x9a, 0x90, 0xf7, 0x68, 0x0b, 0x0f,
-	0x12, 0x22, 0x79, 0x17, 0x48, 0x97, 0x32, 0xe9, 0x1c, 0x86, 0xdc, 0x09, 0xb0, 0x9b, 0xce, 0x2c,
-	0xea, 0x4e, 0x28, 0xd9, 0x97, 0x92, 0x95, 0x9d, 0x90, 0x7f, 0x8a, 0x5d, 0x35, 0xac, 0x10, 0x07,
-	0xae, 0xe9, 0xc7, 0x4b, 0x3d, 0xdb, 0x1c, 0x32, 0x5f, 0x22, 0x4f, 0xcb, 0xda, 0x9c, 0x2a, 0x6b,
-	0x6f, 0xe7, 0xda, 0xa3, 0xc4, 0x77, 0x14, 0xb3, 0xaa, 0x6c, 0xcb, 0x1a, 0x66, 0x84, 0x4e, 0x6e,
-	0x43, 0x55, 0x0d, 0x3b, 0x94, 0xbb, 0x6d, 0x76, 0x42, 0xd3, 0xa1, 0xbb, 0x64, 0x57, 0x12, 0xe2,
-	0xb6, 0xa6, 0x59, 0xff, 0x36, 0xe0, 0x76, 0x61, 0x34, 0x74, 0x7e, 0x3c, 0x84, 0x79, 0xad, 0xa6,
-	0xf0, 0xc2, 0xc9, 0xc4, 0x32, 0x66, 0xf2, 0x7d, 0x28, 0x73, 0xda, 0x75, 0x32, 0xd9, 0x34, 0xd9,
-	0xf3, 0x8f, 0xf4, 0x53, 0x2a, 0xe9, 0x13, 0x3f, 0x6c, 0xda, 0xc0, 0x69, 0x57, 0x03, 0xe5, 0xb9,
-	0x7e, 0x3a, 0xcf, 0xf5, 0x35, 0x28, 0xa5, 0x76, 0xa2, 0xa7, 0x0b, 0x78, 0xff, 0xdb, 0xea, 0x41,
-	0x65, 0x07, 0xa9, 0x8c, 0x39, 0xee, 0xf8, 0xb4, 0x25, 0x08, 0x83, 0xad, 0x9c, 0x7e, 0x92, 0xfa,
-	0x1c, 0xa9, 0x97, 0x5c, 0xea, 0x9d, 0xc8, 0xc7, 0xe4, 0x18, 0x20, 0xe7, 0x21, 0x77, 0x30, 0xa0,
-	0x4d, 0x1f, 0xd3, 0xf9, 0xae, 0x64, 0xdf, 0x3b, 0x93, 0x3a, 0xdb, 0xa9, 0x5c, 0x23, 0x13, 0x7b,
-	0x96, 0x48, 0x3d, 0x4b, 0x85, 0xb6, 0xfe, 0x5e, 0x85, 0x72, 0xe6, 0xdb, 0xed, 0xbd, 0x5d, 0xf2,
-	0x73, 0x03, 0x96, 0xf3, 0x67, 0x77, 0xf2, 0x1a, 0xaf, 0x13, 0xb5, 0x07, 0x17, 0x92, 0xd1, 0xa1,
-	0xfc, 0xc2, 0x80, 0x95, 0x31, 0xaf, 0x2d, 0x64, 0x0c, 0x60, 0xe1, 0x1b, 0x57, 0xed, 0xdb, 0x17,
-	0x13, 0xd2, 0xdb, 0xf8, 0x83, 0x01, 0xeb, 0x93, 0x1e, 0x34, 0xc8, 0x77, 0x8b, 0xa0, 0x27, 0xbd,
-	0x03, 0xd5, 0xbe, 0xf7, 0x9a, 0xd2, 0x7a, 0x87, 0x49, 0xb0, 0xf2, 0xc7, 0xff, 0x31, 0xc1, 0x2a,
-	0x7c, 0x5b, 0x19, 0x13, 0xac, 0x09, 0xef, 0x0b, 0xbf, 0x37, 0x60, 0xad, 0x78, 0xea, 0x26, 0x8f,
-	0xc7, 0xe0, 0x9e, 0xe3, 0xf5, 0xa1, 0xf6, 0xe1, 0x6b, 0xc9, 0xea, 0xbd, 0xfd, 0xc6, 0x80, 0xda,
-	0xf8, 0x31, 0x97, 0x3c, 0xcc, 0xbf, 0xd2, 0x26, 0xbd, 0x0b, 0xd4, 0x1e, 0x5d, 0x58, 0x4e, 0xef,
-	0xe7, 0x57, 0x06, 0x5c, 0x1b, 0x3b, 0xbb, 0x92, 0xf7, 0xf3, 0x8b, 0xce, 0x84, 0xf9, 0xbb, 0xf6,
-	0xf0, 0xa2, 0x62, 0x7a, 0x33, 0x87, 0x50, 0x1d, 0xea, 0xdf, 0x49, 0xc1, 0xd8, 0x31, 0x32, 0x6a,
-	0xd5, 0xee, 0x9e, 0x87, 0x55, 0xeb, 0x09, 0xe1, 0xf2, 0x68, 0x3f, 0x4a, 0xde, 0x2b, 0xdc, 0xf3,
-	0x48, 0xa3, 0x5e, 0xbb, 0x77, 0x4e, 0x6e, 0xad, 0xf0, 0x67, 0x70, 0x35, 0xaf, 0x47, 0x24, 0xdf,
-	0xca, 0x85, 0x29, 0xe8, 0x42, 0x6b, 0xf7, 0x2f, 0x20, 0x71, 0xea, 0x48, 0xe6, 0xf7, 0x78, 0x63,
-	0x8e, 0x64, 0x61, 0x1b, 0x3a, 0xe6, 0x48, 0x4e, 0x68, 0x22, 0x19, 0x2c, 0x0e, 0x37, 0x51, 0xe4,
-	0xee, 0x38, 0x43, 0xce, 0xf6, 0x60, 0xb5, 0x77, 0xcf, 0xc5, 0xab, 0x55, 0xfd, 0xd6, 0x50, 0x7d,
-	0xfc, 0xb8, 0xdb, 0x99, 0x3c, 0x1a, 0x07, 0x36, 0xa1, 0xbb, 0xaa, 0x7d, 0x70, 0x71, 0xc1, 0x74,
-	0x4b, 0x4f, 0x9a, 0x7f, 0x7d, 0xb5, 0x66, 0xfc, 0xe3, 0xd5, 0x9a, 0xf1, 0xaf, 0x57, 0x6b, 0x06,
-	0xac, 0xb8, 0x61, 0x27, 0x0f, 0xea, 0x49, 0x69, 0x3b, 0x62, 0x7b, 0x3c, 0x94, 0xe1, 0x9e, 0xf1,
-	0x93, 0xcd, 0x16, 0x93, 0xed, 0xb8, 0x59, 0x77, 0xc3, 0xce, 0xe6, 0xd0, 0x7f, 0xab, 0xf5, 0x16,
-	0x06, 0xe9, 0x1f, 0xc2, 0xfa, 0x6f, 0xd6, 0x0f, 0x69, 0xc4, 0x4e, 0xee, 0x37, 0xe7, 0x14, 0xed,
-	0xc1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x3c, 0x6b, 0x73, 0x75, 0x1e, 0x00, 0x00,
+	// 2608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x6f, 0x1b, 0xd7,
+	0xf1, 0xc7, 0xea, 0x66, 0x69, 0xa8, 0xeb, 0xb1, 0x25, 0xad, 0x29, 0x4b, 0x96, 0xd6, 0x71, 0xa2,
+	0xbf, 0x13, 0x53, 0x7f, 0xcb, 0x89, 0x9d, 0x38, 0x49, 0x03, 0x89, 0xb2, 0x1c, 0xa1, 0x89, 0xa1,
+	0x2e, 0x9d, 0x1a, 0xed, 0xcb, 0xe2, 0x70, 0x77, 0x44, 0x9d, 0x6a, 0xb9, 0xbb, 0xda, 0x3d, 0x14,
+	0x4d, 0xf7, 0xa9, 0x45, 0x9e, 0x5a, 0xb4, 0x68, 0x5f, 0x0a, 0xf4, 0xa1, 0x17, 0xa0, 0x40, 0xfb,
+	0xd6, 0x87, 0x7e, 0x88, 0xa2, 0x8f, 0xed, 0x37, 0x68, 0xf3, 0x41, 0x82, 0xe2, 0x5c, 0x96, 0x22,
+	0xa9, 0xe5, 0x52, 0x52, 0x60, 0xc4, 0xe8, 0x1b, 0x77, 0xce, 0xcc, 0x6f, 0x2e, 0x67, 0xce, 0xec,
+	0x9c, 0x59, 0xc2, 0x9d, 0x46, 0x15, 0xe3, 0x0d, 0x97, 0x7a, 0x18, 0xb8, 0xb8, 0x41, 0x23, 0xb6,
+	0x71, 0x72, 0x6f, 0x23, 0xc1, 0xf8, 0x84, 0xb9, 0xe8, 0x34, 0xc3, 0xf8, 0xe8, 0xc0, 0x0f, 0x9b,
+	0xa5, 0x28, 0x0e, 0x79, 0x48, 0xae, 0x0a, 0xde, 0x92, 0xe6, 0x2d, 0xd1, 0x88, 0x95, 0x4e, 0xee,
+	0x15, 0x57, 0x6a, 0x61, 0x58, 0xf3, 0x71, 0x43, 0xb2, 0x54, 0x1b, 0x07, 0x1b, 0x5e, 0x23, 0xa6,
+	0x9c, 0x85, 0x81, 0x12, 0x2a, 0xae, 0x66, 0x29, 0x70, 0xc3, 0x7a, 0xbd, 0xcd, 0xb1, 0x96, 0xc5,
+	0x71, 0xc8, 0x12, 0x1e, 0xc6, 0x2d, 0xcd, 0x72, 0x33, 0x8b, 0xe5, 0xb8, 0x81, 0x6d, 0x06, 0x2b,
+	0x8b, 0x81, 0xd3, 0xe4, 0xc8, 0x67, 0x09, 0xcf, 0xe3, 0xe9, 0x76, 0xd1, 0xfa, 0xe3, 0x04, 0x2c,
+	0x57, 0x38, 0x8d, 0xf9, 0x73, 0x4d, 0x7f, 0xfc, 0x02, 0xdd, 0x86, 0x70, 0xc7, 0xc6, 0xe3, 0x06,
+	0x26, 0x9c, 0x2c, 0xc0, 0x98, 0x17, 0xd6, 0x29, 0x0b, 0x4c, 0x63, 0xd5, 0x58, 0x9f, 0xb0, 0xf5,
+	0x13, 0xb9, 0x09, 0x85, 0x14, 0xcb, 0x61, 0x9e, 0x39, 0x24, 0x17, 0x21, 0x25, 0xed, 0x79, 0x64,
+	0x17, 0xa6, 0xda, 0x0c, 0xbc, 0x15, 0xa1, 0x39, 0xbc, 0x6a, 0xac, 0x17, 0x36, 0xd7, 0x4a, 0x19,
+	0x51, 0x2d, 0xa5, 0xea, 0x9f, 0xb5, 0x22, 0xb4, 0x27, 0x9b, 0x1d, 0x4f, 0xe4, 0x11, 0x4c, 0x08,
+	0xc7, 0x1c, 0xe1, 0x99, 0x39, 0x22, 0x31, 0x96, 0x33, 0x31, 0x9e, 0xd1, 0xe4, 0xe8, 0x33, 0x96,
+	0x70, 0x7b, 0x9c, 0xeb, 0x5f, 0x64, 0x13, 0x46, 0x59, 0x10, 0x35, 0xb8, 0x39, 0x2a, 0xe5, 0x6e,
+	0x64, 0xca, 0xed, 0xd3, 0x96, 0x1f, 0x52, 0xcf, 0x56, 0xac, 0x84, 0xc2, 0x2a, 0xa6, 0x41, 0x70,
+	0x12, 0x11, 0x1b, 0x87, 0x87, 0x8e, 0xeb, 0x87, 0x09, 0x3a, 0x9c, 0xd5, 0x31, 0x6c, 0x70, 0x73,
+	0x4c, 0xc2, 0x5d, 0x2f, 0xa9, 0x5c, 0x28, 0xa5, 0xb9, 0x50, 0xda, 0xd1, 0xb9, 0x60, 0xdf, 0x68,
+	0x43, 0xc8, 0xe8, 0x3e, 0x0b, 0xcb, 0x42, 0xfe, 0x99, 0x12, 0x27, 0xcf, 0x61, 0x49, 0xba, 0xd4,
+	0x07, 0xfd, 0xca, 0x20, 0xf4, 0x45, 0x21, 0x9d, 0x05, 0x5c, 0x84, 0x71, 0xe6, 0x61, 0xc0, 0x19,
+	0x6f, 0x99, 0xe3, 0x72, 0x47, 0xda, 0xcf, 0x64, 0x19, 0x20, 0x56, 0x7b, 0x2a, 0xf6, 0x6b, 0x42,
+	0xae, 0x4e, 0x68, 0xca, 0x9e, 0x47, 0x5c, 0x30, 0x3b, 0xf6, 0xd3, 0x89, 0xb1, 0x91, 0xa0, 0x13,
+	0x85, 0x3e, 0x73, 0x5b, 0x26, 0xac, 0x1a, 0xeb, 0xd3, 0x9b, 0x77, 0x72, 0x77, 0x6e, 0xcf, 0xb3,
+	0x85, 0xc8, 0xbe, 0x94, 0xb0, 0xe7, 0x9b, 0x59, 0x64, 0x52, 0x86, 0xc9, 0x18, 0x79, 0xdc, 0x4a,
+	0x81, 0x0b, 0xd2, 0xd3, 0xd5, 0x4c, 0x60, 0x5b, 0x30, 0x6a, 0xb8, 0x42, 0x7c, 0xfa, 0x40, 0x6e,
+	0xc1, 0x94, 0x1b, 0x8b, 0xbd, 0x71, 0x0f, 0xd1, 0x6b, 0xf8, 0x68, 0x4e, 0x4a, 0x5f, 0x26, 0x05,
+	0xb1, 0xa2, 0x69, 0xe4, 0x2e, 0x8c, 0xd4, 0xb1, 0x1e, 0x9a, 0x53, 0x3a, 0x96, 0x59, 0x1a, 0x3e,
+	0xc7, 0x7a, 0x68, 0x4b, 0x36, 0x62, 0xc3, 0x5c, 0x82, 0x34, 0x76, 0x0f, 0x1d, 0xca, 0x79, 0xcc,
+	0xaa, 0x0d, 0x8e, 0x89, 0x39, 0x2d, 0x65, 0x6f, 0x67, 0xca, 0x56, 0x24, 0xf7, 0x56, 0x9b, 0xd9,
+	0x9e, 0x4d, 0x7a, 0x28, 0xe4, 0x3e, 0x8c, 0x1d, 0x22, 0xf5, 0x30, 0x36, 0x67, 0x24, 0xd0, 0x52,
+	0x26, 0xd0, 0xa7, 0x92, 0xc5, 0xd6, 0xac, 0xe4, 0x11, 0x14, 0x3c, 0xf4, 0x69, 0x4b, 0xe5, 0x86,
+	0x39, 0x3b, 0x28, 0x15, 0x40, 0x72, 0xcb, 0x5c, 0x10, 0xbb, 0x1f, 0xc5, 0x2c, 0x8c, 0xc5, 0xee,
+	0xcf, 0xad, 0x1a, 0xeb, 0xa3, 0x76, 0xfb, 0x99, 0xac, 0xc1, 0xe4, 0x01, 0x65, 0x71, 0x80, 0x49,
+	0xe2, 0x1c, 0x61, 0xcb, 0x24, 0x32, 0x66, 0x85, 0x94, 0xf6, 0x5d, 0x6c, 0x91, 0xb7, 0x60, 0x86,
+	0x25, 0xa1, 0x2f, 0x71, 0x9d, 0x5a, 0x1c, 0x36, 0x22, 0xf3, 0xaa, 0xe4, 0x9a, 0x6e, 0x93, 0x9f,
+	0x08, 0x2a, 0xf1, 0x61, 0xa9, 0x33, 0x55, 0xdc, 0x30, 0x38, 0xf0, 0x99, 0xcb, 0xd3, 0x4d, 0xbd,
+	0x26, 0xb3, 0xe5, 0xee, 0x80, 0x6c, 0x29, 0x6b, 0x29, 0xbd, 0xc3, 0x66, 0xb3, 0xcf, 0x8a, 0xf5,
+	0x10, 0x56, 0xfa, 0x55, 0xa8, 0x24, 0x0a, 0x83, 0x04, 0xc9, 0x3c, 0x8c, 0xc5, 0x8d, 0x40, 0x64,
+	0xb5, 0x2a, 0x51, 0xa3, 0x71, 0x23, 0xd8, 0xf3, 0xac, 0xbf, 0x0f, 0xc1, 0x4a, 0x85, 0xd5, 0x02,
+	0xea, 0x5f, 0xb8, 0xb8, 0x7d, 0x01, 0xa4, 0xed, 0x61, 0xfb, 0x24, 0xcb, 0x1a, 0x57, 0xd8, 0x7c,
+	0x33, 0xd7, 0xb1, 0x53, 0x15, 0x73, 0xcd, 0x5e, 0x52, 0xd7, 0xf1, 0x1c, 0xce, 0x3d, 0x9e, 0x23,
+	0xbd, 0xc7, 0xf3, 0x26, 0x14, 0x12, 0xe9, 0x8b, 0x13, 0xd0, 0x3a, 0xca, 0x7a, 0x36, 0x61, 0x83,
+	0x22, 0x3d, 0xa5, 0x75, 0x24, 0x9f, 0xc0, 0xa4, 0x66, 0x50, 0x15, 0x6f, 0xec, 0x1c, 0x15, 0x4f,
+	0x43, 0xee, 0xc9, 0xba, 0x67, 0xc2, 0x15, 0x37, 0x0c, 0x78, 0x1c, 0xfa, 0xb2, 0x00, 0x4d, 0xda,
+	0xe9, 0xa3, 0xb5, 0x06, 0x37, 0xfb, 0xc6, 0x51, 0x6d, 0x81, 0xf5, 0xb5, 0x01, 0x6f, 0x69, 0x1e,
+	0xc6, 0x0f, 0xf3, 0xdf, 0x28, 0xcf, 0x61, 0x4a, 0x15, 0x3e, 0xed, 0x9d, 0x8c, 0x7d, 0x61, 0x73,
+	0x33, 0xfb, 0x9c, 0xe5, 0x41, 0xd9, 0x93, 0x12, 0x28, 0x05, 0xee, 0x89, 0xd1, 0xd0, 0xc0, 0x18,
+	0x0d, 0x7f, 0x83, 0x18, 0x8d, 0x74, 0xc7, 0x68, 0x0b, 0xd6, 0x07, 0xfb, 0x9f, 0x9f, 0xaf, 0x7f,
+	0x1d, 0x82, 0x65, 0x1b, 0x13, 0xe4, 0xaf, 0x4b, 0xba, 0x2e, 0xc0, 0x58, 0x8c, 0x34, 0x09, 0x03,
+	0x9d, 0xac, 0xfa, 0x89, 0x3c, 0x04, 0xd3, 0x43, 0x97, 0x25, 0xa2, 0x4e, 0x1c, 0xb0, 0x80, 0x25,
+	0x87, 0x0e, 0x9e, 0x60, 0xd0, 0x4e, 0xdc, 0x61, 0x7b, 0x3e, 0x5d, 0xdf, 0x95, 0xcb, 0x8f, 0xc5,
+	0xea, 0x9e, 0xd7, 0x93, 0xe3, 0xa3, 0xbd, 0x39, 0x5e, 0x82, 0xab, 0xc9, 0x11, 0x8b, 0x1c, 0xbd,
+	0x47, 0x31, 0xd2, 0x28, 0xf2, 0x5b, 0x32, 0x93, 0xc7, 0xed, 0x39, 0xb1, 0xa4, 0x42, 0x6c, 0xab,
+	0x05, 0x51, 0x19, 0xfa, 0xc5, 0x2b, 0x3f, 0xd2, 0xff, 0x32, 0xe0, 0xb6, 0x8e, 0x69, 0x99, 0x06,
+	0x2e, 0xfe, 0x0f, 0x14, 0x08, 0x6b, 0x1d, 0xde, 0x1c, 0xe4, 0x92, 0x3e, 0xab, 0xbf, 0x1b, 0x82,
+	0xb5, 0x67, 0x18, 0xd7, 0x59, 0x40, 0x39, 0xbe, 0xee, 0xb9, 0xf6, 0x00, 0xae, 0x78, 0xc8, 0x29,
+	0xf3, 0x13, 0x73, 0xe4, 0x1c, 0xa7, 0x35, 0x65, 0xee, 0x8a, 0xe4, 0x68, 0x6e, 0x24, 0xc7, 0x7a,
+	0x23, 0xf9, 0x06, 0x58, 0x79, 0xe1, 0xd1, 0x51, 0xfc, 0x9b, 0x01, 0xb7, 0x1f, 0xbf, 0x88, 0xd0,
+	0x63, 0x19, 0x5c, 0x95, 0xce, 0xb2, 0xf4, 0xfa, 0xe4, 0x90, 0x48, 0x92, 0x41, 0x36, 0x6b, 0xf7,
+	0x7e, 0x6d, 0xc0, 0xea, 0x0e, 0x26, 0x6e, 0xcc, 0xaa, 0xaf, 0x4b, 0x8e, 0x58, 0x5f, 0x0f, 0xc3,
+	0x5a, 0x8e, 0x4d, 0xfa, 0xcc, 0xfb, 0xb0, 0x78, 0xda, 0xbf, 0x8b, 0xde, 0x84, 0xd5, 0x74, 0xb3,
+	0xa4, 0x5f, 0x34, 0xf7, 0xcf, 0x67, 0x41, 0xb9, 0x53, 0xd4, 0x5e, 0xc0, 0x4c, 0x3a, 0xa9, 0xc2,
+	0xe2, 0x59, 0x57, 0x1d, 0x16, 0x1c, 0x84, 0xda, 0xdf, 0x3b, 0xe7, 0xd3, 0xb6, 0x17, 0x1c, 0x84,
+	0xa7, 0x5d, 0x73, 0x17, 0x99, 0x3c, 0x07, 0x12, 0x61, 0xe0, 0xb1, 0xa0, 0xe6, 0x50, 0x97, 0xb3,
+	0x13, 0xc6, 0x19, 0x26, 0xe6, 0xf0, 0xea, 0xf0, 0x7a, 0x61, 0x73, 0x3d, 0xfb, 0x38, 0x28, 0xf6,
+	0x2d, 0xc5, 0xdd, 0x92, 0xe0, 0x73, 0x51, 0x17, 0x91, 0x61, 0x42, 0x7e, 0x00, 0xb3, 0x29, 0xb0,
+	0x7b, 0xc8, 0x7c, 0x2f, 0xc6, 0xc0, 0x1c, 0x91, 0xb0, 0xa5, 0x3c, 0xd8, 0xb2, 0xe0, 0xed, 0xb6,
+	0x7c, 0x26, 0xea, 0x58, 0x8a, 0x31, 0x20, 0x95, 0x53, 0xe8, 0xf4, 0x5d, 0xa0, 0x2f, 0x61, 0xb9,
+	0x16, 0xef, 0x68, 0xde, 0x2e, 0xd0, 0x94, 0x68, 0x7d, 0x39, 0x0c, 0xd7, 0xbe, 0x27, 0x6e, 0xc1,
+	0x69, 0xf8, 0xbe, 0xa5, 0x23, 0xf6, 0x3e, 0x8c, 0xca, 0xcb, 0xb8, 0x6e, 0x20, 0xac, 0x5c, 0x24,
+	0x69, 0xb0, 0xad, 0x04, 0x88, 0x03, 0x0b, 0xf2, 0x87, 0x13, 0xe3, 0x8f, 0xd0, 0xe5, 0x22, 0x3f,
+	0x3d, 0x26, 0x8d, 0x1a, 0x91, 0x5d, 0xf3, 0xff, 0x65, 0x42, 0x29, 0x08, 0x29, 0x51, 0x4e, 0x05,
+	0xec, 0x6b, 0xc7, 0x19, 0x54, 0x91, 0x8f, 0x4a, 0x81, 0x1b, 0x06, 0x09, 0x4b, 0x38, 0x06, 0x6e,
+	0xcb, 0xf1, 0xf1, 0x04, 0x7d, 0x73, 0x34, 0xe7, 0x16, 0x27, 0x35, 0x94, 0x4f, 0x45, 0x3e, 0x13,
+	0x12, 0xf6, 0xfc, 0x71, 0x16, 0xd9, 0xfa, 0x93, 0x01, 0xf3, 0x3d, 0xdb, 0xa0, 0xcf, 0xde, 0x27,
+	0x30, 0x99, 0xba, 0x97, 0x34, 0xfc, 0xb4, 0xb3, 0x1b, 0xd0, 0x60, 0x69, 0x3f, 0x84, 0x00, 0xd9,
+	0x83, 0xe9, 0xce, 0xf8, 0xa0, 0x67, 0x0e, 0xe5, 0x84, 0xb8, 0x23, 0x2e, 0xe8, 0xd9, 0x53, 0xc7,
+	0x9d, 0x8f, 0xd6, 0xef, 0x87, 0x60, 0x31, 0xad, 0x16, 0xed, 0xd1, 0xc0, 0x80, 0x7c, 0xe9, 0x9a,
+	0x35, 0x0c, 0x5d, 0x6c, 0xd6, 0xf0, 0x04, 0xa6, 0xdb, 0xb2, 0xa7, 0x03, 0x8f, 0xe9, 0xcd, 0xb5,
+	0x5c, 0x00, 0x35, 0xf0, 0xe0, 0x1d, 0x4f, 0xa2, 0xbd, 0x62, 0x81, 0xeb, 0x37, 0x3c, 0x74, 0x4e,
+	0x01, 0x13, 0x4e, 0x79, 0x43, 0xbd, 0x03, 0xc7, 0xed, 0x79, 0xbd, 0x9e, 0x82, 0x54, 0xe4, 0x22,
+	0xb9, 0x0b, 0x24, 0x15, 0x8c, 0x68, 0xcc, 0x65, 0x42, 0x24, 0x72, 0xdb, 0xc7, 0xed, 0x39, 0xbd,
+	0xb2, 0xdf, 0x5e, 0xb0, 0x7e, 0x39, 0x0a, 0xe6, 0xd9, 0x00, 0xe9, 0x9d, 0xfc, 0x00, 0xae, 0x44,
+	0xa1, 0xef, 0x63, 0x9c, 0x98, 0x86, 0xac, 0x08, 0x37, 0xb3, 0x37, 0x51, 0xf2, 0xc8, 0xd3, 0x9a,
+	0xf2, 0x93, 0xcf, 0x61, 0xf6, 0x8c, 0xdd, 0x2a, 0x96, 0xb7, 0x72, 0x43, 0xa1, 0xbc, 0xb0, 0xa7,
+	0x79, 0xb7, 0x57, 0xcf, 0x61, 0xb6, 0xed, 0x8d, 0xae, 0xe7, 0xfa, 0xdc, 0xbd, 0x93, 0x0b, 0xd7,
+	0xf6, 0x54, 0x15, 0x6c, 0x7b, 0x26, 0xea, 0x26, 0x90, 0x18, 0xae, 0x6a, 0x93, 0x9d, 0x6a, 0xcb,
+	0xa9, 0x36, 0x98, 0xef, 0xa9, 0xce, 0x4a, 0xb8, 0x5b, 0xce, 0xc4, 0xee, 0x17, 0x2e, 0x1d, 0x87,
+	0x64, 0xbb, 0xb5, 0x2d, 0x60, 0xf6, 0xbc, 0xc7, 0x01, 0x8f, 0x5b, 0xf6, 0x6c, 0xd4, 0x43, 0x26,
+	0xf5, 0x4e, 0x67, 0x74, 0x6c, 0x46, 0xa5, 0xc2, 0xed, 0x0b, 0x2a, 0x4c, 0x51, 0x54, 0x94, 0x94,
+	0xbe, 0x99, 0xa8, 0x9b, 0x5a, 0x2c, 0xc3, 0x7c, 0xa6, 0x65, 0x64, 0x16, 0x86, 0xc5, 0x14, 0x40,
+	0x65, 0xbf, 0xf8, 0x49, 0xae, 0xc1, 0xe8, 0x09, 0xf5, 0x1b, 0xea, 0xda, 0x34, 0x6a, 0xab, 0x87,
+	0x47, 0x43, 0xef, 0x1b, 0xc5, 0x1a, 0x5c, 0xcb, 0xd2, 0x96, 0x81, 0xf1, 0x41, 0x27, 0xc6, 0x39,
+	0xb7, 0xfb, 0x54, 0x91, 0xf5, 0x1e, 0x2c, 0x3d, 0x41, 0x9e, 0xae, 0x27, 0xdb, 0xad, 0x1d, 0x79,
+	0x2a, 0x07, 0x1c, 0x5a, 0x6b, 0x17, 0x6e, 0x64, 0x8b, 0xe9, 0x54, 0x7e, 0x13, 0x66, 0x4e, 0xf3,
+	0x51, 0xdc, 0x0c, 0x55, 0x4a, 0x4f, 0xd8, 0x53, 0x69, 0xa6, 0x89, 0xcb, 0x61, 0x62, 0x25, 0xb0,
+	0x2c, 0xcf, 0x60, 0x6f, 0xfe, 0x24, 0xaf, 0xb0, 0x6a, 0x88, 0x9e, 0x66, 0xa5, 0x9f, 0x56, 0x6d,
+	0xff, 0x31, 0x2c, 0xeb, 0xd7, 0x7e, 0xab, 0xa3, 0x20, 0x74, 0x9c, 0x70, 0x23, 0xe7, 0x95, 0x7d,
+	0x06, 0xf7, 0x73, 0xe4, 0xd4, 0xa3, 0x9c, 0xda, 0xc5, 0x14, 0xf4, 0xac, 0x6a, 0xa1, 0xb2, 0x7d,
+	0xc3, 0xcb, 0x54, 0x39, 0x74, 0x39, 0x95, 0x29, 0x68, 0x86, 0xca, 0x97, 0x70, 0x2b, 0xcf, 0xcb,
+	0x6f, 0x72, 0xf2, 0x6f, 0xf6, 0xf5, 0x54, 0x57, 0x82, 0x97, 0x70, 0x2b, 0xcf, 0xdd, 0x54, 0xf7,
+	0xc8, 0x65, 0x74, 0xf7, 0x75, 0x59, 0x31, 0x58, 0x7f, 0x19, 0x86, 0xb7, 0xbe, 0x88, 0x3c, 0x7d,
+	0xd7, 0xc0, 0x58, 0x1d, 0xd3, 0x9d, 0x72, 0x58, 0x8f, 0x28, 0x67, 0x55, 0xe6, 0x33, 0xde, 0x7a,
+	0x95, 0xaf, 0xad, 0x3d, 0xb8, 0x45, 0x3d, 0xcf, 0x09, 0xb0, 0xd9, 0x2e, 0x81, 0x0e, 0x0b, 0xe4,
+	0xb3, 0x87, 0x07, 0xb4, 0xe1, 0x73, 0x27, 0x41, 0xae, 0x6f, 0x12, 0x37, 0xa8, 0xe7, 0x3d, 0xc5,
+	0xa6, 0x2e, 0x25, 0x7b, 0xc1, 0x53, 0x6c, 0xee, 0x28, 0xa6, 0x0a, 0x72, 0xf2, 0x11, 0x2c, 0xa5,
+	0x50, 0xae, 0x36, 0xdf, 0xc7, 0xce, 0xc2, 0x2a, 0x20, 0x16, 0x15, 0x44, 0xb9, 0xcd, 0x90, 0x96,
+	0xc6, 0x4f, 0xe0, 0x06, 0xbe, 0x60, 0x09, 0x97, 0xdd, 0x68, 0x86, 0xb8, 0xba, 0xc5, 0x5d, 0x4f,
+	0x79, 0xce, 0x02, 0xac, 0xc3, 0x6c, 0x9d, 0x1e, 0xa1, 0x30, 0x37, 0x35, 0x5d, 0xcf, 0x0e, 0xa6,
+	0x05, 0xbd, 0x82, 0x5c, 0xdb, 0x4a, 0xde, 0x85, 0xc5, 0x28, 0x0e, 0xeb, 0x21, 0x57, 0xcc, 0x9d,
+	0xd5, 0xff, 0x8a, 0xd4, 0x72, 0x55, 0x2f, 0x57, 0x90, 0xb7, 0x0b, 0xa7, 0x75, 0x07, 0xd6, 0x07,
+	0x6f, 0x94, 0xbe, 0x3e, 0xbd, 0x84, 0x37, 0x9e, 0x20, 0xcf, 0x63, 0x7c, 0x75, 0x25, 0xe5, 0x18,
+	0x6e, 0x0f, 0xd0, 0xad, 0x0b, 0xcb, 0xa7, 0x30, 0x79, 0x82, 0xb1, 0xcc, 0xfa, 0x04, 0x79, 0x5a,
+	0x47, 0x6e, 0xf7, 0xed, 0x66, 0x31, 0xfe, 0xbe, 0x62, 0xaf, 0x20, 0xb7, 0x0b, 0x27, 0xed, 0xdf,
+	0x89, 0xb5, 0x08, 0xf3, 0x4f, 0x90, 0x97, 0xfd, 0x46, 0xc2, 0x75, 0x37, 0xa0, 0xfc, 0xb3, 0x7e,
+	0x6a, 0xc0, 0x42, 0xef, 0x8a, 0xd6, 0x7e, 0x08, 0xd7, 0x93, 0x46, 0x14, 0x85, 0x31, 0x47, 0xcf,
+	0x71, 0x7d, 0x26, 0x06, 0x48, 0x1a, 0x33, 0x31, 0x8d, 0x9c, 0xa3, 0x56, 0x49, 0xa5, 0xca, 0x52,
+	0x48, 0xdb, 0x94, 0xd8, 0x8b, 0x49, 0xf6, 0x82, 0xf5, 0xf3, 0x61, 0xb0, 0x9e, 0x64, 0x8c, 0x89,
+	0x3e, 0x55, 0xdf, 0xdc, 0xbe, 0xa5, 0x4b, 0xc4, 0x12, 0x4c, 0x44, 0xb4, 0x86, 0x4e, 0xc2, 0x5e,
+	0xaa, 0x56, 0x51, 0x8c, 0xeb, 0x69, 0x0d, 0x2b, 0xec, 0xa5, 0x7c, 0x67, 0x05, 0xf8, 0x42, 0xd4,
+	0xa0, 0x1a, 0x3a, 0x3c, 0x3c, 0xc2, 0x40, 0x0f, 0x1c, 0xa7, 0x04, 0x79, 0x9f, 0xd6, 0xf0, 0x99,
+	0x20, 0x92, 0xb7, 0x81, 0x34, 0x29, 0xe3, 0xce, 0x41, 0x18, 0xcb, 0x73, 0x27, 0xe7, 0x70, 0xba,
+	0xe5, 0x9b, 0x11, 0x2b, 0xbb, 0x61, 0xfc, 0x14, 0x9b, 0x72, 0x00, 0x47, 0x1c, 0xb8, 0xae, 0x3f,
+	0x33, 0x2a, 0x3e, 0xe7, 0x80, 0xf9, 0x1c, 0x63, 0xd5, 0xac, 0x8e, 0xc9, 0x66, 0xf5, 0x8d, 0x4c,
+	0x7f, 0xa4, 0xf8, 0xae, 0x64, 0x96, 0xfd, 0xea, 0x82, 0x86, 0xe9, 0xa1, 0x8b, 0x2f, 0x33, 0x72,
+	0x80, 0x27, 0x3e, 0x84, 0xb0, 0x13, 0xaa, 0x06, 0xc9, 0xe3, 0xf6, 0xa4, 0x20, 0x6e, 0x69, 0x9a,
+	0xf5, 0x1f, 0x03, 0x6e, 0xe5, 0xee, 0x86, 0xce, 0x8f, 0x07, 0x70, 0x45, 0xab, 0xc9, 0xbd, 0x46,
+	0xa4, 0x62, 0x29, 0x33, 0xf9, 0x0e, 0x14, 0x62, 0xda, 0x74, 0x52, 0x59, 0xf5, 0xa6, 0xca, 0x3e,
+	0x3c, 0x3b, 0x94, 0xd3, 0x6d, 0x3f, 0xac, 0xda, 0x10, 0xd3, 0xa6, 0x06, 0xca, 0x0a, 0xfd, 0x70,
+	0x56, 0xe8, 0x8b, 0x30, 0xae, 0xfc, 0x44, 0x4f, 0xb7, 0xe5, 0xed, 0x67, 0xab, 0x05, 0x93, 0xbb,
+	0x48, 0x79, 0x23, 0xc6, 0x5d, 0x9f, 0xd6, 0x12, 0xc2, 0x60, 0x33, 0x63, 0x4a, 0x40, 0xfd, 0x18,
+	0xa9, 0xd7, 0x92, 0xd5, 0xce, 0x47, 0x71, 0x0c, 0x30, 0x8e, 0xc3, 0xd8, 0xc1, 0x80, 0x56, 0x7d,
+	0x54, 0x33, 0xcb, 0x71, 0xfb, 0xee, 0x99, 0xd4, 0xd9, 0x52, 0x72, 0xe5, 0x54, 0xec, 0xb1, 0x90,
+	0x7a, 0xac, 0x84, 0x36, 0xff, 0x3c, 0x07, 0x85, 0x34, 0xb6, 0x5b, 0xfb, 0x7b, 0xe4, 0x27, 0x06,
+	0x2c, 0x64, 0xcf, 0xa3, 0xc9, 0x25, 0x26, 0xee, 0xc5, 0xfb, 0x17, 0x92, 0xd1, 0x5b, 0xf9, 0xa5,
+	0x01, 0x8b, 0x7d, 0xbe, 0x20, 0x90, 0x3e, 0x80, 0xb9, 0xdf, 0x6d, 0x8a, 0xef, 0x5e, 0x4c, 0x48,
+	0x9b, 0xf1, 0x07, 0x03, 0x56, 0x07, 0x0d, 0xe9, 0xc9, 0x47, 0x79, 0xd0, 0x83, 0xbe, 0x6d, 0x14,
+	0x3f, 0xbe, 0xa4, 0xb4, 0xb6, 0x50, 0x6c, 0x56, 0xf6, 0x48, 0xbb, 0xcf, 0x66, 0xe5, 0x7e, 0x2f,
+	0x28, 0xde, 0xbf, 0x90, 0x8c, 0xb6, 0xe1, 0xb7, 0x06, 0xac, 0x68, 0x80, 0x3e, 0x93, 0x64, 0xf2,
+	0xa8, 0x0f, 0xee, 0x39, 0x26, 0xea, 0xc5, 0x0f, 0x2f, 0x25, 0xab, 0x6d, 0xfb, 0x85, 0x01, 0xc5,
+	0xfe, 0xb3, 0x59, 0xf2, 0x20, 0xfb, 0x15, 0x39, 0x68, 0xd6, 0x5d, 0x7c, 0x78, 0x61, 0xb9, 0x8e,
+	0x58, 0xe5, 0x0f, 0x54, 0xfb, 0xc4, 0xea, 0x5c, 0x93, 0xe3, 0xe2, 0x87, 0x97, 0x92, 0xd5, 0xb6,
+	0xfd, 0xcc, 0x80, 0xeb, 0x7d, 0xa7, 0xa5, 0xe4, 0xbd, 0xdc, 0xeb, 0x66, 0xdf, 0x48, 0x3d, 0xb8,
+	0xa8, 0x98, 0x36, 0xe6, 0x00, 0xa6, 0xba, 0x26, 0x46, 0x24, 0x67, 0xd0, 0xd5, 0x33, 0xdc, 0x2b,
+	0xde, 0x39, 0x0f, 0xab, 0xd6, 0x13, 0xc2, 0x6c, 0xef, 0x95, 0x99, 0xbc, 0x73, 0xce, 0x9b, 0xb5,
+	0xd2, 0x76, 0xf7, 0x42, 0xf7, 0x70, 0xf2, 0x63, 0xb8, 0x96, 0x75, 0xf9, 0x24, 0xff, 0x9f, 0x09,
+	0x93, 0x73, 0xbd, 0x2d, 0xde, 0xbb, 0x80, 0x44, 0x47, 0xb9, 0xc8, 0xbe, 0x3c, 0xf6, 0x29, 0x17,
+	0xb9, 0xf7, 0xdb, 0x3e, 0xe5, 0x62, 0xc0, 0xed, 0x54, 0x14, 0xd5, 0x41, 0x6d, 0x71, 0x9f, 0xa2,
+	0x7a, 0xce, 0x6b, 0x4f, 0xf1, 0xe3, 0x4b, 0x4a, 0x6b, 0x0b, 0x7f, 0x63, 0xc0, 0x72, 0x6e, 0x43,
+	0x4c, 0x3e, 0xe8, 0x17, 0xfa, 0xc1, 0xb6, 0x3d, 0xba, 0x8c, 0xa8, 0x36, 0x8c, 0xc1, 0x74, 0x77,
+	0x6f, 0x4c, 0xee, 0xf4, 0x43, 0x3b, 0xdb, 0x5a, 0x17, 0xdf, 0x3e, 0x17, 0xaf, 0x56, 0xf5, 0x2b,
+	0x03, 0x96, 0xb4, 0x51, 0x59, 0x4d, 0x17, 0x79, 0x98, 0xe7, 0x46, 0x4e, 0xd3, 0x5c, 0x7c, 0xff,
+	0xe2, 0x82, 0xca, 0xa4, 0xed, 0xea, 0x3f, 0xbe, 0x5a, 0x31, 0xfe, 0xf9, 0xd5, 0x8a, 0xf1, 0xef,
+	0xaf, 0x56, 0x0c, 0x58, 0x74, 0xc3, 0x7a, 0x16, 0xd4, 0xf6, 0xf8, 0x56, 0xc4, 0xf6, 0xe3, 0x90,
+	0x87, 0xfb, 0xc6, 0x0f, 0x37, 0x6a, 0x8c, 0x1f, 0x36, 0xaa, 0x25, 0x37, 0xac, 0x6f, 0x74, 0xfd,
+	0xb9, 0xad, 0x54, 0xc3, 0x40, 0xfd, 0x23, 0x4f, 0xff, 0xcf, 0xed, 0x43, 0x1a, 0xb1, 0x93, 0x7b,
+	0xd5, 0x31, 0x49, 0xbb, 0xff, 0xdf, 0x01, 0x00, 0x80, 0xa6, 0x65, 0x1f, 0xf6, 0x27, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -1968,6 +2445,38 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WorkflowIdConflictPolicy != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.WorkflowIdConflictPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Priority != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.DelayStart != nil {
 		{
 			size, err := m.DelayStart.MarshalToSizedBuffer(dAtA[:i])
@@ -2599,6 +3108,13 @@ func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Identity) > 0 {
 		i -= len(m.Identity)
 		copy(dAtA[i:], m.Identity)
@@ -2674,7 +3190,7 @@ func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *ExpediteWorkflowExecutionStartRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2684,12 +3200,12 @@ func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExpediteWorkflowExecutionStartRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExpediteWorkflowExecutionStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2698,6 +3214,13 @@ func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.WorkflowExecution != nil {
 		{
 			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
@@ -2720,7 +3243,7 @@ func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *ExpediteWorkflowExecutionStartResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2730,12 +3253,12 @@ func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExpediteWorkflowExecutionStartResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExpediteWorkflowExecutionStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2744,21 +3267,94 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PendingDecision != nil {
-		{
-			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PendingChildren) > 0 {
-		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PendingDecision != nil {
+		{
+			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PendingChildren) > 0 {
+		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -2954,6 +3550,16 @@ func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IncludePartitions {
+		i--
+		if m.IncludePartitions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.IncludeTaskListStatus {
 		i--
 		if m.IncludeTaskListStatus {
@@ -3015,6 +3621,61 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PartitionStatus) > 0 {
+		for k := range m.PartitionStatus {
+			v := m.PartitionStatus[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PollersByBuildId) > 0 {
+		for k := range m.PollersByBuildId {
+			v := m.PollersByBuildId[k]
+			baseI := i
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskListStatus != nil {
 		{
 			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -3184,6 +3845,30 @@ func (m *ListTaskListPartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DecisionTaskListPartitionConfig != nil {
+		{
+			size, err := m.DecisionTaskListPartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivityTaskListPartitionConfig != nil {
+		{
+			size, err := m.ActivityTaskListPartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DecisionTaskListPartitions) > 0 {
 		for iNdEx := len(m.DecisionTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3215,7 +3900,7 @@ func (m *ListTaskListPartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *GetClusterInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateWorkerBuildIDCompatibilityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3225,12 +3910,12 @@ func (m *GetClusterInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetClusterInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateWorkerBuildIDCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetClusterInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateWorkerBuildIDCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3239,10 +3924,67 @@ func (m *GetClusterInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PromoteSetByBuildId) > 0 {
+		i -= len(m.PromoteSetByBuildId)
+		copy(dAtA[i:], m.PromoteSetByBuildId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.PromoteSetByBuildId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MakeSetDefault {
+		i--
+		if m.MakeSetDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ExistingCompatibleBuildId) > 0 {
+		i -= len(m.ExistingCompatibleBuildId)
+		copy(dAtA[i:], m.ExistingCompatibleBuildId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.ExistingCompatibleBuildId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AddNewCompatibleBuildId) > 0 {
+		i -= len(m.AddNewCompatibleBuildId)
+		copy(dAtA[i:], m.AddNewCompatibleBuildId)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.AddNewCompatibleBuildId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddNewBuildIdInNewDefaultSet) > 0 {
+		i -= len(m.AddNewBuildIdInNewDefaultSet)
+		copy(dAtA[i:], m.AddNewBuildIdInNewDefaultSet)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.AddNewBuildIdInNewDefaultSet)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetClusterInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpdateWorkerBuildIDCompatibilityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3252,12 +3994,12 @@ func (m *GetClusterInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetClusterInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateWorkerBuildIDCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateWorkerBuildIDCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3266,22 +4008,10 @@ func (m *GetClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SupportedClientVersions != nil {
-		{
-			size, err := m.SupportedClientVersions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetWorkerBuildIDCompatibilityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3291,12 +4021,12 @@ func (m *GetWorkflowExecutionHistoryRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetWorkerBuildIDCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetWorkflowExecutionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetWorkerBuildIDCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3305,46 +4035,9 @@ func (m *GetWorkflowExecutionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SkipArchival {
-		i--
-		if m.SkipArchival {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.HistoryEventFilterType != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.HistoryEventFilterType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.WaitForNewEvent {
-		i--
-		if m.WaitForNewEvent {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowExecution != nil {
+	if m.TaskList != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3364,7 +4057,7 @@ func (m *GetWorkflowExecutionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetWorkerBuildIDCompatibilityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3374,12 +4067,12 @@ func (m *GetWorkflowExecutionHistoryResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetWorkerBuildIDCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetWorkflowExecutionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetWorkerBuildIDCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3388,27 +4081,10 @@ func (m *GetWorkflowExecutionHistoryResponse) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Archived {
-		i--
-		if m.Archived {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RawHistory) > 0 {
-		for iNdEx := len(m.RawHistory) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.VersionSets) > 0 {
+		for iNdEx := len(m.VersionSets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RawHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.VersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3416,11 +4092,218 @@ func (m *GetWorkflowExecutionHistoryResponse) MarshalToSizedBuffer(dAtA []byte)
 				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if m.History != nil {
-		{
+	return len(dAtA) - i, nil
+}
+
+func (m *GetClusterInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetClusterInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetClusterInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetClusterInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetClusterInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SupportedClientVersions != nil {
+		{
+			size, err := m.SupportedClientVersions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowExecutionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SkipArchival {
+		i--
+		if m.SkipArchival {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HistoryEventFilterType != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.HistoryEventFilterType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WaitForNewEvent {
+		i--
+		if m.WaitForNewEvent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintServiceWorkflow(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RawHistory) > 0 {
+		for iNdEx := len(m.RawHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.History != nil {
+		{
 			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
@@ -3551,6 +4434,20 @@ func (m *StartWorkflowExecutionRequest) Size() (n int) {
 		l = m.DelayStart.Size()
 		n += 2 + l + sovServiceWorkflow(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 2 + sovServiceWorkflow(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 2 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 2 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowIdConflictPolicy != 0 {
+		n += 2 + sovServiceWorkflow(uint64(m.WorkflowIdConflictPolicy))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3785,6 +4682,10 @@ func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovServiceWorkflow(uint64(l))
 	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3803,6 +4704,42 @@ func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
+func (m *ExpediteWorkflowExecutionStartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExpediteWorkflowExecutionStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3929,7 +4866,10 @@ func (m *DescribeTaskListRequest) Size() (n int) {
 	if m.IncludeTaskListStatus {
 		n += 2
 	}
-	if m.XXX_unrecognized != nil {
+	if m.IncludePartitions {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
@@ -3951,6 +4891,31 @@ func (m *DescribeTaskListResponse) Size() (n int) {
 		l = m.TaskListStatus.Size()
 		n += 1 + l + sovServiceWorkflow(uint64(l))
 	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if len(m.PollersByBuildId) > 0 {
+		for k, v := range m.PollersByBuildId {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovServiceWorkflow(uint64(len(k))) + 1 + sovServiceWorkflow(uint64(v))
+			n += mapEntrySize + 1 + sovServiceWorkflow(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PartitionStatus) > 0 {
+		for k, v := range m.PartitionStatus {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovServiceWorkflow(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovServiceWorkflow(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovServiceWorkflow(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4029,6 +4994,103 @@ func (m *ListTaskListPartitionsResponse) Size() (n int) {
 			n += 1 + l + sovServiceWorkflow(uint64(l))
 		}
 	}
+	if m.ActivityTaskListPartitionConfig != nil {
+		l = m.ActivityTaskListPartitionConfig.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.DecisionTaskListPartitionConfig != nil {
+		l = m.DecisionTaskListPartitionConfig.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIDCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.AddNewBuildIdInNewDefaultSet)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.AddNewCompatibleBuildId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	l = len(m.ExistingCompatibleBuildId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.MakeSetDefault {
+		n += 2
+	}
+	l = len(m.PromoteSetByBuildId)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIDCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkerBuildIDCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovServiceWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkerBuildIDCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VersionSets) > 0 {
+		for _, e := range m.VersionSets {
+			l = e.Size()
+			n += 1 + l + sovServiceWorkflow(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4717,6 +5779,108 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdConflictPolicy", wireType)
+			}
+			m.WorkflowIdConflictPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowIdConflictPolicy |= WorkflowIdConflictPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -6196,6 +7360,38 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -6275,7 +7471,7 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *ExpediteWorkflowExecutionStartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6298,10 +7494,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpediteWorkflowExecutionStartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpediteWorkflowExecutionStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6372,6 +7568,38 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -6397,7 +7625,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *ExpediteWorkflowExecutionStartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6420,18 +7648,194 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpediteWorkflowExecutionStartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpediteWorkflowExecutionStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServiceWorkflow
 				}
@@ -7085,6 +8489,26 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IncludeTaskListStatus = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePartitions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePartitions = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -7209,49 +8633,327 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthServiceWorkflow
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServiceWorkflow
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskListPartitionConfig{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollersByBuildId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollersByBuildId == nil {
+				m.PollersByBuildId = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServiceWorkflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServiceWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServiceWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PollersByBuildId[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionStatus == nil {
+				m.PartitionStatus = make(map[string]*TaskListStatus)
+			}
+			var mapkey string
+			var mapvalue *TaskListStatus
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServiceWorkflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServiceWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServiceWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TaskListStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthServiceWorkflow
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PartitionStatus[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
 		}
 		fieldNum := int32(wire >> 3)
@@ -7625,6 +9327,612 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityTaskListPartitionConfig == nil {
+				m.ActivityTaskListPartitionConfig = &TaskListPartitionConfig{}
+			}
+			if err := m.ActivityTaskListPartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListPartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionTaskListPartitionConfig == nil {
+				m.DecisionTaskListPartitionConfig = &TaskListPartitionConfig{}
+			}
+			if err := m.DecisionTaskListPartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIDCompatibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIDCompatibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIDCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddNewBuildIdInNewDefaultSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddNewBuildIdInNewDefaultSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddNewCompatibleBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddNewCompatibleBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExistingCompatibleBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExistingCompatibleBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakeSetDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MakeSetDefault = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoteSetByBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoteSetByBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIDCompatibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIDCompatibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIDCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIDCompatibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerBuildIDCompatibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerBuildIDCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIDCompatibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerBuildIDCompatibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerBuildIDCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionSets = append(m.VersionSets, &WorkerVersionSet{})
+			if err := m.VersionSets[len(m.VersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
@@ -50,11 +50,14 @@ type WorkflowAPIYARPCClient interface {
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
 	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
+	ExpediteWorkflowExecutionStart(context.Context, *ExpediteWorkflowExecutionStartRequest, ...yarpc.CallOption) (*ExpediteWorkflowExecutionStartResponse, error)
 	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
 	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
 	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest, ...yarpc.CallOption) (*ListTaskListPartitionsResponse, error)
+	UpdateWorkerBuildIDCompatibility(context.Context, *UpdateWorkerBuildIDCompatibilityRequest, ...yarpc.CallOption) (*UpdateWorkerBuildIDCompatibilityResponse, error)
+	GetWorkerBuildIDCompatibility(context.Context, *GetWorkerBuildIDCompatibilityRequest, ...yarpc.CallOption) (*GetWorkerBuildIDCompatibilityResponse, error)
 	GetClusterInfo(context.Context, *GetClusterInfoRequest, ...yarpc.CallOption) (*GetClusterInfoResponse, error)
 	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest, ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error)
 }
@@ -83,11 +86,14 @@ type WorkflowAPIYARPCServer interface {
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
 	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest) (*RequestCancelWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
+	ExpediteWorkflowExecutionStart(context.Context, *ExpediteWorkflowExecutionStartRequest) (*ExpediteWorkflowExecutionStartResponse, error)
 	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
 	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
 	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest) (*ListTaskListPartitionsResponse, error)
+	UpdateWorkerBuildIDCompatibility(context.Context, *UpdateWorkerBuildIDCompatibilityRequest) (*UpdateWorkerBuildIDCompatibilityResponse, error)
+	GetWorkerBuildIDCompatibility(context.Context, *GetWorkerBuildIDCompatibilityRequest) (*GetWorkerBuildIDCompatibilityResponse, error)
 	GetClusterInfo(context.Context, *GetClusterInfoRequest) (*GetClusterInfoResponse, error)
 	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error)
 }
@@ -163,6 +169,16 @@ func buildWorkflowAPIYARPCProcedures(params buildWorkflowAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "ExpediteWorkflowExecutionStart",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ExpediteWorkflowExecutionStart,
+							NewRequest:  newWorkflowAPIServiceExpediteWorkflowExecutionStartYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "DescribeWorkflowExecution",
 					Handler: protobuf.NewUnaryHandler(
@@ -213,6 +229,26 @@ func buildWorkflowAPIYARPCProcedures(params buildWorkflowAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "UpdateWorkerBuildIDCompatibility",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateWorkerBuildIDCompatibility,
+							NewRequest:  newWorkflowAPIServiceUpdateWorkerBuildIDCompatibilityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkerBuildIDCompatibility",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetWorkerBuildIDCompatibility,
+							NewRequest:  newWorkflowAPIServiceGetWorkerBuildIDCompatibilityYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "GetClusterInfo",
 					Handler: protobuf.NewUnaryHandler(
@@ -417,6 +453,18 @@ func (c *_WorkflowAPIYARPCCaller) TerminateWorkflowExecution(ctx context.Context
 	return response, err
 }
 
+func (c *_WorkflowAPIYARPCCaller) ExpediteWorkflowExecutionStart(ctx context.Context, request *ExpediteWorkflowExecutionStartRequest, options ...yarpc.CallOption) (*ExpediteWorkflowExecutionStartResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ExpediteWorkflowExecutionStart", request, newWorkflowAPIServiceExpediteWorkflowExecutionStartYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ExpediteWorkflowExecutionStartResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowAPIServiceExpediteWorkflowExecutionStartYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_WorkflowAPIYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newWorkflowAPIServiceDescribeWorkflowExecutionYARPCResponse, options...)
 	if responseMessage == nil {
@@ -477,6 +525,30 @@ func (c *_WorkflowAPIYARPCCaller) ListTaskListPartitions(ctx context.Context, re
 	return response, err
 }
 
+func (c *_WorkflowAPIYARPCCaller) UpdateWorkerBuildIDCompatibility(ctx context.Context, request *UpdateWorkerBuildIDCompatibilityRequest, options ...yarpc.CallOption) (*UpdateWorkerBuildIDCompatibilityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateWorkerBuildIDCompatibility", request, newWorkflowAPIServiceUpdateWorkerBuildIDCompatibilityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateWorkerBuildIDCompatibilityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowAPIServiceUpdateWorkerBuildIDCompatibilityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowAPIYARPCCaller) GetWorkerBuildIDCompatibility(ctx context.Context, request *GetWorkerBuildIDCompatibilityRequest, options ...yarpc.CallOption) (*GetWorkerBuildIDCompatibilityResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetWorkerBuildIDCompatibility", request, newWorkflowAPIServiceGetWorkerBuildIDCompatibilityYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkerBuildIDCompatibilityResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowAPIServiceGetWorkerBuildIDCompatibilityYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_WorkflowAPIYARPCCaller) GetClusterInfo(ctx context.Context, request *GetClusterInfoRequest, options ...yarpc.CallOption) (*GetClusterInfoResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "GetClusterInfo", request, newWorkflowAPIServiceGetClusterInfoYARPCResponse, options...)
 	if responseMessage == nil {
@@ -601,6 +673,22 @@ func (h *_WorkflowAPIYARPCHandler) TerminateWorkflowExecution(ctx context.Contex
 	return response, err
 }
 
+func (h *_WorkflowAPIYARPCHandler) ExpediteWorkflowExecutionStart(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ExpediteWorkflowExecutionStartRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ExpediteWorkflowExecutionStartRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowAPIServiceExpediteWorkflowExecutionStartYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ExpediteWorkflowExecutionStart(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_WorkflowAPIYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *DescribeWorkflowExecutionRequest
 	var ok bool
@@ -681,6 +769,38 @@ func (h *_WorkflowAPIYARPCHandler) ListTaskListPartitions(ctx context.Context, r
 	return response, err
 }
 
+func (h *_WorkflowAPIYARPCHandler) UpdateWorkerBuildIDCompatibility(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateWorkerBuildIDCompatibilityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateWorkerBuildIDCompatibilityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowAPIServiceUpdateWorkerBuildIDCompatibilityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateWorkerBuildIDCompatibility(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowAPIYARPCHandler) GetWorkerBuildIDCompatibility(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkerBuildIDCompatibilityRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkerBuildIDCompatibilityRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowAPIServiceGetWorkerBuildIDCompatibilityYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkerBuildIDCompatibility(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_WorkflowAPIYARPCHandler) GetClusterInfo(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *GetClusterInfoRequest
 	var ok bool
@@ -761,6 +881,14 @@ func newWorkflowAPIServiceTerminateWorkflowExecutionYARPCResponse() proto.Messag
 	return &TerminateWorkflowExecutionResponse{}
 }
 
+func newWorkflowAPIServiceExpediteWorkflowExecutionStartYARPCRequest() proto.Message {
+	return &ExpediteWorkflowExecutionStartRequest{}
+}
+
+func newWorkflowAPIServiceExpediteWorkflowExecutionStartYARPCResponse() proto.Message {
+	return &ExpediteWorkflowExecutionStartResponse{}
+}
+
 func newWorkflowAPIServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
 	return &DescribeWorkflowExecutionRequest{}
 }
@@ -801,6 +929,22 @@ func newWorkflowAPIServiceListTaskListPartitionsYARPCResponse() proto.Message {
 	return &ListTaskListPartitionsResponse{}
 }
 
+func newWorkflowAPIServiceUpdateWorkerBuildIDCompatibilityYARPCRequest() proto.Message {
+	return &UpdateWorkerBuildIDCompatibilityRequest{}
+}
+
+func newWorkflowAPIServiceUpdateWorkerBuildIDCompatibilityYARPCResponse() proto.Message {
+	return &UpdateWorkerBuildIDCompatibilityResponse{}
+}
+
+func newWorkflowAPIServiceGetWorkerBuildIDCompatibilityYARPCRequest() proto.Message {
+	return &GetWorkerBuildIDCompatibilityRequest{}
+}
+
+func newWorkflowAPIServiceGetWorkerBuildIDCompatibilityYARPCResponse() proto.Message {
+	return &GetWorkerBuildIDCompatibilityResponse{}
+}
+
 func newWorkflowAPIServiceGetClusterInfoYARPCRequest() proto.Message {
 	return &GetClusterInfoRequest{}
 }
@@ -830,6 +974,8 @@ var (
 	emptyWorkflowAPIServiceRequestCancelWorkflowExecutionYARPCResponse   = &RequestCancelWorkflowExecutionResponse{}
 	emptyWorkflowAPIServiceTerminateWorkflowExecutionYARPCRequest        = &TerminateWorkflowExecutionRequest{}
 	emptyWorkflowAPIServiceTerminateWorkflowExecutionYARPCResponse       = &TerminateWorkflowExecutionResponse{}
+	emptyWorkflowAPIServiceExpediteWorkflowExecutionStartYARPCRequest    = &ExpediteWorkflowExecutionStartRequest{}
+	emptyWorkflowAPIServiceExpediteWorkflowExecutionStartYARPCResponse   = &ExpediteWorkflowExecutionStartResponse{}
 	emptyWorkflowAPIServiceDescribeWorkflowExecutionYARPCRequest         = &DescribeWorkflowExecutionRequest{}
 	emptyWorkflowAPIServiceDescribeWorkflowExecutionYARPCResponse        = &DescribeWorkflowExecutionResponse{}
 	emptyWorkflowAPIServiceQueryWorkflowYARPCRequest                     = &QueryWorkflowRequest{}
@@ -840,6 +986,10 @@ var (
 	emptyWorkflowAPIServiceGetTaskListsByDomainYARPCResponse             = &GetTaskListsByDomainResponse{}
 	emptyWorkflowAPIServiceListTaskListPartitionsYARPCRequest            = &ListTaskListPartitionsRequest{}
 	emptyWorkflowAPIServiceListTaskListPartitionsYARPCResponse           = &ListTaskListPartitionsResponse{}
+	emptyWorkflowAPIServiceUpdateWorkerBuildIDCompatibilityYARPCRequest  = &UpdateWorkerBuildIDCompatibilityRequest{}
+	emptyWorkflowAPIServiceUpdateWorkerBuildIDCompatibilityYARPCResponse = &UpdateWorkerBuildIDCompatibilityResponse{}
+	emptyWorkflowAPIServiceGetWorkerBuildIDCompatibilityYARPCRequest     = &GetWorkerBuildIDCompatibilityRequest{}
+	emptyWorkflowAPIServiceGetWorkerBuildIDCompatibilityYARPCResponse    = &GetWorkerBuildIDCompatibilityResponse{}
 	emptyWorkflowAPIServiceGetClusterInfoYARPCRequest                    = &GetClusterInfoRequest{}
 	emptyWorkflowAPIServiceGetClusterInfoYARPCResponse                   = &GetClusterInfoResponse{}
 	emptyWorkflowAPIServiceGetWorkflowExecutionHistoryYARPCRequest       = &GetWorkflowExecutionHistoryRequest{}
@@ -849,135 +999,166 @@ var (
 var yarpcFileDescriptorClosure674d14d2fee4e473 = [][]byte{
 	// uber/cadence/api/v1/service_workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcf, 0x73, 0xdb, 0xc6,
-		0xf5, 0x1f, 0x88, 0xfa, 0x41, 0x3d, 0x92, 0xb2, 0xbd, 0xb1, 0x24, 0x98, 0xb6, 0x6c, 0x09, 0x4e,
-		0xfc, 0xd5, 0x57, 0x89, 0xa9, 0x5a, 0x6e, 0xec, 0xd4, 0x69, 0x9b, 0x91, 0x29, 0xcb, 0xd1, 0x4c,
-		0x9c, 0x51, 0x21, 0x35, 0x9e, 0xf6, 0x82, 0x59, 0x01, 0x4f, 0xe4, 0x56, 0x20, 0x00, 0xed, 0x2e,
-		0xc4, 0x30, 0x3d, 0xb5, 0x93, 0xe9, 0xa1, 0x9d, 0xce, 0xb4, 0xc7, 0x9e, 0x7a, 0x68, 0xcf, 0xfd,
-		0x33, 0x7a, 0xe8, 0xa5, 0xd3, 0x3f, 0xa1, 0x7f, 0x41, 0xff, 0x83, 0x4c, 0x07, 0x8b, 0x05, 0x29,
-		0x52, 0x20, 0x28, 0xb9, 0xd3, 0x89, 0xa7, 0x37, 0xe2, 0xed, 0xfb, 0x7c, 0x76, 0xdf, 0x8f, 0xdd,
-		0x7d, 0x6f, 0x09, 0x1b, 0xf1, 0x11, 0xf2, 0x4d, 0x97, 0x7a, 0x18, 0xb8, 0xb8, 0x49, 0x23, 0xb6,
-		0x79, 0xf6, 0x68, 0x53, 0x20, 0x3f, 0x63, 0x2e, 0x3a, 0xdd, 0x90, 0x9f, 0x1c, 0xfb, 0x61, 0xb7,
-		0x11, 0xf1, 0x50, 0x86, 0xe4, 0x9d, 0x44, 0xb7, 0xa1, 0x75, 0x1b, 0x34, 0x62, 0x8d, 0xb3, 0x47,
-		0xf5, 0xbb, 0xad, 0x30, 0x6c, 0xf9, 0xb8, 0xa9, 0x54, 0x8e, 0xe2, 0xe3, 0x4d, 0x2f, 0xe6, 0x54,
-		0xb2, 0x30, 0x48, 0x41, 0xf5, 0xd5, 0xbc, 0x09, 0xdc, 0xb0, 0xd3, 0xe9, 0x6b, 0xac, 0xe5, 0x69,
-		0xb4, 0x99, 0x90, 0x21, 0xef, 0x69, 0x95, 0x7b, 0x79, 0x2a, 0xa7, 0x31, 0xf6, 0x15, 0xac, 0x3c,
-		0x05, 0x49, 0xc5, 0x89, 0xcf, 0x84, 0x2c, 0xd2, 0x19, 0x36, 0xd1, 0xfa, 0xdb, 0x1c, 0xac, 0x1c,
-		0x48, 0xca, 0xe5, 0x6b, 0x2d, 0x7f, 0xf1, 0x25, 0xba, 0x71, 0x62, 0x8e, 0x8d, 0xa7, 0x31, 0x0a,
-		0x49, 0x96, 0x60, 0xd6, 0x0b, 0x3b, 0x94, 0x05, 0xa6, 0xb1, 0x6a, 0xac, 0xcf, 0xdb, 0xfa, 0x8b,
-		0xdc, 0x83, 0x4a, 0xc6, 0xe5, 0x30, 0xcf, 0x9c, 0x52, 0x83, 0x90, 0x89, 0xf6, 0x3c, 0xb2, 0x0b,
-		0xb5, 0xbe, 0x82, 0xec, 0x45, 0x68, 0x96, 0x56, 0x8d, 0xf5, 0xca, 0xd6, 0x5a, 0x23, 0xc7, 0xab,
-		0x8d, 0x6c, 0xfa, 0xc3, 0x5e, 0x84, 0x76, 0xb5, 0x7b, 0xee, 0x8b, 0x3c, 0x83, 0xf9, 0xc4, 0x30,
-		0x27, 0xb1, 0xcc, 0x9c, 0x56, 0x1c, 0x2b, 0xb9, 0x1c, 0x87, 0x54, 0x9c, 0x7c, 0xc6, 0x84, 0xb4,
-		0xcb, 0x52, 0xff, 0x22, 0x5b, 0x30, 0xc3, 0x82, 0x28, 0x96, 0xe6, 0x8c, 0xc2, 0xdd, 0xc9, 0xc5,
-		0xed, 0xd3, 0x9e, 0x1f, 0x52, 0xcf, 0x4e, 0x55, 0x09, 0x85, 0x55, 0xcc, 0x9c, 0xe0, 0x88, 0xc4,
-		0x37, 0x8e, 0x0c, 0x1d, 0xd7, 0x0f, 0x05, 0x3a, 0x92, 0x75, 0x30, 0x8c, 0xa5, 0x39, 0xab, 0xe8,
-		0x6e, 0x35, 0xd2, 0x5c, 0x68, 0x64, 0xb9, 0xd0, 0xd8, 0xd1, 0xb9, 0x60, 0xdf, 0xe9, 0x53, 0x28,
-		0xef, 0x1e, 0x86, 0xcd, 0x04, 0x7f, 0x98, 0xc2, 0xc9, 0x6b, 0xb8, 0xad, 0x4c, 0x1a, 0xc3, 0x3e,
-		0x37, 0x89, 0x7d, 0x39, 0x41, 0xe7, 0x11, 0xd7, 0xa1, 0xcc, 0x3c, 0x0c, 0x24, 0x93, 0x3d, 0xb3,
-		0xac, 0x22, 0xd2, 0xff, 0x26, 0x2b, 0x00, 0x3c, 0x8d, 0x69, 0x12, 0xaf, 0x79, 0x35, 0x3a, 0xaf,
-		0x25, 0x7b, 0x1e, 0x71, 0xc1, 0x3c, 0x17, 0x4f, 0x87, 0x63, 0x2c, 0xd0, 0x89, 0x42, 0x9f, 0xb9,
-		0x3d, 0x13, 0x56, 0x8d, 0xf5, 0x85, 0xad, 0x8d, 0xc2, 0xc8, 0xed, 0x79, 0x76, 0x02, 0xd9, 0x57,
-		0x08, 0x7b, 0xb1, 0x9b, 0x27, 0x26, 0x4d, 0xa8, 0x72, 0x94, 0xbc, 0x97, 0x11, 0x57, 0x94, 0xa5,
-		0xab, 0xb9, 0xc4, 0x76, 0xa2, 0xa8, 0xe9, 0x2a, 0x7c, 0xf0, 0x41, 0xee, 0x43, 0xcd, 0xe5, 0x49,
-		0x6c, 0xdc, 0x36, 0x7a, 0xb1, 0x8f, 0x66, 0x55, 0xd9, 0x52, 0x4d, 0x84, 0x07, 0x5a, 0x46, 0x1e,
-		0xc2, 0x74, 0x07, 0x3b, 0xa1, 0x59, 0xd3, 0xbe, 0xcc, 0x9b, 0xe1, 0x15, 0x76, 0x42, 0x5b, 0xa9,
-		0x11, 0x1b, 0x6e, 0x08, 0xa4, 0xdc, 0x6d, 0x3b, 0x54, 0x4a, 0xce, 0x8e, 0x62, 0x89, 0xc2, 0x5c,
-		0x50, 0xd8, 0xf7, 0x72, 0xb1, 0x07, 0x4a, 0x7b, 0xbb, 0xaf, 0x6c, 0x5f, 0x17, 0x23, 0x12, 0xf2,
-		0x18, 0x66, 0xdb, 0x48, 0x3d, 0xe4, 0xe6, 0x35, 0x45, 0x74, 0x3b, 0x97, 0xe8, 0x53, 0xa5, 0x62,
-		0x6b, 0x55, 0xf2, 0x0c, 0x2a, 0x1e, 0xfa, 0xb4, 0x97, 0xe6, 0x86, 0x79, 0x7d, 0x52, 0x2a, 0x80,
-		0xd2, 0x56, 0xb9, 0x60, 0x3d, 0x85, 0xbb, 0xe3, 0xf6, 0xb2, 0x88, 0xc2, 0x40, 0x20, 0x59, 0x84,
-		0x59, 0x1e, 0x07, 0x49, 0xfc, 0xd3, 0xcd, 0x3c, 0xc3, 0xe3, 0x60, 0xcf, 0xb3, 0xfe, 0x3a, 0x05,
-		0x77, 0x0f, 0x58, 0x2b, 0xa0, 0xfe, 0x95, 0x8f, 0x81, 0x1f, 0x03, 0xe9, 0xa7, 0x4d, 0x3f, 0xe7,
-		0xd5, 0x69, 0x50, 0xd9, 0x7a, 0x50, 0x98, 0x30, 0x83, 0x29, 0x6e, 0x74, 0x47, 0x45, 0x43, 0x89,
-		0x5c, 0x2a, 0x4c, 0xe4, 0xe9, 0xd1, 0x44, 0xbe, 0x07, 0x15, 0xa1, 0x6c, 0x71, 0x02, 0xda, 0x41,
-		0xb5, 0xf3, 0xe7, 0x6d, 0x48, 0x45, 0x9f, 0xd3, 0x0e, 0x92, 0x4f, 0xa0, 0xaa, 0x15, 0xd2, 0xb3,
-		0x61, 0xf6, 0x12, 0x67, 0x83, 0xa6, 0xdc, 0x53, 0x27, 0x84, 0x09, 0x73, 0x6e, 0x18, 0x48, 0x1e,
-		0xfa, 0x6a, 0xab, 0x56, 0xed, 0xec, 0xd3, 0x5a, 0x83, 0x7b, 0x63, 0xfd, 0x98, 0x86, 0xc0, 0xfa,
-		0xc6, 0x80, 0xff, 0xd3, 0x3a, 0x4c, 0xb6, 0x8b, 0xcf, 0xde, 0xd7, 0x50, 0x4b, 0x8f, 0x08, 0x6d,
-		0x9d, 0xf2, 0x7d, 0x65, 0x6b, 0x2b, 0x3f, 0x23, 0x8b, 0xa8, 0xec, 0xaa, 0x22, 0xca, 0x88, 0x47,
-		0x7c, 0x34, 0x35, 0xd1, 0x47, 0xa5, 0xff, 0xc0, 0x47, 0xd3, 0xc3, 0x3e, 0xda, 0x86, 0xf5, 0xc9,
-		0xf6, 0x17, 0xe7, 0xeb, 0x5f, 0xa6, 0x60, 0xc5, 0x46, 0x81, 0xf2, 0x6d, 0x49, 0xd7, 0x25, 0x98,
-		0xe5, 0x48, 0x45, 0x18, 0xe8, 0x64, 0xd5, 0x5f, 0xe4, 0x29, 0x98, 0x1e, 0xba, 0x4c, 0x24, 0x57,
-		0xc9, 0x31, 0x0b, 0x98, 0x68, 0x3b, 0x78, 0x86, 0x41, 0x3f, 0x71, 0x4b, 0xf6, 0x62, 0x36, 0xbe,
-		0xab, 0x86, 0x5f, 0x24, 0xa3, 0x7b, 0xde, 0x48, 0x8e, 0xcf, 0x8c, 0xe6, 0x78, 0x03, 0xde, 0x11,
-		0x27, 0x2c, 0x72, 0x74, 0x8c, 0x38, 0xd2, 0x28, 0xf2, 0x7b, 0x2a, 0x93, 0xcb, 0xf6, 0x8d, 0x64,
-		0x28, 0x75, 0xb1, 0x9d, 0x0e, 0x24, 0x27, 0xc3, 0x38, 0x7f, 0x15, 0x7b, 0xfa, 0x1f, 0x06, 0xbc,
-		0xa7, 0x7d, 0xda, 0xa4, 0x81, 0x8b, 0xff, 0x03, 0x07, 0x84, 0xb5, 0x0e, 0x0f, 0x26, 0x99, 0x34,
-		0xd8, 0xab, 0x6b, 0x87, 0xc8, 0x3b, 0x2c, 0xa0, 0x12, 0xdf, 0xf6, 0x5c, 0x7b, 0x02, 0x73, 0x1e,
-		0x4a, 0xca, 0x7c, 0xa1, 0xab, 0xa4, 0xe2, 0xdd, 0x9a, 0x29, 0x0f, 0x79, 0x72, 0x66, 0xd8, 0x93,
-		0xd6, 0xbb, 0x60, 0x15, 0xd9, 0xaf, 0xdd, 0xf4, 0x7b, 0x03, 0x56, 0x77, 0x50, 0xb8, 0x9c, 0x1d,
-		0xbd, 0x2d, 0x5e, 0xb2, 0xbe, 0x29, 0xc1, 0x5a, 0xc1, 0x9a, 0x74, 0xd6, 0xfb, 0xb0, 0x3c, 0xa8,
-		0xf5, 0xdc, 0x30, 0x38, 0x66, 0x2d, 0x7d, 0xb1, 0xea, 0xa3, 0xf6, 0xf1, 0xe5, 0x56, 0xd0, 0x3c,
-		0x0f, 0xb5, 0x97, 0x30, 0x57, 0x4e, 0x8e, 0x60, 0xf9, 0xa2, 0xa9, 0x0e, 0x0b, 0x8e, 0x43, 0x6d,
-		0xef, 0xc6, 0xe5, 0x66, 0xdb, 0x0b, 0x8e, 0xc3, 0x41, 0x85, 0x35, 0x24, 0x26, 0xaf, 0x81, 0x44,
-		0x18, 0x78, 0x2c, 0x68, 0x39, 0xd4, 0x95, 0xec, 0x8c, 0x49, 0x86, 0xc2, 0x2c, 0xad, 0x96, 0xd6,
-		0x2b, 0x5b, 0xeb, 0xf9, 0x09, 0x91, 0xaa, 0x6f, 0xa7, 0xda, 0x3d, 0x45, 0x7e, 0x23, 0x1a, 0x12,
-		0x32, 0x14, 0xe4, 0x27, 0x70, 0x3d, 0x23, 0x76, 0xdb, 0xcc, 0xf7, 0x38, 0x06, 0xe6, 0xb4, 0xa2,
-		0x6d, 0x14, 0xd1, 0x36, 0x13, 0xdd, 0xe1, 0x95, 0x5f, 0x8b, 0xce, 0x0d, 0x71, 0x0c, 0xc8, 0xc1,
-		0x80, 0x3a, 0x3b, 0x0d, 0x75, 0xc1, 0x5e, 0xb8, 0xe2, 0x1d, 0xad, 0x3b, 0x44, 0x9a, 0x09, 0xad,
-		0xaf, 0x4b, 0x70, 0xf3, 0x47, 0x49, 0xc7, 0x94, 0xb9, 0xef, 0x5b, 0xda, 0xae, 0x1f, 0xc1, 0x8c,
-		0x6a, 0xdc, 0xf4, 0x15, 0x6a, 0x15, 0x32, 0xa9, 0x05, 0xdb, 0x29, 0x80, 0x38, 0xb0, 0xa4, 0x7e,
-		0x38, 0x1c, 0x7f, 0x86, 0xae, 0x4c, 0xf2, 0xd3, 0x63, 0x6a, 0x51, 0xd3, 0xaa, 0x1e, 0xff, 0xff,
-		0x5c, 0xaa, 0x94, 0x42, 0x21, 0x9a, 0x19, 0xc0, 0xbe, 0x79, 0x9a, 0x23, 0x4d, 0xf2, 0x31, 0x9d,
-		0xc0, 0x0d, 0x03, 0xc1, 0x84, 0xc4, 0xc0, 0xed, 0x39, 0x3e, 0x9e, 0xa1, 0xaf, 0xdc, 0x3f, 0xae,
-		0xe2, 0x57, 0x33, 0x34, 0x07, 0x90, 0xcf, 0x12, 0x84, 0xbd, 0x78, 0x9a, 0x27, 0xb6, 0xfe, 0x64,
-		0xc0, 0xe2, 0x48, 0x18, 0xf4, 0xde, 0xfb, 0x04, 0xaa, 0x99, 0x79, 0x22, 0xf6, 0xb3, 0xda, 0x66,
-		0x42, 0x89, 0xa1, 0xed, 0x48, 0x00, 0x64, 0x0f, 0x16, 0xce, 0xfb, 0x07, 0x3d, 0x1d, 0x2c, 0x6b,
-		0x92, 0x5f, 0xd0, 0xb3, 0x6b, 0xa7, 0xe7, 0x3f, 0xad, 0x7f, 0x19, 0xb0, 0x9c, 0x9d, 0x16, 0xfd,
-		0x36, 0x72, 0x42, 0xbe, 0x0c, 0xf5, 0xa5, 0x53, 0x57, 0xeb, 0x4b, 0x5f, 0xc2, 0x42, 0x1f, 0x3b,
-		0x68, 0x8e, 0x17, 0xc6, 0x34, 0xc7, 0x19, 0x41, 0xda, 0x1c, 0xcb, 0x73, 0x5f, 0x49, 0x81, 0xc1,
-		0x02, 0xd7, 0x8f, 0x3d, 0x74, 0x06, 0x84, 0x42, 0x52, 0x19, 0xa7, 0xb7, 0x40, 0xd9, 0x5e, 0xd4,
-		0xe3, 0x19, 0xc9, 0x81, 0x1a, 0xb4, 0xfe, 0x6c, 0x80, 0x79, 0xd1, 0x62, 0x1d, 0x9a, 0xef, 0xc1,
-		0x5c, 0x14, 0xfa, 0x3e, 0x72, 0x61, 0x1a, 0x6a, 0x8b, 0xdf, 0xcb, 0x8f, 0x8a, 0xd2, 0x51, 0xdb,
-		0x2f, 0xd3, 0x27, 0xaf, 0xe0, 0xfa, 0x85, 0x85, 0xa4, 0xce, 0xb9, 0x5f, 0x68, 0x5b, 0xba, 0x2c,
-		0x7b, 0x41, 0x0e, 0x2f, 0xf3, 0x43, 0xb8, 0xfd, 0x12, 0x65, 0xa6, 0x24, 0x9e, 0xf7, 0x76, 0x94,
-		0xf3, 0x27, 0xc4, 0xc6, 0xda, 0x85, 0x3b, 0xf9, 0x30, 0x6d, 0xe0, 0x03, 0xb8, 0x36, 0x58, 0x65,
-		0x52, 0x02, 0xa7, 0x86, 0xce, 0xdb, 0xb5, 0x6c, 0xfe, 0xa4, 0x0a, 0x16, 0x96, 0x80, 0x15, 0xe5,
-		0x6a, 0x2d, 0xdc, 0xa7, 0x5c, 0xaa, 0xad, 0x23, 0xfe, 0x8b, 0xc9, 0x61, 0xfd, 0x6a, 0x0a, 0xee,
-		0x8e, 0x9b, 0x55, 0xaf, 0xff, 0x14, 0x56, 0xf4, 0xe9, 0xde, 0x3b, 0x17, 0xf7, 0xa8, 0xaf, 0xa8,
-		0xc3, 0xd6, 0x28, 0x9c, 0xb2, 0xcf, 0xfb, 0x0a, 0x25, 0xf5, 0xa8, 0xa4, 0x76, 0x3d, 0x23, 0xbd,
-		0x38, 0x75, 0x32, 0x65, 0xbf, 0x94, 0xcd, 0x9d, 0x72, 0xea, 0xcd, 0xa6, 0xcc, 0x48, 0x2f, 0x4e,
-		0x69, 0x2d, 0xc3, 0xe2, 0x4b, 0x94, 0x4d, 0x3f, 0x16, 0x52, 0xa7, 0x59, 0xea, 0x75, 0xeb, 0x97,
-		0x06, 0x2c, 0x8d, 0x8e, 0x68, 0xcf, 0xb4, 0xe1, 0x96, 0x88, 0xa3, 0x28, 0xe4, 0x12, 0x3d, 0xc7,
-		0xf5, 0x59, 0x52, 0x6c, 0x9f, 0x21, 0x17, 0xda, 0x2b, 0x49, 0x20, 0x3e, 0xc8, 0x6f, 0x9f, 0x32,
-		0x54, 0x53, 0x81, 0xbe, 0xd0, 0x18, 0x7b, 0x59, 0xe4, 0x0f, 0x58, 0xbf, 0x29, 0x81, 0xf5, 0x32,
-		0xa7, 0xa4, 0xfe, 0x34, 0x7d, 0xc9, 0xfb, 0x96, 0xae, 0x9b, 0xdb, 0x30, 0x1f, 0xd1, 0x16, 0x3a,
-		0x82, 0x7d, 0x95, 0x1e, 0x2a, 0x33, 0x76, 0x39, 0x11, 0x1c, 0xb0, 0xaf, 0x54, 0xda, 0x07, 0xf8,
-		0x65, 0x12, 0xb5, 0x16, 0x3a, 0x32, 0x3c, 0xc1, 0x40, 0x37, 0x67, 0xb5, 0x44, 0xbc, 0x4f, 0x5b,
-		0x78, 0x98, 0x08, 0xc9, 0xfb, 0x40, 0xba, 0x94, 0x49, 0xe7, 0x38, 0xe4, 0x4e, 0x80, 0xdd, 0xb4,
-		0x67, 0x51, 0x77, 0x42, 0xd9, 0xbe, 0x96, 0x8c, 0xec, 0x86, 0xfc, 0x73, 0xec, 0xaa, 0x66, 0x85,
-		0x38, 0x70, 0x4b, 0x3f, 0x5e, 0xea, 0xde, 0xe6, 0x98, 0xf9, 0x12, 0x79, 0x7a, 0xac, 0xcd, 0xaa,
-		0x63, 0xed, 0xdd, 0x5c, 0x7b, 0x14, 0x7c, 0x57, 0x29, 0xab, 0x93, 0x6d, 0x49, 0xd3, 0x8c, 0xc8,
-		0xc9, 0x7d, 0xa8, 0xa9, 0x66, 0x87, 0x72, 0xb7, 0xcd, 0xce, 0x68, 0xda, 0x74, 0x97, 0xed, 0x6a,
-		0x22, 0xdc, 0xd6, 0x32, 0xeb, 0x9f, 0x06, 0xdc, 0x2f, 0x8c, 0x86, 0xce, 0x8f, 0x27, 0x30, 0xa7,
-		0xa7, 0x29, 0xbc, 0x70, 0x32, 0x58, 0xa6, 0x4c, 0x7e, 0x08, 0x15, 0x4e, 0xbb, 0x4e, 0x86, 0x4d,
-		0x93, 0x3d, 0x7f, 0x4b, 0xef, 0x50, 0x49, 0x9f, 0xfb, 0xe1, 0x91, 0x0d, 0x9c, 0x76, 0x35, 0x51,
-		0x9e, 0xeb, 0x4b, 0x79, 0xae, 0xaf, 0x43, 0x39, 0xb5, 0x13, 0x3d, 0x7d, 0x80, 0xf7, 0xbf, 0xad,
-		0x1e, 0x54, 0x77, 0x91, 0xca, 0x98, 0xe3, 0xae, 0x4f, 0x5b, 0x82, 0x30, 0xd8, 0xca, 0xa9, 0x27,
-		0xa9, 0xcf, 0x91, 0x7a, 0xc9, 0xa5, 0xde, 0x89, 0x7c, 0x4c, 0xb6, 0x01, 0x72, 0x1e, 0x72, 0x07,
-		0x03, 0x7a, 0xe4, 0x63, 0xda, 0xdf, 0x95, 0xed, 0x87, 0x17, 0x52, 0x67, 0x3b, 0xc5, 0x35, 0x33,
-		0xd8, 0x8b, 0x04, 0xf5, 0x22, 0x05, 0x6d, 0xfd, 0xbd, 0x06, 0x95, 0xcc, 0xb7, 0xdb, 0xfb, 0x7b,
-		0xe4, 0x17, 0x06, 0x2c, 0xe5, 0xf7, 0xee, 0xe4, 0x0d, 0x5e, 0x27, 0xea, 0x8f, 0xaf, 0x84, 0xd1,
-		0xa1, 0xfc, 0xda, 0x80, 0xe5, 0x31, 0xaf, 0x2d, 0x64, 0x0c, 0x61, 0xe1, 0x1b, 0x57, 0xfd, 0xbb,
-		0x57, 0x03, 0xe9, 0x65, 0xfc, 0xd1, 0x80, 0xd5, 0x49, 0x0f, 0x1a, 0xe4, 0xfb, 0x45, 0xd4, 0x93,
-		0xde, 0x81, 0xea, 0x3f, 0x78, 0x43, 0xb4, 0x5e, 0x61, 0x12, 0xac, 0xfc, 0xf6, 0x7f, 0x4c, 0xb0,
-		0x0a, 0xdf, 0x56, 0xc6, 0x04, 0x6b, 0xc2, 0xfb, 0xc2, 0x1f, 0x0c, 0xb8, 0x5b, 0xdc, 0x75, 0x93,
-		0x67, 0x63, 0x78, 0x2f, 0xf1, 0xfa, 0x50, 0xff, 0xf8, 0x8d, 0xb0, 0x7a, 0x6d, 0xbf, 0x35, 0xa0,
-		0x3e, 0xbe, 0xcd, 0x25, 0x4f, 0xf2, 0xaf, 0xb4, 0x49, 0xef, 0x02, 0xf5, 0xa7, 0x57, 0xc6, 0xe9,
-		0xf5, 0xfc, 0xda, 0x80, 0x5b, 0x63, 0x7b, 0x57, 0xf2, 0x61, 0xfe, 0xa1, 0x33, 0xa1, 0xff, 0xae,
-		0x3f, 0xb9, 0x2a, 0x4c, 0x2f, 0xe6, 0x18, 0x6a, 0x43, 0xf5, 0x3b, 0x29, 0x68, 0x3b, 0x46, 0x5a,
-		0xad, 0xfa, 0xc6, 0x65, 0x54, 0xf5, 0x3c, 0x21, 0x5c, 0x1f, 0xad, 0x47, 0xc9, 0x07, 0x85, 0x6b,
-		0x1e, 0x29, 0xd4, 0xeb, 0x0f, 0x2f, 0xa9, 0xad, 0x27, 0xfc, 0x39, 0xdc, 0xcc, 0xab, 0x11, 0xc9,
-		0x77, 0x72, 0x69, 0x0a, 0xaa, 0xd0, 0xfa, 0xa3, 0x2b, 0x20, 0xce, 0x6d, 0xc9, 0xfc, 0x1a, 0x6f,
-		0xcc, 0x96, 0x2c, 0x2c, 0x43, 0xc7, 0x6c, 0xc9, 0x09, 0x45, 0x24, 0x83, 0x85, 0xe1, 0x22, 0x8a,
-		0x6c, 0x8c, 0x33, 0xe4, 0x62, 0x0d, 0x56, 0x7f, 0xff, 0x52, 0xba, 0x7a, 0xaa, 0xdf, 0x19, 0xaa,
-		0x8e, 0x1f, 0x77, 0x3b, 0x93, 0xa7, 0xe3, 0xc8, 0x26, 0x54, 0x57, 0xf5, 0x8f, 0xae, 0x0e, 0x4c,
-		0x97, 0xf4, 0xfc, 0x0b, 0x58, 0x76, 0xc3, 0x4e, 0x1e, 0xfc, 0x79, 0x79, 0x3b, 0x62, 0xfb, 0x3c,
-		0x94, 0xe1, 0xbe, 0xf1, 0xd3, 0xcd, 0x16, 0x93, 0xed, 0xf8, 0xa8, 0xe1, 0x86, 0x9d, 0xcd, 0xa1,
-		0xff, 0x53, 0x1b, 0x2d, 0x0c, 0xd2, 0x3f, 0x81, 0xf5, 0x5f, 0xab, 0x1f, 0xd3, 0x88, 0x9d, 0x3d,
-		0x3a, 0x9a, 0x55, 0xb2, 0xc7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x23, 0x9c, 0x68, 0x69,
-		0x1e, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcb, 0x73, 0xdc, 0xc6,
+		0xd1, 0x2f, 0xf0, 0x25, 0xb2, 0x97, 0xcf, 0x91, 0x48, 0x42, 0x2b, 0x51, 0x22, 0x21, 0xcb, 0xe6,
+		0x27, 0x5b, 0xcb, 0x4f, 0x94, 0x2d, 0xd9, 0xb2, 0xfd, 0xb9, 0xc8, 0xa5, 0x28, 0xb3, 0x3e, 0x5b,
+		0xc5, 0x60, 0x65, 0xab, 0x92, 0x0b, 0x6a, 0x08, 0x34, 0x97, 0x13, 0x62, 0x01, 0x10, 0x33, 0x4b,
+		0x6a, 0x95, 0x53, 0x52, 0x3e, 0x25, 0x95, 0x54, 0x72, 0x49, 0x55, 0x2e, 0xc9, 0x21, 0x55, 0xc9,
+		0x2d, 0x07, 0xff, 0x11, 0xb9, 0xe7, 0x4f, 0xc8, 0x3f, 0xe2, 0x4a, 0xcd, 0x03, 0xcb, 0xdd, 0x25,
+		0x16, 0x4b, 0xd2, 0xe5, 0xb2, 0x2a, 0xb7, 0x45, 0x4f, 0xf7, 0xaf, 0x1f, 0xd3, 0xd3, 0x98, 0x6e,
+		0x2c, 0xdc, 0x6b, 0xee, 0x61, 0xba, 0xe6, 0xd3, 0x00, 0x23, 0x1f, 0xd7, 0x68, 0xc2, 0xd6, 0x8e,
+		0x1f, 0xac, 0x71, 0x4c, 0x8f, 0x99, 0x8f, 0xde, 0x49, 0x9c, 0x1e, 0xee, 0x87, 0xf1, 0x49, 0x25,
+		0x49, 0x63, 0x11, 0x93, 0xab, 0x92, 0xb7, 0x62, 0x78, 0x2b, 0x34, 0x61, 0x95, 0xe3, 0x07, 0xe5,
+		0x5b, 0xf5, 0x38, 0xae, 0x87, 0xb8, 0xa6, 0x58, 0xf6, 0x9a, 0xfb, 0x6b, 0x41, 0x33, 0xa5, 0x82,
+		0xc5, 0x91, 0x16, 0x2a, 0x2f, 0xe7, 0x29, 0xf0, 0xe3, 0x46, 0xa3, 0xcd, 0xb1, 0x92, 0xc7, 0x71,
+		0xc0, 0xb8, 0x88, 0xd3, 0x96, 0x61, 0xb9, 0x9d, 0xc7, 0x72, 0xd4, 0xc4, 0x36, 0x83, 0x93, 0xc7,
+		0x20, 0x28, 0x3f, 0x0c, 0x19, 0x17, 0x45, 0x3c, 0xdd, 0x2e, 0x3a, 0xdf, 0x8e, 0xc3, 0x52, 0x4d,
+		0xd0, 0x54, 0xbc, 0x34, 0xf4, 0xa7, 0xaf, 0xd0, 0x6f, 0x4a, 0x77, 0x5c, 0x3c, 0x6a, 0x22, 0x17,
+		0x64, 0x01, 0xc6, 0x82, 0xb8, 0x41, 0x59, 0x64, 0x5b, 0xcb, 0xd6, 0xea, 0x84, 0x6b, 0x9e, 0xc8,
+		0x6d, 0x28, 0x65, 0x58, 0x1e, 0x0b, 0xec, 0x21, 0xb5, 0x08, 0x19, 0x69, 0x27, 0x20, 0xdb, 0x30,
+		0xd5, 0x66, 0x10, 0xad, 0x04, 0xed, 0xe1, 0x65, 0x6b, 0xb5, 0xb4, 0xbe, 0x52, 0xc9, 0x89, 0x6a,
+		0x25, 0x53, 0xff, 0xa2, 0x95, 0xa0, 0x3b, 0x79, 0xd2, 0xf1, 0x44, 0x9e, 0xc0, 0x84, 0x74, 0xcc,
+		0x93, 0x9e, 0xd9, 0x23, 0x0a, 0x63, 0x29, 0x17, 0xe3, 0x05, 0xe5, 0x87, 0x5f, 0x30, 0x2e, 0xdc,
+		0x71, 0x61, 0x7e, 0x91, 0x75, 0x18, 0x65, 0x51, 0xd2, 0x14, 0xf6, 0xa8, 0x92, 0xbb, 0x99, 0x2b,
+		0xb7, 0x4b, 0x5b, 0x61, 0x4c, 0x03, 0x57, 0xb3, 0x12, 0x0a, 0xcb, 0x98, 0x05, 0xc1, 0xe3, 0x32,
+		0x36, 0x9e, 0x88, 0x3d, 0x3f, 0x8c, 0x39, 0x7a, 0x82, 0x35, 0x30, 0x6e, 0x0a, 0x7b, 0x4c, 0xc1,
+		0x5d, 0xaf, 0xe8, 0x5c, 0xa8, 0x64, 0xb9, 0x50, 0xd9, 0x32, 0xb9, 0xe0, 0xde, 0x6c, 0x43, 0xa8,
+		0xe8, 0xbe, 0x88, 0xab, 0x52, 0xfe, 0x85, 0x16, 0x27, 0x2f, 0xe1, 0x86, 0x72, 0xa9, 0x0f, 0xfa,
+		0x95, 0x41, 0xe8, 0x8b, 0x52, 0x3a, 0x0f, 0xb8, 0x0c, 0xe3, 0x2c, 0xc0, 0x48, 0x30, 0xd1, 0xb2,
+		0xc7, 0xd5, 0x8e, 0xb4, 0x9f, 0xc9, 0x12, 0x40, 0xaa, 0xf7, 0x54, 0xee, 0xd7, 0x84, 0x5a, 0x9d,
+		0x30, 0x94, 0x9d, 0x80, 0xf8, 0x60, 0x77, 0xec, 0xa7, 0x97, 0x62, 0x93, 0xa3, 0x97, 0xc4, 0x21,
+		0xf3, 0x5b, 0x36, 0x2c, 0x5b, 0xab, 0xd3, 0xeb, 0xf7, 0x0a, 0x77, 0x6e, 0x27, 0x70, 0xa5, 0xc8,
+		0xae, 0x92, 0x70, 0xe7, 0x4f, 0xf2, 0xc8, 0xa4, 0x0a, 0x93, 0x29, 0x8a, 0xb4, 0x95, 0x01, 0x97,
+		0x94, 0xa7, 0xcb, 0xb9, 0xc0, 0xae, 0x64, 0x34, 0x70, 0xa5, 0xf4, 0xf4, 0x81, 0xdc, 0x81, 0x29,
+		0x3f, 0x95, 0x7b, 0xe3, 0x1f, 0x60, 0xd0, 0x0c, 0xd1, 0x9e, 0x54, 0xbe, 0x4c, 0x4a, 0x62, 0xcd,
+		0xd0, 0xc8, 0x7d, 0x18, 0x69, 0x60, 0x23, 0xb6, 0xa7, 0x4c, 0x2c, 0xf3, 0x34, 0x7c, 0x89, 0x8d,
+		0xd8, 0x55, 0x6c, 0xc4, 0x85, 0x39, 0x8e, 0x34, 0xf5, 0x0f, 0x3c, 0x2a, 0x44, 0xca, 0xf6, 0x9a,
+		0x02, 0xb9, 0x3d, 0xad, 0x64, 0xef, 0xe6, 0xca, 0xd6, 0x14, 0xf7, 0x46, 0x9b, 0xd9, 0x9d, 0xe5,
+		0x3d, 0x14, 0xf2, 0x10, 0xc6, 0x0e, 0x90, 0x06, 0x98, 0xda, 0x33, 0x0a, 0xe8, 0x46, 0x2e, 0xd0,
+		0xe7, 0x8a, 0xc5, 0x35, 0xac, 0xe4, 0x09, 0x94, 0x02, 0x0c, 0x69, 0x4b, 0xe7, 0x86, 0x3d, 0x3b,
+		0x28, 0x15, 0x40, 0x71, 0xab, 0x5c, 0x90, 0xbb, 0x9f, 0xa4, 0x2c, 0x4e, 0xe5, 0xee, 0xcf, 0x2d,
+		0x5b, 0xab, 0xa3, 0x6e, 0xfb, 0x99, 0xac, 0xc0, 0xe4, 0x3e, 0x65, 0x69, 0x84, 0x9c, 0x7b, 0x87,
+		0xd8, 0xb2, 0x89, 0x8a, 0x59, 0x29, 0xa3, 0xfd, 0x3f, 0xb6, 0xc8, 0x3b, 0x30, 0xc3, 0x78, 0x1c,
+		0x2a, 0x5c, 0xaf, 0x9e, 0xc6, 0xcd, 0xc4, 0xbe, 0xaa, 0xb8, 0xa6, 0xdb, 0xe4, 0x67, 0x92, 0xea,
+		0x3c, 0x86, 0x5b, 0xfd, 0x6a, 0x06, 0x4f, 0xe2, 0x88, 0x23, 0x99, 0x87, 0xb1, 0xb4, 0x19, 0xc9,
+		0x3c, 0xd3, 0x45, 0x63, 0x34, 0x6d, 0x46, 0x3b, 0x81, 0xf3, 0xcf, 0x21, 0xb8, 0x55, 0x63, 0xf5,
+		0x88, 0x86, 0x17, 0x2e, 0x37, 0x5f, 0x01, 0x69, 0xa7, 0x67, 0xfb, 0x6c, 0xa9, 0xaa, 0x53, 0x5a,
+		0x7f, 0xbb, 0x30, 0x31, 0x4f, 0x55, 0xcc, 0x9d, 0xf4, 0x92, 0xba, 0x0e, 0xcc, 0x70, 0xe1, 0x81,
+		0x19, 0xe9, 0x3d, 0x30, 0xb7, 0xa1, 0xc4, 0x95, 0x2f, 0x5e, 0x44, 0x1b, 0xa8, 0x2a, 0xcc, 0x84,
+		0x0b, 0x9a, 0xf4, 0x9c, 0x36, 0x90, 0x7c, 0x06, 0x93, 0x86, 0x41, 0xd7, 0xa0, 0xb1, 0x73, 0xd4,
+		0x20, 0x03, 0xb9, 0xa3, 0x2a, 0x91, 0x0d, 0x57, 0xfc, 0x38, 0x12, 0x69, 0x1c, 0xaa, 0x92, 0x30,
+		0xe9, 0x66, 0x8f, 0xce, 0x0a, 0xdc, 0xee, 0x1b, 0x47, 0xbd, 0x05, 0xce, 0x77, 0x16, 0xbc, 0x63,
+		0x78, 0x98, 0x38, 0x28, 0xae, 0xf1, 0x2f, 0x61, 0x4a, 0x97, 0x22, 0xe3, 0x9d, 0x8a, 0x7d, 0x69,
+		0x7d, 0x3d, 0x3f, 0xf3, 0x8b, 0xa0, 0xdc, 0x49, 0x05, 0x94, 0x01, 0xf7, 0xc4, 0x68, 0x68, 0x60,
+		0x8c, 0x86, 0xbf, 0x47, 0x8c, 0x46, 0xba, 0x63, 0xb4, 0x01, 0xab, 0x83, 0xfd, 0x2f, 0xce, 0xd7,
+		0x7f, 0x0c, 0xc1, 0x92, 0x8b, 0x1c, 0xc5, 0x9b, 0x92, 0xae, 0x0b, 0x30, 0x96, 0x22, 0xe5, 0x71,
+		0x64, 0x92, 0xd5, 0x3c, 0x91, 0xc7, 0x60, 0x07, 0xe8, 0x33, 0x2e, 0x4f, 0xee, 0x3e, 0x8b, 0x18,
+		0x3f, 0xf0, 0xf0, 0x18, 0xa3, 0x76, 0xe2, 0x0e, 0xbb, 0xf3, 0xd9, 0xfa, 0xb6, 0x5a, 0x7e, 0x2a,
+		0x57, 0x77, 0x82, 0x9e, 0x1c, 0x1f, 0xed, 0xcd, 0xf1, 0x0a, 0x5c, 0xe5, 0x87, 0x2c, 0xf1, 0xcc,
+		0x1e, 0xa5, 0x48, 0x93, 0x24, 0x6c, 0xa9, 0x4c, 0x1e, 0x77, 0xe7, 0xe4, 0x92, 0x0e, 0xb1, 0xab,
+		0x17, 0x64, 0x65, 0xe8, 0x17, 0xaf, 0xe2, 0x48, 0xff, 0xcb, 0x82, 0xbb, 0x26, 0xa6, 0x55, 0x1a,
+		0xf9, 0xf8, 0x5f, 0x50, 0x20, 0x9c, 0x55, 0x78, 0x7b, 0x90, 0x4b, 0xa7, 0x67, 0x75, 0xe5, 0x05,
+		0xa6, 0x0d, 0x16, 0x51, 0x81, 0x6f, 0x7a, 0xae, 0x3d, 0x82, 0x2b, 0x01, 0x0a, 0xca, 0x42, 0x6e,
+		0x8f, 0x9c, 0xe3, 0xb4, 0x66, 0xcc, 0x5d, 0x91, 0x1c, 0xed, 0x8e, 0xa4, 0xf3, 0x16, 0x38, 0x45,
+		0xfe, 0x9b, 0x30, 0x7d, 0x6b, 0xc1, 0xdd, 0xa7, 0xaf, 0x12, 0x0c, 0x58, 0x0e, 0x57, 0xad, 0xb3,
+		0xee, 0xbc, 0x39, 0x49, 0x22, 0xb3, 0x60, 0x90, 0xcd, 0xc6, 0xbd, 0x3f, 0x58, 0xb0, 0xbc, 0x85,
+		0xdc, 0x4f, 0xd9, 0xde, 0x9b, 0x92, 0x04, 0xce, 0x77, 0xc3, 0xb0, 0x52, 0x60, 0x93, 0x39, 0xd4,
+		0x21, 0x2c, 0x9e, 0x5e, 0x99, 0xfd, 0x38, 0xda, 0x67, 0x75, 0x73, 0x3f, 0x31, 0x6f, 0x92, 0x87,
+		0xe7, 0xb3, 0xa0, 0xda, 0x29, 0xea, 0x2e, 0x60, 0x2e, 0x9d, 0xec, 0xc1, 0xe2, 0x59, 0x57, 0x3d,
+		0x16, 0xed, 0xc7, 0xc6, 0xdf, 0x7b, 0xe7, 0xd3, 0xb6, 0x13, 0xed, 0xc7, 0xa7, 0x17, 0xd5, 0x2e,
+		0x32, 0x79, 0x09, 0x24, 0xc1, 0x28, 0x60, 0x51, 0xdd, 0xa3, 0xbe, 0x60, 0xc7, 0x4c, 0x30, 0xe4,
+		0xf6, 0xf0, 0xf2, 0xf0, 0x6a, 0x69, 0x7d, 0x35, 0x3f, 0xdf, 0x35, 0xfb, 0x86, 0xe6, 0x6e, 0x29,
+		0xf0, 0xb9, 0xa4, 0x8b, 0xc8, 0x90, 0x93, 0x9f, 0xc2, 0x6c, 0x06, 0xec, 0x1f, 0xb0, 0x30, 0x48,
+		0x31, 0xb2, 0x47, 0x14, 0x6c, 0xa5, 0x08, 0xb6, 0x2a, 0x79, 0xbb, 0x2d, 0x9f, 0x49, 0x3a, 0x96,
+		0x52, 0x8c, 0x48, 0xed, 0x14, 0x3a, 0x2b, 0xf6, 0xa6, 0xef, 0x29, 0xb4, 0x78, 0xcb, 0xf0, 0x76,
+		0x81, 0x66, 0x44, 0xe7, 0x9b, 0x61, 0xb8, 0xf6, 0x13, 0xd9, 0x78, 0x66, 0xe1, 0xfb, 0x91, 0x8e,
+		0xd8, 0x87, 0x30, 0xaa, 0xfa, 0x5f, 0x73, 0x43, 0x70, 0x0a, 0x91, 0x94, 0xc1, 0xae, 0x16, 0x20,
+		0x1e, 0x2c, 0xa8, 0x1f, 0x5e, 0x8a, 0x3f, 0x47, 0x5f, 0xc8, 0xfc, 0x0c, 0x98, 0x32, 0x6a, 0x44,
+		0xb5, 0x35, 0xff, 0x93, 0x0b, 0xa5, 0x21, 0x94, 0x44, 0x35, 0x13, 0x70, 0xaf, 0x1d, 0xe5, 0x50,
+		0x65, 0x3e, 0x6a, 0x05, 0x7e, 0x1c, 0x71, 0xc6, 0x05, 0x46, 0x7e, 0xcb, 0x0b, 0xf1, 0x18, 0x43,
+		0x7b, 0xb4, 0xa0, 0x71, 0x52, 0x1a, 0xaa, 0xa7, 0x22, 0x5f, 0x48, 0x09, 0x77, 0xfe, 0x28, 0x8f,
+		0xec, 0xfc, 0xd5, 0x82, 0xf9, 0x9e, 0x6d, 0x30, 0x67, 0xef, 0x33, 0x98, 0xcc, 0xdc, 0xe3, 0xcd,
+		0x30, 0xbb, 0xba, 0x0d, 0xb8, 0x41, 0x19, 0x3f, 0xa4, 0x00, 0xd9, 0x81, 0xe9, 0xce, 0xf8, 0x60,
+		0x60, 0x0f, 0x15, 0x84, 0xb8, 0x23, 0x2e, 0x18, 0xb8, 0x53, 0x47, 0x9d, 0x8f, 0xce, 0x9f, 0x87,
+		0x60, 0x31, 0xab, 0x16, 0xed, 0x6e, 0x7c, 0x40, 0xbe, 0x74, 0xb5, 0xf7, 0x43, 0x17, 0x6b, 0xef,
+		0x9f, 0xc1, 0x74, 0x5b, 0xf6, 0x74, 0xc6, 0x30, 0xbd, 0xbe, 0x52, 0x08, 0xa0, 0x67, 0x0c, 0xa2,
+		0xe3, 0x49, 0xde, 0x9f, 0x58, 0xe4, 0x87, 0xcd, 0x00, 0xbd, 0x53, 0x40, 0x2e, 0xa8, 0x68, 0xea,
+		0x97, 0xdc, 0xb8, 0x3b, 0x6f, 0xd6, 0x33, 0x90, 0x9a, 0x5a, 0x24, 0xf7, 0x81, 0x64, 0x82, 0x09,
+		0x4d, 0x85, 0x4a, 0x08, 0xae, 0xb6, 0x7d, 0xdc, 0x9d, 0x33, 0x2b, 0xbb, 0xed, 0x05, 0xe7, 0x77,
+		0xa3, 0x60, 0x9f, 0x0d, 0x90, 0xd9, 0xc9, 0x8f, 0xe0, 0x4a, 0x12, 0x87, 0x21, 0xa6, 0xdc, 0xb6,
+		0x54, 0x45, 0xb8, 0x9d, 0xbf, 0x89, 0x8a, 0x47, 0x9d, 0xd6, 0x8c, 0x9f, 0x7c, 0x09, 0xb3, 0x67,
+		0xec, 0xd6, 0xb1, 0xbc, 0x53, 0x18, 0x0a, 0xed, 0x85, 0x3b, 0x2d, 0xba, 0xbd, 0x7a, 0x09, 0xb3,
+		0x6d, 0x6f, 0x4c, 0x3d, 0x37, 0xe7, 0xee, 0xbd, 0x42, 0xb8, 0xb6, 0xa7, 0xba, 0x60, 0xbb, 0x33,
+		0x49, 0x37, 0x81, 0xa4, 0x70, 0xd5, 0x98, 0xec, 0xed, 0xb5, 0xbc, 0xbd, 0x26, 0x0b, 0x03, 0x7d,
+		0x75, 0x92, 0xee, 0x56, 0x73, 0xb1, 0xfb, 0x85, 0xcb, 0xc4, 0x81, 0x6f, 0xb6, 0x36, 0x25, 0xcc,
+		0x4e, 0xf0, 0x34, 0x12, 0x69, 0xcb, 0x9d, 0x4d, 0x7a, 0xc8, 0xa4, 0xd1, 0xe9, 0x8c, 0x89, 0xcd,
+		0xa8, 0x52, 0xb8, 0x79, 0x41, 0x85, 0x19, 0x8a, 0x8e, 0x92, 0xd6, 0x37, 0x93, 0x74, 0x53, 0xcb,
+		0x55, 0x98, 0xcf, 0xb5, 0x8c, 0xcc, 0xc2, 0xb0, 0x6c, 0xbc, 0x75, 0xf6, 0xcb, 0x9f, 0xe4, 0x1a,
+		0x8c, 0x1e, 0xd3, 0xb0, 0xa9, 0xfb, 0xa2, 0x51, 0x57, 0x3f, 0x3c, 0x19, 0xfa, 0xd0, 0x2a, 0xd7,
+		0xe1, 0x5a, 0x9e, 0xb6, 0x1c, 0x8c, 0x8f, 0x3a, 0x31, 0xce, 0xb9, 0xdd, 0xa7, 0x8a, 0x9c, 0x0f,
+		0xe0, 0xc6, 0x33, 0x14, 0xd9, 0x3a, 0xdf, 0x6c, 0x6d, 0xa9, 0x53, 0x39, 0xe0, 0xd0, 0x3a, 0xdb,
+		0x70, 0x33, 0x5f, 0xcc, 0xa4, 0xf2, 0xdb, 0x30, 0x73, 0x9a, 0x8f, 0xb2, 0xf5, 0xd3, 0x29, 0x3d,
+		0xe1, 0x4e, 0x65, 0x99, 0x26, 0xbb, 0x3f, 0xee, 0x70, 0x58, 0x52, 0x67, 0xb0, 0x37, 0x7f, 0xf8,
+		0x0f, 0x58, 0x35, 0xe4, 0x9d, 0xe6, 0x56, 0x3f, 0xad, 0xc6, 0xfe, 0x23, 0x58, 0x32, 0xaf, 0xfd,
+		0x56, 0x47, 0x41, 0xe8, 0x38, 0xe1, 0x56, 0xc1, 0x2b, 0xfb, 0x0c, 0xee, 0x97, 0x28, 0x68, 0x40,
+		0x05, 0x75, 0xcb, 0x19, 0xe8, 0x59, 0xd5, 0x52, 0x65, 0xbb, 0x85, 0xcb, 0x55, 0x39, 0x74, 0x39,
+		0x95, 0x19, 0x68, 0x8e, 0xca, 0xd7, 0x70, 0xa7, 0xc8, 0xcb, 0xef, 0x73, 0xf2, 0x6f, 0xf7, 0xf5,
+		0xd4, 0x54, 0x82, 0xd7, 0x70, 0xa7, 0xc8, 0xdd, 0x4c, 0xf7, 0xc8, 0x65, 0x74, 0xf7, 0x75, 0x59,
+		0x33, 0x38, 0x7f, 0x1f, 0x86, 0x77, 0xbe, 0x4a, 0x02, 0xd3, 0x6b, 0x60, 0xaa, 0x8f, 0xe9, 0x56,
+		0x35, 0x6e, 0x24, 0x54, 0xb0, 0x3d, 0x16, 0x32, 0xd1, 0xfa, 0x21, 0x5f, 0x5b, 0x3b, 0x70, 0x87,
+		0x06, 0x81, 0x17, 0xe1, 0x49, 0xbb, 0x04, 0x7a, 0x2c, 0x52, 0xcf, 0x01, 0xee, 0xd3, 0x66, 0x28,
+		0x3c, 0x8e, 0xc2, 0x74, 0x12, 0x37, 0x69, 0x10, 0x3c, 0xc7, 0x13, 0x53, 0x4a, 0x76, 0xa2, 0xe7,
+		0x78, 0xb2, 0xa5, 0x99, 0x6a, 0x28, 0xc8, 0x27, 0x70, 0x23, 0x83, 0xf2, 0x8d, 0xf9, 0x21, 0x76,
+		0x16, 0x56, 0x09, 0xb1, 0xa8, 0x21, 0xaa, 0x6d, 0x86, 0xac, 0x34, 0x7e, 0x06, 0x37, 0xf1, 0x15,
+		0xe3, 0x42, 0xdd, 0x46, 0x73, 0xc4, 0x75, 0x9b, 0x76, 0x3d, 0xe3, 0x39, 0x0b, 0xb0, 0x0a, 0xb3,
+		0x0d, 0x7a, 0x88, 0xd2, 0xdc, 0xcc, 0x74, 0x33, 0x1c, 0x98, 0x96, 0xf4, 0x1a, 0x0a, 0x63, 0x2b,
+		0x79, 0x1f, 0x16, 0x93, 0x34, 0x6e, 0xc4, 0x42, 0x33, 0x77, 0x56, 0xff, 0x2b, 0x4a, 0xcb, 0x55,
+		0xb3, 0x5c, 0x43, 0xd1, 0x2e, 0x9c, 0xce, 0x3d, 0x58, 0x1d, 0xbc, 0x51, 0xa6, 0x7d, 0x7a, 0x0d,
+		0x6f, 0x3d, 0x43, 0x51, 0xc4, 0xf8, 0xc3, 0x95, 0x94, 0x23, 0xb8, 0x3b, 0x40, 0xb7, 0x29, 0x2c,
+		0x9f, 0xc3, 0xe4, 0x31, 0xa6, 0x2a, 0xeb, 0x39, 0x8a, 0xac, 0x8e, 0xdc, 0xed, 0x7b, 0x9b, 0xc5,
+		0xf4, 0x6b, 0xcd, 0x5e, 0x43, 0xe1, 0x96, 0x8e, 0xdb, 0xbf, 0xb9, 0xb3, 0x08, 0xf3, 0xcf, 0x50,
+		0x54, 0xc3, 0x26, 0x17, 0xe6, 0x36, 0xa0, 0xfd, 0x73, 0x7e, 0x65, 0xc1, 0x42, 0xef, 0x8a, 0xd1,
+		0x7e, 0x00, 0xd7, 0x79, 0x33, 0x49, 0xe2, 0x54, 0x60, 0xe0, 0xf9, 0x21, 0xc3, 0x48, 0x78, 0x06,
+		0x93, 0xdb, 0x56, 0xc1, 0x51, 0xab, 0x65, 0x52, 0x55, 0x25, 0x64, 0x6c, 0xe2, 0xee, 0x22, 0xcf,
+		0x5f, 0x70, 0x7e, 0x33, 0x0c, 0xce, 0xb3, 0x9c, 0x39, 0xd0, 0xe7, 0xfa, 0x33, 0xd7, 0x8f, 0xd4,
+		0x44, 0xdc, 0x80, 0x89, 0x84, 0xd6, 0xd1, 0xe3, 0xec, 0xb5, 0xbe, 0x2a, 0xca, 0x09, 0x39, 0xad,
+		0x63, 0x8d, 0xbd, 0x56, 0xef, 0xac, 0x08, 0x5f, 0xc9, 0x1a, 0x54, 0x47, 0x4f, 0xc4, 0x87, 0x18,
+		0x99, 0x89, 0xe2, 0x94, 0x24, 0xef, 0xd2, 0x3a, 0xbe, 0x90, 0x44, 0xf2, 0x2e, 0x90, 0x13, 0xca,
+		0x84, 0xb7, 0x1f, 0xa7, 0xea, 0xdc, 0xa9, 0x41, 0x9b, 0xb9, 0xf2, 0xcd, 0xc8, 0x95, 0xed, 0x38,
+		0x7d, 0x8e, 0x27, 0x6a, 0xc2, 0x46, 0x3c, 0xb8, 0x6e, 0xbe, 0xec, 0x69, 0x3e, 0x6f, 0x9f, 0x85,
+		0x02, 0x53, 0x7d, 0x59, 0x1d, 0x53, 0x97, 0xd5, 0xb7, 0x72, 0xfd, 0x51, 0xe2, 0xdb, 0x8a, 0x59,
+		0xdd, 0x57, 0x17, 0x0c, 0x4c, 0x0f, 0x5d, 0x7e, 0x0c, 0x51, 0x13, 0x3a, 0xf9, 0xed, 0x81, 0x1d,
+		0x53, 0x3d, 0x29, 0x1e, 0x77, 0x27, 0x25, 0x71, 0xc3, 0xd0, 0x9c, 0x7f, 0x5b, 0x70, 0xa7, 0x70,
+		0x37, 0x4c, 0x7e, 0x3c, 0x82, 0x2b, 0x46, 0x4d, 0x61, 0x1b, 0x91, 0x89, 0x65, 0xcc, 0xe4, 0xff,
+		0xa0, 0x94, 0xd2, 0x13, 0x2f, 0x93, 0xd5, 0x6f, 0xaa, 0xfc, 0xc3, 0xb3, 0x45, 0x05, 0xdd, 0x0c,
+		0xe3, 0x3d, 0x17, 0x52, 0x7a, 0x62, 0x80, 0xf2, 0x42, 0x3f, 0x9c, 0x17, 0xfa, 0x32, 0x8c, 0x6b,
+		0x3f, 0x31, 0x30, 0xd7, 0xf2, 0xf6, 0xb3, 0xd3, 0x82, 0xc9, 0x6d, 0xa4, 0xa2, 0x99, 0xe2, 0x76,
+		0x48, 0xeb, 0x9c, 0x30, 0x58, 0xcf, 0x99, 0x12, 0xd0, 0x30, 0x45, 0x1a, 0xb4, 0x54, 0xb5, 0x0b,
+		0x51, 0x1e, 0x03, 0x4c, 0xd3, 0x38, 0xf5, 0x30, 0xa2, 0x7b, 0x21, 0xea, 0xa1, 0xe4, 0xb8, 0x7b,
+		0xff, 0x4c, 0xea, 0x6c, 0x68, 0xb9, 0x6a, 0x26, 0xf6, 0x54, 0x4a, 0x3d, 0xd5, 0x42, 0xeb, 0x7f,
+		0x9b, 0x83, 0x52, 0x16, 0xdb, 0x8d, 0xdd, 0x1d, 0xf2, 0x4b, 0x0b, 0x16, 0xf2, 0x07, 0xce, 0xe4,
+		0x12, 0x23, 0xf5, 0xf2, 0xc3, 0x0b, 0xc9, 0x98, 0xad, 0xfc, 0xc6, 0x82, 0xc5, 0x3e, 0x9f, 0x08,
+		0x48, 0x1f, 0xc0, 0xc2, 0x0f, 0x33, 0xe5, 0xf7, 0x2f, 0x26, 0x64, 0xcc, 0xf8, 0x8b, 0x05, 0xcb,
+		0x83, 0xa6, 0xf0, 0xe4, 0x93, 0x22, 0xe8, 0x41, 0x1f, 0x2f, 0xca, 0x9f, 0x5e, 0x52, 0xda, 0x58,
+		0x28, 0x37, 0x2b, 0x7f, 0x66, 0xdd, 0x67, 0xb3, 0x0a, 0x3f, 0x08, 0x94, 0x1f, 0x5e, 0x48, 0xc6,
+		0xd8, 0xf0, 0x27, 0x0b, 0x6e, 0x19, 0x80, 0x3e, 0xa3, 0x62, 0xf2, 0xa4, 0x0f, 0xee, 0x39, 0x46,
+		0xe6, 0xe5, 0x8f, 0x2f, 0x25, 0x6b, 0x6c, 0xfb, 0xad, 0x05, 0xe5, 0xfe, 0xb3, 0x59, 0xf2, 0x28,
+		0xff, 0x15, 0x39, 0x68, 0x98, 0x5d, 0x7e, 0x7c, 0x61, 0xb9, 0x8e, 0x58, 0x15, 0x0f, 0x54, 0xfb,
+		0xc4, 0xea, 0x5c, 0x93, 0xe3, 0xf2, 0xc7, 0x97, 0x92, 0x35, 0xb6, 0xfd, 0xda, 0x82, 0xeb, 0x7d,
+		0xa7, 0xa5, 0xe4, 0x83, 0xc2, 0x76, 0xb3, 0x6f, 0xa4, 0x1e, 0x5d, 0x54, 0xcc, 0x18, 0xb3, 0x0f,
+		0x53, 0x5d, 0x13, 0x23, 0x52, 0x30, 0xe8, 0xea, 0x19, 0xee, 0x95, 0xef, 0x9d, 0x87, 0xd5, 0xe8,
+		0x89, 0x61, 0xb6, 0xb7, 0x65, 0x26, 0xef, 0x9d, 0xb3, 0xb3, 0xd6, 0xda, 0xee, 0x5f, 0xa8, 0x0f,
+		0x27, 0xbf, 0x80, 0x6b, 0x79, 0xcd, 0x27, 0xf9, 0xdf, 0x5c, 0x98, 0x82, 0xf6, 0xb6, 0xfc, 0xe0,
+		0x02, 0x12, 0x1d, 0xe5, 0x22, 0xbf, 0x79, 0xec, 0x53, 0x2e, 0x0a, 0xfb, 0xdb, 0x3e, 0xe5, 0x62,
+		0x40, 0x77, 0x2a, 0x8b, 0xea, 0xa0, 0x6b, 0x71, 0x9f, 0xa2, 0x7a, 0xce, 0xb6, 0xa7, 0xfc, 0xe9,
+		0x25, 0xa5, 0x8d, 0x85, 0x7f, 0xb4, 0x60, 0xa9, 0xf0, 0x42, 0x4c, 0x3e, 0xea, 0x17, 0xfa, 0xc1,
+		0xb6, 0x3d, 0xb9, 0x8c, 0xa8, 0x31, 0x8c, 0xc1, 0x74, 0xf7, 0xdd, 0x98, 0xdc, 0xeb, 0x87, 0x76,
+		0xf6, 0x6a, 0x5d, 0x7e, 0xf7, 0x5c, 0xbc, 0x46, 0xd5, 0xef, 0x2d, 0xb8, 0x61, 0x8c, 0xca, 0xbb,
+		0x74, 0x91, 0xc7, 0x45, 0x6e, 0x14, 0x5c, 0x9a, 0xcb, 0x1f, 0x5e, 0x5c, 0x50, 0x9b, 0xb4, 0xf9,
+		0x35, 0x2c, 0xfa, 0x71, 0x23, 0x4f, 0x7c, 0x73, 0x7c, 0x23, 0x61, 0xbb, 0x69, 0x2c, 0xe2, 0x5d,
+		0xeb, 0x67, 0x6b, 0x75, 0x26, 0x0e, 0x9a, 0x7b, 0x15, 0x3f, 0x6e, 0xac, 0x75, 0xfd, 0x87, 0xac,
+		0x52, 0xc7, 0x48, 0xff, 0xf1, 0xcd, 0xfc, 0x9d, 0xec, 0x63, 0x9a, 0xb0, 0xe3, 0x07, 0x7b, 0x63,
+		0x8a, 0xf6, 0xf0, 0x3f, 0x03, 0x00, 0x41, 0x72, 0xf3, 0xa4, 0x5d, 0x27, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -992,289 +1173,311 @@ var yarpcFileDescriptorClosure674d14d2fee4e473 = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
-		0x14, 0x9f, 0xe2, 0xda, 0x49, 0x9f, 0xdd, 0xd4, 0x63, 0xd6, 0xd4, 0xc9, 0xfe, 0x79, 0x06, 0x86,
-		0x66, 0x3b, 0x48, 0x88, 0x7b, 0x29, 0x56, 0x14, 0x83, 0x13, 0x3b, 0xab, 0xda, 0x2d, 0x31, 0x64,
-		0x23, 0xc1, 0x76, 0x98, 0x40, 0x4b, 0x4f, 0x2e, 0x67, 0x89, 0x14, 0x28, 0xca, 0x89, 0x6f, 0xfb,
-		0x24, 0x3b, 0xec, 0x2b, 0xed, 0x0b, 0x0d, 0x94, 0xe8, 0xd8, 0xee, 0x3c, 0xf4, 0x32, 0xec, 0x46,
-		0xbe, 0xdf, 0x9f, 0xf7, 0xa3, 0xf0, 0x48, 0x41, 0x3b, 0x9f, 0xa0, 0x74, 0x02, 0x1a, 0x22, 0x0f,
-		0xd0, 0xa1, 0x29, 0x73, 0xe6, 0xa7, 0x4e, 0x20, 0x92, 0x44, 0x70, 0x3b, 0x95, 0x42, 0x09, 0x72,
-		0xa0, 0x19, 0xb6, 0x61, 0xd8, 0x34, 0x65, 0xf6, 0xfc, 0xf4, 0xf8, 0x8b, 0xa9, 0x10, 0xd3, 0x18,
-		0x9d, 0x82, 0x32, 0xc9, 0x23, 0x27, 0xcc, 0x25, 0x55, 0x6c, 0x29, 0xea, 0xbc, 0x85, 0x8f, 0x6f,
-		0x84, 0x9c, 0x45, 0xb1, 0xb8, 0x1d, 0xdc, 0x61, 0x90, 0x6b, 0x88, 0x7c, 0x09, 0xf5, 0x5b, 0x53,
-		0xf4, 0x59, 0xd8, 0xb2, 0xda, 0xd6, 0xc9, 0x43, 0x0f, 0x96, 0x25, 0x37, 0x24, 0x4f, 0xa0, 0x26,
-		0x73, 0xae, 0xb1, 0x9d, 0x02, 0xab, 0xca, 0x9c, 0xbb, 0x61, 0xa7, 0x03, 0x8d, 0xa5, 0xd9, 0x78,
-		0x91, 0x22, 0x21, 0xf0, 0x80, 0xd3, 0x04, 0x8d, 0x41, 0xb1, 0xd6, 0x9c, 0x5e, 0xa0, 0xd8, 0x9c,
-		0xa9, 0xc5, 0xbf, 0x72, 0x3e, 0x87, 0xdd, 0x21, 0x5d, 0xc4, 0x82, 0x86, 0x1a, 0x0e, 0xa9, 0xa2,
-		0x05, 0xdc, 0xf0, 0x8a, 0x75, 0xe7, 0x25, 0xec, 0x5e, 0x50, 0x16, 0xe7, 0x12, 0xc9, 0x21, 0xd4,
-		0x24, 0xd2, 0x4c, 0x70, 0xa3, 0x37, 0x3b, 0xd2, 0x82, 0xdd, 0x10, 0x15, 0x65, 0x71, 0x56, 0x24,
-		0x6c, 0x78, 0xcb, 0x6d, 0xe7, 0x0f, 0x0b, 0x1e, 0xfc, 0x84, 0x89, 0x20, 0xaf, 0xa0, 0x16, 0x31,
-		0x8c, 0xc3, 0xac, 0x65, 0xb5, 0x2b, 0x27, 0xf5, 0xee, 0xd7, 0xf6, 0x96, 0xef, 0x67, 0x6b, 0xaa,
-		0x7d, 0x51, 0xf0, 0x06, 0x5c, 0xc9, 0x85, 0x67, 0x44, 0xc7, 0x37, 0x50, 0x5f, 0x2b, 0x93, 0x26,
-		0x54, 0x66, 0xb8, 0x30, 0x29, 0xf4, 0x92, 0x74, 0xa1, 0x3a, 0xa7, 0x71, 0x8e, 0x45, 0x80, 0x7a,
-		0xf7, 0xb3, 0xad, 0xf6, 0xe6, 0x98, 0x5e, 0x49, 0xfd, 0x6e, 0xe7, 0x85, 0xd5, 0xf9, 0xd3, 0x82,
-		0xda, 0x6b, 0xa4, 0x21, 0x4a, 0xf2, 0xfd, 0x7b, 0x11, 0x9f, 0x6d, 0xf5, 0x28, 0xc9, 0xff, 0x6f,
-		0xc8, 0xbf, 0x2c, 0x68, 0x8e, 0x90, 0xca, 0xe0, 0x5d, 0x4f, 0x29, 0xc9, 0x26, 0xb9, 0xc2, 0x8c,
-		0xf8, 0xb0, 0xcf, 0x78, 0x88, 0x77, 0x18, 0xfa, 0x1b, 0xb1, 0x5f, 0x6c, 0x75, 0x7d, 0x5f, 0x6e,
-		0xbb, 0xa5, 0x76, 0xfd, 0x1c, 0x8f, 0xd8, 0x7a, 0xed, 0xf8, 0x57, 0x20, 0xff, 0x24, 0xfd, 0x87,
-		0xa7, 0x8a, 0x60, 0xaf, 0x4f, 0x15, 0x3d, 0x8b, 0xc5, 0x84, 0x5c, 0xc0, 0x23, 0xe4, 0x81, 0x08,
-		0x19, 0x9f, 0xfa, 0x6a, 0x91, 0x96, 0x03, 0xba, 0xdf, 0xfd, 0x6a, 0xab, 0xd7, 0xc0, 0x30, 0xf5,
-		0x44, 0x7b, 0x0d, 0x5c, 0xdb, 0xdd, 0x0f, 0xf0, 0xce, 0xda, 0x00, 0x0f, 0xcb, 0x4b, 0x87, 0xf2,
-		0x1a, 0x65, 0xc6, 0x04, 0x77, 0x79, 0x24, 0x34, 0x91, 0x25, 0x69, 0xbc, 0xbc, 0x08, 0x7a, 0x4d,
-		0x9e, 0xc1, 0xe3, 0x08, 0xa9, 0xca, 0x25, 0xfa, 0xf3, 0x92, 0x6a, 0x2e, 0xdc, 0xbe, 0x29, 0x1b,
-		0x83, 0xce, 0x5b, 0x78, 0x3a, 0xca, 0xd3, 0x54, 0x48, 0x85, 0xe1, 0x79, 0xcc, 0x90, 0x2b, 0x83,
-		0x64, 0xfa, 0xae, 0x4e, 0x85, 0x9f, 0x85, 0x33, 0xe3, 0x5c, 0x9d, 0x8a, 0x51, 0x38, 0x23, 0x47,
-		0xb0, 0xf7, 0x1b, 0x9d, 0xd3, 0x02, 0x28, 0x3d, 0x77, 0xf5, 0x7e, 0x14, 0xce, 0x3a, 0xbf, 0x57,
-		0xa0, 0xee, 0xa1, 0x92, 0x8b, 0xa1, 0x88, 0x59, 0xb0, 0x20, 0x7d, 0x68, 0x32, 0xce, 0x14, 0xa3,
-		0xb1, 0xcf, 0xb8, 0x42, 0x39, 0xa7, 0x65, 0xca, 0x7a, 0xf7, 0xc8, 0x2e, 0x9f, 0x17, 0x7b, 0xf9,
-		0xbc, 0xd8, 0x7d, 0xf3, 0xbc, 0x78, 0x8f, 0x8d, 0xc4, 0x35, 0x0a, 0xe2, 0xc0, 0xc1, 0x84, 0x06,
-		0x33, 0x11, 0x45, 0x7e, 0x20, 0x30, 0x8a, 0x58, 0xa0, 0x63, 0x16, 0xbd, 0x2d, 0x8f, 0x18, 0xe8,
-		0x7c, 0x85, 0xe8, 0xb6, 0x09, 0xbd, 0x63, 0x49, 0x9e, 0xac, 0xda, 0x56, 0x3e, 0xd8, 0xd6, 0x48,
-		0xee, 0xdb, 0x7e, 0xb3, 0x72, 0xa1, 0x4a, 0x61, 0x92, 0xaa, 0xac, 0xf5, 0xa0, 0x6d, 0x9d, 0x54,
-		0xef, 0xa9, 0x3d, 0x53, 0x26, 0xaf, 0xe0, 0x53, 0x2e, 0xb8, 0x2f, 0xf5, 0xd1, 0xe9, 0x24, 0x46,
-		0x1f, 0xa5, 0x14, 0xd2, 0x2f, 0x9f, 0x94, 0xac, 0x55, 0x6d, 0x57, 0x4e, 0x1e, 0x7a, 0x2d, 0x2e,
-		0xb8, 0xb7, 0x64, 0x0c, 0x34, 0xc1, 0x2b, 0x71, 0xf2, 0x06, 0x0e, 0xf0, 0x2e, 0x65, 0x65, 0x90,
-		0x55, 0xe4, 0xda, 0x87, 0x22, 0x93, 0x95, 0x6a, 0x99, 0xfa, 0xdb, 0x5b, 0x68, 0xac, 0xcf, 0x14,
-		0x39, 0x82, 0x27, 0x83, 0xcb, 0xf3, 0xab, 0xbe, 0x7b, 0xf9, 0x83, 0x3f, 0xfe, 0x79, 0x38, 0xf0,
-		0xdd, 0xcb, 0xeb, 0xde, 0x8f, 0x6e, 0xbf, 0xf9, 0x11, 0x39, 0x86, 0xc3, 0x4d, 0x68, 0xfc, 0xda,
-		0x73, 0x2f, 0xc6, 0xde, 0x4d, 0xd3, 0x22, 0x87, 0x40, 0x36, 0xb1, 0x37, 0xa3, 0xab, 0xcb, 0xe6,
-		0x0e, 0x69, 0xc1, 0x27, 0x9b, 0xf5, 0xa1, 0x77, 0x35, 0xbe, 0x7a, 0xde, 0xac, 0x9c, 0x5d, 0xc3,
-		0xd3, 0x40, 0x24, 0xdb, 0x86, 0xfc, 0x6c, 0xaf, 0x97, 0xb2, 0xa1, 0x4e, 0x3f, 0xb4, 0x7e, 0x71,
-		0xa6, 0x4c, 0xbd, 0xcb, 0x27, 0x76, 0x20, 0x12, 0x67, 0xe3, 0xc7, 0x64, 0x4f, 0x91, 0x97, 0x3f,
-		0x1b, 0xf3, 0x8f, 0x7a, 0x49, 0x53, 0x36, 0x3f, 0x9d, 0xd4, 0x8a, 0xda, 0xf3, 0xbf, 0x03, 0x00,
-		0x00, 0xff, 0xff, 0xdc, 0x8c, 0x77, 0x9a, 0xc7, 0x06, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x53, 0xdb, 0x46,
+		0x14, 0xae, 0x30, 0x36, 0xf0, 0x6c, 0xc0, 0x59, 0x1a, 0x30, 0xa4, 0x69, 0x5d, 0x4f, 0xd3, 0xd0,
+		0x1c, 0xe4, 0x81, 0x5c, 0x32, 0xcd, 0x64, 0x3a, 0x06, 0x8b, 0xc6, 0x04, 0x6c, 0x57, 0x76, 0x60,
+		0xd2, 0x43, 0x35, 0x6b, 0xe9, 0xd9, 0x2c, 0x96, 0xb4, 0x9a, 0xd5, 0xca, 0xe0, 0x53, 0x4f, 0xfd,
+		0x1b, 0x3d, 0xf4, 0x47, 0xf4, 0x8f, 0xf4, 0x0f, 0x75, 0x24, 0xad, 0xb1, 0x01, 0x53, 0x2e, 0x9d,
+		0xdc, 0x76, 0xdf, 0xf7, 0x7d, 0x6f, 0xbf, 0xf7, 0xfc, 0x76, 0x2d, 0x28, 0x47, 0x3d, 0x14, 0x55,
+		0x9b, 0x3a, 0xe8, 0xdb, 0x58, 0xa5, 0x01, 0xab, 0x8e, 0xf6, 0xaa, 0x36, 0xf7, 0x3c, 0xee, 0xeb,
+		0x81, 0xe0, 0x92, 0x93, 0x8d, 0x98, 0xa1, 0x2b, 0x86, 0x4e, 0x03, 0xa6, 0x8f, 0xf6, 0x76, 0xbe,
+		0x1e, 0x70, 0x3e, 0x70, 0xb1, 0x9a, 0x50, 0x7a, 0x51, 0xbf, 0xea, 0x44, 0x82, 0x4a, 0x36, 0x11,
+		0x55, 0x3e, 0xc0, 0x93, 0x73, 0x2e, 0x86, 0x7d, 0x97, 0x5f, 0x19, 0xd7, 0x68, 0x47, 0x31, 0x44,
+		0xbe, 0x81, 0xfc, 0x95, 0x0a, 0x5a, 0xcc, 0x29, 0x69, 0x65, 0x6d, 0x77, 0xc5, 0x84, 0x49, 0xa8,
+		0xe1, 0x90, 0xa7, 0x90, 0x13, 0x91, 0x1f, 0x63, 0x0b, 0x09, 0x96, 0x15, 0x91, 0xdf, 0x70, 0x2a,
+		0x15, 0x28, 0x4c, 0x92, 0x75, 0xc7, 0x01, 0x12, 0x02, 0x8b, 0x3e, 0xf5, 0x50, 0x25, 0x48, 0xd6,
+		0x31, 0xa7, 0x66, 0x4b, 0x36, 0x62, 0x72, 0xfc, 0x20, 0xe7, 0x39, 0x2c, 0xb5, 0xe9, 0xd8, 0xe5,
+		0xd4, 0x89, 0x61, 0x87, 0x4a, 0x9a, 0xc0, 0x05, 0x33, 0x59, 0x57, 0xde, 0xc2, 0xd2, 0x11, 0x65,
+		0x6e, 0x24, 0x90, 0x6c, 0x42, 0x4e, 0x20, 0x0d, 0xb9, 0xaf, 0xf4, 0x6a, 0x47, 0x4a, 0xb0, 0xe4,
+		0xa0, 0xa4, 0xcc, 0x0d, 0x13, 0x87, 0x05, 0x73, 0xb2, 0xad, 0xfc, 0xa9, 0xc1, 0xe2, 0x29, 0x7a,
+		0x9c, 0xbc, 0x83, 0x5c, 0x9f, 0xa1, 0xeb, 0x84, 0x25, 0xad, 0x9c, 0xd9, 0xcd, 0xef, 0xbf, 0xd0,
+		0xe7, 0xf4, 0x4f, 0x8f, 0xa9, 0xfa, 0x51, 0xc2, 0x33, 0x7c, 0x29, 0xc6, 0xa6, 0x12, 0xed, 0x9c,
+		0x43, 0x7e, 0x26, 0x4c, 0x8a, 0x90, 0x19, 0xe2, 0x58, 0xb9, 0x88, 0x97, 0x64, 0x1f, 0xb2, 0x23,
+		0xea, 0x46, 0x98, 0x18, 0xc8, 0xef, 0x7f, 0x35, 0x37, 0xbd, 0x2a, 0xd3, 0x4c, 0xa9, 0x3f, 0x2e,
+		0xbc, 0xd1, 0x2a, 0x7f, 0x69, 0x90, 0x7b, 0x8f, 0xd4, 0x41, 0x41, 0x7e, 0xba, 0x63, 0xf1, 0xe5,
+		0xdc, 0x1c, 0x29, 0xf9, 0xf3, 0x9a, 0xfc, 0x47, 0x83, 0x62, 0x07, 0xa9, 0xb0, 0x2f, 0x6a, 0x52,
+		0x0a, 0xd6, 0x8b, 0x24, 0x86, 0xc4, 0x82, 0x35, 0xe6, 0x3b, 0x78, 0x8d, 0x8e, 0x75, 0xcb, 0xf6,
+		0x9b, 0xb9, 0x59, 0xef, 0xca, 0xf5, 0x46, 0xaa, 0x9d, 0xad, 0x63, 0x95, 0xcd, 0xc6, 0x76, 0x7e,
+		0x03, 0x72, 0x9f, 0xf4, 0x3f, 0x56, 0xd5, 0x87, 0xe5, 0x3a, 0x95, 0xf4, 0xc0, 0xe5, 0x3d, 0x72,
+		0x04, 0xab, 0xe8, 0xdb, 0xdc, 0x61, 0xfe, 0xc0, 0x92, 0xe3, 0x20, 0x1d, 0xd0, 0xb5, 0xfd, 0x6f,
+		0xe7, 0xe6, 0x32, 0x14, 0x33, 0x9e, 0x68, 0xb3, 0x80, 0x33, 0xbb, 0x9b, 0x01, 0x5e, 0x98, 0x19,
+		0xe0, 0x76, 0x7a, 0xe9, 0x50, 0x9c, 0xa1, 0x08, 0x19, 0xf7, 0x1b, 0x7e, 0x9f, 0xc7, 0x44, 0xe6,
+		0x05, 0xee, 0xe4, 0x22, 0xc4, 0x6b, 0xf2, 0x12, 0xd6, 0xfb, 0x48, 0x65, 0x24, 0xd0, 0x1a, 0xa5,
+		0x54, 0x75, 0xe1, 0xd6, 0x54, 0x58, 0x25, 0xa8, 0x7c, 0x80, 0xad, 0x4e, 0x14, 0x04, 0x5c, 0x48,
+		0x74, 0x0e, 0x5d, 0x86, 0xbe, 0x54, 0x48, 0x18, 0xdf, 0xd5, 0x01, 0xb7, 0x42, 0x67, 0xa8, 0x32,
+		0x67, 0x07, 0xbc, 0xe3, 0x0c, 0xc9, 0x36, 0x2c, 0x5f, 0xd2, 0x11, 0x4d, 0x80, 0x34, 0xe7, 0x52,
+		0xbc, 0xef, 0x38, 0xc3, 0xca, 0xdf, 0x59, 0xc8, 0x9b, 0x28, 0xc5, 0xb8, 0xcd, 0x5d, 0x66, 0x8f,
+		0x49, 0x1d, 0x8a, 0xcc, 0x67, 0x92, 0x51, 0xd7, 0x62, 0xbe, 0x44, 0x31, 0xa2, 0xa9, 0xcb, 0xfc,
+		0xfe, 0xb6, 0x9e, 0x3e, 0x2f, 0xfa, 0xe4, 0x79, 0xd1, 0xeb, 0xea, 0x79, 0x31, 0xd7, 0x95, 0xa4,
+		0xa1, 0x14, 0xa4, 0x0a, 0x1b, 0x3d, 0x6a, 0x0f, 0x79, 0xbf, 0x6f, 0xd9, 0x1c, 0xfb, 0x7d, 0x66,
+		0xc7, 0x36, 0x93, 0xb3, 0x35, 0x93, 0x28, 0xe8, 0x70, 0x8a, 0xc4, 0xc7, 0x7a, 0xf4, 0x9a, 0x79,
+		0x91, 0x37, 0x3d, 0x36, 0xf3, 0xe8, 0xb1, 0x4a, 0x72, 0x73, 0xec, 0x0f, 0xd3, 0x2c, 0x54, 0x4a,
+		0xf4, 0x02, 0x19, 0x96, 0x16, 0xcb, 0xda, 0x6e, 0xf6, 0x86, 0x5a, 0x53, 0x61, 0xf2, 0x0e, 0x9e,
+		0xf9, 0xdc, 0xb7, 0x44, 0x5c, 0x3a, 0xed, 0xb9, 0x68, 0xa1, 0x10, 0x5c, 0x58, 0xe9, 0x93, 0x12,
+		0x96, 0xb2, 0xe5, 0xcc, 0xee, 0x8a, 0x59, 0xf2, 0xb9, 0x6f, 0x4e, 0x18, 0x46, 0x4c, 0x30, 0x53,
+		0x9c, 0x1c, 0xc3, 0x06, 0x5e, 0x07, 0x2c, 0x35, 0x32, 0xb5, 0x9c, 0x7b, 0xcc, 0x32, 0x99, 0xaa,
+		0x6e, 0x5c, 0xd7, 0xa1, 0x38, 0x69, 0x56, 0x68, 0x5f, 0xa0, 0x13, 0xb9, 0x58, 0x5a, 0x2a, 0x67,
+		0xfe, 0x3b, 0xd1, 0xba, 0x92, 0x74, 0x94, 0x82, 0x18, 0x90, 0xbf, 0x64, 0x52, 0xa2, 0xb0, 0x3c,
+		0xee, 0x60, 0x69, 0x39, 0x99, 0xe0, 0xef, 0xe6, 0x4e, 0x70, 0x52, 0xd2, 0x71, 0x42, 0x3e, 0xe5,
+		0x0e, 0x9a, 0x70, 0x79, 0xb3, 0x26, 0x2d, 0x58, 0x4f, 0x7b, 0x60, 0x05, 0xf1, 0x40, 0x30, 0x0c,
+		0x4b, 0x2b, 0x89, 0x97, 0xef, 0x1f, 0x4e, 0x95, 0x36, 0x25, 0x1d, 0x20, 0x73, 0x4d, 0x4c, 0x77,
+		0x0c, 0x43, 0x72, 0x06, 0x3b, 0x93, 0xdf, 0x44, 0x72, 0x49, 0x5d, 0x0b, 0x5d, 0x1a, 0x84, 0xe8,
+		0x58, 0x92, 0x79, 0x58, 0x82, 0xc7, 0x1a, 0xb6, 0xa5, 0xc4, 0xdd, 0x58, 0x6b, 0xa4, 0xd2, 0x2e,
+		0xf3, 0xb0, 0xf2, 0x3b, 0x3c, 0xb9, 0x77, 0x38, 0x79, 0x01, 0x6b, 0x13, 0xf7, 0xf1, 0x00, 0x88,
+		0xc9, 0x5f, 0xc5, 0xaa, 0x32, 0x95, 0x06, 0xc9, 0x21, 0x14, 0x92, 0x1f, 0x3e, 0xad, 0x71, 0xac,
+		0x9e, 0x8e, 0xf2, 0xc3, 0x15, 0xaa, 0xda, 0xf2, 0x62, 0xba, 0x79, 0xf5, 0x87, 0x06, 0xeb, 0x77,
+		0x3a, 0x49, 0x9e, 0xc3, 0xb6, 0x69, 0x74, 0xcd, 0x4f, 0xd6, 0x71, 0xa3, 0xdb, 0x35, 0x4c, 0xeb,
+		0xb4, 0x55, 0x37, 0xac, 0x46, 0xf3, 0xac, 0x76, 0xd2, 0xa8, 0x17, 0xbf, 0x20, 0x3b, 0xb0, 0x79,
+		0x1f, 0x6e, 0xb6, 0x9a, 0x46, 0x51, 0x9b, 0x8f, 0x1d, 0x7d, 0x3c, 0x39, 0x29, 0x2e, 0x90, 0x67,
+		0xb0, 0x75, 0x1f, 0x33, 0x7e, 0xf9, 0x58, 0x3b, 0x29, 0x66, 0x5e, 0x5d, 0x41, 0x61, 0xf6, 0x49,
+		0x22, 0xdb, 0xf0, 0xd4, 0x68, 0x1e, 0xb6, 0xea, 0x8d, 0xe6, 0xcf, 0x56, 0xf7, 0x53, 0xfb, 0xce,
+		0xf9, 0xb7, 0xa1, 0xee, 0x7b, 0xb3, 0x71, 0xd4, 0x35, 0xcf, 0x8b, 0x1a, 0xd9, 0x04, 0x72, 0x1b,
+		0x3b, 0xee, 0xb4, 0x9a, 0xc5, 0x05, 0x52, 0x82, 0x2f, 0x6f, 0xc7, 0xdb, 0x66, 0xab, 0xdb, 0x7a,
+		0x5d, 0xcc, 0x1c, 0x9c, 0xc1, 0x96, 0xcd, 0xbd, 0x79, 0x4d, 0x3b, 0x58, 0xae, 0x05, 0xac, 0x1d,
+		0xff, 0x96, 0x6d, 0xed, 0xd7, 0xea, 0x80, 0xc9, 0x8b, 0xa8, 0xa7, 0xdb, 0xdc, 0xab, 0xde, 0xfa,
+		0xae, 0xd1, 0x07, 0xe8, 0xa7, 0xdf, 0x2a, 0xea, 0x13, 0xe7, 0x2d, 0x0d, 0xd8, 0x68, 0xaf, 0x97,
+		0x4b, 0x62, 0xaf, 0xff, 0x1d, 0x00, 0xb0, 0xc4, 0x52, 0x5f, 0x06, 0x09, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x38, 0x8e, 0xad, 0xae, 0x64,
-		0x92, 0x68, 0xe2, 0xa4, 0x80, 0x90, 0x96, 0xf1, 0xcc, 0x75, 0x3c, 0xf2, 0xee, 0xcc, 0x76, 0x66,
-		0xd6, 0x1b, 0x23, 0xf1, 0xc4, 0x03, 0x12, 0x6a, 0x05, 0x55, 0x85, 0x44, 0x05, 0x08, 0x84, 0x04,
-		0x6a, 0x11, 0x52, 0x11, 0x08, 0x01, 0xe2, 0x05, 0x90, 0x10, 0x48, 0xa0, 0xc2, 0x13, 0x2f, 0x3c,
-		0xc0, 0x03, 0x0f, 0xf4, 0x8d, 0x07, 0xca, 0x1b, 0x12, 0x9a, 0x3b, 0x77, 0x76, 0x77, 0x66, 0xee,
-		0x9d, 0xb9, 0xb3, 0xde, 0xb4, 0xa0, 0xe6, 0xcd, 0x73, 0xe7, 0xdc, 0x33, 0xbf, 0x7b, 0xee, 0x39,
-		0xe7, 0x9e, 0x7b, 0xce, 0x59, 0xc3, 0xa9, 0xd6, 0x06, 0xb6, 0x17, 0x34, 0x55, 0xc7, 0xa6, 0x86,
-		0x17, 0xd4, 0xa6, 0xb1, 0xb0, 0x73, 0x69, 0x61, 0xcb, 0x70, 0x5c, 0xcb, 0xde, 0xad, 0x34, 0x6d,
-		0xcb, 0xb5, 0xd0, 0x01, 0x8f, 0xa4, 0x42, 0x49, 0x2a, 0x6a, 0xd3, 0xa8, 0xec, 0x5c, 0x3a, 0x72,
-		0xe2, 0xa1, 0x65, 0x3d, 0xac, 0xe3, 0x05, 0x42, 0xb2, 0xd1, 0xda, 0x5c, 0xd0, 0x5b, 0xb6, 0xea,
-		0x1a, 0x96, 0xe9, 0x4f, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x35, 0x1a, 0xd8, 0x71, 0xd5, 0x46, 0x93,
-		0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x59, 0x8d, 0x46, 0x87, 0x85, 0xcc, 0xa2, 0x70, 0x55, 0x67, 0xbb,
-		0x6e, 0x38, 0x6e, 0x12, 0x4d, 0xdb, 0xb2, 0xb7, 0x37, 0xeb, 0x56, 0xdb, 0xa7, 0x91, 0x6f, 0x41,
-		0xf1, 0x25, 0x7f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x36, 0x5d, 0x67, 0x4a, 0x9a, 0xc9, 0xcf,
-		0x96, 0x17, 0x4f, 0x55, 0x18, 0x6b, 0xab, 0x50, 0xea, 0x15, 0x8f, 0x52, 0xa1, 0x13, 0xe4, 0xf7,
-		0xae, 0xc1, 0x68, 0xef, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19, 0x69,
-		0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xfc, 0x57, 0xde, 0xa2, 0xa7, 0x72, 0x33,
-		0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x97, 0x48, 0x25, 0x90, 0x48, 0x65, 0x3d, 0x90, 0x88, 0x52,
-		0x22, 0xd4, 0xde, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x1d, 0xc3, 0x32, 0xa7, 0xf2, 0x3e, 0x53,
-		0xfa, 0x88, 0x0e, 0x43, 0xd1, 0x5b, 0xbc, 0xf7, 0xb9, 0x21, 0xf2, 0xa6, 0xe0, 0x3d, 0x56, 0x75,
-		0xf4, 0x75, 0x09, 0xce, 0x07, 0x4b, 0xae, 0xe1, 0x47, 0x58, 0x6b, 0x79, 0xfb, 0x50, 0x73, 0x5c,
-		0xd5, 0x76, 0xb1, 0x5e, 0xf3, 0x91, 0xa8, 0xae, 0x6b, 0x1b, 0x1b, 0x2d, 0x17, 0x3b, 0x53, 0xc3,
-		0x04, 0xcf, 0xff, 0x33, 0x97, 0xfe, 0x32, 0xe5, 0xb3, 0x12, 0xb0, 0xb9, 0xe7, 0x73, 0x21, 0x4b,
-		0x5e, 0xea, 0xf0, 0x78, 0xe9, 0x29, 0xe5, 0x5c, 0x5b, 0x8c, 0x14, 0x7d, 0x5b, 0x82, 0x0b, 0x0c,
-		0x78, 0x9a, 0xd5, 0x68, 0xd6, 0x31, 0x13, 0x60, 0x81, 0x00, 0x7c, 0x41, 0x0c, 0xe0, 0x72, 0xc0,
-		0x27, 0x0e, 0xf1, 0x99, 0xb6, 0x28, 0x31, 0x7a, 0x53, 0x82, 0x39, 0x06, 0xc8, 0x4d, 0xd5, 0xa8,
-		0xb3, 0x10, 0x16, 0x09, 0xc2, 0x1b, 0x62, 0x08, 0x57, 0x09, 0x93, 0x38, 0xbc, 0xb3, 0x6d, 0x21,
-		0x4a, 0xf4, 0x2d, 0xb6, 0x00, 0x3d, 0xdd, 0xd2, 0x6b, 0x56, 0xcb, 0x8d, 0xc3, 0x1b, 0x21, 0xf0,
-		0x9e, 0x17, 0x83, 0xe7, 0xa9, 0x9d, 0x7e, 0xa7, 0xe5, 0xc6, 0x01, 0xce, 0xb6, 0x05, 0x69, 0xd1,
-		0x1b, 0x12, 0xcc, 0xea, 0x58, 0x33, 0x1c, 0x02, 0xcc, 0xd3, 0x52, 0x47, 0xdb, 0xc2, 0x7a, 0x8b,
-		0x29, 0xbc, 0x12, 0x41, 0x77, 0x9d, 0x89, 0xee, 0x16, 0x65, 0xb2, 0xae, 0x3a, 0xdb, 0xf7, 0x02,
-		0x16, 0x71, 0x64, 0x67, 0x74, 0x01, 0x3a, 0xf4, 0x9a, 0x04, 0x67, 0x23, 0xa8, 0x78, 0x36, 0x01,
-		0x04, 0xd3, 0xb5, 0x74, 0x4c, 0x3c, 0x73, 0x90, 0xf5, 0x54, 0x2a, 0x86, 0x94, 0x12, 0x8c, 0xa0,
-		0x2c, 0x28, 0xa5, 0x04, 0xfd, 0x0f, 0x49, 0x89, 0xab, 0xfa, 0xaf, 0xc7, 0x50, 0x25, 0x68, 0xd6,
-		0x28, 0x41, 0xf5, 0x7f, 0xa9, 0xa8, 0xf8, 0x4a, 0x75, 0x5a, 0x4f, 0x27, 0x43, 0x5f, 0x94, 0xe0,
-		0xe9, 0x30, 0x26, 0x9e, 0x25, 0xee, 0x23, 0x80, 0xae, 0xa6, 0x02, 0xe2, 0x19, 0xe1, 0x29, 0x3d,
-		0x8d, 0x88, 0x6c, 0x9b, 0xaa, 0xb9, 0xc6, 0x8e, 0xe1, 0xee, 0xa6, 0x2a, 0xf7, 0x58, 0xc2, 0xb6,
-		0x2d, 0x51, 0x26, 0x69, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0xe5, 0x8e, 0xa0, 0xe2, 0x29, 0xf7, 0xfe,
-		0x04, 0xe5, 0x0e, 0x61, 0xe2, 0x2a, 0xb7, 0x9a, 0x4a, 0xc5, 0x90, 0x52, 0x82, 0x72, 0x8f, 0x0b,
-		0x4a, 0x29, 0x49, 0xb9, 0x55, 0x01, 0x3a, 0xa2, 0x48, 0x61, 0x54, 0x3c, 0x45, 0x9a, 0x48, 0x50,
-		0xa4, 0x5e, 0x48, 0x5c, 0x45, 0x52, 0xd3, 0x88, 0x88, 0xa5, 0x85, 0xc1, 0x24, 0x58, 0x1a, 0x4a,
-		0xb0, 0xb4, 0x5e, 0x3c, 0x09, 0x96, 0xa6, 0xa6, 0x93, 0xa1, 0x36, 0x9c, 0xf0, 0x40, 0xd8, 0x7c,
-		0xed, 0x39, 0x40, 0x80, 0x5c, 0x64, 0x02, 0xf1, 0xb8, 0xda, 0x5c, 0xb5, 0x39, 0xea, 0xf2, 0x5f,
-		0xa3, 0x57, 0xe0, 0x98, 0xff, 0xe1, 0x4d, 0xc3, 0x66, 0x7d, 0xf6, 0x20, 0xf9, 0x6c, 0x85, 0xff,
-		0xd9, 0x55, 0x6f, 0x5e, 0xfc, 0xa3, 0xd3, 0x2e, 0xef, 0x25, 0xfa, 0xae, 0x04, 0x0b, 0x11, 0x15,
-		0x55, 0x4d, 0x0d, 0xd7, 0x6b, 0x36, 0x7e, 0xa5, 0x85, 0x1d, 0xe6, 0xea, 0x0f, 0x11, 0x18, 0x2f,
-		0xa6, 0x6b, 0x2a, 0xe1, 0xa4, 0x04, 0x8c, 0xe2, 0xb8, 0xe6, 0x54, 0x61, 0x6a, 0xf4, 0x23, 0x09,
-		0xae, 0x50, 0x4c, 0x01, 0x44, 0x31, 0x25, 0x9e, 0x24, 0x68, 0x97, 0x99, 0x68, 0xe9, 0xd7, 0xfc,
-		0x4f, 0x8b, 0x68, 0x74, 0xc5, 0xce, 0x34, 0x03, 0x7d, 0x59, 0x82, 0x73, 0x2c, 0xf1, 0xb2, 0x80,
-		0x1e, 0x16, 0xd4, 0xee, 0x65, 0xca, 0x21, 0x45, 0xbb, 0x39, 0x64, 0xe8, 0xb3, 0x70, 0xd2, 0x57,
-		0x32, 0x3e, 0x92, 0x29, 0x82, 0xe4, 0x12, 0x5f, 0xcf, 0xf8, 0x10, 0x7c, 0x05, 0xe6, 0x7d, 0xfb,
-		0x0b, 0x12, 0x9c, 0xa1, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0xd3, 0x04, 0xc1, 0xb3, 0x4c, 0x04,
-		0x3e, 0x73, 0x5f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0xa5, 0xd0, 0xa0, 0xcf, 0xc1, 0x4c, 0x43, 0xb5,
-		0xb7, 0xb1, 0x5d, 0xb3, 0xb1, 0x66, 0xd9, 0x3a, 0x0b, 0xc4, 0x11, 0x02, 0x62, 0x91, 0x09, 0xe2,
-		0xe3, 0x64, 0xb2, 0x42, 0xe7, 0xc6, 0x11, 0x1c, 0x6f, 0x24, 0x11, 0xa0, 0x6f, 0x4a, 0x30, 0xcf,
-		0xba, 0x9f, 0x18, 0x0f, 0x4d, 0x95, 0x29, 0x90, 0xa3, 0x59, 0xc2, 0xd7, 0x7b, 0x94, 0x8d, 0x48,
-		0xf8, 0xca, 0xa1, 0x45, 0xdf, 0x91, 0xa0, 0xc2, 0x8a, 0xb0, 0xb1, 0xdd, 0x30, 0x4c, 0x95, 0xe9,
-		0x17, 0x8e, 0x25, 0xf8, 0x85, 0x78, 0x88, 0xdd, 0x61, 0xc4, 0xf0, 0x0b, 0x6d, 0x61, 0x6a, 0xf4,
-		0x63, 0x09, 0xae, 0xb0, 0xae, 0x52, 0xa9, 0x5e, 0xec, 0x38, 0x41, 0x7b, 0x4b, 0xf0, 0x46, 0x95,
-		0xe6, 0xca, 0x16, 0xda, 0xd9, 0xa6, 0xf0, 0x34, 0x80, 0x6f, 0x94, 0x27, 0xb2, 0x68, 0x00, 0xdf,
-		0x40, 0x67, 0xdb, 0x82, 0xb4, 0xe8, 0x6f, 0x12, 0xac, 0x44, 0x3c, 0x2e, 0x7e, 0xe4, 0x62, 0xdb,
-		0x54, 0xeb, 0x35, 0x06, 0x72, 0xc3, 0x34, 0x5c, 0x83, 0xad, 0x18, 0x27, 0x09, 0xf4, 0x7b, 0xe9,
-		0x2e, 0x78, 0x85, 0xf2, 0x8f, 0xad, 0xa7, 0x1a, 0x30, 0x8f, 0x2f, 0xe8, 0x05, 0x7b, 0x4f, 0x1c,
-		0xd0, 0x9f, 0x25, 0xb8, 0x99, 0x61, 0x99, 0x3c, 0x8f, 0x35, 0x43, 0xd6, 0x78, 0x77, 0x0f, 0x6b,
-		0xe4, 0x39, 0xb3, 0x1b, 0x76, 0xff, 0xd3, 0xd1, 0xbb, 0x12, 0x3c, 0x9f, 0xb4, 0x9c, 0x74, 0x3b,
-		0x39, 0x45, 0x16, 0xb6, 0xc6, 0x5c, 0x18, 0x17, 0x4c, 0xaa, 0xbd, 0x5c, 0xc3, 0xfd, 0x4d, 0x25,
-		0x71, 0x00, 0x33, 0x75, 0x62, 0xba, 0x86, 0xd9, 0xc2, 0x7a, 0x4d, 0x75, 0x6a, 0x26, 0x6e, 0xc7,
-		0xd7, 0x21, 0x27, 0xc4, 0x01, 0x8c, 0x0c, 0x0a, 0x65, 0xb7, 0xe4, 0xdc, 0xc6, 0x6d, 0x46, 0x1c,
-		0xd0, 0xce, 0x34, 0x03, 0xfd, 0x4a, 0x82, 0xeb, 0x24, 0x9a, 0xac, 0x69, 0x5b, 0x46, 0x5d, 0xcf,
-		0x68, 0x3f, 0xa7, 0x09, 0xf4, 0x97, 0x98, 0xd0, 0x49, 0x28, 0xb9, 0xec, 0x31, 0xcd, 0x62, 0x34,
-		0x97, 0x9d, 0xec, 0xd3, 0xd0, 0xcf, 0x24, 0xb8, 0x9a, 0xb2, 0x08, 0x9e, 0x75, 0x9c, 0x21, 0x2b,
-		0x58, 0xc9, 0xba, 0x02, 0x9e, 0x49, 0x5c, 0x74, 0x32, 0xce, 0x41, 0xdf, 0x97, 0xe0, 0x12, 0x17,
-		0x35, 0x37, 0xce, 0x7f, 0x9a, 0xc0, 0x5e, 0x62, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03, 0xff, 0x79,
-		0x2d, 0x03, 0x3d, 0xfa, 0xa1, 0x04, 0x97, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0x67, 0x13, 0x94, 0x9c,
-		0x0d, 0x38, 0xe1, 0x3a, 0x59, 0xd1, 0x32, 0xcd, 0x40, 0x6f, 0x4b, 0x70, 0x31, 0xb3, 0x66, 0x9c,
-		0x23, 0x88, 0x3f, 0x96, 0x01, 0x31, 0x4f, 0x29, 0xce, 0x6b, 0x19, 0xf4, 0xe1, 0x1d, 0x09, 0x16,
-		0xf9, 0x02, 0xe6, 0x1e, 0xc2, 0xb3, 0x04, 0xed, 0xcd, 0x2c, 0xf2, 0xe5, 0x9e, 0xc4, 0x17, 0xb4,
-		0x2c, 0x13, 0xd0, 0x0f, 0x92, 0x54, 0x22, 0xe1, 0xd2, 0xfc, 0x4c, 0x66, 0xc8, 0xfc, 0xeb, 0x33,
-		0x07, 0x32, 0xef, 0x22, 0xed, 0xc5, 0x66, 0x7c, 0xc8, 0x09, 0x91, 0xe4, 0x5c, 0x42, 0x6c, 0xc6,
-		0xc1, 0x9c, 0x10, 0x4e, 0x2e, 0x68, 0xd9, 0xa6, 0x90, 0x43, 0xd3, 0x0f, 0xc5, 0xfb, 0x8d, 0x78,
-		0xce, 0x27, 0x1c, 0x9a, 0x7e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xe9, 0x6f, 0x2a, 0xfa, 0xb5,
-		0x04, 0xcf, 0x09, 0x2c, 0x88, 0x67, 0xa3, 0xf3, 0x64, 0x35, 0xd5, 0x7e, 0x56, 0xc3, 0x33, 0xd6,
-		0x2b, 0x4e, 0x1f, 0xf3, 0xd0, 0x4f, 0x25, 0x78, 0x36, 0x69, 0x01, 0xfc, 0xfb, 0xd3, 0x85, 0x84,
-		0x03, 0x88, 0x0b, 0x82, 0x7f, 0x8f, 0xba, 0x88, 0x33, 0xce, 0x21, 0x0e, 0xa7, 0xd5, 0x74, 0xb0,
-		0xed, 0x76, 0x81, 0x3b, 0x58, 0xb5, 0xb5, 0xad, 0x1e, 0x98, 0x71, 0xdc, 0x95, 0x04, 0xeb, 0xbd,
-		0x4f, 0xd8, 0x05, 0x08, 0xee, 0x11, 0x66, 0xdd, 0x2f, 0x32, 0xac, 0xb7, 0x95, 0x65, 0xc2, 0xcd,
-		0x51, 0x80, 0x2e, 0x10, 0xf9, 0xaf, 0x65, 0x38, 0x27, 0x7a, 0x7a, 0xad, 0xc2, 0xbe, 0xce, 0x1a,
-		0xdd, 0xdd, 0x26, 0x26, 0xb5, 0x40, 0x5e, 0x65, 0x31, 0x60, 0xba, 0xbe, 0xdb, 0xc4, 0xca, 0x68,
-		0xbb, 0xe7, 0x09, 0x7d, 0x1a, 0x0e, 0x35, 0x55, 0xdb, 0x93, 0x48, 0xaf, 0xd1, 0x6d, 0x5a, 0xb4,
-		0x7c, 0x38, 0xcb, 0xe4, 0x77, 0x97, 0xcc, 0xe8, 0xb1, 0x89, 0x4d, 0x4b, 0x39, 0xd0, 0x8c, 0x0f,
-		0xa2, 0xe7, 0xa0, 0x44, 0x32, 0x32, 0x75, 0xc3, 0x71, 0x49, 0x61, 0xb1, 0xbc, 0x78, 0x9c, 0x9d,
-		0xf2, 0x50, 0x9d, 0xed, 0x35, 0xc3, 0x71, 0x95, 0x11, 0x97, 0xfe, 0x85, 0x16, 0x61, 0xd8, 0x30,
-		0x9b, 0x2d, 0x97, 0x94, 0x1d, 0xcb, 0x8b, 0xc7, 0x38, 0x48, 0x76, 0xeb, 0x96, 0xaa, 0x2b, 0x3e,
-		0x29, 0x52, 0x61, 0x26, 0x12, 0x72, 0xd4, 0x5c, 0xab, 0xa6, 0xd5, 0x2d, 0x07, 0x13, 0xff, 0x6d,
-		0xb5, 0x5c, 0x5a, 0x87, 0x9c, 0x8e, 0xd5, 0x45, 0x6f, 0xd1, 0x4a, 0xb2, 0x72, 0x0c, 0x87, 0x64,
-		0xbf, 0x6e, 0x2d, 0x7b, 0xf3, 0xd7, 0xfd, 0xe9, 0xe8, 0x65, 0x38, 0xda, 0x4d, 0x7b, 0xc7, 0xb9,
-		0x17, 0xd2, 0xb8, 0x1f, 0x76, 0x83, 0x64, 0x76, 0x84, 0xf1, 0x0d, 0x38, 0xd2, 0x8d, 0xb0, 0xbb,
-		0xab, 0xb0, 0x5b, 0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xee, 0x50, 0x74, 0xe4, 0xac,
-		0xb4, 0xcc, 0xaa, 0x8e, 0xaa, 0x50, 0xa2, 0xae, 0xd2, 0xb2, 0x49, 0x1d, 0x6e, 0x6c, 0xf1, 0x3c,
-		0xdb, 0xb5, 0x53, 0x06, 0x24, 0x84, 0xae, 0x06, 0x53, 0x94, 0xee, 0x6c, 0x54, 0x85, 0x89, 0x2e,
-		0x0e, 0xcf, 0x5d, 0xb5, 0x6c, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf5, 0x69, 0x94, 0xf1, 0xce,
-		0x34, 0x3a, 0x82, 0x14, 0x98, 0xac, 0xab, 0xde, 0x9d, 0xcf, 0x0f, 0x67, 0xc8, 0x72, 0xb0, 0xd3,
-		0xaa, 0xbb, 0xb4, 0xf0, 0x95, 0xbc, 0xa7, 0x07, 0xbd, 0xb9, 0xcb, 0x9d, 0xa9, 0x0a, 0x99, 0x89,
-		0xae, 0xc3, 0xb4, 0x65, 0x1b, 0x0f, 0x0d, 0xdf, 0xd1, 0x46, 0xa4, 0x54, 0x26, 0x52, 0x9a, 0x0c,
-		0x08, 0x22, 0x42, 0x3a, 0x02, 0x23, 0x86, 0x8e, 0x4d, 0xd7, 0x70, 0x77, 0x49, 0x45, 0xa9, 0xa4,
-		0x74, 0x9e, 0xd1, 0x65, 0x98, 0xdc, 0x34, 0x6c, 0xc7, 0x8d, 0xf3, 0xdc, 0x47, 0x28, 0x0f, 0x90,
-		0xb7, 0x11, 0x86, 0xcb, 0x30, 0x6a, 0x63, 0xd7, 0xde, 0xad, 0x35, 0xad, 0xba, 0xa1, 0xed, 0xd2,
-		0x2a, 0xcc, 0x0c, 0xe7, 0x82, 0xea, 0xda, 0xbb, 0x77, 0x09, 0x9d, 0x52, 0xb6, 0xbb, 0x0f, 0x68,
-		0x0a, 0x8a, 0xaa, 0xeb, 0xe2, 0x46, 0xd3, 0x25, 0x15, 0x93, 0x61, 0x25, 0x78, 0x44, 0xcb, 0xb0,
-		0x1f, 0x3f, 0x6a, 0x1a, 0xbe, 0xe2, 0xf8, 0x45, 0xfd, 0xf1, 0xd4, 0xa2, 0xfe, 0x58, 0x77, 0x0a,
-		0xa9, 0xec, 0x9f, 0x86, 0x7d, 0x9a, 0xed, 0x59, 0x03, 0xad, 0xe8, 0x90, 0x8a, 0x43, 0x49, 0x19,
-		0xf5, 0x06, 0x83, 0x2a, 0x0f, 0xfa, 0x04, 0x1c, 0xf5, 0x57, 0x1f, 0xae, 0x7e, 0x6d, 0xa8, 0xda,
-		0xb6, 0xb5, 0xb9, 0x49, 0x8b, 0x02, 0x09, 0x4a, 0x3d, 0x45, 0x66, 0xf7, 0x16, 0xbe, 0x6e, 0xfa,
-		0x53, 0xd1, 0x05, 0x18, 0x6a, 0xe0, 0x86, 0x45, 0xd3, 0xf9, 0xd3, 0xec, 0x44, 0x1f, 0x6e, 0x58,
-		0x0a, 0x21, 0x43, 0x0a, 0x4c, 0xc4, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b, 0x23, 0x1e,
-		0x56, 0x19, 0x77, 0x22, 0x23, 0xe8, 0x3e, 0x4c, 0x36, 0x6d, 0xbc, 0x53, 0x53, 0x5b, 0xae, 0xe5,
-		0xe9, 0x1f, 0x76, 0x6b, 0x4d, 0xcb, 0x30, 0xdd, 0x20, 0xcb, 0xce, 0xdb, 0x2f, 0x07, 0xbb, 0x77,
-		0x09, 0x9d, 0x72, 0xc0, 0x9b, 0xbf, 0xd4, 0x72, 0xad, 0x9e, 0x41, 0x74, 0x19, 0x0a, 0x5b, 0x58,
-		0xd5, 0xb1, 0x4d, 0xd3, 0xdf, 0x47, 0xd9, 0x4d, 0x1d, 0x84, 0x44, 0xa1, 0xa4, 0xf2, 0xdb, 0x12,
-		0x3c, 0x23, 0x1e, 0xed, 0x5f, 0x81, 0x02, 0xb5, 0x17, 0x49, 0xc0, 0x5e, 0x28, 0x2d, 0x5a, 0x85,
-		0x99, 0xe4, 0x72, 0xaf, 0xa1, 0x13, 0xef, 0x9e, 0x57, 0x8e, 0xf1, 0x2b, 0xb5, 0x55, 0x5d, 0x7e,
-		0x4b, 0x82, 0xb3, 0x82, 0x41, 0xc3, 0x55, 0x28, 0x06, 0x9e, 0x42, 0x12, 0xf0, 0x14, 0x01, 0xf1,
-		0xc0, 0xa0, 0x5a, 0x30, 0x2b, 0x1c, 0x31, 0x2f, 0xc3, 0x28, 0x75, 0xd6, 0xdd, 0x83, 0x73, 0x8c,
-		0xa3, 0x04, 0xd4, 0x37, 0x93, 0x73, 0xb3, 0xec, 0x76, 0x1f, 0xe4, 0xdf, 0x4b, 0x70, 0x46, 0xa4,
-		0x69, 0x20, 0x7c, 0x02, 0x4a, 0xd9, 0x4e, 0xc0, 0xdb, 0x30, 0xc9, 0x39, 0x65, 0x72, 0x69, 0x06,
-		0x79, 0xc0, 0x61, 0x9c, 0x30, 0x3d, 0x9e, 0x26, 0x1f, 0xf2, 0x34, 0xf2, 0x6b, 0x12, 0xc8, 0xe9,
-		0xfd, 0x06, 0x68, 0x1e, 0x50, 0xb4, 0x06, 0xdd, 0xe9, 0x42, 0x1a, 0x77, 0x42, 0x22, 0x88, 0xb8,
-		0xdb, 0x5c, 0xc4, 0xdd, 0x1e, 0x07, 0x08, 0x12, 0x82, 0x86, 0x4e, 0xd0, 0x94, 0x94, 0x12, 0x1d,
-		0xa9, 0xea, 0xf2, 0x3f, 0x22, 0xe2, 0xe5, 0x5a, 0x48, 0x36, 0x44, 0xb3, 0x30, 0x1e, 0xce, 0x43,
-		0x74, 0xd4, 0x6b, 0xcc, 0xe9, 0x59, 0x71, 0x04, 0x7b, 0x3e, 0x82, 0xfd, 0x1c, 0xec, 0xdf, 0x30,
-		0x4c, 0xd5, 0xde, 0xad, 0x69, 0x5b, 0x58, 0xdb, 0x76, 0x5a, 0x0d, 0x12, 0xa2, 0x94, 0x94, 0x31,
-		0x7f, 0x78, 0x99, 0x8e, 0xa2, 0xf3, 0x30, 0x11, 0xce, 0x9e, 0xe1, 0x47, 0x7e, 0xf8, 0x31, 0xaa,
-		0x8c, 0xe3, 0xde, 0xa4, 0x16, 0x7e, 0xe4, 0xca, 0xaf, 0xe6, 0xe1, 0xb4, 0x40, 0x2b, 0xc3, 0x63,
-		0x5b, 0x71, 0xd4, 0x2c, 0xf2, 0x7d, 0x98, 0x05, 0x3a, 0x01, 0xe5, 0x0d, 0xd5, 0xc1, 0xc1, 0xd1,
-		0xe9, 0x8b, 0xa5, 0xe4, 0x0d, 0xf9, 0x07, 0xe6, 0x31, 0x00, 0x13, 0xb7, 0x83, 0xd7, 0xc3, 0xbe,
-		0x60, 0x4d, 0xdc, 0xf6, 0xdf, 0xce, 0x03, 0xda, 0xb4, 0xec, 0x6d, 0x8a, 0x34, 0xe8, 0x47, 0x2b,
-		0xf8, 0x4b, 0xf3, 0xde, 0x10, 0xac, 0x0f, 0x68, 0x63, 0xda, 0xa4, 0xe7, 0x1c, 0x55, 0xc7, 0x32,
-		0x69, 0x6c, 0x44, 0x9f, 0xd0, 0x2d, 0x18, 0xd6, 0xd4, 0x96, 0x83, 0x69, 0x18, 0x54, 0x11, 0x6e,
-		0x1a, 0x59, 0xf6, 0x66, 0x29, 0xfe, 0x64, 0xf9, 0xad, 0x3c, 0x9c, 0x4a, 0x6d, 0xe4, 0x78, 0x6c,
-		0x9b, 0x71, 0x33, 0x58, 0x83, 0xbf, 0x0b, 0xf3, 0x82, 0x7d, 0x26, 0xbd, 0x2b, 0xe8, 0xf5, 0xc9,
-		0x43, 0x59, 0x7c, 0x72, 0xaf, 0xea, 0x0f, 0x47, 0x54, 0x3f, 0xb2, 0xbf, 0x85, 0xe4, 0xfd, 0x2d,
-		0x0a, 0xed, 0xef, 0x08, 0x67, 0x7f, 0x19, 0x66, 0x56, 0x62, 0x99, 0x99, 0xfc, 0x8d, 0x02, 0x9c,
-		0x11, 0xe9, 0x71, 0x41, 0x27, 0xa1, 0xdc, 0x29, 0x14, 0xd3, 0x6d, 0x2a, 0x29, 0x10, 0x0c, 0x55,
-		0x75, 0xef, 0x52, 0xd5, 0xad, 0x24, 0x7b, 0x46, 0x90, 0x4b, 0xb8, 0x54, 0x75, 0x3e, 0x49, 0x2e,
-		0x55, 0x6a, 0xcf, 0x93, 0xa7, 0x9a, 0xba, 0xd5, 0x50, 0x0d, 0x93, 0xfa, 0x0e, 0xfa, 0x14, 0x3e,
-		0x0c, 0x86, 0xfa, 0xbc, 0x0e, 0x15, 0xc4, 0xaf, 0x43, 0xeb, 0x30, 0x1d, 0x28, 0x61, 0xfc, 0x0c,
-		0x29, 0xa6, 0x9d, 0x21, 0x93, 0xc1, 0xdc, 0xc8, 0x31, 0x12, 0xe1, 0x4a, 0x8f, 0x28, 0xca, 0x75,
-		0x24, 0x03, 0x57, 0xff, 0x16, 0x44, 0xb9, 0xf2, 0x0f, 0xbb, 0x52, 0x5f, 0x87, 0xdd, 0x2a, 0x4c,
-		0x6c, 0x61, 0xd5, 0x76, 0x37, 0xb0, 0xda, 0x45, 0x07, 0x69, 0xac, 0xc6, 0x3b, 0x73, 0xba, 0x7c,
-		0xd2, 0x43, 0x94, 0x72, 0x7a, 0x88, 0x12, 0xbb, 0x2b, 0x8c, 0xf6, 0x73, 0x57, 0xe8, 0xc6, 0x9c,
-		0xfb, 0xc4, 0x63, 0xce, 0xbf, 0x4b, 0x20, 0xa7, 0xf7, 0x5b, 0x7d, 0x60, 0x87, 0x7b, 0x6f, 0x18,
-		0x32, 0x14, 0xbe, 0xf0, 0xbc, 0x08, 0xa3, 0xe4, 0xbe, 0x18, 0xf8, 0xad, 0x61, 0x01, 0xbf, 0x55,
-		0xf6, 0x66, 0xd0, 0x07, 0xf9, 0x8f, 0x52, 0xd8, 0x15, 0x0c, 0x38, 0xb2, 0x66, 0x8b, 0x28, 0x97,
-		0xc1, 0xdd, 0xe7, 0x53, 0xa3, 0x8d, 0xa1, 0xb0, 0x30, 0xe5, 0x3f, 0x48, 0x70, 0x2a, 0xbd, 0x09,
-		0xa6, 0xdf, 0x00, 0xfc, 0xc3, 0x58, 0xd1, 0xcf, 0x73, 0x70, 0x5a, 0xa0, 0x95, 0xcc, 0x5b, 0x93,
-		0x8e, 0x5d, 0xd5, 0xa8, 0x3b, 0x42, 0x9b, 0x14, 0x10, 0x3f, 0xb6, 0x35, 0x45, 0x23, 0xa4, 0xa1,
-		0x7e, 0x22, 0xa4, 0x3d, 0xab, 0xf8, 0x57, 0x24, 0x98, 0x13, 0xef, 0x00, 0x13, 0x39, 0xf3, 0x06,
-		0x73, 0x05, 0x7b, 0x47, 0x82, 0x8c, 0xbd, 0x5e, 0xe9, 0xd8, 0x0e, 0x06, 0x61, 0x90, 0xef, 0x61,
-		0x68, 0x60, 0x23, 0x82, 0x38, 0x2f, 0x80, 0xf8, 0xcd, 0x88, 0x1e, 0xf2, 0xaa, 0x42, 0xfd, 0xea,
-		0xe1, 0x2a, 0xcc, 0xd4, 0x55, 0xb7, 0xa7, 0xe7, 0x21, 0xda, 0x01, 0xd0, 0x95, 0xac, 0x4f, 0xc7,
-		0xda, 0x4a, 0x3f, 0x6c, 0x62, 0xe8, 0x73, 0x3e, 0x83, 0x3e, 0x0f, 0xa5, 0xda, 0x68, 0x24, 0xd0,
-		0x93, 0xdf, 0x95, 0xe0, 0x68, 0x42, 0x97, 0x25, 0x9a, 0x86, 0x11, 0xbf, 0xbb, 0xac, 0xb3, 0x6f,
-		0x45, 0xf2, 0x5c, 0xd5, 0xd1, 0x1a, 0x1c, 0xea, 0x1c, 0xe4, 0x9b, 0x86, 0x9d, 0xe1, 0xd2, 0x8a,
-		0xe8, 0x39, 0xbe, 0x6a, 0xd8, 0x38, 0xcb, 0xf1, 0x2b, 0xb2, 0xd9, 0x9f, 0x81, 0x69, 0x6e, 0xfb,
-		0x66, 0xd2, 0x6a, 0x84, 0x63, 0x76, 0xf9, 0x37, 0x12, 0x1c, 0x4b, 0xea, 0xdc, 0x1b, 0xc8, 0x57,
-		0x06, 0x25, 0x8f, 0x44, 0x07, 0xfd, 0x13, 0x09, 0x66, 0xd2, 0x3a, 0x00, 0x93, 0x56, 0xf3, 0x58,
-		0xcd, 0x36, 0x11, 0xf9, 0xbf, 0x8b, 0x90, 0xb1, 0xd1, 0x04, 0x2d, 0xc0, 0x41, 0xd2, 0xcb, 0x12,
-		0x4d, 0xfb, 0xfa, 0x6b, 0x9a, 0x30, 0x71, 0x3b, 0x92, 0xf4, 0x8d, 0x55, 0x5e, 0x72, 0xfd, 0x55,
-		0x5e, 0x9e, 0xd4, 0x46, 0xc4, 0x6b, 0x23, 0x22, 0xba, 0x53, 0x14, 0xd0, 0x9d, 0x3b, 0x30, 0x49,
-		0x73, 0xda, 0x14, 0xa3, 0x61, 0xba, 0xd8, 0xde, 0x51, 0xeb, 0xe9, 0xf7, 0x96, 0x83, 0x74, 0x22,
-		0x81, 0x57, 0xa5, 0xd3, 0xc2, 0x75, 0x97, 0xd2, 0x9e, 0xea, 0x2e, 0x3d, 0x21, 0x1c, 0x64, 0x09,
-		0xe1, 0xf8, 0x45, 0x96, 0x72, 0xdf, 0x45, 0x96, 0xee, 0x3d, 0x63, 0x54, 0xf8, 0x9e, 0xd1, 0x49,
-		0xf5, 0xef, 0xdb, 0x43, 0xaa, 0x7f, 0x6c, 0x4f, 0xa9, 0x7e, 0xcf, 0x07, 0x2f, 0x64, 0xed, 0x76,
-		0xeb, 0x78, 0x2b, 0xa9, 0xd7, 0x5b, 0x25, 0xdd, 0x6f, 0x36, 0xe0, 0x70, 0xa7, 0x42, 0x1e, 0xa9,
-		0x9a, 0xfa, 0x76, 0x3c, 0x97, 0x58, 0x03, 0x0f, 0xd7, 0x4d, 0x0f, 0x61, 0xd6, 0xb0, 0xfc, 0x3d,
-		0x89, 0x91, 0xd2, 0xe6, 0x9d, 0x2c, 0x22, 0xe6, 0x21, 0x09, 0x98, 0x47, 0x4f, 0xa4, 0x93, 0xcb,
-		0x10, 0xe9, 0xc8, 0xef, 0x4b, 0x70, 0x3c, 0xb1, 0x5b, 0xdb, 0x0b, 0xf5, 0x68, 0x2f, 0xb8, 0xa9,
-		0x36, 0x02, 0x51, 0x83, 0x3f, 0x74, 0x5b, 0x6d, 0xe0, 0x7e, 0x3f, 0x3d, 0xb0, 0x53, 0xa5, 0xab,
-		0xf1, 0x43, 0xe2, 0x37, 0xeb, 0xaf, 0xb1, 0x36, 0x89, 0xd7, 0x9d, 0x70, 0x12, 0xca, 0xb4, 0x3f,
-		0xa4, 0x57, 0x04, 0xfe, 0x10, 0x11, 0x41, 0xc7, 0xa9, 0xe7, 0xc4, 0x9d, 0x7a, 0x42, 0x9e, 0x5a,
-		0xfe, 0xaa, 0x04, 0x73, 0x19, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x29, 0x94, 0x4f, 0xed, 0x77, 0x67,
-		0x92, 0xa0, 0xfd, 0x32, 0x07, 0x2f, 0xec, 0xad, 0x2b, 0x79, 0x60, 0x3a, 0xdf, 0xcd, 0xd5, 0xe5,
-		0x42, 0xb9, 0xba, 0xfb, 0x80, 0xe2, 0xdd, 0x2f, 0xd4, 0xbe, 0xcf, 0x8a, 0x75, 0xb8, 0x2a, 0x13,
-		0xb1, 0x16, 0x56, 0x34, 0x05, 0x45, 0xcd, 0x32, 0x5d, 0xdb, 0xaa, 0x13, 0x45, 0x1b, 0x55, 0x82,
-		0x47, 0x54, 0x81, 0x03, 0x91, 0x46, 0x2e, 0xcb, 0xac, 0xfb, 0x91, 0xf9, 0x88, 0x32, 0x11, 0xea,
-		0xaf, 0xba, 0x63, 0xd6, 0x77, 0xe5, 0x37, 0xf2, 0x70, 0x63, 0x0f, 0x5d, 0xcf, 0xe8, 0x7e, 0xaf,
-		0xdf, 0x1b, 0xe3, 0xfc, 0xa6, 0x40, 0x88, 0x73, 0x28, 0xed, 0x3c, 0xa0, 0xfb, 0x24, 0x37, 0x87,
-		0xca, 0xde, 0x97, 0xa1, 0xbd, 0xee, 0xcb, 0x3c, 0xa0, 0x68, 0xaf, 0x19, 0xad, 0x50, 0xe4, 0x95,
-		0x71, 0x23, 0xa4, 0x84, 0x7e, 0x0a, 0x2b, 0xd8, 0xc5, 0x42, 0x68, 0x17, 0xe5, 0x3f, 0x49, 0x70,
-		0xad, 0xcf, 0x96, 0x6d, 0x0e, 0x06, 0x89, 0x83, 0xe1, 0x83, 0x55, 0x5c, 0xf9, 0x4b, 0x79, 0xb8,
-		0xd6, 0x67, 0x5b, 0xdd, 0xff, 0xaa, 0xad, 0x46, 0x3c, 0xf6, 0x10, 0xdf, 0x63, 0x0f, 0x8b, 0x7b,
-		0x6c, 0xae, 0xea, 0xf0, 0x1c, 0x40, 0x91, 0xe7, 0x00, 0x5e, 0xcd, 0xc3, 0x95, 0x7e, 0x5a, 0x03,
-		0xc5, 0x2c, 0x5f, 0x88, 0xf3, 0x13, 0xcb, 0xef, 0x5a, 0xfe, 0x7b, 0x12, 0x5c, 0xcc, 0xda, 0xe6,
-		0xf8, 0x5f, 0x6d, 0xf2, 0xfc, 0xb3, 0x4a, 0xfe, 0x9d, 0x04, 0x17, 0x32, 0xb5, 0x46, 0x0e, 0xcc,
-		0x05, 0x30, 0x6f, 0x0d, 0xb9, 0xbd, 0xdd, 0x1a, 0xfe, 0x32, 0x02, 0x97, 0xfb, 0xf8, 0x8d, 0x47,
-		0xcf, 0x76, 0x48, 0xa1, 0xed, 0x38, 0x09, 0xe5, 0xce, 0x76, 0x50, 0x9d, 0x2f, 0x29, 0x10, 0x0c,
-		0xb1, 0x52, 0x08, 0xf9, 0x01, 0xa4, 0x10, 0xfa, 0xad, 0x27, 0x0e, 0x0f, 0x36, 0x85, 0x50, 0x78,
-		0xac, 0x29, 0x84, 0x62, 0xdf, 0x29, 0x84, 0x07, 0x40, 0x3b, 0x54, 0x29, 0x47, 0x5a, 0x86, 0xf3,
-		0x9b, 0x04, 0xce, 0x26, 0xb4, 0xb9, 0x12, 0x2e, 0xb4, 0x18, 0x37, 0xd1, 0x8c, 0x0e, 0xf5, 0x1a,
-		0x49, 0x29, 0xec, 0xcf, 0x45, 0x54, 0x1e, 0x04, 0x54, 0x5e, 0x83, 0xa9, 0x1e, 0x75, 0xaa, 0xd9,
-		0xb8, 0xd5, 0x85, 0x5f, 0x26, 0xf0, 0xe7, 0x12, 0x15, 0xa7, 0xaa, 0x2b, 0xde, 0x14, 0xba, 0x84,
-		0x43, 0x6d, 0xd6, 0x70, 0xac, 0x3c, 0xb9, 0xaf, 0x9f, 0xf2, 0x64, 0xac, 0xd7, 0x70, 0x8c, 0xd1,
-		0x6b, 0xd8, 0xbd, 0x69, 0xed, 0xcf, 0x9e, 0x5b, 0x18, 0xdf, 0x43, 0x6e, 0x61, 0x62, 0x6f, 0x6d,
-		0x84, 0xcf, 0x41, 0x59, 0xc7, 0x75, 0x75, 0xd7, 0x57, 0xcd, 0xf4, 0x9e, 0x48, 0x20, 0xd4, 0x44,
-		0x15, 0xe5, 0xd7, 0xf3, 0x70, 0x31, 0xeb, 0x6f, 0xb0, 0x3e, 0x7c, 0xf7, 0xb2, 0x16, 0xc4, 0x09,
-		0x7e, 0xa5, 0xeb, 0x6a, 0xe6, 0x1f, 0x10, 0x85, 0xc2, 0x83, 0x1e, 0x43, 0x19, 0x0e, 0x1b, 0x0a,
-		0xfb, 0x10, 0x2c, 0x70, 0x0e, 0xc1, 0x01, 0xe5, 0x02, 0xe5, 0xdf, 0xe6, 0x60, 0x3e, 0xcb, 0x0f,
-		0xcc, 0xb8, 0xfb, 0xc1, 0x3e, 0x7d, 0x73, 0x7b, 0x3d, 0x7d, 0x07, 0xb5, 0x8b, 0x6c, 0xe9, 0x0e,
-		0x71, 0xa4, 0xdb, 0xb5, 0xce, 0x61, 0xf1, 0x3c, 0xc8, 0xfb, 0x39, 0xc8, 0xf8, 0xd3, 0xb7, 0x8f,
-		0x86, 0x30, 0x59, 0x65, 0x9d, 0x61, 0x66, 0x59, 0xa7, 0xdb, 0x8f, 0x50, 0x10, 0xef, 0x47, 0x90,
-		0xff, 0x99, 0x83, 0xf3, 0x83, 0xf0, 0x28, 0x1f, 0x51, 0xa1, 0xf7, 0x64, 0xdc, 0x0b, 0x19, 0x32,
-		0xee, 0xf2, 0xbf, 0x72, 0x70, 0x21, 0xd3, 0x2f, 0x11, 0x9f, 0x08, 0x3e, 0x26, 0xf8, 0x20, 0xa5,
-		0x58, 0xc8, 0x92, 0x67, 0xfe, 0x7c, 0x9e, 0x27, 0x78, 0x5e, 0x0f, 0xc9, 0x13, 0xc1, 0x27, 0xb6,
-		0xb0, 0x14, 0xfa, 0xe9, 0x7d, 0xff, 0x45, 0x0e, 0x16, 0x32, 0xfe, 0x42, 0xf4, 0xc9, 0x3e, 0x84,
-		0xf6, 0x61, 0xce, 0x85, 0xfd, 0xe4, 0xcf, 0x55, 0xa3, 0xee, 0x62, 0x9b, 0x7c, 0xea, 0x38, 0x4c,
-		0xaf, 0x3c, 0x58, 0xb9, 0xbd, 0x5e, 0x5b, 0xad, 0xae, 0xad, 0xaf, 0x28, 0xb5, 0xf5, 0x4f, 0xde,
-		0x5d, 0xa9, 0x55, 0x6f, 0x3f, 0x58, 0x5a, 0xab, 0xde, 0x1a, 0x7f, 0x0a, 0x9d, 0x84, 0xa3, 0xf1,
-		0xd7, 0x4b, 0x6b, 0x6b, 0x35, 0x32, 0x3a, 0x2e, 0xa1, 0x53, 0x70, 0x3c, 0x4e, 0xb0, 0xbc, 0x76,
-		0xe7, 0xde, 0x0a, 0x25, 0xc9, 0xdd, 0x7c, 0x00, 0x87, 0x35, 0xab, 0xc1, 0x92, 0xc1, 0xcd, 0x91,
-		0xa5, 0xa6, 0x71, 0xd7, 0x0b, 0x61, 0xef, 0x4a, 0x9f, 0x5a, 0x78, 0x68, 0xb8, 0x5b, 0xad, 0x8d,
-		0x8a, 0x66, 0x35, 0x16, 0x42, 0xff, 0xe7, 0xb4, 0xf2, 0x10, 0x9b, 0xfe, 0x7f, 0x56, 0xa5, 0xff,
-		0xf2, 0xf4, 0x86, 0xda, 0x34, 0x76, 0x2e, 0x6d, 0x14, 0xc8, 0xd8, 0xe5, 0xff, 0x04, 0x00, 0x00,
-		0xff, 0xff, 0xee, 0x07, 0x38, 0xbd, 0xd5, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x51, 0x6c, 0x1c, 0x57,
+		0xd5, 0xee, 0xec, 0xda, 0x6b, 0xef, 0x59, 0xdb, 0xb1, 0xaf, 0x13, 0xc7, 0x76, 0xec, 0xc4, 0x9e,
+		0xa4, 0x89, 0xeb, 0x38, 0xeb, 0xc4, 0x49, 0x93, 0xa6, 0xf9, 0xdb, 0xfe, 0x8e, 0x63, 0x93, 0x15,
+		0x6e, 0x12, 0x4d, 0x9c, 0x14, 0x10, 0x68, 0x19, 0xcf, 0x5c, 0xc7, 0x23, 0xef, 0xee, 0x6c, 0x67,
+		0x66, 0xbd, 0x31, 0x12, 0x4f, 0x3c, 0x20, 0xa1, 0x56, 0x50, 0x55, 0x48, 0x14, 0x10, 0x20, 0x24,
+		0xa0, 0x45, 0x48, 0x45, 0x20, 0x04, 0x88, 0x17, 0x40, 0x42, 0x20, 0x81, 0x0a, 0x4f, 0xbc, 0xc0,
+		0x23, 0x0f, 0xf0, 0xc6, 0x03, 0xed, 0x1b, 0x12, 0x9a, 0x3b, 0x77, 0x76, 0x77, 0x66, 0xee, 0x9d,
+		0xb9, 0xb3, 0x76, 0x5a, 0x50, 0xf3, 0xe6, 0xb9, 0x73, 0xee, 0x99, 0xef, 0xdc, 0x7b, 0xce, 0xb9,
+		0xe7, 0x9e, 0x73, 0xd6, 0x30, 0xdb, 0xd8, 0xc4, 0xd6, 0xa2, 0xa6, 0xea, 0xb8, 0xa6, 0xe1, 0x45,
+		0xb5, 0x6e, 0x2c, 0xee, 0x5e, 0x58, 0xdc, 0x36, 0x6c, 0xc7, 0xb4, 0xf6, 0x8a, 0x75, 0xcb, 0x74,
+		0x4c, 0x34, 0xea, 0x92, 0x14, 0x29, 0x49, 0x51, 0xad, 0x1b, 0xc5, 0xdd, 0x0b, 0x93, 0xc7, 0x1f,
+		0x98, 0xe6, 0x83, 0x0a, 0x5e, 0x24, 0x24, 0x9b, 0x8d, 0xad, 0x45, 0xbd, 0x61, 0xa9, 0x8e, 0x61,
+		0xd6, 0xbc, 0x49, 0x93, 0x27, 0xc2, 0xef, 0x1d, 0xa3, 0x8a, 0x6d, 0x47, 0xad, 0xd6, 0x29, 0xc1,
+		0x0c, 0xeb, 0xc3, 0x9a, 0x59, 0xad, 0xb6, 0x58, 0xc8, 0x2c, 0x0a, 0x47, 0xb5, 0x77, 0x2a, 0x86,
+		0xed, 0xc4, 0xd1, 0x34, 0x4d, 0x6b, 0x67, 0xab, 0x62, 0x36, 0x3d, 0x1a, 0xf9, 0x06, 0xf4, 0xdd,
+		0xf4, 0x04, 0x42, 0x57, 0x21, 0x87, 0x77, 0x71, 0xcd, 0xb1, 0xc7, 0xa5, 0x99, 0xec, 0x5c, 0x61,
+		0x69, 0xb6, 0xc8, 0x90, 0xad, 0x48, 0xa9, 0x57, 0x5d, 0x4a, 0x85, 0x4e, 0x90, 0xdf, 0x7b, 0x06,
+		0x06, 0x3a, 0x5f, 0xa0, 0x09, 0xe8, 0x27, 0xaf, 0xca, 0x86, 0x3e, 0x2e, 0xcd, 0x48, 0x73, 0x59,
+		0xa5, 0x8f, 0x3c, 0x97, 0x74, 0x74, 0x15, 0xc0, 0x7b, 0xe5, 0x0a, 0x3d, 0x9e, 0x99, 0x91, 0xe6,
+		0x0a, 0x4b, 0x93, 0x45, 0x6f, 0x45, 0x8a, 0xfe, 0x8a, 0x14, 0x37, 0xfc, 0x15, 0x51, 0xf2, 0x84,
+		0xda, 0x7d, 0x46, 0xe3, 0xd0, 0xb7, 0x8b, 0x2d, 0xdb, 0x30, 0x6b, 0xe3, 0x59, 0x8f, 0x29, 0x7d,
+		0x44, 0x47, 0xa1, 0xcf, 0x15, 0xde, 0xfd, 0x5c, 0x0f, 0x79, 0x93, 0x73, 0x1f, 0x4b, 0x3a, 0xfa,
+		0xba, 0x04, 0x67, 0x7d, 0x91, 0xcb, 0xf8, 0x21, 0xd6, 0x1a, 0xee, 0x3e, 0x94, 0x6d, 0x47, 0xb5,
+		0x1c, 0xac, 0x97, 0x3d, 0x24, 0xaa, 0xe3, 0x58, 0xc6, 0x66, 0xc3, 0xc1, 0xf6, 0x78, 0x2f, 0xc1,
+		0xf3, 0x7f, 0x4c, 0xd1, 0x5f, 0xa2, 0x7c, 0x56, 0x7d, 0x36, 0x77, 0x3d, 0x2e, 0x44, 0xe4, 0xe5,
+		0x16, 0x8f, 0x9b, 0x4f, 0x28, 0x67, 0x9a, 0x62, 0xa4, 0xe8, 0xdb, 0x12, 0x9c, 0x63, 0xc0, 0xd3,
+		0xcc, 0x6a, 0xbd, 0x82, 0x99, 0x00, 0x73, 0x04, 0xe0, 0xf3, 0x62, 0x00, 0x57, 0x7c, 0x3e, 0x51,
+		0x88, 0x4f, 0x35, 0x45, 0x89, 0xd1, 0x1b, 0x12, 0xcc, 0x33, 0x40, 0x6e, 0xa9, 0x46, 0x85, 0x85,
+		0xb0, 0x8f, 0x20, 0xbc, 0x26, 0x86, 0x70, 0x8d, 0x30, 0x89, 0xc2, 0x3b, 0xdd, 0x14, 0xa2, 0x44,
+		0xdf, 0x62, 0x2f, 0xa0, 0xab, 0x5b, 0x7a, 0xd9, 0x6c, 0x38, 0x51, 0x78, 0xfd, 0x04, 0xde, 0x73,
+		0x62, 0xf0, 0x5c, 0xb5, 0xd3, 0x6f, 0x37, 0x9c, 0x28, 0xc0, 0xb9, 0xa6, 0x20, 0x2d, 0x7a, 0x5d,
+		0x82, 0x39, 0x1d, 0x6b, 0x86, 0x4d, 0x80, 0xb9, 0x5a, 0x6a, 0x6b, 0xdb, 0x58, 0x6f, 0x30, 0x17,
+		0x2f, 0x4f, 0xd0, 0x5d, 0x65, 0xa2, 0xbb, 0x41, 0x99, 0x6c, 0xa8, 0xf6, 0xce, 0x5d, 0x9f, 0x45,
+		0x14, 0xd9, 0x29, 0x5d, 0x80, 0x0e, 0xbd, 0x2a, 0xc1, 0xe9, 0x10, 0x2a, 0x9e, 0x4d, 0x00, 0xc1,
+		0x74, 0x25, 0x19, 0x13, 0xcf, 0x1c, 0x64, 0x3d, 0x91, 0x8a, 0xb1, 0x4a, 0x31, 0x46, 0x50, 0x10,
+		0x5c, 0xa5, 0x18, 0xfd, 0x3f, 0xa5, 0x0b, 0xd0, 0xa1, 0xd7, 0x22, 0xa8, 0x62, 0x34, 0x6b, 0x80,
+		0xa0, 0x7a, 0x26, 0x11, 0x15, 0x5f, 0xa9, 0x4e, 0xea, 0xc9, 0x64, 0xe8, 0x0b, 0x12, 0x3c, 0x19,
+		0xc4, 0xc4, 0xb3, 0xc4, 0x41, 0x02, 0xe8, 0x72, 0x22, 0x20, 0x9e, 0x11, 0xce, 0xea, 0x49, 0x44,
+		0x64, 0xdb, 0x54, 0xcd, 0x31, 0x76, 0x0d, 0x67, 0x2f, 0x51, 0xb9, 0x87, 0x62, 0xb6, 0x6d, 0x99,
+		0x32, 0x49, 0x52, 0x6e, 0x55, 0x80, 0x8e, 0x28, 0x77, 0x08, 0x15, 0x4f, 0xb9, 0x0f, 0xc5, 0x28,
+		0x77, 0x00, 0x13, 0x57, 0xb9, 0xd5, 0x44, 0x2a, 0xc6, 0x2a, 0xc5, 0x28, 0xf7, 0xb0, 0xe0, 0x2a,
+		0xc5, 0x29, 0xb7, 0x2a, 0x40, 0x47, 0x14, 0x29, 0x88, 0x8a, 0xa7, 0x48, 0x23, 0x31, 0x8a, 0xd4,
+		0x09, 0x89, 0xab, 0x48, 0x6a, 0x12, 0x11, 0xb1, 0xb4, 0x20, 0x98, 0x18, 0x4b, 0x43, 0x31, 0x96,
+		0xd6, 0x89, 0x27, 0xc6, 0xd2, 0xd4, 0x64, 0x32, 0xd4, 0x84, 0xe3, 0x2e, 0x08, 0x8b, 0xaf, 0x3d,
+		0xa3, 0x04, 0xc8, 0x79, 0x26, 0x10, 0x97, 0xab, 0xc5, 0x55, 0x9b, 0x63, 0x0e, 0xff, 0x35, 0x7a,
+		0x19, 0xa6, 0xbc, 0x0f, 0x6f, 0x19, 0x16, 0xeb, 0xb3, 0x87, 0xc9, 0x67, 0x8b, 0xfc, 0xcf, 0xae,
+		0x19, 0x56, 0x84, 0xeb, 0xcd, 0x27, 0x94, 0x09, 0x87, 0xf7, 0x12, 0x7d, 0x57, 0x82, 0xc5, 0x90,
+		0x8a, 0xaa, 0x35, 0x0d, 0x57, 0xca, 0x16, 0x7e, 0xb9, 0x81, 0x6d, 0xa6, 0xf4, 0x47, 0x08, 0x8c,
+		0x17, 0x92, 0x35, 0x95, 0x70, 0x52, 0x7c, 0x46, 0x51, 0x5c, 0xf3, 0xaa, 0x30, 0x35, 0xfa, 0xb1,
+		0x04, 0x97, 0x28, 0x26, 0x1f, 0xa2, 0x98, 0x12, 0x8f, 0x11, 0xb4, 0x2b, 0x4c, 0xb4, 0xf4, 0x6b,
+		0xde, 0xa7, 0x45, 0x34, 0xba, 0x68, 0xa5, 0x9a, 0x81, 0xbe, 0x24, 0xc1, 0x19, 0xd6, 0xf2, 0xb2,
+		0x80, 0x1e, 0x15, 0xd4, 0xee, 0x15, 0xca, 0x21, 0x41, 0xbb, 0x39, 0x64, 0xe8, 0x33, 0x70, 0xc2,
+		0x53, 0x32, 0x3e, 0x92, 0x71, 0x82, 0xe4, 0x02, 0x5f, 0xcf, 0xf8, 0x10, 0xa6, 0x9c, 0x98, 0xf7,
+		0xe8, 0xf3, 0x12, 0x9c, 0xa2, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0x13, 0x04, 0xc1, 0xd3, 0x4c,
+		0x04, 0x1e, 0x73, 0x4f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0x25, 0xd0, 0xa0, 0xcf, 0xc2, 0x4c, 0x55,
+		0xb5, 0x76, 0xb0, 0x55, 0xb6, 0xb0, 0x66, 0x5a, 0x3a, 0x0b, 0xc4, 0x24, 0x01, 0xb1, 0xc4, 0x04,
+		0xf1, 0x22, 0x99, 0xac, 0xd0, 0xb9, 0x51, 0x04, 0xd3, 0xd5, 0x38, 0x02, 0xf4, 0x4d, 0x09, 0x16,
+		0x58, 0xf7, 0x13, 0xe3, 0x41, 0x4d, 0x65, 0x2e, 0xc8, 0xb1, 0x34, 0xe1, 0xeb, 0x5d, 0xca, 0x46,
+		0x24, 0x7c, 0xe5, 0xd0, 0xa2, 0xef, 0x48, 0x50, 0x64, 0x20, 0x74, 0xb0, 0x55, 0x35, 0x6a, 0x2a,
+		0xd3, 0x2f, 0x4c, 0xc5, 0xf8, 0x85, 0x68, 0x88, 0xdd, 0x62, 0xc4, 0xf0, 0x0b, 0x4d, 0x61, 0x6a,
+		0xf4, 0x13, 0x09, 0x2e, 0xb1, 0xae, 0x52, 0x89, 0x5e, 0x6c, 0x9a, 0xa0, 0xbd, 0x21, 0x78, 0xa3,
+		0x4a, 0x72, 0x65, 0x8b, 0xcd, 0x74, 0x53, 0x78, 0x1a, 0xc0, 0x37, 0xca, 0xe3, 0x69, 0x34, 0x80,
+		0x6f, 0xa0, 0x73, 0x4d, 0x41, 0x5a, 0xf4, 0x37, 0x09, 0x56, 0x43, 0x1e, 0x17, 0x3f, 0x74, 0xb0,
+		0x55, 0x53, 0x2b, 0x65, 0x06, 0x72, 0xa3, 0x66, 0x38, 0x06, 0x5b, 0x31, 0x4e, 0x10, 0xe8, 0x77,
+		0x93, 0x5d, 0xf0, 0x2a, 0xe5, 0x1f, 0x91, 0xa7, 0xe4, 0x33, 0x8f, 0x0a, 0xf4, 0xbc, 0xb5, 0x2f,
+		0x0e, 0xe8, 0x2f, 0x12, 0x5c, 0x4f, 0x21, 0x26, 0xcf, 0x63, 0xcd, 0x10, 0x19, 0xef, 0xec, 0x43,
+		0x46, 0x9e, 0x33, 0xbb, 0x66, 0x75, 0x3f, 0x1d, 0xbd, 0x23, 0xc1, 0x73, 0x71, 0xe2, 0x24, 0xdb,
+		0xc9, 0x2c, 0x11, 0x6c, 0x9d, 0x29, 0x18, 0x17, 0x4c, 0xa2, 0xbd, 0x5c, 0xc1, 0xdd, 0x4d, 0x25,
+		0x71, 0x00, 0x4b, 0x0e, 0xb3, 0xe6, 0x18, 0xb5, 0x06, 0xd6, 0xcb, 0xaa, 0x5d, 0xae, 0xe1, 0x66,
+		0x54, 0x0e, 0x39, 0x26, 0x0e, 0x88, 0x82, 0xf0, 0xd9, 0x2d, 0xdb, 0xb7, 0x70, 0x33, 0x0a, 0xbf,
+		0xd8, 0x4c, 0x35, 0x03, 0xfd, 0x5a, 0x82, 0xab, 0x24, 0x9a, 0x2c, 0x6b, 0xdb, 0x46, 0x45, 0x4f,
+		0x69, 0x3f, 0x27, 0x09, 0xf4, 0x9b, 0x4c, 0xe8, 0x24, 0x94, 0x5c, 0x71, 0x99, 0xa6, 0x31, 0x9a,
+		0x8b, 0x76, 0xfa, 0x69, 0xe8, 0xe7, 0x12, 0x5c, 0x4e, 0x10, 0x82, 0x67, 0x1d, 0xa7, 0x88, 0x04,
+		0xab, 0x69, 0x25, 0xe0, 0x99, 0xc4, 0x79, 0x3b, 0xe5, 0x1c, 0xf4, 0x03, 0x09, 0x2e, 0x70, 0x51,
+		0x73, 0xe3, 0xfc, 0x27, 0x09, 0xec, 0x65, 0x76, 0x18, 0xc2, 0xfc, 0x3a, 0x37, 0xf0, 0x5f, 0xd0,
+		0x52, 0xd0, 0xa3, 0x1f, 0x49, 0x70, 0x91, 0x0b, 0x37, 0xe6, 0x12, 0x79, 0x3a, 0x46, 0xc9, 0xd9,
+		0x80, 0x63, 0xae, 0x93, 0x45, 0x2d, 0xd5, 0x0c, 0xf4, 0x96, 0x04, 0xe7, 0x53, 0x6b, 0xc6, 0x19,
+		0x82, 0xf8, 0xff, 0x53, 0x20, 0xe6, 0x29, 0xc5, 0x59, 0x2d, 0x85, 0x3e, 0xbc, 0x2d, 0xc1, 0x12,
+		0x7f, 0x81, 0xb9, 0x87, 0xf0, 0x1c, 0x41, 0x7b, 0x3d, 0xcd, 0xfa, 0x72, 0x4f, 0xe2, 0x73, 0x5a,
+		0x9a, 0x09, 0xe8, 0x87, 0x71, 0x2a, 0x11, 0x73, 0x69, 0x7e, 0x2a, 0x35, 0x64, 0xfe, 0xf5, 0xf9,
+		0x9c, 0x96, 0x66, 0x02, 0x89, 0xcd, 0xf8, 0x90, 0x63, 0x22, 0xc9, 0xf9, 0x98, 0xd8, 0x8c, 0x83,
+		0x39, 0x26, 0x9c, 0x5c, 0xd4, 0xd2, 0x4d, 0x21, 0x87, 0xa6, 0x17, 0x8a, 0x77, 0x1b, 0xf1, 0x9c,
+		0x8d, 0x39, 0x34, 0xbd, 0x88, 0xbb, 0x9b, 0x50, 0xe7, 0x8a, 0xdd, 0xdd, 0x54, 0xf4, 0x1b, 0x09,
+		0x9e, 0x15, 0x10, 0x88, 0x67, 0xa3, 0x0b, 0x44, 0x9a, 0x52, 0x37, 0xd2, 0xf0, 0x8c, 0xf5, 0x92,
+		0xdd, 0xc5, 0x3c, 0xf4, 0x33, 0x09, 0x9e, 0x8e, 0x13, 0x80, 0x7f, 0x7f, 0x3a, 0x17, 0x73, 0x00,
+		0x71, 0x41, 0xf0, 0xef, 0x51, 0xe7, 0x71, 0xca, 0x39, 0xc4, 0xe1, 0x34, 0xea, 0x36, 0xb6, 0x9c,
+		0x36, 0x70, 0x1b, 0xab, 0x96, 0xb6, 0xdd, 0x01, 0x33, 0x8a, 0xbb, 0x18, 0x63, 0xbd, 0xf7, 0x08,
+		0x3b, 0x1f, 0xc1, 0x5d, 0xc2, 0xac, 0xfd, 0x45, 0x86, 0xf5, 0x36, 0xd2, 0x4c, 0x20, 0x79, 0xc2,
+		0x30, 0xe2, 0x2a, 0xae, 0x9a, 0x51, 0x90, 0x8b, 0x31, 0x79, 0xc2, 0x20, 0xc8, 0x17, 0x71, 0xd5,
+		0x64, 0xe4, 0x09, 0x1b, 0x49, 0x44, 0xd7, 0x07, 0x00, 0xda, 0x1f, 0x94, 0xbf, 0x37, 0x08, 0x67,
+		0x44, 0x8f, 0xd2, 0x35, 0x18, 0x6c, 0xc1, 0x77, 0xf6, 0xea, 0x98, 0x14, 0x26, 0x79, 0x65, 0x4e,
+		0x9f, 0xe9, 0xc6, 0x5e, 0x1d, 0x2b, 0x03, 0xcd, 0x8e, 0x27, 0xf4, 0x49, 0x38, 0x52, 0x57, 0x2d,
+		0x57, 0xf2, 0x4e, 0x0f, 0xb0, 0x65, 0xd2, 0x5a, 0xe6, 0x1c, 0x93, 0xdf, 0x1d, 0x32, 0xa3, 0xc3,
+		0x40, 0xb7, 0x4c, 0x65, 0xb4, 0x1e, 0x1d, 0x44, 0xcf, 0x42, 0x9e, 0xa4, 0x87, 0x2a, 0x86, 0xed,
+		0x90, 0x2a, 0x67, 0x61, 0x69, 0x9a, 0x9d, 0x7f, 0x51, 0xed, 0x9d, 0x75, 0xc3, 0x76, 0x94, 0x7e,
+		0x87, 0xfe, 0x85, 0x96, 0xa0, 0xd7, 0xa8, 0xd5, 0x1b, 0x0e, 0xa9, 0x81, 0x16, 0x96, 0xa6, 0x38,
+		0x48, 0xf6, 0x2a, 0xa6, 0xaa, 0x2b, 0x1e, 0x29, 0x52, 0x61, 0x26, 0x14, 0xff, 0x94, 0x1d, 0xb3,
+		0xac, 0x55, 0x4c, 0x1b, 0x93, 0xc3, 0xc4, 0x6c, 0x38, 0xb4, 0x28, 0x3a, 0x11, 0x29, 0xd2, 0xde,
+		0xa0, 0x65, 0x6d, 0x65, 0x0a, 0x07, 0xd6, 0x7e, 0xc3, 0x5c, 0x71, 0xe7, 0x6f, 0x78, 0xd3, 0xd1,
+		0x4b, 0x70, 0xac, 0x9d, 0x83, 0x8f, 0x72, 0xcf, 0x25, 0x71, 0x3f, 0xea, 0xf8, 0x99, 0xf5, 0x10,
+		0xe3, 0x6b, 0x30, 0xd9, 0x0e, 0xf7, 0xdb, 0x52, 0x58, 0x8d, 0x9a, 0x5b, 0x08, 0x76, 0xeb, 0x90,
+		0x79, 0xe5, 0x68, 0x8b, 0xa2, 0xb5, 0xce, 0x4a, 0xa3, 0x56, 0xd2, 0x51, 0x09, 0xf2, 0xd4, 0x6f,
+		0x9b, 0x16, 0x29, 0x0a, 0x0e, 0x2d, 0x9d, 0x65, 0x9f, 0x33, 0x94, 0x01, 0x89, 0xe7, 0x4b, 0xfe,
+		0x14, 0xa5, 0x3d, 0x1b, 0x95, 0x60, 0xa4, 0x8d, 0xc3, 0xf5, 0x9d, 0x0d, 0x0b, 0x8f, 0xe7, 0x63,
+		0xf6, 0x60, 0xcd, 0xa3, 0x51, 0x86, 0x5b, 0xd3, 0xe8, 0x08, 0x52, 0x60, 0xac, 0xa2, 0xba, 0x17,
+		0x50, 0x2f, 0xb6, 0x22, 0xe2, 0x60, 0xbb, 0x51, 0x71, 0xc6, 0x21, 0x86, 0x9f, 0xbf, 0xa7, 0x87,
+		0xdd, 0xb9, 0x2b, 0xad, 0xa9, 0x0a, 0x99, 0x89, 0xae, 0xc2, 0x84, 0x69, 0x19, 0x0f, 0x0c, 0xcf,
+		0xeb, 0x87, 0x56, 0xa9, 0x40, 0x56, 0x69, 0xcc, 0x27, 0x08, 0x2d, 0xd2, 0x24, 0xf4, 0x1b, 0x3a,
+		0xae, 0x39, 0x86, 0xb3, 0x47, 0xca, 0x5b, 0x79, 0xa5, 0xf5, 0x8c, 0x2e, 0xc2, 0xd8, 0x96, 0x61,
+		0xd9, 0x4e, 0x94, 0xe7, 0x20, 0xa1, 0x1c, 0x25, 0x6f, 0x43, 0x0c, 0x57, 0x60, 0xc0, 0xc2, 0x8e,
+		0xb5, 0x57, 0xae, 0x9b, 0x15, 0x43, 0xdb, 0xa3, 0x25, 0xa1, 0x19, 0xce, 0x6d, 0xd9, 0xb1, 0xf6,
+		0xee, 0x10, 0x3a, 0xa5, 0x60, 0xb5, 0x1f, 0xdc, 0x3e, 0x00, 0xd5, 0x71, 0x70, 0xb5, 0xee, 0x90,
+		0xf2, 0x4d, 0xaf, 0xe2, 0x3f, 0xa2, 0x15, 0x38, 0x84, 0x1f, 0xd6, 0x0d, 0x4f, 0x71, 0xbc, 0x0e,
+		0x83, 0xe1, 0xc4, 0x0e, 0x83, 0xa1, 0xf6, 0x14, 0x77, 0x10, 0x9d, 0x84, 0x41, 0xcd, 0x72, 0xad,
+		0x81, 0x96, 0x97, 0x48, 0xf9, 0x23, 0xaf, 0x0c, 0xb8, 0x83, 0x7e, 0xc9, 0x09, 0x7d, 0x0c, 0x8e,
+		0x79, 0xd2, 0x07, 0x4b, 0x71, 0x9b, 0xaa, 0xb6, 0x63, 0x6e, 0x6d, 0x8d, 0xa3, 0x24, 0xa5, 0x1e,
+		0x27, 0xb3, 0x3b, 0xab, 0x70, 0xd7, 0xbd, 0xa9, 0xe8, 0x1c, 0xf4, 0xb8, 0xde, 0x95, 0xd6, 0x16,
+		0x26, 0xd8, 0x59, 0x47, 0x5c, 0x35, 0x15, 0x42, 0x86, 0x14, 0x18, 0x89, 0x1c, 0x1f, 0xb4, 0x40,
+		0xf0, 0x24, 0xfb, 0xa0, 0x0e, 0xb9, 0x7b, 0x65, 0xd8, 0x0e, 0x8d, 0xa0, 0x7b, 0x30, 0x56, 0xb7,
+		0xf0, 0x6e, 0x59, 0x6d, 0x38, 0xa6, 0xab, 0x7f, 0xd8, 0x29, 0xd7, 0x4d, 0xa3, 0xe6, 0xf8, 0x29,
+		0x7f, 0xde, 0x7e, 0xd9, 0xd8, 0xb9, 0x43, 0xe8, 0x94, 0x51, 0x77, 0xfe, 0x72, 0xc3, 0x31, 0x3b,
+		0x06, 0xd1, 0x45, 0xc8, 0x6d, 0x63, 0x55, 0xc7, 0x16, 0xcd, 0xc5, 0x1f, 0x63, 0x77, 0x98, 0x10,
+		0x12, 0x85, 0x92, 0xba, 0x2a, 0x58, 0xb7, 0x0c, 0xd3, 0x72, 0x55, 0xf0, 0x28, 0xd9, 0xed, 0xd6,
+		0x33, 0x9a, 0x85, 0x81, 0x2d, 0xd5, 0xb0, 0x6a, 0xd8, 0xb6, 0xcb, 0x3b, 0x78, 0x8f, 0xe4, 0xab,
+		0xf3, 0x4a, 0xc1, 0x1f, 0xfb, 0x28, 0xde, 0x43, 0x67, 0xe0, 0x90, 0x61, 0x9b, 0x15, 0x4f, 0x21,
+		0x1e, 0x58, 0x66, 0xa3, 0x4e, 0x72, 0xca, 0x79, 0x65, 0xa8, 0x35, 0xfc, 0x11, 0x77, 0x14, 0x7d,
+		0x0a, 0xa6, 0x3d, 0xcd, 0xc4, 0x15, 0xb5, 0x6e, 0x13, 0x87, 0x12, 0x54, 0xa4, 0xc9, 0x44, 0x45,
+		0x9a, 0x24, 0x0c, 0x56, 0xbd, 0xf9, 0xab, 0x01, 0xa5, 0x92, 0xdf, 0x92, 0xe0, 0x29, 0xf1, 0x1b,
+		0xd4, 0x25, 0xc8, 0x51, 0xb3, 0x97, 0x04, 0xcc, 0x9e, 0xd2, 0xa2, 0x35, 0x98, 0x89, 0x2f, 0xa1,
+		0x1b, 0x3a, 0x39, 0xa4, 0xb2, 0xca, 0x14, 0xbf, 0xfa, 0x5d, 0xd2, 0xe5, 0x37, 0x25, 0x38, 0x2d,
+		0x18, 0x88, 0x5d, 0x86, 0x3e, 0xdf, 0xe1, 0x49, 0x02, 0x0e, 0xcf, 0x27, 0x3e, 0x30, 0xa8, 0x26,
+		0xcc, 0x09, 0xdf, 0x42, 0x56, 0x60, 0x80, 0x9e, 0x39, 0xed, 0xf3, 0x7f, 0x88, 0xa3, 0xcb, 0xf4,
+		0x88, 0x21, 0xc7, 0x7f, 0xc1, 0x69, 0x3f, 0xc8, 0x7f, 0x90, 0xe0, 0x94, 0x48, 0x23, 0x46, 0xf0,
+		0x20, 0x97, 0xd2, 0x1d, 0xe4, 0xb7, 0x60, 0x8c, 0x73, 0x58, 0x66, 0x92, 0xfc, 0xca, 0xa8, 0xcd,
+		0x38, 0x28, 0x3b, 0x1c, 0x66, 0x36, 0xe0, 0x30, 0xe5, 0x57, 0x25, 0x90, 0x93, 0x7b, 0x38, 0xd0,
+		0x02, 0xa0, 0x70, 0x5d, 0xbf, 0xd5, 0xd9, 0x35, 0x6c, 0x07, 0x96, 0x20, 0x74, 0x6a, 0x64, 0x42,
+		0xa7, 0xc6, 0x34, 0x80, 0x9f, 0x64, 0x35, 0x74, 0x82, 0x26, 0xaf, 0xe4, 0xe9, 0x48, 0x49, 0x97,
+		0xff, 0x19, 0x5a, 0x5e, 0xae, 0x85, 0xa4, 0x43, 0x34, 0x07, 0xc3, 0xc1, 0xdc, 0x4e, 0x4b, 0xbd,
+		0x86, 0xec, 0x0e, 0x89, 0x43, 0xd8, 0xb3, 0x21, 0xec, 0x67, 0xe0, 0xd0, 0xa6, 0x51, 0x53, 0xad,
+		0xbd, 0xb2, 0xb6, 0x8d, 0xb5, 0x1d, 0xbb, 0x51, 0x25, 0x91, 0x56, 0x5e, 0x19, 0xf2, 0x86, 0x57,
+		0xe8, 0x28, 0x3a, 0x0b, 0x23, 0xc1, 0x8c, 0x24, 0x7e, 0xe8, 0x45, 0x51, 0x03, 0xca, 0x30, 0xee,
+		0x4c, 0x14, 0xe2, 0x87, 0x8e, 0xfc, 0x4a, 0x16, 0x4e, 0x0a, 0xb4, 0x87, 0x3c, 0x32, 0x89, 0xc3,
+		0x66, 0x91, 0xed, 0xc2, 0x2c, 0xd0, 0x71, 0x28, 0x6c, 0xaa, 0x36, 0xf6, 0x23, 0x00, 0x6f, 0x59,
+		0xf2, 0xee, 0x90, 0x77, 0xee, 0x4f, 0x01, 0xb8, 0xc9, 0x58, 0xfa, 0xba, 0xd7, 0x5b, 0xd8, 0x1a,
+		0x6e, 0x7a, 0x6f, 0x17, 0x00, 0x6d, 0x99, 0xd6, 0x0e, 0x45, 0xea, 0xf7, 0xf8, 0xe5, 0x3c, 0xd1,
+		0xdc, 0x37, 0x04, 0xeb, 0x7d, 0x6f, 0x1c, 0x8d, 0xb9, 0xce, 0x51, 0xb5, 0xcd, 0x1a, 0x0d, 0xf1,
+		0xe8, 0x13, 0xba, 0x01, 0xbd, 0x9a, 0xda, 0xb0, 0x31, 0x8d, 0xe6, 0x8a, 0xc2, 0x8d, 0x38, 0x2b,
+		0xee, 0x2c, 0xc5, 0x9b, 0x2c, 0xbf, 0x99, 0x85, 0xd9, 0xc4, 0xe6, 0x98, 0x47, 0xb6, 0x19, 0xd7,
+		0x7d, 0x19, 0xbc, 0x5d, 0x58, 0x10, 0xec, 0xdd, 0xe9, 0x94, 0xa0, 0xd3, 0x27, 0xf7, 0xa4, 0xf1,
+		0xc9, 0x9d, 0xaa, 0xdf, 0x1b, 0x52, 0xfd, 0xd0, 0xfe, 0xe6, 0xe2, 0xf7, 0xb7, 0x4f, 0x68, 0x7f,
+		0xfb, 0x39, 0xfb, 0xcb, 0x30, 0xb3, 0x3c, 0xcb, 0xcc, 0xe4, 0xbf, 0xe6, 0xe0, 0x94, 0x48, 0xdf,
+		0x10, 0x3a, 0x01, 0x85, 0x56, 0xf1, 0x9d, 0x6e, 0x53, 0x5e, 0x01, 0x7f, 0xa8, 0xa4, 0xbb, 0x77,
+		0xc3, 0x16, 0x01, 0x31, 0x82, 0x4c, 0xcc, 0xdd, 0xb0, 0xf5, 0x49, 0x72, 0x37, 0x54, 0x3b, 0x9e,
+		0x5c, 0xd5, 0xd4, 0xcd, 0xaa, 0x6a, 0xd4, 0xa8, 0xef, 0xa0, 0x4f, 0xc1, 0xc3, 0xa0, 0xa7, 0xcb,
+		0x5b, 0x5d, 0x4e, 0xfc, 0x56, 0xb7, 0x01, 0x13, 0xbe, 0x12, 0x46, 0xcf, 0x90, 0xbe, 0xa4, 0x33,
+		0x64, 0xcc, 0x9f, 0x1b, 0x3a, 0x46, 0x42, 0x5c, 0xe9, 0x11, 0x45, 0xb9, 0xf6, 0xa7, 0xe0, 0xea,
+		0x5d, 0xe6, 0x28, 0x57, 0xfe, 0x61, 0x97, 0xef, 0xea, 0xb0, 0x5b, 0x83, 0x91, 0x6d, 0xac, 0x5a,
+		0xce, 0x26, 0x56, 0xdb, 0xe8, 0x20, 0x89, 0xd5, 0x70, 0x6b, 0x4e, 0x9b, 0x4f, 0x72, 0x88, 0x52,
+		0x48, 0x0e, 0x51, 0x22, 0x57, 0x9e, 0x81, 0x6e, 0xae, 0x3c, 0xed, 0xd0, 0x79, 0xb0, 0xbb, 0xd0,
+		0x79, 0x28, 0x21, 0x74, 0x3e, 0x14, 0x09, 0x9d, 0xe5, 0xbf, 0x4b, 0x20, 0x27, 0xb7, 0xc0, 0xbd,
+		0x6f, 0xb1, 0x41, 0x67, 0x14, 0xd3, 0x13, 0xbc, 0xf6, 0xbd, 0x00, 0x03, 0xe4, 0xd6, 0xec, 0xbb,
+		0xbd, 0x5e, 0x01, 0xb7, 0x57, 0x70, 0x67, 0xd0, 0x07, 0xf9, 0x4f, 0x52, 0xd0, 0x93, 0x1c, 0x70,
+		0x60, 0xce, 0x5e, 0xa2, 0x4c, 0x8a, 0xd3, 0x22, 0x9b, 0x18, 0xac, 0xf4, 0x04, 0x17, 0x53, 0xfe,
+		0xa3, 0x04, 0xb3, 0xc9, 0x7d, 0x49, 0xdd, 0xc6, 0xef, 0x1f, 0x84, 0x44, 0xbf, 0xc8, 0xc0, 0x49,
+		0x81, 0xee, 0x3e, 0x57, 0x26, 0x1d, 0x3b, 0xaa, 0x51, 0xb1, 0x85, 0x36, 0xc9, 0x27, 0x7e, 0x64,
+		0x32, 0x85, 0x03, 0xac, 0x9e, 0x6e, 0x02, 0xac, 0x7d, 0xab, 0xf8, 0x97, 0x25, 0x98, 0x17, 0x6f,
+		0xca, 0x13, 0x39, 0x32, 0x0f, 0xe6, 0x06, 0xf7, 0xb6, 0x04, 0x29, 0xdb, 0xef, 0x92, 0xb1, 0x1d,
+		0xf6, 0xa3, 0x28, 0xcf, 0xc3, 0x78, 0x0f, 0x42, 0x88, 0xb3, 0x02, 0x88, 0xdf, 0x08, 0xe9, 0x21,
+		0xaf, 0x50, 0xd7, 0xad, 0x1e, 0xae, 0xc1, 0x4c, 0x45, 0x75, 0x3a, 0xda, 0x50, 0xc2, 0x4d, 0x19,
+		0xed, 0x95, 0xf5, 0xe8, 0x58, 0x5b, 0xe9, 0x45, 0x5d, 0x0c, 0x7d, 0xce, 0xa6, 0xd0, 0xe7, 0x9e,
+		0x44, 0x1b, 0x0d, 0xc5, 0x89, 0xf2, 0x3b, 0x12, 0x1c, 0x8b, 0x69, 0x7c, 0x75, 0x7f, 0x18, 0xe4,
+		0x35, 0xfc, 0xb5, 0xf6, 0xad, 0x8f, 0x3c, 0x97, 0x74, 0xb4, 0x0e, 0x47, 0x5a, 0x71, 0xc0, 0x96,
+		0x61, 0xa5, 0xb8, 0xf3, 0x22, 0x1a, 0x06, 0xb8, 0x8d, 0xad, 0x69, 0x4e, 0x6f, 0x91, 0xcd, 0xfe,
+		0x34, 0x4c, 0x70, 0x3b, 0x6a, 0xe3, 0xa4, 0x11, 0x0e, 0xf9, 0xe5, 0xdf, 0x4a, 0x30, 0x15, 0xd7,
+		0x4c, 0x79, 0x20, 0x5f, 0x39, 0xa8, 0xf5, 0x88, 0x75, 0xd0, 0x3f, 0x95, 0x60, 0x26, 0xa9, 0x29,
+		0x33, 0x4e, 0x9a, 0x47, 0x6a, 0xb6, 0xb1, 0xc8, 0xff, 0xdd, 0x07, 0x29, 0x7b, 0x7f, 0xd0, 0x22,
+		0x1c, 0x26, 0xed, 0x45, 0xe1, 0xe4, 0xb7, 0x27, 0xd3, 0x48, 0x0d, 0x37, 0x43, 0xa9, 0xef, 0x48,
+		0xfd, 0x29, 0xd3, 0x5d, 0xfd, 0xe9, 0x71, 0x85, 0x48, 0xbc, 0x42, 0x24, 0xa2, 0x3b, 0x7d, 0x02,
+		0xba, 0x73, 0x1b, 0xc6, 0x68, 0x66, 0x9f, 0x62, 0x34, 0x6a, 0x0e, 0xb6, 0x76, 0xd5, 0x4a, 0xf2,
+		0xb5, 0xe7, 0x30, 0x9d, 0x48, 0xe0, 0x95, 0xe8, 0xb4, 0x60, 0xf5, 0x29, 0xbf, 0xaf, 0xea, 0x53,
+		0x47, 0x08, 0x07, 0x69, 0x42, 0x38, 0x7e, 0xa9, 0xa9, 0xd0, 0x75, 0xa9, 0xa9, 0x7d, 0x4d, 0x19,
+		0x10, 0xbf, 0xa6, 0xf8, 0x05, 0x8f, 0xc1, 0x7d, 0x14, 0x3c, 0x86, 0xf6, 0x55, 0xf0, 0x70, 0x7d,
+		0xf0, 0x62, 0xda, 0x06, 0xc4, 0x96, 0xb7, 0x92, 0x3a, 0xbd, 0x55, 0xdc, 0xfd, 0x66, 0x13, 0x8e,
+		0xb6, 0x9a, 0x16, 0x42, 0xb5, 0x63, 0xcf, 0x8e, 0xe7, 0x63, 0xdb, 0x12, 0x82, 0xd5, 0xe3, 0x23,
+		0x98, 0x35, 0x2c, 0x7f, 0x5f, 0x82, 0x39, 0x8e, 0x24, 0xac, 0x92, 0x78, 0xb2, 0x79, 0x48, 0x02,
+		0xe6, 0xd1, 0x11, 0xe9, 0x64, 0x52, 0x44, 0x3a, 0xf2, 0xbb, 0x12, 0x4c, 0xc7, 0x36, 0xd0, 0xbb,
+		0xa1, 0x1e, 0x6d, 0xcf, 0xaf, 0xa9, 0x55, 0x7f, 0xa9, 0xc1, 0x1b, 0xba, 0xa5, 0x56, 0x71, 0xb7,
+		0x9f, 0x3e, 0xb0, 0x53, 0xa5, 0xad, 0xf1, 0x3d, 0xc2, 0x1a, 0x2f, 0x7f, 0x8d, 0xb5, 0x49, 0xbc,
+		0x86, 0x91, 0x13, 0x50, 0xa0, 0x2d, 0x3b, 0x9d, 0x4b, 0xe0, 0x0d, 0x91, 0x25, 0x68, 0x39, 0xf5,
+		0x8c, 0xb8, 0x53, 0x8f, 0x49, 0x73, 0xcb, 0x5f, 0x91, 0x60, 0x3e, 0x45, 0x93, 0x54, 0x3b, 0x1d,
+		0x2b, 0x05, 0xd2, 0xb1, 0xdd, 0xee, 0x4c, 0x1c, 0xb4, 0x5f, 0x65, 0xe0, 0xf9, 0xfd, 0x35, 0x8a,
+		0x1f, 0x98, 0xce, 0xb7, 0x53, 0x7d, 0x99, 0x40, 0xaa, 0xef, 0x1e, 0xa0, 0x68, 0x43, 0x12, 0xb5,
+		0xef, 0xd3, 0x62, 0x4d, 0xc7, 0xca, 0x48, 0xa4, 0xab, 0xd8, 0x4d, 0x7e, 0x68, 0x66, 0xcd, 0xb1,
+		0xcc, 0x0a, 0x51, 0xb4, 0x01, 0xc5, 0x7f, 0x44, 0x45, 0x18, 0x0d, 0xf5, 0xd6, 0x99, 0xb5, 0x8a,
+		0x17, 0x99, 0xf7, 0x2b, 0x23, 0x81, 0x96, 0xb7, 0xdb, 0xb5, 0xca, 0x9e, 0xfc, 0x7a, 0x16, 0xae,
+		0xed, 0xa3, 0x11, 0x1d, 0xdd, 0xeb, 0xf4, 0x7b, 0x43, 0x9c, 0x9f, 0x79, 0x08, 0x71, 0x0e, 0x64,
+		0xad, 0x0f, 0xe8, 0x3e, 0xc9, 0x4d, 0xc1, 0xb2, 0xf7, 0xa5, 0x67, 0xbf, 0xfb, 0xb2, 0x00, 0x28,
+		0xdc, 0xfe, 0x47, 0x0b, 0x1c, 0x59, 0x65, 0xd8, 0x08, 0x28, 0xa1, 0x97, 0xc2, 0xf2, 0x77, 0x31,
+		0x17, 0xd8, 0x45, 0xf9, 0xcf, 0x12, 0x5c, 0xe9, 0xb2, 0x8b, 0x9e, 0x83, 0x41, 0xe2, 0x60, 0x78,
+		0x7f, 0x15, 0x57, 0xfe, 0x62, 0x16, 0xae, 0x74, 0xd9, 0xe9, 0xf8, 0xbf, 0x6a, 0xab, 0x21, 0x8f,
+		0xdd, 0xc3, 0xf7, 0xd8, 0xbd, 0xe2, 0x1e, 0x9b, 0xab, 0x3a, 0x3c, 0x07, 0xd0, 0xc7, 0x73, 0x00,
+		0xaf, 0x64, 0xe1, 0x52, 0x37, 0xdd, 0x9a, 0x62, 0x96, 0x2f, 0xc4, 0xf9, 0xb1, 0xe5, 0xb7, 0x2d,
+		0xff, 0x1f, 0x12, 0x9c, 0x4f, 0xdb, 0x79, 0xfa, 0x5f, 0x6d, 0xf2, 0xfc, 0xb3, 0x4a, 0xfe, 0xbd,
+		0x04, 0xe7, 0x52, 0x75, 0xab, 0x1e, 0x98, 0x0b, 0x60, 0xde, 0x1a, 0x32, 0xfb, 0xbb, 0x35, 0x7c,
+		0x55, 0x82, 0xd9, 0xc4, 0xb6, 0xd6, 0x03, 0x93, 0xc0, 0xbf, 0x26, 0x65, 0x84, 0xae, 0x49, 0xf2,
+		0x37, 0xf2, 0x70, 0xb1, 0x8b, 0xdf, 0x04, 0x75, 0xe8, 0x8a, 0x14, 0xd0, 0x95, 0x13, 0x50, 0x68,
+		0xe9, 0x0a, 0x35, 0xc8, 0xbc, 0x02, 0xfe, 0x10, 0x2b, 0xbf, 0x91, 0x3d, 0x80, 0xfc, 0x46, 0xb7,
+		0xb5, 0xd2, 0xde, 0x83, 0xcd, 0x6f, 0xe4, 0x1e, 0x69, 0x7e, 0xa3, 0xaf, 0xeb, 0xfc, 0xc6, 0x7d,
+		0xa0, 0x4d, 0xc4, 0x94, 0x23, 0x2d, 0x31, 0x7a, 0x0d, 0x10, 0xa7, 0x63, 0x3a, 0x91, 0x09, 0x17,
+		0x5a, 0x68, 0x1c, 0xa9, 0x87, 0x87, 0x3a, 0x2d, 0x38, 0x1f, 0x3c, 0x6c, 0x44, 0xb4, 0x19, 0x04,
+		0xb4, 0x59, 0x83, 0xf1, 0x0e, 0x75, 0x2a, 0x5b, 0xb8, 0xd1, 0x86, 0x5f, 0x20, 0xf0, 0xe7, 0x63,
+		0x15, 0xa7, 0xa4, 0x2b, 0xb8, 0xe1, 0xe3, 0x55, 0x8e, 0x34, 0x59, 0xc3, 0x91, 0xd2, 0xeb, 0x60,
+		0x37, 0xa5, 0xd7, 0x48, 0x3b, 0xe8, 0x10, 0xa3, 0x1d, 0xb4, 0x7d, 0x0d, 0x3c, 0x94, 0x3e, 0xf1,
+		0x31, 0xbc, 0x8f, 0xc4, 0xc7, 0xc8, 0xfe, 0x3a, 0x3d, 0x9f, 0x85, 0x82, 0x8e, 0x2b, 0xea, 0x9e,
+		0xa7, 0x9a, 0xc9, 0x6d, 0xab, 0x40, 0xa8, 0x89, 0x2a, 0x06, 0xca, 0xcb, 0xa3, 0x09, 0xe5, 0xe5,
+		0xc3, 0xd1, 0xf2, 0xf2, 0x6b, 0x59, 0x38, 0x9f, 0xf6, 0x27, 0x7f, 0x1f, 0xbc, 0x77, 0x5a, 0xf7,
+		0x63, 0x20, 0xaf, 0x8a, 0x77, 0x39, 0xf5, 0xef, 0xd5, 0x02, 0xa1, 0x4f, 0x87, 0x9d, 0xf5, 0x06,
+		0xed, 0x8c, 0x7d, 0xc0, 0xe7, 0x38, 0x07, 0xfc, 0x01, 0xe5, 0x39, 0xe5, 0xdf, 0x65, 0x60, 0x21,
+		0xcd, 0xef, 0x19, 0xb9, 0xfb, 0xc1, 0x8e, 0x2c, 0x32, 0xfb, 0x8d, 0x2c, 0x0e, 0x6a, 0x17, 0xd9,
+		0xab, 0xdb, 0xc3, 0x59, 0xdd, 0xb6, 0x71, 0xf7, 0x8a, 0xe7, 0x78, 0xde, 0xcd, 0x40, 0xca, 0x5f,
+		0x5a, 0x7e, 0x38, 0x16, 0x93, 0x55, 0xb2, 0xea, 0x65, 0x96, 0xac, 0xda, 0xbd, 0x16, 0x39, 0xf1,
+		0x5e, 0x0b, 0xf9, 0x5f, 0x19, 0x38, 0x7b, 0x10, 0x1e, 0xe5, 0x43, 0xba, 0xe8, 0x1d, 0xd5, 0x84,
+		0x5c, 0x8a, 0x6a, 0x82, 0xfc, 0x5e, 0x06, 0xce, 0xa5, 0xfa, 0xe1, 0xeb, 0xe3, 0x85, 0x8f, 0x2c,
+		0xbc, 0x9f, 0x2e, 0xcd, 0xa5, 0xc9, 0xa1, 0x7f, 0x2e, 0xcb, 0x5b, 0x78, 0x5e, 0x7f, 0xcc, 0xe3,
+		0x85, 0x8f, 0x6d, 0xcf, 0xc9, 0x75, 0xf3, 0xb3, 0x80, 0x5f, 0x66, 0x60, 0x31, 0xe5, 0x0f, 0x92,
+		0x1f, 0xef, 0x43, 0x60, 0x1f, 0xe6, 0x1d, 0x38, 0x44, 0xfe, 0x5c, 0x33, 0x2a, 0x0e, 0xb6, 0xc8,
+		0xa7, 0xa6, 0x61, 0x62, 0xf5, 0xfe, 0xea, 0xad, 0x8d, 0xf2, 0x5a, 0x69, 0x7d, 0x63, 0x55, 0x29,
+		0x6f, 0x7c, 0xfc, 0xce, 0x6a, 0xb9, 0x74, 0xeb, 0xfe, 0xf2, 0x7a, 0xe9, 0xc6, 0xf0, 0x13, 0xe8,
+		0x04, 0x1c, 0x8b, 0xbe, 0x5e, 0x5e, 0x5f, 0x2f, 0x93, 0xd1, 0x61, 0x09, 0xcd, 0xc2, 0x74, 0x94,
+		0x60, 0x65, 0xfd, 0xf6, 0xdd, 0x55, 0x4a, 0x92, 0xb9, 0x7e, 0x1f, 0x8e, 0x6a, 0x66, 0x95, 0xb5,
+		0x06, 0xd7, 0xfb, 0x97, 0xeb, 0xc6, 0x1d, 0xcb, 0x74, 0xcc, 0x3b, 0xd2, 0x27, 0x16, 0x1f, 0x18,
+		0xce, 0x76, 0x63, 0xb3, 0xa8, 0x99, 0xd5, 0xc5, 0xc0, 0xbf, 0xd5, 0x2d, 0x3e, 0xc0, 0x35, 0xef,
+		0x1f, 0xf9, 0xd2, 0xff, 0xb0, 0x7b, 0x4d, 0xad, 0x1b, 0xbb, 0x17, 0x36, 0x73, 0x64, 0xec, 0xe2,
+		0x7f, 0x06, 0x00, 0xf5, 0xbf, 0xfd, 0x8e, 0x44, 0x58, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -1289,57 +1492,78 @@ var yarpcFileDescriptorClosure674d14d2fee4e473 = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x73, 0x98, 0x25, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xcb, 0x74, 0x51,
-		0x04, 0xc5, 0x20, 0x21, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0x89, 0x83, 0x55, 0x88, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0xbb, 0xe1, 0x28, 0xf1, 0xd4, 0x21, 0xf4, 0x43, 0x81, 0xa4, 0x92, 0xf8, 0x45, 0xf6,
-		0x30, 0x7b, 0xa2, 0x3d, 0xc6, 0x40, 0x4a, 0xf6, 0xdc, 0xc4, 0xeb, 0x1d, 0x79, 0xbe, 0xf3, 0x9d,
-		0x9f, 0x8f, 0xe7, 0x10, 0x79, 0x4d, 0x0a, 0x32, 0xc8, 0x28, 0x83, 0x2a, 0x83, 0x80, 0xd6, 0x3c,
-		0xb8, 0x3d, 0x0e, 0x34, 0x55, 0x79, 0xc1, 0x95, 0xf6, 0x6b, 0x29, 0xb4, 0xc0, 0xdf, 0x18, 0x1f,
-		0xbf, 0xf3, 0xf1, 0x69, 0xcd, 0xfd, 0xdb, 0xe3, 0xe1, 0xf7, 0x73, 0x21, 0xe6, 0x05, 0x04, 0xd6,
-		0x25, 0x6d, 0x3e, 0x06, 0xac, 0x91, 0x54, 0x73, 0x51, 0xb5, 0xa4, 0xe1, 0x0f, 0x0f, 0x71, 0xcd,
-		0x4b, 0x50, 0x9a, 0x96, 0x75, 0xe7, 0xf0, 0x28, 0xc0, 0x9d, 0xa4, 0x75, 0x0d, 0x52, 0xb5, 0xb8,
-		0xf7, 0x01, 0xf5, 0x13, 0xaa, 0xf2, 0x09, 0x57, 0x1a, 0x63, 0xb4, 0x5d, 0xd1, 0x12, 0x0e, 0x9c,
-		0x43, 0xe7, 0x68, 0x27, 0xb2, 0x67, 0xfc, 0x0b, 0xda, 0xce, 0x79, 0xc5, 0x0e, 0xb6, 0x0e, 0x9d,
-		0xa3, 0xfd, 0x93, 0x1f, 0xfd, 0x0d, 0x45, 0xfa, 0xcb, 0x00, 0x17, 0xbc, 0x62, 0x91, 0x75, 0xf7,
-		0x28, 0x72, 0x97, 0xd6, 0x4b, 0xd0, 0x94, 0x51, 0x4d, 0xf1, 0x25, 0xfa, 0xb6, 0xa4, 0xf7, 0xc4,
-		0xb4, 0xad, 0x48, 0x0d, 0x92, 0x28, 0xc8, 0x44, 0xc5, 0x6c, 0xba, 0xdd, 0x93, 0xef, 0xfc, 0xb6,
-		0x52, 0x7f, 0x59, 0xa9, 0x3f, 0x16, 0x4d, 0x5a, 0xc0, 0x15, 0x2d, 0x1a, 0x88, 0xbe, 0x2e, 0xe9,
-		0xbd, 0x09, 0xa8, 0x66, 0x20, 0x63, 0x4b, 0xf3, 0x3e, 0xa0, 0xc1, 0x32, 0xc5, 0x8c, 0x4a, 0xcd,
-		0x8d, 0x2a, 0xab, 0x5c, 0x2e, 0xea, 0xe5, 0xb0, 0xe8, 0x3a, 0x31, 0x47, 0xfc, 0x06, 0x3d, 0x13,
-		0x77, 0x15, 0x48, 0x72, 0x23, 0x94, 0x26, 0xb6, 0xcf, 0x2d, 0x8b, 0xee, 0x59, 0xf3, 0x3b, 0xa1,
-		0xf4, 0x94, 0x96, 0xe0, 0xfd, 0xe3, 0xa0, 0xfd, 0x65, 0xdc, 0x58, 0x53, 0xdd, 0x28, 0xfc, 0x13,
-		0xc2, 0x29, 0xcd, 0xf2, 0x42, 0xcc, 0x49, 0x26, 0x9a, 0x4a, 0x93, 0x1b, 0x5e, 0x69, 0x1b, 0xbb,
-		0x17, 0xb9, 0x1d, 0x72, 0x66, 0x80, 0x77, 0xbc, 0xd2, 0xf8, 0x35, 0x42, 0x12, 0x28, 0x23, 0x05,
-		0xdc, 0x42, 0x61, 0x73, 0xf4, 0xa2, 0x1d, 0x63, 0x99, 0x18, 0x03, 0x7e, 0x85, 0x76, 0x68, 0x96,
-		0x77, 0x68, 0xcf, 0xa2, 0x7d, 0x9a, 0xe5, 0x2d, 0xf8, 0x06, 0x3d, 0x93, 0x54, 0xc3, 0xba, 0x3a,
-		0xdb, 0x87, 0xce, 0x91, 0x13, 0xed, 0x19, 0xf3, 0xaa, 0x77, 0x3c, 0x46, 0x7b, 0x46, 0x46, 0xc2,
-		0x19, 0x49, 0x0b, 0x91, 0xe5, 0x07, 0x4f, 0xac, 0x86, 0x87, 0xff, 0xfb, 0x3c, 0xe1, 0xf8, 0xd4,
-		0xf8, 0x45, 0xbb, 0x86, 0x16, 0x32, 0x7b, 0xf1, 0x7e, 0x43, 0xbb, 0x6b, 0x18, 0x1e, 0xa0, 0xbe,
-		0xd2, 0x54, 0x6a, 0xc2, 0x59, 0xd7, 0xdc, 0x97, 0xf6, 0x1e, 0x32, 0xfc, 0x1c, 0x3d, 0x85, 0x8a,
-		0x19, 0xa0, 0xed, 0xe7, 0x09, 0x54, 0x2c, 0x64, 0xde, 0x5f, 0x0e, 0x42, 0x33, 0x51, 0x14, 0x20,
-		0xc3, 0xea, 0xa3, 0xc0, 0x63, 0xe4, 0x16, 0x54, 0x69, 0x42, 0xb3, 0x0c, 0x94, 0x22, 0x66, 0x14,
-		0xbb, 0xc7, 0x1d, 0x3e, 0x7a, 0xdc, 0x64, 0x39, 0xa7, 0xd1, 0xbe, 0xe1, 0x8c, 0x2c, 0xc5, 0x18,
-		0xf1, 0x10, 0xf5, 0x39, 0x83, 0x4a, 0x73, 0xbd, 0xe8, 0x5e, 0x68, 0x75, 0xdf, 0xa4, 0x4f, 0x6f,
-		0x83, 0x3e, 0xde, 0xdf, 0x0e, 0x1a, 0xc4, 0x9a, 0x67, 0xf9, 0xe2, 0xfc, 0x1e, 0xb2, 0xc6, 0x8c,
-		0xc6, 0x48, 0x6b, 0xc9, 0xd3, 0x46, 0x83, 0xc2, 0xbf, 0x23, 0xf7, 0x4e, 0xc8, 0x1c, 0xa4, 0x9d,
-		0x45, 0x62, 0x76, 0xb0, 0xab, 0xf3, 0xf5, 0x67, 0xe7, 0x3b, 0xda, 0x6f, 0x69, 0xab, 0x85, 0x49,
-		0xd0, 0x40, 0x65, 0x37, 0xc0, 0x9a, 0x02, 0x88, 0x16, 0xa4, 0x55, 0xcf, 0xb4, 0x2d, 0x1a, 0x6d,
-		0x6b, 0xdf, 0x3d, 0x19, 0x3c, 0x1e, 0xeb, 0x6e, 0x83, 0xa3, 0x17, 0x4b, 0x6e, 0x22, 0x62, 0xc3,
-		0x4c, 0x5a, 0xe2, 0xdb, 0x3f, 0xd1, 0x57, 0xeb, 0x1b, 0x85, 0x87, 0xe8, 0x45, 0x32, 0x8a, 0x2f,
-		0xc8, 0x24, 0x8c, 0x13, 0x72, 0x11, 0x4e, 0xc7, 0x24, 0x9c, 0x5e, 0x8d, 0x26, 0xe1, 0xd8, 0xfd,
-		0x02, 0x0f, 0xd0, 0xf3, 0x07, 0xd8, 0xf4, 0x7d, 0x74, 0x39, 0x9a, 0xb8, 0xce, 0x06, 0x28, 0x4e,
-		0xc2, 0xb3, 0x8b, 0x6b, 0x77, 0xeb, 0x2d, 0xfb, 0x2f, 0x43, 0xb2, 0xa8, 0xe1, 0xd3, 0x0c, 0xc9,
-		0xf5, 0xec, 0x7c, 0x2d, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36, 0x3e, 0x3f, 0x0b, 0xe3, 0xf0, 0xfd,
-		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
-		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
-		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0xc7, 0xa7, 0xb8, 0x69, 0x6c, 0xa6, 0x71, 0x14, 0xe6, 0xcb, 0x76, 0x97, 0xce, 0xf3, 0x45,
+		0x67, 0x14, 0x9b, 0xbc, 0x64, 0x28, 0x86, 0xa1, 0x17, 0x9b, 0x13, 0x07, 0xad, 0x16, 0x27, 0x35,
+		0x64, 0x35, 0x45, 0x76, 0xc3, 0xd1, 0x22, 0x63, 0x13, 0x96, 0x48, 0x41, 0xa4, 0xf2, 0xf1, 0x3e,
+		0x7b, 0x8a, 0x3d, 0xca, 0x5e, 0x60, 0xaf, 0x31, 0x90, 0x92, 0x1c, 0x27, 0x76, 0x77, 0x67, 0x9d,
+		0xff, 0xf9, 0xf1, 0x1c, 0x1e, 0x1e, 0x1e, 0x1a, 0xb4, 0xd2, 0x11, 0x4d, 0x3a, 0x01, 0x26, 0x94,
+		0x07, 0xb4, 0x83, 0x63, 0xd6, 0xb9, 0x39, 0xec, 0x28, 0x2c, 0xa7, 0x21, 0x93, 0xca, 0x89, 0x13,
+		0xa1, 0x04, 0xdc, 0xd6, 0x3e, 0x4e, 0xee, 0xe3, 0xe0, 0x98, 0x39, 0x37, 0x87, 0x8d, 0x57, 0x63,
+		0x21, 0xc6, 0x21, 0xed, 0x18, 0x97, 0x51, 0x7a, 0xdd, 0x21, 0x69, 0x82, 0x15, 0x13, 0x3c, 0x83,
+		0x1a, 0xdf, 0x3c, 0xd5, 0x15, 0x8b, 0xa8, 0x54, 0x38, 0x8a, 0x73, 0x87, 0x85, 0x05, 0x6e, 0x13,
+		0x1c, 0xc7, 0x34, 0x91, 0x99, 0xde, 0xfa, 0x04, 0xca, 0x3e, 0x96, 0xd3, 0x3e, 0x93, 0x0a, 0x42,
+		0xf0, 0x8c, 0xe3, 0x88, 0xd6, 0xac, 0xa6, 0xd5, 0xae, 0x78, 0xe6, 0x37, 0x7c, 0x0b, 0x9e, 0x4d,
+		0x19, 0x27, 0xb5, 0x95, 0xa6, 0xd5, 0xae, 0x1e, 0x7d, 0xeb, 0x2c, 0x49, 0xd2, 0x29, 0x16, 0x38,
+		0x63, 0x9c, 0x78, 0xc6, 0xbd, 0x85, 0x81, 0x5d, 0x58, 0xcf, 0xa9, 0xc2, 0x04, 0x2b, 0x0c, 0xcf,
+		0xc1, 0x4e, 0x84, 0xef, 0x90, 0xde, 0xb6, 0x44, 0x31, 0x4d, 0x90, 0xa4, 0x81, 0xe0, 0xc4, 0x84,
+		0x5b, 0x3f, 0xfa, 0xda, 0xc9, 0x32, 0x75, 0x8a, 0x4c, 0x9d, 0x9e, 0x48, 0x47, 0x21, 0xbd, 0xc4,
+		0x61, 0x4a, 0xbd, 0xad, 0x08, 0xdf, 0xe9, 0x05, 0xe5, 0x80, 0x26, 0x43, 0x83, 0xb5, 0x3e, 0x81,
+		0x7a, 0x11, 0x62, 0x80, 0x13, 0xc5, 0x74, 0x55, 0x66, 0xb1, 0x6c, 0x50, 0x9a, 0xd2, 0xfb, 0x7c,
+		0x27, 0xfa, 0x27, 0x7c, 0x0d, 0x36, 0xc5, 0x2d, 0xa7, 0x09, 0x9a, 0x08, 0xa9, 0x90, 0xd9, 0xe7,
+		0x8a, 0x51, 0x37, 0x8c, 0xf9, 0x83, 0x90, 0xea, 0x02, 0x47, 0xb4, 0xd5, 0x01, 0xf6, 0x67, 0x91,
+		0x4c, 0x69, 0x72, 0x49, 0x13, 0xc9, 0x04, 0x1f, 0x52, 0x05, 0x5f, 0x82, 0xca, 0x28, 0x65, 0x21,
+		0x41, 0x8c, 0xc8, 0x9a, 0xd5, 0x2c, 0xb5, 0x2b, 0x5e, 0xd9, 0x18, 0x5c, 0x22, 0x5b, 0x7f, 0x59,
+		0x60, 0x7f, 0x21, 0x91, 0x13, 0xc1, 0xaf, 0xd9, 0x18, 0x3a, 0x60, 0x9b, 0xa7, 0x11, 0x4a, 0x28,
+		0x26, 0x28, 0x2e, 0x34, 0x69, 0xd2, 0x5a, 0xf5, 0xb6, 0x78, 0x1a, 0x79, 0x14, 0x93, 0x19, 0x24,
+		0xe1, 0x8f, 0x60, 0x47, 0xfb, 0xdf, 0x26, 0x4c, 0xd1, 0x79, 0x60, 0xc5, 0x00, 0x90, 0xa7, 0xd1,
+		0x67, 0x2d, 0xcd, 0x11, 0xdf, 0x81, 0x4d, 0x19, 0xe0, 0x90, 0xf1, 0x31, 0xa2, 0x1c, 0x8f, 0x42,
+		0x4a, 0x6a, 0xa5, 0xa6, 0xd5, 0x2e, 0x7b, 0xd5, 0xdc, 0x7c, 0x9a, 0x59, 0x5b, 0xff, 0xae, 0x82,
+		0x6a, 0x91, 0xe6, 0x50, 0x61, 0x95, 0x4a, 0xf8, 0x3d, 0x80, 0x23, 0x1c, 0x4c, 0x43, 0x31, 0x46,
+		0x81, 0x48, 0xb9, 0x42, 0x13, 0xc6, 0x95, 0x49, 0xae, 0xe4, 0xd9, 0xb9, 0x72, 0xa2, 0x85, 0x0f,
+		0x8c, 0x2b, 0x78, 0x00, 0x80, 0xd9, 0x47, 0x48, 0x6f, 0x68, 0x68, 0x32, 0x2a, 0x79, 0x15, 0x6d,
+		0xe9, 0x6b, 0x83, 0xae, 0x11, 0x0e, 0xa6, 0xb9, 0x5a, 0x32, 0x6a, 0x19, 0x07, 0xd3, 0x4c, 0x7c,
+		0x0d, 0x36, 0x13, 0xac, 0xe8, 0xfc, 0xa9, 0x3f, 0x6b, 0x5a, 0x6d, 0xcb, 0xdb, 0xd0, 0xe6, 0xd9,
+		0x99, 0xc2, 0x1e, 0xd8, 0xd0, 0xed, 0x81, 0x18, 0x41, 0xa3, 0x50, 0x04, 0xd3, 0xda, 0xaa, 0xe9,
+		0x8d, 0xe6, 0x17, 0xdb, 0xce, 0xed, 0x1d, 0x6b, 0x3f, 0x6f, 0x5d, 0x63, 0x2e, 0x31, 0x1f, 0xf0,
+		0x07, 0xb0, 0x8d, 0x09, 0x41, 0x4f, 0x23, 0x3e, 0x37, 0x11, 0x6d, 0x4c, 0x88, 0xf7, 0x28, 0xe8,
+		0xcf, 0xa0, 0x46, 0x98, 0x8c, 0xb1, 0x0a, 0x26, 0x0b, 0xcc, 0x9a, 0x61, 0x76, 0x0b, 0xfd, 0x31,
+		0xd8, 0x06, 0xb6, 0xbc, 0xe7, 0x01, 0x8a, 0x0a, 0x94, 0x89, 0x5a, 0xd9, 0x00, 0x55, 0x6d, 0x3f,
+		0xcf, 0x09, 0x26, 0xe0, 0x5b, 0xb0, 0x2f, 0x42, 0x42, 0xa5, 0x32, 0xdd, 0x8f, 0xf0, 0x98, 0xe6,
+		0x01, 0x64, 0xad, 0x62, 0x80, 0x9d, 0x4c, 0xd6, 0x5b, 0xea, 0x8e, 0x69, 0xb6, 0xbe, 0xd4, 0xed,
+		0xc3, 0xf8, 0x98, 0x4a, 0x7d, 0xd4, 0x28, 0x89, 0x25, 0x0a, 0x59, 0xc4, 0x54, 0x0d, 0x18, 0x64,
+		0x6b, 0x26, 0x79, 0xb1, 0xec, 0x6b, 0x01, 0xbe, 0x03, 0x0d, 0x22, 0x22, 0xcc, 0x38, 0x5a, 0x86,
+		0xad, 0x1b, 0x6c, 0x3f, 0xf3, 0x70, 0x17, 0xe0, 0x5f, 0x40, 0x3d, 0x87, 0x1f, 0xaa, 0x31, 0x63,
+		0x5f, 0x18, 0x76, 0x2f, 0x73, 0xe8, 0x15, 0xd5, 0x28, 0xd0, 0xdf, 0xc0, 0x81, 0x9a, 0x24, 0x42,
+		0xa9, 0x90, 0x12, 0xb4, 0xac, 0xf4, 0x1b, 0x06, 0xaf, 0xcf, 0x9c, 0xba, 0x4f, 0xcf, 0xe0, 0x77,
+		0xd0, 0x7a, 0x58, 0xe1, 0x8b, 0xa7, 0x51, 0x35, 0xcb, 0xbc, 0x9a, 0x79, 0xf6, 0x96, 0x1d, 0x4b,
+		0xeb, 0x57, 0xb0, 0x3e, 0xd7, 0x1a, 0xb0, 0x0e, 0xca, 0x52, 0xe1, 0x44, 0x21, 0x46, 0xf2, 0xde,
+		0x5e, 0x33, 0xdf, 0x2e, 0x81, 0xbb, 0xe0, 0x39, 0xe5, 0xfa, 0x56, 0xe7, 0xed, 0xbc, 0x4a, 0x39,
+		0x71, 0x49, 0xeb, 0x1f, 0x0b, 0x80, 0x81, 0x08, 0x43, 0x9a, 0xb8, 0xfc, 0x5a, 0xc0, 0x1e, 0xb0,
+		0x43, 0x2c, 0x15, 0xc2, 0x41, 0x40, 0xa5, 0x44, 0x7a, 0xc2, 0xe6, 0x33, 0xab, 0xb1, 0x30, 0xb3,
+		0xfc, 0x62, 0xfc, 0x7a, 0x55, 0xcd, 0x74, 0x0d, 0xa2, 0x8d, 0xb0, 0x01, 0xca, 0x8c, 0x50, 0xae,
+		0x98, 0xba, 0xcf, 0x07, 0xcf, 0xec, 0x7b, 0xd9, 0xf5, 0x28, 0x2d, 0xbb, 0x1e, 0x75, 0x50, 0x2e,
+		0xe6, 0x90, 0xb9, 0x3f, 0x15, 0x6f, 0x2d, 0x1f, 0x43, 0x7a, 0x0e, 0x30, 0x29, 0x42, 0xf3, 0x36,
+		0xa0, 0x71, 0x22, 0xd2, 0xd8, 0xdc, 0x9d, 0x8a, 0x57, 0x9d, 0x99, 0xdf, 0x6b, 0x6b, 0xeb, 0x6f,
+		0x0b, 0xd4, 0x87, 0x8a, 0x05, 0xd3, 0xfb, 0xd3, 0x3b, 0x1a, 0xa4, 0x5a, 0xe8, 0x2a, 0x95, 0xb0,
+		0x51, 0xaa, 0xa8, 0x84, 0xef, 0x81, 0x7d, 0x6b, 0xa6, 0x5f, 0xd6, 0xa8, 0xfa, 0x79, 0xca, 0xf7,
+		0x7a, 0xf0, 0xbf, 0xa3, 0xdf, 0xab, 0x66, 0x58, 0xf1, 0x0d, 0x7d, 0x50, 0x97, 0xc1, 0x84, 0x92,
+		0x34, 0xa4, 0x48, 0x09, 0x94, 0x9d, 0x80, 0x2e, 0x9d, 0x48, 0x95, 0xd9, 0xff, 0xfa, 0x51, 0x7d,
+		0x71, 0xe2, 0xe7, 0x8f, 0x9b, 0xb7, 0x57, 0xb0, 0xbe, 0x18, 0x6a, 0xd2, 0xcf, 0xc0, 0x37, 0x7f,
+		0x82, 0x17, 0xf3, 0x8f, 0x0d, 0x6c, 0x80, 0x3d, 0xbf, 0x3b, 0x3c, 0x43, 0x7d, 0x77, 0xe8, 0xa3,
+		0x33, 0xf7, 0xa2, 0x87, 0xdc, 0x8b, 0xcb, 0x6e, 0xdf, 0xed, 0xd9, 0x5f, 0xc1, 0x3a, 0xd8, 0x7d,
+		0xa2, 0x5d, 0x7c, 0xf4, 0xce, 0xbb, 0x7d, 0xdb, 0x5a, 0x22, 0x0d, 0x7d, 0xf7, 0xe4, 0xec, 0xca,
+		0x5e, 0x79, 0x43, 0x1e, 0x22, 0xf8, 0xf7, 0x31, 0x7d, 0x1c, 0xc1, 0xbf, 0x1a, 0x9c, 0xce, 0x45,
+		0x78, 0x09, 0xf6, 0x9f, 0x68, 0xbd, 0xd3, 0x13, 0x77, 0xe8, 0x7e, 0xbc, 0xb0, 0xad, 0x25, 0x62,
+		0xf7, 0xc4, 0x77, 0x2f, 0x5d, 0xff, 0xca, 0x5e, 0x39, 0xbe, 0x04, 0xfb, 0x81, 0x88, 0x96, 0x55,
+		0xf4, 0xb8, 0xdc, 0x8d, 0xd9, 0x40, 0x17, 0x64, 0x60, 0xfd, 0xd1, 0x19, 0x33, 0x35, 0x49, 0x47,
+		0x4e, 0x20, 0xa2, 0xce, 0xa3, 0x7f, 0x10, 0xce, 0x98, 0xf2, 0xec, 0x49, 0xcf, 0xff, 0x4c, 0xbc,
+		0xc3, 0x31, 0xbb, 0x39, 0x1c, 0x3d, 0x37, 0xb6, 0x9f, 0xfe, 0x1b, 0x00, 0xc4, 0xb2, 0x03, 0x19,
+		0x70, 0x08, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -1358,154 +1582,156 @@ var yarpcFileDescriptorClosure674d14d2fee4e473 = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
-		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x1c, 0xc7, 0xca, 0xb7, 0xa3, 0xfb, 0x26,
-		0x71, 0xd4, 0x1b, 0xf9, 0x3a, 0xb9, 0xb9, 0x69, 0x6e, 0x9a, 0xa6, 0x34, 0x49, 0xc7, 0x4c, 0x64,
-		0x4a, 0x1d, 0x51, 0x71, 0x7c, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x72, 0x94,
-		0xc4, 0xfb, 0x02, 0x5d, 0x77, 0x57, 0xb4, 0x9b, 0xfe, 0x80, 0x02, 0x45, 0x7f, 0x40, 0xd1, 0xa2,
-		0x8b, 0xee, 0xba, 0xed, 0xb2, 0xfb, 0xfe, 0x8b, 0x62, 0x86, 0x43, 0x89, 0xb2, 0x3e, 0xa8, 0xb4,
-		0xc0, 0xed, 0xce, 0x3c, 0x7c, 0x9e, 0x87, 0x67, 0xce, 0x9c, 0xf3, 0x70, 0x68, 0x41, 0xa1, 0x7b,
-		0x4c, 0x82, 0xed, 0x86, 0xd3, 0x24, 0x5e, 0x83, 0x6c, 0x3b, 0x1d, 0x77, 0xfb, 0xc3, 0xce, 0xf6,
-		0x47, 0x3f, 0x78, 0x7f, 0xd2, 0xf2, 0x3f, 0x96, 0x3a, 0x81, 0x4f, 0x7d, 0xb4, 0xc6, 0x30, 0x25,
-		0x81, 0x29, 0x39, 0x1d, 0xb7, 0xf4, 0x61, 0xe7, 0xda, 0xad, 0x53, 0xdf, 0x3f, 0x6d, 0x91, 0x6d,
-		0x0e, 0x39, 0xee, 0x9e, 0x6c, 0x37, 0xbb, 0x81, 0x43, 0x5d, 0xdf, 0x8b, 0x48, 0xd7, 0x6e, 0x5f,
-		0xbc, 0x4f, 0xdd, 0x36, 0x09, 0xa9, 0xd3, 0xee, 0x08, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xfc, 0x76,
-		0xbb, 0x27, 0x31, 0x32, 0x37, 0xea, 0x84, 0xef, 0x5b, 0x6e, 0x48, 0x23, 0x4c, 0xe1, 0x2f, 0x73,
-		0xb0, 0x7e, 0x28, 0xd2, 0xd5, 0x3f, 0x91, 0x46, 0x97, 0xa5, 0x60, 0x78, 0x27, 0x3e, 0xaa, 0x03,
-		0x8a, 0xd7, 0x61, 0x93, 0xf8, 0x4e, 0x5e, 0xda, 0x94, 0xb6, 0x72, 0x8f, 0xee, 0x95, 0x46, 0x2c,
-		0xa9, 0x34, 0xa4, 0x83, 0x57, 0x3f, 0x5e, 0x0c, 0xa1, 0x27, 0x30, 0x43, 0xcf, 0x3b, 0x24, 0x9f,
-		0xe1, 0x42, 0x77, 0x26, 0x0a, 0x59, 0xe7, 0x1d, 0x82, 0x39, 0x1c, 0x3d, 0x03, 0x08, 0xa9, 0x13,
-		0x50, 0x9b, 0x95, 0x21, 0x9f, 0xe5, 0xe4, 0x6b, 0xa5, 0xa8, 0x46, 0xa5, 0xb8, 0x46, 0x25, 0x2b,
-		0xae, 0x11, 0x5e, 0xe0, 0x68, 0x76, 0xcd, 0xa8, 0x8d, 0x96, 0x1f, 0x92, 0x88, 0x3a, 0x93, 0x4e,
-		0xe5, 0x68, 0x4e, 0xb5, 0x60, 0x31, 0xa2, 0x86, 0xd4, 0xa1, 0xdd, 0x30, 0x3f, 0xbb, 0x29, 0x6d,
-		0x2d, 0x3f, 0xda, 0x99, 0x6e, 0xf5, 0x2a, 0x63, 0xd6, 0x38, 0x11, 0xe7, 0x1a, 0xfd, 0x0b, 0x74,
-		0x17, 0x96, 0xcf, 0xdc, 0x90, 0xfa, 0xc1, 0xb9, 0xdd, 0x22, 0xde, 0x29, 0x3d, 0xcb, 0xcf, 0x6d,
-		0x4a, 0x5b, 0x59, 0xbc, 0x24, 0xa2, 0x65, 0x1e, 0x44, 0x3f, 0x83, 0xf5, 0x8e, 0x13, 0x10, 0x8f,
-		0xf6, 0xcb, 0x6f, 0xbb, 0xde, 0x89, 0x9f, 0xbf, 0xc4, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x2a, 0x67,
-		0x0c, 0xec, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x02, 0xcb, 0x7d, 0x59, 0x5e, 0x99, 0xf9, 0xd4,
-		0xca, 0x2c, 0xf5, 0x18, 0xbc, 0x3a, 0x0f, 0x61, 0xa6, 0x4d, 0xda, 0x7e, 0x7e, 0x81, 0x13, 0xaf,
-		0x8e, 0xcc, 0xe7, 0x80, 0xb4, 0x7d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x48, 0x9c, 0xa0, 0x71, 0x66,
-		0x3b, 0x94, 0x06, 0xee, 0x71, 0x97, 0x92, 0x30, 0x0f, 0x9c, 0x7b, 0x77, 0x24, 0xb7, 0xc6, 0xd1,
-		0x4a, 0x0f, 0x8c, 0xe5, 0xf0, 0x42, 0x04, 0x95, 0x61, 0xd5, 0xe9, 0x52, 0xdf, 0x0e, 0x48, 0x48,
-		0xa8, 0xdd, 0xf1, 0x5d, 0x8f, 0x86, 0xf9, 0x1c, 0xd7, 0xdc, 0x1c, 0xa9, 0x89, 0x19, 0xb0, 0xca,
-		0x71, 0x78, 0x85, 0x51, 0x13, 0x01, 0x74, 0x1d, 0x16, 0xd8, 0x78, 0xd8, 0x6c, 0x3e, 0xf2, 0x8b,
-		0x9b, 0xd2, 0xd6, 0x02, 0x9e, 0x67, 0x81, 0xb2, 0x1b, 0x52, 0xb4, 0x01, 0x97, 0xdc, 0xd0, 0x6e,
-		0x04, 0xbe, 0x97, 0x5f, 0xda, 0x94, 0xb6, 0xe6, 0xf1, 0x9c, 0x1b, 0xaa, 0x81, 0xef, 0x15, 0x7e,
-		0x9d, 0x81, 0x5b, 0xc3, 0x9b, 0xef, 0x7b, 0x27, 0xee, 0xa9, 0x18, 0x69, 0xf4, 0x6d, 0x52, 0x38,
-		0x1a, 0xa1, 0x9b, 0x23, 0xd3, 0xb3, 0xc4, 0xd3, 0x12, 0xcf, 0x75, 0x60, 0xb3, 0xbf, 0x51, 0x62,
-		0x06, 0x7c, 0xbb, 0xdf, 0xd1, 0x7e, 0x97, 0x8a, 0x61, 0xba, 0x3a, 0xb4, 0x75, 0x9a, 0x48, 0x00,
-		0xdf, 0xe8, 0x49, 0xd4, 0xf8, 0x5c, 0xf8, 0x6a, 0xdc, 0xe3, 0x7e, 0x97, 0xa2, 0x43, 0xb8, 0xce,
-		0xd3, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0x85, 0xbf, 0x4b, 0xb0, 0x36,
-		0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x7e, 0xdb, 0x71, 0x3d, 0xdb, 0x6d, 0xf2, 0x7a, 0x2c, 0xe0, 0xf9,
-		0x28, 0x60, 0x34, 0xd1, 0x6d, 0xc8, 0x89, 0x9b, 0x9e, 0xd3, 0x8e, 0x8c, 0x62, 0x01, 0x43, 0x14,
-		0x32, 0x9d, 0x36, 0x19, 0xe3, 0x4c, 0xd9, 0xff, 0xd6, 0x99, 0xee, 0xc0, 0xa2, 0xeb, 0xb9, 0xd4,
-		0x75, 0x28, 0x69, 0xb2, 0xbc, 0x66, 0xf8, 0x50, 0xe6, 0x7a, 0x31, 0xa3, 0x59, 0xf8, 0x95, 0x04,
-		0xeb, 0xfa, 0x27, 0x4a, 0x02, 0xcf, 0x69, 0x7d, 0x2f, 0x6e, 0x79, 0x31, 0xa7, 0xcc, 0x70, 0x4e,
-		0xff, 0x9c, 0x85, 0xb5, 0x2a, 0xf1, 0x9a, 0xae, 0x77, 0xaa, 0x34, 0xa8, 0xfb, 0xc1, 0xa5, 0xe7,
-		0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xbf, 0xca, 0x10, 0x87, 0x8c, 0x26, 0xda, 0x83, 0xa5,
-		0x1e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0xbd, 0x84, 0x59, 0x66,
-		0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0x7a, 0x30, 0xda, 0x97, 0x06, 0x33, 0x64, 0x4e, 0x48, 0x70, 0xc4,
-		0x43, 0x06, 0xac, 0x9e, 0x11, 0x27, 0xa0, 0xc7, 0xc4, 0xa1, 0x76, 0x93, 0x50, 0xc7, 0x6d, 0x85,
-		0xc2, 0xa7, 0x6f, 0x8c, 0x31, 0xb9, 0xf3, 0x96, 0xef, 0x34, 0xb1, 0xdc, 0xa3, 0x69, 0x11, 0x0b,
-		0xbd, 0x86, 0xb5, 0x96, 0x13, 0x52, 0xbb, 0xaf, 0xc7, 0xad, 0x6d, 0x36, 0xd5, 0xda, 0x56, 0x19,
-		0x6d, 0x3f, 0x66, 0x71, 0x7b, 0xdb, 0x03, 0x1e, 0x8c, 0xa6, 0x82, 0x34, 0x23, 0xa5, 0xb9, 0x54,
-		0xa5, 0x15, 0x46, 0xaa, 0x45, 0x1c, 0xae, 0x93, 0x87, 0x4b, 0x0e, 0xa5, 0xa4, 0xdd, 0xa1, 0xdc,
-		0xb9, 0x67, 0x71, 0x7c, 0x89, 0x1e, 0x80, 0xdc, 0x76, 0x3e, 0xb9, 0xed, 0x6e, 0xdb, 0x16, 0xa1,
-		0x90, 0xbb, 0xf0, 0x2c, 0x5e, 0x11, 0x71, 0x45, 0x84, 0x99, 0x5d, 0x87, 0x8d, 0x33, 0xd2, 0xec,
-		0xb6, 0xe2, 0x4c, 0x16, 0xd2, 0xed, 0xba, 0xc7, 0xe0, 0x79, 0xa8, 0xb0, 0x42, 0x3e, 0x75, 0xdc,
-		0x68, 0x66, 0x23, 0x0d, 0x48, 0xd5, 0x58, 0xee, 0x53, 0xb8, 0xc8, 0x4b, 0x58, 0xe4, 0x45, 0x39,
-		0x71, 0xdc, 0x56, 0x37, 0x20, 0xc2, 0x6b, 0x47, 0x6f, 0xd3, 0x5e, 0x84, 0xc1, 0x39, 0xc6, 0x10,
-		0x17, 0xe8, 0x2b, 0xb8, 0xcc, 0x05, 0x58, 0xaf, 0x93, 0xc0, 0x76, 0x9b, 0xc4, 0xa3, 0x2e, 0x3d,
-		0x17, 0x76, 0x8b, 0xd8, 0xbd, 0x43, 0x7e, 0xcb, 0x10, 0x77, 0x0a, 0xbf, 0xcd, 0xc0, 0x55, 0xd1,
-		0x3e, 0xea, 0x99, 0xdb, 0x6a, 0x7e, 0x2f, 0x83, 0xf7, 0x65, 0x42, 0x96, 0x0d, 0x47, 0xd2, 0x8b,
-		0xe4, 0x8f, 0x89, 0xf3, 0x09, 0x77, 0xa4, 0x8b, 0x63, 0x9a, 0x1d, 0x1a, 0x53, 0xf4, 0x16, 0xc4,
-		0x6b, 0x58, 0x98, 0x6b, 0xc7, 0x6f, 0xb9, 0x8d, 0x73, 0xde, 0xe6, 0xcb, 0x63, 0x12, 0x8d, 0x9c,
-		0x93, 0x1b, 0x6a, 0x95, 0xa3, 0xf1, 0x6a, 0xe7, 0x62, 0xa8, 0xf0, 0xb7, 0x4c, 0x6f, 0xfc, 0x35,
-		0xd2, 0x70, 0xc3, 0xb8, 0x2e, 0xbd, 0xa9, 0x94, 0xd2, 0xa7, 0x32, 0x26, 0x0e, 0x4c, 0xe5, 0x70,
-		0xc7, 0x65, 0x3e, 0xb7, 0xe3, 0x5e, 0xc0, 0xe2, 0xc0, 0xf0, 0xa4, 0x1f, 0xdb, 0x72, 0xe1, 0xe8,
-		0xc1, 0x99, 0x19, 0x1c, 0x1c, 0x0c, 0x1b, 0x7e, 0xe0, 0x9e, 0xba, 0x9e, 0xd3, 0xb2, 0x2f, 0x24,
-		0x99, 0x3e, 0xea, 0xeb, 0x31, 0xb5, 0x96, 0x4c, 0xb6, 0xf0, 0xa7, 0x0c, 0x5c, 0x8d, 0xed, 0xa9,
-		0xec, 0x37, 0x9c, 0x96, 0xe6, 0x86, 0x1d, 0x87, 0x36, 0xce, 0xa6, 0x73, 0xd3, 0xff, 0x7d, 0xb9,
-		0x7e, 0x0e, 0xb7, 0x06, 0x33, 0xb0, 0xfd, 0x13, 0x9b, 0x9e, 0xb9, 0xa1, 0x9d, 0xac, 0xe2, 0x64,
-		0xc1, 0x6b, 0x03, 0x19, 0x55, 0x4e, 0xac, 0x33, 0x37, 0x14, 0x1e, 0x84, 0x6e, 0x02, 0xf0, 0x53,
-		0x02, 0xf5, 0xdf, 0x13, 0x8f, 0xd7, 0x79, 0x11, 0xf3, 0x63, 0x8d, 0xc5, 0x02, 0x85, 0xd7, 0x90,
-		0x4b, 0x9e, 0xa5, 0x9e, 0xc3, 0x9c, 0x38, 0x8e, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0xa3, 0x2f, 0x52,
-		0x8e, 0x63, 0xfc, 0xa4, 0x2a, 0x28, 0x85, 0x3f, 0x64, 0x60, 0x79, 0xf0, 0x16, 0xba, 0x0f, 0x2b,
-		0xc7, 0xae, 0xe7, 0x04, 0xe7, 0x76, 0xe3, 0x8c, 0x34, 0xde, 0x87, 0xdd, 0xb6, 0xd8, 0x84, 0xe5,
-		0x28, 0xac, 0x8a, 0x28, 0x5a, 0x87, 0xb9, 0xa0, 0xeb, 0xc5, 0x2f, 0xcb, 0x05, 0x3c, 0x1b, 0x74,
-		0xd9, 0xa9, 0xe2, 0x05, 0x5c, 0x3f, 0x71, 0x83, 0x90, 0xbd, 0x60, 0xa2, 0x66, 0xb7, 0x1b, 0x7e,
-		0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xd4, 0x18, 0xc0, 0xe9, 0x8b, 0x8d,
-		0x80, 0x38, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x4e, 0xe0, 0x85, 0x6d, 0x2e, 0x71, 0x23, 0x75, 0xbd,
-		0xd3, 0x69, 0xdb, 0x74, 0x31, 0x26, 0x70, 0x81, 0x5b, 0x00, 0xfc, 0x8c, 0x4b, 0x9d, 0xe3, 0x56,
-		0xf4, 0x16, 0x9a, 0xc7, 0x89, 0x48, 0xf1, 0x8f, 0x12, 0x5c, 0x1e, 0xf5, 0x8e, 0x45, 0x05, 0xb8,
-		0x55, 0xd5, 0x4d, 0xcd, 0x30, 0x5f, 0xd9, 0x8a, 0x6a, 0x19, 0x6f, 0x0d, 0xeb, 0xc8, 0xae, 0x59,
-		0x8a, 0xa5, 0xdb, 0x86, 0xf9, 0x56, 0x29, 0x1b, 0x9a, 0xfc, 0x7f, 0xe8, 0xff, 0x61, 0x73, 0x0c,
-		0xa6, 0xa6, 0xee, 0xeb, 0x5a, 0xbd, 0xac, 0x6b, 0xb2, 0x34, 0x41, 0xa9, 0x66, 0x29, 0xd8, 0xd2,
-		0x35, 0x39, 0x83, 0x7e, 0x00, 0xf7, 0xc7, 0x60, 0x54, 0xc5, 0x54, 0xf5, 0xb2, 0x8d, 0xf5, 0x9f,
-		0xd6, 0xf5, 0x1a, 0x03, 0x67, 0x8b, 0xbf, 0xe8, 0xe7, 0x3c, 0xe0, 0x40, 0xc9, 0x27, 0x69, 0xba,
-		0x6a, 0xd4, 0x8c, 0x8a, 0x39, 0x29, 0xe7, 0x0b, 0x98, 0x31, 0x39, 0x5f, 0x44, 0xc5, 0x39, 0x17,
-		0x7f, 0x99, 0xe9, 0x7f, 0x02, 0x1b, 0x4d, 0x4c, 0xba, 0xb1, 0xb7, 0xb2, 0x67, 0x1c, 0x56, 0xf0,
-		0x9b, 0xbd, 0x72, 0xe5, 0xd0, 0x36, 0x34, 0x1b, 0xeb, 0xf5, 0x9a, 0x6e, 0x57, 0x2b, 0x65, 0x43,
-		0x3d, 0x4a, 0x64, 0xf2, 0x43, 0xf8, 0x7a, 0x2c, 0x4a, 0x29, 0xb3, 0xa8, 0x56, 0xaf, 0x96, 0x0d,
-		0x95, 0x3d, 0x75, 0x4f, 0x31, 0xca, 0xba, 0x66, 0x57, 0xcc, 0xf2, 0x91, 0x2c, 0xa1, 0x2f, 0x61,
-		0x6b, 0x5a, 0xa6, 0x9c, 0x41, 0x0f, 0xe1, 0xc1, 0x58, 0x34, 0xd6, 0x5f, 0xeb, 0xaa, 0x95, 0x80,
-		0x67, 0xd1, 0x0e, 0x3c, 0x1c, 0x0b, 0xb7, 0x74, 0x7c, 0x60, 0x98, 0xbc, 0xa0, 0x7b, 0x36, 0xae,
-		0x9b, 0xa6, 0x61, 0xbe, 0x92, 0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0xa5, 0x83, 0x6e, 0xc3,
-		0xf5, 0xaa, 0x82, 0x75, 0xd3, 0xb2, 0xd5, 0x72, 0x65, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x5d, 0xc5,
-		0xd4, 0x2a, 0xa6, 0x2c, 0xa1, 0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0,
-		0x3b, 0x70, 0x73, 0x14, 0xae, 0x97, 0xad, 0x9c, 0x2d, 0xfe, 0x2b, 0x03, 0x37, 0x26, 0x7d, 0x69,
-		0xb3, 0x0e, 0xec, 0x2d, 0x5b, 0x7f, 0xa7, 0xab, 0x75, 0x8b, 0xed, 0x79, 0xa4, 0xc7, 0x76, 0xbe,
-		0x5e, 0x4b, 0x64, 0x9e, 0x2c, 0xe9, 0x18, 0xb0, 0x5a, 0x39, 0xa8, 0x96, 0x75, 0x8b, 0x77, 0x53,
-		0x11, 0xee, 0xa5, 0xc1, 0xa3, 0x0d, 0x96, 0x33, 0x03, 0x7b, 0x3b, 0x4e, 0x9a, 0xaf, 0x9b, 0x8d,
-		0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x26, 0xcf, 0xa0, 0xaf, 0xe1, 0xab, 0xf4, 0xc4,
-		0x4d, 0xcb, 0x30, 0xeb, 0xba, 0x66, 0x2b, 0x35, 0xdb, 0xd4, 0x0f, 0xe5, 0xd9, 0x69, 0x96, 0x6b,
-		0x19, 0x07, 0xac, 0x3f, 0xeb, 0x96, 0x3c, 0x57, 0xfc, 0xb3, 0x04, 0x57, 0x54, 0xdf, 0xa3, 0xae,
-		0xd7, 0x25, 0x4a, 0x68, 0x92, 0x8f, 0x46, 0x74, 0x9e, 0xf1, 0x03, 0x74, 0x17, 0xee, 0xc4, 0xfa,
-		0x42, 0xde, 0x36, 0x4c, 0xc3, 0x32, 0x14, 0xab, 0x82, 0x13, 0xf5, 0x9d, 0x08, 0x63, 0x03, 0xa9,
-		0xe9, 0x38, 0xaa, 0xeb, 0x78, 0x18, 0xd6, 0x2d, 0x7c, 0x24, 0x5a, 0x21, 0x72, 0x98, 0xf1, 0x58,
-		0x15, 0xb3, 0xf9, 0x16, 0xf3, 0x2f, 0x67, 0x8b, 0xbf, 0x97, 0x20, 0x27, 0xbe, 0x45, 0xf9, 0xa7,
-		0x4a, 0x1e, 0x2e, 0xb3, 0x05, 0x56, 0xea, 0x96, 0x6d, 0x1d, 0x55, 0xf5, 0xc1, 0x1e, 0x1e, 0xb8,
-		0xc3, 0xed, 0xc1, 0xb6, 0x2a, 0x51, 0x75, 0x22, 0x27, 0x19, 0x04, 0x88, 0xa7, 0x30, 0x0c, 0x07,
-		0xcb, 0x99, 0x89, 0x98, 0x48, 0x27, 0x8b, 0xae, 0xc1, 0x95, 0x01, 0xcc, 0xbe, 0xae, 0x60, 0x6b,
-		0x57, 0x57, 0x2c, 0x79, 0xa6, 0xf8, 0x1b, 0x09, 0xae, 0xc6, 0x4e, 0x68, 0xb1, 0x17, 0xab, 0xdb,
-		0x26, 0xcd, 0x4a, 0x97, 0xaa, 0x4e, 0x37, 0x24, 0xe8, 0x01, 0xdc, 0xed, 0x79, 0x98, 0xa5, 0xd4,
-		0xde, 0xf4, 0xf7, 0xca, 0x56, 0x15, 0x36, 0xdc, 0xfd, 0xd5, 0xa4, 0x42, 0x45, 0x0a, 0xb2, 0x84,
-		0xee, 0xc3, 0x17, 0x93, 0xa1, 0x58, 0xaf, 0xe9, 0x96, 0x9c, 0x29, 0xfe, 0x23, 0x07, 0x1b, 0xc9,
-		0xe4, 0xd8, 0x81, 0x9e, 0x34, 0xa3, 0xd4, 0xee, 0x41, 0x61, 0x50, 0x44, 0xf8, 0xdc, 0xc5, 0xbc,
-		0x76, 0xe0, 0xe1, 0x04, 0x5c, 0xdd, 0xdc, 0x57, 0x4c, 0x8d, 0x5d, 0xc7, 0x20, 0x59, 0x42, 0x2f,
-		0xe1, 0xf9, 0x04, 0xca, 0xae, 0xa2, 0xf5, 0xab, 0xdc, 0x7b, 0xe3, 0x28, 0x96, 0x85, 0x8d, 0xdd,
-		0xba, 0xa5, 0xd7, 0xe4, 0x0c, 0xd2, 0x41, 0x49, 0x11, 0x18, 0xf4, 0xa1, 0x91, 0x32, 0x59, 0xf4,
-		0x0c, 0x9e, 0xa4, 0xe5, 0x11, 0xb5, 0x8c, 0x71, 0xa0, 0xe3, 0x24, 0x75, 0x06, 0x7d, 0x0b, 0xdf,
-		0xa4, 0x50, 0xc5, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x39, 0x3c, 0x4d, 0xcd, 0x5e, 0xad, 0x60, 0xcd,
-		0x3e, 0x50, 0xf0, 0x9b, 0x41, 0xf2, 0x1c, 0x32, 0x40, 0x4f, 0x7b, 0xb0, 0x70, 0x37, 0x7b, 0x84,
-		0x2f, 0x24, 0xa4, 0x2e, 0x4d, 0x51, 0x45, 0x16, 0x48, 0x91, 0x99, 0x47, 0xaf, 0x40, 0x9d, 0xae,
-		0x14, 0x93, 0x85, 0x16, 0xd0, 0x3b, 0xb0, 0x3e, 0x6f, 0x57, 0xf5, 0x77, 0x96, 0x8e, 0x4d, 0x25,
-		0x4d, 0x19, 0xd0, 0x0b, 0x78, 0x96, 0x5a, 0xb4, 0x41, 0xff, 0x49, 0xd0, 0x73, 0xe8, 0x29, 0x3c,
-		0x9e, 0x40, 0x4f, 0xf6, 0x48, 0xff, 0x54, 0x60, 0x68, 0xf2, 0x22, 0x7a, 0x02, 0x3b, 0x13, 0x88,
-		0x7c, 0x0a, 0xed, 0x9a, 0x65, 0xa8, 0x6f, 0x8e, 0xa2, 0xdb, 0x65, 0xa3, 0x66, 0xc9, 0x4b, 0xe8,
-		0x27, 0xf0, 0xa3, 0x09, 0xb4, 0xde, 0x62, 0xd9, 0x1f, 0x3a, 0x4e, 0x8c, 0x18, 0x83, 0xd5, 0xb1,
-		0x2e, 0x2f, 0x4f, 0xb1, 0x27, 0x35, 0xe3, 0x55, 0x7a, 0xe5, 0x56, 0x90, 0x0a, 0x2f, 0xa7, 0x1a,
-		0x11, 0x75, 0xdf, 0x28, 0x6b, 0xa3, 0x45, 0x64, 0xf4, 0x18, 0xb6, 0x27, 0x88, 0xec, 0x55, 0xb0,
-		0xaa, 0x8b, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x37, 0xf0, 0x68, 0x12, 0x49, 0x31, 0xca, 0x95,
-		0xb7, 0x3a, 0xbe, 0xc8, 0x43, 0xec, 0x35, 0x3a, 0xdd, 0xd2, 0x0d, 0xb3, 0x5a, 0xb7, 0xec, 0x9a,
-		0xf1, 0x9d, 0x2e, 0xaf, 0xb1, 0xd7, 0x68, 0xea, 0x4e, 0xc5, 0xb5, 0x92, 0x2f, 0x0f, 0x9b, 0xf1,
-		0xd0, 0x43, 0x76, 0x0d, 0x53, 0xc1, 0x47, 0xf2, 0x7a, 0x4a, 0xef, 0x0d, 0x1b, 0xdd, 0x40, 0x0b,
-		0x5d, 0x99, 0x66, 0x39, 0xba, 0x82, 0xd5, 0xfd, 0x64, 0xc5, 0x37, 0xd8, 0x5b, 0xe7, 0x0e, 0xff,
-		0xc7, 0xca, 0xd0, 0xb9, 0x2a, 0x69, 0xf1, 0x3b, 0xf0, 0x30, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6,
-		0xed, 0x77, 0xe1, 0xc7, 0xd3, 0x51, 0x7a, 0xf7, 0x95, 0x32, 0xd6, 0x15, 0xed, 0xa8, 0x77, 0x24,
-		0x95, 0x8a, 0x7f, 0x95, 0xa0, 0xa8, 0x3a, 0x5e, 0x83, 0xb4, 0xe2, 0xff, 0xbb, 0x4e, 0xcc, 0xf2,
-		0x39, 0x3c, 0x9d, 0x62, 0xde, 0xc7, 0xe4, 0x7b, 0x08, 0xb5, 0xcf, 0x25, 0xd7, 0xcd, 0x37, 0x66,
-		0xe5, 0xd0, 0x9c, 0x44, 0x10, 0x8b, 0xa8, 0xb9, 0xa7, 0xfc, 0x9f, 0xc6, 0xd3, 0x2d, 0x42, 0xb4,
-		0xdd, 0x7f, 0xb6, 0x88, 0xcf, 0x25, 0x4f, 0xb5, 0x88, 0xdd, 0xb7, 0xb0, 0xd1, 0xf0, 0xdb, 0xa3,
-		0xbe, 0xe2, 0x77, 0xe7, 0x95, 0x8e, 0x5b, 0x65, 0x5f, 0xb0, 0x55, 0xe9, 0xbb, 0xed, 0x53, 0x97,
-		0x9e, 0x75, 0x8f, 0x4b, 0x0d, 0xbf, 0xbd, 0x3d, 0xf0, 0xfb, 0x63, 0xe9, 0x94, 0x78, 0xd1, 0xaf,
-		0x99, 0xe2, 0xa7, 0xc8, 0xe7, 0x4e, 0xc7, 0xfd, 0xb0, 0x73, 0x3c, 0xc7, 0x63, 0x8f, 0xff, 0x1d,
-		0x00, 0x00, 0xff, 0xff, 0x1c, 0xfe, 0xdc, 0x7d, 0x4a, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+		0x15, 0xad, 0x24, 0xdb, 0xb1, 0xaf, 0xfc, 0x41, 0x8f, 0xe3, 0xb5, 0xf2, 0xed, 0x68, 0x9b, 0xc4,
+		0x51, 0x37, 0xf2, 0x3a, 0xd9, 0x6c, 0x9a, 0x4d, 0xb3, 0x29, 0x4d, 0x8e, 0x63, 0x26, 0x32, 0xa5,
+		0x0e, 0xa9, 0x38, 0x5e, 0x14, 0x25, 0x68, 0x69, 0x6c, 0x13, 0x91, 0x48, 0x81, 0x1c, 0x25, 0xf1,
+		0xfb, 0x02, 0x7d, 0xee, 0x5b, 0xb1, 0x7d, 0x29, 0xfa, 0x5c, 0xa0, 0xe8, 0x0f, 0x28, 0x0a, 0xf4,
+		0xa1, 0x6f, 0xfd, 0x21, 0xfd, 0x17, 0xc5, 0x0c, 0x49, 0x89, 0xb2, 0x3e, 0xa8, 0xb4, 0xc0, 0xf6,
+		0xcd, 0xbc, 0x73, 0xce, 0xe5, 0x99, 0x3b, 0x73, 0xcf, 0x0c, 0x65, 0x28, 0x76, 0x8f, 0xa9, 0xbf,
+		0xdd, 0xb0, 0x9b, 0xd4, 0x6d, 0xd0, 0x6d, 0xbb, 0xe3, 0x6c, 0xbf, 0xdf, 0xd9, 0xfe, 0xe0, 0xf9,
+		0xef, 0x4e, 0x5a, 0xde, 0x87, 0x72, 0xc7, 0xf7, 0x98, 0x87, 0xd6, 0x38, 0xa6, 0x1c, 0x61, 0xca,
+		0x76, 0xc7, 0x29, 0xbf, 0xdf, 0xb9, 0x7a, 0xf3, 0xd4, 0xf3, 0x4e, 0x5b, 0x74, 0x5b, 0x40, 0x8e,
+		0xbb, 0x27, 0xdb, 0xcd, 0xae, 0x6f, 0x33, 0xc7, 0x73, 0x43, 0xd2, 0xd5, 0x5b, 0x17, 0xc7, 0x99,
+		0xd3, 0xa6, 0x01, 0xb3, 0xdb, 0x9d, 0x08, 0xb0, 0x39, 0xea, 0xcd, 0x0d, 0xaf, 0xdd, 0xee, 0xa5,
+		0x18, 0xa9, 0x8d, 0xd9, 0xc1, 0xbb, 0x96, 0x13, 0xb0, 0x10, 0x53, 0xfc, 0xfe, 0x12, 0xac, 0x1f,
+		0x46, 0x72, 0xf1, 0x47, 0xda, 0xe8, 0x72, 0x09, 0x9a, 0x7b, 0xe2, 0xa1, 0x3a, 0xa0, 0x78, 0x1e,
+		0x16, 0x8d, 0x47, 0x0a, 0x99, 0xcd, 0xcc, 0x56, 0xfe, 0xe1, 0xdd, 0xf2, 0x88, 0x29, 0x95, 0x87,
+		0xf2, 0x90, 0xd5, 0x0f, 0x17, 0x43, 0xe8, 0x31, 0xcc, 0xb0, 0xf3, 0x0e, 0x2d, 0x64, 0x45, 0xa2,
+		0xdb, 0x13, 0x13, 0x99, 0xe7, 0x1d, 0x4a, 0x04, 0x1c, 0x3d, 0x05, 0x08, 0x98, 0xed, 0x33, 0x8b,
+		0x97, 0xa1, 0x90, 0x13, 0xe4, 0xab, 0xe5, 0xb0, 0x46, 0xe5, 0xb8, 0x46, 0x65, 0x33, 0xae, 0x11,
+		0x59, 0x10, 0x68, 0xfe, 0xcc, 0xa9, 0x8d, 0x96, 0x17, 0xd0, 0x90, 0x3a, 0x93, 0x4e, 0x15, 0x68,
+		0x41, 0x35, 0x61, 0x31, 0xa4, 0x06, 0xcc, 0x66, 0xdd, 0xa0, 0x30, 0xbb, 0x99, 0xd9, 0x5a, 0x7e,
+		0xb8, 0x33, 0xdd, 0xec, 0x15, 0xce, 0x34, 0x04, 0x91, 0xe4, 0x1b, 0xfd, 0x07, 0x74, 0x07, 0x96,
+		0xcf, 0x9c, 0x80, 0x79, 0xfe, 0xb9, 0xd5, 0xa2, 0xee, 0x29, 0x3b, 0x2b, 0xcc, 0x6d, 0x66, 0xb6,
+		0x72, 0x64, 0x29, 0x8a, 0x56, 0x44, 0x10, 0xfd, 0x1a, 0xd6, 0x3b, 0xb6, 0x4f, 0x5d, 0xd6, 0x2f,
+		0xbf, 0xe5, 0xb8, 0x27, 0x5e, 0xe1, 0x92, 0x98, 0xc2, 0xd6, 0x48, 0x15, 0x35, 0xc1, 0x18, 0x58,
+		0x49, 0xb2, 0xd6, 0x19, 0x0e, 0x22, 0x19, 0x96, 0xfb, 0x69, 0x45, 0x65, 0xe6, 0x53, 0x2b, 0xb3,
+		0xd4, 0x63, 0x88, 0xea, 0x3c, 0x80, 0x99, 0x36, 0x6d, 0x7b, 0x85, 0x05, 0x41, 0xbc, 0x32, 0x52,
+		0xcf, 0x01, 0x6d, 0x7b, 0x44, 0xc0, 0x10, 0x81, 0xd5, 0x80, 0xda, 0x7e, 0xe3, 0xcc, 0xb2, 0x19,
+		0xf3, 0x9d, 0xe3, 0x2e, 0xa3, 0x41, 0x01, 0x04, 0xf7, 0xce, 0x48, 0xae, 0x21, 0xd0, 0x72, 0x0f,
+		0x4c, 0xa4, 0xe0, 0x42, 0x04, 0x55, 0x60, 0xd5, 0xee, 0x32, 0xcf, 0xf2, 0x69, 0x40, 0x99, 0xd5,
+		0xf1, 0x1c, 0x97, 0x05, 0x85, 0xbc, 0xc8, 0xb9, 0x39, 0x32, 0x27, 0xe1, 0xc0, 0x9a, 0xc0, 0x91,
+		0x15, 0x4e, 0x4d, 0x04, 0xd0, 0x35, 0x58, 0xe0, 0xed, 0x61, 0xf1, 0xfe, 0x28, 0x2c, 0x6e, 0x66,
+		0xb6, 0x16, 0xc8, 0x3c, 0x0f, 0x54, 0x9c, 0x80, 0xa1, 0x0d, 0xb8, 0xe4, 0x04, 0x56, 0xc3, 0xf7,
+		0xdc, 0xc2, 0xd2, 0x66, 0x66, 0x6b, 0x9e, 0xcc, 0x39, 0x81, 0xe2, 0x7b, 0x2e, 0xda, 0x85, 0x15,
+		0x97, 0x7e, 0x64, 0x96, 0x4f, 0x99, 0x7f, 0x1e, 0x96, 0x72, 0x39, 0xbd, 0x94, 0x9c, 0x42, 0x38,
+		0x83, 0xc7, 0x8a, 0xbf, 0xcf, 0xc2, 0xcd, 0xe1, 0x0d, 0xe4, 0xb9, 0x27, 0xce, 0x69, 0x64, 0x0b,
+		0xe8, 0x9b, 0xa4, 0xb8, 0xb0, 0x0d, 0x6f, 0x8c, 0x9c, 0xa2, 0x19, 0x29, 0x4e, 0x68, 0xb7, 0x61,
+		0xb3, 0xbf, 0xd8, 0x51, 0x1f, 0x79, 0x56, 0xbf, 0x2b, 0xbc, 0x2e, 0x8b, 0x1a, 0xf2, 0xca, 0x90,
+		0x66, 0x35, 0x12, 0x40, 0xae, 0xf7, 0x52, 0x18, 0xa2, 0xb7, 0x3c, 0x25, 0xee, 0x13, 0xaf, 0xcb,
+		0xd0, 0x21, 0x5c, 0x13, 0xf2, 0xc6, 0x64, 0xcf, 0xa5, 0x65, 0xdf, 0xe0, 0xec, 0x11, 0x89, 0x8b,
+		0xff, 0xca, 0xc0, 0xda, 0x88, 0x5d, 0xcd, 0x17, 0xab, 0xe9, 0xb5, 0x6d, 0xc7, 0xb5, 0x9c, 0xa6,
+		0xa8, 0xc7, 0x02, 0x99, 0x0f, 0x03, 0x5a, 0x13, 0xdd, 0x82, 0x7c, 0x34, 0xe8, 0xda, 0xed, 0xd0,
+		0x6c, 0x16, 0x08, 0x84, 0x21, 0xdd, 0x6e, 0xd3, 0x31, 0xee, 0x96, 0xfb, 0x5f, 0xdd, 0xed, 0x36,
+		0x2c, 0x3a, 0xae, 0xc3, 0x1c, 0x9b, 0xd1, 0x26, 0xd7, 0x35, 0x23, 0x1a, 0x3b, 0xdf, 0x8b, 0x69,
+		0xcd, 0xe2, 0xef, 0x32, 0xb0, 0x8e, 0x3f, 0x32, 0xea, 0xbb, 0x76, 0xeb, 0x47, 0x71, 0xdc, 0x8b,
+		0x9a, 0xb2, 0xc3, 0x9a, 0xfe, 0x34, 0x07, 0x6b, 0x35, 0xea, 0x36, 0x1d, 0xf7, 0x54, 0x6e, 0x30,
+		0xe7, 0xbd, 0xc3, 0xce, 0x85, 0xa2, 0x5b, 0x90, 0xb7, 0xa3, 0xe7, 0x7e, 0x95, 0x21, 0x0e, 0x69,
+		0x4d, 0xb4, 0x07, 0x4b, 0x3d, 0x40, 0xaa, 0xad, 0xc7, 0xa9, 0x85, 0xad, 0x2f, 0xda, 0x89, 0x27,
+		0xf4, 0x02, 0x66, 0xb9, 0xc5, 0x86, 0xce, 0xbe, 0xfc, 0xf0, 0xfe, 0x68, 0x6f, 0x1b, 0x54, 0xc8,
+		0xdd, 0x94, 0x92, 0x90, 0x87, 0x34, 0x58, 0x3d, 0xa3, 0xb6, 0xcf, 0x8e, 0xa9, 0xcd, 0xac, 0x26,
+		0x65, 0xb6, 0xd3, 0x0a, 0x22, 0xaf, 0xbf, 0x3e, 0xc6, 0x28, 0xcf, 0x5b, 0x9e, 0xdd, 0x24, 0x52,
+		0x8f, 0xa6, 0x86, 0x2c, 0xf4, 0x0a, 0xd6, 0x5a, 0x76, 0xc0, 0xac, 0x7e, 0x3e, 0xd1, 0xd3, 0xb3,
+		0xa9, 0x3d, 0xbd, 0xca, 0x69, 0xfb, 0x31, 0x8b, 0xc7, 0xd1, 0x1e, 0x88, 0x60, 0xd8, 0x15, 0xb4,
+		0x19, 0x66, 0x9a, 0x4b, 0xcd, 0xb4, 0xc2, 0x49, 0x46, 0xc8, 0x11, 0x79, 0x0a, 0x70, 0xc9, 0x66,
+		0x8c, 0xb6, 0x3b, 0x4c, 0xb8, 0xff, 0x2c, 0x89, 0x1f, 0xd1, 0x7d, 0x90, 0xda, 0xf6, 0x47, 0xa7,
+		0xdd, 0x6d, 0x5b, 0x51, 0x28, 0x10, 0x4e, 0x3e, 0x4b, 0x56, 0xa2, 0xb8, 0x1c, 0x85, 0xb9, 0xe5,
+		0x07, 0x8d, 0x33, 0xda, 0xec, 0xb6, 0x62, 0x25, 0x0b, 0xe9, 0x3e, 0xd5, 0x63, 0x08, 0x1d, 0x0a,
+		0xac, 0xd0, 0x8f, 0x1d, 0x27, 0xec, 0xd9, 0x30, 0x07, 0xa4, 0xe6, 0x58, 0xee, 0x53, 0x44, 0x92,
+		0x17, 0xb0, 0x28, 0x8a, 0x72, 0x62, 0x3b, 0xad, 0xae, 0x4f, 0x0b, 0xf9, 0x09, 0xcb, 0xb4, 0x17,
+		0x62, 0x48, 0x9e, 0x33, 0xa2, 0x07, 0xf4, 0x25, 0x5c, 0x16, 0x09, 0xf8, 0x5e, 0xa7, 0xbe, 0xe5,
+		0x34, 0xa9, 0xcb, 0x1c, 0x76, 0x1e, 0x59, 0x36, 0xe2, 0x63, 0x87, 0x62, 0x48, 0x8b, 0x46, 0x46,
+		0x79, 0xf4, 0xd2, 0xa7, 0x7a, 0xf4, 0x1f, 0xb2, 0x70, 0x25, 0xda, 0x82, 0xca, 0x99, 0xd3, 0x6a,
+		0xfe, 0x28, 0xcd, 0xfb, 0x45, 0x22, 0x2d, 0x6f, 0xb0, 0xa4, 0x9f, 0x49, 0x1f, 0x12, 0xf7, 0x24,
+		0xe1, 0x6a, 0x17, 0x5b, 0x3d, 0x37, 0xd4, 0xea, 0xe8, 0x0d, 0x44, 0xd7, 0x81, 0xc8, 0xa0, 0x3b,
+		0x5e, 0xcb, 0x69, 0x9c, 0x8b, 0x56, 0x59, 0x1e, 0x23, 0x34, 0x74, 0x5f, 0x61, 0xca, 0x35, 0x81,
+		0x26, 0xab, 0x9d, 0x8b, 0xa1, 0xe2, 0x3f, 0xb3, 0x3d, 0x0b, 0x51, 0x69, 0xc3, 0x09, 0xe2, 0xba,
+		0xf4, 0x3a, 0x3b, 0x93, 0xde, 0xd9, 0x31, 0x71, 0xa0, 0xb3, 0x87, 0x77, 0x6d, 0xf6, 0x53, 0x77,
+		0xed, 0x73, 0x58, 0x1c, 0x68, 0xc0, 0xf4, 0xeb, 0x63, 0x3e, 0x18, 0xdd, 0x7c, 0x33, 0x83, 0xcd,
+		0x47, 0x60, 0xc3, 0xf3, 0x9d, 0x53, 0xc7, 0xb5, 0x5b, 0xd6, 0x05, 0x91, 0xe9, 0x76, 0xb1, 0x1e,
+		0x53, 0x8d, 0xa4, 0xd8, 0xe2, 0xdf, 0xb2, 0x70, 0x25, 0xb6, 0xb8, 0x8a, 0xd7, 0xb0, 0x5b, 0xaa,
+		0x13, 0x74, 0x6c, 0xd6, 0x38, 0x9b, 0xce, 0x91, 0xff, 0xff, 0xe5, 0xfa, 0x0d, 0xdc, 0x1c, 0x54,
+		0x60, 0x79, 0x27, 0x16, 0x3b, 0x73, 0x02, 0x2b, 0x59, 0xc5, 0xc9, 0x09, 0xaf, 0x0e, 0x28, 0xaa,
+		0x9e, 0x98, 0x67, 0x4e, 0x10, 0xf9, 0x18, 0xba, 0x01, 0x20, 0x6e, 0x1a, 0xcc, 0x7b, 0x47, 0x5d,
+		0x51, 0xe7, 0x45, 0x22, 0xae, 0x46, 0x26, 0x0f, 0x14, 0x5f, 0x41, 0x3e, 0x79, 0xa7, 0x7b, 0x06,
+		0x73, 0xd1, 0xb5, 0x30, 0xb3, 0x99, 0xdb, 0xca, 0x3f, 0xfc, 0x3c, 0xe5, 0x5a, 0x28, 0x6e, 0xcc,
+		0x11, 0xa5, 0xf8, 0x97, 0x2c, 0x2c, 0x0f, 0x0e, 0xa1, 0x7b, 0xb0, 0x72, 0xec, 0xb8, 0xb6, 0x7f,
+		0x6e, 0x35, 0xce, 0x68, 0xe3, 0x5d, 0xd0, 0x6d, 0x47, 0x8b, 0xb0, 0x1c, 0x86, 0x95, 0x28, 0x8a,
+		0xd6, 0x61, 0xce, 0xef, 0xba, 0xf1, 0x81, 0xbb, 0x40, 0x66, 0xfd, 0x2e, 0xbf, 0x99, 0x3c, 0x87,
+		0x6b, 0x27, 0x8e, 0x1f, 0xf0, 0x43, 0x2a, 0xdc, 0xec, 0x56, 0xc3, 0x6b, 0x77, 0x5a, 0x74, 0xa0,
+		0x63, 0x0b, 0x02, 0x12, 0xb7, 0x83, 0x12, 0x03, 0x04, 0x7d, 0xb1, 0xe1, 0x53, 0xbb, 0xb7, 0x36,
+		0xe9, 0xa5, 0xcc, 0x47, 0xf8, 0xc8, 0x7a, 0x97, 0x84, 0x19, 0x3b, 0xee, 0xe9, 0xb4, 0xdb, 0x74,
+		0x31, 0x26, 0x88, 0x04, 0x37, 0x01, 0xc4, 0x5d, 0x9b, 0xd9, 0xc7, 0xad, 0xf0, 0x24, 0x9b, 0x27,
+		0x89, 0x48, 0xe9, 0xaf, 0x19, 0xb8, 0x3c, 0xea, 0x9c, 0x46, 0x45, 0xb8, 0x59, 0xc3, 0xba, 0xaa,
+		0xe9, 0x2f, 0x2d, 0x59, 0x31, 0xb5, 0x37, 0x9a, 0x79, 0x64, 0x19, 0xa6, 0x6c, 0x62, 0x4b, 0xd3,
+		0xdf, 0xc8, 0x15, 0x4d, 0x95, 0x7e, 0x82, 0x7e, 0x0a, 0x9b, 0x63, 0x30, 0x86, 0xb2, 0x8f, 0xd5,
+		0x7a, 0x05, 0xab, 0x52, 0x66, 0x42, 0x26, 0xc3, 0x94, 0x89, 0x89, 0x55, 0x29, 0x8b, 0x7e, 0x06,
+		0xf7, 0xc6, 0x60, 0x14, 0x59, 0x57, 0x70, 0xc5, 0x22, 0xf8, 0x57, 0x75, 0x6c, 0x70, 0x70, 0xae,
+		0xf4, 0x7d, 0x5f, 0xf3, 0x80, 0x03, 0x25, 0xdf, 0xa4, 0x62, 0x45, 0x33, 0xb4, 0xaa, 0x3e, 0x49,
+		0xf3, 0x05, 0xcc, 0x18, 0xcd, 0x17, 0x51, 0xb1, 0xe6, 0xd2, 0x6f, 0xb3, 0xfd, 0x4f, 0x71, 0xad,
+		0x49, 0x68, 0x37, 0xf6, 0x56, 0xfe, 0x8e, 0xc3, 0x2a, 0x79, 0xbd, 0x57, 0xa9, 0x1e, 0x5a, 0x9a,
+		0x6a, 0x11, 0x5c, 0x37, 0xb0, 0x55, 0xab, 0x56, 0x34, 0xe5, 0x28, 0xa1, 0xe4, 0xe7, 0xf0, 0xd5,
+		0x58, 0x94, 0x5c, 0xe1, 0x51, 0xb5, 0x5e, 0xab, 0x68, 0x0a, 0x7f, 0xeb, 0x9e, 0xac, 0x55, 0xb0,
+		0x6a, 0x55, 0xf5, 0xca, 0x91, 0x94, 0x41, 0x5f, 0xc0, 0xd6, 0xb4, 0x4c, 0x29, 0x8b, 0x1e, 0xc0,
+		0xfd, 0xb1, 0x68, 0x82, 0x5f, 0x61, 0xc5, 0x4c, 0xc0, 0x73, 0x68, 0x07, 0x1e, 0x8c, 0x85, 0x9b,
+		0x98, 0x1c, 0x68, 0xba, 0x28, 0xe8, 0x9e, 0x45, 0xea, 0xba, 0xae, 0xe9, 0x2f, 0xa5, 0x99, 0xd2,
+		0x1f, 0x33, 0xb0, 0x3a, 0x74, 0xe8, 0xa0, 0x5b, 0x70, 0xad, 0x26, 0x13, 0xac, 0x9b, 0x96, 0x52,
+		0xa9, 0x8e, 0x2a, 0xc0, 0x18, 0x80, 0xbc, 0x2b, 0xeb, 0x6a, 0x55, 0x97, 0x32, 0xe8, 0x2e, 0x14,
+		0x47, 0x01, 0xa2, 0xbd, 0x10, 0x6d, 0x0d, 0x29, 0x8b, 0x6e, 0xc3, 0x8d, 0x51, 0xb8, 0x9e, 0x5a,
+		0x29, 0x57, 0xfa, 0x77, 0x16, 0xae, 0x4f, 0xfa, 0xe2, 0xe7, 0x3b, 0xb0, 0x37, 0x6d, 0xfc, 0x16,
+		0x2b, 0x75, 0x93, 0xaf, 0x79, 0x98, 0x8f, 0xaf, 0x7c, 0xdd, 0x48, 0x28, 0x4f, 0x96, 0x74, 0x0c,
+		0x58, 0xa9, 0x1e, 0xd4, 0x2a, 0xd8, 0x14, 0xbb, 0xa9, 0x04, 0x77, 0xd3, 0xe0, 0xe1, 0x02, 0x4b,
+		0xd9, 0x81, 0xb5, 0x1d, 0x97, 0x5a, 0xcc, 0x9b, 0xb7, 0x02, 0x2a, 0x43, 0x29, 0x0d, 0xdd, 0xab,
+		0x82, 0x2a, 0xcd, 0xa0, 0xaf, 0xe0, 0xcb, 0x74, 0xe1, 0xba, 0xa9, 0xe9, 0x75, 0xac, 0x5a, 0xb2,
+		0x61, 0xe9, 0xf8, 0x50, 0x9a, 0x9d, 0x66, 0xba, 0xa6, 0x76, 0xc0, 0xf7, 0x67, 0xdd, 0x94, 0xe6,
+		0x4a, 0x7f, 0xcf, 0xc0, 0x67, 0x8a, 0xe7, 0x32, 0xc7, 0xed, 0x52, 0x39, 0xd0, 0xe9, 0x07, 0x2d,
+		0xbc, 0xcf, 0x78, 0x3e, 0xba, 0x03, 0xb7, 0xe3, 0xfc, 0x51, 0x7a, 0x4b, 0xd3, 0x35, 0x53, 0x93,
+		0xcd, 0x2a, 0x49, 0xd4, 0x77, 0x22, 0x8c, 0x37, 0xa4, 0x8a, 0x49, 0x58, 0xd7, 0xf1, 0x30, 0x82,
+		0x4d, 0x72, 0x14, 0x6d, 0x85, 0xd0, 0x61, 0xc6, 0x63, 0x15, 0x52, 0xd5, 0x7b, 0xfd, 0x2f, 0xe5,
+		0x4a, 0x7f, 0xce, 0x40, 0x3e, 0xfa, 0x9e, 0x15, 0x9f, 0x3b, 0x05, 0xb8, 0xcc, 0x27, 0x58, 0xad,
+		0x9b, 0x96, 0x79, 0x54, 0xc3, 0x83, 0x7b, 0x78, 0x60, 0x44, 0xd8, 0x83, 0x65, 0x56, 0xc3, 0xea,
+		0x84, 0x4e, 0x32, 0x08, 0x88, 0xde, 0xc2, 0x31, 0x02, 0x2c, 0x65, 0x27, 0x62, 0xc2, 0x3c, 0x39,
+		0x74, 0x15, 0x3e, 0x1b, 0xc0, 0xec, 0x63, 0x99, 0x98, 0xbb, 0x58, 0x36, 0xa5, 0x99, 0xd2, 0x0f,
+		0x19, 0xb8, 0x12, 0x3b, 0x21, 0xff, 0x35, 0x81, 0x4b, 0x6f, 0x56, 0xbb, 0x4c, 0xb1, 0xbb, 0x01,
+		0x45, 0xf7, 0xe1, 0x4e, 0xcf, 0xc3, 0x4c, 0xd9, 0x78, 0xdd, 0x5f, 0x2b, 0x4b, 0x91, 0xeb, 0x46,
+		0x72, 0x36, 0xa9, 0xd0, 0x48, 0x82, 0x94, 0x41, 0xf7, 0xe0, 0xf3, 0xc9, 0x50, 0x82, 0x0d, 0x6c,
+		0x4a, 0xd9, 0xd2, 0x0f, 0x8b, 0xb0, 0x91, 0x14, 0xc7, 0x3f, 0x0a, 0x68, 0x33, 0x94, 0x76, 0x17,
+		0x8a, 0x83, 0x49, 0x22, 0x9f, 0xbb, 0xa8, 0x6b, 0x07, 0x1e, 0x4c, 0xc0, 0xd5, 0xf5, 0x7d, 0x59,
+		0x57, 0xf9, 0x73, 0x0c, 0x92, 0x32, 0xe8, 0x05, 0x3c, 0x9b, 0x40, 0xd9, 0x95, 0xd5, 0x7e, 0x95,
+		0x7b, 0x27, 0x8e, 0x6c, 0x9a, 0x44, 0xdb, 0xad, 0x9b, 0xd8, 0x90, 0xb2, 0x08, 0x83, 0x9c, 0x92,
+		0x60, 0xd0, 0x87, 0x46, 0xa6, 0xc9, 0xa1, 0xa7, 0xf0, 0x38, 0x4d, 0x47, 0xb8, 0x65, 0xb4, 0x03,
+		0x4c, 0x92, 0xd4, 0x19, 0xf4, 0x0d, 0x7c, 0x9d, 0x42, 0x8d, 0xde, 0x3c, 0xc4, 0x9d, 0x45, 0xcf,
+		0xe0, 0x49, 0xaa, 0x7a, 0xa5, 0x4a, 0x54, 0xeb, 0x40, 0x26, 0xaf, 0x07, 0xc9, 0x73, 0x48, 0x03,
+		0x9c, 0xf6, 0xe2, 0xc8, 0xdd, 0xac, 0x11, 0xbe, 0x90, 0x48, 0x75, 0x69, 0x8a, 0x2a, 0xf2, 0x40,
+		0x4a, 0x9a, 0x79, 0xf4, 0x12, 0x94, 0xe9, 0x4a, 0x31, 0x39, 0xd1, 0x02, 0x7a, 0x0b, 0xe6, 0xa7,
+		0xad, 0x2a, 0x7e, 0x6b, 0x62, 0xa2, 0xcb, 0x69, 0x99, 0x01, 0x3d, 0x87, 0xa7, 0xa9, 0x45, 0x1b,
+		0xf4, 0x9f, 0x04, 0x3d, 0x8f, 0x9e, 0xc0, 0xa3, 0x09, 0xf4, 0xe4, 0x1e, 0xe9, 0xdf, 0x0a, 0x34,
+		0x55, 0x5a, 0x44, 0x8f, 0x61, 0x67, 0x02, 0x51, 0x74, 0xa1, 0x65, 0x98, 0x9a, 0xf2, 0xfa, 0x28,
+		0x1c, 0xae, 0x68, 0x86, 0x29, 0x2d, 0xa1, 0x5f, 0xc2, 0x2f, 0x26, 0xd0, 0x7a, 0x93, 0xe5, 0x7f,
+		0x60, 0x92, 0x68, 0x31, 0x0e, 0xab, 0x13, 0x2c, 0x2d, 0x4f, 0xb1, 0x26, 0x86, 0xf6, 0x32, 0xbd,
+		0x72, 0x2b, 0x48, 0x81, 0x17, 0x53, 0xb5, 0x88, 0xb2, 0xaf, 0x55, 0xd4, 0xd1, 0x49, 0x24, 0xf4,
+		0x08, 0xb6, 0x27, 0x24, 0xd9, 0xab, 0x12, 0x05, 0x47, 0x27, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0xd7,
+		0xf0, 0x70, 0x12, 0x49, 0xd6, 0x2a, 0xd5, 0x37, 0x98, 0x5c, 0xe4, 0x21, 0x7e, 0x8c, 0x4e, 0x37,
+		0x75, 0x4d, 0xaf, 0xd5, 0x4d, 0xcb, 0xd0, 0xbe, 0xc3, 0xd2, 0x1a, 0x3f, 0x46, 0x53, 0x57, 0x2a,
+		0xae, 0x95, 0x74, 0x79, 0xd8, 0x8c, 0x87, 0x5e, 0xb2, 0xab, 0xe9, 0x32, 0x39, 0x92, 0xd6, 0x53,
+		0xf6, 0xde, 0xb0, 0xd1, 0x0d, 0x6c, 0xa1, 0xcf, 0xa6, 0x99, 0x0e, 0x96, 0x89, 0xb2, 0x9f, 0xac,
+		0xf8, 0x06, 0xda, 0x85, 0x6f, 0x53, 0x58, 0xf5, 0x9a, 0x81, 0x49, 0x7f, 0x4e, 0xd6, 0x01, 0x3e,
+		0xa8, 0x26, 0x73, 0x14, 0xf8, 0xc9, 0x75, 0x5b, 0xfc, 0x38, 0x33, 0x74, 0x37, 0x4b, 0x1e, 0x13,
+		0x3b, 0xf0, 0x20, 0x5c, 0xfb, 0x11, 0x3b, 0x69, 0xcc, 0x89, 0xb1, 0x0b, 0xdf, 0x4e, 0x47, 0xe9,
+		0x8d, 0xcb, 0x15, 0x82, 0x65, 0xf5, 0xa8, 0x77, 0xad, 0xcd, 0x94, 0xfe, 0x91, 0x81, 0x92, 0x62,
+		0xbb, 0x0d, 0xda, 0x8a, 0x7f, 0xff, 0x9d, 0xa8, 0xf2, 0x19, 0x3c, 0x99, 0xc2, 0x33, 0xc6, 0xe8,
+		0x3d, 0x04, 0xe3, 0x53, 0xc9, 0x75, 0xfd, 0xb5, 0x5e, 0x3d, 0xd4, 0x27, 0x11, 0xa2, 0x49, 0x18,
+		0xce, 0xa9, 0x6b, 0x4f, 0x3d, 0x89, 0x68, 0xeb, 0xfe, 0x77, 0x93, 0xf8, 0x54, 0xf2, 0x54, 0x93,
+		0xd8, 0x7d, 0x03, 0x1b, 0x0d, 0xaf, 0x3d, 0xea, 0x97, 0x80, 0xdd, 0x79, 0xb9, 0xe3, 0xd4, 0xf8,
+		0x57, 0x70, 0x2d, 0xf3, 0xdd, 0xf6, 0xa9, 0xc3, 0xce, 0xba, 0xc7, 0xe5, 0x86, 0xd7, 0xde, 0x1e,
+		0xf8, 0x5f, 0x6a, 0xf9, 0x94, 0xba, 0xe1, 0x7f, 0x66, 0xa3, 0x7f, 0xab, 0x3e, 0xb3, 0x3b, 0xce,
+		0xfb, 0x9d, 0xe3, 0x39, 0x11, 0x7b, 0xf4, 0x9f, 0x01, 0x00, 0xff, 0x9b, 0x02, 0xd2, 0x16, 0x1e,
+		0x00, 0x00,
 	},
 	// uber/cadence/api/v1/query.proto
 	[]byte{
@@ -1541,8 +1767,8 @@ var yarpcFileDescriptorClosure674d14d2fee4e473 = [][]byte{
 		0x47, 0x5c, 0xdb, 0xfa, 0x20, 0xd7, 0xae, 0x7a, 0xf0, 0x32, 0x64, 0x93, 0xaa, 0x17, 0xbd, 0x7a,
 		0xa2, 0x25, 0x91, 0x93, 0xdf, 0x8f, 0x23, 0x7d, 0x52, 0x47, 0x51, 0xfa, 0x25, 0x1b, 0x28, 0x21,
 		0x9b, 0xa8, 0xf7, 0x0e, 0x4e, 0x19, 0xd1, 0x58, 0x2d, 0xae, 0xac, 0xbc, 0xbd, 0xcb, 0x20, 0x89,
-		0xa6, 0xed, 0xc1, 0x56, 0x81, 0xbd, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x96, 0x7d, 0xf9, 0x10,
-		0xf7, 0x03, 0x00, 0x00,
+		0xa6, 0xed, 0xc1, 0x56, 0x81, 0xbd, 0xfd, 0x3d, 0x00, 0x96, 0x7d, 0xf9, 0x10, 0xf7, 0x03, 0x00,
+		0x00,
 	},
 }
 
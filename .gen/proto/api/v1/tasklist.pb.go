@@ -260,22 +260,142 @@ func (m *TaskListPartitionMetadata) GetOwnerHostName() string {
 	return ""
 }
 
+// WorkerVersionSet is a set of worker build IDs which are compatible with each other
+type WorkerVersionSet struct {
+	BuildIds             []string `protobuf:"bytes,1,rep,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkerVersionSet) Reset()         { *m = WorkerVersionSet{} }
+func (m *WorkerVersionSet) String() string { return proto.CompactTextString(m) }
+func (*WorkerVersionSet) ProtoMessage()    {}
+func (*WorkerVersionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_216fa006947e00a0, []int{3}
+}
+func (m *WorkerVersionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerVersionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerVersionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkerVersionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerVersionSet.Merge(m, src)
+}
+func (m *WorkerVersionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerVersionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerVersionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerVersionSet proto.InternalMessageInfo
+
+func (m *WorkerVersionSet) GetBuildIds() []string {
+	if m != nil {
+		return m.BuildIds
+	}
+	return nil
+}
+
+type TaskListPartitionConfig struct {
+	NumReadPartitions    int32    `protobuf:"varint,1,opt,name=num_read_partitions,json=numReadPartitions,proto3" json:"num_read_partitions,omitempty"`
+	NumWritePartitions   int32    `protobuf:"varint,2,opt,name=num_write_partitions,json=numWritePartitions,proto3" json:"num_write_partitions,omitempty"`
+	ScalingEnabled       bool     `protobuf:"varint,3,opt,name=scaling_enabled,json=scalingEnabled,proto3" json:"scaling_enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskListPartitionConfig) Reset()         { *m = TaskListPartitionConfig{} }
+func (m *TaskListPartitionConfig) String() string { return proto.CompactTextString(m) }
+func (*TaskListPartitionConfig) ProtoMessage()    {}
+func (*TaskListPartitionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_216fa006947e00a0, []int{4}
+}
+func (m *TaskListPartitionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListPartitionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListPartitionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskListPartitionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListPartitionConfig.Merge(m, src)
+}
+func (m *TaskListPartitionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListPartitionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListPartitionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListPartitionConfig proto.InternalMessageInfo
+
+func (m *TaskListPartitionConfig) GetNumReadPartitions() int32 {
+	if m != nil {
+		return m.NumReadPartitions
+	}
+	return 0
+}
+
+func (m *TaskListPartitionConfig) GetNumWritePartitions() int32 {
+	if m != nil {
+		return m.NumWritePartitions
+	}
+	return 0
+}
+
+func (m *TaskListPartitionConfig) GetScalingEnabled() bool {
+	if m != nil {
+		return m.ScalingEnabled
+	}
+	return false
+}
+
 type TaskListStatus struct {
-	BacklogCountHint     int64        `protobuf:"varint,1,opt,name=backlog_count_hint,json=backlogCountHint,proto3" json:"backlog_count_hint,omitempty"`
-	ReadLevel            int64        `protobuf:"varint,2,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
-	AckLevel             int64        `protobuf:"varint,3,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
-	RatePerSecond        float64      `protobuf:"fixed64,4,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second,omitempty"`
-	TaskIdBlock          *TaskIDBlock `protobuf:"bytes,5,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	BacklogCountHint               int64        `protobuf:"varint,1,opt,name=backlog_count_hint,json=backlogCountHint,proto3" json:"backlog_count_hint,omitempty"`
+	ReadLevel                      int64        `protobuf:"varint,2,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
+	AckLevel                       int64        `protobuf:"varint,3,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	RatePerSecond                  float64      `protobuf:"fixed64,4,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second,omitempty"`
+	TaskIdBlock                    *TaskIDBlock `protobuf:"bytes,5,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
+	AddRatePerSecond               float64      `protobuf:"fixed64,6,opt,name=add_rate_per_second,json=addRatePerSecond,proto3" json:"add_rate_per_second,omitempty"`
+	DispatchRatePerSecond          float64      `protobuf:"fixed64,7,opt,name=dispatch_rate_per_second,json=dispatchRatePerSecond,proto3" json:"dispatch_rate_per_second,omitempty"`
+	SyncMatchRatio                 float64      `protobuf:"fixed64,8,opt,name=sync_match_ratio,json=syncMatchRatio,proto3" json:"sync_match_ratio,omitempty"`
+	OldestTaskAgeSeconds           float64      `protobuf:"fixed64,9,opt,name=oldest_task_age_seconds,json=oldestTaskAgeSeconds,proto3" json:"oldest_task_age_seconds,omitempty"`
+	IngestionRpsLimit              float64      `protobuf:"fixed64,10,opt,name=ingestion_rps_limit,json=ingestionRpsLimit,proto3" json:"ingestion_rps_limit,omitempty"`
+	DomainIngestionRpsLimit        float64      `protobuf:"fixed64,11,opt,name=domain_ingestion_rps_limit,json=domainIngestionRpsLimit,proto3" json:"domain_ingestion_rps_limit,omitempty"`
+	DomainDispatchRpsLimit         float64      `protobuf:"fixed64,12,opt,name=domain_dispatch_rps_limit,json=domainDispatchRpsLimit,proto3" json:"domain_dispatch_rps_limit,omitempty"`
+	ThrottledAddRatePerSecond      float64      `protobuf:"fixed64,13,opt,name=throttled_add_rate_per_second,json=throttledAddRatePerSecond,proto3" json:"throttled_add_rate_per_second,omitempty"`
+	ThrottledDispatchRatePerSecond float64      `protobuf:"fixed64,14,opt,name=throttled_dispatch_rate_per_second,json=throttledDispatchRatePerSecond,proto3" json:"throttled_dispatch_rate_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{}     `json:"-"`
+	XXX_unrecognized               []byte       `json:"-"`
+	XXX_sizecache                  int32        `json:"-"`
 }
 
 func (m *TaskListStatus) Reset()         { *m = TaskListStatus{} }
 func (m *TaskListStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskListStatus) ProtoMessage()    {}
 func (*TaskListStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_216fa006947e00a0, []int{3}
+	return fileDescriptor_216fa006947e00a0, []int{5}
 }
 func (m *TaskListStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,6 +459,69 @@ func (m *TaskListStatus) GetTaskIdBlock() *TaskIDBlock {
 	return nil
 }
 
+func (m *TaskListStatus) GetAddRatePerSecond() float64 {
+	if m != nil {
+		return m.AddRatePerSecond
+	}
+	return 0
+}
+
+func (m *TaskListStatus) GetDispatchRatePerSecond() float64 {
+	if m != nil {
+		return m.DispatchRatePerSecond
+	}
+	return 0
+}
+
+func (m *TaskListStatus) GetSyncMatchRatio() float64 {
+	if m != nil {
+		return m.SyncMatchRatio
+	}
+	return 0
+}
+
+func (m *TaskListStatus) GetOldestTaskAgeSeconds() float64 {
+	if m != nil {
+		return m.OldestTaskAgeSeconds
+	}
+	return 0
+}
+
+func (m *TaskListStatus) GetIngestionRpsLimit() float64 {
+	if m != nil {
+		return m.IngestionRpsLimit
+	}
+	return 0
+}
+
+func (m *TaskListStatus) GetDomainIngestionRpsLimit() float64 {
+	if m != nil {
+		return m.DomainIngestionRpsLimit
+	}
+	return 0
+}
+
+func (m *TaskListStatus) GetDomainDispatchRpsLimit() float64 {
+	if m != nil {
+		return m.DomainDispatchRpsLimit
+	}
+	return 0
+}
+
+func (m *TaskListStatus) GetThrottledAddRatePerSecond() float64 {
+	if m != nil {
+		return m.ThrottledAddRatePerSecond
+	}
+	return 0
+}
+
+func (m *TaskListStatus) GetThrottledDispatchRatePerSecond() float64 {
+	if m != nil {
+		return m.ThrottledDispatchRatePerSecond
+	}
+	return 0
+}
+
 type TaskIDBlock struct {
 	StartId              int64    `protobuf:"varint,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
 	EndId                int64    `protobuf:"varint,2,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
@@ -351,7 +534,7 @@ func (m *TaskIDBlock) Reset()         { *m = TaskIDBlock{} }
 func (m *TaskIDBlock) String() string { return proto.CompactTextString(m) }
 func (*TaskIDBlock) ProtoMessage()    {}
 func (*TaskIDBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_216fa006947e00a0, []int{4}
+	return fileDescriptor_216fa006947e00a0, []int{6}
 }
 func (m *TaskIDBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,6 +581,8 @@ type PollerInfo struct {
 	LastAccessTime       *types.Timestamp `protobuf:"bytes,1,opt,name=last_access_time,json=lastAccessTime,proto3" json:"last_access_time,omitempty"`
 	Identity             string           `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 	RatePerSecond        float64          `protobuf:"fixed64,3,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second,omitempty"`
+	BuildId              string           `protobuf:"bytes,4,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	IsolationGroup       string           `protobuf:"bytes,5,opt,name=isolation_group,json=isolationGroup,proto3" json:"isolation_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -407,7 +592,7 @@ func (m *PollerInfo) Reset()         { *m = PollerInfo{} }
 func (m *PollerInfo) String() string { return proto.CompactTextString(m) }
 func (*PollerInfo) ProtoMessage()    {}
 func (*PollerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_216fa006947e00a0, []int{5}
+	return fileDescriptor_216fa006947e00a0, []int{7}
 }
 func (m *PollerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,6 +642,20 @@ func (m *PollerInfo) GetRatePerSecond() float64 {
 	return 0
 }
 
+func (m *PollerInfo) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *PollerInfo) GetIsolationGroup() string {
+	if m != nil {
+		return m.IsolationGroup
+	}
+	return ""
+}
+
 type StickyExecutionAttributes struct {
 	WorkerTaskList         *TaskList       `protobuf:"bytes,1,opt,name=worker_task_list,json=workerTaskList,proto3" json:"worker_task_list,omitempty"`
 	ScheduleToStartTimeout *types.Duration `protobuf:"bytes,2,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
@@ -469,7 +668,7 @@ func (m *StickyExecutionAttributes) Reset()         { *m = StickyExecutionAttrib
 func (m *StickyExecutionAttributes) String() string { return proto.CompactTextString(m) }
 func (*StickyExecutionAttributes) ProtoMessage()    {}
 func (*StickyExecutionAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_216fa006947e00a0, []int{6}
+	return fileDescriptor_216fa006947e00a0, []int{8}
 }
 func (m *StickyExecutionAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,6 +717,8 @@ func init() {
 	proto.RegisterType((*TaskList)(nil), "uber.cadence.api.v1.TaskList")
 	proto.RegisterType((*TaskListMetadata)(nil), "uber.cadence.api.v1.TaskListMetadata")
 	proto.RegisterType((*TaskListPartitionMetadata)(nil), "uber.cadence.api.v1.TaskListPartitionMetadata")
+	proto.RegisterType((*WorkerVersionSet)(nil), "uber.cadence.api.v1.WorkerVersionSet")
+	proto.RegisterType((*TaskListPartitionConfig)(nil), "uber.cadence.api.v1.TaskListPartitionConfig")
 	proto.RegisterType((*TaskListStatus)(nil), "uber.cadence.api.v1.TaskListStatus")
 	proto.RegisterType((*TaskIDBlock)(nil), "uber.cadence.api.v1.TaskIDBlock")
 	proto.RegisterType((*PollerInfo)(nil), "uber.cadence.api.v1.PollerInfo")
@@ -529,55 +730,76 @@ func init() {
 }
 
 var fileDescriptor_216fa006947e00a0 = []byte{
-	// 764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x6e, 0xdc, 0x36,
-	0x14, 0xad, 0x3c, 0x4e, 0x3a, 0xa6, 0x6b, 0x47, 0x65, 0x9b, 0xc4, 0x33, 0x69, 0x5c, 0x57, 0x8b,
-	0xc0, 0x08, 0x0a, 0x09, 0x76, 0xd1, 0x55, 0x17, 0xc5, 0xd8, 0x63, 0x34, 0x82, 0xc7, 0x93, 0x81,
-	0xa4, 0x18, 0x70, 0x37, 0x2c, 0x25, 0xde, 0x8c, 0x09, 0x3d, 0x28, 0x90, 0x94, 0x1f, 0x3f, 0xd2,
-	0x8f, 0xe9, 0x17, 0x74, 0xd9, 0x4f, 0x28, 0xbc, 0xea, 0x67, 0x14, 0xa4, 0x34, 0xae, 0x1f, 0xd3,
-	0xec, 0xc8, 0x7b, 0xee, 0xb9, 0x8f, 0xc3, 0x7b, 0x89, 0xbc, 0x26, 0x05, 0x19, 0x64, 0x94, 0x41,
-	0x95, 0x41, 0x40, 0x6b, 0x1e, 0x5c, 0xec, 0x05, 0x9a, 0xaa, 0xbc, 0xe0, 0x4a, 0xfb, 0xb5, 0x14,
-	0x5a, 0xe0, 0xaf, 0x8c, 0x8f, 0xdf, 0xf9, 0xf8, 0xb4, 0xe6, 0xfe, 0xc5, 0xde, 0x70, 0x7b, 0x2e,
-	0xc4, 0xbc, 0x80, 0xc0, 0xba, 0xa4, 0xcd, 0xc7, 0x80, 0x35, 0x92, 0x6a, 0x2e, 0xaa, 0x96, 0x34,
-	0xfc, 0xf6, 0x21, 0xae, 0x79, 0x09, 0x4a, 0xd3, 0xb2, 0xee, 0x1c, 0x1e, 0x05, 0xb8, 0x94, 0xb4,
-	0xae, 0x41, 0xaa, 0x16, 0xf7, 0x3e, 0xa0, 0x7e, 0x42, 0x55, 0x3e, 0xe1, 0x4a, 0x63, 0x8c, 0x56,
-	0x2b, 0x5a, 0xc2, 0x96, 0xb3, 0xe3, 0xec, 0xae, 0x45, 0xf6, 0x8c, 0x7f, 0x44, 0xab, 0x39, 0xaf,
-	0xd8, 0xd6, 0xca, 0x8e, 0xb3, 0xbb, 0xb9, 0xff, 0x9d, 0xbf, 0xa4, 0x48, 0x7f, 0x11, 0xe0, 0x98,
-	0x57, 0x2c, 0xb2, 0xee, 0x1e, 0x45, 0xee, 0xc2, 0x7a, 0x02, 0x9a, 0x32, 0xaa, 0x29, 0x3e, 0x41,
-	0x5f, 0x97, 0xf4, 0x8a, 0x98, 0xb6, 0x15, 0xa9, 0x41, 0x12, 0x05, 0x99, 0xa8, 0x98, 0x4d, 0xb7,
-	0xbe, 0xff, 0x8d, 0xdf, 0x56, 0xea, 0x2f, 0x2a, 0xf5, 0xc7, 0xa2, 0x49, 0x0b, 0x38, 0xa5, 0x45,
-	0x03, 0xd1, 0x97, 0x25, 0xbd, 0x32, 0x01, 0xd5, 0x0c, 0x64, 0x6c, 0x69, 0xde, 0x07, 0x34, 0x58,
-	0xa4, 0x98, 0x51, 0xa9, 0xb9, 0x51, 0xe5, 0x36, 0x97, 0x8b, 0x7a, 0x39, 0x5c, 0x77, 0x9d, 0x98,
-	0x23, 0x7e, 0x83, 0x9e, 0x89, 0xcb, 0x0a, 0x24, 0x39, 0x17, 0x4a, 0x13, 0xdb, 0xe7, 0x8a, 0x45,
-	0x37, 0xac, 0xf9, 0x9d, 0x50, 0x7a, 0x4a, 0x4b, 0xf0, 0xfe, 0x71, 0xd0, 0xe6, 0x22, 0x6e, 0xac,
-	0xa9, 0x6e, 0x14, 0xfe, 0x1e, 0xe1, 0x94, 0x66, 0x79, 0x21, 0xe6, 0x24, 0x13, 0x4d, 0xa5, 0xc9,
-	0x39, 0xaf, 0xb4, 0x8d, 0xdd, 0x8b, 0xdc, 0x0e, 0x39, 0x34, 0xc0, 0x3b, 0x5e, 0x69, 0xfc, 0x1a,
-	0x21, 0x09, 0x94, 0x91, 0x02, 0x2e, 0xa0, 0xb0, 0x39, 0x7a, 0xd1, 0x9a, 0xb1, 0x4c, 0x8c, 0x01,
-	0xbf, 0x42, 0x6b, 0x34, 0xcb, 0x3b, 0xb4, 0x67, 0xd1, 0x3e, 0xcd, 0xf2, 0x16, 0x7c, 0x83, 0x9e,
-	0x49, 0xaa, 0xe1, 0xae, 0x3a, 0xab, 0x3b, 0xce, 0xae, 0x13, 0x6d, 0x18, 0xf3, 0x6d, 0xef, 0x78,
-	0x8c, 0x36, 0x8c, 0x8c, 0x84, 0x33, 0x92, 0x16, 0x22, 0xcb, 0xb7, 0x9e, 0x58, 0x0d, 0x77, 0xfe,
-	0xf7, 0x79, 0xc2, 0xf1, 0x81, 0xf1, 0x8b, 0xd6, 0x0d, 0x2d, 0x64, 0xf6, 0xe2, 0xfd, 0x8c, 0xd6,
-	0xef, 0x60, 0x78, 0x80, 0xfa, 0x4a, 0x53, 0xa9, 0x09, 0x67, 0x5d, 0x73, 0x9f, 0xdb, 0x7b, 0xc8,
-	0xf0, 0x73, 0xf4, 0x14, 0x2a, 0x66, 0x80, 0xb6, 0x9f, 0x27, 0x50, 0xb1, 0x90, 0x79, 0xbf, 0x3b,
-	0x08, 0xcd, 0x44, 0x51, 0x80, 0x0c, 0xab, 0x8f, 0x02, 0x8f, 0x91, 0x5b, 0x50, 0xa5, 0x09, 0xcd,
-	0x32, 0x50, 0x8a, 0x98, 0x51, 0xec, 0x1e, 0x77, 0xf8, 0xe8, 0x71, 0x93, 0xc5, 0x9c, 0x46, 0x9b,
-	0x86, 0x33, 0xb2, 0x14, 0x63, 0xc4, 0x43, 0xd4, 0xe7, 0x0c, 0x2a, 0xcd, 0xf5, 0x75, 0xf7, 0x42,
-	0xb7, 0xf7, 0x65, 0xfa, 0xf4, 0x96, 0xe8, 0xe3, 0xfd, 0xe1, 0xa0, 0x41, 0xac, 0x79, 0x96, 0x5f,
-	0x1f, 0x5d, 0x41, 0xd6, 0x98, 0xd1, 0x18, 0x69, 0x2d, 0x79, 0xda, 0x68, 0x50, 0xf8, 0x17, 0xe4,
-	0x5e, 0x0a, 0x99, 0x83, 0xb4, 0xb3, 0x48, 0xcc, 0x0e, 0x76, 0x75, 0xbe, 0xfe, 0xe4, 0x7c, 0x47,
-	0x9b, 0x2d, 0xed, 0x76, 0x61, 0x12, 0x34, 0x50, 0xd9, 0x39, 0xb0, 0xa6, 0x00, 0xa2, 0x05, 0x69,
-	0xd5, 0x33, 0x6d, 0x8b, 0x46, 0xdb, 0xda, 0xd7, 0xf7, 0x07, 0x8f, 0xc7, 0xba, 0xdb, 0xe0, 0xe8,
-	0xc5, 0x82, 0x9b, 0x88, 0xd8, 0x30, 0x93, 0x96, 0xf8, 0xf6, 0x37, 0xf4, 0xc5, 0xdd, 0x8d, 0xc2,
-	0x43, 0xf4, 0x22, 0x19, 0xc5, 0xc7, 0x64, 0x12, 0xc6, 0x09, 0x39, 0x0e, 0xa7, 0x63, 0x12, 0x4e,
-	0x4f, 0x47, 0x93, 0x70, 0xec, 0x7e, 0x86, 0x07, 0xe8, 0xf9, 0x03, 0x6c, 0xfa, 0x3e, 0x3a, 0x19,
-	0x4d, 0x5c, 0x67, 0x09, 0x14, 0x27, 0xe1, 0xe1, 0xf1, 0x99, 0xbb, 0xf2, 0x96, 0xfd, 0x97, 0x21,
-	0xb9, 0xae, 0xe1, 0x7e, 0x86, 0xe4, 0x6c, 0x76, 0x74, 0x27, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36,
-	0x3e, 0x3a, 0x0c, 0xe3, 0xf0, 0xfd, 0xd4, 0x75, 0x96, 0x80, 0xa3, 0xc3, 0x24, 0x3c, 0x0d, 0x93,
-	0x33, 0x77, 0xe5, 0x20, 0xfd, 0xf3, 0x66, 0xdb, 0xf9, 0xeb, 0x66, 0xdb, 0xf9, 0xfb, 0x66, 0xdb,
-	0x41, 0x2f, 0x33, 0x51, 0x2e, 0x53, 0xf7, 0xa0, 0x3f, 0xaa, 0xf9, 0xcc, 0x88, 0x33, 0x73, 0x7e,
-	0x0d, 0xe6, 0x5c, 0x9f, 0x37, 0xa9, 0x9f, 0x89, 0x32, 0xb8, 0xf7, 0x65, 0xfa, 0x73, 0xa8, 0xda,
-	0x3f, 0xac, 0xfb, 0x3d, 0x7f, 0xa2, 0x35, 0xbf, 0xd8, 0x4b, 0x9f, 0x5a, 0xdb, 0x0f, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xed, 0xf7, 0x38, 0xe6, 0x61, 0x05, 0x00, 0x00,
+	// 1095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0xcf, 0x72, 0xdb, 0xb6,
+	0x13, 0xc7, 0x7f, 0xb4, 0xe2, 0x44, 0x82, 0x63, 0x85, 0x41, 0xfc, 0x47, 0x52, 0x7e, 0x76, 0x55,
+	0x1d, 0x52, 0x4d, 0xa6, 0xa5, 0x6a, 0x77, 0x32, 0x9d, 0x4e, 0x0e, 0xad, 0x6c, 0x79, 0x12, 0xd6,
+	0xb2, 0xa3, 0xa1, 0x18, 0x67, 0xdc, 0x0b, 0x0a, 0x11, 0xb0, 0x84, 0x11, 0x09, 0x70, 0x08, 0xd0,
+	0x7f, 0xde, 0xa7, 0x4f, 0xd1, 0x27, 0xe8, 0xb1, 0xe7, 0x9e, 0x3a, 0xbe, 0xf4, 0x35, 0x3a, 0x00,
+	0x49, 0x59, 0xb6, 0x94, 0xde, 0xc4, 0xfd, 0xee, 0x07, 0xbb, 0x58, 0x2c, 0x16, 0x02, 0xad, 0x74,
+	0x44, 0x93, 0x4e, 0x80, 0x09, 0xe5, 0x01, 0xed, 0xe0, 0x98, 0x75, 0x2e, 0xf7, 0x3a, 0x0a, 0xcb,
+	0x69, 0xc8, 0xa4, 0x72, 0xe2, 0x44, 0x28, 0x01, 0x5f, 0x68, 0x1f, 0x27, 0xf7, 0x71, 0x70, 0xcc,
+	0x9c, 0xcb, 0xbd, 0xc6, 0xee, 0x58, 0x88, 0x71, 0x48, 0x3b, 0xc6, 0x65, 0x94, 0x5e, 0x74, 0x48,
+	0x9a, 0x60, 0xc5, 0x04, 0xcf, 0xa0, 0xc6, 0x17, 0x0f, 0x75, 0xc5, 0x22, 0x2a, 0x15, 0x8e, 0xe2,
+	0xdc, 0x61, 0x61, 0x81, 0xab, 0x04, 0xc7, 0x31, 0x4d, 0x64, 0xa6, 0xb7, 0x3e, 0x82, 0xb2, 0x8f,
+	0xe5, 0xb4, 0xcf, 0xa4, 0x82, 0x10, 0x3c, 0xe2, 0x38, 0xa2, 0x35, 0xab, 0x69, 0xb5, 0x2b, 0x9e,
+	0xf9, 0x0d, 0xdf, 0x80, 0x47, 0x53, 0xc6, 0x49, 0x6d, 0xa5, 0x69, 0xb5, 0xab, 0xfb, 0x5f, 0x3a,
+	0x4b, 0x92, 0x74, 0x8a, 0x05, 0x8e, 0x19, 0x27, 0x9e, 0x71, 0x6f, 0x61, 0x60, 0x17, 0xd6, 0x13,
+	0xaa, 0x30, 0xc1, 0x0a, 0xc3, 0x13, 0xb0, 0x11, 0xe1, 0x6b, 0xa4, 0xb7, 0x2d, 0x51, 0x4c, 0x13,
+	0x24, 0x69, 0x20, 0x38, 0x31, 0xe1, 0xd6, 0xf6, 0xff, 0xef, 0x64, 0x99, 0x3a, 0x45, 0xa6, 0x4e,
+	0x4f, 0xa4, 0xa3, 0x90, 0x9e, 0xe1, 0x30, 0xa5, 0xde, 0xf3, 0x08, 0x5f, 0xeb, 0x05, 0xe5, 0x80,
+	0x26, 0x43, 0x83, 0xb5, 0x3e, 0x82, 0x7a, 0x11, 0x62, 0x80, 0x13, 0xc5, 0x74, 0x55, 0x66, 0xb1,
+	0x6c, 0x50, 0x9a, 0xd2, 0x9b, 0x7c, 0x27, 0xfa, 0x27, 0x7c, 0x05, 0x9e, 0x89, 0x2b, 0x4e, 0x13,
+	0x34, 0x11, 0x52, 0x21, 0xb3, 0xcf, 0x15, 0xa3, 0xae, 0x1b, 0xf3, 0x7b, 0x21, 0xd5, 0x29, 0x8e,
+	0x68, 0xab, 0x03, 0xec, 0x4f, 0x22, 0x99, 0xd2, 0xe4, 0x8c, 0x26, 0x92, 0x09, 0x3e, 0xa4, 0x0a,
+	0xbe, 0x04, 0x95, 0x51, 0xca, 0x42, 0x82, 0x18, 0x91, 0x35, 0xab, 0x59, 0x6a, 0x57, 0xbc, 0xb2,
+	0x31, 0xb8, 0x44, 0xb6, 0x7e, 0xb3, 0xc0, 0xf6, 0x42, 0x22, 0x87, 0x82, 0x5f, 0xb0, 0x31, 0x74,
+	0xc0, 0x0b, 0x9e, 0x46, 0x28, 0xa1, 0x98, 0xa0, 0xb8, 0xd0, 0xa4, 0x49, 0x6b, 0xd5, 0x7b, 0xce,
+	0xd3, 0xc8, 0xa3, 0x98, 0xcc, 0x20, 0x09, 0xbf, 0x05, 0x1b, 0xda, 0xff, 0x2a, 0x61, 0x8a, 0xce,
+	0x03, 0x2b, 0x06, 0x80, 0x3c, 0x8d, 0x3e, 0x69, 0x69, 0x8e, 0xf8, 0x0a, 0x3c, 0x93, 0x01, 0x0e,
+	0x19, 0x1f, 0x23, 0xca, 0xf1, 0x28, 0xa4, 0xa4, 0x56, 0x6a, 0x5a, 0xed, 0xb2, 0x57, 0xcd, 0xcd,
+	0x47, 0x99, 0xb5, 0xf5, 0xcf, 0x2a, 0xa8, 0x16, 0x69, 0x0e, 0x15, 0x56, 0xa9, 0x84, 0x5f, 0x03,
+	0x38, 0xc2, 0xc1, 0x34, 0x14, 0x63, 0x14, 0x88, 0x94, 0x2b, 0x34, 0x61, 0x5c, 0x99, 0xe4, 0x4a,
+	0x9e, 0x9d, 0x2b, 0x87, 0x5a, 0x78, 0xcf, 0xb8, 0x82, 0x3b, 0x00, 0x98, 0x7d, 0x84, 0xf4, 0x92,
+	0x86, 0x26, 0xa3, 0x92, 0x57, 0xd1, 0x96, 0xbe, 0x36, 0xe8, 0x1a, 0xe1, 0x60, 0x9a, 0xab, 0x25,
+	0xa3, 0x96, 0x71, 0x30, 0xcd, 0xc4, 0x57, 0xe0, 0x59, 0x82, 0x15, 0x9d, 0x3f, 0xf5, 0x47, 0x4d,
+	0xab, 0x6d, 0x79, 0xeb, 0xda, 0x3c, 0x3b, 0x53, 0xd8, 0x03, 0xeb, 0xba, 0x3d, 0x10, 0x23, 0x68,
+	0x14, 0x8a, 0x60, 0x5a, 0x5b, 0x35, 0xbd, 0xd1, 0xfc, 0x6c, 0xdb, 0xb9, 0xbd, 0x03, 0xed, 0xe7,
+	0xad, 0x69, 0xcc, 0x25, 0xe6, 0x03, 0x7e, 0x03, 0x5e, 0x60, 0x42, 0xd0, 0xc3, 0x88, 0x8f, 0x4d,
+	0x44, 0x1b, 0x13, 0xe2, 0xdd, 0x0b, 0xfa, 0x3d, 0xa8, 0x11, 0x26, 0x63, 0xac, 0x82, 0xc9, 0x02,
+	0xf3, 0xc4, 0x30, 0x9b, 0x85, 0x7e, 0x1f, 0x6c, 0x03, 0x5b, 0xde, 0xf0, 0x00, 0x45, 0x05, 0xca,
+	0x44, 0xad, 0x6c, 0x80, 0xaa, 0xb6, 0x9f, 0xe4, 0x04, 0x13, 0xf0, 0x0d, 0xd8, 0x16, 0x21, 0xa1,
+	0x52, 0x99, 0xee, 0x47, 0x78, 0x4c, 0xf3, 0x00, 0xb2, 0x56, 0x31, 0xc0, 0x46, 0x26, 0xeb, 0x2d,
+	0x75, 0xc7, 0x34, 0x5b, 0x5f, 0xea, 0xf6, 0x61, 0x7c, 0x4c, 0xa5, 0x3e, 0x6a, 0x94, 0xc4, 0x12,
+	0x85, 0x2c, 0x62, 0xaa, 0x06, 0x0c, 0xf2, 0x7c, 0x26, 0x79, 0xb1, 0xec, 0x6b, 0x01, 0xbe, 0x05,
+	0x0d, 0x22, 0x22, 0xcc, 0x38, 0x5a, 0x86, 0xad, 0x19, 0x6c, 0x3b, 0xf3, 0x70, 0x17, 0xe0, 0x1f,
+	0x40, 0x3d, 0x87, 0xef, 0xaa, 0x31, 0x63, 0x9f, 0x1a, 0x76, 0x2b, 0x73, 0xe8, 0x15, 0xd5, 0x28,
+	0xd0, 0x9f, 0xc0, 0x8e, 0x9a, 0x24, 0x42, 0xa9, 0x90, 0x12, 0xb4, 0xac, 0xf4, 0xeb, 0x06, 0xaf,
+	0xcf, 0x9c, 0xba, 0x0f, 0xcf, 0xe0, 0x67, 0xd0, 0xba, 0x5b, 0xe1, 0xb3, 0xa7, 0x51, 0x35, 0xcb,
+	0xec, 0xce, 0x3c, 0x7b, 0xcb, 0x8e, 0xa5, 0xf5, 0x23, 0x58, 0x9b, 0x6b, 0x0d, 0x58, 0x07, 0x65,
+	0xa9, 0x70, 0xa2, 0x10, 0x23, 0x79, 0x6f, 0x3f, 0x31, 0xdf, 0x2e, 0x81, 0x9b, 0xe0, 0x31, 0xe5,
+	0xfa, 0x56, 0xe7, 0xed, 0xbc, 0x4a, 0x39, 0x71, 0x49, 0xeb, 0x2f, 0x0b, 0x80, 0x81, 0x08, 0x43,
+	0x9a, 0xb8, 0xfc, 0x42, 0xc0, 0x1e, 0xb0, 0x43, 0x2c, 0x15, 0xc2, 0x41, 0x40, 0xa5, 0x44, 0x7a,
+	0xc2, 0xe6, 0x33, 0xab, 0xb1, 0x30, 0xb3, 0xfc, 0x62, 0xfc, 0x7a, 0x55, 0xcd, 0x74, 0x0d, 0xa2,
+	0x8d, 0xb0, 0x01, 0xca, 0x8c, 0x50, 0xae, 0x98, 0xba, 0xc9, 0x07, 0xcf, 0xec, 0x7b, 0xd9, 0xf5,
+	0x28, 0x2d, 0xbb, 0x1e, 0x75, 0x50, 0x2e, 0xe6, 0x90, 0xb9, 0x3f, 0x15, 0xef, 0x49, 0x3e, 0x86,
+	0xf4, 0x1c, 0x60, 0x52, 0x84, 0xe6, 0x6d, 0x40, 0xe3, 0x44, 0xa4, 0xb1, 0xb9, 0x3b, 0x15, 0xaf,
+	0x3a, 0x33, 0xbf, 0xd3, 0xd6, 0xd6, 0xef, 0x16, 0xa8, 0x0f, 0x15, 0x0b, 0xa6, 0x37, 0x47, 0xd7,
+	0x34, 0x48, 0xb5, 0xd0, 0x55, 0x2a, 0x61, 0xa3, 0x54, 0x51, 0x09, 0xdf, 0x01, 0xfb, 0xca, 0x4c,
+	0xbf, 0xac, 0x51, 0xf5, 0xf3, 0x94, 0xef, 0x75, 0xe7, 0x3f, 0x47, 0xbf, 0x57, 0xcd, 0xb0, 0xe2,
+	0x1b, 0xfa, 0xa0, 0x2e, 0x83, 0x09, 0x25, 0x69, 0x48, 0x91, 0x12, 0x28, 0x3b, 0x01, 0x5d, 0x3a,
+	0x91, 0x2a, 0xb3, 0xff, 0xb5, 0xfd, 0xfa, 0xe2, 0xc4, 0xcf, 0x1f, 0x37, 0x6f, 0xab, 0x60, 0x7d,
+	0x31, 0xd4, 0xa4, 0x9f, 0x81, 0xaf, 0x7f, 0x05, 0x4f, 0xe7, 0x1f, 0x1b, 0xd8, 0x00, 0x5b, 0x7e,
+	0x77, 0x78, 0x8c, 0xfa, 0xee, 0xd0, 0x47, 0xc7, 0xee, 0x69, 0x0f, 0xb9, 0xa7, 0x67, 0xdd, 0xbe,
+	0xdb, 0xb3, 0xff, 0x07, 0xeb, 0x60, 0xf3, 0x81, 0x76, 0xfa, 0xc1, 0x3b, 0xe9, 0xf6, 0x6d, 0x6b,
+	0x89, 0x34, 0xf4, 0xdd, 0xc3, 0xe3, 0x73, 0x7b, 0xe5, 0x35, 0xb9, 0x8b, 0xe0, 0xdf, 0xc4, 0xf4,
+	0x7e, 0x04, 0xff, 0x7c, 0x70, 0x34, 0x17, 0xe1, 0x25, 0xd8, 0x7e, 0xa0, 0xf5, 0x8e, 0x0e, 0xdd,
+	0xa1, 0xfb, 0xe1, 0xd4, 0xb6, 0x96, 0x88, 0xdd, 0x43, 0xdf, 0x3d, 0x73, 0xfd, 0x73, 0x7b, 0xe5,
+	0x60, 0xf4, 0xc7, 0xed, 0xae, 0xf5, 0xe7, 0xed, 0xae, 0xf5, 0xf7, 0xed, 0xae, 0x05, 0xb6, 0x03,
+	0x11, 0x2d, 0xab, 0xee, 0x41, 0xb9, 0x1b, 0xb3, 0x81, 0x2e, 0xce, 0xc0, 0xfa, 0xa5, 0x33, 0x66,
+	0x6a, 0x92, 0x8e, 0x9c, 0x40, 0x44, 0x9d, 0x7b, 0xff, 0x26, 0x9c, 0x31, 0xe5, 0xd9, 0xf3, 0x9e,
+	0xff, 0xb1, 0x78, 0x8b, 0x63, 0x76, 0xb9, 0x37, 0x7a, 0x6c, 0x6c, 0xdf, 0xfd, 0x3b, 0x00, 0x89,
+	0x68, 0x86, 0x4b, 0x7c, 0x08, 0x00, 0x00,
 }
 
 func (m *TaskList) Marshal() (dAtA []byte, err error) {
@@ -699,6 +921,89 @@ func (m *TaskListPartitionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkerVersionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerVersionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerVersionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BuildIds) > 0 {
+		for iNdEx := len(m.BuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildIds[iNdEx])
+			copy(dAtA[i:], m.BuildIds[iNdEx])
+			i = encodeVarintTasklist(dAtA, i, uint64(len(m.BuildIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskListPartitionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListPartitionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListPartitionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ScalingEnabled {
+		i--
+		if m.ScalingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumWritePartitions != 0 {
+		i = encodeVarintTasklist(dAtA, i, uint64(m.NumWritePartitions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumReadPartitions != 0 {
+		i = encodeVarintTasklist(dAtA, i, uint64(m.NumReadPartitions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TaskListStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -723,6 +1028,60 @@ func (m *TaskListStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ThrottledDispatchRatePerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ThrottledDispatchRatePerSecond))))
+		i--
+		dAtA[i] = 0x71
+	}
+	if m.ThrottledAddRatePerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ThrottledAddRatePerSecond))))
+		i--
+		dAtA[i] = 0x69
+	}
+	if m.DomainDispatchRpsLimit != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DomainDispatchRpsLimit))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.DomainIngestionRpsLimit != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DomainIngestionRpsLimit))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.IngestionRpsLimit != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.IngestionRpsLimit))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.OldestTaskAgeSeconds != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OldestTaskAgeSeconds))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.SyncMatchRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SyncMatchRatio))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.DispatchRatePerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchRatePerSecond))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.AddRatePerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AddRatePerSecond))))
+		i--
+		dAtA[i] = 0x31
+	}
 	if m.TaskIdBlock != nil {
 		{
 			size, err := m.TaskIdBlock.MarshalToSizedBuffer(dAtA[:i])
@@ -820,6 +1179,20 @@ func (m *PollerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintTasklist(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintTasklist(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.RatePerSecond != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RatePerSecond))))
@@ -965,27 +1338,17 @@ func (m *TaskListPartitionMetadata) Size() (n int) {
 	return n
 }
 
-func (m *TaskListStatus) Size() (n int) {
+func (m *WorkerVersionSet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BacklogCountHint != 0 {
-		n += 1 + sovTasklist(uint64(m.BacklogCountHint))
-	}
-	if m.ReadLevel != 0 {
-		n += 1 + sovTasklist(uint64(m.ReadLevel))
-	}
-	if m.AckLevel != 0 {
-		n += 1 + sovTasklist(uint64(m.AckLevel))
-	}
-	if m.RatePerSecond != 0 {
-		n += 9
-	}
-	if m.TaskIdBlock != nil {
-		l = m.TaskIdBlock.Size()
-		n += 1 + l + sovTasklist(uint64(l))
+	if len(m.BuildIds) > 0 {
+		for _, s := range m.BuildIds {
+			l = len(s)
+			n += 1 + l + sovTasklist(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -993,17 +1356,20 @@ func (m *TaskListStatus) Size() (n int) {
 	return n
 }
 
-func (m *TaskIDBlock) Size() (n int) {
+func (m *TaskListPartitionConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StartId != 0 {
-		n += 1 + sovTasklist(uint64(m.StartId))
+	if m.NumReadPartitions != 0 {
+		n += 1 + sovTasklist(uint64(m.NumReadPartitions))
 	}
-	if m.EndId != 0 {
-		n += 1 + sovTasklist(uint64(m.EndId))
+	if m.NumWritePartitions != 0 {
+		n += 1 + sovTasklist(uint64(m.NumWritePartitions))
+	}
+	if m.ScalingEnabled {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1011,15 +1377,88 @@ func (m *TaskIDBlock) Size() (n int) {
 	return n
 }
 
-func (m *PollerInfo) Size() (n int) {
+func (m *TaskListStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.LastAccessTime != nil {
-		l = m.LastAccessTime.Size()
-		n += 1 + l + sovTasklist(uint64(l))
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovTasklist(uint64(m.BacklogCountHint))
+	}
+	if m.ReadLevel != 0 {
+		n += 1 + sovTasklist(uint64(m.ReadLevel))
+	}
+	if m.AckLevel != 0 {
+		n += 1 + sovTasklist(uint64(m.AckLevel))
+	}
+	if m.RatePerSecond != 0 {
+		n += 9
+	}
+	if m.TaskIdBlock != nil {
+		l = m.TaskIdBlock.Size()
+		n += 1 + l + sovTasklist(uint64(l))
+	}
+	if m.AddRatePerSecond != 0 {
+		n += 9
+	}
+	if m.DispatchRatePerSecond != 0 {
+		n += 9
+	}
+	if m.SyncMatchRatio != 0 {
+		n += 9
+	}
+	if m.OldestTaskAgeSeconds != 0 {
+		n += 9
+	}
+	if m.IngestionRpsLimit != 0 {
+		n += 9
+	}
+	if m.DomainIngestionRpsLimit != 0 {
+		n += 9
+	}
+	if m.DomainDispatchRpsLimit != 0 {
+		n += 9
+	}
+	if m.ThrottledAddRatePerSecond != 0 {
+		n += 9
+	}
+	if m.ThrottledDispatchRatePerSecond != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskIDBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartId != 0 {
+		n += 1 + sovTasklist(uint64(m.StartId))
+	}
+	if m.EndId != 0 {
+		n += 1 + sovTasklist(uint64(m.EndId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PollerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastAccessTime != nil {
+		l = m.LastAccessTime.Size()
+		n += 1 + l + sovTasklist(uint64(l))
 	}
 	l = len(m.Identity)
 	if l > 0 {
@@ -1028,6 +1467,14 @@ func (m *PollerInfo) Size() (n int) {
 	if m.RatePerSecond != 0 {
 		n += 9
 	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovTasklist(uint64(l))
+	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovTasklist(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1373,6 +1820,204 @@ func (m *TaskListPartitionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkerVersionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasklist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerVersionSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerVersionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildIds = append(m.BuildIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasklist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasklist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListPartitionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListPartitionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
+			}
+			m.NumReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
+			}
+			m.NumWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScalingEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasklist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TaskListStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1506,6 +2151,105 @@ func (m *TaskListStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddRatePerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AddRatePerSecond = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchRatePerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchRatePerSecond = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatchRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SyncMatchRatio = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestTaskAgeSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OldestTaskAgeSeconds = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngestionRpsLimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.IngestionRpsLimit = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainIngestionRpsLimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DomainIngestionRpsLimit = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainDispatchRpsLimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DomainDispatchRpsLimit = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledAddRatePerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ThrottledAddRatePerSecond = float64(math.Float64frombits(v))
+		case 14:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledDispatchRatePerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ThrottledDispatchRatePerSecond = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasklist(dAtA[iNdEx:])
@@ -1731,6 +2475,70 @@ func (m *PollerInfo) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.RatePerSecond = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasklist(dAtA[iNdEx:])
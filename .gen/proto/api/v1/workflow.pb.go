@@ -144,6 +144,40 @@ func (WorkflowIdReusePolicy) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2775eefb5053680f, []int{2}
 }
 
+type WorkflowIdConflictPolicy int32
+
+const (
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID WorkflowIdConflictPolicy = 0
+	// Fail the request if a workflow is running using the same workflow ID.
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL WorkflowIdConflictPolicy = 1
+	// If a workflow is running using the same workflow ID, return its run ID instead of starting a new one.
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING WorkflowIdConflictPolicy = 2
+	// If a workflow is running using the same workflow ID, terminate it and start a new one.
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING WorkflowIdConflictPolicy = 3
+)
+
+var WorkflowIdConflictPolicy_name = map[int32]string{
+	0: "WORKFLOW_ID_CONFLICT_POLICY_INVALID",
+	1: "WORKFLOW_ID_CONFLICT_POLICY_FAIL",
+	2: "WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING",
+	3: "WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING",
+}
+
+var WorkflowIdConflictPolicy_value = map[string]int32{
+	"WORKFLOW_ID_CONFLICT_POLICY_INVALID":            0,
+	"WORKFLOW_ID_CONFLICT_POLICY_FAIL":               1,
+	"WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING":       2,
+	"WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING": 3,
+}
+
+func (x WorkflowIdConflictPolicy) String() string {
+	return proto.EnumName(WorkflowIdConflictPolicy_name, int32(x))
+}
+
+func (WorkflowIdConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2775eefb5053680f, []int{3}
+}
+
 type ParentClosePolicy int32
 
 const (
@@ -175,7 +209,7 @@ func (x ParentClosePolicy) String() string {
 }
 
 func (ParentClosePolicy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{3}
+	return fileDescriptor_2775eefb5053680f, []int{4}
 }
 
 type WorkflowExecutionCloseStatus int32
@@ -215,7 +249,7 @@ func (x WorkflowExecutionCloseStatus) String() string {
 }
 
 func (WorkflowExecutionCloseStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{4}
+	return fileDescriptor_2775eefb5053680f, []int{5}
 }
 
 type ContinueAsNewInitiator int32
@@ -246,7 +280,7 @@ func (x ContinueAsNewInitiator) String() string {
 }
 
 func (ContinueAsNewInitiator) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{5}
+	return fileDescriptor_2775eefb5053680f, []int{6}
 }
 
 type TimeoutType int32
@@ -280,7 +314,7 @@ func (x TimeoutType) String() string {
 }
 
 func (TimeoutType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{6}
+	return fileDescriptor_2775eefb5053680f, []int{7}
 }
 
 type DecisionTaskTimedOutCause int32
@@ -308,7 +342,7 @@ func (x DecisionTaskTimedOutCause) String() string {
 }
 
 func (DecisionTaskTimedOutCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{7}
+	return fileDescriptor_2775eefb5053680f, []int{8}
 }
 
 type DecisionTaskFailedCause int32
@@ -338,6 +372,7 @@ const (
 	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_BINARY                                                DecisionTaskFailedCause = 21
 	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID                            DecisionTaskFailedCause = 22
 	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES                                     DecisionTaskFailedCause = 23
+	DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES                       DecisionTaskFailedCause = 24
 )
 
 var DecisionTaskFailedCause_name = map[int32]string{
@@ -365,6 +400,7 @@ var DecisionTaskFailedCause_name = map[int32]string{
 	21: "DECISION_TASK_FAILED_CAUSE_BAD_BINARY",
 	22: "DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID",
 	23: "DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES",
+	24: "DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES",
 }
 
 var DecisionTaskFailedCause_value = map[string]int32{
@@ -392,6 +428,7 @@ var DecisionTaskFailedCause_value = map[string]int32{
 	"DECISION_TASK_FAILED_CAUSE_BAD_BINARY":                                                21,
 	"DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID":                            22,
 	"DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES":                                     23,
+	"DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES":                       24,
 }
 
 func (x DecisionTaskFailedCause) String() string {
@@ -399,7 +436,7 @@ func (x DecisionTaskFailedCause) String() string {
 }
 
 func (DecisionTaskFailedCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{8}
+	return fileDescriptor_2775eefb5053680f, []int{9}
 }
 
 type ChildWorkflowExecutionFailedCause int32
@@ -424,7 +461,7 @@ func (x ChildWorkflowExecutionFailedCause) String() string {
 }
 
 func (ChildWorkflowExecutionFailedCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{9}
+	return fileDescriptor_2775eefb5053680f, []int{10}
 }
 
 type CancelExternalWorkflowExecutionFailedCause int32
@@ -449,7 +486,7 @@ func (x CancelExternalWorkflowExecutionFailedCause) String() string {
 }
 
 func (CancelExternalWorkflowExecutionFailedCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{10}
+	return fileDescriptor_2775eefb5053680f, []int{11}
 }
 
 type SignalExternalWorkflowExecutionFailedCause int32
@@ -474,7 +511,7 @@ func (x SignalExternalWorkflowExecutionFailedCause) String() string {
 }
 
 func (SignalExternalWorkflowExecutionFailedCause) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2775eefb5053680f, []int{11}
+	return fileDescriptor_2775eefb5053680f, []int{12}
 }
 
 type WorkflowExecutionInfo struct {
@@ -491,6 +528,7 @@ type WorkflowExecutionInfo struct {
 	AutoResetPoints      *ResetPoints                 `protobuf:"bytes,11,opt,name=auto_reset_points,json=autoResetPoints,proto3" json:"auto_reset_points,omitempty"`
 	TaskList             string                       `protobuf:"bytes,12,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
 	IsCron               bool                         `protobuf:"varint,13,opt,name=is_cron,json=isCron,proto3" json:"is_cron,omitempty"`
+	NextRetryTime        *types.Timestamp             `protobuf:"bytes,14,opt,name=next_retry_time,json=nextRetryTime,proto3" json:"next_retry_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -620,6 +658,13 @@ func (m *WorkflowExecutionInfo) GetIsCron() bool {
 	return false
 }
 
+func (m *WorkflowExecutionInfo) GetNextRetryTime() *types.Timestamp {
+	if m != nil {
+		return m.NextRetryTime
+	}
+	return nil
+}
+
 type WorkflowExecutionConfiguration struct {
 	TaskList                     *TaskList       `protobuf:"bytes,1,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
 	ExecutionStartToCloseTimeout *types.Duration `protobuf:"bytes,2,opt,name=execution_start_to_close_timeout,json=executionStartToCloseTimeout,proto3" json:"execution_start_to_close_timeout,omitempty"`
@@ -822,6 +867,7 @@ type PendingActivityInfo struct {
 	ExpirationTime       *types.Timestamp     `protobuf:"bytes,10,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
 	LastFailure          *Failure             `protobuf:"bytes,11,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity   string               `protobuf:"bytes,12,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
+	NextRetryTime        *types.Timestamp     `protobuf:"bytes,13,opt,name=next_retry_time,json=nextRetryTime,proto3" json:"next_retry_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -944,6 +990,13 @@ func (m *PendingActivityInfo) GetLastWorkerIdentity() string {
 	return ""
 }
 
+func (m *PendingActivityInfo) GetNextRetryTime() *types.Timestamp {
+	if m != nil {
+		return m.NextRetryTime
+	}
+	return nil
+}
+
 type PendingChildExecutionInfo struct {
 	WorkflowExecution    *WorkflowExecution `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	WorkflowTypeName     string             `protobuf:"bytes,2,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
@@ -1311,6 +1364,7 @@ func init() {
 	proto.RegisterEnum("uber.cadence.api.v1.PendingActivityState", PendingActivityState_name, PendingActivityState_value)
 	proto.RegisterEnum("uber.cadence.api.v1.PendingDecisionState", PendingDecisionState_name, PendingDecisionState_value)
 	proto.RegisterEnum("uber.cadence.api.v1.WorkflowIdReusePolicy", WorkflowIdReusePolicy_name, WorkflowIdReusePolicy_value)
+	proto.RegisterEnum("uber.cadence.api.v1.WorkflowIdConflictPolicy", WorkflowIdConflictPolicy_name, WorkflowIdConflictPolicy_value)
 	proto.RegisterEnum("uber.cadence.api.v1.ParentClosePolicy", ParentClosePolicy_name, ParentClosePolicy_value)
 	proto.RegisterEnum("uber.cadence.api.v1.WorkflowExecutionCloseStatus", WorkflowExecutionCloseStatus_name, WorkflowExecutionCloseStatus_value)
 	proto.RegisterEnum("uber.cadence.api.v1.ContinueAsNewInitiator", ContinueAsNewInitiator_name, ContinueAsNewInitiator_value)
@@ -1337,151 +1391,157 @@ func init() {
 }
 
 var fileDescriptor_2775eefb5053680f = []byte{
-	// 2302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0x6b, 0x25, 0xd9, 0x38, 0x8e, 0xb6,
-	0x49, 0x1c, 0x75, 0x23, 0xaf, 0x93, 0xcd, 0xa6, 0xd9, 0x34, 0x4d, 0x69, 0x92, 0x8e, 0x99, 0xc8,
-	0x94, 0x3a, 0xa2, 0xe2, 0x78, 0x51, 0x94, 0xa0, 0xa5, 0xb1, 0x4d, 0x44, 0x22, 0x05, 0x92, 0x4a,
-	0xe2, 0x7b, 0x81, 0x9e, 0x7b, 0x2b, 0xda, 0x4b, 0xff, 0x80, 0x02, 0x45, 0xff, 0x80, 0xa2, 0x45,
-	0x0f, 0x45, 0x2f, 0x05, 0x7a, 0xea, 0xb1, 0x28, 0x72, 0xeb, 0x7f, 0x51, 0xcc, 0x70, 0x28, 0x51,
-	0x12, 0x25, 0x2a, 0x2d, 0xb0, 0xbd, 0x99, 0x8f, 0xdf, 0xf7, 0xf1, 0xcd, 0x9b, 0xf7, 0x3e, 0x0e,
-	0x2d, 0x28, 0x74, 0x4f, 0x88, 0xb7, 0xd3, 0xb0, 0x9a, 0xc4, 0x69, 0x90, 0x1d, 0xab, 0x63, 0xef,
-	0xbc, 0xdd, 0xdd, 0x79, 0xe7, 0x7a, 0x6f, 0x4e, 0x5b, 0xee, 0xbb, 0x52, 0xc7, 0x73, 0x03, 0x17,
-	0xad, 0x51, 0x4c, 0x89, 0x63, 0x4a, 0x56, 0xc7, 0x2e, 0xbd, 0xdd, 0xbd, 0xba, 0x79, 0xe6, 0xba,
-	0x67, 0x2d, 0xb2, 0xc3, 0x20, 0x27, 0xdd, 0xd3, 0x9d, 0x66, 0xd7, 0xb3, 0x02, 0xdb, 0x75, 0x42,
-	0xd2, 0xd5, 0x1b, 0xc3, 0xf7, 0x03, 0xbb, 0x4d, 0xfc, 0xc0, 0x6a, 0x77, 0x38, 0x60, 0x2b, 0xe9,
-	0xc9, 0x0d, 0xb7, 0xdd, 0xee, 0x49, 0x24, 0xe6, 0x16, 0x58, 0xfe, 0x9b, 0x96, 0xed, 0x07, 0x21,
-	0xa6, 0xf0, 0xa7, 0x39, 0x58, 0x3f, 0xe2, 0xe9, 0xaa, 0xef, 0x49, 0xa3, 0x4b, 0x53, 0xd0, 0x9c,
-	0x53, 0x17, 0xd5, 0x01, 0x45, 0xeb, 0x30, 0x49, 0x74, 0x27, 0x2f, 0x6c, 0x09, 0xdb, 0xb9, 0xfb,
-	0xb7, 0x4b, 0x09, 0x4b, 0x2a, 0x8d, 0xe8, 0xe0, 0xd5, 0x77, 0xc3, 0x21, 0xf4, 0x10, 0x66, 0x82,
-	0x8b, 0x0e, 0xc9, 0x67, 0x98, 0xd0, 0xcd, 0x89, 0x42, 0xc6, 0x45, 0x87, 0x60, 0x06, 0x47, 0x8f,
-	0x01, 0xfc, 0xc0, 0xf2, 0x02, 0x93, 0x96, 0x21, 0x9f, 0x65, 0xe4, 0xab, 0xa5, 0xb0, 0x46, 0xa5,
-	0xa8, 0x46, 0x25, 0x23, 0xaa, 0x11, 0x5e, 0x60, 0x68, 0x7a, 0x4d, 0xa9, 0x8d, 0x96, 0xeb, 0x93,
-	0x90, 0x3a, 0x93, 0x4e, 0x65, 0x68, 0x46, 0x35, 0x60, 0x31, 0xa4, 0xfa, 0x81, 0x15, 0x74, 0xfd,
-	0xfc, 0xec, 0x96, 0xb0, 0xbd, 0x7c, 0x7f, 0x77, 0xba, 0xd5, 0xcb, 0x94, 0x59, 0x63, 0x44, 0x9c,
-	0x6b, 0xf4, 0x2f, 0xd0, 0x2d, 0x58, 0x3e, 0xb7, 0xfd, 0xc0, 0xf5, 0x2e, 0xcc, 0x16, 0x71, 0xce,
-	0x82, 0xf3, 0xfc, 0xdc, 0x96, 0xb0, 0x9d, 0xc5, 0x4b, 0x3c, 0x5a, 0x66, 0x41, 0xf4, 0x13, 0x58,
-	0xef, 0x58, 0x1e, 0x71, 0x82, 0x7e, 0xf9, 0x4d, 0xdb, 0x39, 0x75, 0xf3, 0x97, 0xd8, 0x12, 0xb6,
-	0x13, 0xb3, 0xa8, 0x32, 0xc6, 0xc0, 0x4e, 0xe2, 0xb5, 0xce, 0x68, 0x10, 0x49, 0xb0, 0xdc, 0x97,
-	0x65, 0x95, 0x99, 0x4f, 0xad, 0xcc, 0x52, 0x8f, 0xc1, 0xaa, 0x73, 0x0f, 0x66, 0xda, 0xa4, 0xed,
-	0xe6, 0x17, 0x18, 0xf1, 0x4a, 0x62, 0x3e, 0x87, 0xa4, 0xed, 0x62, 0x06, 0x43, 0x18, 0x56, 0x7d,
-	0x62, 0x79, 0x8d, 0x73, 0xd3, 0x0a, 0x02, 0xcf, 0x3e, 0xe9, 0x06, 0xc4, 0xcf, 0x03, 0xe3, 0xde,
-	0x4a, 0xe4, 0xd6, 0x18, 0x5a, 0xea, 0x81, 0xb1, 0xe8, 0x0f, 0x45, 0x50, 0x19, 0x56, 0xad, 0x6e,
-	0xe0, 0x9a, 0x1e, 0xf1, 0x49, 0x60, 0x76, 0x5c, 0xdb, 0x09, 0xfc, 0x7c, 0x8e, 0x69, 0x6e, 0x25,
-	0x6a, 0x62, 0x0a, 0xac, 0x32, 0x1c, 0x5e, 0xa1, 0xd4, 0x58, 0x00, 0x5d, 0x83, 0x05, 0x3a, 0x1e,
-	0x26, 0x9d, 0x8f, 0xfc, 0xe2, 0x96, 0xb0, 0xbd, 0x80, 0xe7, 0x69, 0xa0, 0x6c, 0xfb, 0x01, 0xda,
-	0x80, 0x4b, 0xb6, 0x6f, 0x36, 0x3c, 0xd7, 0xc9, 0x2f, 0x6d, 0x09, 0xdb, 0xf3, 0x78, 0xce, 0xf6,
-	0x65, 0xcf, 0x75, 0x0a, 0xbf, 0xcc, 0xc0, 0xe6, 0xe8, 0xe6, 0xbb, 0xce, 0xa9, 0x7d, 0xc6, 0x47,
-	0x1a, 0x7d, 0x1d, 0x17, 0x0e, 0x47, 0xe8, 0x7a, 0x62, 0x7a, 0x06, 0x7f, 0x5a, 0xec, 0xb9, 0x16,
-	0x6c, 0xf5, 0x37, 0x8a, 0xcf, 0x80, 0x6b, 0xf6, 0x3b, 0xda, 0xed, 0x06, 0x7c, 0x98, 0xae, 0x8c,
-	0x6c, 0x9d, 0xc2, 0x13, 0xc0, 0x9f, 0xf6, 0x24, 0x6a, 0x6c, 0x2e, 0x5c, 0x39, 0xea, 0x71, 0xb7,
-	0x1b, 0xa0, 0x23, 0xb8, 0xc6, 0xd2, 0x1b, 0xa3, 0x9e, 0x4d, 0x53, 0xdf, 0xa0, 0xec, 0x04, 0xe1,
-	0xc2, 0xdf, 0x04, 0x58, 0x4b, 0xe8, 0x48, 0x5a, 0xe8, 0xa6, 0xdb, 0xb6, 0x6c, 0xc7, 0xb4, 0x9b,
-	0xac, 0x1e, 0x0b, 0x78, 0x3e, 0x0c, 0x68, 0x4d, 0x74, 0x03, 0x72, 0xfc, 0xa6, 0x63, 0xb5, 0x43,
-	0xa3, 0x58, 0xc0, 0x10, 0x86, 0x74, 0xab, 0x4d, 0xc6, 0x38, 0x53, 0xf6, 0x7f, 0x75, 0xa6, 0x9b,
-	0xb0, 0x68, 0x3b, 0x76, 0x60, 0x5b, 0x01, 0x69, 0xd2, 0xbc, 0x66, 0xd8, 0x50, 0xe6, 0x7a, 0x31,
-	0xad, 0x59, 0xf8, 0x85, 0x00, 0xeb, 0xea, 0xfb, 0x80, 0x78, 0x8e, 0xd5, 0xfa, 0x56, 0xdc, 0x72,
-	0x38, 0xa7, 0xcc, 0x68, 0x4e, 0xff, 0x9c, 0x85, 0xb5, 0x2a, 0x71, 0x9a, 0xb6, 0x73, 0x26, 0x35,
-	0x02, 0xfb, 0xad, 0x1d, 0x5c, 0xb0, 0x8c, 0x6e, 0x40, 0xce, 0xe2, 0xd7, 0xfd, 0x2a, 0x43, 0x14,
-	0xd2, 0x9a, 0x68, 0x1f, 0x96, 0x7a, 0x80, 0x54, 0x4b, 0x8e, 0xa4, 0x99, 0x25, 0x2f, 0x5a, 0xb1,
-	0x2b, 0xf4, 0x0c, 0x66, 0xa9, 0x3d, 0x86, 0xae, 0xbc, 0x7c, 0xff, 0x6e, 0xb2, 0x2f, 0x0d, 0x66,
-	0x48, 0x9d, 0x90, 0xe0, 0x90, 0x87, 0x34, 0x58, 0x3d, 0x27, 0x96, 0x17, 0x9c, 0x10, 0x2b, 0x30,
-	0x9b, 0x24, 0xb0, 0xec, 0x96, 0xcf, 0x7d, 0xfa, 0xd3, 0x31, 0x26, 0x77, 0xd1, 0x72, 0xad, 0x26,
-	0x16, 0x7b, 0x34, 0x25, 0x64, 0xa1, 0x17, 0xb0, 0xd6, 0xb2, 0xfc, 0xc0, 0xec, 0xeb, 0x31, 0x6b,
-	0x9b, 0x4d, 0xb5, 0xb6, 0x55, 0x4a, 0x3b, 0x88, 0x58, 0xcc, 0xde, 0xf6, 0x81, 0x05, 0xc3, 0xa9,
-	0x20, 0xcd, 0x50, 0x69, 0x2e, 0x55, 0x69, 0x85, 0x92, 0x6a, 0x21, 0x87, 0xe9, 0xe4, 0xe1, 0x92,
-	0x15, 0x04, 0xa4, 0xdd, 0x09, 0x98, 0x73, 0xcf, 0xe2, 0xe8, 0x12, 0xdd, 0x05, 0xb1, 0x6d, 0xbd,
-	0xb7, 0xdb, 0xdd, 0xb6, 0xc9, 0x43, 0x3e, 0x73, 0xe1, 0x59, 0xbc, 0xc2, 0xe3, 0x12, 0x0f, 0x53,
-	0xbb, 0xf6, 0x1b, 0xe7, 0xa4, 0xd9, 0x6d, 0x45, 0x99, 0x2c, 0xa4, 0xdb, 0x75, 0x8f, 0xc1, 0xf2,
-	0x90, 0x61, 0x85, 0xbc, 0xef, 0xd8, 0xe1, 0xcc, 0x86, 0x1a, 0x90, 0xaa, 0xb1, 0xdc, 0xa7, 0x30,
-	0x91, 0x67, 0xb0, 0xc8, 0x8a, 0x72, 0x6a, 0xd9, 0xad, 0xae, 0x47, 0xb8, 0xd7, 0x26, 0x6f, 0xd3,
-	0x7e, 0x88, 0xc1, 0x39, 0xca, 0xe0, 0x17, 0xe8, 0x0b, 0xb8, 0xcc, 0x04, 0x68, 0xaf, 0x13, 0xcf,
-	0xb4, 0x9b, 0xc4, 0x09, 0xec, 0xe0, 0x82, 0xdb, 0x2d, 0xa2, 0xf7, 0x8e, 0xd8, 0x2d, 0x8d, 0xdf,
-	0x29, 0xfc, 0x3a, 0x03, 0x57, 0x78, 0xfb, 0xc8, 0xe7, 0x76, 0xab, 0xf9, 0xad, 0x0c, 0xde, 0xe7,
-	0x31, 0x59, 0x3a, 0x1c, 0x71, 0x2f, 0x12, 0xdf, 0xc5, 0xce, 0x27, 0xcc, 0x91, 0x86, 0xc7, 0x34,
-	0x3b, 0x32, 0xa6, 0xe8, 0x15, 0xf0, 0xd7, 0x30, 0x37, 0xd7, 0x8e, 0xdb, 0xb2, 0x1b, 0x17, 0xac,
-	0xcd, 0x97, 0xc7, 0x24, 0x1a, 0x3a, 0x27, 0x33, 0xd4, 0x2a, 0x43, 0xe3, 0xd5, 0xce, 0x70, 0xa8,
-	0xf0, 0x97, 0x4c, 0x6f, 0xfc, 0x15, 0xd2, 0xb0, 0xfd, 0xa8, 0x2e, 0xbd, 0xa9, 0x14, 0xd2, 0xa7,
-	0x32, 0x22, 0x0e, 0x4c, 0xe5, 0x68, 0xc7, 0x65, 0x3e, 0xb6, 0xe3, 0x9e, 0xc2, 0xe2, 0xc0, 0xf0,
-	0xa4, 0x1f, 0xdb, 0x72, 0x7e, 0xf2, 0xe0, 0xcc, 0x0c, 0x0e, 0x0e, 0x86, 0x0d, 0xd7, 0xb3, 0xcf,
-	0x6c, 0xc7, 0x6a, 0x99, 0x43, 0x49, 0xa6, 0x8f, 0xfa, 0x7a, 0x44, 0xad, 0xc5, 0x93, 0x2d, 0xfc,
-	0x21, 0x03, 0x57, 0x22, 0x7b, 0x2a, 0xbb, 0x0d, 0xab, 0xa5, 0xd8, 0x7e, 0xc7, 0x0a, 0x1a, 0xe7,
-	0xd3, 0xb9, 0xe9, 0xff, 0xbf, 0x5c, 0x3f, 0x85, 0xcd, 0xc1, 0x0c, 0x4c, 0xf7, 0xd4, 0x0c, 0xce,
-	0x6d, 0xdf, 0x8c, 0x57, 0x71, 0xb2, 0xe0, 0xd5, 0x81, 0x8c, 0x2a, 0xa7, 0xc6, 0xb9, 0xed, 0x73,
-	0x0f, 0x42, 0xd7, 0x01, 0xd8, 0x29, 0x21, 0x70, 0xdf, 0x10, 0x87, 0xd5, 0x79, 0x11, 0xb3, 0x63,
-	0x8d, 0x41, 0x03, 0x85, 0x17, 0x90, 0x8b, 0x9f, 0xa5, 0x9e, 0xc0, 0x1c, 0x3f, 0x8e, 0x09, 0x5b,
-	0xd9, 0xed, 0xdc, 0xfd, 0xcf, 0x52, 0x8e, 0x63, 0xec, 0xa4, 0xca, 0x29, 0x85, 0xdf, 0x65, 0x60,
-	0x79, 0xf0, 0x16, 0xba, 0x03, 0x2b, 0x27, 0xb6, 0x63, 0x79, 0x17, 0x66, 0xe3, 0x9c, 0x34, 0xde,
-	0xf8, 0xdd, 0x36, 0xdf, 0x84, 0xe5, 0x30, 0x2c, 0xf3, 0x28, 0x5a, 0x87, 0x39, 0xaf, 0xeb, 0x44,
-	0x2f, 0xcb, 0x05, 0x3c, 0xeb, 0x75, 0xe9, 0xa9, 0xe2, 0x29, 0x5c, 0x3b, 0xb5, 0x3d, 0x9f, 0xbe,
-	0x60, 0xc2, 0x66, 0x37, 0x1b, 0x6e, 0xbb, 0xd3, 0x22, 0x03, 0x13, 0x9b, 0x67, 0x90, 0x68, 0x1c,
-	0xe4, 0x08, 0xc0, 0xe8, 0x8b, 0x0d, 0x8f, 0x58, 0xbd, 0xbd, 0x49, 0x2f, 0x65, 0x8e, 0xe3, 0xb9,
-	0x6d, 0x2e, 0x31, 0x23, 0xb5, 0x9d, 0xb3, 0x69, 0xdb, 0x74, 0x31, 0x22, 0x30, 0x81, 0x4d, 0x00,
-	0x76, 0xc6, 0x0d, 0xac, 0x93, 0x56, 0xf8, 0x16, 0x9a, 0xc7, 0xb1, 0x48, 0xf1, 0xf7, 0x02, 0x5c,
-	0x4e, 0x7a, 0xc7, 0xa2, 0x02, 0x6c, 0x56, 0x55, 0x5d, 0xd1, 0xf4, 0xe7, 0xa6, 0x24, 0x1b, 0xda,
-	0x2b, 0xcd, 0x38, 0x36, 0x6b, 0x86, 0x64, 0xa8, 0xa6, 0xa6, 0xbf, 0x92, 0xca, 0x9a, 0x22, 0x7e,
-	0x07, 0x7d, 0x17, 0xb6, 0xc6, 0x60, 0x6a, 0xf2, 0x81, 0xaa, 0xd4, 0xcb, 0xaa, 0x22, 0x0a, 0x13,
-	0x94, 0x6a, 0x86, 0x84, 0x0d, 0x55, 0x11, 0x33, 0xe8, 0x7b, 0x70, 0x67, 0x0c, 0x46, 0x96, 0x74,
-	0x59, 0x2d, 0x9b, 0x58, 0xfd, 0x71, 0x5d, 0xad, 0x51, 0x70, 0xb6, 0xf8, 0xb3, 0x7e, 0xce, 0x03,
-	0x0e, 0x14, 0x7f, 0x92, 0xa2, 0xca, 0x5a, 0x4d, 0xab, 0xe8, 0x93, 0x72, 0x1e, 0xc2, 0x8c, 0xc9,
-	0x79, 0x18, 0x15, 0xe5, 0x5c, 0xfc, 0x79, 0xa6, 0xff, 0x09, 0xac, 0x35, 0x31, 0xe9, 0x46, 0xde,
-	0x4a, 0x9f, 0x71, 0x54, 0xc1, 0x2f, 0xf7, 0xcb, 0x95, 0x23, 0x53, 0x53, 0x4c, 0xac, 0xd6, 0x6b,
-	0xaa, 0x59, 0xad, 0x94, 0x35, 0xf9, 0x38, 0x96, 0xc9, 0xf7, 0xe1, 0xcb, 0xb1, 0x28, 0xa9, 0x4c,
-	0xa3, 0x4a, 0xbd, 0x5a, 0xd6, 0x64, 0xfa, 0xd4, 0x7d, 0x49, 0x2b, 0xab, 0x8a, 0x59, 0xd1, 0xcb,
-	0xc7, 0xa2, 0x80, 0x3e, 0x87, 0xed, 0x69, 0x99, 0x62, 0x06, 0xdd, 0x83, 0xbb, 0x63, 0xd1, 0x58,
-	0x7d, 0xa1, 0xca, 0x46, 0x0c, 0x9e, 0x45, 0xbb, 0x70, 0x6f, 0x2c, 0xdc, 0x50, 0xf1, 0xa1, 0xa6,
-	0xb3, 0x82, 0xee, 0x9b, 0xb8, 0xae, 0xeb, 0x9a, 0xfe, 0x5c, 0x9c, 0x29, 0xfe, 0x46, 0x80, 0xd5,
-	0x91, 0x97, 0x0e, 0xba, 0x01, 0xd7, 0xaa, 0x12, 0x56, 0x75, 0xc3, 0x94, 0xcb, 0x95, 0xa4, 0x02,
-	0x8c, 0x01, 0x48, 0x7b, 0x92, 0xae, 0x54, 0x74, 0x51, 0x40, 0xb7, 0xa1, 0x90, 0x04, 0xe0, 0xbd,
-	0xc0, 0x5b, 0x43, 0xcc, 0xa0, 0x9b, 0x70, 0x3d, 0x09, 0xd7, 0xcb, 0x56, 0xcc, 0x16, 0xff, 0x9d,
-	0x81, 0x4f, 0x27, 0x7d, 0x69, 0xd3, 0x0e, 0xec, 0x2d, 0x5b, 0x7d, 0xad, 0xca, 0x75, 0x83, 0xee,
-	0x79, 0xa8, 0x47, 0x77, 0xbe, 0x5e, 0x8b, 0x65, 0x1e, 0x2f, 0xe9, 0x18, 0xb0, 0x5c, 0x39, 0xac,
-	0x96, 0x55, 0x83, 0x75, 0x53, 0x11, 0x6e, 0xa7, 0xc1, 0xc3, 0x0d, 0x16, 0x33, 0x03, 0x7b, 0x3b,
-	0x4e, 0x9a, 0xad, 0x9b, 0x8e, 0x02, 0x2a, 0x41, 0x31, 0x0d, 0xdd, 0xab, 0x82, 0x22, 0xce, 0xa0,
-	0x2f, 0xe1, 0x8b, 0xf4, 0xc4, 0x75, 0x43, 0xd3, 0xeb, 0xaa, 0x62, 0x4a, 0x35, 0x53, 0x57, 0x8f,
-	0xc4, 0xd9, 0x69, 0x96, 0x6b, 0x68, 0x87, 0xb4, 0x3f, 0xeb, 0x86, 0x38, 0x57, 0xfc, 0xa3, 0x00,
-	0x9f, 0xc8, 0xae, 0x13, 0xd8, 0x4e, 0x97, 0x48, 0xbe, 0x4e, 0xde, 0x69, 0xe1, 0x79, 0xc6, 0xf5,
-	0xd0, 0x2d, 0xb8, 0x19, 0xe9, 0x73, 0x79, 0x53, 0xd3, 0x35, 0x43, 0x93, 0x8c, 0x0a, 0x8e, 0xd5,
-	0x77, 0x22, 0x8c, 0x0e, 0xa4, 0xa2, 0xe2, 0xb0, 0xae, 0xe3, 0x61, 0x58, 0x35, 0xf0, 0x31, 0x6f,
-	0x85, 0xd0, 0x61, 0xc6, 0x63, 0x65, 0x4c, 0xe7, 0x9b, 0xcf, 0xbf, 0x98, 0x2d, 0xfe, 0x56, 0x80,
-	0x1c, 0xff, 0x16, 0x65, 0x9f, 0x2a, 0x79, 0xb8, 0x4c, 0x17, 0x58, 0xa9, 0x1b, 0xa6, 0x71, 0x5c,
-	0x55, 0x07, 0x7b, 0x78, 0xe0, 0x0e, 0xb3, 0x07, 0xd3, 0xa8, 0x84, 0xd5, 0x09, 0x9d, 0x64, 0x10,
-	0xc0, 0x9f, 0x42, 0x31, 0x0c, 0x2c, 0x66, 0x26, 0x62, 0x42, 0x9d, 0x2c, 0xba, 0x0a, 0x9f, 0x0c,
-	0x60, 0x0e, 0x54, 0x09, 0x1b, 0x7b, 0xaa, 0x64, 0x88, 0x33, 0xc5, 0x5f, 0x09, 0x70, 0x25, 0x72,
-	0x42, 0x83, 0xbe, 0x58, 0xed, 0x36, 0x69, 0x56, 0xba, 0x81, 0x6c, 0x75, 0x7d, 0x82, 0xee, 0xc2,
-	0xad, 0x9e, 0x87, 0x19, 0x52, 0xed, 0x65, 0x7f, 0xaf, 0x4c, 0x59, 0xa2, 0xc3, 0xdd, 0x5f, 0x4d,
-	0x2a, 0x94, 0xa7, 0x20, 0x0a, 0xe8, 0x0e, 0x7c, 0x36, 0x19, 0x8a, 0xd5, 0x9a, 0x6a, 0x88, 0x99,
-	0xe2, 0x3f, 0x72, 0xb0, 0x11, 0x4f, 0x8e, 0x1e, 0xe8, 0x49, 0x33, 0x4c, 0xed, 0x36, 0x14, 0x06,
-	0x45, 0xb8, 0xcf, 0x0d, 0xe7, 0xb5, 0x0b, 0xf7, 0x26, 0xe0, 0xea, 0xfa, 0x81, 0xa4, 0x2b, 0xf4,
-	0x3a, 0x02, 0x89, 0x02, 0x7a, 0x06, 0x4f, 0x26, 0x50, 0xf6, 0x24, 0xa5, 0x5f, 0xe5, 0xde, 0x1b,
-	0x47, 0x32, 0x0c, 0xac, 0xed, 0xd5, 0x0d, 0xb5, 0x26, 0x66, 0x90, 0x0a, 0x52, 0x8a, 0xc0, 0xa0,
-	0x0f, 0x25, 0xca, 0x64, 0xd1, 0x63, 0x78, 0x98, 0x96, 0x47, 0xd8, 0x32, 0xda, 0xa1, 0x8a, 0xe3,
-	0xd4, 0x19, 0xf4, 0x35, 0x7c, 0x95, 0x42, 0xe5, 0x4f, 0x1e, 0xe1, 0xce, 0xa2, 0x27, 0xf0, 0x28,
-	0x35, 0x7b, 0xb9, 0x82, 0x15, 0xf3, 0x50, 0xc2, 0x2f, 0x07, 0xc9, 0x73, 0x48, 0x03, 0x35, 0xed,
-	0xc1, 0xdc, 0xdd, 0xcc, 0x04, 0x5f, 0x88, 0x49, 0x5d, 0x9a, 0xa2, 0x8a, 0x34, 0x90, 0x22, 0x33,
-	0x8f, 0x9e, 0x83, 0x3c, 0x5d, 0x29, 0x26, 0x0b, 0x2d, 0xa0, 0xd7, 0x60, 0x7c, 0xdc, 0xae, 0xaa,
-	0xaf, 0x0d, 0x15, 0xeb, 0x52, 0x9a, 0x32, 0xa0, 0xa7, 0xf0, 0x38, 0xb5, 0x68, 0x83, 0xfe, 0x13,
-	0xa3, 0xe7, 0xd0, 0x23, 0x78, 0x30, 0x81, 0x1e, 0xef, 0x91, 0xfe, 0xa9, 0x40, 0x53, 0xc4, 0x45,
-	0xf4, 0x10, 0x76, 0x27, 0x10, 0xd9, 0x14, 0x9a, 0x35, 0x43, 0x93, 0x5f, 0x1e, 0x87, 0xb7, 0xcb,
-	0x5a, 0xcd, 0x10, 0x97, 0xd0, 0x8f, 0xe0, 0x07, 0x13, 0x68, 0xbd, 0xc5, 0xd2, 0x3f, 0x54, 0x1c,
-	0x1b, 0x31, 0x0a, 0xab, 0x63, 0x55, 0x5c, 0x9e, 0x62, 0x4f, 0x6a, 0xda, 0xf3, 0xf4, 0xca, 0xad,
-	0x20, 0x19, 0x9e, 0x4d, 0x35, 0x22, 0xf2, 0x81, 0x56, 0x56, 0x92, 0x45, 0x44, 0xf4, 0x00, 0x76,
-	0x26, 0x88, 0xec, 0x57, 0xb0, 0xac, 0xf2, 0x37, 0x56, 0xcf, 0x24, 0x56, 0xd1, 0x57, 0x70, 0x7f,
-	0x12, 0x49, 0xd2, 0xca, 0x95, 0x57, 0x2a, 0x1e, 0xe6, 0x21, 0xfa, 0x1a, 0x9d, 0x6e, 0xe9, 0x9a,
-	0x5e, 0xad, 0x1b, 0x66, 0x4d, 0xfb, 0x46, 0x15, 0xd7, 0xe8, 0x6b, 0x34, 0x75, 0xa7, 0xa2, 0x5a,
-	0x89, 0x97, 0x47, 0xcd, 0x78, 0xe4, 0x21, 0x7b, 0x9a, 0x2e, 0xe1, 0x63, 0x71, 0x3d, 0xa5, 0xf7,
-	0x46, 0x8d, 0x6e, 0xa0, 0x85, 0x3e, 0x99, 0x66, 0x39, 0xaa, 0x84, 0xe5, 0x83, 0x78, 0xc5, 0x37,
-	0xe8, 0x5b, 0xe7, 0x26, 0xfb, 0xc7, 0xca, 0xc8, 0xb9, 0x2a, 0x6e, 0xf1, 0xbb, 0x70, 0x2f, 0xdc,
-	0xb7, 0x84, 0x2e, 0x18, 0xe3, 0xf6, 0x7b, 0xf0, 0xc3, 0xe9, 0x28, 0xbd, 0xfb, 0x52, 0x19, 0xab,
-	0x92, 0x72, 0xdc, 0x3b, 0x92, 0x0a, 0xc5, 0x3f, 0x0b, 0x50, 0x94, 0x2d, 0xa7, 0x41, 0x5a, 0xd1,
-	0xff, 0x5d, 0x27, 0x66, 0xf9, 0x04, 0x1e, 0x4d, 0x31, 0xef, 0x63, 0xf2, 0x3d, 0x82, 0xda, 0xc7,
-	0x92, 0xeb, 0xfa, 0x4b, 0xbd, 0x72, 0xa4, 0x4f, 0x22, 0xf0, 0x45, 0xd4, 0xec, 0x33, 0xf6, 0x4f,
-	0xe3, 0xe9, 0x16, 0xc1, 0xdb, 0xee, 0xbf, 0x5b, 0xc4, 0xc7, 0x92, 0xa7, 0x5a, 0xc4, 0xde, 0xc9,
-	0x5f, 0x3f, 0x6c, 0x0a, 0x7f, 0xff, 0xb0, 0x29, 0xfc, 0xeb, 0xc3, 0xa6, 0x00, 0x1b, 0x0d, 0xb7,
-	0x9d, 0xf4, 0x45, 0xbf, 0x37, 0x2f, 0x75, 0xec, 0x2a, 0xfd, 0x9a, 0xad, 0x0a, 0xdf, 0xec, 0x9c,
-	0xd9, 0xc1, 0x79, 0xf7, 0xa4, 0xd4, 0x70, 0xdb, 0x3b, 0x03, 0xbf, 0x45, 0x96, 0xce, 0x88, 0x13,
-	0xfe, 0xb2, 0xc9, 0x7f, 0x96, 0x7c, 0x62, 0x75, 0xec, 0xb7, 0xbb, 0x27, 0x73, 0x2c, 0xf6, 0xe0,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xa4, 0xf1, 0x62, 0x56, 0x1d, 0x00, 0x00,
+	// 2397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0xdb, 0xc8,
+	0x15, 0x2d, 0x25, 0xdb, 0xb1, 0xaf, 0xfc, 0x41, 0x8f, 0xe3, 0xb5, 0x92, 0xec, 0x3a, 0x8e, 0xb6,
+	0xc9, 0x3a, 0xea, 0x46, 0x5e, 0x7b, 0x37, 0x9b, 0x66, 0xd3, 0x6c, 0x4a, 0x93, 0x74, 0xcc, 0x44,
+	0xa6, 0xd4, 0x21, 0x15, 0xc7, 0x8b, 0xa2, 0x04, 0x2d, 0x8d, 0x6d, 0x22, 0x12, 0x29, 0x90, 0xa3,
+	0x24, 0x7e, 0x5f, 0xa0, 0xcf, 0x7d, 0x2b, 0xb6, 0x2f, 0x45, 0x9f, 0x0b, 0x14, 0xfd, 0x01, 0x45,
+	0x81, 0x3e, 0x14, 0x05, 0x8a, 0x02, 0xfd, 0x09, 0x45, 0xde, 0xfa, 0x2f, 0x8a, 0x19, 0x92, 0x12,
+	0xf5, 0x49, 0xa5, 0x05, 0xb6, 0x6f, 0xe6, 0xe5, 0x39, 0x97, 0x67, 0xee, 0xdc, 0x7b, 0x38, 0x94,
+	0xa1, 0xd0, 0x39, 0x25, 0xfe, 0x4e, 0xdd, 0x6e, 0x10, 0xb7, 0x4e, 0x76, 0xec, 0xb6, 0xb3, 0xf3,
+	0x7a, 0x77, 0xe7, 0x8d, 0xe7, 0xbf, 0x3a, 0x6b, 0x7a, 0x6f, 0x4a, 0x6d, 0xdf, 0xa3, 0x1e, 0x5a,
+	0x63, 0x98, 0x52, 0x84, 0x29, 0xd9, 0x6d, 0xa7, 0xf4, 0x7a, 0xf7, 0xfa, 0xe6, 0xb9, 0xe7, 0x9d,
+	0x37, 0xc9, 0x0e, 0x87, 0x9c, 0x76, 0xce, 0x76, 0x1a, 0x1d, 0xdf, 0xa6, 0x8e, 0xe7, 0x86, 0xa4,
+	0xeb, 0x37, 0x07, 0xef, 0x53, 0xa7, 0x45, 0x02, 0x6a, 0xb7, 0xda, 0x11, 0x60, 0x6b, 0xd4, 0x93,
+	0xeb, 0x5e, 0xab, 0xd5, 0x4d, 0x31, 0x52, 0x1b, 0xb5, 0x83, 0x57, 0x4d, 0x27, 0xa0, 0x21, 0xa6,
+	0xf0, 0xed, 0x15, 0x58, 0x3f, 0x8e, 0xe4, 0xaa, 0x6f, 0x49, 0xbd, 0xc3, 0x24, 0x68, 0xee, 0x99,
+	0x87, 0x6a, 0x80, 0xe2, 0x75, 0x58, 0x24, 0xbe, 0x93, 0x17, 0xb6, 0x84, 0xed, 0xdc, 0xde, 0x9d,
+	0xd2, 0x88, 0x25, 0x95, 0x86, 0xf2, 0xe0, 0xd5, 0x37, 0x83, 0x21, 0x74, 0x1f, 0x66, 0xe8, 0x65,
+	0x9b, 0xe4, 0x33, 0x3c, 0xd1, 0xad, 0x89, 0x89, 0xcc, 0xcb, 0x36, 0xc1, 0x1c, 0x8e, 0x1e, 0x02,
+	0x04, 0xd4, 0xf6, 0xa9, 0xc5, 0xca, 0x90, 0xcf, 0x72, 0xf2, 0xf5, 0x52, 0x58, 0xa3, 0x52, 0x5c,
+	0xa3, 0x92, 0x19, 0xd7, 0x08, 0x2f, 0x70, 0x34, 0xbb, 0x66, 0xd4, 0x7a, 0xd3, 0x0b, 0x48, 0x48,
+	0x9d, 0x49, 0xa7, 0x72, 0x34, 0xa7, 0x9a, 0xb0, 0x18, 0x52, 0x03, 0x6a, 0xd3, 0x4e, 0x90, 0x9f,
+	0xdd, 0x12, 0xb6, 0x97, 0xf7, 0x76, 0xa7, 0x5b, 0xbd, 0xcc, 0x98, 0x06, 0x27, 0xe2, 0x5c, 0xbd,
+	0x77, 0x81, 0x6e, 0xc3, 0xf2, 0x85, 0x13, 0x50, 0xcf, 0xbf, 0xb4, 0x9a, 0xc4, 0x3d, 0xa7, 0x17,
+	0xf9, 0xb9, 0x2d, 0x61, 0x3b, 0x8b, 0x97, 0xa2, 0x68, 0x99, 0x07, 0xd1, 0xcf, 0x61, 0xbd, 0x6d,
+	0xfb, 0xc4, 0xa5, 0xbd, 0xf2, 0x5b, 0x8e, 0x7b, 0xe6, 0xe5, 0xaf, 0xf0, 0x25, 0x6c, 0x8f, 0x54,
+	0x51, 0xe5, 0x8c, 0xbe, 0x9d, 0xc4, 0x6b, 0xed, 0xe1, 0x20, 0x92, 0x60, 0xb9, 0x97, 0x96, 0x57,
+	0x66, 0x3e, 0xb5, 0x32, 0x4b, 0x5d, 0x06, 0xaf, 0xce, 0x3d, 0x98, 0x69, 0x91, 0x96, 0x97, 0x5f,
+	0xe0, 0xc4, 0x6b, 0x23, 0xf5, 0x1c, 0x91, 0x96, 0x87, 0x39, 0x0c, 0x61, 0x58, 0x0d, 0x88, 0xed,
+	0xd7, 0x2f, 0x2c, 0x9b, 0x52, 0xdf, 0x39, 0xed, 0x50, 0x12, 0xe4, 0x81, 0x73, 0x6f, 0x8f, 0xe4,
+	0x1a, 0x1c, 0x2d, 0x75, 0xc1, 0x58, 0x0c, 0x06, 0x22, 0xa8, 0x0c, 0xab, 0x76, 0x87, 0x7a, 0x96,
+	0x4f, 0x02, 0x42, 0xad, 0xb6, 0xe7, 0xb8, 0x34, 0xc8, 0xe7, 0x78, 0xce, 0xad, 0x91, 0x39, 0x31,
+	0x03, 0x56, 0x39, 0x0e, 0xaf, 0x30, 0x6a, 0x22, 0x80, 0x6e, 0xc0, 0x02, 0x1b, 0x0f, 0x8b, 0xcd,
+	0x47, 0x7e, 0x71, 0x4b, 0xd8, 0x5e, 0xc0, 0xf3, 0x2c, 0x50, 0x76, 0x02, 0x8a, 0x36, 0xe0, 0x8a,
+	0x13, 0x58, 0x75, 0xdf, 0x73, 0xf3, 0x4b, 0x5b, 0xc2, 0xf6, 0x3c, 0x9e, 0x73, 0x02, 0xd9, 0xf7,
+	0x5c, 0xb4, 0x0f, 0x2b, 0x2e, 0x79, 0x4b, 0x2d, 0x9f, 0x50, 0xff, 0x32, 0x2c, 0xe5, 0x72, 0x7a,
+	0x29, 0x19, 0x05, 0x33, 0x06, 0x8b, 0x15, 0x7e, 0x9d, 0x81, 0xcd, 0xe1, 0x06, 0xf2, 0xdc, 0x33,
+	0xe7, 0x3c, 0xb2, 0x05, 0xf4, 0x55, 0x52, 0x5c, 0x38, 0x86, 0x1f, 0x8d, 0x5c, 0xa2, 0x19, 0x29,
+	0x4e, 0x68, 0xb7, 0x61, 0xab, 0xb7, 0xd9, 0xd1, 0x1c, 0x79, 0x56, 0x6f, 0x2a, 0xbc, 0x0e, 0x8d,
+	0x06, 0xf2, 0xda, 0x90, 0x66, 0x25, 0x12, 0x80, 0x3f, 0xec, 0xa6, 0x30, 0xf8, 0x6c, 0x79, 0x72,
+	0x3c, 0x27, 0x5e, 0x87, 0xa2, 0x63, 0xb8, 0xc1, 0xe5, 0x8d, 0xc9, 0x9e, 0x4d, 0xcb, 0xbe, 0xc1,
+	0xd8, 0x23, 0x12, 0x17, 0xfe, 0x21, 0xc0, 0xda, 0x88, 0xae, 0x66, 0x9b, 0xd5, 0xf0, 0x5a, 0xb6,
+	0xe3, 0x5a, 0x4e, 0x83, 0xd7, 0x63, 0x01, 0xcf, 0x87, 0x01, 0xad, 0x81, 0x6e, 0x42, 0x2e, 0xba,
+	0xe9, 0xda, 0xad, 0xd0, 0x6c, 0x16, 0x30, 0x84, 0x21, 0xdd, 0x6e, 0x91, 0x31, 0xee, 0x96, 0xfd,
+	0x5f, 0xdd, 0xed, 0x16, 0x2c, 0x3a, 0xae, 0x43, 0x1d, 0x9b, 0x92, 0x06, 0xd3, 0x35, 0xc3, 0x07,
+	0x3b, 0xd7, 0x8d, 0x69, 0x8d, 0xc2, 0xaf, 0x04, 0x58, 0x57, 0xdf, 0x52, 0xe2, 0xbb, 0x76, 0xf3,
+	0x7b, 0x71, 0xdc, 0x41, 0x4d, 0x99, 0x61, 0x4d, 0xbf, 0x9b, 0x83, 0xb5, 0x2a, 0x71, 0x1b, 0x8e,
+	0x7b, 0x2e, 0xd5, 0xa9, 0xf3, 0xda, 0xa1, 0x97, 0x5c, 0xd1, 0x4d, 0xc8, 0xd9, 0xd1, 0x75, 0xaf,
+	0xca, 0x10, 0x87, 0xb4, 0x06, 0x3a, 0x80, 0xa5, 0x2e, 0x20, 0xd5, 0xd6, 0xe3, 0xd4, 0xdc, 0xd6,
+	0x17, 0xed, 0xc4, 0x15, 0x7a, 0x02, 0xb3, 0xcc, 0x62, 0x43, 0x67, 0x5f, 0xde, 0xbb, 0x3b, 0xda,
+	0xdb, 0xfa, 0x15, 0x32, 0x37, 0x25, 0x38, 0xe4, 0x21, 0x0d, 0x56, 0x2f, 0x88, 0xed, 0xd3, 0x53,
+	0x62, 0x53, 0xab, 0x41, 0xa8, 0xed, 0x34, 0x83, 0xc8, 0xeb, 0x3f, 0x1c, 0x63, 0x94, 0x97, 0x4d,
+	0xcf, 0x6e, 0x60, 0xb1, 0x4b, 0x53, 0x42, 0x16, 0x7a, 0x06, 0x6b, 0x4d, 0x3b, 0xa0, 0x56, 0x2f,
+	0x1f, 0x9f, 0xe9, 0xd9, 0xd4, 0x99, 0x5e, 0x65, 0xb4, 0xc3, 0x98, 0xc5, 0xe2, 0xe8, 0x00, 0x78,
+	0x30, 0x9c, 0x0a, 0xd2, 0x08, 0x33, 0xcd, 0xa5, 0x66, 0x5a, 0x61, 0x24, 0x23, 0xe4, 0xf0, 0x3c,
+	0x79, 0xb8, 0x62, 0x53, 0x4a, 0x5a, 0x6d, 0xca, 0xdd, 0x7f, 0x16, 0xc7, 0x97, 0xe8, 0x2e, 0x88,
+	0x2d, 0xfb, 0xad, 0xd3, 0xea, 0xb4, 0xac, 0x28, 0x14, 0x70, 0x27, 0x9f, 0xc5, 0x2b, 0x51, 0x5c,
+	0x8a, 0xc2, 0xcc, 0xf2, 0x83, 0xfa, 0x05, 0x69, 0x74, 0x9a, 0xb1, 0x92, 0x85, 0x74, 0x9f, 0xea,
+	0x32, 0xb8, 0x0e, 0x19, 0x56, 0xc8, 0xdb, 0xb6, 0x13, 0xce, 0x6c, 0x98, 0x03, 0x52, 0x73, 0x2c,
+	0xf7, 0x28, 0x3c, 0xc9, 0x13, 0x58, 0xe4, 0x45, 0x39, 0xb3, 0x9d, 0x66, 0xc7, 0x27, 0xf9, 0xdc,
+	0x84, 0x6d, 0x3a, 0x08, 0x31, 0x38, 0xc7, 0x18, 0xd1, 0x05, 0xfa, 0x0c, 0xae, 0xf2, 0x04, 0xac,
+	0xd7, 0x89, 0x6f, 0x39, 0x0d, 0xe2, 0x52, 0x87, 0x5e, 0x46, 0x96, 0x8d, 0xd8, 0xbd, 0x63, 0x7e,
+	0x4b, 0x8b, 0xee, 0x8c, 0xf2, 0xe8, 0xa5, 0xf7, 0xf5, 0xe8, 0xdf, 0x64, 0xe0, 0x5a, 0xd4, 0x82,
+	0xf2, 0x85, 0xd3, 0x6c, 0x7c, 0x2f, 0xc3, 0xfb, 0x69, 0x22, 0x2d, 0x1b, 0xb0, 0xa4, 0x9f, 0x89,
+	0x6f, 0x12, 0xe7, 0x24, 0xee, 0x6a, 0x83, 0xa3, 0x9e, 0x1d, 0x1a, 0x75, 0xf4, 0x02, 0xa2, 0xe3,
+	0x40, 0x64, 0xd0, 0x6d, 0xaf, 0xe9, 0xd4, 0x2f, 0xf9, 0xa8, 0x2c, 0x8f, 0x11, 0x1a, 0xba, 0x2f,
+	0x37, 0xe5, 0x2a, 0x47, 0xe3, 0xd5, 0xf6, 0x60, 0xa8, 0xf0, 0xd7, 0x4c, 0xd7, 0x42, 0x14, 0x52,
+	0x77, 0x82, 0xb8, 0x2e, 0xdd, 0xc9, 0x16, 0xd2, 0x27, 0x3b, 0x26, 0xf6, 0x4d, 0xf6, 0x70, 0xd7,
+	0x66, 0xde, 0xb7, 0x6b, 0x1f, 0xc3, 0x62, 0xdf, 0x00, 0xa6, 0x1f, 0x1f, 0x73, 0xc1, 0xe8, 0xe1,
+	0x9b, 0xe9, 0x1f, 0x3e, 0x0c, 0x1b, 0x9e, 0xef, 0x9c, 0x3b, 0xae, 0xdd, 0xb4, 0x06, 0x44, 0xa6,
+	0xdb, 0xc5, 0x7a, 0x4c, 0x35, 0x92, 0x62, 0x0b, 0x7f, 0xca, 0xc0, 0xb5, 0xd8, 0xe2, 0xca, 0x5e,
+	0xdd, 0x6e, 0x2a, 0x4e, 0xd0, 0xb6, 0x69, 0xfd, 0x62, 0x3a, 0x47, 0xfe, 0xff, 0x97, 0xeb, 0x17,
+	0xb0, 0xd9, 0xaf, 0xc0, 0xf2, 0xce, 0x2c, 0x7a, 0xe1, 0x04, 0x56, 0xb2, 0x8a, 0x93, 0x13, 0x5e,
+	0xef, 0x53, 0x54, 0x39, 0x33, 0x2f, 0x9c, 0x20, 0xf2, 0x31, 0xf4, 0x11, 0x00, 0x3f, 0x69, 0x50,
+	0xef, 0x15, 0x71, 0x79, 0x9d, 0x17, 0x31, 0x3f, 0x1a, 0x99, 0x2c, 0x50, 0x78, 0x06, 0xb9, 0xe4,
+	0x99, 0xee, 0x11, 0xcc, 0x45, 0xc7, 0x42, 0x61, 0x2b, 0xbb, 0x9d, 0xdb, 0xfb, 0x38, 0xe5, 0x58,
+	0xc8, 0x4f, 0xcc, 0x11, 0xa5, 0xf0, 0x87, 0x0c, 0x2c, 0xf7, 0xdf, 0x42, 0x9f, 0xc0, 0xca, 0xa9,
+	0xe3, 0xda, 0xfe, 0xa5, 0x55, 0xbf, 0x20, 0xf5, 0x57, 0x41, 0xa7, 0x15, 0x6d, 0xc2, 0x72, 0x18,
+	0x96, 0xa3, 0x28, 0x5a, 0x87, 0x39, 0xbf, 0xe3, 0xc6, 0x2f, 0xdc, 0x05, 0x3c, 0xeb, 0x77, 0xd8,
+	0xc9, 0xe4, 0x31, 0xdc, 0x38, 0x73, 0xfc, 0x80, 0xbd, 0xa4, 0xc2, 0x66, 0xb7, 0xea, 0x5e, 0xab,
+	0xdd, 0x24, 0x7d, 0x13, 0x9b, 0xe7, 0x90, 0x78, 0x1c, 0xe4, 0x18, 0xc0, 0xe9, 0x8b, 0x75, 0x9f,
+	0xd8, 0xdd, 0xbd, 0x49, 0x2f, 0x65, 0x2e, 0xc2, 0x47, 0xd6, 0xbb, 0xc4, 0xcd, 0xd8, 0x71, 0xcf,
+	0xa7, 0x6d, 0xd3, 0xc5, 0x98, 0xc0, 0x13, 0x6c, 0x02, 0xf0, 0xb3, 0x36, 0xb5, 0x4f, 0x9b, 0xe1,
+	0x9b, 0x6c, 0x1e, 0x27, 0x22, 0xc5, 0x3f, 0x0a, 0x70, 0x75, 0xd4, 0x7b, 0x1a, 0x15, 0x60, 0xb3,
+	0xaa, 0xea, 0x8a, 0xa6, 0x3f, 0xb5, 0x24, 0xd9, 0xd4, 0x5e, 0x68, 0xe6, 0x89, 0x65, 0x98, 0x92,
+	0xa9, 0x5a, 0x9a, 0xfe, 0x42, 0x2a, 0x6b, 0x8a, 0xf8, 0x03, 0xf4, 0x43, 0xd8, 0x1a, 0x83, 0x31,
+	0xe4, 0x43, 0x55, 0xa9, 0x95, 0x55, 0x45, 0x14, 0x26, 0x64, 0x32, 0x4c, 0x09, 0x9b, 0xaa, 0x22,
+	0x66, 0xd0, 0x8f, 0xe0, 0x93, 0x31, 0x18, 0x59, 0xd2, 0x65, 0xb5, 0x6c, 0x61, 0xf5, 0x67, 0x35,
+	0xd5, 0x60, 0xe0, 0x6c, 0xf1, 0xdb, 0x9e, 0xe6, 0x3e, 0x07, 0x4a, 0x3e, 0x49, 0x51, 0x65, 0xcd,
+	0xd0, 0x2a, 0xfa, 0x24, 0xcd, 0x03, 0x98, 0x31, 0x9a, 0x07, 0x51, 0xb1, 0xe6, 0xe2, 0x2f, 0x33,
+	0xbd, 0x4f, 0x71, 0xad, 0x81, 0x49, 0x27, 0xf6, 0x56, 0xf6, 0x8c, 0xe3, 0x0a, 0x7e, 0x7e, 0x50,
+	0xae, 0x1c, 0x5b, 0x9a, 0x62, 0x61, 0xb5, 0x66, 0xa8, 0x56, 0xb5, 0x52, 0xd6, 0xe4, 0x93, 0x84,
+	0x92, 0x1f, 0xc3, 0x17, 0x63, 0x51, 0x52, 0x99, 0x45, 0x95, 0x5a, 0xb5, 0xac, 0xc9, 0xec, 0xa9,
+	0x07, 0x92, 0x56, 0x56, 0x15, 0xab, 0xa2, 0x97, 0x4f, 0x44, 0x01, 0x7d, 0x0a, 0xdb, 0xd3, 0x32,
+	0xc5, 0x0c, 0xba, 0x07, 0x77, 0xc7, 0xa2, 0xb1, 0xfa, 0x4c, 0x95, 0xcd, 0x04, 0x3c, 0x8b, 0x76,
+	0xe1, 0xde, 0x58, 0xb8, 0xa9, 0xe2, 0x23, 0x4d, 0xe7, 0x05, 0x3d, 0xb0, 0x70, 0x4d, 0xd7, 0x35,
+	0xfd, 0xa9, 0x38, 0x53, 0xfc, 0xbb, 0x00, 0xf9, 0x5e, 0x25, 0xd8, 0x67, 0x50, 0xd3, 0xa9, 0xd3,
+	0xa8, 0x18, 0x9f, 0xc0, 0xc7, 0xc9, 0x7c, 0x72, 0x45, 0x3f, 0x28, 0x6b, 0xb2, 0x39, 0x5c, 0x8f,
+	0x81, 0xaa, 0x0d, 0x02, 0x59, 0x09, 0x86, 0xd7, 0x3e, 0x88, 0x62, 0x62, 0xd5, 0x97, 0x9a, 0x61,
+	0x32, 0x65, 0x19, 0xb4, 0x07, 0xa5, 0x49, 0xe8, 0xde, 0x7a, 0xba, 0x9c, 0x6c, 0xf1, 0xb7, 0x02,
+	0xac, 0x0e, 0xbd, 0x42, 0xd1, 0x4d, 0xb8, 0x51, 0x95, 0xb0, 0xaa, 0x9b, 0x96, 0x5c, 0xae, 0x8c,
+	0xda, 0xce, 0x31, 0x00, 0x69, 0x5f, 0xd2, 0x95, 0x8a, 0x2e, 0x0a, 0xe8, 0x0e, 0x14, 0x46, 0x01,
+	0xa2, 0xce, 0x8e, 0x1a, 0x5d, 0xcc, 0xa0, 0x5b, 0xf0, 0xd1, 0x28, 0x5c, 0x57, 0xab, 0x98, 0x2d,
+	0xfe, 0x3b, 0x03, 0x1f, 0x4e, 0xfa, 0xfd, 0x82, 0xcd, 0x53, 0x77, 0xdd, 0xea, 0x4b, 0x55, 0xae,
+	0x99, 0xac, 0x83, 0xc3, 0x7c, 0xac, 0x8f, 0x6b, 0x46, 0x42, 0x79, 0xb2, 0x41, 0xc6, 0x80, 0xe5,
+	0xca, 0x51, 0xb5, 0xac, 0x9a, 0x7c, 0x36, 0x8a, 0x70, 0x27, 0x0d, 0x1e, 0xb6, 0xab, 0x98, 0xe9,
+	0xdb, 0xad, 0x71, 0xa9, 0xf9, 0xba, 0xd9, 0x60, 0xa3, 0x12, 0x14, 0xd3, 0xd0, 0xdd, 0x2a, 0x28,
+	0xe2, 0x0c, 0xfa, 0x02, 0x3e, 0x4b, 0x17, 0xae, 0x9b, 0x9a, 0x5e, 0x53, 0x15, 0x4b, 0x32, 0x2c,
+	0x5d, 0x3d, 0x16, 0x67, 0xa7, 0x59, 0xae, 0xa9, 0x1d, 0xb1, 0x69, 0xab, 0x99, 0xe2, 0x5c, 0xf1,
+	0xcf, 0x02, 0x7c, 0x20, 0x7b, 0x2e, 0x75, 0xdc, 0x0e, 0x91, 0x02, 0x9d, 0xbc, 0xd1, 0xc2, 0xd3,
+	0x99, 0xe7, 0xa3, 0xdb, 0x70, 0x2b, 0xce, 0x1f, 0xa5, 0xb7, 0x34, 0x5d, 0x33, 0x35, 0xc9, 0xac,
+	0xe0, 0x44, 0x7d, 0x27, 0xc2, 0x98, 0xbd, 0x28, 0x2a, 0x0e, 0xeb, 0x3a, 0x1e, 0x86, 0x55, 0x13,
+	0x9f, 0x44, 0xad, 0x10, 0xfa, 0xe5, 0x78, 0xac, 0x8c, 0x2b, 0x7a, 0xd7, 0xcd, 0xc4, 0x6c, 0xf1,
+	0xf7, 0x02, 0xe4, 0xa2, 0xaf, 0x73, 0xfe, 0xf1, 0x96, 0x87, 0xab, 0x6c, 0x81, 0x95, 0x9a, 0x69,
+	0x99, 0x27, 0x55, 0xb5, 0xbf, 0x87, 0xfb, 0xee, 0x70, 0xb3, 0xb3, 0xcc, 0x4a, 0x58, 0x9d, 0xd0,
+	0x17, 0xfb, 0x01, 0xd1, 0x53, 0x18, 0x86, 0x83, 0xc5, 0xcc, 0x44, 0x4c, 0x98, 0x27, 0x8b, 0xae,
+	0xc3, 0x07, 0x7d, 0x98, 0x43, 0x55, 0xc2, 0xe6, 0xbe, 0x2a, 0x99, 0xe2, 0x4c, 0xf1, 0x3b, 0x01,
+	0xae, 0xc5, 0xbe, 0xce, 0x7e, 0x1b, 0x61, 0xd2, 0x1b, 0x95, 0x0e, 0x95, 0xed, 0x4e, 0x40, 0xd0,
+	0x5d, 0xb8, 0xdd, 0x75, 0x64, 0x53, 0x32, 0x9e, 0xf7, 0xf6, 0xca, 0x92, 0xa5, 0x9a, 0x91, 0x5c,
+	0x4d, 0x2a, 0x34, 0x92, 0x20, 0x0a, 0xcc, 0xa4, 0x26, 0x43, 0xb1, 0x6a, 0xa8, 0xa6, 0x98, 0x29,
+	0x7e, 0xb7, 0x08, 0x1b, 0x49, 0x71, 0xec, 0x13, 0x87, 0x34, 0x42, 0x69, 0x77, 0xa0, 0xd0, 0x9f,
+	0x24, 0x72, 0xed, 0x41, 0x5d, 0xbb, 0x70, 0x6f, 0x02, 0xae, 0xa6, 0x1f, 0x4a, 0xba, 0xc2, 0xae,
+	0x63, 0x90, 0x28, 0xa0, 0x27, 0xf0, 0x68, 0x02, 0x65, 0x5f, 0x52, 0x7a, 0x55, 0xee, 0xbe, 0x3f,
+	0x25, 0xd3, 0xc4, 0xda, 0x7e, 0xcd, 0x54, 0x0d, 0x31, 0x83, 0x54, 0x90, 0x52, 0x12, 0xf4, 0xfb,
+	0xd0, 0xc8, 0x34, 0x59, 0xf4, 0x10, 0xee, 0xa7, 0xe9, 0x08, 0x5b, 0x46, 0x3b, 0x52, 0x71, 0x92,
+	0x3a, 0x83, 0xbe, 0x82, 0x2f, 0x53, 0xa8, 0xd1, 0x93, 0x87, 0xb8, 0xb3, 0xe8, 0x11, 0x3c, 0x48,
+	0x55, 0x2f, 0x57, 0xb0, 0x62, 0x1d, 0x49, 0xf8, 0x79, 0x3f, 0x79, 0x0e, 0x69, 0xa0, 0xa6, 0x3d,
+	0x38, 0x72, 0x37, 0x6b, 0x84, 0x2f, 0x24, 0x52, 0x5d, 0x99, 0xa2, 0x8a, 0x2c, 0x90, 0x92, 0x66,
+	0x1e, 0x3d, 0x05, 0x79, 0xba, 0x52, 0x4c, 0x4e, 0xb4, 0x80, 0x5e, 0x82, 0xf9, 0x7e, 0xbb, 0xaa,
+	0xbe, 0x34, 0x55, 0xac, 0x4b, 0x69, 0x99, 0x01, 0x3d, 0x86, 0x87, 0xa9, 0x45, 0xeb, 0xf7, 0x9f,
+	0x04, 0x3d, 0x87, 0x1e, 0xc0, 0xe7, 0x13, 0xe8, 0xc9, 0x1e, 0xe9, 0x9d, 0x71, 0x34, 0x45, 0x5c,
+	0x44, 0xf7, 0x61, 0x77, 0x02, 0x91, 0x4f, 0xa1, 0x65, 0x98, 0x9a, 0xfc, 0xfc, 0x24, 0xbc, 0x5d,
+	0xd6, 0x0c, 0x53, 0x5c, 0x42, 0x3f, 0x85, 0x9f, 0x4c, 0xa0, 0x75, 0x17, 0xcb, 0xfe, 0x50, 0x71,
+	0x62, 0xc4, 0x18, 0xac, 0x86, 0x55, 0x71, 0x79, 0x8a, 0x3d, 0x31, 0xb4, 0xa7, 0xe9, 0x95, 0x5b,
+	0x41, 0x32, 0x3c, 0x99, 0x6a, 0x44, 0xe4, 0x43, 0xad, 0xac, 0x8c, 0x4e, 0x22, 0xa2, 0xcf, 0x61,
+	0x67, 0x42, 0x92, 0x83, 0x0a, 0x96, 0xd5, 0xe8, 0x8d, 0xd5, 0x35, 0x89, 0x55, 0xf4, 0x25, 0xec,
+	0x4d, 0x22, 0x49, 0x5a, 0xb9, 0xf2, 0x42, 0xc5, 0x83, 0x3c, 0xc4, 0x5e, 0xa3, 0xd3, 0x2d, 0x5d,
+	0xd3, 0xab, 0x35, 0xd3, 0x32, 0xb4, 0x6f, 0x54, 0x71, 0x8d, 0xbd, 0x46, 0x53, 0x77, 0x2a, 0xae,
+	0x95, 0x78, 0x75, 0xd8, 0x8c, 0x87, 0x1e, 0xb2, 0xaf, 0xe9, 0x12, 0x3e, 0x11, 0xd7, 0x53, 0x7a,
+	0x6f, 0xd8, 0xe8, 0xfa, 0x5a, 0xe8, 0x83, 0x69, 0x96, 0xa3, 0x4a, 0x58, 0x3e, 0x4c, 0x56, 0x7c,
+	0x03, 0xed, 0xc3, 0xd7, 0x29, 0xac, 0x5a, 0xd5, 0x50, 0x71, 0x6f, 0x4d, 0xd6, 0x91, 0x7a, 0x54,
+	0x49, 0xe6, 0xc8, 0xb3, 0x37, 0xd7, 0x2d, 0xfe, 0x53, 0xd3, 0xd0, 0xd9, 0x2c, 0xf9, 0x9a, 0xd8,
+	0x85, 0x7b, 0xe1, 0xde, 0x8f, 0xe8, 0xa4, 0x31, 0x6f, 0x8c, 0x7d, 0xf8, 0x7a, 0x3a, 0x4a, 0xf7,
+	0xbe, 0x54, 0xc6, 0xaa, 0xa4, 0x9c, 0x74, 0x0f, 0xe9, 0x42, 0xf1, 0x2f, 0x02, 0x14, 0x65, 0xdb,
+	0xad, 0x93, 0x66, 0xfc, 0x6b, 0xf6, 0x44, 0x95, 0x8f, 0xe0, 0xc1, 0x14, 0x9e, 0x31, 0x46, 0xef,
+	0x31, 0x18, 0xef, 0x4b, 0xae, 0xe9, 0xcf, 0xf5, 0xca, 0xb1, 0x3e, 0x89, 0x10, 0x2d, 0xc2, 0x70,
+	0xce, 0x5d, 0x7b, 0xea, 0x45, 0x44, 0xad, 0xfb, 0xdf, 0x2d, 0xe2, 0x7d, 0xc9, 0x53, 0x2d, 0x62,
+	0xff, 0xf4, 0x6f, 0xef, 0x36, 0x85, 0x7f, 0xbe, 0xdb, 0x14, 0xfe, 0xf5, 0x6e, 0x53, 0x80, 0x8d,
+	0xba, 0xd7, 0x1a, 0xf5, 0x1b, 0xc7, 0xfe, 0xbc, 0xd4, 0x76, 0xaa, 0xec, 0xfb, 0xbe, 0x2a, 0x7c,
+	0xb3, 0x73, 0xee, 0xd0, 0x8b, 0xce, 0x69, 0xa9, 0xee, 0xb5, 0x76, 0xfa, 0xfe, 0x4b, 0x5c, 0x3a,
+	0x27, 0x6e, 0xf8, 0x3f, 0xe7, 0xe8, 0x1f, 0xc6, 0x8f, 0xec, 0xb6, 0xf3, 0x7a, 0xf7, 0x74, 0x8e,
+	0xc7, 0x3e, 0xff, 0xcf, 0x00, 0x81, 0x4c, 0xcc, 0x72, 0xf0, 0x1e, 0x00, 0x00,
 }
 
 func (m *WorkflowExecutionInfo) Marshal() (dAtA []byte, err error) {
@@ -1508,6 +1568,18 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.NextRetryTime != nil {
+		{
+			size, err := m.NextRetryTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.IsCron {
 		i--
 		if m.IsCron {
@@ -1835,6 +1907,18 @@ func (m *PendingActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.NextRetryTime != nil {
+		{
+			size, err := m.NextRetryTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.LastWorkerIdentity) > 0 {
 		i -= len(m.LastWorkerIdentity)
 		copy(dAtA[i:], m.LastWorkerIdentity)
@@ -2344,6 +2428,10 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 	if m.IsCron {
 		n += 2
 	}
+	if m.NextRetryTime != nil {
+		l = m.NextRetryTime.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2471,6 +2559,10 @@ func (m *PendingActivityInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	if m.NextRetryTime != nil {
+		l = m.NextRetryTime.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3066,6 +3158,42 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsCron = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextRetryTime == nil {
+				m.NextRetryTime = &types.Timestamp{}
+			}
+			if err := m.NextRetryTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -3937,6 +4065,42 @@ func (m *PendingActivityInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextRetryTime == nil {
+				m.NextRetryTime = &types.Timestamp{}
+			}
+			if err := m.NextRetryTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
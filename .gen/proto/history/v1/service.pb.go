@@ -50,18 +50,19 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StartWorkflowExecutionRequest struct {
-	Request                  *v1.StartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId                 string                            `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	ParentExecutionInfo      *v1.ParentExecutionInfo           `protobuf:"bytes,3,opt,name=parent_execution_info,json=parentExecutionInfo,proto3" json:"parent_execution_info,omitempty"`
-	Attempt                  int32                             `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	ExpirationTime           *types.Timestamp                  `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
-	ContinueAsNewInitiator   v1.ContinueAsNewInitiator         `protobuf:"varint,6,opt,name=continue_as_new_initiator,json=continueAsNewInitiator,proto3,enum=uber.cadence.api.v1.ContinueAsNewInitiator" json:"continue_as_new_initiator,omitempty"`
-	ContinuedFailure         *v1.Failure                       `protobuf:"bytes,7,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
-	LastCompletionResult     *v1.Payload                       `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
-	FirstDecisionTaskBackoff *types.Duration                   `protobuf:"bytes,9,opt,name=first_decision_task_backoff,json=firstDecisionTaskBackoff,proto3" json:"first_decision_task_backoff,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                          `json:"-"`
-	XXX_unrecognized         []byte                            `json:"-"`
-	XXX_sizecache            int32                             `json:"-"`
+	Request                    *v1.StartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId                   string                            `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	ParentExecutionInfo        *v1.ParentExecutionInfo           `protobuf:"bytes,3,opt,name=parent_execution_info,json=parentExecutionInfo,proto3" json:"parent_execution_info,omitempty"`
+	Attempt                    int32                             `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ExpirationTime             *types.Timestamp                  `protobuf:"bytes,5,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	ContinueAsNewInitiator     v1.ContinueAsNewInitiator         `protobuf:"varint,6,opt,name=continue_as_new_initiator,json=continueAsNewInitiator,proto3,enum=uber.cadence.api.v1.ContinueAsNewInitiator" json:"continue_as_new_initiator,omitempty"`
+	ContinuedFailure           *v1.Failure                       `protobuf:"bytes,7,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
+	LastCompletionResult       *v1.Payload                       `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
+	FirstDecisionTaskBackoff   *types.Duration                   `protobuf:"bytes,9,opt,name=first_decision_task_backoff,json=firstDecisionTaskBackoff,proto3" json:"first_decision_task_backoff,omitempty"`
+	RetryElapsedExpirationTime *types.Timestamp                  `protobuf:"bytes,10,opt,name=retry_elapsed_expiration_time,json=retryElapsedExpirationTime,proto3" json:"retry_elapsed_expiration_time,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                          `json:"-"`
+	XXX_unrecognized           []byte                            `json:"-"`
+	XXX_sizecache              int32                             `json:"-"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()         { *m = StartWorkflowExecutionRequest{} }
@@ -160,6 +161,13 @@ func (m *StartWorkflowExecutionRequest) GetFirstDecisionTaskBackoff() *types.Dur
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetRetryElapsedExpirationTime() *types.Timestamp {
+	if m != nil {
+		return m.RetryElapsedExpirationTime
+	}
+	return nil
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -619,6 +627,102 @@ func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
 
+type ExpediteWorkflowExecutionStartRequest struct {
+	Request              *v1.ExpediteWorkflowExecutionStartRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                                    `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ExpediteWorkflowExecutionStartRequest) Reset()         { *m = ExpediteWorkflowExecutionStartRequest{} }
+func (m *ExpediteWorkflowExecutionStartRequest) String() string { return proto.CompactTextString(m) }
+func (*ExpediteWorkflowExecutionStartRequest) ProtoMessage()    {}
+func (*ExpediteWorkflowExecutionStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{10}
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpediteWorkflowExecutionStartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpediteWorkflowExecutionStartRequest.Merge(m, src)
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpediteWorkflowExecutionStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpediteWorkflowExecutionStartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpediteWorkflowExecutionStartRequest proto.InternalMessageInfo
+
+func (m *ExpediteWorkflowExecutionStartRequest) GetRequest() *v1.ExpediteWorkflowExecutionStartRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ExpediteWorkflowExecutionStartRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+type ExpediteWorkflowExecutionStartResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExpediteWorkflowExecutionStartResponse) Reset() {
+	*m = ExpediteWorkflowExecutionStartResponse{}
+}
+func (m *ExpediteWorkflowExecutionStartResponse) String() string { return proto.CompactTextString(m) }
+func (*ExpediteWorkflowExecutionStartResponse) ProtoMessage()    {}
+func (*ExpediteWorkflowExecutionStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{11}
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpediteWorkflowExecutionStartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpediteWorkflowExecutionStartResponse.Merge(m, src)
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpediteWorkflowExecutionStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpediteWorkflowExecutionStartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpediteWorkflowExecutionStartResponse proto.InternalMessageInfo
+
 type DescribeWorkflowExecutionRequest struct {
 	Request              *v1.DescribeWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -631,7 +735,7 @@ func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkfl
 func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{10}
+	return fileDescriptor_fee8ff76963a38ed, []int{12}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +793,7 @@ func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkf
 func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{11}
+	return fileDescriptor_fee8ff76963a38ed, []int{13}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +869,7 @@ func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
 func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowRequest) ProtoMessage()    {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{12}
+	return fileDescriptor_fee8ff76963a38ed, []int{14}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +924,7 @@ func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
 func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowResponse) ProtoMessage()    {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{13}
+	return fileDescriptor_fee8ff76963a38ed, []int{15}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +979,7 @@ func (m *ResetStickyTaskListRequest) Reset()         { *m = ResetStickyTaskListR
 func (m *ResetStickyTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListRequest) ProtoMessage()    {}
 func (*ResetStickyTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{14}
+	return fileDescriptor_fee8ff76963a38ed, []int{16}
 }
 func (m *ResetStickyTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,7 +1032,7 @@ func (m *ResetStickyTaskListResponse) Reset()         { *m = ResetStickyTaskList
 func (m *ResetStickyTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListResponse) ProtoMessage()    {}
 func (*ResetStickyTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{15}
+	return fileDescriptor_fee8ff76963a38ed, []int{17}
 }
 func (m *ResetStickyTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1075,7 @@ func (m *GetMutableStateRequest) Reset()         { *m = GetMutableStateRequest{}
 func (m *GetMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateRequest) ProtoMessage()    {}
 func (*GetMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{16}
+	return fileDescriptor_fee8ff76963a38ed, []int{18}
 }
 func (m *GetMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1046,6 +1150,7 @@ type GetMutableStateResponse struct {
 	WorkflowCloseState                   v1.WorkflowExecutionCloseStatus `protobuf:"varint,15,opt,name=workflow_close_state,json=workflowCloseState,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"workflow_close_state,omitempty"`
 	VersionHistories                     *v11.VersionHistories           `protobuf:"bytes,16,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	IsStickyTaskListEnabled              bool                            `protobuf:"varint,17,opt,name=is_sticky_task_list_enabled,json=isStickyTaskListEnabled,proto3" json:"is_sticky_task_list_enabled,omitempty"`
+	BuildId                              string                          `protobuf:"bytes,18,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
 	XXX_NoUnkeyedLiteral                 struct{}                        `json:"-"`
 	XXX_unrecognized                     []byte                          `json:"-"`
 	XXX_sizecache                        int32                           `json:"-"`
@@ -1055,7 +1160,7 @@ func (m *GetMutableStateResponse) Reset()         { *m = GetMutableStateResponse
 func (m *GetMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateResponse) ProtoMessage()    {}
 func (*GetMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{17}
+	return fileDescriptor_fee8ff76963a38ed, []int{19}
 }
 func (m *GetMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1203,6 +1308,13 @@ func (m *GetMutableStateResponse) GetIsStickyTaskListEnabled() bool {
 	return false
 }
 
+func (m *GetMutableStateResponse) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
 type PollMutableStateRequest struct {
 	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -1217,7 +1329,7 @@ func (m *PollMutableStateRequest) Reset()         { *m = PollMutableStateRequest
 func (m *PollMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateRequest) ProtoMessage()    {}
 func (*PollMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{18}
+	return fileDescriptor_fee8ff76963a38ed, []int{20}
 }
 func (m *PollMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1411,7 @@ func (m *PollMutableStateResponse) Reset()         { *m = PollMutableStateRespon
 func (m *PollMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateResponse) ProtoMessage()    {}
 func (*PollMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{19}
+	return fileDescriptor_fee8ff76963a38ed, []int{21}
 }
 func (m *PollMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1450,7 +1562,7 @@ func (m *RecordDecisionTaskStartedRequest) Reset()         { *m = RecordDecision
 func (m *RecordDecisionTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedRequest) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{20}
+	return fileDescriptor_fee8ff76963a38ed, []int{22}
 }
 func (m *RecordDecisionTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1657,7 @@ func (m *RecordDecisionTaskStartedResponse) Reset()         { *m = RecordDecisio
 func (m *RecordDecisionTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedResponse) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{21}
+	return fileDescriptor_fee8ff76963a38ed, []int{23}
 }
 func (m *RecordDecisionTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,7 +1801,7 @@ func (m *RecordActivityTaskStartedRequest) Reset()         { *m = RecordActivity
 func (m *RecordActivityTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedRequest) ProtoMessage()    {}
 func (*RecordActivityTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{22}
+	return fileDescriptor_fee8ff76963a38ed, []int{24}
 }
 func (m *RecordActivityTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1777,7 +1889,7 @@ func (m *RecordActivityTaskStartedResponse) Reset()         { *m = RecordActivit
 func (m *RecordActivityTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedResponse) ProtoMessage()    {}
 func (*RecordActivityTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{23}
+	return fileDescriptor_fee8ff76963a38ed, []int{25}
 }
 func (m *RecordActivityTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,7 +1979,7 @@ func (m *RespondDecisionTaskCompletedRequest) Reset()         { *m = RespondDeci
 func (m *RespondDecisionTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{24}
+	return fileDescriptor_fee8ff76963a38ed, []int{26}
 }
 func (m *RespondDecisionTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1922,7 +2034,7 @@ func (m *RespondDecisionTaskCompletedResponse) Reset()         { *m = RespondDec
 func (m *RespondDecisionTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{25}
+	return fileDescriptor_fee8ff76963a38ed, []int{27}
 }
 func (m *RespondDecisionTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1977,7 +2089,7 @@ func (m *RespondDecisionTaskFailedRequest) Reset()         { *m = RespondDecisio
 func (m *RespondDecisionTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{26}
+	return fileDescriptor_fee8ff76963a38ed, []int{28}
 }
 func (m *RespondDecisionTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2030,7 +2142,7 @@ func (m *RespondDecisionTaskFailedResponse) Reset()         { *m = RespondDecisi
 func (m *RespondDecisionTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{27}
+	return fileDescriptor_fee8ff76963a38ed, []int{29}
 }
 func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2071,7 +2183,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Reset()         { *m = RecordActivi
 func (m *RecordActivityTaskHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatRequest) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{28}
+	return fileDescriptor_fee8ff76963a38ed, []int{30}
 }
 func (m *RecordActivityTaskHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2125,7 +2237,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Reset()         { *m = RecordActiv
 func (m *RecordActivityTaskHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatResponse) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{29}
+	return fileDescriptor_fee8ff76963a38ed, []int{31}
 }
 func (m *RecordActivityTaskHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2173,7 +2285,7 @@ func (m *RespondActivityTaskCompletedRequest) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{30}
+	return fileDescriptor_fee8ff76963a38ed, []int{32}
 }
 func (m *RespondActivityTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2226,7 +2338,7 @@ func (m *RespondActivityTaskCompletedResponse) Reset()         { *m = RespondAct
 func (m *RespondActivityTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{31}
+	return fileDescriptor_fee8ff76963a38ed, []int{33}
 }
 func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2267,7 +2379,7 @@ func (m *RespondActivityTaskFailedRequest) Reset()         { *m = RespondActivit
 func (m *RespondActivityTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{32}
+	return fileDescriptor_fee8ff76963a38ed, []int{34}
 }
 func (m *RespondActivityTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2320,7 +2432,7 @@ func (m *RespondActivityTaskFailedResponse) Reset()         { *m = RespondActivi
 func (m *RespondActivityTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{33}
+	return fileDescriptor_fee8ff76963a38ed, []int{35}
 }
 func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2361,7 +2473,7 @@ func (m *RespondActivityTaskCanceledRequest) Reset()         { *m = RespondActiv
 func (m *RespondActivityTaskCanceledRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{34}
+	return fileDescriptor_fee8ff76963a38ed, []int{36}
 }
 func (m *RespondActivityTaskCanceledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2414,7 +2526,7 @@ func (m *RespondActivityTaskCanceledResponse) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCanceledResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{35}
+	return fileDescriptor_fee8ff76963a38ed, []int{37}
 }
 func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2456,7 +2568,7 @@ func (m *RemoveSignalMutableStateRequest) Reset()         { *m = RemoveSignalMut
 func (m *RemoveSignalMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateRequest) ProtoMessage()    {}
 func (*RemoveSignalMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{36}
+	return fileDescriptor_fee8ff76963a38ed, []int{38}
 }
 func (m *RemoveSignalMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2516,7 +2628,7 @@ func (m *RemoveSignalMutableStateResponse) Reset()         { *m = RemoveSignalMu
 func (m *RemoveSignalMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateResponse) ProtoMessage()    {}
 func (*RemoveSignalMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{37}
+	return fileDescriptor_fee8ff76963a38ed, []int{39}
 }
 func (m *RemoveSignalMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2671,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCa
 func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{38}
+	return fileDescriptor_fee8ff76963a38ed, []int{40}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2628,7 +2740,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{39}
+	return fileDescriptor_fee8ff76963a38ed, []int{41}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2670,7 +2782,7 @@ func (m *ScheduleDecisionTaskRequest) Reset()         { *m = ScheduleDecisionTas
 func (m *ScheduleDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskRequest) ProtoMessage()    {}
 func (*ScheduleDecisionTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{40}
+	return fileDescriptor_fee8ff76963a38ed, []int{42}
 }
 func (m *ScheduleDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2730,7 +2842,7 @@ func (m *ScheduleDecisionTaskResponse) Reset()         { *m = ScheduleDecisionTa
 func (m *ScheduleDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskResponse) ProtoMessage()    {}
 func (*ScheduleDecisionTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{41}
+	return fileDescriptor_fee8ff76963a38ed, []int{43}
 }
 func (m *ScheduleDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2779,7 +2891,7 @@ func (m *RecordChildExecutionCompletedRequest) Reset()         { *m = RecordChil
 func (m *RecordChildExecutionCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{42}
+	return fileDescriptor_fee8ff76963a38ed, []int{44}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2853,7 +2965,7 @@ func (m *RecordChildExecutionCompletedResponse) Reset()         { *m = RecordChi
 func (m *RecordChildExecutionCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{43}
+	return fileDescriptor_fee8ff76963a38ed, []int{45}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2898,7 +3010,7 @@ func (m *ReplicateEventsV2Request) Reset()         { *m = ReplicateEventsV2Reque
 func (m *ReplicateEventsV2Request) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Request) ProtoMessage()    {}
 func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{44}
+	return fileDescriptor_fee8ff76963a38ed, []int{46}
 }
 func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2972,7 +3084,7 @@ func (m *ReplicateEventsV2Response) Reset()         { *m = ReplicateEventsV2Resp
 func (m *ReplicateEventsV2Response) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Response) ProtoMessage()    {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{45}
+	return fileDescriptor_fee8ff76963a38ed, []int{47}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3014,7 +3126,7 @@ func (m *SyncShardStatusRequest) Reset()         { *m = SyncShardStatusRequest{}
 func (m *SyncShardStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusRequest) ProtoMessage()    {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{46}
+	return fileDescriptor_fee8ff76963a38ed, []int{48}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3074,7 +3186,7 @@ func (m *SyncShardStatusResponse) Reset()         { *m = SyncShardStatusResponse
 func (m *SyncShardStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusResponse) ProtoMessage()    {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{47}
+	return fileDescriptor_fee8ff76963a38ed, []int{49}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3126,7 +3238,7 @@ func (m *SyncActivityRequest) Reset()         { *m = SyncActivityRequest{} }
 func (m *SyncActivityRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityRequest) ProtoMessage()    {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{48}
+	return fileDescriptor_fee8ff76963a38ed, []int{50}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3256,7 +3368,7 @@ func (m *SyncActivityResponse) Reset()         { *m = SyncActivityResponse{} }
 func (m *SyncActivityResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityResponse) ProtoMessage()    {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{49}
+	return fileDescriptor_fee8ff76963a38ed, []int{51}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3297,7 +3409,7 @@ func (m *DescribeMutableStateRequest) Reset()         { *m = DescribeMutableStat
 func (m *DescribeMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateRequest) ProtoMessage()    {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{50}
+	return fileDescriptor_fee8ff76963a38ed, []int{52}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3352,7 +3464,7 @@ func (m *DescribeMutableStateResponse) Reset()         { *m = DescribeMutableSta
 func (m *DescribeMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateResponse) ProtoMessage()    {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{51}
+	return fileDescriptor_fee8ff76963a38ed, []int{53}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3405,7 +3517,7 @@ func (m *DescribeHistoryHostRequest) Reset()         { *m = DescribeHistoryHostR
 func (m *DescribeHistoryHostRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostRequest) ProtoMessage()    {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{52}
+	return fileDescriptor_fee8ff76963a38ed, []int{54}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3435,21 +3547,22 @@ func (m *DescribeHistoryHostRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_DescribeHistoryHostRequest proto.InternalMessageInfo
 
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        int32                `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
-	ShardIds              []int32              `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	DomainCache           *v11.DomainCacheInfo `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
-	ShardControllerStatus string               `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
-	Address               string               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
-	XXX_unrecognized      []byte               `json:"-"`
-	XXX_sizecache         int32                `json:"-"`
+	NumberOfShards             int32                                `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
+	ShardIds                   []int32                              `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	DomainCache                *v11.DomainCacheInfo                 `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
+	ShardControllerStatus      string                               `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
+	Address                    string                               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	PersistenceCircuitBreakers []*v11.PersistenceCircuitBreakerInfo `protobuf:"bytes,6,rep,name=persistence_circuit_breakers,json=persistenceCircuitBreakers,proto3" json:"persistence_circuit_breakers,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                             `json:"-"`
+	XXX_unrecognized           []byte                               `json:"-"`
+	XXX_sizecache              int32                                `json:"-"`
 }
 
 func (m *DescribeHistoryHostResponse) Reset()         { *m = DescribeHistoryHostResponse{} }
 func (m *DescribeHistoryHostResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostResponse) ProtoMessage()    {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{53}
+	return fileDescriptor_fee8ff76963a38ed, []int{55}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3513,6 +3626,13 @@ func (m *DescribeHistoryHostResponse) GetAddress() string {
 	return ""
 }
 
+func (m *DescribeHistoryHostResponse) GetPersistenceCircuitBreakers() []*v11.PersistenceCircuitBreakerInfo {
+	if m != nil {
+		return m.PersistenceCircuitBreakers
+	}
+	return nil
+}
+
 type CloseShardRequest struct {
 	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3524,7 +3644,7 @@ func (m *CloseShardRequest) Reset()         { *m = CloseShardRequest{} }
 func (m *CloseShardRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseShardRequest) ProtoMessage()    {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{54}
+	return fileDescriptor_fee8ff76963a38ed, []int{56}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3570,7 +3690,7 @@ func (m *CloseShardResponse) Reset()         { *m = CloseShardResponse{} }
 func (m *CloseShardResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseShardResponse) ProtoMessage()    {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{55}
+	return fileDescriptor_fee8ff76963a38ed, []int{57}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3613,7 +3733,7 @@ func (m *RemoveTaskRequest) Reset()         { *m = RemoveTaskRequest{} }
 func (m *RemoveTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskRequest) ProtoMessage()    {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{56}
+	return fileDescriptor_fee8ff76963a38ed, []int{58}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3680,7 +3800,7 @@ func (m *RemoveTaskResponse) Reset()         { *m = RemoveTaskResponse{} }
 func (m *RemoveTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskResponse) ProtoMessage()    {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{57}
+	return fileDescriptor_fee8ff76963a38ed, []int{59}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3722,7 +3842,7 @@ func (m *ResetQueueRequest) Reset()         { *m = ResetQueueRequest{} }
 func (m *ResetQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueRequest) ProtoMessage()    {}
 func (*ResetQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{58}
+	return fileDescriptor_fee8ff76963a38ed, []int{60}
 }
 func (m *ResetQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3782,7 +3902,7 @@ func (m *ResetQueueResponse) Reset()         { *m = ResetQueueResponse{} }
 func (m *ResetQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueResponse) ProtoMessage()    {}
 func (*ResetQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{59}
+	return fileDescriptor_fee8ff76963a38ed, []int{61}
 }
 func (m *ResetQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +3944,7 @@ func (m *DescribeQueueRequest) Reset()         { *m = DescribeQueueRequest{} }
 func (m *DescribeQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueRequest) ProtoMessage()    {}
 func (*DescribeQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{60}
+	return fileDescriptor_fee8ff76963a38ed, []int{62}
 }
 func (m *DescribeQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3885,7 +4005,7 @@ func (m *DescribeQueueResponse) Reset()         { *m = DescribeQueueResponse{} }
 func (m *DescribeQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueResponse) ProtoMessage()    {}
 func (*DescribeQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{61}
+	return fileDescriptor_fee8ff76963a38ed, []int{63}
 }
 func (m *DescribeQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3933,7 +4053,7 @@ func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMes
 func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{62}
+	return fileDescriptor_fee8ff76963a38ed, []int{64}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3987,7 +4107,7 @@ func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMe
 func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{63}
+	return fileDescriptor_fee8ff76963a38ed, []int{65}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4034,7 +4154,7 @@ func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicat
 func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{64}
+	return fileDescriptor_fee8ff76963a38ed, []int{66}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4081,7 +4201,7 @@ func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplica
 func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{65}
+	return fileDescriptor_fee8ff76963a38ed, []int{67}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4131,7 +4251,7 @@ func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
 func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsRequest) ProtoMessage()    {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{66}
+	return fileDescriptor_fee8ff76963a38ed, []int{68}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4198,7 +4318,7 @@ func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
 func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsResponse) ProtoMessage()    {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{67}
+	return fileDescriptor_fee8ff76963a38ed, []int{69}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4240,7 +4360,7 @@ func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTask
 func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{68}
+	return fileDescriptor_fee8ff76963a38ed, []int{70}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4420,7 @@ func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTas
 func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{69}
+	return fileDescriptor_fee8ff76963a38ed, []int{71}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4345,7 +4465,7 @@ func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{}
 func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesRequest) ProtoMessage()    {}
 func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{70}
+	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
 func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4430,7 +4550,7 @@ func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse
 func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesResponse) ProtoMessage()    {}
 func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{71}
+	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
 func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4501,7 +4621,7 @@ func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest
 func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{72}
+	return fileDescriptor_fee8ff76963a38ed, []int{74}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4568,7 +4688,7 @@ func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesRespon
 func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{73}
+	return fileDescriptor_fee8ff76963a38ed, []int{75}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4613,7 +4733,7 @@ func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest
 func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesRequest) ProtoMessage()    {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{74}
+	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4695,7 +4815,7 @@ func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesRespon
 func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesResponse) ProtoMessage()    {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{75}
+	return fileDescriptor_fee8ff76963a38ed, []int{77}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4742,7 +4862,7 @@ func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMark
 func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
 func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{76}
+	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
 func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4788,7 +4908,7 @@ func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMar
 func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
 func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{77}
+	return fileDescriptor_fee8ff76963a38ed, []int{79}
 }
 func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4829,7 +4949,7 @@ func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTask
 func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
 func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{78}
+	return fileDescriptor_fee8ff76963a38ed, []int{80}
 }
 func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4884,7 +5004,7 @@ func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTas
 func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
 func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{79}
+	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
 func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4943,7 +5063,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
 func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
 func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{80}
+	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
 func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5015,7 +5135,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) String() string {
 }
 func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
 func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{81}
+	return fileDescriptor_fee8ff76963a38ed, []int{83}
 }
 func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5062,6 +5182,8 @@ func init() {
 	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
 	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
 	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*ExpediteWorkflowExecutionStartRequest)(nil), "uber.cadence.history.v1.ExpediteWorkflowExecutionStartRequest")
+	proto.RegisterType((*ExpediteWorkflowExecutionStartResponse)(nil), "uber.cadence.history.v1.ExpediteWorkflowExecutionStartResponse")
 	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
 	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
 	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
@@ -5146,298 +5268,305 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x5b, 0x6c, 0x1c, 0x59,
-	0x56, 0x2a, 0x77, 0xfc, 0x3a, 0xb6, 0xdb, 0xf6, 0x8d, 0x1f, 0xed, 0x76, 0xe2, 0xd8, 0xb5, 0x93,
-	0x89, 0x27, 0xb3, 0xd3, 0x99, 0x24, 0x33, 0x49, 0x26, 0x33, 0xb3, 0x33, 0x89, 0x9d, 0x64, 0x3a,
-	0xca, 0xb3, 0x6c, 0x12, 0x40, 0x28, 0xa5, 0x72, 0xd5, 0x6d, 0xbb, 0x70, 0x75, 0x55, 0xa7, 0xaa,
-	0xda, 0x4e, 0xcf, 0x07, 0x5a, 0x60, 0x85, 0xb4, 0x2b, 0x04, 0xcb, 0xb2, 0x20, 0x24, 0xa4, 0x95,
-	0xd0, 0x22, 0x2d, 0x2c, 0x1f, 0x7c, 0xc0, 0x1f, 0xe2, 0x8b, 0x1f, 0x10, 0x3f, 0xfc, 0x21, 0xbe,
-	0x40, 0xa3, 0x15, 0x1f, 0xf0, 0x09, 0xe2, 0x13, 0xa1, 0xfb, 0xa8, 0xea, 0xaa, 0xae, 0x5b, 0xb7,
-	0xca, 0x36, 0x52, 0x86, 0x61, 0xfe, 0xdc, 0xf7, 0xde, 0x73, 0xee, 0xb9, 0xe7, 0x9e, 0x73, 0xee,
-	0x79, 0x95, 0xe1, 0x7c, 0x77, 0x07, 0xfb, 0x97, 0x4c, 0xc3, 0xc2, 0xae, 0x89, 0x2f, 0xed, 0xd9,
-	0x41, 0xe8, 0xf9, 0xbd, 0x4b, 0x07, 0x97, 0x2f, 0x05, 0xd8, 0x3f, 0xb0, 0x4d, 0xdc, 0xe8, 0xf8,
-	0x5e, 0xe8, 0xa1, 0x45, 0xb2, 0xac, 0xc1, 0x97, 0x35, 0xf8, 0xb2, 0xc6, 0xc1, 0xe5, 0xfa, 0xca,
-	0xae, 0xe7, 0xed, 0x3a, 0xf8, 0x12, 0x5d, 0xb6, 0xd3, 0x6d, 0x5d, 0xb2, 0xba, 0xbe, 0x11, 0xda,
-	0x9e, 0xcb, 0x00, 0xeb, 0xe7, 0x06, 0xe7, 0x43, 0xbb, 0x8d, 0x83, 0xd0, 0x68, 0x77, 0xf8, 0x82,
-	0x0c, 0x82, 0x43, 0xdf, 0xe8, 0x74, 0xb0, 0x1f, 0xf0, 0xf9, 0xd5, 0x14, 0x81, 0x46, 0xc7, 0x26,
-	0xc4, 0x99, 0x5e, 0xbb, 0x1d, 0x6f, 0xb1, 0x26, 0x5a, 0x11, 0x91, 0xc8, 0xa9, 0x10, 0x2d, 0x79,
-	0xd9, 0xc5, 0xf1, 0x02, 0x55, 0xb4, 0x20, 0x34, 0x82, 0x7d, 0xc7, 0x0e, 0x42, 0xd9, 0x9a, 0x43,
-	0xcf, 0xdf, 0x6f, 0x39, 0xde, 0x21, 0x5f, 0x73, 0x51, 0xb4, 0x86, 0xb3, 0x52, 0x1f, 0x58, 0xbb,
-	0x5e, 0xb4, 0x16, 0xfb, 0x7c, 0xe5, 0x1b, 0xa9, 0x95, 0xc1, 0x9e, 0xe1, 0x63, 0x8b, 0xb2, 0xc1,
-	0xe9, 0x06, 0x61, 0xe1, 0xaa, 0x34, 0x2b, 0xd4, 0x9c, 0x55, 0x2f, 0xbb, 0xb8, 0x8b, 0x85, 0x94,
-	0xf5, 0xd7, 0xf8, 0xb8, 0xe3, 0xd8, 0x66, 0xf2, 0x7a, 0xcf, 0xe7, 0xac, 0x4c, 0x1f, 0x55, 0xfd,
-	0x93, 0x61, 0x38, 0xbb, 0x15, 0x1a, 0x7e, 0xf8, 0x9c, 0x8f, 0xdf, 0x79, 0x85, 0xcd, 0x2e, 0xc1,
-	0xa3, 0xe1, 0x97, 0x5d, 0x1c, 0x84, 0xe8, 0x01, 0x8c, 0xfa, 0xec, 0xcf, 0x9a, 0xb2, 0xaa, 0xac,
-	0x4f, 0x5c, 0xb9, 0xd2, 0x48, 0x89, 0x9c, 0xd1, 0xb1, 0x1b, 0x07, 0x97, 0x1b, 0x52, 0x24, 0x5a,
-	0x84, 0x02, 0x2d, 0xc3, 0xb8, 0xe5, 0xb5, 0x0d, 0xdb, 0xd5, 0x6d, 0xab, 0x36, 0xb4, 0xaa, 0xac,
-	0x8f, 0x6b, 0x63, 0x6c, 0xa0, 0x69, 0xa1, 0x5f, 0x82, 0xf9, 0x8e, 0xe1, 0x63, 0x37, 0xd4, 0x71,
-	0x84, 0x40, 0xb7, 0xdd, 0x96, 0x57, 0xab, 0xd0, 0x8d, 0xd7, 0x85, 0x1b, 0x3f, 0xa1, 0x10, 0xf1,
-	0x8e, 0x4d, 0xb7, 0xe5, 0x69, 0xa7, 0x3b, 0xd9, 0x41, 0x54, 0x83, 0x51, 0x23, 0x0c, 0x71, 0xbb,
-	0x13, 0xd6, 0x4e, 0xad, 0x2a, 0xeb, 0xc3, 0x5a, 0xf4, 0x13, 0x6d, 0xc0, 0x34, 0x7e, 0xd5, 0xb1,
-	0x99, 0x7a, 0xe8, 0x44, 0x0f, 0x6a, 0xc3, 0x74, 0xc7, 0x7a, 0x83, 0xe9, 0x40, 0x23, 0xd2, 0x81,
-	0xc6, 0x76, 0xa4, 0x24, 0x5a, 0xb5, 0x0f, 0x42, 0x06, 0x51, 0x0b, 0x96, 0x4c, 0xcf, 0x0d, 0x6d,
-	0xb7, 0x8b, 0x75, 0x23, 0xd0, 0x5d, 0x7c, 0xa8, 0xdb, 0xae, 0x1d, 0xda, 0x46, 0xe8, 0xf9, 0xb5,
-	0x91, 0x55, 0x65, 0xbd, 0x7a, 0xe5, 0x6d, 0xe1, 0x01, 0x36, 0x38, 0xd4, 0xad, 0xe0, 0x11, 0x3e,
-	0x6c, 0x46, 0x20, 0xda, 0x82, 0x29, 0x1c, 0x47, 0x4d, 0x98, 0x8d, 0x66, 0x2c, 0xbd, 0x65, 0xd8,
-	0x4e, 0xd7, 0xc7, 0xb5, 0x51, 0x4a, 0xee, 0x19, 0x21, 0xfe, 0xbb, 0x6c, 0x8d, 0x36, 0x13, 0x83,
-	0xf1, 0x11, 0xa4, 0xc1, 0x82, 0x63, 0x04, 0xa1, 0x6e, 0x7a, 0xed, 0x8e, 0x83, 0xe9, 0xe1, 0x7d,
-	0x1c, 0x74, 0x9d, 0xb0, 0x36, 0x26, 0xc1, 0xf7, 0xc4, 0xe8, 0x39, 0x9e, 0x61, 0x69, 0x73, 0x04,
-	0x76, 0x23, 0x06, 0xd5, 0x28, 0x24, 0xfa, 0x79, 0x58, 0x6e, 0xd9, 0x7e, 0x10, 0xea, 0x16, 0x36,
-	0xed, 0x80, 0xf2, 0xd3, 0x08, 0xf6, 0xf5, 0x1d, 0xc3, 0xdc, 0xf7, 0x5a, 0xad, 0xda, 0x38, 0x45,
-	0xbc, 0x94, 0xe1, 0xeb, 0x26, 0x37, 0x4e, 0x5a, 0x8d, 0x42, 0x6f, 0x72, 0xe0, 0x6d, 0x23, 0xd8,
-	0xbf, 0xcd, 0x40, 0xd5, 0xeb, 0xb0, 0x92, 0x27, 0x64, 0x41, 0xc7, 0x73, 0x03, 0x8c, 0xe6, 0x61,
-	0xc4, 0xef, 0x52, 0xc9, 0x52, 0xa8, 0x64, 0x0d, 0xfb, 0x5d, 0xb7, 0x69, 0xa9, 0x7f, 0x3c, 0x04,
-	0x2b, 0x5b, 0xf6, 0xae, 0x6b, 0x38, 0xb9, 0x42, 0xfe, 0x70, 0x50, 0xc8, 0xaf, 0x8a, 0x85, 0x5c,
-	0x8a, 0xa5, 0xa4, 0x94, 0xb7, 0x60, 0x19, 0xbf, 0x0a, 0xb1, 0xef, 0x1a, 0x4e, 0x6c, 0x78, 0xfa,
-	0x02, 0xcf, 0x65, 0xfd, 0x4d, 0xe1, 0xfe, 0xd9, 0x9d, 0x97, 0x22, 0x54, 0x99, 0x29, 0xd4, 0x80,
-	0xd3, 0xe6, 0x9e, 0xed, 0x58, 0xfd, 0x4d, 0x3c, 0xd7, 0xe9, 0x51, 0xd9, 0x1f, 0xd3, 0x66, 0xe9,
-	0x54, 0x04, 0xf4, 0xd8, 0x75, 0x7a, 0xea, 0x1a, 0x9c, 0xcb, 0x3d, 0x1f, 0x63, 0xb0, 0xfa, 0x23,
-	0x05, 0x2e, 0xf0, 0x35, 0x76, 0xb8, 0x27, 0xb7, 0x1b, 0xcf, 0x06, 0x59, 0xfa, 0x91, 0x8c, 0xa5,
-	0x45, 0xe8, 0xca, 0xf1, 0x56, 0xbd, 0x05, 0xeb, 0xc5, 0x08, 0xe5, 0xd2, 0xf2, 0x3d, 0x05, 0xce,
-	0x6a, 0x38, 0xc0, 0x27, 0xb6, 0x88, 0x52, 0x24, 0x25, 0xcf, 0x73, 0x1d, 0x56, 0xf2, 0xd0, 0xc8,
-	0x4f, 0xf1, 0x03, 0x05, 0xd6, 0xb6, 0xb1, 0xdf, 0xb6, 0x5d, 0x23, 0xc4, 0xb9, 0x27, 0x79, 0x32,
-	0x78, 0x92, 0x6b, 0xc2, 0x93, 0x14, 0x22, 0x2a, 0x79, 0x9a, 0x37, 0x40, 0x95, 0xa1, 0xe2, 0x42,
-	0xf6, 0x7d, 0x05, 0x56, 0x37, 0x71, 0x60, 0xfa, 0xf6, 0x4e, 0x3e, 0xe5, 0x8f, 0x07, 0x29, 0x7f,
-	0x5f, 0x48, 0x79, 0x11, 0x9e, 0x92, 0x84, 0xff, 0x77, 0x05, 0xd6, 0x24, 0xa8, 0xf8, 0x55, 0x38,
-	0xb0, 0xd8, 0x7f, 0xb7, 0x4c, 0xcf, 0x6d, 0xd9, 0xbb, 0xdc, 0xaa, 0x49, 0x8d, 0x4a, 0x06, 0xe1,
-	0x46, 0x12, 0x54, 0x5b, 0xc0, 0xc2, 0x71, 0xb4, 0x03, 0x8b, 0x59, 0xeb, 0xc1, 0x9e, 0xcb, 0x21,
-	0xba, 0xdb, 0xc5, 0x72, 0xbb, 0xd1, 0x07, 0x73, 0xfe, 0x50, 0x34, 0x8c, 0x9e, 0x03, 0xea, 0x60,
-	0xd7, 0xb2, 0xdd, 0x5d, 0xdd, 0x30, 0x43, 0xfb, 0xc0, 0x0e, 0x6d, 0x1c, 0xd4, 0x2a, 0xab, 0x95,
-	0xfc, 0xd7, 0x98, 0x2d, 0xbf, 0xc5, 0x56, 0xf7, 0x28, 0xf2, 0xd9, 0x4e, 0x6a, 0xd0, 0xc6, 0x01,
-	0xfa, 0x05, 0x98, 0x89, 0x10, 0x53, 0x43, 0xe4, 0x63, 0xb7, 0x76, 0x8a, 0xa2, 0x6d, 0xc8, 0xd0,
-	0x6e, 0x90, 0xb5, 0x69, 0xca, 0xa7, 0x3b, 0x89, 0x29, 0x1f, 0xbb, 0x68, 0xab, 0x8f, 0x3a, 0x7a,
-	0x82, 0xf8, 0x6b, 0x2e, 0xa5, 0x38, 0x7a, 0x71, 0x52, 0x48, 0xa3, 0x41, 0xf5, 0x15, 0xcc, 0x3d,
-	0x25, 0x4e, 0x69, 0xc4, 0xbd, 0x48, 0x0c, 0x37, 0x06, 0xc5, 0xf0, 0x2d, 0xe1, 0x1e, 0x22, 0xd8,
-	0x92, 0xa2, 0xf7, 0x63, 0x05, 0xe6, 0x07, 0xc0, 0xb9, 0xb8, 0x7d, 0x02, 0x93, 0xd4, 0x51, 0x8e,
-	0xde, 0x6c, 0xa5, 0xc4, 0x9b, 0x3d, 0x41, 0x21, 0xf8, 0x53, 0xdd, 0x84, 0x6a, 0x84, 0xe0, 0x97,
-	0xb1, 0x19, 0x62, 0x8b, 0x0b, 0x8e, 0x9a, 0x7f, 0x06, 0x8d, 0xaf, 0xd4, 0xa6, 0x5e, 0x26, 0x7f,
-	0xaa, 0xdf, 0x51, 0xa0, 0x4e, 0x0d, 0xd5, 0x56, 0x68, 0x9b, 0xfb, 0x3d, 0xf2, 0x6c, 0x3f, 0xb0,
-	0x83, 0x30, 0x62, 0x53, 0x73, 0x90, 0x4d, 0x97, 0xf2, 0x2d, 0xa6, 0x10, 0x43, 0x49, 0x66, 0x9d,
-	0x85, 0x65, 0x21, 0x0e, 0x6e, 0x59, 0xfe, 0x43, 0x81, 0x85, 0x7b, 0x38, 0x7c, 0xd8, 0x0d, 0x8d,
-	0x1d, 0x07, 0x6f, 0x85, 0x46, 0x88, 0x35, 0x11, 0x5a, 0x65, 0xe0, 0xc5, 0xfe, 0x39, 0x40, 0x82,
-	0x87, 0x7a, 0xe8, 0x48, 0x0f, 0xf5, 0x6c, 0x46, 0xc3, 0xd0, 0x55, 0x58, 0xc0, 0xaf, 0x3a, 0x94,
-	0x81, 0xba, 0x8b, 0x5f, 0x85, 0x3a, 0x3e, 0x20, 0xbe, 0xaf, 0x6d, 0x51, 0x1f, 0xa0, 0xa2, 0x9d,
-	0x8e, 0x66, 0x1f, 0xe1, 0x57, 0xe1, 0x1d, 0x32, 0xd7, 0xb4, 0xd0, 0xbb, 0x30, 0x67, 0x76, 0x7d,
-	0xea, 0x24, 0xef, 0xf8, 0x86, 0x6b, 0xee, 0xe9, 0xa1, 0xb7, 0x4f, 0xb5, 0x47, 0x59, 0x9f, 0xd4,
-	0x10, 0x9f, 0xbb, 0x4d, 0xa7, 0xb6, 0xc9, 0x8c, 0xfa, 0xc3, 0x71, 0x58, 0xcc, 0x9c, 0x9a, 0xcb,
-	0x90, 0xf8, 0x64, 0xca, 0x49, 0x4f, 0x76, 0x17, 0xa6, 0x62, 0xb4, 0x61, 0xaf, 0x83, 0x39, 0xaf,
-	0xd6, 0xa4, 0x18, 0xb7, 0x7b, 0x1d, 0xac, 0x4d, 0x1e, 0x26, 0x7e, 0x21, 0x15, 0xa6, 0x44, 0x8c,
-	0x99, 0x70, 0x13, 0x0c, 0x79, 0x06, 0x4b, 0x1d, 0x1f, 0x1f, 0xd8, 0x5e, 0x37, 0xd0, 0x03, 0xf2,
-	0xe2, 0x63, 0xab, 0xbf, 0xfe, 0x14, 0xdd, 0x77, 0x39, 0xe3, 0x6e, 0x36, 0xdd, 0xf0, 0xda, 0x7b,
-	0xcf, 0x0c, 0xa7, 0x8b, 0xb5, 0x85, 0x08, 0x7a, 0x8b, 0x01, 0x47, 0x78, 0xdf, 0x81, 0xd3, 0xd4,
-	0x39, 0x66, 0xde, 0x6c, 0x8c, 0x71, 0x98, 0x52, 0x30, 0x43, 0xa6, 0xee, 0x92, 0x99, 0x68, 0xf9,
-	0x4d, 0x18, 0xa7, 0x8e, 0x2e, 0x09, 0x4b, 0xa9, 0xbb, 0x3f, 0x71, 0xe5, 0xac, 0xf8, 0x31, 0x8d,
-	0xa4, 0x72, 0x2c, 0xe4, 0x7f, 0xa1, 0x7b, 0x30, 0x13, 0x50, 0x89, 0xd5, 0xfb, 0x28, 0x46, 0xcb,
-	0xa0, 0xa8, 0x06, 0x29, 0x41, 0x47, 0xef, 0xc1, 0x82, 0xe9, 0xd8, 0x84, 0x52, 0xc7, 0xde, 0xf1,
-	0x0d, 0xbf, 0xa7, 0x1f, 0x60, 0x9f, 0x5a, 0xc0, 0x31, 0x2a, 0xd2, 0x73, 0x6c, 0xf6, 0x01, 0x9b,
-	0x7c, 0xc6, 0xe6, 0x12, 0x50, 0x2d, 0x6c, 0x84, 0x5d, 0x1f, 0xc7, 0x50, 0xe3, 0x49, 0xa8, 0xbb,
-	0x6c, 0x32, 0x82, 0x3a, 0x07, 0x13, 0x1c, 0xca, 0x6e, 0x77, 0x9c, 0x1a, 0xd0, 0xa5, 0xc0, 0x86,
-	0x9a, 0xed, 0x8e, 0x83, 0x02, 0xb8, 0x38, 0x78, 0x2a, 0x3d, 0x30, 0xf7, 0xb0, 0xd5, 0x75, 0xb0,
-	0x1e, 0x7a, 0xec, 0xb2, 0x68, 0xb4, 0xe5, 0x75, 0xc3, 0xda, 0x44, 0x51, 0x60, 0xf0, 0x46, 0xfa,
-	0xac, 0x5b, 0x1c, 0xd3, 0xb6, 0x47, 0xef, 0x6d, 0x9b, 0xa1, 0x21, 0x4e, 0x2f, 0xbb, 0x2a, 0x12,
-	0x59, 0xf7, 0x0f, 0x32, 0x49, 0x03, 0xbe, 0x59, 0x3a, 0xb5, 0x45, 0x66, 0xa2, 0x53, 0xe4, 0xa9,
-	0xd3, 0x54, 0x9e, 0x3a, 0xa1, 0x07, 0x50, 0x8d, 0x65, 0x3b, 0x20, 0xca, 0x54, 0xab, 0xd2, 0xe0,
-	0xee, 0x7c, 0xfa, 0xaa, 0x58, 0xc4, 0x9d, 0x94, 0x6f, 0xa6, 0x79, 0xb1, 0x62, 0xd0, 0x9f, 0xc8,
-	0x84, 0xb9, 0x18, 0x9b, 0xe9, 0x78, 0x01, 0xe6, 0x38, 0xa7, 0x29, 0xce, 0xcb, 0x25, 0x1d, 0x06,
-	0x02, 0x48, 0xf0, 0x75, 0x03, 0x2d, 0xd6, 0xe7, 0x78, 0x90, 0x68, 0xf9, 0x2c, 0x67, 0x84, 0xce,
-	0x52, 0x0e, 0xe4, 0x15, 0x9f, 0x11, 0xbd, 0x89, 0x7d, 0xaa, 0x39, 0x83, 0x3e, 0x8b, 0xd6, 0x6b,
-	0x33, 0x07, 0x03, 0x23, 0xe8, 0x23, 0x58, 0xb6, 0x89, 0xce, 0x0d, 0xdc, 0x31, 0x76, 0x89, 0x9d,
-	0xb1, 0x6a, 0xb3, 0x34, 0xd0, 0x58, 0xb4, 0x83, 0xb4, 0x35, 0xbe, 0xc3, 0xa6, 0xd5, 0xff, 0x54,
-	0x60, 0xf1, 0x89, 0xe7, 0x38, 0xff, 0xcf, 0xac, 0xf1, 0x4f, 0xc6, 0xa0, 0x96, 0x3d, 0xf6, 0xd7,
-	0xe6, 0xf8, 0x6b, 0x73, 0xfc, 0x55, 0x34, 0xc7, 0x79, 0xfa, 0x31, 0x99, 0x6b, 0x5e, 0x85, 0xb6,
-	0x6a, 0xea, 0xc4, 0xb6, 0xea, 0xff, 0x9e, 0xd5, 0x56, 0xff, 0x66, 0x08, 0x56, 0x35, 0x6c, 0x7a,
-	0xbe, 0x95, 0xcc, 0x86, 0x71, 0xb5, 0x78, 0x9d, 0x96, 0xf2, 0x1c, 0x4c, 0xc4, 0x82, 0x13, 0x1b,
-	0x01, 0x88, 0x86, 0x9a, 0x16, 0x5a, 0x84, 0x51, 0x2a, 0x63, 0x5c, 0xe3, 0x2b, 0xda, 0x08, 0xf9,
-	0xd9, 0xb4, 0xd0, 0x59, 0x00, 0xee, 0xc7, 0x47, 0xba, 0x3b, 0xae, 0x8d, 0xf3, 0x91, 0xa6, 0x85,
-	0x34, 0x98, 0xec, 0x78, 0x8e, 0xa3, 0x47, 0xb1, 0xc2, 0x88, 0x24, 0x56, 0x20, 0x36, 0xf4, 0xae,
-	0xe7, 0x27, 0x59, 0x13, 0xc5, 0x0a, 0x13, 0x04, 0x09, 0xff, 0xa1, 0xfe, 0xf3, 0x28, 0xac, 0x49,
-	0xb8, 0xc8, 0x0d, 0x6f, 0xc6, 0x42, 0x2a, 0xc7, 0xb3, 0x90, 0x52, 0xeb, 0x37, 0x74, 0x7c, 0xeb,
-	0xf7, 0x4d, 0x40, 0x11, 0x7f, 0xad, 0x41, 0xf3, 0x3b, 0x13, 0xcf, 0x44, 0xab, 0xd7, 0x89, 0x01,
-	0x13, 0x98, 0xde, 0x0a, 0xb1, 0x50, 0x29, 0xbc, 0x19, 0x8b, 0x3e, 0x9c, 0xb5, 0xe8, 0x89, 0xbc,
-	0xf9, 0x48, 0x3a, 0x6f, 0x7e, 0x03, 0x6a, 0xdc, 0xa4, 0xf4, 0x13, 0x10, 0xd1, 0xeb, 0x3f, 0x4a,
-	0x5f, 0xff, 0x05, 0x36, 0x1f, 0xcb, 0x0e, 0x7f, 0xfc, 0x91, 0x06, 0x53, 0x71, 0x7e, 0x98, 0xa6,
-	0x2c, 0x58, 0xc2, 0xf9, 0x9d, 0x3c, 0x6d, 0xdc, 0xf6, 0x0d, 0x37, 0x20, 0xa6, 0x2c, 0x15, 0xa6,
-	0x4f, 0x5a, 0x89, 0x5f, 0xe8, 0x05, 0x9c, 0x11, 0x24, 0x44, 0xfa, 0x26, 0x7c, 0xbc, 0x8c, 0x09,
-	0x5f, 0xca, 0x88, 0x7b, 0x6c, 0xcd, 0x73, 0x5c, 0x4b, 0xc8, 0x73, 0x2d, 0xd7, 0x60, 0x32, 0x65,
-	0xf3, 0x26, 0xa8, 0xcd, 0x9b, 0xd8, 0x49, 0x18, 0xbb, 0x5b, 0x50, 0xed, 0x5f, 0x2b, 0xad, 0x3b,
-	0x4c, 0x16, 0xd6, 0x1d, 0xa6, 0x62, 0x08, 0x5a, 0x76, 0xf8, 0x18, 0x26, 0xa3, 0xbb, 0xa6, 0x08,
-	0xa6, 0x0a, 0x11, 0x4c, 0xf0, 0xf5, 0x14, 0xdc, 0x80, 0x51, 0x12, 0xc9, 0x13, 0x23, 0x5b, 0xa5,
-	0xf9, 0x97, 0x7b, 0x8d, 0x9c, 0x82, 0x62, 0xa3, 0x50, 0x8b, 0x68, 0x8a, 0xc0, 0xc6, 0xc1, 0x1d,
-	0x37, 0xf4, 0x7b, 0x5a, 0x84, 0xb7, 0xfe, 0x02, 0x26, 0x93, 0x13, 0x68, 0x06, 0x2a, 0xfb, 0xb8,
-	0xc7, 0x8d, 0x15, 0xf9, 0x13, 0xdd, 0x80, 0xe1, 0x03, 0x22, 0xfe, 0xd2, 0xfc, 0x43, 0xa4, 0x75,
-	0x2c, 0x0f, 0xc1, 0x00, 0x6e, 0x0e, 0xdd, 0x50, 0x12, 0x76, 0x32, 0xca, 0x3a, 0x7d, 0x6d, 0x27,
-	0x33, 0x76, 0x32, 0xc9, 0x1a, 0xa1, 0x9d, 0xfc, 0x59, 0x25, 0xb2, 0x93, 0x42, 0x2e, 0x72, 0x3b,
-	0x79, 0x1f, 0xa6, 0x07, 0xec, 0x90, 0xd4, 0x52, 0xb2, 0xf7, 0xb7, 0x47, 0x2d, 0x89, 0x56, 0x4d,
-	0xdb, 0xa9, 0x8c, 0xe4, 0x0e, 0x1d, 0x4d, 0x72, 0x13, 0x66, 0xa9, 0x92, 0x36, 0x4b, 0x2f, 0x60,
-	0x25, 0xad, 0x55, 0xba, 0xd7, 0xd2, 0xc3, 0x3d, 0x3b, 0xd0, 0x93, 0xf5, 0x3f, 0xf9, 0x56, 0xf5,
-	0x94, 0x96, 0x3d, 0x6e, 0x6d, 0xef, 0xd9, 0xc1, 0x2d, 0x8e, 0xbf, 0x09, 0xb3, 0x7b, 0xd8, 0xf0,
-	0xc3, 0x1d, 0x6c, 0x84, 0xba, 0x85, 0x43, 0xc3, 0x76, 0x02, 0x9e, 0x62, 0x94, 0x67, 0xdf, 0x66,
-	0x62, 0xb0, 0x4d, 0x06, 0x95, 0x7d, 0x77, 0x46, 0x8e, 0xf7, 0xee, 0x5c, 0x80, 0xe9, 0x18, 0x0f,
-	0x13, 0x6b, 0x6a, 0x80, 0xc7, 0xb5, 0xd8, 0xeb, 0xd9, 0xa4, 0xa3, 0xea, 0xef, 0x2b, 0xf0, 0x0d,
-	0x76, 0x9b, 0x29, 0x4d, 0xe6, 0x65, 0xbc, 0xbe, 0xbe, 0x68, 0x83, 0x19, 0xbb, 0x1b, 0x79, 0x19,
-	0xbb, 0x22, 0x54, 0x25, 0x53, 0x77, 0x7f, 0x51, 0x81, 0x37, 0xe4, 0xd8, 0xb8, 0x08, 0xe2, 0xfe,
-	0xe3, 0xe6, 0xf3, 0x31, 0x4e, 0xe2, 0xcd, 0xe3, 0x9b, 0x2e, 0x6d, 0x3a, 0x18, 0x90, 0xf4, 0x1f,
-	0x2b, 0xb0, 0xd2, 0xcf, 0x79, 0x13, 0x07, 0xd9, 0xb2, 0x83, 0x8e, 0x11, 0x9a, 0x7b, 0xba, 0xe3,
-	0x99, 0x86, 0xe3, 0xf4, 0x6a, 0x43, 0xd4, 0x60, 0xbe, 0x90, 0xec, 0x5a, 0x7c, 0x9c, 0x46, 0x3f,
-	0x29, 0xbe, 0xed, 0x6d, 0xf2, 0x1d, 0x1e, 0xb0, 0x0d, 0x98, 0x1d, 0x5d, 0x36, 0xf2, 0x57, 0xd4,
-	0x7f, 0x05, 0x56, 0x8b, 0x10, 0x08, 0xec, 0xed, 0x66, 0xda, 0xde, 0x8a, 0x53, 0xee, 0x91, 0x19,
-	0xa0, 0xb8, 0x22, 0xc4, 0xf4, 0xd9, 0x4d, 0xd8, 0xde, 0xef, 0x2b, 0xc4, 0xf6, 0x66, 0x8e, 0x79,
-	0xd7, 0xb0, 0x9d, 0xbe, 0x2c, 0x95, 0xac, 0xd5, 0x14, 0xe1, 0x29, 0x29, 0x48, 0xdf, 0x20, 0x76,
-	0x2c, 0x17, 0x13, 0xcf, 0x04, 0xff, 0x50, 0x01, 0x35, 0x6b, 0xed, 0x3e, 0x8b, 0xd4, 0x33, 0xa2,
-	0xfc, 0xe9, 0x20, 0xe5, 0xd7, 0x73, 0x28, 0x2f, 0xc2, 0x54, 0x92, 0xf6, 0x27, 0x44, 0x39, 0x25,
-	0xb8, 0xb8, 0x6c, 0xbe, 0x05, 0x33, 0xa6, 0xe1, 0x9a, 0x38, 0x7e, 0x01, 0x30, 0x7b, 0xd3, 0xc6,
-	0xb4, 0x69, 0x36, 0xae, 0x45, 0xc3, 0x49, 0x7d, 0x4f, 0xe2, 0x3c, 0xa1, 0xbe, 0xcb, 0x50, 0x95,
-	0x3c, 0xea, 0x9b, 0xb1, 0xba, 0xe7, 0x20, 0x4b, 0x54, 0x03, 0x05, 0x0b, 0x4f, 0x22, 0x61, 0xb9,
-	0x78, 0x8e, 0x2c, 0x61, 0x22, 0x4c, 0x29, 0x09, 0xcb, 0x1e, 0x90, 0xde, 0x4f, 0x9f, 0xf2, 0xd2,
-	0x12, 0x56, 0x84, 0xa9, 0x24, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe3, 0xe2, 0xd4, 0xff, 0xa5, 0x02,
-	0xe7, 0x34, 0xdc, 0xf6, 0x0e, 0x30, 0x2b, 0xa7, 0x7f, 0x59, 0x92, 0x74, 0x69, 0xc7, 0xa8, 0x32,
-	0xe0, 0x18, 0xa9, 0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0x6a, 0x08, 0xce, 0xf3, 0x23,
-	0xb0, 0x63, 0xe7, 0xd6, 0x98, 0xa5, 0x07, 0x34, 0xa0, 0x9a, 0xd6, 0x41, 0x7e, 0xb8, 0x9b, 0x39,
-	0xf7, 0x57, 0x62, 0x43, 0x6d, 0x2a, 0xa5, 0xbd, 0x68, 0x07, 0x16, 0xe3, 0x46, 0x11, 0x61, 0x43,
-	0x94, 0xb8, 0xc2, 0x7b, 0x87, 0xc3, 0x0c, 0x54, 0x78, 0xb1, 0x68, 0xf8, 0xc8, 0x4d, 0x22, 0xeb,
-	0xf0, 0x66, 0xd1, 0x59, 0x38, 0x9f, 0xff, 0x5a, 0x81, 0xe5, 0x28, 0x2b, 0x24, 0x88, 0xd2, 0x5f,
-	0x8b, 0xf8, 0x5c, 0x84, 0x59, 0x3b, 0xd0, 0xd3, 0xfd, 0x49, 0x94, 0x97, 0x63, 0xda, 0xb4, 0x1d,
-	0xdc, 0x4d, 0x76, 0x1e, 0xa9, 0x2b, 0x70, 0x46, 0x4c, 0x3e, 0x3f, 0xdf, 0xcf, 0x86, 0x88, 0x05,
-	0x23, 0xc6, 0x3a, 0x5d, 0x95, 0xce, 0x98, 0xd6, 0xd7, 0x71, 0xd0, 0x35, 0x98, 0xe4, 0xcd, 0x67,
-	0xd8, 0x4a, 0x24, 0x6a, 0xe3, 0xb1, 0xa6, 0x85, 0x9e, 0xc3, 0x69, 0x33, 0x22, 0x35, 0xb1, 0xf5,
-	0xa9, 0x23, 0x6d, 0x8d, 0x62, 0x14, 0xfd, 0xbd, 0x1f, 0xc0, 0x4c, 0xa2, 0xa1, 0x8c, 0x05, 0x09,
-	0xc3, 0x65, 0x83, 0x84, 0xe9, 0x3e, 0x28, 0x1d, 0x50, 0x2f, 0x10, 0x6d, 0x95, 0x72, 0x99, 0xdf,
-	0xc7, 0xbf, 0x0d, 0x41, 0x4d, 0xe3, 0x6d, 0x90, 0x98, 0xc2, 0x06, 0xcf, 0xae, 0xbc, 0xce, 0x3b,
-	0x78, 0x01, 0xf3, 0xe9, 0x4c, 0x66, 0x4f, 0xb7, 0x43, 0xdc, 0x8e, 0xfa, 0x27, 0x2e, 0x96, 0xca,
-	0x66, 0xf6, 0x9a, 0x21, 0x6e, 0x6b, 0xa7, 0x0f, 0x32, 0x63, 0x01, 0x7a, 0x1f, 0x46, 0x28, 0x73,
-	0x03, 0x7e, 0x67, 0xe2, 0xcc, 0xc6, 0xa6, 0x11, 0x1a, 0xb7, 0x1d, 0x6f, 0x47, 0xe3, 0x8b, 0xd1,
-	0x06, 0x54, 0x5d, 0x7c, 0xa8, 0xfb, 0x5d, 0x7e, 0x37, 0x51, 0xe8, 0x52, 0x00, 0x3e, 0xe9, 0xe2,
-	0x43, 0xad, 0xcb, 0x2e, 0x25, 0x50, 0x97, 0x61, 0x49, 0xc0, 0x6b, 0x7e, 0x13, 0xdf, 0x53, 0x60,
-	0x61, 0xab, 0xe7, 0x9a, 0x5b, 0x7b, 0x86, 0x6f, 0xf1, 0x04, 0x27, 0xbf, 0x87, 0xf3, 0x50, 0x0d,
-	0xbc, 0xae, 0x6f, 0x62, 0x9d, 0x77, 0xc8, 0xf2, 0xcb, 0x98, 0x62, 0xa3, 0x1b, 0x6c, 0x10, 0x2d,
-	0xc1, 0x18, 0xe1, 0x87, 0x15, 0xbd, 0x60, 0xc3, 0xda, 0x28, 0xfd, 0xdd, 0xb4, 0x50, 0x03, 0x4e,
-	0xd1, 0x68, 0xb1, 0x52, 0x18, 0xc2, 0xd1, 0x75, 0xea, 0x12, 0x2c, 0x66, 0x68, 0xe1, 0x74, 0xfe,
-	0xdd, 0x30, 0x9c, 0x26, 0x73, 0xd1, 0x4b, 0xf8, 0x3a, 0x85, 0xa5, 0x06, 0xa3, 0x51, 0x42, 0x89,
-	0xe9, 0x6a, 0xf4, 0x93, 0xa8, 0x72, 0x3f, 0x9a, 0x8d, 0x33, 0x05, 0x71, 0x66, 0x81, 0xf0, 0x24,
-	0x9b, 0x46, 0x1a, 0x3e, 0x6a, 0x1a, 0xe9, 0x2c, 0x40, 0x14, 0x55, 0xd9, 0x16, 0x8d, 0x42, 0x2b,
-	0xda, 0x38, 0x1f, 0x69, 0x5a, 0x99, 0x58, 0x7d, 0xf4, 0x68, 0xb1, 0xfa, 0x7d, 0x5e, 0xbc, 0xe9,
-	0x87, 0xcd, 0x14, 0xcb, 0x58, 0x21, 0x96, 0x59, 0x02, 0x16, 0x3b, 0xc0, 0x14, 0xd7, 0x35, 0x18,
-	0x8d, 0x62, 0xee, 0xf1, 0x12, 0x31, 0x77, 0xb4, 0x38, 0x99, 0x2f, 0x80, 0x74, 0xbe, 0xe0, 0x13,
-	0x98, 0x64, 0xa5, 0x25, 0xde, 0x4c, 0x3b, 0x51, 0xa2, 0x99, 0x76, 0x82, 0x56, 0x9c, 0x78, 0x1f,
-	0xed, 0xbb, 0x40, 0x7b, 0x61, 0x79, 0x6b, 0xb8, 0x6e, 0x5b, 0xd8, 0x0d, 0xed, 0xb0, 0x47, 0x93,
-	0x79, 0xe3, 0x1a, 0x22, 0x73, 0xcf, 0xe9, 0x54, 0x93, 0xcf, 0xa0, 0xc7, 0x30, 0x3d, 0x60, 0x1b,
-	0x78, 0xe2, 0xee, 0xcd, 0x72, 0x56, 0x41, 0xab, 0xa6, 0x2d, 0x82, 0xba, 0x00, 0x73, 0x69, 0x51,
-	0xe6, 0x32, 0xfe, 0x3b, 0x0a, 0x2c, 0x47, 0x9d, 0x6b, 0x5f, 0x12, 0x27, 0x4e, 0xfd, 0x2d, 0x05,
-	0xce, 0x88, 0x69, 0xe2, 0xf1, 0xcd, 0x55, 0x58, 0x68, 0xb3, 0x71, 0x56, 0x57, 0xd1, 0x6d, 0x57,
-	0x37, 0x0d, 0x73, 0x0f, 0x73, 0x0a, 0x4f, 0xb7, 0x13, 0x50, 0x4d, 0x77, 0x83, 0x4c, 0xa1, 0x0f,
-	0x60, 0x29, 0x03, 0x64, 0x19, 0xa1, 0xb1, 0x63, 0x04, 0x98, 0xbb, 0xc1, 0x0b, 0x69, 0xb8, 0x4d,
-	0x3e, 0xab, 0x9e, 0x81, 0x7a, 0x44, 0x0f, 0xe7, 0xe7, 0x67, 0x5e, 0xdc, 0x7a, 0xa4, 0xfe, 0xda,
-	0x50, 0x9f, 0x85, 0xa9, 0x69, 0x4e, 0xed, 0x3a, 0xcc, 0xb8, 0xdd, 0xf6, 0x0e, 0xf6, 0x75, 0xaf,
-	0xa5, 0x53, 0x33, 0x15, 0x50, 0x3a, 0x87, 0xb5, 0x2a, 0x1b, 0x7f, 0xdc, 0xa2, 0xd6, 0x27, 0x20,
-	0xcc, 0x8e, 0xcc, 0x5a, 0x40, 0xb3, 0x07, 0xc3, 0xda, 0x18, 0xb7, 0x6b, 0x01, 0xba, 0x0f, 0x93,
-	0xfc, 0x26, 0xd8, 0x51, 0x99, 0x81, 0xbb, 0x90, 0x27, 0x0f, 0x2c, 0x9f, 0x43, 0x8f, 0x4e, 0xfd,
-	0xbb, 0x09, 0xab, 0x3f, 0x80, 0xae, 0xc1, 0x22, 0xdb, 0xc8, 0xf4, 0xdc, 0xd0, 0xf7, 0x1c, 0x07,
-	0xfb, 0x94, 0x29, 0x5d, 0xf6, 0x56, 0x8c, 0x6b, 0xf3, 0x74, 0x7a, 0x23, 0x9e, 0x65, 0x96, 0x91,
-	0xea, 0x88, 0x65, 0xf9, 0x38, 0x08, 0x78, 0xd2, 0x31, 0xfa, 0xa9, 0x36, 0x60, 0x96, 0x95, 0xa6,
-	0x08, 0x5c, 0x24, 0x3c, 0x49, 0x33, 0xad, 0xa4, 0xcc, 0xb4, 0x3a, 0x07, 0x28, 0xb9, 0x9e, 0x4b,
-	0xe3, 0xdf, 0x2b, 0x30, 0xcb, 0x1c, 0xf4, 0xa4, 0x27, 0x98, 0x8f, 0x06, 0x7d, 0xcc, 0xcb, 0xb8,
-	0x71, 0xd5, 0xba, 0x7a, 0x65, 0x35, 0xb7, 0x46, 0x60, 0x04, 0xfb, 0x34, 0x35, 0x46, 0x2b, 0xb9,
-	0x34, 0x2d, 0x96, 0x48, 0xb0, 0x56, 0x52, 0x09, 0xd6, 0x0d, 0x98, 0x3e, 0xb0, 0x03, 0x7b, 0xc7,
-	0x76, 0xec, 0xb0, 0xc7, 0x8c, 0x51, 0x71, 0x4e, 0xb0, 0xda, 0x07, 0x21, 0x83, 0xe4, 0x8c, 0xc9,
-	0xc3, 0xf4, 0x03, 0xd6, 0x59, 0xda, 0x84, 0xf6, 0xb4, 0x8b, 0xbb, 0xb8, 0xc4, 0x19, 0xd7, 0x60,
-	0x92, 0x3f, 0x86, 0xba, 0x6b, 0xb4, 0x23, 0x59, 0x9d, 0xe0, 0x63, 0x8f, 0x0c, 0x9a, 0xe4, 0x4f,
-	0xb0, 0xa1, 0x72, 0x54, 0x36, 0x30, 0x42, 0xfb, 0x14, 0x71, 0x42, 0x7f, 0x57, 0x81, 0xb9, 0x48,
-	0xae, 0xbf, 0x3c, 0xb4, 0x3e, 0x86, 0xf9, 0x01, 0xa2, 0xb8, 0x9a, 0x5d, 0x83, 0xc5, 0x8e, 0xef,
-	0x99, 0x38, 0x08, 0x6c, 0x77, 0x57, 0xa7, 0x1f, 0xc5, 0x30, 0x45, 0x27, 0xda, 0x56, 0x21, 0x32,
-	0xdd, 0x9f, 0xa6, 0x90, 0x54, 0xcb, 0x03, 0xf5, 0x3b, 0x0a, 0x9c, 0xbd, 0x87, 0x43, 0xad, 0xff,
-	0x85, 0xcc, 0x43, 0x1c, 0x04, 0xc6, 0x2e, 0x8e, 0x9d, 0x92, 0x4f, 0x61, 0x84, 0x56, 0x68, 0x18,
-	0x22, 0x49, 0x9d, 0x39, 0x81, 0x83, 0xd6, 0x6f, 0x34, 0x0e, 0x57, 0x82, 0x2d, 0xc4, 0x8a, 0xac,
-	0xe4, 0x91, 0xc1, 0x4f, 0xf8, 0x12, 0xaa, 0x8c, 0xef, 0x6d, 0x3e, 0xc3, 0xe9, 0xb9, 0x9f, 0x9b,
-	0x61, 0x94, 0x23, 0x6c, 0x50, 0xe5, 0x8b, 0x46, 0x59, 0x36, 0x71, 0x2a, 0x48, 0x8e, 0xd5, 0xdb,
-	0x80, 0xb2, 0x8b, 0x92, 0x19, 0xc3, 0x61, 0x96, 0x31, 0xbc, 0x95, 0xce, 0x18, 0xbe, 0x5d, 0x82,
-	0x43, 0x31, 0x35, 0x89, 0x74, 0xa1, 0x0b, 0xab, 0xf7, 0x70, 0xb8, 0xf9, 0xe0, 0xa9, 0xe4, 0x36,
-	0xee, 0x03, 0x30, 0x9d, 0x75, 0x5b, 0x5e, 0xc4, 0x81, 0x32, 0xfb, 0x11, 0x59, 0xa2, 0x96, 0x90,
-	0x8a, 0x1f, 0xf9, 0x2b, 0x50, 0x7b, 0xb0, 0x26, 0xd9, 0x8f, 0xb3, 0x7d, 0x1b, 0x66, 0x13, 0x9f,
-	0x4f, 0xd1, 0x82, 0x61, 0xb4, 0xef, 0x85, 0x92, 0xfb, 0x6a, 0x33, 0x7e, 0x7a, 0x20, 0x50, 0xff,
-	0x49, 0x81, 0x39, 0x0d, 0x1b, 0x9d, 0x8e, 0xc3, 0x22, 0x9b, 0xf8, 0x7c, 0x0b, 0x30, 0xc2, 0x33,
-	0xf4, 0xec, 0x31, 0xe3, 0xbf, 0xe4, 0xdf, 0x8c, 0x88, 0x5f, 0xe2, 0xca, 0x49, 0xbd, 0xce, 0xe3,
-	0x85, 0x10, 0xea, 0x22, 0xcc, 0x0f, 0x1c, 0x8d, 0x9b, 0x94, 0x3f, 0x55, 0x60, 0x59, 0xc3, 0x2d,
-	0x1f, 0x07, 0x7b, 0x71, 0xb1, 0x82, 0x70, 0xe3, 0x4b, 0x78, 0x76, 0x12, 0xdf, 0x8b, 0x49, 0xe5,
-	0x67, 0xf9, 0xe9, 0x10, 0x2c, 0x68, 0xd8, 0xb0, 0x36, 0x1f, 0x3c, 0x1d, 0x14, 0xd1, 0xab, 0x70,
-	0x2a, 0x6e, 0x12, 0xa8, 0x5e, 0x39, 0x97, 0xfb, 0x44, 0x3f, 0x78, 0x4a, 0x8d, 0x1b, 0x5d, 0x2c,
-	0x8b, 0x69, 0xb2, 0x51, 0x51, 0x45, 0x14, 0x15, 0x6d, 0x43, 0xcd, 0x76, 0xc9, 0x0a, 0xfb, 0x00,
-	0xeb, 0xd8, 0x8d, 0xed, 0x44, 0xc9, 0xce, 0xaa, 0xf9, 0x18, 0xf8, 0x8e, 0x1b, 0x29, 0x7c, 0xd3,
-	0x22, 0xbc, 0xef, 0x10, 0x24, 0x81, 0xfd, 0x39, 0x8b, 0x1b, 0x86, 0xb5, 0x31, 0x32, 0xb0, 0x65,
-	0x7f, 0x8e, 0xd1, 0x9b, 0x30, 0x4d, 0xfb, 0x03, 0xe8, 0x0a, 0x56, 0xc6, 0x1e, 0xa1, 0x65, 0x6c,
-	0xda, 0x36, 0xf0, 0xc4, 0xd8, 0xc5, 0xac, 0xab, 0xed, 0xcf, 0x87, 0x60, 0x31, 0xc3, 0xac, 0xd8,
-	0x9b, 0x3b, 0x06, 0xb7, 0x84, 0x4a, 0x39, 0x74, 0x42, 0xa5, 0x44, 0x06, 0x2c, 0x64, 0xb0, 0x46,
-	0x09, 0xb5, 0x23, 0xdb, 0x99, 0xb9, 0x41, 0xf4, 0x34, 0xa1, 0x26, 0xe0, 0xd8, 0x29, 0x11, 0xc7,
-	0xfe, 0x55, 0x81, 0xc5, 0x27, 0x5d, 0x7f, 0x17, 0x7f, 0xc5, 0xe5, 0x4b, 0xad, 0x43, 0x2d, 0x7b,
-	0x4e, 0xae, 0x63, 0x7f, 0x36, 0x04, 0x8b, 0x0f, 0xf1, 0x57, 0x9f, 0x09, 0xff, 0x3b, 0x4a, 0x76,
-	0x1b, 0x6a, 0x59, 0x66, 0x71, 0x25, 0x13, 0xe0, 0x50, 0x44, 0x38, 0x7e, 0x55, 0x81, 0x33, 0x8f,
-	0xbc, 0xd0, 0x6e, 0xf5, 0x48, 0xf0, 0xea, 0x1d, 0x60, 0xff, 0xa1, 0x41, 0x22, 0xd3, 0x98, 0xed,
-	0x06, 0x2c, 0xb4, 0xf8, 0x8c, 0xde, 0xa6, 0x53, 0x7a, 0xca, 0x39, 0xca, 0x55, 0x91, 0x34, 0x3e,
-	0xe6, 0x1f, 0xcd, 0xb5, 0xb2, 0x83, 0x81, 0x7a, 0x0e, 0xce, 0xe6, 0x90, 0xc0, 0xc5, 0xc2, 0x80,
-	0xe5, 0x7b, 0x38, 0xdc, 0xf0, 0xbd, 0x20, 0xe0, 0xd7, 0x92, 0x7a, 0x45, 0x52, 0x61, 0x94, 0x32,
-	0x10, 0x46, 0x9d, 0x87, 0x6a, 0x68, 0xf8, 0xbb, 0x38, 0x8c, 0xaf, 0x99, 0xbd, 0x27, 0x53, 0x6c,
-	0x94, 0xe3, 0x53, 0xff, 0xab, 0x02, 0x67, 0xc4, 0x7b, 0x70, 0x86, 0xb6, 0x09, 0x1e, 0x62, 0x1e,
-	0x76, 0x7a, 0x2c, 0xa8, 0xe3, 0xe7, 0xbf, 0x27, 0x73, 0xc6, 0x72, 0xd1, 0x51, 0x4f, 0x37, 0xb8,
-	0xdd, 0xa3, 0xce, 0x16, 0xf3, 0xc4, 0x26, 0xc3, 0xc4, 0x10, 0xfa, 0xb6, 0x02, 0xf3, 0x2d, 0x5a,
-	0x41, 0xd2, 0x4d, 0xa3, 0x1b, 0xe0, 0xfe, 0xb6, 0xcc, 0xe8, 0x3d, 0x3c, 0xde, 0xb6, 0xac, 0x28,
-	0xb5, 0x41, 0x30, 0xa6, 0x36, 0x47, 0xad, 0xcc, 0x44, 0xfd, 0x25, 0xcc, 0x66, 0xa8, 0x14, 0xb8,
-	0x82, 0x77, 0xd3, 0xae, 0xe0, 0xbb, 0x79, 0xf2, 0x30, 0x48, 0x14, 0xbf, 0xbd, 0xa4, 0x3f, 0x58,
-	0x7f, 0x09, 0x8b, 0x39, 0x14, 0x0a, 0x36, 0xfe, 0x34, 0xb9, 0x71, 0x35, 0x3f, 0x7f, 0x7a, 0x0f,
-	0x87, 0xfd, 0x7a, 0x1c, 0x45, 0x9c, 0x74, 0x41, 0xff, 0x5d, 0x81, 0x75, 0x5e, 0x01, 0xcb, 0xb0,
-	0x2d, 0x93, 0xba, 0x97, 0x44, 0x42, 0xe5, 0xe4, 0x0c, 0x3d, 0x67, 0x62, 0x14, 0xb7, 0x2a, 0x44,
-	0xd9, 0xdf, 0x23, 0xb0, 0x8d, 0x77, 0x28, 0x4c, 0x85, 0x89, 0x5f, 0x01, 0x7a, 0x03, 0xa6, 0x5a,
-	0x38, 0x34, 0xf7, 0x1e, 0x61, 0xe6, 0xb7, 0xf0, 0x92, 0x4d, 0x7a, 0x50, 0x0d, 0xe0, 0xad, 0x12,
-	0x87, 0x8d, 0x9b, 0x20, 0x87, 0x23, 0xe7, 0xf7, 0x98, 0x37, 0x4b, 0xc1, 0xaf, 0xfc, 0xe3, 0xdb,
-	0x00, 0x3c, 0x51, 0x72, 0xeb, 0x49, 0x13, 0x7d, 0x57, 0x81, 0x05, 0xf1, 0xa7, 0xb8, 0xe8, 0x5a,
-	0xae, 0x54, 0x4b, 0x3f, 0x06, 0xae, 0x5f, 0x3f, 0x32, 0x1c, 0x3f, 0xe2, 0x6f, 0x2a, 0xb0, 0x98,
-	0xf3, 0x91, 0x33, 0x92, 0x20, 0x95, 0x7e, 0xf6, 0x5d, 0xbf, 0x71, 0x74, 0x40, 0x4e, 0xce, 0x4f,
-	0x14, 0x58, 0x2d, 0xfa, 0x5e, 0x19, 0x7d, 0x5a, 0x84, 0xbe, 0xe8, 0xdb, 0xe9, 0xfa, 0xad, 0x13,
-	0x60, 0xe0, 0x94, 0x92, 0x4b, 0x14, 0x7f, 0x89, 0x2c, 0xb9, 0x44, 0xe9, 0x17, 0xd0, 0x92, 0x4b,
-	0x2c, 0xf8, 0xe4, 0xf9, 0xf7, 0x14, 0xa8, 0xe7, 0x7f, 0x47, 0x8c, 0xf2, 0xdb, 0x80, 0x0a, 0xbf,
-	0x63, 0xae, 0x7f, 0x78, 0x2c, 0x58, 0x4e, 0xd7, 0x0f, 0x14, 0x58, 0xca, 0xfd, 0x4a, 0x18, 0x7d,
-	0x90, 0x8b, 0xba, 0xe8, 0x23, 0xe5, 0xfa, 0xcd, 0xe3, 0x80, 0x72, 0xa2, 0x5c, 0x98, 0x4a, 0x7d,
-	0x3e, 0x8a, 0xde, 0xc9, 0x45, 0x26, 0xfa, 0x4a, 0xb5, 0xde, 0x28, 0xbb, 0x9c, 0xef, 0xf7, 0x6d,
-	0x05, 0x4e, 0x0b, 0xbe, 0xc1, 0x44, 0x57, 0xe5, 0xb7, 0x2d, 0xfc, 0xea, 0xb3, 0xfe, 0xde, 0xd1,
-	0x80, 0x38, 0x09, 0x21, 0x4c, 0x0f, 0x7c, 0xef, 0x88, 0x2e, 0xc9, 0x9e, 0x4f, 0x41, 0x5e, 0xbc,
-	0xfe, 0x6e, 0x79, 0x00, 0xbe, 0xeb, 0x21, 0xcc, 0x0c, 0x7e, 0xd7, 0x83, 0xf2, 0xb1, 0xe4, 0x7c,
-	0xf9, 0x54, 0xbf, 0x7c, 0x04, 0x88, 0x84, 0xd8, 0xe5, 0x36, 0xb8, 0x49, 0xc4, 0xae, 0xe8, 0xdb,
-	0x82, 0xfa, 0x09, 0xfa, 0xe9, 0xd0, 0x1f, 0x2a, 0x24, 0xbc, 0xce, 0xef, 0x7f, 0x43, 0x1f, 0x1d,
-	0xb3, 0x6d, 0x8e, 0x91, 0xf6, 0xf1, 0x89, 0x9a, 0xee, 0x38, 0xcb, 0x72, 0x9a, 0xc4, 0xa4, 0x2c,
-	0x93, 0xb7, 0xa8, 0x49, 0x59, 0x56, 0xd0, 0x93, 0x96, 0xb8, 0x47, 0x41, 0x07, 0x6e, 0xe1, 0x3d,
-	0xe6, 0xf7, 0x3e, 0x17, 0xde, 0xa3, 0xac, 0xe1, 0x37, 0x71, 0x8f, 0xc2, 0x3e, 0xad, 0xe2, 0x7b,
-	0x94, 0xf5, 0x8a, 0x15, 0xdf, 0xa3, 0xb4, 0x39, 0x2c, 0x79, 0x8f, 0xd9, 0x56, 0xac, 0xe2, 0x7b,
-	0xcc, 0x6d, 0x04, 0x2b, 0xbe, 0xc7, 0xfc, 0xce, 0x2f, 0xf4, 0x07, 0x34, 0x09, 0x96, 0xdb, 0x63,
-	0x85, 0x3e, 0x3c, 0xd2, 0x99, 0xd3, 0x5d, 0x5e, 0xf5, 0x8f, 0x8e, 0x07, 0x9c, 0x22, 0x2d, 0xb7,
-	0xc1, 0x50, 0x4a, 0x5a, 0x51, 0x8b, 0xa3, 0x94, 0xb4, 0xe2, 0x9e, 0xc6, 0x3f, 0x52, 0x60, 0x45,
-	0xde, 0x59, 0x84, 0xbe, 0x25, 0xd9, 0xa0, 0x44, 0x7b, 0x55, 0xfd, 0x93, 0x63, 0xc3, 0x73, 0x1a,
-	0x7f, 0x5b, 0x81, 0x5a, 0x5e, 0x7f, 0x19, 0xba, 0x21, 0xc1, 0x2e, 0x6d, 0xa4, 0xab, 0x7f, 0x70,
-	0x0c, 0x48, 0x4e, 0xd1, 0xaf, 0x2b, 0x30, 0x27, 0xea, 0x52, 0x42, 0xf9, 0x2f, 0xa7, 0xa4, 0x27,
-	0xab, 0xfe, 0xfe, 0x11, 0xa1, 0x38, 0x15, 0x3f, 0xa2, 0xff, 0x32, 0x47, 0xd2, 0xa4, 0x83, 0x3e,
-	0x2e, 0x90, 0x0d, 0x79, 0x0b, 0x55, 0xfd, 0x5b, 0xc7, 0x05, 0xe7, 0x04, 0x7e, 0x0e, 0xb3, 0x99,
-	0x76, 0x15, 0x74, 0x59, 0x82, 0x54, 0xdc, 0x46, 0x54, 0xbf, 0x72, 0x14, 0x90, 0xbe, 0x37, 0x32,
-	0xd0, 0x80, 0x22, 0xf1, 0x46, 0xc4, 0x6d, 0x33, 0x12, 0x6f, 0x24, 0xa7, 0xb7, 0x05, 0xed, 0xc3,
-	0x64, 0xb2, 0x1f, 0x00, 0x7d, 0x53, 0x8a, 0x61, 0xa0, 0x03, 0xa6, 0xfe, 0x4e, 0xc9, 0xd5, 0x09,
-	0x29, 0x14, 0x15, 0xf4, 0x25, 0x52, 0x28, 0xe9, 0x49, 0x90, 0x48, 0xa1, 0xb4, 0x6b, 0x80, 0x78,
-	0x9e, 0x82, 0x3a, 0xbd, 0xc4, 0xf3, 0xcc, 0x2f, 0xfa, 0xd7, 0xdf, 0x3b, 0x1a, 0x50, 0xfc, 0x6d,
-	0x02, 0xf4, 0xab, 0xde, 0xe8, 0x62, 0x2e, 0x8e, 0x4c, 0x29, 0xbd, 0xfe, 0x76, 0xa9, 0xb5, 0xfd,
-	0x6d, 0xfa, 0x85, 0x67, 0xc9, 0x36, 0x99, 0x52, 0xbb, 0x64, 0x9b, 0x6c, 0x25, 0x9b, 0x6d, 0x13,
-	0x95, 0x8d, 0xa5, 0xdb, 0x0c, 0x54, 0xbb, 0xa5, 0xdb, 0x0c, 0xd6, 0xa1, 0x49, 0x84, 0x92, 0xaa,
-	0xf8, 0x4a, 0x22, 0x14, 0x51, 0xb9, 0x5a, 0x12, 0xa1, 0x88, 0x0b, 0xc9, 0xdf, 0x65, 0xff, 0x05,
-	0x46, 0x50, 0x12, 0x94, 0x84, 0xb2, 0xd2, 0x0a, 0xb2, 0x24, 0x94, 0x2d, 0x28, 0xf9, 0x12, 0x07,
-	0x26, 0xb7, 0x42, 0x29, 0x71, 0x60, 0x8a, 0xaa, 0xa8, 0x12, 0x07, 0xa6, 0xb8, 0x20, 0xea, 0xc2,
-	0x54, 0xaa, 0xbc, 0x27, 0xb9, 0x10, 0x51, 0x85, 0x53, 0x72, 0x21, 0xc2, 0xaa, 0x21, 0x35, 0x1f,
-	0xa2, 0x52, 0x1c, 0x92, 0x85, 0x7f, 0xb9, 0x45, 0x46, 0x89, 0xf9, 0x90, 0xd5, 0xfb, 0x88, 0x9d,
-	0x1e, 0xa8, 0x60, 0x49, 0xec, 0xb4, 0xb8, 0x30, 0x28, 0xb1, 0xd3, 0x79, 0xc5, 0x31, 0x12, 0x35,
-	0x0e, 0x54, 0x47, 0x64, 0x51, 0xa3, 0xb8, 0x60, 0x24, 0x8b, 0x1a, 0x73, 0x4a, 0x2f, 0x64, 0xe3,
-	0xc1, 0x62, 0x82, 0x64, 0xe3, 0x9c, 0x22, 0x8d, 0x64, 0xe3, 0xdc, 0x4a, 0xc5, 0x6f, 0x28, 0x30,
-	0x2f, 0x4c, 0xff, 0xa3, 0xfc, 0x8b, 0x93, 0x55, 0x2c, 0xea, 0xd7, 0x8e, 0x0a, 0x96, 0x10, 0x3b,
-	0x51, 0xf2, 0x5c, 0x22, 0x76, 0x92, 0xaa, 0x84, 0x44, 0xec, 0xa4, 0x75, 0x86, 0x9f, 0x2a, 0xf1,
-	0xd7, 0x24, 0xf9, 0x29, 0x5a, 0x74, 0xab, 0xc8, 0xed, 0x2f, 0xcc, 0x65, 0xd7, 0x6f, 0x9f, 0x04,
-	0x05, 0x23, 0xf6, 0xf6, 0x9d, 0xbf, 0xfd, 0x62, 0x45, 0xf9, 0x87, 0x2f, 0x56, 0x94, 0x7f, 0xf9,
-	0x62, 0x45, 0xf9, 0xc5, 0xeb, 0xbb, 0x76, 0xb8, 0xd7, 0xdd, 0x69, 0x98, 0x5e, 0xfb, 0x52, 0xea,
-	0xbf, 0xcd, 0x36, 0x76, 0xb1, 0xcb, 0xfe, 0x6d, 0x70, 0xe2, 0xff, 0x16, 0x7f, 0xc8, 0xff, 0x3c,
-	0xb8, 0xbc, 0x33, 0x42, 0xe7, 0xae, 0xfe, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0x58, 0x45,
-	0xc4, 0xe3, 0x58, 0x00, 0x00,
+	// 4755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0x68, 0x8e, 0xf8, 0x7b, 0x24, 0x87, 0x64, 0x89, 0x9f, 0x61, 0x53, 0xa2, 0xc8, 0xb1, 0x64,
+	0xd3, 0xb2, 0x3d, 0xb2, 0x24, 0xeb, 0x63, 0xd9, 0x5e, 0x5b, 0x22, 0x25, 0x79, 0x0c, 0xc9, 0x92,
+	0x9a, 0x8c, 0x94, 0x04, 0x89, 0x1a, 0xcd, 0xee, 0x1a, 0xb2, 0xc3, 0x99, 0xee, 0x51, 0x57, 0x0f,
+	0xa9, 0xf1, 0x21, 0xd8, 0x7c, 0x90, 0x20, 0x8b, 0x20, 0x9f, 0xcd, 0x07, 0x41, 0x02, 0x2c, 0x10,
+	0x6c, 0x80, 0x45, 0x36, 0x87, 0x1c, 0x92, 0x5b, 0x90, 0x53, 0x2e, 0x09, 0x72, 0xc9, 0x35, 0xa7,
+	0x04, 0xc6, 0x22, 0x87, 0xe4, 0x98, 0x20, 0x97, 0x05, 0x82, 0xa0, 0x3e, 0xfd, 0x9b, 0xae, 0xee,
+	0xe9, 0x19, 0x06, 0x90, 0xe3, 0xf5, 0x8d, 0x53, 0x55, 0xef, 0xd5, 0xab, 0x57, 0xef, 0xbd, 0x7e,
+	0xbf, 0x22, 0x5c, 0xe8, 0xec, 0x61, 0xef, 0x92, 0x69, 0x58, 0xd8, 0x31, 0xf1, 0xa5, 0x03, 0x9b,
+	0xf8, 0xae, 0xd7, 0xbd, 0x74, 0x74, 0xf9, 0x12, 0xc1, 0xde, 0x91, 0x6d, 0xe2, 0x5a, 0xdb, 0x73,
+	0x7d, 0x17, 0x2d, 0xd3, 0x65, 0x35, 0xb1, 0xac, 0x26, 0x96, 0xd5, 0x8e, 0x2e, 0xab, 0x6b, 0xfb,
+	0xae, 0xbb, 0xdf, 0xc4, 0x97, 0xd8, 0xb2, 0xbd, 0x4e, 0xe3, 0x92, 0xd5, 0xf1, 0x0c, 0xdf, 0x76,
+	0x1d, 0x0e, 0xa8, 0x9e, 0xeb, 0x9d, 0xf7, 0xed, 0x16, 0x26, 0xbe, 0xd1, 0x6a, 0x8b, 0x05, 0x29,
+	0x04, 0xc7, 0x9e, 0xd1, 0x6e, 0x63, 0x8f, 0x88, 0xf9, 0xf5, 0x04, 0x81, 0x46, 0xdb, 0xa6, 0xc4,
+	0x99, 0x6e, 0xab, 0x15, 0x6e, 0xb1, 0x21, 0x5b, 0x11, 0x90, 0x28, 0xa8, 0x90, 0x2d, 0x79, 0xd1,
+	0xc1, 0xe1, 0x82, 0xaa, 0x6c, 0x81, 0x6f, 0x90, 0xc3, 0xa6, 0x4d, 0xfc, 0xbc, 0x35, 0xc7, 0xae,
+	0x77, 0xd8, 0x68, 0xba, 0xc7, 0x62, 0xcd, 0x45, 0xd9, 0x1a, 0xc1, 0x4a, 0xbd, 0x67, 0xed, 0x66,
+	0xbf, 0xb5, 0xd8, 0x13, 0x2b, 0xcf, 0x27, 0x56, 0x92, 0x03, 0xc3, 0xc3, 0x16, 0x63, 0x43, 0xb3,
+	0x43, 0xfc, 0xbe, 0xab, 0x92, 0xac, 0xa8, 0x66, 0xac, 0x7a, 0xd1, 0xc1, 0x1d, 0x2c, 0xa5, 0x2c,
+	0x5a, 0xe3, 0xe1, 0x76, 0xd3, 0x36, 0xe3, 0xd7, 0x7b, 0x21, 0x63, 0x65, 0xf2, 0xa8, 0xd5, 0x5f,
+	0x1f, 0x83, 0xb3, 0x3b, 0xbe, 0xe1, 0xf9, 0xcf, 0xc4, 0xf8, 0xdd, 0x97, 0xd8, 0xec, 0x50, 0x3c,
+	0x1a, 0x7e, 0xd1, 0xc1, 0xc4, 0x47, 0x0f, 0x60, 0xdc, 0xe3, 0x7f, 0x56, 0x94, 0x75, 0x65, 0x73,
+	0xea, 0xca, 0x95, 0x5a, 0x42, 0xe4, 0x8c, 0xb6, 0x5d, 0x3b, 0xba, 0x5c, 0xcb, 0x45, 0xa2, 0x05,
+	0x28, 0xd0, 0x2a, 0x4c, 0x5a, 0x6e, 0xcb, 0xb0, 0x1d, 0xdd, 0xb6, 0x2a, 0x23, 0xeb, 0xca, 0xe6,
+	0xa4, 0x36, 0xc1, 0x07, 0xea, 0x16, 0xfa, 0x39, 0x58, 0x6c, 0x1b, 0x1e, 0x76, 0x7c, 0x1d, 0x07,
+	0x08, 0x74, 0xdb, 0x69, 0xb8, 0x95, 0x12, 0xdb, 0x78, 0x53, 0xba, 0xf1, 0x63, 0x06, 0x11, 0xee,
+	0x58, 0x77, 0x1a, 0xae, 0x76, 0xba, 0x9d, 0x1e, 0x44, 0x15, 0x18, 0x37, 0x7c, 0x1f, 0xb7, 0xda,
+	0x7e, 0xe5, 0xd4, 0xba, 0xb2, 0x39, 0xaa, 0x05, 0x3f, 0xd1, 0x16, 0xcc, 0xe2, 0x97, 0x6d, 0x9b,
+	0xab, 0x87, 0x4e, 0xf5, 0xa0, 0x32, 0xca, 0x76, 0x54, 0x6b, 0x5c, 0x07, 0x6a, 0x81, 0x0e, 0xd4,
+	0x76, 0x03, 0x25, 0xd1, 0xca, 0x11, 0x08, 0x1d, 0x44, 0x0d, 0x58, 0x31, 0x5d, 0xc7, 0xb7, 0x9d,
+	0x0e, 0xd6, 0x0d, 0xa2, 0x3b, 0xf8, 0x58, 0xb7, 0x1d, 0xdb, 0xb7, 0x0d, 0xdf, 0xf5, 0x2a, 0x63,
+	0xeb, 0xca, 0x66, 0xf9, 0xca, 0x5b, 0xd2, 0x03, 0x6c, 0x09, 0xa8, 0xdb, 0xe4, 0x73, 0x7c, 0x5c,
+	0x0f, 0x40, 0xb4, 0x25, 0x53, 0x3a, 0x8e, 0xea, 0x30, 0x1f, 0xcc, 0x58, 0x7a, 0xc3, 0xb0, 0x9b,
+	0x1d, 0x0f, 0x57, 0xc6, 0x19, 0xb9, 0x67, 0xa4, 0xf8, 0xef, 0xf1, 0x35, 0xda, 0x5c, 0x08, 0x26,
+	0x46, 0x90, 0x06, 0x4b, 0x4d, 0x83, 0xf8, 0xba, 0xe9, 0xb6, 0xda, 0x4d, 0xcc, 0x0e, 0xef, 0x61,
+	0xd2, 0x69, 0xfa, 0x95, 0x89, 0x1c, 0x7c, 0x8f, 0x8d, 0x6e, 0xd3, 0x35, 0x2c, 0x6d, 0x81, 0xc2,
+	0x6e, 0x85, 0xa0, 0x1a, 0x83, 0x44, 0x3f, 0x0d, 0xab, 0x0d, 0xdb, 0x23, 0xbe, 0x6e, 0x61, 0xd3,
+	0x26, 0x8c, 0x9f, 0x06, 0x39, 0xd4, 0xf7, 0x0c, 0xf3, 0xd0, 0x6d, 0x34, 0x2a, 0x93, 0x0c, 0xf1,
+	0x4a, 0x8a, 0xaf, 0xdb, 0xc2, 0x38, 0x69, 0x15, 0x06, 0xbd, 0x2d, 0x80, 0x77, 0x0d, 0x72, 0x78,
+	0x87, 0x83, 0xa2, 0x9f, 0x87, 0xb3, 0x1e, 0xf6, 0xbd, 0xae, 0x8e, 0x9b, 0x46, 0x9b, 0x60, 0x4b,
+	0xef, 0xbd, 0x33, 0xe8, 0x7b, 0x67, 0x2a, 0x43, 0x70, 0x97, 0xc3, 0xdf, 0x4d, 0xdc, 0x5f, 0xf5,
+	0x06, 0xac, 0x65, 0xc9, 0x30, 0x69, 0xbb, 0x0e, 0xc1, 0x68, 0x11, 0xc6, 0xbc, 0x0e, 0x13, 0x5c,
+	0x85, 0x09, 0xee, 0xa8, 0xd7, 0x71, 0xea, 0x56, 0xf5, 0xcf, 0x46, 0x60, 0x6d, 0xc7, 0xde, 0x77,
+	0x8c, 0x66, 0xa6, 0x0e, 0x3d, 0xec, 0xd5, 0xa1, 0xab, 0x72, 0x1d, 0xca, 0xc5, 0x52, 0x50, 0x89,
+	0x1a, 0xb0, 0x8a, 0x5f, 0xfa, 0xd8, 0x73, 0x8c, 0x66, 0x68, 0xd7, 0x22, 0x7d, 0x12, 0xaa, 0xf4,
+	0xba, 0x74, 0xff, 0xf4, 0xce, 0x2b, 0x01, 0xaa, 0xd4, 0x14, 0xaa, 0xc1, 0x69, 0xf3, 0xc0, 0x6e,
+	0x5a, 0xd1, 0x26, 0xae, 0xd3, 0xec, 0x32, 0xd5, 0x9a, 0xd0, 0xe6, 0xd9, 0x54, 0x00, 0xf4, 0xc8,
+	0x69, 0x76, 0xab, 0x1b, 0x70, 0x2e, 0xf3, 0x7c, 0x9c, 0xc1, 0xd5, 0xef, 0x29, 0xf0, 0x86, 0x58,
+	0x63, 0xfb, 0x07, 0xf9, 0x66, 0xe9, 0x69, 0x2f, 0x4b, 0x3f, 0xcc, 0x63, 0x69, 0x3f, 0x74, 0xc5,
+	0x78, 0x5b, 0xbd, 0x0d, 0x9b, 0xfd, 0x11, 0xe6, 0x4b, 0xcb, 0x77, 0x14, 0x38, 0xab, 0x61, 0x82,
+	0x4f, 0x6c, 0x70, 0x73, 0x91, 0x14, 0x3c, 0xcf, 0x0d, 0x58, 0xcb, 0x42, 0x93, 0x7f, 0x8a, 0xef,
+	0x2a, 0xb0, 0xb1, 0x8b, 0xbd, 0x96, 0xed, 0x18, 0x3e, 0xce, 0x3c, 0xc9, 0xe3, 0xde, 0x93, 0x5c,
+	0x97, 0x9e, 0xa4, 0x2f, 0xa2, 0x82, 0xa7, 0x39, 0x0f, 0xd5, 0x3c, 0x54, 0x42, 0xc8, 0xfe, 0x58,
+	0x81, 0x0b, 0x77, 0x5f, 0xb6, 0xb1, 0x65, 0x4b, 0x56, 0xb1, 0x3b, 0x0d, 0xc8, 0xdf, 0xed, 0x25,
+	0xff, 0x96, 0x94, 0xfc, 0x42, 0xc8, 0x0a, 0x1e, 0x61, 0x13, 0x5e, 0xef, 0x87, 0x4e, 0x1c, 0xe3,
+	0x77, 0x14, 0x58, 0xdf, 0xc6, 0xc4, 0xf4, 0xec, 0xbd, 0xec, 0x0b, 0x78, 0xd4, 0x7b, 0x82, 0x6b,
+	0xd2, 0x13, 0xf4, 0xc3, 0x53, 0x90, 0xf8, 0xff, 0x29, 0xc1, 0x46, 0x0e, 0x2a, 0x21, 0x51, 0x4d,
+	0x58, 0x8e, 0xbe, 0xee, 0xa6, 0xeb, 0x34, 0xec, 0x7d, 0x61, 0xfb, 0x73, 0x6d, 0x63, 0x0a, 0xe1,
+	0x56, 0x1c, 0x54, 0x5b, 0xc2, 0xd2, 0x71, 0xb4, 0x07, 0xcb, 0x69, 0x23, 0xc8, 0x9d, 0x8a, 0x11,
+	0xb6, 0xdb, 0xc5, 0x62, 0xbb, 0x31, 0xb7, 0x62, 0xf1, 0x58, 0x36, 0x8c, 0x9e, 0x01, 0x6a, 0x63,
+	0xc7, 0xb2, 0x9d, 0x7d, 0xdd, 0x30, 0x7d, 0xfb, 0xc8, 0xf6, 0x6d, 0x4c, 0x2a, 0xa5, 0xf5, 0x52,
+	0xb6, 0xcf, 0xc2, 0x97, 0xdf, 0xe6, 0xab, 0xbb, 0x0c, 0xf9, 0x7c, 0x3b, 0x31, 0x68, 0x63, 0x82,
+	0x7e, 0x06, 0xe6, 0x02, 0xc4, 0xcc, 0x9e, 0x7a, 0xd8, 0xa9, 0x9c, 0x62, 0x68, 0x6b, 0x79, 0x68,
+	0xb7, 0xe8, 0xda, 0x24, 0xe5, 0xb3, 0xed, 0xd8, 0x94, 0x87, 0x1d, 0xb4, 0x13, 0xa1, 0x0e, 0x3e,
+	0xd4, 0xc2, 0xe7, 0xc9, 0xa5, 0x38, 0xf8, 0x2e, 0x27, 0x90, 0x06, 0x83, 0xd5, 0x97, 0xb0, 0xf0,
+	0x84, 0xba, 0xee, 0x01, 0xf7, 0x02, 0x31, 0xdc, 0xea, 0x15, 0xc3, 0x37, 0xa5, 0x7b, 0xc8, 0x60,
+	0x0b, 0x8a, 0xde, 0xf7, 0x15, 0x58, 0xec, 0x01, 0x17, 0xe2, 0xf6, 0x31, 0x4c, 0xb3, 0x70, 0x22,
+	0xf0, 0x6c, 0x94, 0x02, 0x9e, 0xcd, 0x14, 0x83, 0x10, 0x0e, 0x4d, 0x1d, 0xca, 0x01, 0x82, 0x5f,
+	0xc0, 0xa6, 0x8f, 0x2d, 0x21, 0x38, 0xd5, 0xec, 0x33, 0x68, 0x62, 0xa5, 0x36, 0xf3, 0x22, 0xfe,
+	0xb3, 0xfa, 0xab, 0x0a, 0xa8, 0xcc, 0xde, 0xee, 0xf8, 0xb6, 0x79, 0xd8, 0xa5, 0xce, 0xcd, 0x03,
+	0x9b, 0x84, 0xf6, 0xa6, 0xde, 0xcb, 0xa6, 0x4b, 0xd9, 0x86, 0x5f, 0x8a, 0xa1, 0x20, 0xb3, 0xce,
+	0xc2, 0xaa, 0x14, 0x87, 0xb0, 0x2c, 0xff, 0xa9, 0xc0, 0xd2, 0x7d, 0xec, 0x3f, 0xec, 0xf8, 0xc6,
+	0x5e, 0x13, 0xef, 0xf8, 0x86, 0x8f, 0x35, 0x19, 0x5a, 0xa5, 0xc7, 0xf1, 0xf8, 0x29, 0x40, 0x12,
+	0x7f, 0x63, 0x64, 0x20, 0x7f, 0x63, 0x3e, 0xa5, 0x61, 0xe8, 0x2a, 0x2c, 0xe1, 0x97, 0x6d, 0xc6,
+	0x40, 0xdd, 0xc1, 0x2f, 0x7d, 0x1d, 0x1f, 0xd1, 0x08, 0xc1, 0xb6, 0x98, 0x2b, 0x53, 0xd2, 0x4e,
+	0x07, 0xb3, 0x9f, 0xe3, 0x97, 0xfe, 0x5d, 0x3a, 0x57, 0xb7, 0xd0, 0xbb, 0xb0, 0x60, 0x76, 0x3c,
+	0x16, 0x4a, 0xec, 0x79, 0x86, 0x63, 0x1e, 0xe8, 0xbe, 0x7b, 0xc8, 0xb4, 0x47, 0xd9, 0x9c, 0xd6,
+	0x90, 0x98, 0xbb, 0xc3, 0xa6, 0x76, 0xe9, 0x4c, 0xf5, 0x2f, 0x27, 0x61, 0x39, 0x75, 0x6a, 0x21,
+	0x43, 0xf2, 0x93, 0x29, 0x27, 0x3d, 0xd9, 0x3d, 0x98, 0x09, 0xd1, 0xfa, 0xdd, 0x36, 0x16, 0xbc,
+	0xda, 0xc8, 0xc5, 0xb8, 0xdb, 0x6d, 0x63, 0x6d, 0xfa, 0x38, 0xf6, 0x0b, 0x55, 0x61, 0x46, 0xc6,
+	0x98, 0x29, 0x27, 0xc6, 0x90, 0xa7, 0xb0, 0xd2, 0xf6, 0xf0, 0x91, 0xed, 0x76, 0x88, 0x4e, 0xe8,
+	0x87, 0x04, 0x5b, 0xd1, 0xfa, 0x53, 0x6c, 0xdf, 0xd5, 0x94, 0xe3, 0x5c, 0x77, 0xfc, 0xeb, 0xef,
+	0x3d, 0x35, 0x9a, 0x1d, 0xac, 0x2d, 0x05, 0xd0, 0x3b, 0x1c, 0x38, 0xc0, 0xfb, 0x0e, 0x9c, 0x66,
+	0x21, 0x04, 0xf7, 0xf9, 0x43, 0x8c, 0xa3, 0x8c, 0x82, 0x39, 0x3a, 0x75, 0x8f, 0xce, 0x04, 0xcb,
+	0x6f, 0xc1, 0x24, 0x0b, 0x07, 0x68, 0xf0, 0xce, 0x82, 0xa2, 0xa9, 0x2b, 0x67, 0xe5, 0x3e, 0x41,
+	0x20, 0x95, 0x13, 0xbe, 0xf8, 0x0b, 0xdd, 0x87, 0x39, 0xc2, 0x24, 0x56, 0x8f, 0x50, 0x8c, 0x17,
+	0x41, 0x51, 0x26, 0x09, 0x41, 0x47, 0xef, 0xc1, 0x92, 0xd9, 0xb4, 0x29, 0xa5, 0x4d, 0x7b, 0xcf,
+	0x33, 0xbc, 0xae, 0x7e, 0x84, 0x3d, 0x66, 0x01, 0x27, 0x98, 0x48, 0x2f, 0xf0, 0xd9, 0x07, 0x7c,
+	0xf2, 0x29, 0x9f, 0x8b, 0x41, 0x35, 0xb0, 0xe1, 0x77, 0x3c, 0x1c, 0x42, 0x4d, 0xc6, 0xa1, 0xee,
+	0xf1, 0xc9, 0x00, 0xea, 0x1c, 0x4c, 0x09, 0x28, 0xbb, 0xd5, 0x6e, 0xb2, 0x10, 0x65, 0x52, 0x03,
+	0x3e, 0x54, 0x6f, 0xb5, 0x9b, 0x88, 0xc0, 0xc5, 0xde, 0x53, 0xe9, 0xc4, 0x3c, 0xc0, 0x56, 0xa7,
+	0x89, 0x75, 0xdf, 0xe5, 0x97, 0xc5, 0xe2, 0x1b, 0xb7, 0xe3, 0x57, 0xa6, 0xfa, 0x85, 0x4f, 0xe7,
+	0x93, 0x67, 0xdd, 0x11, 0x98, 0x76, 0x5d, 0x76, 0x6f, 0xbb, 0x1c, 0x0d, 0xf5, 0xdd, 0xf9, 0x55,
+	0x11, 0xdf, 0x8d, 0x1d, 0x64, 0x9a, 0x85, 0xc5, 0xf3, 0x6c, 0x6a, 0xc7, 0x77, 0xa3, 0x53, 0x64,
+	0xa9, 0xd3, 0x4c, 0x96, 0x3a, 0xa1, 0x07, 0x50, 0x0e, 0x65, 0x9b, 0x50, 0x65, 0xaa, 0x94, 0x59,
+	0x08, 0x7c, 0x21, 0x79, 0x55, 0x3c, 0x2f, 0x11, 0x97, 0x6f, 0xae, 0x79, 0x33, 0xc7, 0xf1, 0x9f,
+	0xc8, 0x84, 0x85, 0x10, 0x9b, 0xd9, 0x74, 0x09, 0x16, 0x38, 0x67, 0x19, 0xce, 0xcb, 0x05, 0x1d,
+	0x06, 0x0a, 0x48, 0xf1, 0x75, 0x88, 0x16, 0xea, 0x73, 0x38, 0x48, 0xb5, 0x7c, 0x5e, 0x30, 0x42,
+	0xe7, 0x89, 0x19, 0xfa, 0x15, 0x9f, 0x93, 0x7d, 0x13, 0x23, 0xaa, 0x05, 0x83, 0x3e, 0x0d, 0xd6,
+	0x6b, 0x73, 0x47, 0x3d, 0x23, 0xe8, 0x43, 0x58, 0xb5, 0x89, 0xce, 0xaf, 0x25, 0x76, 0xc7, 0xd8,
+	0xa1, 0x76, 0xc6, 0xaa, 0xcc, 0xb3, 0x78, 0x69, 0xd9, 0x26, 0x49, 0x6b, 0x7c, 0x97, 0x4f, 0xa3,
+	0x15, 0x98, 0xd8, 0xeb, 0xd0, 0x28, 0xcb, 0xb6, 0x2a, 0x88, 0x09, 0xcf, 0x38, 0xfb, 0x5d, 0xb7,
+	0xaa, 0xff, 0xa5, 0xc0, 0xf2, 0x63, 0xb7, 0xd9, 0xfc, 0x09, 0x33, 0xd4, 0x3f, 0x98, 0x80, 0x4a,
+	0xfa, 0xd8, 0xdf, 0x58, 0xea, 0x6f, 0x2c, 0xf5, 0xd7, 0xd1, 0x52, 0x67, 0xe9, 0xc7, 0x74, 0xa6,
+	0xe5, 0x95, 0x9a, 0xb1, 0x99, 0x13, 0x9b, 0xb1, 0xff, 0x7f, 0x06, 0xbd, 0xfa, 0x77, 0x23, 0xb0,
+	0xae, 0x61, 0xd3, 0xf5, 0xac, 0x78, 0x3a, 0x51, 0xa8, 0xc5, 0xab, 0xb4, 0x94, 0xe7, 0x60, 0x2a,
+	0x14, 0x9c, 0xd0, 0x08, 0x40, 0x30, 0x54, 0xb7, 0xd0, 0x32, 0x8c, 0x33, 0x19, 0x13, 0x1a, 0x5f,
+	0xd2, 0xc6, 0xe8, 0xcf, 0xba, 0x85, 0xce, 0x02, 0x08, 0x17, 0x3f, 0xd0, 0xdd, 0x49, 0x6d, 0x52,
+	0x8c, 0xd4, 0x2d, 0xa4, 0xc1, 0x74, 0xdb, 0x6d, 0x36, 0x75, 0x31, 0x52, 0x19, 0xcb, 0x09, 0x23,
+	0xa8, 0x0d, 0xbd, 0xe7, 0x7a, 0x71, 0xd6, 0x04, 0x61, 0xc4, 0x14, 0x45, 0x22, 0x7e, 0x54, 0xff,
+	0x65, 0x1c, 0x36, 0x72, 0xb8, 0x28, 0x0c, 0x6f, 0xca, 0x42, 0x2a, 0xc3, 0x59, 0xc8, 0x5c, 0xeb,
+	0x37, 0x32, 0xbc, 0xf5, 0x7b, 0x1b, 0x50, 0xc0, 0x5f, 0xab, 0xd7, 0xfc, 0xce, 0x85, 0x33, 0xc1,
+	0xea, 0x4d, 0x6a, 0xc0, 0x24, 0xa6, 0xb7, 0xa4, 0x95, 0xc5, 0x78, 0xb0, 0x32, 0x65, 0xd1, 0x47,
+	0xd3, 0x16, 0x3d, 0x56, 0x78, 0x18, 0x4b, 0x16, 0x1e, 0x6e, 0x42, 0x45, 0x98, 0x94, 0x28, 0x37,
+	0x11, 0x38, 0x06, 0xe3, 0xcc, 0x31, 0x58, 0xe2, 0xf3, 0xa1, 0xec, 0x04, 0x7e, 0x81, 0x06, 0x33,
+	0x61, 0x82, 0x9d, 0x65, 0x33, 0x78, 0xc6, 0xfe, 0x9d, 0x2c, 0x6d, 0xdc, 0xf5, 0x0c, 0x87, 0x50,
+	0x53, 0x96, 0x88, 0xe0, 0xa7, 0xad, 0xd8, 0x2f, 0xf4, 0x1c, 0xce, 0x48, 0x72, 0x25, 0x91, 0x09,
+	0x9f, 0x2c, 0x62, 0xc2, 0x57, 0x52, 0xe2, 0x1e, 0x4c, 0x65, 0x79, 0x9d, 0x90, 0xe5, 0x75, 0x6e,
+	0xc0, 0x74, 0xc2, 0xe6, 0x4d, 0x31, 0x9b, 0x37, 0xb5, 0x17, 0x33, 0x76, 0xb7, 0xa1, 0x1c, 0x5d,
+	0x2b, 0x2b, 0x02, 0x4c, 0xf7, 0x2d, 0x02, 0xcc, 0x84, 0x10, 0x74, 0x0c, 0x7d, 0x04, 0xd3, 0xc1,
+	0x5d, 0x33, 0x04, 0x33, 0x7d, 0x11, 0x4c, 0x89, 0xf5, 0x0c, 0xdc, 0x80, 0x71, 0x1a, 0xe4, 0x53,
+	0x23, 0x5b, 0x66, 0xa9, 0x99, 0xfb, 0xb5, 0x8c, 0x8a, 0x6c, 0xad, 0xaf, 0x16, 0xb1, 0xec, 0x81,
+	0x8d, 0xc9, 0x5d, 0xc7, 0xf7, 0xba, 0x5a, 0x80, 0x57, 0x7d, 0x0e, 0xd3, 0xf1, 0x09, 0x34, 0x07,
+	0xa5, 0x43, 0xdc, 0x15, 0xc6, 0x8a, 0xfe, 0x89, 0x6e, 0xc2, 0xe8, 0x11, 0x15, 0xff, 0xdc, 0xd4,
+	0x44, 0xa0, 0x75, 0x3c, 0x45, 0xc1, 0x01, 0x6e, 0x8d, 0xdc, 0x54, 0x62, 0x76, 0x32, 0x48, 0x48,
+	0x7d, 0x63, 0x27, 0x53, 0x76, 0x32, 0xce, 0x1a, 0xa9, 0x9d, 0xfc, 0x51, 0x29, 0xb0, 0x93, 0x52,
+	0x2e, 0x0a, 0x3b, 0xf9, 0x19, 0xcc, 0xf6, 0xd8, 0xa1, 0x5c, 0x4b, 0xc9, 0xbf, 0xbf, 0x5d, 0x66,
+	0x49, 0xb4, 0x72, 0xd2, 0x4e, 0xa5, 0x24, 0x77, 0x64, 0x30, 0xc9, 0x8d, 0x99, 0xa5, 0x52, 0xd2,
+	0x2c, 0x3d, 0x87, 0xb5, 0xa4, 0x56, 0xe9, 0x6e, 0x43, 0xf7, 0x0f, 0x6c, 0xa2, 0xc7, 0x0b, 0xa8,
+	0x7d, 0x4a, 0x6d, 0x09, 0x2d, 0x7b, 0xd4, 0xd8, 0x3d, 0xb0, 0xc9, 0x6d, 0x81, 0xbf, 0x0e, 0xf3,
+	0x07, 0xd8, 0xf0, 0xfc, 0x3d, 0x6c, 0xf8, 0xba, 0x85, 0x7d, 0xc3, 0x6e, 0x92, 0xca, 0x68, 0x81,
+	0xc4, 0xdc, 0x5c, 0x08, 0xb6, 0xcd, 0xa1, 0xd2, 0xdf, 0x9d, 0xb1, 0xe1, 0xbe, 0x3b, 0x6f, 0xc0,
+	0x6c, 0x88, 0x87, 0x8b, 0x35, 0x33, 0xc0, 0x93, 0x5a, 0xe8, 0xf5, 0x6c, 0xb3, 0xd1, 0xea, 0x1f,
+	0x2a, 0xf0, 0x1a, 0xbf, 0xcd, 0x84, 0x26, 0x8b, 0x3a, 0x68, 0xa4, 0x2f, 0x5a, 0x6f, 0x32, 0xef,
+	0x66, 0x56, 0x32, 0xaf, 0x1f, 0xaa, 0x82, 0x59, 0xbd, 0xbf, 0x2a, 0xc1, 0xf9, 0x7c, 0x6c, 0x42,
+	0x04, 0x71, 0xf4, 0x71, 0xf3, 0xc4, 0x98, 0xbc, 0xbe, 0x31, 0x88, 0xe9, 0xd2, 0x66, 0x49, 0x8f,
+	0xa4, 0x7f, 0x5f, 0x81, 0xb5, 0x28, 0x1d, 0x4e, 0x1d, 0x64, 0xcb, 0x26, 0x6d, 0xc3, 0x37, 0x0f,
+	0xf4, 0xa6, 0x6b, 0x1a, 0xcd, 0x66, 0xb7, 0x32, 0xc2, 0x0c, 0xe6, 0xf3, 0x9c, 0x5d, 0xfb, 0x1f,
+	0xa7, 0x16, 0xe5, 0xcb, 0x77, 0xdd, 0x6d, 0xb1, 0xc3, 0x03, 0xbe, 0x01, 0xb7, 0xa3, 0xab, 0x46,
+	0xf6, 0x0a, 0xf5, 0x17, 0x61, 0xbd, 0x1f, 0x02, 0x89, 0xbd, 0xdd, 0x4e, 0xda, 0x5b, 0x79, 0x36,
+	0x3e, 0x30, 0x03, 0x0c, 0x57, 0x80, 0x98, 0x7d, 0x76, 0x63, 0xb6, 0x97, 0x96, 0x71, 0x24, 0xc7,
+	0xa4, 0x15, 0x7a, 0x6c, 0x0d, 0x58, 0xc6, 0xe9, 0x87, 0xa7, 0xa0, 0x20, 0xbd, 0x06, 0x1b, 0x39,
+	0x98, 0x44, 0x92, 0xf8, 0xf7, 0x15, 0xa8, 0xa6, 0xad, 0xdd, 0xa7, 0x81, 0x7a, 0x06, 0x94, 0x3f,
+	0xe9, 0xa5, 0xfc, 0x46, 0x06, 0xe5, 0xfd, 0x30, 0x15, 0xa4, 0xfd, 0x31, 0xbc, 0x96, 0x8b, 0x4b,
+	0xc8, 0xe6, 0x9b, 0x30, 0x67, 0x1a, 0x8e, 0x89, 0xc3, 0x2f, 0x00, 0xe6, 0xdf, 0xb4, 0x09, 0x6d,
+	0x96, 0x8f, 0x6b, 0xc1, 0x70, 0x5c, 0xdf, 0xe3, 0x38, 0x4f, 0xa8, 0xef, 0x79, 0xa8, 0x0a, 0x1e,
+	0xf5, 0x75, 0x38, 0x9f, 0x8f, 0x2c, 0x56, 0x28, 0x94, 0x2c, 0x3c, 0x89, 0x84, 0x65, 0xe2, 0x19,
+	0x58, 0xc2, 0x64, 0x98, 0x12, 0x12, 0x96, 0x3e, 0x20, 0xbb, 0x1f, 0x6c, 0x0d, 0x2c, 0x61, 0xfd,
+	0x30, 0x15, 0xa4, 0xfd, 0x02, 0xbc, 0x96, 0x8b, 0x4b, 0x50, 0xff, 0xd7, 0x0a, 0x9c, 0xd3, 0x70,
+	0xcb, 0x3d, 0xc2, 0xbc, 0x61, 0xe0, 0xab, 0x92, 0xa4, 0x4b, 0x3a, 0x46, 0xa5, 0x1e, 0xc7, 0xa8,
+	0x5a, 0x85, 0xf5, 0x6c, 0xaa, 0xc5, 0xd1, 0xfe, 0x66, 0x04, 0x2e, 0x88, 0x23, 0xf0, 0x63, 0x67,
+	0x96, 0x9f, 0x73, 0x0f, 0x68, 0x40, 0x39, 0xa9, 0x83, 0x95, 0x11, 0xd9, 0x47, 0x28, 0xbc, 0xbf,
+	0x02, 0x1b, 0x6a, 0x33, 0x09, 0xed, 0xa5, 0xc5, 0xdf, 0xb0, 0x15, 0x46, 0xda, 0x51, 0x76, 0x31,
+	0xa3, 0xa0, 0xcf, 0x61, 0x7a, 0x8a, 0xbf, 0x58, 0x36, 0x3c, 0x70, 0x1b, 0xcc, 0x26, 0xbc, 0xde,
+	0xef, 0x2c, 0x82, 0xcf, 0x7f, 0xab, 0xc0, 0x6a, 0x90, 0x15, 0x92, 0x44, 0xe9, 0xaf, 0x44, 0x7c,
+	0x2e, 0xc2, 0xbc, 0x4d, 0xf4, 0x64, 0x83, 0x17, 0xe3, 0xe5, 0x84, 0x36, 0x6b, 0x93, 0x7b, 0xf1,
+	0xd6, 0xad, 0xea, 0x1a, 0x9c, 0x91, 0x93, 0x2f, 0xce, 0xf7, 0xa3, 0x11, 0x38, 0xcf, 0x8d, 0x75,
+	0xb2, 0x60, 0x9d, 0x32, 0xad, 0xaf, 0xe2, 0xa0, 0x1b, 0x30, 0x2d, 0xba, 0xf7, 0xb0, 0x15, 0x4b,
+	0xd4, 0x86, 0x63, 0x75, 0x0b, 0x3d, 0x83, 0xd3, 0x66, 0x40, 0x6a, 0x6c, 0xeb, 0x53, 0x03, 0x6d,
+	0x8d, 0x42, 0x14, 0xd1, 0xde, 0x0f, 0x60, 0x2e, 0xd6, 0x91, 0xc7, 0x83, 0x84, 0xd1, 0xa2, 0x41,
+	0xc2, 0x6c, 0x04, 0xca, 0x06, 0xaa, 0x6f, 0xc0, 0x85, 0x3e, 0x5c, 0x16, 0xf7, 0xf1, 0xef, 0x23,
+	0x50, 0xd1, 0x44, 0x1f, 0x29, 0x66, 0xb0, 0xe4, 0xe9, 0x95, 0x57, 0x79, 0x07, 0xcf, 0x61, 0x31,
+	0x99, 0xc9, 0xec, 0xea, 0xb6, 0x8f, 0x5b, 0x41, 0x6b, 0xc5, 0xc5, 0x42, 0xd9, 0xcc, 0x6e, 0xdd,
+	0xc7, 0x2d, 0xed, 0xf4, 0x51, 0x6a, 0x8c, 0xa0, 0x6b, 0x30, 0xc6, 0x98, 0x4b, 0x2a, 0xa7, 0x72,
+	0x32, 0x1b, 0xdb, 0x86, 0x6f, 0xdc, 0x69, 0xba, 0x7b, 0x9a, 0x58, 0x8c, 0xb6, 0xa0, 0x4c, 0x9b,
+	0x3b, 0x69, 0x5b, 0x94, 0x00, 0x1f, 0x2d, 0x02, 0x3e, 0xed, 0xe0, 0x63, 0xad, 0xc3, 0x2f, 0x85,
+	0x54, 0x57, 0x61, 0x45, 0xc2, 0x6b, 0x71, 0x13, 0xdf, 0x51, 0x60, 0x69, 0xa7, 0xeb, 0x98, 0x3b,
+	0x07, 0x86, 0x67, 0x89, 0x04, 0xa7, 0xb8, 0x87, 0x0b, 0x50, 0x26, 0x6e, 0xc7, 0x33, 0xb1, 0x2e,
+	0x5a, 0x8c, 0xc5, 0x65, 0xcc, 0xf0, 0xd1, 0x2d, 0x3e, 0x48, 0xcb, 0x46, 0x94, 0x1f, 0x56, 0xf0,
+	0x05, 0x1b, 0xd5, 0xc6, 0xd9, 0xef, 0xba, 0x85, 0x6a, 0x70, 0x8a, 0x45, 0x8b, 0xa5, 0xbe, 0x21,
+	0x1c, 0x5b, 0x57, 0x5d, 0x81, 0xe5, 0x14, 0x2d, 0x82, 0xce, 0x7f, 0x18, 0x85, 0xd3, 0x74, 0x2e,
+	0xf8, 0x12, 0xbe, 0x4a, 0x61, 0xa9, 0xc0, 0x78, 0x90, 0x50, 0xe2, 0xba, 0x1a, 0xfc, 0xa4, 0xaa,
+	0x1c, 0x45, 0xb3, 0x61, 0xa6, 0x20, 0xcc, 0x2c, 0x50, 0x9e, 0xa4, 0xd3, 0x48, 0xa3, 0x83, 0xa6,
+	0x91, 0xce, 0x02, 0x04, 0x51, 0x95, 0x6d, 0xb1, 0x28, 0xb4, 0xa4, 0x4d, 0x8a, 0x91, 0xba, 0x95,
+	0x8a, 0xd5, 0xc7, 0x07, 0x8b, 0xd5, 0x3f, 0x13, 0xc5, 0x9b, 0x28, 0x6c, 0x66, 0x58, 0x26, 0xfa,
+	0x62, 0x99, 0xa7, 0x60, 0xa1, 0x03, 0xcc, 0x70, 0x5d, 0x87, 0xf1, 0x20, 0xe6, 0x9e, 0x2c, 0x10,
+	0x73, 0x07, 0x8b, 0xe3, 0xf9, 0x02, 0x48, 0xe6, 0x0b, 0x3e, 0x86, 0x69, 0x5e, 0x5a, 0x12, 0xdd,
+	0xc8, 0x53, 0x05, 0xba, 0x91, 0xa7, 0x58, 0xc5, 0x89, 0xff, 0xa0, 0x55, 0x0e, 0x86, 0x80, 0xf7,
+	0xd6, 0xeb, 0xb6, 0x85, 0x1d, 0xdf, 0xf6, 0xbb, 0x2c, 0x99, 0x37, 0xa9, 0x21, 0x3a, 0xf7, 0x8c,
+	0x4d, 0xd5, 0xc5, 0x0c, 0x7a, 0x04, 0xb3, 0x3d, 0xb6, 0xa1, 0x32, 0x23, 0x13, 0xa1, 0x2c, 0xab,
+	0xa0, 0x95, 0x93, 0x16, 0xa1, 0xba, 0x04, 0x0b, 0x49, 0x51, 0x16, 0x32, 0xfe, 0xbb, 0x0a, 0xac,
+	0x06, 0x4d, 0x6d, 0x5f, 0x11, 0x27, 0xae, 0xfa, 0x5b, 0x0a, 0x9c, 0x91, 0xd3, 0x24, 0xe2, 0x9b,
+	0xab, 0xb0, 0xd4, 0xe2, 0xe3, 0xbc, 0xae, 0xa2, 0xdb, 0x8e, 0x6e, 0x1a, 0xe6, 0x01, 0x16, 0x14,
+	0x9e, 0x6e, 0xc5, 0xa0, 0xea, 0xce, 0x16, 0x9d, 0x42, 0xef, 0xc3, 0x4a, 0x0a, 0xc8, 0x32, 0x7c,
+	0x63, 0xcf, 0x20, 0x58, 0xb8, 0xc1, 0x4b, 0x49, 0xb8, 0x6d, 0x31, 0x5b, 0x3d, 0x03, 0x6a, 0x40,
+	0x8f, 0xe0, 0xe7, 0xa7, 0x6e, 0xd8, 0x95, 0x54, 0xfd, 0xf1, 0x08, 0xac, 0x4a, 0xa7, 0x05, 0xb5,
+	0x9b, 0x30, 0xe7, 0x74, 0x5a, 0x7b, 0xd8, 0xa3, 0x69, 0x26, 0x66, 0xa6, 0x08, 0xa3, 0x73, 0x54,
+	0x2b, 0xf3, 0xf1, 0x47, 0x0d, 0x66, 0x7d, 0x08, 0x65, 0x76, 0x60, 0xd6, 0x08, 0xcb, 0x1e, 0x8c,
+	0x6a, 0x13, 0xc2, 0xae, 0x11, 0xf4, 0x19, 0x4c, 0x8b, 0x9b, 0xe0, 0x47, 0xe5, 0x06, 0xee, 0x8d,
+	0x2c, 0x79, 0xe0, 0xf9, 0x1c, 0x76, 0x74, 0xe6, 0xdf, 0x4d, 0x59, 0xd1, 0x00, 0xba, 0x0e, 0xcb,
+	0x7c, 0x23, 0xd3, 0x75, 0x7c, 0xcf, 0x6d, 0x36, 0xb1, 0xc7, 0x98, 0xd2, 0xe1, 0xdf, 0x8a, 0x49,
+	0x6d, 0x91, 0x4d, 0x6f, 0x85, 0xb3, 0xdc, 0x32, 0x32, 0x1d, 0xb1, 0x2c, 0x0f, 0x13, 0x22, 0x92,
+	0x8e, 0xc1, 0x4f, 0x74, 0x0c, 0x67, 0xda, 0x54, 0xe2, 0x88, 0x4f, 0xe9, 0xd0, 0x4d, 0xdb, 0x33,
+	0x3b, 0x36, 0x2d, 0xea, 0x61, 0xe3, 0x10, 0x7b, 0xa4, 0x32, 0xb6, 0x5e, 0x4a, 0x87, 0x5d, 0x11,
+	0xb5, 0x8f, 0x23, 0xd8, 0x2d, 0x0e, 0x7a, 0x87, 0x43, 0x32, 0xda, 0xd5, 0x76, 0xd6, 0x34, 0xa9,
+	0xd6, 0x60, 0x9e, 0xd7, 0xc4, 0x28, 0xc1, 0x81, 0xd4, 0xc6, 0xbf, 0x0f, 0x4a, 0xe2, 0xfb, 0x50,
+	0x5d, 0x00, 0x14, 0x5f, 0x2f, 0xd4, 0xe0, 0x1f, 0x15, 0x98, 0xe7, 0x91, 0x41, 0xdc, 0x05, 0xcd,
+	0x46, 0x83, 0x3e, 0x12, 0xf5, 0xe3, 0xb0, 0x5c, 0x5e, 0xbe, 0xb2, 0x9e, 0x59, 0x9c, 0x30, 0xc8,
+	0x21, 0xcb, 0xc9, 0x4d, 0xf8, 0xe2, 0xaf, 0x78, 0x66, 0xb7, 0x94, 0xc8, 0xec, 0x6e, 0xc1, 0xec,
+	0x91, 0x4d, 0xec, 0x3d, 0xbb, 0x69, 0xfb, 0x5d, 0x6e, 0x05, 0xfb, 0x27, 0x23, 0xcb, 0x11, 0x08,
+	0x1d, 0xa4, 0x67, 0x8c, 0x1f, 0x26, 0x8a, 0x94, 0xe7, 0x35, 0x4c, 0xb0, 0xff, 0xa4, 0x83, 0x3b,
+	0xb8, 0xc0, 0x19, 0x37, 0x60, 0x5a, 0x7c, 0x85, 0x75, 0xc7, 0x68, 0x05, 0x4a, 0x32, 0x25, 0xc6,
+	0x3e, 0x37, 0x5a, 0x38, 0xc9, 0x86, 0xd2, 0xa0, 0x6c, 0xe0, 0x84, 0x46, 0x14, 0x09, 0x42, 0x7f,
+	0x4f, 0x81, 0x85, 0x40, 0xa1, 0xbe, 0x3a, 0xb4, 0x3e, 0x82, 0xc5, 0x1e, 0xa2, 0x84, 0x7e, 0x5f,
+	0x87, 0xe5, 0xb6, 0xe7, 0x9a, 0x98, 0x10, 0xda, 0x6e, 0xca, 0x9e, 0x33, 0x71, 0x0b, 0x43, 0xd5,
+	0xbc, 0x44, 0x95, 0x29, 0x9a, 0x66, 0x90, 0xcc, 0xbc, 0x10, 0xda, 0x2e, 0x79, 0xf6, 0x3e, 0xf6,
+	0xb5, 0xe8, 0x6d, 0xd3, 0x43, 0x4c, 0x88, 0xb1, 0x8f, 0x43, 0x6f, 0xe8, 0x13, 0x18, 0x63, 0xa5,
+	0x21, 0x8e, 0x28, 0xa7, 0xc0, 0x1d, 0xc3, 0xc1, 0x0a, 0x47, 0x9a, 0x80, 0x2b, 0xc0, 0x96, 0xea,
+	0x2f, 0x8f, 0xc0, 0x5a, 0x16, 0x19, 0xe2, 0x84, 0x2f, 0xa0, 0xcc, 0xf9, 0xde, 0x12, 0x33, 0x82,
+	0x9e, 0xcf, 0x32, 0x53, 0x9b, 0xf9, 0x08, 0x6b, 0x4c, 0xf9, 0x82, 0x51, 0x9e, 0xc6, 0x9c, 0x21,
+	0xf1, 0x31, 0xb5, 0x05, 0x28, 0xbd, 0x28, 0x9e, 0xaa, 0x1c, 0xe5, 0xa9, 0xca, 0xdb, 0xc9, 0x54,
+	0xe5, 0x5b, 0x05, 0x38, 0x14, 0x52, 0x13, 0xcb, 0x53, 0x3a, 0xb0, 0x7e, 0x1f, 0xfb, 0xdb, 0x0f,
+	0x9e, 0xe4, 0xdc, 0xc6, 0x67, 0x00, 0x5c, 0x67, 0x9d, 0x86, 0x1b, 0x70, 0xa0, 0xc8, 0x7e, 0x54,
+	0x96, 0x98, 0x19, 0x9b, 0xf4, 0xc5, 0x5f, 0xa4, 0xda, 0x85, 0x8d, 0x9c, 0xfd, 0x04, 0xdb, 0x77,
+	0x61, 0x3e, 0xf6, 0xf0, 0x8d, 0x55, 0x2a, 0x83, 0x7d, 0xdf, 0x28, 0xb8, 0xaf, 0x36, 0xe7, 0x25,
+	0x07, 0x48, 0xf5, 0x9f, 0x15, 0x58, 0xd0, 0xb0, 0xd1, 0x6e, 0x37, 0x79, 0x48, 0x15, 0x9e, 0x6f,
+	0x09, 0xc6, 0x44, 0x69, 0x80, 0x7f, 0x45, 0xc5, 0xaf, 0xfc, 0xe7, 0x38, 0x72, 0x17, 0xa0, 0x74,
+	0x52, 0x77, 0x77, 0xb8, 0xd8, 0xa5, 0xba, 0x0c, 0x8b, 0x3d, 0x47, 0x13, 0x26, 0xe5, 0xcf, 0x15,
+	0xda, 0x14, 0xdc, 0xf0, 0x30, 0x39, 0x08, 0xab, 0x24, 0x94, 0x1b, 0x5f, 0xc1, 0xb3, 0xd3, 0xc4,
+	0x82, 0x9c, 0x54, 0x71, 0x96, 0x1f, 0x8e, 0xc0, 0x92, 0x86, 0x0d, 0x6b, 0xfb, 0xc1, 0x93, 0x5e,
+	0x11, 0xbd, 0x0a, 0xa7, 0xc2, 0xee, 0x84, 0xf2, 0x95, 0x73, 0x99, 0xbe, 0xc1, 0x83, 0x27, 0xcc,
+	0xb8, 0xb1, 0xc5, 0x79, 0xc1, 0x54, 0x3a, 0x1c, 0x2b, 0xc9, 0xc2, 0xb1, 0x5d, 0xa8, 0xd8, 0x0e,
+	0x5d, 0x61, 0x1f, 0x61, 0x1d, 0x3b, 0xa1, 0x9d, 0x28, 0xd8, 0xd2, 0xb5, 0x18, 0x02, 0xdf, 0x75,
+	0x02, 0x85, 0xaf, 0x5b, 0x94, 0xf7, 0x6d, 0x8a, 0x84, 0xd8, 0x5f, 0xf0, 0x80, 0x65, 0x54, 0x9b,
+	0xa0, 0x03, 0x3b, 0xf6, 0x17, 0x18, 0xbd, 0x0e, 0xb3, 0xac, 0x31, 0x81, 0xad, 0xe0, 0xf5, 0xf3,
+	0x31, 0x56, 0x3f, 0x67, 0xfd, 0x0a, 0x8f, 0x8d, 0x7d, 0x2c, 0xfa, 0x9e, 0x47, 0x60, 0x39, 0xc5,
+	0xac, 0xd0, 0x8d, 0x1c, 0x82, 0x5b, 0x52, 0xa5, 0x1c, 0x39, 0xa1, 0x52, 0x22, 0x03, 0x96, 0x52,
+	0x58, 0x83, 0x4c, 0xde, 0xc0, 0x76, 0x66, 0xa1, 0x17, 0x3d, 0x1d, 0x95, 0x71, 0xec, 0x94, 0x8c,
+	0x63, 0xff, 0x46, 0xfb, 0x2e, 0x3b, 0xde, 0x3e, 0xfe, 0x9a, 0xcb, 0x57, 0x55, 0x85, 0x4a, 0xfa,
+	0x9c, 0x42, 0xc7, 0xfe, 0x62, 0x04, 0x96, 0x1f, 0xe2, 0xaf, 0x3f, 0x13, 0xfe, 0x6f, 0x94, 0xec,
+	0x0e, 0x54, 0x1e, 0x62, 0x39, 0x27, 0x65, 0x38, 0x14, 0x19, 0x8e, 0x5f, 0x52, 0xe0, 0xcc, 0xe7,
+	0xae, 0x6f, 0x37, 0xba, 0x34, 0x6a, 0x76, 0x8f, 0xb0, 0xf7, 0xd0, 0xa0, 0x21, 0x71, 0xc8, 0x76,
+	0x03, 0x96, 0x1a, 0x62, 0x46, 0x6f, 0xb1, 0x29, 0x3d, 0xe1, 0x1c, 0x65, 0xaa, 0x48, 0x12, 0x1f,
+	0xdb, 0x4d, 0x5b, 0x68, 0xa4, 0x07, 0x49, 0xf5, 0x1c, 0x9c, 0xcd, 0x20, 0x41, 0x88, 0x85, 0x01,
+	0xab, 0xf7, 0xb1, 0xbf, 0xe5, 0xb9, 0x84, 0x88, 0x6b, 0x49, 0x7c, 0x45, 0x12, 0xf1, 0x9b, 0xd2,
+	0x13, 0xbf, 0x5d, 0x80, 0xb2, 0x6f, 0x78, 0xfb, 0xd8, 0x0f, 0xaf, 0x99, 0x7f, 0x4f, 0x66, 0xf8,
+	0xa8, 0xc0, 0x57, 0xfd, 0xef, 0x12, 0x9c, 0x91, 0xef, 0x21, 0x18, 0xda, 0x82, 0x32, 0x37, 0x0f,
+	0x7b, 0x5d, 0x1e, 0x4d, 0x56, 0x94, 0x3e, 0x8d, 0x39, 0x79, 0xe8, 0x98, 0xa7, 0x4b, 0xee, 0x74,
+	0x99, 0xb3, 0xc5, 0x3d, 0xb1, 0x69, 0x3f, 0x36, 0x84, 0xbe, 0xad, 0xc0, 0x62, 0x83, 0x95, 0xae,
+	0x74, 0xd3, 0xe8, 0x10, 0x1c, 0x6d, 0xcb, 0x8d, 0xde, 0xc3, 0xe1, 0xb6, 0xe5, 0xd5, 0xb0, 0x2d,
+	0x8a, 0x31, 0xb1, 0x39, 0x6a, 0xa4, 0x26, 0xd4, 0x17, 0x30, 0x9f, 0xa2, 0x52, 0xe2, 0x0a, 0xde,
+	0x4b, 0xba, 0x82, 0xef, 0x66, 0xc9, 0x43, 0x2f, 0x51, 0xe2, 0xf6, 0xe2, 0xfe, 0xa0, 0xfa, 0x02,
+	0x96, 0x33, 0x28, 0x94, 0x6c, 0xfc, 0x49, 0x7c, 0xe3, 0x72, 0x76, 0xe2, 0xf6, 0x3e, 0xf6, 0xa3,
+	0x42, 0x20, 0x43, 0x1c, 0x77, 0x41, 0xff, 0x43, 0x81, 0x4d, 0x51, 0x7a, 0x4b, 0xb1, 0x2d, 0x55,
+	0x33, 0xc8, 0x89, 0x84, 0x8a, 0xc9, 0x19, 0x7a, 0xc6, 0xc5, 0x28, 0xec, 0x91, 0x08, 0xd2, 0xce,
+	0x03, 0xb0, 0x8d, 0x03, 0x52, 0xc4, 0xd1, 0x2f, 0x82, 0xce, 0xc3, 0x4c, 0x03, 0xfb, 0xe6, 0xc1,
+	0xe7, 0x98, 0xfb, 0x2d, 0xa2, 0x56, 0x94, 0x1c, 0xac, 0x12, 0x78, 0xb3, 0xc0, 0x61, 0xc3, 0xee,
+	0xcb, 0xd1, 0xc0, 0xf9, 0x1d, 0xf2, 0x66, 0x19, 0xf8, 0x95, 0x1f, 0xbf, 0x0d, 0x20, 0x32, 0x34,
+	0xb7, 0x1f, 0xd7, 0xd1, 0x6f, 0xd0, 0x3c, 0xb4, 0xf4, 0x95, 0x33, 0xba, 0x9e, 0x29, 0xd5, 0xb9,
+	0xef, 0xac, 0xd5, 0x1b, 0x03, 0xc3, 0x89, 0x23, 0xfe, 0xa6, 0x02, 0xcb, 0x19, 0xef, 0xc7, 0x51,
+	0x0e, 0xd2, 0xdc, 0x17, 0xf5, 0xea, 0xcd, 0xc1, 0x01, 0x05, 0x39, 0x3f, 0x50, 0x60, 0xbd, 0xdf,
+	0x53, 0x70, 0xf4, 0x49, 0x3f, 0xf4, 0xfd, 0x9e, 0xa5, 0xab, 0xb7, 0x4f, 0x80, 0x41, 0x50, 0x4a,
+	0x2f, 0x51, 0xfe, 0xc8, 0x3b, 0xe7, 0x12, 0x73, 0x1f, 0x97, 0xab, 0x37, 0x06, 0x86, 0x13, 0xb4,
+	0xfc, 0x81, 0x02, 0x6a, 0xf6, 0x13, 0x6d, 0x94, 0xdd, 0x7f, 0xd4, 0xf7, 0x89, 0xb8, 0xfa, 0xc1,
+	0x50, 0xb0, 0x82, 0xae, 0x3f, 0x55, 0x60, 0x2d, 0xff, 0xdd, 0x35, 0xfa, 0x56, 0x26, 0xfe, 0x42,
+	0xef, 0xbf, 0xd5, 0x8f, 0x87, 0x86, 0x17, 0x34, 0x7e, 0x57, 0x81, 0x95, 0xcc, 0xd7, 0xd5, 0xe8,
+	0xfd, 0x4c, 0xf4, 0xfd, 0x1e, 0x77, 0xab, 0xb7, 0x86, 0x01, 0x15, 0x44, 0x39, 0x30, 0x93, 0x78,
+	0x76, 0x8b, 0xde, 0xc9, 0x44, 0x26, 0x7b, 0xdd, 0xab, 0xd6, 0x8a, 0x2e, 0x17, 0xfb, 0x7d, 0x5b,
+	0x81, 0xd3, 0x92, 0xb7, 0xab, 0xe8, 0x6a, 0xbe, 0x44, 0x4a, 0x5f, 0xcb, 0xaa, 0xef, 0x0d, 0x06,
+	0x24, 0x48, 0xf0, 0x61, 0xb6, 0xe7, 0x9d, 0x28, 0xba, 0x94, 0xf7, 0x89, 0x97, 0x14, 0x0d, 0xd4,
+	0x77, 0x8b, 0x03, 0x88, 0x5d, 0x8f, 0x61, 0xae, 0xf7, 0xd1, 0x13, 0xca, 0xc6, 0x92, 0xf1, 0x2c,
+	0x4c, 0xbd, 0x3c, 0x00, 0x44, 0x4c, 0xec, 0x32, 0xbb, 0xff, 0x72, 0xc4, 0xae, 0xdf, 0xc3, 0x0b,
+	0xf5, 0x04, 0xcd, 0x86, 0xe8, 0x4f, 0x14, 0x38, 0xc3, 0x7f, 0xc8, 0x9b, 0x03, 0xd1, 0x87, 0x43,
+	0xf6, 0x14, 0x72, 0xd2, 0x3e, 0x3a, 0x51, 0x47, 0xa2, 0x60, 0x59, 0x46, 0x07, 0x5d, 0x2e, 0xcb,
+	0xf2, 0xfb, 0xf7, 0xd4, 0x5b, 0xc3, 0x80, 0xa6, 0xee, 0x51, 0xd2, 0x9e, 0xdc, 0xf7, 0x1e, 0xb3,
+	0x1b, 0xc3, 0xd5, 0x5b, 0xc3, 0x80, 0xa6, 0xef, 0x51, 0xda, 0xc4, 0xd6, 0xff, 0x1e, 0xf3, 0x1a,
+	0xe9, 0xd4, 0x8f, 0x86, 0x84, 0x4e, 0xdf, 0x63, 0xba, 0x4f, 0xad, 0xff, 0x3d, 0x66, 0x76, 0xc9,
+	0xa9, 0xb7, 0x86, 0x01, 0x15, 0x44, 0xfd, 0x11, 0x4b, 0xd4, 0x65, 0x36, 0xa0, 0xa1, 0x0f, 0x06,
+	0x3a, 0x73, 0xb2, 0x05, 0x4e, 0xfd, 0x70, 0x38, 0xe0, 0x04, 0x69, 0x99, 0xdd, 0x97, 0xb9, 0xa4,
+	0xf5, 0xeb, 0xff, 0x54, 0x3f, 0x1c, 0x0e, 0x38, 0xf6, 0x81, 0xcf, 0x6f, 0xbb, 0xca, 0xf9, 0xc0,
+	0x17, 0xea, 0x3d, 0xcb, 0xf9, 0xc0, 0x17, 0xeb, 0xf7, 0x42, 0xbf, 0xad, 0x40, 0x85, 0x57, 0xa5,
+	0xd2, 0xcd, 0x77, 0xe8, 0x66, 0x0e, 0xf6, 0xdc, 0x2e, 0x43, 0xf5, 0xfd, 0x21, 0x20, 0x05, 0x45,
+	0xbf, 0xa2, 0xc0, 0x82, 0xac, 0x85, 0x0b, 0x65, 0x7f, 0x39, 0x73, 0x1a, 0xd6, 0xd4, 0x6b, 0x03,
+	0x42, 0x09, 0x2a, 0xbe, 0xc7, 0xfe, 0x63, 0x52, 0x4e, 0x07, 0x13, 0xfa, 0xa8, 0x8f, 0x6c, 0xe4,
+	0xf7, 0x97, 0xa9, 0xdf, 0x1a, 0x16, 0x5c, 0x10, 0xf8, 0x05, 0x2d, 0x1b, 0xf6, 0xf4, 0xf2, 0xa0,
+	0xcb, 0x39, 0x48, 0xe5, 0x3d, 0x56, 0xea, 0x95, 0x41, 0x40, 0x22, 0x6f, 0xa4, 0xa7, 0x3b, 0x27,
+	0xc7, 0x1b, 0x91, 0xf7, 0x14, 0xa9, 0xef, 0x16, 0x07, 0x10, 0xbb, 0x1e, 0xc2, 0x74, 0xbc, 0x59,
+	0x02, 0xbd, 0x9d, 0x8b, 0xa1, 0xa7, 0x3d, 0x48, 0x7d, 0xa7, 0xe0, 0xea, 0x98, 0x14, 0xca, 0xba,
+	0x1d, 0x72, 0xa4, 0x30, 0xa7, 0x61, 0x43, 0xbd, 0x36, 0x20, 0x54, 0xcc, 0xf3, 0x94, 0x34, 0x31,
+	0xe4, 0x78, 0x9e, 0xd9, 0x1d, 0x11, 0xea, 0x7b, 0x83, 0x01, 0x85, 0x0f, 0x37, 0x20, 0xaa, 0xcc,
+	0xa3, 0x8b, 0x99, 0x38, 0x52, 0xe5, 0x7e, 0xf5, 0xad, 0x42, 0x6b, 0xa3, 0x6d, 0xa2, 0xe2, 0x78,
+	0xce, 0x36, 0xa9, 0x76, 0x00, 0xf5, 0xad, 0x42, 0x6b, 0xe3, 0xdb, 0x04, 0xa5, 0xed, 0xdc, 0x6d,
+	0x7a, 0x2a, 0xf2, 0xea, 0x5b, 0x85, 0xd6, 0x46, 0x11, 0x4a, 0xa2, 0x2a, 0x9d, 0x13, 0xa1, 0xc8,
+	0x4a, 0xea, 0x6a, 0xad, 0xe8, 0xf2, 0x58, 0xb8, 0x2d, 0x2f, 0xee, 0xe6, 0x84, 0xdb, 0xb9, 0x55,
+	0x6e, 0xf5, 0xc6, 0xc0, 0x70, 0x31, 0x07, 0x26, 0xb3, 0x8a, 0x9a, 0xe3, 0xc0, 0xf4, 0xab, 0xf4,
+	0xaa, 0xb7, 0x86, 0x01, 0x8d, 0x2e, 0x24, 0x51, 0x82, 0xcc, 0xb9, 0x10, 0x59, 0x15, 0x56, 0xad,
+	0x15, 0x5d, 0x1e, 0x33, 0x1f, 0xb2, 0x72, 0x21, 0xca, 0x0b, 0xff, 0x32, 0x0b, 0xa1, 0xea, 0xb5,
+	0x01, 0xa1, 0x22, 0x3b, 0xdd, 0x53, 0x65, 0xcb, 0xb1, 0xd3, 0xf2, 0xe2, 0xa5, 0xfa, 0x6e, 0x71,
+	0x80, 0x58, 0xd4, 0xd8, 0x53, 0xc1, 0xc9, 0x8b, 0x1a, 0xe5, 0x45, 0x2d, 0xf5, 0xf2, 0x00, 0x10,
+	0xd1, 0xc6, 0x0f, 0x71, 0xe1, 0x8d, 0x1f, 0xe2, 0x41, 0x37, 0xce, 0xac, 0xa6, 0xfc, 0x9a, 0x02,
+	0x8b, 0xd2, 0x12, 0x05, 0xca, 0xbe, 0xb8, 0xbc, 0xaa, 0x8a, 0x7a, 0x7d, 0x50, 0xb0, 0x98, 0xd8,
+	0xc9, 0x12, 0xfc, 0x39, 0x62, 0x97, 0x53, 0x39, 0x51, 0xaf, 0x0d, 0x08, 0x25, 0xa8, 0xf8, 0xa1,
+	0x12, 0x3e, 0xb5, 0xc9, 0x4e, 0x23, 0xa3, 0xdb, 0xfd, 0xdc, 0xfe, 0xbe, 0xf9, 0x76, 0xf5, 0xce,
+	0x49, 0x50, 0x70, 0x62, 0xef, 0xdc, 0xfd, 0xfb, 0x2f, 0xd7, 0x94, 0x7f, 0xfa, 0x72, 0x4d, 0xf9,
+	0xd7, 0x2f, 0xd7, 0x94, 0x9f, 0xbd, 0xb1, 0x6f, 0xfb, 0x07, 0x9d, 0xbd, 0x9a, 0xe9, 0xb6, 0x2e,
+	0x25, 0xfe, 0x97, 0x71, 0x6d, 0x1f, 0x3b, 0xfc, 0x9f, 0x52, 0xc7, 0xfe, 0x2b, 0xf6, 0x07, 0xe2,
+	0xcf, 0xa3, 0xcb, 0x7b, 0x63, 0x6c, 0xee, 0xea, 0xff, 0x0e, 0x00, 0x20, 0x46, 0x66, 0x29, 0x41,
+	0x5b, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -5464,6 +5593,18 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RetryElapsedExpirationTime != nil {
+		{
+			size, err := m.RetryElapsedExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.FirstDecisionTaskBackoff != nil {
 		{
 			size, err := m.FirstDecisionTaskBackoff.MarshalToSizedBuffer(dAtA[:i])
@@ -5918,6 +6059,79 @@ func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *ExpediteWorkflowExecutionStartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpediteWorkflowExecutionStartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpediteWorkflowExecutionStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpediteWorkflowExecutionStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpediteWorkflowExecutionStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpediteWorkflowExecutionStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6307,6 +6521,15 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if m.IsStickyTaskListEnabled {
 		i--
 		if m.IsStickyTaskListEnabled {
@@ -8439,6 +8662,20 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PersistenceCircuitBreakers) > 0 {
+		for iNdEx := len(m.PersistenceCircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersistenceCircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -8466,21 +8703,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA81 := make([]byte, len(m.ShardIds)*10)
-		var j80 int
+		dAtA83 := make([]byte, len(m.ShardIds)*10)
+		var j82 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA81[j80] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j80++
+				j82++
 			}
-			dAtA81[j80] = uint8(num)
-			j80++
+			dAtA83[j82] = uint8(num)
+			j82++
 		}
-		i -= j80
-		copy(dAtA[i:], dAtA81[:j80])
-		i = encodeVarintService(dAtA, i, uint64(j80))
+		i -= j82
+		copy(dAtA[i:], dAtA83[:j82])
+		i = encodeVarintService(dAtA, i, uint64(j82))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9556,21 +9793,21 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA91 := make([]byte, len(m.ShardIds)*10)
-		var j90 int
+		dAtA93 := make([]byte, len(m.ShardIds)*10)
+		var j92 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j90++
+				j92++
 			}
-			dAtA91[j90] = uint8(num)
-			j90++
+			dAtA93[j92] = uint8(num)
+			j92++
 		}
-		i -= j90
-		copy(dAtA[i:], dAtA91[:j90])
-		i = encodeVarintService(dAtA, i, uint64(j90))
+		i -= j92
+		copy(dAtA[i:], dAtA93[:j92])
+		i = encodeVarintService(dAtA, i, uint64(j92))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9796,6 +10033,10 @@ func (m *StartWorkflowExecutionRequest) Size() (n int) {
 		l = m.FirstDecisionTaskBackoff.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.RetryElapsedExpirationTime != nil {
+		l = m.RetryElapsedExpirationTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9961,6 +10202,38 @@ func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
+func (m *ExpediteWorkflowExecutionStartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExpediteWorkflowExecutionStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10184,6 +10457,10 @@ func (m *GetMutableStateResponse) Size() (n int) {
 	if m.IsStickyTaskListEnabled {
 		n += 3
 	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 2 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11061,6 +11338,12 @@ func (m *DescribeHistoryHostResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.PersistenceCircuitBreakers) > 0 {
+		for _, e := range m.PersistenceCircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11858,9 +12141,45 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinuedFailure == nil {
+				m.ContinuedFailure = &v1.Failure{}
+			}
+			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11887,16 +12206,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinuedFailure == nil {
-				m.ContinuedFailure = &v1.Failure{}
+			if m.LastCompletionResult == nil {
+				m.LastCompletionResult = &v1.Payload{}
 			}
-			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstDecisionTaskBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11923,16 +12242,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionResult == nil {
-				m.LastCompletionResult = &v1.Payload{}
+			if m.FirstDecisionTaskBackoff == nil {
+				m.FirstDecisionTaskBackoff = &types.Duration{}
 			}
-			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FirstDecisionTaskBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstDecisionTaskBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryElapsedExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11959,10 +12278,10 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FirstDecisionTaskBackoff == nil {
-				m.FirstDecisionTaskBackoff = &types.Duration{}
+			if m.RetryElapsedExpirationTime == nil {
+				m.RetryElapsedExpirationTime = &types.Timestamp{}
 			}
-			if err := m.FirstDecisionTaskBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryElapsedExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12901,6 +13220,182 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExpediteWorkflowExecutionStartRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpediteWorkflowExecutionStartRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpediteWorkflowExecutionStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.ExpediteWorkflowExecutionStartRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpediteWorkflowExecutionStartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpediteWorkflowExecutionStartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpediteWorkflowExecutionStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14378,6 +14873,38 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsStickyTaskListEnabled = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20018,6 +20545,40 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceCircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersistenceCircuitBreakers = append(m.PersistenceCircuitBreakers, &v11.PersistenceCircuitBreakerInfo{})
+			if err := m.PersistenceCircuitBreakers[len(m.PersistenceCircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -32,6 +32,7 @@ import (
 	math_bits "math/bits"
 
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +274,77 @@ func (m *DomainCacheInfo) GetNumOfItemsInCacheByName() int64 {
 	return 0
 }
 
+type PersistenceCircuitBreakerInfo struct {
+	StoreName            string           `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	OperationClass       string           `protobuf:"bytes,2,opt,name=operation_class,json=operationClass,proto3" json:"operation_class,omitempty"`
+	State                string           `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	LastStateChangeTime  *types.Timestamp `protobuf:"bytes,4,opt,name=last_state_change_time,json=lastStateChangeTime,proto3" json:"last_state_change_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PersistenceCircuitBreakerInfo) Reset()         { *m = PersistenceCircuitBreakerInfo{} }
+func (m *PersistenceCircuitBreakerInfo) String() string { return proto.CompactTextString(m) }
+func (*PersistenceCircuitBreakerInfo) ProtoMessage()    {}
+func (*PersistenceCircuitBreakerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31e4c5c03631024e, []int{4}
+}
+func (m *PersistenceCircuitBreakerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersistenceCircuitBreakerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PersistenceCircuitBreakerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PersistenceCircuitBreakerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistenceCircuitBreakerInfo.Merge(m, src)
+}
+func (m *PersistenceCircuitBreakerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersistenceCircuitBreakerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistenceCircuitBreakerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistenceCircuitBreakerInfo proto.InternalMessageInfo
+
+func (m *PersistenceCircuitBreakerInfo) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *PersistenceCircuitBreakerInfo) GetOperationClass() string {
+	if m != nil {
+		return m.OperationClass
+	}
+	return ""
+}
+
+func (m *PersistenceCircuitBreakerInfo) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *PersistenceCircuitBreakerInfo) GetLastStateChangeTime() *types.Timestamp {
+	if m != nil {
+		return m.LastStateChangeTime
+	}
+	return nil
+}
+
 type PersistenceSetting struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -285,7 +357,7 @@ func (m *PersistenceSetting) Reset()         { *m = PersistenceSetting{} }
 func (m *PersistenceSetting) String() string { return proto.CompactTextString(m) }
 func (*PersistenceSetting) ProtoMessage()    {}
 func (*PersistenceSetting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{4}
+	return fileDescriptor_31e4c5c03631024e, []int{5}
 }
 func (m *PersistenceSetting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +412,7 @@ func (m *PersistenceFeature) Reset()         { *m = PersistenceFeature{} }
 func (m *PersistenceFeature) String() string { return proto.CompactTextString(m) }
 func (*PersistenceFeature) ProtoMessage()    {}
 func (*PersistenceFeature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{5}
+	return fileDescriptor_31e4c5c03631024e, []int{6}
 }
 func (m *PersistenceFeature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +468,7 @@ func (m *PersistenceInfo) Reset()         { *m = PersistenceInfo{} }
 func (m *PersistenceInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistenceInfo) ProtoMessage()    {}
 func (*PersistenceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{6}
+	return fileDescriptor_31e4c5c03631024e, []int{7}
 }
 func (m *PersistenceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +523,7 @@ func init() {
 	proto.RegisterType((*RingInfo)(nil), "uber.cadence.shared.v1.RingInfo")
 	proto.RegisterType((*MembershipInfo)(nil), "uber.cadence.shared.v1.MembershipInfo")
 	proto.RegisterType((*DomainCacheInfo)(nil), "uber.cadence.shared.v1.DomainCacheInfo")
+	proto.RegisterType((*PersistenceCircuitBreakerInfo)(nil), "uber.cadence.shared.v1.PersistenceCircuitBreakerInfo")
 	proto.RegisterType((*PersistenceSetting)(nil), "uber.cadence.shared.v1.PersistenceSetting")
 	proto.RegisterType((*PersistenceFeature)(nil), "uber.cadence.shared.v1.PersistenceFeature")
 	proto.RegisterType((*PersistenceInfo)(nil), "uber.cadence.shared.v1.PersistenceInfo")
@@ -461,39 +534,46 @@ func init() {
 }
 
 var fileDescriptor_31e4c5c03631024e = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x8a, 0x13, 0x4d,
-	0x10, 0x65, 0x76, 0xbe, 0x7c, 0x49, 0x2a, 0x8b, 0xbb, 0x36, 0xfe, 0x04, 0xc5, 0x10, 0x07, 0x91,
-	0xa0, 0x30, 0x43, 0x56, 0xf4, 0x62, 0x15, 0x91, 0x8d, 0x2c, 0xe6, 0xc2, 0x1f, 0xda, 0x3b, 0x6f,
-	0x86, 0x9e, 0x9e, 0xca, 0x4c, 0xb3, 0x99, 0xee, 0xa5, 0xa7, 0x27, 0x90, 0x0b, 0x1f, 0xc1, 0x17,
-	0xd2, 0x17, 0xf0, 0xd2, 0x47, 0x90, 0x3c, 0x89, 0x4c, 0xf7, 0x4c, 0x74, 0x31, 0x8b, 0x7b, 0x57,
-	0xd5, 0x75, 0xce, 0xe9, 0x53, 0x55, 0x14, 0x3c, 0xa8, 0x12, 0xd4, 0x11, 0x67, 0x29, 0x4a, 0x8e,
-	0x51, 0x99, 0x33, 0x8d, 0x69, 0xb4, 0x9a, 0x46, 0x7c, 0x59, 0x95, 0x06, 0x75, 0x78, 0xae, 0x95,
-	0x51, 0xe4, 0x56, 0x8d, 0x0a, 0x1b, 0x54, 0xe8, 0x50, 0xe1, 0x6a, 0x1a, 0x3c, 0x84, 0xde, 0x1b,
-	0x55, 0x9a, 0xb9, 0x5c, 0x28, 0x72, 0x07, 0x7a, 0x22, 0x45, 0x69, 0x84, 0x59, 0x0f, 0xbd, 0xb1,
-	0x37, 0xe9, 0xd3, 0x6d, 0x1e, 0x7c, 0x86, 0x1e, 0x15, 0x32, 0xb3, 0x38, 0x02, 0xff, 0x69, 0xb5,
-	0xc4, 0x06, 0x63, 0x63, 0x72, 0x1f, 0xf6, 0x0b, 0x2c, 0x12, 0xd4, 0x31, 0x57, 0x95, 0x34, 0xc3,
-	0xbd, 0xb1, 0x37, 0xe9, 0xd0, 0x81, 0x7b, 0x9b, 0xd5, 0x4f, 0xe4, 0x18, 0xba, 0x2e, 0x2d, 0x87,
-	0xfe, 0xd8, 0x9f, 0x0c, 0x8e, 0xc6, 0xe1, 0x6e, 0x53, 0x61, 0xeb, 0x88, 0xb6, 0x84, 0xe0, 0xab,
-	0x07, 0xd7, 0xde, 0xba, 0x38, 0x17, 0xe7, 0xd6, 0xc5, 0x0c, 0xf6, 0x79, 0xa5, 0x35, 0x4a, 0x13,
-	0xe7, 0xaa, 0x34, 0xd6, 0xcd, 0x55, 0x34, 0x07, 0x0d, 0xab, 0x7e, 0x20, 0x8f, 0xe1, 0xba, 0x46,
-	0xc6, 0x73, 0x96, 0x2c, 0x31, 0x6e, 0xdd, 0xed, 0x8d, 0xfd, 0x49, 0x9f, 0x1e, 0x6e, 0x0b, 0xcd,
-	0xc7, 0xe4, 0x19, 0x74, 0xb4, 0x90, 0xd9, 0x3f, 0xed, 0xb7, 0x83, 0xa2, 0x0e, 0x1e, 0x7c, 0xf1,
-	0xe0, 0xe0, 0xb5, 0x2a, 0x98, 0x90, 0x33, 0xc6, 0x73, 0xb4, 0xee, 0x8f, 0xe1, 0xae, 0xac, 0x8a,
-	0x58, 0x2d, 0x62, 0x61, 0xb0, 0x28, 0x63, 0x21, 0x63, 0x5e, 0x17, 0xe3, 0x64, 0x1d, 0x8b, 0xd4,
-	0x36, 0xe3, 0xd3, 0x9b, 0xb2, 0x2a, 0xde, 0x2f, 0xe6, 0x35, 0x60, 0xee, 0xb8, 0x27, 0xeb, 0x79,
-	0x4a, 0x5e, 0xc2, 0xbd, 0x4b, 0xb9, 0x92, 0x15, 0x68, 0x87, 0xef, 0xd3, 0xdb, 0x3b, 0xd8, 0xef,
-	0x58, 0x81, 0xc1, 0x0b, 0x20, 0x1f, 0x50, 0x97, 0xa2, 0x34, 0xb5, 0xf1, 0x8f, 0x68, 0x8c, 0x90,
-	0x19, 0x39, 0x04, 0xff, 0x0c, 0xdb, 0xc5, 0xd7, 0x21, 0xb9, 0x01, 0x9d, 0x15, 0x5b, 0x56, 0x4e,
-	0xaf, 0x4f, 0x5d, 0x12, 0xbc, 0xba, 0xc0, 0x3e, 0x45, 0x66, 0x2a, 0x8d, 0x3b, 0xd8, 0x43, 0xe8,
-	0xa2, 0xac, 0xc7, 0x97, 0x5a, 0x7e, 0x8f, 0xb6, 0x69, 0xf0, 0xcd, 0x83, 0x83, 0x3f, 0x24, 0xec,
-	0x3c, 0x86, 0xd0, 0x4d, 0x18, 0x3f, 0x43, 0x99, 0x36, 0x1a, 0x6d, 0x4a, 0x4e, 0xa1, 0x57, 0x3a,
-	0x8b, 0x6e, 0x33, 0x83, 0xa3, 0x47, 0x97, 0x0d, 0xfe, 0xef, 0xae, 0xe8, 0x96, 0x5b, 0xeb, 0x2c,
-	0x9c, 0xd9, 0x76, 0x81, 0x57, 0xd1, 0x69, 0xfa, 0xa3, 0x5b, 0xee, 0xc9, 0xec, 0xfb, 0x66, 0xe4,
-	0xfd, 0xd8, 0x8c, 0xbc, 0x9f, 0x9b, 0x91, 0xf7, 0xe9, 0x69, 0x26, 0x4c, 0x5e, 0x25, 0x21, 0x57,
-	0x45, 0x74, 0xe1, 0x10, 0xc3, 0x0c, 0x65, 0x64, 0x6f, 0xef, 0xf7, 0x4d, 0x3e, 0x77, 0xd1, 0x6a,
-	0x9a, 0xfc, 0x6f, 0x2b, 0x4f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x93, 0x7f, 0x12, 0xb0, 0xbd,
-	0x03, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6a, 0xd4, 0x40,
+	0x14, 0x26, 0x4d, 0x6b, 0x77, 0x67, 0x4b, 0x5b, 0x47, 0xad, 0xa1, 0xd2, 0xba, 0x06, 0xd1, 0x45,
+	0x21, 0xa1, 0x15, 0xbd, 0xa8, 0x22, 0xd2, 0x48, 0x71, 0x2f, 0xb4, 0x92, 0x7a, 0xe5, 0x4d, 0x98,
+	0x24, 0x67, 0x93, 0xa1, 0xc9, 0xcc, 0x32, 0x33, 0x59, 0xe8, 0x85, 0x8f, 0xe0, 0x0b, 0xe9, 0x0b,
+	0x78, 0x25, 0x3e, 0x82, 0xf4, 0x49, 0x64, 0x66, 0x92, 0xb5, 0xc5, 0x2d, 0xf6, 0xee, 0xfc, 0x7c,
+	0xdf, 0x99, 0xef, 0xcc, 0x7c, 0x0c, 0x7a, 0xd8, 0xa4, 0x20, 0xc2, 0x8c, 0xe4, 0xc0, 0x32, 0x08,
+	0x65, 0x49, 0x04, 0xe4, 0xe1, 0x6c, 0x2f, 0xcc, 0xaa, 0x46, 0x2a, 0x10, 0xc1, 0x54, 0x70, 0xc5,
+	0xf1, 0x96, 0x46, 0x05, 0x2d, 0x2a, 0xb0, 0xa8, 0x60, 0xb6, 0xb7, 0x7d, 0xbf, 0xe0, 0xbc, 0xa8,
+	0x20, 0x34, 0xa8, 0xb4, 0x99, 0x84, 0x8a, 0xd6, 0x20, 0x15, 0xa9, 0xa7, 0x96, 0xe8, 0x3f, 0x42,
+	0xbd, 0x77, 0x5c, 0xaa, 0x31, 0x9b, 0x70, 0xbc, 0x8d, 0x7a, 0x34, 0x07, 0xa6, 0xa8, 0x3a, 0xf3,
+	0x9c, 0xa1, 0x33, 0xea, 0xc7, 0xf3, 0xdc, 0xff, 0x82, 0x7a, 0x31, 0x65, 0x85, 0xc1, 0x61, 0xb4,
+	0x2c, 0x78, 0x05, 0x2d, 0xc6, 0xc4, 0xf8, 0x01, 0x5a, 0xab, 0xa1, 0x4e, 0x41, 0x24, 0x19, 0x6f,
+	0x98, 0xf2, 0x96, 0x86, 0xce, 0x68, 0x25, 0x1e, 0xd8, 0x5a, 0xa4, 0x4b, 0xf8, 0x00, 0xad, 0xda,
+	0x54, 0x7a, 0xee, 0xd0, 0x1d, 0x0d, 0xf6, 0x87, 0xc1, 0x62, 0xd5, 0x41, 0xa7, 0x28, 0xee, 0x08,
+	0xfe, 0x37, 0x07, 0xad, 0xbf, 0xb7, 0x71, 0x49, 0xa7, 0x46, 0x45, 0x84, 0xd6, 0xb2, 0x46, 0x08,
+	0x60, 0x2a, 0x29, 0xb9, 0x54, 0x46, 0xcd, 0x75, 0x66, 0x0e, 0x5a, 0x96, 0x2e, 0xe0, 0xa7, 0xe8,
+	0xa6, 0x00, 0x92, 0x95, 0x24, 0xad, 0x20, 0xe9, 0xd4, 0x2d, 0x0d, 0xdd, 0x51, 0x3f, 0xde, 0x9c,
+	0x37, 0xda, 0x83, 0xf1, 0x0b, 0xb4, 0x22, 0x28, 0x2b, 0xfe, 0x2b, 0xbf, 0xbb, 0xa8, 0xd8, 0xc2,
+	0xfd, 0xaf, 0x0e, 0xda, 0x78, 0xcb, 0x6b, 0x42, 0x59, 0x44, 0xb2, 0x12, 0x8c, 0xfa, 0x03, 0x74,
+	0x8f, 0x35, 0x75, 0xc2, 0x27, 0x09, 0x55, 0x50, 0xcb, 0x84, 0xb2, 0x24, 0xd3, 0xcd, 0x24, 0x3d,
+	0x4b, 0x68, 0x6e, 0x96, 0x71, 0xe3, 0x3b, 0xac, 0xa9, 0x8f, 0x27, 0x63, 0x0d, 0x18, 0x5b, 0xee,
+	0xe1, 0xd9, 0x38, 0xc7, 0xaf, 0xd1, 0xce, 0x95, 0x5c, 0x46, 0x6a, 0x30, 0x97, 0xef, 0xc6, 0x77,
+	0x17, 0xb0, 0x3f, 0x90, 0x1a, 0xfc, 0x9f, 0x0e, 0xda, 0xf9, 0x08, 0x42, 0x52, 0xa9, 0xb4, 0xf2,
+	0x88, 0x8a, 0xac, 0xa1, 0xea, 0x50, 0x00, 0x39, 0x05, 0x61, 0xd4, 0xed, 0x20, 0x24, 0x15, 0x17,
+	0x60, 0xc7, 0xd9, 0x77, 0xee, 0x9b, 0x8a, 0x1e, 0x80, 0x1f, 0xa3, 0x0d, 0x3e, 0x05, 0x41, 0x14,
+	0xe5, 0x2c, 0xc9, 0x2a, 0x22, 0xa5, 0x39, 0xb2, 0x1f, 0xaf, 0xcf, 0xcb, 0x91, 0xae, 0xe2, 0xdb,
+	0x68, 0x45, 0x2a, 0xa2, 0xc0, 0x73, 0x4d, 0xdb, 0x26, 0xf8, 0x18, 0x6d, 0x55, 0x44, 0xaa, 0xc4,
+	0x64, 0x49, 0x56, 0x12, 0x56, 0x40, 0xa2, 0x8d, 0xe9, 0x2d, 0x9b, 0x37, 0xdc, 0x0e, 0xac, 0x6b,
+	0x83, 0xce, 0xb5, 0xc1, 0xa7, 0xce, 0xb5, 0xf1, 0x2d, 0xcd, 0x3c, 0xd1, 0xc4, 0xc8, 0xf0, 0x74,
+	0xc7, 0x7f, 0x85, 0xf0, 0x85, 0x7d, 0x4e, 0x40, 0x29, 0xca, 0x0a, 0xbc, 0x89, 0xdc, 0x53, 0xe8,
+	0x9c, 0xac, 0x43, 0x2d, 0x67, 0x46, 0xaa, 0x06, 0x5a, 0xb5, 0x36, 0xf1, 0xdf, 0x5c, 0x62, 0x1f,
+	0x01, 0x51, 0x8d, 0x80, 0x05, 0x6c, 0x0f, 0xad, 0x02, 0xd3, 0x7e, 0xc8, 0x0d, 0xbf, 0x17, 0x77,
+	0xa9, 0xff, 0xdd, 0x41, 0x1b, 0x17, 0x46, 0x98, 0x2b, 0xf4, 0xd0, 0x6a, 0x4a, 0xb2, 0x53, 0x60,
+	0x79, 0x3b, 0xa3, 0x4b, 0xf1, 0x11, 0xea, 0x49, 0x2b, 0xd1, 0x5a, 0x6d, 0xb0, 0xff, 0xe4, 0x2a,
+	0x27, 0xfd, 0xbb, 0x55, 0x3c, 0xe7, 0xea, 0x39, 0x13, 0x2b, 0xb6, 0x73, 0xe4, 0x75, 0xe6, 0xb4,
+	0xfb, 0xc5, 0x73, 0xee, 0x61, 0xf4, 0xe3, 0x7c, 0xd7, 0xf9, 0x75, 0xbe, 0xeb, 0xfc, 0x3e, 0xdf,
+	0x75, 0x3e, 0x3f, 0x2f, 0xa8, 0x2a, 0x9b, 0x34, 0xc8, 0x78, 0x1d, 0x5e, 0xfa, 0x7a, 0x82, 0x02,
+	0x98, 0xfd, 0x47, 0xfe, 0xfe, 0x42, 0x2f, 0x6d, 0x34, 0xdb, 0x4b, 0x6f, 0x98, 0xce, 0xb3, 0x3f,
+	0x03, 0x00, 0xa1, 0xdd, 0x25, 0x66, 0xaf, 0x04, 0x00, 0x00,
 }
 
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
@@ -682,6 +762,66 @@ func (m *DomainCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PersistenceCircuitBreakerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersistenceCircuitBreakerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersistenceCircuitBreakerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastStateChangeTime != nil {
+		{
+			size, err := m.LastStateChangeTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCluster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperationClass) > 0 {
+		i -= len(m.OperationClass)
+		copy(dAtA[i:], m.OperationClass)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.OperationClass)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.StoreName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PersistenceSetting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -927,6 +1067,34 @@ func (m *DomainCacheInfo) Size() (n int) {
 	return n
 }
 
+func (m *PersistenceCircuitBreakerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.OperationClass)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.LastStateChangeTime != nil {
+		l = m.LastStateChangeTime.Size()
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *PersistenceSetting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1473,6 +1641,192 @@ func (m *DomainCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PersistenceCircuitBreakerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersistenceCircuitBreakerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersistenceCircuitBreakerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStateChangeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastStateChangeTime == nil {
+				m.LastStateChangeTime = &types.Timestamp{}
+			}
+			if err := m.LastStateChangeTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PersistenceSetting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,35 +28,58 @@ package sharedv1
 var yarpcFileDescriptorClosure31e4c5c03631024e = [][]byte{
 	// uber/cadence/shared/v1/cluster.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x8b, 0xd3, 0x40,
-		0x10, 0x26, 0x17, 0x6b, 0xdb, 0xe9, 0xe1, 0x9d, 0x8b, 0x3f, 0x82, 0x22, 0xc4, 0x20, 0x52, 0x14,
-		0x12, 0x7a, 0xa2, 0xc2, 0x29, 0x22, 0x57, 0x39, 0xec, 0x83, 0x3f, 0x58, 0xdf, 0x7c, 0x09, 0x9b,
-		0xcd, 0x34, 0x59, 0xae, 0xd9, 0x3d, 0x36, 0x9b, 0x42, 0x1f, 0xfc, 0x13, 0xfc, 0x87, 0xf4, 0x9f,
-		0x93, 0xec, 0x26, 0xd5, 0xc3, 0x1e, 0x77, 0x6f, 0x33, 0x3b, 0xdf, 0xf7, 0xed, 0x37, 0x33, 0x0c,
-		0x3c, 0x69, 0x32, 0xd4, 0x09, 0x67, 0x39, 0x4a, 0x8e, 0x49, 0x5d, 0x32, 0x8d, 0x79, 0xb2, 0x9e,
-		0x25, 0x7c, 0xd5, 0xd4, 0x06, 0x75, 0x7c, 0xae, 0x95, 0x51, 0xe4, 0x5e, 0x8b, 0x8a, 0x3b, 0x54,
-		0xec, 0x50, 0xf1, 0x7a, 0x16, 0x3d, 0x85, 0xd1, 0x47, 0x55, 0x9b, 0x85, 0x5c, 0x2a, 0xf2, 0x00,
-		0x46, 0x22, 0x47, 0x69, 0x84, 0xd9, 0x04, 0x5e, 0xe8, 0x4d, 0xc7, 0x74, 0x9b, 0x47, 0x3f, 0x60,
-		0x44, 0x85, 0x2c, 0x2c, 0x8e, 0xc0, 0x0d, 0xad, 0x56, 0xd8, 0x61, 0x6c, 0x4c, 0x1e, 0xc3, 0x7e,
-		0x85, 0x55, 0x86, 0x3a, 0xe5, 0xaa, 0x91, 0x26, 0xd8, 0x0b, 0xbd, 0xe9, 0x80, 0x4e, 0xdc, 0xdb,
-		0xbc, 0x7d, 0x22, 0xc7, 0x30, 0x74, 0x69, 0x1d, 0xf8, 0xa1, 0x3f, 0x9d, 0x1c, 0x85, 0xf1, 0x6e,
-		0x53, 0x71, 0xef, 0x88, 0xf6, 0x84, 0xe8, 0x97, 0x07, 0xb7, 0x3e, 0xb9, 0xb8, 0x14, 0xe7, 0xd6,
-		0xc5, 0x1c, 0xf6, 0x79, 0xa3, 0x35, 0x4a, 0x93, 0x96, 0xaa, 0x36, 0xd6, 0xcd, 0x75, 0x34, 0x27,
-		0x1d, 0xab, 0x7d, 0x20, 0xcf, 0xe1, 0xb6, 0x46, 0xc6, 0x4b, 0x96, 0xad, 0x30, 0xed, 0xdd, 0xed,
-		0x85, 0xfe, 0x74, 0x4c, 0x0f, 0xb7, 0x85, 0xee, 0x63, 0xf2, 0x0a, 0x06, 0x5a, 0xc8, 0xe2, 0x4a,
-		0xfb, 0xfd, 0xa0, 0xa8, 0x83, 0x47, 0x3f, 0x3d, 0x38, 0xf8, 0xa0, 0x2a, 0x26, 0xe4, 0x9c, 0xf1,
-		0x12, 0xad, 0xfb, 0x63, 0x78, 0x28, 0x9b, 0x2a, 0x55, 0xcb, 0x54, 0x18, 0xac, 0xea, 0x54, 0xc8,
-		0x94, 0xb7, 0xc5, 0x34, 0xdb, 0xa4, 0x22, 0xb7, 0xcd, 0xf8, 0xf4, 0xae, 0x6c, 0xaa, 0x2f, 0xcb,
-		0x45, 0x0b, 0x58, 0x38, 0xee, 0xc9, 0x66, 0x91, 0x93, 0x77, 0xf0, 0xe8, 0x52, 0xae, 0x64, 0x15,
-		0xda, 0xe1, 0xfb, 0xf4, 0xfe, 0x0e, 0xf6, 0x67, 0x56, 0x61, 0xf4, 0x16, 0xc8, 0x57, 0xd4, 0xb5,
-		0xa8, 0x4d, 0x6b, 0xfc, 0x1b, 0x1a, 0x23, 0x64, 0x41, 0x0e, 0xc1, 0x3f, 0xc3, 0x7e, 0xf1, 0x6d,
-		0x48, 0xee, 0xc0, 0x60, 0xcd, 0x56, 0x8d, 0xd3, 0x1b, 0x53, 0x97, 0x44, 0xef, 0x2f, 0xb0, 0x4f,
-		0x91, 0x99, 0x46, 0xe3, 0x0e, 0x76, 0x00, 0x43, 0x94, 0xed, 0xf8, 0x72, 0xcb, 0x1f, 0xd1, 0x3e,
-		0x8d, 0x7e, 0x7b, 0x70, 0xf0, 0x8f, 0x84, 0x9d, 0x47, 0x00, 0xc3, 0x8c, 0xf1, 0x33, 0x94, 0x79,
-		0xa7, 0xd1, 0xa7, 0xe4, 0x14, 0x46, 0xb5, 0xb3, 0xe8, 0x36, 0x33, 0x39, 0x7a, 0x76, 0xd9, 0xe0,
-		0xff, 0xef, 0x8a, 0x6e, 0xb9, 0xad, 0xce, 0xd2, 0x99, 0xed, 0x17, 0x78, 0x1d, 0x9d, 0xae, 0x3f,
-		0xba, 0xe5, 0x9e, 0xbc, 0xfe, 0xfe, 0xb2, 0x10, 0xa6, 0x6c, 0xb2, 0x98, 0xab, 0x2a, 0xb9, 0x70,
-		0x7c, 0x71, 0x81, 0x32, 0xb1, 0xf7, 0xf6, 0xf7, 0x0e, 0xdf, 0xb8, 0x68, 0x3d, 0xcb, 0x6e, 0xda,
-		0xca, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0xef, 0x39, 0x7d, 0xb1, 0x03, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6a, 0xd4, 0x40,
+		0x14, 0x66, 0x9b, 0xd6, 0xee, 0x9e, 0x2d, 0x6d, 0x1d, 0xb5, 0x86, 0x4a, 0x71, 0x0d, 0xa2, 0x8b,
+		0x42, 0x42, 0x2b, 0x2a, 0x54, 0x11, 0xe9, 0x4a, 0x71, 0x2f, 0xb4, 0x92, 0x7a, 0xe5, 0x4d, 0x98,
+		0x24, 0x67, 0x93, 0xa1, 0xc9, 0xcc, 0x32, 0x33, 0x59, 0xe8, 0x85, 0x8f, 0xe0, 0x0b, 0xe9, 0x3b,
+		0xf8, 0x4c, 0x32, 0x33, 0xc9, 0xda, 0x62, 0x8b, 0xbd, 0x3b, 0x3f, 0xdf, 0x77, 0xe6, 0x3b, 0x33,
+		0x1f, 0x03, 0x8f, 0x9b, 0x14, 0x65, 0x94, 0xd1, 0x1c, 0x79, 0x86, 0x91, 0x2a, 0xa9, 0xc4, 0x3c,
+		0x5a, 0xec, 0x47, 0x59, 0xd5, 0x28, 0x8d, 0x32, 0x9c, 0x4b, 0xa1, 0x05, 0xd9, 0x31, 0xa8, 0xb0,
+		0x45, 0x85, 0x0e, 0x15, 0x2e, 0xf6, 0x77, 0x1f, 0x16, 0x42, 0x14, 0x15, 0x46, 0x16, 0x95, 0x36,
+		0xb3, 0x48, 0xb3, 0x1a, 0x95, 0xa6, 0xf5, 0xdc, 0x11, 0x83, 0x27, 0xd0, 0xff, 0x28, 0x94, 0x9e,
+		0xf2, 0x99, 0x20, 0xbb, 0xd0, 0x67, 0x39, 0x72, 0xcd, 0xf4, 0xb9, 0xdf, 0x1b, 0xf5, 0xc6, 0x83,
+		0x78, 0x99, 0x07, 0xdf, 0xa1, 0x1f, 0x33, 0x5e, 0x58, 0x1c, 0x81, 0x55, 0x29, 0x2a, 0x6c, 0x31,
+		0x36, 0x26, 0x8f, 0x60, 0xa3, 0xc6, 0x3a, 0x45, 0x99, 0x64, 0xa2, 0xe1, 0xda, 0x5f, 0x19, 0xf5,
+		0xc6, 0x6b, 0xf1, 0xd0, 0xd5, 0x26, 0xa6, 0x44, 0x0e, 0x61, 0xdd, 0xa5, 0xca, 0xf7, 0x46, 0xde,
+		0x78, 0x78, 0x30, 0x0a, 0xaf, 0x56, 0x1d, 0x76, 0x8a, 0xe2, 0x8e, 0x10, 0xfc, 0xec, 0xc1, 0xe6,
+		0x27, 0x17, 0x97, 0x6c, 0x6e, 0x55, 0x4c, 0x60, 0x23, 0x6b, 0xa4, 0x44, 0xae, 0x93, 0x52, 0x28,
+		0x6d, 0xd5, 0xdc, 0x64, 0xe6, 0xb0, 0x65, 0x99, 0x02, 0x79, 0x0e, 0xb7, 0x25, 0xd2, 0xac, 0xa4,
+		0x69, 0x85, 0x49, 0xa7, 0x6e, 0x65, 0xe4, 0x8d, 0x07, 0xf1, 0xf6, 0xb2, 0xd1, 0x1e, 0x4c, 0x5e,
+		0xc1, 0x9a, 0x64, 0xbc, 0xf8, 0xaf, 0xfc, 0xee, 0xa2, 0x62, 0x07, 0x0f, 0x7e, 0xf4, 0x60, 0xeb,
+		0x83, 0xa8, 0x29, 0xe3, 0x13, 0x9a, 0x95, 0x68, 0xd5, 0x1f, 0xc2, 0x03, 0xde, 0xd4, 0x89, 0x98,
+		0x25, 0x4c, 0x63, 0xad, 0x12, 0xc6, 0x93, 0xcc, 0x34, 0x93, 0xf4, 0x3c, 0x61, 0xb9, 0x5d, 0xc6,
+		0x8b, 0xef, 0xf1, 0xa6, 0x3e, 0x99, 0x4d, 0x0d, 0x60, 0xea, 0xb8, 0x47, 0xe7, 0xd3, 0x9c, 0xbc,
+		0x83, 0xbd, 0x6b, 0xb9, 0x9c, 0xd6, 0x68, 0x2f, 0xdf, 0x8b, 0xef, 0x5f, 0xc1, 0xfe, 0x4c, 0x6b,
+		0x0c, 0x7e, 0xf7, 0x60, 0xef, 0x0b, 0x4a, 0xc5, 0x94, 0x36, 0xca, 0x27, 0x4c, 0x66, 0x0d, 0xd3,
+		0x47, 0x12, 0xe9, 0x19, 0x4a, 0xab, 0x6e, 0x0f, 0x40, 0x69, 0x21, 0xd1, 0x8d, 0x73, 0xef, 0x3c,
+		0xb0, 0x15, 0x33, 0x80, 0x3c, 0x85, 0x2d, 0x31, 0x47, 0x49, 0x35, 0x13, 0x3c, 0xc9, 0x2a, 0xaa,
+		0x94, 0x3d, 0x72, 0x10, 0x6f, 0x2e, 0xcb, 0x13, 0x53, 0x25, 0x77, 0x61, 0x4d, 0x69, 0xaa, 0xd1,
+		0xf7, 0x6c, 0xdb, 0x25, 0xe4, 0x04, 0x76, 0x2a, 0xaa, 0x74, 0x62, 0xb3, 0x24, 0x2b, 0x29, 0x2f,
+		0x30, 0x31, 0xc6, 0xf4, 0x57, 0xed, 0x1b, 0xee, 0x86, 0xce, 0xb5, 0x61, 0xe7, 0xda, 0xf0, 0x6b,
+		0xe7, 0xda, 0xf8, 0x8e, 0x61, 0x9e, 0x1a, 0xe2, 0xc4, 0xf2, 0x4c, 0x27, 0x78, 0x0b, 0xe4, 0xc2,
+		0x3e, 0xa7, 0xa8, 0x35, 0xe3, 0x05, 0xd9, 0x06, 0xef, 0x0c, 0x3b, 0x27, 0x9b, 0xd0, 0xc8, 0x59,
+		0xd0, 0xaa, 0xc1, 0x56, 0xad, 0x4b, 0x82, 0xf7, 0x97, 0xd8, 0xc7, 0x48, 0x75, 0x23, 0xf1, 0x0a,
+		0xb6, 0x0f, 0xeb, 0xc8, 0x8d, 0x1f, 0x72, 0xcb, 0xef, 0xc7, 0x5d, 0x1a, 0xfc, 0xea, 0xc1, 0xd6,
+		0x85, 0x11, 0xf6, 0x0a, 0x7d, 0x58, 0x4f, 0x69, 0x76, 0x86, 0x3c, 0x6f, 0x67, 0x74, 0x29, 0x39,
+		0x86, 0xbe, 0x72, 0x12, 0x9d, 0xd5, 0x86, 0x07, 0xcf, 0xae, 0x73, 0xd2, 0xbf, 0x5b, 0xc5, 0x4b,
+		0xae, 0x99, 0x33, 0x73, 0x62, 0x3b, 0x47, 0xde, 0x64, 0x4e, 0xbb, 0x5f, 0xbc, 0xe4, 0x1e, 0xbd,
+		0xfe, 0xf6, 0xb2, 0x60, 0xba, 0x6c, 0xd2, 0x30, 0x13, 0x75, 0x74, 0xe9, 0xbb, 0x09, 0x0b, 0xe4,
+		0xee, 0xef, 0xf8, 0xfb, 0xf3, 0xbc, 0x71, 0xd1, 0x62, 0x3f, 0xbd, 0x65, 0x3b, 0x2f, 0xfe, 0x0c,
+		0x00, 0xa9, 0x1d, 0x56, 0xea, 0xa3, 0x04, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 }
@@ -54,231 +54,234 @@ var yarpcFileDescriptorClosure0370c4177fcc3ee8 = [][]byte{
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x38, 0x8e, 0xad, 0xae, 0x64,
-		0x92, 0x68, 0xe2, 0xa4, 0x80, 0x90, 0x96, 0xf1, 0xcc, 0x75, 0x3c, 0xf2, 0xee, 0xcc, 0x76, 0x66,
-		0xd6, 0x1b, 0x23, 0xf1, 0xc4, 0x03, 0x12, 0x6a, 0x05, 0x55, 0x85, 0x44, 0x05, 0x08, 0x84, 0x04,
-		0x6a, 0x11, 0x52, 0x11, 0x08, 0x01, 0xe2, 0x05, 0x90, 0x10, 0x48, 0xa0, 0xc2, 0x13, 0x2f, 0x3c,
-		0xc0, 0x03, 0x0f, 0xf4, 0x8d, 0x07, 0xca, 0x1b, 0x12, 0x9a, 0x3b, 0x77, 0x76, 0x77, 0x66, 0xee,
-		0x9d, 0xb9, 0xb3, 0xde, 0xb4, 0xa0, 0xe6, 0xcd, 0x73, 0xe7, 0xdc, 0x33, 0xbf, 0x7b, 0xee, 0x39,
-		0xe7, 0x9e, 0x7b, 0xce, 0x59, 0xc3, 0xa9, 0xd6, 0x06, 0xb6, 0x17, 0x34, 0x55, 0xc7, 0xa6, 0x86,
-		0x17, 0xd4, 0xa6, 0xb1, 0xb0, 0x73, 0x69, 0x61, 0xcb, 0x70, 0x5c, 0xcb, 0xde, 0xad, 0x34, 0x6d,
-		0xcb, 0xb5, 0xd0, 0x01, 0x8f, 0xa4, 0x42, 0x49, 0x2a, 0x6a, 0xd3, 0xa8, 0xec, 0x5c, 0x3a, 0x72,
-		0xe2, 0xa1, 0x65, 0x3d, 0xac, 0xe3, 0x05, 0x42, 0xb2, 0xd1, 0xda, 0x5c, 0xd0, 0x5b, 0xb6, 0xea,
-		0x1a, 0x96, 0xe9, 0x4f, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x35, 0x1a, 0xd8, 0x71, 0xd5, 0x46, 0x93,
-		0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x59, 0x8d, 0x46, 0x87, 0x85, 0xcc, 0xa2, 0x70, 0x55, 0x67, 0xbb,
-		0x6e, 0x38, 0x6e, 0x12, 0x4d, 0xdb, 0xb2, 0xb7, 0x37, 0xeb, 0x56, 0xdb, 0xa7, 0x91, 0x6f, 0x41,
-		0xf1, 0x25, 0x7f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x36, 0x5d, 0x67, 0x4a, 0x9a, 0xc9, 0xcf,
-		0x96, 0x17, 0x4f, 0x55, 0x18, 0x6b, 0xab, 0x50, 0xea, 0x15, 0x8f, 0x52, 0xa1, 0x13, 0xe4, 0xf7,
-		0xae, 0xc1, 0x68, 0xef, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19, 0x69,
-		0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xfc, 0x57, 0xde, 0xa2, 0xa7, 0x72, 0x33,
-		0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x97, 0x48, 0x25, 0x90, 0x48, 0x65, 0x3d, 0x90, 0x88, 0x52,
-		0x22, 0xd4, 0xde, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x1d, 0xc3, 0x32, 0xa7, 0xf2, 0x3e, 0x53,
-		0xfa, 0x88, 0x0e, 0x43, 0xd1, 0x5b, 0xbc, 0xf7, 0xb9, 0x21, 0xf2, 0xa6, 0xe0, 0x3d, 0x56, 0x75,
-		0xf4, 0x75, 0x09, 0xce, 0x07, 0x4b, 0xae, 0xe1, 0x47, 0x58, 0x6b, 0x79, 0xfb, 0x50, 0x73, 0x5c,
-		0xd5, 0x76, 0xb1, 0x5e, 0xf3, 0x91, 0xa8, 0xae, 0x6b, 0x1b, 0x1b, 0x2d, 0x17, 0x3b, 0x53, 0xc3,
-		0x04, 0xcf, 0xff, 0x33, 0x97, 0xfe, 0x32, 0xe5, 0xb3, 0x12, 0xb0, 0xb9, 0xe7, 0x73, 0x21, 0x4b,
-		0x5e, 0xea, 0xf0, 0x78, 0xe9, 0x29, 0xe5, 0x5c, 0x5b, 0x8c, 0x14, 0x7d, 0x5b, 0x82, 0x0b, 0x0c,
-		0x78, 0x9a, 0xd5, 0x68, 0xd6, 0x31, 0x13, 0x60, 0x81, 0x00, 0x7c, 0x41, 0x0c, 0xe0, 0x72, 0xc0,
-		0x27, 0x0e, 0xf1, 0x99, 0xb6, 0x28, 0x31, 0x7a, 0x53, 0x82, 0x39, 0x06, 0xc8, 0x4d, 0xd5, 0xa8,
-		0xb3, 0x10, 0x16, 0x09, 0xc2, 0x1b, 0x62, 0x08, 0x57, 0x09, 0x93, 0x38, 0xbc, 0xb3, 0x6d, 0x21,
-		0x4a, 0xf4, 0x2d, 0xb6, 0x00, 0x3d, 0xdd, 0xd2, 0x6b, 0x56, 0xcb, 0x8d, 0xc3, 0x1b, 0x21, 0xf0,
-		0x9e, 0x17, 0x83, 0xe7, 0xa9, 0x9d, 0x7e, 0xa7, 0xe5, 0xc6, 0x01, 0xce, 0xb6, 0x05, 0x69, 0xd1,
-		0x1b, 0x12, 0xcc, 0xea, 0x58, 0x33, 0x1c, 0x02, 0xcc, 0xd3, 0x52, 0x47, 0xdb, 0xc2, 0x7a, 0x8b,
-		0x29, 0xbc, 0x12, 0x41, 0x77, 0x9d, 0x89, 0xee, 0x16, 0x65, 0xb2, 0xae, 0x3a, 0xdb, 0xf7, 0x02,
-		0x16, 0x71, 0x64, 0x67, 0x74, 0x01, 0x3a, 0xf4, 0x9a, 0x04, 0x67, 0x23, 0xa8, 0x78, 0x36, 0x01,
-		0x04, 0xd3, 0xb5, 0x74, 0x4c, 0x3c, 0x73, 0x90, 0xf5, 0x54, 0x2a, 0x86, 0x94, 0x12, 0x8c, 0xa0,
-		0x2c, 0x28, 0xa5, 0x04, 0xfd, 0x0f, 0x49, 0x89, 0xab, 0xfa, 0xaf, 0xc7, 0x50, 0x25, 0x68, 0xd6,
-		0x28, 0x41, 0xf5, 0x7f, 0xa9, 0xa8, 0xf8, 0x4a, 0x75, 0x5a, 0x4f, 0x27, 0x43, 0x5f, 0x94, 0xe0,
-		0xe9, 0x30, 0x26, 0x9e, 0x25, 0xee, 0x23, 0x80, 0xae, 0xa6, 0x02, 0xe2, 0x19, 0xe1, 0x29, 0x3d,
-		0x8d, 0x88, 0x6c, 0x9b, 0xaa, 0xb9, 0xc6, 0x8e, 0xe1, 0xee, 0xa6, 0x2a, 0xf7, 0x58, 0xc2, 0xb6,
-		0x2d, 0x51, 0x26, 0x69, 0xca, 0xad, 0x0a, 0xd0, 0x11, 0xe5, 0x8e, 0xa0, 0xe2, 0x29, 0xf7, 0xfe,
-		0x04, 0xe5, 0x0e, 0x61, 0xe2, 0x2a, 0xb7, 0x9a, 0x4a, 0xc5, 0x90, 0x52, 0x82, 0x72, 0x8f, 0x0b,
-		0x4a, 0x29, 0x49, 0xb9, 0x55, 0x01, 0x3a, 0xa2, 0x48, 0x61, 0x54, 0x3c, 0x45, 0x9a, 0x48, 0x50,
-		0xa4, 0x5e, 0x48, 0x5c, 0x45, 0x52, 0xd3, 0x88, 0x88, 0xa5, 0x85, 0xc1, 0x24, 0x58, 0x1a, 0x4a,
-		0xb0, 0xb4, 0x5e, 0x3c, 0x09, 0x96, 0xa6, 0xa6, 0x93, 0xa1, 0x36, 0x9c, 0xf0, 0x40, 0xd8, 0x7c,
-		0xed, 0x39, 0x40, 0x80, 0x5c, 0x64, 0x02, 0xf1, 0xb8, 0xda, 0x5c, 0xb5, 0x39, 0xea, 0xf2, 0x5f,
-		0xa3, 0x57, 0xe0, 0x98, 0xff, 0xe1, 0x4d, 0xc3, 0x66, 0x7d, 0xf6, 0x20, 0xf9, 0x6c, 0x85, 0xff,
-		0xd9, 0x55, 0x6f, 0x5e, 0xfc, 0xa3, 0xd3, 0x2e, 0xef, 0x25, 0xfa, 0xae, 0x04, 0x0b, 0x11, 0x15,
-		0x55, 0x4d, 0x0d, 0xd7, 0x6b, 0x36, 0x7e, 0xa5, 0x85, 0x1d, 0xe6, 0xea, 0x0f, 0x11, 0x18, 0x2f,
-		0xa6, 0x6b, 0x2a, 0xe1, 0xa4, 0x04, 0x8c, 0xe2, 0xb8, 0xe6, 0x54, 0x61, 0x6a, 0xf4, 0x23, 0x09,
-		0xae, 0x50, 0x4c, 0x01, 0x44, 0x31, 0x25, 0x9e, 0x24, 0x68, 0x97, 0x99, 0x68, 0xe9, 0xd7, 0xfc,
-		0x4f, 0x8b, 0x68, 0x74, 0xc5, 0xce, 0x34, 0x03, 0x7d, 0x59, 0x82, 0x73, 0x2c, 0xf1, 0xb2, 0x80,
-		0x1e, 0x16, 0xd4, 0xee, 0x65, 0xca, 0x21, 0x45, 0xbb, 0x39, 0x64, 0xe8, 0xb3, 0x70, 0xd2, 0x57,
-		0x32, 0x3e, 0x92, 0x29, 0x82, 0xe4, 0x12, 0x5f, 0xcf, 0xf8, 0x10, 0x7c, 0x05, 0xe6, 0x7d, 0xfb,
-		0x0b, 0x12, 0x9c, 0xa1, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0xd3, 0x04, 0xc1, 0xb3, 0x4c, 0x04,
-		0x3e, 0x73, 0x5f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0xa5, 0xd0, 0xa0, 0xcf, 0xc1, 0x4c, 0x43, 0xb5,
-		0xb7, 0xb1, 0x5d, 0xb3, 0xb1, 0x66, 0xd9, 0x3a, 0x0b, 0xc4, 0x11, 0x02, 0x62, 0x91, 0x09, 0xe2,
-		0xe3, 0x64, 0xb2, 0x42, 0xe7, 0xc6, 0x11, 0x1c, 0x6f, 0x24, 0x11, 0xa0, 0x6f, 0x4a, 0x30, 0xcf,
-		0xba, 0x9f, 0x18, 0x0f, 0x4d, 0x95, 0x29, 0x90, 0xa3, 0x59, 0xc2, 0xd7, 0x7b, 0x94, 0x8d, 0x48,
-		0xf8, 0xca, 0xa1, 0x45, 0xdf, 0x91, 0xa0, 0xc2, 0x8a, 0xb0, 0xb1, 0xdd, 0x30, 0x4c, 0x95, 0xe9,
-		0x17, 0x8e, 0x25, 0xf8, 0x85, 0x78, 0x88, 0xdd, 0x61, 0xc4, 0xf0, 0x0b, 0x6d, 0x61, 0x6a, 0xf4,
-		0x63, 0x09, 0xae, 0xb0, 0xae, 0x52, 0xa9, 0x5e, 0xec, 0x38, 0x41, 0x7b, 0x4b, 0xf0, 0x46, 0x95,
-		0xe6, 0xca, 0x16, 0xda, 0xd9, 0xa6, 0xf0, 0x34, 0x80, 0x6f, 0x94, 0x27, 0xb2, 0x68, 0x00, 0xdf,
-		0x40, 0x67, 0xdb, 0x82, 0xb4, 0xe8, 0x6f, 0x12, 0xac, 0x44, 0x3c, 0x2e, 0x7e, 0xe4, 0x62, 0xdb,
-		0x54, 0xeb, 0x35, 0x06, 0x72, 0xc3, 0x34, 0x5c, 0x83, 0xad, 0x18, 0x27, 0x09, 0xf4, 0x7b, 0xe9,
-		0x2e, 0x78, 0x85, 0xf2, 0x8f, 0xad, 0xa7, 0x1a, 0x30, 0x8f, 0x2f, 0xe8, 0x05, 0x7b, 0x4f, 0x1c,
-		0xd0, 0x9f, 0x25, 0xb8, 0x99, 0x61, 0x99, 0x3c, 0x8f, 0x35, 0x43, 0xd6, 0x78, 0x77, 0x0f, 0x6b,
-		0xe4, 0x39, 0xb3, 0x1b, 0x76, 0xff, 0xd3, 0xd1, 0xbb, 0x12, 0x3c, 0x9f, 0xb4, 0x9c, 0x74, 0x3b,
-		0x39, 0x45, 0x16, 0xb6, 0xc6, 0x5c, 0x18, 0x17, 0x4c, 0xaa, 0xbd, 0x5c, 0xc3, 0xfd, 0x4d, 0x25,
-		0x71, 0x00, 0x33, 0x75, 0x62, 0xba, 0x86, 0xd9, 0xc2, 0x7a, 0x4d, 0x75, 0x6a, 0x26, 0x6e, 0xc7,
-		0xd7, 0x21, 0x27, 0xc4, 0x01, 0x8c, 0x0c, 0x0a, 0x65, 0xb7, 0xe4, 0xdc, 0xc6, 0x6d, 0x46, 0x1c,
-		0xd0, 0xce, 0x34, 0x03, 0xfd, 0x4a, 0x82, 0xeb, 0x24, 0x9a, 0xac, 0x69, 0x5b, 0x46, 0x5d, 0xcf,
-		0x68, 0x3f, 0xa7, 0x09, 0xf4, 0x97, 0x98, 0xd0, 0x49, 0x28, 0xb9, 0xec, 0x31, 0xcd, 0x62, 0x34,
-		0x97, 0x9d, 0xec, 0xd3, 0xd0, 0xcf, 0x24, 0xb8, 0x9a, 0xb2, 0x08, 0x9e, 0x75, 0x9c, 0x21, 0x2b,
-		0x58, 0xc9, 0xba, 0x02, 0x9e, 0x49, 0x5c, 0x74, 0x32, 0xce, 0x41, 0xdf, 0x97, 0xe0, 0x12, 0x17,
-		0x35, 0x37, 0xce, 0x7f, 0x9a, 0xc0, 0x5e, 0x62, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03, 0xff, 0x79,
-		0x2d, 0x03, 0x3d, 0xfa, 0xa1, 0x04, 0x97, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0x67, 0x13, 0x94, 0x9c,
-		0x0d, 0x38, 0xe1, 0x3a, 0x59, 0xd1, 0x32, 0xcd, 0x40, 0x6f, 0x4b, 0x70, 0x31, 0xb3, 0x66, 0x9c,
-		0x23, 0x88, 0x3f, 0x96, 0x01, 0x31, 0x4f, 0x29, 0xce, 0x6b, 0x19, 0xf4, 0xe1, 0x1d, 0x09, 0x16,
-		0xf9, 0x02, 0xe6, 0x1e, 0xc2, 0xb3, 0x04, 0xed, 0xcd, 0x2c, 0xf2, 0xe5, 0x9e, 0xc4, 0x17, 0xb4,
-		0x2c, 0x13, 0xd0, 0x0f, 0x92, 0x54, 0x22, 0xe1, 0xd2, 0xfc, 0x4c, 0x66, 0xc8, 0xfc, 0xeb, 0x33,
-		0x07, 0x32, 0xef, 0x22, 0xed, 0xc5, 0x66, 0x7c, 0xc8, 0x09, 0x91, 0xe4, 0x5c, 0x42, 0x6c, 0xc6,
-		0xc1, 0x9c, 0x10, 0x4e, 0x2e, 0x68, 0xd9, 0xa6, 0x90, 0x43, 0xd3, 0x0f, 0xc5, 0xfb, 0x8d, 0x78,
-		0xce, 0x27, 0x1c, 0x9a, 0x7e, 0xc4, 0xdd, 0x4f, 0xa8, 0x73, 0xcd, 0xe9, 0x6f, 0x2a, 0xfa, 0xb5,
-		0x04, 0xcf, 0x09, 0x2c, 0x88, 0x67, 0xa3, 0xf3, 0x64, 0x35, 0xd5, 0x7e, 0x56, 0xc3, 0x33, 0xd6,
-		0x2b, 0x4e, 0x1f, 0xf3, 0xd0, 0x4f, 0x25, 0x78, 0x36, 0x69, 0x01, 0xfc, 0xfb, 0xd3, 0x85, 0x84,
-		0x03, 0x88, 0x0b, 0x82, 0x7f, 0x8f, 0xba, 0x88, 0x33, 0xce, 0x21, 0x0e, 0xa7, 0xd5, 0x74, 0xb0,
-		0xed, 0x76, 0x81, 0x3b, 0x58, 0xb5, 0xb5, 0xad, 0x1e, 0x98, 0x71, 0xdc, 0x95, 0x04, 0xeb, 0xbd,
-		0x4f, 0xd8, 0x05, 0x08, 0xee, 0x11, 0x66, 0xdd, 0x2f, 0x32, 0xac, 0xb7, 0x95, 0x65, 0xc2, 0xcd,
-		0x51, 0x80, 0x2e, 0x10, 0xf9, 0xaf, 0x65, 0x38, 0x27, 0x7a, 0x7a, 0xad, 0xc2, 0xbe, 0xce, 0x1a,
-		0xdd, 0xdd, 0x26, 0x26, 0xb5, 0x40, 0x5e, 0x65, 0x31, 0x60, 0xba, 0xbe, 0xdb, 0xc4, 0xca, 0x68,
-		0xbb, 0xe7, 0x09, 0x7d, 0x1a, 0x0e, 0x35, 0x55, 0xdb, 0x93, 0x48, 0xaf, 0xd1, 0x6d, 0x5a, 0xb4,
-		0x7c, 0x38, 0xcb, 0xe4, 0x77, 0x97, 0xcc, 0xe8, 0xb1, 0x89, 0x4d, 0x4b, 0x39, 0xd0, 0x8c, 0x0f,
-		0xa2, 0xe7, 0xa0, 0x44, 0x32, 0x32, 0x75, 0xc3, 0x71, 0x49, 0x61, 0xb1, 0xbc, 0x78, 0x9c, 0x9d,
-		0xf2, 0x50, 0x9d, 0xed, 0x35, 0xc3, 0x71, 0x95, 0x11, 0x97, 0xfe, 0x85, 0x16, 0x61, 0xd8, 0x30,
-		0x9b, 0x2d, 0x97, 0x94, 0x1d, 0xcb, 0x8b, 0xc7, 0x38, 0x48, 0x76, 0xeb, 0x96, 0xaa, 0x2b, 0x3e,
-		0x29, 0x52, 0x61, 0x26, 0x12, 0x72, 0xd4, 0x5c, 0xab, 0xa6, 0xd5, 0x2d, 0x07, 0x13, 0xff, 0x6d,
-		0xb5, 0x5c, 0x5a, 0x87, 0x9c, 0x8e, 0xd5, 0x45, 0x6f, 0xd1, 0x4a, 0xb2, 0x72, 0x0c, 0x87, 0x64,
-		0xbf, 0x6e, 0x2d, 0x7b, 0xf3, 0xd7, 0xfd, 0xe9, 0xe8, 0x65, 0x38, 0xda, 0x4d, 0x7b, 0xc7, 0xb9,
-		0x17, 0xd2, 0xb8, 0x1f, 0x76, 0x83, 0x64, 0x76, 0x84, 0xf1, 0x0d, 0x38, 0xd2, 0x8d, 0xb0, 0xbb,
-		0xab, 0xb0, 0x5b, 0x66, 0xcd, 0xd0, 0x49, 0xe9, 0xaf, 0xa4, 0x1c, 0xee, 0x50, 0x74, 0xe4, 0xac,
-		0xb4, 0xcc, 0xaa, 0x8e, 0xaa, 0x50, 0xa2, 0xae, 0xd2, 0xb2, 0x49, 0x1d, 0x6e, 0x6c, 0xf1, 0x3c,
-		0xdb, 0xb5, 0x53, 0x06, 0x24, 0x84, 0xae, 0x06, 0x53, 0x94, 0xee, 0x6c, 0x54, 0x85, 0x89, 0x2e,
-		0x0e, 0xcf, 0x5d, 0xb5, 0x6c, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf5, 0x69, 0x94, 0xf1, 0xce,
-		0x34, 0x3a, 0x82, 0x14, 0x98, 0xac, 0xab, 0xde, 0x9d, 0xcf, 0x0f, 0x67, 0xc8, 0x72, 0xb0, 0xd3,
-		0xaa, 0xbb, 0xb4, 0xf0, 0x95, 0xbc, 0xa7, 0x07, 0xbd, 0xb9, 0xcb, 0x9d, 0xa9, 0x0a, 0x99, 0x89,
-		0xae, 0xc3, 0xb4, 0x65, 0x1b, 0x0f, 0x0d, 0xdf, 0xd1, 0x46, 0xa4, 0x54, 0x26, 0x52, 0x9a, 0x0c,
-		0x08, 0x22, 0x42, 0x3a, 0x02, 0x23, 0x86, 0x8e, 0x4d, 0xd7, 0x70, 0x77, 0x49, 0x45, 0xa9, 0xa4,
-		0x74, 0x9e, 0xd1, 0x65, 0x98, 0xdc, 0x34, 0x6c, 0xc7, 0x8d, 0xf3, 0xdc, 0x47, 0x28, 0x0f, 0x90,
-		0xb7, 0x11, 0x86, 0xcb, 0x30, 0x6a, 0x63, 0xd7, 0xde, 0xad, 0x35, 0xad, 0xba, 0xa1, 0xed, 0xd2,
-		0x2a, 0xcc, 0x0c, 0xe7, 0x82, 0xea, 0xda, 0xbb, 0x77, 0x09, 0x9d, 0x52, 0xb6, 0xbb, 0x0f, 0x68,
-		0x0a, 0x8a, 0xaa, 0xeb, 0xe2, 0x46, 0xd3, 0x25, 0x15, 0x93, 0x61, 0x25, 0x78, 0x44, 0xcb, 0xb0,
-		0x1f, 0x3f, 0x6a, 0x1a, 0xbe, 0xe2, 0xf8, 0x45, 0xfd, 0xf1, 0xd4, 0xa2, 0xfe, 0x58, 0x77, 0x0a,
-		0xa9, 0xec, 0x9f, 0x86, 0x7d, 0x9a, 0xed, 0x59, 0x03, 0xad, 0xe8, 0x90, 0x8a, 0x43, 0x49, 0x19,
-		0xf5, 0x06, 0x83, 0x2a, 0x0f, 0xfa, 0x04, 0x1c, 0xf5, 0x57, 0x1f, 0xae, 0x7e, 0x6d, 0xa8, 0xda,
-		0xb6, 0xb5, 0xb9, 0x49, 0x8b, 0x02, 0x09, 0x4a, 0x3d, 0x45, 0x66, 0xf7, 0x16, 0xbe, 0x6e, 0xfa,
-		0x53, 0xd1, 0x05, 0x18, 0x6a, 0xe0, 0x86, 0x45, 0xd3, 0xf9, 0xd3, 0xec, 0x44, 0x1f, 0x6e, 0x58,
-		0x0a, 0x21, 0x43, 0x0a, 0x4c, 0xc4, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b, 0x23, 0x1e,
-		0x56, 0x19, 0x77, 0x22, 0x23, 0xe8, 0x3e, 0x4c, 0x36, 0x6d, 0xbc, 0x53, 0x53, 0x5b, 0xae, 0xe5,
-		0xe9, 0x1f, 0x76, 0x6b, 0x4d, 0xcb, 0x30, 0xdd, 0x20, 0xcb, 0xce, 0xdb, 0x2f, 0x07, 0xbb, 0x77,
-		0x09, 0x9d, 0x72, 0xc0, 0x9b, 0xbf, 0xd4, 0x72, 0xad, 0x9e, 0x41, 0x74, 0x19, 0x0a, 0x5b, 0x58,
-		0xd5, 0xb1, 0x4d, 0xd3, 0xdf, 0x47, 0xd9, 0x4d, 0x1d, 0x84, 0x44, 0xa1, 0xa4, 0xf2, 0xdb, 0x12,
-		0x3c, 0x23, 0x1e, 0xed, 0x5f, 0x81, 0x02, 0xb5, 0x17, 0x49, 0xc0, 0x5e, 0x28, 0x2d, 0x5a, 0x85,
-		0x99, 0xe4, 0x72, 0xaf, 0xa1, 0x13, 0xef, 0x9e, 0x57, 0x8e, 0xf1, 0x2b, 0xb5, 0x55, 0x5d, 0x7e,
-		0x4b, 0x82, 0xb3, 0x82, 0x41, 0xc3, 0x55, 0x28, 0x06, 0x9e, 0x42, 0x12, 0xf0, 0x14, 0x01, 0xf1,
-		0xc0, 0xa0, 0x5a, 0x30, 0x2b, 0x1c, 0x31, 0x2f, 0xc3, 0x28, 0x75, 0xd6, 0xdd, 0x83, 0x73, 0x8c,
-		0xa3, 0x04, 0xd4, 0x37, 0x93, 0x73, 0xb3, 0xec, 0x76, 0x1f, 0xe4, 0xdf, 0x4b, 0x70, 0x46, 0xa4,
-		0x69, 0x20, 0x7c, 0x02, 0x4a, 0xd9, 0x4e, 0xc0, 0xdb, 0x30, 0xc9, 0x39, 0x65, 0x72, 0x69, 0x06,
-		0x79, 0xc0, 0x61, 0x9c, 0x30, 0x3d, 0x9e, 0x26, 0x1f, 0xf2, 0x34, 0xf2, 0x6b, 0x12, 0xc8, 0xe9,
-		0xfd, 0x06, 0x68, 0x1e, 0x50, 0xb4, 0x06, 0xdd, 0xe9, 0x42, 0x1a, 0x77, 0x42, 0x22, 0x88, 0xb8,
-		0xdb, 0x5c, 0xc4, 0xdd, 0x1e, 0x07, 0x08, 0x12, 0x82, 0x86, 0x4e, 0xd0, 0x94, 0x94, 0x12, 0x1d,
-		0xa9, 0xea, 0xf2, 0x3f, 0x22, 0xe2, 0xe5, 0x5a, 0x48, 0x36, 0x44, 0xb3, 0x30, 0x1e, 0xce, 0x43,
-		0x74, 0xd4, 0x6b, 0xcc, 0xe9, 0x59, 0x71, 0x04, 0x7b, 0x3e, 0x82, 0xfd, 0x1c, 0xec, 0xdf, 0x30,
-		0x4c, 0xd5, 0xde, 0xad, 0x69, 0x5b, 0x58, 0xdb, 0x76, 0x5a, 0x0d, 0x12, 0xa2, 0x94, 0x94, 0x31,
-		0x7f, 0x78, 0x99, 0x8e, 0xa2, 0xf3, 0x30, 0x11, 0xce, 0x9e, 0xe1, 0x47, 0x7e, 0xf8, 0x31, 0xaa,
-		0x8c, 0xe3, 0xde, 0xa4, 0x16, 0x7e, 0xe4, 0xca, 0xaf, 0xe6, 0xe1, 0xb4, 0x40, 0x2b, 0xc3, 0x63,
-		0x5b, 0x71, 0xd4, 0x2c, 0xf2, 0x7d, 0x98, 0x05, 0x3a, 0x01, 0xe5, 0x0d, 0xd5, 0xc1, 0xc1, 0xd1,
-		0xe9, 0x8b, 0xa5, 0xe4, 0x0d, 0xf9, 0x07, 0xe6, 0x31, 0x00, 0x13, 0xb7, 0x83, 0xd7, 0xc3, 0xbe,
-		0x60, 0x4d, 0xdc, 0xf6, 0xdf, 0xce, 0x03, 0xda, 0xb4, 0xec, 0x6d, 0x8a, 0x34, 0xe8, 0x47, 0x2b,
-		0xf8, 0x4b, 0xf3, 0xde, 0x10, 0xac, 0x0f, 0x68, 0x63, 0xda, 0xa4, 0xe7, 0x1c, 0x55, 0xc7, 0x32,
-		0x69, 0x6c, 0x44, 0x9f, 0xd0, 0x2d, 0x18, 0xd6, 0xd4, 0x96, 0x83, 0x69, 0x18, 0x54, 0x11, 0x6e,
-		0x1a, 0x59, 0xf6, 0x66, 0x29, 0xfe, 0x64, 0xf9, 0xad, 0x3c, 0x9c, 0x4a, 0x6d, 0xe4, 0x78, 0x6c,
-		0x9b, 0x71, 0x33, 0x58, 0x83, 0xbf, 0x0b, 0xf3, 0x82, 0x7d, 0x26, 0xbd, 0x2b, 0xe8, 0xf5, 0xc9,
-		0x43, 0x59, 0x7c, 0x72, 0xaf, 0xea, 0x0f, 0x47, 0x54, 0x3f, 0xb2, 0xbf, 0x85, 0xe4, 0xfd, 0x2d,
-		0x0a, 0xed, 0xef, 0x08, 0x67, 0x7f, 0x19, 0x66, 0x56, 0x62, 0x99, 0x99, 0xfc, 0x8d, 0x02, 0x9c,
-		0x11, 0xe9, 0x71, 0x41, 0x27, 0xa1, 0xdc, 0x29, 0x14, 0xd3, 0x6d, 0x2a, 0x29, 0x10, 0x0c, 0x55,
-		0x75, 0xef, 0x52, 0xd5, 0xad, 0x24, 0x7b, 0x46, 0x90, 0x4b, 0xb8, 0x54, 0x75, 0x3e, 0x49, 0x2e,
-		0x55, 0x6a, 0xcf, 0x93, 0xa7, 0x9a, 0xba, 0xd5, 0x50, 0x0d, 0x93, 0xfa, 0x0e, 0xfa, 0x14, 0x3e,
-		0x0c, 0x86, 0xfa, 0xbc, 0x0e, 0x15, 0xc4, 0xaf, 0x43, 0xeb, 0x30, 0x1d, 0x28, 0x61, 0xfc, 0x0c,
-		0x29, 0xa6, 0x9d, 0x21, 0x93, 0xc1, 0xdc, 0xc8, 0x31, 0x12, 0xe1, 0x4a, 0x8f, 0x28, 0xca, 0x75,
-		0x24, 0x03, 0x57, 0xff, 0x16, 0x44, 0xb9, 0xf2, 0x0f, 0xbb, 0x52, 0x5f, 0x87, 0xdd, 0x2a, 0x4c,
-		0x6c, 0x61, 0xd5, 0x76, 0x37, 0xb0, 0xda, 0x45, 0x07, 0x69, 0xac, 0xc6, 0x3b, 0x73, 0xba, 0x7c,
-		0xd2, 0x43, 0x94, 0x72, 0x7a, 0x88, 0x12, 0xbb, 0x2b, 0x8c, 0xf6, 0x73, 0x57, 0xe8, 0xc6, 0x9c,
-		0xfb, 0xc4, 0x63, 0xce, 0xbf, 0x4b, 0x20, 0xa7, 0xf7, 0x5b, 0x7d, 0x60, 0x87, 0x7b, 0x6f, 0x18,
-		0x32, 0x14, 0xbe, 0xf0, 0xbc, 0x08, 0xa3, 0xe4, 0xbe, 0x18, 0xf8, 0xad, 0x61, 0x01, 0xbf, 0x55,
-		0xf6, 0x66, 0xd0, 0x07, 0xf9, 0x8f, 0x52, 0xd8, 0x15, 0x0c, 0x38, 0xb2, 0x66, 0x8b, 0x28, 0x97,
-		0xc1, 0xdd, 0xe7, 0x53, 0xa3, 0x8d, 0xa1, 0xb0, 0x30, 0xe5, 0x3f, 0x48, 0x70, 0x2a, 0xbd, 0x09,
-		0xa6, 0xdf, 0x00, 0xfc, 0xc3, 0x58, 0xd1, 0xcf, 0x73, 0x70, 0x5a, 0xa0, 0x95, 0xcc, 0x5b, 0x93,
-		0x8e, 0x5d, 0xd5, 0xa8, 0x3b, 0x42, 0x9b, 0x14, 0x10, 0x3f, 0xb6, 0x35, 0x45, 0x23, 0xa4, 0xa1,
-		0x7e, 0x22, 0xa4, 0x3d, 0xab, 0xf8, 0x57, 0x24, 0x98, 0x13, 0xef, 0x00, 0x13, 0x39, 0xf3, 0x06,
-		0x73, 0x05, 0x7b, 0x47, 0x82, 0x8c, 0xbd, 0x5e, 0xe9, 0xd8, 0x0e, 0x06, 0x61, 0x90, 0xef, 0x61,
-		0x68, 0x60, 0x23, 0x82, 0x38, 0x2f, 0x80, 0xf8, 0xcd, 0x88, 0x1e, 0xf2, 0xaa, 0x42, 0xfd, 0xea,
-		0xe1, 0x2a, 0xcc, 0xd4, 0x55, 0xb7, 0xa7, 0xe7, 0x21, 0xda, 0x01, 0xd0, 0x95, 0xac, 0x4f, 0xc7,
-		0xda, 0x4a, 0x3f, 0x6c, 0x62, 0xe8, 0x73, 0x3e, 0x83, 0x3e, 0x0f, 0xa5, 0xda, 0x68, 0x24, 0xd0,
-		0x93, 0xdf, 0x95, 0xe0, 0x68, 0x42, 0x97, 0x25, 0x9a, 0x86, 0x11, 0xbf, 0xbb, 0xac, 0xb3, 0x6f,
-		0x45, 0xf2, 0x5c, 0xd5, 0xd1, 0x1a, 0x1c, 0xea, 0x1c, 0xe4, 0x9b, 0x86, 0x9d, 0xe1, 0xd2, 0x8a,
-		0xe8, 0x39, 0xbe, 0x6a, 0xd8, 0x38, 0xcb, 0xf1, 0x2b, 0xb2, 0xd9, 0x9f, 0x81, 0x69, 0x6e, 0xfb,
-		0x66, 0xd2, 0x6a, 0x84, 0x63, 0x76, 0xf9, 0x37, 0x12, 0x1c, 0x4b, 0xea, 0xdc, 0x1b, 0xc8, 0x57,
-		0x06, 0x25, 0x8f, 0x44, 0x07, 0xfd, 0x13, 0x09, 0x66, 0xd2, 0x3a, 0x00, 0x93, 0x56, 0xf3, 0x58,
-		0xcd, 0x36, 0x11, 0xf9, 0xbf, 0x8b, 0x90, 0xb1, 0xd1, 0x04, 0x2d, 0xc0, 0x41, 0xd2, 0xcb, 0x12,
-		0x4d, 0xfb, 0xfa, 0x6b, 0x9a, 0x30, 0x71, 0x3b, 0x92, 0xf4, 0x8d, 0x55, 0x5e, 0x72, 0xfd, 0x55,
-		0x5e, 0x9e, 0xd4, 0x46, 0xc4, 0x6b, 0x23, 0x22, 0xba, 0x53, 0x14, 0xd0, 0x9d, 0x3b, 0x30, 0x49,
-		0x73, 0xda, 0x14, 0xa3, 0x61, 0xba, 0xd8, 0xde, 0x51, 0xeb, 0xe9, 0xf7, 0x96, 0x83, 0x74, 0x22,
-		0x81, 0x57, 0xa5, 0xd3, 0xc2, 0x75, 0x97, 0xd2, 0x9e, 0xea, 0x2e, 0x3d, 0x21, 0x1c, 0x64, 0x09,
-		0xe1, 0xf8, 0x45, 0x96, 0x72, 0xdf, 0x45, 0x96, 0xee, 0x3d, 0x63, 0x54, 0xf8, 0x9e, 0xd1, 0x49,
-		0xf5, 0xef, 0xdb, 0x43, 0xaa, 0x7f, 0x6c, 0x4f, 0xa9, 0x7e, 0xcf, 0x07, 0x2f, 0x64, 0xed, 0x76,
-		0xeb, 0x78, 0x2b, 0xa9, 0xd7, 0x5b, 0x25, 0xdd, 0x6f, 0x36, 0xe0, 0x70, 0xa7, 0x42, 0x1e, 0xa9,
-		0x9a, 0xfa, 0x76, 0x3c, 0x97, 0x58, 0x03, 0x0f, 0xd7, 0x4d, 0x0f, 0x61, 0xd6, 0xb0, 0xfc, 0x3d,
-		0x89, 0x91, 0xd2, 0xe6, 0x9d, 0x2c, 0x22, 0xe6, 0x21, 0x09, 0x98, 0x47, 0x4f, 0xa4, 0x93, 0xcb,
-		0x10, 0xe9, 0xc8, 0xef, 0x4b, 0x70, 0x3c, 0xb1, 0x5b, 0xdb, 0x0b, 0xf5, 0x68, 0x2f, 0xb8, 0xa9,
-		0x36, 0x02, 0x51, 0x83, 0x3f, 0x74, 0x5b, 0x6d, 0xe0, 0x7e, 0x3f, 0x3d, 0xb0, 0x53, 0xa5, 0xab,
-		0xf1, 0x43, 0xe2, 0x37, 0xeb, 0xaf, 0xb1, 0x36, 0x89, 0xd7, 0x9d, 0x70, 0x12, 0xca, 0xb4, 0x3f,
-		0xa4, 0x57, 0x04, 0xfe, 0x10, 0x11, 0x41, 0xc7, 0xa9, 0xe7, 0xc4, 0x9d, 0x7a, 0x42, 0x9e, 0x5a,
-		0xfe, 0xaa, 0x04, 0x73, 0x19, 0x3a, 0x72, 0xba, 0xf9, 0x54, 0x29, 0x94, 0x4f, 0xed, 0x77, 0x67,
-		0x92, 0xa0, 0xfd, 0x32, 0x07, 0x2f, 0xec, 0xad, 0x2b, 0x79, 0x60, 0x3a, 0xdf, 0xcd, 0xd5, 0xe5,
-		0x42, 0xb9, 0xba, 0xfb, 0x80, 0xe2, 0xdd, 0x2f, 0xd4, 0xbe, 0xcf, 0x8a, 0x75, 0xb8, 0x2a, 0x13,
-		0xb1, 0x16, 0x56, 0x34, 0x05, 0x45, 0xcd, 0x32, 0x5d, 0xdb, 0xaa, 0x13, 0x45, 0x1b, 0x55, 0x82,
-		0x47, 0x54, 0x81, 0x03, 0x91, 0x46, 0x2e, 0xcb, 0xac, 0xfb, 0x91, 0xf9, 0x88, 0x32, 0x11, 0xea,
-		0xaf, 0xba, 0x63, 0xd6, 0x77, 0xe5, 0x37, 0xf2, 0x70, 0x63, 0x0f, 0x5d, 0xcf, 0xe8, 0x7e, 0xaf,
-		0xdf, 0x1b, 0xe3, 0xfc, 0xa6, 0x40, 0x88, 0x73, 0x28, 0xed, 0x3c, 0xa0, 0xfb, 0x24, 0x37, 0x87,
-		0xca, 0xde, 0x97, 0xa1, 0xbd, 0xee, 0xcb, 0x3c, 0xa0, 0x68, 0xaf, 0x19, 0xad, 0x50, 0xe4, 0x95,
-		0x71, 0x23, 0xa4, 0x84, 0x7e, 0x0a, 0x2b, 0xd8, 0xc5, 0x42, 0x68, 0x17, 0xe5, 0x3f, 0x49, 0x70,
-		0xad, 0xcf, 0x96, 0x6d, 0x0e, 0x06, 0x89, 0x83, 0xe1, 0x83, 0x55, 0x5c, 0xf9, 0x4b, 0x79, 0xb8,
-		0xd6, 0x67, 0x5b, 0xdd, 0xff, 0xaa, 0xad, 0x46, 0x3c, 0xf6, 0x10, 0xdf, 0x63, 0x0f, 0x8b, 0x7b,
-		0x6c, 0xae, 0xea, 0xf0, 0x1c, 0x40, 0x91, 0xe7, 0x00, 0x5e, 0xcd, 0xc3, 0x95, 0x7e, 0x5a, 0x03,
-		0xc5, 0x2c, 0x5f, 0x88, 0xf3, 0x13, 0xcb, 0xef, 0x5a, 0xfe, 0x7b, 0x12, 0x5c, 0xcc, 0xda, 0xe6,
-		0xf8, 0x5f, 0x6d, 0xf2, 0xfc, 0xb3, 0x4a, 0xfe, 0x9d, 0x04, 0x17, 0x32, 0xb5, 0x46, 0x0e, 0xcc,
-		0x05, 0x30, 0x6f, 0x0d, 0xb9, 0xbd, 0xdd, 0x1a, 0xfe, 0x32, 0x02, 0x97, 0xfb, 0xf8, 0x8d, 0x47,
-		0xcf, 0x76, 0x48, 0xa1, 0xed, 0x38, 0x09, 0xe5, 0xce, 0x76, 0x50, 0x9d, 0x2f, 0x29, 0x10, 0x0c,
-		0xb1, 0x52, 0x08, 0xf9, 0x01, 0xa4, 0x10, 0xfa, 0xad, 0x27, 0x0e, 0x0f, 0x36, 0x85, 0x50, 0x78,
-		0xac, 0x29, 0x84, 0x62, 0xdf, 0x29, 0x84, 0x07, 0x40, 0x3b, 0x54, 0x29, 0x47, 0x5a, 0x86, 0xf3,
-		0x9b, 0x04, 0xce, 0x26, 0xb4, 0xb9, 0x12, 0x2e, 0xb4, 0x18, 0x37, 0xd1, 0x8c, 0x0e, 0xf5, 0x1a,
-		0x49, 0x29, 0xec, 0xcf, 0x45, 0x54, 0x1e, 0x04, 0x54, 0x5e, 0x83, 0xa9, 0x1e, 0x75, 0xaa, 0xd9,
-		0xb8, 0xd5, 0x85, 0x5f, 0x26, 0xf0, 0xe7, 0x12, 0x15, 0xa7, 0xaa, 0x2b, 0xde, 0x14, 0xba, 0x84,
-		0x43, 0x6d, 0xd6, 0x70, 0xac, 0x3c, 0xb9, 0xaf, 0x9f, 0xf2, 0x64, 0xac, 0xd7, 0x70, 0x8c, 0xd1,
-		0x6b, 0xd8, 0xbd, 0x69, 0xed, 0xcf, 0x9e, 0x5b, 0x18, 0xdf, 0x43, 0x6e, 0x61, 0x62, 0x6f, 0x6d,
-		0x84, 0xcf, 0x41, 0x59, 0xc7, 0x75, 0x75, 0xd7, 0x57, 0xcd, 0xf4, 0x9e, 0x48, 0x20, 0xd4, 0x44,
-		0x15, 0xe5, 0xd7, 0xf3, 0x70, 0x31, 0xeb, 0x6f, 0xb0, 0x3e, 0x7c, 0xf7, 0xb2, 0x16, 0xc4, 0x09,
-		0x7e, 0xa5, 0xeb, 0x6a, 0xe6, 0x1f, 0x10, 0x85, 0xc2, 0x83, 0x1e, 0x43, 0x19, 0x0e, 0x1b, 0x0a,
-		0xfb, 0x10, 0x2c, 0x70, 0x0e, 0xc1, 0x01, 0xe5, 0x02, 0xe5, 0xdf, 0xe6, 0x60, 0x3e, 0xcb, 0x0f,
-		0xcc, 0xb8, 0xfb, 0xc1, 0x3e, 0x7d, 0x73, 0x7b, 0x3d, 0x7d, 0x07, 0xb5, 0x8b, 0x6c, 0xe9, 0x0e,
-		0x71, 0xa4, 0xdb, 0xb5, 0xce, 0x61, 0xf1, 0x3c, 0xc8, 0xfb, 0x39, 0xc8, 0xf8, 0xd3, 0xb7, 0x8f,
-		0x86, 0x30, 0x59, 0x65, 0x9d, 0x61, 0x66, 0x59, 0xa7, 0xdb, 0x8f, 0x50, 0x10, 0xef, 0x47, 0x90,
-		0xff, 0x99, 0x83, 0xf3, 0x83, 0xf0, 0x28, 0x1f, 0x51, 0xa1, 0xf7, 0x64, 0xdc, 0x0b, 0x19, 0x32,
-		0xee, 0xf2, 0xbf, 0x72, 0x70, 0x21, 0xd3, 0x2f, 0x11, 0x9f, 0x08, 0x3e, 0x26, 0xf8, 0x20, 0xa5,
-		0x58, 0xc8, 0x92, 0x67, 0xfe, 0x7c, 0x9e, 0x27, 0x78, 0x5e, 0x0f, 0xc9, 0x13, 0xc1, 0x27, 0xb6,
-		0xb0, 0x14, 0xfa, 0xe9, 0x7d, 0xff, 0x45, 0x0e, 0x16, 0x32, 0xfe, 0x42, 0xf4, 0xc9, 0x3e, 0x84,
-		0xf6, 0x61, 0xce, 0x85, 0xfd, 0xe4, 0xcf, 0x55, 0xa3, 0xee, 0x62, 0x9b, 0x7c, 0xea, 0x38, 0x4c,
-		0xaf, 0x3c, 0x58, 0xb9, 0xbd, 0x5e, 0x5b, 0xad, 0xae, 0xad, 0xaf, 0x28, 0xb5, 0xf5, 0x4f, 0xde,
-		0x5d, 0xa9, 0x55, 0x6f, 0x3f, 0x58, 0x5a, 0xab, 0xde, 0x1a, 0x7f, 0x0a, 0x9d, 0x84, 0xa3, 0xf1,
-		0xd7, 0x4b, 0x6b, 0x6b, 0x35, 0x32, 0x3a, 0x2e, 0xa1, 0x53, 0x70, 0x3c, 0x4e, 0xb0, 0xbc, 0x76,
-		0xe7, 0xde, 0x0a, 0x25, 0xc9, 0xdd, 0x7c, 0x00, 0x87, 0x35, 0xab, 0xc1, 0x92, 0xc1, 0xcd, 0x91,
-		0xa5, 0xa6, 0x71, 0xd7, 0x0b, 0x61, 0xef, 0x4a, 0x9f, 0x5a, 0x78, 0x68, 0xb8, 0x5b, 0xad, 0x8d,
-		0x8a, 0x66, 0x35, 0x16, 0x42, 0xff, 0xe7, 0xb4, 0xf2, 0x10, 0x9b, 0xfe, 0x7f, 0x56, 0xa5, 0xff,
-		0xf2, 0xf4, 0x86, 0xda, 0x34, 0x76, 0x2e, 0x6d, 0x14, 0xc8, 0xd8, 0xe5, 0xff, 0x04, 0x00, 0x00,
-		0xff, 0xff, 0xee, 0x07, 0x38, 0xbd, 0xd5, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x51, 0x6c, 0x1c, 0x47,
+		0x19, 0xee, 0xde, 0xd9, 0x67, 0xdf, 0x7f, 0x8e, 0x63, 0x8f, 0x13, 0xc7, 0x4e, 0x9c, 0xc4, 0xde,
+		0xa4, 0x89, 0xeb, 0x38, 0xe7, 0xc4, 0x49, 0x13, 0xd2, 0xd0, 0x16, 0xc7, 0xb1, 0x95, 0x13, 0x26,
+		0x89, 0x36, 0x4e, 0x0a, 0x08, 0xe9, 0x58, 0xef, 0x8e, 0xe3, 0x95, 0xef, 0x76, 0xaf, 0xbb, 0x7b,
+		0xbe, 0x18, 0x89, 0x27, 0x1e, 0x90, 0x50, 0x2b, 0xa8, 0x2a, 0x24, 0x2a, 0x10, 0x45, 0x48, 0xa0,
+		0x16, 0x21, 0x15, 0x81, 0x10, 0x20, 0x5e, 0x00, 0x09, 0x81, 0x04, 0x2a, 0x3c, 0xf1, 0x02, 0x8f,
+		0x3c, 0xd0, 0x37, 0x1e, 0x28, 0x6f, 0x48, 0x68, 0x67, 0x67, 0xef, 0x6e, 0x77, 0x67, 0x76, 0x67,
+		0xcf, 0x4e, 0x0b, 0x6a, 0xde, 0xbc, 0xb3, 0xff, 0xfc, 0xfb, 0xfd, 0x33, 0xff, 0xff, 0xcf, 0x3f,
+		0xff, 0xff, 0x9f, 0x61, 0xa6, 0xb9, 0x81, 0xed, 0x05, 0x4d, 0xd5, 0xb1, 0xa9, 0xe1, 0x05, 0xb5,
+		0x61, 0x2c, 0xec, 0x5c, 0x5c, 0xd8, 0x32, 0x1c, 0xd7, 0xb2, 0x77, 0xcb, 0x0d, 0xdb, 0x72, 0x2d,
+		0x34, 0xe6, 0x91, 0x94, 0x29, 0x49, 0x59, 0x6d, 0x18, 0xe5, 0x9d, 0x8b, 0x47, 0x4f, 0x3c, 0xb4,
+		0xac, 0x87, 0x35, 0xbc, 0x40, 0x48, 0x36, 0x9a, 0x9b, 0x0b, 0x7a, 0xd3, 0x56, 0x5d, 0xc3, 0x32,
+		0xfd, 0x49, 0x47, 0x4f, 0x46, 0xdf, 0xbb, 0x46, 0x1d, 0x3b, 0xae, 0x5a, 0x6f, 0x50, 0x82, 0x69,
+		0xd6, 0x87, 0x35, 0xab, 0x5e, 0x6f, 0xb3, 0x90, 0x59, 0x14, 0xae, 0xea, 0x6c, 0xd7, 0x0c, 0xc7,
+		0x4d, 0xa2, 0x69, 0x59, 0xf6, 0xf6, 0x66, 0xcd, 0x6a, 0xf9, 0x34, 0xf2, 0x4d, 0x18, 0xb8, 0xe5,
+		0x0b, 0x84, 0xae, 0x41, 0x01, 0xef, 0x60, 0xd3, 0x75, 0x26, 0xa4, 0xe9, 0xfc, 0x6c, 0x69, 0x71,
+		0xa6, 0xcc, 0x90, 0xad, 0x4c, 0xa9, 0x57, 0x3c, 0x4a, 0x85, 0x4e, 0x90, 0xdf, 0xbb, 0x0a, 0x43,
+		0xdd, 0x2f, 0xd0, 0x24, 0x0c, 0x92, 0x57, 0x55, 0x43, 0x9f, 0x90, 0xa6, 0xa5, 0xd9, 0xbc, 0x32,
+		0x40, 0x9e, 0x2b, 0x3a, 0xba, 0x06, 0xe0, 0xbf, 0xf2, 0x84, 0x9e, 0xc8, 0x4d, 0x4b, 0xb3, 0xa5,
+		0xc5, 0xa3, 0x65, 0x7f, 0x45, 0xca, 0xc1, 0x8a, 0x94, 0xd7, 0x83, 0x15, 0x51, 0x8a, 0x84, 0xda,
+		0x7b, 0x46, 0x13, 0x30, 0xb0, 0x83, 0x6d, 0xc7, 0xb0, 0xcc, 0x89, 0xbc, 0xcf, 0x94, 0x3e, 0xa2,
+		0x23, 0x30, 0xe0, 0x09, 0xef, 0x7d, 0xae, 0x8f, 0xbc, 0x29, 0x78, 0x8f, 0x15, 0x1d, 0x7d, 0x4b,
+		0x82, 0x73, 0x81, 0xc8, 0x55, 0xfc, 0x08, 0x6b, 0x4d, 0x6f, 0x1f, 0xaa, 0x8e, 0xab, 0xda, 0x2e,
+		0xd6, 0xab, 0x3e, 0x12, 0xd5, 0x75, 0x6d, 0x63, 0xa3, 0xe9, 0x62, 0x67, 0xa2, 0x9f, 0xe0, 0xf9,
+		0x38, 0x53, 0xf4, 0x97, 0x28, 0x9f, 0x95, 0x80, 0xcd, 0x3d, 0x9f, 0x0b, 0x11, 0x79, 0xa9, 0xcd,
+		0xe3, 0xd6, 0x53, 0xca, 0xd9, 0x96, 0x18, 0x29, 0xfa, 0xae, 0x04, 0xe7, 0x19, 0xf0, 0x34, 0xab,
+		0xde, 0xa8, 0x61, 0x26, 0xc0, 0x02, 0x01, 0xf8, 0x82, 0x18, 0xc0, 0xe5, 0x80, 0x4f, 0x1c, 0xe2,
+		0x33, 0x2d, 0x51, 0x62, 0xf4, 0x86, 0x04, 0x73, 0x0c, 0x90, 0x9b, 0xaa, 0x51, 0x63, 0x21, 0x1c,
+		0x20, 0x08, 0xaf, 0x8b, 0x21, 0x5c, 0x25, 0x4c, 0xe2, 0xf0, 0xce, 0xb4, 0x84, 0x28, 0xd1, 0x77,
+		0xd8, 0x0b, 0xe8, 0xe9, 0x96, 0x5e, 0xb5, 0x9a, 0x6e, 0x1c, 0xde, 0x20, 0x81, 0xf7, 0xbc, 0x18,
+		0x3c, 0x4f, 0xed, 0xf4, 0x3b, 0x4d, 0x37, 0x0e, 0x70, 0xb6, 0x25, 0x48, 0x8b, 0x5e, 0x97, 0x60,
+		0x56, 0xc7, 0x9a, 0xe1, 0x10, 0x60, 0x9e, 0x96, 0x3a, 0xda, 0x16, 0xd6, 0x9b, 0xcc, 0xc5, 0x2b,
+		0x12, 0x74, 0xd7, 0x98, 0xe8, 0x6e, 0x52, 0x26, 0xeb, 0xaa, 0xb3, 0x7d, 0x2f, 0x60, 0x11, 0x47,
+		0x76, 0x5a, 0x17, 0xa0, 0x43, 0xaf, 0x4a, 0x70, 0x26, 0x82, 0x8a, 0x67, 0x13, 0x40, 0x30, 0x5d,
+		0x4d, 0xc7, 0xc4, 0x33, 0x07, 0x59, 0x4f, 0xa5, 0x62, 0xac, 0x52, 0x82, 0x11, 0x94, 0x04, 0x57,
+		0x29, 0x41, 0xff, 0x4f, 0xeb, 0x02, 0x74, 0xe8, 0xb5, 0x18, 0xaa, 0x04, 0xcd, 0x1a, 0x22, 0xa8,
+		0x3e, 0x96, 0x8a, 0x8a, 0xaf, 0x54, 0xa7, 0xf4, 0x74, 0x32, 0xf4, 0x15, 0x09, 0x9e, 0x0e, 0x63,
+		0xe2, 0x59, 0xe2, 0x01, 0x02, 0xe8, 0x4a, 0x2a, 0x20, 0x9e, 0x11, 0xce, 0xe8, 0x69, 0x44, 0x64,
+		0xdb, 0x54, 0xcd, 0x35, 0x76, 0x0c, 0x77, 0x37, 0x55, 0xb9, 0x87, 0x13, 0xb6, 0x6d, 0x89, 0x32,
+		0x49, 0x53, 0x6e, 0x55, 0x80, 0x8e, 0x28, 0x77, 0x04, 0x15, 0x4f, 0xb9, 0x0f, 0x26, 0x28, 0x77,
+		0x08, 0x13, 0x57, 0xb9, 0xd5, 0x54, 0x2a, 0xc6, 0x2a, 0x25, 0x28, 0xf7, 0x88, 0xe0, 0x2a, 0x25,
+		0x29, 0xb7, 0x2a, 0x40, 0x47, 0x14, 0x29, 0x8c, 0x8a, 0xa7, 0x48, 0xa3, 0x09, 0x8a, 0xd4, 0x0d,
+		0x89, 0xab, 0x48, 0x6a, 0x1a, 0x11, 0xb1, 0xb4, 0x30, 0x98, 0x04, 0x4b, 0x43, 0x09, 0x96, 0xd6,
+		0x8d, 0x27, 0xc1, 0xd2, 0xd4, 0x74, 0x32, 0xd4, 0x82, 0x13, 0x1e, 0x08, 0x9b, 0xaf, 0x3d, 0x63,
+		0x04, 0xc8, 0x05, 0x26, 0x10, 0x8f, 0xab, 0xcd, 0x55, 0x9b, 0x63, 0x2e, 0xff, 0x35, 0x7a, 0x19,
+		0xa6, 0xfc, 0x0f, 0x6f, 0x1a, 0x36, 0xeb, 0xb3, 0x87, 0xc8, 0x67, 0xcb, 0xfc, 0xcf, 0xae, 0x1a,
+		0x76, 0x8c, 0xeb, 0xad, 0xa7, 0x94, 0x49, 0x97, 0xf7, 0x12, 0x7d, 0x5f, 0x82, 0x85, 0x88, 0x8a,
+		0xaa, 0xa6, 0x86, 0x6b, 0x55, 0x1b, 0xbf, 0xdc, 0xc4, 0x0e, 0x53, 0xfa, 0xc3, 0x04, 0xc6, 0x8b,
+		0xe9, 0x9a, 0x4a, 0x38, 0x29, 0x01, 0xa3, 0x38, 0xae, 0x39, 0x55, 0x98, 0x1a, 0xfd, 0x44, 0x82,
+		0xcb, 0x14, 0x53, 0x00, 0x51, 0x4c, 0x89, 0xc7, 0x09, 0xda, 0x65, 0x26, 0x5a, 0xfa, 0x35, 0xff,
+		0xd3, 0x22, 0x1a, 0x5d, 0xb6, 0x33, 0xcd, 0x40, 0x5f, 0x93, 0xe0, 0x2c, 0x6b, 0x79, 0x59, 0x40,
+		0x8f, 0x08, 0x6a, 0xf7, 0x32, 0xe5, 0x90, 0xa2, 0xdd, 0x1c, 0x32, 0xf4, 0x05, 0x38, 0xe9, 0x2b,
+		0x19, 0x1f, 0xc9, 0x04, 0x41, 0x72, 0x91, 0xaf, 0x67, 0x7c, 0x08, 0x53, 0x6e, 0xc2, 0x7b, 0xf4,
+		0x65, 0x09, 0x4e, 0xd3, 0xcd, 0xa3, 0x8a, 0xce, 0xd9, 0xb4, 0x49, 0x82, 0xe0, 0x59, 0x26, 0x02,
+		0x9f, 0xb9, 0xaf, 0xef, 0x9c, 0x6d, 0x9a, 0xd6, 0x52, 0x68, 0xd0, 0x17, 0x61, 0xba, 0xae, 0xda,
+		0xdb, 0xd8, 0xae, 0xda, 0x58, 0xb3, 0x6c, 0x9d, 0x05, 0xe2, 0x28, 0x01, 0xb1, 0xc8, 0x04, 0xf1,
+		0x29, 0x32, 0x59, 0xa1, 0x73, 0xe3, 0x08, 0x8e, 0xd7, 0x93, 0x08, 0xd0, 0x9b, 0x12, 0xcc, 0xb3,
+		0xee, 0x27, 0xc6, 0x43, 0x53, 0x65, 0x2e, 0xc8, 0xb1, 0x2c, 0xe1, 0xeb, 0x3d, 0xca, 0x46, 0x24,
+		0x7c, 0xe5, 0xd0, 0xa2, 0xef, 0x49, 0x50, 0x66, 0x20, 0x74, 0xb1, 0x5d, 0x37, 0x4c, 0x95, 0xe9,
+		0x17, 0xa6, 0x12, 0xfc, 0x42, 0x3c, 0xc4, 0x6e, 0x33, 0x62, 0xf8, 0x85, 0x96, 0x30, 0x35, 0xfa,
+		0xa9, 0x04, 0x97, 0x59, 0x57, 0xa9, 0x54, 0x2f, 0x76, 0x9c, 0xa0, 0xbd, 0x29, 0x78, 0xa3, 0x4a,
+		0x73, 0x65, 0x0b, 0xad, 0x6c, 0x53, 0x78, 0x1a, 0xc0, 0x37, 0xca, 0x13, 0x59, 0x34, 0x80, 0x6f,
+		0xa0, 0xb3, 0x2d, 0x41, 0x5a, 0xf4, 0x77, 0x09, 0x56, 0x22, 0x1e, 0x17, 0x3f, 0x72, 0xb1, 0x6d,
+		0xaa, 0xb5, 0x2a, 0x03, 0xb9, 0x61, 0x1a, 0xae, 0xc1, 0x56, 0x8c, 0x93, 0x04, 0xfa, 0xbd, 0x74,
+		0x17, 0xbc, 0x42, 0xf9, 0xc7, 0xe4, 0xa9, 0x04, 0xcc, 0xe3, 0x02, 0xbd, 0x60, 0xef, 0x89, 0x03,
+		0xfa, 0xab, 0x04, 0x37, 0x32, 0x88, 0xc9, 0xf3, 0x58, 0xd3, 0x44, 0xc6, 0xbb, 0x7b, 0x90, 0x91,
+		0xe7, 0xcc, 0xae, 0xdb, 0xbd, 0x4f, 0x47, 0xef, 0x4a, 0xf0, 0x7c, 0x92, 0x38, 0xe9, 0x76, 0x32,
+		0x43, 0x04, 0x5b, 0x63, 0x0a, 0xc6, 0x05, 0x93, 0x6a, 0x2f, 0x57, 0x71, 0x6f, 0x53, 0x49, 0x1c,
+		0xc0, 0x92, 0xc3, 0x32, 0x5d, 0xc3, 0x6c, 0x62, 0xbd, 0xaa, 0x3a, 0x55, 0x13, 0xb7, 0xe2, 0x72,
+		0xc8, 0x09, 0x71, 0x40, 0x1c, 0x44, 0xc0, 0x6e, 0xc9, 0xb9, 0x8d, 0x5b, 0x71, 0xf8, 0xe5, 0x56,
+		0xa6, 0x19, 0xe8, 0x37, 0x12, 0x5c, 0x23, 0xd1, 0x64, 0x55, 0xdb, 0x32, 0x6a, 0x7a, 0x46, 0xfb,
+		0x39, 0x45, 0xa0, 0xdf, 0x62, 0x42, 0x27, 0xa1, 0xe4, 0xb2, 0xc7, 0x34, 0x8b, 0xd1, 0x5c, 0x72,
+		0xb2, 0x4f, 0x43, 0xbf, 0x90, 0xe0, 0x4a, 0x8a, 0x10, 0x3c, 0xeb, 0x38, 0x4d, 0x24, 0x58, 0xc9,
+		0x2a, 0x01, 0xcf, 0x24, 0x2e, 0x38, 0x19, 0xe7, 0xa0, 0x1f, 0x4a, 0x70, 0x91, 0x8b, 0x9a, 0x1b,
+		0xe7, 0x3f, 0x4d, 0x60, 0x2f, 0xb1, 0xc3, 0x10, 0xe6, 0xd7, 0xb9, 0x81, 0xff, 0xbc, 0x96, 0x81,
+		0x1e, 0xfd, 0x58, 0x82, 0x4b, 0x5c, 0xb8, 0x09, 0x97, 0xc8, 0x33, 0x09, 0x4a, 0xce, 0x06, 0x9c,
+		0x70, 0x9d, 0x2c, 0x6b, 0x99, 0x66, 0xa0, 0xb7, 0x25, 0xb8, 0x90, 0x59, 0x33, 0xce, 0x12, 0xc4,
+		0x9f, 0xc8, 0x80, 0x98, 0xa7, 0x14, 0xe7, 0xb4, 0x0c, 0xfa, 0xf0, 0x8e, 0x04, 0x8b, 0xfc, 0x05,
+		0xe6, 0x1e, 0xc2, 0xb3, 0x04, 0xed, 0x8d, 0x2c, 0xeb, 0xcb, 0x3d, 0x89, 0xcf, 0x6b, 0x59, 0x26,
+		0xa0, 0x1f, 0x25, 0xa9, 0x44, 0xc2, 0xa5, 0xf9, 0x99, 0xcc, 0x90, 0xf9, 0xd7, 0xe7, 0xf3, 0x5a,
+		0x96, 0x09, 0x24, 0x36, 0xe3, 0x43, 0x4e, 0x88, 0x24, 0xe7, 0x12, 0x62, 0x33, 0x0e, 0xe6, 0x84,
+		0x70, 0x72, 0x41, 0xcb, 0x36, 0x85, 0x1c, 0x9a, 0x7e, 0x28, 0xde, 0x6b, 0xc4, 0x73, 0x2e, 0xe1,
+		0xd0, 0xf4, 0x23, 0xee, 0x5e, 0x42, 0x9d, 0xab, 0x4e, 0x6f, 0x53, 0xd1, 0x6f, 0x25, 0x78, 0x4e,
+		0x40, 0x20, 0x9e, 0x8d, 0xce, 0x13, 0x69, 0x2a, 0xbd, 0x48, 0xc3, 0x33, 0xd6, 0xcb, 0x4e, 0x0f,
+		0xf3, 0xd0, 0xcf, 0x25, 0x78, 0x36, 0x49, 0x00, 0xfe, 0xfd, 0xe9, 0x7c, 0xc2, 0x01, 0xc4, 0x05,
+		0xc1, 0xbf, 0x47, 0x5d, 0xc0, 0x19, 0xe7, 0x10, 0x87, 0xd3, 0x6c, 0x38, 0xd8, 0x76, 0x3b, 0xc0,
+		0x1d, 0xac, 0xda, 0xda, 0x56, 0x17, 0xcc, 0x38, 0xee, 0x72, 0x82, 0xf5, 0xde, 0x27, 0xec, 0x02,
+		0x04, 0xf7, 0x08, 0xb3, 0xce, 0x17, 0x19, 0xd6, 0xdb, 0xcc, 0x32, 0xe1, 0xc6, 0x10, 0x40, 0x07,
+		0x88, 0xfc, 0xe6, 0x10, 0x9c, 0x15, 0x3d, 0xbd, 0x56, 0xe1, 0x40, 0x5b, 0x46, 0x77, 0xb7, 0x81,
+		0x49, 0x2d, 0x90, 0x57, 0x59, 0x0c, 0x98, 0xae, 0xef, 0x36, 0xb0, 0x32, 0xd4, 0xea, 0x7a, 0x42,
+		0x9f, 0x83, 0xc3, 0x0d, 0xd5, 0xf6, 0x56, 0xa4, 0xdb, 0xe8, 0x36, 0x2d, 0x5a, 0x3e, 0x9c, 0x65,
+		0xf2, 0xbb, 0x4b, 0x66, 0x74, 0xd9, 0xc4, 0xa6, 0xa5, 0x8c, 0x35, 0xe2, 0x83, 0xe8, 0x39, 0x28,
+		0x92, 0x8c, 0x4c, 0xcd, 0x70, 0x5c, 0x52, 0x58, 0x2c, 0x2d, 0x1e, 0x67, 0xa7, 0x3c, 0x54, 0x67,
+		0x7b, 0xcd, 0x70, 0x5c, 0x65, 0xd0, 0xa5, 0x7f, 0xa1, 0x45, 0xe8, 0x37, 0xcc, 0x46, 0xd3, 0x25,
+		0x65, 0xc7, 0xd2, 0xe2, 0x14, 0x07, 0xc9, 0x6e, 0xcd, 0x52, 0x75, 0xc5, 0x27, 0x45, 0x2a, 0x4c,
+		0x47, 0x42, 0x8e, 0xaa, 0x6b, 0x55, 0xb5, 0x9a, 0xe5, 0x60, 0xe2, 0xbf, 0xad, 0xa6, 0x4b, 0xeb,
+		0x90, 0x93, 0xb1, 0xba, 0xe8, 0x4d, 0x5a, 0x49, 0x56, 0xa6, 0x70, 0x68, 0xed, 0xd7, 0xad, 0x65,
+		0x6f, 0xfe, 0xba, 0x3f, 0x1d, 0xbd, 0x04, 0xc7, 0x3a, 0x69, 0xef, 0x38, 0xf7, 0x42, 0x1a, 0xf7,
+		0x23, 0x6e, 0x90, 0xcc, 0x8e, 0x30, 0xbe, 0x0e, 0x47, 0x3b, 0x11, 0x76, 0x47, 0x0a, 0xbb, 0x69,
+		0x7a, 0xb5, 0x57, 0xaf, 0xf4, 0x57, 0x54, 0x8e, 0xb4, 0x29, 0xda, 0xeb, 0xac, 0x34, 0xcd, 0x8a,
+		0x8e, 0x2a, 0x50, 0xa4, 0xae, 0xd2, 0xb2, 0x49, 0x1d, 0x6e, 0x78, 0xf1, 0x1c, 0xdb, 0xb5, 0x53,
+		0x06, 0x24, 0x84, 0xae, 0x04, 0x53, 0x94, 0xce, 0x6c, 0x54, 0x81, 0xd1, 0x0e, 0x0e, 0xcf, 0x5d,
+		0x35, 0x6d, 0x3c, 0x51, 0x4c, 0xd8, 0x83, 0x55, 0x9f, 0x46, 0x19, 0x69, 0x4f, 0xa3, 0x23, 0x48,
+		0x81, 0xf1, 0x9a, 0xea, 0xdd, 0xf9, 0xfc, 0x70, 0x86, 0x88, 0x83, 0x9d, 0x66, 0xcd, 0x9d, 0x80,
+		0x04, 0x7e, 0xc1, 0x9e, 0x1e, 0xf2, 0xe6, 0x2e, 0xb7, 0xa7, 0x2a, 0x64, 0x26, 0xba, 0x06, 0x93,
+		0x96, 0x6d, 0x3c, 0x34, 0x7c, 0x47, 0x1b, 0x59, 0xa5, 0x12, 0x59, 0xa5, 0xf1, 0x80, 0x20, 0xb2,
+		0x48, 0x47, 0x61, 0xd0, 0xd0, 0xb1, 0xe9, 0x1a, 0xee, 0x2e, 0xa9, 0x28, 0x15, 0x95, 0xf6, 0x33,
+		0xba, 0x04, 0xe3, 0x9b, 0x86, 0xed, 0xb8, 0x71, 0x9e, 0x07, 0x08, 0xe5, 0x18, 0x79, 0x1b, 0x61,
+		0xb8, 0x0c, 0x43, 0x36, 0x76, 0xed, 0xdd, 0x6a, 0xc3, 0xaa, 0x19, 0xda, 0x2e, 0xad, 0xc2, 0x4c,
+		0x73, 0x2e, 0xa8, 0xae, 0xbd, 0x7b, 0x97, 0xd0, 0x29, 0x25, 0xbb, 0xf3, 0xe0, 0x95, 0xde, 0x55,
+		0xd7, 0xc5, 0xf5, 0x86, 0x4b, 0x2a, 0x26, 0xfd, 0x4a, 0xf0, 0x88, 0x96, 0xe1, 0x20, 0x7e, 0xd4,
+		0x30, 0x7c, 0xc5, 0xf1, 0x8b, 0xfa, 0x23, 0xa9, 0x45, 0xfd, 0xe1, 0xce, 0x14, 0x6f, 0x10, 0x9d,
+		0x82, 0x03, 0x9a, 0xed, 0x59, 0x03, 0xad, 0xe8, 0x90, 0x8a, 0x43, 0x51, 0x19, 0xf2, 0x06, 0x83,
+		0x2a, 0x0f, 0xfa, 0x34, 0x1c, 0xf3, 0xa5, 0x0f, 0x57, 0xbf, 0x36, 0x54, 0x6d, 0xdb, 0xda, 0xdc,
+		0x9c, 0x40, 0x69, 0x4a, 0x3d, 0x41, 0x66, 0x77, 0x17, 0xbe, 0x6e, 0xf8, 0x53, 0xd1, 0x79, 0xe8,
+		0xab, 0xe3, 0xba, 0x45, 0xd3, 0xf9, 0x93, 0xec, 0x44, 0x1f, 0xae, 0x5b, 0x0a, 0x21, 0x43, 0x0a,
+		0x8c, 0xc6, 0x3c, 0x36, 0xcd, 0xc9, 0x3f, 0xcd, 0x3e, 0x1b, 0x23, 0x1e, 0x56, 0x19, 0x71, 0x22,
+		0x23, 0xe8, 0x3e, 0x8c, 0x37, 0x6c, 0xbc, 0x53, 0x55, 0x9b, 0xae, 0xe5, 0xe9, 0x1f, 0x76, 0xab,
+		0x0d, 0xcb, 0x30, 0xdd, 0x20, 0xcb, 0xce, 0xdb, 0x2f, 0x07, 0xbb, 0x77, 0x09, 0x9d, 0x32, 0xe6,
+		0xcd, 0x5f, 0x6a, 0xba, 0x56, 0xd7, 0x20, 0xba, 0x04, 0x85, 0x2d, 0xac, 0xea, 0xd8, 0xa6, 0xe9,
+		0xef, 0x63, 0xec, 0xa6, 0x0e, 0x42, 0xa2, 0x50, 0x52, 0x4f, 0x05, 0x1b, 0xb6, 0x61, 0xd9, 0x9e,
+		0x0a, 0x1e, 0x21, 0xbb, 0xdd, 0x7e, 0x46, 0x33, 0x30, 0xb4, 0xa9, 0x1a, 0xb6, 0x89, 0x1d, 0xa7,
+		0xba, 0x8d, 0x77, 0x49, 0x8a, 0xb8, 0xa8, 0x94, 0x82, 0xb1, 0x4f, 0xe2, 0x5d, 0xf9, 0x6d, 0x09,
+		0x9e, 0x11, 0xbf, 0x2c, 0x5c, 0x86, 0x02, 0x35, 0x37, 0x49, 0xc0, 0xdc, 0x28, 0x2d, 0x5a, 0x85,
+		0xe9, 0xe4, 0x6a, 0xb1, 0xa1, 0x93, 0xc3, 0x21, 0xaf, 0x4c, 0xf1, 0x0b, 0xbd, 0x15, 0x5d, 0x7e,
+		0x4b, 0x82, 0x33, 0x82, 0x31, 0xc7, 0x15, 0x18, 0x08, 0x1c, 0x8d, 0x24, 0xe0, 0x68, 0x02, 0xe2,
+		0x7d, 0x83, 0x6a, 0xc1, 0xac, 0x70, 0xc0, 0xbd, 0x0c, 0x43, 0xd4, 0xd7, 0x77, 0xce, 0xdd, 0x61,
+		0x8e, 0x0e, 0x51, 0xd7, 0x4e, 0x8e, 0xdd, 0x92, 0xdb, 0x79, 0x90, 0xff, 0x28, 0xc1, 0x69, 0x91,
+		0x9e, 0x83, 0xf0, 0x01, 0x2a, 0x65, 0x3b, 0x40, 0x6f, 0xc3, 0x38, 0xe7, 0x90, 0xca, 0xa5, 0xd9,
+		0xf3, 0x98, 0xc3, 0x38, 0xa0, 0xba, 0x1c, 0x55, 0x3e, 0xe4, 0xa8, 0xe4, 0x57, 0x25, 0x90, 0xd3,
+		0xdb, 0x15, 0xd0, 0x3c, 0xa0, 0x68, 0x09, 0xbb, 0xdd, 0xc4, 0x34, 0xe2, 0x84, 0x96, 0x20, 0xe2,
+		0xad, 0x73, 0x11, 0x6f, 0x7d, 0x1c, 0x20, 0xc8, 0x27, 0x1a, 0x3a, 0x41, 0x53, 0x54, 0x8a, 0x74,
+		0xa4, 0xa2, 0xcb, 0xff, 0x8c, 0x2c, 0x2f, 0xd7, 0x42, 0xb2, 0x21, 0x9a, 0x85, 0x91, 0x70, 0x1a,
+		0xa3, 0xad, 0x5e, 0xc3, 0x4e, 0x97, 0xc4, 0x11, 0xec, 0xf9, 0x08, 0xf6, 0xb3, 0x70, 0x70, 0xc3,
+		0x30, 0x55, 0x7b, 0xb7, 0xaa, 0x6d, 0x61, 0x6d, 0xdb, 0x69, 0xd6, 0x49, 0x84, 0x53, 0x54, 0x86,
+		0xfd, 0xe1, 0x65, 0x3a, 0x8a, 0xce, 0xc1, 0x68, 0x38, 0xf9, 0x86, 0x1f, 0xf9, 0xd1, 0xcb, 0x90,
+		0x32, 0x82, 0xbb, 0x73, 0x62, 0xf8, 0x91, 0x2b, 0xbf, 0x92, 0x87, 0x53, 0x02, 0x9d, 0x10, 0x8f,
+		0x4d, 0xe2, 0xa8, 0x59, 0xe4, 0x7b, 0x30, 0x0b, 0x74, 0x02, 0x4a, 0x1b, 0xaa, 0x83, 0x83, 0x93,
+		0xd7, 0x5f, 0x96, 0xa2, 0x37, 0xe4, 0x9f, 0xb7, 0x53, 0x00, 0x5e, 0xde, 0x91, 0xbe, 0xee, 0xf7,
+		0x17, 0xd6, 0xc4, 0x2d, 0xff, 0xed, 0x3c, 0xa0, 0x4d, 0xcb, 0xde, 0xa6, 0x48, 0x83, 0x76, 0xb6,
+		0x82, 0x2f, 0x9a, 0xf7, 0x86, 0x60, 0x7d, 0xe0, 0x8f, 0xa3, 0x71, 0xcf, 0x39, 0xaa, 0x8e, 0x65,
+		0xd2, 0xd0, 0x8a, 0x3e, 0xa1, 0x9b, 0xd0, 0xaf, 0xa9, 0x4d, 0x07, 0xd3, 0x28, 0xaa, 0x2c, 0xdc,
+		0x73, 0xb2, 0xec, 0xcd, 0x52, 0xfc, 0xc9, 0xf2, 0x5b, 0x79, 0x98, 0x49, 0xed, 0x03, 0x79, 0x6c,
+		0x9b, 0x71, 0x23, 0x90, 0xc1, 0xdf, 0x85, 0x79, 0xc1, 0x36, 0x95, 0x6e, 0x09, 0xba, 0x7d, 0x72,
+		0x5f, 0x16, 0x9f, 0xdc, 0xad, 0xfa, 0xfd, 0x11, 0xd5, 0x8f, 0xec, 0x6f, 0x21, 0x79, 0x7f, 0x07,
+		0x84, 0xf6, 0x77, 0x90, 0xb3, 0xbf, 0x0c, 0x33, 0x2b, 0xb2, 0xcc, 0x4c, 0xfe, 0x5b, 0x01, 0x4e,
+		0x8b, 0xb4, 0xc8, 0xa0, 0x93, 0x50, 0x6a, 0xd7, 0x99, 0xe9, 0x36, 0x15, 0x15, 0x08, 0x86, 0x2a,
+		0xba, 0x77, 0x27, 0x6b, 0x13, 0x10, 0x23, 0xc8, 0x25, 0xdc, 0xc9, 0xda, 0x9f, 0x24, 0x77, 0x32,
+		0xb5, 0xeb, 0xc9, 0x53, 0x4d, 0xdd, 0xaa, 0xab, 0x86, 0x49, 0x7d, 0x07, 0x7d, 0x0a, 0x1f, 0x06,
+		0x7d, 0x3d, 0xde, 0xa6, 0x0a, 0xe2, 0xb7, 0xa9, 0x75, 0x98, 0x0c, 0x94, 0x30, 0x7e, 0x86, 0x0c,
+		0xa4, 0x9d, 0x21, 0xe3, 0xc1, 0xdc, 0xc8, 0x31, 0x12, 0xe1, 0x4a, 0x8f, 0x28, 0xca, 0x75, 0x30,
+		0x03, 0x57, 0xff, 0x12, 0x45, 0xb9, 0xf2, 0x0f, 0xbb, 0x62, 0x4f, 0x87, 0xdd, 0x2a, 0x8c, 0x6e,
+		0x61, 0xd5, 0x76, 0x37, 0xb0, 0xda, 0x41, 0x07, 0x69, 0xac, 0x46, 0xda, 0x73, 0x3a, 0x7c, 0xd2,
+		0x43, 0x94, 0x52, 0x7a, 0x88, 0x12, 0xbb, 0x6a, 0x0c, 0xf5, 0x72, 0xd5, 0xe8, 0x84, 0xac, 0x07,
+		0x7a, 0x0b, 0x59, 0x87, 0x53, 0x42, 0xd6, 0x83, 0xf1, 0x90, 0xf5, 0x1f, 0x12, 0xc8, 0xe9, 0xdd,
+		0x5e, 0x1f, 0x58, 0x6c, 0xd0, 0x1d, 0xc5, 0xf4, 0x85, 0xaf, 0x5b, 0x2f, 0xc2, 0x10, 0xb9, 0xad,
+		0x06, 0x6e, 0xaf, 0x5f, 0xc0, 0xed, 0x95, 0xbc, 0x19, 0xf4, 0x41, 0xfe, 0xb3, 0x14, 0xf6, 0x24,
+		0xfb, 0x1c, 0x98, 0xb3, 0x97, 0x28, 0x97, 0xe1, 0xb4, 0xc8, 0xa7, 0x06, 0x2b, 0x7d, 0xe1, 0xc5,
+		0x94, 0xff, 0x24, 0xc1, 0x4c, 0x7a, 0x0b, 0x4e, 0xaf, 0xf1, 0xfb, 0x87, 0x21, 0xd1, 0x2f, 0x73,
+		0x70, 0x4a, 0xa0, 0x91, 0xcd, 0x93, 0x49, 0xc7, 0xae, 0x6a, 0xd4, 0x1c, 0xa1, 0x4d, 0x0a, 0x88,
+		0x1f, 0x9b, 0x4c, 0xd1, 0x00, 0xab, 0xaf, 0x97, 0x00, 0x6b, 0xcf, 0x2a, 0xfe, 0x75, 0x09, 0xe6,
+		0xc4, 0xfb, 0xcf, 0x44, 0x8e, 0xcc, 0xfd, 0xb9, 0xc1, 0xbd, 0x23, 0x41, 0xc6, 0x4e, 0xb3, 0x74,
+		0x6c, 0x87, 0x82, 0x28, 0xca, 0xf7, 0x30, 0xfe, 0x83, 0x10, 0xe2, 0xbc, 0x00, 0xe2, 0x37, 0x22,
+		0x7a, 0xc8, 0xab, 0x49, 0xf5, 0xaa, 0x87, 0xab, 0x30, 0x5d, 0x53, 0xdd, 0xae, 0x8e, 0x8b, 0x68,
+		0xff, 0x41, 0x67, 0x65, 0x7d, 0x3a, 0xd6, 0x56, 0xfa, 0x51, 0x17, 0x43, 0x9f, 0xf3, 0x19, 0xf4,
+		0xb9, 0x2f, 0xd5, 0x46, 0x23, 0x71, 0xa2, 0xfc, 0xae, 0x04, 0xc7, 0x12, 0x7a, 0x3c, 0xbd, 0xdf,
+		0xc0, 0xf8, 0xbd, 0x6d, 0xed, 0x7d, 0x1b, 0x20, 0xcf, 0x15, 0x1d, 0xad, 0xc1, 0xe1, 0x76, 0x1c,
+		0xb0, 0x69, 0xd8, 0x19, 0xee, 0xbc, 0x88, 0x86, 0x01, 0x5e, 0x0f, 0x67, 0x96, 0xd3, 0x5b, 0x64,
+		0xb3, 0x3f, 0x0f, 0x93, 0xdc, 0xe6, 0xd1, 0x24, 0x69, 0x84, 0x43, 0x7e, 0xf9, 0x77, 0x12, 0x4c,
+		0x25, 0xf5, 0x0d, 0xee, 0xcb, 0x57, 0xf6, 0x6b, 0x3d, 0x12, 0x1d, 0xf4, 0xcf, 0x24, 0x98, 0x4e,
+		0xeb, 0x3f, 0x4c, 0x92, 0xe6, 0xb1, 0x9a, 0x6d, 0x22, 0xf2, 0xff, 0x0c, 0x40, 0xc6, 0x36, 0x17,
+		0xb4, 0x00, 0x87, 0x48, 0x27, 0x4d, 0x34, 0xe9, 0xec, 0xcb, 0x34, 0x6a, 0xe2, 0x56, 0x24, 0xe5,
+		0x1c, 0xab, 0xfb, 0xe4, 0x7a, 0xab, 0xfb, 0x3c, 0xa9, 0xcc, 0x88, 0x57, 0x66, 0x44, 0x74, 0x67,
+		0x40, 0x40, 0x77, 0xee, 0xc0, 0x38, 0xcd, 0xa8, 0x53, 0x8c, 0x86, 0xe9, 0x62, 0x7b, 0x47, 0xad,
+		0xa5, 0x5f, 0x7b, 0x0e, 0xd1, 0x89, 0x04, 0x5e, 0x85, 0x4e, 0x0b, 0x57, 0x7d, 0x8a, 0x7b, 0xaa,
+		0xfa, 0x74, 0x85, 0x70, 0x90, 0x25, 0x84, 0xe3, 0x97, 0x78, 0x4a, 0x3d, 0x97, 0x78, 0x3a, 0xd7,
+		0x94, 0x21, 0xf1, 0x6b, 0x4a, 0x50, 0x68, 0x38, 0xb0, 0x87, 0x42, 0xc3, 0xf0, 0x9e, 0x0a, 0x0d,
+		0x9e, 0x0f, 0x5e, 0xc8, 0xda, 0x6b, 0xd7, 0xf6, 0x56, 0x52, 0xb7, 0xb7, 0x4a, 0xba, 0xdf, 0x6c,
+		0xc0, 0x91, 0x76, 0x7d, 0x3e, 0x52, 0xb3, 0xf5, 0xed, 0x78, 0x2e, 0xb1, 0x02, 0x1f, 0xae, 0xda,
+		0x1e, 0xc6, 0xac, 0x61, 0xf9, 0x07, 0x12, 0xcc, 0x72, 0x24, 0x61, 0x95, 0xa2, 0xd3, 0xcd, 0x43,
+		0x12, 0x30, 0x8f, 0xae, 0x48, 0x27, 0x97, 0x21, 0xd2, 0x91, 0xdf, 0x97, 0xe0, 0x78, 0x62, 0xaf,
+		0xb8, 0x17, 0xea, 0xd1, 0x4e, 0x74, 0x53, 0xad, 0x07, 0x4b, 0x0d, 0xfe, 0xd0, 0x6d, 0xb5, 0x8e,
+		0x7b, 0xfd, 0xf4, 0xbe, 0x9d, 0x2a, 0x1d, 0x8d, 0xef, 0x13, 0xd6, 0x78, 0xf9, 0x9b, 0xac, 0x4d,
+		0xe2, 0xf5, 0x46, 0x9c, 0x84, 0x12, 0xed, 0x4e, 0xe9, 0x5e, 0x02, 0x7f, 0x88, 0x2c, 0x41, 0xdb,
+		0xa9, 0xe7, 0xc4, 0x9d, 0x7a, 0x42, 0x9a, 0x5b, 0xfe, 0x86, 0x04, 0x73, 0x19, 0xfa, 0x81, 0x3a,
+		0xe9, 0x58, 0x29, 0x94, 0x8e, 0xed, 0x75, 0x67, 0x92, 0xa0, 0xfd, 0x3a, 0x07, 0x2f, 0xec, 0xad,
+		0x27, 0x7a, 0xdf, 0x74, 0xbe, 0x93, 0xea, 0xcb, 0x85, 0x52, 0x7d, 0xf7, 0x01, 0xc5, 0x7b, 0x6f,
+		0xa8, 0x7d, 0x9f, 0x11, 0xeb, 0xaf, 0x55, 0x46, 0x63, 0x0d, 0xb4, 0x5e, 0xf2, 0x43, 0xb3, 0x4c,
+		0xd7, 0xb6, 0x6a, 0x44, 0xd1, 0x86, 0x94, 0xe0, 0x11, 0x95, 0x61, 0x2c, 0xd2, 0x46, 0x66, 0x99,
+		0x35, 0x3f, 0x32, 0x1f, 0x54, 0x46, 0x43, 0xdd, 0x5d, 0x77, 0xcc, 0xda, 0xae, 0xfc, 0x7a, 0x1e,
+		0xae, 0xef, 0xa1, 0xe7, 0x1a, 0xdd, 0xef, 0xf6, 0x7b, 0xc3, 0x9c, 0x5f, 0x34, 0x08, 0x71, 0x0e,
+		0x65, 0xad, 0xf7, 0xe9, 0x3e, 0xc9, 0x4d, 0xc1, 0xb2, 0xf7, 0xa5, 0x6f, 0xaf, 0xfb, 0x32, 0x0f,
+		0x28, 0xda, 0xe9, 0x46, 0x0b, 0x1c, 0x79, 0x65, 0xc4, 0x08, 0x29, 0xa1, 0x9f, 0xc2, 0x0a, 0x76,
+		0xb1, 0x10, 0xda, 0x45, 0xf9, 0x2f, 0x12, 0x5c, 0xed, 0xb1, 0x61, 0x9c, 0x83, 0x41, 0xe2, 0x60,
+		0xf8, 0x60, 0x15, 0x57, 0xfe, 0x6a, 0x1e, 0xae, 0xf6, 0xd8, 0xd4, 0xf7, 0xff, 0x6a, 0xab, 0x11,
+		0x8f, 0xdd, 0xc7, 0xf7, 0xd8, 0xfd, 0xe2, 0x1e, 0x9b, 0xab, 0x3a, 0x3c, 0x07, 0x30, 0xc0, 0x73,
+		0x00, 0xaf, 0xe4, 0xe1, 0x72, 0x2f, 0x8d, 0x89, 0x62, 0x96, 0x2f, 0xc4, 0xf9, 0x89, 0xe5, 0x77,
+		0x2c, 0xff, 0x3d, 0x09, 0x2e, 0x64, 0x6d, 0xb2, 0xfc, 0x9f, 0x36, 0x79, 0xfe, 0x59, 0x25, 0xff,
+		0x41, 0x82, 0xf3, 0x99, 0x1a, 0x33, 0xf7, 0xcd, 0x05, 0x30, 0x6f, 0x0d, 0xb9, 0xbd, 0xdd, 0x1a,
+		0xbe, 0x5d, 0x84, 0x4b, 0x3d, 0xfc, 0xc2, 0xa4, 0x6b, 0x3b, 0xa4, 0xd0, 0x76, 0x9c, 0x84, 0x52,
+		0x7b, 0x3b, 0xa8, 0xce, 0x17, 0x15, 0x08, 0x86, 0x58, 0x29, 0x84, 0xfc, 0x3e, 0xa4, 0x10, 0x7a,
+		0x2d, 0x47, 0xf6, 0xef, 0x6f, 0x0a, 0xa1, 0xf0, 0x58, 0x53, 0x08, 0x03, 0x3d, 0xa7, 0x10, 0x1e,
+		0x00, 0xed, 0x8f, 0xa5, 0x1c, 0x69, 0x15, 0xcf, 0xef, 0x31, 0x38, 0x93, 0xd0, 0x64, 0x4b, 0xb8,
+		0xd0, 0x5a, 0xde, 0x68, 0x23, 0x3a, 0xd4, 0x6d, 0x24, 0xc5, 0xb0, 0x3f, 0x17, 0x51, 0x79, 0x10,
+		0x50, 0x79, 0x0d, 0x26, 0xba, 0xd4, 0xa9, 0x6a, 0xe3, 0x66, 0x07, 0x7e, 0x89, 0xc0, 0x9f, 0x4b,
+		0x54, 0x9c, 0x8a, 0xae, 0xe0, 0x66, 0x80, 0x57, 0x39, 0xdc, 0x62, 0x0d, 0xc7, 0xaa, 0x9b, 0x07,
+		0x7a, 0xa9, 0x6e, 0xc6, 0x3a, 0x1d, 0x87, 0x19, 0x9d, 0x8e, 0x9d, 0x9b, 0xd6, 0xc1, 0xec, 0xb9,
+		0x85, 0x91, 0x3d, 0xe4, 0x16, 0x46, 0xf7, 0xd6, 0xc4, 0xf8, 0x1c, 0x94, 0x74, 0x5c, 0x53, 0x77,
+		0x7d, 0xd5, 0x4c, 0xef, 0xc8, 0x04, 0x42, 0x4d, 0x54, 0x31, 0x54, 0xc1, 0x1d, 0x4b, 0xa9, 0xe0,
+		0x1e, 0x8a, 0x57, 0x70, 0x5f, 0xcb, 0xc3, 0x85, 0xac, 0x3f, 0x20, 0xfb, 0xf0, 0xbd, 0xd3, 0x5a,
+		0x10, 0x66, 0xf8, 0x85, 0xb2, 0x2b, 0x99, 0x7f, 0xfd, 0x14, 0x8a, 0x2e, 0xba, 0xec, 0xac, 0x3f,
+		0x6c, 0x67, 0xec, 0x33, 0xb4, 0xc0, 0x39, 0x43, 0xf7, 0x29, 0x95, 0x28, 0xff, 0x3e, 0x07, 0xf3,
+		0x59, 0x7e, 0x1d, 0xc7, 0xdd, 0x0f, 0xf6, 0xe1, 0x9d, 0xdb, 0xeb, 0xe1, 0xbd, 0x5f, 0xbb, 0xc8,
+		0x5e, 0xdd, 0x3e, 0xce, 0xea, 0x76, 0x8c, 0xbb, 0x5f, 0x3c, 0x8d, 0xf2, 0x7e, 0x0e, 0x32, 0xfe,
+		0x6e, 0xef, 0xa3, 0xb1, 0x98, 0xac, 0xaa, 0x50, 0x3f, 0xb3, 0x2a, 0xd4, 0x69, 0x67, 0x28, 0x88,
+		0xb7, 0x33, 0xc8, 0xff, 0xca, 0xc1, 0xb9, 0xfd, 0xf0, 0x28, 0x1f, 0xd1, 0x45, 0xef, 0x4a, 0xd8,
+		0x17, 0x32, 0x24, 0xec, 0xe5, 0x7f, 0xe7, 0xe0, 0x7c, 0xa6, 0x9f, 0x51, 0x3e, 0x59, 0xf8, 0xd8,
+		0xc2, 0x07, 0x19, 0xc9, 0x42, 0x96, 0x34, 0xf5, 0x97, 0xf2, 0xbc, 0x85, 0xe7, 0xb5, 0xa0, 0x3c,
+		0x59, 0xf8, 0xc4, 0x0e, 0x98, 0x42, 0x2f, 0x9d, 0xf7, 0xbf, 0xca, 0xc1, 0x42, 0xc6, 0x9f, 0xb7,
+		0x3e, 0xd9, 0x87, 0xd0, 0x3e, 0xcc, 0xb9, 0x70, 0x90, 0xfc, 0xb9, 0x6a, 0xd4, 0x5c, 0x6c, 0x93,
+		0x4f, 0x1d, 0x87, 0xc9, 0x95, 0x07, 0x2b, 0xb7, 0xd7, 0xab, 0xab, 0x95, 0xb5, 0xf5, 0x15, 0xa5,
+		0xba, 0xfe, 0x99, 0xbb, 0x2b, 0xd5, 0xca, 0xed, 0x07, 0x4b, 0x6b, 0x95, 0x9b, 0x23, 0x4f, 0xa1,
+		0x93, 0x70, 0x2c, 0xfe, 0x7a, 0x69, 0x6d, 0xad, 0x4a, 0x46, 0x47, 0x24, 0x34, 0x03, 0xc7, 0xe3,
+		0x04, 0xcb, 0x6b, 0x77, 0xee, 0xad, 0x50, 0x92, 0xdc, 0x8d, 0x07, 0x70, 0x44, 0xb3, 0xea, 0xac,
+		0x35, 0xb8, 0x31, 0xb8, 0xd4, 0x30, 0xee, 0xda, 0x96, 0x6b, 0xdd, 0x95, 0x3e, 0xbb, 0xf0, 0xd0,
+		0x70, 0xb7, 0x9a, 0x1b, 0x65, 0xcd, 0xaa, 0x2f, 0x84, 0xfe, 0x49, 0x6b, 0xf9, 0x21, 0x36, 0xfd,
+		0x7f, 0x0b, 0x4b, 0xff, 0x5f, 0xeb, 0x75, 0xb5, 0x61, 0xec, 0x5c, 0xdc, 0x28, 0x90, 0xb1, 0x4b,
+		0xff, 0x1d, 0x00, 0xd2, 0x53, 0xd9, 0xbd, 0x92, 0x56, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -366,53 +369,58 @@ var yarpcFileDescriptorClosure0370c4177fcc3ee8 = [][]byte{
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-		0x14, 0x9e, 0xe2, 0xb4, 0x73, 0x98, 0x25, 0xd5, 0xb8, 0xb5, 0x8d, 0xdd, 0x75, 0xcb, 0x74, 0x51,
-		0x04, 0xc5, 0x20, 0x21, 0x19, 0x76, 0xb5, 0x8b, 0xc1, 0x89, 0x83, 0x55, 0x88, 0xe3, 0x1a, 0x92,
-		0x1a, 0x20, 0xbb, 0xe1, 0x28, 0xf1, 0xd4, 0x21, 0xf4, 0x43, 0x81, 0xa4, 0x92, 0xf8, 0x45, 0xf6,
-		0x30, 0x7b, 0xa2, 0x3d, 0xc6, 0x40, 0x4a, 0xf6, 0xdc, 0xc4, 0xeb, 0x1d, 0x79, 0xbe, 0xf3, 0x9d,
-		0x9f, 0x8f, 0xe7, 0x10, 0x79, 0x4d, 0x0a, 0x32, 0xc8, 0x28, 0x83, 0x2a, 0x83, 0x80, 0xd6, 0x3c,
-		0xb8, 0x3d, 0x0e, 0x34, 0x55, 0x79, 0xc1, 0x95, 0xf6, 0x6b, 0x29, 0xb4, 0xc0, 0xdf, 0x18, 0x1f,
-		0xbf, 0xf3, 0xf1, 0x69, 0xcd, 0xfd, 0xdb, 0xe3, 0xe1, 0xf7, 0x73, 0x21, 0xe6, 0x05, 0x04, 0xd6,
-		0x25, 0x6d, 0x3e, 0x06, 0xac, 0x91, 0x54, 0x73, 0x51, 0xb5, 0xa4, 0xe1, 0x0f, 0x0f, 0x71, 0xcd,
-		0x4b, 0x50, 0x9a, 0x96, 0x75, 0xe7, 0xf0, 0x28, 0xc0, 0x9d, 0xa4, 0x75, 0x0d, 0x52, 0xb5, 0xb8,
-		0xf7, 0x01, 0xf5, 0x13, 0xaa, 0xf2, 0x09, 0x57, 0x1a, 0x63, 0xb4, 0x5d, 0xd1, 0x12, 0x0e, 0x9c,
-		0x43, 0xe7, 0x68, 0x27, 0xb2, 0x67, 0xfc, 0x0b, 0xda, 0xce, 0x79, 0xc5, 0x0e, 0xb6, 0x0e, 0x9d,
-		0xa3, 0xfd, 0x93, 0x1f, 0xfd, 0x0d, 0x45, 0xfa, 0xcb, 0x00, 0x17, 0xbc, 0x62, 0x91, 0x75, 0xf7,
-		0x28, 0x72, 0x97, 0xd6, 0x4b, 0xd0, 0x94, 0x51, 0x4d, 0xf1, 0x25, 0xfa, 0xb6, 0xa4, 0xf7, 0xc4,
-		0xb4, 0xad, 0x48, 0x0d, 0x92, 0x28, 0xc8, 0x44, 0xc5, 0x6c, 0xba, 0xdd, 0x93, 0xef, 0xfc, 0xb6,
-		0x52, 0x7f, 0x59, 0xa9, 0x3f, 0x16, 0x4d, 0x5a, 0xc0, 0x15, 0x2d, 0x1a, 0x88, 0xbe, 0x2e, 0xe9,
-		0xbd, 0x09, 0xa8, 0x66, 0x20, 0x63, 0x4b, 0xf3, 0x3e, 0xa0, 0xc1, 0x32, 0xc5, 0x8c, 0x4a, 0xcd,
-		0x8d, 0x2a, 0xab, 0x5c, 0x2e, 0xea, 0xe5, 0xb0, 0xe8, 0x3a, 0x31, 0x47, 0xfc, 0x06, 0x3d, 0x13,
-		0x77, 0x15, 0x48, 0x72, 0x23, 0x94, 0x26, 0xb6, 0xcf, 0x2d, 0x8b, 0xee, 0x59, 0xf3, 0x3b, 0xa1,
-		0xf4, 0x94, 0x96, 0xe0, 0xfd, 0xe3, 0xa0, 0xfd, 0x65, 0xdc, 0x58, 0x53, 0xdd, 0x28, 0xfc, 0x13,
-		0xc2, 0x29, 0xcd, 0xf2, 0x42, 0xcc, 0x49, 0x26, 0x9a, 0x4a, 0x93, 0x1b, 0x5e, 0x69, 0x1b, 0xbb,
-		0x17, 0xb9, 0x1d, 0x72, 0x66, 0x80, 0x77, 0xbc, 0xd2, 0xf8, 0x35, 0x42, 0x12, 0x28, 0x23, 0x05,
-		0xdc, 0x42, 0x61, 0x73, 0xf4, 0xa2, 0x1d, 0x63, 0x99, 0x18, 0x03, 0x7e, 0x85, 0x76, 0x68, 0x96,
-		0x77, 0x68, 0xcf, 0xa2, 0x7d, 0x9a, 0xe5, 0x2d, 0xf8, 0x06, 0x3d, 0x93, 0x54, 0xc3, 0xba, 0x3a,
-		0xdb, 0x87, 0xce, 0x91, 0x13, 0xed, 0x19, 0xf3, 0xaa, 0x77, 0x3c, 0x46, 0x7b, 0x46, 0x46, 0xc2,
-		0x19, 0x49, 0x0b, 0x91, 0xe5, 0x07, 0x4f, 0xac, 0x86, 0x87, 0xff, 0xfb, 0x3c, 0xe1, 0xf8, 0xd4,
-		0xf8, 0x45, 0xbb, 0x86, 0x16, 0x32, 0x7b, 0xf1, 0x7e, 0x43, 0xbb, 0x6b, 0x18, 0x1e, 0xa0, 0xbe,
-		0xd2, 0x54, 0x6a, 0xc2, 0x59, 0xd7, 0xdc, 0x97, 0xf6, 0x1e, 0x32, 0xfc, 0x1c, 0x3d, 0x85, 0x8a,
-		0x19, 0xa0, 0xed, 0xe7, 0x09, 0x54, 0x2c, 0x64, 0xde, 0x5f, 0x0e, 0x42, 0x33, 0x51, 0x14, 0x20,
-		0xc3, 0xea, 0xa3, 0xc0, 0x63, 0xe4, 0x16, 0x54, 0x69, 0x42, 0xb3, 0x0c, 0x94, 0x22, 0x66, 0x14,
-		0xbb, 0xc7, 0x1d, 0x3e, 0x7a, 0xdc, 0x64, 0x39, 0xa7, 0xd1, 0xbe, 0xe1, 0x8c, 0x2c, 0xc5, 0x18,
-		0xf1, 0x10, 0xf5, 0x39, 0x83, 0x4a, 0x73, 0xbd, 0xe8, 0x5e, 0x68, 0x75, 0xdf, 0xa4, 0x4f, 0x6f,
-		0x83, 0x3e, 0xde, 0xdf, 0x0e, 0x1a, 0xc4, 0x9a, 0x67, 0xf9, 0xe2, 0xfc, 0x1e, 0xb2, 0xc6, 0x8c,
-		0xc6, 0x48, 0x6b, 0xc9, 0xd3, 0x46, 0x83, 0xc2, 0xbf, 0x23, 0xf7, 0x4e, 0xc8, 0x1c, 0xa4, 0x9d,
-		0x45, 0x62, 0x76, 0xb0, 0xab, 0xf3, 0xf5, 0x67, 0xe7, 0x3b, 0xda, 0x6f, 0x69, 0xab, 0x85, 0x49,
-		0xd0, 0x40, 0x65, 0x37, 0xc0, 0x9a, 0x02, 0x88, 0x16, 0xa4, 0x55, 0xcf, 0xb4, 0x2d, 0x1a, 0x6d,
-		0x6b, 0xdf, 0x3d, 0x19, 0x3c, 0x1e, 0xeb, 0x6e, 0x83, 0xa3, 0x17, 0x4b, 0x6e, 0x22, 0x62, 0xc3,
-		0x4c, 0x5a, 0xe2, 0xdb, 0x3f, 0xd1, 0x57, 0xeb, 0x1b, 0x85, 0x87, 0xe8, 0x45, 0x32, 0x8a, 0x2f,
-		0xc8, 0x24, 0x8c, 0x13, 0x72, 0x11, 0x4e, 0xc7, 0x24, 0x9c, 0x5e, 0x8d, 0x26, 0xe1, 0xd8, 0xfd,
-		0x02, 0x0f, 0xd0, 0xf3, 0x07, 0xd8, 0xf4, 0x7d, 0x74, 0x39, 0x9a, 0xb8, 0xce, 0x06, 0x28, 0x4e,
-		0xc2, 0xb3, 0x8b, 0x6b, 0x77, 0xeb, 0x2d, 0xfb, 0x2f, 0x43, 0xb2, 0xa8, 0xe1, 0xd3, 0x0c, 0xc9,
-		0xf5, 0xec, 0x7c, 0x2d, 0xc3, 0x2b, 0xf4, 0xf2, 0x01, 0x36, 0x3e, 0x3f, 0x0b, 0xe3, 0xf0, 0xfd,
-		0xd4, 0x75, 0x36, 0x80, 0xa3, 0xb3, 0x24, 0xbc, 0x0a, 0x93, 0x6b, 0x77, 0xeb, 0xf4, 0x0a, 0xbd,
-		0xcc, 0x44, 0xb9, 0x49, 0xd1, 0xd3, 0xfe, 0xa8, 0xe6, 0x33, 0x23, 0xc8, 0xcc, 0xf9, 0x23, 0x98,
-		0x73, 0x7d, 0xd3, 0xa4, 0x7e, 0x26, 0xca, 0xe0, 0x93, 0x6f, 0xd2, 0x9f, 0x43, 0xd5, 0xfe, 0x5b,
-		0xdd, 0x8f, 0xf9, 0x2b, 0xad, 0xf9, 0xed, 0x71, 0xfa, 0xd4, 0xda, 0x7e, 0xfe, 0x37, 0x00, 0x00,
-		0xff, 0xff, 0x58, 0x62, 0x2b, 0xbf, 0x55, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x72, 0xe3, 0x34,
+		0x14, 0xc6, 0xc9, 0x76, 0x49, 0x55, 0x9a, 0x7a, 0xb5, 0x3f, 0x6d, 0xb2, 0x2c, 0x84, 0x5c, 0x2c,
+		0x9d, 0x1d, 0xc6, 0xa1, 0x65, 0xb8, 0xe2, 0x82, 0x49, 0x93, 0x0e, 0xeb, 0x69, 0x9a, 0xcd, 0x38,
+		0xde, 0x32, 0xe5, 0x46, 0xc8, 0xd6, 0x69, 0xaa, 0xb1, 0x2d, 0x79, 0x2c, 0xb9, 0x3f, 0x2f, 0xc2,
+		0x13, 0xf0, 0x14, 0x3c, 0x11, 0x8f, 0xc1, 0x48, 0x76, 0x42, 0xb6, 0x0d, 0xdc, 0x49, 0xdf, 0x77,
+		0x3e, 0x9d, 0x1f, 0x9d, 0x73, 0x50, 0xbf, 0x8c, 0xa0, 0x18, 0xc4, 0x94, 0x81, 0x88, 0x61, 0x40,
+		0x73, 0x3e, 0xb8, 0x39, 0x1a, 0x68, 0xaa, 0x92, 0x94, 0x2b, 0xed, 0xe5, 0x85, 0xd4, 0x12, 0x3f,
+		0x37, 0x36, 0x5e, 0x6d, 0xe3, 0xd1, 0x9c, 0x7b, 0x37, 0x47, 0xdd, 0xaf, 0x16, 0x52, 0x2e, 0x52,
+		0x18, 0x58, 0x93, 0xa8, 0xbc, 0x1a, 0xb0, 0xb2, 0xa0, 0x9a, 0x4b, 0x51, 0x89, 0xba, 0x5f, 0x3f,
+		0xe4, 0x35, 0xcf, 0x40, 0x69, 0x9a, 0xe5, 0xb5, 0xc1, 0xa3, 0x07, 0x6e, 0x0b, 0x9a, 0xe7, 0x50,
+		0xa8, 0x8a, 0xef, 0x7f, 0x44, 0xad, 0x90, 0xaa, 0x64, 0xc2, 0x95, 0xc6, 0x18, 0x3d, 0x11, 0x34,
+		0x83, 0x03, 0xa7, 0xe7, 0x1c, 0x6e, 0x07, 0xf6, 0x8c, 0x7f, 0x44, 0x4f, 0x12, 0x2e, 0xd8, 0x41,
+		0xa3, 0xe7, 0x1c, 0xb6, 0x8f, 0xbf, 0xf1, 0x36, 0x04, 0xe9, 0x2d, 0x1f, 0x38, 0xe3, 0x82, 0x05,
+		0xd6, 0xbc, 0x4f, 0x91, 0xbb, 0x44, 0xcf, 0x41, 0x53, 0x46, 0x35, 0xc5, 0xe7, 0xe8, 0x45, 0x46,
+		0xef, 0x88, 0x49, 0x5b, 0x91, 0x1c, 0x0a, 0xa2, 0x20, 0x96, 0x82, 0x59, 0x77, 0x3b, 0xc7, 0x5f,
+		0x7a, 0x55, 0xa4, 0xde, 0x32, 0x52, 0x6f, 0x2c, 0xcb, 0x28, 0x85, 0x0b, 0x9a, 0x96, 0x10, 0x3c,
+		0xcb, 0xe8, 0x9d, 0x79, 0x50, 0xcd, 0xa0, 0x98, 0x5b, 0x59, 0xff, 0x23, 0xea, 0x2c, 0x5d, 0xcc,
+		0x68, 0xa1, 0xb9, 0xa9, 0xca, 0xca, 0x97, 0x8b, 0x9a, 0x09, 0xdc, 0xd7, 0x99, 0x98, 0x23, 0x7e,
+		0x8b, 0xf6, 0xe4, 0xad, 0x80, 0x82, 0x5c, 0x4b, 0xa5, 0x89, 0xcd, 0xb3, 0x61, 0xd9, 0x5d, 0x0b,
+		0xbf, 0x97, 0x4a, 0x4f, 0x69, 0x06, 0xfd, 0x3f, 0x1d, 0xb4, 0xff, 0xe8, 0xdd, 0x91, 0x14, 0x57,
+		0x7c, 0x81, 0x3d, 0xf4, 0x5c, 0x94, 0x19, 0x29, 0x80, 0x32, 0x92, 0x2f, 0x39, 0x65, 0xbd, 0x6c,
+		0x05, 0xcf, 0x44, 0x99, 0x05, 0x40, 0xd9, 0x4a, 0xa4, 0xf0, 0xf7, 0xe8, 0x85, 0xb1, 0xbf, 0x2d,
+		0xb8, 0x86, 0x75, 0x41, 0xc3, 0x0a, 0xb0, 0x28, 0xb3, 0x5f, 0x0d, 0xb5, 0xa6, 0xf8, 0x16, 0xed,
+		0xa9, 0x98, 0xa6, 0x5c, 0x2c, 0x08, 0x08, 0x1a, 0xa5, 0xc0, 0x0e, 0x9a, 0x3d, 0xe7, 0xb0, 0x15,
+		0xb4, 0x6b, 0xf8, 0xb4, 0x42, 0xfb, 0x7f, 0x3b, 0xa8, 0xbd, 0x0c, 0x73, 0xae, 0xa9, 0x2e, 0x15,
+		0xfe, 0x0e, 0xe1, 0x88, 0xc6, 0x49, 0x2a, 0x17, 0x24, 0x96, 0xa5, 0xd0, 0xe4, 0x9a, 0x0b, 0x6d,
+		0x83, 0x6b, 0x06, 0x6e, 0xcd, 0x8c, 0x0c, 0xf1, 0x9e, 0x0b, 0x8d, 0xdf, 0x20, 0x64, 0xf3, 0x48,
+		0xe1, 0x06, 0x52, 0x1b, 0x51, 0x33, 0xd8, 0x36, 0xc8, 0xc4, 0x00, 0xf8, 0x35, 0xda, 0xa6, 0x71,
+		0x52, 0xb3, 0x4d, 0xcb, 0xb6, 0x68, 0x9c, 0x54, 0xe4, 0x5b, 0xb4, 0x57, 0x50, 0x0d, 0xeb, 0x9f,
+		0xf8, 0xa4, 0xe7, 0x1c, 0x3a, 0xc1, 0xae, 0x81, 0x57, 0x5f, 0x84, 0xc7, 0x68, 0xd7, 0xfc, 0x36,
+		0xe1, 0x8c, 0x44, 0xa9, 0x8c, 0x93, 0x83, 0x2d, 0xfb, 0xd5, 0xbd, 0xff, 0xec, 0x22, 0x7f, 0x7c,
+		0x62, 0xec, 0x82, 0x1d, 0x23, 0xf3, 0x99, 0xbd, 0xf4, 0x7f, 0x46, 0x3b, 0x6b, 0x1c, 0xee, 0xa0,
+		0x96, 0xd2, 0xb4, 0xd0, 0x84, 0xb3, 0x3a, 0xb9, 0xcf, 0xed, 0xdd, 0x67, 0xf8, 0x25, 0x7a, 0x0a,
+		0x82, 0x19, 0xa2, 0xca, 0x67, 0x0b, 0x04, 0xf3, 0x59, 0xff, 0x0f, 0x07, 0xa1, 0x99, 0x4c, 0x53,
+		0x28, 0x7c, 0x71, 0x25, 0xf1, 0x18, 0xb9, 0x29, 0x55, 0x9a, 0xd0, 0x38, 0x06, 0xa5, 0x88, 0x99,
+		0x98, 0xba, 0x07, 0xbb, 0x8f, 0x7a, 0x30, 0x5c, 0x8e, 0x53, 0xd0, 0x36, 0x9a, 0xa1, 0x95, 0x18,
+		0x10, 0x77, 0x51, 0x8b, 0x33, 0x10, 0x9a, 0xeb, 0xfb, 0xba, 0x91, 0x56, 0xf7, 0x4d, 0xf5, 0x69,
+		0x6e, 0xa8, 0x4f, 0xff, 0x2f, 0x07, 0x75, 0xe6, 0x9a, 0xc7, 0xc9, 0xfd, 0xe9, 0x1d, 0xc4, 0xa5,
+		0x69, 0x81, 0xa1, 0xd6, 0x05, 0x8f, 0x4a, 0x0d, 0x0a, 0xff, 0x82, 0xdc, 0x5b, 0x59, 0x24, 0x50,
+		0xd8, 0x91, 0x21, 0x66, 0x55, 0xd4, 0x71, 0xbe, 0xf9, 0xdf, 0x31, 0x0c, 0xda, 0x95, 0x6c, 0x35,
+		0xd7, 0x21, 0xea, 0xa8, 0xf8, 0x1a, 0x58, 0x99, 0x02, 0xd1, 0x92, 0x54, 0xd5, 0x33, 0x69, 0xcb,
+		0x52, 0xdb, 0xd8, 0x77, 0x8e, 0x3b, 0x8f, 0xa7, 0xaf, 0x5e, 0x34, 0xc1, 0xab, 0xa5, 0x36, 0x94,
+		0x73, 0xa3, 0x0c, 0x2b, 0xe1, 0xbb, 0xdf, 0xd1, 0x17, 0xeb, 0x83, 0x8f, 0xbb, 0xe8, 0x55, 0x38,
+		0x9c, 0x9f, 0x91, 0x89, 0x3f, 0x0f, 0xc9, 0x99, 0x3f, 0x1d, 0x13, 0x7f, 0x7a, 0x31, 0x9c, 0xf8,
+		0x63, 0xf7, 0x33, 0xdc, 0x41, 0x2f, 0x1f, 0x70, 0xd3, 0x0f, 0xc1, 0xf9, 0x70, 0xe2, 0x3a, 0x1b,
+		0xa8, 0x79, 0xe8, 0x8f, 0xce, 0x2e, 0xdd, 0xc6, 0x3b, 0xf6, 0xaf, 0x87, 0xf0, 0x3e, 0x87, 0x4f,
+		0x3d, 0x84, 0x97, 0xb3, 0xd3, 0x35, 0x0f, 0xaf, 0xd1, 0xfe, 0x03, 0x6e, 0x7c, 0x3a, 0xf2, 0xe7,
+		0xfe, 0x87, 0xa9, 0xeb, 0x6c, 0x20, 0x87, 0xa3, 0xd0, 0xbf, 0xf0, 0xc3, 0x4b, 0xb7, 0x71, 0x72,
+		0x81, 0xf6, 0x63, 0x99, 0x6d, 0xaa, 0xe8, 0x49, 0x6b, 0x98, 0xf3, 0x99, 0x29, 0xc8, 0xcc, 0xf9,
+		0x6d, 0xb0, 0xe0, 0xfa, 0xba, 0x8c, 0xbc, 0x58, 0x66, 0x83, 0x4f, 0xb6, 0xb9, 0xb7, 0x00, 0x51,
+		0xad, 0xd7, 0x7a, 0xb1, 0xff, 0x44, 0x73, 0x7e, 0x73, 0x14, 0x3d, 0xb5, 0xd8, 0x0f, 0xff, 0x0c,
+		0x00, 0x27, 0xcf, 0x98, 0xcc, 0xfc, 0x05, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
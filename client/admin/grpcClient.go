@@ -137,3 +137,83 @@ func (g grpcClient) GetCrossClusterTasks(ctx context.Context, request *types.Get
 	response, err := g.c.GetCrossClusterTasks(ctx, proto.FromAdminGetCrossClusterTasksRequest(request), opts...)
 	return proto.ToAdminGetCrossClusterTasksResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) GetDynamicConfig(ctx context.Context, request *types.GetDynamicConfigRequest, opts ...yarpc.CallOption) (*types.GetDynamicConfigResponse, error) {
+	response, err := g.c.GetDynamicConfig(ctx, proto.FromAdminGetDynamicConfigRequest(request), opts...)
+	return proto.ToAdminGetDynamicConfigResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) UpdateDynamicConfig(ctx context.Context, request *types.UpdateDynamicConfigRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UpdateDynamicConfig(ctx, proto.FromAdminUpdateDynamicConfigRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) RestoreDynamicConfig(ctx context.Context, request *types.RestoreDynamicConfigRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RestoreDynamicConfig(ctx, proto.FromAdminRestoreDynamicConfigRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest, opts ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
+	response, err := g.c.ListDynamicConfig(ctx, proto.FromAdminListDynamicConfigRequest(request), opts...)
+	return proto.ToAdminListDynamicConfigResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) PauseTaskList(ctx context.Context, request *types.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.PauseTaskList(ctx, proto.FromAdminPauseTaskListRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ResumeTaskList(ctx context.Context, request *types.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ResumeTaskList(ctx, proto.FromAdminResumeTaskListRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.MigrateTaskList(ctx, proto.FromAdminMigrateTaskListRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ReadTaskListDLQ(ctx context.Context, request *types.ReadTaskListDLQRequest, opts ...yarpc.CallOption) (*types.ReadTaskListDLQResponse, error) {
+	response, err := g.c.ReadTaskListDLQ(ctx, proto.FromAdminReadTaskListDLQRequest(request), opts...)
+	return proto.ToAdminReadTaskListDLQResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) DescribeTaskListDLQEntry(ctx context.Context, request *types.DescribeTaskListDLQEntryRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListDLQEntryResponse, error) {
+	response, err := g.c.DescribeTaskListDLQEntry(ctx, proto.FromAdminDescribeTaskListDLQEntryRequest(request), opts...)
+	return proto.ToAdminDescribeTaskListDLQEntryResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) RedriveTaskListDLQEntry(ctx context.Context, request *types.RedriveTaskListDLQEntryRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RedriveTaskListDLQEntry(ctx, proto.FromAdminRedriveTaskListDLQEntryRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) FailTaskListDLQEntry(ctx context.Context, request *types.FailTaskListDLQEntryRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.FailTaskListDLQEntry(ctx, proto.FromAdminFailTaskListDLQEntryRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) UnpinShard(ctx context.Context, request *types.UnpinShardRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UnpinShard(ctx, proto.FromAdminUnpinShardRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) ListQuarantinedWorkflows(ctx context.Context, request *types.ListQuarantinedWorkflowsRequest, opts ...yarpc.CallOption) (*types.ListQuarantinedWorkflowsResponse, error) {
+	response, err := g.c.ListQuarantinedWorkflows(ctx, proto.FromAdminListQuarantinedWorkflowsRequest(request), opts...)
+	return proto.ToAdminListQuarantinedWorkflowsResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) ReleaseQuarantinedWorkflow(ctx context.Context, request *types.ReleaseQuarantinedWorkflowRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ReleaseQuarantinedWorkflow(ctx, proto.FromAdminReleaseQuarantinedWorkflowRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) CheckWorkflowBuild(ctx context.Context, request *types.CheckWorkflowBuildRequest, opts ...yarpc.CallOption) (*types.CheckWorkflowBuildResponse, error) {
+	response, err := g.c.CheckWorkflowBuild(ctx, proto.FromAdminCheckWorkflowBuildRequest(request), opts...)
+	return proto.ToAdminCheckWorkflowBuildResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) MoveShard(ctx context.Context, request *types.MoveShardRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.MoveShard(ctx, proto.FromAdminMoveShardRequest(request), opts...)
+	return proto.ToError(err)
+}
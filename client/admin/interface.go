@@ -52,4 +52,20 @@ type Client interface {
 	ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest, ...yarpc.CallOption) error
 	ResetQueue(context.Context, *types.ResetQueueRequest, ...yarpc.CallOption) error
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest, ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error)
+	GetDynamicConfig(context.Context, *types.GetDynamicConfigRequest, ...yarpc.CallOption) (*types.GetDynamicConfigResponse, error)
+	UpdateDynamicConfig(context.Context, *types.UpdateDynamicConfigRequest, ...yarpc.CallOption) error
+	RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest, ...yarpc.CallOption) error
+	ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest, ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error)
+	PauseTaskList(context.Context, *types.PauseTaskListRequest, ...yarpc.CallOption) error
+	ResumeTaskList(context.Context, *types.ResumeTaskListRequest, ...yarpc.CallOption) error
+	MigrateTaskList(context.Context, *types.MigrateTaskListRequest, ...yarpc.CallOption) error
+	ReadTaskListDLQ(context.Context, *types.ReadTaskListDLQRequest, ...yarpc.CallOption) (*types.ReadTaskListDLQResponse, error)
+	DescribeTaskListDLQEntry(context.Context, *types.DescribeTaskListDLQEntryRequest, ...yarpc.CallOption) (*types.DescribeTaskListDLQEntryResponse, error)
+	RedriveTaskListDLQEntry(context.Context, *types.RedriveTaskListDLQEntryRequest, ...yarpc.CallOption) error
+	FailTaskListDLQEntry(context.Context, *types.FailTaskListDLQEntryRequest, ...yarpc.CallOption) error
+	MoveShard(context.Context, *types.MoveShardRequest, ...yarpc.CallOption) error
+	UnpinShard(context.Context, *types.UnpinShardRequest, ...yarpc.CallOption) error
+	ListQuarantinedWorkflows(context.Context, *types.ListQuarantinedWorkflowsRequest, ...yarpc.CallOption) (*types.ListQuarantinedWorkflowsResponse, error)
+	ReleaseQuarantinedWorkflow(context.Context, *types.ReleaseQuarantinedWorkflowRequest, ...yarpc.CallOption) error
+	CheckWorkflowBuild(context.Context, *types.CheckWorkflowBuildRequest, ...yarpc.CallOption) (*types.CheckWorkflowBuildResponse, error)
 }
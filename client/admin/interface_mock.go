@@ -450,3 +450,313 @@ func (mr *MockClientMockRecorder) GetCrossClusterTasks(arg0, arg1 interface{}, a
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrossClusterTasks", reflect.TypeOf((*MockClient)(nil).GetCrossClusterTasks), varargs...)
 }
+
+// GetDynamicConfig mocks base method
+func (m *MockClient) GetDynamicConfig(arg0 context.Context, arg1 *types.GetDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*types.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig
+func (mr *MockClientMockRecorder) GetDynamicConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockClient)(nil).GetDynamicConfig), varargs...)
+}
+
+// UpdateDynamicConfig mocks base method
+func (m *MockClient) UpdateDynamicConfig(arg0 context.Context, arg1 *types.UpdateDynamicConfigRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig
+func (mr *MockClientMockRecorder) UpdateDynamicConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockClient)(nil).UpdateDynamicConfig), varargs...)
+}
+
+// RestoreDynamicConfig mocks base method
+func (m *MockClient) RestoreDynamicConfig(arg0 context.Context, arg1 *types.RestoreDynamicConfigRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreDynamicConfig", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDynamicConfig indicates an expected call of RestoreDynamicConfig
+func (mr *MockClientMockRecorder) RestoreDynamicConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockClient)(nil).RestoreDynamicConfig), varargs...)
+}
+
+// ListDynamicConfig mocks base method
+func (m *MockClient) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*types.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig
+func (mr *MockClientMockRecorder) ListDynamicConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClient)(nil).ListDynamicConfig), varargs...)
+}
+
+// PauseTaskList mocks base method
+func (m *MockClient) PauseTaskList(arg0 context.Context, arg1 *types.PauseTaskListRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseTaskList", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseTaskList indicates an expected call of PauseTaskList
+func (mr *MockClientMockRecorder) PauseTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskList", reflect.TypeOf((*MockClient)(nil).PauseTaskList), varargs...)
+}
+
+// ResumeTaskList mocks base method
+func (m *MockClient) ResumeTaskList(arg0 context.Context, arg1 *types.ResumeTaskListRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeTaskList", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeTaskList indicates an expected call of ResumeTaskList
+func (mr *MockClientMockRecorder) ResumeTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskList", reflect.TypeOf((*MockClient)(nil).ResumeTaskList), varargs...)
+}
+
+// MigrateTaskList mocks base method
+func (m *MockClient) MigrateTaskList(arg0 context.Context, arg1 *types.MigrateTaskListRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateTaskList", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList
+func (mr *MockClientMockRecorder) MigrateTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockClient)(nil).MigrateTaskList), varargs...)
+}
+
+// ReadTaskListDLQ mocks base method
+func (m *MockClient) ReadTaskListDLQ(arg0 context.Context, arg1 *types.ReadTaskListDLQRequest, arg2 ...yarpc.CallOption) (*types.ReadTaskListDLQResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadTaskListDLQ", varargs...)
+	ret0, _ := ret[0].(*types.ReadTaskListDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTaskListDLQ indicates an expected call of ReadTaskListDLQ
+func (mr *MockClientMockRecorder) ReadTaskListDLQ(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTaskListDLQ", reflect.TypeOf((*MockClient)(nil).ReadTaskListDLQ), varargs...)
+}
+
+// DescribeTaskListDLQEntry mocks base method
+func (m *MockClient) DescribeTaskListDLQEntry(arg0 context.Context, arg1 *types.DescribeTaskListDLQEntryRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListDLQEntryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskListDLQEntry", varargs...)
+	ret0, _ := ret[0].(*types.DescribeTaskListDLQEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListDLQEntry indicates an expected call of DescribeTaskListDLQEntry
+func (mr *MockClientMockRecorder) DescribeTaskListDLQEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListDLQEntry", reflect.TypeOf((*MockClient)(nil).DescribeTaskListDLQEntry), varargs...)
+}
+
+// RedriveTaskListDLQEntry mocks base method
+func (m *MockClient) RedriveTaskListDLQEntry(arg0 context.Context, arg1 *types.RedriveTaskListDLQEntryRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RedriveTaskListDLQEntry", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedriveTaskListDLQEntry indicates an expected call of RedriveTaskListDLQEntry
+func (mr *MockClientMockRecorder) RedriveTaskListDLQEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedriveTaskListDLQEntry", reflect.TypeOf((*MockClient)(nil).RedriveTaskListDLQEntry), varargs...)
+}
+
+// FailTaskListDLQEntry mocks base method
+func (m *MockClient) FailTaskListDLQEntry(arg0 context.Context, arg1 *types.FailTaskListDLQEntryRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FailTaskListDLQEntry", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailTaskListDLQEntry indicates an expected call of FailTaskListDLQEntry
+func (mr *MockClientMockRecorder) FailTaskListDLQEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailTaskListDLQEntry", reflect.TypeOf((*MockClient)(nil).FailTaskListDLQEntry), varargs...)
+}
+
+// UnpinShard mocks base method
+func (m *MockClient) UnpinShard(arg0 context.Context, arg1 *types.UnpinShardRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpinShard", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinShard indicates an expected call of UnpinShard
+func (mr *MockClientMockRecorder) UnpinShard(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinShard", reflect.TypeOf((*MockClient)(nil).UnpinShard), varargs...)
+}
+
+// ListQuarantinedWorkflows mocks base method
+func (m *MockClient) ListQuarantinedWorkflows(arg0 context.Context, arg1 *types.ListQuarantinedWorkflowsRequest, arg2 ...yarpc.CallOption) (*types.ListQuarantinedWorkflowsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQuarantinedWorkflows", varargs...)
+	ret0, _ := ret[0].(*types.ListQuarantinedWorkflowsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuarantinedWorkflows indicates an expected call of ListQuarantinedWorkflows
+func (mr *MockClientMockRecorder) ListQuarantinedWorkflows(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantinedWorkflows", reflect.TypeOf((*MockClient)(nil).ListQuarantinedWorkflows), varargs...)
+}
+
+// ReleaseQuarantinedWorkflow mocks base method
+func (m *MockClient) ReleaseQuarantinedWorkflow(arg0 context.Context, arg1 *types.ReleaseQuarantinedWorkflowRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseQuarantinedWorkflow", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseQuarantinedWorkflow indicates an expected call of ReleaseQuarantinedWorkflow
+func (mr *MockClientMockRecorder) ReleaseQuarantinedWorkflow(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseQuarantinedWorkflow", reflect.TypeOf((*MockClient)(nil).ReleaseQuarantinedWorkflow), varargs...)
+}
+
+// CheckWorkflowBuild mocks base method
+func (m *MockClient) CheckWorkflowBuild(arg0 context.Context, arg1 *types.CheckWorkflowBuildRequest, arg2 ...yarpc.CallOption) (*types.CheckWorkflowBuildResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckWorkflowBuild", varargs...)
+	ret0, _ := ret[0].(*types.CheckWorkflowBuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckWorkflowBuild indicates an expected call of CheckWorkflowBuild
+func (mr *MockClientMockRecorder) CheckWorkflowBuild(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckWorkflowBuild", reflect.TypeOf((*MockClient)(nil).CheckWorkflowBuild), varargs...)
+}
+
+// MoveShard mocks base method
+func (m *MockClient) MoveShard(arg0 context.Context, arg1 *types.MoveShardRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveShard", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveShard indicates an expected call of MoveShard
+func (mr *MockClientMockRecorder) MoveShard(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockClient)(nil).MoveShard), varargs...)
+}
@@ -392,3 +392,291 @@ func (c *metricClient) GetCrossClusterTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *types.GetDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDynamicConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *types.UpdateDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateDynamicConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateDynamicConfigScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateDynamicConfigScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) RestoreDynamicConfig(
+	ctx context.Context,
+	request *types.RestoreDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRestoreDynamicConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRestoreDynamicConfigScope, metrics.CadenceClientLatency)
+	err := c.client.RestoreDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRestoreDynamicConfigScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListDynamicConfig(
+	ctx context.Context,
+	request *types.ListDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseTaskList(
+	ctx context.Context,
+	request *types.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.PauseTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResumeTaskList(
+	ctx context.Context,
+	request *types.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) MigrateTaskList(
+	ctx context.Context,
+	request *types.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientMigrateTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMigrateTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.MigrateTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMigrateTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ReadTaskListDLQ(
+	ctx context.Context,
+	request *types.ReadTaskListDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadTaskListDLQResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReadTaskListDLQScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReadTaskListDLQScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReadTaskListDLQ(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReadTaskListDLQScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeTaskListDLQEntry(
+	ctx context.Context,
+	request *types.DescribeTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListDLQEntryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListDLQEntryScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskListDLQEntryScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeTaskListDLQEntry(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListDLQEntryScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RedriveTaskListDLQEntry(
+	ctx context.Context,
+	request *types.RedriveTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRedriveTaskListDLQEntryScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRedriveTaskListDLQEntryScope, metrics.CadenceClientLatency)
+	err := c.client.RedriveTaskListDLQEntry(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRedriveTaskListDLQEntryScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) FailTaskListDLQEntry(
+	ctx context.Context,
+	request *types.FailTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientFailTaskListDLQEntryScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientFailTaskListDLQEntryScope, metrics.CadenceClientLatency)
+	err := c.client.FailTaskListDLQEntry(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientFailTaskListDLQEntryScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UnpinShard(
+	ctx context.Context,
+	request *types.UnpinShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpinShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpinShardScope, metrics.CadenceClientLatency)
+	err := c.client.UnpinShard(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpinShardScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListQuarantinedWorkflows(
+	ctx context.Context,
+	request *types.ListQuarantinedWorkflowsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListQuarantinedWorkflowsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListQuarantinedWorkflowsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListQuarantinedWorkflowsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListQuarantinedWorkflows(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListQuarantinedWorkflowsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ReleaseQuarantinedWorkflow(
+	ctx context.Context,
+	request *types.ReleaseQuarantinedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReleaseQuarantinedWorkflowScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReleaseQuarantinedWorkflowScope, metrics.CadenceClientLatency)
+	err := c.client.ReleaseQuarantinedWorkflow(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReleaseQuarantinedWorkflowScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) CheckWorkflowBuild(
+	ctx context.Context,
+	request *types.CheckWorkflowBuildRequest,
+	opts ...yarpc.CallOption,
+) (*types.CheckWorkflowBuildResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCheckWorkflowBuildScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCheckWorkflowBuildScope, metrics.CadenceClientLatency)
+	resp, err := c.client.CheckWorkflowBuild(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCheckWorkflowBuildScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) MoveShard(
+	ctx context.Context,
+	request *types.MoveShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientMoveShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMoveShardScope, metrics.CadenceClientLatency)
+	err := c.client.MoveShard(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMoveShardScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
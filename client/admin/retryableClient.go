@@ -328,3 +328,219 @@ func (c *retryableClient) GetCrossClusterTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *types.GetDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDynamicConfigResponse, error) {
+
+	var resp *types.GetDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *types.UpdateDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateDynamicConfig(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RestoreDynamicConfig(
+	ctx context.Context,
+	request *types.RestoreDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RestoreDynamicConfig(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListDynamicConfig(
+	ctx context.Context,
+	request *types.ListDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListDynamicConfigResponse, error) {
+
+	var resp *types.ListDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseTaskList(
+	ctx context.Context,
+	request *types.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseTaskList(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeTaskList(
+	ctx context.Context,
+	request *types.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResumeTaskList(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) MigrateTaskList(
+	ctx context.Context,
+	request *types.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.MigrateTaskList(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReadTaskListDLQ(
+	ctx context.Context,
+	request *types.ReadTaskListDLQRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadTaskListDLQResponse, error) {
+
+	var resp *types.ReadTaskListDLQResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadTaskListDLQ(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskListDLQEntry(
+	ctx context.Context,
+	request *types.DescribeTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListDLQEntryResponse, error) {
+
+	var resp *types.DescribeTaskListDLQEntryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskListDLQEntry(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RedriveTaskListDLQEntry(
+	ctx context.Context,
+	request *types.RedriveTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RedriveTaskListDLQEntry(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) FailTaskListDLQEntry(
+	ctx context.Context,
+	request *types.FailTaskListDLQEntryRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.FailTaskListDLQEntry(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpinShard(
+	ctx context.Context,
+	request *types.UnpinShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpinShard(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListQuarantinedWorkflows(
+	ctx context.Context,
+	request *types.ListQuarantinedWorkflowsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListQuarantinedWorkflowsResponse, error) {
+
+	var resp *types.ListQuarantinedWorkflowsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListQuarantinedWorkflows(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReleaseQuarantinedWorkflow(
+	ctx context.Context,
+	request *types.ReleaseQuarantinedWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReleaseQuarantinedWorkflow(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) CheckWorkflowBuild(
+	ctx context.Context,
+	request *types.CheckWorkflowBuildRequest,
+	opts ...yarpc.CallOption,
+) (*types.CheckWorkflowBuildResponse, error) {
+
+	var resp *types.CheckWorkflowBuildResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CheckWorkflowBuild(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MoveShard(
+	ctx context.Context,
+	request *types.MoveShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.MoveShard(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
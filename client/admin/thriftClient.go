@@ -138,3 +138,83 @@ func (t thriftClient) GetCrossClusterTasks(ctx context.Context, request *types.G
 	response, err := t.c.GetCrossClusterTasks(ctx, thrift.FromGetCrossClusterTasksRequest(request), opts...)
 	return thrift.ToGetCrossClusterTasksResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) GetDynamicConfig(ctx context.Context, request *types.GetDynamicConfigRequest, opts ...yarpc.CallOption) (*types.GetDynamicConfigResponse, error) {
+	response, err := t.c.GetDynamicConfig(ctx, thrift.FromGetDynamicConfigRequest(request), opts...)
+	return thrift.ToGetDynamicConfigResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateDynamicConfig(ctx context.Context, request *types.UpdateDynamicConfigRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UpdateDynamicConfig(ctx, thrift.FromUpdateDynamicConfigRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) RestoreDynamicConfig(ctx context.Context, request *types.RestoreDynamicConfigRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RestoreDynamicConfig(ctx, thrift.FromRestoreDynamicConfigRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest, opts ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
+	response, err := t.c.ListDynamicConfig(ctx, thrift.FromListDynamicConfigRequest(request), opts...)
+	return thrift.ToListDynamicConfigResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) PauseTaskList(ctx context.Context, request *types.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	err := t.c.PauseTaskList(ctx, thrift.FromPauseTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ResumeTaskList(ctx context.Context, request *types.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ResumeTaskList(ctx, thrift.FromResumeTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) error {
+	err := t.c.MigrateTaskList(ctx, thrift.FromMigrateTaskListRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ReadTaskListDLQ(ctx context.Context, request *types.ReadTaskListDLQRequest, opts ...yarpc.CallOption) (*types.ReadTaskListDLQResponse, error) {
+	response, err := t.c.ReadTaskListDLQ(ctx, thrift.FromReadTaskListDLQRequest(request), opts...)
+	return thrift.ToReadTaskListDLQResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeTaskListDLQEntry(ctx context.Context, request *types.DescribeTaskListDLQEntryRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListDLQEntryResponse, error) {
+	response, err := t.c.DescribeTaskListDLQEntry(ctx, thrift.FromDescribeTaskListDLQEntryRequest(request), opts...)
+	return thrift.ToDescribeTaskListDLQEntryResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) RedriveTaskListDLQEntry(ctx context.Context, request *types.RedriveTaskListDLQEntryRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RedriveTaskListDLQEntry(ctx, thrift.FromRedriveTaskListDLQEntryRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) FailTaskListDLQEntry(ctx context.Context, request *types.FailTaskListDLQEntryRequest, opts ...yarpc.CallOption) error {
+	err := t.c.FailTaskListDLQEntry(ctx, thrift.FromFailTaskListDLQEntryRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) UnpinShard(ctx context.Context, request *types.UnpinShardRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UnpinShard(ctx, thrift.FromUnpinShardRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) ListQuarantinedWorkflows(ctx context.Context, request *types.ListQuarantinedWorkflowsRequest, opts ...yarpc.CallOption) (*types.ListQuarantinedWorkflowsResponse, error) {
+	response, err := t.c.ListQuarantinedWorkflows(ctx, thrift.FromListQuarantinedWorkflowsRequest(request), opts...)
+	return thrift.ToListQuarantinedWorkflowsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ReleaseQuarantinedWorkflow(ctx context.Context, request *types.ReleaseQuarantinedWorkflowRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ReleaseQuarantinedWorkflow(ctx, thrift.FromReleaseQuarantinedWorkflowRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) CheckWorkflowBuild(ctx context.Context, request *types.CheckWorkflowBuildRequest, opts ...yarpc.CallOption) (*types.CheckWorkflowBuildResponse, error) {
+	response, err := t.c.CheckWorkflowBuild(ctx, thrift.FromCheckWorkflowBuildRequest(request), opts...)
+	return thrift.ToCheckWorkflowBuildResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) MoveShard(ctx context.Context, request *types.MoveShardRequest, opts ...yarpc.CallOption) error {
+	err := t.c.MoveShard(ctx, thrift.FromMoveShardRequest(request), opts...)
+	return thrift.ToError(err)
+}
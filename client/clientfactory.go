@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/faultinjection"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
@@ -162,6 +163,9 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 	if errorRate := cf.dynConfig.GetFloat64Property(dynamicconfig.HistoryErrorInjectionRate, 0)(); errorRate != 0 {
 		client = history.NewErrorInjectionClient(client, errorRate, cf.logger)
 	}
+	if cf.dynConfig.GetBoolProperty(dynamicconfig.EnableFaultInjection, false)() {
+		client = history.NewFaultInjectionClient(client, cf.newFaultInjectionRulesProvider(), cf.logger)
+	}
 	if cf.metricsClient != nil {
 		client = history.NewMetricClient(client, cf.metricsClient)
 	}
@@ -224,6 +228,9 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 	if errorRate := cf.dynConfig.GetFloat64Property(dynamicconfig.MatchingErrorInjectionRate, 0)(); errorRate != 0 {
 		client = matching.NewErrorInjectionClient(client, errorRate, cf.logger)
 	}
+	if cf.dynConfig.GetBoolProperty(dynamicconfig.EnableFaultInjection, false)() {
+		client = matching.NewFaultInjectionClient(client, cf.newFaultInjectionRulesProvider(), cf.logger)
+	}
 	if cf.metricsClient != nil {
 		client = matching.NewMetricClient(client, cf.metricsClient)
 	}
@@ -358,3 +365,12 @@ func (cf *rpcClientFactory) newFrontendGRPCClient(hostAddress string) (frontend.
 		apiv1.NewVisibilityAPIYARPCClient(config),
 	), nil
 }
+
+func (cf *rpcClientFactory) newFaultInjectionRulesProvider() faultinjection.RulesProvider {
+	return faultinjection.NewDynamicConfigRulesProvider(
+		cf.dynConfig.GetBoolProperty(dynamicconfig.EnableFaultInjection, false),
+		cf.dynConfig.GetMapProperty(dynamicconfig.FaultInjectionRules, nil),
+		cf.dynConfig.GetStringProperty(dynamicconfig.FaultInjectionRulesFile, ""),
+		cf.logger,
+	)
+}
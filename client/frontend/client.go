@@ -107,6 +107,22 @@ func (c *clientImpl) DescribeTaskList(
 	return client.DescribeTaskList(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.GetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkerBuildIDCompatibility(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.DescribeWorkflowExecutionRequest,
@@ -618,6 +634,38 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return client.TerminateWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.ExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ExpediteWorkflowExecutionStart(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.UpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkerBuildIDCompatibility(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateDomain(
 	ctx context.Context,
 	request *types.UpdateDomainRequest,
@@ -133,6 +133,32 @@ func (c *errorInjectionClient) DescribeTaskList(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.GetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.GetWorkerBuildIDCompatibilityResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.GetWorkerBuildIDCompatibility(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.FrontendClientOperationGetWorkerBuildIDCompatibility,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
 func (c *errorInjectionClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.DescribeWorkflowExecutionRequest,
@@ -952,6 +978,56 @@ func (c *errorInjectionClient) TerminateWorkflowExecution(
 	return clientErr
 }
 
+func (c *errorInjectionClient) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.ExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.ExpediteWorkflowExecutionStart(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.FrontendClientOperationExpediteWorkflowExecutionStart,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
+func (c *errorInjectionClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.UpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.UpdateWorkerBuildIDCompatibility(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.FrontendClientOperationUpdateWorkerBuildIDCompatibility,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
 func (c *errorInjectionClient) UpdateDomain(
 	ctx context.Context,
 	request *types.UpdateDomainRequest,
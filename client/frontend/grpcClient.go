@@ -66,11 +66,21 @@ func (g grpcClient) DescribeTaskList(ctx context.Context, request *types.Describ
 	return proto.ToDescribeTaskListResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) GetWorkerBuildIDCompatibility(ctx context.Context, request *types.GetWorkerBuildIDCompatibilityRequest, opts ...yarpc.CallOption) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	response, err := g.workflow.GetWorkerBuildIDCompatibility(ctx, proto.FromGetWorkerBuildIDCompatibilityRequest(request), opts...)
+	return proto.ToGetWorkerBuildIDCompatibilityResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) DescribeWorkflowExecution(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.workflow.DescribeWorkflowExecution(ctx, proto.FromDescribeWorkflowExecutionRequest(request), opts...)
 	return proto.ToDescribeWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) ExpediteWorkflowExecutionStart(ctx context.Context, request *types.ExpediteWorkflowExecutionStartRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.ExpediteWorkflowExecutionStart(ctx, proto.FromExpediteWorkflowExecutionStartRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) GetClusterInfo(ctx context.Context, opts ...yarpc.CallOption) (*types.ClusterInfo, error) {
 	response, err := g.workflow.GetClusterInfo(ctx, &apiv1.GetClusterInfoRequest{}, opts...)
 	return proto.ToGetClusterInfoResponse(response), proto.ToError(err)
@@ -236,6 +246,11 @@ func (g grpcClient) TerminateWorkflowExecution(ctx context.Context, request *typ
 	return proto.ToError(err)
 }
 
+func (g grpcClient) UpdateWorkerBuildIDCompatibility(ctx context.Context, request *types.UpdateWorkerBuildIDCompatibilityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.workflow.UpdateWorkerBuildIDCompatibility(ctx, proto.FromUpdateWorkerBuildIDCompatibilityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) UpdateDomain(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	response, err := g.domain.UpdateDomain(ctx, proto.FromUpdateDomainRequest(request), opts...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
@@ -136,6 +136,26 @@ func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
 }
 
+// GetWorkerBuildIDCompatibility mocks base method
+func (m *MockClient) GetWorkerBuildIDCompatibility(arg0 context.Context, arg1 *types.GetWorkerBuildIDCompatibilityRequest, arg2 ...yarpc.CallOption) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkerBuildIDCompatibility", varargs...)
+	ret0, _ := ret[0].(*types.GetWorkerBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerBuildIDCompatibility indicates an expected call of GetWorkerBuildIDCompatibility
+func (mr *MockClientMockRecorder) GetWorkerBuildIDCompatibility(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerBuildIDCompatibility", reflect.TypeOf((*MockClient)(nil).GetWorkerBuildIDCompatibility), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.DescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +176,25 @@ func (mr *MockClientMockRecorder) DescribeWorkflowExecution(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// ExpediteWorkflowExecutionStart mocks base method
+func (m *MockClient) ExpediteWorkflowExecutionStart(arg0 context.Context, arg1 *types.ExpediteWorkflowExecutionStartRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExpediteWorkflowExecutionStart", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpediteWorkflowExecutionStart indicates an expected call of ExpediteWorkflowExecutionStart
+func (mr *MockClientMockRecorder) ExpediteWorkflowExecutionStart(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpediteWorkflowExecutionStart", reflect.TypeOf((*MockClient)(nil).ExpediteWorkflowExecutionStart), varargs...)
+}
+
 // GetClusterInfo mocks base method
 func (m *MockClient) GetClusterInfo(arg0 context.Context, arg1 ...yarpc.CallOption) (*types.ClusterInfo, error) {
 	m.ctrl.T.Helper()
@@ -804,6 +843,25 @@ func (mr *MockClientMockRecorder) TerminateWorkflowExecution(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// UpdateWorkerBuildIDCompatibility mocks base method
+func (m *MockClient) UpdateWorkerBuildIDCompatibility(arg0 context.Context, arg1 *types.UpdateWorkerBuildIDCompatibilityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkerBuildIDCompatibility", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkerBuildIDCompatibility indicates an expected call of UpdateWorkerBuildIDCompatibility
+func (mr *MockClientMockRecorder) UpdateWorkerBuildIDCompatibility(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIDCompatibility", reflect.TypeOf((*MockClient)(nil).UpdateWorkerBuildIDCompatibility), varargs...)
+}
+
 // UpdateDomain mocks base method
 func (m *MockClient) UpdateDomain(arg0 context.Context, arg1 *types.UpdateDomainRequest, arg2 ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	m.ctrl.T.Helper()
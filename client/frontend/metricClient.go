@@ -101,6 +101,24 @@ func (c *metricClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *metricClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.GetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientGetWorkerBuildIDCompatibilityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientGetWorkerBuildIDCompatibilityScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetWorkerBuildIDCompatibility(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientGetWorkerBuildIDCompatibilityScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.DescribeWorkflowExecutionRequest,
@@ -676,6 +694,42 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.ExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientExpediteWorkflowExecutionStartScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientExpediteWorkflowExecutionStartScope, metrics.CadenceClientLatency)
+	err := c.client.ExpediteWorkflowExecutionStart(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientExpediteWorkflowExecutionStartScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.UpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkerBuildIDCompatibilityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateWorkerBuildIDCompatibilityScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateWorkerBuildIDCompatibility(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkerBuildIDCompatibilityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) UpdateDomain(
 	ctx context.Context,
 	request *types.UpdateDomainRequest,
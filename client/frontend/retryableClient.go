@@ -94,6 +94,22 @@ func (c *retryableClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *retryableClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.GetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+
+	var resp *types.GetWorkerBuildIDCompatibilityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetWorkerBuildIDCompatibility(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.DescribeWorkflowExecutionRequest,
@@ -557,6 +573,30 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.ExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ExpediteWorkflowExecutionStart(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.UpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateWorkerBuildIDCompatibility(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) UpdateDomain(
 	ctx context.Context,
 	request *types.UpdateDomainRequest,
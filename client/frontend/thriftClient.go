@@ -59,11 +59,21 @@ func (t thriftClient) DescribeTaskList(ctx context.Context, request *types.Descr
 	return thrift.ToDescribeTaskListResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) GetWorkerBuildIDCompatibility(ctx context.Context, request *types.GetWorkerBuildIDCompatibilityRequest, opts ...yarpc.CallOption) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	response, err := t.c.GetWorkerBuildIDCompatibility(ctx, thrift.FromGetWorkerBuildIDCompatibilityRequest(request), opts...)
+	return thrift.ToGetWorkerBuildIDCompatibilityResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) DescribeWorkflowExecution(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	response, err := t.c.DescribeWorkflowExecution(ctx, thrift.FromDescribeWorkflowExecutionRequest(request), opts...)
 	return thrift.ToDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) ExpediteWorkflowExecutionStart(ctx context.Context, request *types.ExpediteWorkflowExecutionStartRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ExpediteWorkflowExecutionStart(ctx, thrift.FromExpediteWorkflowExecutionStartRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) GetClusterInfo(ctx context.Context, opts ...yarpc.CallOption) (*types.ClusterInfo, error) {
 	response, err := t.c.GetClusterInfo(ctx, opts...)
 	return thrift.ToClusterInfo(response), thrift.ToError(err)
@@ -229,6 +239,11 @@ func (t thriftClient) TerminateWorkflowExecution(ctx context.Context, request *t
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) UpdateWorkerBuildIDCompatibility(ctx context.Context, request *types.UpdateWorkerBuildIDCompatibilityRequest, opts ...yarpc.CallOption) error {
+	err := t.c.UpdateWorkerBuildIDCompatibility(ctx, thrift.FromUpdateWorkerBuildIDCompatibilityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) UpdateDomain(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	response, err := t.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(request), opts...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
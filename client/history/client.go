@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/future"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/shardplacement"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -652,6 +653,25 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.HistoryExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForWorkflowID(request.ExpediteRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client Client) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ExpediteWorkflowExecutionStart(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *types.HistoryResetWorkflowExecutionRequest,
@@ -1148,7 +1168,7 @@ func (c *clientImpl) getClientForDomainID(domainID string) (Client, error) {
 }
 
 func (c *clientImpl) getClientForShardID(shardID int) (Client, error) {
-	client, err := c.clients.GetClientForKey(string(rune(shardID)))
+	client, err := c.clients.GetClientForKey(shardplacement.ShardKey(shardID))
 	if err != nil {
 		return nil, err
 	}
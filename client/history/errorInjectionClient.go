@@ -670,6 +670,31 @@ func (c *errorInjectionClient) TerminateWorkflowExecution(
 	return clientErr
 }
 
+func (c *errorInjectionClient) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.HistoryExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.ExpediteWorkflowExecutionStart(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.HistoryClientOperationExpediteWorkflowExecutionStart,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
 func (c *errorInjectionClient) ResetWorkflowExecution(
 	ctx context.Context,
 	request *types.HistoryResetWorkflowExecutionRequest,
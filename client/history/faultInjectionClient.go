@@ -0,0 +1,555 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/faultinjection"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+)
+
+var _ Client = (*faultInjectionClient)(nil)
+
+type faultInjectionClient struct {
+	client   Client
+	injector faultinjection.Injector
+}
+
+// NewFaultInjectionClient creates a new instance of Client that injects faults according to the rules
+func NewFaultInjectionClient(
+	client Client,
+	rules faultinjection.RulesProvider,
+	logger log.Logger,
+) Client {
+	return &faultInjectionClient{
+		client:   client,
+		injector: faultinjection.NewInjector(faultinjection.LayerHistory, rules, errors.ErrFakeTimeout, logger),
+	}
+}
+
+func (c *faultInjectionClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.StartWorkflowExecutionResponse, error) {
+	var resp *types.StartWorkflowExecutionResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("StartWorkflowExecution", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartWorkflowExecution(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) DescribeHistoryHost(
+	ctx context.Context,
+	request *types.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeHistoryHostResponse, error) {
+	var resp *types.DescribeHistoryHostResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("DescribeHistoryHost", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHistoryHost(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) CloseShard(
+	ctx context.Context,
+	request *types.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("CloseShard", request), func(ctx context.Context) error {
+		return c.client.CloseShard(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) ResetQueue(
+	ctx context.Context,
+	request *types.ResetQueueRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("ResetQueue", request), func(ctx context.Context) error {
+		return c.client.ResetQueue(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) DescribeQueue(
+	ctx context.Context,
+	request *types.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeQueueResponse, error) {
+	var resp *types.DescribeQueueResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("DescribeQueue", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeQueue(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RemoveTask(
+	ctx context.Context,
+	request *types.RemoveTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RemoveTask", request), func(ctx context.Context) error {
+		return c.client.RemoveTask(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) DescribeMutableState(
+	ctx context.Context,
+	request *types.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeMutableStateResponse, error) {
+	var resp *types.DescribeMutableStateResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("DescribeMutableState", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeMutableState(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) GetMutableState(
+	ctx context.Context,
+	request *types.GetMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetMutableStateResponse, error) {
+	var resp *types.GetMutableStateResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("GetMutableState", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetMutableState(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) PollMutableState(
+	ctx context.Context,
+	request *types.PollMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.PollMutableStateResponse, error) {
+	var resp *types.PollMutableStateResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("PollMutableState", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PollMutableState(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) ResetStickyTaskList(
+	ctx context.Context,
+	request *types.HistoryResetStickyTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryResetStickyTaskListResponse, error) {
+	var resp *types.HistoryResetStickyTaskListResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("ResetStickyTaskList", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetStickyTaskList(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryDescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeWorkflowExecutionResponse, error) {
+	var resp *types.DescribeWorkflowExecutionResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("DescribeWorkflowExecution", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkflowExecution(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RecordDecisionTaskStarted(
+	ctx context.Context,
+	request *types.RecordDecisionTaskStartedRequest,
+	opts ...yarpc.CallOption,
+) (*types.RecordDecisionTaskStartedResponse, error) {
+	var resp *types.RecordDecisionTaskStartedResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("RecordDecisionTaskStarted", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecordDecisionTaskStarted(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RecordActivityTaskStarted(
+	ctx context.Context,
+	request *types.RecordActivityTaskStartedRequest,
+	opts ...yarpc.CallOption,
+) (*types.RecordActivityTaskStartedResponse, error) {
+	var resp *types.RecordActivityTaskStartedResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("RecordActivityTaskStarted", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecordActivityTaskStarted(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *types.HistoryRespondDecisionTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryRespondDecisionTaskCompletedResponse, error) {
+	var resp *types.HistoryRespondDecisionTaskCompletedResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("RespondDecisionTaskCompleted", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RespondDecisionTaskCompleted(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *types.HistoryRespondDecisionTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RespondDecisionTaskFailed", request), func(ctx context.Context) error {
+		return c.client.RespondDecisionTaskFailed(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *types.HistoryRespondActivityTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RespondActivityTaskCompleted", request), func(ctx context.Context) error {
+		return c.client.RespondActivityTaskCompleted(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *types.HistoryRespondActivityTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RespondActivityTaskFailed", request), func(ctx context.Context) error {
+		return c.client.RespondActivityTaskFailed(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *types.HistoryRespondActivityTaskCanceledRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RespondActivityTaskCanceled", request), func(ctx context.Context) error {
+		return c.client.RespondActivityTaskCanceled(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *types.HistoryRecordActivityTaskHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (*types.RecordActivityTaskHeartbeatResponse, error) {
+	var resp *types.RecordActivityTaskHeartbeatResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("RecordActivityTaskHeartbeat", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryRequestCancelWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RequestCancelWorkflowExecution", request), func(ctx context.Context) error {
+		return c.client.RequestCancelWorkflowExecution(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *types.HistorySignalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("SignalWorkflowExecution", request), func(ctx context.Context) error {
+		return c.client.SignalWorkflowExecution(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *types.HistorySignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.StartWorkflowExecutionResponse, error) {
+	var resp *types.StartWorkflowExecutionResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("SignalWithStartWorkflowExecution", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RemoveSignalMutableState(
+	ctx context.Context,
+	request *types.RemoveSignalMutableStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RemoveSignalMutableState", request), func(ctx context.Context) error {
+		return c.client.RemoveSignalMutableState(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryTerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("TerminateWorkflowExecution", request), func(ctx context.Context) error {
+		return c.client.TerminateWorkflowExecution(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.HistoryExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("ExpediteWorkflowExecutionStart", request), func(ctx context.Context) error {
+		return c.client.ExpediteWorkflowExecutionStart(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.ResetWorkflowExecutionResponse, error) {
+	var resp *types.ResetWorkflowExecutionResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("ResetWorkflowExecution", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetWorkflowExecution(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) ScheduleDecisionTask(
+	ctx context.Context,
+	request *types.ScheduleDecisionTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("ScheduleDecisionTask", request), func(ctx context.Context) error {
+		return c.client.ScheduleDecisionTask(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) RecordChildExecutionCompleted(
+	ctx context.Context,
+	request *types.RecordChildExecutionCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RecordChildExecutionCompleted", request), func(ctx context.Context) error {
+		return c.client.RecordChildExecutionCompleted(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) ReplicateEventsV2(
+	ctx context.Context,
+	request *types.ReplicateEventsV2Request,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("ReplicateEventsV2", request), func(ctx context.Context) error {
+		return c.client.ReplicateEventsV2(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) SyncShardStatus(
+	ctx context.Context,
+	request *types.SyncShardStatusRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("SyncShardStatus", request), func(ctx context.Context) error {
+		return c.client.SyncShardStatus(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) SyncActivity(
+	ctx context.Context,
+	request *types.SyncActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("SyncActivity", request), func(ctx context.Context) error {
+		return c.client.SyncActivity(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) GetReplicationMessages(
+	ctx context.Context,
+	request *types.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationMessagesResponse, error) {
+	var resp *types.GetReplicationMessagesResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("GetReplicationMessages", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetReplicationMessages(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) GetDLQReplicationMessages(
+	ctx context.Context,
+	request *types.GetDLQReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDLQReplicationMessagesResponse, error) {
+	var resp *types.GetDLQReplicationMessagesResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("GetDLQReplicationMessages", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDLQReplicationMessages(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) QueryWorkflow(
+	ctx context.Context,
+	request *types.HistoryQueryWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryQueryWorkflowResponse, error) {
+	var resp *types.HistoryQueryWorkflowResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("QueryWorkflow", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.QueryWorkflow(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) ReapplyEvents(
+	ctx context.Context,
+	request *types.HistoryReapplyEventsRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("ReapplyEvents", request), func(ctx context.Context) error {
+		return c.client.ReapplyEvents(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) ReadDLQMessages(
+	ctx context.Context,
+	request *types.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReadDLQMessagesResponse, error) {
+	var resp *types.ReadDLQMessagesResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("ReadDLQMessages", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReadDLQMessages(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) PurgeDLQMessages(
+	ctx context.Context,
+	request *types.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("PurgeDLQMessages", request), func(ctx context.Context) error {
+		return c.client.PurgeDLQMessages(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) MergeDLQMessages(
+	ctx context.Context,
+	request *types.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*types.MergeDLQMessagesResponse, error) {
+	var resp *types.MergeDLQMessagesResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("MergeDLQMessages", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MergeDLQMessages(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *types.HistoryRefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RefreshWorkflowTasks", request), func(ctx context.Context) error {
+		return c.client.RefreshWorkflowTasks(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) NotifyFailoverMarkers(
+	ctx context.Context,
+	request *types.NotifyFailoverMarkersRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("NotifyFailoverMarkers", request), func(ctx context.Context) error {
+		return c.client.NotifyFailoverMarkers(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) GetCrossClusterTasks(
+	ctx context.Context,
+	request *types.GetCrossClusterTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetCrossClusterTasksResponse, error) {
+	var resp *types.GetCrossClusterTasksResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("GetCrossClusterTasks", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetCrossClusterTasks(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RespondCrossClusterTasksCompleted(
+	ctx context.Context,
+	request *types.RespondCrossClusterTasksCompletedRequest,
+	opts ...yarpc.CallOption,
+) (*types.RespondCrossClusterTasksCompletedResponse, error) {
+	var resp *types.RespondCrossClusterTasksCompletedResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("RespondCrossClusterTasksCompleted", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RespondCrossClusterTasksCompleted(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
@@ -242,3 +242,8 @@ func (g grpcClient) TerminateWorkflowExecution(ctx context.Context, request *typ
 	_, err := g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(request), opts...)
 	return proto.ToError(err)
 }
+
+func (g grpcClient) ExpediteWorkflowExecutionStart(ctx context.Context, request *types.HistoryExpediteWorkflowExecutionStartRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ExpediteWorkflowExecutionStart(ctx, proto.FromHistoryExpediteWorkflowExecutionStartRequest(request), opts...)
+	return proto.ToError(err)
+}
@@ -852,6 +852,25 @@ func (m *MockClient) TerminateWorkflowExecution(arg0 context.Context, arg1 *type
 	return ret0
 }
 
+// ExpediteWorkflowExecutionStart mocks base method
+func (m *MockClient) ExpediteWorkflowExecutionStart(arg0 context.Context, arg1 *types.HistoryExpediteWorkflowExecutionStartRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExpediteWorkflowExecutionStart", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpediteWorkflowExecutionStart indicates an expected call of ExpediteWorkflowExecutionStart
+func (mr *MockClientMockRecorder) ExpediteWorkflowExecutionStart(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpediteWorkflowExecutionStart", reflect.TypeOf((*MockClient)(nil).ExpediteWorkflowExecutionStart), varargs...)
+}
+
 // TerminateWorkflowExecution indicates an expected call of TerminateWorkflowExecution
 func (mr *MockClientMockRecorder) TerminateWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -476,6 +476,24 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) ExpediteWorkflowExecutionStart(
+	context context.Context,
+	request *types.HistoryExpediteWorkflowExecutionStartRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientExpediteWorkflowExecutionStartScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientExpediteWorkflowExecutionStartScope, metrics.CadenceClientLatency)
+	err := c.client.ExpediteWorkflowExecutionStart(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientExpediteWorkflowExecutionStartScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) ResetWorkflowExecution(
 	context context.Context,
 	request *types.HistoryResetWorkflowExecutionRequest,
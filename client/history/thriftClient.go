@@ -243,3 +243,8 @@ func (t thriftClient) TerminateWorkflowExecution(ctx context.Context, request *t
 	err := t.c.TerminateWorkflowExecution(ctx, thrift.FromHistoryTerminateWorkflowExecutionRequest(request), opts...)
 	return thrift.ToError(err)
 }
+
+func (t thriftClient) ExpediteWorkflowExecutionStart(ctx context.Context, request *types.HistoryExpediteWorkflowExecutionStartRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ExpediteWorkflowExecutionStart(ctx, thrift.FromHistoryExpediteWorkflowExecutionStartRequest(request), opts...)
+	return thrift.ToError(err)
+}
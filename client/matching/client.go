@@ -282,6 +282,66 @@ func (c *clientImpl) GetTaskListsByDomain(
 	return &types.GetTaskListsByDomainResponse{TaskListNames: taskListNames}, nil
 }
 
+func (c *clientImpl) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTaskList(request.GetUpdateRequest().GetTaskList().GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkerBuildIDCompatibility(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingGetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTaskList(request.GetGetRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkerBuildIDCompatibility(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskListState(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTaskList(request.GetTaskList().GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskListState(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetTaskListState(
+	ctx context.Context,
+	request *types.MatchingGetTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.TaskListState, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTaskList(request.GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetTaskListState(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(
 	parent context.Context,
 ) (context.Context, context.CancelFunc) {
@@ -0,0 +1,223 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/faultinjection"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+)
+
+var _ Client = (*faultInjectionClient)(nil)
+
+type faultInjectionClient struct {
+	client   Client
+	injector faultinjection.Injector
+}
+
+// NewFaultInjectionClient creates a new instance of Client that injects faults according to the rules
+func NewFaultInjectionClient(
+	client Client,
+	rules faultinjection.RulesProvider,
+	logger log.Logger,
+) Client {
+	return &faultInjectionClient{
+		client:   client,
+		injector: faultinjection.NewInjector(faultinjection.LayerMatching, rules, errors.ErrFakeTimeout, logger),
+	}
+}
+
+func (c *faultInjectionClient) AddActivityTask(
+	ctx context.Context,
+	addRequest *types.AddActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("AddActivityTask", addRequest), func(ctx context.Context) error {
+		return c.client.AddActivityTask(ctx, addRequest, opts...)
+	})
+}
+
+func (c *faultInjectionClient) AddDecisionTask(
+	ctx context.Context,
+	addRequest *types.AddDecisionTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("AddDecisionTask", addRequest), func(ctx context.Context) error {
+		return c.client.AddDecisionTask(ctx, addRequest, opts...)
+	})
+}
+
+func (c *faultInjectionClient) PollForActivityTask(
+	ctx context.Context,
+	pollRequest *types.MatchingPollForActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (*types.PollForActivityTaskResponse, error) {
+	var resp *types.PollForActivityTaskResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("PollForActivityTask", pollRequest), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PollForActivityTask(ctx, pollRequest, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) PollForDecisionTask(
+	ctx context.Context,
+	pollRequest *types.MatchingPollForDecisionTaskRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingPollForDecisionTaskResponse, error) {
+	var resp *types.MatchingPollForDecisionTaskResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("PollForDecisionTask", pollRequest), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PollForDecisionTask(ctx, pollRequest, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) QueryWorkflow(
+	ctx context.Context,
+	queryRequest *types.MatchingQueryWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*types.QueryWorkflowResponse, error) {
+	var resp *types.QueryWorkflowResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("QueryWorkflow", queryRequest), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.QueryWorkflow(ctx, queryRequest, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *types.MatchingRespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("RespondQueryTaskCompleted", request), func(ctx context.Context) error {
+		return c.client.RespondQueryTaskCompleted(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) CancelOutstandingPoll(
+	ctx context.Context,
+	request *types.CancelOutstandingPollRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("CancelOutstandingPoll", request), func(ctx context.Context) error {
+		return c.client.CancelOutstandingPoll(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) DescribeTaskList(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListResponse, error) {
+	var resp *types.DescribeTaskListResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("DescribeTaskList", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskList(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) ListTaskListPartitions(
+	ctx context.Context,
+	request *types.MatchingListTaskListPartitionsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListTaskListPartitionsResponse, error) {
+	var resp *types.ListTaskListPartitionsResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("ListTaskListPartitions", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskListPartitions(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) GetTaskListsByDomain(
+	ctx context.Context,
+	request *types.GetTaskListsByDomainRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListsByDomainResponse, error) {
+	var resp *types.GetTaskListsByDomainResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("GetTaskListsByDomain", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskListsByDomain(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("UpdateWorkerBuildIDCompatibility", request), func(ctx context.Context) error {
+		return c.client.UpdateWorkerBuildIDCompatibility(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingGetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	var resp *types.GetWorkerBuildIDCompatibilityResponse
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("GetWorkerBuildIDCompatibility", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetWorkerBuildIDCompatibility(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *faultInjectionClient) UpdateTaskListState(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.injector.Inject(ctx, faultinjection.NewRequest("UpdateTaskListState", request), func(ctx context.Context) error {
+		return c.client.UpdateTaskListState(ctx, request, opts...)
+	})
+}
+
+func (c *faultInjectionClient) GetTaskListState(
+	ctx context.Context,
+	request *types.MatchingGetTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.TaskListState, error) {
+	var resp *types.TaskListState
+	err := c.injector.Inject(ctx, faultinjection.NewRequest("GetTaskListState", request), func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskListState(ctx, request, opts...)
+		return err
+	})
+	return resp, err
+}
@@ -68,6 +68,26 @@ func (g grpcClient) GetTaskListsByDomain(ctx context.Context, request *types.Get
 	return proto.ToMatchingGetTaskListsByDomainResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) UpdateWorkerBuildIDCompatibility(ctx context.Context, request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UpdateWorkerBuildIDCompatibility(ctx, proto.FromMatchingUpdateWorkerBuildIDCompatibilityRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) GetWorkerBuildIDCompatibility(ctx context.Context, request *types.MatchingGetWorkerBuildIDCompatibilityRequest, opts ...yarpc.CallOption) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	response, err := g.c.GetWorkerBuildIDCompatibility(ctx, proto.FromMatchingGetWorkerBuildIDCompatibilityRequest(request), opts...)
+	return proto.ToMatchingGetWorkerBuildIDCompatibilityResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) UpdateTaskListState(ctx context.Context, request *types.MatchingUpdateTaskListStateRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.UpdateTaskListState(ctx, proto.FromMatchingUpdateTaskListStateRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) GetTaskListState(ctx context.Context, request *types.MatchingGetTaskListStateRequest, opts ...yarpc.CallOption) (*types.TaskListState, error) {
+	response, err := g.c.GetTaskListState(ctx, proto.FromMatchingGetTaskListStateRequest(request), opts...)
+	return proto.ToMatchingGetTaskListStateResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) PollForActivityTask(ctx context.Context, request *types.MatchingPollForActivityTaskRequest, opts ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromMatchingPollForActivityTaskRequest(request), opts...)
 	return proto.ToMatchingPollForActivityTaskResponse(response), proto.ToError(err)
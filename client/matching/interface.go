@@ -38,6 +38,10 @@ type Client interface {
 	DescribeTaskList(context.Context, *types.MatchingDescribeTaskListRequest, ...yarpc.CallOption) (*types.DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *types.MatchingListTaskListPartitionsRequest, ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest, ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error)
+	UpdateWorkerBuildIDCompatibility(context.Context, *types.MatchingUpdateWorkerBuildIDCompatibilityRequest, ...yarpc.CallOption) error
+	GetWorkerBuildIDCompatibility(context.Context, *types.MatchingGetWorkerBuildIDCompatibilityRequest, ...yarpc.CallOption) (*types.GetWorkerBuildIDCompatibilityResponse, error)
+	UpdateTaskListState(context.Context, *types.MatchingUpdateTaskListStateRequest, ...yarpc.CallOption) error
+	GetTaskListState(context.Context, *types.MatchingGetTaskListStateRequest, ...yarpc.CallOption) (*types.TaskListState, error)
 	PollForActivityTask(context.Context, *types.MatchingPollForActivityTaskRequest, ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error)
 	PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest, ...yarpc.CallOption) (*types.MatchingPollForDecisionTaskResponse, error)
 	QueryWorkflow(context.Context, *types.MatchingQueryWorkflowRequest, ...yarpc.CallOption) (*types.QueryWorkflowResponse, error)
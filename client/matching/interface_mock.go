@@ -174,6 +174,84 @@ func (mr *MockClientMockRecorder) GetTaskListsByDomain(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListsByDomain", reflect.TypeOf((*MockClient)(nil).GetTaskListsByDomain), varargs...)
 }
 
+// UpdateWorkerBuildIDCompatibility mocks base method
+func (m *MockClient) UpdateWorkerBuildIDCompatibility(arg0 context.Context, arg1 *types.MatchingUpdateWorkerBuildIDCompatibilityRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkerBuildIDCompatibility", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkerBuildIDCompatibility indicates an expected call of UpdateWorkerBuildIDCompatibility
+func (mr *MockClientMockRecorder) UpdateWorkerBuildIDCompatibility(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIDCompatibility", reflect.TypeOf((*MockClient)(nil).UpdateWorkerBuildIDCompatibility), varargs...)
+}
+
+// GetWorkerBuildIDCompatibility mocks base method
+func (m *MockClient) GetWorkerBuildIDCompatibility(arg0 context.Context, arg1 *types.MatchingGetWorkerBuildIDCompatibilityRequest, arg2 ...yarpc.CallOption) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkerBuildIDCompatibility", varargs...)
+	ret0, _ := ret[0].(*types.GetWorkerBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerBuildIDCompatibility indicates an expected call of GetWorkerBuildIDCompatibility
+func (mr *MockClientMockRecorder) GetWorkerBuildIDCompatibility(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerBuildIDCompatibility", reflect.TypeOf((*MockClient)(nil).GetWorkerBuildIDCompatibility), varargs...)
+}
+
+// UpdateTaskListState mocks base method
+func (m *MockClient) UpdateTaskListState(arg0 context.Context, arg1 *types.MatchingUpdateTaskListStateRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskListState", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskListState indicates an expected call of UpdateTaskListState
+func (mr *MockClientMockRecorder) UpdateTaskListState(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListState", reflect.TypeOf((*MockClient)(nil).UpdateTaskListState), varargs...)
+}
+
+// GetTaskListState mocks base method
+func (m *MockClient) GetTaskListState(arg0 context.Context, arg1 *types.MatchingGetTaskListStateRequest, arg2 ...yarpc.CallOption) (*types.TaskListState, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskListState", varargs...)
+	ret0, _ := ret[0].(*types.TaskListState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListState indicates an expected call of GetTaskListState
+func (mr *MockClientMockRecorder) GetTaskListState(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListState", reflect.TypeOf((*MockClient)(nil).GetTaskListState), varargs...)
+}
+
 // PollForActivityTask mocks base method
 func (m *MockClient) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest, arg2 ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -260,6 +260,78 @@ func (c *metricClient) GetTaskListsByDomain(
 	return resp, err
 }
 
+func (c *metricClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientUpdateWorkerBuildIDCompatibilityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientUpdateWorkerBuildIDCompatibilityScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateWorkerBuildIDCompatibility(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientUpdateWorkerBuildIDCompatibilityScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingGetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientGetWorkerBuildIDCompatibilityScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientGetWorkerBuildIDCompatibilityScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetWorkerBuildIDCompatibility(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientGetWorkerBuildIDCompatibilityScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) UpdateTaskListState(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListStateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientUpdateTaskListStateScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateTaskListState(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListStateScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) GetTaskListState(
+	ctx context.Context,
+	request *types.MatchingGetTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.TaskListState, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientGetTaskListStateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientGetTaskListStateScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetTaskListState(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientGetTaskListStateScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) emitForwardedFromStats(
 	scope int,
 	forwardedFrom string,
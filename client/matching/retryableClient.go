@@ -202,3 +202,63 @@ func (c *retryableClient) GetTaskListsByDomain(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateWorkerBuildIDCompatibility(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingGetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+
+	var resp *types.GetWorkerBuildIDCompatibilityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetWorkerBuildIDCompatibility(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskListState(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateTaskListState(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) GetTaskListState(
+	ctx context.Context,
+	request *types.MatchingGetTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.TaskListState, error) {
+
+	var resp *types.TaskListState
+	op := func() error {
+		var err error
+		resp, err = c.client.GetTaskListState(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
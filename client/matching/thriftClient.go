@@ -93,6 +93,42 @@ func (t thriftClient) GetTaskListsByDomain(
 	return thrift.ToGetTaskListsByDomainResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) error {
+	err := t.c.UpdateWorkerBuildIDCompatibility(ctx, thrift.FromMatchingUpdateWorkerBuildIDCompatibilityRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingGetWorkerBuildIDCompatibilityRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	response, err := t.c.GetWorkerBuildIDCompatibility(ctx, thrift.FromMatchingGetWorkerBuildIDCompatibilityRequest(request), opts...)
+	return thrift.ToGetWorkerBuildIDCompatibilityResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateTaskListState(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+	err := t.c.UpdateTaskListState(ctx, thrift.FromMatchingUpdateTaskListStateRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) GetTaskListState(
+	ctx context.Context,
+	request *types.MatchingGetTaskListStateRequest,
+	opts ...yarpc.CallOption,
+) (*types.TaskListState, error) {
+	response, err := t.c.GetTaskListState(ctx, thrift.FromMatchingGetTaskListStateRequest(request), opts...)
+	return thrift.ToTaskListState(response), thrift.ToError(err)
+}
+
 func (t thriftClient) PollForActivityTask(
 	ctx context.Context,
 	request *types.MatchingPollForActivityTaskRequest,
@@ -32,14 +32,18 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	"github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/faultinjection"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging/kafka"
 	"github.com/uber/cadence/common/metrics"
+	persistenceClient "github.com/uber/cadence/common/persistence/client"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/frontend"
 	"github.com/uber/cadence/service/history"
@@ -113,11 +117,20 @@ func (s *server) startService() common.Daemon {
 	params.UpdateLoggerWithServiceName(params.Name)
 	params.PersistenceConfig = s.cfg.Persistence
 
-	params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, s.doneC)
+	svcCfg := s.cfg.Services[s.name]
+	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger, params.Name)
+	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
+
+	params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.MetricsClient, params.Logger, s.doneC)
 	if err != nil {
 		log.Printf("error creating file based dynamic config client, use no-op config client instead. error: %v", err)
 		params.DynamicConfig = dynamicconfig.NewNopClient()
 	}
+	if s.cfg.DynamicConfigStore.Enabled {
+		if err := s.initConfigStoreClient(&params); err != nil {
+			log.Fatalf("error creating config store dynamic config client: %v", err)
+		}
+	}
 	clusterMetadata := s.cfg.ClusterMetadata
 	dc := dynamicconfig.NewCollection(
 		params.DynamicConfig,
@@ -125,8 +138,6 @@ func (s *server) startService() common.Daemon {
 		dynamicconfig.ClusterNameFilter(clusterMetadata.CurrentClusterName),
 	)
 
-	svcCfg := s.cfg.Services[s.name]
-	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger, params.Name)
 	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger, s.cfg.NewGRPCPorts())
 	params.MembershipFactory, err = s.cfg.Ringpop.NewFactory(
 		params.RPCFactory.GetDispatcher(),
@@ -140,8 +151,6 @@ func (s *server) startService() common.Daemon {
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
 
-	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
-
 	//TODO: remove this after 0.23 and mention a breaking change in config.
 	primaryClusterName := clusterMetadata.PrimaryClusterName
 	if len(primaryClusterName) == 0 {
@@ -216,6 +225,21 @@ func (s *server) startService() common.Daemon {
 	params.ArchiverProvider = provider.NewArchiverProvider(s.cfg.Archival.History.Provider, s.cfg.Archival.Visibility.Provider)
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
 	params.PersistenceConfig.ErrorInjectionRate = dc.GetFloat64Property(dynamicconfig.PersistenceErrorInjectionRate, 0)
+	params.PersistenceConfig.CircuitBreaker = &config.PersistenceCircuitBreaker{
+		Enabled:               dc.GetBoolProperty(dynamicconfig.PersistenceCircuitBreakerEnabled, false),
+		ErrorRateThreshold:    dc.GetFloat64Property(dynamicconfig.PersistenceCircuitBreakerErrorRateThreshold, 0.5),
+		SlowCallRateThreshold: dc.GetFloat64Property(dynamicconfig.PersistenceCircuitBreakerSlowCallRateThreshold, 0.8),
+		SlowCallLatency:       dc.GetDurationProperty(dynamicconfig.PersistenceCircuitBreakerSlowCallLatency, 5*time.Second),
+		MinRequests:           dc.GetIntProperty(dynamicconfig.PersistenceCircuitBreakerMinRequests, 100),
+		Window:                dc.GetDurationProperty(dynamicconfig.PersistenceCircuitBreakerWindow, 10*time.Second),
+		OpenDuration:          dc.GetDurationProperty(dynamicconfig.PersistenceCircuitBreakerOpenDuration, 5*time.Second),
+	}
+	params.PersistenceConfig.FaultInjection = faultinjection.NewDynamicConfigRulesProvider(
+		dc.GetBoolProperty(dynamicconfig.EnableFaultInjection, false),
+		dc.GetMapProperty(dynamicconfig.FaultInjectionRules, nil),
+		dc.GetStringProperty(dynamicconfig.FaultInjectionRulesFile, ""),
+		params.Logger,
+	)
 	params.Authorizer = authorization.NewNopAuthorizer()
 	params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
 	if err != nil {
@@ -246,6 +270,35 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// initConfigStoreClient replaces the dynamic config client with the client backed by the cluster database,
+// the replaced client serves the keys which have no value in the database
+func (s *server) initConfigStoreClient(params *service.BootstrapParams) error {
+	// the dynamic config of persistence is not available before the dynamic config client is created
+	persistenceCfg := s.cfg.Persistence
+	persistenceCfg.TransactionSizeLimit = dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit)
+	persistenceCfg.ErrorInjectionRate = dynamicconfig.GetFloatPropertyFn(0)
+
+	factory := persistenceClient.NewFactory(&persistenceCfg, nil, s.cfg.ClusterMetadata.CurrentClusterName, nil, params.Logger)
+	queue, err := factory.NewDynamicConfigQueueManager()
+	if err != nil {
+		factory.Close()
+		return err
+	}
+	store := configstore.NewStore(queue, clock.NewRealTimeSource())
+	client, err := configstore.NewClient(&s.cfg.DynamicConfigStore, store, params.DynamicConfig, params.MetricsClient, params.Logger, s.doneC)
+	if err != nil {
+		factory.Close()
+		return err
+	}
+	params.DynamicConfigStore = store
+	params.DynamicConfig = client
+	go func() {
+		<-s.doneC
+		factory.Close()
+	}()
+	return nil
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
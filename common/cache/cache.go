@@ -71,11 +71,17 @@ type Options struct {
 	// is scheduled for deletion
 	RemovedFunc RemovedFunc
 
+	// EvictedFunc is an optional function called synchronously when an element
+	// is evicted because it expired or the cache is full, it must not call the cache
+	EvictedFunc EvictedFunc
+
 	// MaxCount controls the max capacity of the cache
 	// It is required option if MaxSize is not provided
 	MaxCount int
 
 	// GetCacheItemSizeFunc is a function called upon adding the item to update the cache size.
+	// In Pin mode it is called again when the item is released, so items can grow or shrink while in use,
+	// it must not call the cache then.
 	// It returns 0 by default, assuming the cache is just count based
 	// It is required option if MaxCount is not provided
 	GetCacheItemSizeFunc GetCacheItemSizeFunc
@@ -102,6 +108,21 @@ type SimpleOptions struct {
 // deletion, Cache calls go f(i)
 type RemovedFunc func(interface{})
 
+// EvictedFunc is a type for notifying applications when an item is evicted from the Cache
+type EvictedFunc func(value interface{}, reason EvictionReason)
+
+// EvictionReason is the reason an item is evicted from the Cache
+type EvictionReason int
+
+const (
+	// EvictionReasonExpired means the TTL of the item passed
+	EvictionReasonExpired EvictionReason = iota
+	// EvictionReasonMaxCount means the Cache reached MaxCount
+	EvictionReasonMaxCount
+	// EvictionReasonMaxSize means the Cache reached MaxSize
+	EvictionReasonMaxSize
+)
+
 // Iterator represents the interface for cache iterators
 type Iterator interface {
 	// Close closes the iterator
@@ -45,6 +45,7 @@ type (
 		ttl         time.Duration
 		pin         bool
 		rmFunc      RemovedFunc
+		evictFunc   EvictedFunc
 		sizeFunc    GetCacheItemSizeFunc
 		maxSize     uint64
 		currSize    uint64
@@ -99,7 +100,7 @@ func (it *iteratorImpl) prepareNext() {
 		entry := it.nextItem.Value.(*entryImpl)
 		if it.lru.isEntryExpired(entry, it.createTime) {
 			nextItem := it.nextItem.Next()
-			it.lru.deleteInternal(it.nextItem)
+			it.lru.evictInternal(it.nextItem, EvictionReasonExpired)
 			it.nextItem = nextItem
 		} else {
 			return
@@ -141,11 +142,12 @@ func New(opts *Options) Cache {
 	}
 
 	cache := &lru{
-		byAccess: list.New(),
-		byKey:    make(map[interface{}]*list.Element, opts.InitialCapacity),
-		ttl:      opts.TTL,
-		pin:      opts.Pin,
-		rmFunc:   opts.RemovedFunc,
+		byAccess:  list.New(),
+		byKey:     make(map[interface{}]*list.Element, opts.InitialCapacity),
+		ttl:       opts.TTL,
+		pin:       opts.Pin,
+		rmFunc:    opts.RemovedFunc,
+		evictFunc: opts.EvictedFunc,
 	}
 
 	cache.isSizeBased = opts.GetCacheItemSizeFunc != nil && opts.MaxSize > 0
@@ -178,7 +180,7 @@ func (c *lru) Get(key interface{}) interface{} {
 
 	if c.isEntryExpired(entry, time.Now()) {
 		// Entry has expired
-		c.deleteInternal(element)
+		c.evictInternal(element, EvictionReasonExpired)
 		return nil
 	}
 
@@ -235,6 +237,13 @@ func (c *lru) Release(key interface{}) {
 	}
 	entry := elt.Value.(*entryImpl)
 	entry.refCount--
+
+	if c.isSizeBased {
+		// the element may have grown or shrunk while in use
+		c.updateSizeOnDelete(key)
+		c.updateSizeOnAdd(key, c.sizeFunc(entry.value))
+		c.evictUnpinned()
+	}
 }
 
 // Size returns the number of entries currently in the lru, useful if cache is not full
@@ -257,7 +266,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 		entry := elt.Value.(*entryImpl)
 		if c.isEntryExpired(entry, time.Now()) {
 			// Entry has expired
-			c.deleteInternal(elt)
+			c.evictInternal(elt, EvictionReasonExpired)
 		} else {
 			existing := entry.value
 			if allowUpdate {
@@ -271,6 +280,11 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 			if c.pin {
 				entry.refCount++
 			}
+			if allowUpdate && c.isSizeBased {
+				c.updateSizeOnDelete(key)
+				c.updateSizeOnAdd(key, valueSize)
+				c.evictUnpinned()
+			}
 			return existing, nil
 		}
 	}
@@ -300,11 +314,30 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 			return nil, ErrCacheFull
 		}
 
-		c.deleteInternal(c.byAccess.Back())
+		c.evictInternal(c.byAccess.Back(), c.fullReason())
 	}
 	return nil, nil
 }
 
+// evictUnpinned evicts the least recently used elements until the cache is not full
+// or the least recently used element is pinned
+func (c *lru) evictUnpinned() {
+	for c.isCacheFull() {
+		oldest := c.byAccess.Back()
+		if oldest == nil || oldest.Value.(*entryImpl).refCount > 0 {
+			return
+		}
+		c.evictInternal(oldest, c.fullReason())
+	}
+}
+
+func (c *lru) evictInternal(element *list.Element, reason EvictionReason) {
+	if c.evictFunc != nil {
+		c.evictFunc(element.Value.(*entryImpl).value, reason)
+	}
+	c.deleteInternal(element)
+}
+
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	if c.rmFunc != nil {
@@ -324,6 +357,13 @@ func (c *lru) isCacheFull() bool {
 	return (!c.isSizeBased && count == c.maxCount) || c.currSize > c.maxSize || count > cacheCountLimit
 }
 
+func (c *lru) fullReason() EvictionReason {
+	if c.isSizeBased {
+		return EvictionReasonMaxSize
+	}
+	return EvictionReasonMaxCount
+}
+
 func (c *lru) updateSizeOnAdd(key interface{}, valueSize uint64) {
 	if c.isSizeBased {
 		c.sizeByKey[key] = valueSize
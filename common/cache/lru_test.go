@@ -347,6 +347,70 @@ func TestLRU_SizeBased_CountExceeded(t *testing.T) {
 	assert.Equal(t, 4, cache.Size())
 }
 
+func TestLRU_SizeBased_SizeChangedWhilePinned(t *testing.T) {
+	sizes := map[string]uint64{"A": 5, "B": 5, "C": 5}
+	var evicted []interface{}
+	cache := New(&Options{
+		Pin: true,
+		GetCacheItemSizeFunc: func(value interface{}) uint64 {
+			return sizes[value.(string)]
+		},
+		MaxSize: 15,
+		EvictedFunc: func(value interface{}, reason EvictionReason) {
+			assert.Equal(t, EvictionReasonMaxSize, reason)
+			evicted = append(evicted, value)
+		},
+	})
+
+	for _, key := range []string{"A", "B", "C"} {
+		_, err := cache.PutIfNotExist(key, key)
+		assert.NoError(t, err)
+		cache.Release(key)
+	}
+	assert.Equal(t, 3, cache.Size())
+
+	// C grows while in use, the least recently used elements are evicted when it is released
+	assert.Equal(t, "C", cache.Get("C"))
+	sizes["C"] = 8
+	assert.Equal(t, 3, cache.Size())
+	cache.Release("C")
+	assert.Equal(t, []interface{}{"A"}, evicted)
+	assert.Equal(t, 2, cache.Size())
+
+	// pinned elements are not evicted
+	assert.Equal(t, "B", cache.Get("B"))
+	sizes["C"] = 15
+	assert.Equal(t, "C", cache.Get("C"))
+	cache.Release("C")
+	assert.Equal(t, 2, cache.Size())
+	cache.Release("B")
+	assert.Equal(t, []interface{}{"A", "B"}, evicted)
+	assert.Equal(t, 1, cache.Size())
+}
+
+func TestEvictedFunc(t *testing.T) {
+	var reasons []EvictionReason
+	cache := New(&Options{
+		MaxCount: 3,
+		TTL:      time.Millisecond * 50,
+		EvictedFunc: func(value interface{}, reason EvictionReason) {
+			reasons = append(reasons, reason)
+		},
+	})
+
+	cache.Put("A", "Foo")
+	cache.Put("B", "Bar")
+	cache.Put("C", "Cid")
+	assert.Equal(t, []EvictionReason{EvictionReasonMaxCount}, reasons)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Nil(t, cache.Get("C"))
+	assert.Equal(t, []EvictionReason{EvictionReasonMaxCount, EvictionReasonExpired}, reasons)
+
+	cache.Delete("B")
+	assert.Equal(t, []EvictionReason{EvictionReasonMaxCount, EvictionReasonExpired}, reasons)
+}
+
 func TestPanicMaxCountAndSizeNotProvided(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
@@ -32,6 +32,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/faultinjection"
 )
 
 type (
@@ -58,6 +59,9 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath would be relative to the root directory when the path wasn't absolute.
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// DynamicConfigStore is the config for setting up the dynamic config client backed by the default datastore,
+		// values set through the admin API override the ones of the file based dynamic config client
+		DynamicConfigStore dynamicconfig.ConfigStoreClientConfig `yaml:"dynamicConfigStore"`
 		// DomainDefaults is the default config for every domain
 		DomainDefaults DomainDefaults `yaml:"domainDefaults"`
 		// Blobstore is the config for setting up blobstore
@@ -150,6 +154,30 @@ type (
 		// TODO: move dynamic config out of static config
 		// ErrorInjectionRate is the the rate for injecting random error
 		ErrorInjectionRate dynamicconfig.FloatPropertyFn `yaml:"-" json:"-"`
+		// TODO: move dynamic config out of static config
+		// CircuitBreaker is the config for tripping calls to an unhealthy datastore, nil means disabled
+		CircuitBreaker *PersistenceCircuitBreaker `yaml:"-" json:"-"`
+		// TODO: move dynamic config out of static config
+		// FaultInjection returns the fault injection rules for persistence calls, nil means disabled
+		FaultInjection faultinjection.RulesProvider `yaml:"-" json:"-"`
+	}
+
+	// PersistenceCircuitBreaker contains the dynamic config for persistence circuit breakers
+	PersistenceCircuitBreaker struct {
+		// Enabled turns the circuit breaker on or off
+		Enabled dynamicconfig.BoolPropertyFn
+		// ErrorRateThreshold is the ratio of failed calls in a window above which the breaker trips
+		ErrorRateThreshold dynamicconfig.FloatPropertyFn
+		// SlowCallRateThreshold is the ratio of slow calls in a window above which the breaker trips
+		SlowCallRateThreshold dynamicconfig.FloatPropertyFn
+		// SlowCallLatency is the latency above which a call is considered slow
+		SlowCallLatency dynamicconfig.DurationPropertyFn
+		// MinRequests is the minimal number of calls in a window before the breaker can trip
+		MinRequests dynamicconfig.IntPropertyFn
+		// Window is the length of the window the error and slow call rates are computed over
+		Window dynamicconfig.DurationPropertyFn
+		// OpenDuration is how long the breaker fails fast before letting a probe call through
+		OpenDuration dynamicconfig.DurationPropertyFn
 	}
 
 	// DataStore is the configuration for a single datastore
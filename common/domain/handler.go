@@ -660,6 +660,7 @@ func (d *handlerImpl) createResponse(
 		VisibilityArchivalStatus:               config.VisibilityArchivalStatus.Ptr(),
 		VisibilityArchivalURI:                  config.VisibilityArchivalURI,
 		BadBinaries:                            &config.BadBinaries,
+		DrainedIsolationGroups:                 config.DrainedIsolationGroups,
 	}
 
 	clusters := []*types.ClusterReplicationConfiguration{}
@@ -870,6 +871,10 @@ func (d *handlerImpl) updateDomainConfiguration(
 			}
 		}
 	}
+	if updateRequest.DrainedIsolationGroups != nil {
+		isConfigChanged = true
+		config.DrainedIsolationGroups = updateRequest.DrainedIsolationGroups
+	}
 	return config, isConfigChanged, nil
 }
 
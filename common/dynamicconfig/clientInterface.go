@@ -48,6 +48,13 @@ type Client interface {
 	UpdateValue(name Key, value interface{}) error
 }
 
+// ConfigStoreClientConfig is the config for the dynamic config client backed by the cluster's database.
+// Values set in the database take precedence over the ones in the file based dynamic config.
+type ConfigStoreClientConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	PollInterval time.Duration `yaml:"pollInterval"`
+}
+
 var notFoundError = &types.EntityNotExistsError{
 	Message: "unable to find key",
 }
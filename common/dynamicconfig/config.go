@@ -125,6 +125,9 @@ type FloatPropertyFn func(opts ...FilterOption) float64
 // FloatPropertyFnWithShardIDFilter is a wrapper to get float property from dynamic config with shardID as filter
 type FloatPropertyFnWithShardIDFilter func(shardID int) float64
 
+// FloatPropertyFnWithTaskListInfoFilters is a wrapper to get float property from dynamic config with three filters: domain, taskList, taskType
+type FloatPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) float64
+
 // DurationPropertyFn is a wrapper to get duration property from dynamic config
 type DurationPropertyFn func(opts ...FilterOption) time.Duration
 
@@ -279,6 +282,27 @@ func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue f
 	}
 }
 
+// GetFloat64PropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's a float64
+func (c *Collection) GetFloat64PropertyFilteredByTaskListInfo(key Key, defaultValue float64) FloatPropertyFnWithTaskListInfoFilters {
+	return func(domain string, taskList string, taskType int) float64 {
+		filters := c.toFilterMap(
+			DomainFilter(domain),
+			TaskListFilter(taskList),
+			TaskTypeFilter(taskType),
+		)
+		val, err := c.client.GetFloatValue(
+			key,
+			filters,
+			defaultValue,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		c.logValue(key, filters, val, defaultValue, float64CompareEquals)
+		return val
+	}
+}
+
 // GetDurationProperty gets property and asserts that it's a duration
 func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) DurationPropertyFn {
 	return func(opts ...FilterOption) time.Duration {
@@ -100,6 +100,17 @@ func (s *configSuite) TestGetFloat64Property() {
 	s.Equal(0.01, value())
 }
 
+func (s *configSuite) TestGetFloat64PropertyFilteredByTaskListInfo() {
+	key := testGetFloat64PropertyFilteredByTaskListInfoKey
+	domain := "testDomain"
+	taskList := "testTaskList"
+	taskType := 0
+	value := s.cln.GetFloat64PropertyFilteredByTaskListInfo(key, 0.1)
+	s.Equal(0.1, value(domain, taskList, taskType))
+	s.client.SetValue(key, 0.01)
+	s.Equal(0.01, value(domain, taskList, taskType))
+}
+
 func (s *configSuite) TestGetBoolProperty() {
 	key := testGetBoolPropertyKey
 	value := s.cln.GetBoolProperty(key, true)
@@ -0,0 +1,275 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	minPollInterval = time.Second
+	pollTimeout     = 10 * time.Second
+
+	// clientAuthor is the author of changes made through the dynamic config client interface
+	clientAuthor = "dynamic config client"
+)
+
+var _ dynamicconfig.Client = (*client)(nil)
+
+type client struct {
+	sync.RWMutex
+
+	values       Values
+	lastChangeID int64
+
+	store         Store
+	fallback      dynamicconfig.Client
+	metricsClient metrics.Client
+	logger        log.Logger
+	timeSource    clock.TimeSource
+	doneCh        chan struct{}
+}
+
+// NewClient creates a dynamic config client which serves the values of the config store,
+// the fallback client is used for the keys and filters the config store has no value for.
+// The changes of the store are polled at the given interval until doneCh is closed.
+func NewClient(
+	config *dynamicconfig.ConfigStoreClientConfig,
+	store Store,
+	fallback dynamicconfig.Client,
+	metricsClient metrics.Client,
+	logger log.Logger,
+	doneCh chan struct{},
+) (dynamicconfig.Client, error) {
+	if config.PollInterval < minPollInterval {
+		return nil, fmt.Errorf("poll interval should be at least %v", minPollInterval)
+	}
+
+	c := &client{
+		values:        make(Values),
+		lastChangeID:  EmptyChangeID,
+		store:         store,
+		fallback:      fallback,
+		metricsClient: metricsClient,
+		logger:        logger,
+		timeSource:    clock.NewRealTimeSource(),
+		doneCh:        doneCh,
+	}
+	if err := c.update(); err != nil {
+		return nil, err
+	}
+	c.emitMetrics()
+	go func() {
+		ticker := time.NewTicker(config.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := c.update(); err != nil {
+					c.logger.Error("Failed to update dynamic config from config store", tag.Error(err))
+				}
+				c.emitMetrics()
+			case <-c.doneCh:
+				return
+			}
+		}
+	}()
+	return c, nil
+}
+
+func (c *client) GetValue(name dynamicconfig.Key, defaultValue interface{}) (interface{}, error) {
+	if val, ok := c.match(name, nil); ok {
+		return val, nil
+	}
+	return c.fallback.GetValue(name, defaultValue)
+}
+
+func (c *client) GetValueWithFilters(
+	name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, defaultValue interface{},
+) (interface{}, error) {
+	if val, ok := c.match(name, filters); ok {
+		return val, nil
+	}
+	return c.fallback.GetValueWithFilters(name, filters, defaultValue)
+}
+
+func (c *client) GetIntValue(name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, defaultValue int) (int, error) {
+	if val, ok := c.match(name, filters); ok {
+		if intVal, ok := val.(int); ok {
+			return intVal, nil
+		}
+		return defaultValue, errors.New("value type is not int")
+	}
+	return c.fallback.GetIntValue(name, filters, defaultValue)
+}
+
+func (c *client) GetFloatValue(name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, defaultValue float64) (float64, error) {
+	if val, ok := c.match(name, filters); ok {
+		if floatVal, ok := val.(float64); ok {
+			return floatVal, nil
+		} else if intVal, ok := val.(int); ok {
+			return float64(intVal), nil
+		}
+		return defaultValue, errors.New("value type is not float64")
+	}
+	return c.fallback.GetFloatValue(name, filters, defaultValue)
+}
+
+func (c *client) GetBoolValue(name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, defaultValue bool) (bool, error) {
+	if val, ok := c.match(name, filters); ok {
+		if boolVal, ok := val.(bool); ok {
+			return boolVal, nil
+		}
+		return defaultValue, errors.New("value type is not bool")
+	}
+	return c.fallback.GetBoolValue(name, filters, defaultValue)
+}
+
+func (c *client) GetStringValue(name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, defaultValue string) (string, error) {
+	if val, ok := c.match(name, filters); ok {
+		if stringVal, ok := val.(string); ok {
+			return stringVal, nil
+		}
+		return defaultValue, errors.New("value type is not string")
+	}
+	return c.fallback.GetStringValue(name, filters, defaultValue)
+}
+
+func (c *client) GetMapValue(
+	name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	if val, ok := c.match(name, filters); ok {
+		if mapVal, ok := val.(map[string]interface{}); ok {
+			return mapVal, nil
+		}
+		return defaultValue, errors.New("value type is not map")
+	}
+	return c.fallback.GetMapValue(name, filters, defaultValue)
+}
+
+func (c *client) GetDurationValue(
+	name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, defaultValue time.Duration,
+) (time.Duration, error) {
+	if val, ok := c.match(name, filters); ok {
+		durationString, ok := val.(string)
+		if !ok {
+			return defaultValue, errors.New("value type is not string")
+		}
+		durationVal, err := time.ParseDuration(durationString)
+		if err != nil {
+			return defaultValue, fmt.Errorf("failed to parse duration: %v", err)
+		}
+		return durationVal, nil
+	}
+	return c.fallback.GetDurationValue(name, filters, defaultValue)
+}
+
+func (c *client) UpdateValue(name dynamicconfig.Key, value interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pollTimeout)
+	defer cancel()
+
+	if err := c.store.Update(ctx, &Change{
+		Key:    name.String(),
+		Value:  value,
+		Author: clientAuthor,
+	}); err != nil {
+		return err
+	}
+	return c.update()
+}
+
+func (c *client) match(name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}) (interface{}, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.values.Match(name.String(), filters, c.timeSource.Now())
+}
+
+// emitMetrics reports the number of temporary overrides currently in effect
+func (c *client) emitMetrics() {
+	c.RLock()
+	defer c.RUnlock()
+
+	c.metricsClient.UpdateGauge(
+		metrics.DynamicConfigStoreClientScope,
+		metrics.DynamicConfigActiveOverridesGauge,
+		float64(c.values.ActiveOverrides(c.timeSource.Now())),
+	)
+}
+
+func (c *client) update() error {
+	ctx, cancel := context.WithTimeout(context.Background(), pollTimeout)
+	defer cancel()
+
+	c.RLock()
+	lastChangeID := c.lastChangeID
+	c.RUnlock()
+
+	var changes []*Change
+	for {
+		page, err := c.store.GetChanges(ctx, lastChangeID, readPageSize)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, page...)
+		if len(page) > 0 {
+			lastChangeID = page[len(page)-1].ID
+		}
+		if len(page) < readPageSize {
+			break
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	values := c.values.clone()
+	for _, change := range changes {
+		if change.ID <= c.lastChangeID {
+			// applied by a concurrent update
+			continue
+		}
+		values.Apply(change)
+		c.logger.Info("Updated dynamic config from config store",
+			tag.Key(change.Key),
+			tag.DynamicConfigFilters(change.Constraints),
+			tag.Value(change.Value),
+			tag.DynamicConfigAuthor(change.Author),
+		)
+	}
+	c.values = values
+	if lastChangeID > c.lastChangeID {
+		c.lastChangeID = lastChangeID
+	}
+	return nil
+}
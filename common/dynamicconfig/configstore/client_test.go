@@ -0,0 +1,160 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configstore
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	clientSuite struct {
+		suite.Suite
+
+		queue    *testQueue
+		store    Store
+		fallback dynamicconfig.Client
+		client   *client
+		doneCh   chan struct{}
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	s := new(clientSuite)
+	suite.Run(t, s)
+}
+
+func (s *clientSuite) SetupTest() {
+	s.queue = &testQueue{}
+	s.store = NewStore(s.queue, clock.NewRealTimeSource())
+	s.fallback = dynamicconfig.NewInMemoryClient()
+	s.doneCh = make(chan struct{})
+
+	c, err := NewClient(
+		&dynamicconfig.ConfigStoreClientConfig{PollInterval: time.Hour},
+		s.store,
+		s.fallback,
+		metrics.NewNoopMetricsClient(),
+		loggerimpl.NewNopLogger(),
+		s.doneCh,
+	)
+	s.NoError(err)
+	s.client = c.(*client)
+}
+
+func (s *clientSuite) TearDownTest() {
+	close(s.doneCh)
+}
+
+func (s *clientSuite) TestFilteredValues() {
+	s.NoError(s.fallback.UpdateValue(dynamicconfig.FrontendMaxDomainRPSPerInstance, 1200))
+	s.update(&Change{Key: dynamicconfig.FrontendMaxDomainRPSPerInstance.String(), Constraints: map[string]interface{}{"domainName": "some-domain"}, Value: 100})
+	s.update(&Change{Key: dynamicconfig.ReplicatorTaskBatchSize.String(), Constraints: map[string]interface{}{"shardID": 2}, Value: 200})
+	s.update(&Change{Key: dynamicconfig.PersistenceErrorInjectionRate.String(), Value: 0.5})
+	s.update(&Change{Key: dynamicconfig.MatchingLongPollExpirationInterval.String(), Value: "10s"})
+	s.NoError(s.client.update())
+
+	value, err := s.client.GetIntValue(dynamicconfig.FrontendMaxDomainRPSPerInstance, map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: "some-domain"}, 0)
+	s.NoError(err)
+	s.Equal(100, value)
+	value, err = s.client.GetIntValue(dynamicconfig.FrontendMaxDomainRPSPerInstance, map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: "other-domain"}, 0)
+	s.NoError(err)
+	s.Equal(1200, value)
+	value, err = s.client.GetIntValue(dynamicconfig.ReplicatorTaskBatchSize, map[dynamicconfig.Filter]interface{}{dynamicconfig.ShardID: 2}, 0)
+	s.NoError(err)
+	s.Equal(200, value)
+
+	floatValue, err := s.client.GetFloatValue(dynamicconfig.PersistenceErrorInjectionRate, nil, 0)
+	s.NoError(err)
+	s.Equal(0.5, floatValue)
+	durationValue, err := s.client.GetDurationValue(dynamicconfig.MatchingLongPollExpirationInterval, nil, 0)
+	s.NoError(err)
+	s.Equal(10*time.Second, durationValue)
+}
+
+func (s *clientSuite) TestDeleteFallsBack() {
+	s.NoError(s.fallback.UpdateValue(dynamicconfig.EnableGlobalDomain, false))
+	s.NoError(s.client.UpdateValue(dynamicconfig.EnableGlobalDomain, true))
+	value, err := s.client.GetBoolValue(dynamicconfig.EnableGlobalDomain, nil, false)
+	s.NoError(err)
+	s.True(value)
+
+	s.update(&Change{Key: dynamicconfig.EnableGlobalDomain.String(), Deleted: true})
+	s.NoError(s.client.update())
+	value, err = s.client.GetBoolValue(dynamicconfig.EnableGlobalDomain, nil, true)
+	s.NoError(err)
+	s.False(value)
+}
+
+func (s *clientSuite) TestTemporaryOverride() {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.client.timeSource = timeSource
+	activeFrom := timeSource.Now().Add(time.Hour)
+	expiresAt := timeSource.Now().Add(2 * time.Hour)
+	s.update(&Change{Key: dynamicconfig.FrontendRPS.String(), Value: 1000})
+	s.update(&Change{
+		Key:      dynamicconfig.FrontendRPS.String(),
+		Value:    100,
+		Schedule: dynamicconfig.Schedule{ActiveFrom: &activeFrom, ExpiresAt: &expiresAt},
+	})
+	s.NoError(s.client.update())
+
+	value, err := s.client.GetIntValue(dynamicconfig.FrontendRPS, nil, 0)
+	s.NoError(err)
+	s.Equal(1000, value)
+	s.Equal(0, s.client.values.ActiveOverrides(timeSource.Now()))
+
+	timeSource.Update(activeFrom)
+	value, err = s.client.GetIntValue(dynamicconfig.FrontendRPS, nil, 0)
+	s.NoError(err)
+	s.Equal(100, value)
+	s.Equal(1, s.client.values.ActiveOverrides(timeSource.Now()))
+
+	timeSource.Update(expiresAt)
+	value, err = s.client.GetIntValue(dynamicconfig.FrontendRPS, nil, 0)
+	s.NoError(err)
+	s.Equal(1000, value)
+}
+
+func (s *clientSuite) TestWrongType() {
+	// values recorded before the schema of the key changed are not validated again
+	payload, err := json.Marshal(&Change{Key: dynamicconfig.FrontendRPS.String(), Value: "not a number", Author: "tester"})
+	s.NoError(err)
+	s.NoError(s.queue.EnqueueMessage(context.Background(), payload))
+	s.NoError(s.client.update())
+	value, err := s.client.GetIntValue(dynamicconfig.FrontendRPS, nil, 1200)
+	s.Error(err)
+	s.Equal(1200, value)
+}
+
+func (s *clientSuite) update(change *Change) {
+	change.Author = "tester"
+	s.NoError(s.store.Update(context.Background(), change))
+}
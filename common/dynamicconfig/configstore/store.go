@@ -0,0 +1,353 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// EmptyChangeID is the change ID to read a store from the beginning
+	EmptyChangeID int64 = -1
+
+	readPageSize = 1000
+)
+
+type (
+	// Change is a dynamic config update recorded in the config store,
+	// the changes of the store form the audit trail of the dynamic config
+	Change struct {
+		// ID is the position of the change in the store, it is assigned by the store
+		ID int64 `json:"-"`
+		// Key is the dynamic config key name, e.g. frontend.rps
+		Key string `json:"key"`
+		// Constraints are the filters the value applies to, e.g. domainName, taskListName or shardID
+		Constraints map[string]interface{} `json:"constraints,omitempty"`
+		// Value is the new value, it is nil if the value is deleted
+		Value interface{} `json:"value,omitempty"`
+		// Deleted is true if the value for the key and constraints is deleted,
+		// an unexpired temporary override is deleted before the permanent value
+		Deleted bool `json:"deleted,omitempty"`
+		// Schedule makes the change a temporary override if it has an expiry time
+		dynamicconfig.Schedule
+		// Author is who made the change
+		Author string `json:"author"`
+		// Reason is why the change was made
+		Reason string `json:"reason,omitempty"`
+		// Timestamp is when the change was made
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	// Value is a dynamic config value currently set in the config store
+	Value struct {
+		Key         string
+		Constraints map[string]interface{}
+		Value       interface{}
+		dynamicconfig.Schedule
+		// LastChange is the change which set the value
+		LastChange *Change
+	}
+
+	// Values are the values currently set in the config store by key name,
+	// values with more constraints come first and temporary overrides come before
+	// the permanent value with the same constraints
+	Values map[string][]*Value
+
+	// Store persists dynamic config changes
+	Store interface {
+		// Update validates and records a change
+		Update(ctx context.Context, change *Change) error
+		// GetChanges returns the changes recorded after the given change ID in order
+		GetChanges(ctx context.Context, lastChangeID int64, maxCount int) ([]*Change, error)
+		// GetValues returns the values currently set and the ID of the last change applied
+		GetValues(ctx context.Context) (Values, int64, error)
+	}
+
+	storeImpl struct {
+		queue      persistence.QueueManager
+		timeSource clock.TimeSource
+	}
+)
+
+var _ Store = (*storeImpl)(nil)
+
+// NewStore creates a dynamic config store on top of the dynamic config queue
+func NewStore(
+	queue persistence.QueueManager,
+	timeSource clock.TimeSource,
+) Store {
+	return &storeImpl{
+		queue:      queue,
+		timeSource: timeSource,
+	}
+}
+
+func (s *storeImpl) Update(
+	ctx context.Context,
+	change *Change,
+) error {
+	if err := Validate(change); err != nil {
+		return err
+	}
+	if change.Timestamp.IsZero() {
+		change.Timestamp = s.timeSource.Now()
+	}
+	if change.IsExpired(change.Timestamp) {
+		return &types.BadRequestError{Message: "Dynamic config override is already expired."}
+	}
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	return s.queue.EnqueueMessage(ctx, payload)
+}
+
+func (s *storeImpl) GetChanges(
+	ctx context.Context,
+	lastChangeID int64,
+	maxCount int,
+) ([]*Change, error) {
+	messages, err := s.queue.ReadMessages(ctx, lastChangeID, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*Change, 0, len(messages))
+	for _, message := range messages {
+		change, err := decodeChange(message.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode dynamic config change %v: %v", message.ID, err)
+		}
+		change.ID = message.ID
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (s *storeImpl) GetValues(
+	ctx context.Context,
+) (Values, int64, error) {
+	values := make(Values)
+	lastChangeID := EmptyChangeID
+	for {
+		changes, err := s.GetChanges(ctx, lastChangeID, readPageSize)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, change := range changes {
+			values.Apply(change)
+			lastChangeID = change.ID
+		}
+		if len(changes) < readPageSize {
+			return values, lastChangeID, nil
+		}
+	}
+}
+
+// Validate checks the change refers to a known key and its value and filters match the schema of the key
+func Validate(change *Change) error {
+	key, ok := dynamicconfig.ParseKeyName(change.Key)
+	if !ok {
+		return &types.BadRequestError{Message: fmt.Sprintf("Unknown dynamic config key: %v.", change.Key)}
+	}
+	if err := dynamicconfig.ValidateConstraints(key, change.Constraints); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid dynamic config filters of %v: %v.", change.Key, err)}
+	}
+	if change.Deleted && change.Value != nil {
+		return &types.BadRequestError{Message: "Deleted dynamic config change must not have a value."}
+	}
+	if !change.Deleted && change.Value == nil {
+		return &types.BadRequestError{Message: "Dynamic config change must have a value."}
+	}
+	if !change.Deleted {
+		if err := dynamicconfig.ValidateValue(key, change.Value); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid dynamic config value of %v: %v.", change.Key, err)}
+		}
+	}
+	if change.Deleted && (change.ActiveFrom != nil || change.ExpiresAt != nil) {
+		return &types.BadRequestError{Message: "Deleted dynamic config change must not have a schedule."}
+	}
+	if err := change.Schedule.Validate(); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid dynamic config schedule: %v.", err)}
+	}
+	if change.Author == "" {
+		return &types.BadRequestError{Message: "Dynamic config change must have an author."}
+	}
+	return nil
+}
+
+// Apply updates the values with the change, overrides which expired before the change are dropped
+func (v Values) Apply(change *Change) {
+	temporary := change.IsTemporary()
+	if change.Deleted {
+		temporary = v.hasOverride(change.Key, change.Constraints, change.Timestamp)
+	}
+	values := make([]*Value, 0, len(v[change.Key])+1)
+	for _, value := range v[change.Key] {
+		if value.IsExpired(change.Timestamp) {
+			continue
+		}
+		if sameConstraints(value.Constraints, change.Constraints) && value.IsTemporary() == temporary {
+			continue
+		}
+		values = append(values, value)
+	}
+	if !change.Deleted {
+		values = append(values, &Value{
+			Key:         change.Key,
+			Constraints: change.Constraints,
+			Value:       change.Value,
+			Schedule:    change.Schedule,
+			LastChange:  change,
+		})
+		sort.SliceStable(values, func(i, j int) bool {
+			if len(values[i].Constraints) != len(values[j].Constraints) {
+				return len(values[i].Constraints) > len(values[j].Constraints)
+			}
+			return values[i].IsTemporary() && !values[j].IsTemporary()
+		})
+	}
+	if len(values) == 0 {
+		delete(v, change.Key)
+		return
+	}
+	v[change.Key] = values
+}
+
+// Match returns the value of the key which applies to the filters at the given time,
+// the most constrained value matching the filters is returned
+func (v Values) Match(
+	key string,
+	filters map[dynamicconfig.Filter]interface{},
+	now time.Time,
+) (interface{}, bool) {
+	for _, value := range v[key] {
+		if value.IsActive(now) && matchConstraints(value.Constraints, filters) {
+			return value.Value, true
+		}
+	}
+	return nil, false
+}
+
+// ActiveOverrides returns the number of temporary overrides in effect at the given time
+func (v Values) ActiveOverrides(now time.Time) int {
+	count := 0
+	for _, values := range v {
+		for _, value := range values {
+			if value.IsTemporary() && value.IsActive(now) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (v Values) hasOverride(
+	key string,
+	constraints map[string]interface{},
+	now time.Time,
+) bool {
+	for _, value := range v[key] {
+		if value.IsTemporary() && !value.IsExpired(now) && sameConstraints(value.Constraints, constraints) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchConstraints(
+	constraints map[string]interface{},
+	filters map[dynamicconfig.Filter]interface{},
+) bool {
+	if len(constraints) > len(filters) {
+		return false
+	}
+	for name, constraint := range constraints {
+		filter, _ := dynamicconfig.ParseFilterName(name)
+		if filters[filter] == nil || filters[filter] != constraint {
+			return false
+		}
+	}
+	return true
+}
+
+func (v Values) clone() Values {
+	result := make(Values, len(v))
+	for key, values := range v {
+		result[key] = values
+	}
+	return result
+}
+
+func sameConstraints(a, b map[string]interface{}) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func decodeChange(payload []byte) (*Change, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var change Change
+	if err := decoder.Decode(&change); err != nil {
+		return nil, err
+	}
+	change.Value = normalize(change.Value)
+	for name, constraint := range change.Constraints {
+		change.Constraints[name] = normalize(constraint)
+	}
+	return &change, nil
+}
+
+// normalize converts json numbers to the int and float64 values dynamic config expects
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if intValue, err := v.Int64(); err == nil {
+			return int(intValue)
+		}
+		floatValue, _ := v.Float64()
+		return floatValue
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalize(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalize(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configstore
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	storeSuite struct {
+		suite.Suite
+
+		timeSource clock.TimeSource
+		queue      *testQueue
+		store      Store
+	}
+
+	// testQueue keeps messages in memory, only the methods used by the store are implemented
+	testQueue struct {
+		persistence.QueueManager
+
+		sync.Mutex
+		messages []*persistence.QueueMessage
+	}
+)
+
+func TestStoreSuite(t *testing.T) {
+	s := new(storeSuite)
+	suite.Run(t, s)
+}
+
+func (s *storeSuite) SetupTest() {
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(1600000000, 0))
+	s.queue = &testQueue{}
+	s.store = NewStore(s.queue, s.timeSource)
+}
+
+func (s *storeSuite) TestUpdate_Validation() {
+	ctx := context.Background()
+	s.IsType(&types.BadRequestError{}, s.store.Update(ctx, &Change{Key: "unknown.key", Value: 1, Author: "tester"}))
+	s.IsType(&types.BadRequestError{}, s.store.Update(ctx, &Change{
+		Key:         dynamicconfig.FrontendRPS.String(),
+		Constraints: map[string]interface{}{"unknownFilter": "x"},
+		Value:       1,
+		Author:      "tester",
+	}))
+	s.IsType(&types.BadRequestError{}, s.store.Update(ctx, &Change{
+		Key:         dynamicconfig.FrontendRPS.String(),
+		Constraints: map[string]interface{}{"domainName": "some-domain"},
+		Value:       1,
+		Author:      "tester",
+	}))
+	s.IsType(&types.BadRequestError{}, s.store.Update(ctx, &Change{Key: dynamicconfig.FrontendRPS.String(), Value: "1", Author: "tester"}))
+	s.IsType(&types.BadRequestError{}, s.store.Update(ctx, &Change{Key: dynamicconfig.FrontendRPS.String(), Author: "tester"}))
+	s.IsType(&types.BadRequestError{}, s.store.Update(ctx, &Change{Key: dynamicconfig.FrontendRPS.String(), Value: 1}))
+	expired := s.timeSource.Now().Add(-time.Minute)
+	s.IsType(&types.BadRequestError{}, s.store.Update(ctx, &Change{
+		Key:      dynamicconfig.FrontendRPS.String(),
+		Value:    1,
+		Author:   "tester",
+		Schedule: dynamicconfig.Schedule{ExpiresAt: &expired},
+	}))
+	activeFrom := s.timeSource.Now().Add(time.Minute)
+	s.IsType(&types.BadRequestError{}, s.store.Update(ctx, &Change{
+		Key:      dynamicconfig.FrontendRPS.String(),
+		Value:    1,
+		Author:   "tester",
+		Schedule: dynamicconfig.Schedule{ActiveFrom: &activeFrom},
+	}))
+	s.Empty(s.queue.messages)
+}
+
+func (s *storeSuite) TestGetValues() {
+	ctx := context.Background()
+	s.NoError(s.store.Update(ctx, &Change{Key: dynamicconfig.FrontendMaxDomainRPSPerInstance.String(), Value: 100, Author: "tester"}))
+	s.NoError(s.store.Update(ctx, &Change{
+		Key:         dynamicconfig.FrontendMaxDomainRPSPerInstance.String(),
+		Constraints: map[string]interface{}{"domainName": "some-domain"},
+		Value:       10,
+		Author:      "tester",
+		Reason:      "incident",
+	}))
+	s.NoError(s.store.Update(ctx, &Change{
+		Key:         dynamicconfig.MatchingNumTasklistReadPartitions.String(),
+		Constraints: map[string]interface{}{"domainName": "some-domain", "taskListName": "some-task-list"},
+		Value:       4,
+		Author:      "tester",
+	}))
+	s.NoError(s.store.Update(ctx, &Change{Key: dynamicconfig.FrontendMaxDomainRPSPerInstance.String(), Deleted: true, Author: "tester"}))
+
+	values, lastChangeID, err := s.store.GetValues(ctx)
+	s.NoError(err)
+	s.Equal(int64(3), lastChangeID)
+	s.Len(values, 2)
+	s.Len(values[dynamicconfig.FrontendMaxDomainRPSPerInstance.String()], 1)
+
+	value := values[dynamicconfig.FrontendMaxDomainRPSPerInstance.String()][0]
+	s.Equal(10, value.Value)
+	s.Equal("tester", value.LastChange.Author)
+	s.Equal("incident", value.LastChange.Reason)
+	s.Equal(s.timeSource.Now().Unix(), value.LastChange.Timestamp.Unix())
+
+	changes, err := s.store.GetChanges(ctx, EmptyChangeID, 10)
+	s.NoError(err)
+	s.Len(changes, 4)
+	s.True(changes[3].Deleted)
+}
+
+func (s *storeSuite) TestValues_Match() {
+	values := make(Values)
+	values.Apply(&Change{Key: "key", Value: 1})
+	values.Apply(&Change{Key: "key", Constraints: map[string]interface{}{"domainName": "domain"}, Value: 2})
+	values.Apply(&Change{Key: "key", Constraints: map[string]interface{}{"domainName": "domain", "shardID": 3}, Value: 3})
+
+	value, ok := values.Match("key", nil, time.Time{})
+	s.True(ok)
+	s.Equal(1, value)
+	value, ok = values.Match("key", map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: "domain"}, time.Time{})
+	s.True(ok)
+	s.Equal(2, value)
+	value, ok = values.Match("key", map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: "domain", dynamicconfig.ShardID: 3}, time.Time{})
+	s.True(ok)
+	s.Equal(3, value)
+	value, ok = values.Match("key", map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: "other", dynamicconfig.ShardID: 3}, time.Time{})
+	s.True(ok)
+	s.Equal(1, value)
+
+	values.Apply(&Change{Key: "key", Deleted: true})
+	_, ok = values.Match("key", map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: "other"}, time.Time{})
+	s.False(ok)
+	_, ok = values.Match("other", nil, time.Time{})
+	s.False(ok)
+}
+
+func (s *storeSuite) TestValues_TemporaryOverride() {
+	now := time.Unix(1600000000, 0)
+	expiresAt := now.Add(time.Hour)
+	values := make(Values)
+	values.Apply(&Change{Key: "key", Value: 1, Timestamp: now})
+	values.Apply(&Change{Key: "key", Value: 2, Timestamp: now, Schedule: dynamicconfig.Schedule{ExpiresAt: &expiresAt}})
+
+	value, ok := values.Match("key", nil, now)
+	s.True(ok)
+	s.Equal(2, value)
+	s.Equal(1, values.ActiveOverrides(now))
+	value, ok = values.Match("key", nil, expiresAt)
+	s.True(ok)
+	s.Equal(1, value)
+	s.Equal(0, values.ActiveOverrides(expiresAt))
+
+	// deleting removes the override before the permanent value
+	values.Apply(&Change{Key: "key", Deleted: true, Timestamp: now})
+	value, ok = values.Match("key", nil, now)
+	s.True(ok)
+	s.Equal(1, value)
+	values.Apply(&Change{Key: "key", Deleted: true, Timestamp: now})
+	_, ok = values.Match("key", nil, now)
+	s.False(ok)
+
+	// expired overrides are dropped by later changes
+	values.Apply(&Change{Key: "key", Value: 2, Timestamp: now, Schedule: dynamicconfig.Schedule{ExpiresAt: &expiresAt}})
+	values.Apply(&Change{Key: "key", Value: 1, Timestamp: expiresAt})
+	s.Len(values["key"], 1)
+}
+
+func (q *testQueue) EnqueueMessage(
+	_ context.Context,
+	messagePayload []byte,
+) error {
+	q.Lock()
+	defer q.Unlock()
+
+	q.messages = append(q.messages, &persistence.QueueMessage{
+		ID:        int64(len(q.messages)),
+		QueueType: persistence.DynamicConfigQueueType,
+		Payload:   messagePayload,
+	})
+	return nil
+}
+
+func (q *testQueue) ReadMessages(
+	_ context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var result []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+// DecodeValue decodes a JSON encoded dynamic config value
+func DecodeValue(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return normalize(value), nil
+}
+
+// FromInternalValue converts a JSON encoded blob to a dynamic config value
+func FromInternalValue(blob *types.DataBlob) (interface{}, error) {
+	if blob == nil {
+		return nil, nil
+	}
+	if blob.GetEncodingType() != types.EncodingTypeJSON {
+		return nil, &types.BadRequestError{Message: "Dynamic config value must be JSON encoded."}
+	}
+	value, err := DecodeValue(blob.Data)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid dynamic config value: %v.", err)}
+	}
+	return value, nil
+}
+
+// FromInternalFilters converts filters to the constraints of a change
+func FromInternalFilters(filters []*types.DynamicConfigFilter) (map[string]interface{}, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	constraints := make(map[string]interface{}, len(filters))
+	for _, filter := range filters {
+		value, err := FromInternalValue(filter.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		constraints[filter.GetName()] = value
+	}
+	return constraints, nil
+}
+
+// ToInternalValue converts a dynamic config value to a JSON encoded blob
+func ToInternalValue(value interface{}) (*types.DataBlob, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         data,
+	}, nil
+}
+
+// ToInternalFilters converts the constraints of a change to filters
+func ToInternalFilters(constraints map[string]interface{}) ([]*types.DynamicConfigFilter, error) {
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+	filters := make([]*types.DynamicConfigFilter, 0, len(constraints))
+	for name, constraint := range constraints {
+		value, err := ToInternalValue(constraint)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, &types.DynamicConfigFilter{Name: name, Value: value})
+	}
+	sort.Slice(filters, func(i, j int) bool {
+		return filters[i].Name < filters[j].Name
+	})
+	return filters, nil
+}
+
+// ToInternalChange converts a change to the internal type
+func ToInternalChange(change *Change) (*types.DynamicConfigChange, error) {
+	filters, err := ToInternalFilters(change.Constraints)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ToInternalValue(change.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DynamicConfigChange{
+		ID:             change.ID,
+		ConfigName:     change.Key,
+		Filters:        filters,
+		Value:          value,
+		Deleted:        change.Deleted,
+		Author:         change.Author,
+		Reason:         change.Reason,
+		TimestampNano:  change.Timestamp.UnixNano(),
+		ActiveFromNano: toUnixNano(change.ActiveFrom),
+		ExpiresAtNano:  toUnixNano(change.ExpiresAt),
+	}, nil
+}
+
+// FromInternalSchedule converts the activation and expiry time in unix nanos to a schedule,
+// zero means the time is not set
+func FromInternalSchedule(activeFromNano, expiresAtNano int64) dynamicconfig.Schedule {
+	return dynamicconfig.Schedule{
+		ActiveFrom: fromUnixNano(activeFromNano),
+		ExpiresAt:  fromUnixNano(expiresAtNano),
+	}
+}
+
+func toUnixNano(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func fromUnixNano(nanos int64) *time.Time {
+	if nanos == 0 {
+		return nil
+	}
+	t := time.Unix(0, nanos)
+	return &t
+}
+
+// ToInternalEntries converts the values of the given key to the internal type,
+// the values of all keys are converted if the key is empty
+func ToInternalEntries(values Values, key string) ([]*types.DynamicConfigEntry, error) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if key == "" || k == key {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	entries := make([]*types.DynamicConfigEntry, 0, len(keys))
+	for _, k := range keys {
+		entry := &types.DynamicConfigEntry{ConfigName: k}
+		for _, value := range values[k] {
+			lastChange, err := ToInternalChange(value.LastChange)
+			if err != nil {
+				return nil, err
+			}
+			entry.Values = append(entry.Values, &types.DynamicConfigValue{
+				Filters:    lastChange.Filters,
+				Value:      lastChange.Value,
+				LastChange: lastChange,
+			})
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
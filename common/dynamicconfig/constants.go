@@ -31,10 +31,17 @@ func (k Key) String() string {
 	return keyName
 }
 
+// ParseKeyName returns the key with the given name, the second return value is false if the name is unknown
+func ParseKeyName(keyName string) (Key, bool) {
+	key, ok := keyNames[keyName]
+	return key, ok
+}
+
 /***
 * !!!Important!!!
 * For developer: Make sure to add/maintain the comment in the right format: usage, keyName, and default value
 * So that our go-docs can have the full [documentation](https://pkg.go.dev/github.com/uber/cadence@v0.19.1/common/service/dynamicconfig#Key).
+* Also declare the value type, allowed filters and bounds of the key in keySchemas, dynamic config values are validated against it.
 ***/
 const (
 	unknownKey Key = iota
@@ -51,6 +58,7 @@ const (
 	testGetDurationPropertyFilteredByDomainKey
 	testGetIntPropertyFilteredByTaskListInfoKey
 	testGetDurationPropertyFilteredByTaskListInfoKey
+	testGetFloat64PropertyFilteredByTaskListInfoKey
 	testGetBoolPropertyFilteredByDomainIDKey
 	testGetBoolPropertyFilteredByTaskListInfoKey
 
@@ -100,25 +108,25 @@ const (
 	DisableListVisibilityByFilter
 	// HistoryArchivalStatus is key for the status of history archival
 	// KeyName: system.historyArchivalStatus
-	// Value type: enabled or disabled
+	// Value type: String, one of enabled, paused or disabled
 	// Default value: the value in static config: common.Config.Archival.History.Status
 	// Allowed filters: N/A
 	HistoryArchivalStatus
 	// EnableReadFromHistoryArchival is key for enabling reading history from archival store
 	// KeyName: system.enableReadFromHistoryArchival
-	// Value type: enabled or disabled
+	// Value type: Bool
 	// Default value: the value in static config: common.Config.Archival.History.EnableRead
 	// Allowed filters: N/A
 	EnableReadFromHistoryArchival
 	// VisibilityArchivalStatus is key for the status of visibility archival
 	// KeyName: system.visibilityArchivalStatus
-	// Value type: enabled or disabled
+	// Value type: String, one of enabled, paused or disabled
 	// Default value: the value in static config: common.Config.Archival.Visibility.Status
 	// Allowed filters: N/A
 	VisibilityArchivalStatus
 	// EnableReadFromVisibilityArchival is key for enabling reading visibility from archival store
 	// KeyName: system.enableReadFromVisibilityArchival
-	// Value type: enabled or disabled
+	// Value type: Bool
 	// Default value: the value in static config: common.Config.Archival.Visibility.EnableRead
 	// Allowed filters: N/A
 	EnableReadFromVisibilityArchival
@@ -146,6 +154,74 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	PersistenceErrorInjectionRate
+	// PersistenceCircuitBreakerEnabled is whether to trip persistence calls when a datastore is unhealthy
+	// KeyName: system.persistenceCircuitBreakerEnabled
+	// Value type: Bool
+	// Default value: FALSE
+	// Allowed filters: N/A
+	PersistenceCircuitBreakerEnabled
+	// PersistenceCircuitBreakerErrorRateThreshold is the ratio of failed persistence calls in a window above which the circuit breaker trips
+	// KeyName: system.persistenceCircuitBreakerErrorRateThreshold
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: N/A
+	PersistenceCircuitBreakerErrorRateThreshold
+	// PersistenceCircuitBreakerSlowCallRateThreshold is the ratio of slow persistence calls in a window above which the circuit breaker trips
+	// KeyName: system.persistenceCircuitBreakerSlowCallRateThreshold
+	// Value type: Float64
+	// Default value: 0.8
+	// Allowed filters: N/A
+	PersistenceCircuitBreakerSlowCallRateThreshold
+	// PersistenceCircuitBreakerSlowCallLatency is the latency above which a persistence call is considered slow by the circuit breaker
+	// KeyName: system.persistenceCircuitBreakerSlowCallLatency
+	// Value type: Duration
+	// Default value: 5s
+	// Allowed filters: N/A
+	PersistenceCircuitBreakerSlowCallLatency
+	// PersistenceCircuitBreakerMinRequests is the minimal number of persistence calls in a window before the circuit breaker can trip
+	// KeyName: system.persistenceCircuitBreakerMinRequests
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	PersistenceCircuitBreakerMinRequests
+	// PersistenceCircuitBreakerWindow is the length of the window the circuit breaker computes error and slow call rates over
+	// KeyName: system.persistenceCircuitBreakerWindow
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: N/A
+	PersistenceCircuitBreakerWindow
+	// PersistenceCircuitBreakerOpenDuration is how long the circuit breaker fails fast before letting a probe call through
+	// KeyName: system.persistenceCircuitBreakerOpenDuration
+	// Value type: Duration
+	// Default value: 5s
+	// Allowed filters: N/A
+	PersistenceCircuitBreakerOpenDuration
+	// EnableFaultInjection is whether to inject faults into persistence and RPC calls according to FaultInjectionRules
+	// KeyName: system.enableFaultInjection
+	// Value type: Bool
+	// Default value: FALSE
+	// Allowed filters: N/A
+	EnableFaultInjection
+	// FaultInjectionRules is the fault injection scenario, see common/faultinjection for the format of the rules
+	// KeyName: system.faultInjectionRules
+	// Value type: Map
+	// Default value: nil
+	// Allowed filters: N/A
+	FaultInjectionRules
+	// FaultInjectionRulesFile is the path of a YAML file holding the fault injection scenario, it is used
+	// instead of FaultInjectionRules if set and read again when the file changes
+	// KeyName: system.faultInjectionRulesFile
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: N/A
+	FaultInjectionRulesFile
+	// HistoryShardPlacementMode is how history shards are placed on history hosts, hash places them by the
+	// consistent hash ring, load places them by the assignment the history shard balancer keeps according to the shard load
+	// KeyName: system.historyShardPlacementMode
+	// Value type: String
+	// Default value: hash
+	// Allowed filters: N/A
+	HistoryShardPlacementMode
 	// MaxRetentionDays is the maximum allowed retention days for domain
 	// KeyName: system.maxRetentionDays
 	// Value type: Int
@@ -224,6 +300,18 @@ const (
 	// Default value: 50*1024
 	// Allowed filters: DomainName
 	HistoryCountLimitWarn
+	// MemoNumberOfKeysLimit is the limit of number of keys in a workflow memo after an upsert
+	// KeyName: limit.memoNumberOfKeys
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName
+	MemoNumberOfKeysLimit
+	// MemoTotalSizeLimit is the size limit of a workflow memo after an upsert
+	// KeyName: limit.memoTotalSize
+	// Value type: Int
+	// Default value: 40*1024
+	// Allowed filters: DomainName
+	MemoTotalSizeLimit
 	// DomainNameMaxLength is the length limit for domain name
 	// KeyName: limit.domainNameLength
 	// Value type: Int
@@ -543,13 +631,15 @@ const (
 	// Default value: 20
 	// Allowed filters: N/A
 	MatchingThrottledLogRPS
-	// MatchingNumTasklistWritePartitions is the number of write partitions for a task list
+	// MatchingNumTasklistWritePartitions is the number of write partitions for a task list,
+	// it is set by the partition scaler when MatchingEnablePartitionScaling is enabled for the task list
 	// KeyName: matching.numTasklistWritePartitions
 	// Value type: Int
 	// Default value: 1
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingNumTasklistWritePartitions
-	// MatchingNumTasklistReadPartitions is the number of read partitions for a task list
+	// MatchingNumTasklistReadPartitions is the number of read partitions for a task list,
+	// it is set by the partition scaler when MatchingEnablePartitionScaling is enabled for the task list
 	// KeyName: matching.numTasklistReadPartitions
 	// Value type: Int
 	// Default value: 1
@@ -579,6 +669,14 @@ const (
 	// Default value: 20
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingForwarderTopology is the topology non-root task list partitions forward tasks and polls along.
+	// tree forwards to the parent in the partition tree, flat forwards to the root partition and random forwards
+	// tasks to the root partition and polls to a random sibling partition so idle partitions steal backlogged tasks
+	// KeyName: matching.forwarderTopology
+	// Value type: String, one of tree, flat or random
+	// Default value: tree
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingForwarderTopology
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	// KeyName: matching.shutdownDrainDuration
 	// Value type: Duration
@@ -597,6 +695,157 @@ const (
 	// Default value: FALSE
 	// Allowed filters: DomainID
 	MatchingEnableTaskInfoLogByDomainID
+	// MatchingEnablePartitionScaling is enables scaling the partitions of a task list from its load,
+	// the decisions are written to the dynamic config store which must be enabled
+	// KeyName: matching.enablePartitionScaling
+	// Value type: Bool
+	// Default value: FALSE
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnablePartitionScaling
+	// MatchingPartitionScalingInterval is the interval at which the partition scaler checks the load of task lists
+	// KeyName: matching.partitionScalingInterval
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: N/A
+	MatchingPartitionScalingInterval
+	// MatchingPartitionUpscaleRPS is the add or dispatch rate per partition above which a partition is added
+	// KeyName: matching.partitionUpscaleRPS
+	// Value type: Float64
+	// Default value: 200
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionUpscaleRPS
+	// MatchingPartitionUpscaleBacklog is the backlog per partition above which a partition is added
+	// KeyName: matching.partitionUpscaleBacklog
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionUpscaleBacklog
+	// MatchingPartitionDownscaleFactor is the fraction of the upscale rate the remaining partitions must be under
+	// for a partition to be removed
+	// KeyName: matching.partitionDownscaleFactor
+	// Value type: Float64
+	// Default value: 0.75
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionDownscaleFactor
+	// MatchingPartitionDownscaleSustainedDuration is how long the load must stay low before a partition is removed
+	// KeyName: matching.partitionDownscaleSustainedDuration
+	// Value type: Duration
+	// Default value: 5m
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionDownscaleSustainedDuration
+	// MatchingMaxPartitions is the max number of partitions the partition scaler sets for a task list
+	// KeyName: matching.maxPartitions
+	// Value type: Int
+	// Default value: 32
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxPartitions
+	// MatchingFairnessKeyWeights is the weights of task fairness keys, tasks of the same priority are dispatched
+	// in proportion to the weights of their fairness keys. Keys which are not listed have a weight of 1.
+	// Backlog tasks are ordered by priority and fairness key within the read ahead window of matching.backlogReadAheadSize
+	// KeyName: matching.fairnessKeyWeights
+	// Value type: Map
+	// Default value: empty
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingFairnessKeyWeights
+	// MatchingBacklogReadAheadSize is the max number of backlog tasks read ahead from persistence and buffered
+	// by a task list, the buffered tasks are dispatched by priority and fairness key
+	// KeyName: matching.backlogReadAheadSize
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingBacklogReadAheadSize
+	// MatchingMaxWorkerVersionSets is the max number of worker build ID version sets of a task list
+	// KeyName: matching.maxWorkerVersionSets
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName
+	MatchingMaxWorkerVersionSets
+	// MatchingWorkerVersionSetsCacheTTL is how long a matching host caches the worker build ID version sets
+	// of a task list owned by another host before reading them again
+	// KeyName: matching.workerVersionSetsCacheTTL
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: N/A
+	MatchingWorkerVersionSetsCacheTTL
+	// MatchingTaskListStateCacheTTL is how long a matching host caches the pause and migration state of a task
+	// list whose root partition is owned by another host before reading it again
+	// KeyName: matching.taskListStateCacheTTL
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: N/A
+	MatchingTaskListStateCacheTTL
+	// MatchingMaxTaskDispatchAttempts is the number of times a task can be dispatched to pollers without making
+	// progress before it is moved to the task list DLQ when it is added again, 0 disables the DLQ
+	// KeyName: matching.maxTaskDispatchAttempts
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxTaskDispatchAttempts
+	// MatchingTaskDispatchAttemptsCacheSize is the max number of task attempts whose dispatches are tracked per host
+	// KeyName: matching.taskDispatchAttemptsCacheSize
+	// Value type: Int
+	// Default value: 100000
+	// Allowed filters: N/A
+	MatchingTaskDispatchAttemptsCacheSize
+	// MatchingMaxOutstandingPollsPerTaskList is the max number of polls waiting on a task list partition of a host,
+	// further polls are rejected with a retryable error, 0 means no limit
+	// KeyName: matching.maxOutstandingPollsPerTaskList
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxOutstandingPollsPerTaskList
+	// MatchingMaxOutstandingPollsPerHost is the max number of polls waiting on a matching host, further polls are
+	// rejected with a retryable error while AddTask requests are still served, 0 means no limit
+	// KeyName: matching.maxOutstandingPollsPerHost
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	MatchingMaxOutstandingPollsPerHost
+	// MatchingPollShedBackoff is the backoff hinted to the pollers whose poll was rejected by admission control
+	// KeyName: matching.pollShedBackoff
+	// Value type: Duration
+	// Default value: 1s (1*time.Second)
+	// Allowed filters: N/A
+	MatchingPollShedBackoff
+	// MatchingEnableIsolationGroups is whether tasks are dispatched to the pollers of the isolation group of their
+	// workflow first
+	// KeyName: matching.enableIsolationGroups
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableIsolationGroups
+	// MatchingIsolationGroupFallbackDelay is how long a backlog task waits for a poller of its isolation group
+	// before it is dispatched to the pollers of any isolation group
+	// KeyName: matching.isolationGroupFallbackDelay
+	// Value type: Duration
+	// Default value: 1s (1*time.Second)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingIsolationGroupFallbackDelay
+	// MatchingHostTaskIngestionRPS is the rate at which the tasks of all domains can be added to a matching host
+	// KeyName: matching.hostTaskIngestionRPS
+	// Value type: Float64
+	// Default value: 100000.0
+	// Allowed filters: N/A
+	MatchingHostTaskIngestionRPS
+	// MatchingDomainTaskIngestionRPS is the rate at which the tasks of a domain can be added to a matching host
+	// KeyName: matching.domainTaskIngestionRPS
+	// Value type: Float64
+	// Default value: 100000.0
+	// Allowed filters: DomainName
+	MatchingDomainTaskIngestionRPS
+	// MatchingTaskListIngestionRPS is the rate at which tasks can be added to a task list partition
+	// KeyName: matching.taskListIngestionRPS
+	// Value type: Float64
+	// Default value: 100000.0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskListIngestionRPS
+	// MatchingDomainTaskDispatchRPS is the rate at which the tasks of a domain can be dispatched to pollers by a
+	// matching host
+	// KeyName: matching.domainTaskDispatchRPS
+	// Value type: Float64
+	// Default value: 100000.0
+	// Allowed filters: DomainName
+	MatchingDomainTaskDispatchRPS
 
 	// key for history
 
@@ -648,6 +897,15 @@ const (
 	// Default value: 512
 	// Allowed filters: N/A
 	HistoryCacheMaxSize
+	// HistoryCacheMaxSizeInBytesPerShard is max size in bytes of the history cache of each shard, estimated from the sizes
+	// of the mutable states. The limit applies to each shard owned by the host like HistoryCacheMaxSize, so the memory
+	// used by the host is bounded by the limit times the number of owned shards. History cache is bounded by
+	// HistoryCacheMaxSize instead when it is 0
+	// KeyName: history.cacheMaxSizeInBytesPerShard
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	HistoryCacheMaxSizeInBytesPerShard
 	// HistoryCacheTTL is TTL of history cache
 	// KeyName: history.cacheTTL
 	// Value type: Duration
@@ -714,6 +972,38 @@ const (
 	// Default value: 1
 	// Allowed filters: N/A
 	AcquireShardConcurrency
+	// EnableGracefulShardHandoff is whether a history host hands off the shards it no longer owns according to the
+	// membership ring, instead of keeping them until they are stolen by the new owner
+	// KeyName: history.enableGracefulShardHandoff
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableGracefulShardHandoff
+	// ShardHandoffTimeout is how long a history host acquiring a shard waits for the previous owner to hand it off,
+	// before it steals the shard
+	// KeyName: history.shardHandoffTimeout
+	// Value type: Duration
+	// Default value: 5s (5*time.Second)
+	// Allowed filters: N/A
+	ShardHandoffTimeout
+	// ShardLoadReportInterval is how often a history host records the load of its shards for the shard balancer
+	// KeyName: history.shardLoadReportInterval
+	// Value type: Duration
+	// Default value: 1m (1*time.Minute)
+	// Allowed filters: N/A
+	ShardLoadReportInterval
+	// ShardRebalanceInterval is how often the shard balancer moves shards from the most to the least loaded history hosts
+	// KeyName: history.shardRebalanceInterval
+	// Value type: Duration
+	// Default value: 5m (5*time.Minute)
+	// Allowed filters: N/A
+	ShardRebalanceInterval
+	// ShardRebalanceMaxMoves is the maximum number of shards the shard balancer moves in a round
+	// KeyName: history.shardRebalanceMaxMoves
+	// Value type: Int
+	// Default value: 4
+	// Allowed filters: N/A
+	ShardRebalanceMaxMoves
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	// KeyName: history.standbyClusterDelay
 	// Value type: Duration
@@ -1196,7 +1486,7 @@ const (
 	// KeyName: history.replicatorTaskBatchSize
 	// Value type: Int
 	// Default value: 100
-	// Allowed filters: N/A
+	// Allowed filters: ShardID
 	ReplicatorTaskBatchSize
 	// ReplicatorTaskWorkerCount is number of worker for ReplicatorProcessor
 	// KeyName: history.replicatorTaskWorkerCount
@@ -1283,6 +1573,20 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	MaximumSignalsPerExecution
+	// RequestDedupWindow is how long the request IDs of signal, signalWithStart, requestCancel and terminate requests
+	// are kept by a workflow to drop duplicated requests, the request IDs are carried over continue as new and reset
+	// KeyName: history.requestDedupWindow
+	// Value type: Duration
+	// Default value: 24*time.Hour
+	// Allowed filters: DomainName
+	RequestDedupWindow
+	// RequestDedupMaxRequestIDs is max number of request IDs kept by a workflow for deduplication,
+	// the oldest request IDs are dropped first when the limit is reached
+	// KeyName: history.requestDedupMaxRequestIDs
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: DomainName
+	RequestDedupMaxRequestIDs
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	// KeyName: history.shardUpdateMinInterval
 	// Value type: Duration
@@ -1415,6 +1719,13 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	MutableStateChecksumInvalidateBefore
+	// MutableStateChecksumMismatchAction is the action on a mutable state checksum mismatch: log only, repair the
+	// mutable state by rebuilding it from history when history confirms the mismatch, or quarantine the workflow execution
+	// KeyName: history.mutableStateChecksumMismatchAction
+	// Value type: String enum: "log" or "repair" or "quarantine"
+	// Default value: log
+	// Allowed filters: DomainName
+	MutableStateChecksumMismatchAction
 	// ReplicationEventsFromCurrentCluster is a feature flag to allow cross DC replicate events that generated from the current cluster
 	// KeyName: history.ReplicationEventsFromCurrentCluster
 	// Value type: Bool
@@ -1759,19 +2070,19 @@ const (
 	CurrentExecutionsScannerActivityBatchSize
 	// CurrentExecutionsScannerPersistencePageSize is indicates the page size of execution persistence fetches in current executions scanner
 	// KeyName: worker.currentExecutionsPersistencePageSize
-	// Value type: INt
+	// Value type: Int
 	// Default value: 1000
 	// Allowed filters: N/A
 	CurrentExecutionsScannerPersistencePageSize
 	// CurrentExecutionsScannerInvariantCollectionHistory is indicates if history invariant checks should be run
 	// KeyName: worker.currentExecutionsScannerInvariantCollectionHistory
-	// Value type: Int
+	// Value type: Bool
 	// Default value: FALSE
 	// Allowed filters: N/A
 	CurrentExecutionsScannerInvariantCollectionHistory
 	// CurrentExecutionsScannerInvariantCollectionMutableState is indicates if mutable state invariant checks should be run
 	// KeyName: worker.currentExecutionsInvariantCollectionMutableState
-	// Value type: Int
+	// Value type: Bool
 	// Default value: TRUE
 	// Allowed filters: N/A
 	CurrentExecutionsScannerInvariantCollectionMutableState
@@ -1886,37 +2197,51 @@ const (
 
 	// EnableAuthorization is the key to enable authorization for a domain, only for extension binary:
 	// KeyName: N/A
+	// Value type: Bool
 	// Default value: N/A
+	// Allowed filters: DomainName
 	// TODO: https://github.com/uber/cadence/issues/3861
 	EnableAuthorization
 	// EnableServiceAuthorization is the key to enable authorization for a service, only for extension binary:
 	// KeyName: N/A
+	// Value type: Bool
 	// Default value: N/A
+	// Allowed filters: N/A
 	// TODO: https://github.com/uber/cadence/issues/3861
 	EnableServiceAuthorization
 	// Usage: VisibilityArchivalQueryMaxRangeInDays is the maximum number of days for a visibility archival query
 	// KeyName: N/A
+	// Value type: Int
 	// Default value: N/A
+	// Allowed filters: N/A
 	// TODO: https://github.com/uber/cadence/issues/3861
 	VisibilityArchivalQueryMaxRangeInDays
 	// Usage: VisibilityArchivalQueryMaxQPS is the timeout for a visibility archival query
 	// KeyName: N/A
+	// Value type: Int
 	// Default value: N/A
+	// Allowed filters: N/A
 	// TODO: https://github.com/uber/cadence/issues/3861
 	VisibilityArchivalQueryMaxQPS
 	// EnableArchivalCompression indicates whether blobs are compressed before they are archived
 	// KeyName: N/A
+	// Value type: Bool
 	// Default value: N/A
+	// Allowed filters: DomainName
 	// TODO: https://github.com/uber/cadence/issues/3861
 	EnableArchivalCompression
 	// WorkerDeterministicConstructionCheckProbability controls the probability of running a deterministic construction check for any given archival
 	// KeyName: N/A
+	// Value type: Float64
 	// Default value: N/A
+	// Allowed filters: DomainName
 	// TODO: https://github.com/uber/cadence/issues/3861
 	WorkerDeterministicConstructionCheckProbability
 	// WorkerBlobIntegrityCheckProbability controls the probability of running an integrity check for any given archival
 	// KeyName: N/A
+	// Value type: Float64
 	// Default value: N/A
+	// Allowed filters: DomainName
 	// TODO: https://github.com/uber/cadence/issues/3861
 	WorkerBlobIntegrityCheckProbability
 
@@ -1925,6 +2250,16 @@ const (
 )
 
 // Mapping from Key to keyName, where keyName are used dynamic config source.
+var keyNames = func() map[string]Key {
+	result := make(map[string]Key, len(keys))
+	for key, keyName := range keys {
+		if key != unknownKey {
+			result[keyName] = key
+		}
+	}
+	return result
+}()
+
 var keys = map[Key]string{
 	unknownKey: "unknownKey",
 
@@ -1940,6 +2275,7 @@ var keys = map[Key]string{
 	testGetDurationPropertyFilteredByDomainKey:       "testGetDurationPropertyFilteredByDomainKey",
 	testGetIntPropertyFilteredByTaskListInfoKey:      "testGetIntPropertyFilteredByTaskListInfoKey",
 	testGetDurationPropertyFilteredByTaskListInfoKey: "testGetDurationPropertyFilteredByTaskListInfoKey",
+	testGetFloat64PropertyFilteredByTaskListInfoKey:  "testGetFloat64PropertyFilteredByTaskListInfoKey",
 	testGetBoolPropertyFilteredByDomainIDKey:         "testGetBoolPropertyFilteredByDomainIDKey",
 	testGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
 
@@ -1970,6 +2306,21 @@ var keys = map[Key]string{
 	RequiredDomainDataKeys:              "system.requiredDomainDataKeys",
 	EnableGRPCOutbound:                  "system.enableGRPCOutbound",
 
+	// persistence circuit breaker
+	PersistenceCircuitBreakerEnabled:               "system.persistenceCircuitBreakerEnabled",
+	PersistenceCircuitBreakerErrorRateThreshold:    "system.persistenceCircuitBreakerErrorRateThreshold",
+	PersistenceCircuitBreakerSlowCallRateThreshold: "system.persistenceCircuitBreakerSlowCallRateThreshold",
+	PersistenceCircuitBreakerSlowCallLatency:       "system.persistenceCircuitBreakerSlowCallLatency",
+	PersistenceCircuitBreakerMinRequests:           "system.persistenceCircuitBreakerMinRequests",
+	PersistenceCircuitBreakerWindow:                "system.persistenceCircuitBreakerWindow",
+	PersistenceCircuitBreakerOpenDuration:          "system.persistenceCircuitBreakerOpenDuration",
+
+	// fault injection
+	EnableFaultInjection:      "system.enableFaultInjection",
+	FaultInjectionRules:       "system.faultInjectionRules",
+	FaultInjectionRulesFile:   "system.faultInjectionRulesFile",
+	HistoryShardPlacementMode: "system.historyShardPlacementMode",
+
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
 	BlobSizeLimitWarn:      "limit.blobSize.warn",
@@ -1977,6 +2328,8 @@ var keys = map[Key]string{
 	HistorySizeLimitWarn:   "limit.historySize.warn",
 	HistoryCountLimitError: "limit.historyCount.error",
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
+	MemoNumberOfKeysLimit:  "limit.memoNumberOfKeys",
+	MemoTotalSizeLimit:     "limit.memoTotalSize",
 
 	// id length limits
 	MaxIDLengthWarnLimit:  "limit.maxIDWarnLength",
@@ -2024,29 +2377,53 @@ var keys = map[Key]string{
 	FrontendErrorInjectionRate:                  "frontend.errorInjectionRate",
 
 	// matching settings
-	MatchingRPS:                             "matching.rps",
-	MatchingPersistenceMaxQPS:               "matching.persistenceMaxQPS",
-	MatchingPersistenceGlobalMaxQPS:         "matching.persistenceGlobalMaxQPS",
-	MatchingMinTaskThrottlingBurstSize:      "matching.minTaskThrottlingBurstSize",
-	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
-	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
-	MatchingEnableSyncMatch:                 "matching.enableSyncMatch",
-	MatchingUpdateAckInterval:               "matching.updateAckInterval",
-	MatchingIdleTasklistCheckInterval:       "matching.idleTasklistCheckInterval",
-	MaxTasklistIdleTime:                     "matching.maxTasklistIdleTime",
-	MatchingOutstandingTaskAppendsThreshold: "matching.outstandingTaskAppendsThreshold",
-	MatchingMaxTaskBatchSize:                "matching.maxTaskBatchSize",
-	MatchingMaxTaskDeleteBatchSize:          "matching.maxTaskDeleteBatchSize",
-	MatchingThrottledLogRPS:                 "matching.throttledLogRPS",
-	MatchingNumTasklistWritePartitions:      "matching.numTasklistWritePartitions",
-	MatchingNumTasklistReadPartitions:       "matching.numTasklistReadPartitions",
-	MatchingForwarderMaxOutstandingPolls:    "matching.forwarderMaxOutstandingPolls",
-	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
-	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
-	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
-	MatchingShutdownDrainDuration:           "matching.shutdownDrainDuration",
-	MatchingErrorInjectionRate:              "matching.errorInjectionRate",
-	MatchingEnableTaskInfoLogByDomainID:     "matching.enableTaskInfoLogByDomainID",
+	MatchingRPS:                                 "matching.rps",
+	MatchingPersistenceMaxQPS:                   "matching.persistenceMaxQPS",
+	MatchingPersistenceGlobalMaxQPS:             "matching.persistenceGlobalMaxQPS",
+	MatchingMinTaskThrottlingBurstSize:          "matching.minTaskThrottlingBurstSize",
+	MatchingGetTasksBatchSize:                   "matching.getTasksBatchSize",
+	MatchingLongPollExpirationInterval:          "matching.longPollExpirationInterval",
+	MatchingEnableSyncMatch:                     "matching.enableSyncMatch",
+	MatchingUpdateAckInterval:                   "matching.updateAckInterval",
+	MatchingIdleTasklistCheckInterval:           "matching.idleTasklistCheckInterval",
+	MaxTasklistIdleTime:                         "matching.maxTasklistIdleTime",
+	MatchingOutstandingTaskAppendsThreshold:     "matching.outstandingTaskAppendsThreshold",
+	MatchingMaxTaskBatchSize:                    "matching.maxTaskBatchSize",
+	MatchingMaxTaskDeleteBatchSize:              "matching.maxTaskDeleteBatchSize",
+	MatchingThrottledLogRPS:                     "matching.throttledLogRPS",
+	MatchingNumTasklistWritePartitions:          "matching.numTasklistWritePartitions",
+	MatchingNumTasklistReadPartitions:           "matching.numTasklistReadPartitions",
+	MatchingForwarderMaxOutstandingPolls:        "matching.forwarderMaxOutstandingPolls",
+	MatchingForwarderMaxOutstandingTasks:        "matching.forwarderMaxOutstandingTasks",
+	MatchingForwarderMaxRatePerSecond:           "matching.forwarderMaxRatePerSecond",
+	MatchingForwarderMaxChildrenPerNode:         "matching.forwarderMaxChildrenPerNode",
+	MatchingForwarderTopology:                   "matching.forwarderTopology",
+	MatchingShutdownDrainDuration:               "matching.shutdownDrainDuration",
+	MatchingErrorInjectionRate:                  "matching.errorInjectionRate",
+	MatchingEnableTaskInfoLogByDomainID:         "matching.enableTaskInfoLogByDomainID",
+	MatchingEnablePartitionScaling:              "matching.enablePartitionScaling",
+	MatchingPartitionScalingInterval:            "matching.partitionScalingInterval",
+	MatchingPartitionUpscaleRPS:                 "matching.partitionUpscaleRPS",
+	MatchingPartitionUpscaleBacklog:             "matching.partitionUpscaleBacklog",
+	MatchingPartitionDownscaleFactor:            "matching.partitionDownscaleFactor",
+	MatchingPartitionDownscaleSustainedDuration: "matching.partitionDownscaleSustainedDuration",
+	MatchingMaxPartitions:                       "matching.maxPartitions",
+	MatchingFairnessKeyWeights:                  "matching.fairnessKeyWeights",
+	MatchingBacklogReadAheadSize:                "matching.backlogReadAheadSize",
+	MatchingMaxWorkerVersionSets:                "matching.maxWorkerVersionSets",
+	MatchingWorkerVersionSetsCacheTTL:           "matching.workerVersionSetsCacheTTL",
+	MatchingTaskListStateCacheTTL:               "matching.taskListStateCacheTTL",
+	MatchingMaxTaskDispatchAttempts:             "matching.maxTaskDispatchAttempts",
+	MatchingTaskDispatchAttemptsCacheSize:       "matching.taskDispatchAttemptsCacheSize",
+	MatchingMaxOutstandingPollsPerTaskList:      "matching.maxOutstandingPollsPerTaskList",
+	MatchingMaxOutstandingPollsPerHost:          "matching.maxOutstandingPollsPerHost",
+	MatchingPollShedBackoff:                     "matching.pollShedBackoff",
+	MatchingEnableIsolationGroups:               "matching.enableIsolationGroups",
+	MatchingIsolationGroupFallbackDelay:         "matching.isolationGroupFallbackDelay",
+	MatchingHostTaskIngestionRPS:                "matching.hostTaskIngestionRPS",
+	MatchingDomainTaskIngestionRPS:              "matching.domainTaskIngestionRPS",
+	MatchingTaskListIngestionRPS:                "matching.taskListIngestionRPS",
+	MatchingDomainTaskDispatchRPS:               "matching.domainTaskDispatchRPS",
 
 	// history settings
 	HistoryRPS:                                         "history.rps",
@@ -2058,6 +2435,7 @@ var keys = map[Key]string{
 	HistoryCacheInitialSize:                            "history.cacheInitialSize",
 	HistoryMaxAutoResetPoints:                          "history.historyMaxAutoResetPoints",
 	HistoryCacheMaxSize:                                "history.cacheMaxSize",
+	HistoryCacheMaxSizeInBytesPerShard:                 "history.cacheMaxSizeInBytesPerShard",
 	HistoryCacheTTL:                                    "history.cacheTTL",
 	HistoryShutdownDrainDuration:                       "history.shutdownDrainDuration",
 	EventsCacheInitialCount:                            "history.eventsCacheInitialSize",
@@ -2069,6 +2447,11 @@ var keys = map[Key]string{
 	EventsCacheGlobalMaxCount:                          "history.eventsCacheGlobalMaxSize",
 	AcquireShardInterval:                               "history.acquireShardInterval",
 	AcquireShardConcurrency:                            "history.acquireShardConcurrency",
+	EnableGracefulShardHandoff:                         "history.enableGracefulShardHandoff",
+	ShardHandoffTimeout:                                "history.shardHandoffTimeout",
+	ShardLoadReportInterval:                            "history.shardLoadReportInterval",
+	ShardRebalanceInterval:                             "history.shardRebalanceInterval",
+	ShardRebalanceMaxMoves:                             "history.shardRebalanceMaxMoves",
 	StandbyClusterDelay:                                "history.standbyClusterDelay",
 	StandbyTaskMissingEventsResendDelay:                "history.standbyTaskMissingEventsResendDelay",
 	StandbyTaskMissingEventsDiscardDelay:               "history.standbyTaskMissingEventsDiscardDelay",
@@ -2168,6 +2551,8 @@ var keys = map[Key]string{
 	HistoryMgrNumConns:                                 "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                         "history.maximumBufferedEventsBatch",
 	MaximumSignalsPerExecution:                         "history.maximumSignalsPerExecution",
+	RequestDedupWindow:                                 "history.requestDedupWindow",
+	RequestDedupMaxRequestIDs:                          "history.requestDedupMaxRequestIDs",
 	ShardUpdateMinInterval:                             "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                               "history.shardSyncMinInterval",
 	DefaultEventEncoding:                               "history.defaultEventEncoding",
@@ -2208,6 +2593,7 @@ var keys = map[Key]string{
 	MutableStateChecksumGenProbability:                 "history.mutableStateChecksumGenProbability",
 	MutableStateChecksumVerifyProbability:              "history.mutableStateChecksumVerifyProbability",
 	MutableStateChecksumInvalidateBefore:               "history.mutableStateChecksumInvalidateBefore",
+	MutableStateChecksumMismatchAction:                 "history.mutableStateChecksumMismatchAction",
 	ReplicationEventsFromCurrentCluster:                "history.ReplicationEventsFromCurrentCluster",
 	NotifyFailoverMarkerInterval:                       "history.NotifyFailoverMarkerInterval",
 	NotifyFailoverMarkerTimerJitterCoefficient:         "history.NotifyFailoverMarkerTimerJitterCoefficient",
@@ -25,13 +25,16 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"sync/atomic"
 	"time"
 
 	"gopkg.in/yaml.v2"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 )
 
 var _ Client = (*fileBasedClient)(nil)
@@ -44,6 +47,16 @@ const (
 type constrainedValue struct {
 	Value       interface{}
 	Constraints map[string]interface{}
+	Schedule    `yaml:",inline"`
+}
+
+// ConfigIssue is a problem found in a dynamic config value
+type ConfigIssue struct {
+	KeyName     string
+	Constraints map[string]interface{}
+	// Ignored is true if the value can not be used and is ignored by the client
+	Ignored bool
+	Err     error
 }
 
 // FileBasedClientConfig is the config for the file based dynamic config client.
@@ -60,22 +73,32 @@ type fileBasedClient struct {
 	config          *FileBasedClientConfig
 	doneCh          chan struct{}
 	logger          log.Logger
+	metricsClient   metrics.Client
+	timeSource      clock.TimeSource
 }
 
 // NewFileBasedClient creates a file based client.
-func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh chan struct{}) (Client, error) {
+func NewFileBasedClient(
+	config *FileBasedClientConfig,
+	metricsClient metrics.Client,
+	logger log.Logger,
+	doneCh chan struct{},
+) (Client, error) {
 	if err := validateConfig(config); err != nil {
 		return nil, err
 	}
 
 	client := &fileBasedClient{
-		config: config,
-		doneCh: doneCh,
-		logger: logger,
+		config:        config,
+		doneCh:        doneCh,
+		logger:        logger,
+		metricsClient: metricsClient,
+		timeSource:    clock.NewRealTimeSource(),
 	}
 	if err := client.update(); err != nil {
 		return nil, err
 	}
+	client.emitMetrics()
 	go func() {
 		ticker := time.NewTicker(client.config.PollInterval)
 		for {
@@ -85,6 +108,7 @@ func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh
 				if err != nil {
 					client.logger.Error("Failed to update dynamic config", tag.Error(err))
 				}
+				client.emitMetrics()
 			case <-client.doneCh:
 				ticker.Stop()
 				return
@@ -240,11 +264,56 @@ func (fc *fileBasedClient) update() error {
 }
 
 func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue) error {
+	if err := convertValues(newValues); err != nil {
+		return err
+	}
+
+	newValues, issues := validateValues(newValues, fc.timeSource.Now())
+	for _, issue := range issues {
+		if issue.Ignored {
+			fc.logger.Error("Ignored invalid dynamic config value", tag.Key(issue.KeyName), tag.Error(issue.Err))
+		} else {
+			fc.logger.Warn("Invalid dynamic config value", tag.Key(issue.KeyName), tag.Error(issue.Err))
+		}
+	}
+
+	fc.values.Store(newValues)
+	fc.logger.Info("Updated dynamic config")
+	return nil
+}
+
+// LintConfigFile validates the content of a dynamic config file against the key schemas
+func LintConfigFile(content []byte) ([]*ConfigIssue, error) {
+	values := make(map[string][]*constrainedValue)
+	if err := yaml.Unmarshal(content, values); err != nil {
+		return nil, fmt.Errorf("failed to decode dynamic config %v", err)
+	}
+	if err := convertValues(values); err != nil {
+		return nil, err
+	}
+	_, issues := validateValues(values, time.Now())
+	return issues, nil
+}
+
+func (i *ConfigIssue) String() string {
+	result := i.KeyName
+	if len(i.Constraints) > 0 {
+		result += fmt.Sprintf(" %v", i.Constraints)
+	}
+	result += ": " + i.Err.Error()
+	if i.Ignored {
+		result += ", the value is ignored"
+	}
+	return result
+}
+
+// convertValues converts the values decoded from yaml to the types the client returns
+func convertValues(values map[string][]*constrainedValue) error {
 	// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
 	// manually convert key type to string for all values here
 	// We don't need to convert constraints as their type can't be map. If user does use a map as filter
 	// value, it won't match anyway.
-	for _, s := range newValues {
+	for _, s := range values {
 		for _, cv := range s {
 			var err error
 			cv.Value, err = convertKeyTypeToString(cv.Value)
@@ -253,21 +322,70 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 			}
 		}
 	}
-
-	fc.values.Store(newValues)
-	fc.logger.Info("Updated dynamic config")
 	return nil
 }
 
+// validateValues checks the values against the key schemas and drops the values which can not be used,
+// the remaining values of each key are ordered so that temporary overrides are matched before permanent values
+func validateValues(values map[string][]*constrainedValue, now time.Time) (map[string][]*constrainedValue, []*ConfigIssue) {
+	var issues []*ConfigIssue
+	keyNames := make([]string, 0, len(values))
+	for keyName := range values {
+		keyNames = append(keyNames, keyName)
+	}
+	sort.Strings(keyNames)
+
+	for _, keyName := range keyNames {
+		key, ok := ParseKeyName(keyName)
+		if !ok {
+			issues = append(issues, &ConfigIssue{KeyName: keyName, Err: errors.New("unknown key")})
+			continue
+		}
+		validValues := make([]*constrainedValue, 0, len(values[keyName]))
+		for _, cv := range values[keyName] {
+			if err := ValidateConstraints(key, cv.Constraints); err != nil {
+				issues = append(issues, &ConfigIssue{KeyName: keyName, Constraints: cv.Constraints, Err: err})
+			}
+			if err := ValidateValue(key, cv.Value); err != nil {
+				issues = append(issues, &ConfigIssue{KeyName: keyName, Constraints: cv.Constraints, Ignored: true, Err: err})
+				continue
+			}
+			if err := cv.Schedule.Validate(); err != nil {
+				issues = append(issues, &ConfigIssue{KeyName: keyName, Constraints: cv.Constraints, Ignored: true, Err: err})
+				continue
+			}
+			if cv.IsExpired(now) {
+				// expired overrides are harmless but should be removed from the file
+				issues = append(issues, &ConfigIssue{KeyName: keyName, Constraints: cv.Constraints, Err: fmt.Errorf("override expired at %v", cv.ExpiresAt.Format(time.RFC3339))})
+			}
+			validValues = append(validValues, cv)
+		}
+		sort.SliceStable(validValues, func(i, j int) bool {
+			return validValues[i].IsTemporary() && !validValues[j].IsTemporary()
+		})
+		values[keyName] = validValues
+	}
+	return values, issues
+}
+
 func (fc *fileBasedClient) getValueWithFilters(key Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
 	keyName := keys[key]
 	values := fc.values.Load().(map[string][]*constrainedValue)
+	now := fc.timeSource.Now()
 	found := false
+	foundOverride := false
 	for _, constrainedValue := range values[keyName] {
+		if !constrainedValue.IsActive(now) {
+			continue
+		}
 		if len(constrainedValue.Constraints) == 0 {
-			// special handling for default value (value without any constraints)
-			defaultValue = constrainedValue.Value
-			found = true
+			// special handling for default value (value without any constraints),
+			// an active temporary override takes precedence over the permanent default value
+			if constrainedValue.IsTemporary() || !foundOverride {
+				defaultValue = constrainedValue.Value
+				found = true
+				foundOverride = constrainedValue.IsTemporary()
+			}
 			continue
 		}
 		if match(constrainedValue, filters) {
@@ -280,6 +398,21 @@ func (fc *fileBasedClient) getValueWithFilters(key Key, filters map[Filter]inter
 	return defaultValue, nil
 }
 
+// emitMetrics reports the number of temporary overrides currently in effect
+func (fc *fileBasedClient) emitMetrics() {
+	values := fc.values.Load().(map[string][]*constrainedValue)
+	now := fc.timeSource.Now()
+	activeOverrides := 0
+	for _, constrainedValues := range values {
+		for _, constrainedValue := range constrainedValues {
+			if constrainedValue.IsTemporary() && constrainedValue.IsActive(now) {
+				activeOverrides++
+			}
+		}
+	}
+	fc.metricsClient.UpdateGauge(metrics.DynamicConfigFileClientScope, metrics.DynamicConfigActiveOverridesGauge, float64(activeOverrides))
+}
+
 // match will return true if the constraints matches the filters or any subsets
 func match(v *constrainedValue, filters map[Filter]interface{}) bool {
 	if len(v.Constraints) > len(filters) {
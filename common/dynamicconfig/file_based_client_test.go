@@ -28,7 +28,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 )
 
 type fileBasedClientSuite struct {
@@ -49,7 +51,7 @@ func (s *fileBasedClientSuite) SetupSuite() {
 	s.client, err = NewFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "config/testConfig.yaml",
 		PollInterval: time.Second * 5,
-	}, log.NewNoop(), s.doneCh)
+	}, metrics.NewNoopMetricsClient(), log.NewNoop(), s.doneCh)
 	s.Require().NoError(err)
 }
 
@@ -218,7 +220,7 @@ func (s *fileBasedClientSuite) TestGetDurationValue_ParseFailed() {
 }
 
 func (s *fileBasedClientSuite) TestValidateConfig_ConfigNotExist() {
-	_, err := NewFileBasedClient(nil, nil, nil)
+	_, err := NewFileBasedClient(nil, nil, nil, nil)
 	s.Error(err)
 }
 
@@ -226,7 +228,7 @@ func (s *fileBasedClientSuite) TestValidateConfig_FileNotExist() {
 	_, err := NewFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "file/not/exist.yaml",
 		PollInterval: time.Second * 10,
-	}, nil, nil)
+	}, nil, nil, nil)
 	s.Error(err)
 }
 
@@ -234,7 +236,7 @@ func (s *fileBasedClientSuite) TestValidateConfig_ShortPollInterval() {
 	_, err := NewFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "config/testConfig.yaml",
 		PollInterval: time.Second,
-	}, nil, nil)
+	}, nil, nil, nil)
 	s.Error(err)
 }
 
@@ -352,3 +354,63 @@ func (s *fileBasedClientSuite) TestUpdateConfig() {
 	err = client.UpdateValue(key, v)
 	s.NoError(err)
 }
+
+func (s *fileBasedClientSuite) TestTemporaryOverrides() {
+	now := time.Unix(1600000000, 0)
+	timeSource := clock.NewEventTimeSource().Update(now)
+	client := &fileBasedClient{
+		logger:        log.NewNoop(),
+		metricsClient: metrics.NewNoopMetricsClient(),
+		timeSource:    timeSource,
+	}
+	activeFrom := now.Add(time.Hour)
+	expiresAt := now.Add(2 * time.Hour)
+	domainFilter := map[string]interface{}{DomainName.String(): "samples-domain"}
+	s.NoError(client.storeValues(map[string][]*constrainedValue{
+		FrontendRPS.String(): {
+			{Value: 1200},
+			{Value: 600, Schedule: Schedule{ActiveFrom: &activeFrom, ExpiresAt: &expiresAt}},
+		},
+		FrontendMaxDomainRPSPerInstance.String(): {
+			{Value: 100, Constraints: domainFilter},
+			{Value: 10, Constraints: domainFilter, Schedule: Schedule{ExpiresAt: &expiresAt}},
+		},
+	}))
+	domainFilters := map[Filter]interface{}{DomainName: "samples-domain"}
+
+	value, err := client.GetIntValue(FrontendRPS, nil, 0)
+	s.NoError(err)
+	s.Equal(1200, value)
+	value, err = client.GetIntValue(FrontendMaxDomainRPSPerInstance, domainFilters, 0)
+	s.NoError(err)
+	s.Equal(10, value)
+
+	timeSource.Update(activeFrom)
+	value, err = client.GetIntValue(FrontendRPS, nil, 0)
+	s.NoError(err)
+	s.Equal(600, value)
+
+	timeSource.Update(expiresAt)
+	value, err = client.GetIntValue(FrontendRPS, nil, 0)
+	s.NoError(err)
+	s.Equal(1200, value)
+	value, err = client.GetIntValue(FrontendMaxDomainRPSPerInstance, domainFilters, 0)
+	s.NoError(err)
+	s.Equal(100, value)
+}
+
+func (s *fileBasedClientSuite) TestTemporaryOverrides_Invalid() {
+	now := time.Now()
+	expiresAt := now.Add(-time.Minute)
+	values, issues := validateValues(map[string][]*constrainedValue{
+		FrontendRPS.String(): {
+			{Value: 1200},
+			{Value: 600, Schedule: Schedule{ActiveFrom: &now}},
+			{Value: 300, Schedule: Schedule{ExpiresAt: &expiresAt}},
+		},
+	}, now)
+	s.Len(issues, 2)
+	s.True(issues[0].Ignored)
+	s.False(issues[1].Ignored)
+	s.Len(values[FrontendRPS.String()], 2)
+}
@@ -49,6 +49,12 @@ func parseFilter(filterName string) Filter {
 	}
 }
 
+// ParseFilterName returns the filter with the given name, the second return value is false if the name is unknown
+func ParseFilterName(filterName string) (Filter, bool) {
+	filter := parseFilter(filterName)
+	return filter, filter != unknownFilter
+}
+
 var filters = []string{
 	"unknownFilter",
 	"domainName",
@@ -0,0 +1,68 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"time"
+)
+
+// Schedule limits when a dynamic config value is in effect. A value with an expiry time is a temporary override,
+// while it is active it takes precedence over the permanent value with the same filters, and the permanent value
+// applies again once it expires.
+type Schedule struct {
+	// ActiveFrom is when the override takes effect, the override is in effect immediately if it is not set
+	ActiveFrom *time.Time `yaml:"activeFrom,omitempty" json:"activeFrom,omitempty"`
+	// ExpiresAt is when the override stops taking effect, the value is permanent if it is not set
+	ExpiresAt *time.Time `yaml:"expiresAt,omitempty" json:"expiresAt,omitempty"`
+}
+
+// IsTemporary returns true if the value is a temporary override
+func (s Schedule) IsTemporary() bool {
+	return s.ExpiresAt != nil
+}
+
+// IsActive returns true if the value is in effect at the given time
+func (s Schedule) IsActive(now time.Time) bool {
+	if s.ActiveFrom != nil && now.Before(*s.ActiveFrom) {
+		return false
+	}
+	return !s.IsExpired(now)
+}
+
+// IsExpired returns true if the value is a temporary override which expired before the given time
+func (s Schedule) IsExpired(now time.Time) bool {
+	return s.ExpiresAt != nil && !now.Before(*s.ExpiresAt)
+}
+
+// Validate checks the activation time is only set for temporary overrides and is before the expiry time
+func (s Schedule) Validate() error {
+	if s.ActiveFrom == nil {
+		return nil
+	}
+	if s.ExpiresAt == nil {
+		return errors.New("activation time requires an expiry time")
+	}
+	if !s.ActiveFrom.Before(*s.ExpiresAt) {
+		return errors.New("activation time must be before the expiry time")
+	}
+	return nil
+}
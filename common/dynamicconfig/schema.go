@@ -0,0 +1,577 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ValueType is the type of a dynamic config value
+type ValueType int
+
+const (
+	unknownValueType ValueType = iota
+	// ValueTypeInt is the type of int values
+	ValueTypeInt
+	// ValueTypeFloat is the type of float values, int values are accepted as well
+	ValueTypeFloat
+	// ValueTypeBool is the type of bool values
+	ValueTypeBool
+	// ValueTypeString is the type of string values
+	ValueTypeString
+	// ValueTypeDuration is the type of duration values, durations are strings like "10s"
+	ValueTypeDuration
+	// ValueTypeMap is the type of map values
+	ValueTypeMap
+)
+
+var valueTypes = []string{
+	"unknown",
+	"int",
+	"float",
+	"bool",
+	"string",
+	"duration",
+	"map",
+}
+
+func (t ValueType) String() string {
+	if t <= unknownValueType || t > ValueTypeMap {
+		return valueTypes[unknownValueType]
+	}
+	return valueTypes[t]
+}
+
+type (
+	// Bounds are the inclusive bounds of a numeric value, durations are compared in seconds
+	Bounds struct {
+		Min float64
+		Max float64
+	}
+
+	// KeySchema describes the values allowed for a dynamic config key
+	KeySchema struct {
+		Type ValueType
+		// Filters are the filters the key is looked up with, the cluster name filter is always allowed
+		Filters []Filter
+		// Bounds optionally restrict numeric and duration values, which must not be negative otherwise
+		Bounds *Bounds
+		// AllowedValues optionally restrict string values
+		AllowedValues []string
+	}
+)
+
+var (
+	domainNameFilter = []Filter{DomainName}
+	domainIDFilter   = []Filter{DomainID}
+	taskListFilter   = []Filter{DomainName, TaskListName, TaskType}
+	shardIDFilter    = []Filter{ShardID}
+
+	nonNegativeBounds = &Bounds{Min: 0, Max: math.Inf(1)}
+	positiveBounds    = &Bounds{Min: 1, Max: math.Inf(1)}
+	ratioBounds       = &Bounds{Min: 0, Max: 1}
+
+	archivalStatusValues     = []string{"", "disabled", "paused", "enabled"}
+	forwarderTopologyValues  = []string{"", "tree", "flat", "random"}
+	shardPlacementModeValues = []string{"hash", "load"}
+	checksumMismatchActions  = []string{"log", "repair", "quarantine"}
+)
+
+// GetKeySchema returns the schema of the key, the second return value is false if the key has no schema
+func GetKeySchema(key Key) (KeySchema, bool) {
+	schema, ok := keySchemas[key]
+	return schema, ok
+}
+
+// ValidateValue checks the value against the schema of the key
+func ValidateValue(key Key, value interface{}) error {
+	schema, ok := keySchemas[key]
+	if !ok {
+		return nil
+	}
+
+	var number float64
+	switch schema.Type {
+	case ValueTypeInt:
+		intValue, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("value %v of type %T is not %v", value, value, schema.Type)
+		}
+		number = float64(intValue)
+	case ValueTypeFloat:
+		switch v := value.(type) {
+		case float64:
+			number = v
+		case int:
+			number = float64(v)
+		default:
+			return fmt.Errorf("value %v of type %T is not %v", value, value, schema.Type)
+		}
+	case ValueTypeDuration:
+		durationString, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("value %v of type %T is not %v", value, value, schema.Type)
+		}
+		duration, err := time.ParseDuration(durationString)
+		if err != nil {
+			return fmt.Errorf("value %v is not %v: %v", value, schema.Type, err)
+		}
+		number = duration.Seconds()
+	case ValueTypeBool:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("value %v of type %T is not %v", value, value, schema.Type)
+		}
+		return nil
+	case ValueTypeString:
+		stringValue, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("value %v of type %T is not %v", value, value, schema.Type)
+		}
+		if len(schema.AllowedValues) == 0 {
+			return nil
+		}
+		for _, allowed := range schema.AllowedValues {
+			if stringValue == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %q is not one of %q", stringValue, schema.AllowedValues)
+	case ValueTypeMap:
+		if _, ok := value.(map[string]interface{}); !ok {
+			return fmt.Errorf("value %v of type %T is not %v", value, value, schema.Type)
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	bounds := schema.Bounds
+	if bounds == nil {
+		bounds = nonNegativeBounds
+	}
+	if number < bounds.Min || number > bounds.Max {
+		return fmt.Errorf("value %v is out of bounds [%v, %v]", value, bounds.Min, bounds.Max)
+	}
+	return nil
+}
+
+// ValidateConstraints checks the filters a value applies to against the schema of the key
+func ValidateConstraints(key Key, constraints map[string]interface{}) error {
+	schema, ok := keySchemas[key]
+	for name, value := range constraints {
+		filter, known := ParseFilterName(name)
+		if !known {
+			return fmt.Errorf("unknown filter %v", name)
+		}
+		if err := validateFilterValue(filter, value); err != nil {
+			return err
+		}
+		if !ok || filter == ClusterName || containsFilter(schema.Filters, filter) {
+			continue
+		}
+		return fmt.Errorf("filter %v is not supported, supported filters are %v", name, schema.Filters)
+	}
+	return nil
+}
+
+func validateFilterValue(filter Filter, value interface{}) error {
+	switch filter {
+	case TaskType, ShardID:
+		if _, ok := value.(int); !ok {
+			return fmt.Errorf("value %v of filter %v is not int", value, filter)
+		}
+	default:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("value %v of filter %v is not string", value, filter)
+		}
+	}
+	return nil
+}
+
+func containsFilter(filters []Filter, filter Filter) bool {
+	for _, f := range filters {
+		if f == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// keySchemas declares the type, the allowed filters and the bounds of every key,
+// keep it in sync with the documentation of the keys
+var keySchemas = map[Key]KeySchema{
+	EnableGlobalDomain:                                       {Type: ValueTypeBool},
+	EnableVisibilitySampling:                                 {Type: ValueTypeBool},
+	EnableReadFromClosedExecutionV2:                          {Type: ValueTypeBool},
+	AdvancedVisibilityWritingMode:                            {Type: ValueTypeString},
+	EmitShardDiffLog:                                         {Type: ValueTypeBool},
+	EnableReadVisibilityFromES:                               {Type: ValueTypeBool, Filters: domainNameFilter},
+	DisableListVisibilityByFilter:                            {Type: ValueTypeBool, Filters: domainNameFilter},
+	HistoryArchivalStatus:                                    {Type: ValueTypeString, AllowedValues: archivalStatusValues},
+	EnableReadFromHistoryArchival:                            {Type: ValueTypeBool},
+	VisibilityArchivalStatus:                                 {Type: ValueTypeString, AllowedValues: archivalStatusValues},
+	EnableReadFromVisibilityArchival:                         {Type: ValueTypeBool},
+	EnableDomainNotActiveAutoForwarding:                      {Type: ValueTypeBool, Filters: domainNameFilter},
+	EnableGracefulFailover:                                   {Type: ValueTypeBool},
+	TransactionSizeLimit:                                     {Type: ValueTypeInt},
+	PersistenceErrorInjectionRate:                            {Type: ValueTypeFloat, Bounds: ratioBounds},
+	PersistenceCircuitBreakerEnabled:                         {Type: ValueTypeBool},
+	PersistenceCircuitBreakerErrorRateThreshold:              {Type: ValueTypeFloat, Bounds: ratioBounds},
+	PersistenceCircuitBreakerSlowCallRateThreshold:           {Type: ValueTypeFloat, Bounds: ratioBounds},
+	PersistenceCircuitBreakerSlowCallLatency:                 {Type: ValueTypeDuration},
+	PersistenceCircuitBreakerMinRequests:                     {Type: ValueTypeInt, Bounds: positiveBounds},
+	PersistenceCircuitBreakerWindow:                          {Type: ValueTypeDuration},
+	PersistenceCircuitBreakerOpenDuration:                    {Type: ValueTypeDuration},
+	EnableFaultInjection:                                     {Type: ValueTypeBool},
+	FaultInjectionRules:                                      {Type: ValueTypeMap},
+	FaultInjectionRulesFile:                                  {Type: ValueTypeString},
+	HistoryShardPlacementMode:                                {Type: ValueTypeString, AllowedValues: shardPlacementModeValues},
+	MaxRetentionDays:                                         {Type: ValueTypeInt},
+	MinRetentionDays:                                         {Type: ValueTypeInt},
+	MaxDecisionStartToCloseSeconds:                           {Type: ValueTypeInt, Filters: domainNameFilter},
+	DisallowQuery:                                            {Type: ValueTypeBool, Filters: domainNameFilter},
+	EnableDebugMode:                                          {Type: ValueTypeBool},
+	RequiredDomainDataKeys:                                   {Type: ValueTypeMap},
+	EnableGRPCOutbound:                                       {Type: ValueTypeBool},
+	BlobSizeLimitError:                                       {Type: ValueTypeInt, Filters: domainNameFilter},
+	BlobSizeLimitWarn:                                        {Type: ValueTypeInt, Filters: domainNameFilter},
+	HistorySizeLimitError:                                    {Type: ValueTypeInt, Filters: domainNameFilter},
+	HistorySizeLimitWarn:                                     {Type: ValueTypeInt, Filters: domainNameFilter},
+	HistoryCountLimitError:                                   {Type: ValueTypeInt, Filters: domainNameFilter},
+	HistoryCountLimitWarn:                                    {Type: ValueTypeInt, Filters: domainNameFilter},
+	MemoNumberOfKeysLimit:                                    {Type: ValueTypeInt, Filters: domainNameFilter, Bounds: nonNegativeBounds},
+	MemoTotalSizeLimit:                                       {Type: ValueTypeInt, Filters: domainNameFilter, Bounds: nonNegativeBounds},
+	DomainNameMaxLength:                                      {Type: ValueTypeInt, Filters: domainNameFilter},
+	IdentityMaxLength:                                        {Type: ValueTypeInt, Filters: domainNameFilter},
+	WorkflowIDMaxLength:                                      {Type: ValueTypeInt, Filters: domainNameFilter},
+	SignalNameMaxLength:                                      {Type: ValueTypeInt, Filters: domainNameFilter},
+	WorkflowTypeMaxLength:                                    {Type: ValueTypeInt, Filters: domainNameFilter},
+	RequestIDMaxLength:                                       {Type: ValueTypeInt, Filters: domainNameFilter},
+	TaskListNameMaxLength:                                    {Type: ValueTypeInt, Filters: domainNameFilter},
+	ActivityIDMaxLength:                                      {Type: ValueTypeInt, Filters: domainNameFilter},
+	ActivityTypeMaxLength:                                    {Type: ValueTypeInt, Filters: domainNameFilter},
+	MarkerNameMaxLength:                                      {Type: ValueTypeInt, Filters: domainNameFilter},
+	TimerIDMaxLength:                                         {Type: ValueTypeInt, Filters: domainNameFilter},
+	MaxIDLengthWarnLimit:                                     {Type: ValueTypeInt},
+	AdminErrorInjectionRate:                                  {Type: ValueTypeFloat, Bounds: ratioBounds},
+	FrontendPersistenceMaxQPS:                                {Type: ValueTypeInt},
+	FrontendPersistenceGlobalMaxQPS:                          {Type: ValueTypeInt},
+	FrontendVisibilityMaxPageSize:                            {Type: ValueTypeInt, Filters: domainNameFilter},
+	FrontendVisibilityListMaxQPS:                             {Type: ValueTypeInt, Filters: domainNameFilter},
+	FrontendESVisibilityListMaxQPS:                           {Type: ValueTypeInt, Filters: domainNameFilter},
+	FrontendESIndexMaxResultWindow:                           {Type: ValueTypeInt},
+	FrontendHistoryMaxPageSize:                               {Type: ValueTypeInt, Filters: domainNameFilter},
+	FrontendRPS:                                              {Type: ValueTypeInt},
+	FrontendMaxDomainRPSPerInstance:                          {Type: ValueTypeInt, Filters: domainNameFilter},
+	FrontendGlobalDomainRPS:                                  {Type: ValueTypeInt, Filters: domainNameFilter},
+	FrontendHistoryMgrNumConns:                               {Type: ValueTypeInt},
+	FrontendThrottledLogRPS:                                  {Type: ValueTypeInt},
+	FrontendShutdownDrainDuration:                            {Type: ValueTypeDuration},
+	EnableClientVersionCheck:                                 {Type: ValueTypeBool},
+	FrontendMaxBadBinaries:                                   {Type: ValueTypeInt, Filters: domainNameFilter},
+	FrontendFailoverCoolDown:                                 {Type: ValueTypeDuration, Filters: domainNameFilter},
+	ValidSearchAttributes:                                    {Type: ValueTypeMap},
+	SendRawWorkflowHistory:                                   {Type: ValueTypeBool, Filters: domainNameFilter},
+	SearchAttributesNumberOfKeysLimit:                        {Type: ValueTypeInt, Filters: domainNameFilter},
+	SearchAttributesSizeOfValueLimit:                         {Type: ValueTypeInt, Filters: domainNameFilter},
+	SearchAttributesTotalSizeLimit:                           {Type: ValueTypeInt, Filters: domainNameFilter},
+	VisibilityArchivalQueryMaxPageSize:                       {Type: ValueTypeInt},
+	DomainFailoverRefreshInterval:                            {Type: ValueTypeDuration},
+	DomainFailoverRefreshTimerJitterCoefficient:              {Type: ValueTypeFloat, Bounds: ratioBounds},
+	FrontendErrorInjectionRate:                               {Type: ValueTypeFloat, Bounds: ratioBounds},
+	MatchingRPS:                                              {Type: ValueTypeInt},
+	MatchingPersistenceMaxQPS:                                {Type: ValueTypeInt},
+	MatchingPersistenceGlobalMaxQPS:                          {Type: ValueTypeInt},
+	MatchingMinTaskThrottlingBurstSize:                       {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingGetTasksBatchSize:                                {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingLongPollExpirationInterval:                       {Type: ValueTypeDuration, Filters: taskListFilter},
+	MatchingEnableSyncMatch:                                  {Type: ValueTypeBool, Filters: taskListFilter},
+	MatchingUpdateAckInterval:                                {Type: ValueTypeDuration, Filters: taskListFilter},
+	MatchingIdleTasklistCheckInterval:                        {Type: ValueTypeDuration, Filters: taskListFilter},
+	MaxTasklistIdleTime:                                      {Type: ValueTypeDuration, Filters: taskListFilter},
+	MatchingOutstandingTaskAppendsThreshold:                  {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingMaxTaskBatchSize:                                 {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingMaxTaskDeleteBatchSize:                           {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingThrottledLogRPS:                                  {Type: ValueTypeInt},
+	MatchingNumTasklistWritePartitions:                       {Type: ValueTypeInt, Filters: taskListFilter, Bounds: positiveBounds},
+	MatchingNumTasklistReadPartitions:                        {Type: ValueTypeInt, Filters: taskListFilter, Bounds: positiveBounds},
+	MatchingForwarderMaxOutstandingPolls:                     {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingForwarderMaxOutstandingTasks:                     {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingForwarderMaxRatePerSecond:                        {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingForwarderMaxChildrenPerNode:                      {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingForwarderTopology:                                {Type: ValueTypeString, Filters: taskListFilter, AllowedValues: forwarderTopologyValues},
+	MatchingShutdownDrainDuration:                            {Type: ValueTypeDuration},
+	MatchingErrorInjectionRate:                               {Type: ValueTypeFloat, Bounds: ratioBounds},
+	MatchingEnableTaskInfoLogByDomainID:                      {Type: ValueTypeBool, Filters: domainIDFilter},
+	MatchingEnablePartitionScaling:                           {Type: ValueTypeBool, Filters: taskListFilter},
+	MatchingPartitionScalingInterval:                         {Type: ValueTypeDuration},
+	MatchingPartitionUpscaleRPS:                              {Type: ValueTypeFloat, Filters: taskListFilter, Bounds: positiveBounds},
+	MatchingPartitionUpscaleBacklog:                          {Type: ValueTypeInt, Filters: taskListFilter, Bounds: positiveBounds},
+	MatchingPartitionDownscaleFactor:                         {Type: ValueTypeFloat, Filters: taskListFilter, Bounds: ratioBounds},
+	MatchingPartitionDownscaleSustainedDuration:              {Type: ValueTypeDuration, Filters: taskListFilter},
+	MatchingMaxPartitions:                                    {Type: ValueTypeInt, Filters: taskListFilter, Bounds: positiveBounds},
+	MatchingFairnessKeyWeights:                               {Type: ValueTypeMap, Filters: taskListFilter},
+	MatchingBacklogReadAheadSize:                             {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingMaxWorkerVersionSets:                             {Type: ValueTypeInt, Filters: domainNameFilter, Bounds: positiveBounds},
+	MatchingWorkerVersionSetsCacheTTL:                        {Type: ValueTypeDuration},
+	MatchingTaskListStateCacheTTL:                            {Type: ValueTypeDuration},
+	MatchingMaxTaskDispatchAttempts:                          {Type: ValueTypeInt, Filters: taskListFilter},
+	MatchingTaskDispatchAttemptsCacheSize:                    {Type: ValueTypeInt},
+	MatchingMaxOutstandingPollsPerTaskList:                   {Type: ValueTypeInt, Filters: taskListFilter, Bounds: nonNegativeBounds},
+	MatchingMaxOutstandingPollsPerHost:                       {Type: ValueTypeInt, Bounds: nonNegativeBounds},
+	MatchingPollShedBackoff:                                  {Type: ValueTypeDuration},
+	MatchingEnableIsolationGroups:                            {Type: ValueTypeBool, Filters: taskListFilter},
+	MatchingIsolationGroupFallbackDelay:                      {Type: ValueTypeDuration, Filters: taskListFilter},
+	MatchingHostTaskIngestionRPS:                             {Type: ValueTypeFloat, Bounds: nonNegativeBounds},
+	MatchingDomainTaskIngestionRPS:                           {Type: ValueTypeFloat, Filters: domainNameFilter, Bounds: nonNegativeBounds},
+	MatchingTaskListIngestionRPS:                             {Type: ValueTypeFloat, Filters: taskListFilter, Bounds: nonNegativeBounds},
+	MatchingDomainTaskDispatchRPS:                            {Type: ValueTypeFloat, Filters: domainNameFilter, Bounds: nonNegativeBounds},
+	HistoryRPS:                                               {Type: ValueTypeInt},
+	HistoryPersistenceMaxQPS:                                 {Type: ValueTypeInt},
+	HistoryPersistenceGlobalMaxQPS:                           {Type: ValueTypeInt},
+	HistoryVisibilityOpenMaxQPS:                              {Type: ValueTypeInt, Filters: domainNameFilter},
+	HistoryVisibilityClosedMaxQPS:                            {Type: ValueTypeInt, Filters: domainNameFilter},
+	HistoryLongPollExpirationInterval:                        {Type: ValueTypeDuration, Filters: domainNameFilter},
+	HistoryCacheInitialSize:                                  {Type: ValueTypeInt},
+	HistoryCacheMaxSize:                                      {Type: ValueTypeInt},
+	HistoryCacheMaxSizeInBytesPerShard:                       {Type: ValueTypeInt, Bounds: nonNegativeBounds},
+	HistoryCacheTTL:                                          {Type: ValueTypeDuration},
+	HistoryShutdownDrainDuration:                             {Type: ValueTypeDuration},
+	EventsCacheInitialCount:                                  {Type: ValueTypeInt},
+	EventsCacheMaxCount:                                      {Type: ValueTypeInt},
+	EventsCacheMaxSize:                                       {Type: ValueTypeInt},
+	EventsCacheTTL:                                           {Type: ValueTypeDuration},
+	EventsCacheGlobalEnable:                                  {Type: ValueTypeBool},
+	EventsCacheGlobalInitialCount:                            {Type: ValueTypeInt},
+	EventsCacheGlobalMaxCount:                                {Type: ValueTypeInt},
+	AcquireShardInterval:                                     {Type: ValueTypeDuration},
+	AcquireShardConcurrency:                                  {Type: ValueTypeInt},
+	EnableGracefulShardHandoff:                               {Type: ValueTypeBool},
+	ShardHandoffTimeout:                                      {Type: ValueTypeDuration},
+	ShardLoadReportInterval:                                  {Type: ValueTypeDuration},
+	ShardRebalanceInterval:                                   {Type: ValueTypeDuration},
+	ShardRebalanceMaxMoves:                                   {Type: ValueTypeInt, Bounds: nonNegativeBounds},
+	StandbyClusterDelay:                                      {Type: ValueTypeDuration},
+	StandbyTaskMissingEventsResendDelay:                      {Type: ValueTypeDuration},
+	StandbyTaskMissingEventsDiscardDelay:                     {Type: ValueTypeDuration},
+	TaskProcessRPS:                                           {Type: ValueTypeInt, Filters: domainNameFilter},
+	TaskSchedulerType:                                        {Type: ValueTypeInt},
+	TaskSchedulerWorkerCount:                                 {Type: ValueTypeInt},
+	TaskSchedulerShardWorkerCount:                            {Type: ValueTypeInt},
+	TaskSchedulerQueueSize:                                   {Type: ValueTypeInt},
+	TaskSchedulerShardQueueSize:                              {Type: ValueTypeInt},
+	TaskSchedulerDispatcherCount:                             {Type: ValueTypeInt},
+	TaskSchedulerRoundRobinWeights:                           {Type: ValueTypeMap},
+	ActiveTaskRedispatchInterval:                             {Type: ValueTypeDuration},
+	StandbyTaskRedispatchInterval:                            {Type: ValueTypeDuration},
+	TaskRedispatchIntervalJitterCoefficient:                  {Type: ValueTypeFloat, Bounds: ratioBounds},
+	StandbyTaskReReplicationContextTimeout:                   {Type: ValueTypeDuration, Filters: domainIDFilter},
+	QueueProcessorEnableSplit:                                {Type: ValueTypeBool},
+	QueueProcessorSplitMaxLevel:                              {Type: ValueTypeInt},
+	QueueProcessorEnableRandomSplitByDomainID:                {Type: ValueTypeBool, Filters: domainIDFilter},
+	QueueProcessorRandomSplitProbability:                     {Type: ValueTypeFloat, Bounds: ratioBounds},
+	QueueProcessorEnablePendingTaskSplitByDomainID:           {Type: ValueTypeBool, Filters: domainIDFilter},
+	QueueProcessorPendingTaskSplitThreshold:                  {Type: ValueTypeMap},
+	QueueProcessorEnableStuckTaskSplitByDomainID:             {Type: ValueTypeBool, Filters: domainIDFilter},
+	QueueProcessorStuckTaskSplitThreshold:                    {Type: ValueTypeMap},
+	QueueProcessorSplitLookAheadDurationByDomainID:           {Type: ValueTypeDuration, Filters: domainIDFilter},
+	QueueProcessorPollBackoffInterval:                        {Type: ValueTypeDuration},
+	QueueProcessorPollBackoffIntervalJitterCoefficient:       {Type: ValueTypeFloat, Bounds: ratioBounds},
+	QueueProcessorEnablePersistQueueStates:                   {Type: ValueTypeBool},
+	QueueProcessorEnableLoadQueueStates:                      {Type: ValueTypeBool},
+	TimerTaskBatchSize:                                       {Type: ValueTypeInt},
+	TimerTaskWorkerCount:                                     {Type: ValueTypeInt},
+	TimerTaskMaxRetryCount:                                   {Type: ValueTypeInt},
+	TimerProcessorGetFailureRetryCount:                       {Type: ValueTypeInt},
+	TimerProcessorCompleteTimerFailureRetryCount:             {Type: ValueTypeInt},
+	TimerProcessorUpdateAckInterval:                          {Type: ValueTypeDuration},
+	TimerProcessorUpdateAckIntervalJitterCoefficient:         {Type: ValueTypeFloat, Bounds: ratioBounds},
+	TimerProcessorCompleteTimerInterval:                      {Type: ValueTypeDuration},
+	TimerProcessorFailoverMaxPollRPS:                         {Type: ValueTypeInt},
+	TimerProcessorMaxPollRPS:                                 {Type: ValueTypeInt},
+	TimerProcessorMaxPollInterval:                            {Type: ValueTypeDuration},
+	TimerProcessorMaxPollIntervalJitterCoefficient:           {Type: ValueTypeFloat, Bounds: ratioBounds},
+	TimerProcessorSplitQueueInterval:                         {Type: ValueTypeDuration},
+	TimerProcessorSplitQueueIntervalJitterCoefficient:        {Type: ValueTypeFloat, Bounds: ratioBounds},
+	TimerProcessorMaxRedispatchQueueSize:                     {Type: ValueTypeInt},
+	TimerProcessorMaxTimeShift:                               {Type: ValueTypeDuration},
+	TimerProcessorHistoryArchivalSizeLimit:                   {Type: ValueTypeInt},
+	TimerProcessorArchivalTimeLimit:                          {Type: ValueTypeDuration},
+	TransferProcessorFailoverMaxPollRPS:                      {Type: ValueTypeInt},
+	TransferProcessorMaxPollRPS:                              {Type: ValueTypeInt},
+	TransferTaskBatchSize:                                    {Type: ValueTypeInt},
+	TransferTaskWorkerCount:                                  {Type: ValueTypeInt},
+	TransferTaskMaxRetryCount:                                {Type: ValueTypeInt},
+	TransferProcessorCompleteTransferFailureRetryCount:       {Type: ValueTypeInt},
+	TransferProcessorMaxPollInterval:                         {Type: ValueTypeDuration},
+	TransferProcessorMaxPollIntervalJitterCoefficient:        {Type: ValueTypeFloat, Bounds: ratioBounds},
+	TransferProcessorSplitQueueInterval:                      {Type: ValueTypeDuration},
+	TransferProcessorSplitQueueIntervalJitterCoefficient:     {Type: ValueTypeFloat, Bounds: ratioBounds},
+	TransferProcessorUpdateAckInterval:                       {Type: ValueTypeDuration},
+	TransferProcessorUpdateAckIntervalJitterCoefficient:      {Type: ValueTypeFloat, Bounds: ratioBounds},
+	TransferProcessorCompleteTransferInterval:                {Type: ValueTypeDuration},
+	TransferProcessorMaxRedispatchQueueSize:                  {Type: ValueTypeInt},
+	TransferProcessorEnableValidator:                         {Type: ValueTypeBool},
+	TransferProcessorValidationInterval:                      {Type: ValueTypeDuration},
+	TransferProcessorVisibilityArchivalTimeLimit:             {Type: ValueTypeDuration},
+	CrossClusterTaskBatchSize:                                {Type: ValueTypeInt},
+	CrossClusterProcessorMaxPollRPS:                          {Type: ValueTypeInt},
+	CrossClusterTaskWorkerCount:                              {Type: ValueTypeInt},
+	CrossClusterTaskMaxRetryCount:                            {Type: ValueTypeInt},
+	CrossClusterProcessorCompleteTaskFailureRetryCount:       {Type: ValueTypeInt},
+	CrossClusterProcessorMaxPollInterval:                     {Type: ValueTypeDuration},
+	CrossClusterProcessorMaxPollIntervalJitterCoefficient:    {Type: ValueTypeFloat, Bounds: ratioBounds},
+	CrossClusterProcessorSplitQueueInterval:                  {Type: ValueTypeDuration},
+	CrossClusterProcessorSplitQueueIntervalJitterCoefficient: {Type: ValueTypeFloat, Bounds: ratioBounds},
+	CrossClusterProcessorUpdateAckInterval:                   {Type: ValueTypeDuration},
+	CrossClusterProcessorUpdateAckIntervalJitterCoefficient:  {Type: ValueTypeFloat, Bounds: ratioBounds},
+	CrossClusterProcessorCompleteTaskInterval:                {Type: ValueTypeDuration},
+	CrossClusterProcessorMaxRedispatchQueueSize:              {Type: ValueTypeInt},
+	CrossClusterProcessorEnableValidator:                     {Type: ValueTypeBool},
+	CrossClusterProcessorValidationInterval:                  {Type: ValueTypeDuration},
+	CrossClusterProcessorValidationIntervalJitterCoefficient: {Type: ValueTypeFloat, Bounds: ratioBounds},
+	ReplicatorTaskBatchSize:                                  {Type: ValueTypeInt, Filters: shardIDFilter},
+	ReplicatorTaskWorkerCount:                                {Type: ValueTypeInt},
+	ReplicatorReadTaskMaxRetryCount:                          {Type: ValueTypeInt},
+	ReplicatorTaskMaxRetryCount:                              {Type: ValueTypeInt},
+	ReplicatorProcessorMaxPollRPS:                            {Type: ValueTypeInt},
+	ReplicatorProcessorMaxPollInterval:                       {Type: ValueTypeDuration},
+	ReplicatorProcessorMaxPollIntervalJitterCoefficient:      {Type: ValueTypeFloat, Bounds: ratioBounds},
+	ReplicatorProcessorUpdateAckInterval:                     {Type: ValueTypeDuration},
+	ReplicatorProcessorUpdateAckIntervalJitterCoefficient:    {Type: ValueTypeFloat, Bounds: ratioBounds},
+	ReplicatorProcessorMaxRedispatchQueueSize:                {Type: ValueTypeInt},
+	ReplicatorProcessorEnablePriorityTaskProcessor:           {Type: ValueTypeBool},
+	ExecutionMgrNumConns:                                     {Type: ValueTypeInt},
+	HistoryMgrNumConns:                                       {Type: ValueTypeInt},
+	MaximumBufferedEventsBatch:                               {Type: ValueTypeInt},
+	MaximumSignalsPerExecution:                               {Type: ValueTypeInt, Filters: domainNameFilter},
+	RequestDedupWindow:                                       {Type: ValueTypeDuration, Filters: domainNameFilter},
+	RequestDedupMaxRequestIDs:                                {Type: ValueTypeInt, Filters: domainNameFilter, Bounds: nonNegativeBounds},
+	ShardUpdateMinInterval:                                   {Type: ValueTypeDuration},
+	ShardSyncMinInterval:                                     {Type: ValueTypeDuration},
+	DefaultEventEncoding:                                     {Type: ValueTypeString, Filters: domainNameFilter},
+	NumArchiveSystemWorkflows:                                {Type: ValueTypeInt},
+	ArchiveRequestRPS:                                        {Type: ValueTypeInt},
+	EnableAdminProtection:                                    {Type: ValueTypeBool},
+	AdminOperationToken:                                      {Type: ValueTypeString},
+	HistoryMaxAutoResetPoints:                                {Type: ValueTypeInt, Filters: domainNameFilter},
+	EnableParentClosePolicy:                                  {Type: ValueTypeBool, Filters: domainNameFilter},
+	ParentClosePolicyThreshold:                               {Type: ValueTypeInt, Filters: domainNameFilter},
+	NumParentClosePolicySystemWorkflows:                      {Type: ValueTypeInt},
+	HistoryThrottledLogRPS:                                   {Type: ValueTypeInt},
+	StickyTTL:                                                {Type: ValueTypeDuration, Filters: domainNameFilter},
+	DecisionHeartbeatTimeout:                                 {Type: ValueTypeDuration, Filters: domainNameFilter},
+	DecisionRetryCriticalAttempts:                            {Type: ValueTypeInt},
+	EnableDropStuckTaskByDomainID:                            {Type: ValueTypeBool, Filters: domainIDFilter},
+	EnableConsistentQuery:                                    {Type: ValueTypeBool},
+	EnableConsistentQueryByDomain:                            {Type: ValueTypeBool, Filters: domainNameFilter},
+	MaxBufferedQueryCount:                                    {Type: ValueTypeInt},
+	MutableStateChecksumGenProbability:                       {Type: ValueTypeInt, Filters: domainNameFilter},
+	MutableStateChecksumVerifyProbability:                    {Type: ValueTypeInt, Filters: domainNameFilter},
+	MutableStateChecksumInvalidateBefore:                     {Type: ValueTypeFloat},
+	MutableStateChecksumMismatchAction:                       {Type: ValueTypeString, Filters: domainNameFilter, AllowedValues: checksumMismatchActions},
+	ReplicationEventsFromCurrentCluster:                      {Type: ValueTypeBool, Filters: domainNameFilter},
+	NotifyFailoverMarkerInterval:                             {Type: ValueTypeDuration},
+	NotifyFailoverMarkerTimerJitterCoefficient:               {Type: ValueTypeFloat, Bounds: ratioBounds},
+	EnableActivityLocalDispatchByDomain:                      {Type: ValueTypeBool, Filters: domainNameFilter},
+	HistoryErrorInjectionRate:                                {Type: ValueTypeFloat, Bounds: ratioBounds},
+	HistoryEnableTaskInfoLogByDomainID:                       {Type: ValueTypeBool, Filters: domainIDFilter},
+	ActivityMaxScheduleToStartTimeoutForRetry:                {Type: ValueTypeDuration, Filters: domainNameFilter},
+	ReplicationTaskFetcherParallelism:                        {Type: ValueTypeInt},
+	ReplicationTaskFetcherAggregationInterval:                {Type: ValueTypeDuration},
+	ReplicationTaskFetcherTimerJitterCoefficient:             {Type: ValueTypeFloat, Bounds: ratioBounds},
+	ReplicationTaskFetcherErrorRetryWait:                     {Type: ValueTypeDuration},
+	ReplicationTaskFetcherServiceBusyWait:                    {Type: ValueTypeDuration},
+	ReplicationTaskProcessorErrorRetryWait:                   {Type: ValueTypeDuration, Filters: shardIDFilter},
+	ReplicationTaskProcessorErrorRetryMaxAttempts:            {Type: ValueTypeInt, Filters: shardIDFilter},
+	ReplicationTaskProcessorErrorSecondRetryWait:             {Type: ValueTypeDuration, Filters: shardIDFilter},
+	ReplicationTaskProcessorErrorSecondRetryMaxWait:          {Type: ValueTypeDuration, Filters: shardIDFilter},
+	ReplicationTaskProcessorErrorSecondRetryExpiration:       {Type: ValueTypeDuration, Filters: shardIDFilter},
+	ReplicationTaskProcessorNoTaskInitialWait:                {Type: ValueTypeDuration, Filters: shardIDFilter},
+	ReplicationTaskProcessorCleanupInterval:                  {Type: ValueTypeDuration, Filters: shardIDFilter},
+	ReplicationTaskProcessorCleanupJitterCoefficient:         {Type: ValueTypeFloat, Filters: shardIDFilter, Bounds: ratioBounds},
+	ReplicationTaskProcessorReadHistoryBatchSize:             {Type: ValueTypeInt},
+	ReplicationTaskProcessorStartWait:                        {Type: ValueTypeDuration, Filters: shardIDFilter},
+	ReplicationTaskProcessorStartWaitJitterCoefficient:       {Type: ValueTypeFloat, Filters: shardIDFilter, Bounds: ratioBounds},
+	ReplicationTaskProcessorHostQPS:                          {Type: ValueTypeFloat},
+	ReplicationTaskProcessorShardQPS:                         {Type: ValueTypeFloat},
+	ReplicationTaskGenerationQPS:                             {Type: ValueTypeFloat},
+	WorkerPersistenceMaxQPS:                                  {Type: ValueTypeInt},
+	WorkerPersistenceGlobalMaxQPS:                            {Type: ValueTypeInt},
+	WorkerReplicationTaskMaxRetryDuration:                    {Type: ValueTypeDuration},
+	WorkerIndexerConcurrency:                                 {Type: ValueTypeInt},
+	WorkerESProcessorNumOfWorkers:                            {Type: ValueTypeInt},
+	WorkerESProcessorBulkActions:                             {Type: ValueTypeInt},
+	WorkerESProcessorBulkSize:                                {Type: ValueTypeInt},
+	WorkerESProcessorFlushInterval:                           {Type: ValueTypeDuration},
+	WorkerArchiverConcurrency:                                {Type: ValueTypeInt},
+	WorkerArchivalsPerIteration:                              {Type: ValueTypeInt},
+	WorkerTimeLimitPerArchivalIteration:                      {Type: ValueTypeDuration},
+	WorkerThrottledLogRPS:                                    {Type: ValueTypeInt},
+	ScannerPersistenceMaxQPS:                                 {Type: ValueTypeInt},
+	ScannerGetOrphanTasksPageSize:                            {Type: ValueTypeInt},
+	ScannerBatchSizeForTasklistHandler:                       {Type: ValueTypeInt},
+	EnableCleaningOrphanTaskInTasklistScavenger:              {Type: ValueTypeBool},
+	ScannerMaxTasksProcessedPerTasklistJob:                   {Type: ValueTypeInt},
+	TaskListScannerEnabled:                                   {Type: ValueTypeBool},
+	HistoryScannerEnabled:                                    {Type: ValueTypeBool},
+	ConcreteExecutionsScannerEnabled:                         {Type: ValueTypeBool},
+	ConcreteExecutionsScannerConcurrency:                     {Type: ValueTypeInt},
+	ConcreteExecutionsScannerBlobstoreFlushThreshold:         {Type: ValueTypeInt},
+	ConcreteExecutionsScannerActivityBatchSize:               {Type: ValueTypeInt},
+	ConcreteExecutionsScannerPersistencePageSize:             {Type: ValueTypeInt},
+	ConcreteExecutionsScannerInvariantCollectionMutableState: {Type: ValueTypeBool},
+	ConcreteExecutionsScannerInvariantCollectionHistory:      {Type: ValueTypeBool},
+	CurrentExecutionsScannerEnabled:                          {Type: ValueTypeBool},
+	CurrentExecutionsScannerConcurrency:                      {Type: ValueTypeInt},
+	CurrentExecutionsScannerBlobstoreFlushThreshold:          {Type: ValueTypeInt},
+	CurrentExecutionsScannerActivityBatchSize:                {Type: ValueTypeInt},
+	CurrentExecutionsScannerPersistencePageSize:              {Type: ValueTypeInt},
+	CurrentExecutionsScannerInvariantCollectionHistory:       {Type: ValueTypeBool},
+	CurrentExecutionsScannerInvariantCollectionMutableState:  {Type: ValueTypeBool},
+	EnableBatcher:                                            {Type: ValueTypeBool},
+	EnableParentClosePolicyWorker:                            {Type: ValueTypeBool},
+	EnableStickyQuery:                                        {Type: ValueTypeBool, Filters: domainNameFilter},
+	EnableFailoverManager:                                    {Type: ValueTypeBool},
+	EnableWorkflowShadower:                                   {Type: ValueTypeBool},
+	ConcreteExecutionFixerDomainAllow:                        {Type: ValueTypeBool, Filters: domainNameFilter},
+	CurrentExecutionFixerDomainAllow:                         {Type: ValueTypeBool, Filters: domainNameFilter},
+	TimersScannerEnabled:                                     {Type: ValueTypeBool},
+	TimersFixerEnabled:                                       {Type: ValueTypeBool},
+	TimersScannerConcurrency:                                 {Type: ValueTypeInt},
+	TimersScannerPersistencePageSize:                         {Type: ValueTypeInt},
+	TimersScannerBlobstoreFlushThreshold:                     {Type: ValueTypeInt},
+	TimersScannerActivityBatchSize:                           {Type: ValueTypeInt},
+	TimersScannerPeriodStart:                                 {Type: ValueTypeInt},
+	TimersScannerPeriodEnd:                                   {Type: ValueTypeInt},
+	TimersFixerDomainAllow:                                   {Type: ValueTypeBool, Filters: domainNameFilter},
+	ConcreteExecutionFixerEnabled:                            {Type: ValueTypeBool},
+	CurrentExecutionFixerEnabled:                             {Type: ValueTypeBool},
+	EnableAuthorization:                                      {Type: ValueTypeBool, Filters: domainNameFilter},
+	EnableServiceAuthorization:                               {Type: ValueTypeBool},
+	VisibilityArchivalQueryMaxRangeInDays:                    {Type: ValueTypeInt},
+	VisibilityArchivalQueryMaxQPS:                            {Type: ValueTypeInt},
+	EnableArchivalCompression:                                {Type: ValueTypeBool, Filters: domainNameFilter},
+	WorkerDeterministicConstructionCheckProbability:          {Type: ValueTypeFloat, Filters: domainNameFilter, Bounds: ratioBounds},
+	WorkerBlobIntegrityCheckProbability:                      {Type: ValueTypeFloat, Filters: domainNameFilter, Bounds: ratioBounds},
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type schemaSuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestSchemaSuite(t *testing.T) {
+	s := new(schemaSuite)
+	suite.Run(t, s)
+}
+
+func (s *schemaSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *schemaSuite) TestAllKeysHaveSchema() {
+	for key := range keys {
+		if key <= testGetBoolPropertyFilteredByTaskListInfoKey {
+			continue
+		}
+		schema, ok := GetKeySchema(key)
+		s.True(ok, "key %v has no schema", key)
+		s.NotEqual(unknownValueType, schema.Type, "key %v has no value type", key)
+	}
+}
+
+func (s *schemaSuite) TestValidateValue() {
+	s.NoError(ValidateValue(FrontendRPS, 1200))
+	s.Error(ValidateValue(FrontendRPS, "1200"))
+	s.Error(ValidateValue(FrontendRPS, 1.5))
+	s.Error(ValidateValue(FrontendRPS, -1))
+
+	s.NoError(ValidateValue(PersistenceErrorInjectionRate, 0.5))
+	s.NoError(ValidateValue(PersistenceErrorInjectionRate, 1))
+	s.Error(ValidateValue(PersistenceErrorInjectionRate, 1.5))
+
+	s.NoError(ValidateValue(MatchingNumTasklistReadPartitions, 4))
+	s.Error(ValidateValue(MatchingNumTasklistReadPartitions, 0))
+
+	s.NoError(ValidateValue(MatchingLongPollExpirationInterval, "10s"))
+	s.Error(ValidateValue(MatchingLongPollExpirationInterval, 10))
+	s.Error(ValidateValue(MatchingLongPollExpirationInterval, "10 seconds"))
+	s.Error(ValidateValue(MatchingLongPollExpirationInterval, "-10s"))
+
+	s.NoError(ValidateValue(EnableGlobalDomain, true))
+	s.Error(ValidateValue(EnableGlobalDomain, "true"))
+
+	s.NoError(ValidateValue(HistoryArchivalStatus, "enabled"))
+	s.Error(ValidateValue(HistoryArchivalStatus, "on"))
+
+	s.NoError(ValidateValue(ValidSearchAttributes, map[string]interface{}{"CustomKeywordField": 1}))
+	s.Error(ValidateValue(ValidSearchAttributes, []interface{}{"CustomKeywordField"}))
+
+	// keys without schema are not validated
+	s.NoError(ValidateValue(testGetIntPropertyKey, "not an int"))
+}
+
+func (s *schemaSuite) TestValidateConstraints() {
+	s.NoError(ValidateConstraints(FrontendRPS, nil))
+	s.NoError(ValidateConstraints(FrontendRPS, map[string]interface{}{"clusterName": "active"}))
+	s.Error(ValidateConstraints(FrontendRPS, map[string]interface{}{"domainName": "some-domain"}))
+	s.Error(ValidateConstraints(FrontendRPS, map[string]interface{}{"unknown": "some-domain"}))
+
+	s.NoError(ValidateConstraints(MatchingNumTasklistReadPartitions, map[string]interface{}{
+		"domainName":   "some-domain",
+		"taskListName": "some-task-list",
+		"taskType":     0,
+	}))
+	s.Error(ValidateConstraints(MatchingNumTasklistReadPartitions, map[string]interface{}{"taskType": "0"}))
+	s.Error(ValidateConstraints(ReplicatorTaskBatchSize, map[string]interface{}{"shardID": "1"}))
+}
+
+func (s *schemaSuite) TestLintConfigFile() {
+	issues, err := LintConfigFile([]byte(`
+frontend.rps:
+- value: 1200
+- value: 100
+  constraints:
+    domainName: some-domain
+frontend.rsp:
+- value: 1200
+matching.numTasklistReadPartitions:
+- value: "4"
+`))
+	s.NoError(err)
+	s.Len(issues, 3)
+	s.Equal("frontend.rps", issues[0].KeyName)
+	s.False(issues[0].Ignored)
+	s.Equal("frontend.rsp", issues[1].KeyName)
+	s.False(issues[1].Ignored)
+	s.Equal("matching.numTasklistReadPartitions", issues[2].KeyName)
+	s.True(issues[2].Ignored)
+
+	_, err = LintConfigFile([]byte("frontend.rps: 1200"))
+	s.Error(err)
+}
+
+func (s *schemaSuite) TestLintSampleConfigFiles() {
+	files, err := filepath.Glob("../../config/dynamicconfig/*.yaml")
+	s.NoError(err)
+	s.NotEmpty(files)
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		s.NoError(err)
+		issues, err := LintConfigFile(content)
+		s.NoError(err)
+		s.Empty(issues, "dynamic config file %v is invalid", file)
+	}
+}
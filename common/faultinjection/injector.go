@@ -0,0 +1,254 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjection
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	msgInjectedFault = "Injected fault"
+)
+
+type (
+	// Request describes a call faults can be injected into
+	Request struct {
+		Operation string
+		DomainID  string
+		Domain    string
+		ShardID   *int
+
+		operationRequest interface{}
+	}
+
+	// Injector injects faults into calls according to the rules of its layer
+	Injector interface {
+		// Inject applies the first rule matching the request around the call
+		Inject(ctx context.Context, request *Request, call func(ctx context.Context) error) error
+	}
+
+	injectorImpl struct {
+		layer        string
+		rules        RulesProvider
+		timeoutError error
+		logger       log.Logger
+	}
+)
+
+var _ Injector = (*injectorImpl)(nil)
+
+// NewInjector creates an injector for the given layer,
+// timeoutError is the error the layer returns when a call times out
+func NewInjector(
+	layer string,
+	rules RulesProvider,
+	timeoutError error,
+	logger log.Logger,
+) Injector {
+	return &injectorImpl{
+		layer:        layer,
+		rules:        rules,
+		timeoutError: timeoutError,
+		logger:       logger.WithTags(tag.FaultInjectionLayer(layer)),
+	}
+}
+
+// NewRequest creates a request for the given operation, the domain and shard are extracted from the
+// DomainID, DomainUUID, Domain and ShardID fields of the operation request, or from the execution info of the
+// workflow snapshot or mutation it carries, only once rules are in effect
+func NewRequest(
+	operation string,
+	operationRequest interface{},
+) *Request {
+	return &Request{
+		Operation:        operation,
+		operationRequest: operationRequest,
+	}
+}
+
+// NewShardRequest creates a request for the given operation made on behalf of the shard
+func NewShardRequest(
+	operation string,
+	shardID int,
+	operationRequest interface{},
+) *Request {
+	request := NewRequest(operation, operationRequest)
+	request.ShardID = &shardID
+	return request
+}
+
+func (i *injectorImpl) Inject(
+	ctx context.Context,
+	request *Request,
+	call func(ctx context.Context) error,
+) error {
+	rules := i.rules()
+	if len(rules) == 0 {
+		return call(ctx)
+	}
+	request.resolve()
+	rule := i.match(rules, request)
+	if rule == nil {
+		return call(ctx)
+	}
+
+	i.logger.Warn(msgInjectedFault,
+		tag.FaultInjectionRule(rule.Name),
+		tag.FaultInjectionFault(string(rule.Fault)),
+		tag.FaultInjectionOperation(request.Operation),
+		tag.WorkflowDomainID(request.DomainID),
+	)
+	switch rule.Fault {
+	case FaultError:
+		return i.generateError(rule)
+	case FaultLatency:
+		if err := sleep(ctx, rule.Latency); err != nil {
+			return err
+		}
+		return call(ctx)
+	case FaultTimeout:
+		if rule.Forward {
+			_ = call(ctx)
+		}
+		wait := rule.Latency
+		if deadline, ok := ctx.Deadline(); ok && (wait == 0 || time.Until(deadline) < wait) {
+			wait = time.Until(deadline)
+		}
+		_ = sleep(ctx, wait)
+		return i.timeoutError
+	case FaultReportFailure:
+		if err := call(ctx); err != nil {
+			return err
+		}
+		return i.generateError(rule)
+	default:
+		return call(ctx)
+	}
+}
+
+func (i *injectorImpl) match(rules []*Rule, request *Request) *Rule {
+	for _, rule := range rules {
+		if rule.Layer != "" && rule.Layer != i.layer {
+			continue
+		}
+		if rule.Operation != "" && rule.Operation != request.Operation {
+			continue
+		}
+		if rule.DomainID != "" && rule.DomainID != request.DomainID {
+			continue
+		}
+		if rule.Domain != "" && rule.Domain != request.Domain {
+			continue
+		}
+		if rule.ShardID != nil && (request.ShardID == nil || *rule.ShardID != *request.ShardID) {
+			continue
+		}
+		if rule.Rate > 0 && rand.Float64() >= rule.Rate {
+			continue
+		}
+		return rule
+	}
+	return nil
+}
+
+// resolve fills the domain and shard of the request from the operation request,
+// it is deferred until rules are in effect as it relies on reflection
+func (r *Request) resolve() {
+	value := reflect.ValueOf(r.operationRequest)
+	r.operationRequest = nil
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return
+	}
+	value = value.Elem()
+	if field := value.FieldByName("DomainID"); field.IsValid() && field.Kind() == reflect.String {
+		r.DomainID = field.String()
+	}
+	if field := value.FieldByName("DomainUUID"); field.IsValid() && field.Kind() == reflect.String {
+		r.DomainID = field.String()
+	}
+	if field := value.FieldByName("Domain"); field.IsValid() && field.Kind() == reflect.String {
+		r.Domain = field.String()
+	}
+	if r.DomainID == "" {
+		r.DomainID = executionInfoDomainID(value)
+	}
+	if field := reflect.Indirect(value.FieldByName("ShardID")); r.ShardID == nil && field.IsValid() {
+		switch field.Kind() {
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			shardID := int(field.Int())
+			r.ShardID = &shardID
+		}
+	}
+}
+
+// executionInfoDomainID returns the domain ID of the workflow written by
+// create, update and conflict resolve workflow execution requests
+func executionInfoDomainID(value reflect.Value) string {
+	for _, name := range []string{"UpdateWorkflowMutation", "ResetWorkflowSnapshot", "NewWorkflowSnapshot"} {
+		workflow := reflect.Indirect(value.FieldByName(name))
+		if !workflow.IsValid() || workflow.Kind() != reflect.Struct {
+			continue
+		}
+		info := reflect.Indirect(workflow.FieldByName("ExecutionInfo"))
+		if !info.IsValid() || info.Kind() != reflect.Struct {
+			continue
+		}
+		if field := info.FieldByName("DomainID"); field.IsValid() && field.Kind() == reflect.String {
+			return field.String()
+		}
+	}
+	return ""
+}
+
+func (i *injectorImpl) generateError(rule *Rule) error {
+	switch rule.Error {
+	case ErrorInternal:
+		return errors.ErrFakeInternalService
+	case ErrorTimeout:
+		return i.timeoutError
+	case ErrorUnhandled:
+		return errors.ErrFakeUnhandled
+	default:
+		return errors.ErrFakeServiceBusy
+	}
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
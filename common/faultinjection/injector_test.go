@@ -0,0 +1,228 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjection
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	injectorSuite struct {
+		suite.Suite
+
+		rules    []*Rule
+		injector Injector
+		calls    int
+	}
+)
+
+var errTestTimeout = &types.InternalServiceError{Message: "test timeout"}
+
+func TestInjectorSuite(t *testing.T) {
+	s := new(injectorSuite)
+	suite.Run(t, s)
+}
+
+func (s *injectorSuite) SetupTest() {
+	s.rules = nil
+	s.calls = 0
+	s.injector = NewInjector(
+		LayerPersistence,
+		func() []*Rule { return s.rules },
+		errTestTimeout,
+		loggerimpl.NewNopLogger(),
+	)
+}
+
+func (s *injectorSuite) TestNoRules() {
+	s.NoError(s.inject(context.Background(), NewRequest("GetShard", nil)))
+	s.Equal(1, s.calls)
+}
+
+func (s *injectorSuite) TestError() {
+	s.rules = []*Rule{{Fault: FaultError, Error: ErrorInternal}}
+	s.Equal(errors.ErrFakeInternalService, s.inject(context.Background(), NewRequest("GetShard", nil)))
+	s.Equal(0, s.calls)
+}
+
+func (s *injectorSuite) TestReportFailure() {
+	s.rules = []*Rule{{Fault: FaultReportFailure}}
+	s.Equal(errors.ErrFakeServiceBusy, s.inject(context.Background(), NewRequest("GetShard", nil)))
+	s.Equal(1, s.calls)
+}
+
+func (s *injectorSuite) TestLatency() {
+	s.rules = []*Rule{{Fault: FaultLatency, Latency: 20 * time.Millisecond}}
+	startTime := time.Now()
+	s.NoError(s.inject(context.Background(), NewRequest("GetShard", nil)))
+	s.True(time.Since(startTime) >= 20*time.Millisecond)
+	s.Equal(1, s.calls)
+}
+
+func (s *injectorSuite) TestTimeout() {
+	s.rules = []*Rule{{Fault: FaultTimeout, Forward: true}}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	s.Equal(errTestTimeout, s.inject(ctx, NewRequest("GetShard", nil)))
+	s.Equal(1, s.calls)
+
+	// without a deadline the call fails right away
+	s.rules = []*Rule{{Fault: FaultTimeout}}
+	s.Equal(errTestTimeout, s.inject(context.Background(), NewRequest("GetShard", nil)))
+	s.Equal(1, s.calls)
+}
+
+func (s *injectorSuite) TestMatching() {
+	shardID := 3
+	s.rules = []*Rule{
+		{Layer: LayerHistory, Fault: FaultError},
+		{Operation: "UpdateShard", Fault: FaultError},
+		{DomainID: "some-domain-id", Fault: FaultError},
+		{ShardID: common.IntPtr(shardID), Fault: FaultError},
+	}
+
+	s.NoError(s.inject(context.Background(), NewRequest("GetShard", nil)))
+	s.Error(s.inject(context.Background(), NewRequest("UpdateShard", nil)))
+	s.Error(s.inject(context.Background(), NewShardRequest("GetWorkflowExecution", shardID, nil)))
+	s.NoError(s.inject(context.Background(), NewShardRequest("GetWorkflowExecution", shardID+1, nil)))
+	s.Error(s.inject(context.Background(), NewRequest("AddActivityTask", &types.AddActivityTaskRequest{DomainUUID: "some-domain-id"})))
+}
+
+func (s *injectorSuite) TestMatching_RateMiss() {
+	s.rules = []*Rule{
+		{Rate: 1e-12, Fault: FaultError, Error: ErrorInternal},
+		{Fault: FaultError, Error: ErrorUnhandled},
+	}
+	s.Equal(errors.ErrFakeUnhandled, s.inject(context.Background(), NewRequest("GetShard", nil)))
+}
+
+func (s *injectorSuite) TestMatching_WorkflowDomain() {
+	type executionInfo struct {
+		DomainID string
+	}
+	type workflow struct {
+		ExecutionInfo *executionInfo
+	}
+	s.rules = []*Rule{{DomainID: "some-domain-id", Fault: FaultError}}
+
+	s.Error(s.inject(context.Background(), NewRequest("CreateWorkflowExecution", &struct {
+		NewWorkflowSnapshot workflow
+	}{NewWorkflowSnapshot: workflow{ExecutionInfo: &executionInfo{DomainID: "some-domain-id"}}})))
+	s.Error(s.inject(context.Background(), NewRequest("UpdateWorkflowExecution", &struct {
+		UpdateWorkflowMutation workflow
+		NewWorkflowSnapshot    *workflow
+	}{UpdateWorkflowMutation: workflow{ExecutionInfo: &executionInfo{DomainID: "some-domain-id"}}})))
+	s.Error(s.inject(context.Background(), NewRequest("ConflictResolveWorkflowExecution", &struct {
+		ResetWorkflowSnapshot workflow
+	}{ResetWorkflowSnapshot: workflow{ExecutionInfo: &executionInfo{DomainID: "some-domain-id"}}})))
+	s.NoError(s.inject(context.Background(), NewRequest("UpdateWorkflowExecution", &struct {
+		UpdateWorkflowMutation workflow
+	}{UpdateWorkflowMutation: workflow{ExecutionInfo: &executionInfo{DomainID: "other-domain-id"}}})))
+}
+
+func (s *injectorSuite) TestDynamicConfigRulesProvider() {
+	scenario := &Config{
+		Rules: []*Rule{
+			{Name: "slow shard", Layer: LayerPersistence, ShardID: common.IntPtr(1), Fault: FaultLatency, Latency: time.Second},
+		},
+	}
+	value, err := scenario.ToMap()
+	s.NoError(err)
+
+	enabled := false
+	provider := NewDynamicConfigRulesProvider(
+		func(...dynamicconfig.FilterOption) bool { return enabled },
+		func(...dynamicconfig.FilterOption) map[string]interface{} { return value },
+		func(...dynamicconfig.FilterOption) string { return "" },
+		loggerimpl.NewNopLogger(),
+	)
+	s.Nil(provider())
+
+	enabled = true
+	s.Equal(scenario.Rules, provider())
+
+	value = map[string]interface{}{"rules": []interface{}{map[string]interface{}{"fault": "unknown"}}}
+	s.Nil(provider())
+}
+
+func (s *injectorSuite) TestFileRulesProvider() {
+	dir, err := ioutil.TempDir("", "faultinjection")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "scenario.yaml")
+	s.NoError(ioutil.WriteFile(path, []byte(`
+rules:
+  - name: slow shard
+    layer: persistence
+    shardID: 1
+    fault: latency
+    latency: 1s
+  - name: busy history
+    layer: history
+    operation: RecordActivityTaskStarted
+    rate: 0.5
+    fault: error
+    error: serviceBusy
+`), 0644))
+
+	scenario, err := LoadFile(path)
+	s.NoError(err)
+	s.Equal([]*Rule{
+		{Name: "slow shard", Layer: LayerPersistence, ShardID: common.IntPtr(1), Fault: FaultLatency, Latency: time.Second},
+		{Name: "busy history", Layer: LayerHistory, Operation: "RecordActivityTaskStarted", Rate: 0.5, Fault: FaultError, Error: ErrorServiceBusy},
+	}, scenario.Rules)
+	s.Equal(scenario.Rules, NewFileRulesProvider(path, loggerimpl.NewNopLogger())())
+
+	// the scenario file takes precedence over the rules in dynamic config
+	provider := NewDynamicConfigRulesProvider(
+		func(...dynamicconfig.FilterOption) bool { return true },
+		func(...dynamicconfig.FilterOption) map[string]interface{} { return nil },
+		func(...dynamicconfig.FilterOption) string { return path },
+		loggerimpl.NewNopLogger(),
+	)
+	s.Equal(scenario.Rules, provider())
+
+	s.Nil(NewFileRulesProvider(filepath.Join(dir, "missing.yaml"), loggerimpl.NewNopLogger())())
+	s.NoError(ioutil.WriteFile(path, []byte("rules:\n  - fault: unknown\n"), 0644))
+	_, err = LoadFile(path)
+	s.Error(err)
+	s.Nil(NewFileRulesProvider(path, loggerimpl.NewNopLogger())())
+}
+
+func (s *injectorSuite) inject(ctx context.Context, request *Request) error {
+	return s.injector.Inject(ctx, request, func(ctx context.Context) error {
+		s.calls++
+		return nil
+	})
+}
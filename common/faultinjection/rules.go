@@ -0,0 +1,290 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package faultinjection
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	// LayerPersistence targets calls made to the persistence layer
+	LayerPersistence = "persistence"
+	// LayerHistory targets RPC calls made through the history client
+	LayerHistory = "history"
+	// LayerMatching targets RPC calls made through the matching client
+	LayerMatching = "matching"
+)
+
+const (
+	// FaultError fails the call without forwarding it
+	FaultError FaultType = "error"
+	// FaultLatency delays the call before forwarding it
+	FaultLatency FaultType = "latency"
+	// FaultTimeout holds the call until the context deadline (or the rule latency) and fails it with a timeout,
+	// the call is forwarded only if the rule sets forward
+	FaultTimeout FaultType = "timeout"
+	// FaultReportFailure forwards the call and fails it even if it succeeded
+	FaultReportFailure FaultType = "reportFailure"
+)
+
+// fileCheckInterval is how often a scenario file is checked for changes, rules are evaluated on every call
+// so the file is not checked each time
+const fileCheckInterval = time.Second
+
+const (
+	// ErrorServiceBusy injects a service busy error
+	ErrorServiceBusy ErrorType = "serviceBusy"
+	// ErrorInternal injects an internal service error
+	ErrorInternal ErrorType = "internal"
+	// ErrorTimeout injects the timeout error of the layer
+	ErrorTimeout ErrorType = "timeout"
+	// ErrorUnhandled injects an error unknown to the caller
+	ErrorUnhandled ErrorType = "unhandled"
+)
+
+type (
+	// FaultType is the kind of fault injected by a rule
+	FaultType string
+
+	// ErrorType is the kind of error injected by a rule
+	ErrorType string
+
+	// Config is a fault injection scenario
+	Config struct {
+		// Rules are evaluated in order, the first rule matching a call decides the fault injected
+		Rules []*Rule `yaml:"rules"`
+	}
+
+	// Rule describes which calls to inject a fault into and what the fault is
+	Rule struct {
+		// Name identifies the rule in logs
+		Name string `yaml:"name"`
+		// Layer is one of persistence, history and matching, empty matches all layers
+		Layer string `yaml:"layer"`
+		// Operation is the persistence or RPC method name, empty matches all operations
+		Operation string `yaml:"operation"`
+		// DomainID matches calls whose request carries the domain ID, empty matches all domains
+		DomainID string `yaml:"domainID"`
+		// Domain matches calls whose request carries the domain name, empty matches all domains
+		Domain string `yaml:"domain"`
+		// ShardID matches calls made for the shard, nil matches all shards
+		ShardID *int `yaml:"shardID"`
+		// Rate is the probability a matching call is affected, 0 means always,
+		// calls left unaffected are evaluated against the following rules
+		Rate float64 `yaml:"rate"`
+		// Fault is the fault injected
+		Fault FaultType `yaml:"fault"`
+		// Error is the error injected by error, timeout and reportFailure faults, defaults to serviceBusy
+		Error ErrorType `yaml:"error"`
+		// Latency is the delay added by latency faults, and the max wait of timeout faults
+		Latency time.Duration `yaml:"latency"`
+		// Forward controls whether a call failed by a timeout fault still reaches the target
+		Forward bool `yaml:"forward"`
+	}
+
+	// RulesProvider returns the fault injection rules currently in effect
+	RulesProvider func() []*Rule
+)
+
+// NewStaticRulesProvider returns a RulesProvider which always returns the rules of the given scenario
+func NewStaticRulesProvider(config *Config) RulesProvider {
+	return func() []*Rule {
+		return config.Rules
+	}
+}
+
+// NewFileRulesProvider returns a RulesProvider which reads the scenario from a YAML file, the file is read
+// again when it is modified so the scenario can be changed while the service is running. No rules are
+// returned while the file is missing, and the last valid scenario is kept if the file cannot be parsed.
+func NewFileRulesProvider(
+	path string,
+	logger log.Logger,
+) RulesProvider {
+	var (
+		lock      sync.Mutex
+		checkTime time.Time
+		modTime   time.Time
+		lastRules []*Rule
+	)
+	return func() []*Rule {
+		lock.Lock()
+		defer lock.Unlock()
+
+		now := time.Now()
+		if now.Before(checkTime.Add(fileCheckInterval)) {
+			return lastRules
+		}
+		checkTime = now
+		info, err := os.Stat(path)
+		if err != nil {
+			if !modTime.IsZero() {
+				logger.Error("Failed to read fault injection scenario file.", tag.Value(path), tag.Error(err))
+			}
+			modTime, lastRules = time.Time{}, nil
+			return nil
+		}
+		if info.ModTime().Equal(modTime) {
+			return lastRules
+		}
+		modTime = info.ModTime()
+		config, err := LoadFile(path)
+		if err != nil {
+			logger.Error("Failed to parse fault injection scenario file.", tag.Value(path), tag.Error(err))
+			return lastRules
+		}
+		lastRules = config.Rules
+		return lastRules
+	}
+}
+
+// NewDynamicConfigRulesProvider returns a RulesProvider which reads the scenario from dynamic config,
+// or from the YAML file set by rulesFile if it is not empty. No rules are returned unless fault injection
+// is enabled.
+func NewDynamicConfigRulesProvider(
+	enabled dynamicconfig.BoolPropertyFn,
+	rules dynamicconfig.MapPropertyFn,
+	rulesFile dynamicconfig.StringPropertyFn,
+	logger log.Logger,
+) RulesProvider {
+	var (
+		lock         sync.Mutex
+		lastValue    map[string]interface{}
+		lastRules    []*Rule
+		initialized  bool
+		lastFile     string
+		fileProvider RulesProvider
+	)
+	return func() []*Rule {
+		if !enabled() {
+			return nil
+		}
+		if path := rulesFile(); path != "" {
+			lock.Lock()
+			if fileProvider == nil || path != lastFile {
+				lastFile, fileProvider = path, NewFileRulesProvider(path, logger)
+			}
+			provider := fileProvider
+			lock.Unlock()
+			return provider()
+		}
+		value := rules()
+
+		lock.Lock()
+		defer lock.Unlock()
+		// parsing is only done when the scenario changes as rules are evaluated on every call
+		if initialized && reflect.DeepEqual(value, lastValue) {
+			return lastRules
+		}
+		lastValue, lastRules, initialized = value, nil, true
+		if len(value) == 0 {
+			return nil
+		}
+		config, err := FromMap(value)
+		if err != nil {
+			logger.Error("Failed to parse fault injection rules from dynamic config.", tag.Error(err))
+			return nil
+		}
+		lastRules = config.Rules
+		return lastRules
+	}
+}
+
+// LoadFile reads a scenario from a YAML file
+func LoadFile(path string) (*Config, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parse(bytes)
+}
+
+// FromMap converts a dynamic config map value to a scenario
+func FromMap(value map[string]interface{}) (*Config, error) {
+	bytes, err := yaml.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return parse(bytes)
+}
+
+func parse(bytes []byte) (*Config, error) {
+	var config Config
+	if err := yaml.UnmarshalStrict(bytes, &config); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// ToMap converts a scenario to a value which can be set as dynamic config
+func (c *Config) ToMap() (map[string]interface{}, error) {
+	bytes, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var value map[string]interface{}
+	if err := yaml.Unmarshal(bytes, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Validate checks the rules of the scenario are well formed
+func (c *Config) Validate() error {
+	for i, rule := range c.Rules {
+		switch rule.Layer {
+		case "", LayerPersistence, LayerHistory, LayerMatching:
+		default:
+			return fmt.Errorf("rule %v: unknown layer %q", i, rule.Layer)
+		}
+		switch rule.Fault {
+		case FaultError, FaultTimeout, FaultReportFailure:
+		case FaultLatency:
+			if rule.Latency <= 0 {
+				return fmt.Errorf("rule %v: latency fault requires a positive latency", i)
+			}
+		default:
+			return fmt.Errorf("rule %v: unknown fault %q", i, rule.Fault)
+		}
+		switch rule.Error {
+		case "", ErrorServiceBusy, ErrorInternal, ErrorTimeout, ErrorUnhandled:
+		default:
+			return fmt.Errorf("rule %v: unknown error %q", i, rule.Error)
+		}
+		if rule.Rate < 0 || rule.Rate > 1 {
+			return fmt.Errorf("rule %v: rate must be between 0 and 1", i)
+		}
+	}
+	return nil
+}
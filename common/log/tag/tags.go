@@ -373,6 +373,36 @@ func ValueType(v interface{}) Tag {
 	return newStringTag("value-type", fmt.Sprintf("%T", v))
 }
 
+// DynamicConfigFilters returns tag for DynamicConfigFilters
+func DynamicConfigFilters(filters map[string]interface{}) Tag {
+	return newObjectTag("dynamic-config-filters", filters)
+}
+
+// DynamicConfigAuthor returns tag for DynamicConfigAuthor
+func DynamicConfigAuthor(author string) Tag {
+	return newStringTag("dynamic-config-author", author)
+}
+
+// Caller returns tag for Caller
+func Caller(caller string) Tag {
+	return newStringTag("caller", caller)
+}
+
+// NumReadPartitions returns tag for NumReadPartitions
+func NumReadPartitions(n int) Tag {
+	return newInt("num-read-partitions", n)
+}
+
+// NumWritePartitions returns tag for NumWritePartitions
+func NumWritePartitions(n int) Tag {
+	return newInt("num-write-partitions", n)
+}
+
+// Reason returns tag for Reason
+func Reason(reason string) Tag {
+	return newStringTag("reason", reason)
+}
+
 // DefaultValue returns tag for DefaultValue
 func DefaultValue(v interface{}) Tag {
 	return newObjectTag("default-value", v)
@@ -398,6 +428,41 @@ func StoreType(storeType string) Tag {
 	return newPredefinedStringTag("store-type", storeType)
 }
 
+// StoreOperationClass returns tag for StoreOperationClass
+func StoreOperationClass(operationClass string) Tag {
+	return newStringTag("store-operation-class", operationClass)
+}
+
+// CircuitBreakerState returns tag for CircuitBreakerState
+func CircuitBreakerState(state string) Tag {
+	return newStringTag("circuit-breaker-state", state)
+}
+
+// PrevCircuitBreakerState returns tag for PrevCircuitBreakerState
+func PrevCircuitBreakerState(state string) Tag {
+	return newStringTag("prev-circuit-breaker-state", state)
+}
+
+// FaultInjectionLayer returns tag for FaultInjectionLayer
+func FaultInjectionLayer(layer string) Tag {
+	return newStringTag("fault-injection-layer", layer)
+}
+
+// FaultInjectionRule returns tag for FaultInjectionRule
+func FaultInjectionRule(rule string) Tag {
+	return newStringTag("fault-injection-rule", rule)
+}
+
+// FaultInjectionFault returns tag for FaultInjectionFault
+func FaultInjectionFault(fault string) Tag {
+	return newStringTag("fault-injection-fault", fault)
+}
+
+// FaultInjectionOperation returns tag for FaultInjectionOperation
+func FaultInjectionOperation(operation string) Tag {
+	return newStringTag("fault-injection-operation", operation)
+}
+
 // StoreError returns tag for StoreError
 func StoreError(storeErr error) Tag {
 	return newErrorTag("store-error", storeErr)
@@ -455,6 +520,16 @@ func PreviousShardRangeID(id int64) Tag {
 	return newInt64("previous-shard-range-id", id)
 }
 
+// PreviousShardOwner returns tag for PreviousShardOwner
+func PreviousShardOwner(owner string) Tag {
+	return newStringTag("previous-shard-owner", owner)
+}
+
+// ShardOwner returns tag for ShardOwner
+func ShardOwner(owner string) Tag {
+	return newStringTag("shard-owner", owner)
+}
+
 // ShardRangeID returns tag for ShardRangeID
 func ShardRangeID(id int64) Tag {
 	return newInt64("shard-range-id", id)
@@ -36,6 +36,7 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionUpsertWorkflowMemo             = workflowAction("add-workflow-upsert-memo-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -111,6 +112,7 @@ var (
 	ComponentShardItem                  = component("shard-item")
 	ComponentShardEngine                = component("shard-engine")
 	ComponentMatchingEngine             = component("matching-engine")
+	ComponentPartitionScaler            = component("partition-scaler")
 	ComponentReplicator                 = component("replicator")
 	ComponentReplicationTaskProcessor   = component("replication-task-processor")
 	ComponentReplicationAckManager      = component("replication-ack-manager")
@@ -288,6 +290,22 @@ var (
 	AdminClientOperationRefreshWorkflowTasks             = clientOperation("admin-refresh-wf-tasks")
 	AdminClientOperationResendReplicationTasks           = clientOperation("admin-resend-replication-tasks")
 	AdminClientOperationGetCrossClusterTasks             = clientOperation("admin-get-cross-cluster-tasks")
+	AdminClientOperationGetDynamicConfig                 = clientOperation("admin-get-dynamic-config")
+	AdminClientOperationUpdateDynamicConfig              = clientOperation("admin-update-dynamic-config")
+	AdminClientOperationRestoreDynamicConfig             = clientOperation("admin-restore-dynamic-config")
+	AdminClientOperationListDynamicConfig                = clientOperation("admin-list-dynamic-config")
+	AdminClientOperationPauseTaskList                    = clientOperation("admin-pause-task-list")
+	AdminClientOperationResumeTaskList                   = clientOperation("admin-resume-task-list")
+	AdminClientOperationMigrateTaskList                  = clientOperation("admin-migrate-task-list")
+	AdminClientOperationReadTaskListDLQ                  = clientOperation("admin-read-task-list-dlq")
+	AdminClientOperationDescribeTaskListDLQEntry         = clientOperation("admin-describe-task-list-dlq-entry")
+	AdminClientOperationRedriveTaskListDLQEntry          = clientOperation("admin-redrive-task-list-dlq-entry")
+	AdminClientOperationFailTaskListDLQEntry             = clientOperation("admin-fail-task-list-dlq-entry")
+	AdminClientOperationMoveShard                        = clientOperation("admin-move-shard")
+	AdminClientOperationUnpinShard                       = clientOperation("admin-unpin-shard")
+	AdminClientOperationListQuarantinedWorkflows         = clientOperation("admin-list-quarantined-workflows")
+	AdminClientOperationReleaseQuarantinedWorkflow       = clientOperation("admin-release-quarantined-workflow")
+	AdminClientOperationCheckWorkflowBuild               = clientOperation("admin-check-workflow-build")
 
 	FrontendClientOperationDeprecateDomain                  = clientOperation("frontend-deprecate-domain")
 	FrontendClientOperationDescribeDomain                   = clientOperation("frontend-describe-domain")
@@ -324,10 +342,13 @@ var (
 	FrontendClientOperationSignalWorkflowExecution          = clientOperation("frontend-signal-wf-execution")
 	FrontendClientOperationStartWorkflowExecution           = clientOperation("frontend-start-wf-execution")
 	FrontendClientOperationTerminateWorkflowExecution       = clientOperation("frontend-terminate-wf-execution")
+	FrontendClientOperationExpediteWorkflowExecutionStart   = clientOperation("frontend-expedite-wf-execution-start")
 	FrontendClientOperationUpdateDomain                     = clientOperation("frontend-update-domain")
 	FrontendClientOperationGetClusterInfo                   = clientOperation("frontend-get-cluster-info")
 	FrontendClientOperationListTaskListPartitions           = clientOperation("frontend-list-task-list-partitions")
 	FrontendClientOperationGetTaskListsByDomain             = clientOperation("frontend-get-task-list-for-domain")
+	FrontendClientOperationUpdateWorkerBuildIDCompatibility = clientOperation("frontend-update-worker-build-id-compatibility")
+	FrontendClientOperationGetWorkerBuildIDCompatibility    = clientOperation("frontend-get-worker-build-id-compatibility")
 
 	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
 	HistoryClientOperationDescribeHistoryHost               = clientOperation("history-describe-history-host")
@@ -353,6 +374,7 @@ var (
 	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
 	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
 	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
+	HistoryClientOperationExpediteWorkflowExecutionStart    = clientOperation("history-expedite-wf-execution-start")
 	HistoryClientOperationResetWorkflowExecution            = clientOperation("history-reset-wf-execution")
 	HistoryClientOperationScheduleDecisionTask              = clientOperation("history-schedule-decision-task")
 	HistoryClientOperationRecordChildExecutionCompleted     = clientOperation("history-record-child-execution-completed")
@@ -371,14 +393,18 @@ var (
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
 
-	MatchingClientOperationAddActivityTask        = clientOperation("matching-add-activity-task")
-	MatchingClientOperationAddDecisionTask        = clientOperation("matching-add-decision-task")
-	MatchingClientOperationPollForActivityTask    = clientOperation("matching-poll-for-activity-task")
-	MatchingClientOperationPollForDecisionTask    = clientOperation("matching-poll-for-decision-task")
-	MatchingClientOperationQueryWorkflow          = clientOperation("matching-query-wf")
-	MatchingClientOperationQueryTaskCompleted     = clientOperation("matching-query-task-completed")
-	MatchingClientOperationCancelOutstandingPoll  = clientOperation("matching-cancel-outstanding-poll")
-	MatchingClientOperationDescribeTaskList       = clientOperation("matching-describe-task-list")
-	MatchingClientOperationListTaskListPartitions = clientOperation("matching-list-task-list-partitions")
-	MatchingClientOperationGetTaskListsByDomain   = clientOperation("get-task-list-for-domain")
+	MatchingClientOperationAddActivityTask                  = clientOperation("matching-add-activity-task")
+	MatchingClientOperationAddDecisionTask                  = clientOperation("matching-add-decision-task")
+	MatchingClientOperationPollForActivityTask              = clientOperation("matching-poll-for-activity-task")
+	MatchingClientOperationPollForDecisionTask              = clientOperation("matching-poll-for-decision-task")
+	MatchingClientOperationQueryWorkflow                    = clientOperation("matching-query-wf")
+	MatchingClientOperationQueryTaskCompleted               = clientOperation("matching-query-task-completed")
+	MatchingClientOperationCancelOutstandingPoll            = clientOperation("matching-cancel-outstanding-poll")
+	MatchingClientOperationDescribeTaskList                 = clientOperation("matching-describe-task-list")
+	MatchingClientOperationListTaskListPartitions           = clientOperation("matching-list-task-list-partitions")
+	MatchingClientOperationGetTaskListsByDomain             = clientOperation("get-task-list-for-domain")
+	MatchingClientOperationUpdateWorkerBuildIDCompatibility = clientOperation("matching-update-worker-build-id-compatibility")
+	MatchingClientOperationGetWorkerBuildIDCompatibility    = clientOperation("matching-get-worker-build-id-compatibility")
+	MatchingClientOperationUpdateTaskListState              = clientOperation("matching-update-task-list-state")
+	MatchingClientOperationGetTaskListState                 = clientOperation("matching-get-task-list-state")
 )
@@ -288,6 +288,8 @@ const (
 	PersistenceGetDLQAckLevelScope
 	// PersistenceGetDLQSizeScope tracks GetDLQSize calls made by service to persistence layer
 	PersistenceGetDLQSizeScope
+	// PersistenceCircuitBreakerScope tracks the state of persistence circuit breakers
+	PersistenceCircuitBreakerScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientStartWorkflowExecutionScope
 	// HistoryClientDescribeHistoryHostScope tracks RPC calls to history service
@@ -336,6 +338,8 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientExpediteWorkflowExecutionStartScope tracks RPC calls to history service
+	HistoryClientExpediteWorkflowExecutionStartScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -390,6 +394,14 @@ const (
 	MatchingClientListTaskListPartitionsScope
 	// MatchingClientGetTaskListsByDomainScope tracks RPC calls to matching service
 	MatchingClientGetTaskListsByDomainScope
+	// MatchingClientUpdateWorkerBuildIDCompatibilityScope tracks RPC calls to matching service
+	MatchingClientUpdateWorkerBuildIDCompatibilityScope
+	// MatchingClientGetWorkerBuildIDCompatibilityScope tracks RPC calls to matching service
+	MatchingClientGetWorkerBuildIDCompatibilityScope
+	// MatchingClientUpdateTaskListStateScope tracks RPC calls to matching service
+	MatchingClientUpdateTaskListStateScope
+	// MatchingClientGetTaskListStateScope tracks RPC calls to matching service
+	MatchingClientGetTaskListStateScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -456,6 +468,8 @@ const (
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientExpediteWorkflowExecutionStartScope tracks RPC calls to frontend service
+	FrontendClientExpediteWorkflowExecutionStartScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -480,6 +494,10 @@ const (
 	FrontendClientListTaskListPartitionsScope
 	// FrontendClientGetTaskListsByDomainScope tracks RPC calls to frontend service
 	FrontendClientGetTaskListsByDomainScope
+	// FrontendClientUpdateWorkerBuildIDCompatibilityScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkerBuildIDCompatibilityScope
+	// FrontendClientGetWorkerBuildIDCompatibilityScope tracks RPC calls to frontend service
+	FrontendClientGetWorkerBuildIDCompatibilityScope
 	// AdminClientAddSearchAttributeScope tracks RPC calls to admin service
 	AdminClientAddSearchAttributeScope
 	// AdminClientCloseShardScope tracks RPC calls to admin service
@@ -512,6 +530,38 @@ const (
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetCrossClusterTasksScope tracks RPC calls to Admin service
 	AdminClientGetCrossClusterTasksScope
+	// AdminClientGetDynamicConfigScope tracks RPC calls to Admin service
+	AdminClientGetDynamicConfigScope
+	// AdminClientUpdateDynamicConfigScope tracks RPC calls to Admin service
+	AdminClientUpdateDynamicConfigScope
+	// AdminClientRestoreDynamicConfigScope tracks RPC calls to Admin service
+	AdminClientRestoreDynamicConfigScope
+	// AdminClientListDynamicConfigScope tracks RPC calls to Admin service
+	AdminClientListDynamicConfigScope
+	// AdminClientPauseTaskListScope tracks RPC calls to Admin service
+	AdminClientPauseTaskListScope
+	// AdminClientResumeTaskListScope tracks RPC calls to Admin service
+	AdminClientResumeTaskListScope
+	// AdminClientMigrateTaskListScope tracks RPC calls to Admin service
+	AdminClientMigrateTaskListScope
+	// AdminClientReadTaskListDLQScope tracks RPC calls to Admin service
+	AdminClientReadTaskListDLQScope
+	// AdminClientDescribeTaskListDLQEntryScope tracks RPC calls to Admin service
+	AdminClientDescribeTaskListDLQEntryScope
+	// AdminClientRedriveTaskListDLQEntryScope tracks RPC calls to Admin service
+	AdminClientRedriveTaskListDLQEntryScope
+	// AdminClientFailTaskListDLQEntryScope tracks RPC calls to Admin service
+	AdminClientFailTaskListDLQEntryScope
+	// AdminClientMoveShardScope tracks RPC calls to Admin service
+	AdminClientMoveShardScope
+	// AdminClientUnpinShardScope tracks RPC calls to Admin service
+	AdminClientUnpinShardScope
+	// AdminClientListQuarantinedWorkflowsScope tracks RPC calls to Admin service
+	AdminClientListQuarantinedWorkflowsScope
+	// AdminClientReleaseQuarantinedWorkflowScope tracks RPC calls to Admin service
+	AdminClientReleaseQuarantinedWorkflowScope
+	// AdminClientCheckWorkflowBuildScope tracks RPC calls to Admin service
+	AdminClientCheckWorkflowBuildScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -586,12 +636,18 @@ const (
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionExpediteWorkflowExecutionStartScope tracks RPC calls for dc redirection
+	DCRedirectionExpediteWorkflowExecutionStartScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
 	// DCRedirectionListTaskListPartitionsScope tracks RPC calls for dc redirection
 	DCRedirectionListTaskListPartitionsScope
 	// DCRedirectionGetTaskListsByDomainScope tracks RPC calls for dc redirection
 	DCRedirectionGetTaskListsByDomainScope
+	// DCRedirectionUpdateWorkerBuildIDCompatibilityScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkerBuildIDCompatibilityScope
+	// DCRedirectionGetWorkerBuildIDCompatibilityScope tracks RPC calls for dc redirection
+	DCRedirectionGetWorkerBuildIDCompatibilityScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -631,6 +687,11 @@ const (
 	// ClusterMetadataArchivalConfigScope tracks ArchivalConfig calls to ClusterMetadata
 	ClusterMetadataArchivalConfigScope
 
+	// DynamicConfigFileClientScope tracks the values of the file based dynamic config client
+	DynamicConfigFileClientScope
+	// DynamicConfigStoreClientScope tracks the values of the dynamic config store client
+	DynamicConfigStoreClientScope
+
 	// ElasticsearchRecordWorkflowExecutionStartedScope tracks RecordWorkflowExecutionStarted calls made by service to persistence layer
 	ElasticsearchRecordWorkflowExecutionStartedScope
 	// ElasticsearchRecordWorkflowExecutionClosedScope tracks RecordWorkflowExecutionClosed calls made by service to persistence layer
@@ -694,6 +755,8 @@ const (
 	DomainFailoverScope
 	// DomainReplicationQueueScope is used in domainreplication queue
 	DomainReplicationQueueScope
+	// TaskListDLQScope is used in the dead letter queue of matching tasks
+	TaskListDLQScope
 
 	NumCommonScopes
 )
@@ -740,6 +803,38 @@ const (
 	AdminDescribeShardDistributionScope
 	// AdminGetCrossClusterTasksScope is the metric scope for admin.GetCrossClusterTasks
 	AdminGetCrossClusterTasksScope
+	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
+	AdminGetDynamicConfigScope
+	// AdminUpdateDynamicConfigScope is the metric scope for admin.UpdateDynamicConfig
+	AdminUpdateDynamicConfigScope
+	// AdminRestoreDynamicConfigScope is the metric scope for admin.RestoreDynamicConfig
+	AdminRestoreDynamicConfigScope
+	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
+	AdminListDynamicConfigScope
+	// AdminPauseTaskListScope is the metric scope for admin.PauseTaskList
+	AdminPauseTaskListScope
+	// AdminResumeTaskListScope is the metric scope for admin.ResumeTaskList
+	AdminResumeTaskListScope
+	// AdminMigrateTaskListScope is the metric scope for admin.MigrateTaskList
+	AdminMigrateTaskListScope
+	// AdminReadTaskListDLQScope is the metric scope for admin.ReadTaskListDLQ
+	AdminReadTaskListDLQScope
+	// AdminDescribeTaskListDLQEntryScope is the metric scope for admin.DescribeTaskListDLQEntry
+	AdminDescribeTaskListDLQEntryScope
+	// AdminRedriveTaskListDLQEntryScope is the metric scope for admin.RedriveTaskListDLQEntry
+	AdminRedriveTaskListDLQEntryScope
+	// AdminFailTaskListDLQEntryScope is the metric scope for admin.FailTaskListDLQEntry
+	AdminFailTaskListDLQEntryScope
+	// AdminMoveShardScope is the metric scope for admin.MoveShard
+	AdminMoveShardScope
+	// AdminUnpinShardScope is the metric scope for admin.UnpinShard
+	AdminUnpinShardScope
+	// AdminListQuarantinedWorkflowsScope is the metric scope for admin.ListQuarantinedWorkflows
+	AdminListQuarantinedWorkflowsScope
+	// AdminReleaseQuarantinedWorkflowScope is the metric scope for admin.ReleaseQuarantinedWorkflow
+	AdminReleaseQuarantinedWorkflowScope
+	// AdminCheckWorkflowBuildScope is the metric scope for admin.CheckWorkflowBuild
+	AdminCheckWorkflowBuildScope
 
 	NumAdminScopes
 )
@@ -786,6 +881,8 @@ const (
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendExpediteWorkflowExecutionStartScope is the metric scope for frontend.ExpediteWorkflowExecutionStart
+	FrontendExpediteWorkflowExecutionStartScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
@@ -826,6 +923,10 @@ const (
 	FrontendResetWorkflowExecutionScope
 	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
 	FrontendGetSearchAttributesScope
+	// FrontendUpdateWorkerBuildIDCompatibilityScope is the metric scope for frontend.UpdateWorkerBuildIDCompatibility
+	FrontendUpdateWorkerBuildIDCompatibilityScope
+	// FrontendGetWorkerBuildIDCompatibilityScope is the metric scope for frontend.GetWorkerBuildIDCompatibility
+	FrontendGetWorkerBuildIDCompatibilityScope
 
 	NumFrontendScopes
 )
@@ -872,6 +973,8 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryExpediteWorkflowExecutionStartScope tracks ExpediteWorkflowExecutionStart API calls received by service
+	HistoryExpediteWorkflowExecutionStartScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -1022,6 +1125,8 @@ const (
 	HistoryCacheGetOrCreateCurrentScope
 	// HistoryCacheGetCurrentExecutionScope is the scope used by history cache for getting current execution
 	HistoryCacheGetCurrentExecutionScope
+	// HistoryCacheSizeStatsScope is the scope used for emitting history cache size and eviction stats
+	HistoryCacheSizeStatsScope
 	// EventsCacheGetEventScope is the scope used by events cache
 	EventsCacheGetEventScope
 	// EventsCachePutEventScope is the scope used by events cache
@@ -1086,6 +1191,16 @@ const (
 	MatchingListTaskListPartitionsScope
 	// MatchingGetTaskListsByDomainScope tracks GetTaskListsByDomain API calls received by service
 	MatchingGetTaskListsByDomainScope
+	// MatchingUpdateWorkerBuildIDCompatibilityScope tracks UpdateWorkerBuildIDCompatibility API calls received by service
+	MatchingUpdateWorkerBuildIDCompatibilityScope
+	// MatchingGetWorkerBuildIDCompatibilityScope tracks GetWorkerBuildIDCompatibility API calls received by service
+	MatchingGetWorkerBuildIDCompatibilityScope
+	// MatchingUpdateTaskListStateScope tracks UpdateTaskListState API calls received by service
+	MatchingUpdateTaskListStateScope
+	// MatchingGetTaskListStateScope tracks GetTaskListState API calls received by service
+	MatchingGetTaskListStateScope
+	// MatchingPartitionScalerScope is the metrics scope for the task list partition scaler
+	MatchingPartitionScalerScope
 
 	NumMatchingScopes
 )
@@ -1219,9 +1334,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceUpdateDLQAckLevelScope:                        {operation: "UpdateDLQAckLevel"},
 		PersistenceGetDLQAckLevelScope:                           {operation: "GetDLQAckLevel"},
 		PersistenceGetDLQSizeScope:                               {operation: "GetDLQSize"},
+		PersistenceCircuitBreakerScope:                           {operation: "PersistenceCircuitBreaker"},
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
 
+		DynamicConfigFileClientScope:  {operation: "DynamicConfigFileClient"},
+		DynamicConfigStoreClientScope: {operation: "DynamicConfigStoreClient"},
+
 		HistoryClientStartWorkflowExecutionScope:              {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientDescribeHistoryHostScope:                 {operation: "HistoryClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRemoveTaskScope:                          {operation: "HistoryClientRemoveTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1246,6 +1365,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:    {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:            {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:          {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientExpediteWorkflowExecutionStartScope:      {operation: "HistoryClientExpediteWorkflowExecutionStart", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:              {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:                {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:       {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1273,6 +1393,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientGetTaskListsByDomainScope:               {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientUpdateWorkerBuildIDCompatibilityScope:   {operation: "MatchingClientUpdateWorkerBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientGetWorkerBuildIDCompatibilityScope:      {operation: "MatchingClientGetWorkerBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientUpdateTaskListStateScope:                {operation: "MatchingClientUpdateTaskListState", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientGetTaskListStateScope:                   {operation: "MatchingClientGetTaskListState", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                    {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeDomainScope:                     {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                   {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1306,6 +1430,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientSignalWorkflowExecutionScope:            {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:             {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:         {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientExpediteWorkflowExecutionStartScope:     {operation: "FrontendClientExpediteWorkflowExecutionStart", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUpdateDomainScope:                       {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListWorkflowExecutionsScope:             {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientScanWorkflowExecutionsScope:             {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1318,6 +1443,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientGetClusterInfoScope:                     {operation: "FrontendClientGetClusterInfoScope", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListTaskListPartitionsScope:             {operation: "FrontendClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientGetTaskListsByDomainScope:               {operation: "FrontendClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateWorkerBuildIDCompatibilityScope:   {operation: "FrontendClientUpdateWorkerBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientGetWorkerBuildIDCompatibilityScope:      {operation: "FrontendClientGetWorkerBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		AdminClientAddSearchAttributeScope:                    {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                   {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeShardDistributionScope:             {operation: "AdminClientDescribeShardDistribution", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1334,6 +1461,22 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientMergeDLQMessagesScope:                      {operation: "AdminClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetCrossClusterTasksScope:                  {operation: "AdminClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetDynamicConfigScope:                      {operation: "AdminClientGetDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpdateDynamicConfigScope:                   {operation: "AdminClientUpdateDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRestoreDynamicConfigScope:                  {operation: "AdminClientRestoreDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientPauseTaskListScope:                         {operation: "AdminClientPauseTaskList", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientResumeTaskListScope:                        {operation: "AdminClientResumeTaskList", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientMigrateTaskListScope:                       {operation: "AdminClientMigrateTaskList", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientReadTaskListDLQScope:                       {operation: "AdminClientReadTaskListDLQ", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeTaskListDLQEntryScope:              {operation: "AdminClientDescribeTaskListDLQEntry", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRedriveTaskListDLQEntryScope:               {operation: "AdminClientRedriveTaskListDLQEntry", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientFailTaskListDLQEntryScope:                  {operation: "AdminClientFailTaskListDLQEntry", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientMoveShardScope:                             {operation: "AdminClientMoveShard", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUnpinShardScope:                            {operation: "AdminClientUnpinShard", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListQuarantinedWorkflowsScope:              {operation: "AdminClientListQuarantinedWorkflows", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientReleaseQuarantinedWorkflowScope:            {operation: "AdminClientReleaseQuarantinedWorkflow", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientCheckWorkflowBuildScope:                    {operation: "AdminClientCheckWorkflowBuild", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                     {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                      {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                    {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1371,9 +1514,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionSignalWorkflowExecutionScope:             {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionScope:              {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:          {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionExpediteWorkflowExecutionStartScope:      {operation: "DCRedirectionExpediteWorkflowExecutionStart", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                        {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListTaskListPartitionsScope:              {operation: "DCRedirectionListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetTaskListsByDomainScope:                {operation: "DCRedirectionGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkerBuildIDCompatibilityScope:    {operation: "DCRedirectionUpdateWorkerBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionGetWorkerBuildIDCompatibilityScope:       {operation: "DCRedirectionGetWorkerBuildIDCompatibility", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -1417,6 +1563,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 
 		DomainFailoverScope:         {operation: "DomainFailover"},
 		DomainReplicationQueueScope: {operation: "DomainReplicationQueue"},
+		TaskListDLQScope:            {operation: "TaskListDLQ"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -1441,6 +1588,22 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
 		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
 		AdminGetCrossClusterTasksScope:             {operation: "AdminGetCrossClusterTasksScope"},
+		AdminGetDynamicConfigScope:                 {operation: "GetDynamicConfig"},
+		AdminUpdateDynamicConfigScope:              {operation: "UpdateDynamicConfig"},
+		AdminRestoreDynamicConfigScope:             {operation: "RestoreDynamicConfig"},
+		AdminListDynamicConfigScope:                {operation: "ListDynamicConfig"},
+		AdminPauseTaskListScope:                    {operation: "PauseTaskList"},
+		AdminResumeTaskListScope:                   {operation: "ResumeTaskList"},
+		AdminMigrateTaskListScope:                  {operation: "MigrateTaskList"},
+		AdminReadTaskListDLQScope:                  {operation: "ReadTaskListDLQ"},
+		AdminDescribeTaskListDLQEntryScope:         {operation: "DescribeTaskListDLQEntry"},
+		AdminRedriveTaskListDLQEntryScope:          {operation: "RedriveTaskListDLQEntry"},
+		AdminFailTaskListDLQEntryScope:             {operation: "FailTaskListDLQEntry"},
+		AdminMoveShardScope:                        {operation: "MoveShard"},
+		AdminUnpinShardScope:                       {operation: "UnpinShard"},
+		AdminListQuarantinedWorkflowsScope:         {operation: "ListQuarantinedWorkflows"},
+		AdminReleaseQuarantinedWorkflowScope:       {operation: "ReleaseQuarantinedWorkflow"},
+		AdminCheckWorkflowBuildScope:               {operation: "CheckWorkflowBuild"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:                {operation: "PollForDecisionTask"},
@@ -1462,6 +1625,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWorkflowExecutionScope:            {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope:   {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:         {operation: "TerminateWorkflowExecution"},
+		FrontendExpediteWorkflowExecutionStartScope:     {operation: "ExpediteWorkflowExecutionStart"},
 		FrontendResetWorkflowExecutionScope:             {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:     {operation: "RequestCancelWorkflowExecution"},
 		FrontendListArchivedWorkflowExecutionsScope:     {operation: "ListArchivedWorkflowExecutions"},
@@ -1482,6 +1646,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendDescribeTaskListScope:                   {operation: "DescribeTaskList"},
 		FrontendResetStickyTaskListScope:                {operation: "ResetStickyTaskList"},
 		FrontendGetSearchAttributesScope:                {operation: "GetSearchAttributes"},
+		FrontendUpdateWorkerBuildIDCompatibilityScope:   {operation: "UpdateWorkerBuildIDCompatibility"},
+		FrontendGetWorkerBuildIDCompatibilityScope:      {operation: "GetWorkerBuildIDCompatibility"},
 	},
 	// History Scope Names
 	History: {
@@ -1505,6 +1671,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope:           {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                   {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                 {operation: "TerminateWorkflowExecution"},
+		HistoryExpediteWorkflowExecutionStartScope:             {operation: "ExpediteWorkflowExecutionStart"},
 		HistoryResetWorkflowExecutionScope:                     {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                              {operation: "QueryWorkflow"},
 		HistoryProcessDeleteHistoryEventScope:                  {operation: "ProcessDeleteHistoryEvent"},
@@ -1583,6 +1750,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryCacheGetOrCreateScope:                           {operation: "HistoryCacheGetOrCreate", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
 		HistoryCacheGetOrCreateCurrentScope:                    {operation: "HistoryCacheGetOrCreateCurrent", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
 		HistoryCacheGetCurrentExecutionScope:                   {operation: "HistoryCacheGetCurrentExecution", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
+		HistoryCacheSizeStatsScope:                             {operation: "HistoryCacheSizeStats", tags: map[string]string{CacheTypeTagName: MutableStateCacheTypeTagValue}},
 		EventsCacheGetEventScope:                               {operation: "EventsCacheGetEvent", tags: map[string]string{CacheTypeTagName: EventsCacheTypeTagValue}},
 		EventsCachePutEventScope:                               {operation: "EventsCachePutEvent", tags: map[string]string{CacheTypeTagName: EventsCacheTypeTagValue}},
 		EventsCacheGetFromStoreScope:                           {operation: "EventsCacheGetFromStore", tags: map[string]string{CacheTypeTagName: EventsCacheTypeTagValue}},
@@ -1601,17 +1769,22 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollForDecisionTaskScope:       {operation: "PollForDecisionTask"},
-		MatchingPollForActivityTaskScope:       {operation: "PollForActivityTask"},
-		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
-		MatchingAddDecisionTaskScope:           {operation: "AddDecisionTask"},
-		MatchingTaskListMgrScope:               {operation: "TaskListMgr"},
-		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
-		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
-		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
-		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
-		MatchingListTaskListPartitionsScope:    {operation: "ListTaskListPartitions"},
-		MatchingGetTaskListsByDomainScope:      {operation: "GetTaskListsByDomain"},
+		MatchingPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
+		MatchingPollForActivityTaskScope:              {operation: "PollForActivityTask"},
+		MatchingAddActivityTaskScope:                  {operation: "AddActivityTask"},
+		MatchingAddDecisionTaskScope:                  {operation: "AddDecisionTask"},
+		MatchingTaskListMgrScope:                      {operation: "TaskListMgr"},
+		MatchingQueryWorkflowScope:                    {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope:        {operation: "RespondQueryTaskCompleted"},
+		MatchingCancelOutstandingPollScope:            {operation: "CancelOutstandingPoll"},
+		MatchingDescribeTaskListScope:                 {operation: "DescribeTaskList"},
+		MatchingListTaskListPartitionsScope:           {operation: "ListTaskListPartitions"},
+		MatchingGetTaskListsByDomainScope:             {operation: "GetTaskListsByDomain"},
+		MatchingUpdateWorkerBuildIDCompatibilityScope: {operation: "UpdateWorkerBuildIDCompatibility"},
+		MatchingGetWorkerBuildIDCompatibilityScope:    {operation: "GetWorkerBuildIDCompatibility"},
+		MatchingUpdateTaskListStateScope:              {operation: "UpdateTaskListState"},
+		MatchingGetTaskListStateScope:                 {operation: "GetTaskListState"},
+		MatchingPartitionScalerScope:                  {operation: "PartitionScaler"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1685,6 +1858,11 @@ const (
 	PersistenceErrDomainAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceCircuitBreakerRejectedCounter
+	PersistenceCircuitBreakerTrippedCounter
+	PersistenceCircuitBreakerStateGauge
+
+	DynamicConfigActiveOverridesGauge
 
 	CadenceClientRequests
 	CadenceClientFailures
@@ -1786,6 +1964,9 @@ const (
 	DomainReplicationQueueSizeGauge
 	DomainReplicationQueueSizeErrorCount
 
+	TaskListDLQSizeGauge
+	TaskListDLQSizeErrorCount
+
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 
@@ -1813,6 +1994,7 @@ const (
 	TaskWorkflowBusyPerDomain
 	TaskDiscardedPerDomain
 	TaskUnsupportedPerDomain
+	TaskQuarantinedPerDomain
 	TaskAttemptTimerPerDomain
 	TaskStandbyRetryCounterPerDomain
 	TaskPendingActiveCounterPerDomain
@@ -1860,6 +2042,7 @@ const (
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
 	DecisionTypeUpsertWorkflowSearchAttributesCounter
+	DecisionTypeUpsertWorkflowMemoCounter
 	EmptyCompletionDecisionsCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
@@ -1869,6 +2052,7 @@ const (
 	AutoResetPointsLimitExceededCounter
 	AutoResetPointCorruptionCounter
 	ConcurrencyUpdateFailureCounter
+	DuplicateRequestDroppedCounter
 	CadenceErrEventAlreadyStartedCounter
 	CadenceErrShardOwnershipLostCounter
 	HeartbeatTimeoutCounter
@@ -1904,6 +2088,14 @@ const (
 	GetEngineForShardErrorCounter
 	GetEngineForShardLatency
 	RemoveEngineForShardLatency
+	ShardHandoffCounter
+	ShardHandoffLatency
+	ShardHandoffWaitLatency
+	ShardHandoffTimeoutCounter
+	ShardLoadReportErrorCounter
+	ShardRebalanceCounter
+	ShardRebalanceErrorCounter
+	ShardMovedCounter
 	CompleteDecisionWithStickyEnabledCounter
 	CompleteDecisionWithStickyDisabledCounter
 	DecisionHeartbeatTimeoutCounter
@@ -1923,6 +2115,10 @@ const (
 	CacheFailures
 	CacheLatency
 	CacheMissCounter
+	CacheSizeInBytesGauge
+	CacheEvictedExpiredCounter
+	CacheEvictedMaxCountCounter
+	CacheEvictedMaxSizeCounter
 	AcquireLockFailedCounter
 	WorkflowContextCleared
 	MutableStateSize
@@ -1948,6 +2144,7 @@ const (
 	DeleteRequestCancelInfoCount
 	WorkflowRetryBackoffTimerCount
 	WorkflowCronBackoffTimerCount
+	WorkflowDelayStartBackoffTimerCount
 	WorkflowCleanupDeleteCount
 	WorkflowCleanupArchiveCount
 	WorkflowCleanupNopCount
@@ -2002,6 +2199,11 @@ const (
 	ReplicationTaskLatency
 	MutableStateChecksumMismatch
 	MutableStateChecksumInvalidated
+	MutableStateChecksumFalsePositive
+	MutableStateChecksumRepaired
+	MutableStateChecksumQuarantined
+	MutableStateChecksumReleased
+	WorkflowQuarantinedCounter
 	GracefulFailoverLatency
 	GracefulFailoverFailure
 	FailoverMarkerCount
@@ -2031,12 +2233,14 @@ const (
 	ForwardTaskCallsPerTaskList
 	ForwardTaskErrorsPerTaskList
 	ForwardTaskLatencyPerTaskList
+	ForwardTaskHitsPerTaskList
 	ForwardQueryCallsPerTaskList
 	ForwardQueryErrorsPerTaskList
 	ForwardQueryLatencyPerTaskList
 	ForwardPollCallsPerTaskList
 	ForwardPollErrorsPerTaskList
 	ForwardPollLatencyPerTaskList
+	ForwardPollHitsPerTaskList
 	LocalToLocalMatchPerTaskListCounter
 	LocalToRemoteMatchPerTaskListCounter
 	RemoteToLocalMatchPerTaskListCounter
@@ -2044,6 +2248,25 @@ const (
 	PollerPerTaskListCounter
 	TaskListManagersGauge
 	TaskLagPerTaskListGauge
+	PartitionUpscalePerTaskListCounter
+	PartitionDownscalePerTaskListCounter
+	PartitionScalingFailuresPerTaskListCounter
+	ReadPartitionsPerTaskListGauge
+	WritePartitionsPerTaskListGauge
+	StickyBuildMismatchPerTaskListCounter
+	TaskMovedToDLQPerTaskListCounter
+	TaskAddRatePerTaskListGauge
+	TaskDispatchRatePerTaskListGauge
+	SyncMatchRatioPerTaskListGauge
+	OldestTaskAgePerTaskListGauge
+	PollShedTaskListLimitPerTaskListCounter
+	PollShedHostLimitPerTaskListCounter
+	OutstandingPollsGauge
+	IsolationGroupFallbackPerTaskListCounter
+	DrainedPollsPerTaskListCounter
+	IngestionThrottlePerTaskListCounter
+	DomainIngestionThrottlePerTaskListCounter
+	DomainDispatchThrottlePerTaskListCounter
 
 	NumMatchingMetrics
 )
@@ -2171,6 +2394,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrDomainAlreadyExistsCounter:            {metricName: "persistence_errors_domain_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceCircuitBreakerRejectedCounter:            {metricName: "persistence_circuit_breaker_rejected", metricType: Counter},
+		PersistenceCircuitBreakerTrippedCounter:             {metricName: "persistence_circuit_breaker_tripped", metricType: Counter},
+		PersistenceCircuitBreakerStateGauge:                 {metricName: "persistence_circuit_breaker_state", metricType: Gauge},
+		DynamicConfigActiveOverridesGauge:                   {metricName: "dynamic_config_active_overrides", metricType: Gauge},
 		CadenceClientRequests:                               {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                               {metricName: "cadence_client_errors", metricType: Counter},
 		CadenceClientLatency:                                {metricName: "cadence_client_latency", metricType: Timer},
@@ -2296,6 +2523,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceShardFailureGauge:             {metricName: "cadence_shard_failure", metricType: Gauge},
 		DomainReplicationQueueSizeGauge:      {metricName: "domain_replication_queue_size", metricType: Gauge},
 		DomainReplicationQueueSizeErrorCount: {metricName: "domain_replication_queue_failed", metricType: Counter},
+		TaskListDLQSizeGauge:                 {metricName: "tasklist_dlq_size", metricType: Gauge},
+		TaskListDLQSizeErrorCount:            {metricName: "tasklist_dlq_size_failed", metricType: Counter},
 		ParentClosePolicyProcessorSuccess:    {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:   {metricName: "parent_close_policy_processor_errors", metricType: Counter},
 	},
@@ -2320,6 +2549,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskWorkflowBusyPerDomain:                {metricName: "task_errors_workflow_busy_per_domain", metricRollupName: "task_errors_workflow_busy", metricType: Counter},
 		TaskDiscardedPerDomain:                   {metricName: "task_errors_discarded_per_domain", metricRollupName: "task_errors_discarded", metricType: Counter},
 		TaskUnsupportedPerDomain:                 {metricName: "task_errors_unsupported_per_domain", metricRollupName: "task_errors_discarded", metricType: Counter},
+		TaskQuarantinedPerDomain:                 {metricName: "task_errors_quarantined_per_domain", metricRollupName: "task_errors_discarded", metricType: Counter},
 		TaskStandbyRetryCounterPerDomain:         {metricName: "task_errors_standby_retry_counter_per_domain", metricRollupName: "task_errors_standby_retry_counter", metricType: Counter},
 		TaskPendingActiveCounterPerDomain:        {metricName: "task_errors_pending_active_counter_per_domain", metricRollupName: "task_errors_pending_active_counter", metricType: Counter},
 		TaskNotActiveCounterPerDomain:            {metricName: "task_errors_not_active_counter_per_domain", metricRollupName: "task_errors_not_active_counter", metricType: Counter},
@@ -2362,6 +2592,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeContinueAsNewCounter:                  {metricName: "continue_as_new_decision", metricType: Counter},
 		DecisionTypeSignalExternalWorkflowCounter:         {metricName: "signal_external_workflow_decision", metricType: Counter},
 		DecisionTypeUpsertWorkflowSearchAttributesCounter: {metricName: "upsert_workflow_search_attributes_decision", metricType: Counter},
+		DecisionTypeUpsertWorkflowMemoCounter:             {metricName: "upsert_workflow_memo_decision", metricType: Counter},
 		DecisionTypeChildWorkflowCounter:                  {metricName: "child_workflow_decision", metricType: Counter},
 		EmptyCompletionDecisionsCounter:                   {metricName: "empty_completion_decisions", metricType: Counter},
 		MultipleCompletionDecisionsCounter:                {metricName: "multiple_completion_decisions", metricType: Counter},
@@ -2372,6 +2603,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		AutoResetPointsLimitExceededCounter:               {metricName: "auto_reset_points_exceed_limit", metricType: Counter},
 		AutoResetPointCorruptionCounter:                   {metricName: "auto_reset_point_corruption", metricType: Counter},
 		ConcurrencyUpdateFailureCounter:                   {metricName: "concurrency_update_failure", metricType: Counter},
+		DuplicateRequestDroppedCounter:                    {metricName: "duplicate_request_dropped", metricType: Counter},
 		CadenceErrShardOwnershipLostCounter:               {metricName: "cadence_errors_shard_ownership_lost", metricType: Counter},
 		CadenceErrEventAlreadyStartedCounter:              {metricName: "cadence_errors_event_already_started", metricType: Counter},
 		HeartbeatTimeoutCounter:                           {metricName: "heartbeat_timeout", metricType: Counter},
@@ -2407,6 +2639,14 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		GetEngineForShardErrorCounter:                     {metricName: "get_engine_for_shard_errors", metricType: Counter},
 		GetEngineForShardLatency:                          {metricName: "get_engine_for_shard_latency", metricType: Timer},
 		RemoveEngineForShardLatency:                       {metricName: "remove_engine_for_shard_latency", metricType: Timer},
+		ShardHandoffCounter:                               {metricName: "shard_handoff_count", metricType: Counter},
+		ShardHandoffLatency:                               {metricName: "shard_handoff_latency", metricType: Timer},
+		ShardHandoffWaitLatency:                           {metricName: "shard_handoff_wait_latency", metricType: Timer},
+		ShardHandoffTimeoutCounter:                        {metricName: "shard_handoff_timeout_count", metricType: Counter},
+		ShardLoadReportErrorCounter:                       {metricName: "shard_load_report_error_count", metricType: Counter},
+		ShardRebalanceCounter:                             {metricName: "shard_rebalance_count", metricType: Counter},
+		ShardRebalanceErrorCounter:                        {metricName: "shard_rebalance_error_count", metricType: Counter},
+		ShardMovedCounter:                                 {metricName: "shard_moved_count", metricType: Counter},
 		CompleteDecisionWithStickyEnabledCounter:          {metricName: "complete_decision_sticky_enabled_count", metricType: Counter},
 		CompleteDecisionWithStickyDisabledCounter:         {metricName: "complete_decision_sticky_disabled_count", metricType: Counter},
 		DecisionHeartbeatTimeoutCounter:                   {metricName: "decision_heartbeat_timeout_count", metricType: Counter},
@@ -2426,6 +2666,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheFailures:                                     {metricName: "cache_errors", metricType: Counter},
 		CacheLatency:                                      {metricName: "cache_latency", metricType: Timer},
 		CacheMissCounter:                                  {metricName: "cache_miss", metricType: Counter},
+		CacheSizeInBytesGauge:                             {metricName: "cache_size_bytes", metricType: Gauge},
+		CacheEvictedExpiredCounter:                        {metricName: "cache_evicted_expired", metricType: Counter},
+		CacheEvictedMaxCountCounter:                       {metricName: "cache_evicted_max_count", metricType: Counter},
+		CacheEvictedMaxSizeCounter:                        {metricName: "cache_evicted_max_size", metricType: Counter},
 		AcquireLockFailedCounter:                          {metricName: "acquire_lock_failed", metricType: Counter},
 		WorkflowContextCleared:                            {metricName: "workflow_context_cleared", metricType: Counter},
 		MutableStateSize:                                  {metricName: "mutable_state_size", metricType: Timer},
@@ -2448,6 +2692,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DeleteRequestCancelInfoCount:                      {metricName: "delete_request_cancel_info", metricType: Timer},
 		WorkflowRetryBackoffTimerCount:                    {metricName: "workflow_retry_backoff_timer", metricType: Counter},
 		WorkflowCronBackoffTimerCount:                     {metricName: "workflow_cron_backoff_timer", metricType: Counter},
+		WorkflowDelayStartBackoffTimerCount:               {metricName: "workflow_delay_start_backoff_timer", metricType: Counter},
 		WorkflowCleanupDeleteCount:                        {metricName: "workflow_cleanup_delete", metricType: Counter},
 		WorkflowCleanupArchiveCount:                       {metricName: "workflow_cleanup_archive", metricType: Counter},
 		WorkflowCleanupNopCount:                           {metricName: "workflow_cleanup_nop", metricType: Counter},
@@ -2502,6 +2747,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTaskLatency:                            {metricName: "replication_task_latency", metricType: Timer},
 		MutableStateChecksumMismatch:                      {metricName: "mutable_state_checksum_mismatch", metricType: Counter},
 		MutableStateChecksumInvalidated:                   {metricName: "mutable_state_checksum_invalidated", metricType: Counter},
+		MutableStateChecksumFalsePositive:                 {metricName: "mutable_state_checksum_false_positive", metricType: Counter},
+		MutableStateChecksumRepaired:                      {metricName: "mutable_state_checksum_repaired", metricType: Counter},
+		MutableStateChecksumQuarantined:                   {metricName: "mutable_state_checksum_quarantined", metricType: Counter},
+		MutableStateChecksumReleased:                      {metricName: "mutable_state_checksum_released", metricType: Counter},
+		WorkflowQuarantinedCounter:                        {metricName: "workflow_quarantined", metricType: Counter},
 		GracefulFailoverLatency:                           {metricName: "graceful_failover_latency", metricType: Timer},
 		GracefulFailoverFailure:                           {metricName: "graceful_failover_failures", metricType: Counter},
 		FailoverMarkerCount:                               {metricName: "failover_marker_count", metricType: Counter},
@@ -2514,35 +2764,56 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTasksCount:                             {metricName: "replication_tasks_count", metricType: Timer},
 	},
 	Matching: {
-		PollSuccessPerTaskListCounter:            {metricName: "poll_success_per_tl", metricRollupName: "poll_success"},
-		PollTimeoutPerTaskListCounter:            {metricName: "poll_timeouts_per_tl", metricRollupName: "poll_timeouts"},
-		PollSuccessWithSyncPerTaskListCounter:    {metricName: "poll_success_sync_per_tl", metricRollupName: "poll_success_sync"},
-		LeaseRequestPerTaskListCounter:           {metricName: "lease_requests_per_tl", metricRollupName: "lease_requests"},
-		LeaseFailurePerTaskListCounter:           {metricName: "lease_failures_per_tl", metricRollupName: "lease_failures"},
-		ConditionFailedErrorPerTaskListCounter:   {metricName: "condition_failed_errors_per_tl", metricRollupName: "condition_failed_errors"},
-		RespondQueryTaskFailedPerTaskListCounter: {metricName: "respond_query_failed_per_tl", metricRollupName: "respond_query_failed"},
-		SyncThrottlePerTaskListCounter:           {metricName: "sync_throttle_count_per_tl", metricRollupName: "sync_throttle_count"},
-		BufferThrottlePerTaskListCounter:         {metricName: "buffer_throttle_count_per_tl", metricRollupName: "buffer_throttle_count"},
-		ExpiredTasksPerTaskListCounter:           {metricName: "tasks_expired_per_tl", metricRollupName: "tasks_expired"},
-		ForwardedPerTaskListCounter:              {metricName: "forwarded_per_tl", metricRollupName: "forwarded"},
-		ForwardTaskCallsPerTaskList:              {metricName: "forward_task_calls_per_tl", metricRollupName: "forward_task_calls"},
-		ForwardTaskErrorsPerTaskList:             {metricName: "forward_task_errors_per_tl", metricRollupName: "forward_task_errors"},
-		ForwardQueryCallsPerTaskList:             {metricName: "forward_query_calls_per_tl", metricRollupName: "forward_query_calls"},
-		ForwardQueryErrorsPerTaskList:            {metricName: "forward_query_errors_per_tl", metricRollupName: "forward_query_errors"},
-		ForwardPollCallsPerTaskList:              {metricName: "forward_poll_calls_per_tl", metricRollupName: "forward_poll_calls"},
-		ForwardPollErrorsPerTaskList:             {metricName: "forward_poll_errors_per_tl", metricRollupName: "forward_poll_errors"},
-		SyncMatchLatencyPerTaskList:              {metricName: "syncmatch_latency_per_tl", metricRollupName: "syncmatch_latency", metricType: Timer},
-		AsyncMatchLatencyPerTaskList:             {metricName: "asyncmatch_latency_per_tl", metricRollupName: "asyncmatch_latency", metricType: Timer},
-		ForwardTaskLatencyPerTaskList:            {metricName: "forward_task_latency_per_tl", metricRollupName: "forward_task_latency"},
-		ForwardQueryLatencyPerTaskList:           {metricName: "forward_query_latency_per_tl", metricRollupName: "forward_query_latency"},
-		ForwardPollLatencyPerTaskList:            {metricName: "forward_poll_latency_per_tl", metricRollupName: "forward_poll_latency"},
-		LocalToLocalMatchPerTaskListCounter:      {metricName: "local_to_local_matches_per_tl", metricRollupName: "local_to_local_matches"},
-		LocalToRemoteMatchPerTaskListCounter:     {metricName: "local_to_remote_matches_per_tl", metricRollupName: "local_to_remote_matches"},
-		RemoteToLocalMatchPerTaskListCounter:     {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
-		RemoteToRemoteMatchPerTaskListCounter:    {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
-		PollerPerTaskListCounter:                 {metricName: "poller_count_per_tl", metricRollupName: "poller_count"},
-		TaskListManagersGauge:                    {metricName: "tasklist_managers", metricType: Gauge},
-		TaskLagPerTaskListGauge:                  {metricName: "task_lag_per_tl", metricType: Gauge},
+		PollSuccessPerTaskListCounter:              {metricName: "poll_success_per_tl", metricRollupName: "poll_success"},
+		PollTimeoutPerTaskListCounter:              {metricName: "poll_timeouts_per_tl", metricRollupName: "poll_timeouts"},
+		PollSuccessWithSyncPerTaskListCounter:      {metricName: "poll_success_sync_per_tl", metricRollupName: "poll_success_sync"},
+		LeaseRequestPerTaskListCounter:             {metricName: "lease_requests_per_tl", metricRollupName: "lease_requests"},
+		LeaseFailurePerTaskListCounter:             {metricName: "lease_failures_per_tl", metricRollupName: "lease_failures"},
+		ConditionFailedErrorPerTaskListCounter:     {metricName: "condition_failed_errors_per_tl", metricRollupName: "condition_failed_errors"},
+		RespondQueryTaskFailedPerTaskListCounter:   {metricName: "respond_query_failed_per_tl", metricRollupName: "respond_query_failed"},
+		SyncThrottlePerTaskListCounter:             {metricName: "sync_throttle_count_per_tl", metricRollupName: "sync_throttle_count"},
+		BufferThrottlePerTaskListCounter:           {metricName: "buffer_throttle_count_per_tl", metricRollupName: "buffer_throttle_count"},
+		ExpiredTasksPerTaskListCounter:             {metricName: "tasks_expired_per_tl", metricRollupName: "tasks_expired"},
+		ForwardedPerTaskListCounter:                {metricName: "forwarded_per_tl", metricRollupName: "forwarded"},
+		ForwardTaskCallsPerTaskList:                {metricName: "forward_task_calls_per_tl", metricRollupName: "forward_task_calls"},
+		ForwardTaskErrorsPerTaskList:               {metricName: "forward_task_errors_per_tl", metricRollupName: "forward_task_errors"},
+		ForwardQueryCallsPerTaskList:               {metricName: "forward_query_calls_per_tl", metricRollupName: "forward_query_calls"},
+		ForwardQueryErrorsPerTaskList:              {metricName: "forward_query_errors_per_tl", metricRollupName: "forward_query_errors"},
+		ForwardPollCallsPerTaskList:                {metricName: "forward_poll_calls_per_tl", metricRollupName: "forward_poll_calls"},
+		ForwardPollErrorsPerTaskList:               {metricName: "forward_poll_errors_per_tl", metricRollupName: "forward_poll_errors"},
+		ForwardTaskHitsPerTaskList:                 {metricName: "forward_task_hits_per_tl", metricRollupName: "forward_task_hits"},
+		ForwardPollHitsPerTaskList:                 {metricName: "forward_poll_hits_per_tl", metricRollupName: "forward_poll_hits"},
+		SyncMatchLatencyPerTaskList:                {metricName: "syncmatch_latency_per_tl", metricRollupName: "syncmatch_latency", metricType: Timer},
+		AsyncMatchLatencyPerTaskList:               {metricName: "asyncmatch_latency_per_tl", metricRollupName: "asyncmatch_latency", metricType: Timer},
+		ForwardTaskLatencyPerTaskList:              {metricName: "forward_task_latency_per_tl", metricRollupName: "forward_task_latency", metricType: Timer},
+		ForwardQueryLatencyPerTaskList:             {metricName: "forward_query_latency_per_tl", metricRollupName: "forward_query_latency", metricType: Timer},
+		ForwardPollLatencyPerTaskList:              {metricName: "forward_poll_latency_per_tl", metricRollupName: "forward_poll_latency", metricType: Timer},
+		LocalToLocalMatchPerTaskListCounter:        {metricName: "local_to_local_matches_per_tl", metricRollupName: "local_to_local_matches"},
+		LocalToRemoteMatchPerTaskListCounter:       {metricName: "local_to_remote_matches_per_tl", metricRollupName: "local_to_remote_matches"},
+		RemoteToLocalMatchPerTaskListCounter:       {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
+		RemoteToRemoteMatchPerTaskListCounter:      {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
+		PollerPerTaskListCounter:                   {metricName: "poller_count_per_tl", metricRollupName: "poller_count"},
+		TaskListManagersGauge:                      {metricName: "tasklist_managers", metricType: Gauge},
+		TaskLagPerTaskListGauge:                    {metricName: "task_lag_per_tl", metricType: Gauge},
+		PartitionUpscalePerTaskListCounter:         {metricName: "partition_upscale_per_tl", metricRollupName: "partition_upscale"},
+		PartitionDownscalePerTaskListCounter:       {metricName: "partition_downscale_per_tl", metricRollupName: "partition_downscale"},
+		PartitionScalingFailuresPerTaskListCounter: {metricName: "partition_scaling_failures_per_tl", metricRollupName: "partition_scaling_failures"},
+		ReadPartitionsPerTaskListGauge:             {metricName: "read_partitions_per_tl", metricType: Gauge},
+		WritePartitionsPerTaskListGauge:            {metricName: "write_partitions_per_tl", metricType: Gauge},
+		StickyBuildMismatchPerTaskListCounter:      {metricName: "sticky_build_mismatch_per_tl", metricRollupName: "sticky_build_mismatch"},
+		TaskMovedToDLQPerTaskListCounter:           {metricName: "task_moved_to_dlq_per_tl", metricRollupName: "task_moved_to_dlq"},
+		TaskAddRatePerTaskListGauge:                {metricName: "task_add_rate_per_tl", metricType: Gauge},
+		TaskDispatchRatePerTaskListGauge:           {metricName: "task_dispatch_rate_per_tl", metricType: Gauge},
+		SyncMatchRatioPerTaskListGauge:             {metricName: "sync_match_ratio_per_tl", metricType: Gauge},
+		OldestTaskAgePerTaskListGauge:              {metricName: "oldest_task_age_per_tl", metricType: Gauge},
+		PollShedTaskListLimitPerTaskListCounter:    {metricName: "poll_shed_tasklist_limit_per_tl", metricRollupName: "poll_shed_tasklist_limit"},
+		PollShedHostLimitPerTaskListCounter:        {metricName: "poll_shed_host_limit_per_tl", metricRollupName: "poll_shed_host_limit"},
+		OutstandingPollsGauge:                      {metricName: "outstanding_polls", metricType: Gauge},
+		IsolationGroupFallbackPerTaskListCounter:   {metricName: "isolation_group_fallback_per_tl", metricRollupName: "isolation_group_fallback"},
+		DrainedPollsPerTaskListCounter:             {metricName: "drained_polls_per_tl", metricRollupName: "drained_polls"},
+		IngestionThrottlePerTaskListCounter:        {metricName: "ingestion_throttle_per_tl", metricRollupName: "ingestion_throttle"},
+		DomainIngestionThrottlePerTaskListCounter:  {metricName: "domain_ingestion_throttle_per_tl", metricRollupName: "domain_ingestion_throttle"},
+		DomainDispatchThrottlePerTaskListCounter:   {metricName: "domain_dispatch_throttle_per_tl", metricRollupName: "domain_dispatch_throttle"},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
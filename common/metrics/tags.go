@@ -45,6 +45,9 @@ const (
 	transport      = "transport"
 	signalName     = "signalName"
 
+	persistenceStore          = "persistenceStore"
+	persistenceOperationClass = "persistenceOperationClass"
+
 	domainAllValue = "all"
 	unknownValue   = "_unknown_"
 
@@ -159,3 +162,13 @@ func GPRCTransportTag() Tag {
 func SignalNameTag(value string) Tag {
 	return metricWithUnknown(signalName, value)
 }
+
+// PersistenceStoreTag returns a new persistence store tag.
+func PersistenceStoreTag(value string) Tag {
+	return metricWithUnknown(persistenceStore, value)
+}
+
+// PersistenceOperationClassTag returns a new persistence operation class tag.
+func PersistenceOperationClassTag(value string) Tag {
+	return metricWithUnknown(persistenceOperationClass, value)
+}
@@ -0,0 +1,295 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// CircuitBreakerStateClosed means calls are forwarded to the datastore
+	CircuitBreakerStateClosed CircuitBreakerState = iota
+	// CircuitBreakerStateOpen means calls are rejected without reaching the datastore
+	CircuitBreakerStateOpen
+	// CircuitBreakerStateHalfOpen means a single probe call is allowed to test whether the datastore recovered
+	CircuitBreakerStateHalfOpen
+)
+
+const (
+	// CircuitBreakerOperationClassRead is the operation class of calls which only read from the datastore
+	CircuitBreakerOperationClassRead = "read"
+	// CircuitBreakerOperationClassWrite is the operation class of calls which mutate the datastore
+	CircuitBreakerOperationClassWrite = "write"
+)
+
+var (
+	// ErrPersistenceCircuitBreakerOpen is the error returned when calls to a datastore are shed by the circuit breaker
+	ErrPersistenceCircuitBreakerOpen = &types.ServiceBusyError{Message: "Persistence circuit breaker is open."}
+)
+
+type (
+	// CircuitBreakerState is the state of a persistence circuit breaker
+	CircuitBreakerState int
+
+	// CircuitBreakerToken identifies the state a call was allowed in, it must be passed back to Record
+	CircuitBreakerToken int64
+
+	// CircuitBreakerStatus is a snapshot of a persistence circuit breaker
+	CircuitBreakerStatus struct {
+		StoreName       string
+		OperationClass  string
+		State           CircuitBreakerState
+		Requests        int64
+		Failures        int64
+		SlowCalls       int64
+		LastStateChange time.Time
+	}
+
+	// CircuitBreaker trips when calls to a datastore fail or are slow too often,
+	// and fails fast while tripped so that callers do not pile up on an unhealthy datastore
+	CircuitBreaker interface {
+		// Allow returns ErrPersistenceCircuitBreakerOpen if the call should not reach the datastore,
+		// otherwise it returns the token the outcome of the call is recorded with
+		Allow() (CircuitBreakerToken, error)
+		// Record records the outcome of a call which was allowed, outcomes of calls allowed
+		// before the last state change are ignored
+		Record(token CircuitBreakerToken, latency time.Duration, err error)
+		// Status returns a snapshot of the circuit breaker
+		Status() *CircuitBreakerStatus
+	}
+
+	circuitBreakerImpl struct {
+		sync.Mutex
+
+		storeName      string
+		operationClass string
+		config         *config.PersistenceCircuitBreaker
+		timeSource     clock.TimeSource
+		metricsScope   metrics.Scope
+		logger         log.Logger
+
+		state           CircuitBreakerState
+		generation      CircuitBreakerToken
+		lastStateChange time.Time
+		probeInFlight   bool
+		windowStart     time.Time
+		requests        int64
+		failures        int64
+		slowCalls       int64
+	}
+)
+
+var _ CircuitBreaker = (*circuitBreakerImpl)(nil)
+
+// NewCircuitBreaker creates a circuit breaker for the given datastore and operation class
+func NewCircuitBreaker(
+	storeName string,
+	operationClass string,
+	config *config.PersistenceCircuitBreaker,
+	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) CircuitBreaker {
+	var metricsScope metrics.Scope = metrics.NoopScope(metrics.Common)
+	if metricsClient != nil {
+		metricsScope = metricsClient.Scope(
+			metrics.PersistenceCircuitBreakerScope,
+			metrics.PersistenceStoreTag(storeName),
+			metrics.PersistenceOperationClassTag(operationClass),
+		)
+	}
+	now := timeSource.Now()
+	return &circuitBreakerImpl{
+		storeName:       storeName,
+		operationClass:  operationClass,
+		config:          config,
+		timeSource:      timeSource,
+		metricsScope:    metricsScope,
+		logger:          logger.WithTags(tag.StoreType(storeName), tag.StoreOperationClass(operationClass)),
+		state:           CircuitBreakerStateClosed,
+		lastStateChange: now,
+		windowStart:     now,
+	}
+}
+
+func (c *circuitBreakerImpl) Allow() (CircuitBreakerToken, error) {
+	if !c.config.Enabled() {
+		return 0, nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	switch c.state {
+	case CircuitBreakerStateOpen:
+		if c.timeSource.Now().Sub(c.lastStateChange) < c.config.OpenDuration() {
+			c.metricsScope.IncCounter(metrics.PersistenceCircuitBreakerRejectedCounter)
+			return 0, ErrPersistenceCircuitBreakerOpen
+		}
+		c.transitionLocked(CircuitBreakerStateHalfOpen)
+		c.probeInFlight = true
+		return c.generation, nil
+	case CircuitBreakerStateHalfOpen:
+		if c.probeInFlight {
+			c.metricsScope.IncCounter(metrics.PersistenceCircuitBreakerRejectedCounter)
+			return 0, ErrPersistenceCircuitBreakerOpen
+		}
+		c.probeInFlight = true
+		return c.generation, nil
+	default:
+		return c.generation, nil
+	}
+}
+
+func (c *circuitBreakerImpl) Record(
+	token CircuitBreakerToken,
+	latency time.Duration,
+	err error,
+) {
+	if !c.config.Enabled() {
+		return
+	}
+
+	failed := IsDatastoreUnhealthyError(err)
+	slow := latency > c.config.SlowCallLatency()
+
+	c.Lock()
+	defer c.Unlock()
+
+	if token != c.generation {
+		// the call was allowed before the last state change, e.g. it was still in flight when
+		// the breaker tripped, so its outcome says nothing about the current state
+		return
+	}
+
+	switch c.state {
+	case CircuitBreakerStateHalfOpen:
+		c.probeInFlight = false
+		if failed || slow {
+			c.transitionLocked(CircuitBreakerStateOpen)
+		} else {
+			c.transitionLocked(CircuitBreakerStateClosed)
+		}
+	case CircuitBreakerStateClosed:
+		now := c.timeSource.Now()
+		if now.Sub(c.windowStart) > c.config.Window() {
+			c.resetWindowLocked(now)
+		}
+		c.requests++
+		if failed {
+			c.failures++
+		}
+		if slow {
+			c.slowCalls++
+		}
+		if c.shouldTripLocked() {
+			c.transitionLocked(CircuitBreakerStateOpen)
+		}
+	}
+}
+
+func (c *circuitBreakerImpl) Status() *CircuitBreakerStatus {
+	c.Lock()
+	defer c.Unlock()
+
+	return &CircuitBreakerStatus{
+		StoreName:       c.storeName,
+		OperationClass:  c.operationClass,
+		State:           c.state,
+		Requests:        c.requests,
+		Failures:        c.failures,
+		SlowCalls:       c.slowCalls,
+		LastStateChange: c.lastStateChange,
+	}
+}
+
+func (c *circuitBreakerImpl) shouldTripLocked() bool {
+	if c.requests < int64(c.config.MinRequests()) {
+		return false
+	}
+	errorRate := float64(c.failures) / float64(c.requests)
+	slowCallRate := float64(c.slowCalls) / float64(c.requests)
+	return errorRate >= c.config.ErrorRateThreshold() || slowCallRate >= c.config.SlowCallRateThreshold()
+}
+
+func (c *circuitBreakerImpl) transitionLocked(state CircuitBreakerState) {
+	if c.state == state {
+		return
+	}
+
+	c.logger.Warn("Persistence circuit breaker state changed.",
+		tag.PrevCircuitBreakerState(c.state.String()),
+		tag.CircuitBreakerState(state.String()),
+	)
+	now := c.timeSource.Now()
+	c.state = state
+	c.generation++
+	c.lastStateChange = now
+	c.resetWindowLocked(now)
+	if state == CircuitBreakerStateOpen {
+		c.metricsScope.IncCounter(metrics.PersistenceCircuitBreakerTrippedCounter)
+	}
+	c.metricsScope.UpdateGauge(metrics.PersistenceCircuitBreakerStateGauge, float64(state))
+}
+
+func (c *circuitBreakerImpl) resetWindowLocked(now time.Time) {
+	c.windowStart = now
+	c.requests = 0
+	c.failures = 0
+	c.slowCalls = 0
+}
+
+// String returns the name of the circuit breaker state
+func (s CircuitBreakerState) String() string {
+	switch s {
+	case CircuitBreakerStateClosed:
+		return "closed"
+	case CircuitBreakerStateOpen:
+		return "open"
+	case CircuitBreakerStateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// IsDatastoreUnhealthyError returns true if the error indicates the datastore itself is unhealthy,
+// as opposed to errors which are expected outcomes of a call such as condition failures
+func IsDatastoreUnhealthyError(err error) bool {
+	switch err.(type) {
+	case nil:
+		return false
+	case *TimeoutError,
+		*types.ServiceBusyError,
+		*types.InternalServiceError:
+		return true
+	default:
+		return false
+	}
+}
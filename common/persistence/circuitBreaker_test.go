@@ -0,0 +1,188 @@
+// Copyright (c) 2017-2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	circuitBreakerSuite struct {
+		suite.Suite
+
+		timeSource *clock.EventTimeSource
+		breaker    CircuitBreaker
+	}
+)
+
+func TestCircuitBreakerSuite(t *testing.T) {
+	s := new(circuitBreakerSuite)
+	suite.Run(t, s)
+}
+
+func (s *circuitBreakerSuite) SetupTest() {
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.breaker = NewCircuitBreaker(
+		"execution",
+		CircuitBreakerOperationClassWrite,
+		&config.PersistenceCircuitBreaker{
+			Enabled:               dynamicconfig.GetBoolPropertyFn(true),
+			ErrorRateThreshold:    dynamicconfig.GetFloatPropertyFn(0.5),
+			SlowCallRateThreshold: dynamicconfig.GetFloatPropertyFn(0.8),
+			SlowCallLatency:       dynamicconfig.GetDurationPropertyFn(time.Second),
+			MinRequests:           dynamicconfig.GetIntPropertyFn(10),
+			Window:                dynamicconfig.GetDurationPropertyFn(10 * time.Second),
+			OpenDuration:          dynamicconfig.GetDurationPropertyFn(5 * time.Second),
+		},
+		s.timeSource,
+		nil,
+		loggerimpl.NewNopLogger(),
+	)
+}
+
+func (s *circuitBreakerSuite) TestTripOnErrorRate() {
+	for i := 0; i < 5; i++ {
+		s.call(time.Millisecond, nil)
+	}
+	for i := 0; i < 4; i++ {
+		s.call(time.Millisecond, &TimeoutError{Msg: "timeout"})
+	}
+	s.Equal(CircuitBreakerStateClosed, s.breaker.Status().State)
+
+	s.call(time.Millisecond, &TimeoutError{Msg: "timeout"})
+	s.Equal(CircuitBreakerStateOpen, s.breaker.Status().State)
+	s.rejected()
+}
+
+func (s *circuitBreakerSuite) TestTripOnSlowCalls() {
+	for i := 0; i < 10; i++ {
+		s.call(2*time.Second, nil)
+	}
+	s.Equal(CircuitBreakerStateOpen, s.breaker.Status().State)
+}
+
+func (s *circuitBreakerSuite) TestExpectedErrorsDoNotTrip() {
+	for i := 0; i < 20; i++ {
+		s.call(time.Millisecond, &ConditionFailedError{Msg: "condition failed"})
+		s.call(time.Millisecond, &types.EntityNotExistsError{Message: "not exists"})
+	}
+	s.Equal(CircuitBreakerStateClosed, s.breaker.Status().State)
+}
+
+func (s *circuitBreakerSuite) TestWindowReset() {
+	for i := 0; i < 9; i++ {
+		s.call(time.Millisecond, &TimeoutError{Msg: "timeout"})
+	}
+	s.timeSource.Update(s.timeSource.Now().Add(11 * time.Second))
+	s.call(time.Millisecond, &TimeoutError{Msg: "timeout"})
+	s.Equal(CircuitBreakerStateClosed, s.breaker.Status().State)
+	s.Equal(int64(1), s.breaker.Status().Requests)
+}
+
+func (s *circuitBreakerSuite) TestProbeRecovery() {
+	s.trip()
+
+	s.timeSource.Update(s.timeSource.Now().Add(6 * time.Second))
+	probe, err := s.breaker.Allow()
+	s.NoError(err)
+	s.Equal(CircuitBreakerStateHalfOpen, s.breaker.Status().State)
+	// only a single probe call is allowed at a time
+	s.rejected()
+
+	s.breaker.Record(probe, time.Millisecond, nil)
+	s.Equal(CircuitBreakerStateClosed, s.breaker.Status().State)
+	_, err = s.breaker.Allow()
+	s.NoError(err)
+}
+
+func (s *circuitBreakerSuite) TestProbeFailure() {
+	s.trip()
+
+	s.timeSource.Update(s.timeSource.Now().Add(6 * time.Second))
+	s.call(time.Millisecond, &types.ServiceBusyError{Message: "busy"})
+	s.Equal(CircuitBreakerStateOpen, s.breaker.Status().State)
+	s.rejected()
+}
+
+func (s *circuitBreakerSuite) TestLateCallsDoNotChangeHalfOpenState() {
+	lateSuccess, err := s.breaker.Allow()
+	s.NoError(err)
+	lateFailure, err := s.breaker.Allow()
+	s.NoError(err)
+	s.trip()
+
+	s.timeSource.Update(s.timeSource.Now().Add(6 * time.Second))
+	probe, err := s.breaker.Allow()
+	s.NoError(err)
+	s.Equal(CircuitBreakerStateHalfOpen, s.breaker.Status().State)
+
+	// calls allowed before the breaker tripped finish while the probe is in flight
+	s.breaker.Record(lateSuccess, time.Millisecond, nil)
+	s.Equal(CircuitBreakerStateHalfOpen, s.breaker.Status().State)
+	s.breaker.Record(lateFailure, time.Millisecond, &TimeoutError{Msg: "timeout"})
+	s.Equal(CircuitBreakerStateHalfOpen, s.breaker.Status().State)
+	// the probe is still in flight, so no second probe is allowed
+	s.rejected()
+
+	s.breaker.Record(probe, time.Millisecond, nil)
+	s.Equal(CircuitBreakerStateClosed, s.breaker.Status().State)
+}
+
+func (s *circuitBreakerSuite) TestLateProbeDoesNotCountInClosedState() {
+	s.trip()
+
+	s.timeSource.Update(s.timeSource.Now().Add(6 * time.Second))
+	probe, err := s.breaker.Allow()
+	s.NoError(err)
+	s.breaker.Record(probe, time.Millisecond, nil)
+	s.Equal(CircuitBreakerStateClosed, s.breaker.Status().State)
+
+	// recording the probe again after the breaker closed is ignored
+	s.breaker.Record(probe, time.Millisecond, &TimeoutError{Msg: "timeout"})
+	s.Equal(int64(0), s.breaker.Status().Requests)
+}
+
+func (s *circuitBreakerSuite) call(latency time.Duration, err error) {
+	token, allowErr := s.breaker.Allow()
+	s.NoError(allowErr)
+	s.breaker.Record(token, latency, err)
+}
+
+func (s *circuitBreakerSuite) rejected() {
+	_, err := s.breaker.Allow()
+	s.Equal(ErrPersistenceCircuitBreakerOpen, err)
+}
+
+func (s *circuitBreakerSuite) trip() {
+	for i := 0; i < 10; i++ {
+		s.call(time.Millisecond, &TimeoutError{Msg: "timeout"})
+	}
+	s.Equal(CircuitBreakerStateOpen, s.breaker.Status().State)
+}
@@ -47,6 +47,18 @@ type (
 		GetDomainReplicationQueueManager() persistence.QueueManager
 		SetDomainReplicationQueueManager(persistence.QueueManager)
 
+		GetMatchingDLQQueueManager() persistence.QueueManager
+		SetMatchingDLQQueueManager(persistence.QueueManager)
+
+		GetShardPlacementQueueManager() persistence.QueueManager
+		SetShardPlacementQueueManager(persistence.QueueManager)
+
+		GetWorkflowQuarantineQueueManager() persistence.QueueManager
+		SetWorkflowQuarantineQueueManager(persistence.QueueManager)
+
+		GetShadowerCheckQueueManager() persistence.QueueManager
+		SetShadowerCheckQueueManager(persistence.QueueManager)
+
 		GetShardManager() persistence.ShardManager
 		SetShardManager(persistence.ShardManager)
 
@@ -55,17 +67,23 @@ type (
 
 		GetExecutionManager(int) (persistence.ExecutionManager, error)
 		SetExecutionManager(int, persistence.ExecutionManager)
+
+		GetCircuitBreakerStatus() []*persistence.CircuitBreakerStatus
 	}
 
 	// BeanImpl stores persistence managers
 	BeanImpl struct {
-		domainManager                 persistence.DomainManager
-		taskManager                   persistence.TaskManager
-		visibilityManager             persistence.VisibilityManager
-		domainReplicationQueueManager persistence.QueueManager
-		shardManager                  persistence.ShardManager
-		historyManager                persistence.HistoryManager
-		executionManagerFactory       persistence.ExecutionManagerFactory
+		domainManager                  persistence.DomainManager
+		taskManager                    persistence.TaskManager
+		visibilityManager              persistence.VisibilityManager
+		domainReplicationQueueManager  persistence.QueueManager
+		matchingDLQQueueManager        persistence.QueueManager
+		shardPlacementQueueManager     persistence.QueueManager
+		workflowQuarantineQueueManager persistence.QueueManager
+		shadowerCheckQueueManager      persistence.QueueManager
+		shardManager                   persistence.ShardManager
+		historyManager                 persistence.HistoryManager
+		executionManagerFactory        persistence.ExecutionManagerFactory
 
 		sync.RWMutex
 		shardIDToExecutionManager map[int]persistence.ExecutionManager
@@ -99,6 +117,26 @@ func NewBeanFromFactory(
 		return nil, err
 	}
 
+	matchingDLQQueue, err := factory.NewMatchingDLQQueueManager()
+	if err != nil {
+		return nil, err
+	}
+
+	shardPlacementQueue, err := factory.NewShardPlacementQueueManager()
+	if err != nil {
+		return nil, err
+	}
+
+	workflowQuarantineQueue, err := factory.NewWorkflowQuarantineQueueManager()
+	if err != nil {
+		return nil, err
+	}
+
+	shadowerCheckQueue, err := factory.NewShadowerCheckQueueManager()
+	if err != nil {
+		return nil, err
+	}
+
 	shardMgr, err := factory.NewShardManager()
 	if err != nil {
 		return nil, err
@@ -114,6 +152,10 @@ func NewBeanFromFactory(
 		taskMgr,
 		visibilityMgr,
 		domainReplicationQueue,
+		matchingDLQQueue,
+		shardPlacementQueue,
+		workflowQuarantineQueue,
+		shadowerCheckQueue,
 		shardMgr,
 		historyMgr,
 		factory,
@@ -126,18 +168,26 @@ func NewBean(
 	taskManager persistence.TaskManager,
 	visibilityManager persistence.VisibilityManager,
 	domainReplicationQueueManager persistence.QueueManager,
+	matchingDLQQueueManager persistence.QueueManager,
+	shardPlacementQueueManager persistence.QueueManager,
+	workflowQuarantineQueueManager persistence.QueueManager,
+	shadowerCheckQueueManager persistence.QueueManager,
 	shardManager persistence.ShardManager,
 	historyManager persistence.HistoryManager,
 	executionManagerFactory persistence.ExecutionManagerFactory,
 ) *BeanImpl {
 	return &BeanImpl{
-		domainManager:                 domainManager,
-		taskManager:                   taskManager,
-		visibilityManager:             visibilityManager,
-		domainReplicationQueueManager: domainReplicationQueueManager,
-		shardManager:                  shardManager,
-		historyManager:                historyManager,
-		executionManagerFactory:       executionManagerFactory,
+		domainManager:                  domainManager,
+		taskManager:                    taskManager,
+		visibilityManager:              visibilityManager,
+		domainReplicationQueueManager:  domainReplicationQueueManager,
+		matchingDLQQueueManager:        matchingDLQQueueManager,
+		shardPlacementQueueManager:     shardPlacementQueueManager,
+		workflowQuarantineQueueManager: workflowQuarantineQueueManager,
+		shadowerCheckQueueManager:      shadowerCheckQueueManager,
+		shardManager:                   shardManager,
+		historyManager:                 historyManager,
+		executionManagerFactory:        executionManagerFactory,
 
 		shardIDToExecutionManager: make(map[int]persistence.ExecutionManager),
 	}
@@ -223,6 +273,86 @@ func (s *BeanImpl) SetDomainReplicationQueueManager(
 	s.domainReplicationQueueManager = domainReplicationQueueManager
 }
 
+// GetMatchingDLQQueueManager gets the QueueManager of the dead letter queue of matching tasks
+func (s *BeanImpl) GetMatchingDLQQueueManager() persistence.QueueManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.matchingDLQQueueManager
+}
+
+// SetMatchingDLQQueueManager sets the QueueManager of the dead letter queue of matching tasks
+func (s *BeanImpl) SetMatchingDLQQueueManager(
+	matchingDLQQueueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.matchingDLQQueueManager = matchingDLQQueueManager
+}
+
+// GetShardPlacementQueueManager gets the QueueManager of the placement of history shards
+func (s *BeanImpl) GetShardPlacementQueueManager() persistence.QueueManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.shardPlacementQueueManager
+}
+
+// SetShardPlacementQueueManager sets the QueueManager of the placement of history shards
+func (s *BeanImpl) SetShardPlacementQueueManager(
+	shardPlacementQueueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardPlacementQueueManager = shardPlacementQueueManager
+}
+
+// GetWorkflowQuarantineQueueManager gets the QueueManager of quarantined workflow executions
+func (s *BeanImpl) GetWorkflowQuarantineQueueManager() persistence.QueueManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.workflowQuarantineQueueManager
+}
+
+// SetWorkflowQuarantineQueueManager sets the QueueManager of quarantined workflow executions
+func (s *BeanImpl) SetWorkflowQuarantineQueueManager(
+	workflowQuarantineQueueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.workflowQuarantineQueueManager = workflowQuarantineQueueManager
+}
+
+// GetShadowerCheckQueueManager gets the QueueManager of passed compatibility checks of workflow builds
+func (s *BeanImpl) GetShadowerCheckQueueManager() persistence.QueueManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.shadowerCheckQueueManager
+}
+
+// SetShadowerCheckQueueManager sets the QueueManager of passed compatibility checks of workflow builds
+func (s *BeanImpl) SetShadowerCheckQueueManager(
+	shadowerCheckQueueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.shadowerCheckQueueManager = shadowerCheckQueueManager
+}
+
 // GetShardManager get ShardManager
 func (s *BeanImpl) GetShardManager() persistence.ShardManager {
 
@@ -305,6 +435,16 @@ func (s *BeanImpl) SetExecutionManager(
 	s.shardIDToExecutionManager[shardID] = executionManager
 }
 
+// GetCircuitBreakerStatus get the status of persistence circuit breakers
+func (s *BeanImpl) GetCircuitBreakerStatus() []*persistence.CircuitBreakerStatus {
+
+	factory, ok := s.executionManagerFactory.(Factory)
+	if !ok {
+		return nil
+	}
+	return factory.GetCircuitBreakerStatus()
+}
+
 // Close cleanup connections
 func (s *BeanImpl) Close() {
 
@@ -318,6 +458,10 @@ func (s *BeanImpl) Close() {
 		s.visibilityManager.Close()
 	}
 	s.domainReplicationQueueManager.Close()
+	s.matchingDLQQueueManager.Close()
+	s.shardPlacementQueueManager.Close()
+	s.workflowQuarantineQueueManager.Close()
+	s.shadowerCheckQueueManager.Close()
 	s.shardManager.Close()
 	s.historyManager.Close()
 	s.executionManagerFactory.Close()
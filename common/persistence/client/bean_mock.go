@@ -173,6 +173,110 @@ func (mr *MockBeanMockRecorder) SetDomainReplicationQueueManager(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDomainReplicationQueueManager", reflect.TypeOf((*MockBean)(nil).SetDomainReplicationQueueManager), arg0)
 }
 
+// GetMatchingDLQQueueManager mocks base method
+func (m *MockBean) GetMatchingDLQQueueManager() persistence.QueueManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatchingDLQQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	return ret0
+}
+
+// GetMatchingDLQQueueManager indicates an expected call of GetMatchingDLQQueueManager
+func (mr *MockBeanMockRecorder) GetMatchingDLQQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchingDLQQueueManager", reflect.TypeOf((*MockBean)(nil).GetMatchingDLQQueueManager))
+}
+
+// SetMatchingDLQQueueManager mocks base method
+func (m *MockBean) SetMatchingDLQQueueManager(arg0 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMatchingDLQQueueManager", arg0)
+}
+
+// SetMatchingDLQQueueManager indicates an expected call of SetMatchingDLQQueueManager
+func (mr *MockBeanMockRecorder) SetMatchingDLQQueueManager(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMatchingDLQQueueManager", reflect.TypeOf((*MockBean)(nil).SetMatchingDLQQueueManager), arg0)
+}
+
+// GetShardPlacementQueueManager mocks base method
+func (m *MockBean) GetShardPlacementQueueManager() persistence.QueueManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardPlacementQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	return ret0
+}
+
+// GetShardPlacementQueueManager indicates an expected call of GetShardPlacementQueueManager
+func (mr *MockBeanMockRecorder) GetShardPlacementQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardPlacementQueueManager", reflect.TypeOf((*MockBean)(nil).GetShardPlacementQueueManager))
+}
+
+// SetShardPlacementQueueManager mocks base method
+func (m *MockBean) SetShardPlacementQueueManager(arg0 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetShardPlacementQueueManager", arg0)
+}
+
+// SetShardPlacementQueueManager indicates an expected call of SetShardPlacementQueueManager
+func (mr *MockBeanMockRecorder) SetShardPlacementQueueManager(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShardPlacementQueueManager", reflect.TypeOf((*MockBean)(nil).SetShardPlacementQueueManager), arg0)
+}
+
+// GetWorkflowQuarantineQueueManager mocks base method
+func (m *MockBean) GetWorkflowQuarantineQueueManager() persistence.QueueManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowQuarantineQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	return ret0
+}
+
+// GetWorkflowQuarantineQueueManager indicates an expected call of GetWorkflowQuarantineQueueManager
+func (mr *MockBeanMockRecorder) GetWorkflowQuarantineQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowQuarantineQueueManager", reflect.TypeOf((*MockBean)(nil).GetWorkflowQuarantineQueueManager))
+}
+
+// SetWorkflowQuarantineQueueManager mocks base method
+func (m *MockBean) SetWorkflowQuarantineQueueManager(arg0 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetWorkflowQuarantineQueueManager", arg0)
+}
+
+// SetWorkflowQuarantineQueueManager indicates an expected call of SetWorkflowQuarantineQueueManager
+func (mr *MockBeanMockRecorder) SetWorkflowQuarantineQueueManager(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkflowQuarantineQueueManager", reflect.TypeOf((*MockBean)(nil).SetWorkflowQuarantineQueueManager), arg0)
+}
+
+// GetShadowerCheckQueueManager mocks base method
+func (m *MockBean) GetShadowerCheckQueueManager() persistence.QueueManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShadowerCheckQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	return ret0
+}
+
+// GetShadowerCheckQueueManager indicates an expected call of GetShadowerCheckQueueManager
+func (mr *MockBeanMockRecorder) GetShadowerCheckQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShadowerCheckQueueManager", reflect.TypeOf((*MockBean)(nil).GetShadowerCheckQueueManager))
+}
+
+// SetShadowerCheckQueueManager mocks base method
+func (m *MockBean) SetShadowerCheckQueueManager(arg0 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetShadowerCheckQueueManager", arg0)
+}
+
+// SetShadowerCheckQueueManager indicates an expected call of SetShadowerCheckQueueManager
+func (mr *MockBeanMockRecorder) SetShadowerCheckQueueManager(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShadowerCheckQueueManager", reflect.TypeOf((*MockBean)(nil).SetShadowerCheckQueueManager), arg0)
+}
+
 // GetShardManager mocks base method
 func (m *MockBean) GetShardManager() persistence.ShardManager {
 	m.ctrl.T.Helper()
@@ -251,3 +355,17 @@ func (mr *MockBeanMockRecorder) SetExecutionManager(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExecutionManager", reflect.TypeOf((*MockBean)(nil).SetExecutionManager), arg0, arg1)
 }
+
+// GetCircuitBreakerStatus mocks base method
+func (m *MockBean) GetCircuitBreakerStatus() []*persistence.CircuitBreakerStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCircuitBreakerStatus")
+	ret0, _ := ret[0].([]*persistence.CircuitBreakerStatus)
+	return ret0
+}
+
+// GetCircuitBreakerStatus indicates an expected call of GetCircuitBreakerStatus
+func (mr *MockBeanMockRecorder) GetCircuitBreakerStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCircuitBreakerStatus", reflect.TypeOf((*MockBean)(nil).GetCircuitBreakerStatus))
+}
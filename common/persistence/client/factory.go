@@ -24,9 +24,11 @@ import (
 	"sync"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
 	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/faultinjection"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -62,6 +64,18 @@ type (
 		NewVisibilityManager(params *service.BootstrapParams, resourceConfig *rc.ResourceConfig) (p.VisibilityManager, error)
 		// NewDomainReplicationQueueManager returns a new queue for domain replication
 		NewDomainReplicationQueueManager() (p.QueueManager, error)
+		// NewDynamicConfigQueueManager returns a new queue for dynamic config changes
+		NewDynamicConfigQueueManager() (p.QueueManager, error)
+		// NewMatchingDLQQueueManager returns a new queue for the dead letter queue of matching tasks
+		NewMatchingDLQQueueManager() (p.QueueManager, error)
+		// NewShardPlacementQueueManager returns a new queue for the placement of history shards
+		NewShardPlacementQueueManager() (p.QueueManager, error)
+		// NewWorkflowQuarantineQueueManager returns a new queue for quarantined workflow executions
+		NewWorkflowQuarantineQueueManager() (p.QueueManager, error)
+		// NewShadowerCheckQueueManager returns a new queue for the passed compatibility checks of workflow builds
+		NewShadowerCheckQueueManager() (p.QueueManager, error)
+		// GetCircuitBreakerStatus returns the status of the circuit breakers of all datastores
+		GetCircuitBreakerStatus() []*p.CircuitBreakerStatus
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -87,8 +101,10 @@ type (
 
 	// Datastore represents a datastore
 	Datastore struct {
-		factory   DataStoreFactory
-		ratelimit quotas.Limiter
+		factory      DataStoreFactory
+		ratelimit    quotas.Limiter
+		readBreaker  p.CircuitBreaker
+		writeBreaker p.CircuitBreaker
 	}
 	factoryImpl struct {
 		sync.RWMutex
@@ -97,6 +113,7 @@ type (
 		logger        log.Logger
 		datastores    map[storeType]Datastore
 		clusterName   string
+		faultInjector faultinjection.Injector
 	}
 
 	storeType int
@@ -112,6 +129,16 @@ const (
 	storeTypeQueue
 )
 
+var storeTypeNames = map[storeType]string{
+	storeTypeHistory:    "history",
+	storeTypeTask:       "task",
+	storeTypeShard:      "shard",
+	storeTypeMetadata:   "metadata",
+	storeTypeExecution:  "execution",
+	storeTypeVisibility: "visibility",
+	storeTypeQueue:      "queue",
+}
+
 var storeTypes = []storeType{
 	storeTypeHistory,
 	storeTypeTask,
@@ -142,8 +169,12 @@ func NewFactory(
 		logger:        logger,
 		clusterName:   clusterName,
 	}
+	if cfg.FaultInjection != nil {
+		factory.faultInjector = faultinjection.NewInjector(faultinjection.LayerPersistence, cfg.FaultInjection, p.ErrFakeTimeout, logger)
+	}
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters)
+	factory.initCircuitBreakers()
 	return factory
 }
 
@@ -158,6 +189,12 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewTaskPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
+	if f.faultInjector != nil {
+		result = p.NewTaskPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
+	if ds.readBreaker != nil {
+		result = p.NewTaskPersistenceCircuitBreakerClient(result, ds.readBreaker, ds.writeBreaker, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -178,6 +215,12 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewShardPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
+	if f.faultInjector != nil {
+		result = p.NewShardPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
+	if ds.readBreaker != nil {
+		result = p.NewShardPersistenceCircuitBreakerClient(result, ds.readBreaker, ds.writeBreaker, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -198,6 +241,12 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewHistoryPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
+	if f.faultInjector != nil {
+		result = p.NewHistoryPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
+	if ds.readBreaker != nil {
+		result = p.NewHistoryPersistenceCircuitBreakerClient(result, ds.readBreaker, ds.writeBreaker, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -220,6 +269,12 @@ func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewDomainPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
+	if f.faultInjector != nil {
+		result = p.NewDomainPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
+	if ds.readBreaker != nil {
+		result = p.NewDomainPersistenceCircuitBreakerClient(result, ds.readBreaker, ds.writeBreaker, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewDomainPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -240,6 +295,12 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewWorkflowExecutionPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
+	if f.faultInjector != nil {
+		result = p.NewWorkflowExecutionPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
+	if ds.readBreaker != nil {
+		result = p.NewWorkflowExecutionPersistenceCircuitBreakerClient(result, ds.readBreaker, ds.writeBreaker, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -336,6 +397,12 @@ func (f *factoryImpl) newDBVisibilityManager(
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewVisibilityPersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
+	if f.faultInjector != nil {
+		result = p.NewVisibilityPersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
+	if ds.readBreaker != nil {
+		result = p.NewVisibilityPersistenceCircuitBreakerClient(result, ds.readBreaker, ds.writeBreaker, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -354,8 +421,32 @@ func (f *factoryImpl) newDBVisibilityManager(
 }
 
 func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.DomainReplicationQueueType)
+}
+
+func (f *factoryImpl) NewDynamicConfigQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.DynamicConfigQueueType)
+}
+
+func (f *factoryImpl) NewMatchingDLQQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.MatchingDLQQueueType)
+}
+
+func (f *factoryImpl) NewShardPlacementQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.ShardPlacementQueueType)
+}
+
+func (f *factoryImpl) NewWorkflowQuarantineQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.WorkflowQuarantineQueueType)
+}
+
+func (f *factoryImpl) NewShadowerCheckQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.ShadowerCheckQueueType)
+}
+
+func (f *factoryImpl) newQueueManager(queueType p.QueueType) (p.QueueManager, error) {
 	ds := f.datastores[storeTypeQueue]
-	store, err := ds.factory.NewQueue(p.DomainReplicationQueueType)
+	store, err := ds.factory.NewQueue(queueType)
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +454,12 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
 		result = p.NewQueuePersistenceErrorInjectionClient(result, errorRate, f.logger)
 	}
+	if f.faultInjector != nil {
+		result = p.NewQueuePersistenceFaultInjectionClient(result, f.faultInjector, f.logger)
+	}
+	if ds.readBreaker != nil {
+		result = p.NewQueuePersistenceCircuitBreakerClient(result, ds.readBreaker, ds.writeBreaker, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -373,6 +470,19 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 	return result, nil
 }
 
+// GetCircuitBreakerStatus returns the status of the circuit breakers of all datastores
+func (f *factoryImpl) GetCircuitBreakerStatus() []*p.CircuitBreakerStatus {
+	var result []*p.CircuitBreakerStatus
+	for _, st := range storeTypes {
+		ds, ok := f.datastores[st]
+		if !ok || ds.readBreaker == nil {
+			continue
+		}
+		result = append(result, ds.readBreaker.Status(), ds.writeBreaker.Status())
+	}
+	return result
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
@@ -448,6 +558,19 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	f.datastores[storeTypeVisibility] = visibilityDataStore
 }
 
+func (f *factoryImpl) initCircuitBreakers() {
+	if f.config.CircuitBreaker == nil {
+		return
+	}
+	timeSource := clock.NewRealTimeSource()
+	for st, ds := range f.datastores {
+		name := storeTypeNames[st]
+		ds.readBreaker = p.NewCircuitBreaker(name, p.CircuitBreakerOperationClassRead, f.config.CircuitBreaker, timeSource, f.metricsClient, f.logger)
+		ds.writeBreaker = p.NewCircuitBreaker(name, p.CircuitBreakerOperationClassWrite, f.config.CircuitBreaker, timeSource, f.metricsClient, f.logger)
+		f.datastores[st] = ds
+	}
+}
+
 func getSQLParser(logger log.Logger, encodingType common.EncodingType, decodingTypes ...common.EncodingType) serialization.Parser {
 	parser, err := serialization.NewParser(encodingType, decodingTypes...)
 	if err != nil {
@@ -62,6 +62,11 @@ type QueueType int
 // Negative numbers are reserved for DLQ
 const (
 	DomainReplicationQueueType QueueType = iota + 1
+	DynamicConfigQueueType
+	MatchingDLQQueueType
+	ShardPlacementQueueType
+	WorkflowQuarantineQueueType
+	ShadowerCheckQueueType
 )
 
 // Create Workflow Execution Mode
@@ -184,6 +189,7 @@ const UnknownNumRowsAffected = -1
 const (
 	WorkflowBackoffTimeoutTypeRetry = iota
 	WorkflowBackoffTimeoutTypeCron
+	WorkflowBackoffTimeoutTypeDelayStart
 )
 
 const (
@@ -333,6 +339,22 @@ type (
 		CronSchedule      string
 		IsCron            bool
 		ExpirationSeconds int32 // TODO: is this field useful?
+		// Dispatch priority and fairness key of decision tasks
+		TaskPriority    int32
+		TaskFairnessKey string
+		// Isolation group the tasks of the workflow are routed to
+		IsolationGroup string
+		// Backoff schedule, jitter and failure reason policies of the retry policy,
+		// and the deadline of the total elapsed time of retries, which is carried over continue as new
+		RetryBackoffSchedule       []int32
+		RetryJitterMode            types.RetryJitterMode
+		RetryReasonPolicies        []*types.RetryReasonPolicy
+		RetryElapsedExpirationTime time.Time
+		// Time the run started by the retry policy is scheduled to start, or the next run once this run failed
+		NextRetryTime time.Time
+		// Request IDs of signal, requestCancel and terminate requests and the time they were received, used to
+		// drop duplicated requests within the dedup window and carried over continue as new and reset
+		DedupRequestIDs map[string]time.Time
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -424,6 +446,11 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		// worker build ID version sets, only set on the root decision task list
+		VersionSets []*types.WorkerVersionSet
+		// pause and migration state, only set on root task lists
+		Paused    bool
+		MigrateTo string
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -436,6 +463,15 @@ type (
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		// Dispatch priority and fairness key of the task
+		Priority    int32
+		FairnessKey string
+		// Not written to database yet - worker build which last processed the workflow
+		BuildID string
+		// Isolation group of the workflow the task belongs to
+		IsolationGroup string
+		// Not written to database - attempt of the activity or decision when the task was added
+		Attempt int64
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -728,6 +764,13 @@ type (
 		LastFailureDetails []byte
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
+		// Dispatch priority and fairness key of the activity task
+		Priority    int32
+		FairnessKey string
+		// Backoff schedule, jitter and failure reason policies of the retry policy
+		RetryBackoffSchedule []int32
+		RetryJitterMode      types.RetryJitterMode
+		RetryReasonPolicies  []*types.RetryReasonPolicy
 	}
 
 	// TimerInfo details - metadata about user timer info.
@@ -1249,6 +1292,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              types.BadBinaries
+		DrainedIsolationGroups   []string
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
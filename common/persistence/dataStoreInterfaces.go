@@ -311,6 +311,17 @@ type (
 		ExpirationSeconds  time.Duration
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
+		TaskPriority       int32
+		TaskFairnessKey    string
+		IsolationGroup     string
+		// for retry policy backoff schedule, jitter and failure reason policies
+		RetryBackoffSchedule       []int32
+		RetryJitterMode            types.RetryJitterMode
+		RetryReasonPolicies        *DataBlob
+		RetryElapsedExpirationTime time.Time
+		NextRetryTime              time.Time
+		// request IDs kept for deduplication and the time they were received
+		DedupRequestIDs map[string]time.Time
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -369,6 +380,12 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		Priority           int32
+		FairnessKey        string
+		// for retry policy backoff schedule, jitter and failure reason policies
+		RetryBackoffSchedule []int32
+		RetryJitterMode      types.RetryJitterMode
+		RetryReasonPolicies  *DataBlob
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -744,6 +761,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *DataBlob
+		DrainedIsolationGroups   []string
 	}
 
 	// InternalCreateDomainRequest is used to create the domain
@@ -844,6 +862,9 @@ type (
 		ScheduleToStartTimeout time.Duration
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		Priority               int32
+		FairnessKey            string
+		IsolationGroup         string
 	}
 
 	// InternalCreateTasksInfo describes a task to be created in InternalCreateTasksRequest
@@ -129,6 +129,11 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		return nil, nil, err
 	}
 
+	retryReasonPolicies, err := m.serializer.DeserializeRetryReasonPolicies(info.RetryReasonPolicies)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
 
@@ -186,6 +191,15 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
+		TaskPriority:                       info.TaskPriority,
+		TaskFairnessKey:                    info.TaskFairnessKey,
+		IsolationGroup:                     info.IsolationGroup,
+		RetryBackoffSchedule:               info.RetryBackoffSchedule,
+		RetryJitterMode:                    info.RetryJitterMode,
+		RetryReasonPolicies:                retryReasonPolicies,
+		RetryElapsedExpirationTime:         info.RetryElapsedExpirationTime,
+		NextRetryTime:                      info.NextRetryTime,
+		DedupRequestIDs:                    info.DedupRequestIDs,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -268,6 +282,10 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		retryReasonPolicies, err := m.serializer.DeserializeRetryReasonPolicies(v.RetryReasonPolicies)
+		if err != nil {
+			return nil, err
+		}
 		a := &ActivityInfo{
 			ScheduledEvent: scheduledEvent,
 			StartedEvent:   startedEvent,
@@ -303,6 +321,11 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			Priority:                                v.Priority,
+			FairnessKey:                             v.FairnessKey,
+			RetryBackoffSchedule:                    v.RetryBackoffSchedule,
+			RetryJitterMode:                         v.RetryJitterMode,
+			RetryReasonPolicies:                     retryReasonPolicies,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -393,6 +416,10 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		retryReasonPolicies, err := m.serializer.SerializeRetryReasonPolicies(v.RetryReasonPolicies, encoding)
+		if err != nil {
+			return nil, err
+		}
 		i := &InternalActivityInfo{
 			Version:                                 v.Version,
 			ScheduleID:                              v.ScheduleID,
@@ -427,6 +454,11 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
 			LastFailureDetails:                      v.LastFailureDetails,
+			Priority:                                v.Priority,
+			FairnessKey:                             v.FairnessKey,
+			RetryBackoffSchedule:                    v.RetryBackoffSchedule,
+			RetryJitterMode:                         v.RetryJitterMode,
+			RetryReasonPolicies:                     retryReasonPolicies,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -453,6 +485,11 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		return nil, err
 	}
 
+	retryReasonPolicies, err := m.serializer.SerializeRetryReasonPolicies(info.RetryReasonPolicies, encoding)
+	if err != nil {
+		return nil, err
+	}
+
 	return &InternalWorkflowExecutionInfo{
 		DomainID:                           info.DomainID,
 		WorkflowID:                         info.WorkflowID,
@@ -508,6 +545,15 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		ExpirationSeconds:                  common.SecondsToDuration(int64(info.ExpirationSeconds)),
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
+		TaskPriority:                       info.TaskPriority,
+		TaskFairnessKey:                    info.TaskFairnessKey,
+		IsolationGroup:                     info.IsolationGroup,
+		RetryBackoffSchedule:               info.RetryBackoffSchedule,
+		RetryJitterMode:                    info.RetryJitterMode,
+		RetryReasonPolicies:                retryReasonPolicies,
+		RetryElapsedExpirationTime:         info.RetryElapsedExpirationTime,
+		NextRetryTime:                      info.NextRetryTime,
+		DedupRequestIDs:                    info.DedupRequestIDs,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -508,6 +508,7 @@ func (d *nosqlExecutionStore) prepareActivityInfosForWorkflowTxn(
 		}
 		a.ScheduledEvent = a.ScheduledEvent.ToNilSafeDataBlob()
 		a.StartedEvent = a.StartedEvent.ToNilSafeDataBlob()
+		a.RetryReasonPolicies = a.RetryReasonPolicies.ToNilSafeDataBlob()
 		m[a.ScheduleID] = a
 	}
 	return m, nil
@@ -591,6 +592,7 @@ func (d *nosqlExecutionStore) prepareUpdateWorkflowExecutionTxn(
 
 	executionInfo.CompletionEvent = executionInfo.CompletionEvent.ToNilSafeDataBlob()
 	executionInfo.AutoResetPoints = executionInfo.AutoResetPoints.ToNilSafeDataBlob()
+	executionInfo.RetryReasonPolicies = executionInfo.RetryReasonPolicies.ToNilSafeDataBlob()
 	// TODO also need to set the start / current / last write version
 	versionHistories = versionHistories.ToNilSafeDataBlob()
 	return &nosqlplugin.WorkflowExecutionRequest{
@@ -627,6 +629,7 @@ func (d *nosqlExecutionStore) prepareCreateWorkflowExecutionTxn(
 	executionInfo.LastUpdatedTimestamp = nowTimestamp
 	executionInfo.CompletionEvent = executionInfo.CompletionEvent.ToNilSafeDataBlob()
 	executionInfo.AutoResetPoints = executionInfo.AutoResetPoints.ToNilSafeDataBlob()
+	executionInfo.RetryReasonPolicies = executionInfo.RetryReasonPolicies.ToNilSafeDataBlob()
 	if versionHistories == nil {
 		return nil, &types.InternalServiceError{Message: "encounter empty version histories in createExecution"}
 	}
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -54,6 +55,27 @@ func newNoSQLQueueStore(
 		return nil, err
 	}
 
+	return newNoSQLQueueStoreFromDB(db, logger, queueType)
+}
+
+// NewNoSQLQueueStoreFromSession is used to create an instance of Queue implementation
+// It is being used by some admin toolings
+func NewNoSQLQueueStoreFromSession(
+	client gocql.Client,
+	session gocql.Session,
+	logger log.Logger,
+	queueType persistence.QueueType,
+) (persistence.Queue, error) {
+	// TODO hardcoding to Cassandra for now, will switch to dynamically loading later
+	db := cassandra.NewCassandraDBFromSession(client, session, logger)
+	return newNoSQLQueueStoreFromDB(db, logger, queueType)
+}
+
+func newNoSQLQueueStoreFromDB(
+	db nosqlplugin.DB,
+	logger log.Logger,
+	queueType persistence.QueueType,
+) (persistence.Queue, error) {
 	queue := &nosqlQueueStore{
 		nosqlStore: nosqlStore{
 			db:     db,
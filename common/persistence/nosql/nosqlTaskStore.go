@@ -131,6 +131,9 @@ func (t *nosqlTaskStore) LeaseTaskList(
 			TaskListKind:    currTL.TaskListKind,
 			AckLevel:        currTL.AckLevel,
 			LastUpdatedTime: now,
+			VersionSets:     currTL.VersionSets,
+			Paused:          currTL.Paused,
+			MigrateTo:       currTL.MigrateTo,
 		}, currTL.RangeID-1)
 	}
 	if err != nil {
@@ -151,6 +154,9 @@ func (t *nosqlTaskStore) LeaseTaskList(
 		AckLevel:    currTL.AckLevel,
 		Kind:        request.TaskListKind,
 		LastUpdated: now,
+		VersionSets: fromVersionSetsRow(currTL.VersionSets),
+		Paused:      currTL.Paused,
+		MigrateTo:   currTL.MigrateTo,
 	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -169,6 +175,9 @@ func (t *nosqlTaskStore) UpdateTaskList(
 		TaskListKind:    tli.Kind,
 		AckLevel:        tli.AckLevel,
 		LastUpdatedTime: time.Now(),
+		VersionSets:     toVersionSetsRow(tli.VersionSets),
+		Paused:          tli.Paused,
+		MigrateTo:       tli.MigrateTo,
 	}
 
 	if tli.Kind == p.TaskListKindSticky { // if task_list is sticky, then update with TTL
@@ -232,14 +241,17 @@ func (t *nosqlTaskStore) CreateTasks(
 	var tasks []*nosqlplugin.TaskRowForInsert
 	for _, t := range request.Tasks {
 		task := &nosqlplugin.TaskRow{
-			DomainID:     request.TaskListInfo.DomainID,
-			TaskListName: request.TaskListInfo.Name,
-			TaskListType: request.TaskListInfo.TaskType,
-			TaskID:       t.TaskID,
-			WorkflowID:   t.Execution.GetWorkflowID(),
-			RunID:        t.Execution.GetRunID(),
-			ScheduledID:  t.Data.ScheduleID,
-			CreatedTime:  now,
+			DomainID:       request.TaskListInfo.DomainID,
+			TaskListName:   request.TaskListInfo.Name,
+			TaskListType:   request.TaskListInfo.TaskType,
+			TaskID:         t.TaskID,
+			WorkflowID:     t.Execution.GetWorkflowID(),
+			RunID:          t.Execution.GetRunID(),
+			ScheduledID:    t.Data.ScheduleID,
+			CreatedTime:    now,
+			Priority:       t.Data.Priority,
+			FairnessKey:    t.Data.FairnessKey,
+			IsolationGroup: t.Data.IsolationGroup,
 		}
 		ttl := int(t.Data.ScheduleToStartTimeout.Seconds())
 		tasks = append(tasks, &nosqlplugin.TaskRowForInsert{
@@ -273,9 +285,34 @@ func toTaskListRow(info *p.TaskListInfo) *nosqlplugin.TaskListRow {
 		RangeID:         info.RangeID,
 		AckLevel:        info.AckLevel,
 		LastUpdatedTime: info.LastUpdated,
+		VersionSets:     toVersionSetsRow(info.VersionSets),
+		Paused:          info.Paused,
+		MigrateTo:       info.MigrateTo,
 	}
 }
 
+func toVersionSetsRow(sets []*types.WorkerVersionSet) [][]string {
+	if len(sets) == 0 {
+		return nil
+	}
+	result := make([][]string, 0, len(sets))
+	for _, set := range sets {
+		result = append(result, set.GetBuildIDs())
+	}
+	return result
+}
+
+func fromVersionSetsRow(sets [][]string) []*types.WorkerVersionSet {
+	if len(sets) == 0 {
+		return nil
+	}
+	result := make([]*types.WorkerVersionSet, 0, len(sets))
+	for _, buildIDs := range sets {
+		result = append(result, &types.WorkerVersionSet{BuildIDs: buildIDs})
+	}
+	return result
+}
+
 func (t *nosqlTaskStore) GetTasks(
 	ctx context.Context,
 	request *p.GetTasksRequest,
@@ -314,12 +351,15 @@ func (t *nosqlTaskStore) GetTasks(
 
 func toTaskInfo(t *nosqlplugin.TaskRow) *p.InternalTaskInfo {
 	return &p.InternalTaskInfo{
-		DomainID:    t.DomainID,
-		WorkflowID:  t.WorkflowID,
-		RunID:       t.RunID,
-		TaskID:      t.TaskID,
-		ScheduleID:  t.ScheduledID,
-		CreatedTime: t.CreatedTime,
+		DomainID:       t.DomainID,
+		WorkflowID:     t.WorkflowID,
+		RunID:          t.RunID,
+		TaskID:         t.TaskID,
+		ScheduleID:     t.ScheduledID,
+		CreatedTime:    t.CreatedTime,
+		Priority:       t.Priority,
+		FairnessKey:    t.FairnessKey,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
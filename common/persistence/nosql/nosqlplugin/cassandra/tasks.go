@@ -51,7 +51,10 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`version_sets: ?, ` +
+		`paused: ?, ` +
+		`migrate_to: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -59,7 +62,10 @@ const (
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ?, ` +
+		`isolation_group: ? ` +
 		`}`
 
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
@@ -170,6 +176,9 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 	ackLevel := tlDB["ack_level"].(int64)
 	taskListKind := tlDB["kind"].(int)
 	lastUpdatedTime := tlDB["last_updated"].(time.Time)
+	versionSets, _ := tlDB["version_sets"].([][]string)
+	paused, _ := tlDB["paused"].(bool)
+	migrateTo, _ := tlDB["migrate_to"].(string)
 
 	return &nosqlplugin.TaskListRow{
 		DomainID:     filter.DomainID,
@@ -180,6 +189,9 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 		LastUpdatedTime: lastUpdatedTime,
 		AckLevel:        ackLevel,
 		RangeID:         rangeID,
+		VersionSets:     versionSets,
+		Paused:          paused,
+		MigrateTo:       migrateTo,
 	}, nil
 }
 
@@ -199,6 +211,9 @@ func (db *cdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow)
 		0,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		row.VersionSets,
+		row.Paused,
+		row.MigrateTo,
 	).WithContext(ctx)
 
 	previous := make(map[string]interface{})
@@ -225,6 +240,9 @@ func (db *cdb) UpdateTaskList(
 		row.AckLevel,
 		row.TaskListKind,
 		row.LastUpdatedTime,
+		row.VersionSets,
+		row.Paused,
+		row.MigrateTo,
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -288,6 +306,9 @@ func (db *cdb) UpdateTaskListWithTTL(
 		row.AckLevel,
 		row.TaskListKind,
 		time.Now(),
+		row.VersionSets,
+		row.Paused,
+		row.MigrateTo,
 		row.DomainID,
 		row.TaskListName,
 		row.TaskListType,
@@ -358,7 +379,10 @@ func (db *cdb) InsertTasks(
 				task.WorkflowID,
 				task.RunID,
 				scheduleID,
-				task.CreatedTime)
+				task.CreatedTime,
+				task.Priority,
+				task.FairnessKey,
+				task.IsolationGroup)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -374,6 +398,9 @@ func (db *cdb) InsertTasks(
 				task.RunID,
 				scheduleID,
 				tasklistCondition.LastUpdatedTime,
+				task.Priority,
+				task.FairnessKey,
+				task.IsolationGroup,
 				ttl)
 		}
 	}
@@ -387,6 +414,9 @@ func (db *cdb) InsertTasks(
 		ackLevel,
 		taskListKind,
 		time.Now(),
+		tasklistCondition.VersionSets,
+		tasklistCondition.Paused,
+		tasklistCondition.MigrateTo,
 		domainID,
 		taskListName,
 		taskListType,
@@ -461,6 +491,12 @@ func createTaskInfo(
 			info.ScheduledID = v.(int64)
 		case "created_time":
 			info.CreatedTime = v.(time.Time)
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
+		case "isolation_group":
+			info.IsolationGroup = v.(string)
 		}
 	}
 
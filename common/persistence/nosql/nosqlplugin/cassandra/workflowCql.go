@@ -80,7 +80,17 @@ const (
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
-		`memo: ? ` +
+		`memo: ?, ` +
+		`task_priority: ?, ` +
+		`task_fairness_key: ?, ` +
+		`isolation_group: ?, ` +
+		`retry_backoff_schedule: ?, ` +
+		`retry_jitter_mode: ?, ` +
+		`retry_reason_policies: ?, ` +
+		`retry_reason_policies_encoding: ?, ` +
+		`retry_elapsed_expiration_time: ?, ` +
+		`next_retry_time: ?, ` +
+		`dedup_request_ids: ? ` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -165,7 +175,13 @@ const (
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
 		`last_failure_details: ?, ` +
-		`event_data_encoding: ?` +
+		`event_data_encoding: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ?, ` +
+		`retry_backoff_schedule: ?, ` +
+		`retry_jitter_mode: ?, ` +
+		`retry_reason_policies: ?, ` +
+		`retry_reason_policies_encoding: ?` +
 		`}`
 
 	templateTimerInfoType = `{` +
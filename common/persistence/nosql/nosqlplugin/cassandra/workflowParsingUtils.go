@@ -40,6 +40,8 @@ func parseWorkflowExecutionInfo(
 	var completionEventEncoding common.EncodingType
 	var autoResetPoints []byte
 	var autoResetPointsEncoding common.EncodingType
+	var retryReasonPolicies []byte
+	var retryReasonPoliciesEncoding common.EncodingType
 
 	for k, v := range result {
 		switch k {
@@ -163,10 +165,31 @@ func parseWorkflowExecutionInfo(
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
 			info.Memo = v.(map[string][]byte)
+		case "task_priority":
+			info.TaskPriority = int32(v.(int))
+		case "task_fairness_key":
+			info.TaskFairnessKey = v.(string)
+		case "isolation_group":
+			info.IsolationGroup = v.(string)
+		case "retry_backoff_schedule":
+			info.RetryBackoffSchedule = parseRetryBackoffSchedule(v.([]int))
+		case "retry_jitter_mode":
+			info.RetryJitterMode = types.RetryJitterMode(v.(int))
+		case "retry_reason_policies":
+			retryReasonPolicies = v.([]byte)
+		case "retry_reason_policies_encoding":
+			retryReasonPoliciesEncoding = common.EncodingType(v.(string))
+		case "retry_elapsed_expiration_time":
+			info.RetryElapsedExpirationTime = v.(time.Time)
+		case "next_retry_time":
+			info.NextRetryTime = v.(time.Time)
+		case "dedup_request_ids":
+			info.DedupRequestIDs = v.(map[string]time.Time)
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
 	info.AutoResetPoints = persistence.NewDataBlob(autoResetPoints, autoResetPointsEncoding)
+	info.RetryReasonPolicies = persistence.NewDataBlob(retryReasonPolicies, retryReasonPoliciesEncoding)
 	return info
 }
 
@@ -227,6 +250,8 @@ func parseActivityInfo(
 	info := &persistence.InternalActivityInfo{}
 	var sharedEncoding common.EncodingType
 	var scheduledEventData, startedEventData []byte
+	var retryReasonPolicies []byte
+	var retryReasonPoliciesEncoding common.EncodingType
 	for k, v := range result {
 		switch k {
 		case "version":
@@ -295,15 +320,42 @@ func parseActivityInfo(
 			info.LastFailureDetails = v.([]byte)
 		case "event_data_encoding":
 			sharedEncoding = common.EncodingType(v.(string))
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
+		case "retry_backoff_schedule":
+			info.RetryBackoffSchedule = parseRetryBackoffSchedule(v.([]int))
+		case "retry_jitter_mode":
+			info.RetryJitterMode = types.RetryJitterMode(v.(int))
+		case "retry_reason_policies":
+			retryReasonPolicies = v.([]byte)
+		case "retry_reason_policies_encoding":
+			retryReasonPoliciesEncoding = common.EncodingType(v.(string))
 		}
 	}
 	info.DomainID = domainID
 	info.ScheduledEvent = persistence.NewDataBlob(scheduledEventData, sharedEncoding)
 	info.StartedEvent = persistence.NewDataBlob(startedEventData, sharedEncoding)
+	info.RetryReasonPolicies = persistence.NewDataBlob(retryReasonPolicies, retryReasonPoliciesEncoding)
 
 	return info
 }
 
+func parseRetryBackoffSchedule(
+	schedule []int,
+) []int32 {
+
+	if len(schedule) == 0 {
+		return nil
+	}
+	result := make([]int32, len(schedule))
+	for i, interval := range schedule {
+		result[i] = int32(interval)
+	}
+	return result
+}
+
 func parseTimerInfo(
 	result map[string]interface{},
 ) *persistence.TimerInfo {
@@ -585,4 +637,4 @@ func parseChecksum(result map[string]interface{}) checksum.Checksum {
 		}
 	}
 	return csum
-}
\ No newline at end of file
+}
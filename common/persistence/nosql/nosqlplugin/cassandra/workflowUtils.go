@@ -911,6 +911,12 @@ func resetActivityInfoMap(
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
 		aInfo["last_failure_details"] = a.LastFailureDetails
+		aInfo["priority"] = a.Priority
+		aInfo["fairness_key"] = a.FairnessKey
+		aInfo["retry_backoff_schedule"] = a.RetryBackoffSchedule
+		aInfo["retry_jitter_mode"] = int32(a.RetryJitterMode)
+		aInfo["retry_reason_policies"] = a.RetryReasonPolicies.Data
+		aInfo["retry_reason_policies_encoding"] = a.RetryReasonPolicies.GetEncodingString()
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -963,6 +969,12 @@ func (db *cdb) updateActivityInfos(
 			a.LastWorkerIdentity,
 			a.LastFailureDetails,
 			a.ScheduledEvent.GetEncodingString(),
+			a.Priority,
+			a.FairnessKey,
+			a.RetryBackoffSchedule,
+			int32(a.RetryJitterMode),
+			a.RetryReasonPolicies.Data,
+			a.RetryReasonPolicies.GetEncodingString(),
 			shardID,
 			rowTypeExecution,
 			domainID,
@@ -1254,6 +1266,16 @@ func (db *cdb) updateWorkflowExecution(
 		int32(execution.ExpirationSeconds.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		execution.TaskPriority,
+		execution.TaskFairnessKey,
+		execution.IsolationGroup,
+		execution.RetryBackoffSchedule,
+		int32(execution.RetryJitterMode),
+		execution.RetryReasonPolicies.Data,
+		execution.RetryReasonPolicies.GetEncodingString(),
+		execution.RetryElapsedExpirationTime,
+		execution.NextRetryTime,
+		execution.DedupRequestIDs,
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1348,6 +1370,16 @@ func (db *cdb) createWorkflowExecution(
 		int32(execution.ExpirationSeconds.Seconds()),
 		execution.SearchAttributes,
 		execution.Memo,
+		execution.TaskPriority,
+		execution.TaskFairnessKey,
+		execution.IsolationGroup,
+		execution.RetryBackoffSchedule,
+		int32(execution.RetryJitterMode),
+		execution.RetryReasonPolicies.Data,
+		execution.RetryReasonPolicies.GetEncodingString(),
+		execution.RetryElapsedExpirationTime,
+		execution.NextRetryTime,
+		execution.DedupRequestIDs,
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -1475,4 +1507,4 @@ func populateGetReplicationTasks(
 	err := iter.Close()
 
 	return tasks, nextPageToken, err
-}
\ No newline at end of file
+}
@@ -599,10 +599,13 @@ type (
 		TaskListType int
 		TaskID       int64
 
-		WorkflowID  string
-		RunID       string
-		ScheduledID int64
-		CreatedTime time.Time
+		WorkflowID     string
+		RunID          string
+		ScheduledID    int64
+		CreatedTime    time.Time
+		Priority       int32
+		FairnessKey    string
+		IsolationGroup string
 	}
 
 	TaskListFilter struct {
@@ -620,6 +623,9 @@ type (
 		TaskListKind    int
 		AckLevel        int64
 		LastUpdatedTime time.Time
+		VersionSets     [][]string
+		Paused          bool
+		MigrateTo       string
 	}
 
 	ListTaskListResult struct {
@@ -666,6 +672,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *persistence.DataBlob
+		DrainedIsolationGroups   []string
 	}
 
 	// SelectMessagesBetweenRequest is a request struct for SelectMessagesBetween
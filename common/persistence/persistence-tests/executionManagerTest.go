@@ -1352,6 +1352,15 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	updatedInfo.ExpirationSeconds = math.MaxInt32
 	updatedInfo.ExpirationTime = time.Now()
 	updatedInfo.NonRetriableErrors = []string{"accessDenied", "badRequest"}
+	updatedInfo.RetryBackoffSchedule = []int32{1, 5, 10}
+	updatedInfo.RetryJitterMode = types.RetryJitterModeEqual
+	updatedInfo.RetryReasonPolicies = []*types.RetryReasonPolicy{{
+		ReasonPattern: "^cadenceInternal:Timeout",
+		RetryPolicy:   &types.RetryPolicy{BackoffScheduleInSeconds: []int32{30}},
+	}}
+	updatedInfo.RetryElapsedExpirationTime = time.Now().Add(time.Hour)
+	updatedInfo.NextRetryTime = time.Now().Add(time.Minute)
+	updatedInfo.DedupRequestIDs = map[string]time.Time{"request-1": time.Now()}
 	searchAttrKey := "env"
 	searchAttrVal := []byte("test")
 	updatedInfo.SearchAttributes = map[string][]byte{searchAttrKey: searchAttrVal}
@@ -1411,6 +1420,13 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.ExpirationSeconds, info1.ExpirationSeconds)
 	s.EqualTimes(updatedInfo.ExpirationTime, info1.ExpirationTime)
 	s.Equal(updatedInfo.NonRetriableErrors, info1.NonRetriableErrors)
+	s.Equal(updatedInfo.RetryBackoffSchedule, info1.RetryBackoffSchedule)
+	s.Equal(updatedInfo.RetryJitterMode, info1.RetryJitterMode)
+	s.Equal(updatedInfo.RetryReasonPolicies, info1.RetryReasonPolicies)
+	s.EqualTimes(updatedInfo.RetryElapsedExpirationTime, info1.RetryElapsedExpirationTime)
+	s.EqualTimes(updatedInfo.NextRetryTime, info1.NextRetryTime)
+	s.Len(info1.DedupRequestIDs, 1)
+	s.EqualTimes(updatedInfo.DedupRequestIDs["request-1"], info1.DedupRequestIDs["request-1"])
 	searchAttrVal1, ok := info1.SearchAttributes[searchAttrKey]
 	s.True(ok)
 	s.Equal(searchAttrVal, searchAttrVal1)
@@ -2810,6 +2826,12 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateActivities() {
 		LastFailureReason:        "some random error",
 		LastWorkerIdentity:       uuid.New(),
 		LastFailureDetails:       []byte(uuid.New()),
+		RetryBackoffSchedule:     []int32{1, 5, 10},
+		RetryJitterMode:          types.RetryJitterModeFull,
+		RetryReasonPolicies: []*types.RetryReasonPolicy{{
+			ReasonPattern: "^cadenceInternal:Timeout",
+			RetryPolicy:   &types.RetryPolicy{InitialIntervalInSeconds: 5, BackoffCoefficient: 2},
+		}},
 	}}
 	versionHistory := p.NewVersionHistory([]byte{}, []*p.VersionHistoryItem{
 		{
@@ -2862,6 +2884,9 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateActivities() {
 	s.Equal(activityInfos[0].LastFailureReason, ai.LastFailureReason)
 	s.Equal(activityInfos[0].LastWorkerIdentity, ai.LastWorkerIdentity)
 	s.Equal(activityInfos[0].LastFailureDetails, ai.LastFailureDetails)
+	s.Equal(activityInfos[0].RetryBackoffSchedule, ai.RetryBackoffSchedule)
+	s.Equal(activityInfos[0].RetryJitterMode, ai.RetryJitterMode)
+	s.Equal(activityInfos[0].RetryReasonPolicies, ai.RetryReasonPolicies)
 
 	err2 = s.UpdateWorkflowExecution(ctx, updatedInfo, updatedStats, versionHistories, nil, nil, int64(5), nil, nil, []int64{1}, nil, nil)
 	s.NoError(err2)
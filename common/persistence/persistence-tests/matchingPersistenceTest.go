@@ -116,6 +116,53 @@ func (s *MatchingPersistenceSuite) TestCreateTask() {
 	}
 }
 
+// TestCreateTaskWithPriority test
+func (s *MatchingPersistenceSuite) TestCreateTaskWithPriority() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := "0c2b2e58-7d7e-4e0e-9d8b-3c8a3b0a2f51"
+	workflowExecution := types.WorkflowExecution{WorkflowID: "create-task-with-priority-test",
+		RunID: "5d0ce7b7-0e5c-4f2e-9a43-1f0b0cfa8f16"}
+	taskList := "1f0b0cfa8f16"
+
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:       domainID,
+					WorkflowID:     workflowExecution.WorkflowID,
+					RunID:          workflowExecution.RunID,
+					TaskID:         taskID,
+					ScheduleID:     10,
+					Priority:       3,
+					FairnessKey:    "tenant-a",
+					IsolationGroup: "zone-a",
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(ctx, domainID, taskList, p.TaskListTypeActivity, 100)
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal(int32(3), resp.Tasks[0].Priority)
+	s.Equal("tenant-a", resp.Tasks[0].FairnessKey)
+	s.Equal("zone-a", resp.Tasks[0].IsolationGroup)
+}
+
 // TestGetDecisionTasks test
 func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
@@ -361,6 +408,97 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.Error(err)
 }
 
+// TestTaskListVersionSets test
+func (s *MatchingPersistenceSuite) TestTaskListVersionSets() {
+	domainID := uuid.New()
+	taskList := "task-list-version-sets"
+	versionSets := []*types.WorkerVersionSet{
+		{BuildIDs: []string{"1.0", "1.1"}},
+		{BuildIDs: []string{"2.0"}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	response, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	s.Empty(response.TaskListInfo.VersionSets)
+
+	taskListInfo := response.TaskListInfo
+	taskListInfo.VersionSets = versionSets
+	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
+		TaskListInfo: taskListInfo,
+	})
+	s.NoError(err)
+
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: taskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				Execution: types.WorkflowExecution{WorkflowID: "workflow-id", RunID: uuid.New()},
+				Data: &p.TaskInfo{
+					DomainID:   domainID,
+					WorkflowID: "workflow-id",
+					RunID:      uuid.New(),
+					TaskID:     taskListInfo.RangeID << 20,
+					ScheduleID: 5,
+				},
+				TaskID: taskListInfo.RangeID << 20,
+			},
+		},
+	})
+	s.NoError(err)
+
+	response, err = s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+		RangeID:  taskListInfo.RangeID,
+	})
+	s.NoError(err)
+	s.Equal(versionSets, response.TaskListInfo.VersionSets)
+}
+
+// TestTaskListState test
+func (s *MatchingPersistenceSuite) TestTaskListState() {
+	domainID := uuid.New()
+	taskList := "task-list-state"
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	response, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.False(response.TaskListInfo.Paused)
+	s.Empty(response.TaskListInfo.MigrateTo)
+
+	taskListInfo := response.TaskListInfo
+	taskListInfo.Paused = true
+	taskListInfo.MigrateTo = "other-task-list"
+	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
+		TaskListInfo: taskListInfo,
+	})
+	s.NoError(err)
+
+	response, err = s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+		RangeID:  taskListInfo.RangeID,
+	})
+	s.NoError(err)
+	s.True(response.TaskListInfo.Paused)
+	s.Equal("other-task-list", response.TaskListInfo.MigrateTo)
+}
+
 // TestLeaseAndUpdateTaskListSticky test
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
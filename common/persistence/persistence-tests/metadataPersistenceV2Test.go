@@ -758,6 +758,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	updatedHistoryArchivalURI := ""
 	updatedVisibilityArchivalStatus := types.ArchivalStatusDisabled
 	updatedVisibilityArchivalURI := ""
+	updatedDrainedIsolationGroups := []string{"zone-a"}
 
 	updateClusterActive := "other random active cluster name"
 	updateClusterStandby := "other random standby cluster name"
@@ -802,6 +803,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			VisibilityArchivalStatus: updatedVisibilityArchivalStatus,
 			VisibilityArchivalURI:    updatedVisibilityArchivalURI,
 			BadBinaries:              testBinaries,
+			DrainedIsolationGroups:   updatedDrainedIsolationGroups,
 		},
 		&p.DomainReplicationConfig{
 			ActiveClusterName: updateClusterActive,
@@ -834,6 +836,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(updatedVisibilityArchivalStatus, resp4.Config.VisibilityArchivalStatus)
 	m.Equal(updatedVisibilityArchivalURI, resp4.Config.VisibilityArchivalURI)
 	m.Equal(testBinaries, resp4.Config.BadBinaries)
+	m.Equal(updatedDrainedIsolationGroups, resp4.Config.DrainedIsolationGroups)
 	m.Equal(updateClusterActive, resp4.ReplicationConfig.ActiveClusterName)
 	m.Equal(len(updateClusters), len(resp4.ReplicationConfig.Clusters))
 	for index := range clusters {
@@ -0,0 +1,1485 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+)
+
+type (
+	shardCircuitBreakerPersistenceClient struct {
+		readBreaker  CircuitBreaker
+		writeBreaker CircuitBreaker
+		persistence  ShardManager
+		logger       log.Logger
+	}
+
+	workflowExecutionCircuitBreakerPersistenceClient struct {
+		readBreaker  CircuitBreaker
+		writeBreaker CircuitBreaker
+		persistence  ExecutionManager
+		logger       log.Logger
+	}
+
+	taskCircuitBreakerPersistenceClient struct {
+		readBreaker  CircuitBreaker
+		writeBreaker CircuitBreaker
+		persistence  TaskManager
+		logger       log.Logger
+	}
+
+	historyCircuitBreakerPersistenceClient struct {
+		readBreaker  CircuitBreaker
+		writeBreaker CircuitBreaker
+		persistence  HistoryManager
+		logger       log.Logger
+	}
+
+	metadataCircuitBreakerPersistenceClient struct {
+		readBreaker  CircuitBreaker
+		writeBreaker CircuitBreaker
+		persistence  DomainManager
+		logger       log.Logger
+	}
+
+	visibilityCircuitBreakerPersistenceClient struct {
+		readBreaker  CircuitBreaker
+		writeBreaker CircuitBreaker
+		persistence  VisibilityManager
+		logger       log.Logger
+	}
+
+	queueCircuitBreakerPersistenceClient struct {
+		readBreaker  CircuitBreaker
+		writeBreaker CircuitBreaker
+		persistence  QueueManager
+		logger       log.Logger
+	}
+)
+
+var _ ShardManager = (*shardCircuitBreakerPersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionCircuitBreakerPersistenceClient)(nil)
+var _ TaskManager = (*taskCircuitBreakerPersistenceClient)(nil)
+var _ HistoryManager = (*historyCircuitBreakerPersistenceClient)(nil)
+var _ DomainManager = (*metadataCircuitBreakerPersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityCircuitBreakerPersistenceClient)(nil)
+var _ QueueManager = (*queueCircuitBreakerPersistenceClient)(nil)
+
+// NewShardPersistenceCircuitBreakerClient creates a circuit breaker client to manage shards
+func NewShardPersistenceCircuitBreakerClient(
+	persistence ShardManager,
+	readBreaker CircuitBreaker,
+	writeBreaker CircuitBreaker,
+	logger log.Logger,
+) ShardManager {
+	return &shardCircuitBreakerPersistenceClient{
+		persistence:  persistence,
+		readBreaker:  readBreaker,
+		writeBreaker: writeBreaker,
+		logger:       logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceCircuitBreakerClient creates a circuit breaker client to manage executions
+func NewWorkflowExecutionPersistenceCircuitBreakerClient(
+	persistence ExecutionManager,
+	readBreaker CircuitBreaker,
+	writeBreaker CircuitBreaker,
+	logger log.Logger,
+) ExecutionManager {
+	return &workflowExecutionCircuitBreakerPersistenceClient{
+		persistence:  persistence,
+		readBreaker:  readBreaker,
+		writeBreaker: writeBreaker,
+		logger:       logger,
+	}
+}
+
+// NewTaskPersistenceCircuitBreakerClient creates a circuit breaker client to manage tasks
+func NewTaskPersistenceCircuitBreakerClient(
+	persistence TaskManager,
+	readBreaker CircuitBreaker,
+	writeBreaker CircuitBreaker,
+	logger log.Logger,
+) TaskManager {
+	return &taskCircuitBreakerPersistenceClient{
+		persistence:  persistence,
+		readBreaker:  readBreaker,
+		writeBreaker: writeBreaker,
+		logger:       logger,
+	}
+}
+
+// NewHistoryPersistenceCircuitBreakerClient creates a circuit breaker HistoryManager client to manage workflow execution history
+func NewHistoryPersistenceCircuitBreakerClient(
+	persistence HistoryManager,
+	readBreaker CircuitBreaker,
+	writeBreaker CircuitBreaker,
+	logger log.Logger,
+) HistoryManager {
+	return &historyCircuitBreakerPersistenceClient{
+		persistence:  persistence,
+		readBreaker:  readBreaker,
+		writeBreaker: writeBreaker,
+		logger:       logger,
+	}
+}
+
+// NewDomainPersistenceCircuitBreakerClient creates a circuit breaker DomainManager client to manage metadata
+func NewDomainPersistenceCircuitBreakerClient(
+	persistence DomainManager,
+	readBreaker CircuitBreaker,
+	writeBreaker CircuitBreaker,
+	logger log.Logger,
+) DomainManager {
+	return &metadataCircuitBreakerPersistenceClient{
+		persistence:  persistence,
+		readBreaker:  readBreaker,
+		writeBreaker: writeBreaker,
+		logger:       logger,
+	}
+}
+
+// NewVisibilityPersistenceCircuitBreakerClient creates a circuit breaker client to manage visibility
+func NewVisibilityPersistenceCircuitBreakerClient(
+	persistence VisibilityManager,
+	readBreaker CircuitBreaker,
+	writeBreaker CircuitBreaker,
+	logger log.Logger,
+) VisibilityManager {
+	return &visibilityCircuitBreakerPersistenceClient{
+		persistence:  persistence,
+		readBreaker:  readBreaker,
+		writeBreaker: writeBreaker,
+		logger:       logger,
+	}
+}
+
+// NewQueuePersistenceCircuitBreakerClient creates a circuit breaker client to manage queue
+func NewQueuePersistenceCircuitBreakerClient(
+	persistence QueueManager,
+	readBreaker CircuitBreaker,
+	writeBreaker CircuitBreaker,
+	logger log.Logger,
+) QueueManager {
+	return &queueCircuitBreakerPersistenceClient{
+		persistence:  persistence,
+		readBreaker:  readBreaker,
+		writeBreaker: writeBreaker,
+		logger:       logger,
+	}
+}
+
+func (p *shardCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardCircuitBreakerPersistenceClient) CreateShard(
+	ctx context.Context,
+	request *CreateShardRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.CreateShard(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *shardCircuitBreakerPersistenceClient) GetShard(
+	ctx context.Context,
+	request *GetShardRequest,
+) (*GetShardResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetShard(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *shardCircuitBreakerPersistenceClient) UpdateShard(
+	ctx context.Context,
+	request *UpdateShardRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.UpdateShard(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *shardCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetShardID() int {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CreateWorkflowExecution(
+	ctx context.Context,
+	request *CreateWorkflowExecutionRequest,
+) (*CreateWorkflowExecutionResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.CreateWorkflowExecution(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (*GetWorkflowExecutionResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetWorkflowExecution(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (*UpdateWorkflowExecutionResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.UpdateWorkflowExecution(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *ConflictResolveWorkflowExecutionRequest,
+) (*ConflictResolveWorkflowExecutionResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ConflictResolveWorkflowExecution(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteWorkflowExecution(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *DeleteCurrentWorkflowExecutionRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetCurrentExecution(
+	ctx context.Context,
+	request *GetCurrentExecutionRequest,
+) (*GetCurrentExecutionResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetCurrentExecution(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) ListCurrentExecutions(
+	ctx context.Context,
+	request *ListCurrentExecutionsRequest,
+) (*ListCurrentExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListCurrentExecutions(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) IsWorkflowExecutionExists(
+	ctx context.Context,
+	request *IsWorkflowExecutionExistsRequest,
+) (*IsWorkflowExecutionExistsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.IsWorkflowExecutionExists(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *ListConcreteExecutionsRequest,
+) (*ListConcreteExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListConcreteExecutions(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetTransferTasks(
+	ctx context.Context,
+	request *GetTransferTasksRequest,
+) (*GetTransferTasksResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetTransferTasks(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetCrossClusterTasks(
+	ctx context.Context,
+	request *GetCrossClusterTasksRequest,
+) (*GetCrossClusterTasksResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetCrossClusterTasks(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetReplicationTasks(
+	ctx context.Context,
+	request *GetReplicationTasksRequest,
+) (*GetReplicationTasksResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetReplicationTasks(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CompleteTransferTask(
+	ctx context.Context,
+	request *CompleteTransferTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.CompleteTransferTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeCompleteTransferTask(
+	ctx context.Context,
+	request *RangeCompleteTransferTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.RangeCompleteTransferTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CompleteCrossClusterTask(
+	ctx context.Context,
+	request *CompleteCrossClusterTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.CompleteCrossClusterTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeCompleteCrossClusterTask(
+	ctx context.Context,
+	request *RangeCompleteCrossClusterTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.RangeCompleteCrossClusterTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CompleteReplicationTask(
+	ctx context.Context,
+	request *CompleteReplicationTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.CompleteReplicationTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeCompleteReplicationTask(
+	ctx context.Context,
+	request *RangeCompleteReplicationTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.RangeCompleteReplicationTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.PutReplicationTaskToDLQ(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetReplicationTasksFromDLQResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetReplicationDLQSize(
+	ctx context.Context,
+	request *GetReplicationDLQSizeRequest,
+) (*GetReplicationDLQSizeResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetReplicationDLQSize(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CreateFailoverMarkerTasks(
+	ctx context.Context,
+	request *CreateFailoverMarkersRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.CreateFailoverMarkerTasks(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetTimerIndexTasks(
+	ctx context.Context,
+	request *GetTimerIndexTasksRequest,
+) (*GetTimerIndexTasksResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetTimerIndexTasks(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CompleteTimerTask(
+	ctx context.Context,
+	request *CompleteTimerTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.CompleteTimerTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeCompleteTimerTask(
+	ctx context.Context,
+	request *RangeCompleteTimerTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.RangeCompleteTimerTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskCircuitBreakerPersistenceClient) CreateTasks(
+	ctx context.Context,
+	request *CreateTasksRequest,
+) (*CreateTasksResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.CreateTasks(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) GetTasks(
+	ctx context.Context,
+	request *GetTasksRequest,
+) (*GetTasksResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetTasks(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) CompleteTask(
+	ctx context.Context,
+	request *CompleteTaskRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.CompleteTask(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) CompleteTasksLessThan(
+	ctx context.Context,
+	request *CompleteTasksLessThanRequest,
+) (int, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return 0, err
+	}
+
+	startTime := time.Now()
+	result, err := p.persistence.CompleteTasksLessThan(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return result, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) GetOrphanTasks(ctx context.Context, request *GetOrphanTasksRequest) (*GetOrphanTasksResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetOrphanTasks(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) LeaseTaskList(
+	ctx context.Context,
+	request *LeaseTaskListRequest,
+) (*LeaseTaskListResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.LeaseTaskList(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) UpdateTaskList(
+	ctx context.Context,
+	request *UpdateTaskListRequest,
+) (*UpdateTaskListResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.UpdateTaskList(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) ListTaskList(
+	ctx context.Context,
+	request *ListTaskListRequest,
+) (*ListTaskListResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListTaskList(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) DeleteTaskList(
+	ctx context.Context,
+	request *DeleteTaskListRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteTaskList(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) CreateDomain(
+	ctx context.Context,
+	request *CreateDomainRequest,
+) (*CreateDomainResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.CreateDomain(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) GetDomain(
+	ctx context.Context,
+	request *GetDomainRequest,
+) (*GetDomainResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetDomain(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) UpdateDomain(
+	ctx context.Context,
+	request *UpdateDomainRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.UpdateDomain(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) DeleteDomain(
+	ctx context.Context,
+	request *DeleteDomainRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteDomain(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) DeleteDomainByName(
+	ctx context.Context,
+	request *DeleteDomainByNameRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteDomainByName(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) ListDomains(
+	ctx context.Context,
+	request *ListDomainsRequest,
+) (*ListDomainsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListDomains(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) GetMetadata(
+	ctx context.Context,
+) (*GetMetadataResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetMetadata(ctx)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *RecordWorkflowExecutionStartedRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.RecordWorkflowExecutionStarted(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *RecordWorkflowExecutionClosedRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.RecordWorkflowExecutionClosed(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *UpsertWorkflowExecutionRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.UpsertWorkflowExecution(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListOpenWorkflowExecutions(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListClosedWorkflowExecutions(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByTypeRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByTypeRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByWorkflowIDRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByWorkflowIDRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
+	request *ListClosedWorkflowExecutionsByStatusRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) GetClosedWorkflowExecution(
+	ctx context.Context,
+	request *GetClosedWorkflowExecutionRequest,
+) (*GetClosedWorkflowExecutionResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetClosedWorkflowExecution(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteWorkflowExecution(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByQueryRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ListWorkflowExecutions(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByQueryRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ScanWorkflowExecutions(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *CountWorkflowExecutionsRequest,
+) (*CountWorkflowExecutionsResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.CountWorkflowExecutions(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (p *historyCircuitBreakerPersistenceClient) AppendHistoryNodes(
+	ctx context.Context,
+	request *AppendHistoryNodesRequest,
+) (*AppendHistoryNodesResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.AppendHistoryNodes(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (p *historyCircuitBreakerPersistenceClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ReadHistoryBranch(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyCircuitBreakerPersistenceClient) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchByBatchResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyCircuitBreakerPersistenceClient) ReadRawHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadRawHistoryBranchResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ReadRawHistoryBranch(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+func (p *historyCircuitBreakerPersistenceClient) ForkHistoryBranch(
+	ctx context.Context,
+	request *ForkHistoryBranchRequest,
+) (*ForkHistoryBranchResponse, error) {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.ForkHistoryBranch(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+func (p *historyCircuitBreakerPersistenceClient) DeleteHistoryBranch(
+	ctx context.Context,
+	request *DeleteHistoryBranchRequest,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteHistoryBranch(ctx, request)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (p *historyCircuitBreakerPersistenceClient) GetHistoryTree(
+	ctx context.Context,
+	request *GetHistoryTreeRequest,
+) (*GetHistoryTreeResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetHistoryTree(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *historyCircuitBreakerPersistenceClient) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *GetAllHistoryTreeBranchesRequest,
+) (*GetAllHistoryTreeBranchesResponse, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	response, err := p.persistence.GetAllHistoryTreeBranches(ctx, request)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return response, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) EnqueueMessage(
+	ctx context.Context,
+	message []byte,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.EnqueueMessage(ctx, message)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*QueueMessage, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	messages, err := p.persistence.ReadMessages(ctx, lastMessageID, maxCount)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return messages, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) UpdateAckLevel(
+	ctx context.Context,
+	messageID int64,
+	clusterName string,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.UpdateAckLevel(ctx, messageID, clusterName)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) GetAckLevels(
+	ctx context.Context,
+) (map[string]int64, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	ackLevels, err := p.persistence.GetAckLevels(ctx)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return ackLevels, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteMessagesBefore(ctx, messageID)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) EnqueueMessageToDLQ(
+	ctx context.Context,
+	message []byte,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.EnqueueMessageToDLQ(ctx, message)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*QueueMessage, []byte, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startTime := time.Now()
+	messages, nextPageToken, err := p.persistence.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return messages, nextPageToken, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) UpdateDLQAckLevel(
+	ctx context.Context,
+	messageID int64,
+	clusterName string,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.UpdateDLQAckLevel(ctx, messageID, clusterName)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) GetDLQAckLevels(
+	ctx context.Context,
+) (map[string]int64, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	ackLevels, err := p.persistence.GetDLQAckLevels(ctx)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return ackLevels, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) GetDLQSize(
+	ctx context.Context,
+) (int64, error) {
+	token, err := p.readBreaker.Allow()
+	if err != nil {
+		return 0, err
+	}
+
+	startTime := time.Now()
+	size, err := p.persistence.GetDLQSize(ctx)
+	p.readBreaker.Record(token, time.Since(startTime), err)
+	return size, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	token, err := p.writeBreaker.Allow()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	err = p.persistence.DeleteMessageFromDLQ(ctx, messageID)
+	p.writeBreaker.Record(token, time.Since(startTime), err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
@@ -0,0 +1,1169 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/faultinjection"
+	"github.com/uber/cadence/common/log"
+)
+
+type (
+	shardFaultInjectionPersistenceClient struct {
+		injector    faultinjection.Injector
+		persistence ShardManager
+		logger      log.Logger
+	}
+
+	workflowExecutionFaultInjectionPersistenceClient struct {
+		injector    faultinjection.Injector
+		persistence ExecutionManager
+		logger      log.Logger
+	}
+
+	taskFaultInjectionPersistenceClient struct {
+		injector    faultinjection.Injector
+		persistence TaskManager
+		logger      log.Logger
+	}
+
+	historyFaultInjectionPersistenceClient struct {
+		injector    faultinjection.Injector
+		persistence HistoryManager
+		logger      log.Logger
+	}
+
+	metadataFaultInjectionPersistenceClient struct {
+		injector    faultinjection.Injector
+		persistence DomainManager
+		logger      log.Logger
+	}
+
+	visibilityFaultInjectionPersistenceClient struct {
+		injector    faultinjection.Injector
+		persistence VisibilityManager
+		logger      log.Logger
+	}
+
+	queueFaultInjectionPersistenceClient struct {
+		injector    faultinjection.Injector
+		persistence QueueManager
+		logger      log.Logger
+	}
+)
+
+var _ ShardManager = (*shardFaultInjectionPersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionFaultInjectionPersistenceClient)(nil)
+var _ TaskManager = (*taskFaultInjectionPersistenceClient)(nil)
+var _ HistoryManager = (*historyFaultInjectionPersistenceClient)(nil)
+var _ DomainManager = (*metadataFaultInjectionPersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityFaultInjectionPersistenceClient)(nil)
+var _ QueueManager = (*queueFaultInjectionPersistenceClient)(nil)
+
+// NewShardPersistenceFaultInjectionClient creates a fault injection client to manage shards
+func NewShardPersistenceFaultInjectionClient(
+	persistence ShardManager,
+	injector faultinjection.Injector,
+	logger log.Logger,
+) ShardManager {
+	return &shardFaultInjectionPersistenceClient{
+		persistence: persistence,
+		injector:    injector,
+		logger:      logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceFaultInjectionClient creates a fault injection client to manage executions
+func NewWorkflowExecutionPersistenceFaultInjectionClient(
+	persistence ExecutionManager,
+	injector faultinjection.Injector,
+	logger log.Logger,
+) ExecutionManager {
+	return &workflowExecutionFaultInjectionPersistenceClient{
+		persistence: persistence,
+		injector:    injector,
+		logger:      logger,
+	}
+}
+
+// NewTaskPersistenceFaultInjectionClient creates a fault injection client to manage tasks
+func NewTaskPersistenceFaultInjectionClient(
+	persistence TaskManager,
+	injector faultinjection.Injector,
+	logger log.Logger,
+) TaskManager {
+	return &taskFaultInjectionPersistenceClient{
+		persistence: persistence,
+		injector:    injector,
+		logger:      logger,
+	}
+}
+
+// NewHistoryPersistenceFaultInjectionClient creates a fault injection HistoryManager client to manage workflow execution history
+func NewHistoryPersistenceFaultInjectionClient(
+	persistence HistoryManager,
+	injector faultinjection.Injector,
+	logger log.Logger,
+) HistoryManager {
+	return &historyFaultInjectionPersistenceClient{
+		persistence: persistence,
+		injector:    injector,
+		logger:      logger,
+	}
+}
+
+// NewDomainPersistenceFaultInjectionClient creates a fault injection DomainManager client to manage metadata
+func NewDomainPersistenceFaultInjectionClient(
+	persistence DomainManager,
+	injector faultinjection.Injector,
+	logger log.Logger,
+) DomainManager {
+	return &metadataFaultInjectionPersistenceClient{
+		persistence: persistence,
+		injector:    injector,
+		logger:      logger,
+	}
+}
+
+// NewVisibilityPersistenceFaultInjectionClient creates a fault injection client to manage visibility
+func NewVisibilityPersistenceFaultInjectionClient(
+	persistence VisibilityManager,
+	injector faultinjection.Injector,
+	logger log.Logger,
+) VisibilityManager {
+	return &visibilityFaultInjectionPersistenceClient{
+		persistence: persistence,
+		injector:    injector,
+		logger:      logger,
+	}
+}
+
+// NewQueuePersistenceFaultInjectionClient creates a fault injection client to manage queue
+func NewQueuePersistenceFaultInjectionClient(
+	persistence QueueManager,
+	injector faultinjection.Injector,
+	logger log.Logger,
+) QueueManager {
+	return &queueFaultInjectionPersistenceClient{
+		persistence: persistence,
+		injector:    injector,
+		logger:      logger,
+	}
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardFaultInjectionPersistenceClient) CreateShard(
+	ctx context.Context,
+	request *CreateShardRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("CreateShard", request), func(ctx context.Context) error {
+		return p.persistence.CreateShard(ctx, request)
+	})
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetShard(
+	ctx context.Context,
+	request *GetShardRequest,
+) (*GetShardResponse, error) {
+	var response *GetShardResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetShard", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetShard(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *shardFaultInjectionPersistenceClient) UpdateShard(
+	ctx context.Context,
+	request *UpdateShardRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("UpdateShard", request), func(ctx context.Context) error {
+		return p.persistence.UpdateShard(ctx, request)
+	})
+}
+
+func (p *shardFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetShardID() int {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CreateWorkflowExecution(
+	ctx context.Context,
+	request *CreateWorkflowExecutionRequest,
+) (*CreateWorkflowExecutionResponse, error) {
+	var response *CreateWorkflowExecutionResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("CreateWorkflowExecution", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.CreateWorkflowExecution(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (*GetWorkflowExecutionResponse, error) {
+	var response *GetWorkflowExecutionResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("GetWorkflowExecution", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetWorkflowExecution(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (*UpdateWorkflowExecutionResponse, error) {
+	var response *UpdateWorkflowExecutionResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("UpdateWorkflowExecution", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.UpdateWorkflowExecution(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *ConflictResolveWorkflowExecutionRequest,
+) (*ConflictResolveWorkflowExecutionResponse, error) {
+	var response *ConflictResolveWorkflowExecutionResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("ConflictResolveWorkflowExecution", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ConflictResolveWorkflowExecution(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("DeleteWorkflowExecution", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.DeleteWorkflowExecution(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("DeleteCurrentWorkflowExecution", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetCurrentExecution(
+	ctx context.Context,
+	request *GetCurrentExecutionRequest,
+) (*GetCurrentExecutionResponse, error) {
+	var response *GetCurrentExecutionResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("GetCurrentExecution", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetCurrentExecution(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ListCurrentExecutions(
+	ctx context.Context,
+	request *ListCurrentExecutionsRequest,
+) (*ListCurrentExecutionsResponse, error) {
+	var response *ListCurrentExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("ListCurrentExecutions", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListCurrentExecutions(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) IsWorkflowExecutionExists(
+	ctx context.Context,
+	request *IsWorkflowExecutionExistsRequest,
+) (*IsWorkflowExecutionExistsResponse, error) {
+	var response *IsWorkflowExecutionExistsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("IsWorkflowExecutionExists", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.IsWorkflowExecutionExists(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *ListConcreteExecutionsRequest,
+) (*ListConcreteExecutionsResponse, error) {
+	var response *ListConcreteExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("ListConcreteExecutions", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListConcreteExecutions(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTransferTasks(
+	ctx context.Context,
+	request *GetTransferTasksRequest,
+) (*GetTransferTasksResponse, error) {
+	var response *GetTransferTasksResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("GetTransferTasks", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetTransferTasks(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetCrossClusterTasks(
+	ctx context.Context,
+	request *GetCrossClusterTasksRequest,
+) (*GetCrossClusterTasksResponse, error) {
+	var response *GetCrossClusterTasksResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("GetCrossClusterTasks", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetCrossClusterTasks(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTasks(
+	ctx context.Context,
+	request *GetReplicationTasksRequest,
+) (*GetReplicationTasksResponse, error) {
+	var response *GetReplicationTasksResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("GetReplicationTasks", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetReplicationTasks(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTransferTask(
+	ctx context.Context,
+	request *CompleteTransferTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("CompleteTransferTask", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.CompleteTransferTask(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTransferTask(
+	ctx context.Context,
+	request *RangeCompleteTransferTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("RangeCompleteTransferTask", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.RangeCompleteTransferTask(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteCrossClusterTask(
+	ctx context.Context,
+	request *CompleteCrossClusterTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("CompleteCrossClusterTask", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.CompleteCrossClusterTask(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteCrossClusterTask(
+	ctx context.Context,
+	request *RangeCompleteCrossClusterTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("RangeCompleteCrossClusterTask", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.RangeCompleteCrossClusterTask(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteReplicationTask(
+	ctx context.Context,
+	request *CompleteReplicationTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("CompleteReplicationTask", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.CompleteReplicationTask(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteReplicationTask(
+	ctx context.Context,
+	request *RangeCompleteReplicationTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("RangeCompleteReplicationTask", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.RangeCompleteReplicationTask(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("PutReplicationTaskToDLQ", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.PutReplicationTaskToDLQ(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetReplicationTasksFromDLQResponse, error) {
+	var response *GetReplicationTasksFromDLQResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("GetReplicationTasksFromDLQ", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationDLQSize(
+	ctx context.Context,
+	request *GetReplicationDLQSizeRequest,
+) (*GetReplicationDLQSizeResponse, error) {
+	var response *GetReplicationDLQSizeResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("GetReplicationDLQSize", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetReplicationDLQSize(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("DeleteReplicationTaskFromDLQ", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("RangeDeleteReplicationTaskFromDLQ", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CreateFailoverMarkerTasks(
+	ctx context.Context,
+	request *CreateFailoverMarkersRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("CreateFailoverMarkerTasks", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.CreateFailoverMarkerTasks(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTimerIndexTasks(
+	ctx context.Context,
+	request *GetTimerIndexTasksRequest,
+) (*GetTimerIndexTasksResponse, error) {
+	var response *GetTimerIndexTasksResponse
+	err := p.injector.Inject(ctx, faultinjection.NewShardRequest("GetTimerIndexTasks", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetTimerIndexTasks(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTimerTask(
+	ctx context.Context,
+	request *CompleteTimerTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("CompleteTimerTask", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.CompleteTimerTask(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTimerTask(
+	ctx context.Context,
+	request *RangeCompleteTimerTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewShardRequest("RangeCompleteTimerTask", p.persistence.GetShardID(), request), func(ctx context.Context) error {
+		return p.persistence.RangeCompleteTimerTask(ctx, request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskFaultInjectionPersistenceClient) CreateTasks(
+	ctx context.Context,
+	request *CreateTasksRequest,
+) (*CreateTasksResponse, error) {
+	var response *CreateTasksResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("CreateTasks", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.CreateTasks(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetTasks(
+	ctx context.Context,
+	request *GetTasksRequest,
+) (*GetTasksResponse, error) {
+	var response *GetTasksResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetTasks", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetTasks(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTask(
+	ctx context.Context,
+	request *CompleteTaskRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("CompleteTask", request), func(ctx context.Context) error {
+		return p.persistence.CompleteTask(ctx, request)
+	})
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTasksLessThan(
+	ctx context.Context,
+	request *CompleteTasksLessThanRequest,
+) (int, error) {
+	var result int
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("CompleteTasksLessThan", request), func(ctx context.Context) error {
+		var err error
+		result, err = p.persistence.CompleteTasksLessThan(ctx, request)
+		return err
+	})
+	return result, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetOrphanTasks(ctx context.Context, request *GetOrphanTasksRequest) (*GetOrphanTasksResponse, error) {
+	var response *GetOrphanTasksResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetOrphanTasks", nil), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetOrphanTasks(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) LeaseTaskList(
+	ctx context.Context,
+	request *LeaseTaskListRequest,
+) (*LeaseTaskListResponse, error) {
+	var response *LeaseTaskListResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("LeaseTaskList", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.LeaseTaskList(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) UpdateTaskList(
+	ctx context.Context,
+	request *UpdateTaskListRequest,
+) (*UpdateTaskListResponse, error) {
+	var response *UpdateTaskListResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("UpdateTaskList", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.UpdateTaskList(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) ListTaskList(
+	ctx context.Context,
+	request *ListTaskListRequest,
+) (*ListTaskListResponse, error) {
+	var response *ListTaskListResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListTaskList", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListTaskList(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) DeleteTaskList(
+	ctx context.Context,
+	request *DeleteTaskListRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("DeleteTaskList", request), func(ctx context.Context) error {
+		return p.persistence.DeleteTaskList(ctx, request)
+	})
+}
+
+func (p *taskFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) CreateDomain(
+	ctx context.Context,
+	request *CreateDomainRequest,
+) (*CreateDomainResponse, error) {
+	var response *CreateDomainResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("CreateDomain", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.CreateDomain(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetDomain(
+	ctx context.Context,
+	request *GetDomainRequest,
+) (*GetDomainResponse, error) {
+	var response *GetDomainResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetDomain", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetDomain(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) UpdateDomain(
+	ctx context.Context,
+	request *UpdateDomainRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("UpdateDomain", request), func(ctx context.Context) error {
+		return p.persistence.UpdateDomain(ctx, request)
+	})
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteDomain(
+	ctx context.Context,
+	request *DeleteDomainRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("DeleteDomain", request), func(ctx context.Context) error {
+		return p.persistence.DeleteDomain(ctx, request)
+	})
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteDomainByName(
+	ctx context.Context,
+	request *DeleteDomainByNameRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("DeleteDomainByName", request), func(ctx context.Context) error {
+		return p.persistence.DeleteDomainByName(ctx, request)
+	})
+}
+
+func (p *metadataFaultInjectionPersistenceClient) ListDomains(
+	ctx context.Context,
+	request *ListDomainsRequest,
+) (*ListDomainsResponse, error) {
+	var response *ListDomainsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListDomains", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListDomains(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetMetadata(
+	ctx context.Context,
+) (*GetMetadataResponse, error) {
+	var response *GetMetadataResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetMetadata", nil), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetMetadata(ctx)
+		return err
+	})
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *RecordWorkflowExecutionStartedRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("RecordWorkflowExecutionStarted", request), func(ctx context.Context) error {
+		return p.persistence.RecordWorkflowExecutionStarted(ctx, request)
+	})
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *RecordWorkflowExecutionClosedRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("RecordWorkflowExecutionClosed", request), func(ctx context.Context) error {
+		return p.persistence.RecordWorkflowExecutionClosed(ctx, request)
+	})
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *UpsertWorkflowExecutionRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("UpsertWorkflowExecution", request), func(ctx context.Context) error {
+		return p.persistence.UpsertWorkflowExecution(ctx, request)
+	})
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListOpenWorkflowExecutions", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListOpenWorkflowExecutions(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListClosedWorkflowExecutions", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListClosedWorkflowExecutions(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByTypeRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListOpenWorkflowExecutionsByType", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByTypeRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListClosedWorkflowExecutionsByType", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByWorkflowIDRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListOpenWorkflowExecutionsByWorkflowID", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByWorkflowIDRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListClosedWorkflowExecutionsByWorkflowID", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
+	request *ListClosedWorkflowExecutionsByStatusRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListClosedWorkflowExecutionsByStatus", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetClosedWorkflowExecution(
+	ctx context.Context,
+	request *GetClosedWorkflowExecutionRequest,
+) (*GetClosedWorkflowExecutionResponse, error) {
+	var response *GetClosedWorkflowExecutionResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetClosedWorkflowExecution", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetClosedWorkflowExecution(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("DeleteWorkflowExecution", request), func(ctx context.Context) error {
+		return p.persistence.DeleteWorkflowExecution(ctx, request)
+	})
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByQueryRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ListWorkflowExecutions", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ListWorkflowExecutions(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *ListWorkflowExecutionsByQueryRequest,
+) (*ListWorkflowExecutionsResponse, error) {
+	var response *ListWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ScanWorkflowExecutions", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ScanWorkflowExecutions(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *CountWorkflowExecutionsRequest,
+) (*CountWorkflowExecutionsResponse, error) {
+	var response *CountWorkflowExecutionsResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("CountWorkflowExecutions", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.CountWorkflowExecutions(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (p *historyFaultInjectionPersistenceClient) AppendHistoryNodes(
+	ctx context.Context,
+	request *AppendHistoryNodesRequest,
+) (*AppendHistoryNodesResponse, error) {
+	var response *AppendHistoryNodesResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("AppendHistoryNodes", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.AppendHistoryNodes(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (p *historyFaultInjectionPersistenceClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchResponse, error) {
+	var response *ReadHistoryBranchResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ReadHistoryBranch", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ReadHistoryBranch(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyFaultInjectionPersistenceClient) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchByBatchResponse, error) {
+	var response *ReadHistoryBranchByBatchResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ReadHistoryBranchByBatch", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ReadHistoryBranchByBatch(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyFaultInjectionPersistenceClient) ReadRawHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadRawHistoryBranchResponse, error) {
+	var response *ReadRawHistoryBranchResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ReadRawHistoryBranch", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ReadRawHistoryBranch(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+func (p *historyFaultInjectionPersistenceClient) ForkHistoryBranch(
+	ctx context.Context,
+	request *ForkHistoryBranchRequest,
+) (*ForkHistoryBranchResponse, error) {
+	var response *ForkHistoryBranchResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ForkHistoryBranch", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.ForkHistoryBranch(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+func (p *historyFaultInjectionPersistenceClient) DeleteHistoryBranch(
+	ctx context.Context,
+	request *DeleteHistoryBranchRequest,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("DeleteHistoryBranch", request), func(ctx context.Context) error {
+		return p.persistence.DeleteHistoryBranch(ctx, request)
+	})
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (p *historyFaultInjectionPersistenceClient) GetHistoryTree(
+	ctx context.Context,
+	request *GetHistoryTreeRequest,
+) (*GetHistoryTreeResponse, error) {
+	var response *GetHistoryTreeResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetHistoryTree", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetHistoryTree(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *GetAllHistoryTreeBranchesRequest,
+) (*GetAllHistoryTreeBranchesResponse, error) {
+	var response *GetAllHistoryTreeBranchesResponse
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetAllHistoryTreeBranches", request), func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetAllHistoryTreeBranches(ctx, request)
+		return err
+	})
+	return response, err
+}
+
+func (p *queueFaultInjectionPersistenceClient) EnqueueMessage(
+	ctx context.Context,
+	message []byte,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("EnqueueMessage", nil), func(ctx context.Context) error {
+		return p.persistence.EnqueueMessage(ctx, message)
+	})
+}
+
+func (p *queueFaultInjectionPersistenceClient) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*QueueMessage, error) {
+	var messages []*QueueMessage
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ReadMessages", nil), func(ctx context.Context) error {
+		var err error
+		messages, err = p.persistence.ReadMessages(ctx, lastMessageID, maxCount)
+		return err
+	})
+	return messages, err
+}
+
+func (p *queueFaultInjectionPersistenceClient) UpdateAckLevel(
+	ctx context.Context,
+	messageID int64,
+	clusterName string,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("UpdateAckLevel", nil), func(ctx context.Context) error {
+		return p.persistence.UpdateAckLevel(ctx, messageID, clusterName)
+	})
+}
+
+func (p *queueFaultInjectionPersistenceClient) GetAckLevels(
+	ctx context.Context,
+) (map[string]int64, error) {
+	var ackLevels map[string]int64
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetAckLevels", nil), func(ctx context.Context) error {
+		var err error
+		ackLevels, err = p.persistence.GetAckLevels(ctx)
+		return err
+	})
+	return ackLevels, err
+}
+
+func (p *queueFaultInjectionPersistenceClient) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("DeleteMessagesBefore", nil), func(ctx context.Context) error {
+		return p.persistence.DeleteMessagesBefore(ctx, messageID)
+	})
+}
+
+func (p *queueFaultInjectionPersistenceClient) EnqueueMessageToDLQ(
+	ctx context.Context,
+	message []byte,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("EnqueueMessageToDLQ", nil), func(ctx context.Context) error {
+		return p.persistence.EnqueueMessageToDLQ(ctx, message)
+	})
+}
+
+func (p *queueFaultInjectionPersistenceClient) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*QueueMessage, []byte, error) {
+	var messages []*QueueMessage
+	var nextPageToken []byte
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("ReadMessagesFromDLQ", nil), func(ctx context.Context) error {
+		var err error
+		messages, nextPageToken, err = p.persistence.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+		return err
+	})
+	return messages, nextPageToken, err
+}
+
+func (p *queueFaultInjectionPersistenceClient) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("RangeDeleteMessagesFromDLQ", nil), func(ctx context.Context) error {
+		return p.persistence.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+	})
+}
+
+func (p *queueFaultInjectionPersistenceClient) UpdateDLQAckLevel(
+	ctx context.Context,
+	messageID int64,
+	clusterName string,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("UpdateDLQAckLevel", nil), func(ctx context.Context) error {
+		return p.persistence.UpdateDLQAckLevel(ctx, messageID, clusterName)
+	})
+}
+
+func (p *queueFaultInjectionPersistenceClient) GetDLQAckLevels(
+	ctx context.Context,
+) (map[string]int64, error) {
+	var ackLevels map[string]int64
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetDLQAckLevels", nil), func(ctx context.Context) error {
+		var err error
+		ackLevels, err = p.persistence.GetDLQAckLevels(ctx)
+		return err
+	})
+	return ackLevels, err
+}
+
+func (p *queueFaultInjectionPersistenceClient) GetDLQSize(
+	ctx context.Context,
+) (int64, error) {
+	var size int64
+	err := p.injector.Inject(ctx, faultinjection.NewRequest("GetDLQSize", nil), func(ctx context.Context) error {
+		var err error
+		size, err = p.persistence.GetDLQSize(ctx)
+		return err
+	})
+	return size, err
+}
+
+func (p *queueFaultInjectionPersistenceClient) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return p.injector.Inject(ctx, faultinjection.NewRequest("DeleteMessageFromDLQ", nil), func(ctx context.Context) error {
+		return p.persistence.DeleteMessageFromDLQ(ctx, messageID)
+	})
+}
+
+func (p *queueFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
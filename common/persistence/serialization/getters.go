@@ -372,6 +372,14 @@ func (d *DomainInfo) GetLastUpdatedTimestamp() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetDrainedIsolationGroups internal sql blob getter
+func (d *DomainInfo) GetDrainedIsolationGroups() (o []string) {
+	if d != nil {
+		return d.DrainedIsolationGroups
+	}
+	return
+}
+
 // GetCreatedTimestamp internal sql blob getter
 func (h *HistoryTreeInfo) GetCreatedTimestamp() time.Time {
 	if h != nil {
@@ -836,6 +844,86 @@ func (w *WorkflowExecutionInfo) GetMemo() (o map[string][]byte) {
 	return
 }
 
+// GetTaskPriority internal sql blob getter
+func (w *WorkflowExecutionInfo) GetTaskPriority() (o int32) {
+	if w != nil {
+		return w.TaskPriority
+	}
+	return
+}
+
+// GetTaskFairnessKey internal sql blob getter
+func (w *WorkflowExecutionInfo) GetTaskFairnessKey() (o string) {
+	if w != nil {
+		return w.TaskFairnessKey
+	}
+	return
+}
+
+// GetIsolationGroup internal sql blob getter
+func (w *WorkflowExecutionInfo) GetIsolationGroup() (o string) {
+	if w != nil {
+		return w.IsolationGroup
+	}
+	return
+}
+
+// GetRetryBackoffSchedule internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetryBackoffSchedule() (o []int32) {
+	if w != nil {
+		return w.RetryBackoffSchedule
+	}
+	return
+}
+
+// GetRetryJitterMode internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetryJitterMode() (o int32) {
+	if w != nil {
+		return w.RetryJitterMode
+	}
+	return
+}
+
+// GetRetryReasonPolicies internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetryReasonPolicies() (o []byte) {
+	if w != nil {
+		return w.RetryReasonPolicies
+	}
+	return
+}
+
+// GetRetryReasonPoliciesEncoding internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetryReasonPoliciesEncoding() (o string) {
+	if w != nil {
+		return w.RetryReasonPoliciesEncoding
+	}
+	return
+}
+
+// GetRetryElapsedExpirationTimestamp internal sql blob getter
+func (w *WorkflowExecutionInfo) GetRetryElapsedExpirationTimestamp() time.Time {
+	if w != nil {
+		return w.RetryElapsedExpirationTimestamp
+	}
+	return time.Time{}
+}
+
+// GetNextRetryTimestamp internal sql blob getter
+func (w *WorkflowExecutionInfo) GetNextRetryTimestamp() time.Time {
+	if w != nil {
+		return w.NextRetryTimestamp
+	}
+	return time.Time{}
+}
+
+// GetDedupRequestIDs internal sql blob getter
+func (w *WorkflowExecutionInfo) GetDedupRequestIDs() (o map[string]time.Time) {
+	if w != nil {
+		return w.DedupRequestIDs
+	}
+	return
+}
+
 // GetSearchAttributes internal sql blob getter
 func (w *WorkflowExecutionInfo) GetSearchAttributes() (o map[string][]byte) {
 	if w != nil {
@@ -1084,6 +1172,54 @@ func (a *ActivityInfo) GetRetryLastFailureDetails() (o []byte) {
 	return
 }
 
+// GetPriority internal sql blob getter
+func (a *ActivityInfo) GetPriority() (o int32) {
+	if a != nil {
+		return a.Priority
+	}
+	return
+}
+
+// GetFairnessKey internal sql blob getter
+func (a *ActivityInfo) GetFairnessKey() (o string) {
+	if a != nil {
+		return a.FairnessKey
+	}
+	return
+}
+
+// GetRetryBackoffSchedule internal sql blob getter
+func (a *ActivityInfo) GetRetryBackoffSchedule() (o []int32) {
+	if a != nil {
+		return a.RetryBackoffSchedule
+	}
+	return
+}
+
+// GetRetryJitterMode internal sql blob getter
+func (a *ActivityInfo) GetRetryJitterMode() (o int32) {
+	if a != nil {
+		return a.RetryJitterMode
+	}
+	return
+}
+
+// GetRetryReasonPolicies internal sql blob getter
+func (a *ActivityInfo) GetRetryReasonPolicies() (o []byte) {
+	if a != nil {
+		return a.RetryReasonPolicies
+	}
+	return
+}
+
+// GetRetryReasonPoliciesEncoding internal sql blob getter
+func (a *ActivityInfo) GetRetryReasonPoliciesEncoding() (o string) {
+	if a != nil {
+		return a.RetryReasonPoliciesEncoding
+	}
+	return
+}
+
 // GetCancelRequested internal sql blob getter
 func (a *ActivityInfo) GetCancelRequested() (o bool) {
 	if a != nil {
@@ -1364,6 +1500,30 @@ func (t *TaskInfo) GetCreatedTimestamp() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetPriority internal sql blob getter
+func (t *TaskInfo) GetPriority() (o int32) {
+	if t != nil {
+		return t.Priority
+	}
+	return
+}
+
+// GetFairnessKey internal sql blob getter
+func (t *TaskInfo) GetFairnessKey() (o string) {
+	if t != nil {
+		return t.FairnessKey
+	}
+	return
+}
+
+// GetIsolationGroup internal sql blob getter
+func (t *TaskInfo) GetIsolationGroup() (o string) {
+	if t != nil {
+		return t.IsolationGroup
+	}
+	return
+}
+
 // GetKind internal sql blob getter
 func (t *TaskListInfo) GetKind() (o int16) {
 	if t != nil {
@@ -1396,6 +1556,30 @@ func (t *TaskListInfo) GetLastUpdated() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetVersionSets internal sql blob getter
+func (t *TaskListInfo) GetVersionSets() (o []*types.WorkerVersionSet) {
+	if t != nil {
+		return t.VersionSets
+	}
+	return
+}
+
+// GetPaused internal sql blob getter
+func (t *TaskListInfo) GetPaused() (o bool) {
+	if t != nil {
+		return t.Paused
+	}
+	return
+}
+
+// GetMigrateTo internal sql blob getter
+func (t *TaskListInfo) GetMigrateTo() (o string) {
+	if t != nil {
+		return t.MigrateTo
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *TransferTaskInfo) GetDomainID() (o []byte) {
 	if t != nil {
@@ -83,6 +83,7 @@ type (
 		FailoverEndTimestamp        *time.Time // TODO: There is logic checking if it's nil, should revisit this
 		PreviousFailoverVersion     int64
 		LastUpdatedTimestamp        time.Time
+		DrainedIsolationGroups      []string
 	}
 
 	// HistoryBranchRange blob in a serialization agnostic format
@@ -160,41 +161,57 @@ type (
 		Memo                               map[string][]byte
 		VersionHistories                   []byte
 		VersionHistoriesEncoding           string
+		TaskPriority                       int32
+		TaskFairnessKey                    string
+		IsolationGroup                     string
+		RetryBackoffSchedule               []int32
+		RetryJitterMode                    int32
+		RetryReasonPolicies                []byte
+		RetryReasonPoliciesEncoding        string
+		RetryElapsedExpirationTimestamp    time.Time
+		NextRetryTimestamp                 time.Time
+		DedupRequestIDs                    map[string]time.Time
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
 	ActivityInfo struct {
-		Version                  int64
-		ScheduledEventBatchID    int64
-		ScheduledEvent           []byte
-		ScheduledEventEncoding   string
-		ScheduledTimestamp       time.Time
-		StartedID                int64
-		StartedEvent             []byte
-		StartedEventEncoding     string
-		StartedTimestamp         time.Time
-		ActivityID               string
-		RequestID                string
-		ScheduleToStartTimeout   time.Duration
-		ScheduleToCloseTimeout   time.Duration
-		StartToCloseTimeout      time.Duration
-		HeartbeatTimeout         time.Duration
-		CancelRequested          bool
-		CancelRequestID          int64
-		TimerTaskStatus          int32
-		Attempt                  int32
-		TaskList                 string
-		StartedIdentity          string
-		HasRetryPolicy           bool
-		RetryInitialInterval     time.Duration
-		RetryMaximumInterval     time.Duration
-		RetryMaximumAttempts     int32
-		RetryExpirationTimestamp time.Time
-		RetryBackoffCoefficient  float64
-		RetryNonRetryableErrors  []string
-		RetryLastFailureReason   string
-		RetryLastWorkerIdentity  string
-		RetryLastFailureDetails  []byte
+		Version                     int64
+		ScheduledEventBatchID       int64
+		ScheduledEvent              []byte
+		ScheduledEventEncoding      string
+		ScheduledTimestamp          time.Time
+		StartedID                   int64
+		StartedEvent                []byte
+		StartedEventEncoding        string
+		StartedTimestamp            time.Time
+		ActivityID                  string
+		RequestID                   string
+		ScheduleToStartTimeout      time.Duration
+		ScheduleToCloseTimeout      time.Duration
+		StartToCloseTimeout         time.Duration
+		HeartbeatTimeout            time.Duration
+		CancelRequested             bool
+		CancelRequestID             int64
+		TimerTaskStatus             int32
+		Attempt                     int32
+		TaskList                    string
+		StartedIdentity             string
+		HasRetryPolicy              bool
+		RetryInitialInterval        time.Duration
+		RetryMaximumInterval        time.Duration
+		RetryMaximumAttempts        int32
+		RetryExpirationTimestamp    time.Time
+		RetryBackoffCoefficient     float64
+		RetryNonRetryableErrors     []string
+		RetryLastFailureReason      string
+		RetryLastWorkerIdentity     string
+		RetryLastFailureDetails     []byte
+		Priority                    int32
+		FairnessKey                 string
+		RetryBackoffSchedule        []int32
+		RetryJitterMode             int32
+		RetryReasonPolicies         []byte
+		RetryReasonPoliciesEncoding string
 	}
 
 	// ChildExecutionInfo blob in a serialization agnostic format
@@ -246,6 +263,9 @@ type (
 		ScheduleID       int64
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
+		Priority         int32
+		FairnessKey      string
+		IsolationGroup   string
 	}
 
 	// TaskListInfo blob in a serialization agnostic format
@@ -254,6 +274,9 @@ type (
 		AckLevel        int64
 		ExpiryTimestamp time.Time
 		LastUpdated     time.Time
+		VersionSets     []*types.WorkerVersionSet
+		Paused          bool
+		MigrateTo       string
 	}
 
 	// TransferTaskInfo blob in a serialization agnostic format
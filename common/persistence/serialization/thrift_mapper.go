@@ -127,6 +127,7 @@ func domainInfoToThrift(info *DomainInfo) *sqlblobs.DomainInfo {
 		RetentionDays:               durationToDaysInt16Ptr(info.Retention),
 		FailoverEndTime:             unixNanoPtr(info.FailoverEndTimestamp),
 		LastUpdatedTime:             timeToUnixNanoPtr(info.LastUpdatedTimestamp),
+		DrainedIsolationGroups:      info.DrainedIsolationGroups,
 	}
 }
 
@@ -159,6 +160,7 @@ func domainInfoFromThrift(info *sqlblobs.DomainInfo) *DomainInfo {
 		Retention:                   common.DaysToDuration(int32(info.GetRetentionDays())),
 		FailoverEndTimestamp:        timePtr(info.FailoverEndTime),
 		LastUpdatedTimestamp:        timeFromUnixNano(info.GetLastUpdatedTime()),
+		DrainedIsolationGroups:      info.DrainedIsolationGroups,
 	}
 }
 
@@ -208,7 +210,7 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 	if info == nil {
 		return nil
 	}
-	return &sqlblobs.WorkflowExecutionInfo{
+	result := &sqlblobs.WorkflowExecutionInfo{
 		ParentDomainID:                          info.ParentDomainID,
 		ParentWorkflowID:                        &info.ParentWorkflowID,
 		ParentRunID:                             info.ParentRunID,
@@ -267,14 +269,30 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		Memo:                                    info.Memo,
 		VersionHistories:                        info.VersionHistories,
 		VersionHistoriesEncoding:                &info.VersionHistoriesEncoding,
+		TaskPriority:                            &info.TaskPriority,
+		TaskFairnessKey:                         &info.TaskFairnessKey,
+		IsolationGroup:                          &info.IsolationGroup,
+		RetryBackoffScheduleSeconds:             info.RetryBackoffSchedule,
+		RetryJitterMode:                         &info.RetryJitterMode,
+		RetryReasonPolicies:                     info.RetryReasonPolicies,
+		RetryReasonPoliciesEncoding:             &info.RetryReasonPoliciesEncoding,
+		RetryElapsedExpirationTimeNanos:         timeToUnixNanoPtr(info.RetryElapsedExpirationTimestamp),
+		NextRetryTimeNanos:                      timeToUnixNanoPtr(info.NextRetryTimestamp),
+	}
+	if info.DedupRequestIDs != nil {
+		result.DedupRequestIDTimeNanos = make(map[string]int64, len(info.DedupRequestIDs))
+		for k, v := range info.DedupRequestIDs {
+			result.DedupRequestIDTimeNanos[k] = v.UnixNano()
+		}
 	}
+	return result
 }
 
 func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *WorkflowExecutionInfo {
 	if info == nil {
 		return nil
 	}
-	return &WorkflowExecutionInfo{
+	result := &WorkflowExecutionInfo{
 		ParentDomainID:                     info.ParentDomainID,
 		ParentWorkflowID:                   info.GetParentWorkflowID(),
 		ParentRunID:                        info.ParentRunID,
@@ -333,7 +351,23 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		Memo:                               info.Memo,
 		VersionHistories:                   info.VersionHistories,
 		VersionHistoriesEncoding:           info.GetVersionHistoriesEncoding(),
+		TaskPriority:                       info.GetTaskPriority(),
+		TaskFairnessKey:                    info.GetTaskFairnessKey(),
+		IsolationGroup:                     info.GetIsolationGroup(),
+		RetryBackoffSchedule:               info.RetryBackoffScheduleSeconds,
+		RetryJitterMode:                    info.GetRetryJitterMode(),
+		RetryReasonPolicies:                info.RetryReasonPolicies,
+		RetryReasonPoliciesEncoding:        info.GetRetryReasonPoliciesEncoding(),
+		RetryElapsedExpirationTimestamp:    timeFromOptionalUnixNano(info.RetryElapsedExpirationTimeNanos),
+		NextRetryTimestamp:                 timeFromOptionalUnixNano(info.NextRetryTimeNanos),
+	}
+	if info.DedupRequestIDTimeNanos != nil {
+		result.DedupRequestIDs = make(map[string]time.Time, len(info.DedupRequestIDTimeNanos))
+		for k, v := range info.DedupRequestIDTimeNanos {
+			result.DedupRequestIDs[k] = time.Unix(0, v)
+		}
 	}
+	return result
 }
 
 func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
@@ -372,6 +406,12 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		RetryLastFailureReason:        &info.RetryLastFailureReason,
 		RetryLastWorkerIdentity:       &info.RetryLastWorkerIdentity,
 		RetryLastFailureDetails:       info.RetryLastFailureDetails,
+		Priority:                      &info.Priority,
+		FairnessKey:                   &info.FairnessKey,
+		RetryBackoffScheduleSeconds:   info.RetryBackoffSchedule,
+		RetryJitterMode:               &info.RetryJitterMode,
+		RetryReasonPolicies:           info.RetryReasonPolicies,
+		RetryReasonPoliciesEncoding:   &info.RetryReasonPoliciesEncoding,
 	}
 }
 
@@ -380,37 +420,43 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		return nil
 	}
 	return &ActivityInfo{
-		Version:                  info.GetVersion(),
-		ScheduledEventBatchID:    info.GetScheduledEventBatchID(),
-		ScheduledEvent:           info.ScheduledEvent,
-		ScheduledEventEncoding:   info.GetScheduledEventEncoding(),
-		ScheduledTimestamp:       timeFromUnixNano(info.GetScheduledTimeNanos()),
-		StartedID:                info.GetStartedID(),
-		StartedEvent:             info.StartedEvent,
-		StartedEventEncoding:     info.GetStartedEventEncoding(),
-		StartedTimestamp:         timeFromUnixNano(info.GetStartedTimeNanos()),
-		ActivityID:               info.GetActivityID(),
-		RequestID:                info.GetRequestID(),
-		ScheduleToStartTimeout:   common.SecondsToDuration(int64(info.GetScheduleToStartTimeoutSeconds())),
-		ScheduleToCloseTimeout:   common.SecondsToDuration(int64(info.GetScheduleToCloseTimeoutSeconds())),
-		StartToCloseTimeout:      common.SecondsToDuration(int64(info.GetStartToCloseTimeoutSeconds())),
-		HeartbeatTimeout:         common.SecondsToDuration(int64(info.GetHeartbeatTimeoutSeconds())),
-		CancelRequested:          info.GetCancelRequested(),
-		CancelRequestID:          info.GetCancelRequestID(),
-		TimerTaskStatus:          info.GetTimerTaskStatus(),
-		Attempt:                  info.GetAttempt(),
-		TaskList:                 info.GetTaskList(),
-		StartedIdentity:          info.GetStartedIdentity(),
-		HasRetryPolicy:           info.GetHasRetryPolicy(),
-		RetryInitialInterval:     common.SecondsToDuration(int64(info.GetRetryInitialIntervalSeconds())),
-		RetryMaximumInterval:     common.SecondsToDuration(int64(info.GetRetryMaximumIntervalSeconds())),
-		RetryMaximumAttempts:     info.GetRetryMaximumAttempts(),
-		RetryExpirationTimestamp: timeFromUnixNano(info.GetRetryExpirationTimeNanos()),
-		RetryBackoffCoefficient:  info.GetRetryBackoffCoefficient(),
-		RetryNonRetryableErrors:  info.RetryNonRetryableErrors,
-		RetryLastFailureReason:   info.GetRetryLastFailureReason(),
-		RetryLastWorkerIdentity:  info.GetRetryLastWorkerIdentity(),
-		RetryLastFailureDetails:  info.RetryLastFailureDetails,
+		Version:                     info.GetVersion(),
+		ScheduledEventBatchID:       info.GetScheduledEventBatchID(),
+		ScheduledEvent:              info.ScheduledEvent,
+		ScheduledEventEncoding:      info.GetScheduledEventEncoding(),
+		ScheduledTimestamp:          timeFromUnixNano(info.GetScheduledTimeNanos()),
+		StartedID:                   info.GetStartedID(),
+		StartedEvent:                info.StartedEvent,
+		StartedEventEncoding:        info.GetStartedEventEncoding(),
+		StartedTimestamp:            timeFromUnixNano(info.GetStartedTimeNanos()),
+		ActivityID:                  info.GetActivityID(),
+		RequestID:                   info.GetRequestID(),
+		ScheduleToStartTimeout:      common.SecondsToDuration(int64(info.GetScheduleToStartTimeoutSeconds())),
+		ScheduleToCloseTimeout:      common.SecondsToDuration(int64(info.GetScheduleToCloseTimeoutSeconds())),
+		StartToCloseTimeout:         common.SecondsToDuration(int64(info.GetStartToCloseTimeoutSeconds())),
+		HeartbeatTimeout:            common.SecondsToDuration(int64(info.GetHeartbeatTimeoutSeconds())),
+		CancelRequested:             info.GetCancelRequested(),
+		CancelRequestID:             info.GetCancelRequestID(),
+		TimerTaskStatus:             info.GetTimerTaskStatus(),
+		Attempt:                     info.GetAttempt(),
+		TaskList:                    info.GetTaskList(),
+		StartedIdentity:             info.GetStartedIdentity(),
+		HasRetryPolicy:              info.GetHasRetryPolicy(),
+		RetryInitialInterval:        common.SecondsToDuration(int64(info.GetRetryInitialIntervalSeconds())),
+		RetryMaximumInterval:        common.SecondsToDuration(int64(info.GetRetryMaximumIntervalSeconds())),
+		RetryMaximumAttempts:        info.GetRetryMaximumAttempts(),
+		RetryExpirationTimestamp:    timeFromUnixNano(info.GetRetryExpirationTimeNanos()),
+		RetryBackoffCoefficient:     info.GetRetryBackoffCoefficient(),
+		RetryNonRetryableErrors:     info.RetryNonRetryableErrors,
+		RetryLastFailureReason:      info.GetRetryLastFailureReason(),
+		RetryLastWorkerIdentity:     info.GetRetryLastWorkerIdentity(),
+		RetryLastFailureDetails:     info.RetryLastFailureDetails,
+		Priority:                    info.GetPriority(),
+		FairnessKey:                 info.GetFairnessKey(),
+		RetryBackoffSchedule:        info.RetryBackoffScheduleSeconds,
+		RetryJitterMode:             info.GetRetryJitterMode(),
+		RetryReasonPolicies:         info.RetryReasonPolicies,
+		RetryReasonPoliciesEncoding: info.GetRetryReasonPoliciesEncoding(),
 	}
 }
 
@@ -540,6 +586,9 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ScheduleID:       &info.ScheduleID,
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
+		Priority:         &info.Priority,
+		FairnessKey:      &info.FairnessKey,
+		IsolationGroup:   &info.IsolationGroup,
 	}
 }
 
@@ -553,6 +602,9 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ScheduleID:       info.GetScheduleID(),
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
+		Priority:         info.GetPriority(),
+		FairnessKey:      info.GetFairnessKey(),
+		IsolationGroup:   info.GetIsolationGroup(),
 	}
 }
 
@@ -560,24 +612,42 @@ func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 	if info == nil {
 		return nil
 	}
-	return &sqlblobs.TaskListInfo{
+	result := &sqlblobs.TaskListInfo{
 		Kind:             &info.Kind,
 		AckLevel:         &info.AckLevel,
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		LastUpdatedNanos: timeToUnixNanoPtr(info.LastUpdated),
+		Paused:           &info.Paused,
+		MigrateTo:        &info.MigrateTo,
 	}
+	if info.VersionSets != nil {
+		result.VersionSets = make([]*shared.WorkerVersionSet, len(info.VersionSets))
+		for i, set := range info.VersionSets {
+			result.VersionSets[i] = &shared.WorkerVersionSet{BuildIds: set.BuildIDs}
+		}
+	}
+	return result
 }
 
 func taskListInfoFromThrift(info *sqlblobs.TaskListInfo) *TaskListInfo {
 	if info == nil {
 		return nil
 	}
-	return &TaskListInfo{
+	result := &TaskListInfo{
 		Kind:            info.GetKind(),
 		AckLevel:        info.GetAckLevel(),
 		ExpiryTimestamp: timeFromUnixNano(info.GetExpiryTimeNanos()),
 		LastUpdated:     timeFromUnixNano(info.GetLastUpdatedNanos()),
+		Paused:          info.GetPaused(),
+		MigrateTo:       info.GetMigrateTo(),
 	}
+	if info.VersionSets != nil {
+		result.VersionSets = make([]*types.WorkerVersionSet, len(info.VersionSets))
+		for i, set := range info.VersionSets {
+			result.VersionSets[i] = &types.WorkerVersionSet{BuildIDs: set.GetBuildIds()}
+		}
+	}
+	return result
 }
 
 func transferTaskInfoToThrift(info *TransferTaskInfo) *sqlblobs.TransferTaskInfo {
@@ -736,6 +806,14 @@ func timeFromUnixNano(t int64) time.Time {
 	return time.Unix(0, t)
 }
 
+// timeFromOptionalUnixNano returns zero time for fields missing in blobs written by older versions
+func timeFromOptionalUnixNano(t *int64) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return timeFromUnixNano(*t)
+}
+
 func durationToSecondsInt32Ptr(t time.Duration) *int32 {
 	return common.Int32Ptr(int32(common.DurationToSeconds(t)))
 }
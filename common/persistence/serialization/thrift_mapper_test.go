@@ -32,6 +32,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
 )
 
@@ -105,6 +106,7 @@ func TestDomainInfo(t *testing.T) {
 		FailoverEndTimestamp:        common.TimePtr(time.Now()),
 		PreviousFailoverVersion:     int64(rand.Intn(1000)),
 		LastUpdatedTimestamp:        time.Now(),
+		DrainedIsolationGroups:      []string{"zone-a", "zone-b"},
 	}
 	actual := domainInfoFromThrift(domainInfoToThrift(expected))
 	assert.Equal(t, expected.Name, actual.Name)
@@ -127,6 +129,7 @@ func TestDomainInfo(t *testing.T) {
 	assert.Equal(t, expected.HistoryArchivalURI, actual.HistoryArchivalURI)
 	assert.Equal(t, expected.VisibilityArchivalStatus, actual.VisibilityArchivalStatus)
 	assert.Equal(t, expected.VisibilityArchivalURI, actual.VisibilityArchivalURI)
+	assert.Equal(t, expected.DrainedIsolationGroups, actual.DrainedIsolationGroups)
 	assert.Equal(t, expected.FailoverEndTimestamp.Sub(*actual.FailoverEndTimestamp), time.Duration(0))
 	assert.Equal(t, expected.PreviousFailoverVersion, actual.PreviousFailoverVersion)
 	assert.Equal(t, expected.LastUpdatedTimestamp.Sub(actual.LastUpdatedTimestamp), time.Duration(0))
@@ -215,6 +218,16 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		Memo:                               map[string][]byte{"key_1": []byte("Memo")},
 		VersionHistories:                   []byte("VersionHistories"),
 		VersionHistoriesEncoding:           "VersionHistoriesEncoding",
+		TaskPriority:                       int32(rand.Intn(1000)),
+		TaskFairnessKey:                    "TaskFairnessKey",
+		IsolationGroup:                     "IsolationGroup",
+		RetryBackoffSchedule:               []int32{1, 5, 10},
+		RetryJitterMode:                    int32(rand.Intn(3)),
+		RetryReasonPolicies:                []byte("RetryReasonPolicies"),
+		RetryReasonPoliciesEncoding:        "RetryReasonPoliciesEncoding",
+		RetryElapsedExpirationTimestamp:    time.Now(),
+		NextRetryTimestamp:                 time.Now(),
+		DedupRequestIDs:                    map[string]time.Time{"request_1": time.Now()},
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
 	assert.Equal(t, expected.ParentDomainID, actual.ParentDomainID)
@@ -269,6 +282,18 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 	assert.Equal(t, expected.Memo, actual.Memo)
 	assert.Equal(t, expected.VersionHistories, actual.VersionHistories)
 	assert.Equal(t, expected.VersionHistoriesEncoding, actual.VersionHistoriesEncoding)
+	assert.Equal(t, expected.TaskPriority, actual.TaskPriority)
+	assert.Equal(t, expected.TaskFairnessKey, actual.TaskFairnessKey)
+	assert.Equal(t, expected.IsolationGroup, actual.IsolationGroup)
+	assert.Equal(t, expected.RetryBackoffSchedule, actual.RetryBackoffSchedule)
+	assert.Equal(t, expected.RetryJitterMode, actual.RetryJitterMode)
+	assert.Equal(t, expected.RetryReasonPolicies, actual.RetryReasonPolicies)
+	assert.Equal(t, expected.RetryReasonPoliciesEncoding, actual.RetryReasonPoliciesEncoding)
+	assert.Equal(t, expected.RetryElapsedExpirationTimestamp.Sub(actual.RetryElapsedExpirationTimestamp), time.Duration(0))
+	assert.Equal(t, expected.NextRetryTimestamp.Sub(actual.NextRetryTimestamp), time.Duration(0))
+	assert.Len(t, actual.DedupRequestIDs, 1)
+	assert.Contains(t, actual.DedupRequestIDs, "request_1")
+	assert.Equal(t, expected.DedupRequestIDs["request_1"].Sub(actual.DedupRequestIDs["request_1"]), time.Duration(0))
 	assert.Equal(t, expected.RetryExpirationTimestamp.Sub(actual.RetryExpirationTimestamp), time.Duration(0))
 	assert.True(t, (expected.StickyScheduleToStartTimeout-actual.StickyScheduleToStartTimeout) < time.Second)
 	assert.True(t, (expected.RetryInitialInterval-actual.RetryInitialInterval) < time.Second)
@@ -278,37 +303,43 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 
 func TestActivityInfo(t *testing.T) {
 	expected := &ActivityInfo{
-		Version:                  int64(rand.Intn(1000)),
-		ScheduledEventBatchID:    int64(rand.Intn(1000)),
-		ScheduledEvent:           []byte("ScheduledEvent"),
-		ScheduledEventEncoding:   "ScheduledEventEncoding",
-		ScheduledTimestamp:       time.Now(),
-		StartedID:                int64(rand.Intn(1000)),
-		StartedEvent:             []byte("StartedEvent"),
-		StartedEventEncoding:     "StartedEventEncoding",
-		StartedTimestamp:         time.Now(),
-		ActivityID:               "ActivityID",
-		RequestID:                "RequestID",
-		ScheduleToStartTimeout:   time.Minute * time.Duration(rand.Intn(10)),
-		ScheduleToCloseTimeout:   time.Minute * time.Duration(rand.Intn(10)),
-		StartToCloseTimeout:      time.Minute * time.Duration(rand.Intn(10)),
-		HeartbeatTimeout:         time.Minute * time.Duration(rand.Intn(10)),
-		CancelRequested:          true,
-		CancelRequestID:          int64(rand.Intn(1000)),
-		TimerTaskStatus:          int32(rand.Intn(1000)),
-		Attempt:                  int32(rand.Intn(1000)),
-		TaskList:                 "TaskList",
-		StartedIdentity:          "StartedIdentity",
-		HasRetryPolicy:           true,
-		RetryInitialInterval:     time.Minute * time.Duration(rand.Intn(10)),
-		RetryMaximumInterval:     time.Minute * time.Duration(rand.Intn(10)),
-		RetryMaximumAttempts:     int32(rand.Intn(1000)),
-		RetryExpirationTimestamp: time.Time{},
-		RetryBackoffCoefficient:  rand.Float64() * 1000,
-		RetryNonRetryableErrors:  []string{"RetryNonRetryableErrors"},
-		RetryLastFailureReason:   "RetryLastFailureReason",
-		RetryLastWorkerIdentity:  "RetryLastWorkerIdentity",
-		RetryLastFailureDetails:  []byte("RetryLastFailureDetails"),
+		Version:                     int64(rand.Intn(1000)),
+		ScheduledEventBatchID:       int64(rand.Intn(1000)),
+		ScheduledEvent:              []byte("ScheduledEvent"),
+		ScheduledEventEncoding:      "ScheduledEventEncoding",
+		ScheduledTimestamp:          time.Now(),
+		StartedID:                   int64(rand.Intn(1000)),
+		StartedEvent:                []byte("StartedEvent"),
+		StartedEventEncoding:        "StartedEventEncoding",
+		StartedTimestamp:            time.Now(),
+		ActivityID:                  "ActivityID",
+		RequestID:                   "RequestID",
+		ScheduleToStartTimeout:      time.Minute * time.Duration(rand.Intn(10)),
+		ScheduleToCloseTimeout:      time.Minute * time.Duration(rand.Intn(10)),
+		StartToCloseTimeout:         time.Minute * time.Duration(rand.Intn(10)),
+		HeartbeatTimeout:            time.Minute * time.Duration(rand.Intn(10)),
+		CancelRequested:             true,
+		CancelRequestID:             int64(rand.Intn(1000)),
+		TimerTaskStatus:             int32(rand.Intn(1000)),
+		Attempt:                     int32(rand.Intn(1000)),
+		TaskList:                    "TaskList",
+		StartedIdentity:             "StartedIdentity",
+		HasRetryPolicy:              true,
+		RetryInitialInterval:        time.Minute * time.Duration(rand.Intn(10)),
+		RetryMaximumInterval:        time.Minute * time.Duration(rand.Intn(10)),
+		RetryMaximumAttempts:        int32(rand.Intn(1000)),
+		RetryExpirationTimestamp:    time.Time{},
+		RetryBackoffCoefficient:     rand.Float64() * 1000,
+		RetryNonRetryableErrors:     []string{"RetryNonRetryableErrors"},
+		RetryLastFailureReason:      "RetryLastFailureReason",
+		RetryLastWorkerIdentity:     "RetryLastWorkerIdentity",
+		RetryLastFailureDetails:     []byte("RetryLastFailureDetails"),
+		Priority:                    int32(rand.Intn(1000)),
+		FairnessKey:                 "FairnessKey",
+		RetryBackoffSchedule:        []int32{1, 5, 10},
+		RetryJitterMode:             int32(rand.Intn(3)),
+		RetryReasonPolicies:         []byte("RetryReasonPolicies"),
+		RetryReasonPoliciesEncoding: "RetryReasonPoliciesEncoding",
 	}
 	actual := activityInfoFromThrift(activityInfoToThrift(expected))
 	assert.Equal(t, expected.Version, actual.Version)
@@ -333,6 +364,12 @@ func TestActivityInfo(t *testing.T) {
 	assert.Equal(t, expected.RetryLastFailureReason, actual.RetryLastFailureReason)
 	assert.Equal(t, expected.RetryLastWorkerIdentity, actual.RetryLastWorkerIdentity)
 	assert.Equal(t, expected.RetryLastFailureDetails, actual.RetryLastFailureDetails)
+	assert.Equal(t, expected.Priority, actual.Priority)
+	assert.Equal(t, expected.FairnessKey, actual.FairnessKey)
+	assert.Equal(t, expected.RetryBackoffSchedule, actual.RetryBackoffSchedule)
+	assert.Equal(t, expected.RetryJitterMode, actual.RetryJitterMode)
+	assert.Equal(t, expected.RetryReasonPolicies, actual.RetryReasonPolicies)
+	assert.Equal(t, expected.RetryReasonPoliciesEncoding, actual.RetryReasonPoliciesEncoding)
 	assert.True(t, (expected.ScheduleToStartTimeout-actual.ScheduleToStartTimeout) < time.Second)
 	assert.True(t, (expected.ScheduleToCloseTimeout-actual.ScheduleToCloseTimeout) < time.Second)
 	assert.True(t, (expected.StartToCloseTimeout-actual.StartToCloseTimeout) < time.Second)
@@ -408,6 +445,9 @@ func TestTaskInfo(t *testing.T) {
 		ScheduleID:       int64(rand.Intn(1000)),
 		ExpiryTimestamp:  time.Now(),
 		CreatedTimestamp: time.Now(),
+		Priority:         int32(rand.Intn(1000)),
+		FairnessKey:      "FairnessKey",
+		IsolationGroup:   "IsolationGroup",
 	}
 	actual := taskInfoFromThrift(taskInfoToThrift(expected))
 	assert.Equal(t, expected.WorkflowID, actual.WorkflowID)
@@ -415,6 +455,9 @@ func TestTaskInfo(t *testing.T) {
 	assert.Equal(t, expected.ScheduleID, actual.ScheduleID)
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 	assert.Equal(t, expected.CreatedTimestamp.Sub(actual.CreatedTimestamp), time.Duration(0))
+	assert.Equal(t, expected.Priority, actual.Priority)
+	assert.Equal(t, expected.FairnessKey, actual.FairnessKey)
+	assert.Equal(t, expected.IsolationGroup, actual.IsolationGroup)
 }
 
 func TestTaskListInfo(t *testing.T) {
@@ -423,12 +466,21 @@ func TestTaskListInfo(t *testing.T) {
 		AckLevel:        int64(rand.Intn(1000)),
 		ExpiryTimestamp: time.Now(),
 		LastUpdated:     time.Now(),
+		VersionSets: []*types.WorkerVersionSet{
+			{BuildIDs: []string{"1.0", "1.1"}},
+			{BuildIDs: []string{"2.0"}},
+		},
+		Paused:    true,
+		MigrateTo: "task-list-destination",
 	}
 	actual := taskListInfoFromThrift(taskListInfoToThrift(expected))
 	assert.Equal(t, expected.Kind, actual.Kind)
 	assert.Equal(t, expected.AckLevel, actual.AckLevel)
 	assert.Equal(t, expected.LastUpdated.Sub(actual.LastUpdated), time.Duration(0))
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
+	assert.Equal(t, expected.VersionSets, actual.VersionSets)
+	assert.Equal(t, expected.Paused, actual.Paused)
+	assert.Equal(t, expected.MigrateTo, actual.MigrateTo)
 }
 
 func TestTransferTaskInfo(t *testing.T) {
@@ -482,3 +534,9 @@ func TestReplicationTaskInfo(t *testing.T) {
 	actual := replicationTaskInfoFromThrift(replicationTaskInfoToThrift(expected))
 	assert.Equal(t, expected, actual)
 }
+
+func TestWorkflowExecutionInfo_MissingRetryTimes(t *testing.T) {
+	actual := workflowExecutionInfoFromThrift(&sqlblobs.WorkflowExecutionInfo{})
+	assert.True(t, actual.RetryElapsedExpirationTimestamp.IsZero())
+	assert.True(t, actual.NextRetryTimestamp.IsZero())
+}
@@ -68,6 +68,10 @@ type (
 		// serialize/deserialize processing queue states
 		SerializeProcessingQueueStates(states *types.ProcessingQueueStates, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeProcessingQueueStates(data *DataBlob) (*types.ProcessingQueueStates, error)
+
+		// serialize/deserialize failure reason policies of retry policies
+		SerializeRetryReasonPolicies(policies []*types.RetryReasonPolicy, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeRetryReasonPolicies(data *DataBlob) ([]*types.RetryReasonPolicy, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -234,6 +238,30 @@ func (t *serializerImpl) DeserializeProcessingQueueStates(
 	return &states, err
 }
 
+func (t *serializerImpl) SerializeRetryReasonPolicies(
+	policies []*types.RetryReasonPolicy,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+	if policies == nil {
+		return nil, nil
+	}
+	return t.serialize(policies, encodingType)
+}
+
+func (t *serializerImpl) DeserializeRetryReasonPolicies(
+	data *DataBlob,
+) ([]*types.RetryReasonPolicy, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var policies []*types.RetryReasonPolicy
+	if len(data.Data) == 0 {
+		return policies, nil
+	}
+	err := t.deserialize(data, &policies)
+	return policies, err
+}
+
 func (t *serializerImpl) serialize(input interface{}, encodingType common.EncodingType) (*DataBlob, error) {
 	if input == nil {
 		return nil, nil
@@ -276,6 +304,9 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(&replicator.FailoverMarkers{FailoverMarkers: thrift.FromFailoverMarkerAttributesArray(input)})
 	case *types.ProcessingQueueStates:
 		return t.thriftrwEncoder.Encode(thrift.FromProcessingQueueStates(input))
+	case []*types.RetryReasonPolicy:
+		// reason policies are encoded in a retry policy as thrift has no top level lists
+		return t.thriftrwEncoder.Encode(&workflow.RetryPolicy{ReasonPolicies: thrift.FromRetryReasonPolicyArray(input)})
 	default:
 		return nil, nil
 	}
@@ -363,6 +394,13 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = *thrift.ToProcessingQueueStates(&thriftTarget)
 		return nil
+	case *[]*types.RetryReasonPolicy:
+		thriftTarget := workflow.RetryPolicy{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thrift.ToRetryReasonPolicyArray(thriftTarget.GetReasonPolicies())
+		return nil
 	default:
 		return nil
 	}
@@ -57,6 +57,56 @@ func (s *cadenceSerializerSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 }
 
+func (s *cadenceSerializerSuite) TestSerializeBatchEvents_UpsertWorkflowMemo() {
+	serializer := NewPayloadSerializer()
+	events := []*types.HistoryEvent{{
+		EventID:   5,
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+		EventType: types.EventTypeUpsertWorkflowMemo.Ptr(),
+		UpsertWorkflowMemoEventAttributes: &types.UpsertWorkflowMemoEventAttributes{
+			DecisionTaskCompletedEventID: 4,
+			Memo:                         &types.Memo{Fields: map[string][]byte{"Progress": []byte("50%")}},
+		},
+	}}
+
+	for _, encodingType := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+		blob, err := serializer.SerializeBatchEvents(events, encodingType)
+		s.NoError(err)
+		deserialized, err := serializer.DeserializeBatchEvents(blob)
+		s.NoError(err)
+		s.Equal(events, deserialized)
+	}
+}
+
+func (s *cadenceSerializerSuite) TestSerializeRetryReasonPolicies() {
+	serializer := NewPayloadSerializer()
+	policies := []*types.RetryReasonPolicy{{
+		ReasonPattern: "^cadenceInternal:Timeout",
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds: 5,
+			BackoffCoefficient:       2,
+			MaximumAttempts:          10,
+			BackoffScheduleInSeconds: []int32{1, 10},
+			JitterMode:               types.RetryJitterModeEqual,
+		},
+	}}
+
+	for _, encodingType := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+		blob, err := serializer.SerializeRetryReasonPolicies(policies, encodingType)
+		s.NoError(err)
+		deserialized, err := serializer.DeserializeRetryReasonPolicies(blob)
+		s.NoError(err)
+		s.Equal(policies, deserialized)
+	}
+
+	blob, err := serializer.SerializeRetryReasonPolicies(nil, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	s.Nil(blob)
+	deserialized, err := serializer.DeserializeRetryReasonPolicies(nil)
+	s.NoError(err)
+	s.Nil(deserialized)
+}
+
 func (s *cadenceSerializerSuite) TestSerializer() {
 
 	concurrency := 1
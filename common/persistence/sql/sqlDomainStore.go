@@ -129,6 +129,7 @@ func (m *sqlDomainStore) CreateDomain(
 		LastUpdatedTimestamp:        request.LastUpdatedTime,
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		DrainedIsolationGroups:      request.Config.DrainedIsolationGroups,
 	}
 
 	blob, err := m.parser.DomainInfoToBlob(domainInfo)
@@ -245,6 +246,7 @@ func (m *sqlDomainStore) domainRowToGetDomainResponse(row *sqlplugin.DomainRow)
 			VisibilityArchivalStatus: types.ArchivalStatus(domainInfo.GetVisibilityArchivalStatus()),
 			VisibilityArchivalURI:    domainInfo.GetVisibilityArchivalURI(),
 			BadBinaries:              badBinaries,
+			DrainedIsolationGroups:   domainInfo.GetDrainedIsolationGroups(),
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: cluster.GetOrUseDefaultActiveCluster(m.activeClusterName, domainInfo.GetActiveClusterName()),
@@ -302,6 +304,7 @@ func (m *sqlDomainStore) UpdateDomain(
 		LastUpdatedTimestamp:        request.LastUpdatedTime,
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		DrainedIsolationGroups:      request.Config.DrainedIsolationGroups,
 	}
 
 	blob, err := m.parser.DomainInfoToBlob(domainInfo)
@@ -1313,6 +1313,14 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 		NonRetriableErrors:                 info.GetRetryNonRetryableErrors(),
 		SearchAttributes:                   info.GetSearchAttributes(),
 		Memo:                               info.GetMemo(),
+		TaskPriority:                       info.GetTaskPriority(),
+		TaskFairnessKey:                    info.GetTaskFairnessKey(),
+		IsolationGroup:                     info.GetIsolationGroup(),
+		RetryBackoffSchedule:               info.GetRetryBackoffSchedule(),
+		RetryJitterMode:                    types.RetryJitterMode(info.GetRetryJitterMode()),
+		RetryElapsedExpirationTime:         info.GetRetryElapsedExpirationTimestamp(),
+		NextRetryTime:                      info.GetNextRetryTimestamp(),
+		DedupRequestIDs:                    info.GetDedupRequestIDs(),
 	}
 
 	// TODO: remove this after all 2DC workflows complete
@@ -1356,6 +1364,11 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 		state.ExecutionInfo.AutoResetPoints = p.NewDataBlob(info.AutoResetPoints,
 			common.EncodingType(info.GetAutoResetPointsEncoding()))
 	}
+
+	if info.RetryReasonPolicies != nil {
+		state.ExecutionInfo.RetryReasonPolicies = p.NewDataBlob(info.RetryReasonPolicies,
+			common.EncodingType(info.GetRetryReasonPoliciesEncoding()))
+	}
 	return state, nil
 }
 
@@ -1370,6 +1370,14 @@ func buildExecutionRow(
 		AutoResetPointsEncoding:            string(executionInfo.AutoResetPoints.GetEncoding()),
 		SearchAttributes:                   executionInfo.SearchAttributes,
 		Memo:                               executionInfo.Memo,
+		TaskPriority:                       executionInfo.TaskPriority,
+		TaskFairnessKey:                    executionInfo.TaskFairnessKey,
+		IsolationGroup:                     executionInfo.IsolationGroup,
+		RetryBackoffSchedule:               executionInfo.RetryBackoffSchedule,
+		RetryJitterMode:                    int32(executionInfo.RetryJitterMode),
+		RetryElapsedExpirationTimestamp:    executionInfo.RetryElapsedExpirationTime,
+		NextRetryTimestamp:                 executionInfo.NextRetryTime,
+		DedupRequestIDs:                    executionInfo.DedupRequestIDs,
 		CompletionEventEncoding:            string(common.EncodingTypeEmpty),
 		VersionHistoriesEncoding:           string(common.EncodingTypeEmpty),
 		InitiatedID:                        common.EmptyEventID,
@@ -1381,6 +1389,12 @@ func buildExecutionRow(
 		info.CompletionEventEncoding = string(completionEvent.Encoding)
 	}
 
+	retryReasonPolicies := executionInfo.RetryReasonPolicies
+	if retryReasonPolicies != nil {
+		info.RetryReasonPolicies = retryReasonPolicies.Data
+		info.RetryReasonPoliciesEncoding = string(retryReasonPolicies.Encoding)
+	}
+
 	info.StartVersion = startVersion
 	if versionHistories == nil {
 		// this is allowed
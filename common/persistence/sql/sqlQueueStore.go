@@ -43,6 +43,16 @@ type (
 	}
 )
 
+// NewQueuePersistence creates a queue store on top of an existing sql db,
+// it is being used by some admin toolings
+func NewQueuePersistence(
+	db sqlplugin.DB,
+	logger log.Logger,
+	queueType persistence.QueueType,
+) (persistence.Queue, error) {
+	return newQueueStore(db, logger, queueType)
+}
+
 func newQueueStore(
 	db sqlplugin.DB,
 	logger log.Logger,
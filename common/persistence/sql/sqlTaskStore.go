@@ -182,6 +182,9 @@ func (m *sqlTaskStore) LeaseTaskList(
 			AckLevel:    ackLevel,
 			Kind:        request.TaskListKind,
 			LastUpdated: now,
+			VersionSets: tlInfo.GetVersionSets(),
+			Paused:      tlInfo.GetPaused(),
+			MigrateTo:   tlInfo.GetMigrateTo(),
 		}}
 		return nil
 	})
@@ -199,6 +202,9 @@ func (m *sqlTaskStore) UpdateTaskList(
 		Kind:            int16(request.TaskListInfo.Kind),
 		ExpiryTimestamp: time.Unix(0, 0),
 		LastUpdated:     time.Now(),
+		VersionSets:     request.TaskListInfo.VersionSets,
+		Paused:          request.TaskListInfo.Paused,
+		MigrateTo:       request.TaskListInfo.MigrateTo,
 	}
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		tlInfo.ExpiryTimestamp = stickyTaskListExpiry()
@@ -390,6 +396,9 @@ func (m *sqlTaskStore) CreateTasks(
 			ScheduleID:       v.Data.ScheduleID,
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: time.Now(),
+			Priority:         v.Data.Priority,
+			FairnessKey:      v.Data.FairnessKey,
+			IsolationGroup:   v.Data.IsolationGroup,
 		})
 		if err != nil {
 			return nil, err
@@ -467,13 +476,16 @@ func (m *sqlTaskStore) GetTasks(
 			return nil, err
 		}
 		tasks[i] = &persistence.InternalTaskInfo{
-			DomainID:    request.DomainID,
-			WorkflowID:  info.GetWorkflowID(),
-			RunID:       info.RunID.String(),
-			TaskID:      v.TaskID,
-			ScheduleID:  info.GetScheduleID(),
-			Expiry:      info.GetExpiryTimestamp(),
-			CreatedTime: info.GetCreatedTimestamp(),
+			DomainID:       request.DomainID,
+			WorkflowID:     info.GetWorkflowID(),
+			RunID:          info.RunID.String(),
+			TaskID:         v.TaskID,
+			ScheduleID:     info.GetScheduleID(),
+			Expiry:         info.GetExpiryTimestamp(),
+			CreatedTime:    info.GetCreatedTimestamp(),
+			Priority:       info.GetPriority(),
+			FairnessKey:    info.GetFairnessKey(),
+			IsolationGroup: info.GetIsolationGroup(),
 		}
 	}
 
@@ -49,39 +49,46 @@ func updateActivityInfos(
 		for i, activityInfo := range activityInfos {
 			scheduledEvent, scheduledEncoding := persistence.FromDataBlob(activityInfo.ScheduledEvent)
 			startEvent, startEncoding := persistence.FromDataBlob(activityInfo.StartedEvent)
+			retryReasonPolicies, retryReasonPoliciesEncoding := persistence.FromDataBlob(activityInfo.RetryReasonPolicies)
 
 			info := &serialization.ActivityInfo{
-				Version:                  activityInfo.Version,
-				ScheduledEventBatchID:    activityInfo.ScheduledEventBatchID,
-				ScheduledEvent:           scheduledEvent,
-				ScheduledEventEncoding:   scheduledEncoding,
-				ScheduledTimestamp:       activityInfo.ScheduledTime,
-				StartedID:                activityInfo.StartedID,
-				StartedEvent:             startEvent,
-				StartedEventEncoding:     startEncoding,
-				StartedTimestamp:         activityInfo.StartedTime,
-				ActivityID:               activityInfo.ActivityID,
-				RequestID:                activityInfo.RequestID,
-				ScheduleToStartTimeout:   activityInfo.ScheduleToStartTimeout,
-				ScheduleToCloseTimeout:   activityInfo.ScheduleToCloseTimeout,
-				StartToCloseTimeout:      activityInfo.StartToCloseTimeout,
-				HeartbeatTimeout:         activityInfo.HeartbeatTimeout,
-				CancelRequested:          activityInfo.CancelRequested,
-				CancelRequestID:          activityInfo.CancelRequestID,
-				TimerTaskStatus:          activityInfo.TimerTaskStatus,
-				Attempt:                  activityInfo.Attempt,
-				TaskList:                 activityInfo.TaskList,
-				StartedIdentity:          activityInfo.StartedIdentity,
-				HasRetryPolicy:           activityInfo.HasRetryPolicy,
-				RetryInitialInterval:     activityInfo.InitialInterval,
-				RetryBackoffCoefficient:  activityInfo.BackoffCoefficient,
-				RetryMaximumInterval:     activityInfo.MaximumInterval,
-				RetryExpirationTimestamp: activityInfo.ExpirationTime,
-				RetryMaximumAttempts:     activityInfo.MaximumAttempts,
-				RetryNonRetryableErrors:  activityInfo.NonRetriableErrors,
-				RetryLastFailureReason:   activityInfo.LastFailureReason,
-				RetryLastWorkerIdentity:  activityInfo.LastWorkerIdentity,
-				RetryLastFailureDetails:  activityInfo.LastFailureDetails,
+				Version:                     activityInfo.Version,
+				ScheduledEventBatchID:       activityInfo.ScheduledEventBatchID,
+				ScheduledEvent:              scheduledEvent,
+				ScheduledEventEncoding:      scheduledEncoding,
+				ScheduledTimestamp:          activityInfo.ScheduledTime,
+				StartedID:                   activityInfo.StartedID,
+				StartedEvent:                startEvent,
+				StartedEventEncoding:        startEncoding,
+				StartedTimestamp:            activityInfo.StartedTime,
+				ActivityID:                  activityInfo.ActivityID,
+				RequestID:                   activityInfo.RequestID,
+				ScheduleToStartTimeout:      activityInfo.ScheduleToStartTimeout,
+				ScheduleToCloseTimeout:      activityInfo.ScheduleToCloseTimeout,
+				StartToCloseTimeout:         activityInfo.StartToCloseTimeout,
+				HeartbeatTimeout:            activityInfo.HeartbeatTimeout,
+				CancelRequested:             activityInfo.CancelRequested,
+				CancelRequestID:             activityInfo.CancelRequestID,
+				TimerTaskStatus:             activityInfo.TimerTaskStatus,
+				Attempt:                     activityInfo.Attempt,
+				TaskList:                    activityInfo.TaskList,
+				StartedIdentity:             activityInfo.StartedIdentity,
+				HasRetryPolicy:              activityInfo.HasRetryPolicy,
+				RetryInitialInterval:        activityInfo.InitialInterval,
+				RetryBackoffCoefficient:     activityInfo.BackoffCoefficient,
+				RetryMaximumInterval:        activityInfo.MaximumInterval,
+				RetryExpirationTimestamp:    activityInfo.ExpirationTime,
+				RetryMaximumAttempts:        activityInfo.MaximumAttempts,
+				RetryNonRetryableErrors:     activityInfo.NonRetriableErrors,
+				RetryLastFailureReason:      activityInfo.LastFailureReason,
+				RetryLastWorkerIdentity:     activityInfo.LastWorkerIdentity,
+				RetryLastFailureDetails:     activityInfo.LastFailureDetails,
+				Priority:                    activityInfo.Priority,
+				FairnessKey:                 activityInfo.FairnessKey,
+				RetryBackoffSchedule:        activityInfo.RetryBackoffSchedule,
+				RetryJitterMode:             int32(activityInfo.RetryJitterMode),
+				RetryReasonPolicies:         retryReasonPolicies,
+				RetryReasonPoliciesEncoding: retryReasonPoliciesEncoding,
 			}
 			blob, err := parser.ActivityInfoToBlob(info)
 			if err != nil {
@@ -179,10 +186,17 @@ func getActivityInfoMap(
 			LastFailureReason:        decoded.GetRetryLastFailureReason(),
 			LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
 			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
+			Priority:                 decoded.GetPriority(),
+			FairnessKey:              decoded.GetFairnessKey(),
+			RetryBackoffSchedule:     decoded.GetRetryBackoffSchedule(),
+			RetryJitterMode:          types.RetryJitterMode(decoded.GetRetryJitterMode()),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, common.EncodingType(decoded.GetStartedEventEncoding()))
 		}
+		if decoded.RetryReasonPolicies != nil {
+			info.RetryReasonPolicies = persistence.NewDataBlob(decoded.RetryReasonPolicies, common.EncodingType(decoded.GetRetryReasonPoliciesEncoding()))
+		}
 		ret[row.ScheduleID] = info
 	}
 
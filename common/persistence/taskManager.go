@@ -133,6 +133,9 @@ func (t *taskManager) toInternalTaskInfo(taskInfo *TaskInfo) *InternalTaskInfo {
 		ScheduleToStartTimeout: common.SecondsToDuration(int64(taskInfo.ScheduleToStartTimeout)),
 		Expiry:                 taskInfo.Expiry,
 		CreatedTime:            taskInfo.CreatedTime,
+		Priority:               taskInfo.Priority,
+		FairnessKey:            taskInfo.FairnessKey,
+		IsolationGroup:         taskInfo.IsolationGroup,
 	}
 }
 func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *TaskInfo {
@@ -148,5 +151,8 @@ func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *
 		ScheduleToStartTimeout: int32(internalTaskInfo.ScheduleToStartTimeout.Seconds()),
 		Expiry:                 internalTaskInfo.Expiry,
 		CreatedTime:            internalTaskInfo.CreatedTime,
+		Priority:               internalTaskInfo.Priority,
+		FairnessKey:            internalTaskInfo.FairnessKey,
+		IsolationGroup:         internalTaskInfo.IsolationGroup,
 	}
 }
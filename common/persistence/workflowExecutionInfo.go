@@ -22,6 +22,7 @@ package persistence
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/types"
 )
@@ -41,6 +42,28 @@ func (e *WorkflowExecutionInfo) SetLastFirstEventID(id int64) {
 	e.LastFirstEventID = id
 }
 
+// BuildID returns the worker build which last processed a decision of the workflow,
+// it is the binary checksum of the last auto reset point and empty if there is none
+func (e *WorkflowExecutionInfo) BuildID() string {
+	points := e.AutoResetPoints.GetPoints()
+	if len(points) == 0 {
+		return ""
+	}
+	return points[len(points)-1].GetBinaryChecksum()
+}
+
+// CopyDedupRequestIDs returns a copy of the request IDs kept for deduplication
+func (e *WorkflowExecutionInfo) CopyDedupRequestIDs() map[string]time.Time {
+	if e.DedupRequestIDs == nil {
+		return nil
+	}
+	requestIDs := make(map[string]time.Time, len(e.DedupRequestIDs))
+	for requestID, receivedTime := range e.DedupRequestIDs {
+		requestIDs[requestID] = receivedTime
+	}
+	return requestIDs
+}
+
 // UpdateWorkflowStateCloseStatus update the workflow state
 func (e *WorkflowExecutionInfo) UpdateWorkflowStateCloseStatus(
 	state int,
@@ -63,9 +86,11 @@ func (e *WorkflowExecutionInfo) UpdateWorkflowStateCloseStatus(
 			}
 
 		case WorkflowStateCompleted:
+			// a workflow with a delayed start is canceled before its first decision task
 			if closeStatus != WorkflowCloseStatusTerminated &&
 				closeStatus != WorkflowCloseStatusTimedOut &&
-				closeStatus != WorkflowCloseStatusContinuedAsNew {
+				closeStatus != WorkflowCloseStatusContinuedAsNew &&
+				closeStatus != WorkflowCloseStatusCanceled {
 				return e.createInvalidStateTransitionErr(e.State, state, closeStatus)
 			}
 
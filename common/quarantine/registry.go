@@ -0,0 +1,199 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination registry_mock.go
+
+package quarantine
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	refreshInterval = 10 * time.Second
+	refreshTimeout  = 5 * time.Second
+
+	compactInterval = time.Hour
+	compactTimeout  = 30 * time.Second
+	// releasedEntryRetention is how long the entries of released workflow executions are kept in the store
+	releasedEntryRetention = 24 * time.Hour
+)
+
+type (
+	// Registry caches the quarantine entries of the store on a host, the
+	// entries changed by other hosts are visible after the next refresh
+	Registry interface {
+		common.Daemon
+
+		// GetEntry returns the latest entry of a workflow execution, or nil if it was never quarantined
+		GetEntry(execution Execution) *Entry
+		// Quarantine records a workflow execution as quarantined
+		Quarantine(ctx context.Context, entry *Entry) error
+		// Refresh reads the entries from the store right away
+		Refresh(ctx context.Context) error
+	}
+
+	registryImpl struct {
+		store  Store
+		logger log.Logger
+		status int32
+		done   chan struct{}
+
+		// refreshLock serializes the refreshes, they do not hold the lock of the entries while reading the store
+		refreshLock sync.Mutex
+
+		sync.RWMutex
+		entries map[Execution]*Entry
+		// written holds the entries quarantined on this host while a refresh reads the store,
+		// the store snapshot may predate them so they are merged into it
+		written map[Execution]*Entry
+	}
+)
+
+var _ Registry = (*registryImpl)(nil)
+
+// NewRegistry creates a registry of quarantined workflow executions
+func NewRegistry(
+	store Store,
+	logger log.Logger,
+) Registry {
+	return &registryImpl{
+		store:   store,
+		logger:  logger,
+		status:  common.DaemonStatusInitialized,
+		done:    make(chan struct{}),
+		entries: make(map[Execution]*Entry),
+	}
+}
+
+func (r *registryImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go r.refreshLoop()
+}
+
+func (r *registryImpl) Stop() {
+	if atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		close(r.done)
+	}
+}
+
+func (r *registryImpl) GetEntry(
+	execution Execution,
+) *Entry {
+	r.RLock()
+	defer r.RUnlock()
+	return r.entries[execution]
+}
+
+func (r *registryImpl) Quarantine(
+	ctx context.Context,
+	entry *Entry,
+) error {
+	if err := r.store.Quarantine(ctx, entry); err != nil {
+		return err
+	}
+	// the workflow execution is quarantined on this host right away, the stored entry is picked up by the next refresh
+	local := &Entry{
+		Execution: entry.Execution,
+		ShardID:   entry.ShardID,
+		Details:   entry.Details,
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.entries[entry.Execution] = local
+	if r.written != nil {
+		r.written[entry.Execution] = local
+	}
+	return nil
+}
+
+func (r *registryImpl) Refresh(
+	ctx context.Context,
+) error {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+
+	r.Lock()
+	r.written = make(map[Execution]*Entry)
+	r.Unlock()
+
+	entries, err := r.store.GetEntries(ctx)
+
+	r.Lock()
+	defer r.Unlock()
+	written := r.written
+	r.written = nil
+	if err != nil {
+		return err
+	}
+	for execution, entry := range written {
+		entries[execution] = entry
+	}
+	r.entries = entries
+	return nil
+}
+
+func (r *registryImpl) refreshLoop() {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	// every host compacts the store, the jitter spreads the compactions of the hosts started together
+	compactTimer := time.NewTimer(backoff.JitDuration(compactInterval, 0.5))
+	defer compactTimer.Stop()
+
+	r.refresh()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			r.refresh()
+		case <-compactTimer.C:
+			r.compact()
+			compactTimer.Reset(backoff.JitDuration(compactInterval, 0.5))
+		}
+	}
+}
+
+func (r *registryImpl) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	defer cancel()
+	if err := r.Refresh(ctx); err != nil {
+		// keep the entries until the store is available again
+		r.logger.Warn("Failed to refresh quarantined workflow executions.", tag.Error(err))
+	}
+}
+
+func (r *registryImpl) compact() {
+	ctx, cancel := context.WithTimeout(context.Background(), compactTimeout)
+	defer cancel()
+	if err := r.store.Compact(ctx, releasedEntryRetention); err != nil {
+		r.logger.Warn("Failed to compact quarantined workflow executions.", tag.Error(err))
+	}
+}
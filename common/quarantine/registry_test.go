@@ -0,0 +1,82 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quarantine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+func TestRegistry(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := NewMockStore(controller)
+	r := NewRegistry(store, loggerimpl.NewNopLogger()).(*registryImpl)
+	assert.Nil(t, r.GetEntry(testExecution))
+
+	entry := &Entry{Execution: testExecution, ShardID: 1, Details: "mismatch"}
+	store.EXPECT().Quarantine(gomock.Any(), entry).Return(nil).Times(1)
+	assert.NoError(t, r.Quarantine(context.Background(), entry))
+	assert.True(t, r.GetEntry(testExecution).IsQuarantined())
+
+	// a failed refresh keeps the entries
+	store.EXPECT().GetEntries(gomock.Any()).Return(nil, errors.New("some error")).Times(1)
+	r.refresh()
+	assert.True(t, r.GetEntry(testExecution).IsQuarantined())
+
+	released := &Entry{Execution: testExecution, Released: true, Repair: true}
+	store.EXPECT().GetEntries(gomock.Any()).Return(map[Execution]*Entry{testExecution: released}, nil).Times(1)
+	assert.NoError(t, r.Refresh(context.Background()))
+	assert.Equal(t, released, r.GetEntry(testExecution))
+
+	store.EXPECT().Compact(gomock.Any(), releasedEntryRetention).Return(nil).Times(1)
+	r.compact()
+}
+
+func TestRegistry_QuarantineDuringRefresh(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := NewMockStore(controller)
+	r := NewRegistry(store, loggerimpl.NewNopLogger()).(*registryImpl)
+
+	// the workflow execution is quarantined after the refresh read the store, the entry is not in the snapshot
+	entry := &Entry{Execution: testExecution, ShardID: 1, Details: "mismatch"}
+	store.EXPECT().Quarantine(gomock.Any(), entry).Return(nil).Times(1)
+	store.EXPECT().GetEntries(gomock.Any()).DoAndReturn(func(ctx context.Context) (map[Execution]*Entry, error) {
+		assert.NoError(t, r.Quarantine(ctx, entry))
+		return map[Execution]*Entry{}, nil
+	}).Times(1)
+	assert.NoError(t, r.Refresh(context.Background()))
+	assert.True(t, r.GetEntry(testExecution).IsQuarantined())
+
+	// the next snapshot of the store is used as is
+	store.EXPECT().GetEntries(gomock.Any()).Return(map[Execution]*Entry{}, nil).Times(1)
+	assert.NoError(t, r.Refresh(context.Background()))
+	assert.Nil(t, r.GetEntry(testExecution))
+}
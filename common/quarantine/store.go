@@ -0,0 +1,252 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination store_mock.go
+
+// Package quarantine keeps track of the workflow executions the history service stopped
+// processing because their mutable state failed checksum verification and could not be
+// repaired automatically.
+//
+// A quarantined workflow execution is not loaded by the history service, so its API calls fail
+// and its tasks are dropped until an operator releases it through the admin APIs, either accepting
+// the persisted mutable state as is or requesting it to be rebuilt from history. The tasks of the
+// workflow execution are regenerated on release.
+package quarantine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// emptyRecordID is the record ID to read the store from the beginning
+	emptyRecordID int64 = -1
+
+	readPageSize = 1000
+)
+
+type (
+	// Execution identifies a workflow execution
+	Execution struct {
+		DomainID   string `json:"domainID"`
+		WorkflowID string `json:"workflowID"`
+		RunID      string `json:"runID"`
+	}
+
+	// Entry is the quarantine state of a workflow execution
+	Entry struct {
+		Execution
+		ShardID int `json:"shardID,omitempty"`
+		// Details describe why the workflow execution is quarantined
+		Details       string    `json:"details,omitempty"`
+		QuarantinedAt time.Time `json:"quarantinedAt"`
+		// Released is true if an operator released the workflow execution after it was quarantined
+		Released bool `json:"released,omitempty"`
+		// Repair is true if the mutable state is to be rebuilt from history on release,
+		// otherwise the persisted mutable state is accepted as is
+		Repair     bool      `json:"repair,omitempty"`
+		Author     string    `json:"author,omitempty"`
+		Reason     string    `json:"reason,omitempty"`
+		ReleasedAt time.Time `json:"releasedAt,omitempty"`
+	}
+
+	// Store persists the quarantine entries of workflow executions
+	Store interface {
+		// GetEntries returns the latest entry of all the workflow executions quarantined and not yet compacted
+		GetEntries(ctx context.Context) (map[Execution]*Entry, error)
+		// Quarantine records a workflow execution as quarantined
+		Quarantine(ctx context.Context, entry *Entry) error
+		// Release records a quarantined workflow execution as released
+		Release(ctx context.Context, execution Execution, repair bool, author string, reason string) error
+		// Compact records a snapshot of the entries still quarantined or released within the retention
+		// and deletes the records before it
+		Compact(ctx context.Context, retention time.Duration) error
+	}
+
+	// record is a message of the store, either the entry of a workflow execution or a snapshot of the entries
+	record struct {
+		*Entry
+		// Snapshot is true if Entries are all the entries kept as of the record with ID Base,
+		// the entries recorded after Base are applied on top of the snapshot
+		Snapshot bool     `json:"snapshot,omitempty"`
+		Base     int64    `json:"base,omitempty"`
+		Entries  []*Entry `json:"entries,omitempty"`
+	}
+
+	storeImpl struct {
+		queue      persistence.QueueManager
+		timeSource clock.TimeSource
+	}
+)
+
+var _ Store = (*storeImpl)(nil)
+
+// NewStore creates a quarantine store on top of the workflow quarantine queue
+func NewStore(
+	queue persistence.QueueManager,
+	timeSource clock.TimeSource,
+) Store {
+	return &storeImpl{
+		queue:      queue,
+		timeSource: timeSource,
+	}
+}
+
+// IsQuarantined returns true if the entry quarantines its workflow execution
+func (e *Entry) IsQuarantined() bool {
+	return e != nil && !e.Released
+}
+
+func (s *storeImpl) GetEntries(
+	ctx context.Context,
+) (map[Execution]*Entry, error) {
+	entries, _, _, err := s.read(ctx)
+	return entries, err
+}
+
+// read returns the latest entries of the store, the ID of the last record and the number of records
+func (s *storeImpl) read(
+	ctx context.Context,
+) (map[Execution]*Entry, int64, int, error) {
+	entries := make(map[Execution]*Entry)
+	// the ID of the record of each entry, the entries recorded after the base of a snapshot are kept over it
+	recordIDs := make(map[Execution]int64)
+	lastRecordID := emptyRecordID
+	recordCount := 0
+	for {
+		messages, err := s.queue.ReadMessages(ctx, lastRecordID, readPageSize)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		for _, message := range messages {
+			r := &record{}
+			if err := json.Unmarshal(message.Payload, r); err != nil {
+				return nil, 0, 0, fmt.Errorf("failed to decode workflow quarantine record %v: %v", message.ID, err)
+			}
+			switch {
+			case r.Snapshot:
+				for execution, recordID := range recordIDs {
+					if recordID <= r.Base {
+						delete(entries, execution)
+						delete(recordIDs, execution)
+					}
+				}
+				for _, entry := range r.Entries {
+					if _, ok := recordIDs[entry.Execution]; !ok {
+						entries[entry.Execution] = entry
+						recordIDs[entry.Execution] = r.Base
+					}
+				}
+			case r.Entry != nil:
+				entries[r.Execution] = r.Entry
+				recordIDs[r.Execution] = message.ID
+			}
+			lastRecordID = message.ID
+			recordCount++
+		}
+		if len(messages) < readPageSize {
+			return entries, lastRecordID, recordCount, nil
+		}
+	}
+}
+
+func (s *storeImpl) Quarantine(
+	ctx context.Context,
+	entry *Entry,
+) error {
+	// a new quarantine replaces the release of an earlier one
+	return s.enqueue(ctx, &record{Entry: &Entry{
+		Execution:     entry.Execution,
+		ShardID:       entry.ShardID,
+		Details:       entry.Details,
+		QuarantinedAt: s.timeSource.Now(),
+	}})
+}
+
+func (s *storeImpl) Release(
+	ctx context.Context,
+	execution Execution,
+	repair bool,
+	author string,
+	reason string,
+) error {
+	entries, err := s.GetEntries(ctx)
+	if err != nil {
+		return err
+	}
+	entry, ok := entries[execution]
+	if !ok || !entry.IsQuarantined() {
+		return &types.EntityNotExistsError{
+			Message: fmt.Sprintf("workflow execution %v/%v/%v is not quarantined", execution.DomainID, execution.WorkflowID, execution.RunID),
+		}
+	}
+	entry.Released = true
+	entry.Repair = repair
+	entry.Author = author
+	entry.Reason = reason
+	entry.ReleasedAt = s.timeSource.Now()
+	return s.enqueue(ctx, &record{Entry: entry})
+}
+
+func (s *storeImpl) Compact(
+	ctx context.Context,
+	retention time.Duration,
+) error {
+	entries, lastRecordID, recordCount, err := s.read(ctx)
+	if err != nil {
+		return err
+	}
+	releasedBefore := s.timeSource.Now().Add(-retention)
+	kept := make([]*Entry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsQuarantined() || entry.ReleasedAt.After(releasedBefore) {
+			kept = append(kept, entry)
+		}
+	}
+	if recordCount == 0 || (recordCount == 1 && len(kept) == len(entries)) {
+		return nil
+	}
+	if err := s.enqueue(ctx, &record{
+		Snapshot: true,
+		Base:     lastRecordID,
+		Entries:  kept,
+	}); err != nil {
+		return err
+	}
+	// the snapshot is after the last record, so it is kept
+	return s.queue.DeleteMessagesBefore(ctx, lastRecordID+1)
+}
+
+func (s *storeImpl) enqueue(
+	ctx context.Context,
+	r *record,
+) error {
+	payload, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to encode workflow quarantine record: %v", err)
+	}
+	return s.queue.EnqueueMessage(ctx, payload)
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quarantine
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	storeSuite struct {
+		suite.Suite
+
+		queue      *testQueue
+		timeSource *clock.EventTimeSource
+		store      Store
+	}
+
+	// testQueue keeps messages in memory, only the methods used by the store are implemented
+	testQueue struct {
+		persistence.QueueManager
+
+		sync.Mutex
+		nextID   int64
+		messages []*persistence.QueueMessage
+	}
+)
+
+var testExecution = Execution{
+	DomainID:   "domain-id",
+	WorkflowID: "workflow-id",
+	RunID:      "run-id",
+}
+
+func TestStoreSuite(t *testing.T) {
+	s := new(storeSuite)
+	suite.Run(t, s)
+}
+
+func (s *storeSuite) SetupTest() {
+	s.queue = &testQueue{}
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(1600000000, 0))
+	s.store = NewStore(s.queue, s.timeSource)
+}
+
+func (s *storeSuite) TestQuarantineAndRelease() {
+	ctx := context.Background()
+	other := Execution{DomainID: "domain-id", WorkflowID: "workflow-id", RunID: "other-run-id"}
+	s.NoError(s.store.Quarantine(ctx, &Entry{Execution: testExecution, ShardID: 1, Details: "mismatch"}))
+	s.NoError(s.store.Quarantine(ctx, &Entry{Execution: other, ShardID: 1}))
+	s.NoError(s.store.Release(ctx, testExecution, true, "tester", "test"))
+
+	entries, err := s.store.GetEntries(ctx)
+	s.NoError(err)
+	s.Len(entries, 2)
+	entry := entries[testExecution]
+	s.False(entry.IsQuarantined())
+	s.True(entry.Repair)
+	s.Equal("mismatch", entry.Details)
+	s.Equal("tester", entry.Author)
+	s.Equal("test", entry.Reason)
+	s.Equal(time.Unix(1600000000, 0).Unix(), entry.ReleasedAt.Unix())
+	s.True(entries[other].IsQuarantined())
+
+	// quarantining a released workflow execution again drops the release
+	s.NoError(s.store.Quarantine(ctx, &Entry{Execution: testExecution, ShardID: 1}))
+	entries, err = s.store.GetEntries(ctx)
+	s.NoError(err)
+	s.True(entries[testExecution].IsQuarantined())
+	s.False(entries[testExecution].Repair)
+	s.Empty(entries[testExecution].Author)
+}
+
+func (s *storeSuite) TestRelease_NotQuarantined() {
+	ctx := context.Background()
+	err := s.store.Release(ctx, testExecution, false, "tester", "test")
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	s.NoError(s.store.Quarantine(ctx, &Entry{Execution: testExecution}))
+	s.NoError(s.store.Release(ctx, testExecution, false, "tester", "test"))
+	err = s.store.Release(ctx, testExecution, false, "tester", "test")
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *storeSuite) TestCompact() {
+	ctx := context.Background()
+	other := Execution{DomainID: "domain-id", WorkflowID: "workflow-id", RunID: "other-run-id"}
+	recent := Execution{DomainID: "domain-id", WorkflowID: "workflow-id", RunID: "recent-run-id"}
+	s.NoError(s.store.Compact(ctx, time.Hour))
+	s.Empty(s.queue.messages)
+
+	s.NoError(s.store.Quarantine(ctx, &Entry{Execution: testExecution, ShardID: 1, Details: "mismatch"}))
+	s.NoError(s.store.Quarantine(ctx, &Entry{Execution: other, ShardID: 1}))
+	s.NoError(s.store.Release(ctx, other, false, "tester", "test"))
+	s.timeSource.Update(time.Unix(1600000000, 0).Add(2 * time.Hour))
+	s.NoError(s.store.Quarantine(ctx, &Entry{Execution: recent, ShardID: 1}))
+	s.NoError(s.store.Release(ctx, recent, true, "tester", "test"))
+
+	s.NoError(s.store.Compact(ctx, time.Hour))
+	s.Len(s.queue.messages, 1)
+	entries, err := s.store.GetEntries(ctx)
+	s.NoError(err)
+	s.Len(entries, 2)
+	s.True(entries[testExecution].IsQuarantined())
+	s.Equal("mismatch", entries[testExecution].Details)
+	s.True(entries[recent].Repair)
+	s.NotContains(entries, other)
+
+	// a snapshot without anything to drop is not compacted again
+	s.NoError(s.store.Compact(ctx, time.Hour))
+	s.Len(s.queue.messages, 1)
+}
+
+func (s *storeSuite) TestGetEntries_RecordsAfterSnapshotBase() {
+	ctx := context.Background()
+	s.NoError(s.store.Quarantine(ctx, &Entry{Execution: testExecution, ShardID: 1}))
+	// the release is recorded while a snapshot of the quarantine is taken
+	s.NoError(s.store.Release(ctx, testExecution, false, "tester", "test"))
+	payload, err := json.Marshal(&record{
+		Snapshot: true,
+		Base:     0,
+		Entries:  []*Entry{{Execution: testExecution, ShardID: 1}},
+	})
+	s.NoError(err)
+	s.NoError(s.queue.EnqueueMessage(ctx, payload))
+
+	entries, err := s.store.GetEntries(ctx)
+	s.NoError(err)
+	s.False(entries[testExecution].IsQuarantined())
+}
+
+func (q *testQueue) EnqueueMessage(
+	_ context.Context,
+	messagePayload []byte,
+) error {
+	q.Lock()
+	defer q.Unlock()
+
+	q.messages = append(q.messages, &persistence.QueueMessage{
+		ID:        q.nextID,
+		QueueType: persistence.WorkflowQuarantineQueueType,
+		Payload:   messagePayload,
+	})
+	q.nextID++
+	return nil
+}
+
+func (q *testQueue) ReadMessages(
+	_ context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var result []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
+
+func (q *testQueue) DeleteMessagesBefore(
+	_ context.Context,
+	messageID int64,
+) error {
+	q.Lock()
+	defer q.Unlock()
+
+	var kept []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID >= messageID {
+			kept = append(kept, message)
+		}
+	}
+	q.messages = kept
+	return nil
+}
@@ -86,6 +86,21 @@ func TestMultiStageRateLimiterBlockedByGlobalRps(t *testing.T) {
 	assert.Equal(t, 2, numAllowed)
 }
 
+func TestMultiStageRateLimiterGlobalRpsKeepsDomainRps(t *testing.T) {
+	globalRps := 0.0
+	policy := NewMultiStageRateLimiter(
+		func() float64 { return globalRps },
+		func(domain string) float64 { return 1 },
+	)
+	assert.False(t, policy.Allow(Info{Domain: defaultDomain}))
+	assert.False(t, policy.Allow(Info{Domain: defaultDomain}))
+
+	// the requests rejected by the global rps did not use the token of the domain
+	policy.globalLimiter = NewDynamicRateLimiter(func() float64 { return 1 })
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain}))
+	assert.False(t, policy.Allow(Info{Domain: defaultDomain}))
+}
+
 func BenchmarkRateLimiter(b *testing.B) {
 	rps := float64(defaultRps)
 	limiter := NewRateLimiter(&rps, 2*time.Minute, defaultRps)
@@ -22,6 +22,7 @@ package quotas
 
 import (
 	"sync"
+	"time"
 )
 
 // MultiStageRateLimiter indicates a domain specific rate limit policy
@@ -78,23 +79,26 @@ func (d *MultiStageRateLimiter) Allow(info Info) bool {
 		d.Unlock()
 	}
 
-	// take a reservation with the domain limiter first
-	rsv := limiter.Reserve()
+	// take a reservation with the domain limiter first, the reservation is
+	// cancelled at the time it was taken as a reservation that is due is not
+	// restored by a later cancel
+	now := time.Now()
+	rsv := limiter.ReserveN(now, 1)
 	if !rsv.OK() {
 		return false
 	}
 
 	// check whether the reservation is valid now, otherwise
 	// cancel and return right away so we can drop the request
-	if rsv.Delay() != 0 {
-		rsv.Cancel()
+	if rsv.DelayFrom(now) != 0 {
+		rsv.CancelAt(now)
 		return false
 	}
 
 	// ensure that the reservation does not break the global rate limit, if it
 	// does, cancel the reservation and do not allow to proceed.
 	if !d.globalLimiter.Allow() {
-		rsv.Cancel()
+		rsv.CancelAt(now)
 		return false
 	}
 	return true
@@ -93,6 +93,13 @@ func (rl *RateLimiter) Reserve() *rate.Reservation {
 	return limiter.Reserve()
 }
 
+// ReserveN reserves n rate limit tokens at the given time, the reservation
+// can be cancelled at the same time to restore the tokens
+func (rl *RateLimiter) ReserveN(now time.Time, n int) *rate.Reservation {
+	limiter := rl.goRateLimiter.Load().(*rate.Limiter)
+	return limiter.ReserveN(now, n)
+}
+
 // Allow immediately returns with true or false indicating if a rate limit
 // token is available or not
 func (rl *RateLimiter) Allow() bool {
@@ -169,3 +176,10 @@ func (d *DynamicRateLimiter) Reserve() *rate.Reservation {
 	d.rl.UpdateMaxDispatch(&rps)
 	return d.rl.Reserve()
 }
+
+// ReserveN reserves n rate limit tokens at the given time
+func (d *DynamicRateLimiter) ReserveN(now time.Time, n int) *rate.Reservation {
+	rps := d.rps()
+	d.rl.UpdateMaxDispatch(&rps)
+	return d.rl.ReserveN(now, n)
+}
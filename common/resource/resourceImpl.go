@@ -54,6 +54,7 @@ import (
 	persistenceClient "github.com/uber/cadence/common/persistence/client"
 	"github.com/uber/cadence/common/resource/config"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/shardplacement"
 )
 
 type (
@@ -159,6 +160,37 @@ func New(
 		logger,
 		dynamicconfig.ClusterNameFilter(params.ClusterMetadata.GetCurrentClusterName()),
 	)
+
+	persistenceBean, err := persistenceClient.NewBeanFromFactory(persistenceClient.NewFactory(
+		&params.PersistenceConfig,
+		func(...dynamicconfig.FilterOption) int {
+			if resourceConfig.PersistenceGlobalMaxQPS() > 0 {
+				ringSize, err := membershipMonitor.GetMemberCount(serviceName)
+				if err == nil && ringSize > 0 {
+					avgQuota := common.MaxInt(resourceConfig.PersistenceGlobalMaxQPS()/ringSize, 1)
+					return common.MinInt(avgQuota, resourceConfig.PersistenceMaxQPS())
+				}
+			}
+			return resourceConfig.PersistenceMaxQPS()
+		},
+		params.ClusterMetadata.GetCurrentClusterName(),
+		params.MetricsClient,
+		logger,
+	), params, resourceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	membershipMonitor, err = shardplacement.NewMonitor(
+		membershipMonitor,
+		shardplacement.NewStore(persistenceBean.GetShardPlacementQueueManager(), clock.NewRealTimeSource()),
+		dynamicCollection.GetStringProperty(dynamicconfig.HistoryShardPlacementMode, shardplacement.ModeHash),
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	clientBean, err := client.NewClientBean(
 		client.NewRPCClientFactory(
 			params.RPCFactory,
@@ -195,26 +227,6 @@ func New(
 		return nil, err
 	}
 
-	persistenceBean, err := persistenceClient.NewBeanFromFactory(persistenceClient.NewFactory(
-		&params.PersistenceConfig,
-		func(...dynamicconfig.FilterOption) int {
-			if resourceConfig.PersistenceGlobalMaxQPS() > 0 {
-				ringSize, err := membershipMonitor.GetMemberCount(serviceName)
-				if err == nil && ringSize > 0 {
-					avgQuota := common.MaxInt(resourceConfig.PersistenceGlobalMaxQPS()/ringSize, 1)
-					return common.MinInt(avgQuota, resourceConfig.PersistenceMaxQPS())
-				}
-			}
-			return resourceConfig.PersistenceMaxQPS()
-		},
-		params.ClusterMetadata.GetCurrentClusterName(),
-		params.MetricsClient,
-		logger,
-	), params, resourceConfig)
-	if err != nil {
-		return nil, err
-	}
-
 	domainCache := cache.NewDomainCache(
 		persistenceBean.GetDomainManager(),
 		params.ClusterMetadata,
@@ -244,7 +256,7 @@ func New(
 	matchingClient := matching.NewRetryableClient(
 		matchingRawClient,
 		common.CreateMatchingServiceRetryPolicy(),
-		common.IsServiceTransientError,
+		common.IsServiceTransientErrorWithoutRetryAfter,
 	)
 
 	historyRawClient := clientBean.GetHistoryClient()
@@ -153,6 +153,11 @@ func NewTest(
 	persistenceBean.EXPECT().GetHistoryManager().Return(historyMgr).AnyTimes()
 	persistenceBean.EXPECT().GetShardManager().Return(shardMgr).AnyTimes()
 	persistenceBean.EXPECT().GetExecutionManager(gomock.Any()).Return(executionMgr, nil).AnyTimes()
+	persistenceBean.EXPECT().GetCircuitBreakerStatus().Return(nil).AnyTimes()
+	persistenceBean.EXPECT().GetMatchingDLQQueueManager().Return(nil).AnyTimes()
+	persistenceBean.EXPECT().GetShardPlacementQueueManager().Return(nil).AnyTimes()
+	persistenceBean.EXPECT().GetWorkflowQuarantineQueueManager().Return(nil).AnyTimes()
+	persistenceBean.EXPECT().GetShadowerCheckQueueManager().Return(nil).AnyTimes()
 
 	membershipMonitor := membership.NewMockMonitor(controller)
 	frontendServiceResolver := membership.NewMockServiceResolver(controller)
@@ -0,0 +1,125 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardplacement
+
+import (
+	"sort"
+)
+
+// imbalanceThreshold is how much more load than the average a host can have before shards are moved off it
+const imbalanceThreshold = 0.1
+
+// Rebalance returns at most maxMoves assignments which move shards from the most to the least loaded hosts.
+// owners are the current owners of the shards and hosts are the members of the ring.
+// The load of a shard is the sum of its share of the total RPS, queue backlog and cache size,
+// shards without a reported load and pinned shards are not moved.
+func Rebalance(
+	owners map[int]string,
+	loads map[int]*ShardLoad,
+	pinned map[int]bool,
+	hosts []string,
+	maxMoves int,
+) []*Assignment {
+	if len(hosts) < 2 || maxMoves <= 0 {
+		return nil
+	}
+
+	scores := scoreShards(loads)
+	hostLoads := make(map[string]float64, len(hosts))
+	hostShards := make(map[string][]int, len(hosts))
+	for _, host := range hosts {
+		hostLoads[host] = 0
+	}
+	total := 0.0
+	for shardID, owner := range owners {
+		if _, ok := hostLoads[owner]; !ok {
+			continue
+		}
+		hostLoads[owner] += scores[shardID]
+		total += scores[shardID]
+		if !pinned[shardID] && scores[shardID] > 0 {
+			hostShards[owner] = append(hostShards[owner], shardID)
+		}
+	}
+	average := total / float64(len(hosts))
+
+	sortedHosts := append([]string(nil), hosts...)
+	sort.Strings(sortedHosts)
+
+	var moves []*Assignment
+	for len(moves) < maxMoves {
+		hottest, coldest := sortedHosts[0], sortedHosts[0]
+		for _, host := range sortedHosts {
+			if hostLoads[host] > hostLoads[hottest] {
+				hottest = host
+			}
+			if hostLoads[host] < hostLoads[coldest] {
+				coldest = host
+			}
+		}
+		if hostLoads[hottest] <= average*(1+imbalanceThreshold) {
+			break
+		}
+
+		// move the largest shard which still lowers the load of the hottest host below its current load
+		gap := hostLoads[hottest] - hostLoads[coldest]
+		best := -1
+		for i, shardID := range hostShards[hottest] {
+			if scores[shardID] < gap && (best == -1 || scores[shardID] > scores[hostShards[hottest][best]]) {
+				best = i
+			}
+		}
+		if best == -1 {
+			break
+		}
+		shardID := hostShards[hottest][best]
+		hostShards[hottest] = append(hostShards[hottest][:best], hostShards[hottest][best+1:]...)
+		hostShards[coldest] = append(hostShards[coldest], shardID)
+		hostLoads[hottest] -= scores[shardID]
+		hostLoads[coldest] += scores[shardID]
+		moves = append(moves, &Assignment{ShardID: shardID, Host: coldest})
+	}
+	return moves
+}
+
+func scoreShards(loads map[int]*ShardLoad) map[int]float64 {
+	var totalRPS, totalBacklog, totalCache float64
+	for _, load := range loads {
+		totalRPS += load.RPS
+		totalBacklog += float64(load.QueueBacklog)
+		totalCache += float64(load.CacheSize)
+	}
+	scores := make(map[int]float64, len(loads))
+	for shardID, load := range loads {
+		score := 0.0
+		if totalRPS > 0 {
+			score += load.RPS / totalRPS
+		}
+		if totalBacklog > 0 {
+			score += float64(load.QueueBacklog) / totalBacklog
+		}
+		if totalCache > 0 {
+			score += float64(load.CacheSize) / totalCache
+		}
+		scores[shardID] = score
+	}
+	return scores
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardplacement
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+)
+
+const (
+	refreshInterval = 10 * time.Second
+	refreshTimeout  = 5 * time.Second
+)
+
+type (
+	// monitor overrides the history service resolver of a membership monitor
+	monitor struct {
+		membership.Monitor
+
+		resolver *resolver
+		status   int32
+		done     chan struct{}
+	}
+
+	// resolver looks up the assigned host of a shard before the hash ring
+	resolver struct {
+		membership.ServiceResolver
+
+		store  Store
+		mode   dynamicconfig.StringPropertyFn
+		logger log.Logger
+
+		// assignments is a map[int]string of shard ID to the identity of the assigned host
+		assignments atomic.Value
+
+		sync.Mutex
+		listeners map[string]chan<- *membership.ChangedEvent
+	}
+)
+
+// NewMonitor wraps a membership monitor, so that in the load placement mode the history service
+// resolver returns the host a shard is assigned to, if the host is a member of the ring
+func NewMonitor(
+	membershipMonitor membership.Monitor,
+	store Store,
+	mode dynamicconfig.StringPropertyFn,
+	logger log.Logger,
+) (membership.Monitor, error) {
+	historyResolver, err := membershipMonitor.GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return &monitor{
+		Monitor:  membershipMonitor,
+		resolver: newResolver(historyResolver, store, mode, logger),
+		status:   common.DaemonStatusInitialized,
+		done:     make(chan struct{}),
+	}, nil
+}
+
+func newResolver(
+	historyResolver membership.ServiceResolver,
+	store Store,
+	mode dynamicconfig.StringPropertyFn,
+	logger log.Logger,
+) *resolver {
+	r := &resolver{
+		ServiceResolver: historyResolver,
+		store:           store,
+		mode:            mode,
+		logger:          logger,
+		listeners:       make(map[string]chan<- *membership.ChangedEvent),
+	}
+	r.assignments.Store(map[int]string{})
+	return r
+}
+
+func (m *monitor) Start() {
+	m.Monitor.Start()
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go m.refreshLoop()
+}
+
+func (m *monitor) Stop() {
+	if atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		close(m.done)
+	}
+	m.Monitor.Stop()
+}
+
+func (m *monitor) Lookup(
+	service string,
+	key string,
+) (*membership.HostInfo, error) {
+	if service == common.HistoryServiceName {
+		return m.resolver.Lookup(key)
+	}
+	return m.Monitor.Lookup(service, key)
+}
+
+func (m *monitor) GetResolver(
+	service string,
+) (membership.ServiceResolver, error) {
+	if service == common.HistoryServiceName {
+		return m.resolver, nil
+	}
+	return m.Monitor.GetResolver(service)
+}
+
+func (m *monitor) AddListener(
+	service string,
+	name string,
+	notifyChannel chan<- *membership.ChangedEvent,
+) error {
+	if service == common.HistoryServiceName {
+		return m.resolver.AddListener(name, notifyChannel)
+	}
+	return m.Monitor.AddListener(service, name, notifyChannel)
+}
+
+func (m *monitor) RemoveListener(
+	service string,
+	name string,
+) error {
+	if service == common.HistoryServiceName {
+		return m.resolver.RemoveListener(name)
+	}
+	return m.Monitor.RemoveListener(service, name)
+}
+
+func (m *monitor) refreshLoop() {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	m.resolver.refresh()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			m.resolver.refresh()
+		}
+	}
+}
+
+// Lookup returns the assigned host of the shard the key is for, or the host the shard hashes to
+func (r *resolver) Lookup(
+	key string,
+) (*membership.HostInfo, error) {
+	shardID, err := strconv.Atoi(key)
+	if err != nil {
+		return r.ServiceResolver.Lookup(key)
+	}
+	if identity, ok := r.getAssignments()[shardID]; ok {
+		for _, member := range r.ServiceResolver.Members() {
+			if member.Identity() == identity {
+				return member, nil
+			}
+		}
+	}
+	return r.ServiceResolver.Lookup(ringKey(shardID))
+}
+
+// AddListener adds a listener which is notified on changes of the ring and of the assignments
+func (r *resolver) AddListener(
+	name string,
+	notifyChannel chan<- *membership.ChangedEvent,
+) error {
+	if err := r.ServiceResolver.AddListener(name, notifyChannel); err != nil {
+		return err
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+// RemoveListener removes a listener
+func (r *resolver) RemoveListener(
+	name string,
+) error {
+	r.Lock()
+	delete(r.listeners, name)
+	r.Unlock()
+	return r.ServiceResolver.RemoveListener(name)
+}
+
+func (r *resolver) getAssignments() map[int]string {
+	return r.assignments.Load().(map[int]string)
+}
+
+func (r *resolver) refresh() {
+	assignments := map[int]string{}
+	if r.mode() == ModeLoad {
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		placement, err := r.store.GetPlacement(ctx)
+		cancel()
+		if err != nil {
+			// keep the assignments until the store is available again
+			r.logger.Warn("Failed to refresh history shard placement.", tag.Error(err))
+			return
+		}
+		for shardID, assignment := range placement.Assignments {
+			assignments[shardID] = assignment.Host
+		}
+	}
+	if equalAssignments(assignments, r.getAssignments()) {
+		return
+	}
+	r.assignments.Store(assignments)
+	r.notifyListeners()
+}
+
+func (r *resolver) notifyListeners() {
+	r.Lock()
+	defer r.Unlock()
+	for name, listener := range r.listeners {
+		select {
+		case listener <- &membership.ChangedEvent{}:
+		default:
+			r.logger.Warn("Failed to notify listener of history shard placement change.", tag.Name(name))
+		}
+	}
+}
+
+// ShardKey returns the key the owner of a history shard is looked up with
+func ShardKey(shardID int) string {
+	return strconv.Itoa(shardID)
+}
+
+// ringKey returns the key of a history shard on the hash ring, shards are hashed by their ID
+// as a rune so that the hash placement of the shards does not change
+func ringKey(shardID int) string {
+	return string(rune(shardID))
+}
+
+func equalAssignments(a, b map[int]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for shardID, host := range a {
+		if b[shardID] != host {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardplacement
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/membership"
+)
+
+func TestResolver_Lookup(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	hostA := membership.NewHostInfo("host-a", nil)
+	hostB := membership.NewHostInfo("host-b", nil)
+	ring := membership.NewMockServiceResolver(controller)
+	ring.EXPECT().Members().Return([]*membership.HostInfo{hostA, hostB}).AnyTimes()
+	ring.EXPECT().Lookup(string(rune(1))).Return(hostA, nil).AnyTimes()
+	ring.EXPECT().Lookup(string(rune(2))).Return(hostA, nil).AnyTimes()
+	ring.EXPECT().Lookup("key").Return(hostB, nil).AnyTimes()
+	ring.EXPECT().AddListener("listener", gomock.Any()).Return(nil)
+	store := NewMockStore(controller)
+
+	mode := ModeLoad
+	r := newResolver(ring, store, func(...dynamicconfig.FilterOption) string { return mode }, loggerimpl.NewNopLogger())
+	listener := make(chan *membership.ChangedEvent, 1)
+	assert.NoError(t, r.AddListener("listener", listener))
+
+	store.EXPECT().GetPlacement(gomock.Any()).Return(&Placement{Assignments: map[int]*Assignment{
+		1: {ShardID: 1, Host: "host-b"},
+		2: {ShardID: 2, Host: "host-gone"},
+	}}, nil)
+	r.refresh()
+	assert.Len(t, listener, 1)
+	<-listener
+
+	host, err := r.Lookup(ShardKey(1))
+	assert.NoError(t, err)
+	assert.Equal(t, hostB, host)
+	// the assigned host is not a member of the ring
+	host, err = r.Lookup(ShardKey(2))
+	assert.NoError(t, err)
+	assert.Equal(t, hostA, host)
+
+	// keys which are not shard keys are looked up on the ring
+	host, err = r.Lookup("key")
+	assert.NoError(t, err)
+	assert.Equal(t, hostB, host)
+
+	// the assignments are kept if the store is not available
+	store.EXPECT().GetPlacement(gomock.Any()).Return(nil, errors.New("store failure"))
+	r.refresh()
+	host, err = r.Lookup(ShardKey(1))
+	assert.NoError(t, err)
+	assert.Equal(t, hostB, host)
+	assert.Len(t, listener, 0)
+
+	mode = ModeHash
+	r.refresh()
+	assert.Len(t, listener, 1)
+	host, err = r.Lookup(ShardKey(1))
+	assert.NoError(t, err)
+	assert.Equal(t, hostA, host)
+}
+
+func TestRebalance(t *testing.T) {
+	owners := map[int]string{0: "host-a", 1: "host-a", 2: "host-a", 3: "host-b"}
+	loads := map[int]*ShardLoad{
+		0: {RPS: 40},
+		1: {RPS: 30},
+		2: {RPS: 20},
+		3: {RPS: 10},
+	}
+	hosts := []string{"host-a", "host-b", "host-c"}
+
+	moves := Rebalance(owners, loads, nil, hosts, 10)
+	assert.Equal(t, []*Assignment{{ShardID: 0, Host: "host-c"}, {ShardID: 1, Host: "host-b"}, {ShardID: 3, Host: "host-a"}}, moves)
+
+	// pinned shards stay and the number of moves is limited
+	moves = Rebalance(owners, loads, map[int]bool{0: true}, hosts, 1)
+	assert.Equal(t, []*Assignment{{ShardID: 1, Host: "host-c"}}, moves)
+
+	// balanced hosts
+	moves = Rebalance(map[int]string{0: "host-a", 3: "host-b"}, map[int]*ShardLoad{0: {RPS: 10}, 3: {RPS: 10}}, nil, hosts[:2], 10)
+	assert.Empty(t, moves)
+}
@@ -0,0 +1,275 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination store_mock.go
+
+// Package shardplacement implements the load aware placement of history shards.
+//
+// By default history shards are placed on history hosts by the consistent hash ring of
+// the history service. In the load placement mode, the history shard balancer assigns
+// shards to hosts according to the load the hosts report for their shards, and the
+// history service resolver looks up the assignment of a shard before the hash ring.
+// Operators can move shards and pin them to a host through the admin APIs.
+package shardplacement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// ModeHash places the shards by the consistent hash ring
+	ModeHash = "hash"
+	// ModeLoad places the shards by their assignment
+	ModeLoad = "load"
+
+	// emptyRecordID is the record ID to read the store from the beginning
+	emptyRecordID int64 = -1
+
+	readPageSize = 1000
+)
+
+type (
+	// Assignment assigns a shard to a history host
+	Assignment struct {
+		ShardID int `json:"shardID"`
+		// Host is the identity of the history host, the assignment is removed if it is empty
+		Host string `json:"host,omitempty"`
+		// Pinned assignments are not changed by the shard balancer
+		Pinned bool `json:"pinned,omitempty"`
+	}
+
+	// ShardLoad is the load of a shard reported by its owner
+	ShardLoad struct {
+		// RPS is the rate of the requests to the shard
+		RPS float64 `json:"rps"`
+		// QueueBacklog is the number of transfer task IDs allocated since the transfer ack level
+		QueueBacklog int64 `json:"queueBacklog"`
+		// CacheSize is the number of workflow executions in the cache of the shard
+		CacheSize int64 `json:"cacheSize"`
+		// Host and Timestamp are set from the record of the report, they are kept in snapshots
+		Host      string    `json:"host,omitempty"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	// Record is a change of the placement recorded in the store, it either
+	// changes assignments or reports the load of the shards of a host
+	Record struct {
+		// ID is the position of the record in the store, it is assigned by the store
+		ID          int64         `json:"-"`
+		Assignments []*Assignment `json:"assignments,omitempty"`
+		// Snapshot is true if Assignments are all the assignments as of the record with ID Base,
+		// the assignments recorded after Base are applied on top of the snapshot.
+		// Snapshots also keep the latest load of each shard
+		Snapshot bool  `json:"snapshot,omitempty"`
+		Base     int64 `json:"base,omitempty"`
+		// Loads are reported by the history host Reporter
+		Reporter  string             `json:"reporter,omitempty"`
+		Loads     map[int]*ShardLoad `json:"loads,omitempty"`
+		Author    string             `json:"author,omitempty"`
+		Reason    string             `json:"reason,omitempty"`
+		Timestamp time.Time          `json:"timestamp"`
+	}
+
+	// Placement is the current state of the store
+	Placement struct {
+		// Assignments by shard ID
+		Assignments map[int]*Assignment
+		// Loads are the latest reported loads by shard ID
+		Loads map[int]*ShardLoad
+		// LastRecordID is the ID of the last record applied
+		LastRecordID int64
+		// RecordCount is the number of records applied
+		RecordCount int
+	}
+
+	// Store persists the placement of history shards
+	Store interface {
+		// GetPlacement returns the current assignments and loads of the shards
+		GetPlacement(ctx context.Context) (*Placement, error)
+		// Assign records assignment changes
+		Assign(ctx context.Context, assignments []*Assignment, author string, reason string) error
+		// ReportLoads records the load of the shards owned by a history host
+		ReportLoads(ctx context.Context, host string, loads map[int]*ShardLoad) error
+		// Compact records a snapshot of the assignments and loads of the placement and deletes the records before it
+		Compact(ctx context.Context, placement *Placement) error
+	}
+
+	storeImpl struct {
+		queue      persistence.QueueManager
+		timeSource clock.TimeSource
+	}
+)
+
+var _ Store = (*storeImpl)(nil)
+
+// NewStore creates a shard placement store on top of the shard placement queue
+func NewStore(
+	queue persistence.QueueManager,
+	timeSource clock.TimeSource,
+) Store {
+	return &storeImpl{
+		queue:      queue,
+		timeSource: timeSource,
+	}
+}
+
+func (s *storeImpl) GetPlacement(
+	ctx context.Context,
+) (*Placement, error) {
+	placement := &Placement{
+		Assignments:  make(map[int]*Assignment),
+		Loads:        make(map[int]*ShardLoad),
+		LastRecordID: emptyRecordID,
+	}
+	// assignment records since the base of the last snapshot, they are applied again on top of the next snapshot
+	var recent []*Record
+	for {
+		messages, err := s.queue.ReadMessages(ctx, placement.LastRecordID, readPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range messages {
+			record, err := decodeRecord(message)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode shard placement record %v: %v", message.ID, err)
+			}
+			if record.Snapshot {
+				placement.Assignments = make(map[int]*Assignment)
+				placement.apply(record)
+				kept := recent[:0]
+				for _, r := range recent {
+					if r.ID > record.Base {
+						placement.apply(r)
+						kept = append(kept, r)
+					}
+				}
+				recent = kept
+			} else {
+				placement.apply(record)
+				if len(record.Assignments) > 0 {
+					recent = append(recent, record)
+				}
+			}
+			placement.LastRecordID = record.ID
+			placement.RecordCount++
+		}
+		if len(messages) < readPageSize {
+			return placement, nil
+		}
+	}
+}
+
+func (s *storeImpl) Assign(
+	ctx context.Context,
+	assignments []*Assignment,
+	author string,
+	reason string,
+) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+	return s.enqueue(ctx, &Record{
+		Assignments: assignments,
+		Author:      author,
+		Reason:      reason,
+	})
+}
+
+func (s *storeImpl) ReportLoads(
+	ctx context.Context,
+	host string,
+	loads map[int]*ShardLoad,
+) error {
+	return s.enqueue(ctx, &Record{
+		Reporter: host,
+		Loads:    loads,
+	})
+}
+
+func (s *storeImpl) Compact(
+	ctx context.Context,
+	placement *Placement,
+) error {
+	if placement.LastRecordID == emptyRecordID {
+		return nil
+	}
+	assignments := make([]*Assignment, 0, len(placement.Assignments))
+	for _, assignment := range placement.Assignments {
+		assignments = append(assignments, assignment)
+	}
+	if err := s.enqueue(ctx, &Record{
+		Assignments: assignments,
+		Snapshot:    true,
+		Base:        placement.LastRecordID,
+		Loads:       placement.Loads,
+	}); err != nil {
+		return err
+	}
+	// the snapshot is after the last record, so it is kept
+	return s.queue.DeleteMessagesBefore(ctx, placement.LastRecordID+1)
+}
+
+func (s *storeImpl) enqueue(
+	ctx context.Context,
+	record *Record,
+) error {
+	record.Timestamp = s.timeSource.Now()
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode shard placement record: %v", err)
+	}
+	return s.queue.EnqueueMessage(ctx, payload)
+}
+
+func (p *Placement) apply(record *Record) {
+	for _, assignment := range record.Assignments {
+		if assignment.Host == "" {
+			delete(p.Assignments, assignment.ShardID)
+		} else {
+			p.Assignments[assignment.ShardID] = assignment
+		}
+	}
+	for shardID, load := range record.Loads {
+		if !record.Snapshot {
+			load.Host = record.Reporter
+			load.Timestamp = record.Timestamp
+		}
+		// a snapshot may be recorded after newer reports
+		if current, ok := p.Loads[shardID]; ok && current.Timestamp.After(load.Timestamp) {
+			continue
+		}
+		p.Loads[shardID] = load
+	}
+}
+
+func decodeRecord(message *persistence.QueueMessage) (*Record, error) {
+	record := &Record{}
+	if err := json.Unmarshal(message.Payload, record); err != nil {
+		return nil, err
+	}
+	record.ID = message.ID
+	return record, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardplacement
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	storeSuite struct {
+		suite.Suite
+
+		queue *testQueue
+		store Store
+	}
+
+	// testQueue keeps messages in memory, only the methods used by the store are implemented
+	testQueue struct {
+		persistence.QueueManager
+
+		sync.Mutex
+		nextID   int64
+		messages []*persistence.QueueMessage
+	}
+)
+
+func TestStoreSuite(t *testing.T) {
+	s := new(storeSuite)
+	suite.Run(t, s)
+}
+
+func (s *storeSuite) SetupTest() {
+	s.queue = &testQueue{}
+	s.store = NewStore(s.queue, clock.NewEventTimeSource().Update(time.Unix(1600000000, 0)))
+}
+
+func (s *storeSuite) TestGetPlacement() {
+	ctx := context.Background()
+	s.NoError(s.store.Assign(ctx, []*Assignment{{ShardID: 1, Host: "host-a"}, {ShardID: 2, Host: "host-b", Pinned: true}}, "tester", "test"))
+	s.NoError(s.store.ReportLoads(ctx, "host-a", map[int]*ShardLoad{1: {RPS: 10, QueueBacklog: 5, CacheSize: 100}}))
+	s.NoError(s.store.Assign(ctx, []*Assignment{{ShardID: 1}}, "tester", "test"))
+
+	placement, err := s.store.GetPlacement(ctx)
+	s.NoError(err)
+	s.Equal(int64(2), placement.LastRecordID)
+	s.Equal(3, placement.RecordCount)
+	s.Equal(map[int]*Assignment{2: {ShardID: 2, Host: "host-b", Pinned: true}}, placement.Assignments)
+	s.Equal(10.0, placement.Loads[1].RPS)
+	s.Equal("host-a", placement.Loads[1].Host)
+	s.Equal(time.Unix(1600000000, 0).Unix(), placement.Loads[1].Timestamp.Unix())
+}
+
+func (s *storeSuite) TestCompact() {
+	ctx := context.Background()
+	s.NoError(s.store.Assign(ctx, []*Assignment{{ShardID: 1, Host: "host-a"}}, "tester", "test"))
+	s.NoError(s.store.Assign(ctx, []*Assignment{{ShardID: 2, Host: "host-b"}}, "tester", "test"))
+	placement, err := s.store.GetPlacement(ctx)
+	s.NoError(err)
+
+	// an assignment recorded after the placement was read is kept on top of the snapshot
+	s.NoError(s.store.Assign(ctx, []*Assignment{{ShardID: 1, Host: "host-c"}}, "tester", "test"))
+	s.NoError(s.store.Compact(ctx, placement))
+	s.Len(s.queue.messages, 2)
+
+	compacted, err := s.store.GetPlacement(ctx)
+	s.NoError(err)
+	s.Equal("host-c", compacted.Assignments[1].Host)
+	s.Equal("host-b", compacted.Assignments[2].Host)
+
+	s.NoError(s.store.Compact(ctx, compacted))
+	s.Len(s.queue.messages, 1)
+	compacted, err = s.store.GetPlacement(ctx)
+	s.NoError(err)
+	s.Len(compacted.Assignments, 2)
+	s.Equal("host-c", compacted.Assignments[1].Host)
+}
+
+func (s *storeSuite) TestCompactKeepsLoads() {
+	ctx := context.Background()
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1600000000, 0))
+	s.store = NewStore(s.queue, timeSource)
+	s.NoError(s.store.ReportLoads(ctx, "host-a", map[int]*ShardLoad{1: {RPS: 10}, 2: {RPS: 20}}))
+	placement, err := s.store.GetPlacement(ctx)
+	s.NoError(err)
+
+	// a report recorded after the placement was read is newer than the loads in the snapshot
+	timeSource.Update(time.Unix(1600000060, 0))
+	s.NoError(s.store.ReportLoads(ctx, "host-b", map[int]*ShardLoad{2: {RPS: 30}}))
+	s.NoError(s.store.Compact(ctx, placement))
+	s.Len(s.queue.messages, 2)
+
+	compacted, err := s.store.GetPlacement(ctx)
+	s.NoError(err)
+	s.Equal(10.0, compacted.Loads[1].RPS)
+	s.Equal("host-a", compacted.Loads[1].Host)
+	s.Equal(int64(1600000000), compacted.Loads[1].Timestamp.Unix())
+	s.Equal(30.0, compacted.Loads[2].RPS)
+	s.Equal("host-b", compacted.Loads[2].Host)
+}
+
+func (q *testQueue) EnqueueMessage(
+	_ context.Context,
+	messagePayload []byte,
+) error {
+	q.Lock()
+	defer q.Unlock()
+
+	q.messages = append(q.messages, &persistence.QueueMessage{
+		ID:        q.nextID,
+		QueueType: persistence.ShardPlacementQueueType,
+		Payload:   messagePayload,
+	})
+	q.nextID++
+	return nil
+}
+
+func (q *testQueue) ReadMessages(
+	_ context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var result []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
+
+func (q *testQueue) DeleteMessagesBefore(
+	_ context.Context,
+	messageID int64,
+) error {
+	q.Lock()
+	defer q.Unlock()
+
+	var kept []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID >= messageID {
+			kept = append(kept, message)
+		}
+	}
+	q.messages = kept
+	return nil
+}
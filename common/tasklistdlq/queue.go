@@ -0,0 +1,195 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination queue_mock.go
+
+// Package tasklistdlq implements the dead letter queue of matching tasks.
+//
+// Matching moves a task to the queue when it was dispatched to workers more times than
+// the limit of its task list without being completed, e.g. because the worker crashes
+// while processing it. Operators can list the tasks in the queue, redrive them to their
+// task list or drop them through the admin APIs.
+package tasklistdlq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	queueSizeQueryInterval = 5 * time.Minute
+	// firstMessageID is the message ID before the first message, reads start after it
+	firstMessageID = -1
+)
+
+type (
+	// Message is a task moved to the dead letter queue
+	Message struct {
+		// ID is assigned by the queue
+		ID           int64                 `json:"-"`
+		DomainID     string                `json:"domainID"`
+		TaskList     string                `json:"taskList"`
+		TaskType     int                   `json:"taskType"`
+		Task         *persistence.TaskInfo `json:"task"`
+		Attempts     int                   `json:"attempts"`
+		EnqueuedTime time.Time             `json:"enqueuedTime"`
+	}
+
+	// Queue stores the tasks moved to the dead letter queue
+	Queue interface {
+		common.Daemon
+		Enqueue(ctx context.Context, message *Message) error
+		Read(ctx context.Context, pageSize int, pageToken []byte) ([]*Message, []byte, error)
+		Get(ctx context.Context, messageID int64) (*Message, error)
+		Delete(ctx context.Context, messageID int64) error
+	}
+
+	queueImpl struct {
+		queue         persistence.QueueManager
+		metricsClient metrics.Client
+		logger        log.Logger
+		done          chan struct{}
+		status        int32
+	}
+)
+
+var _ Queue = (*queueImpl)(nil)
+
+// NewQueue creates a new Queue instance, Start only needs to be called to emit the size of the queue
+func NewQueue(
+	queue persistence.QueueManager,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) Queue {
+	return &queueImpl{
+		queue:         queue,
+		metricsClient: metricsClient,
+		logger:        logger,
+		done:          make(chan struct{}),
+		status:        common.DaemonStatusInitialized,
+	}
+}
+
+func (q *queueImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&q.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go q.emitSize()
+}
+
+func (q *queueImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&q.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(q.done)
+}
+
+func (q *queueImpl) Enqueue(
+	ctx context.Context,
+	message *Message,
+) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode task list DLQ message: %v", err)
+	}
+	return q.queue.EnqueueMessageToDLQ(ctx, payload)
+}
+
+func (q *queueImpl) Read(
+	ctx context.Context,
+	pageSize int,
+	pageToken []byte,
+) ([]*Message, []byte, error) {
+	messages, nextPageToken, err := q.queue.ReadMessagesFromDLQ(ctx, firstMessageID, common.EndMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := make([]*Message, 0, len(messages))
+	for _, message := range messages {
+		decoded, err := decodeMessage(message)
+		if err != nil {
+			q.logger.Warn("Failed to decode task list DLQ message.", tag.TaskID(message.ID), tag.Error(err))
+			continue
+		}
+		result = append(result, decoded)
+	}
+	return result, nextPageToken, nil
+}
+
+func (q *queueImpl) Get(
+	ctx context.Context,
+	messageID int64,
+) (*Message, error) {
+	messages, _, err := q.queue.ReadMessagesFromDLQ(ctx, messageID-1, messageID, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("Task list DLQ message %v does not exist.", messageID)}
+	}
+	return decodeMessage(messages[0])
+}
+
+func (q *queueImpl) Delete(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return q.queue.DeleteMessageFromDLQ(ctx, messageID)
+}
+
+func (q *queueImpl) emitSize() {
+	ticker := time.NewTicker(queueSizeQueryInterval)
+	defer ticker.Stop()
+
+	scope := q.metricsClient.Scope(metrics.TaskListDLQScope)
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-ticker.C:
+			size, err := q.queue.GetDLQSize(context.Background())
+			if err != nil {
+				q.logger.Warn("Failed to get task list DLQ size.", tag.Error(err))
+				scope.IncCounter(metrics.TaskListDLQSizeErrorCount)
+			} else {
+				scope.UpdateGauge(metrics.TaskListDLQSizeGauge, float64(size))
+			}
+		}
+	}
+}
+
+func decodeMessage(message *persistence.QueueMessage) (*Message, error) {
+	result := &Message{}
+	if err := json.Unmarshal(message.Payload, result); err != nil {
+		return nil, err
+	}
+	result.ID = message.ID
+	return result, nil
+}
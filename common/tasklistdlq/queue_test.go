@@ -0,0 +1,135 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklistdlq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// testQueueManager keeps the DLQ messages in memory
+type testQueueManager struct {
+	persistence.QueueManager
+
+	messages []*persistence.QueueMessage
+	lastID   int64
+}
+
+func TestQueue(t *testing.T) {
+	queue := NewQueue(&testQueueManager{lastID: -1}, metrics.NewNoopMetricsClient(), loggerimpl.NewNopLogger())
+	ctx := context.Background()
+
+	for i := int64(1); i <= 3; i++ {
+		err := queue.Enqueue(ctx, &Message{
+			DomainID: "domainID",
+			TaskList: "taskList",
+			TaskType: persistence.TaskListTypeActivity,
+			Task: &persistence.TaskInfo{
+				DomainID:   "domainID",
+				WorkflowID: "workflowID",
+				RunID:      "runID",
+				ScheduleID: i,
+			},
+			Attempts:     5,
+			EnqueuedTime: time.Unix(100, 0),
+		})
+		require.NoError(t, err)
+	}
+
+	messages, token, err := queue.Read(ctx, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.NotNil(t, token)
+	assert.Equal(t, int64(0), messages[0].ID)
+	assert.Equal(t, "taskList", messages[0].TaskList)
+	assert.Equal(t, int64(1), messages[0].Task.ScheduleID)
+	assert.Equal(t, 5, messages[0].Attempts)
+	assert.True(t, messages[0].EnqueuedTime.Equal(time.Unix(100, 0)))
+	messages, token, err = queue.Read(ctx, 2, token)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Nil(t, token)
+
+	message, err := queue.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), message.Task.ScheduleID)
+
+	require.NoError(t, queue.Delete(ctx, 1))
+	_, err = queue.Get(ctx, 1)
+	assert.IsType(t, &types.EntityNotExistsError{}, err)
+	messages, _, err = queue.Read(ctx, 10, nil)
+	require.NoError(t, err)
+	assert.Len(t, messages, 2)
+}
+
+func (q *testQueueManager) EnqueueMessageToDLQ(
+	_ context.Context,
+	messagePayload []byte,
+) error {
+	q.lastID++
+	q.messages = append(q.messages, &persistence.QueueMessage{ID: q.lastID, Payload: messagePayload})
+	return nil
+}
+
+func (q *testQueueManager) ReadMessagesFromDLQ(
+	_ context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistence.QueueMessage, []byte, error) {
+	if len(pageToken) > 0 {
+		firstMessageID = int64(pageToken[0])
+	}
+	var result []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID > firstMessageID && message.ID <= lastMessageID && len(result) < pageSize {
+			result = append(result, message)
+		}
+	}
+	var nextPageToken []byte
+	if len(result) >= pageSize {
+		nextPageToken = []byte{byte(result[len(result)-1].ID)}
+	}
+	return result, nextPageToken, nil
+}
+
+func (q *testQueueManager) DeleteMessageFromDLQ(
+	_ context.Context,
+	messageID int64,
+) error {
+	for i, message := range q.messages {
+		if message.ID == messageID {
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
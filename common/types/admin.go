@@ -20,6 +20,12 @@
 
 package types
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // AddSearchAttributeRequest is an internal type (TBD...)
 type AddSearchAttributeRequest struct {
 	SearchAttribute map[string]IndexedValueType `json:"searchAttribute,omitempty"`
@@ -404,3 +410,1100 @@ func (v *RingInfo) GetMembers() (o []*HostInfo) {
 	}
 	return
 }
+
+// DynamicConfigFilter is an internal type (TBD...)
+type DynamicConfigFilter struct {
+	Name  string    `json:"name,omitempty"`
+	Value *DataBlob `json:"value,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *DynamicConfigFilter) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetValue is an internal getter (TBD...)
+func (v *DynamicConfigFilter) GetValue() (o *DataBlob) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+	return
+}
+
+// DynamicConfigChange is an internal type (TBD...)
+type DynamicConfigChange struct {
+	ID             int64                  `json:"id,omitempty"`
+	ConfigName     string                 `json:"configName,omitempty"`
+	Filters        []*DynamicConfigFilter `json:"filters,omitempty"`
+	Value          *DataBlob              `json:"value,omitempty"`
+	Deleted        bool                   `json:"deleted,omitempty"`
+	Author         string                 `json:"author,omitempty"`
+	Reason         string                 `json:"reason,omitempty"`
+	TimestampNano  int64                  `json:"timestampNano,omitempty"`
+	ActiveFromNano int64                  `json:"activeFromNano,omitempty"`
+	ExpiresAtNano  int64                  `json:"expiresAtNano,omitempty"`
+}
+
+// GetID is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetID() (o int64) {
+	if v != nil {
+		return v.ID
+	}
+	return
+}
+
+// GetConfigName is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetConfigName() (o string) {
+	if v != nil {
+		return v.ConfigName
+	}
+	return
+}
+
+// GetFilters is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetFilters() (o []*DynamicConfigFilter) {
+	if v != nil && v.Filters != nil {
+		return v.Filters
+	}
+	return
+}
+
+// GetValue is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetValue() (o *DataBlob) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+	return
+}
+
+// GetDeleted is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetDeleted() (o bool) {
+	if v != nil {
+		return v.Deleted
+	}
+	return
+}
+
+// GetAuthor is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetAuthor() (o string) {
+	if v != nil {
+		return v.Author
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetTimestampNano is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetTimestampNano() (o int64) {
+	if v != nil {
+		return v.TimestampNano
+	}
+	return
+}
+
+// GetActiveFromNano is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetActiveFromNano() (o int64) {
+	if v != nil {
+		return v.ActiveFromNano
+	}
+	return
+}
+
+// GetExpiresAtNano is an internal getter (TBD...)
+func (v *DynamicConfigChange) GetExpiresAtNano() (o int64) {
+	if v != nil {
+		return v.ExpiresAtNano
+	}
+	return
+}
+
+// DynamicConfigValue is an internal type (TBD...)
+type DynamicConfigValue struct {
+	Filters    []*DynamicConfigFilter `json:"filters,omitempty"`
+	Value      *DataBlob              `json:"value,omitempty"`
+	LastChange *DynamicConfigChange   `json:"lastChange,omitempty"`
+}
+
+// GetFilters is an internal getter (TBD...)
+func (v *DynamicConfigValue) GetFilters() (o []*DynamicConfigFilter) {
+	if v != nil && v.Filters != nil {
+		return v.Filters
+	}
+	return
+}
+
+// GetValue is an internal getter (TBD...)
+func (v *DynamicConfigValue) GetValue() (o *DataBlob) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+	return
+}
+
+// GetLastChange is an internal getter (TBD...)
+func (v *DynamicConfigValue) GetLastChange() (o *DynamicConfigChange) {
+	if v != nil && v.LastChange != nil {
+		return v.LastChange
+	}
+	return
+}
+
+// DynamicConfigEntry is an internal type (TBD...)
+type DynamicConfigEntry struct {
+	ConfigName string                `json:"configName,omitempty"`
+	Values     []*DynamicConfigValue `json:"values,omitempty"`
+}
+
+// GetConfigName is an internal getter (TBD...)
+func (v *DynamicConfigEntry) GetConfigName() (o string) {
+	if v != nil {
+		return v.ConfigName
+	}
+	return
+}
+
+// GetValues is an internal getter (TBD...)
+func (v *DynamicConfigEntry) GetValues() (o []*DynamicConfigValue) {
+	if v != nil && v.Values != nil {
+		return v.Values
+	}
+	return
+}
+
+// GetDynamicConfigRequest is an internal type (TBD...)
+type GetDynamicConfigRequest struct {
+	ConfigName string                 `json:"configName,omitempty"`
+	Filters    []*DynamicConfigFilter `json:"filters,omitempty"`
+}
+
+// GetConfigName is an internal getter (TBD...)
+func (v *GetDynamicConfigRequest) GetConfigName() (o string) {
+	if v != nil {
+		return v.ConfigName
+	}
+	return
+}
+
+// GetFilters is an internal getter (TBD...)
+func (v *GetDynamicConfigRequest) GetFilters() (o []*DynamicConfigFilter) {
+	if v != nil && v.Filters != nil {
+		return v.Filters
+	}
+	return
+}
+
+// GetDynamicConfigResponse is an internal type (TBD...)
+type GetDynamicConfigResponse struct {
+	Value *DataBlob `json:"value,omitempty"`
+}
+
+// GetValue is an internal getter (TBD...)
+func (v *GetDynamicConfigResponse) GetValue() (o *DataBlob) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+	return
+}
+
+// UpdateDynamicConfigRequest is an internal type (TBD...)
+type UpdateDynamicConfigRequest struct {
+	ConfigName     string                 `json:"configName,omitempty"`
+	Filters        []*DynamicConfigFilter `json:"filters,omitempty"`
+	Value          *DataBlob              `json:"value,omitempty"`
+	Reason         string                 `json:"reason,omitempty"`
+	ActiveFromNano int64                  `json:"activeFromNano,omitempty"`
+	ExpiresAtNano  int64                  `json:"expiresAtNano,omitempty"`
+}
+
+// GetConfigName is an internal getter (TBD...)
+func (v *UpdateDynamicConfigRequest) GetConfigName() (o string) {
+	if v != nil {
+		return v.ConfigName
+	}
+	return
+}
+
+// GetFilters is an internal getter (TBD...)
+func (v *UpdateDynamicConfigRequest) GetFilters() (o []*DynamicConfigFilter) {
+	if v != nil && v.Filters != nil {
+		return v.Filters
+	}
+	return
+}
+
+// GetValue is an internal getter (TBD...)
+func (v *UpdateDynamicConfigRequest) GetValue() (o *DataBlob) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UpdateDynamicConfigRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// GetActiveFromNano is an internal getter (TBD...)
+func (v *UpdateDynamicConfigRequest) GetActiveFromNano() (o int64) {
+	if v != nil {
+		return v.ActiveFromNano
+	}
+	return
+}
+
+// GetExpiresAtNano is an internal getter (TBD...)
+func (v *UpdateDynamicConfigRequest) GetExpiresAtNano() (o int64) {
+	if v != nil {
+		return v.ExpiresAtNano
+	}
+	return
+}
+
+// RestoreDynamicConfigRequest is an internal type (TBD...)
+type RestoreDynamicConfigRequest struct {
+	ConfigName string                 `json:"configName,omitempty"`
+	Filters    []*DynamicConfigFilter `json:"filters,omitempty"`
+	Reason     string                 `json:"reason,omitempty"`
+}
+
+// GetConfigName is an internal getter (TBD...)
+func (v *RestoreDynamicConfigRequest) GetConfigName() (o string) {
+	if v != nil {
+		return v.ConfigName
+	}
+	return
+}
+
+// GetFilters is an internal getter (TBD...)
+func (v *RestoreDynamicConfigRequest) GetFilters() (o []*DynamicConfigFilter) {
+	if v != nil && v.Filters != nil {
+		return v.Filters
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *RestoreDynamicConfigRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// ListDynamicConfigRequest is an internal type (TBD...)
+type ListDynamicConfigRequest struct {
+	ConfigName     string `json:"configName,omitempty"`
+	IncludeHistory bool   `json:"includeHistory,omitempty"`
+}
+
+// GetConfigName is an internal getter (TBD...)
+func (v *ListDynamicConfigRequest) GetConfigName() (o string) {
+	if v != nil {
+		return v.ConfigName
+	}
+	return
+}
+
+// GetIncludeHistory is an internal getter (TBD...)
+func (v *ListDynamicConfigRequest) GetIncludeHistory() (o bool) {
+	if v != nil {
+		return v.IncludeHistory
+	}
+	return
+}
+
+// ListDynamicConfigResponse is an internal type (TBD...)
+type ListDynamicConfigResponse struct {
+	Entries []*DynamicConfigEntry  `json:"entries,omitempty"`
+	History []*DynamicConfigChange `json:"history,omitempty"`
+}
+
+// GetEntries is an internal getter (TBD...)
+func (v *ListDynamicConfigResponse) GetEntries() (o []*DynamicConfigEntry) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+	return
+}
+
+// GetHistory is an internal getter (TBD...)
+func (v *ListDynamicConfigResponse) GetHistory() (o []*DynamicConfigChange) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+	return
+}
+
+// PauseTaskListRequest is an internal type (TBD...)
+type PauseTaskListRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	Reason       string        `json:"reason,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PauseTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *PauseTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *PauseTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *PauseTaskListRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// ResumeTaskListRequest is an internal type (TBD...)
+type ResumeTaskListRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	Reason       string        `json:"reason,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResumeTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ResumeTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *ResumeTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *ResumeTaskListRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// MigrateTaskListRequest is an internal type (TBD...)
+type MigrateTaskListRequest struct {
+	Domain              string        `json:"domain,omitempty"`
+	TaskList            *TaskList     `json:"taskList,omitempty"`
+	TaskListType        *TaskListType `json:"taskListType,omitempty"`
+	DestinationTaskList string        `json:"destinationTaskList,omitempty"`
+	Reason              string        `json:"reason,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetDestinationTaskList is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetDestinationTaskList() (o string) {
+	if v != nil {
+		return v.DestinationTaskList
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// TaskListDLQEntry is an internal type (TBD...)
+type TaskListDLQEntry struct {
+	MessageID         int64              `json:"messageID,omitempty"`
+	Domain            string             `json:"domain,omitempty"`
+	TaskList          string             `json:"taskList,omitempty"`
+	TaskListType      *TaskListType      `json:"taskListType,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ScheduleID        int64              `json:"scheduleID,omitempty"`
+	Attempts          int32              `json:"attempts,omitempty"`
+	EnqueuedTimestamp *int64             `json:"enqueuedTimestamp,omitempty"`
+}
+
+// GetMessageID is an internal getter (TBD...)
+func (v *TaskListDLQEntry) GetMessageID() (o int64) {
+	if v != nil {
+		return v.MessageID
+	}
+	return
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *TaskListDLQEntry) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *TaskListDLQEntry) GetTaskList() (o string) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *TaskListDLQEntry) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *TaskListDLQEntry) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetScheduleID is an internal getter (TBD...)
+func (v *TaskListDLQEntry) GetScheduleID() (o int64) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+// GetAttempts is an internal getter (TBD...)
+func (v *TaskListDLQEntry) GetAttempts() (o int32) {
+	if v != nil {
+		return v.Attempts
+	}
+	return
+}
+
+// GetEnqueuedTimestamp is an internal getter (TBD...)
+func (v *TaskListDLQEntry) GetEnqueuedTimestamp() (o int64) {
+	if v != nil && v.EnqueuedTimestamp != nil {
+		return *v.EnqueuedTimestamp
+	}
+	return
+}
+
+// ReadTaskListDLQRequest is an internal type (TBD...)
+type ReadTaskListDLQRequest struct {
+	Domain          string        `json:"domain,omitempty"`
+	TaskList        string        `json:"taskList,omitempty"`
+	TaskListType    *TaskListType `json:"taskListType,omitempty"`
+	MaximumPageSize int32         `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte        `json:"nextPageToken,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReadTaskListDLQRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ReadTaskListDLQRequest) GetTaskList() (o string) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *ReadTaskListDLQRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetMaximumPageSize is an internal getter (TBD...)
+func (v *ReadTaskListDLQRequest) GetMaximumPageSize() (o int32) {
+	if v != nil {
+		return v.MaximumPageSize
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ReadTaskListDLQRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ReadTaskListDLQResponse is an internal type (TBD...)
+type ReadTaskListDLQResponse struct {
+	Entries       []*TaskListDLQEntry `json:"entries,omitempty"`
+	NextPageToken []byte              `json:"nextPageToken,omitempty"`
+}
+
+// GetEntries is an internal getter (TBD...)
+func (v *ReadTaskListDLQResponse) GetEntries() (o []*TaskListDLQEntry) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ReadTaskListDLQResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// DescribeTaskListDLQEntryRequest is an internal type (TBD...)
+type DescribeTaskListDLQEntryRequest struct {
+	Domain    string `json:"domain,omitempty"`
+	MessageID int64  `json:"messageID,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeTaskListDLQEntryRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetMessageID is an internal getter (TBD...)
+func (v *DescribeTaskListDLQEntryRequest) GetMessageID() (o int64) {
+	if v != nil {
+		return v.MessageID
+	}
+	return
+}
+
+// DescribeTaskListDLQEntryResponse is an internal type (TBD...)
+type DescribeTaskListDLQEntryResponse struct {
+	Entry *TaskListDLQEntry `json:"entry,omitempty"`
+}
+
+// GetEntry is an internal getter (TBD...)
+func (v *DescribeTaskListDLQEntryResponse) GetEntry() (o *TaskListDLQEntry) {
+	if v != nil && v.Entry != nil {
+		return v.Entry
+	}
+	return
+}
+
+// RedriveTaskListDLQEntryRequest is an internal type (TBD...)
+type RedriveTaskListDLQEntryRequest struct {
+	Domain    string `json:"domain,omitempty"`
+	MessageID int64  `json:"messageID,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RedriveTaskListDLQEntryRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetMessageID is an internal getter (TBD...)
+func (v *RedriveTaskListDLQEntryRequest) GetMessageID() (o int64) {
+	if v != nil {
+		return v.MessageID
+	}
+	return
+}
+
+// FailTaskListDLQEntryRequest is an internal type (TBD...)
+type FailTaskListDLQEntryRequest struct {
+	Domain    string `json:"domain,omitempty"`
+	MessageID int64  `json:"messageID,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *FailTaskListDLQEntryRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetMessageID is an internal getter (TBD...)
+func (v *FailTaskListDLQEntryRequest) GetMessageID() (o int64) {
+	if v != nil {
+		return v.MessageID
+	}
+	return
+}
+
+// MoveShardRequest is an internal type (TBD...)
+type MoveShardRequest struct {
+	ShardID int32  `json:"shardID,omitempty"`
+	Host    string `json:"host,omitempty"`
+	Pin     bool   `json:"pin,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *MoveShardRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetHost is an internal getter (TBD...)
+func (v *MoveShardRequest) GetHost() (o string) {
+	if v != nil {
+		return v.Host
+	}
+	return
+}
+
+// GetPin is an internal getter (TBD...)
+func (v *MoveShardRequest) GetPin() (o bool) {
+	if v != nil {
+		return v.Pin
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *MoveShardRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// UnpinShardRequest is an internal type (TBD...)
+type UnpinShardRequest struct {
+	ShardID int32  `json:"shardID,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *UnpinShardRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *UnpinShardRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// ListQuarantinedWorkflowsRequest is an internal type (TBD...)
+type ListQuarantinedWorkflowsRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListQuarantinedWorkflowsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// ListQuarantinedWorkflowsResponse is an internal type (TBD...)
+type ListQuarantinedWorkflowsResponse struct {
+	Workflows []*QuarantinedWorkflow `json:"workflows,omitempty"`
+}
+
+// GetWorkflows is an internal getter (TBD...)
+func (v *ListQuarantinedWorkflowsResponse) GetWorkflows() (o []*QuarantinedWorkflow) {
+	if v != nil {
+		return v.Workflows
+	}
+	return
+}
+
+// QuarantinedWorkflow is an internal type (TBD...)
+type QuarantinedWorkflow struct {
+	Domain               string `json:"domain,omitempty"`
+	WorkflowID           string `json:"workflowID,omitempty"`
+	RunID                string `json:"runID,omitempty"`
+	ShardID              int32  `json:"shardID,omitempty"`
+	Details              string `json:"details,omitempty"`
+	QuarantinedTimestamp *int64 `json:"quarantinedTimestamp,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *QuarantinedWorkflow) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *QuarantinedWorkflow) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *QuarantinedWorkflow) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *QuarantinedWorkflow) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetDetails is an internal getter (TBD...)
+func (v *QuarantinedWorkflow) GetDetails() (o string) {
+	if v != nil {
+		return v.Details
+	}
+	return
+}
+
+// GetQuarantinedTimestamp is an internal getter (TBD...)
+func (v *QuarantinedWorkflow) GetQuarantinedTimestamp() (o int64) {
+	if v != nil && v.QuarantinedTimestamp != nil {
+		return *v.QuarantinedTimestamp
+	}
+	return
+}
+
+// ReleaseQuarantinedWorkflowRequest is an internal type (TBD...)
+type ReleaseQuarantinedWorkflowRequest struct {
+	Domain     string `json:"domain,omitempty"`
+	WorkflowID string `json:"workflowID,omitempty"`
+	RunID      string `json:"runID,omitempty"`
+	Repair     bool   `json:"repair,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReleaseQuarantinedWorkflowRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *ReleaseQuarantinedWorkflowRequest) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *ReleaseQuarantinedWorkflowRequest) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetRepair is an internal getter (TBD...)
+func (v *ReleaseQuarantinedWorkflowRequest) GetRepair() (o bool) {
+	if v != nil {
+		return v.Repair
+	}
+	return
+}
+
+// GetReason is an internal getter (TBD...)
+func (v *ReleaseQuarantinedWorkflowRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// WorkflowBuildCheckState is an internal type (TBD...)
+type WorkflowBuildCheckState int32
+
+// Ptr is a helper function for getting pointer value
+func (e WorkflowBuildCheckState) Ptr() *WorkflowBuildCheckState {
+	return &e
+}
+
+// String returns a readable string representation of WorkflowBuildCheckState.
+func (e WorkflowBuildCheckState) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "RUNNING"
+	case 1:
+		return "PASSED"
+	case 2:
+		return "FAILED"
+	}
+	return fmt.Sprintf("WorkflowBuildCheckState(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *WorkflowBuildCheckState) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "RUNNING":
+		*e = WorkflowBuildCheckStateRunning
+		return nil
+	case "PASSED":
+		*e = WorkflowBuildCheckStatePassed
+		return nil
+	case "FAILED":
+		*e = WorkflowBuildCheckStateFailed
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowBuildCheckState", err)
+		}
+		*e = WorkflowBuildCheckState(val)
+		return nil
+	}
+}
+
+// MarshalText encodes WorkflowBuildCheckState to text.
+func (e WorkflowBuildCheckState) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// WorkflowBuildCheckStateRunning is an option for WorkflowBuildCheckState
+	WorkflowBuildCheckStateRunning WorkflowBuildCheckState = iota
+	// WorkflowBuildCheckStatePassed is an option for WorkflowBuildCheckState
+	WorkflowBuildCheckStatePassed
+	// WorkflowBuildCheckStateFailed is an option for WorkflowBuildCheckState
+	WorkflowBuildCheckStateFailed
+)
+
+// CheckWorkflowBuildRequest is an internal type (TBD...)
+type CheckWorkflowBuildRequest struct {
+	Domain                              string `json:"domain,omitempty"`
+	WorkflowType                        string `json:"workflowType,omitempty"`
+	BuildID                             string `json:"buildID,omitempty"`
+	SignatureHash                       string `json:"signatureHash,omitempty"`
+	TaskList                            string `json:"taskList,omitempty"`
+	EarliestTime                        *int64 `json:"earliestTime,omitempty"`
+	ShadowCount                         int32  `json:"shadowCount,omitempty"`
+	Concurrency                         int32  `json:"concurrency,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetWorkflowType() (o string) {
+	if v != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
+// GetBuildID is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
+// GetSignatureHash is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetSignatureHash() (o string) {
+	if v != nil {
+		return v.SignatureHash
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetTaskList() (o string) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetEarliestTime is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetEarliestTime() (o int64) {
+	if v != nil && v.EarliestTime != nil {
+		return *v.EarliestTime
+	}
+	return
+}
+
+// GetShadowCount is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetShadowCount() (o int32) {
+	if v != nil {
+		return v.ShadowCount
+	}
+	return
+}
+
+// GetConcurrency is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetConcurrency() (o int32) {
+	if v != nil {
+		return v.Concurrency
+	}
+	return
+}
+
+// GetExecutionStartToCloseTimeoutSeconds is an internal getter (TBD...)
+func (v *CheckWorkflowBuildRequest) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// CheckWorkflowBuildResponse is an internal type (TBD...)
+type CheckWorkflowBuildResponse struct {
+	State          *WorkflowBuildCheckState `json:"state,omitempty"`
+	WorkflowID     string                   `json:"workflowID,omitempty"`
+	RunID          string                   `json:"runID,omitempty"`
+	CheckedBuildID string                   `json:"checkedBuildID,omitempty"`
+	Succeeded      int32                    `json:"succeeded,omitempty"`
+	Skipped        int32                    `json:"skipped,omitempty"`
+	Failed         int32                    `json:"failed,omitempty"`
+}
+
+// GetState is an internal getter (TBD...)
+func (v *CheckWorkflowBuildResponse) GetState() (o WorkflowBuildCheckState) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *CheckWorkflowBuildResponse) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *CheckWorkflowBuildResponse) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetCheckedBuildID is an internal getter (TBD...)
+func (v *CheckWorkflowBuildResponse) GetCheckedBuildID() (o string) {
+	if v != nil {
+		return v.CheckedBuildID
+	}
+	return
+}
+
+// GetSucceeded is an internal getter (TBD...)
+func (v *CheckWorkflowBuildResponse) GetSucceeded() (o int32) {
+	if v != nil {
+		return v.Succeeded
+	}
+	return
+}
+
+// GetSkipped is an internal getter (TBD...)
+func (v *CheckWorkflowBuildResponse) GetSkipped() (o int32) {
+	if v != nil {
+		return v.Skipped
+	}
+	return
+}
+
+// GetFailed is an internal getter (TBD...)
+func (v *CheckWorkflowBuildResponse) GetFailed() (o int32) {
+	if v != nil {
+		return v.Failed
+	}
+	return
+}
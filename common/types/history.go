@@ -86,6 +86,28 @@ func (v *HistoryDescribeWorkflowExecutionRequest) GetRequest() (o *DescribeWorkf
 	return
 }
 
+// HistoryExpediteWorkflowExecutionStartRequest is an internal type (TBD...)
+type HistoryExpediteWorkflowExecutionStartRequest struct {
+	DomainUUID      string                                 `json:"domainUUID,omitempty"`
+	ExpediteRequest *ExpediteWorkflowExecutionStartRequest `json:"expediteRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryExpediteWorkflowExecutionStartRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetExpediteRequest is an internal getter (TBD...)
+func (v *HistoryExpediteWorkflowExecutionStartRequest) GetExpediteRequest() (o *ExpediteWorkflowExecutionStartRequest) {
+	if v != nil && v.ExpediteRequest != nil {
+		return v.ExpediteRequest
+	}
+	return
+}
+
 // DomainFilter is an internal type (TBD...)
 type DomainFilter struct {
 	DomainIDs    []string `json:"domainIDs,omitempty"`
@@ -203,6 +225,7 @@ type GetMutableStateResponse struct {
 	WorkflowCloseState                   *int32             `json:"workflowCloseState,omitempty"`
 	VersionHistories                     *VersionHistories  `json:"versionHistories,omitempty"`
 	IsStickyTaskListEnabled              bool               `json:"isStickyTaskListEnabled,omitempty"`
+	BuildID                              string             `json:"buildId,omitempty"`
 }
 
 // GetExecution is an internal getter (TBD...)
@@ -349,6 +372,14 @@ func (v *GetMutableStateResponse) GetIsStickyTaskListEnabled() (o bool) {
 	return
 }
 
+// GetBuildID is an internal getter (TBD...)
+func (v *GetMutableStateResponse) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
 // NotifyFailoverMarkersRequest is an internal type (TBD...)
 type NotifyFailoverMarkersRequest struct {
 	FailoverMarkerTokens []*FailoverMarkerToken `json:"failoverMarkerTokens,omitempty"`
@@ -1533,6 +1564,7 @@ type HistoryStartWorkflowExecutionRequest struct {
 	ContinuedFailureDetails         []byte                         `json:"continuedFailureDetails,omitempty"`
 	LastCompletionResult            []byte                         `json:"lastCompletionResult,omitempty"`
 	FirstDecisionTaskBackoffSeconds *int32                         `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	RetryElapsedExpirationTimestamp *int64                         `json:"retryElapsedExpirationTimestamp,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -1615,6 +1647,14 @@ func (v *HistoryStartWorkflowExecutionRequest) GetFirstDecisionTaskBackoffSecond
 	return
 }
 
+// GetRetryElapsedExpirationTimestamp is an internal getter (TBD...)
+func (v *HistoryStartWorkflowExecutionRequest) GetRetryElapsedExpirationTimestamp() (o int64) {
+	if v != nil && v.RetryElapsedExpirationTimestamp != nil {
+		return *v.RetryElapsedExpirationTimestamp
+	}
+	return
+}
+
 // SyncActivityRequest is an internal type (TBD...)
 type SyncActivityRequest struct {
 	DomainID           string          `json:"domainId,omitempty"`
@@ -149,6 +149,8 @@ func FromAdminDescribeShardDistributionResponse(t *types.DescribeShardDistributi
 	return &adminv1.DescribeShardDistributionResponse{
 		NumberOfShards: t.NumberOfShards,
 		Shards:         t.Shards,
+		PlacementMode:  t.PlacementMode,
+		Placements:     FromAdminShardPlacementInfoMap(t.Placements),
 	}
 }
 
@@ -157,11 +159,12 @@ func FromAdminDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse)
 		return nil
 	}
 	return &adminv1.DescribeHistoryHostResponse{
-		NumberOfShards:        t.NumberOfShards,
-		ShardIds:              t.ShardIDs,
-		DomainCache:           FromDomainCacheInfo(t.DomainCache),
-		ShardControllerStatus: t.ShardControllerStatus,
-		Address:               t.Address,
+		NumberOfShards:             t.NumberOfShards,
+		ShardIds:                   t.ShardIDs,
+		DomainCache:                FromDomainCacheInfo(t.DomainCache),
+		ShardControllerStatus:      t.ShardControllerStatus,
+		Address:                    t.Address,
+		PersistenceCircuitBreakers: FromPersistenceCircuitBreakerInfoArray(t.PersistenceCircuitBreakers),
 	}
 }
 
@@ -172,19 +175,70 @@ func ToAdminDescribeShardDistributionResponse(t *adminv1.DescribeShardDistributi
 	return &types.DescribeShardDistributionResponse{
 		NumberOfShards: t.NumberOfShards,
 		Shards:         t.Shards,
+		PlacementMode:  t.PlacementMode,
+		Placements:     ToAdminShardPlacementInfoMap(t.Placements),
 	}
 }
 
+func FromAdminShardPlacementInfo(t *types.ShardPlacementInfo) *adminv1.ShardPlacementInfo {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ShardPlacementInfo{
+		AssignedHost: t.AssignedHost,
+		Pinned:       t.Pinned,
+		Rps:          t.RPS,
+		QueueBacklog: t.QueueBacklog,
+		CacheSize:    t.CacheSize,
+	}
+}
+
+func ToAdminShardPlacementInfo(t *adminv1.ShardPlacementInfo) *types.ShardPlacementInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ShardPlacementInfo{
+		AssignedHost: t.AssignedHost,
+		Pinned:       t.Pinned,
+		RPS:          t.Rps,
+		QueueBacklog: t.QueueBacklog,
+		CacheSize:    t.CacheSize,
+	}
+}
+
+func FromAdminShardPlacementInfoMap(t map[int32]*types.ShardPlacementInfo) map[int32]*adminv1.ShardPlacementInfo {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*adminv1.ShardPlacementInfo, len(t))
+	for key := range t {
+		v[key] = FromAdminShardPlacementInfo(t[key])
+	}
+	return v
+}
+
+func ToAdminShardPlacementInfoMap(t map[int32]*adminv1.ShardPlacementInfo) map[int32]*types.ShardPlacementInfo {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*types.ShardPlacementInfo, len(t))
+	for key := range t {
+		v[key] = ToAdminShardPlacementInfo(t[key])
+	}
+	return v
+}
+
 func ToAdminDescribeHistoryHostResponse(t *adminv1.DescribeHistoryHostResponse) *types.DescribeHistoryHostResponse {
 	if t == nil {
 		return nil
 	}
 	return &types.DescribeHistoryHostResponse{
-		NumberOfShards:        t.NumberOfShards,
-		ShardIDs:              t.ShardIds,
-		DomainCache:           ToDomainCacheInfo(t.DomainCache),
-		ShardControllerStatus: t.ShardControllerStatus,
-		Address:               t.Address,
+		NumberOfShards:             t.NumberOfShards,
+		ShardIDs:                   t.ShardIds,
+		DomainCache:                ToDomainCacheInfo(t.DomainCache),
+		ShardControllerStatus:      t.ShardControllerStatus,
+		Address:                    t.Address,
+		PersistenceCircuitBreakers: ToPersistenceCircuitBreakerInfoArray(t.PersistenceCircuitBreakers),
 	}
 }
 
@@ -720,3 +774,815 @@ func ToAdminGetCrossClusterTasksResponse(t *adminv1.GetCrossClusterTasksResponse
 		FailedCauseByShard: ToGetTaskFailedCauseMap(t.FailedCauseByShard),
 	}
 }
+
+func FromDynamicConfigFilter(t *types.DynamicConfigFilter) *adminv1.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DynamicConfigFilter{
+		Name:  t.Name,
+		Value: FromDataBlob(t.Value),
+	}
+}
+
+func ToDynamicConfigFilter(t *adminv1.DynamicConfigFilter) *types.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigFilter{
+		Name:  t.Name,
+		Value: ToDataBlob(t.Value),
+	}
+}
+
+func FromDynamicConfigFilterArray(t []*types.DynamicConfigFilter) []*adminv1.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.DynamicConfigFilter, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigFilter(t[i])
+	}
+	return v
+}
+
+func ToDynamicConfigFilterArray(t []*adminv1.DynamicConfigFilter) []*types.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigFilter, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigFilter(t[i])
+	}
+	return v
+}
+
+func FromDynamicConfigChange(t *types.DynamicConfigChange) *adminv1.DynamicConfigChange {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DynamicConfigChange{
+		Id:         t.ID,
+		ConfigName: t.ConfigName,
+		Filters:    FromDynamicConfigFilterArray(t.Filters),
+		Value:      FromDataBlob(t.Value),
+		Deleted:    t.Deleted,
+		Author:     t.Author,
+		Reason:     t.Reason,
+		Time:       unixNanoToTimeValue(t.TimestampNano),
+		ActiveFrom: unixNanoToTimeValue(t.ActiveFromNano),
+		ExpiresAt:  unixNanoToTimeValue(t.ExpiresAtNano),
+	}
+}
+
+func ToDynamicConfigChange(t *adminv1.DynamicConfigChange) *types.DynamicConfigChange {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigChange{
+		ID:             t.Id,
+		ConfigName:     t.ConfigName,
+		Filters:        ToDynamicConfigFilterArray(t.Filters),
+		Value:          ToDataBlob(t.Value),
+		Deleted:        t.Deleted,
+		Author:         t.Author,
+		Reason:         t.Reason,
+		TimestampNano:  timeToUnixNanoValue(t.Time),
+		ActiveFromNano: timeToUnixNanoValue(t.ActiveFrom),
+		ExpiresAtNano:  timeToUnixNanoValue(t.ExpiresAt),
+	}
+}
+
+func FromDynamicConfigChangeArray(t []*types.DynamicConfigChange) []*adminv1.DynamicConfigChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.DynamicConfigChange, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigChange(t[i])
+	}
+	return v
+}
+
+func ToDynamicConfigChangeArray(t []*adminv1.DynamicConfigChange) []*types.DynamicConfigChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigChange, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigChange(t[i])
+	}
+	return v
+}
+
+func FromDynamicConfigValue(t *types.DynamicConfigValue) *adminv1.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DynamicConfigValue{
+		Filters:    FromDynamicConfigFilterArray(t.Filters),
+		Value:      FromDataBlob(t.Value),
+		LastChange: FromDynamicConfigChange(t.LastChange),
+	}
+}
+
+func ToDynamicConfigValue(t *adminv1.DynamicConfigValue) *types.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigValue{
+		Filters:    ToDynamicConfigFilterArray(t.Filters),
+		Value:      ToDataBlob(t.Value),
+		LastChange: ToDynamicConfigChange(t.LastChange),
+	}
+}
+
+func FromDynamicConfigValueArray(t []*types.DynamicConfigValue) []*adminv1.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.DynamicConfigValue, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigValue(t[i])
+	}
+	return v
+}
+
+func ToDynamicConfigValueArray(t []*adminv1.DynamicConfigValue) []*types.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigValue, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigValue(t[i])
+	}
+	return v
+}
+
+func FromDynamicConfigEntry(t *types.DynamicConfigEntry) *adminv1.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DynamicConfigEntry{
+		ConfigName: t.ConfigName,
+		Values:     FromDynamicConfigValueArray(t.Values),
+	}
+}
+
+func ToDynamicConfigEntry(t *adminv1.DynamicConfigEntry) *types.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigEntry{
+		ConfigName: t.ConfigName,
+		Values:     ToDynamicConfigValueArray(t.Values),
+	}
+}
+
+func FromDynamicConfigEntryArray(t []*types.DynamicConfigEntry) []*adminv1.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.DynamicConfigEntry, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigEntry(t[i])
+	}
+	return v
+}
+
+func ToDynamicConfigEntryArray(t []*adminv1.DynamicConfigEntry) []*types.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigEntry, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigEntry(t[i])
+	}
+	return v
+}
+
+func FromAdminGetDynamicConfigRequest(t *types.GetDynamicConfigRequest) *adminv1.GetDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetDynamicConfigRequest{
+		ConfigName: t.ConfigName,
+		Filters:    FromDynamicConfigFilterArray(t.Filters),
+	}
+}
+
+func ToAdminGetDynamicConfigRequest(t *adminv1.GetDynamicConfigRequest) *types.GetDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDynamicConfigRequest{
+		ConfigName: t.ConfigName,
+		Filters:    ToDynamicConfigFilterArray(t.Filters),
+	}
+}
+
+func FromAdminGetDynamicConfigResponse(t *types.GetDynamicConfigResponse) *adminv1.GetDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetDynamicConfigResponse{
+		Value: FromDataBlob(t.Value),
+	}
+}
+
+func ToAdminGetDynamicConfigResponse(t *adminv1.GetDynamicConfigResponse) *types.GetDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDynamicConfigResponse{
+		Value: ToDataBlob(t.Value),
+	}
+}
+
+func FromAdminUpdateDynamicConfigRequest(t *types.UpdateDynamicConfigRequest) *adminv1.UpdateDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.UpdateDynamicConfigRequest{
+		ConfigName: t.ConfigName,
+		Filters:    FromDynamicConfigFilterArray(t.Filters),
+		Value:      FromDataBlob(t.Value),
+		Reason:     t.Reason,
+		ActiveFrom: unixNanoToTimeValue(t.ActiveFromNano),
+		ExpiresAt:  unixNanoToTimeValue(t.ExpiresAtNano),
+	}
+}
+
+func ToAdminUpdateDynamicConfigRequest(t *adminv1.UpdateDynamicConfigRequest) *types.UpdateDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateDynamicConfigRequest{
+		ConfigName:     t.ConfigName,
+		Filters:        ToDynamicConfigFilterArray(t.Filters),
+		Value:          ToDataBlob(t.Value),
+		Reason:         t.Reason,
+		ActiveFromNano: timeToUnixNanoValue(t.ActiveFrom),
+		ExpiresAtNano:  timeToUnixNanoValue(t.ExpiresAt),
+	}
+}
+
+func FromAdminRestoreDynamicConfigRequest(t *types.RestoreDynamicConfigRequest) *adminv1.RestoreDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RestoreDynamicConfigRequest{
+		ConfigName: t.ConfigName,
+		Filters:    FromDynamicConfigFilterArray(t.Filters),
+		Reason:     t.Reason,
+	}
+}
+
+func ToAdminRestoreDynamicConfigRequest(t *adminv1.RestoreDynamicConfigRequest) *types.RestoreDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RestoreDynamicConfigRequest{
+		ConfigName: t.ConfigName,
+		Filters:    ToDynamicConfigFilterArray(t.Filters),
+		Reason:     t.Reason,
+	}
+}
+
+func FromAdminListDynamicConfigRequest(t *types.ListDynamicConfigRequest) *adminv1.ListDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListDynamicConfigRequest{
+		ConfigName:     t.ConfigName,
+		IncludeHistory: t.IncludeHistory,
+	}
+}
+
+func ToAdminListDynamicConfigRequest(t *adminv1.ListDynamicConfigRequest) *types.ListDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigRequest{
+		ConfigName:     t.ConfigName,
+		IncludeHistory: t.IncludeHistory,
+	}
+}
+
+func FromAdminListDynamicConfigResponse(t *types.ListDynamicConfigResponse) *adminv1.ListDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListDynamicConfigResponse{
+		Entries: FromDynamicConfigEntryArray(t.Entries),
+		History: FromDynamicConfigChangeArray(t.History),
+	}
+}
+
+func ToAdminListDynamicConfigResponse(t *adminv1.ListDynamicConfigResponse) *types.ListDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigResponse{
+		Entries: ToDynamicConfigEntryArray(t.Entries),
+		History: ToDynamicConfigChangeArray(t.History),
+	}
+}
+
+func FromAdminPauseTaskListRequest(t *types.PauseTaskListRequest) *adminv1.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.PauseTaskListRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Reason:       t.Reason,
+	}
+}
+
+func ToAdminPauseTaskListRequest(t *adminv1.PauseTaskListRequest) *types.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseTaskListRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Reason:       t.Reason,
+	}
+}
+
+func FromAdminResumeTaskListRequest(t *types.ResumeTaskListRequest) *adminv1.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ResumeTaskListRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Reason:       t.Reason,
+	}
+}
+
+func ToAdminResumeTaskListRequest(t *adminv1.ResumeTaskListRequest) *types.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeTaskListRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Reason:       t.Reason,
+	}
+}
+
+func FromAdminMigrateTaskListRequest(t *types.MigrateTaskListRequest) *adminv1.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.MigrateTaskListRequest{
+		Domain:              t.Domain,
+		TaskList:            FromTaskList(t.TaskList),
+		TaskListType:        FromTaskListType(t.TaskListType),
+		DestinationTaskList: t.DestinationTaskList,
+		Reason:              t.Reason,
+	}
+}
+
+func ToAdminMigrateTaskListRequest(t *adminv1.MigrateTaskListRequest) *types.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateTaskListRequest{
+		Domain:              t.Domain,
+		TaskList:            ToTaskList(t.TaskList),
+		TaskListType:        ToTaskListType(t.TaskListType),
+		DestinationTaskList: t.DestinationTaskList,
+		Reason:              t.Reason,
+	}
+}
+
+func FromAdminTaskListDLQEntry(t *types.TaskListDLQEntry) *adminv1.TaskListDLQEntry {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.TaskListDLQEntry{
+		MessageId:         t.MessageID,
+		Domain:            t.Domain,
+		TaskList:          t.TaskList,
+		TaskListType:      FromTaskListType(t.TaskListType),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleId:        t.ScheduleID,
+		Attempts:          t.Attempts,
+		EnqueuedTime:      unixNanoToTime(t.EnqueuedTimestamp),
+	}
+}
+
+func ToAdminTaskListDLQEntry(t *adminv1.TaskListDLQEntry) *types.TaskListDLQEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListDLQEntry{
+		MessageID:         t.MessageId,
+		Domain:            t.Domain,
+		TaskList:          t.TaskList,
+		TaskListType:      ToTaskListType(t.TaskListType),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:        t.ScheduleId,
+		Attempts:          t.Attempts,
+		EnqueuedTimestamp: timeToUnixNano(t.EnqueuedTime),
+	}
+}
+
+func FromAdminTaskListDLQEntryArray(t []*types.TaskListDLQEntry) []*adminv1.TaskListDLQEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.TaskListDLQEntry, len(t))
+	for i := range t {
+		v[i] = FromAdminTaskListDLQEntry(t[i])
+	}
+	return v
+}
+
+func ToAdminTaskListDLQEntryArray(t []*adminv1.TaskListDLQEntry) []*types.TaskListDLQEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListDLQEntry, len(t))
+	for i := range t {
+		v[i] = ToAdminTaskListDLQEntry(t[i])
+	}
+	return v
+}
+
+func FromAdminReadTaskListDLQRequest(t *types.ReadTaskListDLQRequest) *adminv1.ReadTaskListDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ReadTaskListDLQRequest{
+		Domain:        t.Domain,
+		TaskList:      t.TaskList,
+		TaskListType:  FromTaskListType(t.TaskListType),
+		PageSize:      t.MaximumPageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminReadTaskListDLQRequest(t *adminv1.ReadTaskListDLQRequest) *types.ReadTaskListDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadTaskListDLQRequest{
+		Domain:          t.Domain,
+		TaskList:        t.TaskList,
+		TaskListType:    ToTaskListType(t.TaskListType),
+		MaximumPageSize: t.PageSize,
+		NextPageToken:   t.NextPageToken,
+	}
+}
+
+func FromAdminReadTaskListDLQResponse(t *types.ReadTaskListDLQResponse) *adminv1.ReadTaskListDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ReadTaskListDLQResponse{
+		Entries:       FromAdminTaskListDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToAdminReadTaskListDLQResponse(t *adminv1.ReadTaskListDLQResponse) *types.ReadTaskListDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadTaskListDLQResponse{
+		Entries:       ToAdminTaskListDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromAdminDescribeTaskListDLQEntryRequest(t *types.DescribeTaskListDLQEntryRequest) *adminv1.DescribeTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DescribeTaskListDLQEntryRequest{
+		Domain:    t.Domain,
+		MessageId: t.MessageID,
+	}
+}
+
+func ToAdminDescribeTaskListDLQEntryRequest(t *adminv1.DescribeTaskListDLQEntryRequest) *types.DescribeTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListDLQEntryRequest{
+		Domain:    t.Domain,
+		MessageID: t.MessageId,
+	}
+}
+
+func FromAdminDescribeTaskListDLQEntryResponse(t *types.DescribeTaskListDLQEntryResponse) *adminv1.DescribeTaskListDLQEntryResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DescribeTaskListDLQEntryResponse{
+		Entry: FromAdminTaskListDLQEntry(t.Entry),
+	}
+}
+
+func ToAdminDescribeTaskListDLQEntryResponse(t *adminv1.DescribeTaskListDLQEntryResponse) *types.DescribeTaskListDLQEntryResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListDLQEntryResponse{
+		Entry: ToAdminTaskListDLQEntry(t.Entry),
+	}
+}
+
+func FromAdminRedriveTaskListDLQEntryRequest(t *types.RedriveTaskListDLQEntryRequest) *adminv1.RedriveTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RedriveTaskListDLQEntryRequest{
+		Domain:    t.Domain,
+		MessageId: t.MessageID,
+	}
+}
+
+func ToAdminRedriveTaskListDLQEntryRequest(t *adminv1.RedriveTaskListDLQEntryRequest) *types.RedriveTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RedriveTaskListDLQEntryRequest{
+		Domain:    t.Domain,
+		MessageID: t.MessageId,
+	}
+}
+
+func FromAdminFailTaskListDLQEntryRequest(t *types.FailTaskListDLQEntryRequest) *adminv1.FailTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.FailTaskListDLQEntryRequest{
+		Domain:    t.Domain,
+		MessageId: t.MessageID,
+	}
+}
+
+func ToAdminFailTaskListDLQEntryRequest(t *adminv1.FailTaskListDLQEntryRequest) *types.FailTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.FailTaskListDLQEntryRequest{
+		Domain:    t.Domain,
+		MessageID: t.MessageId,
+	}
+}
+
+func FromAdminMoveShardRequest(t *types.MoveShardRequest) *adminv1.MoveShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.MoveShardRequest{
+		ShardId: t.ShardID,
+		Host:    t.Host,
+		Pin:     t.Pin,
+		Reason:  t.Reason,
+	}
+}
+
+func ToAdminMoveShardRequest(t *adminv1.MoveShardRequest) *types.MoveShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveShardRequest{
+		ShardID: t.ShardId,
+		Host:    t.Host,
+		Pin:     t.Pin,
+		Reason:  t.Reason,
+	}
+}
+
+func FromAdminUnpinShardRequest(t *types.UnpinShardRequest) *adminv1.UnpinShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.UnpinShardRequest{
+		ShardId: t.ShardID,
+		Reason:  t.Reason,
+	}
+}
+
+func ToAdminUnpinShardRequest(t *adminv1.UnpinShardRequest) *types.UnpinShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpinShardRequest{
+		ShardID: t.ShardId,
+		Reason:  t.Reason,
+	}
+}
+
+func FromAdminListQuarantinedWorkflowsRequest(t *types.ListQuarantinedWorkflowsRequest) *adminv1.ListQuarantinedWorkflowsRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListQuarantinedWorkflowsRequest{
+		Domain: t.Domain,
+	}
+}
+
+func ToAdminListQuarantinedWorkflowsRequest(t *adminv1.ListQuarantinedWorkflowsRequest) *types.ListQuarantinedWorkflowsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListQuarantinedWorkflowsRequest{
+		Domain: t.Domain,
+	}
+}
+
+func FromAdminListQuarantinedWorkflowsResponse(t *types.ListQuarantinedWorkflowsResponse) *adminv1.ListQuarantinedWorkflowsResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListQuarantinedWorkflowsResponse{
+		Workflows: FromQuarantinedWorkflowArray(t.Workflows),
+	}
+}
+
+func ToAdminListQuarantinedWorkflowsResponse(t *adminv1.ListQuarantinedWorkflowsResponse) *types.ListQuarantinedWorkflowsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListQuarantinedWorkflowsResponse{
+		Workflows: ToQuarantinedWorkflowArray(t.Workflows),
+	}
+}
+
+func FromQuarantinedWorkflow(t *types.QuarantinedWorkflow) *adminv1.QuarantinedWorkflow {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.QuarantinedWorkflow{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		ShardId:           t.ShardID,
+		Details:           t.Details,
+		QuarantinedTime:   unixNanoToTime(t.QuarantinedTimestamp),
+	}
+}
+
+func ToQuarantinedWorkflow(t *adminv1.QuarantinedWorkflow) *types.QuarantinedWorkflow {
+	if t == nil {
+		return nil
+	}
+	return &types.QuarantinedWorkflow{
+		Domain:               t.Domain,
+		WorkflowID:           ToWorkflowID(t.WorkflowExecution),
+		RunID:                ToRunID(t.WorkflowExecution),
+		ShardID:              t.ShardId,
+		Details:              t.Details,
+		QuarantinedTimestamp: timeToUnixNano(t.QuarantinedTime),
+	}
+}
+
+func FromQuarantinedWorkflowArray(t []*types.QuarantinedWorkflow) []*adminv1.QuarantinedWorkflow {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.QuarantinedWorkflow, len(t))
+	for i := range t {
+		v[i] = FromQuarantinedWorkflow(t[i])
+	}
+	return v
+}
+
+func ToQuarantinedWorkflowArray(t []*adminv1.QuarantinedWorkflow) []*types.QuarantinedWorkflow {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.QuarantinedWorkflow, len(t))
+	for i := range t {
+		v[i] = ToQuarantinedWorkflow(t[i])
+	}
+	return v
+}
+
+func FromAdminReleaseQuarantinedWorkflowRequest(t *types.ReleaseQuarantinedWorkflowRequest) *adminv1.ReleaseQuarantinedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ReleaseQuarantinedWorkflowRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		Repair:            t.Repair,
+		Reason:            t.Reason,
+	}
+}
+
+func ToAdminReleaseQuarantinedWorkflowRequest(t *adminv1.ReleaseQuarantinedWorkflowRequest) *types.ReleaseQuarantinedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReleaseQuarantinedWorkflowRequest{
+		Domain:     t.Domain,
+		WorkflowID: ToWorkflowID(t.WorkflowExecution),
+		RunID:      ToRunID(t.WorkflowExecution),
+		Repair:     t.Repair,
+		Reason:     t.Reason,
+	}
+}
+
+func FromWorkflowBuildCheckState(t *types.WorkflowBuildCheckState) adminv1.WorkflowBuildCheckState {
+	if t == nil {
+		return adminv1.WorkflowBuildCheckState_WORKFLOW_BUILD_CHECK_STATE_INVALID
+	}
+	switch *t {
+	case types.WorkflowBuildCheckStateRunning:
+		return adminv1.WorkflowBuildCheckState_WORKFLOW_BUILD_CHECK_STATE_RUNNING
+	case types.WorkflowBuildCheckStatePassed:
+		return adminv1.WorkflowBuildCheckState_WORKFLOW_BUILD_CHECK_STATE_PASSED
+	case types.WorkflowBuildCheckStateFailed:
+		return adminv1.WorkflowBuildCheckState_WORKFLOW_BUILD_CHECK_STATE_FAILED
+	}
+	panic("unexpected enum value")
+}
+
+func ToWorkflowBuildCheckState(t adminv1.WorkflowBuildCheckState) *types.WorkflowBuildCheckState {
+	switch t {
+	case adminv1.WorkflowBuildCheckState_WORKFLOW_BUILD_CHECK_STATE_INVALID:
+		return nil
+	case adminv1.WorkflowBuildCheckState_WORKFLOW_BUILD_CHECK_STATE_RUNNING:
+		return types.WorkflowBuildCheckStateRunning.Ptr()
+	case adminv1.WorkflowBuildCheckState_WORKFLOW_BUILD_CHECK_STATE_PASSED:
+		return types.WorkflowBuildCheckStatePassed.Ptr()
+	case adminv1.WorkflowBuildCheckState_WORKFLOW_BUILD_CHECK_STATE_FAILED:
+		return types.WorkflowBuildCheckStateFailed.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromAdminCheckWorkflowBuildRequest(t *types.CheckWorkflowBuildRequest) *adminv1.CheckWorkflowBuildRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.CheckWorkflowBuildRequest{
+		Domain:                       t.Domain,
+		WorkflowType:                 t.WorkflowType,
+		BuildId:                      t.BuildID,
+		SignatureHash:                t.SignatureHash,
+		TaskList:                     t.TaskList,
+		EarliestTime:                 unixNanoToTime(t.EarliestTime),
+		ShadowCount:                  t.ShadowCount,
+		Concurrency:                  t.Concurrency,
+		ExecutionStartToCloseTimeout: secondsToDuration(t.ExecutionStartToCloseTimeoutSeconds),
+	}
+}
+
+func ToAdminCheckWorkflowBuildRequest(t *adminv1.CheckWorkflowBuildRequest) *types.CheckWorkflowBuildRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CheckWorkflowBuildRequest{
+		Domain:                              t.Domain,
+		WorkflowType:                        t.WorkflowType,
+		BuildID:                             t.BuildId,
+		SignatureHash:                       t.SignatureHash,
+		TaskList:                            t.TaskList,
+		EarliestTime:                        timeToUnixNano(t.EarliestTime),
+		ShadowCount:                         t.ShadowCount,
+		Concurrency:                         t.Concurrency,
+		ExecutionStartToCloseTimeoutSeconds: durationToSeconds(t.ExecutionStartToCloseTimeout),
+	}
+}
+
+func FromAdminCheckWorkflowBuildResponse(t *types.CheckWorkflowBuildResponse) *adminv1.CheckWorkflowBuildResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.CheckWorkflowBuildResponse{
+		State:             FromWorkflowBuildCheckState(t.State),
+		WorkflowExecution: FromWorkflowRunPair(t.WorkflowID, t.RunID),
+		CheckedBuildId:    t.CheckedBuildID,
+		Succeeded:         t.Succeeded,
+		Skipped:           t.Skipped,
+		Failed:            t.Failed,
+	}
+}
+
+func ToAdminCheckWorkflowBuildResponse(t *adminv1.CheckWorkflowBuildResponse) *types.CheckWorkflowBuildResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.CheckWorkflowBuildResponse{
+		State:          ToWorkflowBuildCheckState(t.State),
+		WorkflowID:     ToWorkflowID(t.WorkflowExecution),
+		RunID:          ToRunID(t.WorkflowExecution),
+		CheckedBuildID: t.CheckedBuildId,
+		Succeeded:      t.Succeeded,
+		Skipped:        t.Skipped,
+		Failed:         t.Failed,
+	}
+}
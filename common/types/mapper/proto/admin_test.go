@@ -184,3 +184,147 @@ func TestAdminGetCrossClusterTasksResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminGetCrossClusterTasksResponse(FromAdminGetCrossClusterTasksResponse(item)))
 	}
 }
+
+func TestAdminGetDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.GetDynamicConfigRequest{nil, {}, &testdata.AdminGetDynamicConfigRequest} {
+		assert.Equal(t, item, ToAdminGetDynamicConfigRequest(FromAdminGetDynamicConfigRequest(item)))
+	}
+}
+
+func TestAdminGetDynamicConfigResponse(t *testing.T) {
+	for _, item := range []*types.GetDynamicConfigResponse{nil, {}, &testdata.AdminGetDynamicConfigResponse} {
+		assert.Equal(t, item, ToAdminGetDynamicConfigResponse(FromAdminGetDynamicConfigResponse(item)))
+	}
+}
+
+func TestAdminUpdateDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.UpdateDynamicConfigRequest{nil, {}, &testdata.AdminUpdateDynamicConfigRequest} {
+		assert.Equal(t, item, ToAdminUpdateDynamicConfigRequest(FromAdminUpdateDynamicConfigRequest(item)))
+	}
+}
+
+func TestAdminRestoreDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.RestoreDynamicConfigRequest{nil, {}, &testdata.AdminRestoreDynamicConfigRequest} {
+		assert.Equal(t, item, ToAdminRestoreDynamicConfigRequest(FromAdminRestoreDynamicConfigRequest(item)))
+	}
+}
+
+func TestAdminListDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.ListDynamicConfigRequest{nil, {}, &testdata.AdminListDynamicConfigRequest} {
+		assert.Equal(t, item, ToAdminListDynamicConfigRequest(FromAdminListDynamicConfigRequest(item)))
+	}
+}
+
+func TestAdminListDynamicConfigResponse(t *testing.T) {
+	for _, item := range []*types.ListDynamicConfigResponse{nil, {}, &testdata.AdminListDynamicConfigResponse} {
+		assert.Equal(t, item, ToAdminListDynamicConfigResponse(FromAdminListDynamicConfigResponse(item)))
+	}
+}
+
+func TestAdminPauseTaskListRequest(t *testing.T) {
+	for _, item := range []*types.PauseTaskListRequest{nil, {}, &testdata.AdminPauseTaskListRequest} {
+		assert.Equal(t, item, ToAdminPauseTaskListRequest(FromAdminPauseTaskListRequest(item)))
+	}
+}
+
+func TestAdminResumeTaskListRequest(t *testing.T) {
+	for _, item := range []*types.ResumeTaskListRequest{nil, {}, &testdata.AdminResumeTaskListRequest} {
+		assert.Equal(t, item, ToAdminResumeTaskListRequest(FromAdminResumeTaskListRequest(item)))
+	}
+}
+
+func TestAdminMigrateTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MigrateTaskListRequest{nil, {}, &testdata.AdminMigrateTaskListRequest} {
+		assert.Equal(t, item, ToAdminMigrateTaskListRequest(FromAdminMigrateTaskListRequest(item)))
+	}
+}
+
+func TestAdminTaskListDLQEntry(t *testing.T) {
+	for _, item := range []*types.TaskListDLQEntry{nil, {}, &testdata.AdminTaskListDLQEntry} {
+		assert.Equal(t, item, ToAdminTaskListDLQEntry(FromAdminTaskListDLQEntry(item)))
+	}
+}
+
+func TestAdminReadTaskListDLQRequest(t *testing.T) {
+	for _, item := range []*types.ReadTaskListDLQRequest{nil, {}, &testdata.AdminReadTaskListDLQRequest} {
+		assert.Equal(t, item, ToAdminReadTaskListDLQRequest(FromAdminReadTaskListDLQRequest(item)))
+	}
+}
+
+func TestAdminReadTaskListDLQResponse(t *testing.T) {
+	for _, item := range []*types.ReadTaskListDLQResponse{nil, {}, &testdata.AdminReadTaskListDLQResponse} {
+		assert.Equal(t, item, ToAdminReadTaskListDLQResponse(FromAdminReadTaskListDLQResponse(item)))
+	}
+}
+
+func TestAdminDescribeTaskListDLQEntryRequest(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListDLQEntryRequest{nil, {}, &testdata.AdminDescribeTaskListDLQEntryRequest} {
+		assert.Equal(t, item, ToAdminDescribeTaskListDLQEntryRequest(FromAdminDescribeTaskListDLQEntryRequest(item)))
+	}
+}
+
+func TestAdminDescribeTaskListDLQEntryResponse(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListDLQEntryResponse{nil, {}, &testdata.AdminDescribeTaskListDLQEntryResponse} {
+		assert.Equal(t, item, ToAdminDescribeTaskListDLQEntryResponse(FromAdminDescribeTaskListDLQEntryResponse(item)))
+	}
+}
+
+func TestAdminRedriveTaskListDLQEntryRequest(t *testing.T) {
+	for _, item := range []*types.RedriveTaskListDLQEntryRequest{nil, {}, &testdata.AdminRedriveTaskListDLQEntryRequest} {
+		assert.Equal(t, item, ToAdminRedriveTaskListDLQEntryRequest(FromAdminRedriveTaskListDLQEntryRequest(item)))
+	}
+}
+
+func TestAdminFailTaskListDLQEntryRequest(t *testing.T) {
+	for _, item := range []*types.FailTaskListDLQEntryRequest{nil, {}, &testdata.AdminFailTaskListDLQEntryRequest} {
+		assert.Equal(t, item, ToAdminFailTaskListDLQEntryRequest(FromAdminFailTaskListDLQEntryRequest(item)))
+	}
+}
+
+func TestAdminDescribeShardDistributionResponse(t *testing.T) {
+	for _, item := range []*types.DescribeShardDistributionResponse{nil, {}, &testdata.AdminDescribeShardDistributionResponse} {
+		assert.Equal(t, item, ToAdminDescribeShardDistributionResponse(FromAdminDescribeShardDistributionResponse(item)))
+	}
+}
+
+func TestAdminMoveShardRequest(t *testing.T) {
+	for _, item := range []*types.MoveShardRequest{nil, {}, &testdata.AdminMoveShardRequest} {
+		assert.Equal(t, item, ToAdminMoveShardRequest(FromAdminMoveShardRequest(item)))
+	}
+}
+
+func TestAdminUnpinShardRequest(t *testing.T) {
+	for _, item := range []*types.UnpinShardRequest{nil, {}, &testdata.AdminUnpinShardRequest} {
+		assert.Equal(t, item, ToAdminUnpinShardRequest(FromAdminUnpinShardRequest(item)))
+	}
+}
+
+func TestAdminListQuarantinedWorkflowsRequest(t *testing.T) {
+	for _, item := range []*types.ListQuarantinedWorkflowsRequest{nil, {}, &testdata.AdminListQuarantinedWorkflowsRequest} {
+		assert.Equal(t, item, ToAdminListQuarantinedWorkflowsRequest(FromAdminListQuarantinedWorkflowsRequest(item)))
+	}
+}
+
+func TestAdminListQuarantinedWorkflowsResponse(t *testing.T) {
+	for _, item := range []*types.ListQuarantinedWorkflowsResponse{nil, {}, &testdata.AdminListQuarantinedWorkflowsResponse} {
+		assert.Equal(t, item, ToAdminListQuarantinedWorkflowsResponse(FromAdminListQuarantinedWorkflowsResponse(item)))
+	}
+}
+
+func TestAdminReleaseQuarantinedWorkflowRequest(t *testing.T) {
+	for _, item := range []*types.ReleaseQuarantinedWorkflowRequest{nil, {}, &testdata.AdminReleaseQuarantinedWorkflowRequest} {
+		assert.Equal(t, item, ToAdminReleaseQuarantinedWorkflowRequest(FromAdminReleaseQuarantinedWorkflowRequest(item)))
+	}
+}
+
+func TestAdminCheckWorkflowBuildRequest(t *testing.T) {
+	for _, item := range []*types.CheckWorkflowBuildRequest{nil, {}, &testdata.AdminCheckWorkflowBuildRequest} {
+		assert.Equal(t, item, ToAdminCheckWorkflowBuildRequest(FromAdminCheckWorkflowBuildRequest(item)))
+	}
+}
+
+func TestAdminCheckWorkflowBuildResponse(t *testing.T) {
+	for _, item := range []*types.CheckWorkflowBuildResponse{nil, {}, &testdata.AdminCheckWorkflowBuildResponse} {
+		assert.Equal(t, item, ToAdminCheckWorkflowBuildResponse(FromAdminCheckWorkflowBuildResponse(item)))
+	}
+}
@@ -164,6 +164,8 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
 		RetryPolicy:                  FromRetryPolicy(t.RetryPolicy),
 		Header:                       FromHeader(t.Header),
+		Priority:                     t.Priority,
+		FairnessKey:                  t.FairnessKey,
 	}
 }
 
@@ -184,6 +186,8 @@ func ToActivityTaskScheduledEventAttributes(t *apiv1.ActivityTaskScheduledEventA
 		DecisionTaskCompletedEventID:  t.DecisionTaskCompletedEventId,
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -836,6 +840,8 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) apiv1.Decisio
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES
+	case types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES
 	}
 	panic("unexpected enum value")
 }
@@ -890,6 +896,8 @@ func ToDecisionTaskFailedCause(t apiv1.DecisionTaskFailedCause) *types.DecisionT
 		return types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr()
 	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES:
 		return types.DecisionTaskFailedCauseBadSearchAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES:
+		return types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes.Ptr()
 	}
 	panic("unexpected enum value")
 }
@@ -1074,6 +1082,7 @@ func FromDescribeDomainResponseDomain(t *types.DescribeDomainResponse) *apiv1.Do
 		domain.HistoryArchivalUri = config.HistoryArchivalURI
 		domain.VisibilityArchivalStatus = FromArchivalStatus(config.VisibilityArchivalStatus)
 		domain.VisibilityArchivalUri = config.VisibilityArchivalURI
+		domain.DrainedIsolationGroups = config.DrainedIsolationGroups
 	}
 	if repl := t.ReplicationConfiguration; repl != nil {
 		domain.ActiveClusterName = repl.ActiveClusterName
@@ -1112,6 +1121,7 @@ func ToDescribeDomainResponseDomain(t *apiv1.Domain) *types.DescribeDomainRespon
 			HistoryArchivalURI:                     t.HistoryArchivalUri,
 			VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.VisibilityArchivalUri,
+			DrainedIsolationGroups:                 t.DrainedIsolationGroups,
 		},
 		ReplicationConfiguration: &types.DomainReplicationConfiguration{
 			ActiveClusterName: t.ActiveClusterName,
@@ -1138,6 +1148,7 @@ func FromDescribeTaskListRequest(t *types.DescribeTaskListRequest) *apiv1.Descri
 		TaskList:              FromTaskList(t.TaskList),
 		TaskListType:          FromTaskListType(t.TaskListType),
 		IncludeTaskListStatus: t.IncludeTaskListStatus,
+		IncludePartitions:     t.IncludePartitions,
 	}
 }
 
@@ -1150,6 +1161,7 @@ func ToDescribeTaskListRequest(t *apiv1.DescribeTaskListRequest) *types.Describe
 		TaskList:              ToTaskList(t.TaskList),
 		TaskListType:          ToTaskListType(t.TaskListType),
 		IncludeTaskListStatus: t.IncludeTaskListStatus,
+		IncludePartitions:     t.IncludePartitions,
 	}
 }
 
@@ -1158,8 +1170,11 @@ func FromDescribeTaskListResponse(t *types.DescribeTaskListResponse) *apiv1.Desc
 		return nil
 	}
 	return &apiv1.DescribeTaskListResponse{
-		Pollers:        FromPollerInfoArray(t.Pollers),
-		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+		Pollers:          FromPollerInfoArray(t.Pollers),
+		TaskListStatus:   FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  FromTaskListPartitionConfig(t.PartitionConfig),
+		PollersByBuildId: t.PollersByBuildID,
+		PartitionStatus:  FromTaskListStatusMap(t.PartitionStatus),
 	}
 }
 
@@ -1168,8 +1183,11 @@ func ToDescribeTaskListResponse(t *apiv1.DescribeTaskListResponse) *types.Descri
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:        ToPollerInfoArray(t.Pollers),
-		TaskListStatus: ToTaskListStatus(t.TaskListStatus),
+		Pollers:          ToPollerInfoArray(t.Pollers),
+		TaskListStatus:   ToTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  ToTaskListPartitionConfig(t.PartitionConfig),
+		PollersByBuildID: t.PollersByBuildId,
+		PartitionStatus:  ToTaskListStatusMap(t.PartitionStatus),
 	}
 }
 
@@ -1698,8 +1716,10 @@ func FromListTaskListPartitionsResponse(t *types.ListTaskListPartitionsResponse)
 		return nil
 	}
 	return &apiv1.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: FromTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
-		DecisionTaskListPartitions: FromTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitions:      FromTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
+		DecisionTaskListPartitions:      FromTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitionConfig: FromTaskListPartitionConfig(t.ActivityTaskListPartitionConfig),
+		DecisionTaskListPartitionConfig: FromTaskListPartitionConfig(t.DecisionTaskListPartitionConfig),
 	}
 }
 
@@ -1708,8 +1728,10 @@ func ToListTaskListPartitionsResponse(t *apiv1.ListTaskListPartitionsResponse) *
 		return nil
 	}
 	return &types.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: ToTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
-		DecisionTaskListPartitions: ToTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitions:      ToTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
+		DecisionTaskListPartitions:      ToTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitionConfig: ToTaskListPartitionConfig(t.ActivityTaskListPartitionConfig),
+		DecisionTaskListPartitionConfig: ToTaskListPartitionConfig(t.DecisionTaskListPartitionConfig),
 	}
 }
 
@@ -1749,6 +1771,92 @@ func ToGetTaskListsByDomainResponse(t *apiv1.GetTaskListsByDomainResponse) *type
 	}
 }
 
+func FromUpdateWorkerBuildIDCompatibilityRequest(t *types.UpdateWorkerBuildIDCompatibilityRequest) *apiv1.UpdateWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateWorkerBuildIDCompatibilityRequest{
+		Domain:                       t.Domain,
+		TaskList:                     FromTaskList(t.TaskList),
+		AddNewBuildIdInNewDefaultSet: t.AddNewBuildIDInNewDefaultSet,
+		AddNewCompatibleBuildId:      t.AddNewCompatibleBuildID,
+		ExistingCompatibleBuildId:    t.ExistingCompatibleBuildID,
+		MakeSetDefault:               t.MakeSetDefault,
+		PromoteSetByBuildId:          t.PromoteSetByBuildID,
+	}
+}
+
+func ToUpdateWorkerBuildIDCompatibilityRequest(t *apiv1.UpdateWorkerBuildIDCompatibilityRequest) *types.UpdateWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkerBuildIDCompatibilityRequest{
+		Domain:                       t.Domain,
+		TaskList:                     ToTaskList(t.TaskList),
+		AddNewBuildIDInNewDefaultSet: t.AddNewBuildIdInNewDefaultSet,
+		AddNewCompatibleBuildID:      t.AddNewCompatibleBuildId,
+		ExistingCompatibleBuildID:    t.ExistingCompatibleBuildId,
+		MakeSetDefault:               t.MakeSetDefault,
+		PromoteSetByBuildID:          t.PromoteSetByBuildId,
+	}
+}
+
+func FromGetWorkerBuildIDCompatibilityRequest(t *types.GetWorkerBuildIDCompatibilityRequest) *apiv1.GetWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkerBuildIDCompatibilityRequest{
+		Domain:   t.Domain,
+		TaskList: FromTaskList(t.TaskList),
+	}
+}
+
+func ToGetWorkerBuildIDCompatibilityRequest(t *apiv1.GetWorkerBuildIDCompatibilityRequest) *types.GetWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetWorkerBuildIDCompatibilityRequest{
+		Domain:   t.Domain,
+		TaskList: ToTaskList(t.TaskList),
+	}
+}
+
+func FromGetWorkerBuildIDCompatibilityResponse(t *types.GetWorkerBuildIDCompatibilityResponse) *apiv1.GetWorkerBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkerBuildIDCompatibilityResponse{
+		VersionSets: FromWorkerVersionSetArray(t.VersionSets),
+	}
+}
+
+func ToGetWorkerBuildIDCompatibilityResponse(t *apiv1.GetWorkerBuildIDCompatibilityResponse) *types.GetWorkerBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetWorkerBuildIDCompatibilityResponse{
+		VersionSets: ToWorkerVersionSetArray(t.VersionSets),
+	}
+}
+
+func FromWorkerVersionSet(t *types.WorkerVersionSet) *apiv1.WorkerVersionSet {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkerVersionSet{
+		BuildIds: t.BuildIDs,
+	}
+}
+
+func ToWorkerVersionSet(t *apiv1.WorkerVersionSet) *types.WorkerVersionSet {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkerVersionSet{
+		BuildIDs: t.BuildIds,
+	}
+}
+
 func FromListWorkflowExecutionsRequest(t *types.ListWorkflowExecutionsRequest) *apiv1.ListWorkflowExecutionsRequest {
 	if t == nil {
 		return nil
@@ -1881,6 +1989,7 @@ func FromPendingActivityInfo(t *types.PendingActivityInfo) *apiv1.PendingActivit
 		ExpirationTime:     unixNanoToTime(t.ExpirationTimestamp),
 		LastFailure:        FromFailure(t.LastFailureReason, t.LastFailureDetails),
 		LastWorkerIdentity: t.LastWorkerIdentity,
+		NextRetryTime:      unixNanoToTime(t.NextRetryTimestamp),
 	}
 }
 
@@ -1902,6 +2011,7 @@ func ToPendingActivityInfo(t *apiv1.PendingActivityInfo) *types.PendingActivityI
 		LastFailureReason:      ToFailureReason(t.LastFailure),
 		LastFailureDetails:     ToFailureDetails(t.LastFailure),
 		LastWorkerIdentity:     t.LastWorkerIdentity,
+		NextRetryTimestamp:     timeToUnixNano(t.NextRetryTime),
 	}
 }
 
@@ -2019,6 +2129,8 @@ func FromPollForActivityTaskRequest(t *types.PollForActivityTaskRequest) *apiv1.
 		TaskList:         FromTaskList(t.TaskList),
 		Identity:         t.Identity,
 		TaskListMetadata: FromTaskListMetadata(t.TaskListMetadata),
+		BinaryChecksum:   t.BinaryChecksum,
+		IsolationGroup:   t.IsolationGroup,
 	}
 }
 
@@ -2031,6 +2143,8 @@ func ToPollForActivityTaskRequest(t *apiv1.PollForActivityTaskRequest) *types.Po
 		TaskList:         ToTaskList(t.TaskList),
 		Identity:         t.Identity,
 		TaskListMetadata: ToTaskListMetadata(t.TaskListMetadata),
+		BinaryChecksum:   t.BinaryChecksum,
+		IsolationGroup:   t.IsolationGroup,
 	}
 }
 
@@ -2091,6 +2205,7 @@ func FromPollForDecisionTaskRequest(t *types.PollForDecisionTaskRequest) *apiv1.
 		TaskList:       FromTaskList(t.TaskList),
 		Identity:       t.Identity,
 		BinaryChecksum: t.BinaryChecksum,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -2103,6 +2218,7 @@ func ToPollForDecisionTaskRequest(t *apiv1.PollForDecisionTaskRequest) *types.Po
 		TaskList:       ToTaskList(t.TaskList),
 		Identity:       t.Identity,
 		BinaryChecksum: t.BinaryChecksum,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -2160,6 +2276,8 @@ func FromPollerInfo(t *types.PollerInfo) *apiv1.PollerInfo {
 		LastAccessTime: unixNanoToTime(t.LastAccessTime),
 		Identity:       t.Identity,
 		RatePerSecond:  t.RatePerSecond,
+		BuildId:        t.BuildID,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -2171,6 +2289,8 @@ func ToPollerInfo(t *apiv1.PollerInfo) *types.PollerInfo {
 		LastAccessTime: timeToUnixNano(t.LastAccessTime),
 		Identity:       t.Identity,
 		RatePerSecond:  t.RatePerSecond,
+		BuildID:        t.BuildId,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -3011,6 +3131,10 @@ func FromRetryPolicy(t *types.RetryPolicy) *apiv1.RetryPolicy {
 		MaximumAttempts:          t.MaximumAttempts,
 		NonRetryableErrorReasons: t.NonRetriableErrorReasons,
 		ExpirationInterval:       secondsToDuration(common.Int32Ptr(t.ExpirationIntervalInSeconds)),
+		BackoffSchedule:          secondsArrayToDurations(t.BackoffScheduleInSeconds),
+		JitterMode:               FromRetryJitterMode(t.JitterMode),
+		ReasonPolicies:           FromRetryReasonPolicyArray(t.ReasonPolicies),
+		MaximumTotalElapsedTime:  secondsToDuration(common.Int32Ptr(t.MaximumTotalElapsedTimeInSeconds)),
 	}
 }
 
@@ -3019,15 +3143,87 @@ func ToRetryPolicy(t *apiv1.RetryPolicy) *types.RetryPolicy {
 		return nil
 	}
 	return &types.RetryPolicy{
-		InitialIntervalInSeconds:    common.Int32Default(durationToSeconds(t.InitialInterval)),
-		BackoffCoefficient:          t.BackoffCoefficient,
-		MaximumIntervalInSeconds:    common.Int32Default(durationToSeconds(t.MaximumInterval)),
-		MaximumAttempts:             t.MaximumAttempts,
-		NonRetriableErrorReasons:    t.NonRetryableErrorReasons,
-		ExpirationIntervalInSeconds: common.Int32Default(durationToSeconds(t.ExpirationInterval)),
+		InitialIntervalInSeconds:         common.Int32Default(durationToSeconds(t.InitialInterval)),
+		BackoffCoefficient:               t.BackoffCoefficient,
+		MaximumIntervalInSeconds:         common.Int32Default(durationToSeconds(t.MaximumInterval)),
+		MaximumAttempts:                  t.MaximumAttempts,
+		NonRetriableErrorReasons:         t.NonRetryableErrorReasons,
+		ExpirationIntervalInSeconds:      common.Int32Default(durationToSeconds(t.ExpirationInterval)),
+		BackoffScheduleInSeconds:         durationsToSecondsArray(t.BackoffSchedule),
+		JitterMode:                       ToRetryJitterMode(t.JitterMode),
+		ReasonPolicies:                   ToRetryReasonPolicyArray(t.ReasonPolicies),
+		MaximumTotalElapsedTimeInSeconds: common.Int32Default(durationToSeconds(t.MaximumTotalElapsedTime)),
+	}
+}
+
+func FromRetryReasonPolicy(t *types.RetryReasonPolicy) *apiv1.RetryReasonPolicy {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RetryReasonPolicy{
+		ReasonPattern: t.ReasonPattern,
+		RetryPolicy:   FromRetryPolicy(t.RetryPolicy),
 	}
 }
 
+func ToRetryReasonPolicy(t *apiv1.RetryReasonPolicy) *types.RetryReasonPolicy {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryReasonPolicy{
+		ReasonPattern: t.ReasonPattern,
+		RetryPolicy:   ToRetryPolicy(t.RetryPolicy),
+	}
+}
+
+func FromRetryReasonPolicyArray(t []*types.RetryReasonPolicy) []*apiv1.RetryReasonPolicy {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.RetryReasonPolicy, len(t))
+	for i := range t {
+		v[i] = FromRetryReasonPolicy(t[i])
+	}
+	return v
+}
+
+func ToRetryReasonPolicyArray(t []*apiv1.RetryReasonPolicy) []*types.RetryReasonPolicy {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.RetryReasonPolicy, len(t))
+	for i := range t {
+		v[i] = ToRetryReasonPolicy(t[i])
+	}
+	return v
+}
+
+func FromRetryJitterMode(t types.RetryJitterMode) apiv1.RetryJitterMode {
+	switch t {
+	case types.RetryJitterModeNone:
+		return apiv1.RetryJitterMode_RETRY_JITTER_MODE_NONE
+	case types.RetryJitterModeFull:
+		return apiv1.RetryJitterMode_RETRY_JITTER_MODE_FULL
+	case types.RetryJitterModeEqual:
+		return apiv1.RetryJitterMode_RETRY_JITTER_MODE_EQUAL
+	}
+	panic("unexpected enum value")
+}
+
+func ToRetryJitterMode(t apiv1.RetryJitterMode) types.RetryJitterMode {
+	switch t {
+	case apiv1.RetryJitterMode_RETRY_JITTER_MODE_INVALID:
+		return types.RetryJitterModeNone
+	case apiv1.RetryJitterMode_RETRY_JITTER_MODE_NONE:
+		return types.RetryJitterModeNone
+	case apiv1.RetryJitterMode_RETRY_JITTER_MODE_FULL:
+		return types.RetryJitterModeFull
+	case apiv1.RetryJitterMode_RETRY_JITTER_MODE_EQUAL:
+		return types.RetryJitterModeEqual
+	}
+	panic("unexpected enum value")
+}
+
 func FromScanWorkflowExecutionsRequest(t *types.ListWorkflowExecutionsRequest) *apiv1.ScanWorkflowExecutionsRequest {
 	if t == nil {
 		return nil
@@ -3089,6 +3285,8 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
 		Header:                 FromHeader(t.Header),
 		RequestLocalDispatch:   t.RequestLocalDispatch,
+		Priority:               t.Priority,
+		FairnessKey:            t.FairnessKey,
 	}
 }
 
@@ -3109,6 +3307,8 @@ func ToScheduleActivityTaskDecisionAttributes(t *apiv1.ScheduleActivityTaskDecis
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.RequestLocalDispatch,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -3259,6 +3459,8 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 			SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 			Header:                       FromHeader(t.Header),
 			DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+			IsolationGroup:               t.IsolationGroup,
+			WorkflowIdConflictPolicy:     FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 		},
 		SignalName:  t.SignalName,
 		SignalInput: FromPayload(t.SignalInput),
@@ -3290,6 +3492,8 @@ func ToSignalWithStartWorkflowExecutionRequest(t *apiv1.SignalWithStartWorkflowE
 		SearchAttributes:                    ToSearchAttributes(t.StartRequest.SearchAttributes),
 		Header:                              ToHeader(t.StartRequest.Header),
 		DelayStartSeconds:                   durationToSeconds(t.StartRequest.DelayStart),
+		IsolationGroup:                      t.StartRequest.IsolationGroup,
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.StartRequest.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -3361,6 +3565,8 @@ func FromStartChildWorkflowExecutionDecisionAttributes(t *types.StartChildWorkfl
 		Header:                       FromHeader(t.Header),
 		Memo:                         FromMemo(t.Memo),
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
+		Priority:                     t.Priority,
+		FairnessKey:                  t.FairnessKey,
 	}
 }
 
@@ -3384,6 +3590,8 @@ func ToStartChildWorkflowExecutionDecisionAttributes(t *apiv1.StartChildWorkflow
 		Header:                              ToHeader(t.Header),
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+		Priority:                            t.Priority,
+		FairnessKey:                         t.FairnessKey,
 	}
 }
 
@@ -3439,6 +3647,8 @@ func FromStartChildWorkflowExecutionInitiatedEventAttributes(t *types.StartChild
 		Memo:                         FromMemo(t.Memo),
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+		Priority:                     t.Priority,
+		FairnessKey:                  t.FairnessKey,
 	}
 }
 
@@ -3464,6 +3674,8 @@ func ToStartChildWorkflowExecutionInitiatedEventAttributes(t *apiv1.StartChildWo
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		DelayStartSeconds:                   durationToSeconds(t.DelayStart),
+		Priority:                            t.Priority,
+		FairnessKey:                         t.FairnessKey,
 	}
 }
 
@@ -3528,6 +3740,10 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		Header:                       FromHeader(t.Header),
 		DelayStart:                   secondsToDuration(t.DelayStartSeconds),
+		Priority:                     t.Priority,
+		FairnessKey:                  t.FairnessKey,
+		IsolationGroup:               t.IsolationGroup,
+		WorkflowIdConflictPolicy:     FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 	}
 }
 
@@ -3552,6 +3768,10 @@ func ToStartWorkflowExecutionRequest(t *apiv1.StartWorkflowExecutionRequest) *ty
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   durationToSeconds(t.DelayStart),
+		Priority:                            t.Priority,
+		FairnessKey:                         t.FairnessKey,
+		IsolationGroup:                      t.IsolationGroup,
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -3732,16 +3952,47 @@ func ToTaskListPartitionMetadata(t *apiv1.TaskListPartitionMetadata) *types.Task
 	}
 }
 
+func FromTaskListPartitionConfig(t *types.TaskListPartitionConfig) *apiv1.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListPartitionConfig{
+		NumReadPartitions:  t.NumReadPartitions,
+		NumWritePartitions: t.NumWritePartitions,
+		ScalingEnabled:     t.ScalingEnabled,
+	}
+}
+
+func ToTaskListPartitionConfig(t *apiv1.TaskListPartitionConfig) *types.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListPartitionConfig{
+		NumReadPartitions:  t.NumReadPartitions,
+		NumWritePartitions: t.NumWritePartitions,
+		ScalingEnabled:     t.ScalingEnabled,
+	}
+}
+
 func FromTaskListStatus(t *types.TaskListStatus) *apiv1.TaskListStatus {
 	if t == nil {
 		return nil
 	}
 	return &apiv1.TaskListStatus{
-		BacklogCountHint: t.BacklogCountHint,
-		ReadLevel:        t.ReadLevel,
-		AckLevel:         t.AckLevel,
-		RatePerSecond:    t.RatePerSecond,
-		TaskIdBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:               t.BacklogCountHint,
+		ReadLevel:                      t.ReadLevel,
+		AckLevel:                       t.AckLevel,
+		RatePerSecond:                  t.RatePerSecond,
+		TaskIdBlock:                    FromTaskIDBlock(t.TaskIDBlock),
+		AddRatePerSecond:               t.AddRatePerSecond,
+		DispatchRatePerSecond:          t.DispatchRatePerSecond,
+		SyncMatchRatio:                 t.SyncMatchRatio,
+		OldestTaskAgeSeconds:           t.OldestTaskAgeSeconds,
+		IngestionRpsLimit:              t.IngestionRPSLimit,
+		DomainIngestionRpsLimit:        t.DomainIngestionRPSLimit,
+		DomainDispatchRpsLimit:         t.DomainDispatchRPSLimit,
+		ThrottledAddRatePerSecond:      t.ThrottledAddRatePerSecond,
+		ThrottledDispatchRatePerSecond: t.ThrottledDispatchRatePerSecond,
 	}
 }
 
@@ -3750,11 +4001,20 @@ func ToTaskListStatus(t *apiv1.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint: t.BacklogCountHint,
-		ReadLevel:        t.ReadLevel,
-		AckLevel:         t.AckLevel,
-		RatePerSecond:    t.RatePerSecond,
-		TaskIDBlock:      ToTaskIDBlock(t.TaskIdBlock),
+		BacklogCountHint:               t.BacklogCountHint,
+		ReadLevel:                      t.ReadLevel,
+		AckLevel:                       t.AckLevel,
+		RatePerSecond:                  t.RatePerSecond,
+		TaskIDBlock:                    ToTaskIDBlock(t.TaskIdBlock),
+		AddRatePerSecond:               t.AddRatePerSecond,
+		DispatchRatePerSecond:          t.DispatchRatePerSecond,
+		SyncMatchRatio:                 t.SyncMatchRatio,
+		OldestTaskAgeSeconds:           t.OldestTaskAgeSeconds,
+		IngestionRPSLimit:              t.IngestionRpsLimit,
+		DomainIngestionRPSLimit:        t.DomainIngestionRpsLimit,
+		DomainDispatchRPSLimit:         t.DomainDispatchRpsLimit,
+		ThrottledAddRatePerSecond:      t.ThrottledAddRatePerSecond,
+		ThrottledDispatchRatePerSecond: t.ThrottledDispatchRatePerSecond,
 	}
 }
 
@@ -3793,6 +4053,7 @@ func FromTerminateWorkflowExecutionRequest(t *types.TerminateWorkflowExecutionRe
 		Reason:            t.Reason,
 		Details:           FromPayload(t.Details),
 		Identity:          t.Identity,
+		RequestId:         t.RequestID,
 	}
 }
 
@@ -3806,6 +4067,29 @@ func ToTerminateWorkflowExecutionRequest(t *apiv1.TerminateWorkflowExecutionRequ
 		Reason:            t.Reason,
 		Details:           ToPayload(t.Details),
 		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
+func FromExpediteWorkflowExecutionStartRequest(t *types.ExpediteWorkflowExecutionStartRequest) *apiv1.ExpediteWorkflowExecutionStartRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExpediteWorkflowExecutionStartRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+	}
+}
+
+func ToExpediteWorkflowExecutionStartRequest(t *apiv1.ExpediteWorkflowExecutionStartRequest) *types.ExpediteWorkflowExecutionStartRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ExpediteWorkflowExecutionStartRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
 	}
 }
 
@@ -3947,6 +4231,7 @@ const (
 	DomainUpdateClustersField                 = "clusters"
 	DomainUpdateDeleteBadBinaryField          = "delete_bad_binary"
 	DomainUpdateFailoverTimeoutField          = "failover_timeout"
+	DomainUpdateDrainedIsolationGroupsField   = "drained_isolation_groups"
 )
 
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
@@ -4012,6 +4297,10 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRe
 		request.FailoverTimeout = secondsToDuration(t.FailoverTimeoutInSeconds)
 		fields = append(fields, DomainUpdateFailoverTimeoutField)
 	}
+	if t.DrainedIsolationGroups != nil {
+		request.DrainedIsolationGroups = t.DrainedIsolationGroups
+		fields = append(fields, DomainUpdateDrainedIsolationGroupsField)
+	}
 
 	request.UpdateMask = newFieldMask(fields)
 
@@ -4068,6 +4357,9 @@ func ToUpdateDomainRequest(t *apiv1.UpdateDomainRequest) *types.UpdateDomainRequ
 	if fs.isSet(DomainUpdateFailoverTimeoutField) {
 		request.FailoverTimeoutInSeconds = durationToSeconds(t.FailoverTimeout)
 	}
+	if fs.isSet(DomainUpdateDrainedIsolationGroupsField) {
+		request.DrainedIsolationGroups = append([]string{}, t.DrainedIsolationGroups...)
+	}
 
 	return &request
 }
@@ -4095,6 +4387,7 @@ func FromUpdateDomainResponse(t *types.UpdateDomainResponse) *apiv1.UpdateDomain
 		domain.HistoryArchivalUri = config.HistoryArchivalURI
 		domain.VisibilityArchivalStatus = FromArchivalStatus(config.VisibilityArchivalStatus)
 		domain.VisibilityArchivalUri = config.VisibilityArchivalURI
+		domain.DrainedIsolationGroups = config.DrainedIsolationGroups
 	}
 	if repl := t.ReplicationConfiguration; repl != nil {
 		domain.ActiveClusterName = repl.ActiveClusterName
@@ -4126,6 +4419,7 @@ func ToUpdateDomainResponse(t *apiv1.UpdateDomainResponse) *types.UpdateDomainRe
 			HistoryArchivalURI:                     t.Domain.HistoryArchivalUri,
 			VisibilityArchivalStatus:               ToArchivalStatus(t.Domain.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.Domain.VisibilityArchivalUri,
+			DrainedIsolationGroups:                 t.Domain.DrainedIsolationGroups,
 		},
 		ReplicationConfiguration: &types.DomainReplicationConfiguration{
 			ActiveClusterName: t.Domain.ActiveClusterName,
@@ -4174,6 +4468,44 @@ func ToUpsertWorkflowSearchAttributesEventAttributes(t *apiv1.UpsertWorkflowSear
 	}
 }
 
+func FromUpsertWorkflowMemoDecisionAttributes(t *types.UpsertWorkflowMemoDecisionAttributes) *apiv1.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpsertWorkflowMemoDecisionAttributes{
+		Memo: FromMemo(t.Memo),
+	}
+}
+
+func ToUpsertWorkflowMemoDecisionAttributes(t *apiv1.UpsertWorkflowMemoDecisionAttributes) *types.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: ToMemo(t.Memo),
+	}
+}
+
+func FromUpsertWorkflowMemoEventAttributes(t *types.UpsertWorkflowMemoEventAttributes) *apiv1.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventId: t.DecisionTaskCompletedEventID,
+		Memo:                         FromMemo(t.Memo),
+	}
+}
+
+func ToUpsertWorkflowMemoEventAttributes(t *apiv1.UpsertWorkflowMemoEventAttributes) *types.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: t.DecisionTaskCompletedEventId,
+		Memo:                         ToMemo(t.Memo),
+	}
+}
+
 func FromWorkerVersionInfo(t *types.WorkerVersionInfo) *apiv1.WorkerVersionInfo {
 	if t == nil {
 		return nil
@@ -4270,6 +4602,7 @@ func FromWorkflowExecutionCancelRequestedEventAttributes(t *types.WorkflowExecut
 		Cause:                 t.Cause,
 		ExternalExecutionInfo: FromExternalExecutionInfoFields(t.ExternalWorkflowExecution, t.ExternalInitiatedEventID),
 		Identity:              t.Identity,
+		RequestId:             t.RequestID,
 	}
 }
 
@@ -4282,6 +4615,7 @@ func ToWorkflowExecutionCancelRequestedEventAttributes(t *apiv1.WorkflowExecutio
 		ExternalInitiatedEventID:  ToExternalInitiatedID(t.ExternalExecutionInfo),
 		ExternalWorkflowExecution: ToExternalWorkflowExecution(t.ExternalExecutionInfo),
 		Identity:                  t.Identity,
+		RequestID:                 t.RequestId,
 	}
 }
 
@@ -4569,6 +4903,7 @@ func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *apiv1.WorkflowEx
 		SearchAttributes:    FromSearchAttributes(t.SearchAttributes),
 		AutoResetPoints:     FromResetPoints(t.AutoResetPoints),
 		TaskList:            t.TaskList,
+		NextRetryTime:       unixNanoToTime(t.NextRetryTimestamp),
 	}
 }
 
@@ -4577,21 +4912,22 @@ func ToWorkflowExecutionInfo(t *apiv1.WorkflowExecutionInfo) *types.WorkflowExec
 		return nil
 	}
 	return &types.WorkflowExecutionInfo{
-		Execution:         ToWorkflowExecution(t.WorkflowExecution),
-		Type:              ToWorkflowType(t.Type),
-		StartTime:         timeToUnixNano(t.StartTime),
-		CloseTime:         timeToUnixNano(t.CloseTime),
-		CloseStatus:       ToWorkflowExecutionCloseStatus(t.CloseStatus),
-		HistoryLength:     t.HistoryLength,
-		ParentDomainID:    ToParentDomainID(t.ParentExecutionInfo),
-		ParentDomain:      ToParentDomainName(t.ParentExecutionInfo),
-		ParentExecution:   ToParentWorkflowExecution(t.ParentExecutionInfo),
-		ParentInitiatedID: ToParentInitiatedID(t.ParentExecutionInfo),
-		ExecutionTime:     timeToUnixNano(t.ExecutionTime),
-		Memo:              ToMemo(t.Memo),
-		SearchAttributes:  ToSearchAttributes(t.SearchAttributes),
-		AutoResetPoints:   ToResetPoints(t.AutoResetPoints),
-		TaskList:          t.TaskList,
+		Execution:          ToWorkflowExecution(t.WorkflowExecution),
+		Type:               ToWorkflowType(t.Type),
+		StartTime:          timeToUnixNano(t.StartTime),
+		CloseTime:          timeToUnixNano(t.CloseTime),
+		CloseStatus:        ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		HistoryLength:      t.HistoryLength,
+		ParentDomainID:     ToParentDomainID(t.ParentExecutionInfo),
+		ParentDomain:       ToParentDomainName(t.ParentExecutionInfo),
+		ParentExecution:    ToParentWorkflowExecution(t.ParentExecutionInfo),
+		ParentInitiatedID:  ToParentInitiatedID(t.ParentExecutionInfo),
+		ExecutionTime:      timeToUnixNano(t.ExecutionTime),
+		Memo:               ToMemo(t.Memo),
+		SearchAttributes:   ToSearchAttributes(t.SearchAttributes),
+		AutoResetPoints:    ToResetPoints(t.AutoResetPoints),
+		TaskList:           t.TaskList,
+		NextRetryTimestamp: timeToUnixNano(t.NextRetryTime),
 	}
 }
 
@@ -4603,6 +4939,7 @@ func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSign
 		SignalName: t.SignalName,
 		Input:      FromPayload(t.Input),
 		Identity:   t.Identity,
+		RequestId:  t.RequestID,
 	}
 }
 
@@ -4614,6 +4951,7 @@ func ToWorkflowExecutionSignaledEventAttributes(t *apiv1.WorkflowExecutionSignal
 		SignalName: t.SignalName,
 		Input:      ToPayload(t.Input),
 		Identity:   t.Identity,
+		RequestID:  t.RequestId,
 	}
 }
 
@@ -4644,6 +4982,11 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:          FromResetPoints(t.PrevAutoResetPoints),
 		Header:                       FromHeader(t.Header),
+		Priority:                     t.Priority,
+		FairnessKey:                  t.FairnessKey,
+		IsolationGroup:               t.IsolationGroup,
+		RetryElapsedExpirationTime:   unixNanoToTime(t.RetryElapsedExpirationTimestamp),
+		DelayStart:                   secondsToDuration(t.DelayStartSeconds),
 	}
 }
 
@@ -4678,6 +5021,11 @@ func ToWorkflowExecutionStartedEventAttributes(t *apiv1.WorkflowExecutionStarted
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		Priority:                            t.Priority,
+		FairnessKey:                         t.FairnessKey,
+		IsolationGroup:                      t.IsolationGroup,
+		RetryElapsedExpirationTimestamp:     timeToUnixNano(t.RetryElapsedExpirationTime),
+		DelayStartSeconds:                   durationToSeconds(t.DelayStart),
 	}
 }
 
@@ -4686,9 +5034,10 @@ func FromWorkflowExecutionTerminatedEventAttributes(t *types.WorkflowExecutionTe
 		return nil
 	}
 	return &apiv1.WorkflowExecutionTerminatedEventAttributes{
-		Reason:   t.Reason,
-		Details:  FromPayload(t.Details),
-		Identity: t.Identity,
+		Reason:    t.Reason,
+		Details:   FromPayload(t.Details),
+		Identity:  t.Identity,
+		RequestId: t.RequestID,
 	}
 }
 
@@ -4697,9 +5046,10 @@ func ToWorkflowExecutionTerminatedEventAttributes(t *apiv1.WorkflowExecutionTerm
 		return nil
 	}
 	return &types.WorkflowExecutionTerminatedEventAttributes{
-		Reason:   t.Reason,
-		Details:  ToPayload(t.Details),
-		Identity: t.Identity,
+		Reason:    t.Reason,
+		Details:   ToPayload(t.Details),
+		Identity:  t.Identity,
+		RequestID: t.RequestId,
 	}
 }
 
@@ -4721,6 +5071,35 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *apiv1.WorkflowExecutionTimedO
 	}
 }
 
+func FromWorkflowIDConflictPolicy(t *types.WorkflowIDConflictPolicy) apiv1.WorkflowIdConflictPolicy {
+	if t == nil {
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+	}
+	switch *t {
+	case types.WorkflowIDConflictPolicyFail:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL
+	case types.WorkflowIDConflictPolicyUseExisting:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	case types.WorkflowIDConflictPolicyTerminateExisting:
+		return apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING
+	}
+	panic("unexpected enum value")
+}
+
+func ToWorkflowIDConflictPolicy(t apiv1.WorkflowIdConflictPolicy) *types.WorkflowIDConflictPolicy {
+	switch t {
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID:
+		return nil
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL:
+		return types.WorkflowIDConflictPolicyFail.Ptr()
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING:
+		return types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	case apiv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING:
+		return types.WorkflowIDConflictPolicyTerminateExisting.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) apiv1.WorkflowIdReusePolicy {
 	if t == nil {
 		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
@@ -4942,6 +5321,28 @@ func ToPollerInfoArray(t []*apiv1.PollerInfo) []*types.PollerInfo {
 	return v
 }
 
+func FromWorkerVersionSetArray(t []*types.WorkerVersionSet) []*apiv1.WorkerVersionSet {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkerVersionSet, len(t))
+	for i := range t {
+		v[i] = FromWorkerVersionSet(t[i])
+	}
+	return v
+}
+
+func ToWorkerVersionSetArray(t []*apiv1.WorkerVersionSet) []*types.WorkerVersionSet {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkerVersionSet, len(t))
+	for i := range t {
+		v[i] = ToWorkerVersionSet(t[i])
+	}
+	return v
+}
+
 func FromPendingChildExecutionInfoArray(t []*types.PendingChildExecutionInfo) []*apiv1.PendingChildExecutionInfo {
 	if t == nil {
 		return nil
@@ -5118,6 +5519,28 @@ func ToBadBinaryInfoMap(t map[string]*apiv1.BadBinaryInfo) map[string]*types.Bad
 	return v
 }
 
+func FromTaskListStatusMap(t map[string]*types.TaskListStatus) map[string]*apiv1.TaskListStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*apiv1.TaskListStatus, len(t))
+	for key := range t {
+		v[key] = FromTaskListStatus(t[key])
+	}
+	return v
+}
+
+func ToTaskListStatusMap(t map[string]*apiv1.TaskListStatus) map[string]*types.TaskListStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.TaskListStatus, len(t))
+	for key := range t {
+		v[key] = ToTaskListStatus(t[key])
+	}
+	return v
+}
+
 func FromIndexedValueTypeMap(t map[string]types.IndexedValueType) map[string]apiv1.IndexedValueType {
 	if t == nil {
 		return nil
@@ -5432,6 +5855,10 @@ func FromHistoryEvent(e *types.HistoryEvent) *apiv1.HistoryEvent {
 		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
 			UpsertWorkflowSearchAttributesEventAttributes: FromUpsertWorkflowSearchAttributesEventAttributes(e.UpsertWorkflowSearchAttributesEventAttributes),
 		}
+	case types.EventTypeUpsertWorkflowMemo:
+		event.Attributes = &apiv1.HistoryEvent_UpsertWorkflowMemoEventAttributes{
+			UpsertWorkflowMemoEventAttributes: FromUpsertWorkflowMemoEventAttributes(e.UpsertWorkflowMemoEventAttributes),
+		}
 	}
 	return &event
 }
@@ -5573,6 +6000,9 @@ func ToHistoryEvent(e *apiv1.HistoryEvent) *types.HistoryEvent {
 	case *apiv1.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes:
 		event.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		event.UpsertWorkflowSearchAttributesEventAttributes = ToUpsertWorkflowSearchAttributesEventAttributes(attr.UpsertWorkflowSearchAttributesEventAttributes)
+	case *apiv1.HistoryEvent_UpsertWorkflowMemoEventAttributes:
+		event.EventType = types.EventTypeUpsertWorkflowMemo.Ptr()
+		event.UpsertWorkflowMemoEventAttributes = ToUpsertWorkflowMemoEventAttributes(attr.UpsertWorkflowMemoEventAttributes)
 	}
 	return &event
 }
@@ -5635,6 +6065,10 @@ func FromDecision(d *types.Decision) *apiv1.Decision {
 		decision.Attributes = &apiv1.Decision_UpsertWorkflowSearchAttributesDecisionAttributes{
 			UpsertWorkflowSearchAttributesDecisionAttributes: FromUpsertWorkflowSearchAttributesDecisionAttributes(d.UpsertWorkflowSearchAttributesDecisionAttributes),
 		}
+	case types.DecisionTypeUpsertWorkflowMemo:
+		decision.Attributes = &apiv1.Decision_UpsertWorkflowMemoDecisionAttributes{
+			UpsertWorkflowMemoDecisionAttributes: FromUpsertWorkflowMemoDecisionAttributes(d.UpsertWorkflowMemoDecisionAttributes),
+		}
 	}
 	return &decision
 }
@@ -5684,6 +6118,9 @@ func ToDecision(d *apiv1.Decision) *types.Decision {
 	case *apiv1.Decision_UpsertWorkflowSearchAttributesDecisionAttributes:
 		decision.DecisionType = types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr()
 		decision.UpsertWorkflowSearchAttributesDecisionAttributes = ToUpsertWorkflowSearchAttributesDecisionAttributes(attr.UpsertWorkflowSearchAttributesDecisionAttributes)
+	case *apiv1.Decision_UpsertWorkflowMemoDecisionAttributes:
+		decision.DecisionType = types.DecisionTypeUpsertWorkflowMemo.Ptr()
+		decision.UpsertWorkflowMemoDecisionAttributes = ToUpsertWorkflowMemoDecisionAttributes(attr.UpsertWorkflowMemoDecisionAttributes)
 	}
 	return &decision
 }
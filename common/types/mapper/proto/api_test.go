@@ -660,11 +660,21 @@ func TestTaskListPartitionMetadata(t *testing.T) {
 		assert.Equal(t, item, ToTaskListPartitionMetadata(FromTaskListPartitionMetadata(item)))
 	}
 }
+func TestTaskListPartitionConfig(t *testing.T) {
+	for _, item := range []*types.TaskListPartitionConfig{nil, {}, &testdata.TaskListPartitionConfig} {
+		assert.Equal(t, item, ToTaskListPartitionConfig(FromTaskListPartitionConfig(item)))
+	}
+}
 func TestTaskListStatus(t *testing.T) {
 	for _, item := range []*types.TaskListStatus{nil, {}, &testdata.TaskListStatus} {
 		assert.Equal(t, item, ToTaskListStatus(FromTaskListStatus(item)))
 	}
 }
+func TestExpediteWorkflowExecutionStartRequest(t *testing.T) {
+	for _, item := range []*types.ExpediteWorkflowExecutionStartRequest{nil, {}, &testdata.ExpediteWorkflowExecutionStartRequest} {
+		assert.Equal(t, item, ToExpediteWorkflowExecutionStartRequest(FromExpediteWorkflowExecutionStartRequest(item)))
+	}
+}
 func TestTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.TerminateWorkflowExecutionRequest{nil, {}, &testdata.TerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToTerminateWorkflowExecutionRequest(FromTerminateWorkflowExecutionRequest(item)))
@@ -685,6 +695,21 @@ func TestTimerStartedEventAttributes(t *testing.T) {
 		assert.Equal(t, item, ToTimerStartedEventAttributes(FromTimerStartedEventAttributes(item)))
 	}
 }
+func TestUpdateWorkerBuildIDCompatibilityRequest(t *testing.T) {
+	for _, item := range []*types.UpdateWorkerBuildIDCompatibilityRequest{nil, {}, &testdata.UpdateWorkerBuildIDCompatibilityRequest} {
+		assert.Equal(t, item, ToUpdateWorkerBuildIDCompatibilityRequest(FromUpdateWorkerBuildIDCompatibilityRequest(item)))
+	}
+}
+func TestGetWorkerBuildIDCompatibilityRequest(t *testing.T) {
+	for _, item := range []*types.GetWorkerBuildIDCompatibilityRequest{nil, {}, &testdata.GetWorkerBuildIDCompatibilityRequest} {
+		assert.Equal(t, item, ToGetWorkerBuildIDCompatibilityRequest(FromGetWorkerBuildIDCompatibilityRequest(item)))
+	}
+}
+func TestGetWorkerBuildIDCompatibilityResponse(t *testing.T) {
+	for _, item := range []*types.GetWorkerBuildIDCompatibilityResponse{nil, {}, &testdata.GetWorkerBuildIDCompatibilityResponse} {
+		assert.Equal(t, item, ToGetWorkerBuildIDCompatibilityResponse(FromGetWorkerBuildIDCompatibilityResponse(item)))
+	}
+}
 func TestUpdateDomainRequest(t *testing.T) {
 	for _, item := range []*types.UpdateDomainRequest{nil, {EmitMetric: common.BoolPtr(true)}, &testdata.UpdateDomainRequest} {
 		assert.Equal(t, item, ToUpdateDomainRequest(FromUpdateDomainRequest(item)))
@@ -705,6 +730,16 @@ func TestUpsertWorkflowSearchAttributesEventAttributes(t *testing.T) {
 		assert.Equal(t, item, ToUpsertWorkflowSearchAttributesEventAttributes(FromUpsertWorkflowSearchAttributesEventAttributes(item)))
 	}
 }
+func TestUpsertWorkflowMemoDecisionAttributes(t *testing.T) {
+	for _, item := range []*types.UpsertWorkflowMemoDecisionAttributes{nil, {}, &testdata.UpsertWorkflowMemoDecisionAttributes} {
+		assert.Equal(t, item, ToUpsertWorkflowMemoDecisionAttributes(FromUpsertWorkflowMemoDecisionAttributes(item)))
+	}
+}
+func TestUpsertWorkflowMemoEventAttributes(t *testing.T) {
+	for _, item := range []*types.UpsertWorkflowMemoEventAttributes{nil, {}, &testdata.UpsertWorkflowMemoEventAttributes} {
+		assert.Equal(t, item, ToUpsertWorkflowMemoEventAttributes(FromUpsertWorkflowMemoEventAttributes(item)))
+	}
+}
 func TestWorkerVersionInfo(t *testing.T) {
 	for _, item := range []*types.WorkerVersionInfo{nil, {}, &testdata.WorkerVersionInfo} {
 		assert.Equal(t, item, ToWorkerVersionInfo(FromWorkerVersionInfo(item)))
@@ -889,6 +924,11 @@ func TestBadBinaryInfoMap(t *testing.T) {
 		assert.Equal(t, item, ToBadBinaryInfoMap(FromBadBinaryInfoMap(item)))
 	}
 }
+func TestTaskListStatusMap(t *testing.T) {
+	for _, item := range []map[string]*types.TaskListStatus{nil, {}, testdata.TaskListStatusMap} {
+		assert.Equal(t, item, ToTaskListStatusMap(FromTaskListStatusMap(item)))
+	}
+}
 func TestIndexedValueTypeMap(t *testing.T) {
 	for _, item := range []map[string]types.IndexedValueType{nil, {}, testdata.IndexedValueTypeMap} {
 		assert.Equal(t, item, ToIndexedValueTypeMap(FromIndexedValueTypeMap(item)))
@@ -971,6 +1011,7 @@ func TestHistoryEvent(t *testing.T) {
 		&testdata.HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&testdata.HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&testdata.HistoryEvent_UpsertWorkflowSearchAttributes,
+		&testdata.HistoryEvent_UpsertWorkflowMemo,
 	} {
 		assert.Equal(t, item, ToHistoryEvent(FromHistoryEvent(item)))
 	}
@@ -992,6 +1033,7 @@ func TestDecision(t *testing.T) {
 		&testdata.Decision_StartChildWorkflowExecution,
 		&testdata.Decision_StartTimer,
 		&testdata.Decision_UpsertWorkflowSearchAttributes,
+		&testdata.Decision_UpsertWorkflowMemo,
 	} {
 		assert.Equal(t, item, ToDecision(FromDecision(item)))
 	}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	adminv1 "github.com/uber/cadence/.gen/proto/admin/v1"
 	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	sharedv1 "github.com/uber/cadence/.gen/proto/shared/v1"
 	"github.com/uber/cadence/common"
@@ -56,6 +57,18 @@ func TestDLQType(t *testing.T) {
 	assert.Panics(t, func() { ToDLQType(sharedv1.DLQType(UnknownValue)) })
 	assert.Panics(t, func() { FromDLQType(types.DLQType(UnknownValue).Ptr()) })
 }
+func TestWorkflowBuildCheckState(t *testing.T) {
+	for _, item := range []*types.WorkflowBuildCheckState{
+		nil,
+		types.WorkflowBuildCheckStateRunning.Ptr(),
+		types.WorkflowBuildCheckStatePassed.Ptr(),
+		types.WorkflowBuildCheckStateFailed.Ptr(),
+	} {
+		assert.Equal(t, item, ToWorkflowBuildCheckState(FromWorkflowBuildCheckState(item)))
+	}
+	assert.Panics(t, func() { ToWorkflowBuildCheckState(adminv1.WorkflowBuildCheckState(UnknownValue)) })
+	assert.Panics(t, func() { FromWorkflowBuildCheckState(types.WorkflowBuildCheckState(UnknownValue).Ptr()) })
+}
 func TestDomainOperation(t *testing.T) {
 	for _, item := range []*types.DomainOperation{
 		nil,
@@ -158,6 +171,7 @@ func TestDecisionTaskFailedCause(t *testing.T) {
 		types.DecisionTaskFailedCauseBadBinary.Ptr(),
 		types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr(),
 		types.DecisionTaskFailedCauseBadSearchAttributes.Ptr(),
+		types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes.Ptr(),
 	} {
 		assert.Equal(t, item, ToDecisionTaskFailedCause(FromDecisionTaskFailedCause(item)))
 	}
@@ -292,6 +306,18 @@ func TestQueryTaskCompletedType(t *testing.T) {
 	assert.Panics(t, func() { ToQueryTaskCompletedType(apiv1.QueryResultType(UnknownValue)) })
 	assert.Panics(t, func() { FromQueryTaskCompletedType(types.QueryTaskCompletedType(UnknownValue).Ptr()) })
 }
+func TestRetryJitterMode(t *testing.T) {
+	for _, item := range []types.RetryJitterMode{
+		types.RetryJitterModeNone,
+		types.RetryJitterModeFull,
+		types.RetryJitterModeEqual,
+	} {
+		assert.Equal(t, item, ToRetryJitterMode(FromRetryJitterMode(item)))
+	}
+	assert.Equal(t, types.RetryJitterModeNone, ToRetryJitterMode(apiv1.RetryJitterMode_RETRY_JITTER_MODE_INVALID))
+	assert.Panics(t, func() { ToRetryJitterMode(apiv1.RetryJitterMode(UnknownValue)) })
+	assert.Panics(t, func() { FromRetryJitterMode(types.RetryJitterMode(UnknownValue)) })
+}
 func TestSignalExternalWorkflowExecutionFailedCause(t *testing.T) {
 	for _, item := range []*types.SignalExternalWorkflowExecutionFailedCause{
 		nil,
@@ -367,6 +393,18 @@ func TestWorkflowExecutionCloseStatus(t *testing.T) {
 	assert.Panics(t, func() { ToWorkflowExecutionCloseStatus(apiv1.WorkflowExecutionCloseStatus(UnknownValue)) })
 	assert.Panics(t, func() { FromWorkflowExecutionCloseStatus(types.WorkflowExecutionCloseStatus(UnknownValue).Ptr()) })
 }
+func TestWorkflowIDConflictPolicy(t *testing.T) {
+	for _, item := range []*types.WorkflowIDConflictPolicy{
+		nil,
+		types.WorkflowIDConflictPolicyFail.Ptr(),
+		types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+		types.WorkflowIDConflictPolicyTerminateExisting.Ptr(),
+	} {
+		assert.Equal(t, item, ToWorkflowIDConflictPolicy(FromWorkflowIDConflictPolicy(item)))
+	}
+	assert.Panics(t, func() { ToWorkflowIDConflictPolicy(apiv1.WorkflowIdConflictPolicy(UnknownValue)) })
+	assert.Panics(t, func() { FromWorkflowIDConflictPolicy(types.WorkflowIDConflictPolicy(UnknownValue).Ptr()) })
+}
 func TestWorkflowIDReusePolicy(t *testing.T) {
 	for _, item := range []*types.WorkflowIDReusePolicy{
 		nil,
@@ -99,7 +99,9 @@ func FromError(err error) error {
 	case *types.LimitExceededError:
 		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.LimitExceededError{}))
 	case *types.ServiceBusyError:
-		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.ServiceBusyError{}))
+		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.ServiceBusyError{
+			RetryAfter: millisecondsToDuration(e.RetryAfterMilliseconds),
+		}))
 	case *types.RemoteSyncMatchedError:
 		return protobuf.NewError(yarpcerrors.CodeUnavailable, e.Message, protobuf.WithErrorDetails(&sharedv1.RemoteSyncMatchedError{}))
 	}
@@ -216,14 +218,15 @@ func ToError(err error) error {
 			}
 		}
 	case yarpcerrors.CodeResourceExhausted:
-		switch getErrorDetails(err).(type) {
+		switch details := getErrorDetails(err).(type) {
 		case *apiv1.LimitExceededError:
 			return &types.LimitExceededError{
 				Message: status.Message(),
 			}
 		case *apiv1.ServiceBusyError:
 			return &types.ServiceBusyError{
-				Message: status.Message(),
+				Message:                status.Message(),
+				RetryAfterMilliseconds: durationToMilliseconds(details.RetryAfter),
 			}
 		}
 	case yarpcerrors.CodeUnavailable:
@@ -57,6 +57,34 @@ func toInt64Value(v *gogo.Int64Value) *int64 {
 	return common.Int64Ptr(v.Value)
 }
 
+func fromBoolValue(v *bool) *gogo.BoolValue {
+	if v == nil {
+		return nil
+	}
+	return &gogo.BoolValue{Value: *v}
+}
+
+func toBoolValue(v *gogo.BoolValue) *bool {
+	if v == nil {
+		return nil
+	}
+	return common.BoolPtr(v.Value)
+}
+
+func fromStringValue(v *string) *gogo.StringValue {
+	if v == nil {
+		return nil
+	}
+	return &gogo.StringValue{Value: *v}
+}
+
+func toStringValue(v *gogo.StringValue) *string {
+	if v == nil {
+		return nil
+	}
+	return common.StringPtr(v.Value)
+}
+
 func unixNanoToTime(t *int64) *gogo.Timestamp {
 	if t == nil {
 		return nil
@@ -79,6 +107,17 @@ func timeToUnixNano(t *gogo.Timestamp) *int64 {
 	return common.Int64Ptr(timestamp.UnixNano())
 }
 
+func unixNanoToTimeValue(t int64) *gogo.Timestamp {
+	if t == 0 {
+		return nil
+	}
+	return unixNanoToTime(&t)
+}
+
+func timeToUnixNanoValue(t *gogo.Timestamp) int64 {
+	return common.Int64Default(timeToUnixNano(t))
+}
+
 func daysToDuration(d *int32) *gogo.Duration {
 	if d == nil {
 		return nil
@@ -115,6 +154,46 @@ func durationToSeconds(d *gogo.Duration) *int32 {
 	return common.Int32Ptr(int32(common.DurationToSeconds(duration)))
 }
 
+func secondsArrayToDurations(d []int32) []*gogo.Duration {
+	if d == nil {
+		return nil
+	}
+	v := make([]*gogo.Duration, len(d))
+	for i := range d {
+		v[i] = secondsToDuration(&d[i])
+	}
+	return v
+}
+
+func durationsToSecondsArray(d []*gogo.Duration) []int32 {
+	if d == nil {
+		return nil
+	}
+	v := make([]int32, len(d))
+	for i := range d {
+		v[i] = common.Int32Default(durationToSeconds(d[i]))
+	}
+	return v
+}
+
+func millisecondsToDuration(ms int64) *gogo.Duration {
+	if ms == 0 {
+		return nil
+	}
+	return gogo.DurationProto(time.Duration(ms) * time.Millisecond)
+}
+
+func durationToMilliseconds(d *gogo.Duration) int64 {
+	if d == nil {
+		return 0
+	}
+	duration, err := gogo.DurationFromProto(d)
+	if err != nil {
+		panic(err)
+	}
+	return int64(duration / time.Millisecond)
+}
+
 func int32To64(v *int32) *int64 {
 	if v == nil {
 		return nil
@@ -66,11 +66,12 @@ func FromHistoryDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse
 		return nil
 	}
 	return &historyv1.DescribeHistoryHostResponse{
-		NumberOfShards:        t.NumberOfShards,
-		ShardIds:              t.ShardIDs,
-		DomainCache:           FromDomainCacheInfo(t.DomainCache),
-		ShardControllerStatus: t.ShardControllerStatus,
-		Address:               t.Address,
+		NumberOfShards:             t.NumberOfShards,
+		ShardIds:                   t.ShardIDs,
+		DomainCache:                FromDomainCacheInfo(t.DomainCache),
+		ShardControllerStatus:      t.ShardControllerStatus,
+		Address:                    t.Address,
+		PersistenceCircuitBreakers: FromPersistenceCircuitBreakerInfoArray(t.PersistenceCircuitBreakers),
 	}
 }
 
@@ -79,11 +80,12 @@ func ToHistoryDescribeHistoryHostResponse(t *historyv1.DescribeHistoryHostRespon
 		return nil
 	}
 	return &types.DescribeHistoryHostResponse{
-		NumberOfShards:        t.NumberOfShards,
-		ShardIDs:              t.ShardIds,
-		DomainCache:           ToDomainCacheInfo(t.DomainCache),
-		ShardControllerStatus: t.ShardControllerStatus,
-		Address:               t.Address,
+		NumberOfShards:             t.NumberOfShards,
+		ShardIDs:                   t.ShardIds,
+		DomainCache:                ToDomainCacheInfo(t.DomainCache),
+		ShardControllerStatus:      t.ShardControllerStatus,
+		Address:                    t.Address,
+		PersistenceCircuitBreakers: ToPersistenceCircuitBreakerInfoArray(t.PersistenceCircuitBreakers),
 	}
 }
 
@@ -299,6 +301,7 @@ func FromHistoryGetMutableStateResponse(t *types.GetMutableStateResponse) *histo
 		WorkflowCloseState:                   FromWorkflowExecutionCloseStatus(workflowCloseState),
 		VersionHistories:                     FromVersionHistories(t.VersionHistories),
 		IsStickyTaskListEnabled:              t.IsStickyTaskListEnabled,
+		BuildId:                              t.BuildID,
 	}
 }
 
@@ -324,6 +327,7 @@ func ToHistoryGetMutableStateResponse(t *historyv1.GetMutableStateResponse) *typ
 		WorkflowCloseState:                   common.Int32Ptr(int32(persistence.FromInternalWorkflowExecutionCloseStatus(ToWorkflowExecutionCloseStatus(t.WorkflowCloseState)))),
 		VersionHistories:                     ToVersionHistories(t.VersionHistories),
 		IsStickyTaskListEnabled:              t.IsStickyTaskListEnabled,
+		BuildID:                              t.BuildId,
 		IsWorkflowRunning:                    t.WorkflowState == sharedv1.WorkflowState_WORKFLOW_STATE_RUNNING,
 	}
 }
@@ -1257,15 +1261,16 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		return nil
 	}
 	return &historyv1.StartWorkflowExecutionRequest{
-		Request:                  FromStartWorkflowExecutionRequest(t.StartRequest),
-		DomainId:                 t.DomainUUID,
-		ParentExecutionInfo:      FromParentExecutionInfo(t.ParentExecutionInfo),
-		Attempt:                  t.Attempt,
-		ExpirationTime:           unixNanoToTime(t.ExpirationTimestamp),
-		ContinueAsNewInitiator:   FromContinueAsNewInitiator(t.ContinueAsNewInitiator),
-		ContinuedFailure:         FromFailure(t.ContinuedFailureReason, t.ContinuedFailureDetails),
-		LastCompletionResult:     FromPayload(t.LastCompletionResult),
-		FirstDecisionTaskBackoff: secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
+		Request:                    FromStartWorkflowExecutionRequest(t.StartRequest),
+		DomainId:                   t.DomainUUID,
+		ParentExecutionInfo:        FromParentExecutionInfo(t.ParentExecutionInfo),
+		Attempt:                    t.Attempt,
+		ExpirationTime:             unixNanoToTime(t.ExpirationTimestamp),
+		ContinueAsNewInitiator:     FromContinueAsNewInitiator(t.ContinueAsNewInitiator),
+		ContinuedFailure:           FromFailure(t.ContinuedFailureReason, t.ContinuedFailureDetails),
+		LastCompletionResult:       FromPayload(t.LastCompletionResult),
+		FirstDecisionTaskBackoff:   secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
+		RetryElapsedExpirationTime: unixNanoToTime(t.RetryElapsedExpirationTimestamp),
 	}
 }
 
@@ -1284,6 +1289,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 		ContinuedFailureDetails:         ToFailureDetails(t.ContinuedFailure),
 		LastCompletionResult:            ToPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoffSeconds: durationToSeconds(t.FirstDecisionTaskBackoff),
+		RetryElapsedExpirationTimestamp: timeToUnixNano(t.RetryElapsedExpirationTime),
 	}
 }
 
@@ -1391,6 +1397,26 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *historyv1.TerminateWorkflowEx
 	}
 }
 
+func FromHistoryExpediteWorkflowExecutionStartRequest(t *types.HistoryExpediteWorkflowExecutionStartRequest) *historyv1.ExpediteWorkflowExecutionStartRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ExpediteWorkflowExecutionStartRequest{
+		Request:  FromExpediteWorkflowExecutionStartRequest(t.ExpediteRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryExpediteWorkflowExecutionStartRequest(t *historyv1.ExpediteWorkflowExecutionStartRequest) *types.HistoryExpediteWorkflowExecutionStartRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryExpediteWorkflowExecutionStartRequest{
+		ExpediteRequest: ToExpediteWorkflowExecutionStartRequest(t.Request),
+		DomainUUID:      t.DomainId,
+	}
+}
+
 // FromHistoryGetCrossClusterTasksRequest converts internal GetCrossClusterTasksRequest type to proto
 func FromHistoryGetCrossClusterTasksRequest(t *types.GetCrossClusterTasksRequest) *historyv1.GetCrossClusterTasksRequest {
 	if t == nil {
@@ -312,6 +312,11 @@ func TestHistorySyncShardStatusRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistorySyncShardStatusRequest(FromHistorySyncShardStatusRequest(item)))
 	}
 }
+func TestHistoryExpediteWorkflowExecutionStartRequest(t *testing.T) {
+	for _, item := range []*types.HistoryExpediteWorkflowExecutionStartRequest{nil, {}, &testdata.HistoryExpediteWorkflowExecutionStartRequest} {
+		assert.Equal(t, item, ToHistoryExpediteWorkflowExecutionStartRequest(FromHistoryExpediteWorkflowExecutionStartRequest(item)))
+	}
+}
 func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryTerminateWorkflowExecutionRequest{nil, {}, &testdata.HistoryTerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryTerminateWorkflowExecutionRequest(FromHistoryTerminateWorkflowExecutionRequest(item)))
@@ -39,6 +39,12 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
+		Priority:               t.Priority,
+		FairnessKey:            t.FairnessKey,
+		BuildId:                t.BuildID,
+		Attempt:                t.Attempt,
+		LastHeartbeatTime:      unixNanoToTime(t.LastHeartbeatTimestamp),
+		IsolationGroup:         t.IsolationGroup,
 	}
 }
 
@@ -55,6 +61,12 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
+		BuildID:                       t.BuildId,
+		Attempt:                       t.Attempt,
+		LastHeartbeatTimestamp:        timeToUnixNano(t.LastHeartbeatTime),
+		IsolationGroup:                t.IsolationGroup,
 	}
 }
 
@@ -70,6 +82,11 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
+		Priority:               t.Priority,
+		FairnessKey:            t.FairnessKey,
+		BuildId:                t.BuildID,
+		Attempt:                t.Attempt,
+		IsolationGroup:         t.IsolationGroup,
 	}
 }
 
@@ -85,6 +102,11 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
+		BuildID:                       t.BuildId,
+		Attempt:                       t.Attempt,
+		IsolationGroup:                t.IsolationGroup,
 	}
 }
 
@@ -145,8 +167,11 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:        FromPollerInfoArray(t.Pollers),
-		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+		Pollers:          FromPollerInfoArray(t.Pollers),
+		TaskListStatus:   FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  FromTaskListPartitionConfig(t.PartitionConfig),
+		PollersByBuildId: t.PollersByBuildID,
+		PartitionStatus:  FromTaskListStatusMap(t.PartitionStatus),
 	}
 }
 
@@ -155,8 +180,11 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:        ToPollerInfoArray(t.Pollers),
-		TaskListStatus: ToTaskListStatus(t.TaskListStatus),
+		Pollers:          ToPollerInfoArray(t.Pollers),
+		TaskListStatus:   ToTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  ToTaskListPartitionConfig(t.PartitionConfig),
+		PollersByBuildID: t.PollersByBuildId,
+		PartitionStatus:  ToTaskListStatusMap(t.PartitionStatus),
 	}
 }
 
@@ -180,13 +208,141 @@ func ToMatchingListTaskListPartitionsRequest(t *matchingv1.ListTaskListPartition
 	}
 }
 
+func FromMatchingUpdateWorkerBuildIDCompatibilityRequest(t *types.MatchingUpdateWorkerBuildIDCompatibilityRequest) *matchingv1.UpdateWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.UpdateWorkerBuildIDCompatibilityRequest{
+		Request:  FromUpdateWorkerBuildIDCompatibilityRequest(t.UpdateRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToMatchingUpdateWorkerBuildIDCompatibilityRequest(t *matchingv1.UpdateWorkerBuildIDCompatibilityRequest) *types.MatchingUpdateWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+		UpdateRequest: ToUpdateWorkerBuildIDCompatibilityRequest(t.Request),
+		DomainUUID:    t.DomainId,
+	}
+}
+
+func FromMatchingGetWorkerBuildIDCompatibilityRequest(t *types.MatchingGetWorkerBuildIDCompatibilityRequest) *matchingv1.GetWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetWorkerBuildIDCompatibilityRequest{
+		Request:  FromGetWorkerBuildIDCompatibilityRequest(t.GetRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToMatchingGetWorkerBuildIDCompatibilityRequest(t *matchingv1.GetWorkerBuildIDCompatibilityRequest) *types.MatchingGetWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetWorkerBuildIDCompatibilityRequest{
+		GetRequest: ToGetWorkerBuildIDCompatibilityRequest(t.Request),
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromMatchingGetWorkerBuildIDCompatibilityResponse(t *types.GetWorkerBuildIDCompatibilityResponse) *matchingv1.GetWorkerBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetWorkerBuildIDCompatibilityResponse{
+		VersionSets: FromWorkerVersionSetArray(t.VersionSets),
+	}
+}
+
+func ToMatchingGetWorkerBuildIDCompatibilityResponse(t *matchingv1.GetWorkerBuildIDCompatibilityResponse) *types.GetWorkerBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetWorkerBuildIDCompatibilityResponse{
+		VersionSets: ToWorkerVersionSetArray(t.VersionSets),
+	}
+}
+
+func FromMatchingUpdateTaskListStateRequest(t *types.MatchingUpdateTaskListStateRequest) *matchingv1.UpdateTaskListStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.UpdateTaskListStateRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Paused:       fromBoolValue(t.Paused),
+		MigrateTo:    fromStringValue(t.MigrateTo),
+	}
+}
+
+func ToMatchingUpdateTaskListStateRequest(t *matchingv1.UpdateTaskListStateRequest) *types.MatchingUpdateTaskListStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateTaskListStateRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Paused:       toBoolValue(t.Paused),
+		MigrateTo:    toStringValue(t.MigrateTo),
+	}
+}
+
+func FromMatchingGetTaskListStateRequest(t *types.MatchingGetTaskListStateRequest) *matchingv1.GetTaskListStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListStateRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToMatchingGetTaskListStateRequest(t *matchingv1.GetTaskListStateRequest) *types.MatchingGetTaskListStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListStateRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromMatchingGetTaskListStateResponse(t *types.TaskListState) *matchingv1.GetTaskListStateResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListStateResponse{
+		Paused:    t.Paused,
+		MigrateTo: t.MigrateTo,
+	}
+}
+
+func ToMatchingGetTaskListStateResponse(t *matchingv1.GetTaskListStateResponse) *types.TaskListState {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListState{
+		Paused:    t.Paused,
+		MigrateTo: t.MigrateTo,
+	}
+}
+
 func FromMatchingListTaskListPartitionsResponse(t *types.ListTaskListPartitionsResponse) *matchingv1.ListTaskListPartitionsResponse {
 	if t == nil {
 		return nil
 	}
 	return &matchingv1.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: FromTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
-		DecisionTaskListPartitions: FromTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitions:      FromTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
+		DecisionTaskListPartitions:      FromTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitionConfig: FromTaskListPartitionConfig(t.ActivityTaskListPartitionConfig),
+		DecisionTaskListPartitionConfig: FromTaskListPartitionConfig(t.DecisionTaskListPartitionConfig),
 	}
 }
 
@@ -195,8 +351,10 @@ func ToMatchingListTaskListPartitionsResponse(t *matchingv1.ListTaskListPartitio
 		return nil
 	}
 	return &types.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: ToTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
-		DecisionTaskListPartitions: ToTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitions:      ToTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
+		DecisionTaskListPartitions:      ToTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitionConfig: ToTaskListPartitionConfig(t.ActivityTaskListPartitionConfig),
+		DecisionTaskListPartitionConfig: ToTaskListPartitionConfig(t.DecisionTaskListPartitionConfig),
 	}
 }
 
@@ -391,6 +549,7 @@ func FromMatchingQueryWorkflowRequest(t *types.MatchingQueryWorkflowRequest) *ma
 		DomainId:      t.DomainUUID,
 		TaskList:      FromTaskList(t.TaskList),
 		ForwardedFrom: t.ForwardedFrom,
+		BuildId:       t.BuildID,
 	}
 }
 
@@ -403,6 +562,7 @@ func ToMatchingQueryWorkflowRequest(t *matchingv1.QueryWorkflowRequest) *types.M
 		DomainUUID:    t.DomainId,
 		TaskList:      ToTaskList(t.TaskList),
 		ForwardedFrom: t.ForwardedFrom,
+		BuildID:       t.BuildId,
 	}
 }
 
@@ -124,3 +124,39 @@ func TestMatchingGetTaskListsByDomainResponse(t *testing.T) {
 		assert.Equal(t, item, ToMatchingGetTaskListsByDomainResponse(FromMatchingGetTaskListsByDomainResponse(item)))
 	}
 }
+
+func TestMatchingUpdateWorkerBuildIDCompatibilityRequest(t *testing.T) {
+	for _, item := range []*types.MatchingUpdateWorkerBuildIDCompatibilityRequest{nil, {}, &testdata.MatchingUpdateWorkerBuildIDCompatibilityRequest} {
+		assert.Equal(t, item, ToMatchingUpdateWorkerBuildIDCompatibilityRequest(FromMatchingUpdateWorkerBuildIDCompatibilityRequest(item)))
+	}
+}
+
+func TestMatchingGetWorkerBuildIDCompatibilityRequest(t *testing.T) {
+	for _, item := range []*types.MatchingGetWorkerBuildIDCompatibilityRequest{nil, {}, &testdata.MatchingGetWorkerBuildIDCompatibilityRequest} {
+		assert.Equal(t, item, ToMatchingGetWorkerBuildIDCompatibilityRequest(FromMatchingGetWorkerBuildIDCompatibilityRequest(item)))
+	}
+}
+
+func TestMatchingGetWorkerBuildIDCompatibilityResponse(t *testing.T) {
+	for _, item := range []*types.GetWorkerBuildIDCompatibilityResponse{nil, {}, &testdata.MatchingGetWorkerBuildIDCompatibilityResponse} {
+		assert.Equal(t, item, ToMatchingGetWorkerBuildIDCompatibilityResponse(FromMatchingGetWorkerBuildIDCompatibilityResponse(item)))
+	}
+}
+
+func TestMatchingUpdateTaskListStateRequest(t *testing.T) {
+	for _, item := range []*types.MatchingUpdateTaskListStateRequest{nil, {}, &testdata.MatchingUpdateTaskListStateRequest} {
+		assert.Equal(t, item, ToMatchingUpdateTaskListStateRequest(FromMatchingUpdateTaskListStateRequest(item)))
+	}
+}
+
+func TestMatchingGetTaskListStateRequest(t *testing.T) {
+	for _, item := range []*types.MatchingGetTaskListStateRequest{nil, {}, &testdata.MatchingGetTaskListStateRequest} {
+		assert.Equal(t, item, ToMatchingGetTaskListStateRequest(FromMatchingGetTaskListStateRequest(item)))
+	}
+}
+
+func TestMatchingGetTaskListStateResponse(t *testing.T) {
+	for _, item := range []*types.TaskListState{nil, {}, &testdata.MatchingTaskListState} {
+		assert.Equal(t, item, ToMatchingGetTaskListStateResponse(FromMatchingGetTaskListStateResponse(item)))
+	}
+}
@@ -219,6 +219,52 @@ func ToDomainCacheInfo(t *sharedv1.DomainCacheInfo) *types.DomainCacheInfo {
 	}
 }
 
+func FromPersistenceCircuitBreakerInfo(t *types.PersistenceCircuitBreakerInfo) *sharedv1.PersistenceCircuitBreakerInfo {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.PersistenceCircuitBreakerInfo{
+		StoreName:           t.StoreName,
+		OperationClass:      t.OperationClass,
+		State:               t.State,
+		LastStateChangeTime: unixNanoToTime(&t.LastStateChangeTimestamp),
+	}
+}
+
+func ToPersistenceCircuitBreakerInfo(t *sharedv1.PersistenceCircuitBreakerInfo) *types.PersistenceCircuitBreakerInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.PersistenceCircuitBreakerInfo{
+		StoreName:                t.StoreName,
+		OperationClass:           t.OperationClass,
+		State:                    t.State,
+		LastStateChangeTimestamp: common.Int64Default(timeToUnixNano(t.LastStateChangeTime)),
+	}
+}
+
+func FromPersistenceCircuitBreakerInfoArray(t []*types.PersistenceCircuitBreakerInfo) []*sharedv1.PersistenceCircuitBreakerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.PersistenceCircuitBreakerInfo, len(t))
+	for i := range t {
+		v[i] = FromPersistenceCircuitBreakerInfo(t[i])
+	}
+	return v
+}
+
+func ToPersistenceCircuitBreakerInfoArray(t []*sharedv1.PersistenceCircuitBreakerInfo) []*types.PersistenceCircuitBreakerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.PersistenceCircuitBreakerInfo, len(t))
+	for i := range t {
+		v[i] = ToPersistenceCircuitBreakerInfo(t[i])
+	}
+	return v
+}
+
 func FromRingInfo(t *types.RingInfo) *sharedv1.RingInfo {
 	if t == nil {
 		return nil
@@ -467,3 +467,895 @@ func ToHostInfoArray(t []*admin.HostInfo) []*types.HostInfo {
 	}
 	return v
 }
+
+// FromDynamicConfigFilter converts internal DynamicConfigFilter type to thrift
+func FromDynamicConfigFilter(t *types.DynamicConfigFilter) *admin.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	return &admin.DynamicConfigFilter{
+		Name:  &t.Name,
+		Value: FromDataBlob(t.Value),
+	}
+}
+
+// ToDynamicConfigFilter converts thrift DynamicConfigFilter type to internal
+func ToDynamicConfigFilter(t *admin.DynamicConfigFilter) *types.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigFilter{
+		Name:  t.GetName(),
+		Value: ToDataBlob(t.Value),
+	}
+}
+
+// FromDynamicConfigFilterArray converts internal DynamicConfigFilter type array to thrift
+func FromDynamicConfigFilterArray(t []*types.DynamicConfigFilter) []*admin.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.DynamicConfigFilter, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigFilter(t[i])
+	}
+	return v
+}
+
+// ToDynamicConfigFilterArray converts thrift DynamicConfigFilter type array to internal
+func ToDynamicConfigFilterArray(t []*admin.DynamicConfigFilter) []*types.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigFilter, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigFilter(t[i])
+	}
+	return v
+}
+
+// FromDynamicConfigChange converts internal DynamicConfigChange type to thrift
+func FromDynamicConfigChange(t *types.DynamicConfigChange) *admin.DynamicConfigChange {
+	if t == nil {
+		return nil
+	}
+	return &admin.DynamicConfigChange{
+		ID:             &t.ID,
+		ConfigName:     &t.ConfigName,
+		Filters:        FromDynamicConfigFilterArray(t.Filters),
+		Value:          FromDataBlob(t.Value),
+		Deleted:        &t.Deleted,
+		Author:         &t.Author,
+		Reason:         &t.Reason,
+		TimestampNano:  &t.TimestampNano,
+		ActiveFromNano: &t.ActiveFromNano,
+		ExpiresAtNano:  &t.ExpiresAtNano,
+	}
+}
+
+// ToDynamicConfigChange converts thrift DynamicConfigChange type to internal
+func ToDynamicConfigChange(t *admin.DynamicConfigChange) *types.DynamicConfigChange {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigChange{
+		ID:             t.GetID(),
+		ConfigName:     t.GetConfigName(),
+		Filters:        ToDynamicConfigFilterArray(t.Filters),
+		Value:          ToDataBlob(t.Value),
+		Deleted:        t.GetDeleted(),
+		Author:         t.GetAuthor(),
+		Reason:         t.GetReason(),
+		TimestampNano:  t.GetTimestampNano(),
+		ActiveFromNano: t.GetActiveFromNano(),
+		ExpiresAtNano:  t.GetExpiresAtNano(),
+	}
+}
+
+// FromDynamicConfigChangeArray converts internal DynamicConfigChange type array to thrift
+func FromDynamicConfigChangeArray(t []*types.DynamicConfigChange) []*admin.DynamicConfigChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.DynamicConfigChange, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigChange(t[i])
+	}
+	return v
+}
+
+// ToDynamicConfigChangeArray converts thrift DynamicConfigChange type array to internal
+func ToDynamicConfigChangeArray(t []*admin.DynamicConfigChange) []*types.DynamicConfigChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigChange, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigChange(t[i])
+	}
+	return v
+}
+
+// FromDynamicConfigValue converts internal DynamicConfigValue type to thrift
+func FromDynamicConfigValue(t *types.DynamicConfigValue) *admin.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	return &admin.DynamicConfigValue{
+		Filters:    FromDynamicConfigFilterArray(t.Filters),
+		Value:      FromDataBlob(t.Value),
+		LastChange: FromDynamicConfigChange(t.LastChange),
+	}
+}
+
+// ToDynamicConfigValue converts thrift DynamicConfigValue type to internal
+func ToDynamicConfigValue(t *admin.DynamicConfigValue) *types.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigValue{
+		Filters:    ToDynamicConfigFilterArray(t.Filters),
+		Value:      ToDataBlob(t.Value),
+		LastChange: ToDynamicConfigChange(t.LastChange),
+	}
+}
+
+// FromDynamicConfigValueArray converts internal DynamicConfigValue type array to thrift
+func FromDynamicConfigValueArray(t []*types.DynamicConfigValue) []*admin.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.DynamicConfigValue, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigValue(t[i])
+	}
+	return v
+}
+
+// ToDynamicConfigValueArray converts thrift DynamicConfigValue type array to internal
+func ToDynamicConfigValueArray(t []*admin.DynamicConfigValue) []*types.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigValue, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigValue(t[i])
+	}
+	return v
+}
+
+// FromDynamicConfigEntry converts internal DynamicConfigEntry type to thrift
+func FromDynamicConfigEntry(t *types.DynamicConfigEntry) *admin.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	return &admin.DynamicConfigEntry{
+		ConfigName: &t.ConfigName,
+		Values:     FromDynamicConfigValueArray(t.Values),
+	}
+}
+
+// ToDynamicConfigEntry converts thrift DynamicConfigEntry type to internal
+func ToDynamicConfigEntry(t *admin.DynamicConfigEntry) *types.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigEntry{
+		ConfigName: t.GetConfigName(),
+		Values:     ToDynamicConfigValueArray(t.Values),
+	}
+}
+
+// FromDynamicConfigEntryArray converts internal DynamicConfigEntry type array to thrift
+func FromDynamicConfigEntryArray(t []*types.DynamicConfigEntry) []*admin.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.DynamicConfigEntry, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigEntry(t[i])
+	}
+	return v
+}
+
+// ToDynamicConfigEntryArray converts thrift DynamicConfigEntry type array to internal
+func ToDynamicConfigEntryArray(t []*admin.DynamicConfigEntry) []*types.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigEntry, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigEntry(t[i])
+	}
+	return v
+}
+
+// FromGetDynamicConfigRequest converts internal GetDynamicConfigRequest type to thrift
+func FromGetDynamicConfigRequest(t *types.GetDynamicConfigRequest) *admin.GetDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetDynamicConfigRequest{
+		ConfigName: &t.ConfigName,
+		Filters:    FromDynamicConfigFilterArray(t.Filters),
+	}
+}
+
+// ToGetDynamicConfigRequest converts thrift GetDynamicConfigRequest type to internal
+func ToGetDynamicConfigRequest(t *admin.GetDynamicConfigRequest) *types.GetDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDynamicConfigRequest{
+		ConfigName: t.GetConfigName(),
+		Filters:    ToDynamicConfigFilterArray(t.Filters),
+	}
+}
+
+// FromGetDynamicConfigResponse converts internal GetDynamicConfigResponse type to thrift
+func FromGetDynamicConfigResponse(t *types.GetDynamicConfigResponse) *admin.GetDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetDynamicConfigResponse{
+		Value: FromDataBlob(t.Value),
+	}
+}
+
+// ToGetDynamicConfigResponse converts thrift GetDynamicConfigResponse type to internal
+func ToGetDynamicConfigResponse(t *admin.GetDynamicConfigResponse) *types.GetDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDynamicConfigResponse{
+		Value: ToDataBlob(t.Value),
+	}
+}
+
+// FromUpdateDynamicConfigRequest converts internal UpdateDynamicConfigRequest type to thrift
+func FromUpdateDynamicConfigRequest(t *types.UpdateDynamicConfigRequest) *admin.UpdateDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateDynamicConfigRequest{
+		ConfigName:     &t.ConfigName,
+		Filters:        FromDynamicConfigFilterArray(t.Filters),
+		Value:          FromDataBlob(t.Value),
+		Reason:         &t.Reason,
+		ActiveFromNano: &t.ActiveFromNano,
+		ExpiresAtNano:  &t.ExpiresAtNano,
+	}
+}
+
+// ToUpdateDynamicConfigRequest converts thrift UpdateDynamicConfigRequest type to internal
+func ToUpdateDynamicConfigRequest(t *admin.UpdateDynamicConfigRequest) *types.UpdateDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateDynamicConfigRequest{
+		ConfigName:     t.GetConfigName(),
+		Filters:        ToDynamicConfigFilterArray(t.Filters),
+		Value:          ToDataBlob(t.Value),
+		Reason:         t.GetReason(),
+		ActiveFromNano: t.GetActiveFromNano(),
+		ExpiresAtNano:  t.GetExpiresAtNano(),
+	}
+}
+
+// FromRestoreDynamicConfigRequest converts internal RestoreDynamicConfigRequest type to thrift
+func FromRestoreDynamicConfigRequest(t *types.RestoreDynamicConfigRequest) *admin.RestoreDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RestoreDynamicConfigRequest{
+		ConfigName: &t.ConfigName,
+		Filters:    FromDynamicConfigFilterArray(t.Filters),
+		Reason:     &t.Reason,
+	}
+}
+
+// ToRestoreDynamicConfigRequest converts thrift RestoreDynamicConfigRequest type to internal
+func ToRestoreDynamicConfigRequest(t *admin.RestoreDynamicConfigRequest) *types.RestoreDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RestoreDynamicConfigRequest{
+		ConfigName: t.GetConfigName(),
+		Filters:    ToDynamicConfigFilterArray(t.Filters),
+		Reason:     t.GetReason(),
+	}
+}
+
+// FromListDynamicConfigRequest converts internal ListDynamicConfigRequest type to thrift
+func FromListDynamicConfigRequest(t *types.ListDynamicConfigRequest) *admin.ListDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListDynamicConfigRequest{
+		ConfigName:     &t.ConfigName,
+		IncludeHistory: &t.IncludeHistory,
+	}
+}
+
+// ToListDynamicConfigRequest converts thrift ListDynamicConfigRequest type to internal
+func ToListDynamicConfigRequest(t *admin.ListDynamicConfigRequest) *types.ListDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigRequest{
+		ConfigName:     t.GetConfigName(),
+		IncludeHistory: t.GetIncludeHistory(),
+	}
+}
+
+// FromListDynamicConfigResponse converts internal ListDynamicConfigResponse type to thrift
+func FromListDynamicConfigResponse(t *types.ListDynamicConfigResponse) *admin.ListDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListDynamicConfigResponse{
+		Entries: FromDynamicConfigEntryArray(t.Entries),
+		History: FromDynamicConfigChangeArray(t.History),
+	}
+}
+
+// ToListDynamicConfigResponse converts thrift ListDynamicConfigResponse type to internal
+func ToListDynamicConfigResponse(t *admin.ListDynamicConfigResponse) *types.ListDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigResponse{
+		Entries: ToDynamicConfigEntryArray(t.Entries),
+		History: ToDynamicConfigChangeArray(t.History),
+	}
+}
+
+// FromPauseTaskListRequest converts internal PauseTaskListRequest type to thrift
+func FromPauseTaskListRequest(t *types.PauseTaskListRequest) *admin.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.PauseTaskListRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Reason:       &t.Reason,
+	}
+}
+
+// ToPauseTaskListRequest converts thrift PauseTaskListRequest type to internal
+func ToPauseTaskListRequest(t *admin.PauseTaskListRequest) *types.PauseTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseTaskListRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Reason:       t.GetReason(),
+	}
+}
+
+// FromResumeTaskListRequest converts internal ResumeTaskListRequest type to thrift
+func FromResumeTaskListRequest(t *types.ResumeTaskListRequest) *admin.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ResumeTaskListRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Reason:       &t.Reason,
+	}
+}
+
+// ToResumeTaskListRequest converts thrift ResumeTaskListRequest type to internal
+func ToResumeTaskListRequest(t *admin.ResumeTaskListRequest) *types.ResumeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeTaskListRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Reason:       t.GetReason(),
+	}
+}
+
+// FromMigrateTaskListRequest converts internal MigrateTaskListRequest type to thrift
+func FromMigrateTaskListRequest(t *types.MigrateTaskListRequest) *admin.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.MigrateTaskListRequest{
+		Domain:              &t.Domain,
+		TaskList:            FromTaskList(t.TaskList),
+		TaskListType:        FromTaskListType(t.TaskListType),
+		DestinationTaskList: &t.DestinationTaskList,
+		Reason:              &t.Reason,
+	}
+}
+
+// ToMigrateTaskListRequest converts thrift MigrateTaskListRequest type to internal
+func ToMigrateTaskListRequest(t *admin.MigrateTaskListRequest) *types.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateTaskListRequest{
+		Domain:              t.GetDomain(),
+		TaskList:            ToTaskList(t.TaskList),
+		TaskListType:        ToTaskListType(t.TaskListType),
+		DestinationTaskList: t.GetDestinationTaskList(),
+		Reason:              t.GetReason(),
+	}
+}
+
+// FromTaskListDLQEntry converts internal TaskListDLQEntry type to thrift
+func FromTaskListDLQEntry(t *types.TaskListDLQEntry) *admin.TaskListDLQEntry {
+	if t == nil {
+		return nil
+	}
+	return &admin.TaskListDLQEntry{
+		MessageId:         &t.MessageID,
+		Domain:            &t.Domain,
+		TaskList:          &t.TaskList,
+		TaskListType:      FromTaskListType(t.TaskListType),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleId:        &t.ScheduleID,
+		Attempts:          &t.Attempts,
+		EnqueuedTimestamp: t.EnqueuedTimestamp,
+	}
+}
+
+// ToTaskListDLQEntry converts thrift TaskListDLQEntry type to internal
+func ToTaskListDLQEntry(t *admin.TaskListDLQEntry) *types.TaskListDLQEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListDLQEntry{
+		MessageID:         t.GetMessageId(),
+		Domain:            t.GetDomain(),
+		TaskList:          t.GetTaskList(),
+		TaskListType:      ToTaskListType(t.TaskListType),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:        t.GetScheduleId(),
+		Attempts:          t.GetAttempts(),
+		EnqueuedTimestamp: t.EnqueuedTimestamp,
+	}
+}
+
+// FromTaskListDLQEntryArray converts internal TaskListDLQEntry type array to thrift
+func FromTaskListDLQEntryArray(t []*types.TaskListDLQEntry) []*admin.TaskListDLQEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.TaskListDLQEntry, len(t))
+	for i := range t {
+		v[i] = FromTaskListDLQEntry(t[i])
+	}
+	return v
+}
+
+// ToTaskListDLQEntryArray converts thrift TaskListDLQEntry type array to internal
+func ToTaskListDLQEntryArray(t []*admin.TaskListDLQEntry) []*types.TaskListDLQEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListDLQEntry, len(t))
+	for i := range t {
+		v[i] = ToTaskListDLQEntry(t[i])
+	}
+	return v
+}
+
+// FromReadTaskListDLQRequest converts internal ReadTaskListDLQRequest type to thrift
+func FromReadTaskListDLQRequest(t *types.ReadTaskListDLQRequest) *admin.ReadTaskListDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReadTaskListDLQRequest{
+		Domain:          &t.Domain,
+		TaskList:        &t.TaskList,
+		TaskListType:    FromTaskListType(t.TaskListType),
+		MaximumPageSize: &t.MaximumPageSize,
+		NextPageToken:   t.NextPageToken,
+	}
+}
+
+// ToReadTaskListDLQRequest converts thrift ReadTaskListDLQRequest type to internal
+func ToReadTaskListDLQRequest(t *admin.ReadTaskListDLQRequest) *types.ReadTaskListDLQRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadTaskListDLQRequest{
+		Domain:          t.GetDomain(),
+		TaskList:        t.GetTaskList(),
+		TaskListType:    ToTaskListType(t.TaskListType),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.NextPageToken,
+	}
+}
+
+// FromReadTaskListDLQResponse converts internal ReadTaskListDLQResponse type to thrift
+func FromReadTaskListDLQResponse(t *types.ReadTaskListDLQResponse) *admin.ReadTaskListDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReadTaskListDLQResponse{
+		Entries:       FromTaskListDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToReadTaskListDLQResponse converts thrift ReadTaskListDLQResponse type to internal
+func ToReadTaskListDLQResponse(t *admin.ReadTaskListDLQResponse) *types.ReadTaskListDLQResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadTaskListDLQResponse{
+		Entries:       ToTaskListDLQEntryArray(t.Entries),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromDescribeTaskListDLQEntryRequest converts internal DescribeTaskListDLQEntryRequest type to thrift
+func FromDescribeTaskListDLQEntryRequest(t *types.DescribeTaskListDLQEntryRequest) *admin.DescribeTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeTaskListDLQEntryRequest{
+		Domain:    &t.Domain,
+		MessageId: &t.MessageID,
+	}
+}
+
+// ToDescribeTaskListDLQEntryRequest converts thrift DescribeTaskListDLQEntryRequest type to internal
+func ToDescribeTaskListDLQEntryRequest(t *admin.DescribeTaskListDLQEntryRequest) *types.DescribeTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListDLQEntryRequest{
+		Domain:    t.GetDomain(),
+		MessageID: t.GetMessageId(),
+	}
+}
+
+// FromDescribeTaskListDLQEntryResponse converts internal DescribeTaskListDLQEntryResponse type to thrift
+func FromDescribeTaskListDLQEntryResponse(t *types.DescribeTaskListDLQEntryResponse) *admin.DescribeTaskListDLQEntryResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeTaskListDLQEntryResponse{
+		Entry: FromTaskListDLQEntry(t.Entry),
+	}
+}
+
+// ToDescribeTaskListDLQEntryResponse converts thrift DescribeTaskListDLQEntryResponse type to internal
+func ToDescribeTaskListDLQEntryResponse(t *admin.DescribeTaskListDLQEntryResponse) *types.DescribeTaskListDLQEntryResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListDLQEntryResponse{
+		Entry: ToTaskListDLQEntry(t.Entry),
+	}
+}
+
+// FromRedriveTaskListDLQEntryRequest converts internal RedriveTaskListDLQEntryRequest type to thrift
+func FromRedriveTaskListDLQEntryRequest(t *types.RedriveTaskListDLQEntryRequest) *admin.RedriveTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RedriveTaskListDLQEntryRequest{
+		Domain:    &t.Domain,
+		MessageId: &t.MessageID,
+	}
+}
+
+// ToRedriveTaskListDLQEntryRequest converts thrift RedriveTaskListDLQEntryRequest type to internal
+func ToRedriveTaskListDLQEntryRequest(t *admin.RedriveTaskListDLQEntryRequest) *types.RedriveTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RedriveTaskListDLQEntryRequest{
+		Domain:    t.GetDomain(),
+		MessageID: t.GetMessageId(),
+	}
+}
+
+// FromFailTaskListDLQEntryRequest converts internal FailTaskListDLQEntryRequest type to thrift
+func FromFailTaskListDLQEntryRequest(t *types.FailTaskListDLQEntryRequest) *admin.FailTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.FailTaskListDLQEntryRequest{
+		Domain:    &t.Domain,
+		MessageId: &t.MessageID,
+	}
+}
+
+// ToFailTaskListDLQEntryRequest converts thrift FailTaskListDLQEntryRequest type to internal
+func ToFailTaskListDLQEntryRequest(t *admin.FailTaskListDLQEntryRequest) *types.FailTaskListDLQEntryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.FailTaskListDLQEntryRequest{
+		Domain:    t.GetDomain(),
+		MessageID: t.GetMessageId(),
+	}
+}
+
+// FromMoveShardRequest converts internal MoveShardRequest type to thrift
+func FromMoveShardRequest(t *types.MoveShardRequest) *admin.MoveShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.MoveShardRequest{
+		ShardID: &t.ShardID,
+		Host:    &t.Host,
+		Pin:     &t.Pin,
+		Reason:  &t.Reason,
+	}
+}
+
+// ToMoveShardRequest converts thrift MoveShardRequest type to internal
+func ToMoveShardRequest(t *admin.MoveShardRequest) *types.MoveShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveShardRequest{
+		ShardID: t.GetShardID(),
+		Host:    t.GetHost(),
+		Pin:     t.GetPin(),
+		Reason:  t.GetReason(),
+	}
+}
+
+// FromUnpinShardRequest converts internal UnpinShardRequest type to thrift
+func FromUnpinShardRequest(t *types.UnpinShardRequest) *admin.UnpinShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.UnpinShardRequest{
+		ShardID: &t.ShardID,
+		Reason:  &t.Reason,
+	}
+}
+
+// ToUnpinShardRequest converts thrift UnpinShardRequest type to internal
+func ToUnpinShardRequest(t *admin.UnpinShardRequest) *types.UnpinShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpinShardRequest{
+		ShardID: t.GetShardID(),
+		Reason:  t.GetReason(),
+	}
+}
+
+// FromListQuarantinedWorkflowsRequest converts internal ListQuarantinedWorkflowsRequest type to thrift
+func FromListQuarantinedWorkflowsRequest(t *types.ListQuarantinedWorkflowsRequest) *admin.ListQuarantinedWorkflowsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListQuarantinedWorkflowsRequest{
+		Domain: &t.Domain,
+	}
+}
+
+// ToListQuarantinedWorkflowsRequest converts thrift ListQuarantinedWorkflowsRequest type to internal
+func ToListQuarantinedWorkflowsRequest(t *admin.ListQuarantinedWorkflowsRequest) *types.ListQuarantinedWorkflowsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListQuarantinedWorkflowsRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// FromListQuarantinedWorkflowsResponse converts internal ListQuarantinedWorkflowsResponse type to thrift
+func FromListQuarantinedWorkflowsResponse(t *types.ListQuarantinedWorkflowsResponse) *admin.ListQuarantinedWorkflowsResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListQuarantinedWorkflowsResponse{
+		Workflows: FromQuarantinedWorkflowArray(t.Workflows),
+	}
+}
+
+// ToListQuarantinedWorkflowsResponse converts thrift ListQuarantinedWorkflowsResponse type to internal
+func ToListQuarantinedWorkflowsResponse(t *admin.ListQuarantinedWorkflowsResponse) *types.ListQuarantinedWorkflowsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListQuarantinedWorkflowsResponse{
+		Workflows: ToQuarantinedWorkflowArray(t.Workflows),
+	}
+}
+
+// FromQuarantinedWorkflow converts internal QuarantinedWorkflow type to thrift
+func FromQuarantinedWorkflow(t *types.QuarantinedWorkflow) *admin.QuarantinedWorkflow {
+	if t == nil {
+		return nil
+	}
+	return &admin.QuarantinedWorkflow{
+		Domain:               &t.Domain,
+		WorkflowID:           &t.WorkflowID,
+		RunID:                &t.RunID,
+		ShardID:              &t.ShardID,
+		Details:              &t.Details,
+		QuarantinedTimestamp: t.QuarantinedTimestamp,
+	}
+}
+
+// ToQuarantinedWorkflow converts thrift QuarantinedWorkflow type to internal
+func ToQuarantinedWorkflow(t *admin.QuarantinedWorkflow) *types.QuarantinedWorkflow {
+	if t == nil {
+		return nil
+	}
+	return &types.QuarantinedWorkflow{
+		Domain:               t.GetDomain(),
+		WorkflowID:           t.GetWorkflowID(),
+		RunID:                t.GetRunID(),
+		ShardID:              t.GetShardID(),
+		Details:              t.GetDetails(),
+		QuarantinedTimestamp: t.QuarantinedTimestamp,
+	}
+}
+
+// FromQuarantinedWorkflowArray converts internal QuarantinedWorkflow type array to thrift
+func FromQuarantinedWorkflowArray(t []*types.QuarantinedWorkflow) []*admin.QuarantinedWorkflow {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.QuarantinedWorkflow, len(t))
+	for i := range t {
+		v[i] = FromQuarantinedWorkflow(t[i])
+	}
+	return v
+}
+
+// ToQuarantinedWorkflowArray converts thrift QuarantinedWorkflow type array to internal
+func ToQuarantinedWorkflowArray(t []*admin.QuarantinedWorkflow) []*types.QuarantinedWorkflow {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.QuarantinedWorkflow, len(t))
+	for i := range t {
+		v[i] = ToQuarantinedWorkflow(t[i])
+	}
+	return v
+}
+
+// FromReleaseQuarantinedWorkflowRequest converts internal ReleaseQuarantinedWorkflowRequest type to thrift
+func FromReleaseQuarantinedWorkflowRequest(t *types.ReleaseQuarantinedWorkflowRequest) *admin.ReleaseQuarantinedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReleaseQuarantinedWorkflowRequest{
+		Domain:     &t.Domain,
+		WorkflowID: &t.WorkflowID,
+		RunID:      &t.RunID,
+		Repair:     &t.Repair,
+		Reason:     &t.Reason,
+	}
+}
+
+// ToReleaseQuarantinedWorkflowRequest converts thrift ReleaseQuarantinedWorkflowRequest type to internal
+func ToReleaseQuarantinedWorkflowRequest(t *admin.ReleaseQuarantinedWorkflowRequest) *types.ReleaseQuarantinedWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReleaseQuarantinedWorkflowRequest{
+		Domain:     t.GetDomain(),
+		WorkflowID: t.GetWorkflowID(),
+		RunID:      t.GetRunID(),
+		Repair:     t.GetRepair(),
+		Reason:     t.GetReason(),
+	}
+}
+
+// FromWorkflowBuildCheckState converts internal WorkflowBuildCheckState type to thrift
+func FromWorkflowBuildCheckState(t *types.WorkflowBuildCheckState) *admin.WorkflowBuildCheckState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.WorkflowBuildCheckStateRunning:
+		v := admin.WorkflowBuildCheckStateRunning
+		return &v
+	case types.WorkflowBuildCheckStatePassed:
+		v := admin.WorkflowBuildCheckStatePassed
+		return &v
+	case types.WorkflowBuildCheckStateFailed:
+		v := admin.WorkflowBuildCheckStateFailed
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToWorkflowBuildCheckState converts thrift WorkflowBuildCheckState type to internal
+func ToWorkflowBuildCheckState(t *admin.WorkflowBuildCheckState) *types.WorkflowBuildCheckState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case admin.WorkflowBuildCheckStateRunning:
+		v := types.WorkflowBuildCheckStateRunning
+		return &v
+	case admin.WorkflowBuildCheckStatePassed:
+		v := types.WorkflowBuildCheckStatePassed
+		return &v
+	case admin.WorkflowBuildCheckStateFailed:
+		v := types.WorkflowBuildCheckStateFailed
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// FromCheckWorkflowBuildRequest converts internal CheckWorkflowBuildRequest type to thrift
+func FromCheckWorkflowBuildRequest(t *types.CheckWorkflowBuildRequest) *admin.CheckWorkflowBuildRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.CheckWorkflowBuildRequest{
+		Domain:                              &t.Domain,
+		WorkflowType:                        &t.WorkflowType,
+		BuildID:                             &t.BuildID,
+		SignatureHash:                       &t.SignatureHash,
+		TaskList:                            &t.TaskList,
+		EarliestTime:                        t.EarliestTime,
+		ShadowCount:                         &t.ShadowCount,
+		Concurrency:                         &t.Concurrency,
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+	}
+}
+
+// ToCheckWorkflowBuildRequest converts thrift CheckWorkflowBuildRequest type to internal
+func ToCheckWorkflowBuildRequest(t *admin.CheckWorkflowBuildRequest) *types.CheckWorkflowBuildRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CheckWorkflowBuildRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowType:                        t.GetWorkflowType(),
+		BuildID:                             t.GetBuildID(),
+		SignatureHash:                       t.GetSignatureHash(),
+		TaskList:                            t.GetTaskList(),
+		EarliestTime:                        t.EarliestTime,
+		ShadowCount:                         t.GetShadowCount(),
+		Concurrency:                         t.GetConcurrency(),
+		ExecutionStartToCloseTimeoutSeconds: t.ExecutionStartToCloseTimeoutSeconds,
+	}
+}
+
+// FromCheckWorkflowBuildResponse converts internal CheckWorkflowBuildResponse type to thrift
+func FromCheckWorkflowBuildResponse(t *types.CheckWorkflowBuildResponse) *admin.CheckWorkflowBuildResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.CheckWorkflowBuildResponse{
+		State:          FromWorkflowBuildCheckState(t.State),
+		WorkflowID:     &t.WorkflowID,
+		RunID:          &t.RunID,
+		CheckedBuildID: &t.CheckedBuildID,
+		Succeeded:      &t.Succeeded,
+		Skipped:        &t.Skipped,
+		Failed:         &t.Failed,
+	}
+}
+
+// ToCheckWorkflowBuildResponse converts thrift CheckWorkflowBuildResponse type to internal
+func ToCheckWorkflowBuildResponse(t *admin.CheckWorkflowBuildResponse) *types.CheckWorkflowBuildResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.CheckWorkflowBuildResponse{
+		State:          ToWorkflowBuildCheckState(t.State),
+		WorkflowID:     t.GetWorkflowID(),
+		RunID:          t.GetRunID(),
+		CheckedBuildID: t.GetCheckedBuildID(),
+		Succeeded:      t.GetSucceeded(),
+		Skipped:        t.GetSkipped(),
+		Failed:         t.GetFailed(),
+	}
+}
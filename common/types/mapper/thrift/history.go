@@ -206,6 +206,7 @@ func FromGetMutableStateResponse(t *types.GetMutableStateResponse) *history.GetM
 		WorkflowCloseState:                   t.WorkflowCloseState,
 		VersionHistories:                     FromVersionHistories(t.VersionHistories),
 		IsStickyTaskListEnabled:              &t.IsStickyTaskListEnabled,
+		BuildId:                              &t.BuildID,
 	}
 }
 
@@ -233,6 +234,7 @@ func ToGetMutableStateResponse(t *history.GetMutableStateResponse) *types.GetMut
 		WorkflowCloseState:                   t.WorkflowCloseState,
 		VersionHistories:                     ToVersionHistories(t.VersionHistories),
 		IsStickyTaskListEnabled:              t.GetIsStickyTaskListEnabled(),
+		BuildID:                              t.GetBuildId(),
 	}
 }
 
@@ -1058,6 +1060,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		ContinuedFailureDetails:         t.ContinuedFailureDetails,
 		LastCompletionResult:            t.LastCompletionResult,
 		FirstDecisionTaskBackoffSeconds: t.FirstDecisionTaskBackoffSeconds,
+		RetryElapsedExpirationTimestamp: t.RetryElapsedExpirationTimestamp,
 	}
 }
 
@@ -1077,6 +1080,7 @@ func ToHistoryStartWorkflowExecutionRequest(t *history.StartWorkflowExecutionReq
 		ContinuedFailureDetails:         t.ContinuedFailureDetails,
 		LastCompletionResult:            t.LastCompletionResult,
 		FirstDecisionTaskBackoffSeconds: t.FirstDecisionTaskBackoffSeconds,
+		RetryElapsedExpirationTimestamp: t.RetryElapsedExpirationTimestamp,
 	}
 }
 
@@ -1174,6 +1178,28 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *history.TerminateWorkflowExec
 	}
 }
 
+// FromHistoryExpediteWorkflowExecutionStartRequest converts internal ExpediteWorkflowExecutionStartRequest type to thrift
+func FromHistoryExpediteWorkflowExecutionStartRequest(t *types.HistoryExpediteWorkflowExecutionStartRequest) *history.ExpediteWorkflowExecutionStartRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ExpediteWorkflowExecutionStartRequest{
+		DomainUUID:      &t.DomainUUID,
+		ExpediteRequest: FromExpediteWorkflowExecutionStartRequest(t.ExpediteRequest),
+	}
+}
+
+// ToHistoryExpediteWorkflowExecutionStartRequest converts thrift ExpediteWorkflowExecutionStartRequest type to internal
+func ToHistoryExpediteWorkflowExecutionStartRequest(t *history.ExpediteWorkflowExecutionStartRequest) *types.HistoryExpediteWorkflowExecutionStartRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryExpediteWorkflowExecutionStartRequest{
+		DomainUUID:      t.GetDomainUUID(),
+		ExpediteRequest: ToExpediteWorkflowExecutionStartRequest(t.ExpediteRequest),
+	}
+}
+
 // FromFailoverMarkerTokenArray converts internal FailoverMarkerToken type array to thrift
 func FromFailoverMarkerTokenArray(t []*types.FailoverMarkerToken) []*history.FailoverMarkerToken {
 	if t == nil {
@@ -40,6 +40,12 @@ func FromAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matching.AddAc
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
+		BuildId:                       &t.BuildID,
+		Attempt:                       &t.Attempt,
+		LastHeartbeatTimestamp:        t.LastHeartbeatTimestamp,
+		IsolationGroup:                &t.IsolationGroup,
 	}
 }
 
@@ -57,6 +63,12 @@ func ToAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types.AddActi
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
+		BuildID:                       t.GetBuildId(),
+		Attempt:                       t.GetAttempt(),
+		LastHeartbeatTimestamp:        t.LastHeartbeatTimestamp,
+		IsolationGroup:                t.GetIsolationGroup(),
 	}
 }
 
@@ -73,6 +85,11 @@ func FromAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matching.AddDe
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
+		BuildId:                       &t.BuildID,
+		Attempt:                       &t.Attempt,
+		IsolationGroup:                &t.IsolationGroup,
 	}
 }
 
@@ -89,6 +106,11 @@ func ToAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types.AddDeci
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
+		BuildID:                       t.GetBuildId(),
+		Attempt:                       t.GetAttempt(),
+		IsolationGroup:                t.GetIsolationGroup(),
 	}
 }
 
@@ -162,6 +184,124 @@ func ToMatchingListTaskListPartitionsRequest(t *matching.ListTaskListPartitionsR
 	}
 }
 
+// FromMatchingUpdateWorkerBuildIDCompatibilityRequest converts internal UpdateWorkerBuildIDCompatibilityRequest type to thrift
+func FromMatchingUpdateWorkerBuildIDCompatibilityRequest(t *types.MatchingUpdateWorkerBuildIDCompatibilityRequest) *matching.UpdateWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.UpdateWorkerBuildIDCompatibilityRequest{
+		DomainUUID:    &t.DomainUUID,
+		UpdateRequest: FromUpdateWorkerBuildIDCompatibilityRequest(t.UpdateRequest),
+	}
+}
+
+// ToMatchingUpdateWorkerBuildIDCompatibilityRequest converts thrift UpdateWorkerBuildIDCompatibilityRequest type to internal
+func ToMatchingUpdateWorkerBuildIDCompatibilityRequest(t *matching.UpdateWorkerBuildIDCompatibilityRequest) *types.MatchingUpdateWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		UpdateRequest: ToUpdateWorkerBuildIDCompatibilityRequest(t.UpdateRequest),
+	}
+}
+
+// FromMatchingGetWorkerBuildIDCompatibilityRequest converts internal GetWorkerBuildIDCompatibilityRequest type to thrift
+func FromMatchingGetWorkerBuildIDCompatibilityRequest(t *types.MatchingGetWorkerBuildIDCompatibilityRequest) *matching.GetWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.GetWorkerBuildIDCompatibilityRequest{
+		DomainUUID: &t.DomainUUID,
+		GetRequest: FromGetWorkerBuildIDCompatibilityRequest(t.GetRequest),
+	}
+}
+
+// ToMatchingGetWorkerBuildIDCompatibilityRequest converts thrift GetWorkerBuildIDCompatibilityRequest type to internal
+func ToMatchingGetWorkerBuildIDCompatibilityRequest(t *matching.GetWorkerBuildIDCompatibilityRequest) *types.MatchingGetWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetWorkerBuildIDCompatibilityRequest{
+		DomainUUID: t.GetDomainUUID(),
+		GetRequest: ToGetWorkerBuildIDCompatibilityRequest(t.GetRequest),
+	}
+}
+
+// FromMatchingUpdateTaskListStateRequest converts internal UpdateTaskListStateRequest type to thrift
+func FromMatchingUpdateTaskListStateRequest(t *types.MatchingUpdateTaskListStateRequest) *matching.UpdateTaskListStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.UpdateTaskListStateRequest{
+		DomainUUID:   &t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Paused:       t.Paused,
+		MigrateTo:    t.MigrateTo,
+	}
+}
+
+// ToMatchingUpdateTaskListStateRequest converts thrift UpdateTaskListStateRequest type to internal
+func ToMatchingUpdateTaskListStateRequest(t *matching.UpdateTaskListStateRequest) *types.MatchingUpdateTaskListStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateTaskListStateRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Paused:       t.Paused,
+		MigrateTo:    t.MigrateTo,
+	}
+}
+
+// FromMatchingGetTaskListStateRequest converts internal GetTaskListStateRequest type to thrift
+func FromMatchingGetTaskListStateRequest(t *types.MatchingGetTaskListStateRequest) *matching.GetTaskListStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.GetTaskListStateRequest{
+		DomainUUID:   &t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToMatchingGetTaskListStateRequest converts thrift GetTaskListStateRequest type to internal
+func ToMatchingGetTaskListStateRequest(t *matching.GetTaskListStateRequest) *types.MatchingGetTaskListStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListStateRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromTaskListState converts internal TaskListState type to thrift
+func FromTaskListState(t *types.TaskListState) *matching.TaskListState {
+	if t == nil {
+		return nil
+	}
+	return &matching.TaskListState{
+		Paused:    &t.Paused,
+		MigrateTo: &t.MigrateTo,
+	}
+}
+
+// ToTaskListState converts thrift TaskListState type to internal
+func ToTaskListState(t *matching.TaskListState) *types.TaskListState {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListState{
+		Paused:    t.GetPaused(),
+		MigrateTo: t.GetMigrateTo(),
+	}
+}
+
 // FromMatchingPollForActivityTaskRequest converts internal PollForActivityTaskRequest type to thrift
 func FromMatchingPollForActivityTaskRequest(t *types.MatchingPollForActivityTaskRequest) *matching.PollForActivityTaskRequest {
 	if t == nil {
@@ -276,6 +416,7 @@ func FromMatchingQueryWorkflowRequest(t *types.MatchingQueryWorkflowRequest) *ma
 		TaskList:      FromTaskList(t.TaskList),
 		QueryRequest:  FromQueryWorkflowRequest(t.QueryRequest),
 		ForwardedFrom: &t.ForwardedFrom,
+		BuildId:       &t.BuildID,
 	}
 }
 
@@ -289,6 +430,7 @@ func ToMatchingQueryWorkflowRequest(t *matching.QueryWorkflowRequest) *types.Mat
 		TaskList:      ToTaskList(t.TaskList),
 		QueryRequest:  ToQueryWorkflowRequest(t.QueryRequest),
 		ForwardedFrom: t.GetForwardedFrom(),
+		BuildID:       t.GetBuildId(),
 	}
 }
 
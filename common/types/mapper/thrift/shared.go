@@ -196,6 +196,8 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId:  &t.DecisionTaskCompletedEventID,
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -217,6 +219,8 @@ func ToActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEvent
 		DecisionTaskCompletedEventID:  t.GetDecisionTaskCompletedEventId(),
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -1028,6 +1032,7 @@ func FromDecision(t *types.Decision) *shared.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            FromStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        FromSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         FromUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		UpsertWorkflowMemoDecisionAttributes:                     FromUpsertWorkflowMemoDecisionAttributes(t.UpsertWorkflowMemoDecisionAttributes),
 	}
 }
 
@@ -1051,6 +1056,7 @@ func ToDecision(t *shared.Decision) *types.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            ToStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        ToSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         ToUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		UpsertWorkflowMemoDecisionAttributes:                     ToUpsertWorkflowMemoDecisionAttributes(t.UpsertWorkflowMemoDecisionAttributes),
 	}
 }
 
@@ -1157,6 +1163,9 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) *shared.Decis
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		v := shared.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes:
+		v := shared.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1236,6 +1245,9 @@ func ToDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) *types.Decisio
 	case shared.DecisionTaskFailedCauseBadSearchAttributes:
 		v := types.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case shared.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes:
+		v := types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1427,6 +1439,9 @@ func FromDecisionType(t *types.DecisionType) *shared.DecisionType {
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := shared.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.DecisionTypeUpsertWorkflowMemo:
+		v := shared.DecisionTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1476,6 +1491,9 @@ func ToDecisionType(t *shared.DecisionType) *types.DecisionType {
 	case shared.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := types.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.DecisionTypeUpsertWorkflowMemo:
+		v := types.DecisionTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1604,11 +1622,12 @@ func FromDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse) *shar
 		return nil
 	}
 	return &shared.DescribeHistoryHostResponse{
-		NumberOfShards:        &t.NumberOfShards,
-		ShardIDs:              t.ShardIDs,
-		DomainCache:           FromDomainCacheInfo(t.DomainCache),
-		ShardControllerStatus: &t.ShardControllerStatus,
-		Address:               &t.Address,
+		NumberOfShards:             &t.NumberOfShards,
+		ShardIDs:                   t.ShardIDs,
+		DomainCache:                FromDomainCacheInfo(t.DomainCache),
+		ShardControllerStatus:      &t.ShardControllerStatus,
+		Address:                    &t.Address,
+		PersistenceCircuitBreakers: FromPersistenceCircuitBreakerInfoArray(t.PersistenceCircuitBreakers),
 	}
 }
 
@@ -1620,6 +1639,8 @@ func FromDescribeShardDistributionResponse(t *types.DescribeShardDistributionRes
 	return &shared.DescribeShardDistributionResponse{
 		NumberOfShards: &t.NumberOfShards,
 		Shards:         t.Shards,
+		PlacementMode:  &t.PlacementMode,
+		Placements:     FromShardPlacementInfoMap(t.Placements),
 	}
 }
 
@@ -1629,11 +1650,12 @@ func ToDescribeHistoryHostResponse(t *shared.DescribeHistoryHostResponse) *types
 		return nil
 	}
 	return &types.DescribeHistoryHostResponse{
-		NumberOfShards:        t.GetNumberOfShards(),
-		ShardIDs:              t.ShardIDs,
-		DomainCache:           ToDomainCacheInfo(t.DomainCache),
-		ShardControllerStatus: t.GetShardControllerStatus(),
-		Address:               t.GetAddress(),
+		NumberOfShards:             t.GetNumberOfShards(),
+		ShardIDs:                   t.ShardIDs,
+		DomainCache:                ToDomainCacheInfo(t.DomainCache),
+		ShardControllerStatus:      t.GetShardControllerStatus(),
+		Address:                    t.GetAddress(),
+		PersistenceCircuitBreakers: ToPersistenceCircuitBreakerInfoArray(t.PersistenceCircuitBreakers),
 	}
 }
 
@@ -1645,7 +1667,61 @@ func ToDescribeShardDistributionResponse(t *shared.DescribeShardDistributionResp
 	return &types.DescribeShardDistributionResponse{
 		NumberOfShards: t.GetNumberOfShards(),
 		Shards:         t.Shards,
+		PlacementMode:  t.GetPlacementMode(),
+		Placements:     ToShardPlacementInfoMap(t.Placements),
+	}
+}
+
+// FromShardPlacementInfo converts internal ShardPlacementInfo type to thrift
+func FromShardPlacementInfo(t *types.ShardPlacementInfo) *shared.ShardPlacementInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.ShardPlacementInfo{
+		AssignedHost: &t.AssignedHost,
+		Pinned:       &t.Pinned,
+		Rps:          &t.RPS,
+		QueueBacklog: &t.QueueBacklog,
+		CacheSize:    &t.CacheSize,
+	}
+}
+
+// ToShardPlacementInfo converts thrift ShardPlacementInfo type to internal
+func ToShardPlacementInfo(t *shared.ShardPlacementInfo) *types.ShardPlacementInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ShardPlacementInfo{
+		AssignedHost: t.GetAssignedHost(),
+		Pinned:       t.GetPinned(),
+		RPS:          t.GetRps(),
+		QueueBacklog: t.GetQueueBacklog(),
+		CacheSize:    t.GetCacheSize(),
+	}
+}
+
+// FromShardPlacementInfoMap converts internal ShardPlacementInfo type map to thrift
+func FromShardPlacementInfoMap(t map[int32]*types.ShardPlacementInfo) map[int32]*shared.ShardPlacementInfo {
+	if t == nil {
+		return nil
+	}
+	v := make(map[int32]*shared.ShardPlacementInfo, len(t))
+	for key := range t {
+		v[key] = FromShardPlacementInfo(t[key])
+	}
+	return v
+}
+
+// ToShardPlacementInfoMap converts thrift ShardPlacementInfo type map to internal
+func ToShardPlacementInfoMap(t map[int32]*shared.ShardPlacementInfo) map[int32]*types.ShardPlacementInfo {
+	if t == nil {
+		return nil
 	}
+	v := make(map[int32]*types.ShardPlacementInfo, len(t))
+	for key := range t {
+		v[key] = ToShardPlacementInfo(t[key])
+	}
+	return v
 }
 
 // FromDescribeQueueRequest converts internal DescribeQueueRequest type to thrift
@@ -1702,6 +1778,7 @@ func FromDescribeTaskListRequest(t *types.DescribeTaskListRequest) *shared.Descr
 		TaskList:              FromTaskList(t.TaskList),
 		TaskListType:          FromTaskListType(t.TaskListType),
 		IncludeTaskListStatus: &t.IncludeTaskListStatus,
+		IncludePartitions:     &t.IncludePartitions,
 	}
 }
 
@@ -1715,6 +1792,7 @@ func ToDescribeTaskListRequest(t *shared.DescribeTaskListRequest) *types.Describ
 		TaskList:              ToTaskList(t.TaskList),
 		TaskListType:          ToTaskListType(t.TaskListType),
 		IncludeTaskListStatus: t.GetIncludeTaskListStatus(),
+		IncludePartitions:     t.GetIncludePartitions(),
 	}
 }
 
@@ -1724,8 +1802,11 @@ func FromDescribeTaskListResponse(t *types.DescribeTaskListResponse) *shared.Des
 		return nil
 	}
 	return &shared.DescribeTaskListResponse{
-		Pollers:        FromPollerInfoArray(t.Pollers),
-		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+		Pollers:          FromPollerInfoArray(t.Pollers),
+		TaskListStatus:   FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  FromTaskListPartitionConfig(t.PartitionConfig),
+		PollersByBuildId: t.PollersByBuildID,
+		PartitionStatus:  FromTaskListStatusMap(t.PartitionStatus),
 	}
 }
 
@@ -1735,8 +1816,11 @@ func ToDescribeTaskListResponse(t *shared.DescribeTaskListResponse) *types.Descr
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:        ToPollerInfoArray(t.Pollers),
-		TaskListStatus: ToTaskListStatus(t.TaskListStatus),
+		Pollers:          ToPollerInfoArray(t.Pollers),
+		TaskListStatus:   ToTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  ToTaskListPartitionConfig(t.PartitionConfig),
+		PollersByBuildID: t.PollersByBuildId,
+		PartitionStatus:  ToTaskListStatusMap(t.PartitionStatus),
 	}
 }
 
@@ -1832,6 +1916,56 @@ func ToDomainCacheInfo(t *shared.DomainCacheInfo) *types.DomainCacheInfo {
 	}
 }
 
+// FromPersistenceCircuitBreakerInfo converts internal PersistenceCircuitBreakerInfo type to thrift
+func FromPersistenceCircuitBreakerInfo(t *types.PersistenceCircuitBreakerInfo) *shared.PersistenceCircuitBreakerInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.PersistenceCircuitBreakerInfo{
+		StoreName:                &t.StoreName,
+		OperationClass:           &t.OperationClass,
+		State:                    &t.State,
+		LastStateChangeTimestamp: &t.LastStateChangeTimestamp,
+	}
+}
+
+// ToPersistenceCircuitBreakerInfo converts thrift PersistenceCircuitBreakerInfo type to internal
+func ToPersistenceCircuitBreakerInfo(t *shared.PersistenceCircuitBreakerInfo) *types.PersistenceCircuitBreakerInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.PersistenceCircuitBreakerInfo{
+		StoreName:                t.GetStoreName(),
+		OperationClass:           t.GetOperationClass(),
+		State:                    t.GetState(),
+		LastStateChangeTimestamp: t.GetLastStateChangeTimestamp(),
+	}
+}
+
+// FromPersistenceCircuitBreakerInfoArray converts internal PersistenceCircuitBreakerInfo type array to thrift
+func FromPersistenceCircuitBreakerInfoArray(t []*types.PersistenceCircuitBreakerInfo) []*shared.PersistenceCircuitBreakerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.PersistenceCircuitBreakerInfo, len(t))
+	for i := range t {
+		v[i] = FromPersistenceCircuitBreakerInfo(t[i])
+	}
+	return v
+}
+
+// ToPersistenceCircuitBreakerInfoArray converts thrift PersistenceCircuitBreakerInfo type array to internal
+func ToPersistenceCircuitBreakerInfoArray(t []*shared.PersistenceCircuitBreakerInfo) []*types.PersistenceCircuitBreakerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.PersistenceCircuitBreakerInfo, len(t))
+	for i := range t {
+		v[i] = ToPersistenceCircuitBreakerInfo(t[i])
+	}
+	return v
+}
+
 // FromDomainConfiguration converts internal DomainConfiguration type to thrift
 func FromDomainConfiguration(t *types.DomainConfiguration) *shared.DomainConfiguration {
 	if t == nil {
@@ -1845,6 +1979,7 @@ func FromDomainConfiguration(t *types.DomainConfiguration) *shared.DomainConfigu
 		HistoryArchivalURI:                     &t.HistoryArchivalURI,
 		VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  &t.VisibilityArchivalURI,
+		DrainedIsolationGroups:                 t.DrainedIsolationGroups,
 	}
 }
 
@@ -1861,6 +1996,7 @@ func ToDomainConfiguration(t *shared.DomainConfiguration) *types.DomainConfigura
 		HistoryArchivalURI:                     t.GetHistoryArchivalURI(),
 		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  t.GetVisibilityArchivalURI(),
+		DrainedIsolationGroups:                 t.DrainedIsolationGroups,
 	}
 }
 
@@ -2188,6 +2324,9 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeUpsertWorkflowMemo:
+		v := shared.EventTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2324,6 +2463,9 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeUpsertWorkflowMemo:
+		v := types.EventTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2619,6 +2761,7 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		UpsertWorkflowMemoEventAttributes:                              FromUpsertWorkflowMemoEventAttributes(t.UpsertWorkflowMemoEventAttributes),
 	}
 }
 
@@ -2675,6 +2818,7 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		UpsertWorkflowMemoEventAttributes:                              ToUpsertWorkflowMemoEventAttributes(t.UpsertWorkflowMemoEventAttributes),
 	}
 }
 
@@ -3036,8 +3180,10 @@ func FromListTaskListPartitionsResponse(t *types.ListTaskListPartitionsResponse)
 		return nil
 	}
 	return &shared.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: FromTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
-		DecisionTaskListPartitions: FromTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitions:      FromTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
+		DecisionTaskListPartitions:      FromTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitionConfig: FromTaskListPartitionConfig(t.ActivityTaskListPartitionConfig),
+		DecisionTaskListPartitionConfig: FromTaskListPartitionConfig(t.DecisionTaskListPartitionConfig),
 	}
 }
 
@@ -3047,8 +3193,10 @@ func ToListTaskListPartitionsResponse(t *shared.ListTaskListPartitionsResponse)
 		return nil
 	}
 	return &types.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: ToTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
-		DecisionTaskListPartitions: ToTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitions:      ToTaskListPartitionMetadataArray(t.ActivityTaskListPartitions),
+		DecisionTaskListPartitions:      ToTaskListPartitionMetadataArray(t.DecisionTaskListPartitions),
+		ActivityTaskListPartitionConfig: ToTaskListPartitionConfig(t.ActivityTaskListPartitionConfig),
+		DecisionTaskListPartitionConfig: ToTaskListPartitionConfig(t.DecisionTaskListPartitionConfig),
 	}
 }
 
@@ -3092,6 +3240,100 @@ func ToGetTaskListsByDomainResponse(t *shared.GetTaskListsByDomainResponse) *typ
 	}
 }
 
+// FromUpdateWorkerBuildIDCompatibilityRequest converts internal UpdateWorkerBuildIDCompatibilityRequest type to thrift
+func FromUpdateWorkerBuildIDCompatibilityRequest(t *types.UpdateWorkerBuildIDCompatibilityRequest) *shared.UpdateWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkerBuildIDCompatibilityRequest{
+		Domain:                       &t.Domain,
+		TaskList:                     FromTaskList(t.TaskList),
+		AddNewBuildIdInNewDefaultSet: &t.AddNewBuildIDInNewDefaultSet,
+		AddNewCompatibleBuildId:      &t.AddNewCompatibleBuildID,
+		ExistingCompatibleBuildId:    &t.ExistingCompatibleBuildID,
+		MakeSetDefault:               &t.MakeSetDefault,
+		PromoteSetByBuildId:          &t.PromoteSetByBuildID,
+	}
+}
+
+// ToUpdateWorkerBuildIDCompatibilityRequest converts thrift UpdateWorkerBuildIDCompatibilityRequest type to internal
+func ToUpdateWorkerBuildIDCompatibilityRequest(t *shared.UpdateWorkerBuildIDCompatibilityRequest) *types.UpdateWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkerBuildIDCompatibilityRequest{
+		Domain:                       t.GetDomain(),
+		TaskList:                     ToTaskList(t.TaskList),
+		AddNewBuildIDInNewDefaultSet: t.GetAddNewBuildIdInNewDefaultSet(),
+		AddNewCompatibleBuildID:      t.GetAddNewCompatibleBuildId(),
+		ExistingCompatibleBuildID:    t.GetExistingCompatibleBuildId(),
+		MakeSetDefault:               t.GetMakeSetDefault(),
+		PromoteSetByBuildID:          t.GetPromoteSetByBuildId(),
+	}
+}
+
+// FromGetWorkerBuildIDCompatibilityRequest converts internal GetWorkerBuildIDCompatibilityRequest type to thrift
+func FromGetWorkerBuildIDCompatibilityRequest(t *types.GetWorkerBuildIDCompatibilityRequest) *shared.GetWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetWorkerBuildIDCompatibilityRequest{
+		Domain:   &t.Domain,
+		TaskList: FromTaskList(t.TaskList),
+	}
+}
+
+// ToGetWorkerBuildIDCompatibilityRequest converts thrift GetWorkerBuildIDCompatibilityRequest type to internal
+func ToGetWorkerBuildIDCompatibilityRequest(t *shared.GetWorkerBuildIDCompatibilityRequest) *types.GetWorkerBuildIDCompatibilityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetWorkerBuildIDCompatibilityRequest{
+		Domain:   t.GetDomain(),
+		TaskList: ToTaskList(t.TaskList),
+	}
+}
+
+// FromGetWorkerBuildIDCompatibilityResponse converts internal GetWorkerBuildIDCompatibilityResponse type to thrift
+func FromGetWorkerBuildIDCompatibilityResponse(t *types.GetWorkerBuildIDCompatibilityResponse) *shared.GetWorkerBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetWorkerBuildIDCompatibilityResponse{
+		VersionSets: FromWorkerVersionSetArray(t.VersionSets),
+	}
+}
+
+// ToGetWorkerBuildIDCompatibilityResponse converts thrift GetWorkerBuildIDCompatibilityResponse type to internal
+func ToGetWorkerBuildIDCompatibilityResponse(t *shared.GetWorkerBuildIDCompatibilityResponse) *types.GetWorkerBuildIDCompatibilityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetWorkerBuildIDCompatibilityResponse{
+		VersionSets: ToWorkerVersionSetArray(t.VersionSets),
+	}
+}
+
+// FromWorkerVersionSet converts internal WorkerVersionSet type to thrift
+func FromWorkerVersionSet(t *types.WorkerVersionSet) *shared.WorkerVersionSet {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkerVersionSet{
+		BuildIds: t.BuildIDs,
+	}
+}
+
+// ToWorkerVersionSet converts thrift WorkerVersionSet type to internal
+func ToWorkerVersionSet(t *shared.WorkerVersionSet) *types.WorkerVersionSet {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkerVersionSet{
+		BuildIDs: t.BuildIds,
+	}
+}
+
 // FromListWorkflowExecutionsRequest converts internal ListWorkflowExecutionsRequest type to thrift
 func FromListWorkflowExecutionsRequest(t *types.ListWorkflowExecutionsRequest) *shared.ListWorkflowExecutionsRequest {
 	if t == nil {
@@ -3243,6 +3485,7 @@ func FromPendingActivityInfo(t *types.PendingActivityInfo) *shared.PendingActivi
 		LastFailureReason:      t.LastFailureReason,
 		LastWorkerIdentity:     &t.LastWorkerIdentity,
 		LastFailureDetails:     t.LastFailureDetails,
+		NextRetryTimestamp:     t.NextRetryTimestamp,
 	}
 }
 
@@ -3265,6 +3508,7 @@ func ToPendingActivityInfo(t *shared.PendingActivityInfo) *types.PendingActivity
 		LastFailureReason:      t.LastFailureReason,
 		LastWorkerIdentity:     t.GetLastWorkerIdentity(),
 		LastFailureDetails:     t.LastFailureDetails,
+		NextRetryTimestamp:     t.NextRetryTimestamp,
 	}
 }
 
@@ -3404,6 +3648,8 @@ func FromPollForActivityTaskRequest(t *types.PollForActivityTaskRequest) *shared
 		TaskList:         FromTaskList(t.TaskList),
 		Identity:         &t.Identity,
 		TaskListMetadata: FromTaskListMetadata(t.TaskListMetadata),
+		BinaryChecksum:   &t.BinaryChecksum,
+		IsolationGroup:   &t.IsolationGroup,
 	}
 }
 
@@ -3417,6 +3663,8 @@ func ToPollForActivityTaskRequest(t *shared.PollForActivityTaskRequest) *types.P
 		TaskList:         ToTaskList(t.TaskList),
 		Identity:         t.GetIdentity(),
 		TaskListMetadata: ToTaskListMetadata(t.TaskListMetadata),
+		BinaryChecksum:   t.GetBinaryChecksum(),
+		IsolationGroup:   t.GetIsolationGroup(),
 	}
 }
 
@@ -3480,6 +3728,7 @@ func FromPollForDecisionTaskRequest(t *types.PollForDecisionTaskRequest) *shared
 		TaskList:       FromTaskList(t.TaskList),
 		Identity:       &t.Identity,
 		BinaryChecksum: &t.BinaryChecksum,
+		IsolationGroup: &t.IsolationGroup,
 	}
 }
 
@@ -3493,6 +3742,7 @@ func ToPollForDecisionTaskRequest(t *shared.PollForDecisionTaskRequest) *types.P
 		TaskList:       ToTaskList(t.TaskList),
 		Identity:       t.GetIdentity(),
 		BinaryChecksum: t.GetBinaryChecksum(),
+		IsolationGroup: t.GetIsolationGroup(),
 	}
 }
 
@@ -3553,6 +3803,8 @@ func FromPollerInfo(t *types.PollerInfo) *shared.PollerInfo {
 		LastAccessTime: t.LastAccessTime,
 		Identity:       &t.Identity,
 		RatePerSecond:  &t.RatePerSecond,
+		BuildId:        &t.BuildID,
+		IsolationGroup: &t.IsolationGroup,
 	}
 }
 
@@ -3565,6 +3817,8 @@ func ToPollerInfo(t *shared.PollerInfo) *types.PollerInfo {
 		LastAccessTime: t.LastAccessTime,
 		Identity:       t.GetIdentity(),
 		RatePerSecond:  t.GetRatePerSecond(),
+		BuildID:        t.GetBuildId(),
+		IsolationGroup: t.GetIsolationGroup(),
 	}
 }
 
@@ -4626,12 +4880,16 @@ func FromRetryPolicy(t *types.RetryPolicy) *shared.RetryPolicy {
 		return nil
 	}
 	return &shared.RetryPolicy{
-		InitialIntervalInSeconds:    &t.InitialIntervalInSeconds,
-		BackoffCoefficient:          &t.BackoffCoefficient,
-		MaximumIntervalInSeconds:    &t.MaximumIntervalInSeconds,
-		MaximumAttempts:             &t.MaximumAttempts,
-		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
-		ExpirationIntervalInSeconds: &t.ExpirationIntervalInSeconds,
+		InitialIntervalInSeconds:         &t.InitialIntervalInSeconds,
+		BackoffCoefficient:               &t.BackoffCoefficient,
+		MaximumIntervalInSeconds:         &t.MaximumIntervalInSeconds,
+		MaximumAttempts:                  &t.MaximumAttempts,
+		NonRetriableErrorReasons:         t.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds:      &t.ExpirationIntervalInSeconds,
+		BackoffScheduleInSeconds:         t.BackoffScheduleInSeconds,
+		JitterMode:                       FromRetryJitterMode(t.JitterMode),
+		ReasonPolicies:                   FromRetryReasonPolicyArray(t.ReasonPolicies),
+		MaximumTotalElapsedTimeInSeconds: &t.MaximumTotalElapsedTimeInSeconds,
 	}
 }
 
@@ -4641,15 +4899,97 @@ func ToRetryPolicy(t *shared.RetryPolicy) *types.RetryPolicy {
 		return nil
 	}
 	return &types.RetryPolicy{
-		InitialIntervalInSeconds:    t.GetInitialIntervalInSeconds(),
-		BackoffCoefficient:          t.GetBackoffCoefficient(),
-		MaximumIntervalInSeconds:    t.GetMaximumIntervalInSeconds(),
-		MaximumAttempts:             t.GetMaximumAttempts(),
-		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
-		ExpirationIntervalInSeconds: t.GetExpirationIntervalInSeconds(),
+		InitialIntervalInSeconds:         t.GetInitialIntervalInSeconds(),
+		BackoffCoefficient:               t.GetBackoffCoefficient(),
+		MaximumIntervalInSeconds:         t.GetMaximumIntervalInSeconds(),
+		MaximumAttempts:                  t.GetMaximumAttempts(),
+		NonRetriableErrorReasons:         t.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds:      t.GetExpirationIntervalInSeconds(),
+		BackoffScheduleInSeconds:         t.BackoffScheduleInSeconds,
+		JitterMode:                       ToRetryJitterMode(t.JitterMode),
+		ReasonPolicies:                   ToRetryReasonPolicyArray(t.ReasonPolicies),
+		MaximumTotalElapsedTimeInSeconds: t.GetMaximumTotalElapsedTimeInSeconds(),
 	}
 }
 
+// FromRetryReasonPolicy converts internal RetryReasonPolicy type to thrift
+func FromRetryReasonPolicy(t *types.RetryReasonPolicy) *shared.RetryReasonPolicy {
+	if t == nil {
+		return nil
+	}
+	return &shared.RetryReasonPolicy{
+		ReasonPattern: &t.ReasonPattern,
+		RetryPolicy:   FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// ToRetryReasonPolicy converts thrift RetryReasonPolicy type to internal
+func ToRetryReasonPolicy(t *shared.RetryReasonPolicy) *types.RetryReasonPolicy {
+	if t == nil {
+		return nil
+	}
+	return &types.RetryReasonPolicy{
+		ReasonPattern: t.GetReasonPattern(),
+		RetryPolicy:   ToRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// FromRetryReasonPolicyArray converts internal RetryReasonPolicy type array to thrift
+func FromRetryReasonPolicyArray(t []*types.RetryReasonPolicy) []*shared.RetryReasonPolicy {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.RetryReasonPolicy, len(t))
+	for i := range t {
+		v[i] = FromRetryReasonPolicy(t[i])
+	}
+	return v
+}
+
+// ToRetryReasonPolicyArray converts thrift RetryReasonPolicy type array to internal
+func ToRetryReasonPolicyArray(t []*shared.RetryReasonPolicy) []*types.RetryReasonPolicy {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.RetryReasonPolicy, len(t))
+	for i := range t {
+		v[i] = ToRetryReasonPolicy(t[i])
+	}
+	return v
+}
+
+// FromRetryJitterMode converts internal RetryJitterMode type to thrift
+func FromRetryJitterMode(t types.RetryJitterMode) *shared.RetryJitterMode {
+	switch t {
+	case types.RetryJitterModeNone:
+		v := shared.RetryJitterModeNone
+		return &v
+	case types.RetryJitterModeFull:
+		v := shared.RetryJitterModeFull
+		return &v
+	case types.RetryJitterModeEqual:
+		v := shared.RetryJitterModeEqual
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToRetryJitterMode converts thrift RetryJitterMode type to internal
+func ToRetryJitterMode(t *shared.RetryJitterMode) types.RetryJitterMode {
+	if t == nil {
+		return types.RetryJitterModeNone
+	}
+	switch *t {
+	case shared.RetryJitterModeNone:
+		return types.RetryJitterModeNone
+	case shared.RetryJitterModeFull:
+		return types.RetryJitterModeFull
+	case shared.RetryJitterModeEqual:
+		return types.RetryJitterModeEqual
+	}
+	panic("unexpected enum value")
+}
+
 // FromRetryTaskV2Error converts internal RetryTaskV2Error type to thrift
 func FromRetryTaskV2Error(t *types.RetryTaskV2Error) *shared.RetryTaskV2Error {
 	if t == nil {
@@ -4702,6 +5042,8 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
 		RequestLocalDispatch:          &t.RequestLocalDispatch,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -4723,6 +5065,8 @@ func ToScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDeci
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.GetRequestLocalDispatch(),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -4752,7 +5096,8 @@ func FromServiceBusyError(t *types.ServiceBusyError) *shared.ServiceBusyError {
 		return nil
 	}
 	return &shared.ServiceBusyError{
-		Message: t.Message,
+		Message:                t.Message,
+		RetryAfterMilliseconds: &t.RetryAfterMilliseconds,
 	}
 }
 
@@ -4762,7 +5107,8 @@ func ToServiceBusyError(t *shared.ServiceBusyError) *types.ServiceBusyError {
 		return nil
 	}
 	return &types.ServiceBusyError{
-		Message: t.Message,
+		Message:                t.Message,
+		RetryAfterMilliseconds: t.GetRetryAfterMilliseconds(),
 	}
 }
 
@@ -4908,6 +5254,8 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
+		IsolationGroup:                      &t.IsolationGroup,
+		WorkflowIdConflictPolicy:            FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 	}
 }
 
@@ -4935,6 +5283,8 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
+		IsolationGroup:                      t.GetIsolationGroup(),
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -4991,6 +5341,8 @@ func FromStartChildWorkflowExecutionDecisionAttributes(t *types.StartChildWorkfl
 		Header:                              FromHeader(t.Header),
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+		Priority:                            &t.Priority,
+		FairnessKey:                         &t.FairnessKey,
 	}
 }
 
@@ -5015,6 +5367,8 @@ func ToStartChildWorkflowExecutionDecisionAttributes(t *shared.StartChildWorkflo
 		Header:                              ToHeader(t.Header),
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+		Priority:                            t.GetPriority(),
+		FairnessKey:                         t.GetFairnessKey(),
 	}
 }
 
@@ -5072,6 +5426,8 @@ func FromStartChildWorkflowExecutionInitiatedEventAttributes(t *types.StartChild
 		Header:                              FromHeader(t.Header),
 		Memo:                                FromMemo(t.Memo),
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+		Priority:                            &t.Priority,
+		FairnessKey:                         &t.FairnessKey,
 	}
 }
 
@@ -5097,6 +5453,8 @@ func ToStartChildWorkflowExecutionInitiatedEventAttributes(t *shared.StartChildW
 		Header:                              ToHeader(t.Header),
 		Memo:                                ToMemo(t.Memo),
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+		Priority:                            t.GetPriority(),
+		FairnessKey:                         t.GetFairnessKey(),
 	}
 }
 
@@ -5166,6 +5524,10 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		Header:                              FromHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		Priority:                            &t.Priority,
+		FairnessKey:                         &t.FairnessKey,
+		IsolationGroup:                      &t.IsolationGroup,
+		WorkflowIdConflictPolicy:            FromWorkflowIDConflictPolicy(t.WorkflowIDConflictPolicy),
 	}
 }
 
@@ -5191,6 +5553,10 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		Header:                              ToHeader(t.Header),
 		DelayStartSeconds:                   t.DelayStartSeconds,
+		Priority:                            t.GetPriority(),
+		FairnessKey:                         t.GetFairnessKey(),
+		IsolationGroup:                      t.GetIsolationGroup(),
+		WorkflowIDConflictPolicy:            ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy),
 	}
 }
 
@@ -5376,17 +5742,50 @@ func ToTaskListPartitionMetadata(t *shared.TaskListPartitionMetadata) *types.Tas
 	}
 }
 
+// FromTaskListPartitionConfig converts internal TaskListPartitionConfig type to thrift
+func FromTaskListPartitionConfig(t *types.TaskListPartitionConfig) *shared.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskListPartitionConfig{
+		NumReadPartitions:  &t.NumReadPartitions,
+		NumWritePartitions: &t.NumWritePartitions,
+		ScalingEnabled:     &t.ScalingEnabled,
+	}
+}
+
+// ToTaskListPartitionConfig converts thrift TaskListPartitionConfig type to internal
+func ToTaskListPartitionConfig(t *shared.TaskListPartitionConfig) *types.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListPartitionConfig{
+		NumReadPartitions:  t.GetNumReadPartitions(),
+		NumWritePartitions: t.GetNumWritePartitions(),
+		ScalingEnabled:     t.GetScalingEnabled(),
+	}
+}
+
 // FromTaskListStatus converts internal TaskListStatus type to thrift
 func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 	if t == nil {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint: &t.BacklogCountHint,
-		ReadLevel:        &t.ReadLevel,
-		AckLevel:         &t.AckLevel,
-		RatePerSecond:    &t.RatePerSecond,
-		TaskIDBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:               &t.BacklogCountHint,
+		ReadLevel:                      &t.ReadLevel,
+		AckLevel:                       &t.AckLevel,
+		RatePerSecond:                  &t.RatePerSecond,
+		TaskIDBlock:                    FromTaskIDBlock(t.TaskIDBlock),
+		AddRatePerSecond:               &t.AddRatePerSecond,
+		DispatchRatePerSecond:          &t.DispatchRatePerSecond,
+		SyncMatchRatio:                 &t.SyncMatchRatio,
+		OldestTaskAgeSeconds:           &t.OldestTaskAgeSeconds,
+		IngestionRPSLimit:              &t.IngestionRPSLimit,
+		DomainIngestionRPSLimit:        &t.DomainIngestionRPSLimit,
+		DomainDispatchRPSLimit:         &t.DomainDispatchRPSLimit,
+		ThrottledAddRatePerSecond:      &t.ThrottledAddRatePerSecond,
+		ThrottledDispatchRatePerSecond: &t.ThrottledDispatchRatePerSecond,
 	}
 }
 
@@ -5396,11 +5795,20 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint: t.GetBacklogCountHint(),
-		ReadLevel:        t.GetReadLevel(),
-		AckLevel:         t.GetAckLevel(),
-		RatePerSecond:    t.GetRatePerSecond(),
-		TaskIDBlock:      ToTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:               t.GetBacklogCountHint(),
+		ReadLevel:                      t.GetReadLevel(),
+		AckLevel:                       t.GetAckLevel(),
+		RatePerSecond:                  t.GetRatePerSecond(),
+		TaskIDBlock:                    ToTaskIDBlock(t.TaskIDBlock),
+		AddRatePerSecond:               t.GetAddRatePerSecond(),
+		DispatchRatePerSecond:          t.GetDispatchRatePerSecond(),
+		SyncMatchRatio:                 t.GetSyncMatchRatio(),
+		OldestTaskAgeSeconds:           t.GetOldestTaskAgeSeconds(),
+		IngestionRPSLimit:              t.GetIngestionRPSLimit(),
+		DomainIngestionRPSLimit:        t.GetDomainIngestionRPSLimit(),
+		DomainDispatchRPSLimit:         t.GetDomainDispatchRPSLimit(),
+		ThrottledAddRatePerSecond:      t.GetThrottledAddRatePerSecond(),
+		ThrottledDispatchRatePerSecond: t.GetThrottledDispatchRatePerSecond(),
 	}
 }
 
@@ -5447,6 +5855,7 @@ func FromTerminateWorkflowExecutionRequest(t *types.TerminateWorkflowExecutionRe
 		Reason:            &t.Reason,
 		Details:           t.Details,
 		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
 	}
 }
 
@@ -5461,6 +5870,31 @@ func ToTerminateWorkflowExecutionRequest(t *shared.TerminateWorkflowExecutionReq
 		Reason:            t.GetReason(),
 		Details:           t.Details,
 		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromExpediteWorkflowExecutionStartRequest converts internal ExpediteWorkflowExecutionStartRequest type to thrift
+func FromExpediteWorkflowExecutionStartRequest(t *types.ExpediteWorkflowExecutionStartRequest) *shared.ExpediteWorkflowExecutionStartRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ExpediteWorkflowExecutionStartRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          &t.Identity,
+	}
+}
+
+// ToExpediteWorkflowExecutionStartRequest converts thrift ExpediteWorkflowExecutionStartRequest type to internal
+func ToExpediteWorkflowExecutionStartRequest(t *shared.ExpediteWorkflowExecutionStartRequest) *types.ExpediteWorkflowExecutionStartRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ExpediteWorkflowExecutionStartRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
 	}
 }
 
@@ -5626,7 +6060,8 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 		t.HistoryArchivalStatus != nil ||
 		t.HistoryArchivalURI != nil ||
 		t.VisibilityArchivalStatus != nil ||
-		t.VisibilityArchivalURI != nil {
+		t.VisibilityArchivalURI != nil ||
+		t.DrainedIsolationGroups != nil {
 		request.Configuration = &shared.DomainConfiguration{
 			WorkflowExecutionRetentionPeriodInDays: t.WorkflowExecutionRetentionPeriodInDays,
 			EmitMetric:                             t.EmitMetric,
@@ -5635,6 +6070,7 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 			HistoryArchivalURI:                     t.HistoryArchivalURI,
 			VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.VisibilityArchivalURI,
+			DrainedIsolationGroups:                 t.DrainedIsolationGroups,
 		}
 	}
 	if t.ActiveClusterName != nil || t.Clusters != nil {
@@ -5670,6 +6106,7 @@ func ToUpdateDomainRequest(t *shared.UpdateDomainRequest) *types.UpdateDomainReq
 		request.HistoryArchivalURI = t.Configuration.HistoryArchivalURI
 		request.VisibilityArchivalStatus = ToArchivalStatus(t.Configuration.VisibilityArchivalStatus)
 		request.VisibilityArchivalURI = t.Configuration.VisibilityArchivalURI
+		request.DrainedIsolationGroups = t.Configuration.DrainedIsolationGroups
 	}
 	if t.ReplicationConfiguration != nil {
 		request.ActiveClusterName = t.ReplicationConfiguration.ActiveClusterName
@@ -5748,6 +6185,48 @@ func ToUpsertWorkflowSearchAttributesEventAttributes(t *shared.UpsertWorkflowSea
 	}
 }
 
+// FromUpsertWorkflowMemoDecisionAttributes converts internal UpsertWorkflowMemoDecisionAttributes type to thrift
+func FromUpsertWorkflowMemoDecisionAttributes(t *types.UpsertWorkflowMemoDecisionAttributes) *shared.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowMemoDecisionAttributes{
+		Memo: FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowMemoDecisionAttributes converts thrift UpsertWorkflowMemoDecisionAttributes type to internal
+func ToUpsertWorkflowMemoDecisionAttributes(t *shared.UpsertWorkflowMemoDecisionAttributes) *types.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: ToMemo(t.Memo),
+	}
+}
+
+// FromUpsertWorkflowMemoEventAttributes converts internal UpsertWorkflowMemoEventAttributes type to thrift
+func FromUpsertWorkflowMemoEventAttributes(t *types.UpsertWorkflowMemoEventAttributes) *shared.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		Memo:                         FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowMemoEventAttributes converts thrift UpsertWorkflowMemoEventAttributes type to internal
+func ToUpsertWorkflowMemoEventAttributes(t *shared.UpsertWorkflowMemoEventAttributes) *types.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		Memo:                         ToMemo(t.Memo),
+	}
+}
+
 // FromVersionHistories converts internal VersionHistories type to thrift
 func FromVersionHistories(t *types.VersionHistories) *shared.VersionHistories {
 	if t == nil {
@@ -5892,6 +6371,7 @@ func FromWorkflowExecutionCancelRequestedEventAttributes(t *types.WorkflowExecut
 		ExternalInitiatedEventId:  t.ExternalInitiatedEventID,
 		ExternalWorkflowExecution: FromWorkflowExecution(t.ExternalWorkflowExecution),
 		Identity:                  &t.Identity,
+		RequestId:                 &t.RequestID,
 	}
 }
 
@@ -5905,6 +6385,7 @@ func ToWorkflowExecutionCancelRequestedEventAttributes(t *shared.WorkflowExecuti
 		ExternalInitiatedEventID:  t.ExternalInitiatedEventId,
 		ExternalWorkflowExecution: ToWorkflowExecution(t.ExternalWorkflowExecution),
 		Identity:                  t.GetIdentity(),
+		RequestID:                 t.GetRequestId(),
 	}
 }
 
@@ -6132,20 +6613,21 @@ func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *shared.WorkflowE
 		return nil
 	}
 	return &shared.WorkflowExecutionInfo{
-		Execution:        FromWorkflowExecution(t.Execution),
-		Type:             FromWorkflowType(t.Type),
-		StartTime:        t.StartTime,
-		CloseTime:        t.CloseTime,
-		CloseStatus:      FromWorkflowExecutionCloseStatus(t.CloseStatus),
-		HistoryLength:    &t.HistoryLength,
-		ParentDomainId:   t.ParentDomainID,
-		ParentExecution:  FromWorkflowExecution(t.ParentExecution),
-		ExecutionTime:    t.ExecutionTime,
-		Memo:             FromMemo(t.Memo),
-		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
-		AutoResetPoints:  FromResetPoints(t.AutoResetPoints),
-		TaskList:         &t.TaskList,
-		IsCron:           &t.IsCron,
+		Execution:          FromWorkflowExecution(t.Execution),
+		Type:               FromWorkflowType(t.Type),
+		StartTime:          t.StartTime,
+		CloseTime:          t.CloseTime,
+		CloseStatus:        FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		HistoryLength:      &t.HistoryLength,
+		ParentDomainId:     t.ParentDomainID,
+		ParentExecution:    FromWorkflowExecution(t.ParentExecution),
+		ExecutionTime:      t.ExecutionTime,
+		Memo:               FromMemo(t.Memo),
+		SearchAttributes:   FromSearchAttributes(t.SearchAttributes),
+		AutoResetPoints:    FromResetPoints(t.AutoResetPoints),
+		TaskList:           &t.TaskList,
+		IsCron:             &t.IsCron,
+		NextRetryTimestamp: t.NextRetryTimestamp,
 	}
 }
 
@@ -6155,20 +6637,21 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 		return nil
 	}
 	return &types.WorkflowExecutionInfo{
-		Execution:        ToWorkflowExecution(t.Execution),
-		Type:             ToWorkflowType(t.Type),
-		StartTime:        t.StartTime,
-		CloseTime:        t.CloseTime,
-		CloseStatus:      ToWorkflowExecutionCloseStatus(t.CloseStatus),
-		HistoryLength:    t.GetHistoryLength(),
-		ParentDomainID:   t.ParentDomainId,
-		ParentExecution:  ToWorkflowExecution(t.ParentExecution),
-		ExecutionTime:    t.ExecutionTime,
-		Memo:             ToMemo(t.Memo),
-		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
-		AutoResetPoints:  ToResetPoints(t.AutoResetPoints),
-		TaskList:         t.GetTaskList(),
-		IsCron:           t.GetIsCron(),
+		Execution:          ToWorkflowExecution(t.Execution),
+		Type:               ToWorkflowType(t.Type),
+		StartTime:          t.StartTime,
+		CloseTime:          t.CloseTime,
+		CloseStatus:        ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		HistoryLength:      t.GetHistoryLength(),
+		ParentDomainID:     t.ParentDomainId,
+		ParentExecution:    ToWorkflowExecution(t.ParentExecution),
+		ExecutionTime:      t.ExecutionTime,
+		Memo:               ToMemo(t.Memo),
+		SearchAttributes:   ToSearchAttributes(t.SearchAttributes),
+		AutoResetPoints:    ToResetPoints(t.AutoResetPoints),
+		TaskList:           t.GetTaskList(),
+		IsCron:             t.GetIsCron(),
+		NextRetryTimestamp: t.NextRetryTimestamp,
 	}
 }
 
@@ -6181,6 +6664,7 @@ func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSign
 		SignalName: &t.SignalName,
 		Input:      t.Input,
 		Identity:   &t.Identity,
+		RequestId:  &t.RequestID,
 	}
 }
 
@@ -6193,6 +6677,7 @@ func ToWorkflowExecutionSignaledEventAttributes(t *shared.WorkflowExecutionSigna
 		SignalName: t.GetSignalName(),
 		Input:      t.Input,
 		Identity:   t.GetIdentity(),
+		RequestID:  t.GetRequestId(),
 	}
 }
 
@@ -6227,6 +6712,11 @@ func FromWorkflowExecutionStartedEventAttributes(t *types.WorkflowExecutionStart
 		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 FromResetPoints(t.PrevAutoResetPoints),
 		Header:                              FromHeader(t.Header),
+		Priority:                            &t.Priority,
+		FairnessKey:                         &t.FairnessKey,
+		IsolationGroup:                      &t.IsolationGroup,
+		RetryElapsedExpirationTimestamp:     t.RetryElapsedExpirationTimestamp,
+		DelayStartSeconds:                   t.DelayStartSeconds,
 	}
 }
 
@@ -6261,6 +6751,11 @@ func ToWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStarte
 		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
 		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
 		Header:                              ToHeader(t.Header),
+		Priority:                            t.GetPriority(),
+		FairnessKey:                         t.GetFairnessKey(),
+		IsolationGroup:                      t.GetIsolationGroup(),
+		RetryElapsedExpirationTimestamp:     t.RetryElapsedExpirationTimestamp,
+		DelayStartSeconds:                   t.DelayStartSeconds,
 	}
 }
 
@@ -6270,9 +6765,10 @@ func FromWorkflowExecutionTerminatedEventAttributes(t *types.WorkflowExecutionTe
 		return nil
 	}
 	return &shared.WorkflowExecutionTerminatedEventAttributes{
-		Reason:   &t.Reason,
-		Details:  t.Details,
-		Identity: &t.Identity,
+		Reason:    &t.Reason,
+		Details:   t.Details,
+		Identity:  &t.Identity,
+		RequestId: &t.RequestID,
 	}
 }
 
@@ -6282,9 +6778,10 @@ func ToWorkflowExecutionTerminatedEventAttributes(t *shared.WorkflowExecutionTer
 		return nil
 	}
 	return &types.WorkflowExecutionTerminatedEventAttributes{
-		Reason:   t.GetReason(),
-		Details:  t.Details,
-		Identity: t.GetIdentity(),
+		Reason:    t.GetReason(),
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+		RequestID: t.GetRequestId(),
 	}
 }
 
@@ -6308,6 +6805,44 @@ func ToWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimed
 	}
 }
 
+// FromWorkflowIDConflictPolicy converts internal WorkflowIDConflictPolicy type to thrift
+func FromWorkflowIDConflictPolicy(t *types.WorkflowIDConflictPolicy) *shared.WorkflowIdConflictPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.WorkflowIDConflictPolicyFail:
+		v := shared.WorkflowIdConflictPolicyFail
+		return &v
+	case types.WorkflowIDConflictPolicyUseExisting:
+		v := shared.WorkflowIdConflictPolicyUseExisting
+		return &v
+	case types.WorkflowIDConflictPolicyTerminateExisting:
+		v := shared.WorkflowIdConflictPolicyTerminateExisting
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToWorkflowIDConflictPolicy converts thrift WorkflowIdConflictPolicy type to internal
+func ToWorkflowIDConflictPolicy(t *shared.WorkflowIdConflictPolicy) *types.WorkflowIDConflictPolicy {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.WorkflowIdConflictPolicyFail:
+		v := types.WorkflowIDConflictPolicyFail
+		return &v
+	case shared.WorkflowIdConflictPolicyUseExisting:
+		v := types.WorkflowIDConflictPolicyUseExisting
+		return &v
+	case shared.WorkflowIdConflictPolicyTerminateExisting:
+		v := types.WorkflowIDConflictPolicyTerminateExisting
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromWorkflowIDReusePolicy converts internal WorkflowIDReusePolicy type to thrift
 func FromWorkflowIDReusePolicy(t *types.WorkflowIDReusePolicy) *shared.WorkflowIdReusePolicy {
 	if t == nil {
@@ -6690,6 +7225,30 @@ func FromTaskListPartitionMetadataArray(t []*types.TaskListPartitionMetadata) []
 	return v
 }
 
+// FromWorkerVersionSetArray converts internal WorkerVersionSet type array to thrift
+func FromWorkerVersionSetArray(t []*types.WorkerVersionSet) []*shared.WorkerVersionSet {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkerVersionSet, len(t))
+	for i := range t {
+		v[i] = FromWorkerVersionSet(t[i])
+	}
+	return v
+}
+
+// ToWorkerVersionSetArray converts thrift WorkerVersionSet type array to internal
+func ToWorkerVersionSetArray(t []*shared.WorkerVersionSet) []*types.WorkerVersionSet {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkerVersionSet, len(t))
+	for i := range t {
+		v[i] = ToWorkerVersionSet(t[i])
+	}
+	return v
+}
+
 // ToTaskListPartitionMetadataArray converts thrift TaskListPartitionMetadata type array to internal
 func ToTaskListPartitionMetadataArray(t []*shared.TaskListPartitionMetadata) []*types.TaskListPartitionMetadata {
 	if t == nil {
@@ -6894,6 +7453,30 @@ func ToBadBinaryInfoMap(t map[string]*shared.BadBinaryInfo) map[string]*types.Ba
 	return v
 }
 
+// FromTaskListStatusMap converts internal TaskListStatus type map to thrift
+func FromTaskListStatusMap(t map[string]*types.TaskListStatus) map[string]*shared.TaskListStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*shared.TaskListStatus, len(t))
+	for key := range t {
+		v[key] = FromTaskListStatus(t[key])
+	}
+	return v
+}
+
+// ToTaskListStatusMap converts thrift TaskListStatus type map to internal
+func ToTaskListStatusMap(t map[string]*shared.TaskListStatus) map[string]*types.TaskListStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.TaskListStatus, len(t))
+	for key := range t {
+		v[key] = ToTaskListStatus(t[key])
+	}
+	return v
+}
+
 // FromHistoryArray converts internal History array to thrift
 func FromHistoryArray(t []*types.History) []*shared.History {
 	if t == nil {
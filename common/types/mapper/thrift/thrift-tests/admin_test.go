@@ -0,0 +1,175 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package thrifttests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestGetDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.GetDynamicConfigRequest{nil, {}, &testdata.AdminGetDynamicConfigRequest} {
+		assert.Equal(t, item, thrift.ToGetDynamicConfigRequest(thrift.FromGetDynamicConfigRequest(item)))
+	}
+}
+
+func TestGetDynamicConfigResponse(t *testing.T) {
+	for _, item := range []*types.GetDynamicConfigResponse{nil, {}, &testdata.AdminGetDynamicConfigResponse} {
+		assert.Equal(t, item, thrift.ToGetDynamicConfigResponse(thrift.FromGetDynamicConfigResponse(item)))
+	}
+}
+
+func TestUpdateDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.UpdateDynamicConfigRequest{nil, {}, &testdata.AdminUpdateDynamicConfigRequest} {
+		assert.Equal(t, item, thrift.ToUpdateDynamicConfigRequest(thrift.FromUpdateDynamicConfigRequest(item)))
+	}
+}
+
+func TestRestoreDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.RestoreDynamicConfigRequest{nil, {}, &testdata.AdminRestoreDynamicConfigRequest} {
+		assert.Equal(t, item, thrift.ToRestoreDynamicConfigRequest(thrift.FromRestoreDynamicConfigRequest(item)))
+	}
+}
+
+func TestListDynamicConfigRequest(t *testing.T) {
+	for _, item := range []*types.ListDynamicConfigRequest{nil, {}, &testdata.AdminListDynamicConfigRequest} {
+		assert.Equal(t, item, thrift.ToListDynamicConfigRequest(thrift.FromListDynamicConfigRequest(item)))
+	}
+}
+
+func TestListDynamicConfigResponse(t *testing.T) {
+	for _, item := range []*types.ListDynamicConfigResponse{nil, {}, &testdata.AdminListDynamicConfigResponse} {
+		assert.Equal(t, item, thrift.ToListDynamicConfigResponse(thrift.FromListDynamicConfigResponse(item)))
+	}
+}
+
+func TestPauseTaskListRequest(t *testing.T) {
+	for _, item := range []*types.PauseTaskListRequest{nil, {}, &testdata.AdminPauseTaskListRequest} {
+		assert.Equal(t, item, thrift.ToPauseTaskListRequest(thrift.FromPauseTaskListRequest(item)))
+	}
+}
+
+func TestResumeTaskListRequest(t *testing.T) {
+	for _, item := range []*types.ResumeTaskListRequest{nil, {}, &testdata.AdminResumeTaskListRequest} {
+		assert.Equal(t, item, thrift.ToResumeTaskListRequest(thrift.FromResumeTaskListRequest(item)))
+	}
+}
+
+func TestMigrateTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MigrateTaskListRequest{nil, {}, &testdata.AdminMigrateTaskListRequest} {
+		assert.Equal(t, item, thrift.ToMigrateTaskListRequest(thrift.FromMigrateTaskListRequest(item)))
+	}
+}
+
+func TestTaskListDLQEntry(t *testing.T) {
+	for _, item := range []*types.TaskListDLQEntry{nil, {}, &testdata.AdminTaskListDLQEntry} {
+		assert.Equal(t, item, thrift.ToTaskListDLQEntry(thrift.FromTaskListDLQEntry(item)))
+	}
+}
+
+func TestReadTaskListDLQRequest(t *testing.T) {
+	for _, item := range []*types.ReadTaskListDLQRequest{nil, {}, &testdata.AdminReadTaskListDLQRequest} {
+		assert.Equal(t, item, thrift.ToReadTaskListDLQRequest(thrift.FromReadTaskListDLQRequest(item)))
+	}
+}
+
+func TestReadTaskListDLQResponse(t *testing.T) {
+	for _, item := range []*types.ReadTaskListDLQResponse{nil, {}, &testdata.AdminReadTaskListDLQResponse} {
+		assert.Equal(t, item, thrift.ToReadTaskListDLQResponse(thrift.FromReadTaskListDLQResponse(item)))
+	}
+}
+
+func TestDescribeTaskListDLQEntryRequest(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListDLQEntryRequest{nil, {}, &testdata.AdminDescribeTaskListDLQEntryRequest} {
+		assert.Equal(t, item, thrift.ToDescribeTaskListDLQEntryRequest(thrift.FromDescribeTaskListDLQEntryRequest(item)))
+	}
+}
+
+func TestDescribeTaskListDLQEntryResponse(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListDLQEntryResponse{nil, {}, &testdata.AdminDescribeTaskListDLQEntryResponse} {
+		assert.Equal(t, item, thrift.ToDescribeTaskListDLQEntryResponse(thrift.FromDescribeTaskListDLQEntryResponse(item)))
+	}
+}
+
+func TestRedriveTaskListDLQEntryRequest(t *testing.T) {
+	for _, item := range []*types.RedriveTaskListDLQEntryRequest{nil, {}, &testdata.AdminRedriveTaskListDLQEntryRequest} {
+		assert.Equal(t, item, thrift.ToRedriveTaskListDLQEntryRequest(thrift.FromRedriveTaskListDLQEntryRequest(item)))
+	}
+}
+
+func TestFailTaskListDLQEntryRequest(t *testing.T) {
+	for _, item := range []*types.FailTaskListDLQEntryRequest{nil, {}, &testdata.AdminFailTaskListDLQEntryRequest} {
+		assert.Equal(t, item, thrift.ToFailTaskListDLQEntryRequest(thrift.FromFailTaskListDLQEntryRequest(item)))
+	}
+}
+
+func TestDescribeShardDistributionResponse(t *testing.T) {
+	for _, item := range []*types.DescribeShardDistributionResponse{nil, {}, &testdata.AdminDescribeShardDistributionResponse} {
+		assert.Equal(t, item, thrift.ToDescribeShardDistributionResponse(thrift.FromDescribeShardDistributionResponse(item)))
+	}
+}
+
+func TestMoveShardRequest(t *testing.T) {
+	for _, item := range []*types.MoveShardRequest{nil, {}, &testdata.AdminMoveShardRequest} {
+		assert.Equal(t, item, thrift.ToMoveShardRequest(thrift.FromMoveShardRequest(item)))
+	}
+}
+
+func TestUnpinShardRequest(t *testing.T) {
+	for _, item := range []*types.UnpinShardRequest{nil, {}, &testdata.AdminUnpinShardRequest} {
+		assert.Equal(t, item, thrift.ToUnpinShardRequest(thrift.FromUnpinShardRequest(item)))
+	}
+}
+
+func TestListQuarantinedWorkflowsRequest(t *testing.T) {
+	for _, item := range []*types.ListQuarantinedWorkflowsRequest{nil, {}, &testdata.AdminListQuarantinedWorkflowsRequest} {
+		assert.Equal(t, item, thrift.ToListQuarantinedWorkflowsRequest(thrift.FromListQuarantinedWorkflowsRequest(item)))
+	}
+}
+
+func TestListQuarantinedWorkflowsResponse(t *testing.T) {
+	for _, item := range []*types.ListQuarantinedWorkflowsResponse{nil, {}, &testdata.AdminListQuarantinedWorkflowsResponse} {
+		assert.Equal(t, item, thrift.ToListQuarantinedWorkflowsResponse(thrift.FromListQuarantinedWorkflowsResponse(item)))
+	}
+}
+
+func TestReleaseQuarantinedWorkflowRequest(t *testing.T) {
+	for _, item := range []*types.ReleaseQuarantinedWorkflowRequest{nil, {}, &testdata.AdminReleaseQuarantinedWorkflowRequest} {
+		assert.Equal(t, item, thrift.ToReleaseQuarantinedWorkflowRequest(thrift.FromReleaseQuarantinedWorkflowRequest(item)))
+	}
+}
+
+func TestCheckWorkflowBuildRequest(t *testing.T) {
+	for _, item := range []*types.CheckWorkflowBuildRequest{nil, {}, &testdata.AdminCheckWorkflowBuildRequest} {
+		assert.Equal(t, item, thrift.ToCheckWorkflowBuildRequest(thrift.FromCheckWorkflowBuildRequest(item)))
+	}
+}
+
+func TestCheckWorkflowBuildResponse(t *testing.T) {
+	for _, item := range []*types.CheckWorkflowBuildResponse{nil, {}, &testdata.AdminCheckWorkflowBuildResponse} {
+		assert.Equal(t, item, thrift.ToCheckWorkflowBuildResponse(thrift.FromCheckWorkflowBuildResponse(item)))
+	}
+}
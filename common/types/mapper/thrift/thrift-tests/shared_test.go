@@ -125,3 +125,169 @@ func TestGetTaskListsByDomainResponse(t *testing.T) {
 		assert.Equal(t, item, thrift.ToGetTaskListsByDomainResponse(thrift.FromGetTaskListsByDomainResponse(item)))
 	}
 }
+
+func TestDescribeHistoryHostResponse(t *testing.T) {
+	for _, item := range []*types.DescribeHistoryHostResponse{nil, {}, &testdata.AdminDescribeHistoryHostResponse} {
+		assert.Equal(t, item, thrift.ToDescribeHistoryHostResponse(thrift.FromDescribeHistoryHostResponse(item)))
+	}
+}
+
+func TestDescribeTaskListRequest(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListRequest{nil, {}, &testdata.DescribeTaskListRequest} {
+		assert.Equal(t, item, thrift.ToDescribeTaskListRequest(thrift.FromDescribeTaskListRequest(item)))
+	}
+}
+
+func TestDescribeTaskListResponse(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListResponse{nil, {}, &testdata.DescribeTaskListResponse} {
+		assert.Equal(t, item, thrift.ToDescribeTaskListResponse(thrift.FromDescribeTaskListResponse(item)))
+	}
+}
+
+func TestTaskListStatusMap(t *testing.T) {
+	for _, item := range []map[string]*types.TaskListStatus{nil, {}, testdata.TaskListStatusMap} {
+		assert.Equal(t, item, thrift.ToTaskListStatusMap(thrift.FromTaskListStatusMap(item)))
+	}
+}
+
+func TestListTaskListPartitionsResponse(t *testing.T) {
+	for _, item := range []*types.ListTaskListPartitionsResponse{nil, {}, &testdata.ListTaskListPartitionsResponse} {
+		assert.Equal(t, item, thrift.ToListTaskListPartitionsResponse(thrift.FromListTaskListPartitionsResponse(item)))
+	}
+}
+
+func TestTaskListPartitionConfig(t *testing.T) {
+	for _, item := range []*types.TaskListPartitionConfig{nil, {}, &testdata.TaskListPartitionConfig} {
+		assert.Equal(t, item, thrift.ToTaskListPartitionConfig(thrift.FromTaskListPartitionConfig(item)))
+	}
+}
+
+func TestUpdateWorkerBuildIDCompatibilityRequest(t *testing.T) {
+	for _, item := range []*types.UpdateWorkerBuildIDCompatibilityRequest{nil, {}, &testdata.UpdateWorkerBuildIDCompatibilityRequest} {
+		assert.Equal(t, item, thrift.ToUpdateWorkerBuildIDCompatibilityRequest(thrift.FromUpdateWorkerBuildIDCompatibilityRequest(item)))
+	}
+}
+
+func TestGetWorkerBuildIDCompatibilityResponse(t *testing.T) {
+	for _, item := range []*types.GetWorkerBuildIDCompatibilityResponse{nil, {}, &testdata.GetWorkerBuildIDCompatibilityResponse} {
+		assert.Equal(t, item, thrift.ToGetWorkerBuildIDCompatibilityResponse(thrift.FromGetWorkerBuildIDCompatibilityResponse(item)))
+	}
+}
+
+func TestPollForActivityTaskRequest(t *testing.T) {
+	for _, item := range []*types.PollForActivityTaskRequest{nil, {}, &testdata.PollForActivityTaskRequest} {
+		assert.Equal(t, item, thrift.ToPollForActivityTaskRequest(thrift.FromPollForActivityTaskRequest(item)))
+	}
+}
+
+func TestMatchingAddDecisionTaskRequest(t *testing.T) {
+	for _, item := range []*types.AddDecisionTaskRequest{nil, {}, &testdata.MatchingAddDecisionTaskRequest} {
+		assert.Equal(t, item, thrift.ToAddDecisionTaskRequest(thrift.FromAddDecisionTaskRequest(item)))
+	}
+}
+
+func TestMatchingGetWorkerBuildIDCompatibilityRequest(t *testing.T) {
+	for _, item := range []*types.MatchingGetWorkerBuildIDCompatibilityRequest{nil, {}, &testdata.MatchingGetWorkerBuildIDCompatibilityRequest} {
+		assert.Equal(t, item, thrift.ToMatchingGetWorkerBuildIDCompatibilityRequest(thrift.FromMatchingGetWorkerBuildIDCompatibilityRequest(item)))
+	}
+}
+
+func TestServiceBusyError(t *testing.T) {
+	for _, item := range []*types.ServiceBusyError{nil, {}, &testdata.ServiceBusyError} {
+		assert.Equal(t, item, thrift.ToServiceBusyError(thrift.FromServiceBusyError(item)))
+	}
+}
+
+func TestUpsertWorkflowMemoHistoryEvent(t *testing.T) {
+	for _, item := range []*types.HistoryEvent{nil, &testdata.HistoryEvent_UpsertWorkflowMemo} {
+		assert.Equal(t, item, thrift.ToHistoryEvent(thrift.FromHistoryEvent(item)))
+	}
+}
+
+func TestUpsertWorkflowMemoDecision(t *testing.T) {
+	for _, item := range []*types.Decision{nil, &testdata.Decision_UpsertWorkflowMemo} {
+		assert.Equal(t, item, thrift.ToDecision(thrift.FromDecision(item)))
+	}
+}
+
+func TestEventType(t *testing.T) {
+	for _, item := range types.EventTypeValues() {
+		assert.Equal(t, &item, thrift.ToEventType(thrift.FromEventType(&item)))
+	}
+	assert.Panics(t, func() { thrift.FromEventType(types.EventType(9999).Ptr()) })
+}
+
+func TestDecisionType(t *testing.T) {
+	for _, item := range types.DecisionTypeValues() {
+		assert.Equal(t, &item, thrift.ToDecisionType(thrift.FromDecisionType(&item)))
+	}
+	assert.Panics(t, func() { thrift.FromDecisionType(types.DecisionType(9999).Ptr()) })
+}
+
+func TestDecisionTaskFailedCause(t *testing.T) {
+	for _, item := range []types.DecisionTaskFailedCause{
+		types.DecisionTaskFailedCauseBadSearchAttributes,
+		types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes,
+	} {
+		assert.Equal(t, &item, thrift.ToDecisionTaskFailedCause(thrift.FromDecisionTaskFailedCause(&item)))
+	}
+	assert.Panics(t, func() { thrift.FromDecisionTaskFailedCause(types.DecisionTaskFailedCause(9999).Ptr()) })
+}
+
+func TestRetryPolicy(t *testing.T) {
+	for _, item := range []*types.RetryPolicy{nil, &testdata.RetryPolicy} {
+		assert.Equal(t, item, thrift.ToRetryPolicy(thrift.FromRetryPolicy(item)))
+	}
+	assert.Panics(t, func() { thrift.FromRetryJitterMode(types.RetryJitterMode(9999)) })
+}
+
+func TestRetryTimestamps(t *testing.T) {
+	assert.Equal(t, &testdata.PendingActivityInfo, thrift.ToPendingActivityInfo(thrift.FromPendingActivityInfo(&testdata.PendingActivityInfo)))
+	assert.Equal(t, &testdata.HistoryStartWorkflowExecutionRequest, thrift.ToHistoryStartWorkflowExecutionRequest(thrift.FromHistoryStartWorkflowExecutionRequest(&testdata.HistoryStartWorkflowExecutionRequest)))
+	attributes := thrift.ToWorkflowExecutionStartedEventAttributes(thrift.FromWorkflowExecutionStartedEventAttributes(&testdata.WorkflowExecutionStartedEventAttributes))
+	assert.Equal(t, testdata.WorkflowExecutionStartedEventAttributes.RetryElapsedExpirationTimestamp, attributes.RetryElapsedExpirationTimestamp)
+	assert.Equal(t, testdata.WorkflowExecutionStartedEventAttributes.RetryPolicy, attributes.RetryPolicy)
+	assert.Equal(t, testdata.WorkflowExecutionInfo.NextRetryTimestamp, thrift.ToWorkflowExecutionInfo(thrift.FromWorkflowExecutionInfo(&testdata.WorkflowExecutionInfo)).NextRetryTimestamp)
+}
+
+func TestWorkflowIDConflictPolicy(t *testing.T) {
+	for _, item := range []*types.WorkflowIDConflictPolicy{
+		nil,
+		types.WorkflowIDConflictPolicyFail.Ptr(),
+		types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+		types.WorkflowIDConflictPolicyTerminateExisting.Ptr(),
+	} {
+		assert.Equal(t, item, thrift.ToWorkflowIDConflictPolicy(thrift.FromWorkflowIDConflictPolicy(item)))
+	}
+	assert.Panics(t, func() { thrift.FromWorkflowIDConflictPolicy(types.WorkflowIDConflictPolicy(9999).Ptr()) })
+}
+
+func TestStartWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.StartWorkflowExecutionRequest{nil, {}, &testdata.StartWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToStartWorkflowExecutionRequest(thrift.FromStartWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestSignalWithStartWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.SignalWithStartWorkflowExecutionRequest{nil, {}, &testdata.SignalWithStartWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToSignalWithStartWorkflowExecutionRequest(thrift.FromSignalWithStartWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestTerminateWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.TerminateWorkflowExecutionRequest{nil, {}, &testdata.TerminateWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToTerminateWorkflowExecutionRequest(thrift.FromTerminateWorkflowExecutionRequest(item)))
+	}
+}
+
+func TestRequestIDEventAttributes(t *testing.T) {
+	for _, item := range []*types.WorkflowExecutionSignaledEventAttributes{nil, {}, &testdata.WorkflowExecutionSignaledEventAttributes} {
+		assert.Equal(t, item, thrift.ToWorkflowExecutionSignaledEventAttributes(thrift.FromWorkflowExecutionSignaledEventAttributes(item)))
+	}
+	for _, item := range []*types.WorkflowExecutionCancelRequestedEventAttributes{nil, {}, &testdata.WorkflowExecutionCancelRequestedEventAttributes} {
+		assert.Equal(t, item, thrift.ToWorkflowExecutionCancelRequestedEventAttributes(thrift.FromWorkflowExecutionCancelRequestedEventAttributes(item)))
+	}
+	for _, item := range []*types.WorkflowExecutionTerminatedEventAttributes{nil, {}, &testdata.WorkflowExecutionTerminatedEventAttributes} {
+		assert.Equal(t, item, thrift.ToWorkflowExecutionTerminatedEventAttributes(thrift.FromWorkflowExecutionTerminatedEventAttributes(item)))
+	}
+}
@@ -36,6 +36,12 @@ type AddActivityTaskRequest struct {
 	ScheduleToStartTimeoutSeconds *int32             `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
+	Priority                      int32              `json:"priority,omitempty"`
+	FairnessKey                   string             `json:"fairnessKey,omitempty"`
+	BuildID                       string             `json:"buildId,omitempty"`
+	IsolationGroup                string             `json:"isolationGroup,omitempty"`
+	Attempt                       int64              `json:"attempt,omitempty"`
+	LastHeartbeatTimestamp        *int64             `json:"lastHeartbeatTimestamp,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -102,6 +108,54 @@ func (v *AddActivityTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
+// GetBuildID is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetAttempt() (o int64) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetLastHeartbeatTimestamp is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetLastHeartbeatTimestamp() (o int64) {
+	if v != nil && v.LastHeartbeatTimestamp != nil {
+		return *v.LastHeartbeatTimestamp
+	}
+	return
+}
+
 // AddDecisionTaskRequest is an internal type (TBD...)
 type AddDecisionTaskRequest struct {
 	DomainUUID                    string             `json:"domainUUID,omitempty"`
@@ -111,6 +165,11 @@ type AddDecisionTaskRequest struct {
 	ScheduleToStartTimeoutSeconds *int32             `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
+	Priority                      int32              `json:"priority,omitempty"`
+	FairnessKey                   string             `json:"fairnessKey,omitempty"`
+	BuildID                       string             `json:"buildId,omitempty"`
+	IsolationGroup                string             `json:"isolationGroup,omitempty"`
+	Attempt                       int64              `json:"attempt,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -169,6 +228,46 @@ func (v *AddDecisionTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
+// GetBuildID is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetAttempt() (o int64) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
 // CancelOutstandingPollRequest is an internal type (TBD...)
 type CancelOutstandingPollRequest struct {
 	DomainUUID   string    `json:"domainUUID,omitempty"`
@@ -253,6 +352,152 @@ func (v *MatchingListTaskListPartitionsRequest) GetTaskList() (o *TaskList) {
 	return
 }
 
+// MatchingUpdateWorkerBuildIDCompatibilityRequest is an internal type (TBD...)
+type MatchingUpdateWorkerBuildIDCompatibilityRequest struct {
+	DomainUUID    string                                   `json:"domainUUID,omitempty"`
+	UpdateRequest *UpdateWorkerBuildIDCompatibilityRequest `json:"updateRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingUpdateWorkerBuildIDCompatibilityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUpdateRequest is an internal getter (TBD...)
+func (v *MatchingUpdateWorkerBuildIDCompatibilityRequest) GetUpdateRequest() (o *UpdateWorkerBuildIDCompatibilityRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+	return
+}
+
+// MatchingGetWorkerBuildIDCompatibilityRequest is an internal type (TBD...)
+type MatchingGetWorkerBuildIDCompatibilityRequest struct {
+	DomainUUID string                                `json:"domainUUID,omitempty"`
+	GetRequest *GetWorkerBuildIDCompatibilityRequest `json:"getRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingGetWorkerBuildIDCompatibilityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetGetRequest is an internal getter (TBD...)
+func (v *MatchingGetWorkerBuildIDCompatibilityRequest) GetGetRequest() (o *GetWorkerBuildIDCompatibilityRequest) {
+	if v != nil && v.GetRequest != nil {
+		return v.GetRequest
+	}
+	return
+}
+
+// MatchingUpdateTaskListStateRequest is an internal type (TBD...)
+type MatchingUpdateTaskListStateRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	Paused       *bool         `json:"paused,omitempty"`
+	MigrateTo    *string       `json:"migrateTo,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListStateRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListStateRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListStateRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetPaused is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListStateRequest) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+	return
+}
+
+// GetMigrateTo is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListStateRequest) GetMigrateTo() (o string) {
+	if v != nil && v.MigrateTo != nil {
+		return *v.MigrateTo
+	}
+	return
+}
+
+// MatchingGetTaskListStateRequest is an internal type (TBD...)
+type MatchingGetTaskListStateRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingGetTaskListStateRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingGetTaskListStateRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingGetTaskListStateRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// TaskListState is an internal type (TBD...)
+type TaskListState struct {
+	Paused    bool   `json:"paused,omitempty"`
+	MigrateTo string `json:"migrateTo,omitempty"`
+}
+
+// GetPaused is an internal getter (TBD...)
+func (v *TaskListState) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
+// GetMigrateTo is an internal getter (TBD...)
+func (v *TaskListState) GetMigrateTo() (o string) {
+	if v != nil {
+		return v.MigrateTo
+	}
+	return
+}
+
 // MatchingGetTaskListsByDomainRequest is an internal type (TBD...)
 type MatchingGetTaskListsByDomainRequest struct {
 	Domain string `json:"domain,omitempty"`
@@ -509,6 +754,7 @@ type MatchingQueryWorkflowRequest struct {
 	TaskList      *TaskList             `json:"taskList,omitempty"`
 	QueryRequest  *QueryWorkflowRequest `json:"queryRequest,omitempty"`
 	ForwardedFrom string                `json:"forwardedFrom,omitempty"`
+	BuildID       string                `json:"buildId,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -543,6 +789,14 @@ func (v *MatchingQueryWorkflowRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetBuildID is an internal getter (TBD...)
+func (v *MatchingQueryWorkflowRequest) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
 // MatchingRespondQueryTaskCompletedRequest is an internal type (TBD...)
 type MatchingRespondQueryTaskCompletedRequest struct {
 	DomainUUID       string                            `json:"domainUUID,omitempty"`
@@ -262,6 +262,8 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventID  int64         `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      int32         `json:"priority,omitempty"`
+	FairnessKey                   string        `json:"fairnessKey,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -360,6 +362,22 @@ func (v *ActivityTaskScheduledEventAttributes) GetHeader() (o *Header) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // ActivityTaskStartedEventAttributes is an internal type (TBD...)
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventID   int64   `json:"scheduledEventId,omitempty"`
@@ -1491,6 +1509,7 @@ type Decision struct {
 	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
 	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
+	UpsertWorkflowMemoDecisionAttributes                     *UpsertWorkflowMemoDecisionAttributes                     `json:"upsertWorkflowMemoDecisionAttributes,omitempty"`
 }
 
 // GetDecisionType is an internal getter (TBD...)
@@ -1605,6 +1624,14 @@ func (v *Decision) GetUpsertWorkflowSearchAttributesDecisionAttributes() (o *Ups
 	return
 }
 
+// GetUpsertWorkflowMemoDecisionAttributes is an internal getter (TBD...)
+func (v *Decision) GetUpsertWorkflowMemoDecisionAttributes() (o *UpsertWorkflowMemoDecisionAttributes) {
+	if v != nil && v.UpsertWorkflowMemoDecisionAttributes != nil {
+		return v.UpsertWorkflowMemoDecisionAttributes
+	}
+	return
+}
+
 // DecisionTaskCompletedEventAttributes is an internal type (TBD...)
 type DecisionTaskCompletedEventAttributes struct {
 	ExecutionContext []byte `json:"executionContext,omitempty"`
@@ -1712,6 +1739,8 @@ func (e DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_I_D"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -1788,6 +1817,9 @@ func (e *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*e = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "BAD_UPSERT_WORKFLOW_MEMO_ATTRIBUTES":
+		*e = DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1850,6 +1882,8 @@ const (
 	DecisionTaskFailedCauseScheduleActivityDuplicateID
 	// DecisionTaskFailedCauseBadSearchAttributes is an option for DecisionTaskFailedCause
 	DecisionTaskFailedCauseBadSearchAttributes
+	// DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes
 )
 
 // DecisionTaskFailedEventAttributes is an internal type (TBD...)
@@ -2173,6 +2207,8 @@ func (e DecisionType) String() string {
 		return "SignalExternalWorkflowExecution"
 	case 12:
 		return "UpsertWorkflowSearchAttributes"
+	case 13:
+		return "UpsertWorkflowMemo"
 	}
 	return fmt.Sprintf("DecisionType(%d)", w)
 }
@@ -2219,6 +2255,9 @@ func (e *DecisionType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = DecisionTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "UPSERTWORKFLOWMEMO":
+		*e = DecisionTypeUpsertWorkflowMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -2261,6 +2300,8 @@ const (
 	DecisionTypeSignalExternalWorkflowExecution
 	// DecisionTypeUpsertWorkflowSearchAttributes is an option for DecisionType
 	DecisionTypeUpsertWorkflowSearchAttributes
+	// DecisionTypeUpsertWorkflowMemo is an option for DecisionType
+	DecisionTypeUpsertWorkflowMemo
 )
 
 // DeprecateDomainRequest is an internal type (TBD...)
@@ -2397,6 +2438,9 @@ func (v *DescribeHistoryHostRequest) GetExecutionForHost() (o *WorkflowExecution
 type DescribeShardDistributionResponse struct {
 	NumberOfShards int32            `json:"numberOfShards,omitempty"`
 	Shards         map[int32]string `json:"shardIDs,omitempty"`
+	PlacementMode  string           `json:"placementMode,omitempty"`
+	// Placements are only set in the load placement mode
+	Placements map[int32]*ShardPlacementInfo `json:"placements,omitempty"`
 }
 
 // GetNumberOfShards is an internal getter (TBD...)
@@ -2415,13 +2459,79 @@ func (v *DescribeShardDistributionResponse) GetShards() (o map[int32]string) {
 	return
 }
 
+// GetPlacementMode is an internal getter (TBD...)
+func (v *DescribeShardDistributionResponse) GetPlacementMode() (o string) {
+	if v != nil {
+		return v.PlacementMode
+	}
+	return
+}
+
+// GetPlacements is an internal getter (TBD...)
+func (v *DescribeShardDistributionResponse) GetPlacements() (o map[int32]*ShardPlacementInfo) {
+	if v != nil {
+		return v.Placements
+	}
+	return
+}
+
+// ShardPlacementInfo is an internal type (TBD...)
+type ShardPlacementInfo struct {
+	AssignedHost string  `json:"assignedHost,omitempty"`
+	Pinned       bool    `json:"pinned,omitempty"`
+	RPS          float64 `json:"rps,omitempty"`
+	QueueBacklog int64   `json:"queueBacklog,omitempty"`
+	CacheSize    int64   `json:"cacheSize,omitempty"`
+}
+
+// GetAssignedHost is an internal getter (TBD...)
+func (v *ShardPlacementInfo) GetAssignedHost() (o string) {
+	if v != nil {
+		return v.AssignedHost
+	}
+	return
+}
+
+// GetPinned is an internal getter (TBD...)
+func (v *ShardPlacementInfo) GetPinned() (o bool) {
+	if v != nil {
+		return v.Pinned
+	}
+	return
+}
+
+// GetRPS is an internal getter (TBD...)
+func (v *ShardPlacementInfo) GetRPS() (o float64) {
+	if v != nil {
+		return v.RPS
+	}
+	return
+}
+
+// GetQueueBacklog is an internal getter (TBD...)
+func (v *ShardPlacementInfo) GetQueueBacklog() (o int64) {
+	if v != nil {
+		return v.QueueBacklog
+	}
+	return
+}
+
+// GetCacheSize is an internal getter (TBD...)
+func (v *ShardPlacementInfo) GetCacheSize() (o int64) {
+	if v != nil {
+		return v.CacheSize
+	}
+	return
+}
+
 // DescribeHistoryHostResponse is an internal type (TBD...)
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        int32            `json:"numberOfShards,omitempty"`
-	ShardIDs              []int32          `json:"shardIDs,omitempty"`
-	DomainCache           *DomainCacheInfo `json:"domainCache,omitempty"`
-	ShardControllerStatus string           `json:"shardControllerStatus,omitempty"`
-	Address               string           `json:"address,omitempty"`
+	NumberOfShards             int32                            `json:"numberOfShards,omitempty"`
+	ShardIDs                   []int32                          `json:"shardIDs,omitempty"`
+	DomainCache                *DomainCacheInfo                 `json:"domainCache,omitempty"`
+	ShardControllerStatus      string                           `json:"shardControllerStatus,omitempty"`
+	Address                    string                           `json:"address,omitempty"`
+	PersistenceCircuitBreakers []*PersistenceCircuitBreakerInfo `json:"persistenceCircuitBreakers,omitempty"`
 }
 
 // GetNumberOfShards is an internal getter (TBD...)
@@ -2464,6 +2574,14 @@ func (v *DescribeHistoryHostResponse) GetAddress() (o string) {
 	return
 }
 
+// GetPersistenceCircuitBreakers is an internal getter (TBD...)
+func (v *DescribeHistoryHostResponse) GetPersistenceCircuitBreakers() (o []*PersistenceCircuitBreakerInfo) {
+	if v != nil && v.PersistenceCircuitBreakers != nil {
+		return v.PersistenceCircuitBreakers
+	}
+	return
+}
+
 // DescribeQueueRequest is an internal type (TBD...)
 type DescribeQueueRequest struct {
 	ShardID     int32  `json:"shardID,omitempty"`
@@ -2514,6 +2632,7 @@ type DescribeTaskListRequest struct {
 	TaskList              *TaskList     `json:"taskList,omitempty"`
 	TaskListType          *TaskListType `json:"taskListType,omitempty"`
 	IncludeTaskListStatus bool          `json:"includeTaskListStatus,omitempty"`
+	IncludePartitions     bool          `json:"includePartitions,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -2548,10 +2667,21 @@ func (v *DescribeTaskListRequest) GetIncludeTaskListStatus() (o bool) {
 	return
 }
 
+// GetIncludePartitions is an internal getter (TBD...)
+func (v *DescribeTaskListRequest) GetIncludePartitions() (o bool) {
+	if v != nil {
+		return v.IncludePartitions
+	}
+	return
+}
+
 // DescribeTaskListResponse is an internal type (TBD...)
 type DescribeTaskListResponse struct {
-	Pollers        []*PollerInfo   `json:"pollers,omitempty"`
-	TaskListStatus *TaskListStatus `json:"taskListStatus,omitempty"`
+	Pollers          []*PollerInfo              `json:"pollers,omitempty"`
+	TaskListStatus   *TaskListStatus            `json:"taskListStatus,omitempty"`
+	PartitionConfig  *TaskListPartitionConfig   `json:"partitionConfig,omitempty"`
+	PollersByBuildID map[string]int32           `json:"pollersByBuildId,omitempty"`
+	PartitionStatus  map[string]*TaskListStatus `json:"partitionStatus,omitempty"`
 }
 
 // GetPollers is an internal getter (TBD...)
@@ -2570,6 +2700,30 @@ func (v *DescribeTaskListResponse) GetTaskListStatus() (o *TaskListStatus) {
 	return
 }
 
+// GetPartitionConfig is an internal getter (TBD...)
+func (v *DescribeTaskListResponse) GetPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.PartitionConfig != nil {
+		return v.PartitionConfig
+	}
+	return
+}
+
+// GetPollersByBuildID is an internal getter (TBD...)
+func (v *DescribeTaskListResponse) GetPollersByBuildID() (o map[string]int32) {
+	if v != nil && v.PollersByBuildID != nil {
+		return v.PollersByBuildID
+	}
+	return
+}
+
+// GetPartitionStatus is an internal getter (TBD...)
+func (v *DescribeTaskListResponse) GetPartitionStatus() (o map[string]*TaskListStatus) {
+	if v != nil && v.PartitionStatus != nil {
+		return v.PartitionStatus
+	}
+	return
+}
+
 // DescribeWorkflowExecutionRequest is an internal type (TBD...)
 type DescribeWorkflowExecutionRequest struct {
 	Domain    string             `json:"domain,omitempty"`
@@ -2685,6 +2839,7 @@ type DomainConfiguration struct {
 	HistoryArchivalURI                     string          `json:"historyArchivalURI,omitempty"`
 	VisibilityArchivalStatus               *ArchivalStatus `json:"visibilityArchivalStatus,omitempty"`
 	VisibilityArchivalURI                  string          `json:"visibilityArchivalURI,omitempty"`
+	DrainedIsolationGroups                 []string        `json:"drainedIsolationGroups,omitempty"`
 }
 
 // GetWorkflowExecutionRetentionPeriodInDays is an internal getter (TBD...)
@@ -2711,6 +2866,14 @@ func (v *DomainConfiguration) GetBadBinaries() (o *BadBinaries) {
 	return
 }
 
+// GetDrainedIsolationGroups is an internal getter (TBD...)
+func (v *DomainConfiguration) GetDrainedIsolationGroups() (o []string) {
+	if v != nil && v.DrainedIsolationGroups != nil {
+		return v.DrainedIsolationGroups
+	}
+	return
+}
+
 // GetHistoryArchivalStatus is an internal getter (TBD...)
 func (v *DomainConfiguration) GetHistoryArchivalStatus() (o ArchivalStatus) {
 	if v != nil && v.HistoryArchivalStatus != nil {
@@ -3112,6 +3275,8 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "UpsertWorkflowMemo"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -3245,6 +3410,9 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "UPSERTWORKFLOWMEMO":
+		*e = EventTypeUpsertWorkflowMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -3345,8 +3513,41 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeUpsertWorkflowMemo is an option for EventType
+	EventTypeUpsertWorkflowMemo
 )
 
+// ExpediteWorkflowExecutionStartRequest is an internal type (TBD...)
+type ExpediteWorkflowExecutionStartRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ExpediteWorkflowExecutionStartRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ExpediteWorkflowExecutionStartRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *ExpediteWorkflowExecutionStartRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
 type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
 	InitiatedEventID  int64              `json:"initiatedEventId,omitempty"`
@@ -3453,6 +3654,41 @@ func (v *GetSearchAttributesResponse) GetKeys() (o map[string]IndexedValueType)
 	return
 }
 
+// GetWorkerBuildIDCompatibilityRequest is an internal type (TBD...)
+type GetWorkerBuildIDCompatibilityRequest struct {
+	Domain   string    `json:"domain,omitempty"`
+	TaskList *TaskList `json:"taskList,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetWorkerBuildIDCompatibilityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *GetWorkerBuildIDCompatibilityRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetWorkerBuildIDCompatibilityResponse is an internal type (TBD...)
+type GetWorkerBuildIDCompatibilityResponse struct {
+	VersionSets []*WorkerVersionSet `json:"versionSets,omitempty"`
+}
+
+// GetVersionSets is an internal getter (TBD...)
+func (v *GetWorkerBuildIDCompatibilityResponse) GetVersionSets() (o []*WorkerVersionSet) {
+	if v != nil && v.VersionSets != nil {
+		return v.VersionSets
+	}
+	return
+}
+
 // GetWorkflowExecutionHistoryRequest is an internal type (TBD...)
 type GetWorkflowExecutionHistoryRequest struct {
 	Domain                 string                  `json:"domain,omitempty"`
@@ -3697,6 +3933,7 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	UpsertWorkflowMemoEventAttributes                              *UpsertWorkflowMemoEventAttributes                              `json:"upsertWorkflowMemoEventAttributes,omitempty"`
 }
 
 // GetEventID is an internal getter (TBD...)
@@ -4075,6 +4312,14 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetUpsertWorkflowMemoEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetUpsertWorkflowMemoEventAttributes() (o *UpsertWorkflowMemoEventAttributes) {
+	if v != nil && v.UpsertWorkflowMemoEventAttributes != nil {
+		return v.UpsertWorkflowMemoEventAttributes
+	}
+	return
+}
+
 // HistoryEventFilterType is an internal type (TBD...)
 type HistoryEventFilterType int32
 
@@ -4543,8 +4788,10 @@ func (v *ListTaskListPartitionsRequest) GetTaskList() (o *TaskList) {
 
 // ListTaskListPartitionsResponse is an internal type (TBD...)
 type ListTaskListPartitionsResponse struct {
-	ActivityTaskListPartitions []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
-	DecisionTaskListPartitions []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
+	ActivityTaskListPartitions      []*TaskListPartitionMetadata `json:"activityTaskListPartitions,omitempty"`
+	DecisionTaskListPartitions      []*TaskListPartitionMetadata `json:"decisionTaskListPartitions,omitempty"`
+	ActivityTaskListPartitionConfig *TaskListPartitionConfig     `json:"activityTaskListPartitionConfig,omitempty"`
+	DecisionTaskListPartitionConfig *TaskListPartitionConfig     `json:"decisionTaskListPartitionConfig,omitempty"`
 }
 
 // GetActivityTaskListPartitions is an internal getter (TBD...)
@@ -4563,6 +4810,22 @@ func (v *ListTaskListPartitionsResponse) GetDecisionTaskListPartitions() (o []*T
 	return
 }
 
+// GetActivityTaskListPartitionConfig is an internal getter (TBD...)
+func (v *ListTaskListPartitionsResponse) GetActivityTaskListPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.ActivityTaskListPartitionConfig != nil {
+		return v.ActivityTaskListPartitionConfig
+	}
+	return
+}
+
+// GetDecisionTaskListPartitionConfig is an internal getter (TBD...)
+func (v *ListTaskListPartitionsResponse) GetDecisionTaskListPartitionConfig() (o *TaskListPartitionConfig) {
+	if v != nil && v.DecisionTaskListPartitionConfig != nil {
+		return v.DecisionTaskListPartitionConfig
+	}
+	return
+}
+
 // GetTaskListsByDomainRequest is an internal type (TBD...)
 type GetTaskListsByDomainRequest struct {
 	Domain string `json:"domain,omitempty"`
@@ -4777,6 +5040,7 @@ type PendingActivityInfo struct {
 	LastFailureReason      *string               `json:"lastFailureReason,omitempty"`
 	LastWorkerIdentity     string                `json:"lastWorkerIdentity,omitempty"`
 	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
+	NextRetryTimestamp     *int64                `json:"nextRetryTimestamp,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -4883,6 +5147,14 @@ func (v *PendingActivityInfo) GetLastFailureDetails() (o []byte) {
 	return
 }
 
+// GetNextRetryTimestamp is an internal getter (TBD...)
+func (v *PendingActivityInfo) GetNextRetryTimestamp() (o int64) {
+	if v != nil && v.NextRetryTimestamp != nil {
+		return *v.NextRetryTimestamp
+	}
+	return
+}
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -5090,12 +5362,54 @@ const (
 	PendingDecisionStateStarted
 )
 
+// PersistenceCircuitBreakerInfo is an internal type (TBD...)
+type PersistenceCircuitBreakerInfo struct {
+	StoreName                string `json:"storeName,omitempty"`
+	OperationClass           string `json:"operationClass,omitempty"`
+	State                    string `json:"state,omitempty"`
+	LastStateChangeTimestamp int64  `json:"lastStateChangeTimestamp,omitempty"`
+}
+
+// GetStoreName is an internal getter (TBD...)
+func (v *PersistenceCircuitBreakerInfo) GetStoreName() (o string) {
+	if v != nil {
+		return v.StoreName
+	}
+	return
+}
+
+// GetOperationClass is an internal getter (TBD...)
+func (v *PersistenceCircuitBreakerInfo) GetOperationClass() (o string) {
+	if v != nil {
+		return v.OperationClass
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *PersistenceCircuitBreakerInfo) GetState() (o string) {
+	if v != nil {
+		return v.State
+	}
+	return
+}
+
+// GetLastStateChangeTimestamp is an internal getter (TBD...)
+func (v *PersistenceCircuitBreakerInfo) GetLastStateChangeTimestamp() (o int64) {
+	if v != nil {
+		return v.LastStateChangeTimestamp
+	}
+	return
+}
+
 // PollForActivityTaskRequest is an internal type (TBD...)
 type PollForActivityTaskRequest struct {
 	Domain           string            `json:"domain,omitempty"`
 	TaskList         *TaskList         `json:"taskList,omitempty"`
 	Identity         string            `json:"identity,omitempty"`
 	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+	BinaryChecksum   string            `json:"binaryChecksum,omitempty"`
+	IsolationGroup   string            `json:"isolationGroup,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5130,6 +5444,22 @@ func (v *PollForActivityTaskRequest) GetTaskListMetadata() (o *TaskListMetadata)
 	return
 }
 
+// GetBinaryChecksum is an internal getter (TBD...)
+func (v *PollForActivityTaskRequest) GetBinaryChecksum() (o string) {
+	if v != nil {
+		return v.BinaryChecksum
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *PollForActivityTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // PollForActivityTaskResponse is an internal type (TBD...)
 type PollForActivityTaskResponse struct {
 	TaskToken                       []byte             `json:"taskToken,omitempty"`
@@ -5284,6 +5614,7 @@ type PollForDecisionTaskRequest struct {
 	TaskList       *TaskList `json:"taskList,omitempty"`
 	Identity       string    `json:"identity,omitempty"`
 	BinaryChecksum string    `json:"binaryChecksum,omitempty"`
+	IsolationGroup string    `json:"isolationGroup,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -5318,6 +5649,14 @@ func (v *PollForDecisionTaskRequest) GetBinaryChecksum() (o string) {
 	return
 }
 
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *PollForDecisionTaskRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // PollForDecisionTaskResponse is an internal type (TBD...)
 type PollForDecisionTaskResponse struct {
 	TaskToken                 []byte                    `json:"taskToken,omitempty"`
@@ -5462,6 +5801,8 @@ type PollerInfo struct {
 	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
 	Identity       string  `json:"identity,omitempty"`
 	RatePerSecond  float64 `json:"ratePerSecond,omitempty"`
+	BuildID        string  `json:"buildId,omitempty"`
+	IsolationGroup string  `json:"isolationGroup,omitempty"`
 }
 
 // GetLastAccessTime is an internal getter (TBD...)
@@ -5488,6 +5829,22 @@ func (v *PollerInfo) GetRatePerSecond() (o float64) {
 	return
 }
 
+// GetBuildID is an internal getter (TBD...)
+func (v *PollerInfo) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *PollerInfo) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // QueryConsistencyLevel is an internal type (TBD...)
 type QueryConsistencyLevel int32
 
@@ -7088,14 +7445,76 @@ func (v *RespondQueryTaskCompletedRequest) GetWorkerVersionInfo() (o *WorkerVers
 	return
 }
 
+// RetryJitterMode is an internal type (TBD...)
+type RetryJitterMode int32
+
+// Ptr is a helper function for getting pointer value
+func (e RetryJitterMode) Ptr() *RetryJitterMode {
+	return &e
+}
+
+// String returns a readable string representation of RetryJitterMode.
+func (e RetryJitterMode) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "NONE"
+	case 1:
+		return "FULL"
+	case 2:
+		return "EQUAL"
+	}
+	return fmt.Sprintf("RetryJitterMode(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *RetryJitterMode) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "NONE":
+		*e = RetryJitterModeNone
+		return nil
+	case "FULL":
+		*e = RetryJitterModeFull
+		return nil
+	case "EQUAL":
+		*e = RetryJitterModeEqual
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "RetryJitterMode", err)
+		}
+		*e = RetryJitterMode(val)
+		return nil
+	}
+}
+
+// MarshalText encodes RetryJitterMode to text.
+func (e RetryJitterMode) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// RetryJitterModeNone is an option for RetryJitterMode
+	RetryJitterModeNone RetryJitterMode = iota
+	// RetryJitterModeFull is an option for RetryJitterMode
+	RetryJitterModeFull
+	// RetryJitterModeEqual is an option for RetryJitterMode
+	RetryJitterModeEqual
+)
+
 // RetryPolicy is an internal type (TBD...)
 type RetryPolicy struct {
-	InitialIntervalInSeconds    int32    `json:"initialIntervalInSeconds,omitempty"`
-	BackoffCoefficient          float64  `json:"backoffCoefficient,omitempty"`
-	MaximumIntervalInSeconds    int32    `json:"maximumIntervalInSeconds,omitempty"`
-	MaximumAttempts             int32    `json:"maximumAttempts,omitempty"`
-	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
-	ExpirationIntervalInSeconds int32    `json:"expirationIntervalInSeconds,omitempty"`
+	InitialIntervalInSeconds         int32                `json:"initialIntervalInSeconds,omitempty"`
+	BackoffCoefficient               float64              `json:"backoffCoefficient,omitempty"`
+	MaximumIntervalInSeconds         int32                `json:"maximumIntervalInSeconds,omitempty"`
+	MaximumAttempts                  int32                `json:"maximumAttempts,omitempty"`
+	NonRetriableErrorReasons         []string             `json:"nonRetriableErrorReasons,omitempty"`
+	ExpirationIntervalInSeconds      int32                `json:"expirationIntervalInSeconds,omitempty"`
+	BackoffScheduleInSeconds         []int32              `json:"backoffScheduleInSeconds,omitempty"`
+	JitterMode                       RetryJitterMode      `json:"jitterMode,omitempty"`
+	ReasonPolicies                   []*RetryReasonPolicy `json:"reasonPolicies,omitempty"`
+	MaximumTotalElapsedTimeInSeconds int32                `json:"maximumTotalElapsedTimeInSeconds,omitempty"`
 }
 
 // GetInitialIntervalInSeconds is an internal getter (TBD...)
@@ -7146,46 +7565,100 @@ func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
 	return
 }
 
-// RetryTaskV2Error is an internal type (TBD...)
-type RetryTaskV2Error struct {
-	Message           string `json:"message,required"`
-	DomainID          string `json:"domainId,omitempty"`
-	WorkflowID        string `json:"workflowId,omitempty"`
-	RunID             string `json:"runId,omitempty"`
-	StartEventID      *int64 `json:"startEventId,omitempty"`
-	StartEventVersion *int64 `json:"startEventVersion,omitempty"`
-	EndEventID        *int64 `json:"endEventId,omitempty"`
-	EndEventVersion   *int64 `json:"endEventVersion,omitempty"`
+// GetBackoffScheduleInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetBackoffScheduleInSeconds() (o []int32) {
+	if v != nil && v.BackoffScheduleInSeconds != nil {
+		return v.BackoffScheduleInSeconds
+	}
+	return
 }
 
-// GetMessage is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetMessage() (o string) {
+// GetJitterMode is an internal getter (TBD...)
+func (v *RetryPolicy) GetJitterMode() (o RetryJitterMode) {
 	if v != nil {
-		return v.Message
+		return v.JitterMode
 	}
 	return
 }
 
-// GetDomainID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetDomainID() (o string) {
-	if v != nil {
-		return v.DomainID
+// GetReasonPolicies is an internal getter (TBD...)
+func (v *RetryPolicy) GetReasonPolicies() (o []*RetryReasonPolicy) {
+	if v != nil && v.ReasonPolicies != nil {
+		return v.ReasonPolicies
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetWorkflowID() (o string) {
+// GetMaximumTotalElapsedTimeInSeconds is an internal getter (TBD...)
+func (v *RetryPolicy) GetMaximumTotalElapsedTimeInSeconds() (o int32) {
 	if v != nil {
-		return v.WorkflowID
+		return v.MaximumTotalElapsedTimeInSeconds
 	}
 	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *RetryTaskV2Error) GetRunID() (o string) {
+// RetryReasonPolicy is an internal type (TBD...)
+type RetryReasonPolicy struct {
+	ReasonPattern string       `json:"reasonPattern,omitempty"`
+	RetryPolicy   *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// GetReasonPattern is an internal getter (TBD...)
+func (v *RetryReasonPolicy) GetReasonPattern() (o string) {
 	if v != nil {
-		return v.RunID
+		return v.ReasonPattern
+	}
+	return
+}
+
+// GetRetryPolicy is an internal getter (TBD...)
+func (v *RetryReasonPolicy) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+	return
+}
+
+// RetryTaskV2Error is an internal type (TBD...)
+type RetryTaskV2Error struct {
+	Message           string `json:"message,required"`
+	DomainID          string `json:"domainId,omitempty"`
+	WorkflowID        string `json:"workflowId,omitempty"`
+	RunID             string `json:"runId,omitempty"`
+	StartEventID      *int64 `json:"startEventId,omitempty"`
+	StartEventVersion *int64 `json:"startEventVersion,omitempty"`
+	EndEventID        *int64 `json:"endEventId,omitempty"`
+	EndEventVersion   *int64 `json:"endEventVersion,omitempty"`
+}
+
+// GetMessage is an internal getter (TBD...)
+func (v *RetryTaskV2Error) GetMessage() (o string) {
+	if v != nil {
+		return v.Message
+	}
+	return
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *RetryTaskV2Error) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *RetryTaskV2Error) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *RetryTaskV2Error) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
 	}
 	return
 }
@@ -7236,6 +7709,8 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+	Priority                      int32         `json:"priority,omitempty"`
+	FairnessKey                   string        `json:"fairnessKey,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -7334,6 +7809,22 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetRequestLocalDispatch() (o bo
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // SearchAttributes is an internal type (TBD...)
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
@@ -7349,7 +7840,8 @@ func (v *SearchAttributes) GetIndexedFields() (o map[string][]byte) {
 
 // ServiceBusyError is an internal type (TBD...)
 type ServiceBusyError struct {
-	Message string `json:"message,required"`
+	Message                string `json:"message,required"`
+	RetryAfterMilliseconds int64  `json:"retryAfterMilliseconds,omitempty"`
 }
 
 // GetMessage is an internal getter (TBD...)
@@ -7360,6 +7852,14 @@ func (v *ServiceBusyError) GetMessage() (o string) {
 	return
 }
 
+// GetRetryAfterMilliseconds is an internal getter (TBD...)
+func (v *ServiceBusyError) GetRetryAfterMilliseconds() (o int64) {
+	if v != nil {
+		return v.RetryAfterMilliseconds
+	}
+	return
+}
+
 // SignalExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -7589,25 +8089,27 @@ func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkfl
 
 // SignalWithStartWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionRequest struct {
-	Domain                              string                 `json:"domain,omitempty"`
-	WorkflowID                          string                 `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                 `json:"identity,omitempty"`
-	RequestID                           string                 `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	SignalName                          string                 `json:"signalName,omitempty"`
-	SignalInput                         []byte                 `json:"signalInput,omitempty"`
-	Control                             []byte                 `json:"control,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                 `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Domain                              string                    `json:"domain,omitempty"`
+	WorkflowID                          string                    `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                    `json:"identity,omitempty"`
+	RequestID                           string                    `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	SignalName                          string                    `json:"signalName,omitempty"`
+	SignalInput                         []byte                    `json:"signalInput,omitempty"`
+	Control                             []byte                    `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                    `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	IsolationGroup                      string                    `json:"isolationGroup,omitempty"`
+	WorkflowIDConflictPolicy            *WorkflowIDConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7762,6 +8264,22 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetHeader() (o *Header) {
 	return
 }
 
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetWorkflowIDConflictPolicy is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDConflictPolicy() (o WorkflowIDConflictPolicy) {
+	if v != nil && v.WorkflowIDConflictPolicy != nil {
+		return *v.WorkflowIDConflictPolicy
+	}
+	return
+}
+
 // SignalWorkflowExecutionRequest is an internal type (TBD...)
 type SignalWorkflowExecutionRequest struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -7846,6 +8364,8 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	Header                              *Header                `json:"header,omitempty"`
 	Memo                                *Memo                  `json:"memo,omitempty"`
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
+	Priority                            int32                  `json:"priority,omitempty"`
+	FairnessKey                         string                 `json:"fairnessKey,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7968,6 +8488,22 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) GetSearchAttributes() (o
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionDecisionAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // StartChildWorkflowExecutionFailedEventAttributes is an internal type (TBD...)
 type StartChildWorkflowExecutionFailedEventAttributes struct {
 	Domain                       string                             `json:"domain,omitempty"`
@@ -8054,6 +8590,8 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	Memo                                *Memo                  `json:"memo,omitempty"`
 	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Priority                            int32                  `json:"priority,omitempty"`
+	FairnessKey                         string                 `json:"fairnessKey,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -8192,6 +8730,22 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetSearchAttribute
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *StartChildWorkflowExecutionInitiatedEventAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // StartTimeFilter is an internal type (TBD...)
 type StartTimeFilter struct {
 	EarliestTime *int64 `json:"earliestTime,omitempty"`
@@ -8238,22 +8792,26 @@ func (v *StartTimerDecisionAttributes) GetStartToFireTimeoutSeconds() (o int64)
 
 // StartWorkflowExecutionRequest is an internal type (TBD...)
 type StartWorkflowExecutionRequest struct {
-	Domain                              string                 `json:"domain,omitempty"`
-	WorkflowID                          string                 `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
-	TaskList                            *TaskList              `json:"taskList,omitempty"`
-	Input                               []byte                 `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            string                 `json:"identity,omitempty"`
-	RequestID                           string                 `json:"requestId,omitempty"`
-	WorkflowIDReusePolicy               *WorkflowIDReusePolicy `json:"workflowIdReusePolicy,omitempty"`
-	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
-	CronSchedule                        string                 `json:"cronSchedule,omitempty"`
-	Memo                                *Memo                  `json:"memo,omitempty"`
-	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
-	Header                              *Header                `json:"header,omitempty"`
-	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	Domain                              string                    `json:"domain,omitempty"`
+	WorkflowID                          string                    `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType             `json:"workflowType,omitempty"`
+	TaskList                            *TaskList                 `json:"taskList,omitempty"`
+	Input                               []byte                    `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                    `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            string                    `json:"identity,omitempty"`
+	RequestID                           string                    `json:"requestId,omitempty"`
+	WorkflowIDReusePolicy               *WorkflowIDReusePolicy    `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy              `json:"retryPolicy,omitempty"`
+	CronSchedule                        string                    `json:"cronSchedule,omitempty"`
+	Memo                                *Memo                     `json:"memo,omitempty"`
+	SearchAttributes                    *SearchAttributes         `json:"searchAttributes,omitempty"`
+	Header                              *Header                   `json:"header,omitempty"`
+	DelayStartSeconds                   *int32                    `json:"delayStartSeconds,omitempty"`
+	Priority                            int32                     `json:"priority,omitempty"`
+	FairnessKey                         string                    `json:"fairnessKey,omitempty"`
+	IsolationGroup                      string                    `json:"isolationGroup,omitempty"`
+	WorkflowIDConflictPolicy            *WorkflowIDConflictPolicy `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -8344,6 +8902,14 @@ func (v *StartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o WorkflowID
 	return
 }
 
+// GetWorkflowIDConflictPolicy is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetWorkflowIDConflictPolicy() (o WorkflowIDConflictPolicy) {
+	if v != nil && v.WorkflowIDConflictPolicy != nil {
+		return *v.WorkflowIDConflictPolicy
+	}
+	return
+}
+
 // GetRetryPolicy is an internal getter (TBD...)
 func (v *StartWorkflowExecutionRequest) GetRetryPolicy() (o *RetryPolicy) {
 	if v != nil && v.RetryPolicy != nil {
@@ -8384,6 +8950,30 @@ func (v *StartWorkflowExecutionRequest) GetHeader() (o *Header) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // StartWorkflowExecutionResponse is an internal type (TBD...)
 type StartWorkflowExecutionResponse struct {
 	RunID string `json:"runId,omitempty"`
@@ -8549,6 +9139,37 @@ func (v *TaskListMetadata) GetMaxTasksPerSecond() (o float64) {
 	return
 }
 
+// TaskListPartitionConfig is an internal type (TBD...)
+type TaskListPartitionConfig struct {
+	NumReadPartitions  int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions int32 `json:"numWritePartitions,omitempty"`
+	ScalingEnabled     bool  `json:"scalingEnabled,omitempty"`
+}
+
+// GetNumReadPartitions is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetNumReadPartitions() (o int32) {
+	if v != nil {
+		return v.NumReadPartitions
+	}
+	return
+}
+
+// GetNumWritePartitions is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetNumWritePartitions() (o int32) {
+	if v != nil {
+		return v.NumWritePartitions
+	}
+	return
+}
+
+// GetScalingEnabled is an internal getter (TBD...)
+func (v *TaskListPartitionConfig) GetScalingEnabled() (o bool) {
+	if v != nil {
+		return v.ScalingEnabled
+	}
+	return
+}
+
 // TaskListPartitionMetadata is an internal type (TBD...)
 type TaskListPartitionMetadata struct {
 	Key           string `json:"key,omitempty"`
@@ -8573,11 +9194,20 @@ func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
 
 // TaskListStatus is an internal type (TBD...)
 type TaskListStatus struct {
-	BacklogCountHint int64        `json:"backlogCountHint,omitempty"`
-	ReadLevel        int64        `json:"readLevel,omitempty"`
-	AckLevel         int64        `json:"ackLevel,omitempty"`
-	RatePerSecond    float64      `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint               int64        `json:"backlogCountHint,omitempty"`
+	ReadLevel                      int64        `json:"readLevel,omitempty"`
+	AckLevel                       int64        `json:"ackLevel,omitempty"`
+	RatePerSecond                  float64      `json:"ratePerSecond,omitempty"`
+	TaskIDBlock                    *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	AddRatePerSecond               float64      `json:"addRatePerSecond,omitempty"`
+	DispatchRatePerSecond          float64      `json:"dispatchRatePerSecond,omitempty"`
+	SyncMatchRatio                 float64      `json:"syncMatchRatio,omitempty"`
+	OldestTaskAgeSeconds           float64      `json:"oldestTaskAgeSeconds,omitempty"`
+	IngestionRPSLimit              float64      `json:"ingestionRPSLimit,omitempty"`
+	DomainIngestionRPSLimit        float64      `json:"domainIngestionRPSLimit,omitempty"`
+	DomainDispatchRPSLimit         float64      `json:"domainDispatchRPSLimit,omitempty"`
+	ThrottledAddRatePerSecond      float64      `json:"throttledAddRatePerSecond,omitempty"`
+	ThrottledDispatchRatePerSecond float64      `json:"throttledDispatchRatePerSecond,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -8620,6 +9250,78 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetAddRatePerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetAddRatePerSecond() (o float64) {
+	if v != nil {
+		return v.AddRatePerSecond
+	}
+	return
+}
+
+// GetDispatchRatePerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetDispatchRatePerSecond() (o float64) {
+	if v != nil {
+		return v.DispatchRatePerSecond
+	}
+	return
+}
+
+// GetSyncMatchRatio is an internal getter (TBD...)
+func (v *TaskListStatus) GetSyncMatchRatio() (o float64) {
+	if v != nil {
+		return v.SyncMatchRatio
+	}
+	return
+}
+
+// GetOldestTaskAgeSeconds is an internal getter (TBD...)
+func (v *TaskListStatus) GetOldestTaskAgeSeconds() (o float64) {
+	if v != nil {
+		return v.OldestTaskAgeSeconds
+	}
+	return
+}
+
+// GetIngestionRPSLimit is an internal getter (TBD...)
+func (v *TaskListStatus) GetIngestionRPSLimit() (o float64) {
+	if v != nil {
+		return v.IngestionRPSLimit
+	}
+	return
+}
+
+// GetDomainIngestionRPSLimit is an internal getter (TBD...)
+func (v *TaskListStatus) GetDomainIngestionRPSLimit() (o float64) {
+	if v != nil {
+		return v.DomainIngestionRPSLimit
+	}
+	return
+}
+
+// GetDomainDispatchRPSLimit is an internal getter (TBD...)
+func (v *TaskListStatus) GetDomainDispatchRPSLimit() (o float64) {
+	if v != nil {
+		return v.DomainDispatchRPSLimit
+	}
+	return
+}
+
+// GetThrottledAddRatePerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetThrottledAddRatePerSecond() (o float64) {
+	if v != nil {
+		return v.ThrottledAddRatePerSecond
+	}
+	return
+}
+
+// GetThrottledDispatchRatePerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetThrottledDispatchRatePerSecond() (o float64) {
+	if v != nil {
+		return v.ThrottledDispatchRatePerSecond
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -8678,6 +9380,7 @@ type TerminateWorkflowExecutionRequest struct {
 	Reason            string             `json:"reason,omitempty"`
 	Details           []byte             `json:"details,omitempty"`
 	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -8720,6 +9423,14 @@ func (v *TerminateWorkflowExecutionRequest) GetIdentity() (o string) {
 	return
 }
 
+// GetRequestID is an internal getter (TBD...)
+func (v *TerminateWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // TimeoutType is an internal type (TBD...)
 type TimeoutType int32
 
@@ -8918,6 +9629,7 @@ type UpdateDomainRequest struct {
 	SecurityToken                          string                             `json:"securityToken,omitempty"`
 	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
 	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+	DrainedIsolationGroups                 []string                           `json:"drainedIsolationGroups,omitempty"`
 }
 
 // GetName is an internal getter (TBD...)
@@ -9017,6 +9729,73 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// UpdateWorkerBuildIDCompatibilityRequest is an internal type (TBD...)
+type UpdateWorkerBuildIDCompatibilityRequest struct {
+	Domain                       string    `json:"domain,omitempty"`
+	TaskList                     *TaskList `json:"taskList,omitempty"`
+	AddNewBuildIDInNewDefaultSet string    `json:"addNewBuildIdInNewDefaultSet,omitempty"`
+	AddNewCompatibleBuildID      string    `json:"addNewCompatibleBuildId,omitempty"`
+	ExistingCompatibleBuildID    string    `json:"existingCompatibleBuildId,omitempty"`
+	MakeSetDefault               bool      `json:"makeSetDefault,omitempty"`
+	PromoteSetByBuildID          string    `json:"promoteSetByBuildId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetAddNewBuildIDInNewDefaultSet is an internal getter (TBD...)
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetAddNewBuildIDInNewDefaultSet() (o string) {
+	if v != nil {
+		return v.AddNewBuildIDInNewDefaultSet
+	}
+	return
+}
+
+// GetAddNewCompatibleBuildID is an internal getter (TBD...)
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetAddNewCompatibleBuildID() (o string) {
+	if v != nil {
+		return v.AddNewCompatibleBuildID
+	}
+	return
+}
+
+// GetExistingCompatibleBuildID is an internal getter (TBD...)
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetExistingCompatibleBuildID() (o string) {
+	if v != nil {
+		return v.ExistingCompatibleBuildID
+	}
+	return
+}
+
+// GetMakeSetDefault is an internal getter (TBD...)
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetMakeSetDefault() (o bool) {
+	if v != nil {
+		return v.MakeSetDefault
+	}
+	return
+}
+
+// GetPromoteSetByBuildID is an internal getter (TBD...)
+func (v *UpdateWorkerBuildIDCompatibilityRequest) GetPromoteSetByBuildID() (o string) {
+	if v != nil {
+		return v.PromoteSetByBuildID
+	}
+	return
+}
+
 // UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
@@ -9052,6 +9831,41 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) GetSearchAttributes() (o
 	return
 }
 
+// UpsertWorkflowMemoDecisionAttributes is an internal type (TBD...)
+type UpsertWorkflowMemoDecisionAttributes struct {
+	Memo *Memo `json:"memo,omitempty"`
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoDecisionAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// UpsertWorkflowMemoEventAttributes is an internal type (TBD...)
+type UpsertWorkflowMemoEventAttributes struct {
+	DecisionTaskCompletedEventID int64 `json:"decisionTaskCompletedEventId,omitempty"`
+	Memo                         *Memo `json:"memo,omitempty"`
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
 // VersionHistories is an internal type (TBD...)
 type VersionHistories struct {
 	CurrentVersionHistoryIndex int32             `json:"currentVersionHistoryIndex,omitempty"`
@@ -9140,6 +9954,19 @@ func (v *WorkerVersionInfo) GetFeatureVersion() (o string) {
 	return
 }
 
+// WorkerVersionSet is an internal type (TBD...)
+type WorkerVersionSet struct {
+	BuildIDs []string `json:"buildIds,omitempty"`
+}
+
+// GetBuildIDs is an internal getter (TBD...)
+func (v *WorkerVersionSet) GetBuildIDs() (o []string) {
+	if v != nil && v.BuildIDs != nil {
+		return v.BuildIDs
+	}
+	return
+}
+
 // WorkflowExecution is an internal type (TBD...)
 type WorkflowExecution struct {
 	WorkflowID string `json:"workflowId,omitempty"`
@@ -9199,6 +10026,7 @@ type WorkflowExecutionCancelRequestedEventAttributes struct {
 	ExternalInitiatedEventID  *int64             `json:"externalInitiatedEventId,omitempty"`
 	ExternalWorkflowExecution *WorkflowExecution `json:"externalWorkflowExecution,omitempty"`
 	Identity                  string             `json:"identity,omitempty"`
+	RequestID                 string             `json:"requestId,omitempty"`
 }
 
 // GetCause is an internal getter (TBD...)
@@ -9233,6 +10061,14 @@ func (v *WorkflowExecutionCancelRequestedEventAttributes) GetIdentity() (o strin
 	return
 }
 
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionCancelRequestedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // WorkflowExecutionCanceledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionCanceledEventAttributes struct {
 	DecisionTaskCompletedEventID int64  `json:"decisionTaskCompletedEventId,omitempty"`
@@ -9581,22 +10417,23 @@ func (v *WorkflowExecutionFilter) GetRunID() (o string) {
 
 // WorkflowExecutionInfo is an internal type (TBD...)
 type WorkflowExecutionInfo struct {
-	Execution         *WorkflowExecution            `json:"execution,omitempty"`
-	Type              *WorkflowType                 `json:"type,omitempty"`
-	StartTime         *int64                        `json:"startTime,omitempty"`
-	CloseTime         *int64                        `json:"closeTime,omitempty"`
-	CloseStatus       *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-	HistoryLength     int64                         `json:"historyLength,omitempty"`
-	ParentDomainID    *string                       `json:"parentDomainId,omitempty"`
-	ParentDomain      *string                       `json:"parentDomain,omitempty"`
-	ParentExecution   *WorkflowExecution            `json:"parentExecution,omitempty"`
-	ParentInitiatedID *int64                        `json:"parentInitiatedId,omitempty"`
-	ExecutionTime     *int64                        `json:"executionTime,omitempty"`
-	Memo              *Memo                         `json:"memo,omitempty"`
-	SearchAttributes  *SearchAttributes             `json:"searchAttributes,omitempty"`
-	AutoResetPoints   *ResetPoints                  `json:"autoResetPoints,omitempty"`
-	TaskList          string                        `json:"taskList,omitempty"`
-	IsCron            bool                          `json:"isCron,omitempty"`
+	Execution          *WorkflowExecution            `json:"execution,omitempty"`
+	Type               *WorkflowType                 `json:"type,omitempty"`
+	StartTime          *int64                        `json:"startTime,omitempty"`
+	CloseTime          *int64                        `json:"closeTime,omitempty"`
+	CloseStatus        *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	HistoryLength      int64                         `json:"historyLength,omitempty"`
+	ParentDomainID     *string                       `json:"parentDomainId,omitempty"`
+	ParentDomain       *string                       `json:"parentDomain,omitempty"`
+	ParentExecution    *WorkflowExecution            `json:"parentExecution,omitempty"`
+	ParentInitiatedID  *int64                        `json:"parentInitiatedId,omitempty"`
+	ExecutionTime      *int64                        `json:"executionTime,omitempty"`
+	Memo               *Memo                         `json:"memo,omitempty"`
+	SearchAttributes   *SearchAttributes             `json:"searchAttributes,omitempty"`
+	AutoResetPoints    *ResetPoints                  `json:"autoResetPoints,omitempty"`
+	TaskList           string                        `json:"taskList,omitempty"`
+	IsCron             bool                          `json:"isCron,omitempty"`
+	NextRetryTimestamp *int64                        `json:"nextRetryTimestamp,omitempty"`
 }
 
 // GetExecution is an internal getter (TBD...)
@@ -9711,11 +10548,20 @@ func (v *WorkflowExecutionInfo) GetIsCron() (o bool) {
 	return
 }
 
+// GetNextRetryTimestamp is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetNextRetryTimestamp() (o int64) {
+	if v != nil && v.NextRetryTimestamp != nil {
+		return *v.NextRetryTimestamp
+	}
+	return
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
 	Input      []byte `json:"input,omitempty"`
 	Identity   string `json:"identity,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
 }
 
 // GetSignalName is an internal getter (TBD...)
@@ -9742,6 +10588,14 @@ func (v *WorkflowExecutionSignaledEventAttributes) GetIdentity() (o string) {
 	return
 }
 
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionSignaledEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // WorkflowExecutionStartedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionStartedEventAttributes struct {
 	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
@@ -9770,6 +10624,11 @@ type WorkflowExecutionStartedEventAttributes struct {
 	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 	PrevAutoResetPoints                 *ResetPoints            `json:"prevAutoResetPoints,omitempty"`
 	Header                              *Header                 `json:"header,omitempty"`
+	Priority                            int32                   `json:"priority,omitempty"`
+	FairnessKey                         string                  `json:"fairnessKey,omitempty"`
+	IsolationGroup                      string                  `json:"isolationGroup,omitempty"`
+	RetryElapsedExpirationTimestamp     *int64                  `json:"retryElapsedExpirationTimestamp,omitempty"`
+	DelayStartSeconds                   *int32                  `json:"delayStartSeconds,omitempty"`
 }
 
 // GetWorkflowType is an internal getter (TBD...)
@@ -9973,11 +10832,52 @@ func (v *WorkflowExecutionStartedEventAttributes) GetHeader() (o *Header) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetRetryElapsedExpirationTimestamp is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetRetryElapsedExpirationTimestamp() (o int64) {
+	if v != nil && v.RetryElapsedExpirationTimestamp != nil {
+		return *v.RetryElapsedExpirationTimestamp
+	}
+	return
+}
+
+// GetDelayStartSeconds is an internal getter (TBD...)
+func (v *WorkflowExecutionStartedEventAttributes) GetDelayStartSeconds() (o int32) {
+	if v != nil && v.DelayStartSeconds != nil {
+		return *v.DelayStartSeconds
+	}
+	return
+}
+
 // WorkflowExecutionTerminatedEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTerminatedEventAttributes struct {
-	Reason   string `json:"reason,omitempty"`
-	Details  []byte `json:"details,omitempty"`
-	Identity string `json:"identity,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	Details   []byte `json:"details,omitempty"`
+	Identity  string `json:"identity,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
 }
 
 // GetReason is an internal getter (TBD...)
@@ -10004,6 +10904,14 @@ func (v *WorkflowExecutionTerminatedEventAttributes) GetIdentity() (o string) {
 	return
 }
 
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionTerminatedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
 // WorkflowExecutionTimedOutEventAttributes is an internal type (TBD...)
 type WorkflowExecutionTimedOutEventAttributes struct {
 	TimeoutType *TimeoutType `json:"timeoutType,omitempty"`
@@ -10017,6 +10925,64 @@ func (v *WorkflowExecutionTimedOutEventAttributes) GetTimeoutType() (o TimeoutTy
 	return
 }
 
+// WorkflowIDConflictPolicy is an internal type (TBD...)
+type WorkflowIDConflictPolicy int32
+
+// Ptr is a helper function for getting pointer value
+func (e WorkflowIDConflictPolicy) Ptr() *WorkflowIDConflictPolicy {
+	return &e
+}
+
+// String returns a readable string representation of WorkflowIDConflictPolicy.
+func (e WorkflowIDConflictPolicy) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "Fail"
+	case 1:
+		return "UseExisting"
+	case 2:
+		return "TerminateExisting"
+	}
+	return fmt.Sprintf("WorkflowIDConflictPolicy(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *WorkflowIDConflictPolicy) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "FAIL":
+		*e = WorkflowIDConflictPolicyFail
+		return nil
+	case "USEEXISTING":
+		*e = WorkflowIDConflictPolicyUseExisting
+		return nil
+	case "TERMINATEEXISTING":
+		*e = WorkflowIDConflictPolicyTerminateExisting
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "WorkflowIDConflictPolicy", err)
+		}
+		*e = WorkflowIDConflictPolicy(val)
+		return nil
+	}
+}
+
+// MarshalText encodes WorkflowIDConflictPolicy to text.
+func (e WorkflowIDConflictPolicy) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// WorkflowIDConflictPolicyFail is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyFail WorkflowIDConflictPolicy = iota
+	// WorkflowIDConflictPolicyUseExisting is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyUseExisting
+	// WorkflowIDConflictPolicyTerminateExisting is an option for WorkflowIDConflictPolicy
+	WorkflowIDConflictPolicyTerminateExisting
+)
+
 // WorkflowIDReusePolicy is an internal type (TBD...)
 type WorkflowIDReusePolicy int32
 
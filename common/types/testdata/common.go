@@ -48,6 +48,7 @@ const (
 	Identity             = "Identity"
 	CronSchedule         = "CronSchedule"
 	Checksum             = "Checksum"
+	BuildID              = "BuildID"
 	Reason               = "Reason"
 	Cause                = "Cause"
 	SecurityToken        = "SecurityToken"
@@ -56,6 +57,9 @@ const (
 	ClientImpl           = "ClientImpl"
 	ClientLibraryVersion = "ClientLibraryVersion"
 	SupportedVersions    = "SupportedVersions"
+	DynamicConfigName    = "DynamicConfigName"
+	FairnessKey          = "FairnessKey"
+	IsolationGroup       = "IsolationGroup"
 
 	Attempt           = 2
 	PageSize          = 10
@@ -69,6 +73,7 @@ const (
 	MessageID1        = 50001
 	MessageID2        = 50002
 	EventStoreVersion = 333
+	Priority          = 5
 
 	EventID1 = int64(1)
 	EventID2 = int64(2)
@@ -142,12 +147,25 @@ var (
 		Kind: types.TaskListKindNormal.Ptr(),
 	}
 	RetryPolicy = types.RetryPolicy{
-		InitialIntervalInSeconds:    1,
-		BackoffCoefficient:          1.1,
-		MaximumIntervalInSeconds:    2,
-		MaximumAttempts:             3,
-		NonRetriableErrorReasons:    []string{"a", "b"},
-		ExpirationIntervalInSeconds: 4,
+		InitialIntervalInSeconds:         1,
+		BackoffCoefficient:               1.1,
+		MaximumIntervalInSeconds:         2,
+		MaximumAttempts:                  3,
+		NonRetriableErrorReasons:         []string{"a", "b"},
+		ExpirationIntervalInSeconds:      4,
+		BackoffScheduleInSeconds:         []int32{1, 5, 10},
+		JitterMode:                       types.RetryJitterModeEqual,
+		ReasonPolicies:                   []*types.RetryReasonPolicy{&RetryReasonPolicy},
+		MaximumTotalElapsedTimeInSeconds: 60,
+	}
+	RetryReasonPolicy = types.RetryReasonPolicy{
+		ReasonPattern: "^cadenceInternal:Timeout",
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds: 5,
+			BackoffCoefficient:       2,
+			MaximumAttempts:          10,
+			JitterMode:               types.RetryJitterModeFull,
+		},
 	}
 	Header = types.Header{
 		Fields: map[string][]byte{
@@ -217,16 +235,30 @@ var (
 		LastAccessTime: &Timestamp1,
 		Identity:       Identity,
 		RatePerSecond:  RatePerSecond,
+		BuildID:        BuildID,
+		IsolationGroup: IsolationGroup,
 	}
 	PollerInfoArray = []*types.PollerInfo{
 		&PollerInfo,
 	}
 	TaskListStatus = types.TaskListStatus{
-		BacklogCountHint: BacklogCountHint,
-		ReadLevel:        ReadLevel,
-		AckLevel:         AckLevel,
-		RatePerSecond:    RatePerSecond,
-		TaskIDBlock:      &TaskIDBlock,
+		BacklogCountHint:               BacklogCountHint,
+		ReadLevel:                      ReadLevel,
+		AckLevel:                       AckLevel,
+		RatePerSecond:                  RatePerSecond,
+		TaskIDBlock:                    &TaskIDBlock,
+		AddRatePerSecond:               2.5,
+		DispatchRatePerSecond:          2,
+		SyncMatchRatio:                 0.8,
+		OldestTaskAgeSeconds:           12,
+		IngestionRPSLimit:              100,
+		DomainIngestionRPSLimit:        1000,
+		DomainDispatchRPSLimit:         1000,
+		ThrottledAddRatePerSecond:      0.5,
+		ThrottledDispatchRatePerSecond: 0.25,
+	}
+	TaskListStatusMap = map[string]*types.TaskListStatus{
+		TaskListName: &TaskListStatus,
 	}
 	TaskIDBlock = types.TaskIDBlock{
 		StartID: 551,
@@ -239,6 +271,17 @@ var (
 	TaskListPartitionMetadataArray = []*types.TaskListPartitionMetadata{
 		&TaskListPartitionMetadata,
 	}
+	TaskListPartitionConfig = types.TaskListPartitionConfig{
+		NumReadPartitions:  3,
+		NumWritePartitions: 2,
+		ScalingEnabled:     true,
+	}
+	WorkerVersionSet = types.WorkerVersionSet{
+		BuildIDs: []string{BuildID, "BuildID2"},
+	}
+	WorkerVersionSetArray = []*types.WorkerVersionSet{
+		&WorkerVersionSet,
+	}
 	SupportedClientVersions = types.SupportedClientVersions{
 		GoSdk:   "GoSDK",
 		JavaSdk: "JavaSDK",
@@ -278,6 +321,34 @@ var (
 		NumOfItemsInCacheByID:   int64(2000),
 		NumOfItemsInCacheByName: int64(3000),
 	}
+	PersistenceCircuitBreakerInfo = types.PersistenceCircuitBreakerInfo{
+		StoreName:                "cassandra",
+		OperationClass:           "write",
+		State:                    "open",
+		LastStateChangeTimestamp: Timestamp1,
+	}
+	PersistenceCircuitBreakerInfoArray = []*types.PersistenceCircuitBreakerInfo{
+		&PersistenceCircuitBreakerInfo,
+	}
+	DynamicConfigFilter = types.DynamicConfigFilter{
+		Name:  "domainName",
+		Value: &DataBlob,
+	}
+	DynamicConfigFilterArray = []*types.DynamicConfigFilter{
+		&DynamicConfigFilter,
+	}
+	DynamicConfigChange = types.DynamicConfigChange{
+		ID:             TaskID,
+		ConfigName:     DynamicConfigName,
+		Filters:        DynamicConfigFilterArray,
+		Value:          &DataBlob,
+		Deleted:        true,
+		Author:         Identity,
+		Reason:         Reason,
+		TimestampNano:  Timestamp1,
+		ActiveFromNano: Timestamp2,
+		ExpiresAtNano:  Timestamp3,
+	}
 	VersionHistoryItem = types.VersionHistoryItem{
 		EventID: EventID1,
 		Version: Version1,
@@ -319,21 +390,22 @@ var (
 		LatestTime:   &Timestamp2,
 	}
 	WorkflowExecutionInfo = types.WorkflowExecutionInfo{
-		Execution:         &WorkflowExecution,
-		Type:              &WorkflowType,
-		StartTime:         &Timestamp1,
-		CloseTime:         &Timestamp2,
-		CloseStatus:       &WorkflowExecutionCloseStatus,
-		HistoryLength:     HistoryLength,
-		ParentDomainID:    common.StringPtr(DomainID),
-		ParentDomain:      common.StringPtr(DomainName),
-		ParentExecution:   &WorkflowExecution,
-		ParentInitiatedID: common.Int64Ptr(EventID1),
-		ExecutionTime:     &Timestamp3,
-		Memo:              &Memo,
-		SearchAttributes:  &SearchAttributes,
-		AutoResetPoints:   &ResetPoints,
-		TaskList:          TaskListName,
+		Execution:          &WorkflowExecution,
+		Type:               &WorkflowType,
+		StartTime:          &Timestamp1,
+		CloseTime:          &Timestamp2,
+		CloseStatus:        &WorkflowExecutionCloseStatus,
+		HistoryLength:      HistoryLength,
+		ParentDomainID:     common.StringPtr(DomainID),
+		ParentDomain:       common.StringPtr(DomainName),
+		ParentExecution:    &WorkflowExecution,
+		ParentInitiatedID:  common.Int64Ptr(EventID1),
+		ExecutionTime:      &Timestamp3,
+		Memo:               &Memo,
+		SearchAttributes:   &SearchAttributes,
+		AutoResetPoints:    &ResetPoints,
+		TaskList:           TaskListName,
+		NextRetryTimestamp: &Timestamp4,
 	}
 	WorkflowExecutionInfoArray = []*types.WorkflowExecutionInfo{&WorkflowExecutionInfo}
 
@@ -374,6 +446,7 @@ var (
 		LastFailureReason:      &FailureReason,
 		LastWorkerIdentity:     Identity,
 		LastFailureDetails:     FailureDetails,
+		NextRetryTimestamp:     &Timestamp5,
 	}
 	PendingActivityInfoArray = []*types.PendingActivityInfo{
 		&PendingActivityInfo,
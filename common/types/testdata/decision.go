@@ -40,6 +40,7 @@ var (
 		&Decision_StartChildWorkflowExecution,
 		&Decision_StartTimer,
 		&Decision_UpsertWorkflowSearchAttributes,
+		&Decision_UpsertWorkflowMemo,
 	}
 
 	Decision_CancelTimer = types.Decision{
@@ -94,6 +95,10 @@ var (
 		DecisionType: types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr(),
 		UpsertWorkflowSearchAttributesDecisionAttributes: &UpsertWorkflowSearchAttributesDecisionAttributes,
 	}
+	Decision_UpsertWorkflowMemo = types.Decision{
+		DecisionType:                         types.DecisionTypeUpsertWorkflowMemo.Ptr(),
+		UpsertWorkflowMemoDecisionAttributes: &UpsertWorkflowMemoDecisionAttributes,
+	}
 
 	CancelTimerDecisionAttributes = types.CancelTimerDecisionAttributes{
 		TimerID: TimerID,
@@ -153,6 +158,8 @@ var (
 		RetryPolicy:                   &RetryPolicy,
 		Header:                        &Header,
 		RequestLocalDispatch:          true,
+		Priority:                      Priority,
+		FairnessKey:                   FairnessKey,
 	}
 	SignalExternalWorkflowExecutionDecisionAttributes = types.SignalExternalWorkflowExecutionDecisionAttributes{
 		Domain:            DomainName,
@@ -178,6 +185,8 @@ var (
 		Header:                              &Header,
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
+		Priority:                            Priority,
+		FairnessKey:                         FairnessKey,
 	}
 	StartTimerDecisionAttributes = types.StartTimerDecisionAttributes{
 		TimerID:                   TimerID,
@@ -186,4 +195,7 @@ var (
 	UpsertWorkflowSearchAttributesDecisionAttributes = types.UpsertWorkflowSearchAttributesDecisionAttributes{
 		SearchAttributes: &SearchAttributes,
 	}
+	UpsertWorkflowMemoDecisionAttributes = types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: &Memo,
+	}
 )
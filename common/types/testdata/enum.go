@@ -48,4 +48,5 @@ var (
 	TimeoutType                                = types.TimeoutTypeScheduleToStart
 	WorkflowExecutionCloseStatus               = types.WorkflowExecutionCloseStatusContinuedAsNew
 	WorkflowIDReusePolicy                      = types.WorkflowIDReusePolicyTerminateIfRunning
+	WorkflowIDConflictPolicy                   = types.WorkflowIDConflictPolicyUseExisting
 )
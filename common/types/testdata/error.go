@@ -94,7 +94,8 @@ var (
 		EndEventVersion:   common.Int64Ptr(Version2),
 	}
 	ServiceBusyError = types.ServiceBusyError{
-		Message: ErrorMessage,
+		Message:                ErrorMessage,
+		RetryAfterMilliseconds: 1500,
 	}
 	ShardOwnershipLostError = types.ShardOwnershipLostError{
 		Message: ErrorMessage,
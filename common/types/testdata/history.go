@@ -73,6 +73,7 @@ var (
 		&HistoryEvent_SignalExternalWorkflowExecutionFailed,
 		&HistoryEvent_ExternalWorkflowExecutionSignaled,
 		&HistoryEvent_UpsertWorkflowSearchAttributes,
+		&HistoryEvent_UpsertWorkflowMemo,
 	}
 
 	HistoryEvent_WorkflowExecutionStarted = generateEvent(func(e *types.HistoryEvent) {
@@ -243,6 +244,10 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	HistoryEvent_UpsertWorkflowMemo = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeUpsertWorkflowMemo.Ptr()
+		e.UpsertWorkflowMemoEventAttributes = &UpsertWorkflowMemoEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -271,6 +276,11 @@ var (
 		SearchAttributes:                    &SearchAttributes,
 		PrevAutoResetPoints:                 &ResetPoints,
 		Header:                              &Header,
+		Priority:                            Priority,
+		FairnessKey:                         FairnessKey,
+		IsolationGroup:                      IsolationGroup,
+		RetryElapsedExpirationTimestamp:     &Timestamp2,
+		DelayStartSeconds:                   &Duration4,
 	}
 	WorkflowExecutionCompletedEventAttributes = types.WorkflowExecutionCompletedEventAttributes{
 		Result:                       Payload1,
@@ -336,6 +346,8 @@ var (
 		DecisionTaskCompletedEventID:  EventID1,
 		RetryPolicy:                   &RetryPolicy,
 		Header:                        &Header,
+		Priority:                      Priority,
+		FairnessKey:                   FairnessKey,
 	}
 	ActivityTaskStartedEventAttributes = types.ActivityTaskStartedEventAttributes{
 		ScheduledEventID:   EventID1,
@@ -413,17 +425,20 @@ var (
 		SignalName: SignalName,
 		Input:      Payload1,
 		Identity:   Identity,
+		RequestID:  RequestID,
 	}
 	WorkflowExecutionTerminatedEventAttributes = types.WorkflowExecutionTerminatedEventAttributes{
-		Reason:   Reason,
-		Details:  Payload1,
-		Identity: Identity,
+		Reason:    Reason,
+		Details:   Payload1,
+		Identity:  Identity,
+		RequestID: RequestID,
 	}
 	WorkflowExecutionCancelRequestedEventAttributes = types.WorkflowExecutionCancelRequestedEventAttributes{
 		Cause:                     Cause,
 		ExternalInitiatedEventID:  common.Int64Ptr(EventID1),
 		ExternalWorkflowExecution: &WorkflowExecution,
 		Identity:                  Identity,
+		RequestID:                 RequestID,
 	}
 	WorkflowExecutionCanceledEventAttributes = types.WorkflowExecutionCanceledEventAttributes{
 		DecisionTaskCompletedEventID: EventID1,
@@ -483,6 +498,8 @@ var (
 		Header:                              &Header,
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
+		Priority:                            Priority,
+		FairnessKey:                         FairnessKey,
 	}
 	StartChildWorkflowExecutionFailedEventAttributes = types.StartChildWorkflowExecutionFailedEventAttributes{
 		Domain:                       DomainName,
@@ -567,6 +584,10 @@ var (
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
 	}
+	UpsertWorkflowMemoEventAttributes = types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: EventID1,
+		Memo:                         &Memo,
+	}
 )
 
 func generateEvent(modifier func(e *types.HistoryEvent)) types.HistoryEvent {
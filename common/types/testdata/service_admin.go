@@ -51,11 +51,12 @@ var (
 		ExecutionForHost: &WorkflowExecution,
 	}
 	AdminDescribeHistoryHostResponse = types.DescribeHistoryHostResponse{
-		NumberOfShards:        1,
-		ShardIDs:              []int32{ShardID},
-		DomainCache:           &DomainCacheInfo,
-		ShardControllerStatus: "ShardControllerStatus",
-		Address:               HostName,
+		NumberOfShards:             1,
+		ShardIDs:                   []int32{ShardID},
+		DomainCache:                &DomainCacheInfo,
+		ShardControllerStatus:      "ShardControllerStatus",
+		Address:                    HostName,
+		PersistenceCircuitBreakers: PersistenceCircuitBreakerInfoArray,
 	}
 	AdminDescribeQueueRequest = types.DescribeQueueRequest{
 		ShardID:     ShardID,
@@ -174,4 +175,160 @@ var (
 	}
 	AdminGetCrossClusterTasksRequest  = GetCrossClusterTasksRequest
 	AdminGetCrossClusterTasksResponse = GetCrossClusterTasksResponse
+	AdminGetDynamicConfigRequest      = types.GetDynamicConfigRequest{
+		ConfigName: DynamicConfigName,
+		Filters:    DynamicConfigFilterArray,
+	}
+	AdminGetDynamicConfigResponse = types.GetDynamicConfigResponse{
+		Value: &DataBlob,
+	}
+	AdminUpdateDynamicConfigRequest = types.UpdateDynamicConfigRequest{
+		ConfigName:     DynamicConfigName,
+		Filters:        DynamicConfigFilterArray,
+		Value:          &DataBlob,
+		Reason:         Reason,
+		ActiveFromNano: Timestamp1,
+		ExpiresAtNano:  Timestamp2,
+	}
+	AdminRestoreDynamicConfigRequest = types.RestoreDynamicConfigRequest{
+		ConfigName: DynamicConfigName,
+		Filters:    DynamicConfigFilterArray,
+		Reason:     Reason,
+	}
+	AdminListDynamicConfigRequest = types.ListDynamicConfigRequest{
+		ConfigName:     DynamicConfigName,
+		IncludeHistory: true,
+	}
+	AdminListDynamicConfigResponse = types.ListDynamicConfigResponse{
+		Entries: []*types.DynamicConfigEntry{
+			{
+				ConfigName: DynamicConfigName,
+				Values: []*types.DynamicConfigValue{
+					{
+						Filters:    DynamicConfigFilterArray,
+						Value:      &DataBlob,
+						LastChange: &DynamicConfigChange,
+					},
+				},
+			},
+		},
+		History: []*types.DynamicConfigChange{&DynamicConfigChange},
+	}
+	AdminPauseTaskListRequest = types.PauseTaskListRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+		Reason:       Reason,
+	}
+	AdminResumeTaskListRequest = types.ResumeTaskListRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+		Reason:       Reason,
+	}
+	AdminMigrateTaskListRequest = types.MigrateTaskListRequest{
+		Domain:              DomainName,
+		TaskList:            &TaskList,
+		TaskListType:        &TaskListType,
+		DestinationTaskList: TaskListName,
+		Reason:              Reason,
+	}
+	AdminTaskListDLQEntry = types.TaskListDLQEntry{
+		MessageID:         MessageID1,
+		Domain:            DomainName,
+		TaskList:          TaskListName,
+		TaskListType:      &TaskListType,
+		WorkflowExecution: &WorkflowExecution,
+		ScheduleID:        EventID1,
+		Attempts:          Attempt,
+		EnqueuedTimestamp: &Timestamp1,
+	}
+	AdminReadTaskListDLQRequest = types.ReadTaskListDLQRequest{
+		Domain:          DomainName,
+		TaskList:        TaskListName,
+		TaskListType:    &TaskListType,
+		MaximumPageSize: PageSize,
+		NextPageToken:   NextPageToken,
+	}
+	AdminReadTaskListDLQResponse = types.ReadTaskListDLQResponse{
+		Entries:       []*types.TaskListDLQEntry{&AdminTaskListDLQEntry},
+		NextPageToken: NextPageToken,
+	}
+	AdminDescribeTaskListDLQEntryRequest = types.DescribeTaskListDLQEntryRequest{
+		Domain:    DomainName,
+		MessageID: MessageID1,
+	}
+	AdminDescribeTaskListDLQEntryResponse = types.DescribeTaskListDLQEntryResponse{
+		Entry: &AdminTaskListDLQEntry,
+	}
+	AdminRedriveTaskListDLQEntryRequest = types.RedriveTaskListDLQEntryRequest{
+		Domain:    DomainName,
+		MessageID: MessageID1,
+	}
+	AdminFailTaskListDLQEntryRequest = types.FailTaskListDLQEntryRequest{
+		Domain:    DomainName,
+		MessageID: MessageID1,
+	}
+	AdminDescribeShardDistributionResponse = types.DescribeShardDistributionResponse{
+		NumberOfShards: 1,
+		Shards:         map[int32]string{ShardID: HostName},
+		PlacementMode:  "load",
+		Placements: map[int32]*types.ShardPlacementInfo{ShardID: {
+			AssignedHost: HostName,
+			Pinned:       true,
+			RPS:          10.5,
+			QueueBacklog: 100,
+			CacheSize:    1000,
+		}},
+	}
+	AdminMoveShardRequest = types.MoveShardRequest{
+		ShardID: ShardID,
+		Host:    HostName,
+		Pin:     true,
+		Reason:  Reason,
+	}
+	AdminUnpinShardRequest = types.UnpinShardRequest{
+		ShardID: ShardID,
+		Reason:  Reason,
+	}
+	AdminListQuarantinedWorkflowsRequest = types.ListQuarantinedWorkflowsRequest{
+		Domain: DomainName,
+	}
+	AdminListQuarantinedWorkflowsResponse = types.ListQuarantinedWorkflowsResponse{
+		Workflows: []*types.QuarantinedWorkflow{{
+			Domain:               DomainName,
+			WorkflowID:           WorkflowID,
+			RunID:                RunID,
+			ShardID:              ShardID,
+			Details:              Reason,
+			QuarantinedTimestamp: &Timestamp1,
+		}},
+	}
+	AdminReleaseQuarantinedWorkflowRequest = types.ReleaseQuarantinedWorkflowRequest{
+		Domain:     DomainName,
+		WorkflowID: WorkflowID,
+		RunID:      RunID,
+		Repair:     true,
+		Reason:     Reason,
+	}
+	AdminCheckWorkflowBuildRequest = types.CheckWorkflowBuildRequest{
+		Domain:                              DomainName,
+		WorkflowType:                        WorkflowTypeName,
+		BuildID:                             BuildID,
+		SignatureHash:                       "SignatureHash",
+		TaskList:                            TaskListName,
+		EarliestTime:                        &Timestamp1,
+		ShadowCount:                         100,
+		Concurrency:                         4,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600),
+	}
+	AdminCheckWorkflowBuildResponse = types.CheckWorkflowBuildResponse{
+		State:          types.WorkflowBuildCheckStatePassed.Ptr(),
+		WorkflowID:     WorkflowID,
+		RunID:          RunID,
+		CheckedBuildID: BuildID,
+		Succeeded:      90,
+		Skipped:        8,
+		Failed:         2,
+	}
 )
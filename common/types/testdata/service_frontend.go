@@ -80,6 +80,7 @@ var (
 		SecurityToken:                          SecurityToken,
 		DeleteBadBinary:                        common.StringPtr(DeleteBadBinary),
 		FailoverTimeoutInSeconds:               &Duration1,
+		DrainedIsolationGroups:                 []string{IsolationGroup},
 	}
 	UpdateDomainResponse = types.UpdateDomainResponse{
 		DomainInfo:               &DomainInfo,
@@ -170,6 +171,7 @@ var (
 		TaskList:       &TaskList,
 		Identity:       Identity,
 		BinaryChecksum: Checksum,
+		IsolationGroup: IsolationGroup,
 	}
 	PollForDecisionTaskResponse = types.PollForDecisionTaskResponse{
 		TaskToken:                 TaskToken,
@@ -215,6 +217,8 @@ var (
 		TaskList:         &TaskList,
 		Identity:         Identity,
 		TaskListMetadata: &TaskListMetadata,
+		BinaryChecksum:   Checksum,
+		IsolationGroup:   IsolationGroup,
 	}
 	PollForActivityTaskResponse = types.PollForActivityTaskResponse{
 		TaskToken:                       TaskToken,
@@ -320,6 +324,10 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		Priority:                            Priority,
+		FairnessKey:                         FairnessKey,
+		IsolationGroup:                      IsolationGroup,
+		WorkflowIDConflictPolicy:            &WorkflowIDConflictPolicy,
 	}
 	StartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -352,6 +360,8 @@ var (
 		Memo:                                &Memo,
 		SearchAttributes:                    &SearchAttributes,
 		Header:                              &Header,
+		IsolationGroup:                      IsolationGroup,
+		WorkflowIDConflictPolicy:            &WorkflowIDConflictPolicy,
 	}
 	ResetWorkflowExecutionRequest = types.ResetWorkflowExecutionRequest{
 		Domain:                DomainName,
@@ -370,6 +380,12 @@ var (
 		Reason:            Reason,
 		Details:           Payload1,
 		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	ExpediteWorkflowExecutionStartRequest = types.ExpediteWorkflowExecutionStartRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Identity:          Identity,
 	}
 	DescribeWorkflowExecutionRequest = types.DescribeWorkflowExecutionRequest{
 		Domain:    DomainName,
@@ -398,18 +414,38 @@ var (
 		TaskList:              &TaskList,
 		TaskListType:          &TaskListType,
 		IncludeTaskListStatus: true,
+		IncludePartitions:     true,
 	}
 	DescribeTaskListResponse = types.DescribeTaskListResponse{
-		Pollers:        PollerInfoArray,
-		TaskListStatus: &TaskListStatus,
+		Pollers:          PollerInfoArray,
+		TaskListStatus:   &TaskListStatus,
+		PartitionConfig:  &TaskListPartitionConfig,
+		PollersByBuildID: map[string]int32{BuildID: 1},
+		PartitionStatus:  TaskListStatusMap,
 	}
 	ListTaskListPartitionsRequest = types.ListTaskListPartitionsRequest{
 		Domain:   DomainName,
 		TaskList: &TaskList,
 	}
 	ListTaskListPartitionsResponse = types.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: TaskListPartitionMetadataArray,
-		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
+		ActivityTaskListPartitions:      TaskListPartitionMetadataArray,
+		DecisionTaskListPartitions:      TaskListPartitionMetadataArray,
+		ActivityTaskListPartitionConfig: &TaskListPartitionConfig,
+		DecisionTaskListPartitionConfig: &TaskListPartitionConfig,
+	}
+	UpdateWorkerBuildIDCompatibilityRequest = types.UpdateWorkerBuildIDCompatibilityRequest{
+		Domain:                    DomainName,
+		TaskList:                  &TaskList,
+		AddNewCompatibleBuildID:   "BuildID2",
+		ExistingCompatibleBuildID: BuildID,
+		MakeSetDefault:            true,
+	}
+	GetWorkerBuildIDCompatibilityRequest = types.GetWorkerBuildIDCompatibilityRequest{
+		Domain:   DomainName,
+		TaskList: &TaskList,
+	}
+	GetWorkerBuildIDCompatibilityResponse = types.GetWorkerBuildIDCompatibilityResponse{
+		VersionSets: WorkerVersionSetArray,
 	}
 	ResetStickyTaskListRequest = types.ResetStickyTaskListRequest{
 		Domain:    DomainName,
@@ -72,6 +72,7 @@ var (
 		WorkflowCloseState:                   common.Int32Ptr(persistence.WorkflowCloseStatusTimedOut),
 		VersionHistories:                     &VersionHistories,
 		IsStickyTaskListEnabled:              true,
+		BuildID:                              BuildID,
 	}
 	HistoryGetReplicationMessagesRequest  = AdminGetReplicationMessagesRequest
 	HistoryGetReplicationMessagesResponse = AdminGetReplicationMessagesResponse
@@ -259,6 +260,7 @@ var (
 		ContinuedFailureDetails:         FailureDetails,
 		LastCompletionResult:            Payload1,
 		FirstDecisionTaskBackoffSeconds: &Duration1,
+		RetryElapsedExpirationTimestamp: &Timestamp2,
 	}
 	HistoryStartWorkflowExecutionResponse = types.StartWorkflowExecutionResponse{
 		RunID: RunID,
@@ -289,6 +291,10 @@ var (
 		DomainUUID:       DomainID,
 		TerminateRequest: &TerminateWorkflowExecutionRequest,
 	}
+	HistoryExpediteWorkflowExecutionStartRequest = types.HistoryExpediteWorkflowExecutionStartRequest{
+		DomainUUID:      DomainID,
+		ExpediteRequest: &ExpediteWorkflowExecutionStartRequest,
+	}
 	HistoryGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
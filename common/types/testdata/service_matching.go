@@ -40,6 +40,12 @@ var (
 		ScheduleToStartTimeoutSeconds: &Duration1,
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
+		Priority:                      Priority,
+		FairnessKey:                   FairnessKey,
+		BuildID:                       BuildID,
+		Attempt:                       Attempt,
+		LastHeartbeatTimestamp:        &Timestamp1,
+		IsolationGroup:                IsolationGroup,
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -49,6 +55,11 @@ var (
 		ScheduleToStartTimeoutSeconds: &Duration1,
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
+		Priority:                      Priority,
+		FairnessKey:                   FairnessKey,
+		BuildID:                       BuildID,
+		Attempt:                       Attempt,
+		IsolationGroup:                IsolationGroup,
 	}
 	MatchingCancelOutstandingPollRequest = types.CancelOutstandingPollRequest{
 		DomainUUID:   DomainID,
@@ -61,16 +72,21 @@ var (
 		DescRequest: &DescribeTaskListRequest,
 	}
 	MatchingDescribeTaskListResponse = types.DescribeTaskListResponse{
-		Pollers:        PollerInfoArray,
-		TaskListStatus: &TaskListStatus,
+		Pollers:          PollerInfoArray,
+		TaskListStatus:   &TaskListStatus,
+		PartitionConfig:  &TaskListPartitionConfig,
+		PollersByBuildID: map[string]int32{BuildID: 1},
+		PartitionStatus:  TaskListStatusMap,
 	}
 	MatchingListTaskListPartitionsRequest = types.MatchingListTaskListPartitionsRequest{
 		Domain:   DomainName,
 		TaskList: &TaskList,
 	}
 	MatchingListTaskListPartitionsResponse = types.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: TaskListPartitionMetadataArray,
-		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
+		ActivityTaskListPartitions:      TaskListPartitionMetadataArray,
+		DecisionTaskListPartitions:      TaskListPartitionMetadataArray,
+		ActivityTaskListPartitionConfig: &TaskListPartitionConfig,
+		DecisionTaskListPartitionConfig: &TaskListPartitionConfig,
 	}
 	MatchingPollForActivityTaskRequest = types.MatchingPollForActivityTaskRequest{
 		DomainUUID:    DomainID,
@@ -126,8 +142,18 @@ var (
 		TaskList:      &TaskList,
 		QueryRequest:  &QueryWorkflowRequest,
 		ForwardedFrom: ForwardedFrom,
+		BuildID:       BuildID,
 	}
-	MatchingQueryWorkflowResponse = types.QueryWorkflowResponse{
+	MatchingUpdateWorkerBuildIDCompatibilityRequest = types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+		DomainUUID:    DomainID,
+		UpdateRequest: &UpdateWorkerBuildIDCompatibilityRequest,
+	}
+	MatchingGetWorkerBuildIDCompatibilityRequest = types.MatchingGetWorkerBuildIDCompatibilityRequest{
+		DomainUUID: DomainID,
+		GetRequest: &GetWorkerBuildIDCompatibilityRequest,
+	}
+	MatchingGetWorkerBuildIDCompatibilityResponse = GetWorkerBuildIDCompatibilityResponse
+	MatchingQueryWorkflowResponse                 = types.QueryWorkflowResponse{
 		QueryResult:   Payload1,
 		QueryRejected: &QueryRejected,
 	}
@@ -137,6 +163,22 @@ var (
 		TaskID:           "TaskID",
 		CompletedRequest: &RespondQueryTaskCompletedRequest,
 	}
+	MatchingUpdateTaskListStateRequest = types.MatchingUpdateTaskListStateRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+		Paused:       common.BoolPtr(true),
+		MigrateTo:    common.StringPtr(TaskListName),
+	}
+	MatchingGetTaskListStateRequest = types.MatchingGetTaskListStateRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+	}
+	MatchingTaskListState = types.TaskListState{
+		Paused:    true,
+		MigrateTo: TaskListName,
+	}
 	MatchingGetTaskListsByDomainRequest = types.GetTaskListsByDomainRequest{
 		Domain: DomainName,
 	}
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -80,6 +81,8 @@ const (
 
 	contextExpireThreshold = 10 * time.Millisecond
 
+	retryReasonPatternsCacheSize = 1000
+
 	// FailureReasonCompleteResultExceedsLimit is failureReason for complete result exceeds limit
 	FailureReasonCompleteResultExceedsLimit = "COMPLETE_RESULT_EXCEEDS_LIMIT"
 	// FailureReasonFailureDetailsExceedsLimit is failureReason for failure details exceeds limit
@@ -103,6 +106,9 @@ var (
 	ErrContextTimeoutTooShort = &types.BadRequestError{Message: "Context timeout is too short."}
 	// ErrContextTimeoutNotSet is error for not setting a context timeout when calling a long poll API
 	ErrContextTimeoutNotSet = &types.BadRequestError{Message: "Context timeout is not set."}
+
+	retryReasonPatternsLock sync.RWMutex
+	retryReasonPatterns     = make(map[string]*regexp.Regexp)
 )
 
 // AwaitWaitGroup calls Wait on the given wait
@@ -257,6 +263,16 @@ func IsServiceTransientError(err error) bool {
 	return false
 }
 
+// IsServiceTransientErrorWithoutRetryAfter checks if the error is a transient error which can be retried
+// right away. Service busy errors which ask the caller to retry after a backoff are not retried, so that the
+// backoff reaches the caller.
+func IsServiceTransientErrorWithoutRetryAfter(err error) bool {
+	if busy, ok := err.(*types.ServiceBusyError); ok && busy.RetryAfterMilliseconds > 0 {
+		return false
+	}
+	return IsServiceTransientError(err)
+}
+
 // IsEntityNotExistsError checks if the error is an entity not exists error.
 func IsEntityNotExistsError(err error) bool {
 	_, ok := err.(*types.EntityNotExistsError)
@@ -430,11 +446,120 @@ func ValidateRetryPolicy(policy *types.RetryPolicy) error {
 		// nil policy is valid which means no retry
 		return nil
 	}
-	if policy.GetInitialIntervalInSeconds() <= 0 {
-		return &types.BadRequestError{Message: "InitialIntervalInSeconds must be greater than 0 on retry policy."}
+	if err := validateRetryBackoff(policy); err != nil {
+		return err
+	}
+	if policy.GetExpirationIntervalInSeconds() < 0 {
+		return &types.BadRequestError{Message: "ExpirationIntervalInSeconds cannot be less than 0 on retry policy."}
+	}
+	if policy.GetMaximumTotalElapsedTimeInSeconds() < 0 {
+		return &types.BadRequestError{Message: "MaximumTotalElapsedTimeInSeconds cannot be less than 0 on retry policy."}
+	}
+	if policy.GetMaximumAttempts() == 0 && policy.GetExpirationIntervalInSeconds() == 0 && policy.GetMaximumTotalElapsedTimeInSeconds() == 0 {
+		return &types.BadRequestError{Message: "MaximumAttempts, ExpirationIntervalInSeconds and MaximumTotalElapsedTimeInSeconds are all 0. At least one of them must be specified."}
+	}
+	for _, reasonPolicy := range policy.GetReasonPolicies() {
+		if reasonPolicy == nil {
+			return &types.BadRequestError{Message: "ReasonPolicies cannot contain a nil policy on retry policy."}
+		}
+		if reasonPolicy.GetReasonPattern() == "" {
+			return &types.BadRequestError{Message: "ReasonPattern is not set on retry reason policy."}
+		}
+		if _, err := CompileRetryReasonPattern(reasonPolicy.GetReasonPattern()); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("ReasonPattern %q is not a valid regular expression: %v", reasonPolicy.GetReasonPattern(), err)}
+		}
+		nested := reasonPolicy.GetRetryPolicy()
+		if nested == nil {
+			return &types.BadRequestError{Message: "RetryPolicy is not set on retry reason policy."}
+		}
+		// expiration and elapsed time are bounds of the whole retry, so they are only taken from the top level policy
+		if nested.GetExpirationIntervalInSeconds() != 0 || nested.GetMaximumTotalElapsedTimeInSeconds() != 0 || len(nested.GetReasonPolicies()) != 0 {
+			return &types.BadRequestError{Message: "RetryPolicy of retry reason policy cannot set ExpirationIntervalInSeconds, MaximumTotalElapsedTimeInSeconds or ReasonPolicies."}
+		}
+		// the fields the reason policy leaves unset are inherited, so the backoff is validated as applied
+		if err := validateRetryBackoff(ApplyRetryReasonPolicy(policy, nested)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyRetryReasonPolicy returns the backoff of a retry policy overridden by the retry policy of its matching
+// reason policy. Only the fields set on the reason policy are overridden, the others are inherited.
+// An initial interval set without a backoff schedule switches to the exponential backoff.
+func ApplyRetryReasonPolicy(policy *types.RetryPolicy, reasonPolicy *types.RetryPolicy) *types.RetryPolicy {
+	result := &types.RetryPolicy{
+		InitialIntervalInSeconds: policy.GetInitialIntervalInSeconds(),
+		BackoffCoefficient:       policy.GetBackoffCoefficient(),
+		MaximumIntervalInSeconds: policy.GetMaximumIntervalInSeconds(),
+		MaximumAttempts:          policy.GetMaximumAttempts(),
+		NonRetriableErrorReasons: policy.GetNonRetriableErrorReasons(),
+		BackoffScheduleInSeconds: policy.GetBackoffScheduleInSeconds(),
+		JitterMode:               policy.GetJitterMode(),
+	}
+	if reasonPolicy.GetInitialIntervalInSeconds() != 0 {
+		result.InitialIntervalInSeconds = reasonPolicy.GetInitialIntervalInSeconds()
+		result.BackoffScheduleInSeconds = nil
 	}
-	if policy.GetBackoffCoefficient() < 1 {
-		return &types.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 on retry policy."}
+	if len(reasonPolicy.GetBackoffScheduleInSeconds()) > 0 {
+		result.BackoffScheduleInSeconds = reasonPolicy.GetBackoffScheduleInSeconds()
+	}
+	if reasonPolicy.GetBackoffCoefficient() != 0 {
+		result.BackoffCoefficient = reasonPolicy.GetBackoffCoefficient()
+	}
+	if reasonPolicy.GetMaximumIntervalInSeconds() != 0 {
+		result.MaximumIntervalInSeconds = reasonPolicy.GetMaximumIntervalInSeconds()
+	}
+	if reasonPolicy.GetMaximumAttempts() != 0 {
+		result.MaximumAttempts = reasonPolicy.GetMaximumAttempts()
+	}
+	if len(reasonPolicy.GetNonRetriableErrorReasons()) > 0 {
+		result.NonRetriableErrorReasons = reasonPolicy.GetNonRetriableErrorReasons()
+	}
+	if reasonPolicy.GetJitterMode() != types.RetryJitterModeNone {
+		result.JitterMode = reasonPolicy.GetJitterMode()
+	}
+	return result
+}
+
+// CompileRetryReasonPattern compiles the reason pattern of a retry reason policy.
+// Compiled patterns are cached so matching a failure reason does not recompile them.
+func CompileRetryReasonPattern(pattern string) (*regexp.Regexp, error) {
+	retryReasonPatternsLock.RLock()
+	compiled, ok := retryReasonPatterns[pattern]
+	retryReasonPatternsLock.RUnlock()
+	if ok {
+		return compiled, nil
+	}
+
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	retryReasonPatternsLock.Lock()
+	defer retryReasonPatternsLock.Unlock()
+	if len(retryReasonPatterns) >= retryReasonPatternsCacheSize {
+		retryReasonPatterns = make(map[string]*regexp.Regexp)
+	}
+	retryReasonPatterns[pattern] = compiled
+	return compiled, nil
+}
+
+func validateRetryBackoff(policy *types.RetryPolicy) error {
+	if len(policy.GetBackoffScheduleInSeconds()) > 0 {
+		// an explicit schedule replaces the exponential backoff
+		for _, interval := range policy.GetBackoffScheduleInSeconds() {
+			if interval <= 0 {
+				return &types.BadRequestError{Message: "BackoffScheduleInSeconds must only contain intervals greater than 0 on retry policy."}
+			}
+		}
+	} else {
+		if policy.GetInitialIntervalInSeconds() <= 0 {
+			return &types.BadRequestError{Message: "InitialIntervalInSeconds must be greater than 0 on retry policy."}
+		}
+		if policy.GetBackoffCoefficient() < 1 {
+			return &types.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 on retry policy."}
+		}
 	}
 	if policy.GetMaximumIntervalInSeconds() < 0 {
 		return &types.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than 0 on retry policy."}
@@ -445,11 +570,10 @@ func ValidateRetryPolicy(policy *types.RetryPolicy) error {
 	if policy.GetMaximumAttempts() < 0 {
 		return &types.BadRequestError{Message: "MaximumAttempts cannot be less than 0 on retry policy."}
 	}
-	if policy.GetExpirationIntervalInSeconds() < 0 {
-		return &types.BadRequestError{Message: "ExpirationIntervalInSeconds cannot be less than 0 on retry policy."}
-	}
-	if policy.GetMaximumAttempts() == 0 && policy.GetExpirationIntervalInSeconds() == 0 {
-		return &types.BadRequestError{Message: "MaximumAttempts and ExpirationIntervalInSeconds are both 0. At least one of them must be specified."}
+	switch policy.GetJitterMode() {
+	case types.RetryJitterModeNone, types.RetryJitterModeFull, types.RetryJitterModeEqual:
+	default:
+		return &types.BadRequestError{Message: fmt.Sprintf("JitterMode %v is not supported on retry policy.", policy.GetJitterMode())}
 	}
 	return nil
 }
@@ -485,6 +609,12 @@ func CreateHistoryStartWorkflowRequest(
 		deadline := now.Add(time.Duration(expirationInSeconds) * time.Second)
 		histRequest.ExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
 	}
+	if startRequest.RetryPolicy.GetMaximumTotalElapsedTimeInSeconds() > 0 {
+		// unlike expiration, the elapsed time deadline is carried over to the runs continued from this one
+		elapsedInSeconds := startRequest.RetryPolicy.GetMaximumTotalElapsedTimeInSeconds() + firstDecisionTaskBackoffSeconds
+		deadline := now.Add(time.Duration(elapsedInSeconds) * time.Second)
+		histRequest.RetryElapsedExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
+	}
 
 	return histRequest
 }
@@ -712,6 +842,10 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		if event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes != nil {
 			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
 		}
+	case types.EventTypeUpsertWorkflowMemo:
+		if event.UpsertWorkflowMemoEventAttributes.Memo != nil {
+			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowMemoEventAttributes.Memo.Fields)
+		}
 	}
 	return uint64(res)
 }
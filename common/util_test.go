@@ -57,6 +57,13 @@ func TestIsServiceTransientError_ContextCancel(t *testing.T) {
 	require.False(t, IsServiceTransientError(ctx.Err()))
 }
 
+func TestIsServiceTransientErrorWithoutRetryAfter(t *testing.T) {
+	require.True(t, IsServiceTransientErrorWithoutRetryAfter(&types.InternalServiceError{}))
+	require.True(t, IsServiceTransientErrorWithoutRetryAfter(&types.ServiceBusyError{}))
+	require.False(t, IsServiceTransientErrorWithoutRetryAfter(&types.ServiceBusyError{RetryAfterMilliseconds: 100}))
+	require.False(t, IsServiceTransientErrorWithoutRetryAfter(&types.BadRequestError{}))
+}
+
 func TestIsContextTimeoutError(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 	defer cancel()
@@ -175,6 +182,130 @@ func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithoutCron(t *testing.
 	require.True(t, delta < 62*time.Second)
 }
 
+func TestCreateHistoryStartWorkflowRequest_RetryElapsedExpirationTime(t *testing.T) {
+	domainID := uuid.New()
+	request := &types.StartWorkflowExecutionRequest{
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds:         60,
+			MaximumTotalElapsedTimeInSeconds: 3600,
+		},
+	}
+	now := time.Now()
+	startRequest := CreateHistoryStartWorkflowRequest(domainID, request, now)
+	require.NotNil(t, startRequest)
+	require.Nil(t, startRequest.ExpirationTimestamp)
+
+	delta := time.Unix(0, startRequest.GetRetryElapsedExpirationTimestamp()).Sub(now)
+	require.True(t, delta > 3598*time.Second)
+	require.True(t, delta < 3602*time.Second)
+}
+
+func TestValidateRetryPolicy(t *testing.T) {
+	validReasonPolicy := &types.RetryReasonPolicy{
+		ReasonPattern: "^throttled",
+		RetryPolicy:   &types.RetryPolicy{BackoffScheduleInSeconds: []int32{60}},
+	}
+	testCases := []struct {
+		name     string
+		policy   *types.RetryPolicy
+		errorMsg string
+	}{
+		{
+			name: "nil policy",
+		},
+		{
+			name:   "exponential backoff",
+			policy: &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2, MaximumAttempts: 3},
+		},
+		{
+			name:     "missing initial interval",
+			policy:   &types.RetryPolicy{BackoffCoefficient: 2, MaximumAttempts: 3},
+			errorMsg: "InitialIntervalInSeconds must be greater than 0 on retry policy.",
+		},
+		{
+			name:   "backoff schedule with elapsed time",
+			policy: &types.RetryPolicy{BackoffScheduleInSeconds: []int32{1, 10}, JitterMode: types.RetryJitterModeEqual, MaximumTotalElapsedTimeInSeconds: 60},
+		},
+		{
+			name:     "invalid backoff schedule",
+			policy:   &types.RetryPolicy{BackoffScheduleInSeconds: []int32{1, 0}, MaximumAttempts: 3},
+			errorMsg: "BackoffScheduleInSeconds must only contain intervals greater than 0 on retry policy.",
+		},
+		{
+			name:     "invalid jitter mode",
+			policy:   &types.RetryPolicy{BackoffScheduleInSeconds: []int32{1}, JitterMode: types.RetryJitterMode(10), MaximumAttempts: 3},
+			errorMsg: "JitterMode RetryJitterMode(10) is not supported on retry policy.",
+		},
+		{
+			name:     "no bound",
+			policy:   &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2},
+			errorMsg: "MaximumAttempts, ExpirationIntervalInSeconds and MaximumTotalElapsedTimeInSeconds are all 0. At least one of them must be specified.",
+		},
+		{
+			name:   "reason policy",
+			policy: &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2, MaximumAttempts: 3, ReasonPolicies: []*types.RetryReasonPolicy{validReasonPolicy}},
+		},
+		{
+			name: "invalid reason pattern",
+			policy: &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2, MaximumAttempts: 3, ReasonPolicies: []*types.RetryReasonPolicy{
+				{ReasonPattern: "(", RetryPolicy: validReasonPolicy.RetryPolicy},
+			}},
+			errorMsg: "ReasonPattern \"(\" is not a valid regular expression: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name: "reason policy with expiration",
+			policy: &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2, MaximumAttempts: 3, ReasonPolicies: []*types.RetryReasonPolicy{
+				{ReasonPattern: "a", RetryPolicy: &types.RetryPolicy{BackoffScheduleInSeconds: []int32{1}, ExpirationIntervalInSeconds: 10}},
+			}},
+			errorMsg: "RetryPolicy of retry reason policy cannot set ExpirationIntervalInSeconds, MaximumTotalElapsedTimeInSeconds or ReasonPolicies.",
+		},
+		{
+			name: "invalid reason policy backoff",
+			policy: &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2, MaximumAttempts: 3, ReasonPolicies: []*types.RetryReasonPolicy{
+				{ReasonPattern: "a", RetryPolicy: &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 0.5}},
+			}},
+			errorMsg: "BackoffCoefficient cannot be less than 1 on retry policy.",
+		},
+		{
+			name: "partial reason policy",
+			policy: &types.RetryPolicy{InitialIntervalInSeconds: 1, BackoffCoefficient: 2, MaximumAttempts: 3, ReasonPolicies: []*types.RetryReasonPolicy{
+				{ReasonPattern: "a", RetryPolicy: &types.RetryPolicy{MaximumAttempts: 10}},
+			}},
+		},
+		{
+			name: "reason policy exponential backoff without coefficient",
+			policy: &types.RetryPolicy{BackoffScheduleInSeconds: []int32{1}, MaximumAttempts: 3, ReasonPolicies: []*types.RetryReasonPolicy{
+				{ReasonPattern: "a", RetryPolicy: &types.RetryPolicy{InitialIntervalInSeconds: 1}},
+			}},
+			errorMsg: "BackoffCoefficient cannot be less than 1 on retry policy.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRetryPolicy(tc.policy)
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, &types.BadRequestError{Message: tc.errorMsg}, err)
+			}
+		})
+	}
+}
+
+func TestCompileRetryReasonPattern(t *testing.T) {
+	pattern, err := CompileRetryReasonPattern("^throttled")
+	require.NoError(t, err)
+	require.True(t, pattern.MatchString("throttled by server"))
+
+	cached, err := CompileRetryReasonPattern("^throttled")
+	require.NoError(t, err)
+	require.Same(t, pattern, cached)
+
+	_, err = CompileRetryReasonPattern("(")
+	require.Error(t, err)
+}
+
 func TestConvertIndexedValueTypeToThriftType(t *testing.T) {
 	expected := workflow.IndexedValueType_Values()
 	for i := 0; i < len(expected); i++ {
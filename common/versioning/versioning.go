@@ -0,0 +1,147 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package versioning implements the worker build ID version sets of task lists.
+//
+// The build ID of a worker is its binary checksum. A task list holds a list of version
+// sets, each set is a list of build IDs which are compatible with each other, and the
+// last set is the default set. New workflows are routed to the default set, and the
+// tasks of existing workflows are routed to the set of the build which processed them.
+// Workflows whose build is not in any set and workers whose build is not in any set
+// keep using the unversioned task list. The version sets are persisted with the root
+// decision task list and apply to the decision and activity task lists of that name.
+package versioning
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// SetID returns the ID of a version set, which is its first build ID and does not change when builds are added
+func SetID(set *types.WorkerVersionSet) string {
+	return set.BuildIDs[0]
+}
+
+// TaskVersionSet returns the ID of the version set a task of a workflow with the given build
+// is routed to. Workflows which have no build yet are routed to the default set, and the
+// empty string is returned when the task is routed to the unversioned task list.
+func TaskVersionSet(sets []*types.WorkerVersionSet, buildID string) string {
+	if len(sets) == 0 {
+		return ""
+	}
+	if buildID == "" {
+		return SetID(sets[len(sets)-1])
+	}
+	if i := findSet(sets, buildID); i >= 0 {
+		return SetID(sets[i])
+	}
+	return ""
+}
+
+// PollerVersionSet returns the ID of the version set a worker with the given build polls,
+// the empty string is returned when the worker polls the unversioned task list
+func PollerVersionSet(sets []*types.WorkerVersionSet, buildID string) string {
+	if i := findSet(sets, buildID); i >= 0 {
+		return SetID(sets[i])
+	}
+	return ""
+}
+
+// Update applies the operation of the request to the version sets and returns the new version sets,
+// the version sets passed in are not modified
+func Update(
+	sets []*types.WorkerVersionSet,
+	request *types.UpdateWorkerBuildIDCompatibilityRequest,
+	maxSets int,
+) ([]*types.WorkerVersionSet, error) {
+	operations := 0
+	for _, buildID := range []string{
+		request.GetAddNewBuildIDInNewDefaultSet(),
+		request.GetAddNewCompatibleBuildID(),
+		request.GetPromoteSetByBuildID(),
+	} {
+		if buildID != "" {
+			operations++
+		}
+	}
+	if operations != 1 {
+		return nil, &types.BadRequestError{Message: "Exactly one of AddNewBuildIDInNewDefaultSet, AddNewCompatibleBuildID and PromoteSetByBuildID must be set."}
+	}
+
+	result := make([]*types.WorkerVersionSet, 0, len(sets)+1)
+	for _, set := range sets {
+		result = append(result, &types.WorkerVersionSet{BuildIDs: append([]string(nil), set.BuildIDs...)})
+	}
+
+	switch {
+	case request.GetAddNewBuildIDInNewDefaultSet() != "":
+		buildID := request.GetAddNewBuildIDInNewDefaultSet()
+		if findSet(result, buildID) >= 0 {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Build ID %v already exists.", buildID)}
+		}
+		if len(result) >= maxSets {
+			return nil, &types.LimitExceededError{Message: fmt.Sprintf("Task list already has %v version sets.", len(result))}
+		}
+		result = append(result, &types.WorkerVersionSet{BuildIDs: []string{buildID}})
+	case request.GetAddNewCompatibleBuildID() != "":
+		buildID := request.GetAddNewCompatibleBuildID()
+		if findSet(result, buildID) >= 0 {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Build ID %v already exists.", buildID)}
+		}
+		i := findSet(result, request.GetExistingCompatibleBuildID())
+		if i < 0 {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Compatible build ID %v does not exist.", request.GetExistingCompatibleBuildID())}
+		}
+		result[i].BuildIDs = append(result[i].BuildIDs, buildID)
+		if request.GetMakeSetDefault() {
+			result = promote(result, i)
+		}
+	default:
+		buildID := request.GetPromoteSetByBuildID()
+		i := findSet(result, buildID)
+		if i < 0 {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Build ID %v does not exist.", buildID)}
+		}
+		result = promote(result, i)
+	}
+	return result, nil
+}
+
+// promote moves the set at the index to the end so that it becomes the default set
+func promote(sets []*types.WorkerVersionSet, i int) []*types.WorkerVersionSet {
+	set := sets[i]
+	sets = append(sets[:i], sets[i+1:]...)
+	return append(sets, set)
+}
+
+func findSet(sets []*types.WorkerVersionSet, buildID string) int {
+	if buildID == "" {
+		return -1
+	}
+	for i, set := range sets {
+		for _, id := range set.BuildIDs {
+			if id == buildID {
+				return i
+			}
+		}
+	}
+	return -1
+}
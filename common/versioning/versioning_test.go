@@ -0,0 +1,85 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestRouting(t *testing.T) {
+	sets := []*types.WorkerVersionSet{
+		{BuildIDs: []string{"1.0", "1.1"}},
+		{BuildIDs: []string{"2.0"}},
+	}
+
+	assert.Equal(t, "2.0", TaskVersionSet(sets, ""))
+	assert.Equal(t, "1.0", TaskVersionSet(sets, "1.1"))
+	assert.Equal(t, "2.0", TaskVersionSet(sets, "2.0"))
+	assert.Equal(t, "", TaskVersionSet(sets, "0.9"))
+	assert.Equal(t, "", TaskVersionSet(nil, ""))
+
+	assert.Equal(t, "1.0", PollerVersionSet(sets, "1.1"))
+	assert.Equal(t, "", PollerVersionSet(sets, "0.9"))
+	assert.Equal(t, "", PollerVersionSet(sets, ""))
+}
+
+func TestUpdate(t *testing.T) {
+	sets, err := Update(nil, &types.UpdateWorkerBuildIDCompatibilityRequest{AddNewBuildIDInNewDefaultSet: "1.0"}, 2)
+	require.NoError(t, err)
+	sets, err = Update(sets, &types.UpdateWorkerBuildIDCompatibilityRequest{AddNewBuildIDInNewDefaultSet: "2.0"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.WorkerVersionSet{{BuildIDs: []string{"1.0"}}, {BuildIDs: []string{"2.0"}}}, sets)
+
+	updated, err := Update(sets, &types.UpdateWorkerBuildIDCompatibilityRequest{
+		AddNewCompatibleBuildID:   "1.1",
+		ExistingCompatibleBuildID: "1.0",
+		MakeSetDefault:            true,
+	}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.WorkerVersionSet{{BuildIDs: []string{"2.0"}}, {BuildIDs: []string{"1.0", "1.1"}}}, updated)
+	// the input is not modified
+	assert.Equal(t, []*types.WorkerVersionSet{{BuildIDs: []string{"1.0"}}, {BuildIDs: []string{"2.0"}}}, sets)
+
+	updated, err = Update(updated, &types.UpdateWorkerBuildIDCompatibilityRequest{PromoteSetByBuildID: "2.0"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.WorkerVersionSet{{BuildIDs: []string{"1.0", "1.1"}}, {BuildIDs: []string{"2.0"}}}, updated)
+}
+
+func TestUpdate_Invalid(t *testing.T) {
+	sets := []*types.WorkerVersionSet{{BuildIDs: []string{"1.0"}}}
+	for name, request := range map[string]*types.UpdateWorkerBuildIDCompatibilityRequest{
+		"no operation":         {},
+		"many operations":      {AddNewBuildIDInNewDefaultSet: "2.0", PromoteSetByBuildID: "1.0"},
+		"existing build":       {AddNewBuildIDInNewDefaultSet: "1.0"},
+		"existing compatible":  {AddNewCompatibleBuildID: "1.0", ExistingCompatibleBuildID: "1.0"},
+		"unknown compatible":   {AddNewCompatibleBuildID: "1.1", ExistingCompatibleBuildID: "0.9"},
+		"unknown promoted":     {PromoteSetByBuildID: "0.9"},
+		"too many version set": {AddNewBuildIDInNewDefaultSet: "2.0"},
+	} {
+		_, err := Update(sets, request, 1)
+		assert.Error(t, err, name)
+	}
+}
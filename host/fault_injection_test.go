@@ -0,0 +1,289 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package host
+
+import (
+	"flag"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/faultinjection"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+type FaultInjectionIntegrationSuite struct {
+	// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+	// not merely log an error
+	*require.Assertions
+	IntegrationBase
+
+	domainID string
+}
+
+func TestFaultInjectionIntegrationSuite(t *testing.T) {
+	flag.Parse()
+
+	clusterConfig, err := GetTestClusterConfig("testdata/integration_fault_injection_cluster.yaml")
+	if err != nil {
+		panic(err)
+	}
+	testCluster := NewPersistenceTestCluster(clusterConfig)
+
+	s := new(FaultInjectionIntegrationSuite)
+	params := IntegrationBaseParams{
+		DefaultTestCluster:    testCluster,
+		VisibilityTestCluster: testCluster,
+		TestClusterConfig:     clusterConfig,
+	}
+	s.IntegrationBase = NewIntegrationBase(params)
+	suite.Run(t, s)
+}
+
+func (s *FaultInjectionIntegrationSuite) SetupSuite() {
+	s.setupSuite()
+
+	domainResp, err := s.engine.DescribeDomain(createContext(), &types.DescribeDomainRequest{
+		Name: common.StringPtr(s.domainName),
+	})
+	s.Require().NoError(err)
+	s.domainID = domainResp.DomainInfo.GetUUID()
+}
+
+func (s *FaultInjectionIntegrationSuite) TearDownSuite() {
+	s.tearDownSuite()
+}
+
+func (s *FaultInjectionIntegrationSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *FaultInjectionIntegrationSuite) TearDownTest() {
+	s.NoError(s.testCluster.SetFaultInjection(nil))
+}
+
+func (s *FaultInjectionIntegrationSuite) TestPersistenceFaults() {
+	s.NoError(s.testCluster.SetFaultInjection(&faultinjection.Config{
+		Rules: []*faultinjection.Rule{
+			{
+				Name:      "update-workflow-busy",
+				Layer:     faultinjection.LayerPersistence,
+				Operation: "UpdateWorkflowExecution",
+				DomainID:  s.domainID,
+				Rate:      0.3,
+				Fault:     faultinjection.FaultError,
+				Error:     faultinjection.ErrorServiceBusy,
+			},
+			{
+				Name:      "get-workflow-timeout",
+				Layer:     faultinjection.LayerPersistence,
+				Operation: "GetWorkflowExecution",
+				DomainID:  s.domainID,
+				Rate:      0.2,
+				Fault:     faultinjection.FaultError,
+				Error:     faultinjection.ErrorTimeout,
+			},
+			{
+				Name:      "append-history-latency",
+				Layer:     faultinjection.LayerPersistence,
+				Operation: "AppendHistoryNodes",
+				Fault:     faultinjection.FaultLatency,
+				Latency:   50 * time.Millisecond,
+			},
+		},
+	}))
+
+	s.runWorkflowToCompletion("integration-fault-injection-persistence-test")
+}
+
+func (s *FaultInjectionIntegrationSuite) TestRPCFaults() {
+	s.NoError(s.testCluster.SetFaultInjection(&faultinjection.Config{
+		Rules: []*faultinjection.Rule{
+			{
+				Name:      "add-activity-task-busy",
+				Layer:     faultinjection.LayerMatching,
+				Operation: "AddActivityTask",
+				DomainID:  s.domainID,
+				Rate:      0.5,
+				Fault:     faultinjection.FaultError,
+				Error:     faultinjection.ErrorServiceBusy,
+			},
+			{
+				Name:      "add-decision-task-lost-response",
+				Layer:     faultinjection.LayerMatching,
+				Operation: "AddDecisionTask",
+				DomainID:  s.domainID,
+				Rate:      0.3,
+				Fault:     faultinjection.FaultReportFailure,
+				Error:     faultinjection.ErrorTimeout,
+			},
+			{
+				Name:      "record-activity-started-internal",
+				Layer:     faultinjection.LayerHistory,
+				Operation: "RecordActivityTaskStarted",
+				DomainID:  s.domainID,
+				Rate:      0.3,
+				Fault:     faultinjection.FaultError,
+				Error:     faultinjection.ErrorInternal,
+			},
+			{
+				Name:      "respond-decision-completed-latency",
+				Layer:     faultinjection.LayerHistory,
+				Operation: "RespondDecisionTaskCompleted",
+				Fault:     faultinjection.FaultLatency,
+				Latency:   50 * time.Millisecond,
+			},
+		},
+	}))
+
+	s.runWorkflowToCompletion("integration-fault-injection-rpc-test")
+}
+
+// runWorkflowToCompletion starts a workflow running a few activities one after another and keeps polling,
+// ignoring the errors caused by injected faults, until the workflow completes
+func (s *FaultInjectionIntegrationSuite) runWorkflowToCompletion(id string) {
+	wt := id + "-type"
+	tl := id + "-tasklist"
+	identity := "worker1"
+	activityCount := 3
+
+	taskList := &types.TaskList{Name: tl}
+	request := &types.StartWorkflowExecutionRequest{
+		RequestID:                           uuid.New(),
+		Domain:                              s.domainName,
+		WorkflowID:                          id,
+		WorkflowType:                        &types.WorkflowType{Name: wt},
+		TaskList:                            taskList,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(300),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		Identity:                            identity,
+	}
+
+	// the start request is retried the way clients do, the request ID makes it idempotent
+	var we *types.StartWorkflowExecutionResponse
+	var err error
+	for attempt := 0; attempt < 10; attempt++ {
+		if we, err = s.engine.StartWorkflowExecution(createContext(), request); err == nil {
+			break
+		}
+		s.Logger.Info("StartWorkflowExecution failed", tag.Error(err))
+	}
+	s.NoError(err)
+	execution := &types.WorkflowExecution{WorkflowID: id, RunID: we.GetRunID()}
+
+	// decisions are made from the history of the workflow, so decision tasks retried
+	// after injected faults do not schedule the same activity twice
+	dtHandler := func(execution *types.WorkflowExecution, wt *types.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *types.History) ([]byte, []*types.Decision, error) {
+		scheduled, completed := 0, 0
+		for _, event := range history.Events {
+			switch event.GetEventType() {
+			case types.EventTypeActivityTaskScheduled:
+				scheduled++
+			case types.EventTypeActivityTaskCompleted:
+				completed++
+			}
+		}
+		if completed == activityCount {
+			return nil, []*types.Decision{{
+				DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+				CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{
+					Result: []byte("Done."),
+				},
+			}}, nil
+		}
+		if scheduled > completed {
+			return nil, nil, nil
+		}
+		return nil, []*types.Decision{{
+			DecisionType: types.DecisionTypeScheduleActivityTask.Ptr(),
+			ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{
+				ActivityID:                    strconv.Itoa(scheduled),
+				ActivityType:                  &types.ActivityType{Name: "activity-type"},
+				TaskList:                      taskList,
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(5),
+				HeartbeatTimeoutSeconds:       common.Int32Ptr(0),
+			},
+		}}, nil
+	}
+	atHandler := func(execution *types.WorkflowExecution, activityType *types.ActivityType,
+		activityID string, input []byte, taskToken []byte) ([]byte, bool, error) {
+		return []byte("Activity Result."), false, nil
+	}
+
+	poller := &TaskPoller{
+		Engine:          s.engine,
+		Domain:          s.domainName,
+		TaskList:        taskList,
+		Identity:        identity,
+		DecisionHandler: dtHandler,
+		ActivityHandler: atHandler,
+		Logger:          s.Logger,
+		T:               s.T(),
+	}
+
+	completed := false
+	for i := 0; i < 50 && !completed; i++ {
+		if _, err := poller.PollAndProcessDecisionTaskWithoutRetry(false, false); err != nil {
+			s.Logger.Info("PollAndProcessDecisionTask failed", tag.Error(err))
+		}
+		if err := poller.PollAndProcessActivityTask(false); err != nil {
+			s.Logger.Info("PollAndProcessActivityTask failed", tag.Error(err))
+		}
+		completed = s.isWorkflowCompleted(execution)
+	}
+	s.True(completed)
+
+	// without faults the history reflects a clean run of every activity
+	s.NoError(s.testCluster.SetFaultInjection(nil))
+	history := s.getHistory(s.domainName, execution)
+	lastEvent := history[len(history)-1]
+	s.Equal(types.EventTypeWorkflowExecutionCompleted, lastEvent.GetEventType())
+	activitiesCompleted := 0
+	for _, event := range history {
+		if event.GetEventType() == types.EventTypeActivityTaskCompleted {
+			activitiesCompleted++
+		}
+	}
+	s.Equal(activityCount, activitiesCompleted)
+}
+
+func (s *FaultInjectionIntegrationSuite) isWorkflowCompleted(execution *types.WorkflowExecution) bool {
+	resp, err := s.engine.DescribeWorkflowExecution(createContext(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    s.domainName,
+		Execution: execution,
+	})
+	if err != nil {
+		s.Logger.Info("DescribeWorkflowExecution failed", tag.Error(err))
+		return false
+	}
+	return resp.WorkflowExecutionInfo.CloseStatus != nil &&
+		*resp.WorkflowExecutionInfo.CloseStatus == types.WorkflowExecutionCloseStatusCompleted
+}
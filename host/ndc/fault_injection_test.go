@@ -0,0 +1,156 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ndc
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	test "github.com/uber/cadence/common/testing"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/host"
+)
+
+const (
+	faultInjectionScenarioFile = "../testdata/ndc_fault_injection_scenario.yaml"
+
+	replicateMaxAttempts = 20
+	replicateRetryDelay  = 100 * time.Millisecond
+)
+
+// TestReplicationRaceWithFaults replicates two branches forked from the same base branch at the same time,
+// as if they were received from two remote clusters, while persistence calls of the standby cluster fail,
+// lose their responses or are slowed down. The branch with the higher version has to end up current.
+func (s *NDCIntegrationTestSuite) TestReplicationRaceWithFaults() {
+
+	s.setupRemoteFrontendClients()
+	s.Require().NoError(s.active.SetFaultInjectionFile(faultInjectionScenarioFile))
+	defer func() {
+		s.Require().NoError(s.active.SetFaultInjection(nil))
+	}()
+
+	workflowID := "ndc-replication-race-fault-injection-test" + uuid.New()
+	workflowType := "event-generator-workflow-type"
+	tasklist := "event-generator-taskList"
+	runID := uuid.New()
+	version := int64(101)
+	historyClient := s.active.GetHistoryClient()
+
+	baseGenerator := test.InitializeHistoryEventGenerator(s.domainName, version)
+	baseGenerator.SetVersion(version)
+	baseBranch := s.generateEventBatches(baseGenerator, 10)
+	baseVersionHistory := s.eventBatchesToVersionHistory(nil, baseBranch)
+
+	branchGenerator1 := baseGenerator.DeepCopy()
+	branch1 := s.generateEventBatches(branchGenerator1, 10)
+	branchVersionHistory1 := s.eventBatchesToVersionHistory(baseVersionHistory.Duplicate(), branch1)
+
+	branchGenerator2 := baseGenerator.DeepCopy()
+	branchGenerator2.SetVersion(branchGenerator2.GetVersion() + 1)
+	branch2 := s.generateEventBatches(branchGenerator2, 10)
+	branchVersionHistory2 := s.eventBatchesToVersionHistory(baseVersionHistory.Duplicate(), branch2)
+
+	s.NoError(s.applyEventsWithRetry(workflowID, runID, workflowType, tasklist, baseVersionHistory, baseBranch, historyClient))
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, branch := range []struct {
+		versionHistory *persistence.VersionHistory
+		batches        []*types.History
+	}{
+		{versionHistory: branchVersionHistory1, batches: branch1},
+		{versionHistory: branchVersionHistory2, batches: branch2},
+	} {
+		wg.Add(1)
+		go func(i int, versionHistory *persistence.VersionHistory, batches []*types.History) {
+			defer wg.Done()
+			errs[i] = s.applyEventsWithRetry(workflowID, runID, workflowType, tasklist, versionHistory, batches, historyClient)
+		}(i, branch.versionHistory, branch.batches)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		s.NoError(err)
+	}
+
+	s.Require().NoError(s.active.SetFaultInjection(nil))
+	s.NoError(s.verifyEventHistory(workflowID, runID, append(baseBranch, branch2...)))
+}
+
+func (s *NDCIntegrationTestSuite) generateEventBatches(
+	generator test.Generator,
+	maxBatches int,
+) []*types.History {
+
+	var batches []*types.History
+	for i := 0; i < maxBatches && generator.HasNextVertex(); i++ {
+		historyEvents := &types.History{}
+		for _, event := range generator.GetNextVertices() {
+			historyEvents.Events = append(historyEvents.Events, event.GetData().(*types.HistoryEvent))
+		}
+		batches = append(batches, historyEvents)
+	}
+	return batches
+}
+
+// applyEventsWithRetry replicates the event batches in order, retrying each batch the way the replication
+// task processor does until it is applied, it is safe to call from multiple goroutines
+func (s *NDCIntegrationTestSuite) applyEventsWithRetry(
+	workflowID string,
+	runID string,
+	workflowType string,
+	tasklist string,
+	versionHistory *persistence.VersionHistory,
+	eventBatches []*types.History,
+	historyClient host.HistoryClient,
+) error {
+
+	for _, batch := range eventBatches {
+		eventBlob, newRunEventBlob := s.generateEventBlobs(workflowID, runID, workflowType, tasklist, batch)
+		req := &types.ReplicateEventsV2Request{
+			DomainUUID: s.domainID,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: workflowID,
+				RunID:      runID,
+			},
+			VersionHistoryItems: s.toInternalVersionHistoryItems(versionHistory),
+			Events:              s.toInternalDataBlob(eventBlob),
+			NewRunEvents:        s.toInternalDataBlob(newRunEventBlob),
+		}
+
+		var err error
+		for attempt := 0; attempt < replicateMaxAttempts; attempt++ {
+			if err = historyClient.ReplicateEventsV2(s.createContext(), req); err == nil {
+				break
+			}
+			s.logger.Info("ReplicateEventsV2 failed", tag.Error(err), tag.Attempt(int32(attempt)))
+			time.Sleep(replicateRetryDelay)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to replicate history events after %v attempts: %v", replicateMaxAttempts, err)
+		}
+	}
+	return nil
+}
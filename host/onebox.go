@@ -53,6 +53,7 @@ import (
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/faultinjection"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
@@ -79,6 +80,8 @@ type Cadence interface {
 	FrontendAddress() string
 	GetHistoryClient() historyClient.Client
 	GetExecutionManagerFactory() persistence.ExecutionManagerFactory
+	SetFaultInjection(scenario *faultinjection.Config) error
+	SetFaultInjectionFile(path string) error
 }
 
 type (
@@ -113,6 +116,11 @@ type (
 		workerConfig                  *WorkerConfig
 		mockAdminClient               map[string]adminClient.Client
 		domainReplicationTaskExecutor domain.ReplicationTaskExecutor
+
+		faultInjectionLock     sync.Mutex
+		faultInjectionScenario map[string]interface{}
+		faultInjectionFile     string
+		dynamicClients         []*dynamicClient
 	}
 
 	// HistoryConfig contains configs for history service
@@ -401,7 +409,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	params.DispatcherProvider = c.dispatcherProvider
 	params.MessagingClient = c.messagingClient
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger))
-	params.DynamicConfig = newIntegrationConfigClient(dynamicconfig.NewNopClient())
+	params.DynamicConfig = c.newIntegrationConfigClient()
 	params.ArchivalMetadata = c.archiverMetadata
 	params.ArchiverProvider = c.archiverProvider
 	params.ESConfig = c.esConfig
@@ -413,6 +421,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for frontend", tag.Error(err))
 	}
+	params.PersistenceConfig.FaultInjection = newFaultInjectionRulesProvider(params.DynamicConfig, c.logger)
 
 	if c.esConfig != nil {
 		esDataStoreName := "es-visibility"
@@ -465,7 +474,7 @@ func (c *cadenceImpl) startHistory(
 		params.DispatcherProvider = c.dispatcherProvider
 		params.MessagingClient = c.messagingClient
 		params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger))
-		integrationClient := newIntegrationConfigClient(dynamicconfig.NewNopClient())
+		integrationClient := c.newIntegrationConfigClient()
 		c.overrideHistoryDynamicConfig(integrationClient)
 		params.DynamicConfig = integrationClient
 		dispatcher, err := params.DispatcherProvider.Get(common.FrontendServiceName, c.FrontendAddress())
@@ -482,6 +491,7 @@ func (c *cadenceImpl) startHistory(
 		if err != nil {
 			c.logger.Fatal("Failed to copy persistence config for history", tag.Error(err))
 		}
+		params.PersistenceConfig.FaultInjection = newFaultInjectionRulesProvider(params.DynamicConfig, c.logger)
 
 		if c.esConfig != nil {
 			esDataStoreName := "es-visibility"
@@ -534,7 +544,7 @@ func (c *cadenceImpl) startMatching(hosts map[string][]string, startWG *sync.Wai
 	params.ClusterMetadata = c.clusterMetadata
 	params.DispatcherProvider = c.dispatcherProvider
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger))
-	params.DynamicConfig = newIntegrationConfigClient(dynamicconfig.NewNopClient())
+	params.DynamicConfig = c.newIntegrationConfigClient()
 	params.ArchivalMetadata = c.archiverMetadata
 	params.ArchiverProvider = c.archiverProvider
 
@@ -543,6 +553,7 @@ func (c *cadenceImpl) startMatching(hosts map[string][]string, startWG *sync.Wai
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for matching", tag.Error(err))
 	}
+	params.PersistenceConfig.FaultInjection = newFaultInjectionRulesProvider(params.DynamicConfig, c.logger)
 
 	matchingService, err := matching.NewService(params)
 	if err != nil {
@@ -577,7 +588,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 	params.ClusterMetadata = c.clusterMetadata
 	params.DispatcherProvider = c.dispatcherProvider
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger))
-	params.DynamicConfig = newIntegrationConfigClient(dynamicconfig.NewNopClient())
+	params.DynamicConfig = c.newIntegrationConfigClient()
 	params.ArchivalMetadata = c.archiverMetadata
 	params.ArchiverProvider = c.archiverProvider
 
@@ -586,6 +597,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for worker", tag.Error(err))
 	}
+	params.PersistenceConfig.FaultInjection = newFaultInjectionRulesProvider(params.DynamicConfig, c.logger)
 
 	dispatcher, err := params.DispatcherProvider.Get(common.FrontendServiceName, c.FrontendAddress())
 	if err != nil {
@@ -743,6 +755,72 @@ func (c *cadenceImpl) overrideHistoryDynamicConfig(client *dynamicClient) {
 	}
 }
 
+// SetFaultInjection replaces the fault injection scenario of all services in the cluster, nil disables fault injection
+func (c *cadenceImpl) SetFaultInjection(scenario *faultinjection.Config) error {
+	var value map[string]interface{}
+	if scenario != nil {
+		if err := scenario.Validate(); err != nil {
+			return err
+		}
+		var err error
+		if value, err = scenario.ToMap(); err != nil {
+			return err
+		}
+	}
+
+	c.faultInjectionLock.Lock()
+	defer c.faultInjectionLock.Unlock()
+	c.faultInjectionScenario, c.faultInjectionFile = value, ""
+	for _, client := range c.dynamicClients {
+		overrideFaultInjectionDynamicConfig(client, value, "")
+	}
+	return nil
+}
+
+// SetFaultInjectionFile makes all services in the cluster follow the fault injection scenario of a YAML file,
+// the file is read again by the services when it changes
+func (c *cadenceImpl) SetFaultInjectionFile(path string) error {
+	if _, err := faultinjection.LoadFile(path); err != nil {
+		return err
+	}
+
+	c.faultInjectionLock.Lock()
+	defer c.faultInjectionLock.Unlock()
+	c.faultInjectionScenario, c.faultInjectionFile = nil, path
+	for _, client := range c.dynamicClients {
+		overrideFaultInjectionDynamicConfig(client, nil, path)
+	}
+	return nil
+}
+
+// newIntegrationConfigClient returns a dynamic config client following the fault injection scenario of the cluster
+func (c *cadenceImpl) newIntegrationConfigClient() *dynamicClient {
+	client := newIntegrationConfigClient(dynamicconfig.NewNopClient())
+
+	c.faultInjectionLock.Lock()
+	defer c.faultInjectionLock.Unlock()
+	overrideFaultInjectionDynamicConfig(client, c.faultInjectionScenario, c.faultInjectionFile)
+	c.dynamicClients = append(c.dynamicClients, client)
+	return client
+}
+
+func overrideFaultInjectionDynamicConfig(client *dynamicClient, scenario map[string]interface{}, file string) {
+	// fault injection is always enabled in the cluster so the scenario can be changed after clients are created
+	client.OverrideValue(dynamicconfig.EnableFaultInjection, true)
+	client.OverrideValue(dynamicconfig.FaultInjectionRules, scenario)
+	client.OverrideValue(dynamicconfig.FaultInjectionRulesFile, file)
+}
+
+func newFaultInjectionRulesProvider(client dynamicconfig.Client, logger log.Logger) faultinjection.RulesProvider {
+	dc := dynamicconfig.NewCollection(client, logger)
+	return faultinjection.NewDynamicConfigRulesProvider(
+		dc.GetBoolProperty(dynamicconfig.EnableFaultInjection, false),
+		dc.GetMapProperty(dynamicconfig.FaultInjectionRules, nil),
+		dc.GetStringProperty(dynamicconfig.FaultInjectionRulesFile, ""),
+		logger,
+	)
+}
+
 // copyPersistenceConfig makes a deepcopy of persistence config.
 // This is just a temp fix for the race condition of persistence config.
 // The race condition happens because all the services are using the same datastore map in the config.
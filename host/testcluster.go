@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/faultinjection"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -87,6 +88,12 @@ type (
 		ESConfig              *config.ElasticSearchConfig
 		WorkerConfig          *WorkerConfig
 		MockAdminClient       map[string]adminClient.Client
+		// FaultInjection is the fault injection scenario the cluster starts with,
+		// it can be changed while the cluster is running with SetFaultInjection
+		FaultInjection *faultinjection.Config
+		// FaultInjectionFile is the path of a YAML fault injection scenario the cluster starts with,
+		// it takes precedence over FaultInjection
+		FaultInjectionFile string
 	}
 
 	// MessagingClientConfig is the config for messaging config
@@ -155,6 +162,14 @@ func NewCluster(options *TestClusterConfig, logger log.Logger, params persistenc
 		DomainReplicationTaskExecutor: domain.NewReplicationTaskExecutor(testBase.DomainManager, clock.NewRealTimeSource(), logger),
 	}
 	cluster := NewCadence(cadenceParams)
+	if err := cluster.SetFaultInjection(options.FaultInjection); err != nil {
+		return nil, err
+	}
+	if options.FaultInjectionFile != "" {
+		if err := cluster.SetFaultInjectionFile(options.FaultInjectionFile); err != nil {
+			return nil, err
+		}
+	}
 	if err := cluster.Start(); err != nil {
 		return nil, err
 	}
@@ -298,6 +313,16 @@ func (tc *TestCluster) GetHistoryClient() HistoryClient {
 	return tc.host.GetHistoryClient()
 }
 
+// SetFaultInjection replaces the fault injection scenario of the test cluster, nil disables fault injection
+func (tc *TestCluster) SetFaultInjection(scenario *faultinjection.Config) error {
+	return tc.host.SetFaultInjection(scenario)
+}
+
+// SetFaultInjectionFile makes the test cluster follow the fault injection scenario of a YAML file
+func (tc *TestCluster) SetFaultInjectionFile(path string) error {
+	return tc.host.SetFaultInjectionFile(path)
+}
+
 // GetExecutionManagerFactory returns an execution manager factory from the test cluster
 func (tc *TestCluster) GetExecutionManagerFactory() persistence.ExecutionManagerFactory {
 	return tc.host.GetExecutionManagerFactory()
@@ -692,6 +692,29 @@ func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 	return a.frontendHandler.TerminateWorkflowExecution(ctx, request)
 }
 
+// ExpediteWorkflowExecutionStart API call
+func (a *AccessControlledWorkflowHandler) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.ExpediteWorkflowExecutionStartRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendExpediteWorkflowExecutionStartScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "ExpediteWorkflowExecutionStart",
+		DomainName: request.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.ExpediteWorkflowExecutionStart(ctx, request)
+}
+
 // ListTaskListPartitions API call
 func (a *AccessControlledWorkflowHandler) ListTaskListPartitions(
 	ctx context.Context,
@@ -738,6 +761,29 @@ func (a *AccessControlledWorkflowHandler) GetTaskListsByDomain(
 	return a.frontendHandler.GetTaskListsByDomain(ctx, request)
 }
 
+// GetWorkerBuildIDCompatibility API call
+func (a *AccessControlledWorkflowHandler) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.GetWorkerBuildIDCompatibilityRequest,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetWorkerBuildIDCompatibilityScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "GetWorkerBuildIDCompatibility",
+		DomainName: request.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.GetWorkerBuildIDCompatibility(ctx, request)
+}
+
 // UpdateDomain API call
 func (a *AccessControlledWorkflowHandler) UpdateDomain(
 	ctx context.Context,
@@ -761,6 +807,29 @@ func (a *AccessControlledWorkflowHandler) UpdateDomain(
 	return a.frontendHandler.UpdateDomain(ctx, request)
 }
 
+// UpdateWorkerBuildIDCompatibility API call
+func (a *AccessControlledWorkflowHandler) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.UpdateWorkerBuildIDCompatibilityRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateWorkerBuildIDCompatibilityScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "UpdateWorkerBuildIDCompatibility",
+		DomainName: request.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateWorkerBuildIDCompatibility(ctx, request)
+}
+
 func (a *AccessControlledWorkflowHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
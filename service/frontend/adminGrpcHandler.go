@@ -140,3 +140,83 @@ func (g adminGRPCHandler) GetCrossClusterTasks(ctx context.Context, request *adm
 	response, err := g.h.GetCrossClusterTasks(withGRPCTag(ctx), proto.ToAdminGetCrossClusterTasksRequest(request))
 	return proto.FromAdminGetCrossClusterTasksResponse(response), proto.FromError(err)
 }
+
+func (g adminGRPCHandler) GetDynamicConfig(ctx context.Context, request *adminv1.GetDynamicConfigRequest) (*adminv1.GetDynamicConfigResponse, error) {
+	response, err := g.h.GetDynamicConfig(withGRPCTag(ctx), proto.ToAdminGetDynamicConfigRequest(request))
+	return proto.FromAdminGetDynamicConfigResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) UpdateDynamicConfig(ctx context.Context, request *adminv1.UpdateDynamicConfigRequest) (*adminv1.UpdateDynamicConfigResponse, error) {
+	err := g.h.UpdateDynamicConfig(withGRPCTag(ctx), proto.ToAdminUpdateDynamicConfigRequest(request))
+	return &adminv1.UpdateDynamicConfigResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) RestoreDynamicConfig(ctx context.Context, request *adminv1.RestoreDynamicConfigRequest) (*adminv1.RestoreDynamicConfigResponse, error) {
+	err := g.h.RestoreDynamicConfig(withGRPCTag(ctx), proto.ToAdminRestoreDynamicConfigRequest(request))
+	return &adminv1.RestoreDynamicConfigResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ListDynamicConfig(ctx context.Context, request *adminv1.ListDynamicConfigRequest) (*adminv1.ListDynamicConfigResponse, error) {
+	response, err := g.h.ListDynamicConfig(withGRPCTag(ctx), proto.ToAdminListDynamicConfigRequest(request))
+	return proto.FromAdminListDynamicConfigResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) PauseTaskList(ctx context.Context, request *adminv1.PauseTaskListRequest) (*adminv1.PauseTaskListResponse, error) {
+	err := g.h.PauseTaskList(withGRPCTag(ctx), proto.ToAdminPauseTaskListRequest(request))
+	return &adminv1.PauseTaskListResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ResumeTaskList(ctx context.Context, request *adminv1.ResumeTaskListRequest) (*adminv1.ResumeTaskListResponse, error) {
+	err := g.h.ResumeTaskList(withGRPCTag(ctx), proto.ToAdminResumeTaskListRequest(request))
+	return &adminv1.ResumeTaskListResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) MigrateTaskList(ctx context.Context, request *adminv1.MigrateTaskListRequest) (*adminv1.MigrateTaskListResponse, error) {
+	err := g.h.MigrateTaskList(withGRPCTag(ctx), proto.ToAdminMigrateTaskListRequest(request))
+	return &adminv1.MigrateTaskListResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ReadTaskListDLQ(ctx context.Context, request *adminv1.ReadTaskListDLQRequest) (*adminv1.ReadTaskListDLQResponse, error) {
+	response, err := g.h.ReadTaskListDLQ(withGRPCTag(ctx), proto.ToAdminReadTaskListDLQRequest(request))
+	return proto.FromAdminReadTaskListDLQResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) DescribeTaskListDLQEntry(ctx context.Context, request *adminv1.DescribeTaskListDLQEntryRequest) (*adminv1.DescribeTaskListDLQEntryResponse, error) {
+	response, err := g.h.DescribeTaskListDLQEntry(withGRPCTag(ctx), proto.ToAdminDescribeTaskListDLQEntryRequest(request))
+	return proto.FromAdminDescribeTaskListDLQEntryResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) RedriveTaskListDLQEntry(ctx context.Context, request *adminv1.RedriveTaskListDLQEntryRequest) (*adminv1.RedriveTaskListDLQEntryResponse, error) {
+	err := g.h.RedriveTaskListDLQEntry(withGRPCTag(ctx), proto.ToAdminRedriveTaskListDLQEntryRequest(request))
+	return &adminv1.RedriveTaskListDLQEntryResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) FailTaskListDLQEntry(ctx context.Context, request *adminv1.FailTaskListDLQEntryRequest) (*adminv1.FailTaskListDLQEntryResponse, error) {
+	err := g.h.FailTaskListDLQEntry(withGRPCTag(ctx), proto.ToAdminFailTaskListDLQEntryRequest(request))
+	return &adminv1.FailTaskListDLQEntryResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) UnpinShard(ctx context.Context, request *adminv1.UnpinShardRequest) (*adminv1.UnpinShardResponse, error) {
+	err := g.h.UnpinShard(withGRPCTag(ctx), proto.ToAdminUnpinShardRequest(request))
+	return &adminv1.UnpinShardResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ListQuarantinedWorkflows(ctx context.Context, request *adminv1.ListQuarantinedWorkflowsRequest) (*adminv1.ListQuarantinedWorkflowsResponse, error) {
+	response, err := g.h.ListQuarantinedWorkflows(withGRPCTag(ctx), proto.ToAdminListQuarantinedWorkflowsRequest(request))
+	return proto.FromAdminListQuarantinedWorkflowsResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ReleaseQuarantinedWorkflow(ctx context.Context, request *adminv1.ReleaseQuarantinedWorkflowRequest) (*adminv1.ReleaseQuarantinedWorkflowResponse, error) {
+	err := g.h.ReleaseQuarantinedWorkflow(withGRPCTag(ctx), proto.ToAdminReleaseQuarantinedWorkflowRequest(request))
+	return &adminv1.ReleaseQuarantinedWorkflowResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) CheckWorkflowBuild(ctx context.Context, request *adminv1.CheckWorkflowBuildRequest) (*adminv1.CheckWorkflowBuildResponse, error) {
+	response, err := g.h.CheckWorkflowBuild(withGRPCTag(ctx), proto.ToAdminCheckWorkflowBuildRequest(request))
+	return proto.FromAdminCheckWorkflowBuildResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) MoveShard(ctx context.Context, request *adminv1.MoveShardRequest) (*adminv1.MoveShardResponse, error) {
+	err := g.h.MoveShard(withGRPCTag(ctx), proto.ToAdminMoveShardRequest(request))
+	return &adminv1.MoveShardResponse{}, proto.FromError(err)
+}
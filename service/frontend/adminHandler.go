@@ -27,10 +27,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
@@ -38,25 +41,37 @@ import (
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quarantine"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/shardplacement"
+	"github.com/uber/cadence/common/tasklistdlq"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/shadower"
 )
 
 var _ AdminHandler = (*adminHandlerImpl)(nil)
 
 const (
 	endMessageID int64 = 1<<63 - 1
+
+	dynamicConfigHistoryPageSize = 1000
+
+	unknownCallerIdentity = "unknown"
 )
 
 var (
-	errMaxMessageIDNotSet = &types.BadRequestError{Message: "Max messageID is not set."}
+	errMaxMessageIDNotSet           = &types.BadRequestError{Message: "Max messageID is not set."}
+	errConfigNameNotSet             = &types.BadRequestError{Message: "Dynamic config name is not set."}
+	errDynamicConfigStoreNotEnabled = &types.BadRequestError{Message: "Dynamic config store is not enabled."}
+	errDynamicConfigNotFound        = &types.EntityNotExistsError{Message: "Dynamic config value is not set in the dynamic config store."}
 )
 
 type (
@@ -84,6 +99,22 @@ type (
 		ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
 		ResetQueue(context.Context, *types.ResetQueueRequest) error
 		GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
+		GetDynamicConfig(context.Context, *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error)
+		UpdateDynamicConfig(context.Context, *types.UpdateDynamicConfigRequest) error
+		RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest) error
+		ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
+		PauseTaskList(context.Context, *types.PauseTaskListRequest) error
+		ResumeTaskList(context.Context, *types.ResumeTaskListRequest) error
+		MigrateTaskList(context.Context, *types.MigrateTaskListRequest) error
+		ReadTaskListDLQ(context.Context, *types.ReadTaskListDLQRequest) (*types.ReadTaskListDLQResponse, error)
+		DescribeTaskListDLQEntry(context.Context, *types.DescribeTaskListDLQEntryRequest) (*types.DescribeTaskListDLQEntryResponse, error)
+		RedriveTaskListDLQEntry(context.Context, *types.RedriveTaskListDLQEntryRequest) error
+		FailTaskListDLQEntry(context.Context, *types.FailTaskListDLQEntryRequest) error
+		MoveShard(context.Context, *types.MoveShardRequest) error
+		UnpinShard(context.Context, *types.UnpinShardRequest) error
+		ListQuarantinedWorkflows(context.Context, *types.ListQuarantinedWorkflowsRequest) (*types.ListQuarantinedWorkflowsResponse, error)
+		ReleaseQuarantinedWorkflow(context.Context, *types.ReleaseQuarantinedWorkflowRequest) error
+		CheckWorkflowBuild(context.Context, *types.CheckWorkflowBuildRequest) (*types.CheckWorkflowBuildResponse, error)
 	}
 
 	// adminHandlerImpl is an implementation for admin service independent of wire protocol
@@ -97,6 +128,10 @@ type (
 		domainFailoverWatcher domain.FailoverWatcher
 		eventSerializder      persistence.PayloadSerializer
 		esClient              elasticsearch.GenericClient
+		taskListDLQ           tasklistdlq.Queue
+		shardPlacement        shardplacement.Store
+		workflowQuarantine    quarantine.Store
+		shadowerClient        shadower.Client
 	}
 
 	getWorkflowRawHistoryV2Token struct {
@@ -150,6 +185,26 @@ func NewAdminHandler(
 		),
 		eventSerializder: persistence.NewPayloadSerializer(),
 		esClient:         params.ESClient,
+		taskListDLQ: tasklistdlq.NewQueue(
+			resource.GetPersistenceBean().GetMatchingDLQQueueManager(),
+			resource.GetMetricsClient(),
+			resource.GetLogger(),
+		),
+		shardPlacement: shardplacement.NewStore(
+			resource.GetPersistenceBean().GetShardPlacementQueueManager(),
+			resource.GetTimeSource(),
+		),
+		workflowQuarantine: quarantine.NewStore(
+			resource.GetPersistenceBean().GetWorkflowQuarantineQueueManager(),
+			resource.GetTimeSource(),
+		),
+		shadowerClient: shadower.NewClient(
+			resource.GetSDKClient(),
+			shadower.NewStore(
+				resource.GetPersistenceBean().GetShadowerCheckQueueManager(),
+				resource.GetTimeSource(),
+			),
+		),
 	}
 }
 
@@ -258,10 +313,9 @@ func (adh *adminHandlerImpl) DescribeWorkflowExecution(
 	}
 
 	shardID := common.WorkflowIDToHistoryShard(request.Execution.WorkflowID, adh.numberOfHistoryShards)
-	shardIDstr := string(rune(shardID)) // originally `string(int_shard_id)`, but changing it will change the ring hashing
 	shardIDForOutput := strconv.Itoa(shardID)
 
-	historyHost, err := adh.GetMembershipMonitor().Lookup(common.HistoryServiceName, shardIDstr)
+	historyHost, err := adh.GetMembershipMonitor().Lookup(common.HistoryServiceName, shardplacement.ShardKey(shardID))
 	if err != nil {
 		return nil, adh.error(err, scope)
 	}
@@ -372,28 +426,254 @@ func (adh *adminHandlerImpl) DescribeShardDistribution(
 ) (resp *types.DescribeShardDistributionResponse, retError error) {
 
 	defer log.CapturePanic(adh.GetLogger(), &retError)
-	_, sw := adh.startRequestProfile(metrics.AdminDescribeShardDistributionScope)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeShardDistributionScope)
 	defer sw.Stop()
 
 	numShards := adh.config.NumHistoryShards
 	resp = &types.DescribeShardDistributionResponse{
 		NumberOfShards: int32(numShards),
 		Shards:         make(map[int32]string),
+		PlacementMode:  adh.config.HistoryShardPlacementMode(),
+	}
+
+	var placement *shardplacement.Placement
+	if resp.PlacementMode == shardplacement.ModeLoad {
+		var err error
+		placement, err = adh.shardPlacement.GetPlacement(ctx)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		resp.Placements = make(map[int32]*types.ShardPlacementInfo)
 	}
 
 	offset := int(request.PageID * request.PageSize)
 	nextPageStart := offset + int(request.PageSize)
 	for shardID := offset; shardID < numShards && shardID < nextPageStart; shardID++ {
-		info, err := adh.GetHistoryServiceResolver().Lookup(string(rune(shardID)))
+		info, err := adh.GetHistoryServiceResolver().Lookup(shardplacement.ShardKey(shardID))
 		if err != nil {
 			resp.Shards[int32(shardID)] = "unknown"
 		} else {
 			resp.Shards[int32(shardID)] = info.Identity()
 		}
+		if placement != nil {
+			resp.Placements[int32(shardID)] = toShardPlacementInfo(placement, shardID)
+		}
+	}
+	return resp, nil
+}
+
+// MoveShard assigns a history shard to a history host, pinned shards are not moved by the shard balancer
+func (adh *adminHandlerImpl) MoveShard(
+	ctx context.Context,
+	request *types.MoveShardRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminMoveShardScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.GetShardID()); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetHost() == "" {
+		return adh.error(&types.BadRequestError{Message: "Host is not set on request."}, scope)
+	}
+	isMember := false
+	for _, member := range adh.GetHistoryServiceResolver().Members() {
+		if member.Identity() == request.GetHost() {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return adh.error(&types.BadRequestError{Message: fmt.Sprintf("Host %v is not a history host.", request.GetHost())}, scope)
+	}
+
+	if err := adh.shardPlacement.Assign(ctx, []*shardplacement.Assignment{{
+		ShardID: int(request.GetShardID()),
+		Host:    request.GetHost(),
+		Pinned:  request.GetPin(),
+	}}, callerIdentity(ctx), request.GetReason()); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UnpinShard removes the assignment of a history shard, so that the shard balancer can move it again
+func (adh *adminHandlerImpl) UnpinShard(
+	ctx context.Context,
+	request *types.UnpinShardRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUnpinShardScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.GetShardID()); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.shardPlacement.Assign(ctx, []*shardplacement.Assignment{{
+		ShardID: int(request.GetShardID()),
+	}}, callerIdentity(ctx), request.GetReason()); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ListQuarantinedWorkflows lists the workflow executions quarantined after a mutable state checksum mismatch
+func (adh *adminHandlerImpl) ListQuarantinedWorkflows(
+	ctx context.Context,
+	request *types.ListQuarantinedWorkflowsRequest,
+) (resp *types.ListQuarantinedWorkflowsResponse, err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListQuarantinedWorkflowsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	domainID := ""
+	if request.GetDomain() != "" {
+		if domainID, err = adh.GetDomainCache().GetDomainID(request.GetDomain()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	entries, err := adh.workflowQuarantine.GetEntries(ctx)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp = &types.ListQuarantinedWorkflowsResponse{}
+	for _, entry := range entries {
+		if !entry.IsQuarantined() || (domainID != "" && entry.DomainID != domainID) {
+			continue
+		}
+		domainName, err := adh.GetDomainCache().GetDomainName(entry.DomainID)
+		if err != nil {
+			// keep listing the workflow executions of deleted domains
+			domainName = entry.DomainID
+		}
+		resp.Workflows = append(resp.Workflows, &types.QuarantinedWorkflow{
+			Domain:               domainName,
+			WorkflowID:           entry.WorkflowID,
+			RunID:                entry.RunID,
+			ShardID:              int32(entry.ShardID),
+			Details:              entry.Details,
+			QuarantinedTimestamp: common.Int64Ptr(entry.QuarantinedAt.UnixNano()),
+		})
 	}
+	sort.Slice(resp.Workflows, func(i, j int) bool {
+		return resp.Workflows[i].GetQuarantinedTimestamp() < resp.Workflows[j].GetQuarantinedTimestamp()
+	})
 	return resp, nil
 }
 
+// ReleaseQuarantinedWorkflow releases a quarantined workflow execution, either accepting its persisted
+// mutable state or rebuilding the mutable state from history, and regenerates its tasks
+func (adh *adminHandlerImpl) ReleaseQuarantinedWorkflow(
+	ctx context.Context,
+	request *types.ReleaseQuarantinedWorkflowRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminReleaseQuarantinedWorkflowScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if request.GetWorkflowID() == "" {
+		return adh.error(errWorkflowIDNotSet, scope)
+	}
+	if uuid.Parse(request.GetRunID()) == nil {
+		return adh.error(errInvalidRunID, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.workflowQuarantine.Release(ctx, quarantine.Execution{
+		DomainID:   domainID,
+		WorkflowID: request.GetWorkflowID(),
+		RunID:      request.GetRunID(),
+	}, request.GetRepair(), callerIdentity(ctx), request.GetReason()); err != nil {
+		return adh.error(err, scope)
+	}
+
+	// the tasks of the workflow execution were dropped while it was quarantined
+	if err := adh.GetHistoryClient().RefreshWorkflowTasks(ctx, &types.HistoryRefreshWorkflowTasksRequest{
+		DomainUIID: domainID,
+		Request: &types.RefreshWorkflowTasksRequest{
+			Domain: request.GetDomain(),
+			Execution: &types.WorkflowExecution{
+				WorkflowID: request.GetWorkflowID(),
+				RunID:      request.GetRunID(),
+			},
+		},
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// CheckWorkflowBuild checks the compatibility of a workflow build with the histories of open workflows,
+// the check is started on the first call and its state is returned until a new build needs to be checked
+func (adh *adminHandlerImpl) CheckWorkflowBuild(
+	ctx context.Context,
+	request *types.CheckWorkflowBuildRequest,
+) (resp *types.CheckWorkflowBuildResponse, err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminCheckWorkflowBuildScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+
+	resp, err = adh.shadowerClient.CheckBuild(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) validateShardID(shardID int32) error {
+	if shardID < 0 || int(shardID) >= adh.numberOfHistoryShards {
+		return &types.BadRequestError{Message: fmt.Sprintf("Shard ID must be between 0 and %v.", adh.numberOfHistoryShards-1)}
+	}
+	return nil
+}
+
+func toShardPlacementInfo(placement *shardplacement.Placement, shardID int) *types.ShardPlacementInfo {
+	info := &types.ShardPlacementInfo{}
+	if assignment, ok := placement.Assignments[shardID]; ok {
+		info.AssignedHost = assignment.Host
+		info.Pinned = assignment.Pinned
+	}
+	if load, ok := placement.Loads[shardID]; ok {
+		info.RPS = load.RPS
+		info.QueueBacklog = load.QueueBacklog
+		info.CacheSize = load.CacheSize
+	}
+	return info
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *adminHandlerImpl) DescribeHistoryHost(
 	ctx context.Context,
@@ -1028,6 +1308,508 @@ func (adh *adminHandlerImpl) GetCrossClusterTasks(
 	return resp, nil
 }
 
+// GetDynamicConfig returns the value set in the dynamic config store which applies to the filters
+func (adh *adminHandlerImpl) GetDynamicConfig(
+	ctx context.Context,
+	request *types.GetDynamicConfigRequest,
+) (resp *types.GetDynamicConfigResponse, err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.ConfigName == "" {
+		return nil, adh.error(errConfigNameNotSet, scope)
+	}
+	store := adh.params.DynamicConfigStore
+	if store == nil {
+		return nil, adh.error(errDynamicConfigStoreNotEnabled, scope)
+	}
+
+	constraints, err := configstore.FromInternalFilters(request.Filters)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	filters := make(map[dynamicconfig.Filter]interface{}, len(constraints))
+	for name, constraint := range constraints {
+		filter, ok := dynamicconfig.ParseFilterName(name)
+		if !ok {
+			return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf("Unknown dynamic config filter: %v.", name)}, scope)
+		}
+		filters[filter] = constraint
+	}
+
+	values, _, err := store.GetValues(ctx)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	value, ok := values.Match(request.ConfigName, filters, adh.GetTimeSource().Now())
+	if !ok {
+		return nil, adh.error(errDynamicConfigNotFound, scope)
+	}
+	blob, err := configstore.ToInternalValue(value)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.GetDynamicConfigResponse{Value: blob}, nil
+}
+
+// UpdateDynamicConfig sets the value of a dynamic config for the filters in the dynamic config store
+func (adh *adminHandlerImpl) UpdateDynamicConfig(
+	ctx context.Context,
+	request *types.UpdateDynamicConfigRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	value, err := configstore.FromInternalValue(request.Value)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return adh.updateDynamicConfig(ctx, scope, &configstore.Change{
+		Key:      request.ConfigName,
+		Value:    value,
+		Author:   callerIdentity(ctx),
+		Reason:   request.Reason,
+		Schedule: configstore.FromInternalSchedule(request.ActiveFromNano, request.ExpiresAtNano),
+	}, request.Filters)
+}
+
+// RestoreDynamicConfig deletes the value of a dynamic config for the filters from the dynamic config store
+func (adh *adminHandlerImpl) RestoreDynamicConfig(
+	ctx context.Context,
+	request *types.RestoreDynamicConfigRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminRestoreDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	return adh.updateDynamicConfig(ctx, scope, &configstore.Change{
+		Key:     request.ConfigName,
+		Deleted: true,
+		Author:  callerIdentity(ctx),
+		Reason:  request.Reason,
+	}, request.Filters)
+}
+
+// ListDynamicConfig returns the values set in the dynamic config store and optionally the changes made to them
+func (adh *adminHandlerImpl) ListDynamicConfig(
+	ctx context.Context,
+	request *types.ListDynamicConfigRequest,
+) (resp *types.ListDynamicConfigResponse, err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	store := adh.params.DynamicConfigStore
+	if store == nil {
+		return nil, adh.error(errDynamicConfigStoreNotEnabled, scope)
+	}
+
+	values, _, err := store.GetValues(ctx)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	entries, err := configstore.ToInternalEntries(values, request.ConfigName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp = &types.ListDynamicConfigResponse{Entries: entries}
+	if !request.IncludeHistory {
+		return resp, nil
+	}
+
+	lastChangeID := configstore.EmptyChangeID
+	for {
+		changes, err := store.GetChanges(ctx, lastChangeID, dynamicConfigHistoryPageSize)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		for _, change := range changes {
+			lastChangeID = change.ID
+			if request.ConfigName != "" && change.Key != request.ConfigName {
+				continue
+			}
+			internalChange, err := configstore.ToInternalChange(change)
+			if err != nil {
+				return nil, adh.error(err, scope)
+			}
+			resp.History = append(resp.History, internalChange)
+		}
+		if len(changes) < dynamicConfigHistoryPageSize {
+			return resp, nil
+		}
+	}
+}
+
+func (adh *adminHandlerImpl) updateDynamicConfig(
+	ctx context.Context,
+	scope metrics.Scope,
+	change *configstore.Change,
+	filters []*types.DynamicConfigFilter,
+) error {
+
+	if change.Key == "" {
+		return adh.error(errConfigNameNotSet, scope)
+	}
+	store := adh.params.DynamicConfigStore
+	if store == nil {
+		return adh.error(errDynamicConfigStoreNotEnabled, scope)
+	}
+
+	constraints, err := configstore.FromInternalFilters(filters)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	change.Constraints = constraints
+	if err := store.Update(ctx, change); err != nil {
+		return adh.error(err, scope)
+	}
+	adh.GetLogger().Info("Dynamic config updated",
+		tag.Key(change.Key),
+		tag.DynamicConfigFilters(change.Constraints),
+		tag.Value(change.Value),
+		tag.DynamicConfigAuthor(change.Author),
+	)
+	return nil
+}
+
+// callerIdentity returns the caller of the request as established by the transport, changes are
+// attributed to it rather than to an identity the request claims
+func callerIdentity(ctx context.Context) string {
+	if caller := yarpc.CallFromContext(ctx).Caller(); caller != "" {
+		return caller
+	}
+	return unknownCallerIdentity
+}
+
+// PauseTaskList stops dispatching the tasks of a task list to pollers, the tasks stay in the backlog
+// and the pollers receive empty responses until the task list is resumed
+func (adh *adminHandlerImpl) PauseTaskList(
+	ctx context.Context,
+	request *types.PauseTaskListRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminPauseTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	return adh.updateTaskListState(ctx, scope, request.GetDomain(), request.GetTaskList(), request.TaskListType, &types.MatchingUpdateTaskListStateRequest{
+		Paused: common.BoolPtr(true),
+	}, request.GetReason())
+}
+
+// ResumeTaskList resumes dispatching the tasks of a paused task list
+func (adh *adminHandlerImpl) ResumeTaskList(
+	ctx context.Context,
+	request *types.ResumeTaskListRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminResumeTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	return adh.updateTaskListState(ctx, scope, request.GetDomain(), request.GetTaskList(), request.TaskListType, &types.MatchingUpdateTaskListStateRequest{
+		Paused: common.BoolPtr(false),
+	}, request.GetReason())
+}
+
+// MigrateTaskList moves the backlog and the new tasks of a task list to the destination task list,
+// an empty destination stops the migration
+func (adh *adminHandlerImpl) MigrateTaskList(
+	ctx context.Context,
+	request *types.MigrateTaskListRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminMigrateTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDestinationTaskList() != "" && request.GetDestinationTaskList() == request.GetTaskList().GetName() {
+		return adh.error(&types.BadRequestError{Message: "Destination task list must be different from the task list."}, scope)
+	}
+	return adh.updateTaskListState(ctx, scope, request.GetDomain(), request.GetTaskList(), request.TaskListType, &types.MatchingUpdateTaskListStateRequest{
+		MigrateTo: common.StringPtr(request.GetDestinationTaskList()),
+	}, request.GetReason())
+}
+
+// updateTaskListState sends a change of the pause or migration state of a task list to matching, which stores
+// it with the root partition so the state survives task list ownership changes
+func (adh *adminHandlerImpl) updateTaskListState(
+	ctx context.Context,
+	scope metrics.Scope,
+	domain string,
+	taskList *types.TaskList,
+	taskListType *types.TaskListType,
+	request *types.MatchingUpdateTaskListStateRequest,
+	reason string,
+) error {
+
+	if domain == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if taskList.GetName() == "" {
+		return adh.error(errTaskListNotSet, scope)
+	}
+	if taskListType == nil {
+		return adh.error(errTaskListTypeNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(domain)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	request.DomainUUID = domainID
+	request.TaskList = taskList
+	request.TaskListType = taskListType
+	if err := adh.GetMatchingClient().UpdateTaskListState(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	adh.GetLogger().Info("Task list state updated",
+		tag.WorkflowDomainName(domain),
+		tag.WorkflowTaskListName(taskList.GetName()),
+		tag.WorkflowTaskListType(int(*taskListType)),
+		tag.Value(request),
+		tag.Caller(callerIdentity(ctx)),
+		tag.Reason(reason),
+	)
+	return nil
+}
+
+// ReadTaskListDLQ lists the tasks of a domain which matching moved to the task list DLQ
+func (adh *adminHandlerImpl) ReadTaskListDLQ(
+	ctx context.Context,
+	request *types.ReadTaskListDLQRequest,
+) (resp *types.ReadTaskListDLQResponse, err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminReadTaskListDLQScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		pageSize = common.ReadDLQMessagesPageSize
+	}
+
+	// the DLQ is shared by all the domains, a page may contain fewer entries than requested
+	messages, token, err := adh.taskListDLQ.Read(ctx, pageSize, request.GetNextPageToken())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	entries := make([]*types.TaskListDLQEntry, 0, len(messages))
+	for _, message := range messages {
+		if message.DomainID != domainID {
+			continue
+		}
+		if request.GetTaskList() != "" && message.TaskList != request.GetTaskList() {
+			continue
+		}
+		if request.TaskListType != nil && message.TaskType != int(request.GetTaskListType()) {
+			continue
+		}
+		entries = append(entries, toTaskListDLQEntry(request.GetDomain(), message))
+	}
+	return &types.ReadTaskListDLQResponse{
+		Entries:       entries,
+		NextPageToken: token,
+	}, nil
+}
+
+// DescribeTaskListDLQEntry returns a single entry of the task list DLQ
+func (adh *adminHandlerImpl) DescribeTaskListDLQEntry(
+	ctx context.Context,
+	request *types.DescribeTaskListDLQEntryRequest,
+) (resp *types.DescribeTaskListDLQEntryResponse, err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeTaskListDLQEntryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	message, err := adh.getTaskListDLQMessage(ctx, request.GetDomain(), request.GetMessageID())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.DescribeTaskListDLQEntryResponse{
+		Entry: toTaskListDLQEntry(request.GetDomain(), message),
+	}, nil
+}
+
+// RedriveTaskListDLQEntry adds the task of a task list DLQ entry back to its task list and removes the entry
+func (adh *adminHandlerImpl) RedriveTaskListDLQEntry(
+	ctx context.Context,
+	request *types.RedriveTaskListDLQEntryRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminRedriveTaskListDLQEntryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	message, err := adh.getTaskListDLQMessage(ctx, request.GetDomain(), request.GetMessageID())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	task := message.Task
+	scheduleToStartTimeout := task.ScheduleToStartTimeout
+	if !task.Expiry.IsZero() && task.Expiry.Unix() > 0 {
+		remaining := int32(math.Ceil(time.Until(task.Expiry).Seconds()))
+		if remaining <= 0 {
+			return adh.error(&types.BadRequestError{Message: "Task list DLQ entry has expired, it can only be failed."}, scope)
+		}
+		scheduleToStartTimeout = remaining
+	}
+	execution := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
+	taskList := &types.TaskList{Name: message.TaskList, Kind: types.TaskListKindNormal.Ptr()}
+	source := types.TaskSourceDbBacklog
+	switch message.TaskType {
+	case persistence.TaskListTypeDecision:
+		err = adh.GetMatchingClient().AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+			DomainUUID:                    task.DomainID,
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleID:                    task.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &source,
+			Priority:                      task.Priority,
+			FairnessKey:                   task.FairnessKey,
+			BuildID:                       task.BuildID,
+			Attempt:                       task.Attempt,
+		})
+	case persistence.TaskListTypeActivity:
+		err = adh.GetMatchingClient().AddActivityTask(ctx, &types.AddActivityTaskRequest{
+			DomainUUID:                    message.DomainID,
+			SourceDomainUUID:              task.DomainID,
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleID:                    task.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &source,
+			Priority:                      task.Priority,
+			FairnessKey:                   task.FairnessKey,
+			BuildID:                       task.BuildID,
+			Attempt:                       task.Attempt,
+		})
+	default:
+		err = &types.BadRequestError{Message: "Unknown task list type of task list DLQ entry."}
+	}
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if err := adh.taskListDLQ.Delete(ctx, message.ID); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// FailTaskListDLQEntry removes an activity task from the task list DLQ without redriving it,
+// history then times out the activity on its schedule to start timeout. Decision tasks can only be
+// redriven since the workflow would not make progress without them.
+func (adh *adminHandlerImpl) FailTaskListDLQEntry(
+	ctx context.Context,
+	request *types.FailTaskListDLQEntryRequest,
+) (err error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminFailTaskListDLQEntryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	message, err := adh.getTaskListDLQMessage(ctx, request.GetDomain(), request.GetMessageID())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if message.TaskType != persistence.TaskListTypeActivity {
+		return adh.error(&types.BadRequestError{Message: "Decision tasks in the task list DLQ can only be redriven."}, scope)
+	}
+	if err := adh.taskListDLQ.Delete(ctx, message.ID); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// getTaskListDLQMessage returns the task list DLQ message with the given ID, the message must belong to the domain
+func (adh *adminHandlerImpl) getTaskListDLQMessage(
+	ctx context.Context,
+	domain string,
+	messageID int64,
+) (*tasklistdlq.Message, error) {
+
+	if domain == "" {
+		return nil, errDomainNotSet
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(domain)
+	if err != nil {
+		return nil, err
+	}
+	message, err := adh.taskListDLQ.Get(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if message.DomainID != domainID {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("Task list DLQ message %v does not exist.", messageID)}
+	}
+	return message, nil
+}
+
+func toTaskListDLQEntry(domain string, message *tasklistdlq.Message) *types.TaskListDLQEntry {
+	return &types.TaskListDLQEntry{
+		MessageID:    message.ID,
+		Domain:       domain,
+		TaskList:     message.TaskList,
+		TaskListType: types.TaskListType(message.TaskType).Ptr(),
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: message.Task.WorkflowID,
+			RunID:      message.Task.RunID,
+		},
+		ScheduleID:        message.Task.ScheduleID,
+		Attempts:          int32(message.Attempts),
+		EnqueuedTimestamp: common.Int64Ptr(message.EnqueuedTime.UnixNano()),
+	}
+}
+
 func (adh *adminHandlerImpl) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *types.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -373,3 +373,233 @@ func (mr *MockAdminHandlerMockRecorder) GetCrossClusterTasks(arg0, arg1 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrossClusterTasks", reflect.TypeOf((*MockAdminHandler)(nil).GetCrossClusterTasks), arg0, arg1)
 }
+
+// GetDynamicConfig mocks base method
+func (m *MockAdminHandler) GetDynamicConfig(arg0 context.Context, arg1 *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig
+func (mr *MockAdminHandlerMockRecorder) GetDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).GetDynamicConfig), arg0, arg1)
+}
+
+// UpdateDynamicConfig mocks base method
+func (m *MockAdminHandler) UpdateDynamicConfig(arg0 context.Context, arg1 *types.UpdateDynamicConfigRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig
+func (mr *MockAdminHandlerMockRecorder) UpdateDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).UpdateDynamicConfig), arg0, arg1)
+}
+
+// RestoreDynamicConfig mocks base method
+func (m *MockAdminHandler) RestoreDynamicConfig(arg0 context.Context, arg1 *types.RestoreDynamicConfigRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDynamicConfig indicates an expected call of RestoreDynamicConfig
+func (mr *MockAdminHandlerMockRecorder) RestoreDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).RestoreDynamicConfig), arg0, arg1)
+}
+
+// ListDynamicConfig mocks base method
+func (m *MockAdminHandler) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig
+func (mr *MockAdminHandlerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).ListDynamicConfig), arg0, arg1)
+}
+
+// PauseTaskList mocks base method
+func (m *MockAdminHandler) PauseTaskList(arg0 context.Context, arg1 *types.PauseTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseTaskList indicates an expected call of PauseTaskList
+func (mr *MockAdminHandlerMockRecorder) PauseTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskList", reflect.TypeOf((*MockAdminHandler)(nil).PauseTaskList), arg0, arg1)
+}
+
+// ResumeTaskList mocks base method
+func (m *MockAdminHandler) ResumeTaskList(arg0 context.Context, arg1 *types.ResumeTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeTaskList indicates an expected call of ResumeTaskList
+func (mr *MockAdminHandlerMockRecorder) ResumeTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskList", reflect.TypeOf((*MockAdminHandler)(nil).ResumeTaskList), arg0, arg1)
+}
+
+// MigrateTaskList mocks base method
+func (m *MockAdminHandler) MigrateTaskList(arg0 context.Context, arg1 *types.MigrateTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTaskList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList
+func (mr *MockAdminHandlerMockRecorder) MigrateTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockAdminHandler)(nil).MigrateTaskList), arg0, arg1)
+}
+
+// ReadTaskListDLQ mocks base method
+func (m *MockAdminHandler) ReadTaskListDLQ(arg0 context.Context, arg1 *types.ReadTaskListDLQRequest) (*types.ReadTaskListDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTaskListDLQ", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReadTaskListDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTaskListDLQ indicates an expected call of ReadTaskListDLQ
+func (mr *MockAdminHandlerMockRecorder) ReadTaskListDLQ(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTaskListDLQ", reflect.TypeOf((*MockAdminHandler)(nil).ReadTaskListDLQ), arg0, arg1)
+}
+
+// DescribeTaskListDLQEntry mocks base method
+func (m *MockAdminHandler) DescribeTaskListDLQEntry(arg0 context.Context, arg1 *types.DescribeTaskListDLQEntryRequest) (*types.DescribeTaskListDLQEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskListDLQEntry", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeTaskListDLQEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListDLQEntry indicates an expected call of DescribeTaskListDLQEntry
+func (mr *MockAdminHandlerMockRecorder) DescribeTaskListDLQEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListDLQEntry", reflect.TypeOf((*MockAdminHandler)(nil).DescribeTaskListDLQEntry), arg0, arg1)
+}
+
+// RedriveTaskListDLQEntry mocks base method
+func (m *MockAdminHandler) RedriveTaskListDLQEntry(arg0 context.Context, arg1 *types.RedriveTaskListDLQEntryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedriveTaskListDLQEntry", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedriveTaskListDLQEntry indicates an expected call of RedriveTaskListDLQEntry
+func (mr *MockAdminHandlerMockRecorder) RedriveTaskListDLQEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedriveTaskListDLQEntry", reflect.TypeOf((*MockAdminHandler)(nil).RedriveTaskListDLQEntry), arg0, arg1)
+}
+
+// FailTaskListDLQEntry mocks base method
+func (m *MockAdminHandler) FailTaskListDLQEntry(arg0 context.Context, arg1 *types.FailTaskListDLQEntryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailTaskListDLQEntry", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailTaskListDLQEntry indicates an expected call of FailTaskListDLQEntry
+func (mr *MockAdminHandlerMockRecorder) FailTaskListDLQEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailTaskListDLQEntry", reflect.TypeOf((*MockAdminHandler)(nil).FailTaskListDLQEntry), arg0, arg1)
+}
+
+// MoveShard mocks base method
+func (m *MockAdminHandler) MoveShard(arg0 context.Context, arg1 *types.MoveShardRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveShard", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveShard indicates an expected call of MoveShard
+func (mr *MockAdminHandlerMockRecorder) MoveShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockAdminHandler)(nil).MoveShard), arg0, arg1)
+}
+
+// UnpinShard mocks base method
+func (m *MockAdminHandler) UnpinShard(arg0 context.Context, arg1 *types.UnpinShardRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinShard", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinShard indicates an expected call of UnpinShard
+func (mr *MockAdminHandlerMockRecorder) UnpinShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinShard", reflect.TypeOf((*MockAdminHandler)(nil).UnpinShard), arg0, arg1)
+}
+
+// ListQuarantinedWorkflows mocks base method
+func (m *MockAdminHandler) ListQuarantinedWorkflows(arg0 context.Context, arg1 *types.ListQuarantinedWorkflowsRequest) (*types.ListQuarantinedWorkflowsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuarantinedWorkflows", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListQuarantinedWorkflowsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuarantinedWorkflows indicates an expected call of ListQuarantinedWorkflows
+func (mr *MockAdminHandlerMockRecorder) ListQuarantinedWorkflows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantinedWorkflows", reflect.TypeOf((*MockAdminHandler)(nil).ListQuarantinedWorkflows), arg0, arg1)
+}
+
+// ReleaseQuarantinedWorkflow mocks base method
+func (m *MockAdminHandler) ReleaseQuarantinedWorkflow(arg0 context.Context, arg1 *types.ReleaseQuarantinedWorkflowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseQuarantinedWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseQuarantinedWorkflow indicates an expected call of ReleaseQuarantinedWorkflow
+func (mr *MockAdminHandlerMockRecorder) ReleaseQuarantinedWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseQuarantinedWorkflow", reflect.TypeOf((*MockAdminHandler)(nil).ReleaseQuarantinedWorkflow), arg0, arg1)
+}
+
+// CheckWorkflowBuild mocks base method
+func (m *MockAdminHandler) CheckWorkflowBuild(arg0 context.Context, arg1 *types.CheckWorkflowBuildRequest) (*types.CheckWorkflowBuildResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckWorkflowBuild", arg0, arg1)
+	ret0, _ := ret[0].(*types.CheckWorkflowBuildResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckWorkflowBuild indicates an expected call of CheckWorkflowBuild
+func (mr *MockAdminHandlerMockRecorder) CheckWorkflowBuild(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckWorkflowBuild", reflect.TypeOf((*MockAdminHandler)(nil).CheckWorkflowBuild), arg0, arg1)
+}
@@ -25,26 +25,35 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/yarpctest"
 
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	esmock "github.com/uber/cadence/common/elasticsearch/mocks"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quarantine"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/shardplacement"
+	"github.com/uber/cadence/common/tasklistdlq"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/shadower"
 )
 
 type (
@@ -64,6 +73,13 @@ type (
 
 		handler *adminHandlerImpl
 	}
+
+	// testDynamicConfigQueue keeps dynamic config changes in memory
+	testDynamicConfigQueue struct {
+		persistence.QueueManager
+
+		messages []*persistence.QueueMessage
+	}
 )
 
 func TestAdminHandlerSuite(t *testing.T) {
@@ -89,8 +105,10 @@ func (s *adminHandlerSuite) SetupTest() {
 		},
 	}
 	config := &Config{
-		EnableAdminProtection:  dynamicconfig.GetBoolPropertyFn(false),
-		EnableGracefulFailover: dynamicconfig.GetBoolPropertyFn(false),
+		EnableAdminProtection:     dynamicconfig.GetBoolPropertyFn(false),
+		EnableGracefulFailover:    dynamicconfig.GetBoolPropertyFn(false),
+		NumHistoryShards:          1,
+		HistoryShardPlacementMode: dynamicconfig.GetStringPropertyFn(shardplacement.ModeLoad),
 	}
 	s.handler = NewAdminHandler(s.mockResource, params, config).(*adminHandlerImpl)
 	s.handler.Start()
@@ -580,3 +598,321 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 		s.Equal(testCase.Expected, handler.AddSearchAttribute(ctx, testCase.Request))
 	}
 }
+
+func (s *adminHandlerSuite) Test_DynamicConfig() {
+	handler := s.handler
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{Caller: "cadence-cli"})
+	configName := dynamicconfig.FrontendMaxDomainRPSPerInstance.String()
+	domainFilter := []*types.DynamicConfigFilter{{Name: "domainName", Value: s.jsonBlob(`"some-domain"`)}}
+
+	err := handler.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{ConfigName: configName, Value: s.jsonBlob("10")})
+	s.Equal(errDynamicConfigStoreNotEnabled, err)
+
+	handler.params.DynamicConfigStore = configstore.NewStore(&testDynamicConfigQueue{}, clock.NewRealTimeSource())
+	err = handler.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{ConfigName: configName})
+	s.IsType(&types.BadRequestError{}, err)
+	err = handler.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{ConfigName: configName, Value: s.jsonBlob("1200")})
+	s.NoError(err)
+	err = handler.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{
+		ConfigName: configName,
+		Filters:    domainFilter,
+		Value:      s.jsonBlob("10"),
+		Reason:     "incident",
+	})
+	s.NoError(err)
+
+	resp, err := handler.GetDynamicConfig(ctx, &types.GetDynamicConfigRequest{ConfigName: configName, Filters: domainFilter})
+	s.NoError(err)
+	s.Equal([]byte("10"), resp.Value.Data)
+	resp, err = handler.GetDynamicConfig(ctx, &types.GetDynamicConfigRequest{ConfigName: configName})
+	s.NoError(err)
+	s.Equal([]byte("1200"), resp.Value.Data)
+
+	err = handler.RestoreDynamicConfig(ctx, &types.RestoreDynamicConfigRequest{ConfigName: configName})
+	s.NoError(err)
+	_, err = handler.GetDynamicConfig(ctx, &types.GetDynamicConfigRequest{ConfigName: configName})
+	s.Equal(errDynamicConfigNotFound, err)
+
+	listResp, err := handler.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{ConfigName: configName, IncludeHistory: true})
+	s.NoError(err)
+	s.Len(listResp.Entries, 1)
+	s.Len(listResp.Entries[0].Values, 1)
+	s.Equal(domainFilter, listResp.Entries[0].Values[0].Filters)
+	s.Equal("incident", listResp.Entries[0].Values[0].LastChange.Reason)
+	s.Len(listResp.History, 3)
+	s.Equal("cadence-cli", listResp.History[1].Author)
+	s.True(listResp.History[2].Deleted)
+
+	err = handler.UpdateDynamicConfig(context.Background(), &types.UpdateDynamicConfigRequest{ConfigName: configName, Value: s.jsonBlob("1200")})
+	s.NoError(err)
+	listResp, err = handler.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{ConfigName: configName, IncludeHistory: true})
+	s.NoError(err)
+	s.Equal(unknownCallerIdentity, listResp.History[3].Author)
+}
+
+func (s *adminHandlerSuite) Test_TaskListState() {
+	handler := s.handler
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{Caller: "cadence-cli"})
+	taskList := &types.TaskList{Name: "some-task-list"}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
+	expectUpdate := func(paused *bool, migrateTo *string) {
+		s.mockResource.MatchingClient.EXPECT().UpdateTaskListState(gomock.Any(), &types.MatchingUpdateTaskListStateRequest{
+			DomainUUID:   s.domainID,
+			TaskList:     taskList,
+			TaskListType: types.TaskListTypeActivity.Ptr(),
+			Paused:       paused,
+			MigrateTo:    migrateTo,
+		}).Return(nil)
+	}
+
+	err := handler.PauseTaskList(ctx, &types.PauseTaskListRequest{Domain: s.domainName, TaskList: taskList})
+	s.Equal(errTaskListTypeNotSet, err)
+	expectUpdate(common.BoolPtr(true), nil)
+	err = handler.PauseTaskList(ctx, &types.PauseTaskListRequest{
+		Domain:       s.domainName,
+		TaskList:     taskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		Reason:       "incident",
+	})
+	s.NoError(err)
+
+	expectUpdate(common.BoolPtr(false), nil)
+	err = handler.ResumeTaskList(ctx, &types.ResumeTaskListRequest{Domain: s.domainName, TaskList: taskList, TaskListType: types.TaskListTypeActivity.Ptr()})
+	s.NoError(err)
+
+	err = handler.MigrateTaskList(ctx, &types.MigrateTaskListRequest{
+		Domain:              s.domainName,
+		TaskList:            taskList,
+		TaskListType:        types.TaskListTypeActivity.Ptr(),
+		DestinationTaskList: taskList.Name,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+	expectUpdate(nil, common.StringPtr("other-task-list"))
+	err = handler.MigrateTaskList(ctx, &types.MigrateTaskListRequest{
+		Domain:              s.domainName,
+		TaskList:            taskList,
+		TaskListType:        types.TaskListTypeActivity.Ptr(),
+		DestinationTaskList: "other-task-list",
+	})
+	s.NoError(err)
+
+	expectUpdate(nil, common.StringPtr(""))
+	err = handler.MigrateTaskList(ctx, &types.MigrateTaskListRequest{Domain: s.domainName, TaskList: taskList, TaskListType: types.TaskListTypeActivity.Ptr()})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_TaskListDLQ() {
+	handler := s.handler
+	ctx := context.Background()
+	dlq := tasklistdlq.NewMockQueue(s.controller)
+	handler.taskListDLQ = dlq
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
+
+	newMessage := func(id int64, domainID string, taskType int) *tasklistdlq.Message {
+		return &tasklistdlq.Message{
+			ID:       id,
+			DomainID: domainID,
+			TaskList: "some-task-list",
+			TaskType: taskType,
+			Task: &persistence.TaskInfo{
+				DomainID:               domainID,
+				WorkflowID:             "some-workflow",
+				RunID:                  "some-run",
+				ScheduleID:             5,
+				ScheduleToStartTimeout: 10,
+			},
+			Attempts: 3,
+		}
+	}
+	activity := newMessage(1, s.domainID, persistence.TaskListTypeActivity)
+	decision := newMessage(2, s.domainID, persistence.TaskListTypeDecision)
+	otherDomain := newMessage(3, "other-domain-id", persistence.TaskListTypeActivity)
+
+	dlq.EXPECT().Read(gomock.Any(), 10, []byte("token")).Return([]*tasklistdlq.Message{activity, decision, otherDomain}, []byte("next"), nil)
+	readResp, err := handler.ReadTaskListDLQ(ctx, &types.ReadTaskListDLQRequest{
+		Domain:          s.domainName,
+		TaskListType:    types.TaskListTypeActivity.Ptr(),
+		MaximumPageSize: 10,
+		NextPageToken:   []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("next"), readResp.NextPageToken)
+	s.Len(readResp.Entries, 1)
+	s.Equal(int64(1), readResp.Entries[0].GetMessageID())
+	s.Equal(int64(5), readResp.Entries[0].GetScheduleID())
+	s.Equal(int32(3), readResp.Entries[0].GetAttempts())
+
+	dlq.EXPECT().Get(gomock.Any(), int64(3)).Return(otherDomain, nil)
+	_, err = handler.DescribeTaskListDLQEntry(ctx, &types.DescribeTaskListDLQEntryRequest{Domain: s.domainName, MessageID: 3})
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	dlq.EXPECT().Get(gomock.Any(), int64(2)).Return(decision, nil)
+	err = handler.FailTaskListDLQEntry(ctx, &types.FailTaskListDLQEntryRequest{Domain: s.domainName, MessageID: 2})
+	s.IsType(&types.BadRequestError{}, err)
+
+	dlq.EXPECT().Get(gomock.Any(), int64(2)).Return(decision, nil)
+	s.mockResource.MatchingClient.EXPECT().AddDecisionTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.AddDecisionTaskRequest) error {
+			s.Equal(s.domainID, request.GetDomainUUID())
+			s.Equal("some-task-list", request.GetTaskList().GetName())
+			s.Equal(int64(5), request.GetScheduleID())
+			s.Equal(int32(10), request.GetScheduleToStartTimeoutSeconds())
+			return nil
+		})
+	dlq.EXPECT().Delete(gomock.Any(), int64(2)).Return(nil)
+	err = handler.RedriveTaskListDLQEntry(ctx, &types.RedriveTaskListDLQEntryRequest{Domain: s.domainName, MessageID: 2})
+	s.NoError(err)
+
+	dlq.EXPECT().Get(gomock.Any(), int64(1)).Return(activity, nil)
+	dlq.EXPECT().Delete(gomock.Any(), int64(1)).Return(nil)
+	err = handler.FailTaskListDLQEntry(ctx, &types.FailTaskListDLQEntryRequest{Domain: s.domainName, MessageID: 1})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_ShardPlacement() {
+	handler := s.handler
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{Caller: "cadence-cli"})
+	store := shardplacement.NewMockStore(s.controller)
+	handler.shardPlacement = store
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("host-a", nil),
+		membership.NewHostInfo("host-b", nil),
+	}).AnyTimes()
+
+	err := handler.MoveShard(ctx, &types.MoveShardRequest{ShardID: 1, Host: "host-a"})
+	s.IsType(&types.BadRequestError{}, err)
+	err = handler.MoveShard(ctx, &types.MoveShardRequest{ShardID: 0, Host: "host-c"})
+	s.IsType(&types.BadRequestError{}, err)
+
+	store.EXPECT().Assign(gomock.Any(), []*shardplacement.Assignment{{ShardID: 0, Host: "host-b", Pinned: true}}, "cadence-cli", "hot shard").Return(nil)
+	err = handler.MoveShard(ctx, &types.MoveShardRequest{ShardID: 0, Host: "host-b", Pin: true, Reason: "hot shard"})
+	s.NoError(err)
+
+	store.EXPECT().Assign(gomock.Any(), []*shardplacement.Assignment{{ShardID: 0}}, unknownCallerIdentity, "").Return(nil)
+	err = handler.UnpinShard(context.Background(), &types.UnpinShardRequest{ShardID: 0})
+	s.NoError(err)
+
+	store.EXPECT().GetPlacement(gomock.Any()).Return(&shardplacement.Placement{
+		Assignments: map[int]*shardplacement.Assignment{0: {ShardID: 0, Host: "host-b", Pinned: true}},
+		Loads:       map[int]*shardplacement.ShardLoad{0: {RPS: 12.5, QueueBacklog: 3, CacheSize: 7}},
+	}, nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(0)).Return(membership.NewHostInfo("host-b", nil), nil)
+	resp, err := handler.DescribeShardDistribution(ctx, &types.DescribeShardDistributionRequest{PageSize: 10})
+	s.NoError(err)
+	s.Equal(shardplacement.ModeLoad, resp.GetPlacementMode())
+	s.Equal("host-b", resp.Shards[0])
+	s.Equal(&types.ShardPlacementInfo{
+		AssignedHost: "host-b",
+		Pinned:       true,
+		RPS:          12.5,
+		QueueBacklog: 3,
+		CacheSize:    7,
+	}, resp.GetPlacements()[0])
+}
+
+func (s *adminHandlerSuite) Test_QuarantinedWorkflows() {
+	handler := s.handler
+	ctx := context.Background()
+	store := quarantine.NewMockStore(s.controller)
+	handler.workflowQuarantine = store
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainName(s.domainID).Return(s.domainName, nil).AnyTimes()
+
+	runID := uuid.New()
+	quarantined := quarantine.Execution{DomainID: s.domainID, WorkflowID: "workflow-id", RunID: runID}
+	released := quarantine.Execution{DomainID: s.domainID, WorkflowID: "workflow-id", RunID: uuid.New()}
+	store.EXPECT().GetEntries(gomock.Any()).Return(map[quarantine.Execution]*quarantine.Entry{
+		quarantined: {Execution: quarantined, ShardID: 3, Details: "mismatch", QuarantinedAt: time.Unix(0, 100)},
+		released:    {Execution: released, Released: true},
+	}, nil)
+	resp, err := handler.ListQuarantinedWorkflows(ctx, &types.ListQuarantinedWorkflowsRequest{Domain: s.domainName})
+	s.NoError(err)
+	s.Equal([]*types.QuarantinedWorkflow{{
+		Domain:               s.domainName,
+		WorkflowID:           "workflow-id",
+		RunID:                runID,
+		ShardID:              3,
+		Details:              "mismatch",
+		QuarantinedTimestamp: common.Int64Ptr(100),
+	}}, resp.GetWorkflows())
+
+	err = handler.ReleaseQuarantinedWorkflow(ctx, &types.ReleaseQuarantinedWorkflowRequest{Domain: s.domainName, WorkflowID: "workflow-id", RunID: "invalid"})
+	s.IsType(&types.BadRequestError{}, err)
+
+	store.EXPECT().Release(gomock.Any(), quarantined, true, "cadence-cli", "corrupted").Return(nil)
+	s.mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &types.HistoryRefreshWorkflowTasksRequest{
+		DomainUIID: s.domainID,
+		Request: &types.RefreshWorkflowTasksRequest{
+			Domain:    s.domainName,
+			Execution: &types.WorkflowExecution{WorkflowID: "workflow-id", RunID: runID},
+		},
+	}).Return(nil)
+	err = handler.ReleaseQuarantinedWorkflow(yarpctest.ContextWithCall(ctx, &yarpctest.Call{Caller: "cadence-cli"}), &types.ReleaseQuarantinedWorkflowRequest{
+		Domain:     s.domainName,
+		WorkflowID: "workflow-id",
+		RunID:      runID,
+		Repair:     true,
+		Reason:     "corrupted",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_CheckWorkflowBuild() {
+	handler := s.handler
+	ctx := context.Background()
+	client := shadower.NewMockClient(s.controller)
+	handler.shadowerClient = client
+
+	_, err := handler.CheckWorkflowBuild(ctx, nil)
+	s.IsType(&types.BadRequestError{}, err)
+	_, err = handler.CheckWorkflowBuild(ctx, &types.CheckWorkflowBuildRequest{})
+	s.IsType(&types.BadRequestError{}, err)
+
+	request := &types.CheckWorkflowBuildRequest{
+		Domain:        s.domainName,
+		WorkflowType:  "workflow-type",
+		BuildID:       "build-id",
+		SignatureHash: "signature-hash",
+		TaskList:      "task-list",
+	}
+	expected := &types.CheckWorkflowBuildResponse{
+		State:          types.WorkflowBuildCheckStatePassed.Ptr(),
+		WorkflowID:     "workflow-id",
+		RunID:          "run-id",
+		CheckedBuildID: "build-id",
+		Succeeded:      10,
+	}
+	client.EXPECT().CheckBuild(gomock.Any(), request).Return(expected, nil)
+	resp, err := handler.CheckWorkflowBuild(ctx, request)
+	s.NoError(err)
+	s.Equal(expected, resp)
+}
+
+func (s *adminHandlerSuite) jsonBlob(data string) *types.DataBlob {
+	return &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(data)}
+}
+
+func (q *testDynamicConfigQueue) EnqueueMessage(
+	_ context.Context,
+	messagePayload []byte,
+) error {
+	q.messages = append(q.messages, &persistence.QueueMessage{
+		ID:      int64(len(q.messages)),
+		Payload: messagePayload,
+	})
+	return nil
+}
+
+func (q *testDynamicConfigQueue) ReadMessages(
+	_ context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	var result []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
@@ -165,3 +165,99 @@ func (t AdminThriftHandler) GetCrossClusterTasks(ctx context.Context, request *s
 	response, err := t.h.GetCrossClusterTasks(withThriftTag(ctx), thrift.ToGetCrossClusterTasksRequest(request))
 	return thrift.FromGetCrossClusterTasksResponse(response), thrift.FromError(err)
 }
+
+// GetDynamicConfig forwards request to the underlying handler
+func (t AdminThriftHandler) GetDynamicConfig(ctx context.Context, request *admin.GetDynamicConfigRequest) (*admin.GetDynamicConfigResponse, error) {
+	response, err := t.h.GetDynamicConfig(withThriftTag(ctx), thrift.ToGetDynamicConfigRequest(request))
+	return thrift.FromGetDynamicConfigResponse(response), thrift.FromError(err)
+}
+
+// UpdateDynamicConfig forwards request to the underlying handler
+func (t AdminThriftHandler) UpdateDynamicConfig(ctx context.Context, request *admin.UpdateDynamicConfigRequest) error {
+	err := t.h.UpdateDynamicConfig(withThriftTag(ctx), thrift.ToUpdateDynamicConfigRequest(request))
+	return thrift.FromError(err)
+}
+
+// RestoreDynamicConfig forwards request to the underlying handler
+func (t AdminThriftHandler) RestoreDynamicConfig(ctx context.Context, request *admin.RestoreDynamicConfigRequest) error {
+	err := t.h.RestoreDynamicConfig(withThriftTag(ctx), thrift.ToRestoreDynamicConfigRequest(request))
+	return thrift.FromError(err)
+}
+
+// ListDynamicConfig forwards request to the underlying handler
+func (t AdminThriftHandler) ListDynamicConfig(ctx context.Context, request *admin.ListDynamicConfigRequest) (*admin.ListDynamicConfigResponse, error) {
+	response, err := t.h.ListDynamicConfig(withThriftTag(ctx), thrift.ToListDynamicConfigRequest(request))
+	return thrift.FromListDynamicConfigResponse(response), thrift.FromError(err)
+}
+
+// PauseTaskList forwards request to the underlying handler
+func (t AdminThriftHandler) PauseTaskList(ctx context.Context, request *admin.PauseTaskListRequest) error {
+	err := t.h.PauseTaskList(withThriftTag(ctx), thrift.ToPauseTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// ResumeTaskList forwards request to the underlying handler
+func (t AdminThriftHandler) ResumeTaskList(ctx context.Context, request *admin.ResumeTaskListRequest) error {
+	err := t.h.ResumeTaskList(withThriftTag(ctx), thrift.ToResumeTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// MigrateTaskList forwards request to the underlying handler
+func (t AdminThriftHandler) MigrateTaskList(ctx context.Context, request *admin.MigrateTaskListRequest) error {
+	err := t.h.MigrateTaskList(withThriftTag(ctx), thrift.ToMigrateTaskListRequest(request))
+	return thrift.FromError(err)
+}
+
+// ReadTaskListDLQ forwards request to the underlying handler
+func (t AdminThriftHandler) ReadTaskListDLQ(ctx context.Context, request *admin.ReadTaskListDLQRequest) (*admin.ReadTaskListDLQResponse, error) {
+	response, err := t.h.ReadTaskListDLQ(withThriftTag(ctx), thrift.ToReadTaskListDLQRequest(request))
+	return thrift.FromReadTaskListDLQResponse(response), thrift.FromError(err)
+}
+
+// DescribeTaskListDLQEntry forwards request to the underlying handler
+func (t AdminThriftHandler) DescribeTaskListDLQEntry(ctx context.Context, request *admin.DescribeTaskListDLQEntryRequest) (*admin.DescribeTaskListDLQEntryResponse, error) {
+	response, err := t.h.DescribeTaskListDLQEntry(withThriftTag(ctx), thrift.ToDescribeTaskListDLQEntryRequest(request))
+	return thrift.FromDescribeTaskListDLQEntryResponse(response), thrift.FromError(err)
+}
+
+// RedriveTaskListDLQEntry forwards request to the underlying handler
+func (t AdminThriftHandler) RedriveTaskListDLQEntry(ctx context.Context, request *admin.RedriveTaskListDLQEntryRequest) error {
+	err := t.h.RedriveTaskListDLQEntry(withThriftTag(ctx), thrift.ToRedriveTaskListDLQEntryRequest(request))
+	return thrift.FromError(err)
+}
+
+// FailTaskListDLQEntry forwards request to the underlying handler
+func (t AdminThriftHandler) FailTaskListDLQEntry(ctx context.Context, request *admin.FailTaskListDLQEntryRequest) error {
+	err := t.h.FailTaskListDLQEntry(withThriftTag(ctx), thrift.ToFailTaskListDLQEntryRequest(request))
+	return thrift.FromError(err)
+}
+
+// UnpinShard forwards request to the underlying handler
+func (t AdminThriftHandler) UnpinShard(ctx context.Context, request *admin.UnpinShardRequest) error {
+	err := t.h.UnpinShard(withThriftTag(ctx), thrift.ToUnpinShardRequest(request))
+	return thrift.FromError(err)
+}
+
+// ListQuarantinedWorkflows forwards request to the underlying handler
+func (t AdminThriftHandler) ListQuarantinedWorkflows(ctx context.Context, request *admin.ListQuarantinedWorkflowsRequest) (*admin.ListQuarantinedWorkflowsResponse, error) {
+	response, err := t.h.ListQuarantinedWorkflows(withThriftTag(ctx), thrift.ToListQuarantinedWorkflowsRequest(request))
+	return thrift.FromListQuarantinedWorkflowsResponse(response), thrift.FromError(err)
+}
+
+// ReleaseQuarantinedWorkflow forwards request to the underlying handler
+func (t AdminThriftHandler) ReleaseQuarantinedWorkflow(ctx context.Context, request *admin.ReleaseQuarantinedWorkflowRequest) error {
+	err := t.h.ReleaseQuarantinedWorkflow(withThriftTag(ctx), thrift.ToReleaseQuarantinedWorkflowRequest(request))
+	return thrift.FromError(err)
+}
+
+// CheckWorkflowBuild forwards request to the underlying handler
+func (t AdminThriftHandler) CheckWorkflowBuild(ctx context.Context, request *admin.CheckWorkflowBuildRequest) (*admin.CheckWorkflowBuildResponse, error) {
+	response, err := t.h.CheckWorkflowBuild(withThriftTag(ctx), thrift.ToCheckWorkflowBuildRequest(request))
+	return thrift.FromCheckWorkflowBuildResponse(response), thrift.FromError(err)
+}
+
+// MoveShard forwards request to the underlying handler
+func (t AdminThriftHandler) MoveShard(ctx context.Context, request *admin.MoveShardRequest) error {
+	err := t.h.MoveShard(withThriftTag(ctx), thrift.ToMoveShardRequest(request))
+	return thrift.FromError(err)
+}
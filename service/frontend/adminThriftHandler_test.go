@@ -152,4 +152,60 @@ func TestAdminThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.GetCrossClusterTasksResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ReadTaskListDLQ", func(t *testing.T) {
+		h.EXPECT().ReadTaskListDLQ(taggedCtx, &types.ReadTaskListDLQRequest{}).Return(&types.ReadTaskListDLQResponse{}, internalErr).Times(1)
+		resp, err := th.ReadTaskListDLQ(ctx, &admin.ReadTaskListDLQRequest{})
+		assert.Equal(t, admin.ReadTaskListDLQResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DescribeTaskListDLQEntry", func(t *testing.T) {
+		h.EXPECT().DescribeTaskListDLQEntry(taggedCtx, &types.DescribeTaskListDLQEntryRequest{}).Return(&types.DescribeTaskListDLQEntryResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeTaskListDLQEntry(ctx, &admin.DescribeTaskListDLQEntryRequest{})
+		assert.Equal(t, admin.DescribeTaskListDLQEntryResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RedriveTaskListDLQEntry", func(t *testing.T) {
+		h.EXPECT().RedriveTaskListDLQEntry(taggedCtx, &types.RedriveTaskListDLQEntryRequest{}).Return(internalErr).Times(1)
+		err := th.RedriveTaskListDLQEntry(ctx, &admin.RedriveTaskListDLQEntryRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("FailTaskListDLQEntry", func(t *testing.T) {
+		h.EXPECT().FailTaskListDLQEntry(taggedCtx, &types.FailTaskListDLQEntryRequest{}).Return(internalErr).Times(1)
+		err := th.FailTaskListDLQEntry(ctx, &admin.FailTaskListDLQEntryRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("MoveShard", func(t *testing.T) {
+		h.EXPECT().MoveShard(taggedCtx, &types.MoveShardRequest{}).Return(internalErr).Times(1)
+		err := th.MoveShard(ctx, &admin.MoveShardRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UnpinShard", func(t *testing.T) {
+		h.EXPECT().UnpinShard(taggedCtx, &types.UnpinShardRequest{}).Return(internalErr).Times(1)
+		err := th.UnpinShard(ctx, &admin.UnpinShardRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ListQuarantinedWorkflows", func(t *testing.T) {
+		h.EXPECT().ListQuarantinedWorkflows(taggedCtx, &types.ListQuarantinedWorkflowsRequest{}).Return(&types.ListQuarantinedWorkflowsResponse{}, internalErr).Times(1)
+		resp, err := th.ListQuarantinedWorkflows(ctx, &admin.ListQuarantinedWorkflowsRequest{})
+		assert.Equal(t, admin.ListQuarantinedWorkflowsResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ReleaseQuarantinedWorkflow", func(t *testing.T) {
+		h.EXPECT().ReleaseQuarantinedWorkflow(taggedCtx, &types.ReleaseQuarantinedWorkflowRequest{}).Return(internalErr).Times(1)
+		err := th.ReleaseQuarantinedWorkflow(ctx, &admin.ReleaseQuarantinedWorkflowRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("CheckWorkflowBuild", func(t *testing.T) {
+		h.EXPECT().CheckWorkflowBuild(taggedCtx, &types.CheckWorkflowBuildRequest{}).Return(&types.CheckWorkflowBuildResponse{}, internalErr).Times(1)
+		resp, err := th.CheckWorkflowBuild(ctx, &admin.CheckWorkflowBuildRequest{})
+		assert.Equal(t, admin.CheckWorkflowBuildResponse{
+			WorkflowID:     common.StringPtr(""),
+			RunID:          common.StringPtr(""),
+			CheckedBuildID: common.StringPtr(""),
+			Succeeded:      common.Int32Ptr(0),
+			Skipped:        common.Int32Ptr(0),
+			Failed:         common.Int32Ptr(0),
+		}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
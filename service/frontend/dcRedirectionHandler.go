@@ -1113,6 +1113,36 @@ func (handler *DCRedirectionHandlerImpl) TerminateWorkflowExecution(
 	return err
 }
 
+// ExpediteWorkflowExecutionStart API call
+func (handler *DCRedirectionHandlerImpl) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	request *types.ExpediteWorkflowExecutionStartRequest,
+) (retError error) {
+
+	var apiName = "ExpediteWorkflowExecutionStart"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionExpediteWorkflowExecutionStartScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.ExpediteWorkflowExecutionStart(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.ExpediteWorkflowExecutionStart(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
 // ListTaskListPartitions API call
 func (handler *DCRedirectionHandlerImpl) ListTaskListPartitions(
 	ctx context.Context,
@@ -1173,6 +1203,39 @@ func (handler *DCRedirectionHandlerImpl) GetTaskListsByDomain(
 	return resp, err
 }
 
+// UpdateWorkerBuildIDCompatibility API call, version sets are not redirected
+// as they are used by the matching service of the current cluster
+func (handler *DCRedirectionHandlerImpl) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.UpdateWorkerBuildIDCompatibilityRequest,
+) (retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkerBuildIDCompatibilityScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.UpdateWorkerBuildIDCompatibility(ctx, request)
+}
+
+// GetWorkerBuildIDCompatibility API call
+func (handler *DCRedirectionHandlerImpl) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.GetWorkerBuildIDCompatibilityRequest,
+) (resp *types.GetWorkerBuildIDCompatibilityResponse, retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionGetWorkerBuildIDCompatibilityScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.GetWorkerBuildIDCompatibility(ctx, request)
+}
+
 // GetClusterInfo API call
 func (handler *DCRedirectionHandlerImpl) GetClusterInfo(
 	ctx context.Context,
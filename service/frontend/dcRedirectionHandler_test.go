@@ -811,6 +811,27 @@ func (s *dcRedirectionHandlerSuite) TestTerminateWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *dcRedirectionHandlerSuite) TestExpediteWorkflowExecutionStart() {
+	apiName := "ExpediteWorkflowExecutionStart"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Times(1)
+
+	req := &types.ExpediteWorkflowExecutionStartRequest{
+		Domain: s.domainName,
+	}
+	err := s.handler.ExpediteWorkflowExecutionStart(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.EXPECT().ExpediteWorkflowExecutionStart(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.EXPECT().ExpediteWorkflowExecutionStart(gomock.Any(), req).Return(nil).Times(1)
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
 func (s *dcRedirectionHandlerSuite) TestListTaskListPartitions() {
 	apiName := "ListTaskListPartitions"
 
@@ -45,6 +45,7 @@ const (
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
 	// 6. QueryWorkflow
+	// 7. ExpediteWorkflowExecutionStart
 	// please also reference selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 )
@@ -79,6 +80,7 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"TerminateWorkflowExecution":       {},
 	"QueryWorkflow":                    {},
 	"ResetWorkflowExecution":           {},
+	"ExpediteWorkflowExecutionStart":   {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
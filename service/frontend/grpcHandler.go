@@ -86,6 +86,11 @@ func (g grpcHandler) GetSearchAttributes(ctx context.Context, _ *apiv1.GetSearch
 	return proto.FromGetSearchAttributesResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) GetWorkerBuildIDCompatibility(ctx context.Context, request *apiv1.GetWorkerBuildIDCompatibilityRequest) (*apiv1.GetWorkerBuildIDCompatibilityResponse, error) {
+	response, err := g.h.GetWorkerBuildIDCompatibility(withGRPCTag(ctx), proto.ToGetWorkerBuildIDCompatibilityRequest(request))
+	return proto.FromGetWorkerBuildIDCompatibilityResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) GetWorkflowExecutionHistory(ctx context.Context, request *apiv1.GetWorkflowExecutionHistoryRequest) (*apiv1.GetWorkflowExecutionHistoryResponse, error) {
 	response, err := g.h.GetWorkflowExecutionHistory(withGRPCTag(ctx), proto.ToGetWorkflowExecutionHistoryRequest(request))
 	return proto.FromGetWorkflowExecutionHistoryResponse(response), proto.FromError(err)
@@ -241,11 +246,21 @@ func (g grpcHandler) TerminateWorkflowExecution(ctx context.Context, request *ap
 	return &apiv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) ExpediteWorkflowExecutionStart(ctx context.Context, request *apiv1.ExpediteWorkflowExecutionStartRequest) (*apiv1.ExpediteWorkflowExecutionStartResponse, error) {
+	err := g.h.ExpediteWorkflowExecutionStart(withGRPCTag(ctx), proto.ToExpediteWorkflowExecutionStartRequest(request))
+	return &apiv1.ExpediteWorkflowExecutionStartResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) UpdateDomain(ctx context.Context, request *apiv1.UpdateDomainRequest) (*apiv1.UpdateDomainResponse, error) {
 	response, err := g.h.UpdateDomain(withGRPCTag(ctx), proto.ToUpdateDomainRequest(request))
 	return proto.FromUpdateDomainResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) UpdateWorkerBuildIDCompatibility(ctx context.Context, request *apiv1.UpdateWorkerBuildIDCompatibilityRequest) (*apiv1.UpdateWorkerBuildIDCompatibilityResponse, error) {
+	err := g.h.UpdateWorkerBuildIDCompatibility(withGRPCTag(ctx), proto.ToUpdateWorkerBuildIDCompatibilityRequest(request))
+	return &apiv1.UpdateWorkerBuildIDCompatibilityResponse{}, proto.FromError(err)
+}
+
 func withGRPCTag(ctx context.Context) context.Context {
 	return metrics.TagContext(ctx, metrics.GPRCTransportTag())
 }
@@ -145,6 +145,20 @@ func (mr *MockHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// ExpediteWorkflowExecutionStart mocks base method
+func (m *MockHandler) ExpediteWorkflowExecutionStart(arg0 context.Context, arg1 *types.ExpediteWorkflowExecutionStartRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpediteWorkflowExecutionStart", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpediteWorkflowExecutionStart indicates an expected call of ExpediteWorkflowExecutionStart
+func (mr *MockHandlerMockRecorder) ExpediteWorkflowExecutionStart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpediteWorkflowExecutionStart", reflect.TypeOf((*MockHandler)(nil).ExpediteWorkflowExecutionStart), arg0, arg1)
+}
+
 // GetClusterInfo mocks base method
 func (m *MockHandler) GetClusterInfo(arg0 context.Context) (*types.ClusterInfo, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +189,21 @@ func (mr *MockHandlerMockRecorder) GetSearchAttributes(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockHandler)(nil).GetSearchAttributes), arg0)
 }
 
+// GetWorkerBuildIDCompatibility mocks base method
+func (m *MockHandler) GetWorkerBuildIDCompatibility(arg0 context.Context, arg1 *types.GetWorkerBuildIDCompatibilityRequest) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerBuildIDCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetWorkerBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerBuildIDCompatibility indicates an expected call of GetWorkerBuildIDCompatibility
+func (mr *MockHandlerMockRecorder) GetWorkerBuildIDCompatibility(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerBuildIDCompatibility", reflect.TypeOf((*MockHandler)(nil).GetWorkerBuildIDCompatibility), arg0, arg1)
+}
+
 // GetWorkflowExecutionHistory mocks base method
 func (m *MockHandler) GetWorkflowExecutionHistory(arg0 context.Context, arg1 *types.GetWorkflowExecutionHistoryRequest) (*types.GetWorkflowExecutionHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -642,3 +671,17 @@ func (mr *MockHandlerMockRecorder) UpdateDomain(arg0, arg1 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockHandler)(nil).UpdateDomain), arg0, arg1)
 }
+
+// UpdateWorkerBuildIDCompatibility mocks base method
+func (m *MockHandler) UpdateWorkerBuildIDCompatibility(arg0 context.Context, arg1 *types.UpdateWorkerBuildIDCompatibilityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkerBuildIDCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkerBuildIDCompatibility indicates an expected call of UpdateWorkerBuildIDCompatibility
+func (mr *MockHandlerMockRecorder) UpdateWorkerBuildIDCompatibility(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIDCompatibility", reflect.TypeOf((*MockHandler)(nil).UpdateWorkerBuildIDCompatibility), arg0, arg1)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/resource/config"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/shardplacement"
 )
 
 // Config represents configuration for cadence-frontend service
@@ -88,6 +89,9 @@ type Config struct {
 	DomainFailoverRefreshInterval               dynamicconfig.DurationPropertyFn
 	DomainFailoverRefreshTimerJitterCoefficient dynamicconfig.FloatPropertyFn
 
+	// HistoryShardPlacementMode is how history shards are placed on history hosts
+	HistoryShardPlacementMode dynamicconfig.StringPropertyFn
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
@@ -137,6 +141,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		EnableGracefulFailover:                      dc.GetBoolProperty(dynamicconfig.EnableGracefulFailover, false),
 		DomainFailoverRefreshInterval:               dc.GetDurationProperty(dynamicconfig.DomainFailoverRefreshInterval, 10*time.Second),
 		DomainFailoverRefreshTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.DomainFailoverRefreshTimerJitterCoefficient, 0.1),
+		HistoryShardPlacementMode:                   dc.GetStringProperty(dynamicconfig.HistoryShardPlacementMode, shardplacement.ModeHash),
 		EnableClientVersionCheck:                    dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, false),
 		ValidSearchAttributes:                       dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
 		SearchAttributesNumberOfKeysLimit:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
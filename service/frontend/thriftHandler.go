@@ -98,6 +98,12 @@ func (t ThriftHandler) GetSearchAttributes(ctx context.Context) (*shared.GetSear
 	return thrift.FromGetSearchAttributesResponse(response), thrift.FromError(err)
 }
 
+// GetWorkerBuildIDCompatibility forwards request to the underlying handler
+func (t ThriftHandler) GetWorkerBuildIDCompatibility(ctx context.Context, request *shared.GetWorkerBuildIDCompatibilityRequest) (*shared.GetWorkerBuildIDCompatibilityResponse, error) {
+	response, err := t.h.GetWorkerBuildIDCompatibility(withThriftTag(ctx), thrift.ToGetWorkerBuildIDCompatibilityRequest(request))
+	return thrift.FromGetWorkerBuildIDCompatibilityResponse(response), thrift.FromError(err)
+}
+
 // GetWorkflowExecutionHistory forwards request to the underlying handler
 func (t ThriftHandler) GetWorkflowExecutionHistory(ctx context.Context, request *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error) {
 	response, err := t.h.GetWorkflowExecutionHistory(withThriftTag(ctx), thrift.ToGetWorkflowExecutionHistoryRequest(request))
@@ -284,12 +290,24 @@ func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *
 	return thrift.FromError(err)
 }
 
+// ExpediteWorkflowExecutionStart forwards request to the underlying handler
+func (t ThriftHandler) ExpediteWorkflowExecutionStart(ctx context.Context, request *shared.ExpediteWorkflowExecutionStartRequest) error {
+	err := t.h.ExpediteWorkflowExecutionStart(withThriftTag(ctx), thrift.ToExpediteWorkflowExecutionStartRequest(request))
+	return thrift.FromError(err)
+}
+
 // UpdateDomain forwards request to the underlying handler
 func (t ThriftHandler) UpdateDomain(ctx context.Context, request *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error) {
 	response, err := t.h.UpdateDomain(withThriftTag(ctx), thrift.ToUpdateDomainRequest(request))
 	return thrift.FromUpdateDomainResponse(response), thrift.FromError(err)
 }
 
+// UpdateWorkerBuildIDCompatibility forwards request to the underlying handler
+func (t ThriftHandler) UpdateWorkerBuildIDCompatibility(ctx context.Context, request *shared.UpdateWorkerBuildIDCompatibilityRequest) error {
+	err := t.h.UpdateWorkerBuildIDCompatibility(withThriftTag(ctx), thrift.ToUpdateWorkerBuildIDCompatibilityRequest(request))
+	return thrift.FromError(err)
+}
+
 func withThriftTag(ctx context.Context) context.Context {
 	return metrics.TagContext(ctx, metrics.ThriftTransportTag())
 }
@@ -262,6 +262,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ExpediteWorkflowExecutionStart", func(t *testing.T) {
+		h.EXPECT().ExpediteWorkflowExecutionStart(taggedCtx, &types.ExpediteWorkflowExecutionStartRequest{}).Return(internalErr).Times(1)
+		err := th.ExpediteWorkflowExecutionStart(ctx, &shared.ExpediteWorkflowExecutionStartRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("UpdateDomain", func(t *testing.T) {
 		h.EXPECT().UpdateDomain(taggedCtx, &types.UpdateDomainRequest{}).Return(&types.UpdateDomainResponse{}, internalErr).Times(1)
 		resp, err := th.UpdateDomain(ctx, &shared.UpdateDomainRequest{})
@@ -125,6 +125,8 @@ var (
 	errInvalidExecutionStartToCloseTimeoutSeconds = &types.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &types.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidDelayStartSeconds                   = &types.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
+	errInvalidWorkflowIDConflictPolicy            = &types.BadRequestError{Message: "A valid WorkflowIDConflictPolicy is not set on request."}
+	errConflictPolicyWithTerminateIfRunning       = &types.BadRequestError{Message: "WorkflowIDConflictPolicy cannot be set together with the TerminateIfRunning WorkflowIDReusePolicy."}
 	errQueryDisallowedForDomain                   = &types.BadRequestError{Message: "Domain is not allowed to query, please contact cadence team to re-enable queries."}
 	errClusterNameNotSet                          = &types.BadRequestError{Message: "Cluster name is not set."}
 	errEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
@@ -511,7 +513,8 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return err
 	}
 
-	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientError)
+	// polls shed by matching are not retried so that the worker backs off as asked
+	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientErrorWithoutRetryAfter)
 	if err != nil {
 		err = wh.cancelOutstandingPoll(ctx, err, domainID, persistence.TaskListTypeActivity, pollRequest.TaskList, pollerID)
 		if err != nil {
@@ -615,7 +618,8 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return err
 	}
 
-	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientError)
+	// polls shed by matching are not retried so that the worker backs off as asked
+	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientErrorWithoutRetryAfter)
 	if err != nil {
 		err = wh.cancelOutstandingPoll(ctx, err, domainID, persistence.TaskListTypeDecision, pollRequest.TaskList, pollerID)
 		if err != nil {
@@ -1879,6 +1883,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errInvalidDelayStartSeconds, scope)
 	}
 
+	if err := validateWorkflowIDConflictPolicy(startRequest.WorkflowIDConflictPolicy, startRequest.GetWorkflowIDReusePolicy()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if startRequest.GetRequestID() == "" {
 		return nil, wh.error(errRequestIDNotSet, scope)
 	}
@@ -2413,6 +2421,14 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 			scope, getWfIDRunIDTags(wfExecution)...)
 	}
 
+	if signalWithStartRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if err := validateWorkflowIDConflictPolicy(signalWithStartRequest.WorkflowIDConflictPolicy, signalWithStartRequest.GetWorkflowIDReusePolicy()); err != nil {
+		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
 	}
@@ -2524,6 +2540,59 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// ExpediteWorkflowExecutionStart schedules the first decision task of a workflow execution that is still
+// waiting for its delayed start, so that it starts right away instead of after DelayStartSeconds.
+func (wh *WorkflowHandler) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	expediteRequest *types.ExpediteWorkflowExecutionStartRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendExpediteWorkflowExecutionStartScope, expediteRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	wfExecution := expediteRequest.GetWorkflowExecution()
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if expediteRequest == nil {
+		return wh.error(errRequestNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if ok := wh.allow(expediteRequest); !ok {
+		return wh.error(createServiceBusyError(), scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if expediteRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(expediteRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(expediteRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope, getWfIDRunIDTags(wfExecution)...)
+	}
+
+	err = wh.GetHistoryClient().ExpediteWorkflowExecutionStart(ctx, &types.HistoryExpediteWorkflowExecutionStartRequest{
+		DomainUUID:      domainID,
+		ExpediteRequest: expediteRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, getWfIDRunIDTags(wfExecution)...))
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -3493,6 +3562,96 @@ func (wh *WorkflowHandler) GetTaskListsByDomain(
 	return resp, err
 }
 
+// UpdateWorkerBuildIDCompatibility updates the worker build ID version sets of a task list.
+// Workers poll with their binary checksum as build ID, new workflows are routed to the default
+// version set and the tasks of existing workflows to the version set of their build.
+func (wh *WorkflowHandler) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.UpdateWorkerBuildIDCompatibilityRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendUpdateWorkerBuildIDCompatibilityScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if request == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(request); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if request.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.validateTaskList(request.TaskList, scope, request.GetDomain()); err != nil {
+		return err
+	}
+
+	err = wh.GetMatchingClient().UpdateWorkerBuildIDCompatibility(ctx, &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: request,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// GetWorkerBuildIDCompatibility returns the worker build ID version sets of a task list, the last set is the default set
+func (wh *WorkflowHandler) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.GetWorkerBuildIDCompatibilityRequest,
+) (resp *types.GetWorkerBuildIDCompatibilityResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendGetWorkerBuildIDCompatibilityScope, request)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(request); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if request.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.validateTaskList(request.TaskList, scope, request.GetDomain()); err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetMatchingClient().GetWorkerBuildIDCompatibility(ctx, &types.MatchingGetWorkerBuildIDCompatibilityRequest{
+		DomainUUID: domainID,
+		GetRequest: request,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
 func (wh *WorkflowHandler) getRawHistory(
 	ctx context.Context,
 	scope metrics.Scope,
@@ -3772,6 +3931,28 @@ func validateExecution(w *types.WorkflowExecution) error {
 	return nil
 }
 
+// validateWorkflowIDConflictPolicy validates the policy applied when the workflow ID is used by a running workflow,
+// which replaces the TerminateIfRunning WorkflowIDReusePolicy when set
+func validateWorkflowIDConflictPolicy(
+	conflictPolicy *types.WorkflowIDConflictPolicy,
+	reusePolicy types.WorkflowIDReusePolicy,
+) error {
+	if conflictPolicy == nil {
+		return nil
+	}
+	switch *conflictPolicy {
+	case types.WorkflowIDConflictPolicyFail,
+		types.WorkflowIDConflictPolicyUseExisting,
+		types.WorkflowIDConflictPolicyTerminateExisting:
+	default:
+		return errInvalidWorkflowIDConflictPolicy
+	}
+	if reusePolicy == types.WorkflowIDReusePolicyTerminateIfRunning {
+		return errConflictPolicyWithTerminateIfRunning
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 	ctx context.Context,
 	scope metrics.Scope,
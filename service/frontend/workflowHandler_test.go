@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
@@ -49,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
 const (
@@ -231,6 +233,23 @@ func (s *workflowHandlerSuite) TestPollForTask_Failed_ContextTimeoutTooShort() {
 	s.Equal(common.ErrContextTimeoutTooShort, err)
 }
 
+func (s *workflowHandlerSuite) TestPollForActivityTask_ShedPollNotRetried() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := s.getWorkflowHandler(config)
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	shedErr := &types.ServiceBusyError{Message: "Too many outstanding polls on task list.", RetryAfterMilliseconds: 1000}
+	s.mockResource.MatchingClient.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).Return(nil, shedErr).Times(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.MinLongPollTimeout+time.Second)
+	defer cancel()
+	_, err := wh.PollForActivityTask(ctx, &types.PollForActivityTaskRequest{
+		Domain:   s.testDomain,
+		TaskList: &types.TaskList{Name: "task-list"},
+	})
+	s.Equal(shedErr, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -291,6 +310,91 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadDelayStartSe
 	s.Equal(errInvalidDelayStartSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_BadWorkflowIDConflictPolicy() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     "test-domain",
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicy(100).Ptr(),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Equal(errInvalidWorkflowIDConflictPolicy, err)
+
+	startWorkflowExecutionRequest.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	startWorkflowExecutionRequest.WorkflowIDReusePolicy = types.WorkflowIDReusePolicyTerminateIfRunning.Ptr()
+	_, err = wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Equal(errConflictPolicyWithTerminateIfRunning, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_WorkflowIDConflictPolicyForwarded() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.HistoryStartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			wireRequest := thrift.ToHistoryStartWorkflowExecutionRequest(thrift.FromHistoryStartWorkflowExecutionRequest(request))
+			s.Equal(s.testDomainID, wireRequest.DomainUUID)
+			s.Equal(types.WorkflowIDConflictPolicyUseExisting.Ptr(), wireRequest.StartRequest.WorkflowIDConflictPolicy)
+			return &types.StartWorkflowExecutionResponse{RunID: testRunID}, nil
+		}).Times(1)
+
+	resp, err := NewThriftHandler(wh).StartWorkflowExecution(context.Background(), &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(s.testDomain),
+		WorkflowId:                          common.StringPtr(testWorkflowID),
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflow-type")},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr("task-list")},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+		WorkflowIdConflictPolicy:            shared.WorkflowIdConflictPolicyUseExisting.Ptr(),
+	})
+	s.NoError(err)
+	s.Equal(testRunID, resp.GetRunId())
+}
+
+func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution_WorkflowIDConflictPolicyForwarded() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			wireRequest := thrift.ToHistorySignalWithStartWorkflowExecutionRequest(thrift.FromHistorySignalWithStartWorkflowExecutionRequest(request))
+			s.Equal(s.testDomainID, wireRequest.DomainUUID)
+			s.Equal(types.WorkflowIDConflictPolicyTerminateExisting.Ptr(), wireRequest.SignalWithStartRequest.WorkflowIDConflictPolicy)
+			return &types.StartWorkflowExecutionResponse{RunID: testRunID}, nil
+		}).Times(1)
+
+	request := &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(s.testDomain),
+		WorkflowId:                          common.StringPtr(testWorkflowID),
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflow-type")},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr("task-list")},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+		SignalName:                          common.StringPtr("signal-name"),
+		WorkflowIdConflictPolicy:            shared.WorkflowIdConflictPolicyTerminateExisting.Ptr(),
+		WorkflowIdReusePolicy:               shared.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+	}
+	_, err := NewThriftHandler(wh).SignalWithStartWorkflowExecution(context.Background(), request)
+	s.Equal(thrift.FromError(errConflictPolicyWithTerminateIfRunning), err)
+
+	request.WorkflowIdReusePolicy = nil
+	resp, err := NewThriftHandler(wh).SignalWithStartWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal(testRunID, resp.GetRunId())
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -1452,6 +1556,71 @@ func (s *workflowHandlerSuite) TestSignalMetricHasSignalName() {
 	s.True(expectedMetrics["test.cadence_errors_bad_request"])
 }
 
+func (s *workflowHandlerSuite) TestExpediteWorkflowExecutionStart() {
+	ctx := context.Background()
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	workflowExecution := &types.WorkflowExecution{
+		WorkflowID: "workflow-id",
+		RunID:      "e4f90ec0-1313-45be-9877-8aa41f72a45b",
+	}
+
+	err := wh.ExpediteWorkflowExecutionStart(ctx, &types.ExpediteWorkflowExecutionStartRequest{
+		WorkflowExecution: workflowExecution,
+	})
+	s.Equal(errDomainNotSet, err)
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().ExpediteWorkflowExecutionStart(gomock.Any(), &types.HistoryExpediteWorkflowExecutionStartRequest{
+		DomainUUID: s.testDomainID,
+		ExpediteRequest: &types.ExpediteWorkflowExecutionStartRequest{
+			Domain:            s.testDomain,
+			WorkflowExecution: workflowExecution,
+		},
+	}).Return(nil).Times(1)
+	err = wh.ExpediteWorkflowExecutionStart(ctx, &types.ExpediteWorkflowExecutionStartRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: workflowExecution,
+	})
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestWorkerBuildIDCompatibility() {
+	ctx := context.Background()
+	taskList := &types.TaskList{Name: "test-task-list"}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).AnyTimes()
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	updateRequest := &types.UpdateWorkerBuildIDCompatibilityRequest{
+		Domain:                       s.testDomain,
+		TaskList:                     taskList,
+		AddNewBuildIDInNewDefaultSet: "1.0",
+	}
+	s.mockResource.MatchingClient.EXPECT().UpdateWorkerBuildIDCompatibility(ctx, &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+		DomainUUID:    s.testDomainID,
+		UpdateRequest: updateRequest,
+	}).Return(nil)
+	s.NoError(wh.UpdateWorkerBuildIDCompatibility(ctx, updateRequest))
+
+	err := wh.UpdateWorkerBuildIDCompatibility(ctx, &types.UpdateWorkerBuildIDCompatibilityRequest{
+		Domain:                       s.testDomain,
+		AddNewBuildIDInNewDefaultSet: "1.0",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	getRequest := &types.GetWorkerBuildIDCompatibilityRequest{
+		Domain:   s.testDomain,
+		TaskList: taskList,
+	}
+	sets := []*types.WorkerVersionSet{{BuildIDs: []string{"1.0"}}}
+	s.mockResource.MatchingClient.EXPECT().GetWorkerBuildIDCompatibility(ctx, &types.MatchingGetWorkerBuildIDCompatibilityRequest{
+		DomainUUID: s.testDomainID,
+		GetRequest: getRequest,
+	}).Return(&types.GetWorkerBuildIDCompatibilityResponse{VersionSets: sets}, nil)
+	resp, err := wh.GetWorkerBuildIDCompatibility(ctx, getRequest)
+	s.NoError(err)
+	s.Equal(sets, resp.VersionSets)
+}
+
 func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
 	return NewConfig(
 		dc.NewCollection(
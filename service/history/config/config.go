@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/shardplacement"
 	"github.com/uber/cadence/common/task"
 )
 
@@ -61,9 +62,10 @@ type Config struct {
 
 	// HistoryCache settings
 	// Change of these configs require shard restart
-	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
-	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
-	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	HistoryCacheInitialSize            dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSize                dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSizeInBytesPerShard dynamicconfig.IntPropertyFn
+	HistoryCacheTTL                    dynamicconfig.DurationPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
@@ -79,6 +81,14 @@ type Config struct {
 	RangeSizeBits           uint
 	AcquireShardInterval    dynamicconfig.DurationPropertyFn
 	AcquireShardConcurrency dynamicconfig.IntPropertyFn
+	// graceful shard handoff
+	EnableGracefulShardHandoff dynamicconfig.BoolPropertyFn
+	ShardHandoffTimeout        dynamicconfig.DurationPropertyFn
+	// load aware shard placement
+	ShardPlacementMode      dynamicconfig.StringPropertyFn
+	ShardLoadReportInterval dynamicconfig.DurationPropertyFn
+	ShardRebalanceInterval  dynamicconfig.DurationPropertyFn
+	ShardRebalanceMaxMoves  dynamicconfig.IntPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay                  dynamicconfig.DurationPropertyFn
@@ -193,6 +203,10 @@ type Config struct {
 	// System Limits
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithDomainFilter
+	// RequestDedupWindow and RequestDedupMaxRequestIDs bound the request IDs kept by a workflow to drop
+	// duplicated signal, requestCancel and terminate requests
+	RequestDedupWindow        dynamicconfig.DurationPropertyFnWithDomainFilter
+	RequestDedupMaxRequestIDs dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
@@ -234,6 +248,10 @@ type Config struct {
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// MemoNumberOfKeysLimit and MemoTotalSizeLimit bound the memo of a workflow after an upsert
+	MemoNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
+	MemoTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// Decision settings
 	// StickyTTL is to expire a sticky tasklist if no update more than this duration
 	// TODO https://github.com/uber/cadence/issues/2357
@@ -275,6 +293,7 @@ type Config struct {
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithDomainFilter
 	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithDomainFilter
 	MutableStateChecksumInvalidateBefore  dynamicconfig.FloatPropertyFn
+	MutableStateChecksumMismatchAction    dynamicconfig.StringPropertyFnWithDomainFilter
 
 	// Cross DC Replication configuration
 	ReplicationEventsFromCurrentCluster dynamicconfig.BoolPropertyFnWithDomainFilter
@@ -350,6 +369,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		EmitShardDiffLog:                     dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
+		HistoryCacheMaxSizeInBytesPerShard:   dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSizeInBytesPerShard, 0),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
 		EventsCacheInitialCount:              dc.GetIntProperty(dynamicconfig.EventsCacheInitialCount, 128),
 		EventsCacheMaxCount:                  dc.GetIntProperty(dynamicconfig.EventsCacheMaxCount, 512),
@@ -361,6 +381,12 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicconfig.AcquireShardConcurrency, 1),
+		EnableGracefulShardHandoff:           dc.GetBoolProperty(dynamicconfig.EnableGracefulShardHandoff, false),
+		ShardHandoffTimeout:                  dc.GetDurationProperty(dynamicconfig.ShardHandoffTimeout, 5*time.Second),
+		ShardPlacementMode:                   dc.GetStringProperty(dynamicconfig.HistoryShardPlacementMode, shardplacement.ModeHash),
+		ShardLoadReportInterval:              dc.GetDurationProperty(dynamicconfig.ShardLoadReportInterval, time.Minute),
+		ShardRebalanceInterval:               dc.GetDurationProperty(dynamicconfig.ShardRebalanceInterval, 5*time.Minute),
+		ShardRebalanceMaxMoves:               dc.GetIntProperty(dynamicconfig.ShardRebalanceMaxMoves, 4),
 		StandbyClusterDelay:                  dc.GetDurationProperty(dynamicconfig.StandbyClusterDelay, 5*time.Minute),
 		StandbyTaskMissingEventsResendDelay:  dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsResendDelay, 15*time.Minute),
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsDiscardDelay, 25*time.Minute),
@@ -465,6 +491,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		MaximumBufferedEventsBatch: dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
 		// 10K signals should big enough given workflow execution has 200K history lengh limit. It needs to be non-zero to protect continueAsNew from infinit loop
 		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaximumSignalsPerExecution, 10000),
+		RequestDedupWindow:              dc.GetDurationPropertyFilteredByDomain(dynamicconfig.RequestDedupWindow, 24*time.Hour),
+		RequestDedupMaxRequestIDs:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.RequestDedupMaxRequestIDs, 1000),
 		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
 		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
 		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
@@ -494,6 +522,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		SearchAttributesNumberOfKeysLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
 		SearchAttributesTotalSizeLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
+		MemoNumberOfKeysLimit:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.MemoNumberOfKeysLimit, 100),
+		MemoTotalSizeLimit:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.MemoTotalSizeLimit, 40*1024),
 		StickyTTL:                         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.StickyTTL, time.Hour*24*365),
 		DecisionHeartbeatTimeout:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.DecisionHeartbeatTimeout, time.Minute*30),
 		DecisionRetryCriticalAttempts:     dc.GetIntProperty(dynamicconfig.DecisionRetryCriticalAttempts, 10), // about 30m
@@ -524,6 +554,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumGenProbability, 0),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumVerifyProbability, 0),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore, 0),
+		MutableStateChecksumMismatchAction:    dc.GetStringPropertyFilteredByDomain(dynamicconfig.MutableStateChecksumMismatchAction, "log"),
 
 		ReplicationEventsFromCurrentCluster: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.ReplicationEventsFromCurrentCluster, false),
 
@@ -593,6 +593,51 @@ func (v *attrValidator) validateUpsertWorkflowSearchAttributes(
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
 }
 
+func (v *attrValidator) validateUpsertWorkflowMemo(
+	domainName string,
+	attributes *types.UpsertWorkflowMemoDecisionAttributes,
+	currentMemo map[string][]byte,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "UpsertWorkflowMemoDecisionAttributes is not set on decision."}
+	}
+
+	if attributes.Memo == nil {
+		return &types.BadRequestError{Message: "Memo is not set on decision."}
+	}
+
+	upsertMemo := attributes.GetMemo().GetFields()
+	if len(upsertMemo) == 0 {
+		return &types.BadRequestError{Message: "Memo fields are empty on decision."}
+	}
+
+	// limits apply to the memo after the upsert, as that is what gets persisted and indexed
+	numOfKeys, totalSize := 0, 0
+	for key, value := range upsertMemo {
+		numOfKeys++
+		totalSize += len(key) + len(value)
+	}
+	for key, value := range currentMemo {
+		if _, ok := upsertMemo[key]; !ok {
+			numOfKeys++
+			totalSize += len(key) + len(value)
+		}
+	}
+	if numOfKeys > v.config.MemoNumberOfKeysLimit(domainName) {
+		return &types.BadRequestError{
+			Message: fmt.Sprintf("number of memo keys %d exceeds limit", numOfKeys),
+		}
+	}
+	if totalSize > v.config.MemoTotalSizeLimit(domainName) {
+		return &types.BadRequestError{
+			Message: fmt.Sprintf("total size of memo %d exceeds limit", totalSize),
+		}
+	}
+
+	return nil
+}
+
 func (v *attrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes,
 	executionInfo *persistence.WorkflowExecutionInfo,
@@ -94,6 +94,8 @@ func (s *attrValidatorSuite) SetupTest() {
 		SearchAttributesNumberOfKeysLimit: dynamicconfig.GetIntPropertyFilteredByDomain(100),
 		SearchAttributesSizeOfValueLimit:  dynamicconfig.GetIntPropertyFilteredByDomain(2 * 1024),
 		SearchAttributesTotalSizeLimit:    dynamicconfig.GetIntPropertyFilteredByDomain(40 * 1024),
+		MemoNumberOfKeysLimit:             dynamicconfig.GetIntPropertyFilteredByDomain(2),
+		MemoTotalSizeLimit:                dynamicconfig.GetIntPropertyFilteredByDomain(16),
 		ActivityMaxScheduleToStartTimeoutForRetry: dynamicconfig.GetDurationPropertyFnFilteredByDomain(
 			time.Duration(s.testActivityMaxScheduleToStartTimeoutForRetryInSeconds) * time.Second,
 		),
@@ -175,6 +177,36 @@ func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *attrValidatorSuite) TestValidateUpsertWorkflowMemo() {
+	domainName := "testDomain"
+	var attributes *types.UpsertWorkflowMemoDecisionAttributes
+
+	err := s.validator.validateUpsertWorkflowMemo(domainName, attributes, nil)
+	s.EqualError(err, "BadRequestError{Message: UpsertWorkflowMemoDecisionAttributes is not set on decision.}")
+
+	attributes = &types.UpsertWorkflowMemoDecisionAttributes{}
+	err = s.validator.validateUpsertWorkflowMemo(domainName, attributes, nil)
+	s.EqualError(err, "BadRequestError{Message: Memo is not set on decision.}")
+
+	attributes.Memo = &types.Memo{}
+	err = s.validator.validateUpsertWorkflowMemo(domainName, attributes, nil)
+	s.EqualError(err, "BadRequestError{Message: Memo fields are empty on decision.}")
+
+	attributes.Memo.Fields = map[string][]byte{"status": []byte("running")}
+	err = s.validator.validateUpsertWorkflowMemo(domainName, attributes, nil)
+	s.Nil(err)
+
+	// overwriting an existing key does not count twice
+	err = s.validator.validateUpsertWorkflowMemo(domainName, attributes, map[string][]byte{"status": []byte("started"), "a": nil})
+	s.Nil(err)
+
+	err = s.validator.validateUpsertWorkflowMemo(domainName, attributes, map[string][]byte{"a": nil, "b": nil})
+	s.EqualError(err, "BadRequestError{Message: number of memo keys 3 exceeds limit}")
+
+	err = s.validator.validateUpsertWorkflowMemo(domainName, attributes, map[string][]byte{"owner": []byte("someone")})
+	s.EqualError(err, "BadRequestError{Message: total size of memo 25 exceeds limit}")
+}
+
 func (s *attrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: s.testDomainID},
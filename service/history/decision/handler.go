@@ -569,6 +569,7 @@ Update_History_Loop:
 					common.FailureReasonTransactionSizeExceedsLimit,
 					[]byte(updateErr.Error()),
 					execution.IdentityHistoryService,
+					"",
 				); err != nil {
 					return nil, err
 				}
@@ -201,6 +201,9 @@ func (handler *taskHandlerImpl) handleDecision(
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		return handler.handleDecisionUpsertWorkflowSearchAttributes(ctx, decision.UpsertWorkflowSearchAttributesDecisionAttributes)
 
+	case types.DecisionTypeUpsertWorkflowMemo:
+		return handler.handleDecisionUpsertWorkflowMemo(ctx, decision.UpsertWorkflowMemoDecisionAttributes)
+
 	default:
 		return &types.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", decision.GetDecisionType())}
 	}
@@ -628,7 +631,7 @@ func (handler *taskHandlerImpl) handleDecisionCancelWorkflow(
 		return nil
 	}
 
-	_, err := handler.mutableState.AddWorkflowExecutionCanceledEvent(handler.decisionTaskCompletedID, attr)
+	_, err := handler.mutableState.AddWorkflowExecutionCanceledEvent(handler.decisionTaskCompletedID, handler.decisionTaskCompletedID, attr)
 	return err
 }
 
@@ -965,6 +968,56 @@ func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowSearchAttributes(
 	return err
 }
 
+func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowMemo(
+	ctx context.Context,
+	attr *types.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeUpsertWorkflowMemoCounter,
+	)
+
+	// get domain name
+	executionInfo := handler.mutableState.GetExecutionInfo()
+	domainID := executionInfo.DomainID
+	domainName, err := handler.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return &types.InternalServiceError{
+			Message: fmt.Sprintf("Unable to get domain for domainID: %v.", domainID),
+		}
+	}
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateUpsertWorkflowMemo(
+				domainName,
+				attr,
+				executionInfo.Memo,
+			)
+		},
+		types.DecisionTaskFailedCauseBadUpsertWorkflowMemoAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	// blob size limit check
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		metrics.DecisionTypeTag(types.DecisionTypeUpsertWorkflowMemo.String()),
+		convertSearchAttributesToByteArray(attr.GetMemo().GetFields()),
+		"UpsertWorkflowMemoDecisionAttributes exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	_, err = handler.mutableState.AddUpsertWorkflowMemoEvent(
+		handler.decisionTaskCompletedID, attr,
+	)
+	return err
+}
+
 func convertSearchAttributesToByteArray(fields map[string][]byte) []byte {
 	result := make([]byte, 0)
 
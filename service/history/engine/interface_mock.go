@@ -361,6 +361,20 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
 
+// ExpediteWorkflowExecutionStart mocks base method
+func (m *MockEngine) ExpediteWorkflowExecutionStart(ctx context.Context, request *types.HistoryExpediteWorkflowExecutionStartRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpediteWorkflowExecutionStart", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpediteWorkflowExecutionStart indicates an expected call of ExpediteWorkflowExecutionStart
+func (mr *MockEngineMockRecorder) ExpediteWorkflowExecutionStart(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpediteWorkflowExecutionStart", reflect.TypeOf((*MockEngine)(nil).ExpediteWorkflowExecutionStart), ctx, request)
+}
+
 // ResetWorkflowExecution mocks base method
 func (m *MockEngine) ResetWorkflowExecution(ctx context.Context, request *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -656,3 +670,31 @@ func (mr *MockEngineMockRecorder) NotifyNewTimerTasks(executionInfo, tasks inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTimerTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewTimerTasks), executionInfo, tasks)
 }
+
+// GetExecutionCacheSizeByDomain mocks base method
+func (m *MockEngine) GetExecutionCacheSizeByDomain() map[string]uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutionCacheSizeByDomain")
+	ret0, _ := ret[0].(map[string]uint64)
+	return ret0
+}
+
+// GetExecutionCacheSizeByDomain indicates an expected call of GetExecutionCacheSizeByDomain
+func (mr *MockEngineMockRecorder) GetExecutionCacheSizeByDomain() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionCacheSizeByDomain", reflect.TypeOf((*MockEngine)(nil).GetExecutionCacheSizeByDomain))
+}
+
+// GetExecutionCacheSize mocks base method
+func (m *MockEngine) GetExecutionCacheSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutionCacheSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetExecutionCacheSize indicates an expected call of GetExecutionCacheSize
+func (mr *MockEngineMockRecorder) GetExecutionCacheSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionCacheSize", reflect.TypeOf((*MockEngine)(nil).GetExecutionCacheSize))
+}
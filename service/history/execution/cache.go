@@ -64,9 +64,13 @@ var (
 const (
 	cacheNotReleased int32 = 0
 	cacheReleased    int32 = 1
+
+	// contextSizeOverhead is the estimated size of a workflow execution context without its mutable state,
+	// so that the contexts which are not loaded are bounded as well
+	contextSizeOverhead = 1024
 )
 
-// NewCache creates a new workflow execution context cache
+// NewCache creates a new workflow execution context cache of a shard, the count and size limits apply to each shard
 func NewCache(shard shard.Context) *Cache {
 	opts := &cache.Options{}
 	config := shard.GetConfig()
@@ -74,15 +78,50 @@ func NewCache(shard shard.Context) *Cache {
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
 	opts.MaxCount = config.HistoryCacheMaxSize()
+	if maxSize := config.HistoryCacheMaxSizeInBytesPerShard(); maxSize > 0 {
+		opts.MaxSize = uint64(maxSize)
+		opts.GetCacheItemSizeFunc = getContextSize
+	}
 
-	return &Cache{
-		Cache:            cache.New(opts),
+	c := &Cache{
 		shard:            shard,
 		executionManager: shard.GetExecutionManager(),
 		logger:           shard.GetLogger().WithTags(tag.ComponentHistoryCache),
 		metricsClient:    shard.GetMetricsClient(),
 		config:           config,
 	}
+	opts.EvictedFunc = c.onEvicted
+	c.Cache = cache.New(opts)
+	return c
+}
+
+// GetSizeByDomain returns the estimated size in bytes of the workflow execution contexts in the cache by domain ID
+func (c *Cache) GetSizeByDomain() map[string]uint64 {
+	sizes := make(map[string]uint64)
+	it := c.Iterator()
+	defer it.Close()
+	for it.HasNext() {
+		context := it.Next().Value().(Context)
+		sizes[context.GetDomainID()] += getContextSize(context)
+	}
+	return sizes
+}
+
+func (c *Cache) onEvicted(value interface{}, reason cache.EvictionReason) {
+	context := value.(Context)
+	scope := c.metricsClient.Scope(metrics.HistoryCacheSizeStatsScope, metrics.DomainTag(context.GetDomainName()))
+	switch reason {
+	case cache.EvictionReasonExpired:
+		scope.IncCounter(metrics.CacheEvictedExpiredCounter)
+	case cache.EvictionReasonMaxCount:
+		scope.IncCounter(metrics.CacheEvictedMaxCountCounter)
+	case cache.EvictionReasonMaxSize:
+		scope.IncCounter(metrics.CacheEvictedMaxSizeCounter)
+	}
+}
+
+func getContextSize(value interface{}) uint64 {
+	return uint64(contextSizeOverhead + value.(Context).GetMutableStateSize())
 }
 
 // GetOrCreateCurrentWorkflowExecution gets or creates workflow execution context for the current run
@@ -76,6 +76,7 @@ func (s *historyCacheSuite) SetupTest() {
 	)
 
 	s.mockShard.Resource.ClusterMetadata.EXPECT().IsGlobalDomainEnabled().Return(false).AnyTimes()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain", nil).AnyTimes()
 }
 
 func (s *historyCacheSuite) TearDownTest() {
@@ -146,6 +147,67 @@ func (s *historyCacheSuite) TestHistoryCachePinning() {
 	release(err4)
 }
 
+func (s *historyCacheSuite) TestHistoryCacheSizeBased() {
+	s.mockShard.GetConfig().HistoryCacheMaxSizeInBytesPerShard = dynamicconfig.GetIntPropertyFn(2*contextSizeOverhead + 1000)
+	s.cache = NewCache(s.mockShard)
+
+	execution1 := types.WorkflowExecution{WorkflowID: "wf-cache-test-size-1", RunID: uuid.New()}
+	context, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground("domain-1", execution1)
+	s.NoError(err)
+	context.(*contextImpl).estimatedSize = 600
+	release(nil)
+
+	execution2 := types.WorkflowExecution{WorkflowID: "wf-cache-test-size-2", RunID: uuid.New()}
+	context, release, err = s.cache.GetOrCreateWorkflowExecutionForBackground("domain-2", execution2)
+	s.NoError(err)
+	s.Equal(map[string]uint64{
+		"domain-1": contextSizeOverhead + 600,
+		"domain-2": contextSizeOverhead,
+	}, s.cache.GetSizeByDomain())
+
+	// the mutable state grows when it is loaded, the least recently used context is evicted when it is released
+	context.(*contextImpl).estimatedSize = 800
+	release(nil)
+	s.Equal(1, s.cache.Size())
+	s.Equal(map[string]uint64{
+		"domain-2": contextSizeOverhead + 800,
+	}, s.cache.GetSizeByDomain())
+}
+
+func (s *historyCacheSuite) TestHistoryCacheSizeBased_EvictOnAdd() {
+	s.mockShard.GetConfig().HistoryCacheMaxSizeInBytesPerShard = dynamicconfig.GetIntPropertyFn(2*contextSizeOverhead + 1000)
+	s.cache = NewCache(s.mockShard)
+
+	execution1 := types.WorkflowExecution{WorkflowID: "wf-cache-test-size-1", RunID: uuid.New()}
+	context1, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground("domain-1", execution1)
+	s.NoError(err)
+	context1.(*contextImpl).estimatedSize = 600
+	release(nil)
+
+	execution2 := types.WorkflowExecution{WorkflowID: "wf-cache-test-size-2", RunID: uuid.New()}
+	_, release, err = s.cache.GetOrCreateWorkflowExecutionForBackground("domain-2", execution2)
+	s.NoError(err)
+	release(nil)
+	s.Equal(2, s.cache.Size())
+
+	// adding a context beyond the byte limit evicts the least recently used one
+	execution3 := types.WorkflowExecution{WorkflowID: "wf-cache-test-size-3", RunID: uuid.New()}
+	_, release, err = s.cache.GetOrCreateWorkflowExecutionForBackground("domain-3", execution3)
+	s.NoError(err)
+	release(nil)
+	s.Equal(2, s.cache.Size())
+	s.Equal(map[string]uint64{
+		"domain-2": contextSizeOverhead,
+		"domain-3": contextSizeOverhead,
+	}, s.cache.GetSizeByDomain())
+
+	// the evicted context is loaded again
+	context, release, err := s.cache.GetOrCreateWorkflowExecutionForBackground("domain-1", execution1)
+	s.NoError(err)
+	s.False(context1 == context)
+	release(nil)
+}
+
 func (s *historyCacheSuite) TestHistoryCacheClear() {
 	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
 	domainID := "test_domain_id"
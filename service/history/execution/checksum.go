@@ -31,6 +31,9 @@ import (
 
 const (
 	mutableStateChecksumPayloadV1 = 1
+
+	checksumMismatchActionRepair     = "repair"
+	checksumMismatchActionQuarantine = "quarantine"
 )
 
 func generateMutableStateChecksum(ms MutableState) (checksum.Checksum, error) {
@@ -53,6 +56,14 @@ func verifyMutableStateChecksum(
 	return checksum.Verify(payload, csum)
 }
 
+// equalMutableStateChecksumPayloads returns true if the checksums of the mutable states are the same
+func equalMutableStateChecksumPayloads(
+	ms1 MutableState,
+	ms2 MutableState,
+) bool {
+	return newMutableStateChecksumPayload(ms1).Equals(newMutableStateChecksumPayload(ms2))
+}
+
 func newMutableStateChecksumPayload(ms MutableState) *checksumgen.MutableStateChecksumPayload {
 	executionInfo := ms.GetExecutionInfo()
 	payload := &checksumgen.MutableStateChecksumPayload{
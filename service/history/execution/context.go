@@ -27,15 +27,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
+	"github.com/pborman/uuid"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/locks"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quarantine"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/history/shard"
@@ -48,6 +54,9 @@ const (
 var (
 	// ErrConflict is exported temporarily for integration test
 	ErrConflict = errors.New("conditional update failed")
+	// ErrWorkflowQuarantined is the error indicating the workflow execution is quarantined until an operator releases it,
+	// it is not retryable and the tasks of the workflow execution are dropped until they are regenerated on release
+	ErrWorkflowQuarantined = &types.InternalDataInconsistencyError{Message: "workflow execution is quarantined"}
 )
 
 type (
@@ -69,6 +78,7 @@ type (
 
 		GetHistorySize() int64
 		SetHistorySize(size int64)
+		GetMutableStateSize() int64
 
 		ReapplyEvents(
 			eventBatches []*persistence.WorkflowEvents,
@@ -144,10 +154,13 @@ type (
 		logger            log.Logger
 		metricsClient     metrics.Client
 
-		mutex           locks.Mutex
-		mutableState    MutableState
-		stats           *persistence.ExecutionStats
-		updateCondition int64
+		mutex            locks.Mutex
+		mutableState     MutableState
+		stats            *persistence.ExecutionStats
+		updateCondition  int64
+		mutableStateSize mutableStateSize
+		// estimatedSize is read without holding the mutex by the history cache
+		estimatedSize int64
 	}
 )
 
@@ -189,6 +202,8 @@ func (c *contextImpl) Clear() {
 	c.stats = &persistence.ExecutionStats{
 		HistorySize: 0,
 	}
+	c.mutableStateSize = mutableStateSize{}
+	atomic.StoreInt64(&c.estimatedSize, 0)
 }
 
 func (c *contextImpl) GetDomainID() string {
@@ -215,6 +230,15 @@ func (c *contextImpl) SetHistorySize(size int64) {
 	c.stats.HistorySize = size
 }
 
+// GetMutableStateSize returns the estimated size in bytes of the loaded mutable state
+func (c *contextImpl) GetMutableStateSize() int64 {
+	return atomic.LoadInt64(&c.estimatedSize)
+}
+
+func (c *contextImpl) updateMutableStateSize() {
+	atomic.StoreInt64(&c.estimatedSize, c.mutableStateSize.estimate(c.mutableState))
+}
+
 func (c *contextImpl) LoadExecutionStats(
 	ctx context.Context,
 ) (*persistence.ExecutionStats, error) {
@@ -236,32 +260,9 @@ func (c *contextImpl) LoadWorkflowExecutionForReplication(
 	}
 
 	if c.mutableState == nil {
-		response, err := c.getWorkflowExecutionWithRetry(ctx, &persistence.GetWorkflowExecutionRequest{
-			DomainID:  c.domainID,
-			Execution: c.workflowExecution,
-		})
-		if err != nil {
+		if err := c.loadMutableState(ctx, domainEntry); err != nil {
 			return nil, err
 		}
-
-		c.mutableState = NewMutableStateBuilder(
-			c.shard,
-			c.logger,
-			domainEntry,
-		)
-
-		c.mutableState.Load(response.State)
-
-		c.stats = response.State.ExecutionStats
-		c.updateCondition = response.State.ExecutionInfo.NextEventID
-
-		// finally emit execution and session stats
-		emitWorkflowExecutionStats(
-			c.metricsClient,
-			c.GetDomainName(),
-			response.MutableStateStats,
-			c.stats.HistorySize,
-		)
 	}
 
 	lastWriteVersion, err := c.mutableState.GetLastWriteVersion()
@@ -323,32 +324,9 @@ func (c *contextImpl) LoadWorkflowExecution(
 	}
 
 	if c.mutableState == nil {
-		response, err := c.getWorkflowExecutionWithRetry(ctx, &persistence.GetWorkflowExecutionRequest{
-			DomainID:  c.domainID,
-			Execution: c.workflowExecution,
-		})
-		if err != nil {
+		if err := c.loadMutableState(ctx, domainEntry); err != nil {
 			return nil, err
 		}
-
-		c.mutableState = NewMutableStateBuilder(
-			c.shard,
-			c.logger,
-			domainEntry,
-		)
-
-		c.mutableState.Load(response.State)
-
-		c.stats = response.State.ExecutionStats
-		c.updateCondition = response.State.ExecutionInfo.NextEventID
-
-		// finally emit execution and session stats
-		emitWorkflowExecutionStats(
-			c.metricsClient,
-			c.GetDomainName(),
-			response.MutableStateStats,
-			c.stats.HistorySize,
-		)
 	}
 
 	flushBeforeReady, err := c.mutableState.StartTransaction(domainEntry)
@@ -379,6 +357,266 @@ func (c *contextImpl) LoadWorkflowExecution(
 	return c.mutableState, nil
 }
 
+// loadMutableState loads the mutable state from the database, a mismatch of
+// its checksum is handled according to the configured action
+func (c *contextImpl) loadMutableState(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+) error {
+
+	entry := c.shard.GetService().GetWorkflowQuarantine().GetEntry(c.getQuarantineExecution())
+	if entry.IsQuarantined() {
+		c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.WorkflowQuarantinedCounter)
+		return ErrWorkflowQuarantined
+	}
+
+	request := &persistence.GetWorkflowExecutionRequest{
+		DomainID:  c.domainID,
+		Execution: c.workflowExecution,
+	}
+	response, err := c.getWorkflowExecutionWithRetry(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	mutableState := NewMutableStateBuilder(
+		c.shard,
+		c.logger,
+		domainEntry,
+	)
+	if mismatch := mutableState.Load(response.State); mismatch != nil {
+		repaired, err := c.resolveChecksumMismatch(ctx, domainEntry, mutableState, response.State, entry, mismatch)
+		if err != nil {
+			return err
+		}
+		if repaired {
+			// load the mutable state persisted by the repair
+			response, err = c.getWorkflowExecutionWithRetry(ctx, request)
+			if err != nil {
+				return err
+			}
+			mutableState = NewMutableStateBuilder(
+				c.shard,
+				c.logger,
+				domainEntry,
+			)
+			if err := mutableState.Load(response.State); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.mutableState = mutableState
+	c.stats = response.State.ExecutionStats
+	c.updateCondition = response.State.ExecutionInfo.NextEventID
+	c.mutableStateSize.loaded(response.MutableStateStats)
+	c.updateMutableStateSize()
+
+	// finally emit execution and session stats
+	emitWorkflowExecutionStats(
+		c.metricsClient,
+		c.GetDomainName(),
+		response.MutableStateStats,
+		c.stats.HistorySize,
+	)
+	return nil
+}
+
+// resolveChecksumMismatch rebuilds the mutable state from history to tell whether the checksum or the
+// persisted mutable state is wrong, it returns true if the rebuilt mutable state replaced the persisted one
+func (c *contextImpl) resolveChecksumMismatch(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	mutableState MutableState,
+	state *persistence.WorkflowMutableState,
+	entry *quarantine.Entry,
+	mismatch error,
+) (bool, error) {
+
+	// a release applies to the mutable state persisted before it,
+	// a mismatch after the mutable state is updated is a new one
+	released := entry != nil && entry.Released &&
+		!state.ExecutionInfo.LastUpdatedTimestamp.After(entry.ReleasedAt)
+	if released && !entry.Repair {
+		c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumReleased)
+		return false, nil
+	}
+	action := c.shard.GetConfig().MutableStateChecksumMismatchAction(domainEntry.GetInfo().Name)
+	if !released && action != checksumMismatchActionRepair && action != checksumMismatchActionQuarantine {
+		return false, nil
+	}
+
+	rebuiltMutableState, rebuiltHistorySize, err := c.rebuildMutableState(ctx, mutableState)
+	if err != nil {
+		if released {
+			return false, err
+		}
+		return false, c.quarantine(ctx, fmt.Sprintf("%v, failed to rebuild mutable state: %v", mismatch, err))
+	}
+
+	switch {
+	case released:
+		// the operator requested the repair
+	case equalMutableStateChecksumPayloads(rebuiltMutableState, mutableState):
+		// history agrees with the persisted mutable state, the checksum is
+		// wrong and is replaced by the next update of the mutable state
+		c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumFalsePositive)
+		return false, nil
+	case action == checksumMismatchActionRepair && verifyMutableStateChecksum(rebuiltMutableState, state.Checksum) == nil:
+		// history agrees with the checksum, the persisted mutable state is corrupted
+	default:
+		return false, c.quarantine(ctx, mismatch.Error())
+	}
+
+	if err := c.persistRebuiltMutableState(
+		ctx,
+		rebuiltMutableState,
+		rebuiltHistorySize,
+		state.ExecutionInfo.NextEventID,
+	); err != nil {
+		return false, err
+	}
+	c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumRepaired)
+	c.logger.Warn("Mutable state rebuilt from history after checksum mismatch.",
+		tag.WorkflowDomainID(c.domainID),
+		tag.WorkflowID(c.workflowExecution.GetWorkflowID()),
+		tag.WorkflowRunID(c.workflowExecution.GetRunID()),
+		tag.Error(mismatch),
+	)
+	return true, nil
+}
+
+func (c *contextImpl) rebuildMutableState(
+	ctx context.Context,
+	mutableState MutableState,
+) (MutableState, int64, error) {
+
+	if mutableState.HasBufferedEvents() {
+		return nil, 0, &types.InternalServiceError{Message: "buffered events are not in history"}
+	}
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		return nil, 0, ErrMissingVersionHistories
+	}
+	currentVersionHistory, err := versionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return nil, 0, err
+	}
+	lastItem, err := currentVersionHistory.GetLastItem()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	workflowIdentifier := definition.NewWorkflowIdentifier(
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+	)
+	rebuiltMutableState, rebuiltHistorySize, err := NewStateRebuilder(c.shard, c.logger).Rebuild(
+		ctx,
+		executionInfo.StartTimestamp,
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		lastItem.GetEventID(),
+		lastItem.GetVersion(),
+		workflowIdentifier,
+		currentVersionHistory.GetBranchToken(),
+		uuid.New(),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// keep the other branches of the version histories if history agrees with the current one
+	rebuiltVersionHistory, err := rebuiltMutableState.GetVersionHistories().GetCurrentVersionHistory()
+	if err != nil {
+		return nil, 0, err
+	}
+	if rebuiltVersionHistory.Equals(currentVersionHistory) {
+		if err := rebuiltMutableState.SetVersionHistories(versionHistories.Duplicate()); err != nil {
+			return nil, 0, err
+		}
+	}
+	// sticky task list and client info are not recorded in history
+	rebuiltExecutionInfo := rebuiltMutableState.GetExecutionInfo()
+	rebuiltExecutionInfo.StickyTaskList = executionInfo.StickyTaskList
+	rebuiltExecutionInfo.StickyScheduleToStartTimeout = executionInfo.StickyScheduleToStartTimeout
+	rebuiltExecutionInfo.ClientLibraryVersion = executionInfo.ClientLibraryVersion
+	rebuiltExecutionInfo.ClientFeatureVersion = executionInfo.ClientFeatureVersion
+	rebuiltExecutionInfo.ClientImpl = executionInfo.ClientImpl
+	return rebuiltMutableState, rebuiltHistorySize, nil
+}
+
+func (c *contextImpl) persistRebuiltMutableState(
+	ctx context.Context,
+	rebuiltMutableState MutableState,
+	rebuiltHistorySize int64,
+	updateCondition int64,
+) error {
+
+	resp, err := c.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		DomainID:   c.domainID,
+		WorkflowID: c.workflowExecution.GetWorkflowID(),
+	})
+	if err != nil {
+		return err
+	}
+	conflictResolveMode := persistence.ConflictResolveWorkflowModeBypassCurrent
+	if resp.RunID == c.workflowExecution.GetRunID() {
+		conflictResolveMode = persistence.ConflictResolveWorkflowModeUpdateCurrent
+	}
+
+	rebuiltMutableState.SetUpdateCondition(updateCondition)
+	c.Clear()
+	c.SetHistorySize(rebuiltHistorySize)
+	if err := c.ConflictResolveWorkflowExecution(
+		ctx,
+		c.shard.GetTimeSource().Now(),
+		conflictResolveMode,
+		rebuiltMutableState,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	); err != nil {
+		return err
+	}
+	c.Clear()
+	return nil
+}
+
+func (c *contextImpl) quarantine(
+	ctx context.Context,
+	details string,
+) error {
+
+	if err := c.shard.GetService().GetWorkflowQuarantine().Quarantine(ctx, &quarantine.Entry{
+		Execution: c.getQuarantineExecution(),
+		ShardID:   c.shard.GetShardID(),
+		Details:   details,
+	}); err != nil {
+		return err
+	}
+	c.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumQuarantined)
+	c.logger.Error("Workflow execution quarantined after mutable state checksum mismatch.",
+		tag.WorkflowDomainID(c.domainID),
+		tag.WorkflowID(c.workflowExecution.GetWorkflowID()),
+		tag.WorkflowRunID(c.workflowExecution.GetRunID()),
+		tag.Value(details),
+	)
+	return ErrWorkflowQuarantined
+}
+
+func (c *contextImpl) getQuarantineExecution() quarantine.Execution {
+	return quarantine.Execution{
+		DomainID:   c.domainID,
+		WorkflowID: c.workflowExecution.GetWorkflowID(),
+		RunID:      c.workflowExecution.GetRunID(),
+	}
+}
+
 func (c *contextImpl) CreateWorkflowExecution(
 	ctx context.Context,
 	newWorkflow *persistence.WorkflowSnapshot,
@@ -419,6 +657,8 @@ func (c *contextImpl) CreateWorkflowExecution(
 	}
 
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
+	c.mutableStateSize.updated(resp.MutableStateUpdateSessionStats)
+	c.updateMutableStateSize()
 
 	// finally emit session stats
 	domainName := c.GetDomainName()
@@ -577,6 +817,8 @@ func (c *contextImpl) ConflictResolveWorkflowExecution(
 	c.notifyTasksFromWorkflowSnapshot(resetWorkflow)
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
 	c.notifyTasksFromWorkflowMutation(currentWorkflow)
+	c.mutableStateSize.updated(resp.MutableStateUpdateSessionStats)
+	c.updateMutableStateSize()
 
 	// finally emit session stats
 	domainName := c.GetDomainName()
@@ -805,6 +1047,8 @@ func (c *contextImpl) UpdateWorkflowExecutionWithNew(
 
 	// notify new workflow tasks
 	c.notifyTasksFromWorkflowSnapshot(newWorkflow)
+	c.mutableStateSize.updated(resp.MutableStateUpdateSessionStats)
+	c.updateMutableStateSize()
 
 	// finally emit session stats
 	domainName := c.GetDomainName()
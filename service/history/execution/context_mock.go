@@ -225,6 +225,20 @@ func (mr *MockContextMockRecorder) GetHistorySize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistorySize", reflect.TypeOf((*MockContext)(nil).GetHistorySize))
 }
 
+// GetMutableStateSize mocks base method
+func (m *MockContext) GetMutableStateSize() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutableStateSize")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetMutableStateSize indicates an expected call of GetMutableStateSize
+func (mr *MockContextMockRecorder) GetMutableStateSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableStateSize", reflect.TypeOf((*MockContext)(nil).GetMutableStateSize))
+}
+
 // SetHistorySize mocks base method
 func (m *MockContext) SetHistorySize(size int64) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,173 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quarantine"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	contextSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller   *gomock.Controller
+		mockShard    *shard.TestContext
+		mockRegistry *quarantine.MockRegistry
+
+		execution types.WorkflowExecution
+		context   Context
+	}
+)
+
+func TestContextSuite(t *testing.T) {
+	s := new(contextSuite)
+	suite.Run(t, s)
+}
+
+func (s *contextSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewTestContext(
+		s.controller,
+		&persistence.ShardInfo{
+			ShardID: 0,
+			RangeID: 1,
+		},
+		config.NewForTest(),
+	)
+	s.mockShard.GetConfig().MutableStateChecksumVerifyProbability = func(domain string) int { return 100 }
+	s.mockShard.GetConfig().MutableStateChecksumMismatchAction = func(domain string) string { return checksumMismatchActionQuarantine }
+	s.mockRegistry = quarantine.NewMockRegistry(s.controller)
+	s.mockShard.Resource.WorkflowQuarantine = s.mockRegistry
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestLocalDomainEntry, nil).AnyTimes()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(constants.TestDomainID).Return(constants.TestDomainName, nil).AnyTimes()
+
+	s.execution = types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	s.context = NewContext(
+		constants.TestDomainID,
+		s.execution,
+		s.mockShard,
+		s.mockShard.Resource.ExecutionMgr,
+		loggerimpl.NewNopLogger(),
+	)
+}
+
+func (s *contextSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockShard.Finish(s.T())
+}
+
+func (s *contextSuite) TestLoadWorkflowExecution_Quarantined() {
+	s.mockRegistry.EXPECT().GetEntry(s.quarantineExecution()).Return(&quarantine.Entry{Execution: s.quarantineExecution()}).Times(1)
+
+	_, err := s.context.LoadWorkflowExecution(context.Background())
+	s.Equal(ErrWorkflowQuarantined, err)
+}
+
+func (s *contextSuite) TestLoadWorkflowExecution_ChecksumMismatch_Quarantine() {
+	state := s.newMutableStateWithMismatchedChecksum()
+	// buffered events are not in history, so the mutable state cannot be rebuilt
+	state.BufferedEvents = []*types.HistoryEvent{{EventID: common.BufferedEventID}}
+	s.mockRegistry.EXPECT().GetEntry(s.quarantineExecution()).Return(nil).Times(1)
+	s.mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil).Once()
+	s.mockRegistry.EXPECT().Quarantine(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, entry *quarantine.Entry) error {
+			s.Equal(s.quarantineExecution(), entry.Execution)
+			s.NotEmpty(entry.Details)
+			return nil
+		},
+	).Times(1)
+
+	_, err := s.context.LoadWorkflowExecution(context.Background())
+	s.Equal(ErrWorkflowQuarantined, err)
+}
+
+func (s *contextSuite) TestLoadWorkflowExecution_ChecksumMismatch_Released() {
+	state := s.newMutableStateWithMismatchedChecksum()
+	s.mockRegistry.EXPECT().GetEntry(s.quarantineExecution()).Return(&quarantine.Entry{
+		Execution:  s.quarantineExecution(),
+		Released:   true,
+		ReleasedAt: state.ExecutionInfo.LastUpdatedTimestamp.Add(time.Minute),
+	}).Times(1)
+	s.mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil).Once()
+
+	// the operator accepted the persisted mutable state
+	mutableState, err := s.context.LoadWorkflowExecution(context.Background())
+	s.NoError(err)
+	s.Equal(state.ExecutionInfo.NextEventID, mutableState.GetNextEventID())
+}
+
+func (s *contextSuite) quarantineExecution() quarantine.Execution {
+	return quarantine.Execution{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: s.execution.GetWorkflowID(),
+		RunID:      s.execution.GetRunID(),
+	}
+}
+
+func (s *contextSuite) newMutableStateWithMismatchedChecksum() *persistence.WorkflowMutableState {
+	return &persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:             constants.TestDomainID,
+			WorkflowID:           s.execution.GetWorkflowID(),
+			RunID:                s.execution.GetRunID(),
+			State:                persistence.WorkflowStateRunning,
+			CloseStatus:          persistence.WorkflowCloseStatusNone,
+			NextEventID:          int64(3),
+			LastProcessedEvent:   common.EmptyEventID,
+			LastUpdatedTimestamp: time.Now(),
+			DecisionScheduleID:   common.EmptyEventID,
+			DecisionStartedID:    common.EmptyEventID,
+		},
+		ExecutionStats: &persistence.ExecutionStats{},
+		VersionHistories: persistence.NewVersionHistories(persistence.NewVersionHistory(
+			[]byte("branch token"),
+			[]*persistence.VersionHistoryItem{persistence.NewVersionHistoryItem(2, common.EmptyVersion)},
+		)),
+		Checksum: checksum.Checksum{
+			Version: mutableStateChecksumPayloadV1,
+			Flavor:  checksum.FlavorIEEECRC32OverThriftBinary,
+			Value:   []byte{1, 2, 3, 4},
+		},
+	}
+}
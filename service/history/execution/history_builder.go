@@ -235,8 +235,9 @@ func (b *HistoryBuilder) AddWorkflowExecutionTerminatedEvent(
 	reason string,
 	details []byte,
 	identity string,
+	requestID string,
 ) *types.HistoryEvent {
-	event := b.newWorkflowExecutionTerminatedEvent(reason, details, identity)
+	event := b.newWorkflowExecutionTerminatedEvent(reason, details, identity, requestID)
 	return b.addEventToHistory(event)
 }
 
@@ -416,6 +417,15 @@ func (b *HistoryBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddUpsertWorkflowMemoEvent adds UpsertWorkflowMemo event to history
+func (b *HistoryBuilder) AddUpsertWorkflowMemoEvent(
+	DecisionTaskCompletedEventID int64,
+	attributes *types.UpsertWorkflowMemoDecisionAttributes) *types.HistoryEvent {
+	event := b.newUpsertWorkflowMemoEvent(DecisionTaskCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 // AddSignalExternalWorkflowExecutionFailedEvent adds SignalExternalWorkflowExecutionFailed event to history
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(DecisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
@@ -444,8 +454,8 @@ func (b *HistoryBuilder) AddMarkerRecordedEvent(decisionCompletedEventID int64,
 
 // AddWorkflowExecutionSignaledEvent adds WorkflowExecutionSignaled event to history
 func (b *HistoryBuilder) AddWorkflowExecutionSignaledEvent(
-	signalName string, input []byte, identity string) *types.HistoryEvent {
-	event := b.newWorkflowExecutionSignaledEvent(signalName, input, identity)
+	signalName string, input []byte, identity string, requestID string) *types.HistoryEvent {
+	event := b.newWorkflowExecutionSignaledEvent(signalName, input, identity, requestID)
 
 	return b.addEventToHistory(event)
 }
@@ -573,6 +583,11 @@ func (b *HistoryBuilder) newWorkflowExecutionStartedEvent(
 		OriginalExecutionRunID:              originalRunID,
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
+		Priority:                            request.Priority,
+		FairnessKey:                         request.FairnessKey,
+		IsolationGroup:                      request.IsolationGroup,
+		RetryElapsedExpirationTimestamp:     startRequest.RetryElapsedExpirationTimestamp,
+		DelayStartSeconds:                   request.DelayStartSeconds,
 	}
 	if parentInfo := startRequest.ParentExecutionInfo; parentInfo != nil {
 		attributes.ParentWorkflowDomainID = &parentInfo.DomainUUID
@@ -673,6 +688,8 @@ func (b *HistoryBuilder) newActivityTaskScheduledEvent(DecisionTaskCompletedEven
 	attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(common.Int32Default(scheduleAttributes.HeartbeatTimeoutSeconds))
 	attributes.DecisionTaskCompletedEventID = DecisionTaskCompletedEventID
 	attributes.RetryPolicy = scheduleAttributes.RetryPolicy
+	attributes.Priority = scheduleAttributes.Priority
+	attributes.FairnessKey = scheduleAttributes.FairnessKey
 	if scheduleAttributes.Domain != "" {
 		// for backward compatibility
 		// old releases will encounter issues if Domain field is a pointer to an empty string.
@@ -785,24 +802,26 @@ func (b *HistoryBuilder) newTimeoutWorkflowExecutionEvent() *types.HistoryEvent
 }
 
 func (b *HistoryBuilder) newWorkflowExecutionSignaledEvent(
-	signalName string, input []byte, identity string) *types.HistoryEvent {
+	signalName string, input []byte, identity string, requestID string) *types.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionSignaled)
 	attributes := &types.WorkflowExecutionSignaledEventAttributes{}
 	attributes.SignalName = signalName
 	attributes.Input = input
 	attributes.Identity = identity
+	attributes.RequestID = requestID
 	historyEvent.WorkflowExecutionSignaledEventAttributes = attributes
 
 	return historyEvent
 }
 
 func (b *HistoryBuilder) newWorkflowExecutionTerminatedEvent(
-	reason string, details []byte, identity string) *types.HistoryEvent {
+	reason string, details []byte, identity string, requestID string) *types.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionTerminated)
 	attributes := &types.WorkflowExecutionTerminatedEventAttributes{}
 	attributes.Reason = reason
 	attributes.Details = details
 	attributes.Identity = identity
+	attributes.RequestID = requestID
 	historyEvent.WorkflowExecutionTerminatedEventAttributes = attributes
 
 	return historyEvent
@@ -827,6 +846,7 @@ func (b *HistoryBuilder) newWorkflowExecutionCancelRequestedEvent(cause string,
 	attributes := &types.WorkflowExecutionCancelRequestedEventAttributes{}
 	attributes.Cause = cause
 	attributes.Identity = request.CancelRequest.Identity
+	attributes.RequestID = request.CancelRequest.GetRequestID()
 	if request.ExternalInitiatedEventID != nil {
 		attributes.ExternalInitiatedEventID = common.Int64Ptr(*request.ExternalInitiatedEventID)
 	}
@@ -928,6 +948,17 @@ func (b *HistoryBuilder) newUpsertWorkflowSearchAttributesEvent(decisionTaskComp
 	return event
 }
 
+func (b *HistoryBuilder) newUpsertWorkflowMemoEvent(decisionTaskCompletedEventID int64,
+	request *types.UpsertWorkflowMemoDecisionAttributes) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeUpsertWorkflowMemo)
+	attributes := &types.UpsertWorkflowMemoEventAttributes{}
+	attributes.DecisionTaskCompletedEventID = decisionTaskCompletedEventID
+	attributes.Memo = request.GetMemo()
+	event.UpsertWorkflowMemoEventAttributes = attributes
+
+	return event
+}
+
 func (b *HistoryBuilder) newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeSignalExternalWorkflowExecutionFailed)
@@ -1009,6 +1040,8 @@ func (b *HistoryBuilder) newStartChildWorkflowExecutionInitiatedEvent(decisionTa
 	attributes.Memo = startAttributes.Memo
 	attributes.SearchAttributes = startAttributes.SearchAttributes
 	attributes.ParentClosePolicy = startAttributes.GetParentClosePolicy().Ptr()
+	attributes.Priority = startAttributes.Priority
+	attributes.FairnessKey = startAttributes.FairnessKey
 	historyEvent.StartChildWorkflowExecutionInitiatedEventAttributes = attributes
 
 	return historyEvent
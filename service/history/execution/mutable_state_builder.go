@@ -307,7 +307,7 @@ func (e *mutableStateBuilder) CopyToPersistence() *persistence.WorkflowMutableSt
 
 func (e *mutableStateBuilder) Load(
 	state *persistence.WorkflowMutableState,
-) {
+) error {
 
 	e.pendingActivityInfoIDs = state.ActivityInfos
 	for _, activityInfo := range state.ActivityInfos {
@@ -340,14 +340,15 @@ func (e *mutableStateBuilder) Load(
 			e.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumInvalidated)
 		case e.shouldVerifyChecksum():
 			if err := verifyMutableStateChecksum(e, state.Checksum); err != nil {
-				// we ignore checksum verification errors for now until this
-				// feature is tested and/or we have mechanisms in place to deal
-				// with these types of errors
+				// the mutable state is loaded regardless, the caller decides
+				// how to deal with the mismatch
 				e.metricsClient.IncCounter(metrics.WorkflowContextScope, metrics.MutableStateChecksumMismatch)
 				e.logError("mutable state checksum mismatch", tag.Error(err))
+				return err
 			}
 		}
 	}
+	return nil
 }
 
 func (e *mutableStateBuilder) GetCurrentBranchToken() ([]byte, error) {
@@ -882,7 +883,8 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeMarkerRecorded,
 		types.EventTypeStartChildWorkflowExecutionInitiated,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated,
-		types.EventTypeUpsertWorkflowSearchAttributes:
+		types.EventTypeUpsertWorkflowSearchAttributes,
+		types.EventTypeUpsertWorkflowMemo:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -1040,7 +1042,7 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 
 	return getBackoffInterval(
 		e.timeSource.Now(),
-		info.ExpirationTime,
+		earliestExpirationTime(info.ExpirationTime, info.RetryElapsedExpirationTime),
 		info.Attempt,
 		info.MaximumAttempts,
 		info.InitialInterval,
@@ -1048,6 +1050,9 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 		info.BackoffCoefficient,
 		errReason,
 		info.NonRetriableErrors,
+		info.RetryBackoffSchedule,
+		info.RetryJitterMode,
+		info.RetryReasonPolicies,
 	)
 }
 
@@ -1602,20 +1607,6 @@ func (e *mutableStateBuilder) IsSignalRequested(
 	return false
 }
 
-func (e *mutableStateBuilder) AddSignalRequested(
-	requestID string,
-) {
-
-	if e.pendingSignalRequestedIDs == nil {
-		e.pendingSignalRequestedIDs = make(map[string]struct{})
-	}
-	if e.updateSignalRequestedIDs == nil {
-		e.updateSignalRequestedIDs = make(map[string]struct{})
-	}
-	e.pendingSignalRequestedIDs[requestID] = struct{}{} // add requestID to set
-	e.updateSignalRequestedIDs[requestID] = struct{}{}
-}
-
 func (e *mutableStateBuilder) DeleteSignalRequested(
 	requestID string,
 ) {
@@ -1666,6 +1657,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		CronSchedule:                        attributes.CronSchedule,
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
+		IsolationGroup:                      previousExecutionInfo.IsolationGroup,
 	}
 
 	req := &types.HistoryStartWorkflowExecutionRequest{
@@ -1694,6 +1686,15 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 			req.ExpirationTimestamp = common.Int64Ptr(expirationTime.UnixNano())
 		}
 	}
+	// the total elapsed time of retries counts across continue as new, only a cron run starts over
+	elapsedExpirationTime := previousExecutionInfo.RetryElapsedExpirationTime
+	if attributes.GetInitiator() != types.ContinueAsNewInitiatorCronSchedule && !elapsedExpirationTime.IsZero() {
+		req.RetryElapsedExpirationTimestamp = common.Int64Ptr(elapsedExpirationTime.UnixNano())
+	} else if attributes.RetryPolicy.GetMaximumTotalElapsedTimeInSeconds() > 0 {
+		elapsedSeconds := attributes.RetryPolicy.GetMaximumTotalElapsedTimeInSeconds() + req.GetFirstDecisionTaskBackoffSeconds()
+		elapsedExpirationTime = e.timeSource.Now().Add(time.Second * time.Duration(elapsedSeconds))
+		req.RetryElapsedExpirationTimestamp = common.Int64Ptr(elapsedExpirationTime.UnixNano())
+	}
 
 	// History event only has domainName so domainID has to be passed in explicitly to update the mutable state
 	var parentDomainID *string
@@ -1800,6 +1801,9 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	e.executionInfo.WorkflowTypeName = event.WorkflowType.GetName()
 	e.executionInfo.WorkflowTimeout = event.GetExecutionStartToCloseTimeoutSeconds()
 	e.executionInfo.DecisionStartToCloseTimeout = event.GetTaskStartToCloseTimeoutSeconds()
+	e.executionInfo.TaskPriority = event.GetPriority()
+	e.executionInfo.TaskFairnessKey = event.GetFairnessKey()
+	e.executionInfo.IsolationGroup = event.GetIsolationGroup()
 
 	if err := e.UpdateWorkflowStateCloseStatus(
 		persistence.WorkflowStateCreated,
@@ -1843,6 +1847,16 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 		e.executionInfo.MaximumAttempts = event.RetryPolicy.GetMaximumAttempts()
 		e.executionInfo.MaximumInterval = event.RetryPolicy.GetMaximumIntervalInSeconds()
 		e.executionInfo.NonRetriableErrors = event.RetryPolicy.NonRetriableErrorReasons
+		e.executionInfo.RetryBackoffSchedule = event.RetryPolicy.GetBackoffScheduleInSeconds()
+		e.executionInfo.RetryJitterMode = event.RetryPolicy.GetJitterMode()
+		e.executionInfo.RetryReasonPolicies = event.RetryPolicy.GetReasonPolicies()
+	}
+	if event.GetRetryElapsedExpirationTimestamp() != 0 {
+		e.executionInfo.RetryElapsedExpirationTime = time.Unix(0, event.GetRetryElapsedExpirationTimestamp())
+	}
+	if event.GetInitiator() == types.ContinueAsNewInitiatorRetryPolicy && event.GetFirstDecisionTaskBackoffSeconds() > 0 {
+		backoff := time.Duration(event.GetFirstDecisionTaskBackoffSeconds()) * time.Second
+		e.executionInfo.NextRetryTime = time.Unix(0, startEvent.GetTimestamp()).Add(backoff)
 	}
 
 	e.executionInfo.AutoResetPoints = rolloverAutoResetPointsWithExpiringTime(
@@ -2211,6 +2225,8 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		TimerTaskStatus:          TimerTaskStatusNone,
 		TaskList:                 attributes.TaskList.GetName(),
 		HasRetryPolicy:           attributes.RetryPolicy != nil,
+		Priority:                 attributes.GetPriority(),
+		FairnessKey:              attributes.GetFairnessKey(),
 	}
 
 	if ai.HasRetryPolicy {
@@ -2219,9 +2235,17 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		ai.MaximumInterval = attributes.RetryPolicy.GetMaximumIntervalInSeconds()
 		ai.MaximumAttempts = attributes.RetryPolicy.GetMaximumAttempts()
 		ai.NonRetriableErrors = attributes.RetryPolicy.NonRetriableErrorReasons
+		ai.RetryBackoffSchedule = attributes.RetryPolicy.GetBackoffScheduleInSeconds()
+		ai.RetryJitterMode = attributes.RetryPolicy.GetJitterMode()
+		ai.RetryReasonPolicies = attributes.RetryPolicy.GetReasonPolicies()
 		if attributes.RetryPolicy.GetExpirationIntervalInSeconds() != 0 {
 			ai.ExpirationTime = ai.ScheduledTime.Add(time.Duration(attributes.RetryPolicy.GetExpirationIntervalInSeconds()) * time.Second)
 		}
+		if attributes.RetryPolicy.GetMaximumTotalElapsedTimeInSeconds() != 0 {
+			// attempts of an activity never span runs, so the elapsed time is another expiration
+			elapsedExpirationTime := ai.ScheduledTime.Add(time.Duration(attributes.RetryPolicy.GetMaximumTotalElapsedTimeInSeconds()) * time.Second)
+			ai.ExpirationTime = earliestExpirationTime(ai.ExpirationTime, elapsedExpirationTime)
+		}
 	}
 
 	e.pendingActivityInfoIDs[scheduleEventID] = ai
@@ -2728,10 +2752,14 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionCancelRequestedEvent(
 ) error {
 
 	e.executionInfo.CancelRequested = true
+	if requestID := event.WorkflowExecutionCancelRequestedEventAttributes.GetRequestID(); requestID != "" {
+		e.UpdateDuplicatedRequest(requestID, e.unixNanoToTime(event.GetTimestamp()))
+	}
 	return nil
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionCanceledEvent(
+	firstEventID int64,
 	decisionTaskCompletedEventID int64,
 	attributes *types.CancelWorkflowExecutionDecisionAttributes,
 ) (*types.HistoryEvent, error) {
@@ -2742,7 +2770,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionCanceledEvent(
 	}
 
 	event := e.hBuilder.AddWorkflowExecutionCanceledEvent(decisionTaskCompletedEventID, attributes)
-	if err := e.ReplicateWorkflowExecutionCanceledEvent(decisionTaskCompletedEventID, event); err != nil {
+	if err := e.ReplicateWorkflowExecutionCanceledEvent(firstEventID, event); err != nil {
 		return nil, err
 	}
 	// TODO merge active & passive task generation
@@ -2972,6 +3000,35 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	e.executionInfo.SearchAttributes = mergeMapOfByteArray(currentSearchAttr, upsertSearchAttr)
 }
 
+func (e *mutableStateBuilder) AddUpsertWorkflowMemoEvent(
+	decisionCompletedEventID int64,
+	request *types.UpsertWorkflowMemoDecisionAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionUpsertWorkflowMemo
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddUpsertWorkflowMemoEvent(decisionCompletedEventID, request)
+	e.ReplicateUpsertWorkflowMemoEvent(event)
+	// visibility records are upserted from mutable state, so the same task syncs memo and search attributes
+	if err := e.taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateUpsertWorkflowMemoEvent(
+	event *types.HistoryEvent,
+) {
+
+	upsertMemo := event.UpsertWorkflowMemoEventAttributes.GetMemo().GetFields()
+	currentMemo := e.GetExecutionInfo().Memo
+
+	e.executionInfo.Memo = mergeMapOfByteArray(currentMemo, upsertMemo)
+}
+
 func mergeMapOfByteArray(
 	current map[string][]byte,
 	upsert map[string][]byte,
@@ -3241,6 +3298,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	reason string,
 	details []byte,
 	identity string,
+	requestID string,
 ) (*types.HistoryEvent, error) {
 
 	opTag := tag.WorkflowActionWorkflowTerminated
@@ -3248,7 +3306,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 		return nil, err
 	}
 
-	event := e.hBuilder.AddWorkflowExecutionTerminatedEvent(reason, details, identity)
+	event := e.hBuilder.AddWorkflowExecutionTerminatedEvent(reason, details, identity, requestID)
 	if err := e.ReplicateWorkflowExecutionTerminatedEvent(firstEventID, event); err != nil {
 		return nil, err
 	}
@@ -3276,6 +3334,9 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTerminatedEvent(
 	e.executionInfo.CompletionEventBatchID = firstEventID // Used when completion event needs to be loaded from database
 	e.ClearStickyness()
 	e.writeEventToCache(event)
+	if requestID := event.WorkflowExecutionTerminatedEventAttributes.GetRequestID(); requestID != "" {
+		e.UpdateDuplicatedRequest(requestID, e.unixNanoToTime(event.GetTimestamp()))
+	}
 	return nil
 }
 
@@ -3283,6 +3344,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 	signalName string,
 	input []byte,
 	identity string,
+	requestID string,
 ) (*types.HistoryEvent, error) {
 
 	opTag := tag.WorkflowActionWorkflowSignaled
@@ -3290,7 +3352,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 		return nil, err
 	}
 
-	event := e.hBuilder.AddWorkflowExecutionSignaledEvent(signalName, input, identity)
+	event := e.hBuilder.AddWorkflowExecutionSignaledEvent(signalName, input, identity, requestID)
 	if err := e.ReplicateWorkflowExecutionSignaled(event); err != nil {
 		return nil, err
 	}
@@ -3303,6 +3365,9 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+	if requestID := event.WorkflowExecutionSignaledEventAttributes.GetRequestID(); requestID != "" {
+		e.UpdateDuplicatedRequest(requestID, e.unixNanoToTime(event.GetTimestamp()))
+	}
 	return nil
 }
 
@@ -3362,6 +3427,8 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	); err != nil {
 		return nil, nil, &types.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
+	// request IDs are kept per workflow ID so that requests sent to the previous run are still deduplicated
+	newStateBuilder.executionInfo.DedupRequestIDs = e.executionInfo.CopyDedupRequestIDs()
 
 	if err = e.ReplicateWorkflowExecutionContinuedAsNewEvent(
 		firstEventID,
@@ -3417,6 +3484,11 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(
 		return err
 	}
 	e.executionInfo.CompletionEventBatchID = firstEventID // Used when completion event needs to be loaded from database
+	if attributes := continueAsNewEvent.WorkflowExecutionContinuedAsNewEventAttributes; attributes != nil &&
+		attributes.GetInitiator() == types.ContinueAsNewInitiatorRetryPolicy {
+		backoff := time.Duration(attributes.GetBackoffStartIntervalInSeconds()) * time.Second
+		e.executionInfo.NextRetryTime = time.Unix(0, continueAsNewEvent.GetTimestamp()).Add(backoff)
+	}
 	e.ClearStickyness()
 	e.writeEventToCache(continueAsNewEvent)
 	return nil
@@ -3802,6 +3874,9 @@ func (e *mutableStateBuilder) RetryActivity(
 		ai.BackoffCoefficient,
 		failureReason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	)
 	if backoffInterval == backoff.NoBackoff {
 		return false, nil
@@ -4103,6 +4178,58 @@ func (e *mutableStateBuilder) UpdateDuplicatedResource(
 	e.appliedEvents[id] = struct{}{}
 }
 
+func (e *mutableStateBuilder) IsRequestDuplicated(
+	requestID string,
+) bool {
+
+	receivedTime, ok := e.executionInfo.DedupRequestIDs[requestID]
+	if !ok {
+		return false
+	}
+	window := e.config.RequestDedupWindow(e.domainEntry.GetInfo().Name)
+	return e.timeSource.Now().Before(receivedTime.Add(window))
+}
+
+func (e *mutableStateBuilder) UpdateDuplicatedRequest(
+	requestID string,
+	receivedTime time.Time,
+) {
+
+	domainName := e.domainEntry.GetInfo().Name
+	window := e.config.RequestDedupWindow(domainName)
+	maxRequestIDs := e.config.RequestDedupMaxRequestIDs(domainName)
+	if window <= 0 || maxRequestIDs <= 0 {
+		return
+	}
+
+	now := e.timeSource.Now()
+	// requests replicated or replayed from history may already be out of the dedup window
+	if !now.Before(receivedTime.Add(window)) {
+		return
+	}
+	if e.executionInfo.DedupRequestIDs == nil {
+		e.executionInfo.DedupRequestIDs = make(map[string]time.Time)
+	}
+	for id, receivedTime := range e.executionInfo.DedupRequestIDs {
+		if !now.Before(receivedTime.Add(window)) {
+			delete(e.executionInfo.DedupRequestIDs, id)
+		}
+	}
+	// drop the oldest request IDs to keep the mutable state bounded
+	for len(e.executionInfo.DedupRequestIDs) >= maxRequestIDs {
+		oldestID := ""
+		var oldestTime time.Time
+		for id, receivedTime := range e.executionInfo.DedupRequestIDs {
+			if oldestID == "" || receivedTime.Before(oldestTime) {
+				oldestID = id
+				oldestTime = receivedTime
+			}
+		}
+		delete(e.executionInfo.DedupRequestIDs, oldestID)
+	}
+	e.executionInfo.DedupRequestIDs[requestID] = receivedTime
+}
+
 func (e *mutableStateBuilder) prepareCloseTransaction(
 	now time.Time,
 	transactionPolicy TransactionPolicy,
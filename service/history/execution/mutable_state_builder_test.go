@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
@@ -216,6 +217,7 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		types.EventTypeStartChildWorkflowExecutionInitiated:            true,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated:        true,
 		types.EventTypeUpsertWorkflowSearchAttributes:                  true,
+		types.EventTypeUpsertWorkflowMemo:                              true,
 	}
 
 	// other events will not be assign event ID immediately
@@ -389,7 +391,7 @@ func (s *mutableStateSuite) TestChecksum() {
 
 			// create mutable state and verify checksum is generated on close
 			loadErrors = loadErrorsFunc()
-			s.msBuilder.Load(dbState)
+			s.NoError(s.msBuilder.Load(dbState))
 			s.Equal(loadErrors, loadErrorsFunc()) // no errors expected
 			s.EqualValues(dbState.Checksum, s.msBuilder.checksum)
 			s.msBuilder.domainEntry = s.newDomainCacheEntry()
@@ -402,7 +404,7 @@ func (s *mutableStateSuite) TestChecksum() {
 
 			// verify checksum is verified on Load
 			dbState.Checksum = csum
-			s.msBuilder.Load(dbState)
+			s.NoError(s.msBuilder.Load(dbState))
 			s.Equal(loadErrors, loadErrorsFunc())
 
 			// generate checksum again and verify its the same
@@ -413,7 +415,7 @@ func (s *mutableStateSuite) TestChecksum() {
 
 			// modify checksum and verify Load fails
 			dbState.Checksum.Value[0]++
-			s.msBuilder.Load(dbState)
+			s.Error(s.msBuilder.Load(dbState))
 			s.Equal(loadErrors+1, loadErrorsFunc())
 			s.EqualValues(dbState.Checksum, s.msBuilder.checksum)
 
@@ -422,7 +424,7 @@ func (s *mutableStateSuite) TestChecksum() {
 			s.mockShard.GetConfig().MutableStateChecksumInvalidateBefore = func(...dynamicconfig.FilterOption) float64 {
 				return float64((s.msBuilder.executionInfo.LastUpdatedTimestamp.UnixNano() / int64(time.Second)) + 1)
 			}
-			s.msBuilder.Load(dbState)
+			s.NoError(s.msBuilder.Load(dbState))
 			s.Equal(loadErrors, loadErrorsFunc())
 			s.EqualValues(checksum.Checksum{}, s.msBuilder.checksum)
 
@@ -535,6 +537,149 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestRequestDuplicated() {
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	s.msBuilder.timeSource = timeSource
+	s.msBuilder.config.RequestDedupWindow = func(domain string) time.Duration { return time.Minute }
+	s.msBuilder.config.RequestDedupMaxRequestIDs = func(domain string) int { return 2 }
+
+	s.False(s.msBuilder.IsRequestDuplicated("request-1"))
+	s.msBuilder.UpdateDuplicatedRequest("request-1", timeSource.Now())
+	s.True(s.msBuilder.IsRequestDuplicated("request-1"))
+
+	// the oldest request ID is dropped when the limit is reached
+	timeSource.Update(now.Add(time.Second))
+	s.msBuilder.UpdateDuplicatedRequest("request-2", timeSource.Now())
+	timeSource.Update(now.Add(2 * time.Second))
+	s.msBuilder.UpdateDuplicatedRequest("request-3", timeSource.Now())
+	s.False(s.msBuilder.IsRequestDuplicated("request-1"))
+	s.True(s.msBuilder.IsRequestDuplicated("request-2"))
+	s.True(s.msBuilder.IsRequestDuplicated("request-3"))
+
+	// request IDs are no longer duplicated after the window
+	timeSource.Update(now.Add(time.Second + time.Minute))
+	s.False(s.msBuilder.IsRequestDuplicated("request-2"))
+	s.True(s.msBuilder.IsRequestDuplicated("request-3"))
+	s.msBuilder.UpdateDuplicatedRequest("request-4", timeSource.Now())
+	s.Len(s.msBuilder.GetExecutionInfo().DedupRequestIDs, 2)
+
+	// request IDs are not kept if deduplication is disabled
+	s.msBuilder.config.RequestDedupWindow = func(domain string) time.Duration { return 0 }
+	s.msBuilder.UpdateDuplicatedRequest("request-5", timeSource.Now())
+	s.False(s.msBuilder.IsRequestDuplicated("request-5"))
+}
+
+func (s *mutableStateSuite) TestRequestDuplicated_ReloadFromPersistence() {
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	s.mockShard.GetConfig().RequestDedupWindow = func(domain string) time.Duration { return time.Minute }
+
+	s.NoError(s.msBuilder.Load(s.buildWorkflowMutableState()))
+	s.msBuilder.timeSource = timeSource
+	s.msBuilder.domainEntry = s.newDomainCacheEntry()
+	_, err := s.msBuilder.AddWorkflowExecutionSignaled("some random signal name", nil, "some random identity", "request-1")
+	s.NoError(err)
+	s.msBuilder.UpdateDuplicatedRequest("request-2", now.Add(-2*time.Minute))
+	mutation, _, err := s.msBuilder.CloseTransactionAsMutation(now, TransactionPolicyPassive)
+	s.NoError(err)
+
+	dbState := s.buildWorkflowMutableState()
+	dbState.ExecutionInfo = mutation.ExecutionInfo
+	msBuilder := newMutableStateBuilder(s.mockShard, s.logger, s.newDomainCacheEntry())
+	s.NoError(msBuilder.Load(dbState))
+	msBuilder.timeSource = timeSource
+	s.True(msBuilder.IsRequestDuplicated("request-1"))
+	s.False(msBuilder.IsRequestDuplicated("request-2"))
+	s.Equal(map[string]time.Time{"request-1": time.Unix(0, now.UnixNano())}, msBuilder.GetExecutionInfo().DedupRequestIDs)
+
+	timeSource.Update(now.Add(time.Minute))
+	s.False(msBuilder.IsRequestDuplicated("request-1"))
+}
+
+func (s *mutableStateSuite) TestRequestDuplicated_ReplicateEvents() {
+	now := time.Now()
+	s.msBuilder.timeSource = clock.NewEventTimeSource().Update(now)
+	s.msBuilder.config.RequestDedupWindow = func(domain string) time.Duration { return time.Minute }
+
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionSignaled(&types.HistoryEvent{
+		Timestamp: common.Int64Ptr(now.Add(-time.Second).UnixNano()),
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some random signal name",
+			RequestID:  "signal-request",
+		},
+	}))
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionCancelRequestedEvent(&types.HistoryEvent{
+		Timestamp: common.Int64Ptr(now.Add(-time.Second).UnixNano()),
+		EventType: types.EventTypeWorkflowExecutionCancelRequested.Ptr(),
+		WorkflowExecutionCancelRequestedEventAttributes: &types.WorkflowExecutionCancelRequestedEventAttributes{
+			RequestID: "cancel-request",
+		},
+	}))
+	// requests older than the dedup window are not kept
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionSignaled(&types.HistoryEvent{
+		Timestamp: common.Int64Ptr(now.Add(-time.Hour).UnixNano()),
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some random signal name",
+			RequestID:  "expired-request",
+		},
+	}))
+
+	s.True(s.msBuilder.IsRequestDuplicated("signal-request"))
+	s.True(s.msBuilder.IsRequestDuplicated("cancel-request"))
+	s.False(s.msBuilder.IsRequestDuplicated("expired-request"))
+	s.Len(s.msBuilder.GetExecutionInfo().DedupRequestIDs, 2)
+}
+
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionStartedEvent_RetryPolicy() {
+	now := time.Now()
+	execution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      uuid.New(),
+	}
+	reasonPolicies := []*types.RetryReasonPolicy{{
+		ReasonPattern: "^throttled",
+		RetryPolicy:   &types.RetryPolicy{BackoffScheduleInSeconds: []int32{60}},
+	}}
+	startEvent := &types.HistoryEvent{
+		EventID:   common.FirstEventID,
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+		WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+			WorkflowType:                        &types.WorkflowType{Name: "some random workflow type"},
+			TaskList:                            &types.TaskList{Name: "some random tasklist"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(222),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(11),
+			Initiator:                           types.ContinueAsNewInitiatorRetryPolicy.Ptr(),
+			FirstDecisionTaskBackoffSeconds:     common.Int32Ptr(10),
+			Attempt:                             1,
+			RetryPolicy: &types.RetryPolicy{
+				BackoffScheduleInSeconds:         []int32{10, 30},
+				JitterMode:                       types.RetryJitterModeEqual,
+				ReasonPolicies:                   reasonPolicies,
+				MaximumTotalElapsedTimeInSeconds: 60,
+			},
+			RetryElapsedExpirationTimestamp: common.Int64Ptr(now.Add(time.Minute).UnixNano()),
+		},
+	}
+
+	s.mockEventsCache.EXPECT().PutEvent(
+		constants.TestDomainID, execution.GetWorkflowID(), execution.GetRunID(),
+		startEvent.GetEventID(), startEvent,
+	).Times(1)
+	err := s.msBuilder.ReplicateWorkflowExecutionStartedEvent(nil, execution, uuid.New(), startEvent)
+	s.NoError(err)
+
+	executionInfo := s.msBuilder.GetExecutionInfo()
+	s.Equal([]int32{10, 30}, executionInfo.RetryBackoffSchedule)
+	s.Equal(types.RetryJitterModeEqual, executionInfo.RetryJitterMode)
+	s.Equal(reasonPolicies, executionInfo.RetryReasonPolicies)
+	s.Equal(now.Add(time.Minute).UnixNano(), executionInfo.RetryElapsedExpirationTime.UnixNano())
+	s.Equal(now.Add(10*time.Second).UnixNano(), executionInfo.NextRetryTime.UnixNano())
+}
+
 func (s *mutableStateSuite) TestTransientDecisionTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
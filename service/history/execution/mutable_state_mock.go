@@ -579,18 +579,6 @@ func (mr *MockMutableStateMockRecorder) AddSignalExternalWorkflowExecutionInitia
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignalExternalWorkflowExecutionInitiatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddSignalExternalWorkflowExecutionInitiatedEvent), arg0, arg1, arg2)
 }
 
-// AddSignalRequested mocks base method
-func (m *MockMutableState) AddSignalRequested(requestID string) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "AddSignalRequested", requestID)
-}
-
-// AddSignalRequested indicates an expected call of AddSignalRequested
-func (mr *MockMutableStateMockRecorder) AddSignalRequested(requestID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignalRequested", reflect.TypeOf((*MockMutableState)(nil).AddSignalRequested), requestID)
-}
-
 // AddStartChildWorkflowExecutionFailedEvent mocks base method
 func (m *MockMutableState) AddStartChildWorkflowExecutionFailedEvent(arg0 int64, arg1 types.ChildWorkflowExecutionFailedCause, arg2 *types.StartChildWorkflowExecutionInitiatedEventAttributes) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +686,21 @@ func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowSearchAttributesEvent(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowSearchAttributesEvent", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowSearchAttributesEvent), arg0, arg1)
 }
 
+// AddUpsertWorkflowMemoEvent mocks base method
+func (m *MockMutableState) AddUpsertWorkflowMemoEvent(arg0 int64, arg1 *types.UpsertWorkflowMemoDecisionAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUpsertWorkflowMemoEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUpsertWorkflowMemoEvent indicates an expected call of AddUpsertWorkflowMemoEvent
+func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowMemoEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowMemoEvent", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowMemoEvent), arg0, arg1)
+}
+
 // AddWorkflowExecutionCancelRequestedEvent mocks base method
 func (m *MockMutableState) AddWorkflowExecutionCancelRequestedEvent(arg0 string, arg1 *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -714,33 +717,33 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCancelRequestedEvent
 }
 
 // AddWorkflowExecutionCanceledEvent mocks base method
-func (m *MockMutableState) AddWorkflowExecutionCanceledEvent(arg0 int64, arg1 *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error) {
+func (m *MockMutableState) AddWorkflowExecutionCanceledEvent(firstEventID, decisionTaskCompletedEventID int64, attributes *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddWorkflowExecutionCanceledEvent", arg0, arg1)
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionCanceledEvent", firstEventID, decisionTaskCompletedEventID, attributes)
 	ret0, _ := ret[0].(*types.HistoryEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddWorkflowExecutionCanceledEvent indicates an expected call of AddWorkflowExecutionCanceledEvent
-func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCanceledEvent(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCanceledEvent(firstEventID, decisionTaskCompletedEventID, attributes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCanceledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCanceledEvent), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCanceledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCanceledEvent), firstEventID, decisionTaskCompletedEventID, attributes)
 }
 
 // AddWorkflowExecutionSignaled mocks base method
-func (m *MockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*types.HistoryEvent, error) {
+func (m *MockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity, requestID string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddWorkflowExecutionSignaled", signalName, input, identity)
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionSignaled", signalName, input, identity, requestID)
 	ret0, _ := ret[0].(*types.HistoryEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddWorkflowExecutionSignaled indicates an expected call of AddWorkflowExecutionSignaled
-func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionSignaled(signalName, input, identity interface{}) *gomock.Call {
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionSignaled(signalName, input, identity, requestID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionSignaled", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionSignaled), signalName, input, identity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionSignaled", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionSignaled), signalName, input, identity, requestID)
 }
 
 // AddWorkflowExecutionStartedEvent mocks base method
@@ -759,18 +762,18 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionStartedEvent(arg0, a
 }
 
 // AddWorkflowExecutionTerminatedEvent mocks base method
-func (m *MockMutableState) AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error) {
+func (m *MockMutableState) AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity, requestID string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddWorkflowExecutionTerminatedEvent", firstEventID, reason, details, identity)
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionTerminatedEvent", firstEventID, reason, details, identity, requestID)
 	ret0, _ := ret[0].(*types.HistoryEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddWorkflowExecutionTerminatedEvent indicates an expected call of AddWorkflowExecutionTerminatedEvent
-func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionTerminatedEvent(firstEventID, reason, details, identity interface{}) *gomock.Call {
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionTerminatedEvent(firstEventID, reason, details, identity, requestID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionTerminatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionTerminatedEvent), firstEventID, reason, details, identity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionTerminatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionTerminatedEvent), firstEventID, reason, details, identity, requestID)
 }
 
 // ClearStickyness mocks base method
@@ -1624,10 +1627,38 @@ func (mr *MockMutableStateMockRecorder) UpdateDuplicatedResource(resourceDedupKe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDuplicatedResource", reflect.TypeOf((*MockMutableState)(nil).UpdateDuplicatedResource), resourceDedupKey)
 }
 
+// IsRequestDuplicated mocks base method
+func (m *MockMutableState) IsRequestDuplicated(requestID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRequestDuplicated", requestID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsRequestDuplicated indicates an expected call of IsRequestDuplicated
+func (mr *MockMutableStateMockRecorder) IsRequestDuplicated(requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRequestDuplicated", reflect.TypeOf((*MockMutableState)(nil).IsRequestDuplicated), requestID)
+}
+
+// UpdateDuplicatedRequest mocks base method
+func (m *MockMutableState) UpdateDuplicatedRequest(requestID string, receivedTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateDuplicatedRequest", requestID, receivedTime)
+}
+
+// UpdateDuplicatedRequest indicates an expected call of UpdateDuplicatedRequest
+func (mr *MockMutableStateMockRecorder) UpdateDuplicatedRequest(requestID, receivedTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDuplicatedRequest", reflect.TypeOf((*MockMutableState)(nil).UpdateDuplicatedRequest), requestID, receivedTime)
+}
+
 // Load mocks base method
-func (m *MockMutableState) Load(arg0 *persistence.WorkflowMutableState) {
+func (m *MockMutableState) Load(arg0 *persistence.WorkflowMutableState) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Load", arg0)
+	ret := m.ctrl.Call(m, "Load", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Load indicates an expected call of Load
@@ -2090,6 +2121,18 @@ func (mr *MockMutableStateMockRecorder) ReplicateUpsertWorkflowSearchAttributesE
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowSearchAttributesEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateUpsertWorkflowSearchAttributesEvent), arg0)
 }
 
+// ReplicateUpsertWorkflowMemoEvent mocks base method
+func (m *MockMutableState) ReplicateUpsertWorkflowMemoEvent(arg0 *types.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReplicateUpsertWorkflowMemoEvent", arg0)
+}
+
+// ReplicateUpsertWorkflowMemoEvent indicates an expected call of ReplicateUpsertWorkflowMemoEvent
+func (mr *MockMutableStateMockRecorder) ReplicateUpsertWorkflowMemoEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowMemoEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateUpsertWorkflowMemoEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionCancelRequestedEvent mocks base method
 func (m *MockMutableState) ReplicateWorkflowExecutionCancelRequestedEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"github.com/uber/cadence/common/persistence"
+)
+
+// mutableStateSize estimates the memory used by a mutable state from the sizes of its records
+// computed by persistence when the mutable state is loaded and updated. Records are updated in place,
+// so only the average size of each kind of record is kept and multiplied by the number of pending records.
+type mutableStateSize struct {
+	executionInfo  int
+	bufferedEvents int
+	activityInfo   int
+	timerInfo      int
+	childInfo      int
+	signalInfo     int
+}
+
+func (s *mutableStateSize) loaded(stats *persistence.MutableStateStats) {
+	*s = mutableStateSize{}
+	if stats == nil {
+		return
+	}
+	s.executionInfo = stats.ExecutionInfoSize
+	s.bufferedEvents = stats.BufferedEventsSize
+	s.activityInfo = averageRecordSize(stats.ActivityInfoSize, stats.ActivityInfoCount, 0)
+	s.timerInfo = averageRecordSize(stats.TimerInfoSize, stats.TimerInfoCount, 0)
+	s.childInfo = averageRecordSize(stats.ChildInfoSize, stats.ChildInfoCount, 0)
+	s.signalInfo = averageRecordSize(stats.SignalInfoSize, stats.SignalInfoCount, 0)
+}
+
+func (s *mutableStateSize) updated(stats *persistence.MutableStateUpdateSessionStats) {
+	if stats == nil {
+		return
+	}
+	if stats.ExecutionInfoSize > 0 {
+		s.executionInfo = stats.ExecutionInfoSize
+	}
+	s.bufferedEvents += stats.BufferedEventsSize
+	s.activityInfo = averageRecordSize(stats.ActivityInfoSize, stats.ActivityInfoCount, s.activityInfo)
+	s.timerInfo = averageRecordSize(stats.TimerInfoSize, stats.TimerInfoCount, s.timerInfo)
+	s.childInfo = averageRecordSize(stats.ChildInfoSize, stats.ChildInfoCount, s.childInfo)
+	s.signalInfo = averageRecordSize(stats.SignalInfoSize, stats.SignalInfoCount, s.signalInfo)
+}
+
+func (s *mutableStateSize) estimate(mutableState MutableState) int64 {
+	if mutableState == nil {
+		return 0
+	}
+	if !mutableState.HasBufferedEvents() {
+		s.bufferedEvents = 0
+	}
+	size := s.executionInfo + s.bufferedEvents
+	size += len(mutableState.GetPendingActivityInfos()) * s.activityInfo
+	size += len(mutableState.GetPendingTimerInfos()) * s.timerInfo
+	size += len(mutableState.GetPendingChildExecutionInfos()) * s.childInfo
+	size += len(mutableState.GetPendingSignalExternalInfos()) * s.signalInfo
+	return int64(size)
+}
+
+func averageRecordSize(size int, count int, previous int) int {
+	if count == 0 {
+		return previous
+	}
+	if previous == 0 {
+		return size / count
+	}
+	return (previous + size/count) / 2
+}
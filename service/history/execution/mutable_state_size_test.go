@@ -0,0 +1,67 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestMutableStateSize(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mutableState := NewMockMutableState(controller)
+	activityInfos := map[int64]*persistence.ActivityInfo{1: {}, 2: {}}
+	timerInfos := map[string]*persistence.TimerInfo{"1": {}}
+	mutableState.EXPECT().GetPendingActivityInfos().Return(activityInfos).AnyTimes()
+	mutableState.EXPECT().GetPendingTimerInfos().Return(timerInfos).AnyTimes()
+	mutableState.EXPECT().GetPendingChildExecutionInfos().Return(nil).AnyTimes()
+	mutableState.EXPECT().GetPendingSignalExternalInfos().Return(nil).AnyTimes()
+
+	var size mutableStateSize
+	assert.Equal(t, int64(0), size.estimate(nil))
+
+	size.loaded(&persistence.MutableStateStats{
+		ExecutionInfoSize:  1000,
+		ActivityInfoSize:   400,
+		ActivityInfoCount:  2,
+		TimerInfoSize:      50,
+		TimerInfoCount:     1,
+		BufferedEventsSize: 300,
+	})
+	mutableState.EXPECT().HasBufferedEvents().Return(true).Times(1)
+	assert.Equal(t, int64(1000+300+2*200+50), size.estimate(mutableState))
+
+	// an activity is scheduled and the buffered events are flushed
+	activityInfos[3] = &persistence.ActivityInfo{}
+	size.updated(&persistence.MutableStateUpdateSessionStats{
+		ExecutionInfoSize: 1100,
+		ActivityInfoSize:  600,
+		ActivityInfoCount: 1,
+	})
+	mutableState.EXPECT().HasBufferedEvents().Return(false).Times(1)
+	assert.Equal(t, int64(1100+3*400+50), size.estimate(mutableState))
+}
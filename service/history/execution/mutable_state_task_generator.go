@@ -198,6 +198,9 @@ func (r *mutableStateTaskGeneratorImpl) GenerateDelayedDecisionTasks(
 
 	// noParentWorkflow case
 	firstDecisionDelayType := persistence.WorkflowBackoffTimeoutTypeCron
+	if startAttr.GetDelayStartSeconds() > 0 && startAttr.GetCronSchedule() == "" {
+		firstDecisionDelayType = persistence.WorkflowBackoffTimeoutTypeDelayStart
+	}
 	// continue as new case
 	if startAttr.Initiator != nil {
 		switch startAttr.GetInitiator() {
@@ -28,10 +28,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/constants"
 )
 
@@ -122,6 +124,52 @@ func (s *mutableStateTaskGeneratorSuite) TestIsCrossClusterTask() {
 	}
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestGenerateDelayedDecisionTasks() {
+	now := time.Now()
+	testCases := []struct {
+		name        string
+		attributes  *types.WorkflowExecutionStartedEventAttributes
+		timeoutType int
+	}{
+		{
+			name:        "cron",
+			attributes:  &types.WorkflowExecutionStartedEventAttributes{CronSchedule: "* * * * *"},
+			timeoutType: persistence.WorkflowBackoffTimeoutTypeCron,
+		},
+		{
+			name:        "delayed start",
+			attributes:  &types.WorkflowExecutionStartedEventAttributes{DelayStartSeconds: common.Int32Ptr(5)},
+			timeoutType: persistence.WorkflowBackoffTimeoutTypeDelayStart,
+		},
+		{
+			name:        "delayed start of cron",
+			attributes:  &types.WorkflowExecutionStartedEventAttributes{DelayStartSeconds: common.Int32Ptr(5), CronSchedule: "* * * * *"},
+			timeoutType: persistence.WorkflowBackoffTimeoutTypeCron,
+		},
+		{
+			name:        "retry",
+			attributes:  &types.WorkflowExecutionStartedEventAttributes{Initiator: types.ContinueAsNewInitiatorRetryPolicy.Ptr()},
+			timeoutType: persistence.WorkflowBackoffTimeoutTypeRetry,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.attributes.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(10)
+			s.mockMutableState.EXPECT().AddTimerTasks(gomock.Any()).Do(func(tasks ...persistence.Task) {
+				s.Len(tasks, 1)
+				task := tasks[0].(*persistence.WorkflowBackoffTimerTask)
+				s.Equal(tc.timeoutType, task.TimeoutType)
+				s.Equal(now.Add(10*time.Second), task.VisibilityTimestamp)
+			})
+			err := s.taskGenerator.GenerateDelayedDecisionTasks(now, &types.HistoryEvent{
+				WorkflowExecutionStartedEventAttributes: tc.attributes,
+			})
+			s.NoError(err)
+		})
+	}
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateCrossClusterTaskFromTransferTask() {
 	targetCluster := cluster.TestAlternativeClusterName
 	now := time.Now()
@@ -373,6 +373,15 @@ func CopyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		NonRetriableErrors:                 sourceInfo.NonRetriableErrors,
 		BranchToken:                        sourceInfo.BranchToken,
 		ExpirationSeconds:                  sourceInfo.ExpirationSeconds,
+		TaskPriority:                       sourceInfo.TaskPriority,
+		TaskFairnessKey:                    sourceInfo.TaskFairnessKey,
+		IsolationGroup:                     sourceInfo.IsolationGroup,
+		RetryBackoffSchedule:               sourceInfo.RetryBackoffSchedule,
+		RetryJitterMode:                    sourceInfo.RetryJitterMode,
+		RetryReasonPolicies:                sourceInfo.RetryReasonPolicies,
+		RetryElapsedExpirationTime:         sourceInfo.RetryElapsedExpirationTime,
+		NextRetryTime:                      sourceInfo.NextRetryTime,
+		DedupRequestIDs:                    sourceInfo.CopyDedupRequestIDs(),
 	}
 }
 
@@ -417,6 +426,11 @@ func CopyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds: sourceInfo.LastHeartbeatTimeoutVisibilityInSeconds,
+		Priority:                                sourceInfo.Priority,
+		FairnessKey:                             sourceInfo.FairnessKey,
+		RetryBackoffSchedule:                    sourceInfo.RetryBackoffSchedule,
+		RetryJitterMode:                         sourceInfo.RetryJitterMode,
+		RetryReasonPolicies:                     sourceInfo.RetryReasonPolicies,
 	}
 }
 
@@ -22,10 +22,12 @@ package execution
 
 import (
 	"math"
+	"math/rand"
 	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/types"
 )
 
 func getBackoffInterval(
@@ -38,8 +40,32 @@ func getBackoffInterval(
 	backoffCoefficient float64,
 	failureReason string,
 	nonRetriableErrors []string,
+	backoffSchedule []int32,
+	jitterMode types.RetryJitterMode,
+	reasonPolicies []*types.RetryReasonPolicy,
 ) time.Duration {
 
+	// the first policy matching the failure reason overrides the backoff fields it sets,
+	// expiration of the policy still applies
+	if reasonPolicy := matchRetryReasonPolicy(reasonPolicies, failureReason); reasonPolicy != nil {
+		policy := common.ApplyRetryReasonPolicy(&types.RetryPolicy{
+			InitialIntervalInSeconds: initInterval,
+			BackoffCoefficient:       backoffCoefficient,
+			MaximumIntervalInSeconds: maxInterval,
+			MaximumAttempts:          maxAttempts,
+			NonRetriableErrorReasons: nonRetriableErrors,
+			BackoffScheduleInSeconds: backoffSchedule,
+			JitterMode:               jitterMode,
+		}, reasonPolicy)
+		maxAttempts = policy.GetMaximumAttempts()
+		initInterval = policy.GetInitialIntervalInSeconds()
+		maxInterval = policy.GetMaximumIntervalInSeconds()
+		backoffCoefficient = policy.GetBackoffCoefficient()
+		nonRetriableErrors = policy.GetNonRetriableErrorReasons()
+		backoffSchedule = policy.GetBackoffScheduleInSeconds()
+		jitterMode = policy.GetJitterMode()
+	}
+
 	if maxAttempts == 0 && expirationTime.IsZero() {
 		return backoff.NoBackoff
 	}
@@ -50,7 +76,13 @@ func getBackoffInterval(
 		return backoff.NoBackoff
 	}
 
-	nextInterval := int64(float64(initInterval) * math.Pow(backoffCoefficient, float64(currAttempt)))
+	var nextInterval int64
+	if len(backoffSchedule) > 0 {
+		// the last interval of the schedule repeats for the remaining attempts
+		nextInterval = int64(backoffSchedule[common.MinInt32(currAttempt, int32(len(backoffSchedule)-1))])
+	} else {
+		nextInterval = int64(float64(initInterval) * math.Pow(backoffCoefficient, float64(currAttempt)))
+	}
 	if nextInterval <= 0 {
 		// math.Pow() could overflow
 		if maxInterval > 0 {
@@ -65,7 +97,7 @@ func getBackoffInterval(
 		nextInterval = int64(maxInterval)
 	}
 
-	backoffInterval := time.Duration(nextInterval) * time.Second
+	backoffInterval := addRetryJitter(time.Duration(nextInterval)*time.Second, jitterMode)
 	nextScheduleTime := now.Add(backoffInterval)
 	if !expirationTime.IsZero() && nextScheduleTime.After(expirationTime) {
 		return backoff.NoBackoff
@@ -88,3 +120,49 @@ func getBackoffInterval(
 
 	return backoffInterval
 }
+
+func matchRetryReasonPolicy(
+	reasonPolicies []*types.RetryReasonPolicy,
+	failureReason string,
+) *types.RetryPolicy {
+
+	for _, reasonPolicy := range reasonPolicies {
+		// patterns are validated when the policy is accepted, so they compile from the cache
+		if pattern, err := common.CompileRetryReasonPattern(reasonPolicy.GetReasonPattern()); err == nil && pattern.MatchString(failureReason) {
+			return reasonPolicy.GetRetryPolicy()
+		}
+	}
+	return nil
+}
+
+func addRetryJitter(
+	interval time.Duration,
+	jitterMode types.RetryJitterMode,
+) time.Duration {
+
+	switch jitterMode {
+	case types.RetryJitterModeFull:
+		// anywhere between the minimum retry backoff and the full interval
+		if interval <= time.Second {
+			return interval
+		}
+		return time.Second + time.Duration(rand.Int63n(int64(interval-time.Second)+1))
+	case types.RetryJitterModeEqual:
+		// keep half of the interval and randomize the other half
+		half := interval / 2
+		return interval - half + time.Duration(rand.Int63n(int64(half)+1))
+	default:
+		return interval
+	}
+}
+
+func earliestExpirationTime(
+	expirationTime time.Time,
+	otherExpirationTime time.Time,
+) time.Time {
+
+	if expirationTime.IsZero() || (!otherExpirationTime.IsZero() && otherExpirationTime.Before(expirationTime)) {
+		return otherExpirationTime
+	}
+	return expirationTime
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 func Test_NextRetry(t *testing.T) {
@@ -56,6 +57,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 
 	// no retry if cancel requested
@@ -71,6 +75,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 
 	// no retry if both MaximumAttempts and ExpirationTime are not set
@@ -85,6 +92,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 
 	// no retry if MaximumAttempts is 1 (for initial attempt)
@@ -100,6 +110,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 
 	// backoff retry, intervals: 1s, 2s, 4s, 8s.
@@ -115,6 +128,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 	ai.Attempt++
 
@@ -128,6 +144,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 	ai.Attempt++
 
@@ -141,6 +160,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 	ai.Attempt++
 
@@ -156,6 +178,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 
 	reason = "good-reason"
@@ -170,6 +195,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 	ai.Attempt++
 
@@ -185,6 +213,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 
 	// increase max attempts, with max interval cap at 10s
@@ -200,6 +231,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 	ai.Attempt++
 
@@ -216,6 +250,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 
 	// extend expiration, next interval should be 10s
@@ -230,6 +267,9 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 	ai.Attempt++
 
@@ -246,6 +286,128 @@ func Test_NextRetry(t *testing.T) {
 		ai.BackoffCoefficient,
 		reason,
 		ai.NonRetriableErrors,
+		ai.RetryBackoffSchedule,
+		ai.RetryJitterMode,
+		ai.RetryReasonPolicies,
 	))
 	ai.Attempt++
 }
+
+func Test_NextRetry_BackoffSchedule(t *testing.T) {
+	a := assert.New(t)
+	now, _ := time.Parse(time.RFC3339, "2018-04-13T16:08:08+00:00")
+	schedule := []int32{1, 5, 30}
+
+	// the last interval of the schedule repeats
+	for attempt, expected := range []time.Duration{time.Second, 5 * time.Second, 30 * time.Second, 30 * time.Second} {
+		a.Equal(expected, getBackoffInterval(
+			now, time.Time{}, int32(attempt), 10, 0, 0, 0, "reason", nil, schedule, types.RetryJitterModeNone, nil,
+		))
+	}
+
+	// schedule is capped by MaximumInterval
+	a.Equal(10*time.Second, getBackoffInterval(
+		now, time.Time{}, 2, 10, 0, 10, 0, "reason", nil, schedule, types.RetryJitterModeNone, nil,
+	))
+
+	// no retry past expiration
+	a.Equal(backoff.NoBackoff, getBackoffInterval(
+		now, now.Add(10*time.Second), 2, 0, 0, 0, 0, "reason", nil, schedule, types.RetryJitterModeNone, nil,
+	))
+}
+
+func Test_NextRetry_Jitter(t *testing.T) {
+	a := assert.New(t)
+	now, _ := time.Parse(time.RFC3339, "2018-04-13T16:08:08+00:00")
+
+	for i := 0; i < 100; i++ {
+		interval := getBackoffInterval(now, time.Time{}, 2, 10, 1, 0, 2, "reason", nil, nil, types.RetryJitterModeFull, nil)
+		a.True(interval >= time.Second && interval <= 4*time.Second, interval)
+
+		interval = getBackoffInterval(now, time.Time{}, 2, 10, 1, 0, 2, "reason", nil, nil, types.RetryJitterModeEqual, nil)
+		a.True(interval >= 2*time.Second && interval <= 4*time.Second, interval)
+	}
+
+	// full jitter never retries sooner than a second
+	a.Equal(time.Second, addRetryJitter(time.Second, types.RetryJitterModeFull))
+}
+
+func Test_NextRetry_ReasonPolicies(t *testing.T) {
+	a := assert.New(t)
+	now, _ := time.Parse(time.RFC3339, "2018-04-13T16:08:08+00:00")
+	reasonPolicies := []*types.RetryReasonPolicy{
+		{
+			ReasonPattern: "^throttled",
+			RetryPolicy: &types.RetryPolicy{
+				BackoffScheduleInSeconds: []int32{60},
+				MaximumAttempts:          20,
+			},
+		},
+		{
+			ReasonPattern: "invalid",
+			RetryPolicy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 1,
+				BackoffCoefficient:       1,
+				NonRetriableErrorReasons: []string{"invalid input"},
+			},
+		},
+		{
+			ReasonPattern: "^quota",
+			RetryPolicy: &types.RetryPolicy{
+				MaximumAttempts:          10,
+				MaximumIntervalInSeconds: 30,
+			},
+		},
+	}
+
+	// no matching policy uses the top level policy
+	a.Equal(4*time.Second, getBackoffInterval(
+		now, time.Time{}, 2, 5, 1, 0, 2, "timeout", nil, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+
+	// matching policy overrides the backoff and maximum attempts
+	a.Equal(time.Minute, getBackoffInterval(
+		now, time.Time{}, 2, 5, 1, 0, 2, "throttled by server", nil, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+	a.Equal(time.Minute, getBackoffInterval(
+		now, time.Time{}, 10, 5, 1, 0, 2, "throttled by server", nil, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+
+	// matching policy keeps the maximum attempts of the top level policy if not set
+	a.Equal(time.Second, getBackoffInterval(
+		now, time.Time{}, 2, 5, 1, 0, 2, "invalid state", nil, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+	a.Equal(backoff.NoBackoff, getBackoffInterval(
+		now, time.Time{}, 4, 5, 1, 0, 2, "invalid state", nil, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+
+	// non retriable reasons come from the matching policy
+	a.Equal(backoff.NoBackoff, getBackoffInterval(
+		now, time.Time{}, 2, 5, 1, 0, 2, "invalid input", nil, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+
+	// partially specified policy only overrides the fields it sets
+	a.Equal(4*time.Second, getBackoffInterval(
+		now, time.Time{}, 2, 5, 1, 0, 2, "quota exceeded", nil, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+	a.Equal(30*time.Second, getBackoffInterval(
+		now, time.Time{}, 6, 5, 1, 0, 2, "quota exceeded", nil, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+	a.Equal(backoff.NoBackoff, getBackoffInterval(
+		now, time.Time{}, 2, 5, 1, 0, 2, "quota exceeded", []string{"quota exceeded"}, nil, types.RetryJitterModeNone, reasonPolicies,
+	))
+	a.Equal(10*time.Second, getBackoffInterval(
+		now, time.Time{}, 1, 5, 1, 0, 2, "quota exceeded", nil, []int32{5, 10}, types.RetryJitterModeNone, reasonPolicies,
+	))
+}
+
+func Test_EarliestExpirationTime(t *testing.T) {
+	a := assert.New(t)
+	now := time.Now()
+
+	a.True(earliestExpirationTime(time.Time{}, time.Time{}).IsZero())
+	a.Equal(now, earliestExpirationTime(now, time.Time{}))
+	a.Equal(now, earliestExpirationTime(time.Time{}, now))
+	a.Equal(now, earliestExpirationTime(now.Add(time.Minute), now))
+	a.Equal(now, earliestExpirationTime(now, now.Add(time.Minute)))
+}
@@ -515,6 +515,12 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeUpsertWorkflowMemo:
+			b.mutableState.ReplicateUpsertWorkflowMemoEvent(event)
+			if err := taskGenerator.GenerateWorkflowSearchAttrTasks(); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCompleted:
 			if err := b.mutableState.ReplicateWorkflowExecutionCompletedEvent(
 				firstEvent.GetEventID(),
@@ -599,6 +605,8 @@ func (b *stateBuilderImpl) ApplyEvents(
 					b.logger,
 					b.mutableState.GetDomainEntry(),
 				)
+				// request IDs are kept per workflow ID, same as the active side when continuing as new
+				newRunMutableStateBuilder.GetExecutionInfo().DedupRequestIDs = b.mutableState.GetExecutionInfo().CopyDedupRequestIDs()
 				newRunStateBuilder := NewStateBuilder(b.shard, b.logger, newRunMutableStateBuilder, b.taskGeneratorProvider)
 				newRunID := event.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunID()
 				newExecution := types.WorkflowExecution{
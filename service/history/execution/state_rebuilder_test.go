@@ -225,7 +225,7 @@ func (s *stateRebuilderSuite) TestPagination() {
 func (s *stateRebuilderSuite) TestRebuild() {
 	requestID := uuid.New()
 	version := int64(12)
-	lastEventID := int64(2)
+	lastEventID := int64(3)
 	branchToken := []byte("other random branch token")
 	targetBranchToken := []byte("some other random branch token")
 	now := time.Now()
@@ -248,6 +248,10 @@ func (s *stateRebuilderSuite) TestRebuild() {
 			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(123),
 			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(233),
 			Identity:                            "some random identity",
+			Memo: &types.Memo{Fields: map[string][]byte{
+				"some random memo key": []byte("some random memo value"),
+				"some other memo key":  []byte("some other memo value"),
+			}},
 		},
 	}}
 	events2 := []*types.HistoryEvent{{
@@ -259,6 +263,15 @@ func (s *stateRebuilderSuite) TestRebuild() {
 			Input:      []byte("some random signal input"),
 			Identity:   "some random identity",
 		},
+	}, {
+		EventID:   3,
+		Version:   version,
+		EventType: types.EventTypeUpsertWorkflowMemo.Ptr(),
+		UpsertWorkflowMemoEventAttributes: &types.UpsertWorkflowMemoEventAttributes{
+			Memo: &types.Memo{Fields: map[string][]byte{
+				"some random memo key": []byte("some updated memo value"),
+			}},
+		},
 	}}
 	history1 := []*types.History{{Events: events1}}
 	history2 := []*types.History{{Events: events2}}
@@ -323,6 +336,10 @@ func (s *stateRebuilderSuite) TestRebuild() {
 	s.Equal(targetDomainID, rebuildExecutionInfo.DomainID)
 	s.Equal(targetWorkflowID, rebuildExecutionInfo.WorkflowID)
 	s.Equal(targetRunID, rebuildExecutionInfo.RunID)
+	s.Equal(map[string][]byte{
+		"some random memo key": []byte("some updated memo value"),
+		"some other memo key":  []byte("some other memo value"),
+	}, rebuildExecutionInfo.Memo)
 	s.Equal(int64(historySize1+historySize2), rebuiltHistorySize)
 	s.Equal(persistence.NewVersionHistories(
 		persistence.NewVersionHistory(
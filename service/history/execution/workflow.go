@@ -274,6 +274,7 @@ func (r *workflowImpl) terminateWorkflow(
 		WorkflowTerminationReason,
 		[]byte(fmt.Sprintf("terminated by version: %v", incomingLastWriteVersion)),
 		WorkflowTerminationIdentity,
+		"",
 	)
 
 	return err
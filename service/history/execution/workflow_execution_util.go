@@ -31,6 +31,7 @@ func TerminateWorkflow(
 	terminateReason string,
 	terminateDetails []byte,
 	terminateIdentity string,
+	terminateRequestID string,
 ) error {
 
 	if decision, ok := mutableState.GetInFlightDecision(); ok {
@@ -48,6 +49,7 @@ func TerminateWorkflow(
 		terminateReason,
 		terminateDetails,
 		terminateIdentity,
+		terminateRequestID,
 	)
 	return err
 }
@@ -254,6 +254,11 @@ func (g grpcHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) ExpediteWorkflowExecutionStart(ctx context.Context, request *historyv1.ExpediteWorkflowExecutionStartRequest) (*historyv1.ExpediteWorkflowExecutionStartResponse, error) {
+	err := g.h.ExpediteWorkflowExecutionStart(withGRPCTag(ctx), proto.ToHistoryExpediteWorkflowExecutionStartRequest(request))
+	return &historyv1.ExpediteWorkflowExecutionStartResponse{}, proto.FromError(err)
+}
+
 func withGRPCTag(ctx context.Context) context.Context {
 	return metrics.TagContext(ctx, metrics.GPRCTransportTag())
 }
@@ -26,7 +26,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -41,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/shardplacement"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -66,6 +66,7 @@ type (
 		DescribeMutableState(context.Context, *types.DescribeMutableStateRequest) (*types.DescribeMutableStateResponse, error)
 		DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
 		DescribeWorkflowExecution(context.Context, *types.HistoryDescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error)
+		ExpediteWorkflowExecutionStart(context.Context, *types.HistoryExpediteWorkflowExecutionStartRequest) error
 		GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 		GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 		GetMutableState(context.Context, *types.GetMutableStateRequest) (*types.GetMutableStateResponse, error)
@@ -751,6 +752,14 @@ func (h *handlerImpl) DescribeHistoryHost(
 		ShardControllerStatus: status,
 		Address:               h.GetHostInfo().GetAddress(),
 	}
+	for _, breaker := range h.GetPersistenceBean().GetCircuitBreakerStatus() {
+		resp.PersistenceCircuitBreakers = append(resp.PersistenceCircuitBreakers, &types.PersistenceCircuitBreakerInfo{
+			StoreName:                breaker.StoreName,
+			OperationClass:           breaker.OperationClass,
+			State:                    breaker.State.String(),
+			LastStateChangeTimestamp: breaker.LastStateChange.UnixNano(),
+		})
+	}
 	return resp, nil
 }
 
@@ -1205,6 +1214,47 @@ func (h *handlerImpl) TerminateWorkflowExecution(
 	return nil
 }
 
+// ExpediteWorkflowExecutionStart schedules the first decision task of a workflow execution
+// that is still waiting for its delayed start
+func (h *handlerImpl) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	wrappedRequest *types.HistoryExpediteWorkflowExecutionStartRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryExpediteWorkflowExecutionStartScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.ExpediteRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ExpediteWorkflowExecutionStart(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *handlerImpl) ResetWorkflowExecution(
@@ -1889,7 +1939,7 @@ func (h *handlerImpl) convertError(err error) error {
 	switch err.(type) {
 	case *persistence.ShardOwnershipLostError:
 		shardID := err.(*persistence.ShardOwnershipLostError).ShardID
-		info, err := h.GetHistoryServiceResolver().Lookup(strconv.Itoa(shardID))
+		info, err := h.GetHistoryServiceResolver().Lookup(shardplacement.ShardKey(shardID))
 		if err == nil {
 			return shard.CreateShardOwnershipLostError(h.GetHostInfo().GetAddress(), info.GetAddress())
 		}
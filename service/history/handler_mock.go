@@ -706,3 +706,17 @@ func (mr *MockHandlerMockRecorder) TerminateWorkflowExecution(arg0, arg1 interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
+
+// ExpediteWorkflowExecutionStart mocks base method
+func (m *MockHandler) ExpediteWorkflowExecutionStart(arg0 context.Context, arg1 *types.HistoryExpediteWorkflowExecutionStartRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpediteWorkflowExecutionStart", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpediteWorkflowExecutionStart indicates an expected call of ExpediteWorkflowExecutionStart
+func (mr *MockHandlerMockRecorder) ExpediteWorkflowExecutionStart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpediteWorkflowExecutionStart", reflect.TypeOf((*MockHandler)(nil).ExpediteWorkflowExecutionStart), arg0, arg1)
+}
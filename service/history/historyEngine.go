@@ -47,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	cndc "github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quarantine"
 	"github.com/uber/cadence/common/reconciliation/invariant"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
@@ -675,7 +676,16 @@ func (e *historyEngineImpl) startWorkflowHelper(
 		}
 
 		prevRunID = t.RunID
-		if shouldTerminateAndStart(startRequest, t.State) {
+		if shouldUseExistingWorkflow(startRequest, t.State) {
+			return &types.StartWorkflowExecutionResponse{
+				RunID: t.RunID,
+			}, nil
+		}
+		if shouldTerminateAndStart(
+			startRequest.StartRequest.WorkflowIDConflictPolicy,
+			startRequest.StartRequest.GetWorkflowIDReusePolicy(),
+			t.State,
+		) {
 			runningWFCtx, err := workflow.LoadOnce(ctx, e.executionCache, domainID, workflowID, prevRunID)
 			if err != nil {
 				return nil, err
@@ -732,11 +742,29 @@ func (e *historyEngineImpl) startWorkflowHelper(
 }
 
 func shouldTerminateAndStart(
+	conflictPolicy *types.WorkflowIDConflictPolicy,
+	reusePolicy types.WorkflowIDReusePolicy,
+	state int,
+) bool {
+	if !isWorkflowStateRunning(state) {
+		return false
+	}
+	if conflictPolicy != nil {
+		return *conflictPolicy == types.WorkflowIDConflictPolicyTerminateExisting
+	}
+	return reusePolicy == types.WorkflowIDReusePolicyTerminateIfRunning
+}
+
+func shouldUseExistingWorkflow(
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
 	state int,
 ) bool {
-	return startRequest.StartRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning &&
-		(state == persistence.WorkflowStateRunning || state == persistence.WorkflowStateCreated)
+	return isWorkflowStateRunning(state) &&
+		startRequest.StartRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyUseExisting
+}
+
+func isWorkflowStateRunning(state int) bool {
+	return state == persistence.WorkflowStateRunning || state == persistence.WorkflowStateCreated
 }
 
 // terminate running workflow then start a new run in one transaction
@@ -762,6 +790,7 @@ UpdateWorkflowLoop:
 			TerminateIfRunningReason,
 			getTerminateIfRunningDetails(workflowExecution.GetRunID()),
 			execution.IdentityHistoryService,
+			"",
 		); err != nil {
 			if err == workflow.ErrStaleState {
 				// Handler detected that cached workflow mutable could potentially be stale
@@ -850,7 +879,8 @@ func (e *historyEngineImpl) addStartEventsAndTasks(
 		_, err := mutableState.AddWorkflowExecutionSignaled(
 			sRequest.GetSignalName(),
 			sRequest.GetSignalInput(),
-			sRequest.GetIdentity())
+			sRequest.GetIdentity(),
+			sRequest.GetRequestID())
 		if err != nil {
 			return &types.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
 		}
@@ -864,6 +894,15 @@ func (e *historyEngineImpl) addStartEventsAndTasks(
 	)
 }
 
+func (e *historyEngineImpl) emitDuplicateRequestDropped(
+	scope int,
+	domainName string,
+) {
+	e.metricsClient.Scope(scope).
+		Tagged(metrics.DomainTag(domainName)).
+		IncCounter(metrics.DuplicateRequestDroppedCounter)
+}
+
 func getTerminateIfRunningDetails(newRunID string) []byte {
 	return []byte(fmt.Sprintf(TerminateIfRunningDetailsTemplate, newRunID))
 }
@@ -1272,6 +1311,7 @@ func (e *historyEngineImpl) queryDirectlyThroughMatching(
 		DomainUUID:   domainID,
 		QueryRequest: queryRequest,
 		TaskList:     msResp.TaskList,
+		BuildID:      msResp.BuildID,
 	}
 
 	nonStickyStopWatch := scope.StartTimer(metrics.DirectQueryDispatchNonStickyLatency)
@@ -1332,6 +1372,7 @@ func (e *historyEngineImpl) getMutableState(
 		WorkflowState:                        common.Int32Ptr(int32(workflowState)),
 		WorkflowCloseState:                   common.Int32Ptr(int32(workflowCloseState)),
 		IsStickyTaskListEnabled:              mutableState.IsStickyTaskListEnabled(),
+		BuildID:                              executionInfo.BuildID(),
 	}
 	versionHistories := mutableState.GetVersionHistories()
 	if versionHistories != nil {
@@ -1482,8 +1523,19 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	backoffDuration := time.Duration(startEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstDecisionTaskBackoffSeconds()) * time.Second
+	startAttributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+	backoffDuration := time.Duration(startAttributes.GetFirstDecisionTaskBackoffSeconds()) * time.Second
 	result.WorkflowExecutionInfo.ExecutionTime = common.Int64Ptr(result.WorkflowExecutionInfo.GetStartTime() + backoffDuration.Nanoseconds())
+	if !executionInfo.NextRetryTime.IsZero() &&
+		(!mutableState.IsWorkflowExecutionRunning() || executionInfo.NextRetryTime.After(e.timeSource.Now())) {
+		// the run is waiting for its retry backoff, or was retried by a new run
+		result.WorkflowExecutionInfo.NextRetryTimestamp = common.Int64Ptr(executionInfo.NextRetryTime.UnixNano())
+	} else if startAttributes.GetInitiator() == types.ContinueAsNewInitiatorRetryPolicy &&
+		mutableState.IsWorkflowExecutionRunning() &&
+		result.WorkflowExecutionInfo.GetExecutionTime() > e.timeSource.Now().UnixNano() {
+		// runs persisted before the next retry time was recorded
+		result.WorkflowExecutionInfo.NextRetryTimestamp = result.WorkflowExecutionInfo.ExecutionTime
+	}
 
 	if executionInfo.ParentRunID != "" {
 		result.WorkflowExecutionInfo.ParentExecution = &types.WorkflowExecution{
@@ -1506,6 +1558,11 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			return nil, err
 		}
 		result.WorkflowExecutionInfo.CloseTime = common.Int64Ptr(completionEvent.GetTimestamp())
+		if continuedAsNew := completionEvent.GetWorkflowExecutionContinuedAsNewEventAttributes(); continuedAsNew != nil &&
+			continuedAsNew.GetInitiator() == types.ContinueAsNewInitiatorRetryPolicy {
+			retryBackoff := time.Duration(continuedAsNew.GetBackoffStartIntervalInSeconds()) * time.Second
+			result.WorkflowExecutionInfo.NextRetryTimestamp = common.Int64Ptr(completionEvent.GetTimestamp() + retryBackoff.Nanoseconds())
+		}
 	}
 
 	if len(mutableState.GetPendingActivityInfos()) > 0 {
@@ -1549,6 +1606,9 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 				if ai.LastWorkerIdentity != "" {
 					p.LastWorkerIdentity = ai.LastWorkerIdentity
 				}
+				if ai.Attempt > 0 && state == types.PendingActivityStateScheduled && ai.ScheduledTime.After(e.timeSource.Now()) {
+					p.NextRetryTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
+				}
 			}
 			result.PendingActivities = append(result.PendingActivities, p)
 		}
@@ -2081,6 +2141,12 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 
 	return workflow.UpdateCurrentWithActionFunc(ctx, e.executionCache, e.executionManager, domainID, workflowExecution, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			// deduplicate by request id before workflow running check, same as signal
+			if requestID := request.GetRequestID(); requestID != "" && mutableState.IsRequestDuplicated(requestID) {
+				e.emitDuplicateRequestDropped(metrics.HistoryRequestCancelWorkflowExecutionScope, domainEntry.GetInfo().Name)
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, workflow.ErrAlreadyCompleted
 			}
@@ -2106,10 +2172,28 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 				return nil, workflow.ErrCancellationAlreadyRequested
 			}
 
+			eventBatchFirstEventID := mutableState.GetNextEventID()
 			if _, err := mutableState.AddWorkflowExecutionCancelRequestedEvent("", req); err != nil {
 				return nil, &types.InternalServiceError{Message: "Unable to cancel workflow execution."}
 			}
 
+			// the workflow has not started running yet if its delayed start is still pending,
+			// there is nothing for the workflow to clean up so the pending start is canceled right away
+			delayedStart, err := isPendingDelayedStart(ctx, mutableState)
+			if err != nil {
+				return nil, err
+			}
+			if delayedStart {
+				if _, err := mutableState.AddWorkflowExecutionCanceledEvent(
+					eventBatchFirstEventID,
+					common.EmptyEventID,
+					&types.CancelWorkflowExecutionDecisionAttributes{},
+				); err != nil {
+					return nil, &types.InternalServiceError{Message: "Unable to cancel workflow execution."}
+				}
+				return workflow.UpdateWithoutDecision, nil
+			}
+
 			return workflow.UpdateWithNewDecision, nil
 		})
 }
@@ -2147,8 +2231,11 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			// first deduplicate by request id for signal decision
 			// this is done before workflow running check so that already completed error
 			// won't be returned for duplicated signals even if the workflow is closed.
+			// The signaled event records the request ID for deduplication, signal requested IDs
+			// are only checked for the workflows which recorded them before.
 			if requestID := request.GetRequestID(); requestID != "" {
-				if mutableState.IsSignalRequested(requestID) {
+				if mutableState.IsSignalRequested(requestID) || mutableState.IsRequestDuplicated(requestID) {
+					e.emitDuplicateRequestDropped(metrics.HistorySignalWorkflowExecutionScope, domainEntry.GetInfo().Name)
 					return &workflow.UpdateAction{
 						Noop:           true,
 						CreateDecision: false,
@@ -2162,8 +2249,13 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 
 			executionInfo := mutableState.GetExecutionInfo()
 			createDecisionTask := true
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
+			// Do not create decision task when the workflow is cron or delayed start,
+			// and the workflow has not been started yet
+			delayedStart, err := isPendingDelayedStart(ctx, mutableState)
+			if err != nil {
+				return nil, err
+			}
+			if delayedStart || (executionInfo.CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision()) {
 				createDecisionTask = false
 			}
 
@@ -2185,14 +2277,11 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 				}
 			}
 
-			if requestID := request.GetRequestID(); requestID != "" {
-				mutableState.AddSignalRequested(requestID)
-			}
-
 			if _, err := mutableState.AddWorkflowExecutionSignaled(
 				request.GetSignalName(),
 				request.GetInput(),
-				request.GetIdentity()); err != nil {
+				request.GetIdentity(),
+				request.GetRequestID()); err != nil {
 				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
 
@@ -2239,13 +2328,30 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				}
 				return nil, err1
 			}
+			// deduplicate by request id before workflow running check, so that a duplicated request
+			// won't restart a closed workflow or terminate a running one
+			if requestID := sRequest.GetRequestID(); requestID != "" && mutableState.IsRequestDuplicated(requestID) {
+				e.emitDuplicateRequestDropped(metrics.HistorySignalWithStartWorkflowExecutionScope, domainEntry.GetInfo().Name)
+				return &types.StartWorkflowExecutionResponse{RunID: wfContext.GetExecution().RunID}, nil
+			}
 			// workflow exist but not running, will restart workflow then signal
 			if !mutableState.IsWorkflowExecutionRunning() {
 				prevMutableState = mutableState
 				break
 			}
-			// workflow is running, if policy is TerminateIfRunning, terminate current run then signalWithStart
-			if sRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning {
+			// workflow is running, if policy is Fail, reject the request without signaling the current run
+			if sRequest.WorkflowIDConflictPolicy != nil &&
+				sRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyFail {
+				msg := "Workflow execution is already running. WorkflowId: %v, RunId: %v."
+				executionInfo := mutableState.GetExecutionInfo()
+				return nil, getWorkflowAlreadyStartedError(msg, executionInfo.CreateRequestID, executionInfo.WorkflowID, executionInfo.RunID)
+			}
+			// workflow is running, if policy is TerminateExisting or TerminateIfRunning, terminate current run then signalWithStart
+			if shouldTerminateAndStart(
+				sRequest.WorkflowIDConflictPolicy,
+				sRequest.GetWorkflowIDReusePolicy(),
+				mutableState.GetExecutionInfo().State,
+			) {
 				workflowExecution.RunID = uuid.New()
 				runningWFCtx := workflow.NewContext(wfContext, release, mutableState)
 				resp, errTerm := e.terminateAndStartWorkflow(
@@ -2277,12 +2383,18 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 			if _, err := mutableState.AddWorkflowExecutionSignaled(
 				sRequest.GetSignalName(),
 				sRequest.GetSignalInput(),
-				sRequest.GetIdentity()); err != nil {
+				sRequest.GetIdentity(),
+				sRequest.GetRequestID()); err != nil {
 				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
 
-			// Create a transfer task to schedule a decision task
-			if !mutableState.HasPendingDecision() {
+			// Create a transfer task to schedule a decision task, unless the workflow is a delayed start
+			// which has not been started yet
+			delayedStart, err := isPendingDelayedStart(ctx, mutableState)
+			if err != nil {
+				return nil, err
+			}
+			if !delayedStart && !mutableState.HasPendingDecision() {
 				_, err := mutableState.AddDecisionTaskScheduledEvent(false)
 				if err != nil {
 					return nil, &types.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -2382,6 +2494,13 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		workflowExecution,
 		e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			// deduplicate by request id before workflow running check, same as signal
+			requestID := request.GetRequestID()
+			if requestID != "" && mutableState.IsRequestDuplicated(requestID) {
+				e.emitDuplicateRequestDropped(metrics.HistoryTerminateWorkflowExecutionScope, domainEntry.GetInfo().Name)
+				return &workflow.UpdateAction{Noop: true}, nil
+			}
+
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, workflow.ErrAlreadyCompleted
 			}
@@ -2393,10 +2512,71 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 				request.GetReason(),
 				request.GetDetails(),
 				request.GetIdentity(),
+				requestID,
 			)
 		})
 }
 
+// ExpediteWorkflowExecutionStart schedules the first decision task of a workflow execution which is
+// still waiting for its delayed start, it is a noop if the first decision task is already scheduled
+func (e *historyEngineImpl) ExpediteWorkflowExecutionStart(
+	ctx context.Context,
+	expediteRequest *types.HistoryExpediteWorkflowExecutionStartRequest,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(expediteRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := expediteRequest.ExpediteRequest
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: request.WorkflowExecution.WorkflowID,
+		RunID:      request.WorkflowExecution.RunID,
+	}
+
+	return workflow.UpdateCurrentWithActionFunc(
+		ctx,
+		e.executionCache,
+		e.executionManager,
+		domainID,
+		workflowExecution,
+		e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) (*workflow.UpdateAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, workflow.ErrAlreadyCompleted
+			}
+
+			if mutableState.HasProcessedOrPendingDecision() {
+				return &workflow.UpdateAction{
+					Noop: true,
+				}, nil
+			}
+
+			// the workflow backoff timer task becomes a noop once the first decision task is scheduled
+			return workflow.UpdateWithNewDecision, nil
+		})
+}
+
+// isPendingDelayedStart returns true if the workflow is the first run of a delayed start
+// and its first decision task is not scheduled yet. Only the started event of the first run
+// records the delay, runs continued as new with a backoff are not delayed starts.
+func isPendingDelayedStart(
+	ctx context.Context,
+	mutableState execution.MutableState,
+) (bool, error) {
+
+	if mutableState.HasProcessedOrPendingDecision() || mutableState.GetExecutionInfo().CronSchedule != "" {
+		return false, nil
+	}
+	startEvent, err := mutableState.GetStartEvent(ctx)
+	if err != nil {
+		return false, err
+	}
+	return startEvent.GetWorkflowExecutionStartedEventAttributes().GetDelayStartSeconds() > 0, nil
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx context.Context,
@@ -2658,6 +2838,14 @@ func (e *historyEngineImpl) NotifyNewTimerTasks(
 	}
 }
 
+func (e *historyEngineImpl) GetExecutionCacheSize() int {
+	return e.executionCache.Size()
+}
+
+func (e *historyEngineImpl) GetExecutionCacheSizeByDomain() map[string]uint64 {
+	return e.executionCache.GetSizeByDomain()
+}
+
 func (e *historyEngineImpl) ResetTransferQueue(
 	ctx context.Context,
 	clusterName string,
@@ -2829,6 +3017,8 @@ func getStartRequest(
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
 		DelayStartSeconds:                   request.DelayStartSeconds,
+		IsolationGroup:                      request.IsolationGroup,
+		WorkflowIDConflictPolicy:            request.WorkflowIDConflictPolicy,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now())
@@ -3079,8 +3269,13 @@ func (e *historyEngineImpl) ReapplyEvents(
 			postActions := &workflow.UpdateAction{
 				CreateDecision: true,
 			}
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
+			// Do not create decision task when the workflow is cron or delayed start,
+			// and the workflow has not been started yet
+			delayedStart, err := isPendingDelayedStart(ctx, mutableState)
+			if err != nil {
+				return nil, err
+			}
+			if delayedStart || (mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision()) {
 				postActions.CreateDecision = false
 			}
 			reappliedEvents, err := e.eventsReapplier.ReapplyEvents(
@@ -3170,6 +3365,19 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	}
 	domainID := domainEntry.GetInfo().ID
 
+	// the tasks are refreshed when a quarantined workflow execution is released,
+	// which may be before the quarantine registry of this host is refreshed
+	workflowQuarantine := e.shard.GetService().GetWorkflowQuarantine()
+	if workflowQuarantine.GetEntry(quarantine.Execution{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+	}).IsQuarantined() {
+		if err := workflowQuarantine.Refresh(ctx); err != nil {
+			return err
+		}
+	}
+
 	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
 	if err != nil {
 		return err
@@ -1015,6 +1015,112 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
 	s.Nil(resp)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_ConflictPolicy() {
+	domainID := constants.TestDomainID
+	workflowID := "workflowID"
+	runID := "runID"
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	lastWriteVersion := common.EmptyVersion
+
+	policies := []types.WorkflowIDConflictPolicy{
+		types.WorkflowIDConflictPolicyFail,
+		types.WorkflowIDConflictPolicyUseExisting,
+	}
+	expectedErrs := []bool{true, false}
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Times(len(policies))
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            runID,
+		State:            p.WorkflowStateRunning,
+		CloseStatus:      p.WorkflowCloseStatusNone,
+		LastWriteVersion: lastWriteVersion,
+	}).Times(len(policies))
+
+	for index, policy := range policies {
+		resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &types.HistoryStartWorkflowExecutionRequest{
+			DomainUUID: domainID,
+			StartRequest: &types.StartWorkflowExecutionRequest{
+				Domain:                              domainID,
+				WorkflowID:                          workflowID,
+				WorkflowType:                        &types.WorkflowType{Name: workflowType},
+				TaskList:                            &types.TaskList{Name: taskList},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+				Identity:                            identity,
+				RequestID:                           "newRequestID",
+				WorkflowIDConflictPolicy:            policy.Ptr(),
+			},
+		})
+		if expectedErrs[index] {
+			s.IsType(&types.WorkflowExecutionAlreadyStartedError{}, err)
+			s.Nil(resp)
+		} else {
+			s.Nil(err)
+			s.Equal(runID, resp.GetRunID())
+		}
+	}
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_TerminateExisting() {
+	domainID := constants.TestDomainID
+	we := types.WorkflowExecution{
+		WorkflowID: "workflowID",
+		RunID:      constants.TestRunID,
+	}
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	lastWriteVersion := common.EmptyVersion
+
+	msBuilder := s.createExecutionStartedState(we, taskList, identity, false)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil)
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &p.WorkflowExecutionAlreadyStartedError{
+		Msg:              "random message",
+		StartRequestID:   "oldRequestID",
+		RunID:            we.GetRunID(),
+		State:            p.WorkflowStateRunning,
+		CloseStatus:      p.WorkflowCloseStatusNone,
+		LastWriteVersion: lastWriteVersion,
+	}).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, we.GetWorkflowID(), we.GetRunID(), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionTerminated.Ptr(),
+		WorkflowExecutionTerminatedEventAttributes: &types.WorkflowExecutionTerminatedEventAttributes{
+			Reason: TerminateIfRunningReason,
+		},
+	}, nil).AnyTimes()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return request.UpdateWorkflowMutation.ExecutionInfo.CloseStatus == p.WorkflowCloseStatusTerminated &&
+			request.NewWorkflowSnapshot != nil
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &types.HistoryStartWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:                              domainID,
+			WorkflowID:                          we.GetWorkflowID(),
+			WorkflowType:                        &types.WorkflowType{Name: workflowType},
+			TaskList:                            &types.TaskList{Name: taskList},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            identity,
+			RequestID:                           "newRequestID",
+			WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyTerminateExisting.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.NotEqual(we.GetRunID(), resp.GetRunID())
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 	domainID := constants.TestDomainID
 	workflowID := "workflowID"
@@ -1200,6 +1306,10 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 		runID,
 		constants.TestLocalDomainEntry,
 	)
+	startEvent := test.AddWorkflowExecutionStartedEvent(msBuilder, types.WorkflowExecution{WorkflowID: workflowID, RunID: runID}, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, workflowID, runID, common.FirstEventID, common.FirstEventID, gomock.Any(),
+	).Return(startEvent, nil).AnyTimes()
 	ms := execution.CreatePersistenceMutableState(msBuilder)
 	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
@@ -1216,6 +1326,89 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	s.Equal(runID, resp.GetRunID())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_StillRunning_ConflictPolicyFail() {
+	domainID := constants.TestDomainID
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	identity := "testIdentity"
+	sRequest := &types.HistorySignalWithStartWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		SignalWithStartRequest: &types.SignalWithStartWorkflowExecutionRequest{
+			Domain:                   domainID,
+			WorkflowID:               we.GetWorkflowID(),
+			Identity:                 identity,
+			SignalName:               "my signal name",
+			RequestID:                "newRequestID",
+			WorkflowIDConflictPolicy: types.WorkflowIDConflictPolicyFail.Ptr(),
+		},
+	}
+
+	msBuilder := s.createExecutionStartedState(we, "testTaskList", identity, false)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &p.GetCurrentExecutionResponse{RunID: we.GetRunID()}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.IsType(&types.WorkflowExecutionAlreadyStartedError{}, err)
+	s.Equal(we.GetRunID(), err.(*types.WorkflowExecutionAlreadyStartedError).RunID)
+	s.Nil(resp)
+}
+
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_StillRunning_ConflictPolicyTerminateExisting() {
+	domainID := constants.TestDomainID
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	sRequest := &types.HistorySignalWithStartWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		SignalWithStartRequest: &types.SignalWithStartWorkflowExecutionRequest{
+			Domain:                              domainID,
+			WorkflowID:                          we.GetWorkflowID(),
+			WorkflowType:                        &types.WorkflowType{Name: "workflowType"},
+			TaskList:                            &types.TaskList{Name: taskList},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            identity,
+			SignalName:                          "my signal name",
+			RequestID:                           "newRequestID",
+			WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyTerminateExisting.Ptr(),
+		},
+	}
+
+	msBuilder := s.createExecutionStartedState(we, taskList, identity, false)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &p.GetCurrentExecutionResponse{RunID: we.GetRunID()}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil)
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, we.GetWorkflowID(), we.GetRunID(), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&types.HistoryEvent{
+		EventType: types.EventTypeWorkflowExecutionTerminated.Ptr(),
+		WorkflowExecutionTerminatedEventAttributes: &types.WorkflowExecutionTerminatedEventAttributes{
+			Reason: TerminateIfRunningReason,
+		},
+	}, nil).AnyTimes()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return request.UpdateWorkflowMutation.ExecutionInfo.CloseStatus == p.WorkflowCloseStatusTerminated &&
+			request.NewWorkflowSnapshot != nil
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.NotEqual(we.GetRunID(), resp.GetRunID())
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 	sRequest := &types.HistorySignalWithStartWorkflowExecutionRequest{}
 	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
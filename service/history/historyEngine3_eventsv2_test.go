@@ -330,6 +330,10 @@ func (s *engine3Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 		runID,
 		constants.TestLocalDomainEntry,
 	)
+	startEvent := test.AddWorkflowExecutionStartedEvent(msBuilder, types.WorkflowExecution{WorkflowID: workflowID, RunID: runID}, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, workflowID, runID, common.FirstEventID, common.FirstEventID, gomock.Any(),
+	).Return(startEvent, nil).AnyTimes()
 	ms := execution.CreatePersistenceMutableState(msBuilder)
 	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
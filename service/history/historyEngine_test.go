@@ -5044,6 +5044,163 @@ func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowCompl
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WithinDedupWindow() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        "my signal name",
+			Input:             []byte("test input"),
+			RequestID:         requestID,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	// assume duplicate request id carried over from the previous run
+	ms.ExecutionInfo.DedupRequestIDs = map[string]time.Time{requestID: time.Now()}
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_OutsideDedupWindow() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        "my signal name",
+			Input:             []byte("test input"),
+			RequestID:         requestID,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	window := s.mockHistoryEngine.config.RequestDedupWindow(constants.TestDomainName)
+	ms.ExecutionInfo.DedupRequestIDs = map[string]time.Time{requestID: time.Now().Add(-window)}
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		// the request ID is only recorded for deduplication, not as a signal requested ID
+		receivedTime, ok := request.UpdateWorkflowMutation.ExecutionInfo.DedupRequestIDs[requestID]
+		return ok && receivedTime.After(time.Now().Add(-window)) && len(request.UpdateWorkflowMutation.UpsertSignalRequestedIDs) == 0
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestRequestCancelWorkflowExecution_DuplicateRequest_WorkflowCompleted() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	identity := "testIdentity"
+	requestID := uuid.New()
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DedupRequestIDs = map[string]time.Time{requestID: time.Now()}
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RequestCancelWorkflowExecution(context.Background(), &types.HistoryRequestCancelWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			RequestID:         requestID,
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestTerminateWorkflowExecution_DuplicateRequest_WorkflowCompleted() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	identity := "testIdentity"
+	requestID := uuid.New()
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DedupRequestIDs = map[string]time.Time{requestID: time.Now()}
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.TerminateWorkflowExecution(context.Background(), &types.HistoryTerminateWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		TerminateRequest: &types.TerminateWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Reason:            "test reason",
+			Identity:          identity,
+			RequestID:         requestID,
+		},
+	})
+	s.Nil(err)
+}
+
 func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
 	we := &types.WorkflowExecution{
 		WorkflowID: constants.TestWorkflowID,
@@ -5082,6 +5239,187 @@ func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
 	s.EqualError(err, "WorkflowExecutionAlreadyCompletedError{Message: workflow execution already completed}")
 }
 
+func (s *engineSuite) TestRequestCancelWorkflowExecution_PendingStart() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	// the first decision task is not scheduled while the workflow start is delayed
+	executionBuilder := s.requestCancelDelayedWorkflowExecution(we, 0, "", nil, 10)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.GetExecutionInfo().State)
+	s.Equal(persistence.WorkflowCloseStatusCanceled, executionBuilder.GetExecutionInfo().CloseStatus)
+	s.False(executionBuilder.HasPendingDecision())
+
+	completionEvent, err := executionBuilder.GetCompletionEvent(context.Background())
+	s.NoError(err)
+	s.Equal(types.EventTypeWorkflowExecutionCanceled, completionEvent.GetEventType())
+	s.Equal(common.EmptyEventID, completionEvent.WorkflowExecutionCanceledEventAttributes.GetDecisionTaskCompletedEventID())
+}
+
+func (s *engineSuite) TestRequestCancelWorkflowExecution_PendingCronRun() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	// the cancellation is delivered to the workflow with its first decision task
+	executionBuilder := s.requestCancelDelayedWorkflowExecution(we, 0, "* * * * *", types.ContinueAsNewInitiatorCronSchedule.Ptr(), 0)
+	s.True(executionBuilder.IsWorkflowExecutionRunning())
+	s.True(executionBuilder.HasPendingDecision())
+	isCancelRequested, _ := executionBuilder.IsCancelRequested()
+	s.True(isCancelRequested)
+}
+
+func (s *engineSuite) TestRequestCancelWorkflowExecution_PendingRetryRun() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	// the cancellation is delivered to the workflow with its first decision task
+	executionBuilder := s.requestCancelDelayedWorkflowExecution(we, 1, "", types.ContinueAsNewInitiatorRetryPolicy.Ptr(), 0)
+	s.True(executionBuilder.IsWorkflowExecutionRunning())
+	s.True(executionBuilder.HasPendingDecision())
+	isCancelRequested, _ := executionBuilder.IsCancelRequested()
+	s.True(isCancelRequested)
+}
+
+func (s *engineSuite) TestRequestCancelWorkflowExecution_PendingBackoffWithoutDelayStart() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+
+	// only the started event of a delayed start records the delay, other first decision backoffs get the cancellation
+	executionBuilder := s.requestCancelDelayedWorkflowExecution(we, 0, "", nil, 0)
+	s.True(executionBuilder.IsWorkflowExecutionRunning())
+	s.True(executionBuilder.HasPendingDecision())
+	isCancelRequested, _ := executionBuilder.IsCancelRequested()
+	s.True(isCancelRequested)
+}
+
+func (s *engineSuite) requestCancelDelayedWorkflowExecution(
+	we types.WorkflowExecution,
+	attempt int32,
+	cronSchedule string,
+	initiator *types.ContinueAsNewInitiator,
+	delayStartSeconds int32,
+) execution.MutableState {
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	_, err := msBuilder.AddWorkflowExecutionStartedEvent(we, &types.HistoryStartWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			WorkflowID:                          we.WorkflowID,
+			WorkflowType:                        &types.WorkflowType{Name: "wType"},
+			TaskList:                            &types.TaskList{Name: "testTaskList"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            identity,
+			CronSchedule:                        cronSchedule,
+			DelayStartSeconds:                   common.Int32Ptr(delayStartSeconds),
+		},
+		Attempt:                         attempt,
+		ContinueAsNewInitiator:          initiator,
+		FirstDecisionTaskBackoffSeconds: common.Int32Ptr(10),
+	})
+	s.NoError(err)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.RequestCancelWorkflowExecution(context.Background(), &types.HistoryRequestCancelWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		CancelRequest: &types.RequestCancelWorkflowExecutionRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	})
+	s.NoError(err)
+
+	return s.getBuilder(constants.TestDomainID, we)
+}
+
+func (s *engineSuite) TestExpediteWorkflowExecutionStart() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.ExpediteWorkflowExecutionStart(context.Background(), &types.HistoryExpediteWorkflowExecutionStartRequest{
+		DomainUUID: constants.TestDomainID,
+		ExpediteRequest: &types.ExpediteWorkflowExecutionStartRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.True(executionBuilder.HasPendingDecision())
+}
+
+func (s *engineSuite) TestExpediteWorkflowExecutionStart_AlreadyStarted() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.ExpediteWorkflowExecutionStart(context.Background(), &types.HistoryExpediteWorkflowExecutionStartRequest{
+		DomainUUID: constants.TestDomainID,
+		ExpediteRequest: &types.ExpediteWorkflowExecutionStartRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+		},
+	})
+	s.Nil(err)
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	removeRequest := &types.RemoveSignalMutableStateRequest{}
 	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
@@ -5137,6 +5475,7 @@ func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 		workflowExecution.GetRunID(),
 		constants.TestLocalDomainEntry,
 	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
 	ms := execution.CreatePersistenceMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: constants.TestRunID}
@@ -84,6 +84,7 @@ func (s *eventReapplicationSuite) TestReapplyEvents_AppliedEvent() {
 			Identity:   "test",
 			SignalName: "signal",
 			Input:      []byte{},
+			RequestID:  "request-id",
 		},
 	}
 	attr := event.WorkflowExecutionSignaledEventAttributes
@@ -96,6 +97,7 @@ func (s *eventReapplicationSuite) TestReapplyEvents_AppliedEvent() {
 		attr.GetSignalName(),
 		attr.GetInput(),
 		attr.GetIdentity(),
+		attr.GetRequestID(),
 	).Return(event, nil).Times(1)
 	dedupResource := definition.NewEventReappliedID(runID, event.GetEventID(), event.GetVersion())
 	msBuilderCurrent.EXPECT().IsResourceDuplicated(dedupResource).Return(false).Times(1)
@@ -166,6 +168,7 @@ func (s *eventReapplicationSuite) TestReapplyEvents_PartialAppliedEvent() {
 		attr1.GetSignalName(),
 		attr1.GetInput(),
 		attr1.GetIdentity(),
+		attr1.GetRequestID(),
 	).Return(event1, nil).Times(1)
 	dedupResource1 := definition.NewEventReappliedID(runID, event1.GetEventID(), event1.GetVersion())
 	msBuilderCurrent.EXPECT().IsResourceDuplicated(dedupResource1).Return(false).Times(1)
@@ -206,6 +209,7 @@ func (s *eventReapplicationSuite) TestReapplyEvents_Error() {
 		attr.GetSignalName(),
 		attr.GetInput(),
 		attr.GetIdentity(),
+		attr.GetRequestID(),
 	).Return(nil, fmt.Errorf("test")).Times(1)
 	dedupResource := definition.NewEventReappliedID(runID, event.GetEventID(), event.GetVersion())
 	msBuilderCurrent.EXPECT().IsResourceDuplicated(dedupResource).Return(false).Times(1)
@@ -151,6 +151,9 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	}
 	defer resetWorkflow.GetReleaseFn()(retError)
 
+	// request IDs are kept per workflow ID so that requests sent before the reset are still deduplicated
+	resetWorkflow.GetMutableState().GetExecutionInfo().DedupRequestIDs = currentMutableState.GetExecutionInfo().CopyDedupRequestIDs()
+
 	return r.persistToDB(
 		ctx,
 		currentWorkflowTerminated,
@@ -443,6 +446,7 @@ func (r *workflowResetterImpl) terminateWorkflow(
 		terminateReason,
 		nil,
 		execution.IdentityHistoryService,
+		"",
 	)
 }
 
@@ -583,6 +587,7 @@ func (r *workflowResetterImpl) reapplyEvents(
 				attr.GetSignalName(),
 				attr.GetInput(),
 				attr.GetIdentity(),
+				attr.GetRequestID(),
 			); err != nil {
 				return err
 			}
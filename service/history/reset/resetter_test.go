@@ -353,6 +353,7 @@ func (s *workflowResetterSuite) TestTerminateWorkflow() {
 		terminateReason,
 		([]byte)(nil),
 		execution.IdentityHistoryService,
+		"",
 	).Return(&types.HistoryEvent{}, nil).Times(1)
 
 	err := s.workflowResetter.terminateWorkflow(mutableState, terminateReason)
@@ -596,6 +597,7 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 			SignalName: "some random signal name",
 			Input:      []byte("some random signal input"),
 			Identity:   "some random signal identity",
+			RequestID:  "some random signal request ID",
 		},
 	}
 	event2 := &types.HistoryEvent{
@@ -623,6 +625,7 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 				attr.GetSignalName(),
 				attr.GetInput(),
 				attr.GetIdentity(),
+				attr.GetRequestID(),
 			).Return(&types.HistoryEvent{}, nil).Times(1)
 		}
 	}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/quarantine"
 	"github.com/uber/cadence/common/resource"
 	rc "github.com/uber/cadence/common/resource/config"
 	"github.com/uber/cadence/common/service"
@@ -36,13 +37,15 @@ import (
 type Resource interface {
 	resource.Resource
 	GetEventCache() events.Cache
+	GetWorkflowQuarantine() quarantine.Registry
 }
 
 type resourceImpl struct {
 	status int32
 
 	resource.Resource
-	eventCache events.Cache
+	eventCache         events.Cache
+	workflowQuarantine quarantine.Registry
 }
 
 // Start starts all resources
@@ -57,6 +60,7 @@ func (h *resourceImpl) Start() {
 	}
 
 	h.Resource.Start()
+	h.workflowQuarantine.Start()
 	h.GetLogger().Info("history resource started", tag.LifeCycleStarted)
 }
 
@@ -71,6 +75,7 @@ func (h *resourceImpl) Stop() {
 		return
 	}
 
+	h.workflowQuarantine.Stop()
 	h.Resource.Stop()
 	h.GetLogger().Info("history resource stopped", tag.LifeCycleStopped)
 }
@@ -80,6 +85,11 @@ func (h *resourceImpl) GetEventCache() events.Cache {
 	return h.eventCache
 }
 
+// GetWorkflowQuarantine return the registry of quarantined workflow executions
+func (h *resourceImpl) GetWorkflowQuarantine() quarantine.Registry {
+	return h.workflowQuarantine
+}
+
 // New create a new resource containing common history dependencies
 func New(
 	params *service.BootstrapParams,
@@ -122,9 +132,18 @@ func New(
 		uint64(config.EventsCacheMaxSize()),
 	)
 
+	workflowQuarantine := quarantine.NewRegistry(
+		quarantine.NewStore(
+			serviceResource.GetPersistenceBean().GetWorkflowQuarantineQueueManager(),
+			serviceResource.GetTimeSource(),
+		),
+		params.Logger,
+	)
+
 	historyResource = &resourceImpl{
-		Resource:   serviceResource,
-		eventCache: eventCache,
+		Resource:           serviceResource,
+		eventCache:         eventCache,
+		workflowQuarantine: workflowQuarantine,
 	}
 	return
 }
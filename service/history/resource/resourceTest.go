@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quarantine"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/service/history/events"
 )
@@ -32,7 +33,8 @@ type (
 	// Test is the test implementation used for testing
 	Test struct {
 		*resource.Test
-		EventCache *events.MockCache
+		EventCache         *events.MockCache
+		WorkflowQuarantine *quarantine.MockRegistry
 	}
 )
 
@@ -43,9 +45,13 @@ func NewTest(
 	controller *gomock.Controller,
 	serviceMetricsIndex metrics.ServiceIdx,
 ) *Test {
+	workflowQuarantine := quarantine.NewMockRegistry(controller)
+	workflowQuarantine.EXPECT().GetEntry(gomock.Any()).Return(nil).AnyTimes()
+
 	return &Test{
-		Test:       resource.NewTest(controller, serviceMetricsIndex),
-		EventCache: events.NewMockCache(controller),
+		Test:               resource.NewTest(controller, serviceMetricsIndex),
+		EventCache:         events.NewMockCache(controller),
+		WorkflowQuarantine: workflowQuarantine,
 	}
 }
 
@@ -53,3 +59,8 @@ func NewTest(
 func (s *Test) GetEventCache() events.Cache {
 	return s.EventCache
 }
+
+// GetWorkflowQuarantine for testing
+func (s *Test) GetWorkflowQuarantine() quarantine.Registry {
+	return s.WorkflowQuarantine
+}
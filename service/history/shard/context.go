@@ -165,6 +165,9 @@ type (
 
 		// true if previous owner was different from the acquirer's identity.
 		previousShardOwnerWasDifferent bool
+
+		// identity of the host this shard is being handed off to, empty if no handoff is in progress
+		handOffOwner string
 	}
 )
 
@@ -185,6 +188,7 @@ const (
 	logWarnTimerLevelDiff    = time.Duration(30 * time.Minute)
 	historySizeLogThreshold  = 10 * 1024 * 1024
 	minContextTimeout        = 1 * time.Second
+	shardHandoffPollInterval = 100 * time.Millisecond
 )
 
 func (s *contextImpl) GetShardID() int {
@@ -604,6 +608,10 @@ func (s *contextImpl) CreateWorkflowExecution(
 	s.Lock()
 	defer s.Unlock()
 
+	if err := s.checkHandOffLocked(); err != nil {
+		return nil, err
+	}
+
 	transferMaxReadLevel := int64(0)
 	if err := s.allocateTaskIDsLocked(
 		domainEntry,
@@ -708,6 +716,10 @@ func (s *contextImpl) UpdateWorkflowExecution(
 	s.Lock()
 	defer s.Unlock()
 
+	if err := s.checkHandOffLocked(); err != nil {
+		return nil, err
+	}
+
 	transferMaxReadLevel := int64(0)
 	if err := s.allocateTaskIDsLocked(
 		domainEntry,
@@ -818,6 +830,10 @@ func (s *contextImpl) ConflictResolveWorkflowExecution(
 	s.Lock()
 	defer s.Unlock()
 
+	if err := s.checkHandOffLocked(); err != nil {
+		return nil, err
+	}
+
 	transferMaxReadLevel := int64(0)
 	if request.CurrentWorkflowMutation != nil {
 		if err := s.allocateTaskIDsLocked(
@@ -1019,7 +1035,54 @@ func (s *contextImpl) closeShard() {
 	atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeID)
 }
 
+// prepareHandOff makes the shard reject new writes with ShardOwnershipLostError
+// so that callers get redirected to the new owner while the shard is handed off.
+func (s *contextImpl) prepareHandOff(newOwner string) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.handOffOwner = newOwner
+}
+
+// handOff persists the latest shard info with the new owner, so that the new owner
+// can take over the shard without stealing it, and then closes the shard.
+// It should be called after the engine is stopped so that the ack levels are final.
+func (s *contextImpl) handOff() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isClosed() {
+		return ErrShardClosed
+	}
+	if s.handOffOwner == "" {
+		return fmt.Errorf("shard %v is not being handed off", s.shardID)
+	}
+
+	s.shardInfo.Owner = s.handOffOwner
+	err := s.forceUpdateShardInfoLocked()
+
+	// the shard item is already removed from the controller, so there is no need for the close callback
+	if atomic.CompareAndSwapInt32(&s.closed, 0, 1) {
+		s.shardInfo.RangeID = -1
+		atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeID)
+	}
+	return err
+}
+
+func (s *contextImpl) checkHandOffLocked() error {
+	if s.handOffOwner == "" {
+		return nil
+	}
+	return &persistence.ShardOwnershipLostError{
+		ShardID: s.shardID,
+		Msg:     fmt.Sprintf("Shard is being handed off to host: %v", s.handOffOwner),
+	}
+}
+
 func (s *contextImpl) generateTransferTaskIDLocked() (int64, error) {
+	if err := s.checkHandOffLocked(); err != nil {
+		return -1, err
+	}
 	if err := s.updateRangeIfNeededLocked(); err != nil {
 		return -1, err
 	}
@@ -1354,6 +1417,10 @@ func (s *contextImpl) ReplicateFailoverMarkers(
 	s.Lock()
 	defer s.Unlock()
 
+	if err := s.checkHandOffLocked(); err != nil {
+		return err
+	}
+
 	transferMaxReadLevel := int64(0)
 	if err := s.allocateTransferIDsLocked(
 		tasks,
@@ -1459,7 +1526,7 @@ func (s *contextImpl) ValidateAndUpdateFailoverMarkers() ([]*types.FailoverMarke
 func acquireShard(
 	shardItem *historyShardsItem,
 	closeCallback func(int, *historyShardsItem),
-) (Context, error) {
+) (*contextImpl, error) {
 
 	var shardInfo *persistence.ShardInfo
 
@@ -1502,6 +1569,8 @@ func acquireShard(
 		return nil, err
 	}
 
+	handedOff := shardItem.isHandedOff() && shardInfo.Owner == shardItem.GetHostInfo().Identity()
+
 	updatedShardInfo := shardInfo.Copy()
 	ownershipChanged := shardInfo.Owner != shardItem.GetHostInfo().Identity()
 	updatedShardInfo.Owner = shardItem.GetHostInfo().Identity()
@@ -1578,10 +1647,86 @@ func acquireShard(
 
 	context.logger.Debug(fmt.Sprintf("Global event cache mode: %v", context.config.EventsCacheGlobalEnable()))
 
-	err1 := context.renewRangeLocked(true)
+	err1 := context.renewRangeLocked(!handedOff)
 	if err1 != nil {
 		return nil, err1
 	}
 
 	return context, nil
 }
+
+// waitForShardHandOff waits for the previous owner of the shard, if it is still a member of the ring,
+// to hand the shard off to this host, and closes the handoff channel of the shard item when the handoff
+// completes or ShardHandoffTimeout elapses. The shard is stolen after the timeout.
+// It runs in its own goroutine, so the shard item lock is not held while waiting.
+func waitForShardHandOff(
+	shardItem *historyShardsItem,
+) {
+
+	defer close(shardItem.handOffCh)
+
+	getShardInfo := func() (*persistence.ShardInfo, error) {
+		resp, err := shardItem.GetShardManager().GetShard(context.Background(), &persistence.GetShardRequest{
+			ShardID: shardItem.shardID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.ShardInfo, nil
+	}
+
+	shardInfo, err := getShardInfo()
+	if err != nil {
+		// the shard is created or the error is surfaced when the shard is acquired
+		return
+	}
+	hostIdentity := shardItem.GetHostInfo().Identity()
+	if shardInfo.Owner == "" || shardInfo.Owner == hostIdentity {
+		return
+	}
+	if !isHistoryHostAlive(shardItem, shardInfo.Owner) {
+		return
+	}
+
+	sw := shardItem.GetMetricsClient().StartTimer(metrics.ShardInfoScope, metrics.ShardHandoffWaitLatency)
+	defer sw.Stop()
+
+	previousOwner := shardInfo.Owner
+	timer := time.NewTimer(shardItem.config.ShardHandoffTimeout())
+	defer timer.Stop()
+	ticker := time.NewTicker(shardHandoffPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-shardItem.stopCh:
+			return
+		case <-timer.C:
+			shardItem.GetMetricsClient().IncCounter(metrics.ShardInfoScope, metrics.ShardHandoffTimeoutCounter)
+			shardItem.logger.Warn("Timed out waiting for shard handoff, stealing shard.", tag.PreviousShardOwner(previousOwner))
+			return
+		case <-ticker.C:
+			shardInfo, err := getShardInfo()
+			if err != nil {
+				shardItem.logger.Warn("Failed to get shard while waiting for handoff.", tag.Error(err))
+				continue
+			}
+			if shardInfo.Owner == hostIdentity {
+				shardItem.logger.Info("Shard handed off by previous owner.", tag.PreviousShardOwner(previousOwner))
+				shardItem.handedOff = true
+				return
+			}
+		}
+	}
+}
+
+func isHistoryHostAlive(
+	shardItem *historyShardsItem,
+	identity string,
+) bool {
+	for _, member := range shardItem.GetHistoryServiceResolver().Members() {
+		if member.Identity() == identity {
+			return true
+		}
+	}
+	return false
+}
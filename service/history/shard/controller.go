@@ -23,6 +23,7 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -34,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/shardplacement"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -42,6 +44,10 @@ import (
 
 const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
+
+	shardPlacementTimeout = 10 * time.Second
+	// balancerShardID is the shard whose owner runs the shard balancer
+	balancerShardID = 0
 )
 
 type (
@@ -81,6 +87,9 @@ type (
 		throttledLogger    log.Logger
 		config             *config.Config
 		metricsScope       metrics.Scope
+		placement          shardplacement.Store
+		// cachedDomains are the domains which had workflow executions in the history cache at the last report
+		cachedDomains map[string]struct{}
 
 		sync.RWMutex
 		historyShards map[int]*historyShardsItem
@@ -96,9 +105,20 @@ type (
 		logger          log.Logger
 		throttledLogger log.Logger
 		engineFactory   EngineFactory
+		// requests is the number of requests to the shard since the last load report
+		requests int64
+
+		// handOffCh is closed when the previous owner has handed the shard off to this host
+		// or the handoff timed out, so that engine creation waits without holding the item lock
+		handOffCh   chan struct{}
+		handOffOnce sync.Once
+		handedOff   bool
+		// stopCh is closed when the shard item is stopped
+		stopCh chan struct{}
 
 		sync.RWMutex
 		status historyShardsItemStatus
+		shard  *contextImpl
 		engine engine.Engine
 	}
 )
@@ -127,6 +147,7 @@ func NewShardController(
 		throttledLogger:    resource.GetThrottledLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
 		config:             config,
 		metricsScope:       resource.GetMetricsClient().Scope(metrics.HistoryShardControllerScope),
+		placement:          shardplacement.NewStore(resource.GetPersistenceBean().GetShardPlacementQueueManager(), resource.GetTimeSource()),
 	}
 }
 
@@ -146,6 +167,8 @@ func newHistoryShardsItem(
 		config:          config,
 		logger:          resource.GetLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
 		throttledLogger: resource.GetThrottledLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
+		handOffCh:       make(chan struct{}),
+		stopCh:          make(chan struct{}),
 	}, nil
 }
 
@@ -155,8 +178,9 @@ func (c *controller) Start() {
 	}
 
 	c.acquireShards()
-	c.shutdownWG.Add(1)
+	c.shutdownWG.Add(2)
 	go c.shardManagementPump()
+	go c.shardPlacementPump()
 
 	err := c.GetHistoryServiceResolver().AddListener(shardControllerMembershipUpdateListenerName, c.membershipUpdateCh)
 	if err != nil {
@@ -201,6 +225,7 @@ func (c *controller) GetEngineForShard(shardID int) (engine.Engine, error) {
 	if err != nil {
 		return nil, err
 	}
+	atomic.AddInt64(&item.requests, 1)
 	return item.getOrCreateEngine(c.shardClosedCallback)
 }
 
@@ -278,7 +303,7 @@ func (c *controller) getOrCreateHistoryShardItem(shardID int) (*historyShardsIte
 	if c.isShuttingDown() || atomic.LoadInt32(&c.status) == common.DaemonStatusStopped {
 		return nil, fmt.Errorf("controller for host '%v' shutting down", c.GetHostInfo().Identity())
 	}
-	info, err := c.GetHistoryServiceResolver().Lookup(string(rune(shardID)))
+	info, err := c.GetHistoryServiceResolver().Lookup(shardplacement.ShardKey(shardID))
 	if err != nil {
 		return nil, err
 	}
@@ -378,7 +403,7 @@ func (c *controller) acquireShards() {
 				if c.isShuttingDown() {
 					return
 				}
-				info, err := c.GetHistoryServiceResolver().Lookup(string(rune(shardID)))
+				info, err := c.GetHistoryServiceResolver().Lookup(shardplacement.ShardKey(shardID))
 				if err != nil {
 					c.logger.Error("Error looking up host for shardID", tag.Error(err), tag.OperationFailed, tag.ShardID(shardID))
 				} else {
@@ -388,6 +413,8 @@ func (c *controller) acquireShards() {
 							c.metricsScope.IncCounter(metrics.GetEngineForShardErrorCounter)
 							c.logger.Error("Unable to create history shard engine", tag.Error(err1), tag.OperationFailed, tag.ShardID(shardID))
 						}
+					} else if c.config.EnableGracefulShardHandoff() {
+						c.handOffShard(shardID, info)
 					}
 				}
 			}
@@ -407,6 +434,221 @@ func (c *controller) acquireShards() {
 	c.metricsScope.UpdateGauge(metrics.NumShardsGauge, float64(c.NumShards()))
 }
 
+// handOffShard releases a shard this host no longer owns according to the membership ring.
+// The shard item is removed first, so that new requests are redirected to the new owner
+// while the engine is being stopped.
+func (c *controller) handOffShard(shardID int, newOwner *membership.HostInfo) {
+	c.RLock()
+	_, ok := c.historyShards[shardID]
+	c.RUnlock()
+	if !ok {
+		return
+	}
+
+	c.metricsScope.IncCounter(metrics.ShardHandoffCounter)
+	sw := c.metricsScope.StartTimer(metrics.ShardHandoffLatency)
+	defer sw.Stop()
+
+	c.logger.Info("Handing off shard", tag.ShardID(shardID), tag.ShardOwner(newOwner.Identity()))
+	item, err := c.removeHistoryShardItem(shardID, nil)
+	if err != nil {
+		return
+	}
+	item.handOffEngine(newOwner.Identity())
+}
+
+// shardPlacementPump reports the load of the shards of this host and, on the owner
+// of the balancer shard, moves shards between hosts in the load placement mode
+func (c *controller) shardPlacementPump() {
+
+	defer c.shutdownWG.Done()
+
+	reportTimer := time.NewTimer(c.config.ShardLoadReportInterval())
+	defer reportTimer.Stop()
+	rebalanceTimer := time.NewTimer(c.config.ShardRebalanceInterval())
+	defer rebalanceTimer.Stop()
+
+	lastReportTime := c.GetTimeSource().Now()
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-reportTimer.C:
+			now := c.GetTimeSource().Now()
+			c.reportShardLoads(now.Sub(lastReportTime))
+			c.emitExecutionCacheSizes()
+			lastReportTime = now
+			reportTimer.Reset(c.config.ShardLoadReportInterval())
+		case <-rebalanceTimer.C:
+			if c.config.ShardPlacementMode() == shardplacement.ModeLoad && c.isShardBalancer() {
+				c.rebalanceShards()
+				c.compactShardPlacement()
+			}
+			rebalanceTimer.Reset(c.config.ShardRebalanceInterval())
+		}
+	}
+}
+
+func (c *controller) reportShardLoads(elapsed time.Duration) {
+	c.RLock()
+	items := make(map[int]*historyShardsItem, len(c.historyShards))
+	for shardID, item := range c.historyShards {
+		items[shardID] = item
+	}
+	c.RUnlock()
+
+	loads := make(map[int]*shardplacement.ShardLoad, len(items))
+	for shardID, item := range items {
+		// the request counts are reset in any mode, so that the first report after switching the mode is accurate
+		requests := atomic.SwapInt64(&item.requests, 0)
+		load, ok := item.getLoad()
+		if !ok {
+			continue
+		}
+		if elapsed > 0 {
+			load.RPS = float64(requests) / elapsed.Seconds()
+		}
+		loads[shardID] = load
+	}
+	if c.config.ShardPlacementMode() != shardplacement.ModeLoad {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shardPlacementTimeout)
+	defer cancel()
+	if err := c.placement.ReportLoads(ctx, c.GetHostInfo().Identity(), loads); err != nil {
+		c.metricsScope.IncCounter(metrics.ShardLoadReportErrorCounter)
+		c.logger.Warn("Failed to report shard loads", tag.Error(err))
+	}
+}
+
+// emitExecutionCacheSizes emits the size of the history caches of all shards on the host by domain
+func (c *controller) emitExecutionCacheSizes() {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	sizes := make(map[string]uint64)
+	for _, item := range items {
+		for domainID, size := range item.getCacheSizeByDomain() {
+			sizes[domainID] += size
+		}
+	}
+	// domains which left the caches are reported once more with zero size
+	for domainID := range c.cachedDomains {
+		if _, ok := sizes[domainID]; !ok {
+			sizes[domainID] = 0
+		}
+	}
+
+	c.cachedDomains = make(map[string]struct{}, len(sizes))
+	for domainID, size := range sizes {
+		domainName, err := c.GetDomainCache().GetDomainName(domainID)
+		if err != nil {
+			continue
+		}
+		if size > 0 {
+			c.cachedDomains[domainID] = struct{}{}
+		}
+		c.GetMetricsClient().Scope(metrics.HistoryCacheSizeStatsScope, metrics.DomainTag(domainName)).
+			UpdateGauge(metrics.CacheSizeInBytesGauge, float64(size))
+	}
+}
+
+func (c *controller) isShardBalancer() bool {
+	info, err := c.GetHistoryServiceResolver().Lookup(shardplacement.ShardKey(balancerShardID))
+	return err == nil && info.Identity() == c.GetHostInfo().Identity()
+}
+
+// rebalanceShards assigns shards from the most to the least loaded hosts according to the recently reported loads,
+// and removes the assignments to hosts which left the ring unless they are pinned
+func (c *controller) rebalanceShards() {
+	c.metricsScope.IncCounter(metrics.ShardRebalanceCounter)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shardPlacementTimeout)
+	defer cancel()
+	placement, err := c.placement.GetPlacement(ctx)
+	if err != nil {
+		c.metricsScope.IncCounter(metrics.ShardRebalanceErrorCounter)
+		c.logger.Warn("Failed to get shard placement", tag.Error(err))
+		return
+	}
+
+	resolver := c.GetHistoryServiceResolver()
+	members := make(map[string]bool)
+	var hosts []string
+	for _, member := range resolver.Members() {
+		members[member.Identity()] = true
+		hosts = append(hosts, member.Identity())
+	}
+	owners := make(map[int]string, c.config.NumberOfShards)
+	for shardID := 0; shardID < c.config.NumberOfShards; shardID++ {
+		info, err := resolver.Lookup(shardplacement.ShardKey(shardID))
+		if err != nil {
+			c.metricsScope.IncCounter(metrics.ShardRebalanceErrorCounter)
+			c.logger.Warn("Failed to look up shard owner", tag.Error(err), tag.ShardID(shardID))
+			return
+		}
+		owners[shardID] = info.Identity()
+	}
+	// loads which are not reported recently belong to shards which moved or hosts which are gone
+	freshLoads := make(map[int]*shardplacement.ShardLoad, len(placement.Loads))
+	oldest := c.GetTimeSource().Now().Add(-2 * c.config.ShardLoadReportInterval())
+	for shardID, load := range placement.Loads {
+		if load.Timestamp.After(oldest) && load.Host == owners[shardID] {
+			freshLoads[shardID] = load
+		}
+	}
+	pinned := make(map[int]bool)
+	for shardID, assignment := range placement.Assignments {
+		pinned[shardID] = assignment.Pinned
+	}
+
+	moves := shardplacement.Rebalance(owners, freshLoads, pinned, hosts, c.config.ShardRebalanceMaxMoves())
+	moved := make(map[int]bool, len(moves))
+	for _, move := range moves {
+		moved[move.ShardID] = true
+	}
+	for shardID, assignment := range placement.Assignments {
+		if !assignment.Pinned && !members[assignment.Host] && !moved[shardID] {
+			moves = append(moves, &shardplacement.Assignment{ShardID: shardID})
+		}
+	}
+	if err := c.placement.Assign(ctx, moves, c.GetHostInfo().Identity(), "rebalance"); err != nil {
+		c.metricsScope.IncCounter(metrics.ShardRebalanceErrorCounter)
+		c.logger.Warn("Failed to assign shards", tag.Error(err))
+		return
+	}
+	for _, move := range moves {
+		if move.Host != "" {
+			c.metricsScope.IncCounter(metrics.ShardMovedCounter)
+			c.logger.Info("Moving shard", tag.ShardID(move.ShardID), tag.PreviousShardOwner(owners[move.ShardID]), tag.ShardOwner(move.Host))
+		}
+	}
+}
+
+// compactShardPlacement replaces the records of the shard placement store with a snapshot after every rebalance,
+// so that the load reports do not pile up in the store every history host reads on refresh
+func (c *controller) compactShardPlacement() {
+	ctx, cancel := context.WithTimeout(context.Background(), shardPlacementTimeout)
+	defer cancel()
+	placement, err := c.placement.GetPlacement(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to get shard placement", tag.Error(err))
+		return
+	}
+	// the store only has the last snapshot
+	if placement.RecordCount <= 1 {
+		return
+	}
+	if err := c.placement.Compact(ctx, placement); err != nil {
+		c.logger.Warn("Failed to compact shard placement", tag.Error(err))
+	}
+}
+
 func (c *controller) doShutdown() {
 	c.logger.Info("Shard controller state changed", tag.LifeCycleStopping)
 	c.Lock()
@@ -431,6 +673,16 @@ func (i *historyShardsItem) getOrCreateEngine(
 	}
 	i.RUnlock()
 
+	if i.config.EnableGracefulShardHandoff() {
+		i.handOffOnce.Do(func() {
+			go waitForShardHandOff(i)
+		})
+		select {
+		case <-i.handOffCh:
+		case <-i.stopCh:
+		}
+	}
+
 	i.Lock()
 	defer i.Unlock()
 	switch i.status {
@@ -441,13 +693,14 @@ func (i *historyShardsItem) getOrCreateEngine(
 			// invalidate the shardItem so that the same shardItem won't be
 			// used to create another shardContext
 			i.logger.Info("Shard engine state changed", tag.LifeCycleStopped, tag.ComponentShardEngine)
-			i.status = historyShardsItemStatusStopped
+			i.setStoppedLocked()
 			return nil, err
 		}
 		if context.PreviousShardOwnerWasDifferent() {
 			i.GetMetricsClient().RecordTimer(metrics.ShardInfoScope, metrics.ShardItemAcquisitionLatency,
 				context.GetCurrentTime(i.GetClusterMetadata().GetCurrentClusterName()).Sub(context.GetLastUpdatedTime()))
 		}
+		i.shard = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStarted, tag.ComponentShardEngine)
@@ -468,13 +721,14 @@ func (i *historyShardsItem) stopEngine() {
 
 	switch i.status {
 	case historyShardsItemStatusInitialized:
-		i.status = historyShardsItemStatusStopped
+		i.setStoppedLocked()
 	case historyShardsItemStatusStarted:
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStopping, tag.ComponentShardEngine)
 		i.engine.Stop()
 		i.engine = nil
+		i.shard = nil
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStopped, tag.ComponentShardEngine)
-		i.status = historyShardsItemStatusStopped
+		i.setStoppedLocked()
 	case historyShardsItemStatusStopped:
 		// no op
 	default:
@@ -482,6 +736,76 @@ func (i *historyShardsItem) stopEngine() {
 	}
 }
 
+// handOffEngine stops the engine and hands the shard off to the new owner.
+// New writes are rejected before the engine is stopped, and the shard info,
+// including the final ack levels, is persisted with the new owner afterwards,
+// so that the new owner can acquire the shard without stealing it.
+func (i *historyShardsItem) handOffEngine(newOwner string) {
+	i.Lock()
+	defer i.Unlock()
+
+	switch i.status {
+	case historyShardsItemStatusInitialized:
+		i.setStoppedLocked()
+	case historyShardsItemStatusStarted:
+		i.logger.Info("Shard engine state changed", tag.LifeCycleStopping, tag.ComponentShardEngine, tag.ShardOwner(newOwner))
+		i.shard.prepareHandOff(newOwner)
+		i.engine.Stop()
+		if err := i.shard.handOff(); err != nil {
+			i.logger.Warn("Failed to hand off shard, new owner will steal it.", tag.Error(err), tag.ShardOwner(newOwner))
+		}
+		i.engine = nil
+		i.shard = nil
+		i.logger.Info("Shard engine state changed", tag.LifeCycleStopped, tag.ComponentShardEngine)
+		i.setStoppedLocked()
+	case historyShardsItemStatusStopped:
+		// no op
+	default:
+		panic(i.logInvalidStatus())
+	}
+}
+
+// setStoppedLocked marks the shard item as stopped and releases the callers waiting for its handoff
+func (i *historyShardsItem) setStoppedLocked() {
+	i.status = historyShardsItemStatusStopped
+	close(i.stopCh)
+}
+
+// isHandedOff returns whether the previous owner has handed the shard off to this host
+func (i *historyShardsItem) isHandedOff() bool {
+	select {
+	case <-i.handOffCh:
+		return i.handedOff
+	default:
+		return false
+	}
+}
+
+// getLoad returns the queue backlog and the cache size of the shard if its engine is started
+func (i *historyShardsItem) getLoad() (*shardplacement.ShardLoad, bool) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if i.status != historyShardsItemStatusStarted {
+		return nil, false
+	}
+	currentCluster := i.GetClusterMetadata().GetCurrentClusterName()
+	return &shardplacement.ShardLoad{
+		QueueBacklog: i.shard.GetTransferMaxReadLevel() - i.shard.GetTransferClusterAckLevel(currentCluster),
+		CacheSize:    int64(i.engine.GetExecutionCacheSize()),
+	}, true
+}
+
+func (i *historyShardsItem) getCacheSizeByDomain() map[string]uint64 {
+	i.RLock()
+	defer i.RUnlock()
+
+	if i.status != historyShardsItemStatusStarted {
+		return nil
+	}
+	return i.engine.GetExecutionCacheSizeByDomain()
+}
+
 func (i *historyShardsItem) isValid() bool {
 	i.RLock()
 	defer i.RUnlock()
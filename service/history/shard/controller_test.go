@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/shardplacement"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -113,7 +114,7 @@ func (s *controllerSuite) TestAcquireShardSuccess() {
 		if hostID == 0 {
 			myShards = append(myShards, shardID)
 			s.mockHistoryEngine.EXPECT().Start().Return().Times(1)
-			s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(s.hostInfo, nil).Times(2)
+			s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(2)
 			s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
 			s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 				&persistence.GetShardResponse{
@@ -168,7 +169,7 @@ func (s *controllerSuite) TestAcquireShardSuccess() {
 			}).Return(nil).Once()
 		} else {
 			ownerHost := fmt.Sprintf("test-acquire-shard-host-%v", hostID)
-			s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(membership.NewHostInfo(ownerHost, nil), nil).Times(1)
+			s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(membership.NewHostInfo(ownerHost, nil), nil).Times(1)
 		}
 	}
 
@@ -203,7 +204,7 @@ func (s *controllerSuite) TestAcquireShardsConcurrently() {
 		if hostID == 0 {
 			myShards = append(myShards, shardID)
 			s.mockHistoryEngine.EXPECT().Start().Return().Times(1)
-			s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(s.hostInfo, nil).Times(2)
+			s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(2)
 			s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
 			s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 				&persistence.GetShardResponse{
@@ -258,7 +259,7 @@ func (s *controllerSuite) TestAcquireShardsConcurrently() {
 			}).Return(nil).Once()
 		} else {
 			ownerHost := fmt.Sprintf("test-acquire-shard-host-%v", hostID)
-			s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(membership.NewHostInfo(ownerHost, nil), nil).Times(1)
+			s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(membership.NewHostInfo(ownerHost, nil), nil).Times(1)
 		}
 	}
 
@@ -278,12 +279,12 @@ func (s *controllerSuite) TestAcquireShardLookupFailure() {
 	numShards := 2
 	s.config.NumberOfShards = numShards
 	for shardID := 0; shardID < numShards; shardID++ {
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(nil, errors.New("ring failure")).Times(1)
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(nil, errors.New("ring failure")).Times(1)
 	}
 
 	s.shardController.acquireShards()
 	for shardID := 0; shardID < numShards; shardID++ {
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(nil, errors.New("ring failure")).Times(1)
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(nil, errors.New("ring failure")).Times(1)
 		s.Nil(s.shardController.GetEngineForShard(shardID))
 	}
 }
@@ -300,7 +301,7 @@ func (s *controllerSuite) TestAcquireShardRenewSuccess() {
 
 	for shardID := 0; shardID < numShards; shardID++ {
 		s.mockHistoryEngine.EXPECT().Start().Return().Times(1)
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(s.hostInfo, nil).Times(2)
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(2)
 		s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
 		s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 			&persistence.GetShardResponse{
@@ -361,7 +362,7 @@ func (s *controllerSuite) TestAcquireShardRenewSuccess() {
 	s.shardController.acquireShards()
 
 	for shardID := 0; shardID < numShards; shardID++ {
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(s.hostInfo, nil).Times(1)
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(1)
 	}
 	s.shardController.acquireShards()
 
@@ -382,7 +383,7 @@ func (s *controllerSuite) TestAcquireShardRenewLookupFailed() {
 
 	for shardID := 0; shardID < numShards; shardID++ {
 		s.mockHistoryEngine.EXPECT().Start().Return().Times(1)
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(s.hostInfo, nil).Times(2)
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(2)
 		s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
 		s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 			&persistence.GetShardResponse{
@@ -443,7 +444,7 @@ func (s *controllerSuite) TestAcquireShardRenewLookupFailed() {
 	s.shardController.acquireShards()
 
 	for shardID := 0; shardID < numShards; shardID++ {
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(nil, errors.New("ring failure")).Times(1)
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(nil, errors.New("ring failure")).Times(1)
 	}
 	s.shardController.acquireShards()
 
@@ -490,7 +491,7 @@ func (s *controllerSuite) TestHistoryEngineClosed() {
 	for shardID := 0; shardID < 2; shardID++ {
 		mockEngine := historyEngines[shardID]
 		mockEngine.EXPECT().Stop().Return().Times(1)
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(differentHostInfo, nil).AnyTimes()
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(differentHostInfo, nil).AnyTimes()
 		s.shardController.shardClosedCallback(shardID, nil)
 	}
 
@@ -535,7 +536,7 @@ func (s *controllerSuite) TestHistoryEngineClosed() {
 	for shardID := 2; shardID < numShards; shardID++ {
 		mockEngine := historyEngines[shardID]
 		mockEngine.EXPECT().Stop().Return().Times(1)
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(s.hostInfo, nil).AnyTimes()
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).AnyTimes()
 	}
 	s.shardController.Stop()
 }
@@ -582,12 +583,129 @@ func (s *controllerSuite) TestShardControllerClosed() {
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockEngine := historyEngines[shardID]
 		mockEngine.EXPECT().Stop().Times(1)
-		s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(s.hostInfo, nil).AnyTimes()
+		s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).AnyTimes()
 	}
 	s.shardController.Stop()
 	workerWG.Wait()
 }
 
+func (s *controllerSuite) TestShardHandOff() {
+	s.config.NumberOfShards = 1
+	shardID := 0
+	newOwner := membership.NewHostInfo("test-handoff-new-owner", nil)
+
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.setupMocksForAcquireShard(shardID, s.mockHistoryEngine, 5, 6)
+	s.shardController.acquireShards()
+	s.Equal(1, s.shardController.NumShards())
+
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(newOwner, nil).Times(2)
+	s.mockHistoryEngine.EXPECT().Stop().Times(1)
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == newOwner.Identity() && request.PreviousRangeID == 6
+	})).Return(nil).Once()
+	s.shardController.acquireShards()
+
+	s.Equal(0, s.shardController.NumShards())
+	_, err := s.shardController.GetEngineForShard(shardID)
+	s.IsType(&types.ShardOwnershipLostError{}, err)
+	s.mockShardManager.AssertExpectations(s.T())
+}
+
+func (s *controllerSuite) TestAcquireShardWaitsForHandOff() {
+	shardID := 0
+	previousOwner := membership.NewHostInfo("test-handoff-previous-owner", nil)
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(5 * time.Second)
+
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(1)
+	s.mockServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{previousOwner, s.hostInfo}).Times(1)
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{ShardID: shardID, Owner: previousOwner.Identity(), RangeID: 5},
+		}, nil).Once()
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{ShardID: shardID, Owner: s.hostInfo.Identity(), RangeID: 5},
+		}, nil).Times(2)
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == s.hostInfo.Identity() &&
+			request.ShardInfo.StolenSinceRenew == 0 &&
+			request.ShardInfo.RangeID == 6
+	})).Return(nil).Once()
+	s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
+	s.mockHistoryEngine.EXPECT().Start().Times(1)
+
+	historyEngine, err := s.shardController.GetEngineForShard(shardID)
+	s.NoError(err)
+	s.NotNil(historyEngine)
+	s.mockShardManager.AssertExpectations(s.T())
+}
+
+func (s *controllerSuite) TestAcquireShardStealsAfterHandOffTimeout() {
+	shardID := 0
+	previousOwner := membership.NewHostInfo("test-handoff-previous-owner", nil)
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(300 * time.Millisecond)
+
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(1)
+	s.mockServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{previousOwner, s.hostInfo}).Times(1)
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{ShardID: shardID, Owner: previousOwner.Identity(), RangeID: 5},
+		}, nil)
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == s.hostInfo.Identity() &&
+			request.ShardInfo.StolenSinceRenew == 1 &&
+			request.PreviousRangeID == 5
+	})).Return(nil).Once()
+	s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(s.mockHistoryEngine).Times(1)
+	s.mockHistoryEngine.EXPECT().Start().Times(1)
+
+	historyEngine, err := s.shardController.GetEngineForShard(shardID)
+	s.NoError(err)
+	s.NotNil(historyEngine)
+	s.mockShardManager.AssertExpectations(s.T())
+}
+
+func (s *controllerSuite) TestStopShardWhileWaitingForHandOff() {
+	shardID := 0
+	previousOwner := membership.NewHostInfo("test-handoff-previous-owner", nil)
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(time.Minute)
+
+	s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(1)
+	s.mockServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{previousOwner, s.hostInfo}).Times(1)
+	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{ShardID: shardID, Owner: previousOwner.Identity(), RangeID: 5},
+		}, nil)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.shardController.GetEngineForShard(shardID)
+		errCh <- err
+	}()
+	s.Eventually(func() bool {
+		return s.shardController.NumShards() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// the item lock is not held while waiting, so the shard can be removed before the handoff timeout
+	s.shardController.RemoveEngineForShard(shardID)
+	select {
+	case err := <-errCh:
+		s.Error(err)
+	case <-time.After(5 * time.Second):
+		s.Fail("timed out waiting for shard engine creation to be released")
+	}
+}
+
 func (s *controllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *engine.MockEngine, currentRangeID,
 	newRangeID int64) {
 
@@ -599,7 +717,7 @@ func (s *controllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *eng
 
 	// s.mockResource.ExecutionMgr.On("Close").Return()
 	mockEngine.EXPECT().Start().Times(1)
-	s.mockServiceResolver.EXPECT().Lookup(string(rune(shardID))).Return(s.hostInfo, nil).Times(2)
+	s.mockServiceResolver.EXPECT().Lookup(shardplacement.ShardKey(shardID)).Return(s.hostInfo, nil).Times(2)
 	s.mockEngineFactory.EXPECT().CreateEngine(gomock.Any()).Return(mockEngine).Times(1)
 	s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: shardID}).Return(
 		&persistence.GetShardResponse{
@@ -116,11 +116,22 @@ type (
 
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
+		priority                       int32
+		fairnessKey                    string
+		buildID                        string
+		isolationGroup                 string
+		attempt                        int64
+		lastHeartbeatTimestamp         *int64
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
+		priority                       int32
+		fairnessKey                    string
+		buildID                        string
+		isolationGroup                 string
+		attempt                        int64
 	}
 )
 
@@ -136,21 +147,43 @@ func newHistoryResendInfo(
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
+	priority int32,
+	fairnessKey string,
+	buildID string,
+	isolationGroup string,
+	attempt int64,
+	lastHeartbeatTimestamp *int64,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priority:                       priority,
+		fairnessKey:                    fairnessKey,
+		buildID:                        buildID,
+		isolationGroup:                 isolationGroup,
+		attempt:                        attempt,
+		lastHeartbeatTimestamp:         lastHeartbeatTimestamp,
 	}
 }
 
 func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
+	priority int32,
+	fairnessKey string,
+	buildID string,
+	isolationGroup string,
+	attempt int64,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
+		priority:                       priority,
+		fairnessKey:                    fairnessKey,
+		buildID:                        buildID,
+		isolationGroup:                 isolationGroup,
+		attempt:                        attempt,
 	}
 }
 
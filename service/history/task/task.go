@@ -273,6 +273,12 @@ func (t *taskImpl) HandleErr(
 		err = nil
 	}
 
+	// tasks of a quarantined workflow execution are regenerated when it is released
+	if err == execution.ErrWorkflowQuarantined {
+		t.scope.IncCounter(metrics.TaskQuarantinedPerDomain)
+		err = nil
+	}
+
 	if err == execution.ErrMissingVersionHistories {
 		t.logger.Error("Encounter 2DC workflow during task processing.")
 		t.scope.IncCounter(metrics.TaskUnsupportedPerDomain)
@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/shard"
 )
 
@@ -153,6 +154,15 @@ func (s *taskSuite) TestHandleErr_ErrTaskDiscarded() {
 	s.NoError(taskBase.HandleErr(err))
 }
 
+func (s *taskSuite) TestHandleErr_ErrWorkflowQuarantined() {
+	taskBase := s.newTestTask(func(task Info) (bool, error) {
+		return true, nil
+	}, nil)
+
+	err := execution.ErrWorkflowQuarantined
+	s.NoError(taskBase.HandleErr(err))
+}
+
 func (s *taskSuite) TestHandleErr_ErrDomainNotActive() {
 	taskBase := s.newTestTask(func(task Info) (bool, error) {
 		return true, nil
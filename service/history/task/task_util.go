@@ -392,6 +392,18 @@ func retryWorkflow(
 	return newMutableState, nil
 }
 
+// getLastHeartbeatTimestamp returns the last heartbeat of an activity, matching uses it
+// to tell whether the previous attempt of the activity made progress
+func getLastHeartbeatTimestamp(
+	activityInfo *persistence.ActivityInfo,
+) *int64 {
+
+	if activityInfo.LastHeartBeatUpdatedTime.IsZero() {
+		return nil
+	}
+	return common.Int64Ptr(activityInfo.LastHeartBeatUpdatedTime.UnixNano())
+}
+
 func getWorkflowExecution(
 	taskInfo Info,
 ) types.WorkflowExecution {
@@ -379,9 +379,12 @@ func (t *timerActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 		return nil
 	}
 
-	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
+	switch task.TimeoutType {
+	case persistence.WorkflowBackoffTimeoutTypeRetry:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
-	} else {
+	case persistence.WorkflowBackoffTimeoutTypeDelayStart:
+		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowDelayStartBackoffTimerCount)
+	default:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowCronBackoffTimerCount)
 	}
 
@@ -471,6 +474,7 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	attempt, lastHeartbeatTimestamp := int64(activityInfo.Attempt), getLastHeartbeatTimestamp(activityInfo)
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -481,6 +485,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		TaskList:                      taskList,
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+		Attempt:                       attempt,
+		LastHeartbeatTimestamp:        lastHeartbeatTimestamp,
 	})
 }
 
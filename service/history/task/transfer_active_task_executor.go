@@ -180,10 +180,14 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	priority, fairnessKey := ai.Priority, ai.FairnessKey
+	buildID := mutableState.GetExecutionInfo().BuildID()
+	isolationGroup := mutableState.GetExecutionInfo().IsolationGroup
+	attempt, lastHeartbeatTimestamp := int64(ai.Attempt), getLastHeartbeatTimestamp(ai)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(ctx, task, timeout)
+	return t.pushActivity(ctx, task, timeout, priority, fairnessKey, buildID, isolationGroup, attempt, lastHeartbeatTimestamp)
 }
 
 func (t *transferActiveTaskExecutor) processDecisionTask(
@@ -241,10 +245,14 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		decisionTimeout = executionInfo.StickyScheduleToStartTimeout
 	}
 
+	priority, fairnessKey := executionInfo.TaskPriority, executionInfo.TaskFairnessKey
+	buildID := executionInfo.BuildID()
+	isolationGroup := executionInfo.IsolationGroup
+	attempt := decision.Attempt
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(ctx, task, taskList, decisionTimeout)
+	return t.pushDecision(ctx, task, taskList, decisionTimeout, priority, fairnessKey, buildID, isolationGroup, attempt)
 }
 
 func (t *transferActiveTaskExecutor) processCloseExecution(
@@ -1365,6 +1373,8 @@ func (t *transferActiveTaskExecutor) startWorkflowWithRetry(
 		Memo:                  attributes.Memo,
 		SearchAttributes:      attributes.SearchAttributes,
 		DelayStartSeconds:     attributes.DelayStartSeconds,
+		Priority:              attributes.Priority,
+		FairnessKey:           attributes.FairnessKey,
 	}
 
 	now := t.shard.GetTimeSource().Now()
@@ -137,6 +137,12 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 		if activityInfo.StartedID == common.EmptyEventID {
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
+				activityInfo.Priority,
+				activityInfo.FairnessKey,
+				mutableState.GetExecutionInfo().BuildID(),
+				mutableState.GetExecutionInfo().IsolationGroup,
+				int64(activityInfo.Attempt),
+				getLastHeartbeatTimestamp(activityInfo),
 			), nil
 		}
 
@@ -185,6 +191,11 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
 				types.TaskList{Name: transferTask.TaskList},
+				executionInfo.TaskPriority,
+				executionInfo.TaskFairnessKey,
+				executionInfo.BuildID(),
+				executionInfo.IsolationGroup,
+				decisionInfo.Attempt,
 			), nil
 		}
 
@@ -561,6 +572,12 @@ func (t *transferStandbyTaskExecutor) pushActivity(
 		ctx,
 		task.(*persistence.TransferTaskInfo),
 		timeout,
+		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
+		pushActivityInfo.buildID,
+		pushActivityInfo.isolationGroup,
+		pushActivityInfo.attempt,
+		pushActivityInfo.lastHeartbeatTimestamp,
 	)
 }
 
@@ -582,6 +599,11 @@ func (t *transferStandbyTaskExecutor) pushDecision(
 		task.(*persistence.TransferTaskInfo),
 		&pushDecisionInfo.tasklist,
 		timeout,
+		pushDecisionInfo.priority,
+		pushDecisionInfo.fairnessKey,
+		pushDecisionInfo.buildID,
+		pushDecisionInfo.isolationGroup,
+		pushDecisionInfo.attempt,
 	)
 }
 
@@ -83,6 +83,12 @@ func (t *transferTaskExecutorBase) pushActivity(
 	ctx context.Context,
 	task *persistence.TransferTaskInfo,
 	activityScheduleToStartTimeout int32,
+	priority int32,
+	fairnessKey string,
+	buildID string,
+	isolationGroup string,
+	attempt int64,
+	lastHeartbeatTimestamp *int64,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -102,6 +108,12 @@ func (t *transferTaskExecutorBase) pushActivity(
 		TaskList:                      &types.TaskList{Name: task.TaskList},
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
+		Priority:                      priority,
+		FairnessKey:                   fairnessKey,
+		BuildID:                       buildID,
+		IsolationGroup:                isolationGroup,
+		Attempt:                       attempt,
+		LastHeartbeatTimestamp:        lastHeartbeatTimestamp,
 	})
 }
 
@@ -110,6 +122,11 @@ func (t *transferTaskExecutorBase) pushDecision(
 	task *persistence.TransferTaskInfo,
 	tasklist *types.TaskList,
 	decisionScheduleToStartTimeout int32,
+	priority int32,
+	fairnessKey string,
+	buildID string,
+	isolationGroup string,
+	attempt int64,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -128,6 +145,11 @@ func (t *transferTaskExecutorBase) pushDecision(
 		TaskList:                      tasklist,
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
+		Priority:                      priority,
+		FairnessKey:                   fairnessKey,
+		BuildID:                       buildID,
+		IsolationGroup:                isolationGroup,
+		Attempt:                       attempt,
 	})
 }
 
@@ -302,6 +302,12 @@ func (t ThriftHandler) TerminateWorkflowExecution(ctx context.Context, request *
 	return thrift.FromError(err)
 }
 
+// ExpediteWorkflowExecutionStart forwards request to the underlying handler
+func (t ThriftHandler) ExpediteWorkflowExecutionStart(ctx context.Context, request *h.ExpediteWorkflowExecutionStartRequest) error {
+	err := t.h.ExpediteWorkflowExecutionStart(withThriftTag(ctx), thrift.ToHistoryExpediteWorkflowExecutionStartRequest(request))
+	return thrift.FromError(err)
+}
+
 func withThriftTag(ctx context.Context) context.Context {
 	return metrics.TagContext(ctx, metrics.ThriftTransportTag())
 }
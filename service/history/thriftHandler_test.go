@@ -106,6 +106,7 @@ func TestThriftHandler(t *testing.T) {
 			ClientImpl:              common.StringPtr(""),
 			EventStoreVersion:       common.Int32Ptr(0),
 			IsStickyTaskListEnabled: common.BoolPtr(false),
+			BuildId:                 common.StringPtr(""),
 		}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
@@ -301,4 +302,9 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &hist.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ExpediteWorkflowExecutionStart", func(t *testing.T) {
+		h.EXPECT().ExpediteWorkflowExecutionStart(taggedCtx, &types.HistoryExpediteWorkflowExecutionStartRequest{}).Return(internalErr).Times(1)
+		err := th.ExpediteWorkflowExecutionStart(ctx, &hist.ExpediteWorkflowExecutionStartRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 }
@@ -49,6 +49,46 @@ type (
 		ForwarderMaxOutstandingTasks dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		ForwarderTopology            dynamicconfig.StringPropertyFn
+
+		// partition scaling configuration
+		EnablePartitionScaling              dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		PartitionScalingInterval            dynamicconfig.DurationPropertyFn
+		PartitionUpscaleRPS                 dynamicconfig.FloatPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleBacklog             dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleFactor            dynamicconfig.FloatPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleSustainedDuration dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		MaxPartitions                       dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+		// task priority and fairness configuration
+		FairnessKeyWeights   dynamicconfig.MapPropertyFn
+		BacklogReadAheadSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+		// worker build ID version sets of task lists
+		MaxWorkerVersionSets      dynamicconfig.IntPropertyFnWithDomainFilter
+		WorkerVersionSetsCacheTTL dynamicconfig.DurationPropertyFn
+
+		// task list pause and migration
+		TaskListStateCacheTTL dynamicconfig.DurationPropertyFn
+
+		// task list DLQ
+		MaxTaskDispatchAttempts       dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		TaskDispatchAttemptsCacheSize dynamicconfig.IntPropertyFn
+
+		// poll admission control
+		MaxOutstandingPollsPerTaskList dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		MaxOutstandingPollsPerHost     dynamicconfig.IntPropertyFn
+		PollShedBackoff                dynamicconfig.DurationPropertyFn
+
+		// isolation groups
+		EnableIsolationGroups       dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		IsolationGroupFallbackDelay dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// task ingestion and dispatch quotas
+		HostTaskIngestionRPS   dynamicconfig.FloatPropertyFn
+		DomainTaskIngestionRPS dynamicconfig.FloatPropertyFn
+		TaskListIngestionRPS   dynamicconfig.FloatPropertyFnWithTaskListInfoFilters
+		DomainTaskDispatchRPS  dynamicconfig.FloatPropertyFn
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
@@ -71,6 +111,9 @@ type (
 		ForwarderMaxOutstandingTasks func() int
 		ForwarderMaxRatePerSecond    func() int
 		ForwarderMaxChildrenPerNode  func() int
+		ForwarderTopology            func() string
+		// number of read partitions of the root task list, the peers a partition can forward polls to
+		ForwarderNumPartitions func() int
 	}
 
 	taskListConfig struct {
@@ -90,36 +133,76 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		EnablePartitionScaling          func() bool
+		// task priority and fairness configuration
+		FairnessKeyWeights   func() map[string]interface{}
+		BacklogReadAheadSize func() int
+		// MaxTaskDispatchAttempts is the number of attempts dispatched without progress after which a task is moved
+		// to the DLQ, 0 disables the DLQ. It applies to all partitions of the task list
+		MaxTaskDispatchAttempts func() int
+		// isolation groups, they apply to all partitions of the task list
+		EnableIsolationGroups       func() bool
+		IsolationGroupFallbackDelay func() time.Duration
+		// the isolation groups drained in the domain config
+		DrainedIsolationGroups func() []string
+		// quotas, the ingestion quota of a task list applies to each partition
+		DomainTaskIngestionRPS func() float64
+		TaskListIngestionRPS   func() float64
+		DomainTaskDispatchRPS  func() float64
 	}
 )
 
 // NewConfig returns new service config with default values
 func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
-		PersistenceGlobalMaxQPS:         dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS, 0),
-		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch, true),
-		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
-		RangeSize:                       100000,
-		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
-		UpdateAckInterval:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval, 1*time.Minute),
-		IdleTasklistCheckInterval:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval, 5*time.Minute),
-		MaxTasklistIdleTime:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime, 5*time.Minute),
-		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
-		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
-		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
-		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
-		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
-		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
-		NumTasklistWritePartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
-		NumTasklistReadPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
-		ForwarderMaxOutstandingPolls:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
-		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
-		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
-		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
-		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
-		EnableDebugMode:                 dc.GetBoolProperty(dynamicconfig.EnableDebugMode, false)(),
-		EnableTaskInfoLogByDomainID:     dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID, false),
+		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
+		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicconfig.MatchingPersistenceGlobalMaxQPS, 0),
+		EnableSyncMatch:                     dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch, true),
+		RPS:                                 dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
+		RangeSize:                           100000,
+		GetTasksBatchSize:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
+		UpdateAckInterval:                   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval, 1*time.Minute),
+		IdleTasklistCheckInterval:           dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval, 5*time.Minute),
+		MaxTasklistIdleTime:                 dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime, 5*time.Minute),
+		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
+		MinTaskThrottlingBurstSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
+		MaxTaskDeleteBatchSize:              dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
+		OutstandingTaskAppendsThreshold:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
+		MaxTaskBatchSize:                    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
+		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
+		NumTasklistWritePartitions:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
+		NumTasklistReadPartitions:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
+		ForwarderMaxOutstandingPolls:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
+		ForwarderMaxOutstandingTasks:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
+		ForwarderMaxRatePerSecond:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
+		ForwarderMaxChildrenPerNode:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
+		ForwarderTopology:                   dc.GetStringProperty(dynamicconfig.MatchingForwarderTopology, forwarderTopologyTree),
+		EnablePartitionScaling:              dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnablePartitionScaling, false),
+		PartitionScalingInterval:            dc.GetDurationProperty(dynamicconfig.MatchingPartitionScalingInterval, time.Minute),
+		PartitionUpscaleRPS:                 dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleRPS, 200),
+		PartitionUpscaleBacklog:             dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleBacklog, 1000),
+		PartitionDownscaleFactor:            dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleFactor, 0.75),
+		PartitionDownscaleSustainedDuration: dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleSustainedDuration, 5*time.Minute),
+		MaxPartitions:                       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxPartitions, 32),
+		FairnessKeyWeights:                  dc.GetMapProperty(dynamicconfig.MatchingFairnessKeyWeights, map[string]interface{}{}),
+		BacklogReadAheadSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingBacklogReadAheadSize, 10000),
+		MaxWorkerVersionSets:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingMaxWorkerVersionSets, 100),
+		WorkerVersionSetsCacheTTL:           dc.GetDurationProperty(dynamicconfig.MatchingWorkerVersionSetsCacheTTL, 10*time.Second),
+		TaskListStateCacheTTL:               dc.GetDurationProperty(dynamicconfig.MatchingTaskListStateCacheTTL, 10*time.Second),
+		MaxTaskDispatchAttempts:             dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDispatchAttempts, 0),
+		TaskDispatchAttemptsCacheSize:       dc.GetIntProperty(dynamicconfig.MatchingTaskDispatchAttemptsCacheSize, 100000),
+		MaxOutstandingPollsPerTaskList:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxOutstandingPollsPerTaskList, 0),
+		MaxOutstandingPollsPerHost:          dc.GetIntProperty(dynamicconfig.MatchingMaxOutstandingPollsPerHost, 0),
+		PollShedBackoff:                     dc.GetDurationProperty(dynamicconfig.MatchingPollShedBackoff, time.Second),
+		EnableIsolationGroups:               dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableIsolationGroups, false),
+		IsolationGroupFallbackDelay:         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIsolationGroupFallbackDelay, time.Second),
+		HostTaskIngestionRPS:                dc.GetFloat64Property(dynamicconfig.MatchingHostTaskIngestionRPS, _defaultTaskDispatchRPS),
+		DomainTaskIngestionRPS:              dc.GetFloat64Property(dynamicconfig.MatchingDomainTaskIngestionRPS, _defaultTaskDispatchRPS),
+		TaskListIngestionRPS:                dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskListIngestionRPS, _defaultTaskDispatchRPS),
+		DomainTaskDispatchRPS:               dc.GetFloat64Property(dynamicconfig.MatchingDomainTaskDispatchRPS, _defaultTaskDispatchRPS),
+		ShutdownDrainDuration:               dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
+		EnableDebugMode:                     dc.GetBoolProperty(dynamicconfig.EnableDebugMode, false)(),
+		EnableTaskInfoLogByDomainID:         dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID, false),
 	}
 }
 
@@ -169,6 +252,44 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTasklistReadPartitions(domainName, taskListName, taskType))
 		},
+		EnablePartitionScaling: func() bool {
+			return config.EnablePartitionScaling(domainName, taskListName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]interface{} {
+			return config.FairnessKeyWeights(
+				dynamicconfig.DomainFilter(domainName),
+				dynamicconfig.TaskListFilter(taskListName),
+				dynamicconfig.TaskTypeFilter(taskType),
+			)
+		},
+		BacklogReadAheadSize: func() int {
+			return config.BacklogReadAheadSize(domainName, taskListName, taskType)
+		},
+		MaxTaskDispatchAttempts: func() int {
+			return config.MaxTaskDispatchAttempts(domainName, id.GetRoot(), taskType)
+		},
+		EnableIsolationGroups: func() bool {
+			return config.EnableIsolationGroups(domainName, id.GetRoot(), taskType)
+		},
+		IsolationGroupFallbackDelay: func() time.Duration {
+			return config.IsolationGroupFallbackDelay(domainName, id.GetRoot(), taskType)
+		},
+		DrainedIsolationGroups: func() []string {
+			entry, err := domainCache.GetDomainByID(id.domainID)
+			if err != nil {
+				return nil
+			}
+			return entry.GetConfig().DrainedIsolationGroups
+		},
+		DomainTaskIngestionRPS: func() float64 {
+			return config.DomainTaskIngestionRPS(dynamicconfig.DomainFilter(domainName))
+		},
+		TaskListIngestionRPS: func() float64 {
+			return config.TaskListIngestionRPS(domainName, taskListName, taskType)
+		},
+		DomainTaskDispatchRPS: func() float64 {
+			return config.DomainTaskDispatchRPS(dynamicconfig.DomainFilter(domainName))
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(domainName, taskListName, taskType)
@@ -182,6 +303,16 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 			ForwarderMaxChildrenPerNode: func() int {
 				return common.MaxInt(1, config.ForwarderMaxChildrenPerNode(domainName, taskListName, taskType))
 			},
+			ForwarderTopology: func() string {
+				return config.ForwarderTopology(
+					dynamicconfig.DomainFilter(domainName),
+					dynamicconfig.TaskListFilter(id.GetRoot()),
+					dynamicconfig.TaskTypeFilter(taskType),
+				)
+			},
+			ForwarderNumPartitions: func() int {
+				return common.MaxInt(1, config.NumTasklistReadPartitions(domainName, id.GetRoot(), taskType))
+			},
 		},
 	}, nil
 }
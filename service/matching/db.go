@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -39,6 +40,9 @@ type (
 		taskType     int
 		rangeID      int64
 		ackLevel     int64
+		versionSets  []*types.WorkerVersionSet
+		paused       bool
+		migrateTo    string
 		store        persistence.TaskManager
 		logger       log.Logger
 	}
@@ -93,6 +97,9 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
+	db.versionSets = resp.TaskListInfo.VersionSets
+	db.paused = resp.TaskListInfo.Paused
+	db.migrateTo = resp.TaskListInfo.MigrateTo
 	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
 }
 
@@ -102,12 +109,15 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:    db.domainID,
+			Name:        db.taskListName,
+			TaskType:    db.taskType,
+			AckLevel:    ackLevel,
+			RangeID:     db.rangeID,
+			Kind:        db.taskListKind,
+			VersionSets: db.versionSets,
+			Paused:      db.paused,
+			MigrateTo:   db.migrateTo,
 		},
 	})
 	if err == nil {
@@ -116,18 +126,95 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// VersionSets returns the worker build ID version sets stored with the task list
+func (db *taskListDB) VersionSets() []*types.WorkerVersionSet {
+	db.Lock()
+	defer db.Unlock()
+	return db.versionSets
+}
+
+// UpdateVersionSets applies the update to the worker build ID version sets and stores the result
+func (db *taskListDB) UpdateVersionSets(
+	update func([]*types.WorkerVersionSet) ([]*types.WorkerVersionSet, error),
+) error {
+	db.Lock()
+	defer db.Unlock()
+	versionSets, err := update(db.versionSets)
+	if err != nil {
+		return err
+	}
+	_, err = db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:    db.domainID,
+			Name:        db.taskListName,
+			TaskType:    db.taskType,
+			AckLevel:    db.ackLevel,
+			RangeID:     db.rangeID,
+			Kind:        db.taskListKind,
+			VersionSets: versionSets,
+			Paused:      db.paused,
+			MigrateTo:   db.migrateTo,
+		},
+	})
+	if err == nil {
+		db.versionSets = versionSets
+	}
+	return err
+}
+
+// TaskListState returns the pause and migration state stored with the task list
+func (db *taskListDB) TaskListState() *types.TaskListState {
+	db.Lock()
+	defer db.Unlock()
+	return &types.TaskListState{Paused: db.paused, MigrateTo: db.migrateTo}
+}
+
+// UpdateTaskListState stores the given pause and migration state, nil values are left unchanged
+func (db *taskListDB) UpdateTaskListState(paused *bool, migrateTo *string) error {
+	db.Lock()
+	defer db.Unlock()
+	state := types.TaskListState{Paused: db.paused, MigrateTo: db.migrateTo}
+	if paused != nil {
+		state.Paused = *paused
+	}
+	if migrateTo != nil {
+		state.MigrateTo = *migrateTo
+	}
+	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:    db.domainID,
+			Name:        db.taskListName,
+			TaskType:    db.taskType,
+			AckLevel:    db.ackLevel,
+			RangeID:     db.rangeID,
+			Kind:        db.taskListKind,
+			VersionSets: db.versionSets,
+			Paused:      state.Paused,
+			MigrateTo:   state.MigrateTo,
+		},
+	})
+	if err == nil {
+		db.paused = state.Paused
+		db.migrateTo = state.MigrateTo
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
 	defer db.Unlock()
 	return db.store.CreateTasks(context.Background(), &persistence.CreateTasksRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: db.ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:    db.domainID,
+			Name:        db.taskListName,
+			TaskType:    db.taskType,
+			AckLevel:    db.ackLevel,
+			RangeID:     db.rangeID,
+			Kind:        db.taskListKind,
+			VersionSets: db.versionSets,
+			Paused:      db.paused,
+			MigrateTo:   db.migrateTo,
 		},
 		Tasks: tasks,
 	})
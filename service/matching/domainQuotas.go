@@ -0,0 +1,87 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+)
+
+var (
+	errDomainIngestionThrottled   = &types.ServiceBusyError{Message: "Domain task ingestion rate exceeded"}
+	errTaskListIngestionThrottled = &types.ServiceBusyError{Message: "Task list ingestion rate exceeded"}
+)
+
+type (
+	// domainQuotas are the task ingestion and dispatch quotas of the domains on a matching host,
+	// they are shared by all the task lists of a domain
+	domainQuotas struct {
+		config *Config
+		// ingestion limits the rate at which the tasks of a domain and of all the domains are added
+		ingestion quotas.Policy
+
+		sync.RWMutex
+		dispatchLimiters map[string]*quotas.DynamicRateLimiter
+	}
+)
+
+func newDomainQuotas(config *Config) *domainQuotas {
+	return &domainQuotas{
+		config: config,
+		ingestion: quotas.NewMultiStageRateLimiter(
+			func() float64 {
+				return config.HostTaskIngestionRPS()
+			},
+			func(domainName string) float64 {
+				return config.DomainTaskIngestionRPS(dynamicconfig.DomainFilter(domainName))
+			},
+		),
+		dispatchLimiters: make(map[string]*quotas.DynamicRateLimiter),
+	}
+}
+
+// allowIngestion returns true if a task of the domain can be added
+func (q *domainQuotas) allowIngestion(domainName string) bool {
+	return q.ingestion.Allow(quotas.Info{Domain: domainName})
+}
+
+// dispatchLimiter returns the limiter of the rate at which the tasks of the domain are dispatched to pollers
+func (q *domainQuotas) dispatchLimiter(domainName string) *quotas.DynamicRateLimiter {
+	q.RLock()
+	limiter, ok := q.dispatchLimiters[domainName]
+	q.RUnlock()
+	if ok {
+		return limiter
+	}
+
+	q.Lock()
+	defer q.Unlock()
+	if limiter, ok = q.dispatchLimiters[domainName]; !ok {
+		limiter = quotas.NewDynamicRateLimiter(func() float64 {
+			return q.config.DomainTaskDispatchRPS(dynamicconfig.DomainFilter(domainName))
+		})
+		q.dispatchLimiters[domainName] = limiter
+	}
+	return limiter
+}
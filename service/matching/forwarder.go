@@ -23,9 +23,11 @@ package matching
 import (
 	"context"
 	"errors"
+	"math/rand"
 	"sync/atomic"
 
 	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
@@ -39,6 +41,7 @@ type (
 		taskListID   *taskListID
 		taskListKind types.TaskListKind
 		client       matching.Client
+		scope        func() metrics.Scope
 
 		// token channels that vend tokens necessary to make
 		// API calls exposed by forwarder. Tokens are used
@@ -66,6 +69,16 @@ type (
 	}
 )
 
+const (
+	// forwarderTopologyTree forwards tasks and polls to the parent partition in the partition tree
+	forwarderTopologyTree = "tree"
+	// forwarderTopologyFlat forwards tasks and polls directly to the root partition
+	forwarderTopologyFlat = "flat"
+	// forwarderTopologyRandom forwards tasks to the root partition and polls to a random sibling
+	// partition, which lets idle partitions steal tasks from backlogged ones
+	forwarderTopologyRandom = "random"
+)
+
 var (
 	errNoParent            = errors.New("cannot find parent task list for forwarding")
 	errTaskListKind        = errors.New("forwarding is not supported on sticky task list")
@@ -79,8 +92,9 @@ var noopForwarderTokenC <-chan *ForwarderReqToken = make(chan *ForwarderReqToken
 // newForwarder returns an instance of Forwarder object which
 // can be used to forward api request calls from a task list
 // child partition to a task list parent partition. The returned
-// forwarder is tied to a single task list. All of the exposed
-// methods can return the following errors:
+// forwarder is tied to a single task list. The partition calls are
+// forwarded to is chosen by the forwarder topology of the task list.
+// All of the exposed methods can return the following errors:
 // Returns following errors:
 //  - errNoParent: If this task list doesn't have a parent to forward to
 //  - errTaskListKind: If the task list is a sticky task list. Sticky task lists are never partitioned
@@ -91,6 +105,7 @@ func newForwarder(
 	taskListID *taskListID,
 	kind types.TaskListKind,
 	client matching.Client,
+	scope func() metrics.Scope,
 ) *Forwarder {
 	rpsFunc := func() float64 { return float64(cfg.ForwarderMaxRatePerSecond()) }
 	fwdr := &Forwarder{
@@ -98,6 +113,7 @@ func newForwarder(
 		client:                client,
 		taskListID:            taskListID,
 		taskListKind:          kind,
+		scope:                 scope,
 		outstandingTasksLimit: int32(cfg.ForwarderMaxOutstandingTasks()),
 		outstandingPollsLimit: int32(cfg.ForwarderMaxOutstandingPolls()),
 		limiter:               quotas.NewDynamicRateLimiter(rpsFunc),
@@ -113,7 +129,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 		return errTaskListKind
 	}
 
-	name := fwdr.taskListID.Parent(fwdr.cfg.ForwarderMaxChildrenPerNode())
+	name := fwdr.taskTarget()
 	if name == "" {
 		return errNoParent
 	}
@@ -122,6 +138,11 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 		return errForwarderSlowDown
 	}
 
+	scope := fwdr.scope()
+	scope.IncCounter(metrics.ForwardTaskCallsPerTaskList)
+	sw := scope.StartTimer(metrics.ForwardTaskLatencyPerTaskList)
+	defer sw.Stop()
+
 	var err error
 
 	switch fwdr.taskListID.taskType {
@@ -137,6 +158,11 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
+			Priority:                      task.event.Priority,
+			FairnessKey:                   task.event.FairnessKey,
+			BuildID:                       task.event.BuildID,
+			IsolationGroup:                task.event.IsolationGroup,
+			Attempt:                       task.event.Attempt,
 		})
 	case persistence.TaskListTypeActivity:
 		err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -151,12 +177,23 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
+			Priority:                      task.event.Priority,
+			FairnessKey:                   task.event.FairnessKey,
+			BuildID:                       task.event.BuildID,
+			IsolationGroup:                task.event.IsolationGroup,
+			Attempt:                       task.event.Attempt,
 		})
 	default:
 		return errInvalidTaskListType
 	}
 
-	return fwdr.handleErr(err)
+	if err != nil {
+		scope.IncCounter(metrics.ForwardTaskErrorsPerTaskList)
+		return fwdr.handleErr(err)
+	}
+	// the task was sync matched with a poller of the partition it was forwarded to
+	scope.IncCounter(metrics.ForwardTaskHitsPerTaskList)
+	return nil
 }
 
 // ForwardQueryTask forwards a query task to parent task list partition, if it exist
@@ -169,11 +206,16 @@ func (fwdr *Forwarder) ForwardQueryTask(
 		return nil, errTaskListKind
 	}
 
-	name := fwdr.taskListID.Parent(fwdr.cfg.ForwarderMaxChildrenPerNode())
+	name := fwdr.taskTarget()
 	if name == "" {
 		return nil, errNoParent
 	}
 
+	scope := fwdr.scope()
+	scope.IncCounter(metrics.ForwardQueryCallsPerTaskList)
+	sw := scope.StartTimer(metrics.ForwardQueryLatencyPerTaskList)
+	defer sw.Stop()
+
 	resp, err := fwdr.client.QueryWorkflow(ctx, &types.MatchingQueryWorkflowRequest{
 		DomainUUID: task.query.request.DomainUUID,
 		TaskList: &types.TaskList{
@@ -183,6 +225,9 @@ func (fwdr *Forwarder) ForwardQueryTask(
 		QueryRequest:  task.query.request.QueryRequest,
 		ForwardedFrom: fwdr.taskListID.name,
 	})
+	if err != nil {
+		scope.IncCounter(metrics.ForwardQueryErrorsPerTaskList)
+	}
 
 	return resp, fwdr.handleErr(err)
 }
@@ -193,13 +238,20 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 		return nil, errTaskListKind
 	}
 
-	name := fwdr.taskListID.Parent(fwdr.cfg.ForwarderMaxChildrenPerNode())
+	name := fwdr.pollTarget(ctx)
 	if name == "" {
 		return nil, errNoParent
 	}
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	buildID, _ := ctx.Value(buildIDKey).(string)
+	isolationGroup, _ := ctx.Value(isolationGroupKey).(string)
+
+	scope := fwdr.scope()
+	scope.IncCounter(metrics.ForwardPollCallsPerTaskList)
+	sw := scope.StartTimer(metrics.ForwardPollLatencyPerTaskList)
+	defer sw.Stop()
 
 	switch fwdr.taskListID.taskType {
 	case persistence.TaskListTypeDecision:
@@ -211,13 +263,19 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 					Name: name,
 					Kind: &fwdr.taskListKind,
 				},
-				Identity: identity,
+				Identity:       identity,
+				BinaryChecksum: buildID,
+				IsolationGroup: isolationGroup,
 			},
 			ForwardedFrom: fwdr.taskListID.name,
 		})
 		if err != nil {
+			scope.IncCounter(metrics.ForwardPollErrorsPerTaskList)
 			return nil, fwdr.handleErr(err)
 		}
+		if len(resp.GetTaskToken()) > 0 {
+			scope.IncCounter(metrics.ForwardPollHitsPerTaskList)
+		}
 		return newInternalStartedTask(&startedTaskInfo{decisionTaskInfo: resp}), nil
 	case persistence.TaskListTypeActivity:
 		resp, err := fwdr.client.PollForActivityTask(ctx, &types.MatchingPollForActivityTaskRequest{
@@ -228,13 +286,19 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*InternalTask, error) {
 					Name: name,
 					Kind: &fwdr.taskListKind,
 				},
-				Identity: identity,
+				Identity:       identity,
+				BinaryChecksum: buildID,
+				IsolationGroup: isolationGroup,
 			},
 			ForwardedFrom: fwdr.taskListID.name,
 		})
 		if err != nil {
+			scope.IncCounter(metrics.ForwardPollErrorsPerTaskList)
 			return nil, fwdr.handleErr(err)
 		}
+		if len(resp.GetTaskToken()) > 0 {
+			scope.IncCounter(metrics.ForwardPollHitsPerTaskList)
+		}
 		return newInternalStartedTask(&startedTaskInfo{activityTaskInfo: resp}), nil
 	}
 
@@ -266,6 +330,44 @@ func (fwdr *Forwarder) refreshTokenC(value *atomic.Value, curr *int32, maxLimit
 	}
 }
 
+// taskTarget returns the name of the partition tasks and queries are forwarded to,
+// or an empty string if this partition doesn't forward them
+func (fwdr *Forwarder) taskTarget() string {
+	switch fwdr.cfg.ForwarderTopology() {
+	case forwarderTopologyFlat, forwarderTopologyRandom:
+		if fwdr.taskListID.IsRoot() {
+			return ""
+		}
+		return fwdr.taskListID.GetRoot()
+	default:
+		return fwdr.taskListID.Parent(fwdr.cfg.ForwarderMaxChildrenPerNode())
+	}
+}
+
+// pollTarget returns the name of the partition polls are forwarded to,
+// or an empty string if this partition doesn't forward them
+func (fwdr *Forwarder) pollTarget(ctx context.Context) string {
+	if fwdr.cfg.ForwarderTopology() != forwarderTopologyRandom {
+		return fwdr.taskTarget()
+	}
+	// a poll stolen from a sibling is served locally, forwarding it again
+	// could bounce it between partitions until it times out
+	if forwardedFrom, _ := ctx.Value(forwardedFromKey).(string); forwardedFrom != "" {
+		return ""
+	}
+	numPartitions := fwdr.cfg.ForwarderNumPartitions()
+	if fwdr.taskListID.partition >= numPartitions {
+		// the partition is being drained after a downscale, send polls to the root
+		return fwdr.taskTarget()
+	}
+	// pick one of the other partitions, root included, uniformly at random
+	partition := rand.Intn(numPartitions - 1)
+	if partition >= fwdr.taskListID.partition {
+		partition++
+	}
+	return fwdr.taskListID.mkName(partition)
+}
+
 func (fwdr *Forwarder) handleErr(err error) error {
 	if _, ok := err.(*types.ServiceBusyError); ok {
 		return errForwarderSlowDown
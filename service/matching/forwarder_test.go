@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"sync"
 	"sync/atomic"
@@ -31,9 +32,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
 
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -45,6 +48,7 @@ type ForwarderTestSuite struct {
 	fwdr       *Forwarder
 	cfg        *forwarderConfig
 	taskList   *taskListID
+	scope      tally.TestScope
 }
 
 func TestForwarderSuite(t *testing.T) {
@@ -59,9 +63,13 @@ func (t *ForwarderTestSuite) SetupTest() {
 		ForwarderMaxRatePerSecond:    func() int { return 2 },
 		ForwarderMaxChildrenPerNode:  func() int { return 20 },
 		ForwarderMaxOutstandingTasks: func() int { return 1 },
+		ForwarderTopology:            func() string { return forwarderTopologyTree },
+		ForwarderNumPartitions:       func() int { return 1 },
 	}
 	t.taskList = newTestTaskListID("fwdr", "tl0", persistence.TaskListTypeDecision)
-	t.fwdr = newForwarder(t.cfg, t.taskList, types.TaskListKindNormal, t.client)
+	t.scope = tally.NewTestScope("test", nil)
+	scope := metrics.NewClient(t.scope, metrics.Matching).Scope(metrics.MatchingTaskListMgrScope)
+	t.fwdr = newForwarder(t.cfg, t.taskList, types.TaskListKindNormal, t.client, func() metrics.Scope { return scope })
 }
 
 func (t *ForwarderTestSuite) TearDownTest() {
@@ -250,6 +258,125 @@ func (t *ForwarderTestSuite) TestForwardPollForActivity() {
 	t.Nil(task.pollForDecisionResponse())
 }
 
+func (t *ForwarderTestSuite) TestForwardTopology() {
+	testCases := []struct {
+		topology string
+		parent   string
+	}{
+		{forwarderTopologyTree, common.ReservedTaskListPrefix + "tl0/1"},
+		{forwarderTopologyFlat, "tl0"},
+		{forwarderTopologyRandom, "tl0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.topology, func() {
+			t.cfg.ForwarderTopology = func() string { return tc.topology }
+			t.fwdr = newForwarder(t.cfg, t.taskList, types.TaskListKindNormal, t.client, t.fwdr.scope)
+			t.usingTasklistPartitionID(persistence.TaskListTypeActivity, 30)
+
+			var request *types.AddActivityTaskRequest
+			t.client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Do(
+				func(arg0 context.Context, arg1 *types.AddActivityTaskRequest) {
+					request = arg1
+				},
+			).Return(nil).Times(1)
+			task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false)
+			t.NoError(t.fwdr.ForwardTask(context.Background(), task))
+			t.Equal(tc.parent, request.TaskList.GetName())
+
+			if tc.topology != forwarderTopologyRandom {
+				var pollRequest *types.MatchingPollForActivityTaskRequest
+				t.client.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).Do(
+					func(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest) {
+						pollRequest = arg1
+					},
+				).Return(&types.PollForActivityTaskResponse{}, nil).Times(1)
+				_, err := t.fwdr.ForwardPoll(context.Background())
+				t.NoError(err)
+				t.Equal(tc.parent, pollRequest.GetPollRequest().GetTaskList().GetName())
+			}
+		})
+	}
+}
+
+func (t *ForwarderTestSuite) TestForwardPollRandomTopology() {
+	t.cfg.ForwarderTopology = func() string { return forwarderTopologyRandom }
+	t.cfg.ForwarderNumPartitions = func() int { return 4 }
+	t.usingTasklistPartitionID(persistence.TaskListTypeActivity, 2)
+
+	targets := make(map[string]int)
+	t.client.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).Do(
+		func(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest) {
+			targets[arg1.GetPollRequest().GetTaskList().GetName()]++
+		},
+	).Return(&types.PollForActivityTaskResponse{}, nil).AnyTimes()
+	for i := 0; i < 100; i++ {
+		_, err := t.fwdr.ForwardPoll(context.Background())
+		t.NoError(err)
+	}
+	t.Len(targets, 3)
+	t.Contains(targets, "tl0")
+	t.Contains(targets, common.ReservedTaskListPrefix+"tl0/1")
+	t.Contains(targets, common.ReservedTaskListPrefix+"tl0/3")
+
+	// polls stolen from a sibling are not forwarded again
+	ctx := context.WithValue(context.Background(), forwardedFromKey, common.ReservedTaskListPrefix+"tl0/1")
+	_, err := t.fwdr.ForwardPoll(ctx)
+	t.Equal(errNoParent, err)
+
+	// partitions removed by a downscale forward their polls to the root
+	t.cfg.ForwarderNumPartitions = func() int { return 2 }
+	targets = make(map[string]int)
+	_, err = t.fwdr.ForwardPoll(context.Background())
+	t.NoError(err)
+	t.Equal(map[string]int{"tl0": 1}, targets)
+}
+
+func (t *ForwarderTestSuite) TestForwardMetrics() {
+	t.usingTasklistPartition(persistence.TaskListTypeActivity)
+
+	gomock.InOrder(
+		t.client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(nil),
+		t.client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(errRemoteSyncMatchFailed),
+	)
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false)
+	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
+	t.Error(t.fwdr.ForwardTask(context.Background(), task))
+
+	gomock.InOrder(
+		t.client.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).Return(&types.PollForActivityTaskResponse{TaskToken: []byte("token")}, nil),
+		t.client.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).Return(&types.PollForActivityTaskResponse{}, nil),
+	)
+	_, err := t.fwdr.ForwardPoll(context.Background())
+	t.NoError(err)
+	_, err = t.fwdr.ForwardPoll(context.Background())
+	t.NoError(err)
+
+	snapshot := t.scope.Snapshot()
+	counters := make(map[string]int64)
+	for _, counter := range snapshot.Counters() {
+		counters[counter.Name()] += counter.Value()
+	}
+	t.Equal(int64(2), counters["test.forward_task_calls_per_tl"])
+	t.Equal(int64(1), counters["test.forward_task_hits_per_tl"])
+	t.Equal(int64(1), counters["test.forward_task_errors_per_tl"])
+	t.Equal(int64(2), counters["test.forward_poll_calls_per_tl"])
+	t.Equal(int64(1), counters["test.forward_poll_hits_per_tl"])
+	t.Zero(counters["test.forward_poll_errors_per_tl"])
+
+	var taskLatencies, pollLatencies int
+	for _, timer := range snapshot.Timers() {
+		switch timer.Name() {
+		case "test.forward_task_latency_per_tl":
+			taskLatencies += len(timer.Values())
+		case "test.forward_poll_latency_per_tl":
+			pollLatencies += len(timer.Values())
+		}
+	}
+	t.Equal(2, taskLatencies)
+	t.Equal(2, pollLatencies)
+}
+
 func (t *ForwarderTestSuite) TestMaxOutstandingConcurrency() {
 	concurrency := 50
 	testCases := []struct {
@@ -331,7 +458,11 @@ func (t *ForwarderTestSuite) TestMaxOutstandingConfigUpdate() {
 }
 
 func (t *ForwarderTestSuite) usingTasklistPartition(taskType int) {
-	t.taskList = newTestTaskListID("fwdr", common.ReservedTaskListPrefix+"tl0/1", taskType)
+	t.usingTasklistPartitionID(taskType, 1)
+}
+
+func (t *ForwarderTestSuite) usingTasklistPartitionID(taskType int, partition int) {
+	t.taskList = newTestTaskListID("fwdr", fmt.Sprintf("%vtl0/%v", common.ReservedTaskListPrefix, partition), taskType)
 	t.fwdr.taskListID = t.taskList
 }
 
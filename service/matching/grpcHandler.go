@@ -79,6 +79,26 @@ func (g grpcHandler) GetTaskListsByDomain(ctx context.Context, request *matching
 	return proto.FromMatchingGetTaskListsByDomainResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) UpdateWorkerBuildIDCompatibility(ctx context.Context, request *matchingv1.UpdateWorkerBuildIDCompatibilityRequest) (*matchingv1.UpdateWorkerBuildIDCompatibilityResponse, error) {
+	err := g.h.UpdateWorkerBuildIDCompatibility(withGRPCTag(ctx), proto.ToMatchingUpdateWorkerBuildIDCompatibilityRequest(request))
+	return &matchingv1.UpdateWorkerBuildIDCompatibilityResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) GetWorkerBuildIDCompatibility(ctx context.Context, request *matchingv1.GetWorkerBuildIDCompatibilityRequest) (*matchingv1.GetWorkerBuildIDCompatibilityResponse, error) {
+	response, err := g.h.GetWorkerBuildIDCompatibility(withGRPCTag(ctx), proto.ToMatchingGetWorkerBuildIDCompatibilityRequest(request))
+	return proto.FromMatchingGetWorkerBuildIDCompatibilityResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) UpdateTaskListState(ctx context.Context, request *matchingv1.UpdateTaskListStateRequest) (*matchingv1.UpdateTaskListStateResponse, error) {
+	err := g.h.UpdateTaskListState(withGRPCTag(ctx), proto.ToMatchingUpdateTaskListStateRequest(request))
+	return &matchingv1.UpdateTaskListStateResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) GetTaskListState(ctx context.Context, request *matchingv1.GetTaskListStateRequest) (*matchingv1.GetTaskListStateResponse, error) {
+	response, err := g.h.GetTaskListState(withGRPCTag(ctx), proto.ToMatchingGetTaskListStateRequest(request))
+	return proto.FromMatchingGetTaskListStateResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) PollForActivityTask(ctx context.Context, request *matchingv1.PollForActivityTaskRequest) (*matchingv1.PollForActivityTaskResponse, error) {
 	response, err := g.h.PollForActivityTask(withGRPCTag(ctx), proto.ToMatchingPollForActivityTaskRequest(request))
 	return proto.FromMatchingPollForActivityTaskResponse(response), proto.FromError(err)
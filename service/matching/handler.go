@@ -28,10 +28,13 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/tasklistdlq"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -49,6 +52,10 @@ type (
 		DescribeTaskList(context.Context, *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(context.Context, *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		UpdateWorkerBuildIDCompatibility(context.Context, *types.MatchingUpdateWorkerBuildIDCompatibilityRequest) error
+		GetWorkerBuildIDCompatibility(context.Context, *types.MatchingGetWorkerBuildIDCompatibilityRequest) (*types.GetWorkerBuildIDCompatibilityResponse, error)
+		UpdateTaskListState(context.Context, *types.MatchingUpdateTaskListStateRequest) error
+		GetTaskListState(context.Context, *types.MatchingGetTaskListStateRequest) (*types.TaskListState, error)
 		PollForActivityTask(context.Context, *types.MatchingPollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
 		PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest) (*types.MatchingPollForDecisionTaskResponse, error)
 		QueryWorkflow(context.Context, *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
@@ -64,6 +71,7 @@ type (
 		metricsClient metrics.Client
 		startWG       sync.WaitGroup
 		rateLimiter   quotas.Limiter
+		pollAdmission *pollAdmission
 	}
 )
 
@@ -75,6 +83,7 @@ var (
 func NewHandler(
 	resource resource.Resource,
 	config *Config,
+	dynamicConfigStore configstore.Store,
 ) Handler {
 	handler := &handlerImpl{
 		Resource:      resource,
@@ -83,6 +92,7 @@ func NewHandler(
 		rateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
 			return float64(config.RPS())
 		}),
+		pollAdmission: newPollAdmission(config, resource.GetMetricsClient()),
 		engine: NewEngine(
 			resource.GetTaskManager(),
 			resource.GetHistoryClient(),
@@ -92,6 +102,12 @@ func NewHandler(
 			resource.GetMetricsClient(),
 			resource.GetDomainCache(),
 			resource.GetMatchingServiceResolver(),
+			dynamicConfigStore,
+			tasklistdlq.NewQueue(
+				resource.GetPersistenceBean().GetMatchingDLQQueueManager(),
+				resource.GetMetricsClient(),
+				resource.GetLogger(),
+			),
 		),
 	}
 	// prevent us from trying to serve requests before matching engine is started and ready
@@ -101,6 +117,7 @@ func NewHandler(
 
 // Start starts the handler
 func (h *handlerImpl) Start() {
+	h.engine.Start()
 	h.startWG.Done()
 }
 
@@ -218,6 +235,20 @@ func (h *handlerImpl) PollForActivityTask(
 		hCtx.scope.IncCounter(metrics.ForwardedPerTaskListCounter)
 	}
 
+	// polls are shed before they are rate limited to leave room for AddTask requests
+	release, err := h.pollAdmission.admit(
+		hCtx,
+		request.GetDomainUUID(),
+		h.domainName(request.GetDomainUUID()),
+		request.GetPollRequest().GetTaskList().GetName(),
+		persistence.TaskListTypeActivity,
+		metrics.MatchingPollForActivityTaskScope,
+	)
+	if err != nil {
+		return nil, hCtx.handleErr(err)
+	}
+	defer release()
+
 	if ok := h.rateLimiter.Allow(); !ok {
 		return nil, hCtx.handleErr(errMatchingHostThrottle)
 	}
@@ -254,6 +285,20 @@ func (h *handlerImpl) PollForDecisionTask(
 		hCtx.scope.IncCounter(metrics.ForwardedPerTaskListCounter)
 	}
 
+	// polls are shed before they are rate limited to leave room for AddTask requests
+	release, err := h.pollAdmission.admit(
+		hCtx,
+		request.GetDomainUUID(),
+		h.domainName(request.GetDomainUUID()),
+		request.GetPollRequest().GetTaskList().GetName(),
+		persistence.TaskListTypeDecision,
+		metrics.MatchingPollForDecisionTaskScope,
+	)
+	if err != nil {
+		return nil, hCtx.handleErr(err)
+	}
+	defer release()
+
 	if ok := h.rateLimiter.Allow(); !ok {
 		return nil, hCtx.handleErr(errMatchingHostThrottle)
 	}
@@ -420,6 +465,102 @@ func (h *handlerImpl) GetTaskListsByDomain(
 	return response, hCtx.handleErr(err)
 }
 
+// UpdateWorkerBuildIDCompatibility updates the worker version sets stored with a task list
+func (h *handlerImpl) UpdateWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest,
+) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetDomainUUID(),
+		request.GetUpdateRequest().GetTaskList(),
+		metrics.MatchingUpdateWorkerBuildIDCompatibilityScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	err := h.engine.UpdateWorkerBuildIDCompatibility(hCtx, request)
+	return hCtx.handleErr(err)
+}
+
+// GetWorkerBuildIDCompatibility returns the worker version sets stored with a task list
+func (h *handlerImpl) GetWorkerBuildIDCompatibility(
+	ctx context.Context,
+	request *types.MatchingGetWorkerBuildIDCompatibilityRequest,
+) (resp *types.GetWorkerBuildIDCompatibilityResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetDomainUUID(),
+		request.GetGetRequest().GetTaskList(),
+		metrics.MatchingGetWorkerBuildIDCompatibilityScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.GetWorkerBuildIDCompatibility(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+// UpdateTaskListState pauses, resumes or migrates a task list
+func (h *handlerImpl) UpdateTaskListState(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListStateRequest,
+) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetDomainUUID(),
+		request.GetTaskList(),
+		metrics.MatchingUpdateTaskListStateScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	err := h.engine.UpdateTaskListState(hCtx, request)
+	return hCtx.handleErr(err)
+}
+
+// GetTaskListState returns the pause and migration state of a task list
+func (h *handlerImpl) GetTaskListState(
+	ctx context.Context,
+	request *types.MatchingGetTaskListStateRequest,
+) (resp *types.TaskListState, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetDomainUUID(),
+		request.GetTaskList(),
+		metrics.MatchingGetTaskListStateScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.GetTaskListState(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 func (h *handlerImpl) domainName(id string) string {
 	domainName, err := h.GetDomainCache().GetDomainName(id)
 	if err != nil {
@@ -184,6 +184,64 @@ func (mr *MockHandlerMockRecorder) GetTaskListsByDomain(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListsByDomain", reflect.TypeOf((*MockHandler)(nil).GetTaskListsByDomain), arg0, arg1)
 }
 
+// UpdateWorkerBuildIDCompatibility mocks base method
+func (m *MockHandler) UpdateWorkerBuildIDCompatibility(arg0 context.Context, arg1 *types.MatchingUpdateWorkerBuildIDCompatibilityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkerBuildIDCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkerBuildIDCompatibility indicates an expected call of UpdateWorkerBuildIDCompatibility
+func (mr *MockHandlerMockRecorder) UpdateWorkerBuildIDCompatibility(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIDCompatibility", reflect.TypeOf((*MockHandler)(nil).UpdateWorkerBuildIDCompatibility), arg0, arg1)
+}
+
+// GetWorkerBuildIDCompatibility mocks base method
+func (m *MockHandler) GetWorkerBuildIDCompatibility(arg0 context.Context, arg1 *types.MatchingGetWorkerBuildIDCompatibilityRequest) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerBuildIDCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetWorkerBuildIDCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerBuildIDCompatibility indicates an expected call of GetWorkerBuildIDCompatibility
+func (mr *MockHandlerMockRecorder) GetWorkerBuildIDCompatibility(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerBuildIDCompatibility", reflect.TypeOf((*MockHandler)(nil).GetWorkerBuildIDCompatibility), arg0, arg1)
+}
+
+// UpdateTaskListState mocks base method
+func (m *MockHandler) UpdateTaskListState(arg0 context.Context, arg1 *types.MatchingUpdateTaskListStateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskListState indicates an expected call of UpdateTaskListState
+func (mr *MockHandlerMockRecorder) UpdateTaskListState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListState", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListState), arg0, arg1)
+}
+
+// GetTaskListState mocks base method
+func (m *MockHandler) GetTaskListState(arg0 context.Context, arg1 *types.MatchingGetTaskListStateRequest) (*types.TaskListState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListState", arg0, arg1)
+	ret0, _ := ret[0].(*types.TaskListState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListState indicates an expected call of GetTaskListState
+func (mr *MockHandlerMockRecorder) GetTaskListState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListState", reflect.TypeOf((*MockHandler)(nil).GetTaskListState), arg0, arg1)
+}
+
 // PollForActivityTask mocks base method
 func (m *MockHandler) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,103 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+)
+
+// isolationGroupPollerTTL is how long an isolation group is considered to have pollers after its last poll
+const isolationGroupPollerTTL = time.Minute
+
+type (
+	// isolationGroups routes the tasks of a task list partition to the pollers of the isolation group
+	// of their workflow. Each isolation group with pollers has a task channel of its own, which the
+	// pollers of the group read from in addition to the task channel shared by all pollers.
+	isolationGroups struct {
+		sync.Mutex
+		enabled       func() bool
+		fallbackDelay func() time.Duration
+		drained       func() []string
+		taskC         map[string]chan *InternalTask
+		lastPoll      map[string]time.Time
+	}
+)
+
+func newIsolationGroups(config *taskListConfig) *isolationGroups {
+	return &isolationGroups{
+		enabled:       config.EnableIsolationGroups,
+		fallbackDelay: config.IsolationGroupFallbackDelay,
+		drained:       config.DrainedIsolationGroups,
+		taskC:         make(map[string]chan *InternalTask),
+		lastPoll:      make(map[string]time.Time),
+	}
+}
+
+// forPoller returns the task channel of the isolation group of a poller and records that the group
+// has pollers. It returns nil if the poller only reads from the shared task channel.
+func (g *isolationGroups) forPoller(group string) <-chan *InternalTask {
+	if group == "" || !g.enabled() {
+		return nil
+	}
+	g.Lock()
+	defer g.Unlock()
+	g.lastPoll[group] = time.Now()
+	return g.groupTaskC(group)
+}
+
+// forTask returns the task channel of the isolation group a task is dispatched to first. It returns
+// nil if the task can be dispatched to any poller, because it has no isolation group, or its group
+// is drained or has no pollers.
+func (g *isolationGroups) forTask(group string) chan<- *InternalTask {
+	if group == "" || !g.enabled() || g.isDrained(group) {
+		return nil
+	}
+	g.Lock()
+	defer g.Unlock()
+	if time.Since(g.lastPoll[group]) > isolationGroupPollerTTL {
+		return nil
+	}
+	return g.groupTaskC(group)
+}
+
+// isPollerDrained returns true if the isolation group of a poller is drained, such pollers get no tasks
+func (g *isolationGroups) isPollerDrained(group string) bool {
+	return group != "" && g.enabled() && g.isDrained(group)
+}
+
+func (g *isolationGroups) isDrained(group string) bool {
+	for _, drained := range g.drained() {
+		if drained == group {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *isolationGroups) groupTaskC(group string) chan *InternalTask {
+	taskC, ok := g.taskC[group]
+	if !ok {
+		taskC = make(chan *InternalTask)
+		g.taskC[group] = taskC
+	}
+	return taskC
+}
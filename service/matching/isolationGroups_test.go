@@ -0,0 +1,65 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsolationGroups(t *testing.T) {
+	enabled := true
+	drained := []string{"drained"}
+	groups := newIsolationGroups(&taskListConfig{
+		EnableIsolationGroups:       func() bool { return enabled },
+		IsolationGroupFallbackDelay: func() time.Duration { return time.Second },
+		DrainedIsolationGroups:      func() []string { return drained },
+	})
+
+	assert.Nil(t, groups.forPoller(""))
+	assert.Nil(t, groups.forTask(""))
+
+	// tasks of a group without pollers can be dispatched to any poller
+	assert.Nil(t, groups.forTask("a"))
+	pollerTaskC := groups.forPoller("a")
+	assert.NotNil(t, pollerTaskC)
+	assert.NotNil(t, groups.forTask("a"))
+	assert.Nil(t, groups.forTask("b"))
+
+	// a group whose pollers stopped polling has no pollers anymore
+	groups.lastPoll["a"] = time.Now().Add(-isolationGroupPollerTTL - time.Second)
+	assert.Nil(t, groups.forTask("a"))
+
+	groups.forPoller("drained")
+	groups.forPoller("undrained")
+	assert.Nil(t, groups.forTask("drained"))
+	assert.NotNil(t, groups.forTask("undrained"))
+	assert.True(t, groups.isPollerDrained("drained"))
+	assert.False(t, groups.isPollerDrained("undrained"))
+	assert.False(t, groups.isPollerDrained(""))
+
+	enabled = false
+	assert.Nil(t, groups.forPoller("undrained"))
+	assert.Nil(t, groups.forTask("undrained"))
+	assert.False(t, groups.isPollerDrained("drained"))
+}
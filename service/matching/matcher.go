@@ -23,10 +23,10 @@ package matching
 import (
 	"context"
 	"errors"
+	"math"
+	"sync/atomic"
 	"time"
 
-	"golang.org/x/time/rate"
-
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
@@ -49,11 +49,25 @@ type TaskMatcher struct {
 	fwdr          *Forwarder
 	scope         func() metrics.Scope // domain metric scope
 	numPartitions func() int           // number of task list partitions
+	// backlogPriority is the priority of the backlog task being dispatched, sync match
+	// of tasks with a lower priority is skipped so that they are ordered in the backlog
+	backlogPriority int64
+	// isolation routes tasks to the pollers of their isolation group first
+	isolation *isolationGroups
+	// domainLimiter returns the limiter of the dispatch quota of the domain, it is shared by the
+	// task lists of the domain. onDomainThrottle is called when the quota delays or rejects a task
+	domainLimiter    func() *quotas.DynamicRateLimiter
+	onDomainThrottle func()
 }
 
 const (
 	_defaultTaskDispatchRPS    = 100000.0
 	_defaultTaskDispatchRPSTTL = 60 * time.Second
+
+	noBacklogPriority = math.MinInt64
+
+	// domainQuotaRetryInterval is how often a backlog task retries when the dispatch quota of its domain is zero
+	domainQuotaRetryInterval = time.Second
 )
 
 var errTasklistThrottled = errors.New("cannot add to tasklist, limit exceeded")
@@ -61,16 +75,26 @@ var errTasklistThrottled = errors.New("cannot add to tasklist, limit exceeded")
 // newTaskMatcher returns an task matcher instance. The returned instance can be
 // used by task producers and consumers to find a match. Both sync matches and non-sync
 // matches should use this implementation
-func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() metrics.Scope) *TaskMatcher {
+func newTaskMatcher(
+	config *taskListConfig,
+	fwdr *Forwarder,
+	scopeFunc func() metrics.Scope,
+	domainLimiter func() *quotas.DynamicRateLimiter,
+	onDomainThrottle func(),
+) *TaskMatcher {
 	dPtr := _defaultTaskDispatchRPS
 	limiter := quotas.NewRateLimiter(&dPtr, _defaultTaskDispatchRPSTTL, config.MinTaskThrottlingBurstSize())
 	return &TaskMatcher{
-		limiter:       limiter,
-		scope:         scopeFunc,
-		fwdr:          fwdr,
-		taskC:         make(chan *InternalTask),
-		queryTaskC:    make(chan *InternalTask),
-		numPartitions: config.NumReadPartitions,
+		limiter:          limiter,
+		scope:            scopeFunc,
+		fwdr:             fwdr,
+		taskC:            make(chan *InternalTask),
+		queryTaskC:       make(chan *InternalTask),
+		numPartitions:    config.NumReadPartitions,
+		backlogPriority:  noBacklogPriority,
+		isolation:        newIsolationGroups(config),
+		domainLimiter:    domainLimiter,
+		onDomainThrottle: onDomainThrottle,
 	}
 }
 
@@ -100,22 +124,35 @@ func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() me
 // correct context timeout.
 //
 // returns error when:
-//  - ratelimit is exceeded (does not apply to query task)
-//  - context deadline is exceeded
-//  - task is matched and consumer returns error in response channel
+//   - ratelimit is exceeded (does not apply to query task)
+//   - context deadline is exceeded
+//   - task is matched and consumer returns error in response channel
+//
+// Tasks with a lower priority than the backlog task being dispatched are not
+// matched, so that they are dispatched from the backlog in priority order.
+// Tasks of an isolation group with pollers are only matched with the pollers of
+// the group, they fall back to all pollers when dispatched from the backlog.
 func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, error) {
 	var err error
-	var rsv *rate.Reservation
+	var cancelRatelimit func()
 	if !task.isForwarded() {
-		rsv, err = tm.ratelimit(ctx)
+		if int64(task.priority()) < atomic.LoadInt64(&tm.backlogPriority) {
+			return false, nil
+		}
+		cancelRatelimit, err = tm.ratelimit(ctx)
 		if err != nil {
 			tm.scope().IncCounter(metrics.SyncThrottlePerTaskListCounter)
 			return false, err
 		}
 	}
 
+	var taskC chan<- *InternalTask = tm.taskC
+	if isolatedTaskC := tm.isolation.forTask(task.isolationGroup()); isolatedTaskC != nil {
+		taskC = isolatedTaskC
+	}
+
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case taskC <- task: // poller picked up the task
 		if task.responseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -144,18 +181,29 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 			}
 		}
 
-		if rsv != nil {
-			// there was a ratelimit token we consumed
-			// return it since we did not really do any work
-			rsv.Cancel()
+		if cancelRatelimit != nil {
+			// there were ratelimit tokens we consumed
+			// return them since we did not really do any work
+			cancelRatelimit()
 		}
 		return false, nil
 	}
 }
 
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *InternalTask) (bool, error) {
-	select {
-	case tm.taskC <- task: // poller picked up the task
+	taskC, isolatedTaskC, fallbackC, stop := tm.backlogTaskC(task)
+	defer stop()
+
+	for {
+		select {
+		case taskC <- task: // poller picked up the task
+		case isolatedTaskC <- task:
+		case <-fallbackC:
+			taskC = tm.fallbackTaskC()
+			continue
+		case <-ctx.Done():
+			return false, nil
+		}
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
@@ -165,8 +213,6 @@ func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *InternalTask) (
 			}
 		}
 		return false, nil
-	case <-ctx.Done():
-		return false, nil
 	}
 }
 
@@ -215,10 +261,18 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error
 		return err
 	}
 
+	atomic.StoreInt64(&tm.backlogPriority, int64(task.priority()))
+	defer atomic.StoreInt64(&tm.backlogPriority, noBacklogPriority)
+
+	taskC, isolatedTaskC, fallbackC, stop := tm.backlogTaskC(task)
+	defer stop()
+
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
-	case tm.taskC <- task:
+	case taskC <- task:
+		return nil
+	case isolatedTaskC <- task:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -228,8 +282,12 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error
 forLoop:
 	for {
 		select {
-		case tm.taskC <- task:
+		case taskC <- task:
+			return nil
+		case isolatedTaskC <- task:
 			return nil
+		case <-fallbackC:
+			taskC = tm.fallbackTaskC()
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithDeadline(ctx, time.Now().Add(time.Second*2))
 			err := tm.fwdr.ForwardTask(childCtx, task)
@@ -240,9 +298,14 @@ forLoop:
 				// the next forwarded call after this childCtx expires. Till then, we block
 				// hoping for a local poller match
 				select {
-				case tm.taskC <- task:
+				case taskC <- task:
 					cancel()
 					return nil
+				case isolatedTaskC <- task:
+					cancel()
+					return nil
+				case <-fallbackC:
+					taskC = tm.fallbackTaskC()
 				case <-childCtx.Done():
 				case <-ctx.Done():
 					cancel()
@@ -267,27 +330,29 @@ forLoop:
 // On success, the returned task could be a query task or a regular task
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) Poll(ctx context.Context) (*InternalTask, error) {
+	isolationGroup, _ := ctx.Value(isolationGroupKey).(string)
+	isolatedTaskC := tm.isolation.forPoller(isolationGroup)
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC, isolatedTaskC); err == nil {
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC)
+	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC, isolatedTaskC)
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*InternalTask, error) {
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC, nil); err == nil {
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctx, nil, tm.queryTaskC, nil)
 }
 
 // UpdateRatelimit updates the task dispatch rate
@@ -313,6 +378,7 @@ func (tm *TaskMatcher) pollOrForward(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
 	case task := <-taskC:
@@ -321,6 +387,12 @@ func (tm *TaskMatcher) pollOrForward(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-isolatedTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -334,7 +406,7 @@ func (tm *TaskMatcher) pollOrForward(
 			return task, nil
 		}
 		token.release()
-		return tm.poll(ctx, taskC, queryTaskC)
+		return tm.poll(ctx, taskC, queryTaskC, isolatedTaskC)
 	}
 }
 
@@ -342,6 +414,7 @@ func (tm *TaskMatcher) poll(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
 	case task := <-taskC:
@@ -350,6 +423,12 @@ func (tm *TaskMatcher) poll(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-isolatedTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -364,6 +443,7 @@ func (tm *TaskMatcher) pollNonBlocking(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	isolatedTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
 	case task := <-taskC:
@@ -372,6 +452,12 @@ func (tm *TaskMatcher) pollNonBlocking(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-isolatedTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -381,6 +467,30 @@ func (tm *TaskMatcher) pollNonBlocking(
 	}
 }
 
+// backlogTaskC returns the channels a backlog task is offered on. A task of an isolation group
+// with pollers is offered to the pollers of the group on isolatedTaskC, and taskC is nil until
+// fallbackC fires, after which the task is offered to all pollers. stop must be called once the
+// task is dispatched.
+func (tm *TaskMatcher) backlogTaskC(task *InternalTask) (
+	taskC chan<- *InternalTask,
+	isolatedTaskC chan<- *InternalTask,
+	fallbackC <-chan time.Time,
+	stop func(),
+) {
+	isolatedTaskC = tm.isolation.forTask(task.isolationGroup())
+	if isolatedTaskC == nil {
+		return tm.taskC, nil, nil, func() {}
+	}
+	fallback := time.NewTimer(tm.isolation.fallbackDelay())
+	return nil, isolatedTaskC, fallback.C, func() { fallback.Stop() }
+}
+
+// fallbackTaskC returns the shared task channel once a task fell back from its isolation group
+func (tm *TaskMatcher) fallbackTaskC() chan<- *InternalTask {
+	tm.scope().IncCounter(metrics.IsolationGroupFallbackPerTaskListCounter)
+	return tm.taskC
+}
+
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return noopForwarderTokenC
@@ -395,7 +505,9 @@ func (tm *TaskMatcher) fwdrAddReqTokenC() <-chan *ForwarderReqToken {
 	return tm.fwdr.AddReqTokenC()
 }
 
-func (tm *TaskMatcher) ratelimit(ctx context.Context) (*rate.Reservation, error) {
+// ratelimit waits for the task list and domain dispatch quotas, it returns a func that returns the
+// consumed tokens when the context has a deadline
+func (tm *TaskMatcher) ratelimit(ctx context.Context) (func(), error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -407,6 +519,9 @@ func (tm *TaskMatcher) ratelimit(ctx context.Context) (*rate.Reservation, error)
 		if err := tm.limiter.Wait(ctx); err != nil {
 			return nil, err
 		}
+		if err := tm.waitDomainQuota(ctx); err != nil {
+			return nil, err
+		}
 		return nil, nil
 	}
 
@@ -419,8 +534,67 @@ func (tm *TaskMatcher) ratelimit(ctx context.Context) (*rate.Reservation, error)
 		return nil, errTasklistThrottled
 	}
 
-	time.Sleep(rsv.Delay())
-	return rsv, nil
+	delay := rsv.Delay()
+	cancel := rsv.Cancel
+	if tm.domainLimiter != nil {
+		domainRsv := tm.domainLimiter().Reserve()
+		if !domainRsv.OK() || domainRsv.Delay() > 0 {
+			tm.onDomainThrottle()
+		}
+		if !domainRsv.OK() || domainRsv.Delay() > deadline.Sub(time.Now()) {
+			if domainRsv.OK() {
+				domainRsv.Cancel()
+			}
+			rsv.Cancel()
+			return nil, errTasklistThrottled
+		}
+		if domainRsv.Delay() > delay {
+			delay = domainRsv.Delay()
+		}
+		cancel = func() {
+			rsv.Cancel()
+			domainRsv.Cancel()
+		}
+	}
+
+	time.Sleep(delay)
+	return cancel, nil
+}
+
+// waitDomainQuota blocks until the dispatch quota of the domain allows a task to be dispatched
+func (tm *TaskMatcher) waitDomainQuota(ctx context.Context) error {
+	if tm.domainLimiter == nil {
+		return nil
+	}
+	throttled := false
+	for {
+		rsv := tm.domainLimiter().Reserve()
+		// a zero quota allows no dispatch, retry until the quota is raised
+		delay := domainQuotaRetryInterval
+		if rsv.OK() {
+			delay = rsv.Delay()
+		}
+		if delay == 0 {
+			return nil
+		}
+		if !throttled {
+			throttled = true
+			tm.onDomainThrottle()
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if rsv.OK() {
+				rsv.Cancel()
+			}
+			return ctx.Err()
+		case <-timer.C:
+			if rsv.OK() {
+				return nil
+			}
+		}
+	}
 }
 
 func (tm *TaskMatcher) isForwardingAllowed() bool {
@@ -23,6 +23,7 @@ package matching
 import (
 	"context"
 	"math/rand"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -66,15 +68,17 @@ func (t *MatcherTestSuite) SetupTest() {
 		ForwarderMaxOutstandingTasks: func() int { return 1 },
 		ForwarderMaxRatePerSecond:    func() int { return 2 },
 		ForwarderMaxChildrenPerNode:  func() int { return 20 },
+		ForwarderTopology:            func() string { return forwarderTopologyTree },
+		ForwarderNumPartitions:       func() int { return 1 },
 	}
 	t.cfg = tlCfg
-	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskList, types.TaskListKindNormal, t.client)
-	t.matcher = newTaskMatcher(tlCfg, t.fwdr, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
+	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskList, types.TaskListKindNormal, t.client, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
+	t.matcher = newTaskMatcher(tlCfg, t.fwdr, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) }, nil, nil)
 
 	rootTaskList := newTestTaskListID(t.taskList.domainID, t.taskList.Parent(20), persistence.TaskListTypeDecision)
 	rootTasklistCfg, err := newTaskListConfig(rootTaskList, cfg, t.newDomainCache())
 	t.NoError(err)
-	t.rootMatcher = newTaskMatcher(rootTasklistCfg, nil, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
+	t.rootMatcher = newTaskMatcher(rootTasklistCfg, nil, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) }, nil, nil)
 }
 
 func (t *MatcherTestSuite) TearDownTest() {
@@ -192,6 +196,132 @@ func (t *MatcherTestSuite) TestSyncMatchFailure() {
 	t.False(syncMatch)
 }
 
+func (t *MatcherTestSuite) TestSyncMatchSkippedForHigherPriorityBacklog() {
+	atomic.StoreInt64(&t.matcher.backlogPriority, 5)
+	defer atomic.StoreInt64(&t.matcher.backlogPriority, noBacklogPriority)
+
+	taskInfo := t.newTaskInfo()
+	taskInfo.Priority = 1
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.matcher.Offer(ctx, task)
+	cancel()
+	t.NoError(err)
+	t.False(syncMatch)
+}
+
+func (t *MatcherTestSuite) TestIsolationGroupSyncMatch() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.enableIsolationGroups(time.Second, nil)
+	t.matcher.isolation.forPoller("a") // group a has pollers
+
+	taskInfo := t.newTaskInfo()
+	taskInfo.IsolationGroup = "a"
+
+	// a poller of another group doesn't sync match the task
+	wait := t.pollWithIsolationGroup("b", 50*time.Millisecond)
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true)
+	syncMatch, err := t.matcher.Offer(context.Background(), task)
+	wait()
+	t.NoError(err)
+	t.False(syncMatch)
+
+	wait = t.pollWithIsolationGroup("a", time.Second)
+	task = newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true)
+	syncMatch, err = t.matcher.Offer(context.Background(), task)
+	wait()
+	t.NoError(err)
+	t.True(syncMatch)
+}
+
+func (t *MatcherTestSuite) TestIsolationGroupWithoutPollersSyncMatch() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	t.enableIsolationGroups(time.Second, []string{"a"})
+	t.matcher.isolation.forPoller("a")
+
+	for _, group := range []string{"a", "c"} { // group a is drained and group c has no pollers
+		taskInfo := t.newTaskInfo()
+		taskInfo.IsolationGroup = group
+		wait := t.pollWithIsolationGroup("b", time.Second)
+		task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true)
+		syncMatch, err := t.matcher.Offer(context.Background(), task)
+		wait()
+		t.NoError(err)
+		t.True(syncMatch)
+	}
+}
+
+func (t *MatcherTestSuite) TestIsolationGroupMustOfferFallback() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+	fallbackDelay := 50 * time.Millisecond
+	t.enableIsolationGroups(fallbackDelay, nil)
+	t.matcher.isolation.forPoller("a")
+
+	taskInfo := t.newTaskInfo()
+	taskInfo.IsolationGroup = "a"
+	task := newInternalTask(taskInfo, nil, types.TaskSourceDbBacklog, "", false)
+
+	wait := t.pollWithIsolationGroup("b", time.Second)
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	err := t.matcher.MustOffer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.True(time.Since(start) >= fallbackDelay)
+}
+
+func (t *MatcherTestSuite) TestDomainDispatchQuota() {
+	var throttled int32
+	limiter := quotas.NewDynamicRateLimiter(func() float64 { return 1 })
+	matcher := newTaskMatcher(
+		t.cfg,
+		nil,
+		func() metrics.Scope { return metrics.NoopScope(metrics.Matching) },
+		func() *quotas.DynamicRateLimiter { return limiter },
+		func() { atomic.AddInt32(&throttled, 1) },
+	)
+
+	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
+		task, err := matcher.Poll(ctx)
+		if err == nil {
+			task.finish(nil)
+		}
+	})
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := matcher.Offer(ctx, task)
+	cancel()
+	wait()
+	t.NoError(err)
+	t.True(syncMatch)
+	t.Zero(atomic.LoadInt32(&throttled))
+
+	// the quota of the domain is used up, the next token is not available before the deadline
+	task = newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true)
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	syncMatch, err = matcher.Offer(ctx, task)
+	cancel()
+	t.Equal(errTasklistThrottled, err)
+	t.False(syncMatch)
+	t.EqualValues(1, atomic.LoadInt32(&throttled))
+
+	// a backlog task waits while the quota of the domain is zero
+	limiter = quotas.NewDynamicRateLimiter(func() float64 { return 0 })
+	task = newInternalTask(t.newTaskInfo(), nil, types.TaskSourceDbBacklog, "", false)
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	err = matcher.MustOffer(ctx, task)
+	t.Equal(context.Canceled, err)
+	t.EqualValues(2, atomic.LoadInt32(&throttled))
+}
+
 func (t *MatcherTestSuite) TestQueryLocalSyncMatch() {
 	// force disable remote forwarding
 	<-t.fwdr.AddReqTokenC()
@@ -426,6 +556,23 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 	t.True(task.isStarted())
 }
 
+func (t *MatcherTestSuite) enableIsolationGroups(fallbackDelay time.Duration, drained []string) {
+	t.matcher.isolation.enabled = func() bool { return true }
+	t.matcher.isolation.fallbackDelay = func() time.Duration { return fallbackDelay }
+	t.matcher.isolation.drained = func() []string { return drained }
+}
+
+// pollWithIsolationGroup polls the matcher as a poller of the isolation group until a task is
+// matched or the timeout expires, the returned function waits for the poll to complete
+func (t *MatcherTestSuite) pollWithIsolationGroup(group string, timeout time.Duration) func() {
+	return ensureAsyncReady(timeout, func(ctx context.Context) {
+		task, err := t.matcher.Poll(context.WithValue(ctx, isolationGroupKey, group))
+		if err == nil {
+			task.finish(nil)
+		}
+	})
+}
+
 func (t *MatcherTestSuite) newDomainCache() cache.DomainCache {
 	domainName := "test-domain"
 	dc := cache.NewMockDomainCache(t.controller)
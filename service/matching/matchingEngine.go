@@ -37,20 +37,26 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tasklistdlq"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/versioning"
 )
 
 // Implements matching.Engine
 // TODO: Switch implementation from lock/channel based to a partitioned agent
 // to simplify code and reduce possibility of synchronization errors.
 type (
-	pollerIDCtxKey string
-	identityCtxKey string
+	pollerIDCtxKey       string
+	identityCtxKey       string
+	buildIDCtxKey        string
+	forwardedFromCtxKey  string
+	isolationGroupCtxKey string
 
 	queryResult struct {
 		workerResponse *types.MatchingRespondQueryTaskCompletedRequest
@@ -80,6 +86,12 @@ type (
 		domainCache          cache.DomainCache
 		versionChecker       client.VersionChecker
 		keyResolver          membership.ServiceResolver
+		partitionScaler      *partitionScaler
+		taskListDLQ          tasklistdlq.Queue
+		domainQuotas         *domainQuotas
+		versionSetsCache     *versionSetsCache
+		taskListStateCache   *taskListStateCache
+		dispatchAttempts     *taskDispatchAttempts
 	}
 )
 
@@ -95,8 +107,11 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey       pollerIDCtxKey       = "pollerID"
+	identityKey       identityCtxKey       = "identity"
+	buildIDKey        buildIDCtxKey        = "buildID"
+	forwardedFromKey  forwardedFromCtxKey  = "forwardedFrom"
+	isolationGroupKey isolationGroupCtxKey = "isolationGroup"
 )
 
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
@@ -110,9 +125,11 @@ func NewEngine(taskManager persistence.TaskManager,
 	metricsClient metrics.Client,
 	domainCache cache.DomainCache,
 	resolver membership.ServiceResolver,
+	dynamicConfigStore configstore.Store,
+	taskListDLQ tasklistdlq.Queue,
 ) Engine {
 
-	return &matchingEngineImpl{
+	e := &matchingEngineImpl{
 		taskManager:          taskManager,
 		historyService:       historyService,
 		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
@@ -125,14 +142,32 @@ func NewEngine(taskManager persistence.TaskManager,
 		domainCache:          domainCache,
 		versionChecker:       client.NewVersionChecker(),
 		keyResolver:          resolver,
+		taskListDLQ:          taskListDLQ,
+		domainQuotas:         newDomainQuotas(config),
+		versionSetsCache:     newVersionSetsCache(config.WorkerVersionSetsCacheTTL),
+		taskListStateCache:   newTaskListStateCache(config.TaskListStateCacheTTL),
+		dispatchAttempts:     newTaskDispatchAttempts(config.TaskDispatchAttemptsCacheSize()),
 	}
+	// partition scaling decisions are shared through the dynamic config store
+	if dynamicConfigStore != nil {
+		e.partitionScaler = newPartitionScaler(e, dynamicConfigStore)
+	}
+	return e
 }
 
 func (e *matchingEngineImpl) Start() {
-	// As task lists are initialized lazily nothing is done on startup at this point.
+	// As task lists are initialized lazily nothing else is done on startup at this point.
+	if e.partitionScaler != nil {
+		e.partitionScaler.Start()
+	}
+	e.taskListDLQ.Start()
 }
 
 func (e *matchingEngineImpl) Stop() {
+	if e.partitionScaler != nil {
+		e.partitionScaler.Stop()
+	}
+	e.taskListDLQ.Stop()
 	// Executes Stop() on each task list outside of lock
 	for _, l := range e.getTaskLists(math.MaxInt32) {
 		l.Stop()
@@ -272,6 +307,16 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		return false, err
 	}
 
+	versionedTaskList, err := e.getVersionedTaskList(hCtx.Context, taskList, request.GetTaskList(), request.GetForwardedFrom(), request.GetBuildID(), false)
+	if err != nil {
+		return false, err
+	}
+	if versionedTaskList != nil {
+		versionedRequest := *request
+		versionedRequest.TaskList = versionedTaskList
+		return false, e.matchingClient.AddDecisionTask(hCtx.Context, &versionedRequest)
+	}
+
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
 		return false, err
@@ -284,6 +329,11 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleID:             request.GetScheduleID(),
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               request.GetPriority(),
+		FairnessKey:            request.GetFairnessKey(),
+		BuildID:                request.GetBuildID(),
+		IsolationGroup:         request.GetIsolationGroup(),
+		Attempt:                request.GetAttempt(),
 	}
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
 		execution:     request.Execution,
@@ -320,6 +370,16 @@ func (e *matchingEngineImpl) AddActivityTask(
 		return false, err
 	}
 
+	versionedTaskList, err := e.getVersionedTaskList(hCtx.Context, taskList, request.GetTaskList(), request.GetForwardedFrom(), request.GetBuildID(), false)
+	if err != nil {
+		return false, err
+	}
+	if versionedTaskList != nil {
+		versionedRequest := *request
+		versionedRequest.TaskList = versionedTaskList
+		return false, e.matchingClient.AddActivityTask(hCtx.Context, &versionedRequest)
+	}
+
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
 		return false, err
@@ -332,12 +392,18 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleID:             request.GetScheduleID(),
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               request.GetPriority(),
+		FairnessKey:            request.GetFairnessKey(),
+		BuildID:                request.GetBuildID(),
+		IsolationGroup:         request.GetIsolationGroup(),
+		Attempt:                request.GetAttempt(),
 	}
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
-		execution:     request.Execution,
-		taskInfo:      taskInfo,
-		source:        request.GetSource(),
-		forwardedFrom: request.GetForwardedFrom(),
+		execution:              request.Execution,
+		taskInfo:               taskInfo,
+		source:                 request.GetSource(),
+		forwardedFrom:          request.GetForwardedFrom(),
+		lastHeartbeatTimestamp: request.GetLastHeartbeatTimestamp(),
 	})
 }
 
@@ -366,10 +432,25 @@ pollLoop:
 			return nil, err
 		}
 
+		versionedTaskList, err := e.getVersionedTaskList(hCtx.Context, taskList, request.GetTaskList(), req.GetForwardedFrom(), request.GetBinaryChecksum(), true)
+		if err != nil {
+			return nil, err
+		}
+		if versionedTaskList != nil {
+			versionedRequest := *req
+			versionedPollRequest := *request
+			versionedPollRequest.TaskList = versionedTaskList
+			versionedRequest.PollRequest = &versionedPollRequest
+			return e.matchingClient.PollForDecisionTask(hCtx.Context, &versionedRequest)
+		}
+
 		// Add frontend generated pollerID to context so tasklistMgr can support cancellation of
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, buildIDKey, request.GetBinaryChecksum())
+		pollerCtx = context.WithValue(pollerCtx, forwardedFromKey, req.GetForwardedFrom())
+		pollerCtx = context.WithValue(pollerCtx, isolationGroupKey, request.GetIsolationGroup())
 		task, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
@@ -386,6 +467,26 @@ pollLoop:
 			return task.pollForDecisionResponse(), nil
 		}
 
+		if e.isStickyBuildMismatch(hCtx.Context, taskListKind, task, request.GetBinaryChecksum()) {
+			// the workflow moved to an incompatible build, move the task to the normal task list of the
+			// workflow, which routes it to the version set of the build
+			err := e.redirectStickyDecisionTask(hCtx.Context, task)
+			e.emitInfoOrDebugLog(
+				task.event.DomainID,
+				"Redirected sticky decision task of incompatible build",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(task.event.WorkflowID),
+				tag.WorkflowRunID(task.event.RunID),
+				tag.WorkflowTaskListName(taskListName),
+				tag.WorkflowScheduleID(task.event.ScheduleID),
+				tag.TaskID(task.event.TaskID),
+				tag.Error(err),
+			)
+			hCtx.scope.IncCounter(metrics.StickyBuildMismatchPerTaskListCounter)
+			task.finish(err)
+			continue pollLoop
+		}
+
 		if task.isQuery() {
 			task.finish(nil) // this only means query task sync match succeed.
 
@@ -439,6 +540,7 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
+		e.dispatchAttempts.dispatched(task.event.TaskInfo, resp.Attempt, resp.GetStartedTimestamp())
 		return e.createPollForDecisionTaskResponse(task, resp, hCtx.scope), nil
 	}
 }
@@ -471,6 +573,18 @@ pollLoop:
 			return nil, err
 		}
 
+		versionedTaskList, err := e.getVersionedTaskList(hCtx.Context, taskList, request.GetTaskList(), req.GetForwardedFrom(), request.GetBinaryChecksum(), true)
+		if err != nil {
+			return nil, err
+		}
+		if versionedTaskList != nil {
+			versionedRequest := *req
+			versionedPollRequest := *request
+			versionedPollRequest.TaskList = versionedTaskList
+			versionedRequest.PollRequest = &versionedPollRequest
+			return e.matchingClient.PollForActivityTask(hCtx.Context, &versionedRequest)
+		}
+
 		var maxDispatch *float64
 		if request.TaskListMetadata != nil {
 			maxDispatch = request.TaskListMetadata.MaxTasksPerSecond
@@ -479,6 +593,9 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, buildIDKey, request.GetBinaryChecksum())
+		pollerCtx = context.WithValue(pollerCtx, forwardedFromKey, req.GetForwardedFrom())
+		pollerCtx = context.WithValue(pollerCtx, isolationGroupKey, request.GetIsolationGroup())
 		taskListKind := request.TaskList.Kind
 		task, err := e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
 		if err != nil {
@@ -518,6 +635,7 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
+		e.dispatchAttempts.dispatched(task.event.TaskInfo, resp.Attempt, resp.GetStartedTimestamp())
 		return e.createPollForActivityTaskResponse(task, resp, hCtx.scope), nil
 	}
 }
@@ -536,6 +654,16 @@ func (e *matchingEngineImpl) QueryWorkflow(
 		return nil, err
 	}
 
+	versionedTaskList, err := e.getVersionedTaskList(hCtx.Context, taskList, queryRequest.GetTaskList(), queryRequest.GetForwardedFrom(), queryRequest.GetBuildID(), false)
+	if err != nil {
+		return nil, err
+	}
+	if versionedTaskList != nil {
+		versionedRequest := *queryRequest
+		versionedRequest.TaskList = versionedTaskList
+		return e.matchingClient.QueryWorkflow(hCtx.Context, &versionedRequest)
+	}
+
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
 		return nil, err
@@ -647,7 +775,89 @@ func (e *matchingEngineImpl) DescribeTaskList(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	response := tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus())
+	if taskList.IsRoot() && tlMgr.GetTaskListKind() == types.TaskListKindNormal {
+		if request.DescRequest.GetIncludeTaskListStatus() && request.DescRequest.GetIncludePartitions() {
+			if err := e.describePartitions(hCtx, taskList, request, response); err != nil {
+				return nil, err
+			}
+		}
+		if !taskList.IsVersioned() {
+			if err := e.describeVersionedTaskLists(hCtx, taskList, request, response); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return response, nil
+}
+
+// describePartitions adds the status of every partition of the task list to the response
+func (e *matchingEngineImpl) describePartitions(
+	hCtx *handlerContext,
+	taskList *taskListID,
+	request *types.MatchingDescribeTaskListRequest,
+	response *types.DescribeTaskListResponse,
+) error {
+	domainName, err := e.domainCache.GetDomainName(taskList.domainID)
+	if err != nil {
+		return err
+	}
+	partitions := e.getPartitionNames(domainName, taskList)
+	response.PartitionStatus = make(map[string]*types.TaskListStatus, len(partitions))
+	response.PartitionStatus[taskList.name] = response.TaskListStatus
+	for _, partition := range partitions[1:] {
+		partitionRequest := *request
+		partitionDescRequest := *request.DescRequest
+		partitionDescRequest.TaskList = &types.TaskList{Name: partition, Kind: types.TaskListKindNormal.Ptr()}
+		partitionDescRequest.IncludePartitions = false
+		partitionRequest.DescRequest = &partitionDescRequest
+		partitionResponse, err := e.matchingClient.DescribeTaskList(hCtx.Context, &partitionRequest)
+		if err != nil {
+			return err
+		}
+		response.PartitionStatus[partition] = partitionResponse.GetTaskListStatus()
+	}
+	return nil
+}
+
+// describeVersionedTaskLists adds the pollers of the task lists of the worker version sets to the response
+// and counts the pollers of each build
+func (e *matchingEngineImpl) describeVersionedTaskLists(
+	hCtx *handlerContext,
+	taskList *taskListID,
+	request *types.MatchingDescribeTaskListRequest,
+	response *types.DescribeTaskListResponse,
+) error {
+	sets, err := e.getVersionSets(hCtx.Context, taskList)
+	if err != nil {
+		return err
+	}
+	for _, set := range sets {
+		versionedRequest := *request
+		versionedDescRequest := *request.DescRequest
+		versionedDescRequest.TaskList = &types.TaskList{
+			Name: versionedTaskListName(taskList.GetRoot(), versioning.SetID(set)),
+			Kind: types.TaskListKindNormal.Ptr(),
+		}
+		versionedDescRequest.IncludeTaskListStatus = false
+		versionedRequest.DescRequest = &versionedDescRequest
+		versionedResponse, err := e.matchingClient.DescribeTaskList(hCtx.Context, &versionedRequest)
+		if err != nil {
+			return err
+		}
+		response.Pollers = append(response.Pollers, versionedResponse.GetPollers()...)
+	}
+
+	for _, poller := range response.Pollers {
+		if poller.GetBuildID() == "" {
+			continue
+		}
+		if response.PollersByBuildID == nil {
+			response.PollersByBuildID = make(map[string]int32)
+		}
+		response.PollersByBuildID[poller.GetBuildID()]++
+	}
+	return nil
 }
 
 func (e *matchingEngineImpl) ListTaskListPartitions(
@@ -663,8 +873,10 @@ func (e *matchingEngineImpl) ListTaskListPartitions(
 		return nil, err
 	}
 	resp := &types.ListTaskListPartitionsResponse{
-		ActivityTaskListPartitions: activityTaskListInfo,
-		DecisionTaskListPartitions: decisionTaskListInfo,
+		ActivityTaskListPartitions:      activityTaskListInfo,
+		DecisionTaskListPartitions:      decisionTaskListInfo,
+		ActivityTaskListPartitionConfig: e.getPartitionConfig(request, persistence.TaskListTypeActivity),
+		DecisionTaskListPartitionConfig: e.getPartitionConfig(request, persistence.TaskListTypeDecision),
 	}
 
 	return resp, nil
@@ -711,6 +923,312 @@ func (e *matchingEngineImpl) GetTaskListsByDomain(
 	}, nil
 }
 
+// UpdateWorkerBuildIDCompatibility applies an update to the worker version sets of a task list. The version sets
+// are stored with the root decision task list, this host owns it as the request is routed by the task list name.
+func (e *matchingEngineImpl) UpdateWorkerBuildIDCompatibility(
+	hCtx *handlerContext,
+	request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest,
+) error {
+	updateRequest := request.GetUpdateRequest()
+	taskList, err := e.getVersionSetsTaskList(request.GetDomainUUID(), updateRequest.GetTaskList())
+	if err != nil {
+		return err
+	}
+	domainName, err := e.domainCache.GetDomainName(taskList.domainID)
+	if err != nil {
+		return err
+	}
+	tlMgr, err := e.getTaskListManager(taskList, types.TaskListKindNormal.Ptr())
+	if err != nil {
+		return err
+	}
+	maxSets := e.config.MaxWorkerVersionSets(domainName)
+	err = tlMgr.UpdateVersionSets(func(sets []*types.WorkerVersionSet) ([]*types.WorkerVersionSet, error) {
+		return versioning.Update(sets, updateRequest, maxSets)
+	})
+	if err != nil {
+		return err
+	}
+	e.versionSetsCache.put(*taskList, tlMgr.GetVersionSets())
+	return nil
+}
+
+// GetWorkerBuildIDCompatibility returns the worker version sets of a task list
+func (e *matchingEngineImpl) GetWorkerBuildIDCompatibility(
+	hCtx *handlerContext,
+	request *types.MatchingGetWorkerBuildIDCompatibilityRequest,
+) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+	taskList, err := e.getVersionSetsTaskList(request.GetDomainUUID(), request.GetGetRequest().GetTaskList())
+	if err != nil {
+		return nil, err
+	}
+	tlMgr, err := e.getTaskListManager(taskList, types.TaskListKindNormal.Ptr())
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetWorkerBuildIDCompatibilityResponse{
+		VersionSets: tlMgr.GetVersionSets(),
+	}, nil
+}
+
+// getVersionSetsTaskList returns the root decision task list which stores the worker version sets of a task list
+func (e *matchingEngineImpl) getVersionSetsTaskList(domainID string, taskList *types.TaskList) (*taskListID, error) {
+	if taskList.GetKind() == types.TaskListKindSticky {
+		return nil, &types.BadRequestError{Message: "Sticky task lists do not have worker version sets."}
+	}
+	id, err := newTaskListID(domainID, taskList.GetName(), persistence.TaskListTypeDecision)
+	if err != nil {
+		return nil, err
+	}
+	if !id.IsRoot() || id.IsVersioned() {
+		return nil, &types.BadRequestError{Message: "Worker version sets can only be set on the root task list."}
+	}
+	return id, nil
+}
+
+// getVersionSets returns the worker version sets of the root of a task list. They are read from the root
+// decision task list when this host owns it, from the cache, or from the owner of the root task list.
+func (e *matchingEngineImpl) getVersionSets(ctx context.Context, taskList *taskListID) ([]*types.WorkerVersionSet, error) {
+	root, err := newTaskListID(taskList.domainID, taskList.GetRoot(), persistence.TaskListTypeDecision)
+	if err != nil {
+		return nil, err
+	}
+
+	e.taskListsLock.RLock()
+	tlMgr, ok := e.taskLists[*root]
+	e.taskListsLock.RUnlock()
+	if ok {
+		return tlMgr.GetVersionSets(), nil
+	}
+
+	cached, ok, fresh := e.versionSetsCache.get(*root)
+	if fresh {
+		return cached, nil
+	}
+	domainName, err := e.domainCache.GetDomainName(root.domainID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.matchingClient.GetWorkerBuildIDCompatibility(ctx, &types.MatchingGetWorkerBuildIDCompatibilityRequest{
+		DomainUUID: root.domainID,
+		GetRequest: &types.GetWorkerBuildIDCompatibilityRequest{
+			Domain:   domainName,
+			TaskList: &types.TaskList{Name: root.name, Kind: types.TaskListKindNormal.Ptr()},
+		},
+	})
+	if err != nil {
+		if ok {
+			// keep routing by the last known version sets while the owner of the root task list is unavailable
+			return cached, nil
+		}
+		return nil, err
+	}
+	e.versionSetsCache.put(*root, resp.GetVersionSets())
+	return resp.GetVersionSets(), nil
+}
+
+// UpdateTaskListState pauses, resumes or migrates a task list. The state is stored with the root partition of
+// the task list, this host owns it as the request is routed by the task list name. The other partitions pick
+// up the change when their cached state expires.
+func (e *matchingEngineImpl) UpdateTaskListState(
+	hCtx *handlerContext,
+	request *types.MatchingUpdateTaskListStateRequest,
+) error {
+	taskList, err := e.getTaskListStateTaskList(request.GetDomainUUID(), request.GetTaskList(), request.TaskListType)
+	if err != nil {
+		return err
+	}
+	tlMgr, err := e.getTaskListManager(taskList, types.TaskListKindNormal.Ptr())
+	if err != nil {
+		return err
+	}
+	if err := tlMgr.UpdateTaskListState(request.Paused, request.MigrateTo); err != nil {
+		return err
+	}
+	e.taskListStateCache.put(*taskList, tlMgr.GetTaskListState())
+	return nil
+}
+
+// GetTaskListState returns the pause and migration state of a task list
+func (e *matchingEngineImpl) GetTaskListState(
+	hCtx *handlerContext,
+	request *types.MatchingGetTaskListStateRequest,
+) (*types.TaskListState, error) {
+	taskList, err := e.getTaskListStateTaskList(request.GetDomainUUID(), request.GetTaskList(), request.TaskListType)
+	if err != nil {
+		return nil, err
+	}
+	tlMgr, err := e.getTaskListManager(taskList, types.TaskListKindNormal.Ptr())
+	if err != nil {
+		return nil, err
+	}
+	return tlMgr.GetTaskListState(), nil
+}
+
+// getTaskListStateTaskList returns the root partition which stores the pause and migration state of a task list
+func (e *matchingEngineImpl) getTaskListStateTaskList(
+	domainID string,
+	taskList *types.TaskList,
+	taskListType *types.TaskListType,
+) (*taskListID, error) {
+	if taskList.GetKind() == types.TaskListKindSticky {
+		return nil, &types.BadRequestError{Message: "Sticky task lists cannot be paused or migrated."}
+	}
+	if taskListType == nil {
+		return nil, &types.BadRequestError{Message: "TaskListType is not set on request."}
+	}
+	taskType := persistence.TaskListTypeDecision
+	if *taskListType == types.TaskListTypeActivity {
+		taskType = persistence.TaskListTypeActivity
+	}
+	id, err := newTaskListID(domainID, taskList.GetName(), taskType)
+	if err != nil {
+		return nil, err
+	}
+	if !id.IsRoot() {
+		return nil, &types.BadRequestError{Message: "Only the root partition of a task list can be paused or migrated."}
+	}
+	return id, nil
+}
+
+// getTaskListState returns the pause and migration state of the root partition of a task list. It is read from
+// the root partition when this host owns it, from the cache, or from the owner of the root partition.
+func (e *matchingEngineImpl) getTaskListState(ctx context.Context, taskList *taskListID) (*types.TaskListState, error) {
+	root, err := newTaskListID(taskList.domainID, taskList.GetRoot(), taskList.taskType)
+	if err != nil {
+		return nil, err
+	}
+
+	e.taskListsLock.RLock()
+	tlMgr, ok := e.taskLists[*root]
+	e.taskListsLock.RUnlock()
+	if ok {
+		return tlMgr.GetTaskListState(), nil
+	}
+
+	cached, ok, fresh := e.taskListStateCache.get(*root)
+	if fresh {
+		return cached, nil
+	}
+	taskListType := types.TaskListTypeDecision
+	if root.taskType == persistence.TaskListTypeActivity {
+		taskListType = types.TaskListTypeActivity
+	}
+	state, err := e.matchingClient.GetTaskListState(ctx, &types.MatchingGetTaskListStateRequest{
+		DomainUUID:   root.domainID,
+		TaskList:     &types.TaskList{Name: root.name, Kind: types.TaskListKindNormal.Ptr()},
+		TaskListType: &taskListType,
+	})
+	if err != nil {
+		if ok {
+			// keep the last known state while the owner of the root partition is unavailable
+			return cached, nil
+		}
+		return nil, err
+	}
+	e.taskListStateCache.put(*root, state)
+	return state, nil
+}
+
+// getVersionedTaskList returns the task list of the worker version set a task or poller with the given build
+// is routed to, it returns nil if the request stays on the given task list. Requests forwarded between partitions,
+// requests on sticky task lists and requests which are already routed to a version set are never rerouted.
+func (e *matchingEngineImpl) getVersionedTaskList(
+	ctx context.Context,
+	taskList *taskListID,
+	requestTaskList *types.TaskList,
+	forwardedFrom string,
+	buildID string,
+	isPoller bool,
+) (*types.TaskList, error) {
+	if forwardedFrom != "" || requestTaskList.GetKind() == types.TaskListKindSticky || taskList.IsVersioned() {
+		return nil, nil
+	}
+
+	sets, err := e.getVersionSets(ctx, taskList)
+	if err != nil {
+		return nil, err
+	}
+	versionSet := versioning.TaskVersionSet(sets, buildID)
+	if isPoller {
+		versionSet = versioning.PollerVersionSet(sets, buildID)
+	}
+	if versionSet == "" {
+		return nil, nil
+	}
+	return &types.TaskList{
+		Name: versionedTaskListName(taskList.GetRoot(), versionSet),
+		Kind: requestTaskList.Kind,
+	}, nil
+}
+
+// isStickyBuildMismatch returns true if a task polled from a sticky task list belongs to a workflow whose build
+// is not compatible with the build of the poller. Different builds are compatible when the task of the workflow
+// is routed to the version set the poller polls on the normal task list of the workflow, which includes the case
+// where neither build is in a version set. The task is redirected when the version sets cannot be read.
+func (e *matchingEngineImpl) isStickyBuildMismatch(
+	ctx context.Context,
+	taskListKind *types.TaskListKind,
+	task *InternalTask,
+	buildID string,
+) bool {
+	if taskListKind == nil || *taskListKind != types.TaskListKindSticky || task.event == nil {
+		return false
+	}
+	if task.event.BuildID == "" || buildID == "" || task.event.BuildID == buildID {
+		return false
+	}
+	mutableStateResp, err := e.historyService.GetMutableState(ctx, &types.GetMutableStateRequest{
+		DomainUUID: task.event.DomainID,
+		Execution:  task.workflowExecution(),
+	})
+	if err != nil || !mutableStateResp.GetIsWorkflowRunning() {
+		return true
+	}
+	taskList, err := newTaskListID(task.event.DomainID, mutableStateResp.GetTaskList().GetName(), persistence.TaskListTypeDecision)
+	if err != nil {
+		return true
+	}
+	sets, err := e.getVersionSets(ctx, taskList)
+	if err != nil {
+		return true
+	}
+	return versioning.TaskVersionSet(sets, task.event.BuildID) != versioning.PollerVersionSet(sets, buildID)
+}
+
+// redirectStickyDecisionTask adds a decision task polled from a sticky task list to the normal task list of the
+// workflow, with the same schedule ID so that history starts it once. Tasks of closed workflows are dropped.
+func (e *matchingEngineImpl) redirectStickyDecisionTask(ctx context.Context, task *InternalTask) error {
+	mutableStateResp, err := e.historyService.GetMutableState(ctx, &types.GetMutableStateRequest{
+		DomainUUID: task.event.DomainID,
+		Execution:  task.workflowExecution(),
+	})
+	if err != nil {
+		switch err.(type) {
+		case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError:
+			return nil
+		}
+		return err
+	}
+	if !mutableStateResp.GetIsWorkflowRunning() {
+		return nil
+	}
+	return e.matchingClient.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+		DomainUUID: task.event.DomainID,
+		Execution:  task.workflowExecution(),
+		TaskList: &types.TaskList{
+			Name: mutableStateResp.GetTaskList().GetName(),
+			Kind: types.TaskListKindNormal.Ptr(),
+		},
+		ScheduleID:                    task.event.ScheduleID,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(task.event.ScheduleToStartTimeout),
+		Priority:                      task.event.Priority,
+		FairnessKey:                   task.event.FairnessKey,
+		BuildID:                       mutableStateResp.GetBuildID(),
+		IsolationGroup:                task.event.IsolationGroup,
+	})
+}
+
 func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
 	host, err := e.keyResolver.Lookup(partitionKey)
 	if err != nil {
@@ -730,21 +1248,39 @@ func (e *matchingEngineImpl) getAllPartitions(
 	}
 	taskList := request.GetTaskList()
 	taskListID, err := newTaskListID(domainID, taskList.GetName(), taskListType)
-	rootPartition := taskListID.GetRoot()
-
-	partitionKeys = append(partitionKeys, rootPartition)
-
-	nWritePartitions := e.config.NumTasklistWritePartitions
-	n := nWritePartitions(request.GetDomain(), rootPartition, taskListType)
-	if n <= 0 {
-		return partitionKeys, nil
+	if err != nil {
+		return partitionKeys, err
 	}
+	return e.getPartitionNames(request.GetDomain(), taskListID), nil
+}
 
+// getPartitionNames returns the names of all partitions of a task list, starting with the root partition
+func (e *matchingEngineImpl) getPartitionNames(domainName string, taskList *taskListID) []string {
+	rootPartition := taskList.GetRoot()
+	partitionKeys := []string{rootPartition}
+
+	// partitions which are being drained are still read from
+	n := common.MaxInt(
+		e.config.NumTasklistWritePartitions(domainName, rootPartition, taskList.taskType),
+		e.config.NumTasklistReadPartitions(domainName, rootPartition, taskList.taskType),
+	)
 	for i := 1; i < n; i++ {
-		partitionKeys = append(partitionKeys, fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, rootPartition, i))
+		partitionKeys = append(partitionKeys, taskList.mkName(i))
 	}
+	return partitionKeys
+}
 
-	return partitionKeys, nil
+func (e *matchingEngineImpl) getPartitionConfig(
+	request *types.MatchingListTaskListPartitionsRequest,
+	taskListType int,
+) *types.TaskListPartitionConfig {
+	domainName := request.GetDomain()
+	taskListName := request.GetTaskList().GetName()
+	return &types.TaskListPartitionConfig{
+		NumReadPartitions:  int32(common.MaxInt(1, e.config.NumTasklistReadPartitions(domainName, taskListName, taskListType))),
+		NumWritePartitions: int32(common.MaxInt(1, e.config.NumTasklistWritePartitions(domainName, taskListName, taskListType))),
+		ScalingEnabled:     e.config.EnablePartitionScaling(domainName, taskListName, taskListType),
+	}
 }
 
 // Loads a task from persistence and wraps it in a task context
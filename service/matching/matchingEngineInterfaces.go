@@ -25,6 +25,7 @@ import "github.com/uber/cadence/common/types"
 type (
 	// Engine exposes interfaces for clients to poll for activity and decision tasks.
 	Engine interface {
+		Start()
 		Stop()
 		AddDecisionTask(hCtx *handlerContext, request *types.AddDecisionTaskRequest) (syncMatch bool, err error)
 		AddActivityTask(hCtx *handlerContext, request *types.AddActivityTaskRequest) (syncMatch bool, err error)
@@ -36,5 +37,9 @@ type (
 		DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(hCtx *handlerContext, request *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		UpdateWorkerBuildIDCompatibility(hCtx *handlerContext, request *types.MatchingUpdateWorkerBuildIDCompatibilityRequest) error
+		GetWorkerBuildIDCompatibility(hCtx *handlerContext, request *types.MatchingGetWorkerBuildIDCompatibilityRequest) (*types.GetWorkerBuildIDCompatibilityResponse, error)
+		UpdateTaskListState(hCtx *handlerContext, request *types.MatchingUpdateTaskListStateRequest) error
+		GetTaskListState(hCtx *handlerContext, request *types.MatchingGetTaskListStateRequest) (*types.TaskListState, error)
 	}
 )
@@ -33,6 +33,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -43,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/tasklistdlq"
 	"github.com/uber/cadence/common/types"
 
 	"github.com/davecgh/go-spew/spew"
@@ -131,22 +133,38 @@ func (s *matchingEngineSuite) TearDownTest() {
 func (s *matchingEngineSuite) newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager,
 ) *matchingEngineImpl {
-	return newMatchingEngine(config, taskMgr, s.mockHistoryClient, s.logger, s.mockDomainCache)
+	e := newMatchingEngine(config, taskMgr, s.mockHistoryClient, s.logger, s.mockDomainCache)
+	e.matchingClient = s.newMockMatchingClient()
+	return e
+}
+
+// newMockMatchingClient returns a matching client mock for task lists which have no worker version sets
+func (s *matchingEngineSuite) newMockMatchingClient() *matching.MockClient {
+	mockMatchingClient := matching.NewMockClient(s.controller)
+	mockMatchingClient.EXPECT().GetWorkerBuildIDCompatibility(gomock.Any(), gomock.Any()).
+		Return(&types.GetWorkerBuildIDCompatibilityResponse{}, nil).AnyTimes()
+	return mockMatchingClient
 }
 
 func newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager, mockHistoryClient history.Client,
 	logger log.Logger, mockDomainCache cache.DomainCache,
 ) *matchingEngineImpl {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Matching)
 	return &matchingEngineImpl{
-		taskManager:     taskMgr,
-		historyService:  mockHistoryClient,
-		taskLists:       make(map[taskListID]taskListManager),
-		logger:          logger,
-		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Matching),
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
-		config:          config,
-		domainCache:     mockDomainCache,
+		taskManager:        taskMgr,
+		historyService:     mockHistoryClient,
+		taskLists:          make(map[taskListID]taskListManager),
+		logger:             logger,
+		metricsClient:      metricsClient,
+		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
+		config:             config,
+		domainCache:        mockDomainCache,
+		taskListDLQ:        tasklistdlq.NewQueue(nil, metricsClient, logger),
+		domainQuotas:       newDomainQuotas(config),
+		versionSetsCache:   newVersionSetsCache(config.WorkerVersionSetsCacheTTL),
+		taskListStateCache: newTaskListStateCache(config.TaskListStateCacheTTL),
+		dispatchAttempts:   newTaskDispatchAttempts(config.TaskDispatchAttemptsCacheSize()),
 	}
 }
 
@@ -338,6 +356,548 @@ func (s *matchingEngineSuite) TestAddDecisionTasksForwarded() {
 	s.AddTasksTest(persistence.TaskListTypeDecision, true)
 }
 
+func (s *matchingEngineSuite) TestTasksAndPollsRoutedByVersionSet() {
+	domainID := "domainId"
+	tl := "makeToast"
+	taskList := &types.TaskList{Name: tl, Kind: types.TaskListKindNormal.Ptr()}
+	execution := &types.WorkflowExecution{RunID: "run1", WorkflowID: "workflow1"}
+
+	for _, update := range []*types.UpdateWorkerBuildIDCompatibilityRequest{
+		{TaskList: taskList, AddNewBuildIDInNewDefaultSet: "1.0"},
+		{TaskList: taskList, AddNewCompatibleBuildID: "1.1", ExistingCompatibleBuildID: "1.0"},
+		{TaskList: taskList, AddNewBuildIDInNewDefaultSet: "2.0"},
+	} {
+		err := s.matchingEngine.UpdateWorkerBuildIDCompatibility(s.handlerContext, &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+			DomainUUID:    domainID,
+			UpdateRequest: update,
+		})
+		s.NoError(err)
+	}
+	mockMatchingClient := s.newMockMatchingClient()
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	testCases := []struct {
+		buildID  string
+		taskList string
+	}{
+		{"", tl + "/__cadence_build/2.0"},
+		{"1.1", tl + "/__cadence_build/1.0"},
+		{"2.0", tl + "/__cadence_build/2.0"},
+	}
+	for _, tc := range testCases {
+		mockMatchingClient.EXPECT().AddDecisionTask(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *types.AddDecisionTaskRequest, _ ...interface{}) error {
+				s.Equal(tc.taskList, request.GetTaskList().GetName())
+				s.Equal(tc.buildID, request.GetBuildID())
+				return nil
+			})
+		_, err := s.matchingEngine.AddDecisionTask(s.handlerContext, &types.AddDecisionTaskRequest{
+			DomainUUID:                    domainID,
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+			BuildID:                       tc.buildID,
+		})
+		s.NoError(err)
+	}
+
+	// tasks of workflows whose build is not in any set stay on the unversioned task list
+	_, err := s.matchingEngine.AddDecisionTask(s.handlerContext, &types.AddDecisionTaskRequest{
+		DomainUUID:                    domainID,
+		Execution:                     execution,
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+		BuildID:                       "0.9",
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(newTestTaskListID(domainID, tl, persistence.TaskListTypeDecision)))
+
+	mockMatchingClient.EXPECT().PollForActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.MatchingPollForActivityTaskRequest, _ ...interface{}) (*types.PollForActivityTaskResponse, error) {
+			s.Equal(tl+"/__cadence_build/1.0", request.GetPollRequest().GetTaskList().GetName())
+			return emptyPollForActivityTaskResponse, nil
+		})
+	resp, err := s.matchingEngine.PollForActivityTask(s.handlerContext, &types.MatchingPollForActivityTaskRequest{
+		DomainUUID: domainID,
+		PollerID:   "pollerID",
+		PollRequest: &types.PollForActivityTaskRequest{
+			TaskList:       taskList,
+			Identity:       "identity",
+			BinaryChecksum: "1.1",
+		},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForActivityTaskResponse, resp)
+}
+
+func (s *matchingEngineSuite) TestWorkerVersionSetsPersistedWithTaskList() {
+	domainID := "domainId"
+	taskList := &types.TaskList{Name: "makeToast", Kind: types.TaskListKindNormal.Ptr()}
+	update := func(request *types.UpdateWorkerBuildIDCompatibilityRequest) error {
+		return s.matchingEngine.UpdateWorkerBuildIDCompatibility(s.handlerContext, &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+			DomainUUID:    domainID,
+			UpdateRequest: request,
+		})
+	}
+	s.matchingEngine.config.MaxWorkerVersionSets = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+
+	s.NoError(update(&types.UpdateWorkerBuildIDCompatibilityRequest{TaskList: taskList, AddNewBuildIDInNewDefaultSet: "1.0"}))
+	s.NoError(update(&types.UpdateWorkerBuildIDCompatibilityRequest{TaskList: taskList, AddNewBuildIDInNewDefaultSet: "2.0"}))
+	s.IsType(&types.LimitExceededError{}, update(&types.UpdateWorkerBuildIDCompatibilityRequest{TaskList: taskList, AddNewBuildIDInNewDefaultSet: "3.0"}))
+	s.IsType(&types.BadRequestError{}, update(&types.UpdateWorkerBuildIDCompatibilityRequest{
+		TaskList:                     &types.TaskList{Name: "/__cadence_sys/makeToast/1"},
+		AddNewBuildIDInNewDefaultSet: "3.0",
+	}))
+	s.IsType(&types.BadRequestError{}, update(&types.UpdateWorkerBuildIDCompatibilityRequest{
+		TaskList:                     &types.TaskList{Name: "makeToast", Kind: types.TaskListKindSticky.Ptr()},
+		AddNewBuildIDInNewDefaultSet: "3.0",
+	}))
+
+	// the version sets are read back from persistence when the task list is loaded again
+	s.matchingEngine.unloadTaskList(newTestTaskListID(domainID, "makeToast", persistence.TaskListTypeDecision))
+	resp, err := s.matchingEngine.GetWorkerBuildIDCompatibility(s.handlerContext, &types.MatchingGetWorkerBuildIDCompatibilityRequest{
+		DomainUUID: domainID,
+		GetRequest: &types.GetWorkerBuildIDCompatibilityRequest{TaskList: taskList},
+	})
+	s.NoError(err)
+	s.Equal([]*types.WorkerVersionSet{{BuildIDs: []string{"1.0"}}, {BuildIDs: []string{"2.0"}}}, resp.GetVersionSets())
+}
+
+func (s *matchingEngineSuite) TestWorkerVersionSetsReadFromOwner() {
+	domainID := "domainId"
+	taskList := newTestTaskListID(domainID, "/__cadence_sys/makeToast/1", persistence.TaskListTypeActivity)
+	sets := []*types.WorkerVersionSet{{BuildIDs: []string{"1.0"}}}
+	mockMatchingClient := matching.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	// the version sets of the root decision task list are read from its owner and cached
+	mockMatchingClient.EXPECT().GetWorkerBuildIDCompatibility(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.MatchingGetWorkerBuildIDCompatibilityRequest, _ ...interface{}) (*types.GetWorkerBuildIDCompatibilityResponse, error) {
+			s.Equal("makeToast", request.GetGetRequest().GetTaskList().GetName())
+			return &types.GetWorkerBuildIDCompatibilityResponse{VersionSets: sets}, nil
+		}).Times(1)
+	for i := 0; i < 2; i++ {
+		result, err := s.matchingEngine.getVersionSets(context.Background(), taskList)
+		s.NoError(err)
+		s.Equal(sets, result)
+	}
+
+	// the last known version sets are used when the owner cannot be reached
+	s.matchingEngine.versionSetsCache.ttl = dynamicconfig.GetDurationPropertyFn(0)
+	mockMatchingClient.EXPECT().GetWorkerBuildIDCompatibility(gomock.Any(), gomock.Any()).Return(nil, &types.ServiceBusyError{})
+	result, err := s.matchingEngine.getVersionSets(context.Background(), taskList)
+	s.NoError(err)
+	s.Equal(sets, result)
+}
+
+func (s *matchingEngineSuite) TestPauseAndMigrateTaskList() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	mockMatchingClient := s.newMockMatchingClient()
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+	taskList := &types.TaskList{Name: tl}
+	execution := &types.WorkflowExecution{RunID: "run1", WorkflowID: "workflow1"}
+	addTask := func(scheduleID int64) {
+		_, err := s.matchingEngine.AddActivityTask(s.handlerContext, &types.AddActivityTaskRequest{
+			SourceDomainUUID:              domainID,
+			DomainUUID:                    domainID,
+			Execution:                     execution,
+			ScheduleID:                    scheduleID,
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+		})
+		s.NoError(err)
+	}
+
+	updateState := func(paused *bool, migrateTo *string) {
+		err := s.matchingEngine.UpdateTaskListState(s.handlerContext, &types.MatchingUpdateTaskListStateRequest{
+			DomainUUID:   domainID,
+			TaskList:     taskList,
+			TaskListType: types.TaskListTypeActivity.Ptr(),
+			Paused:       paused,
+			MigrateTo:    migrateTo,
+		})
+		s.NoError(err)
+	}
+
+	// tasks of a paused task list stay in the backlog and pollers receive empty responses
+	updateState(common.BoolPtr(true), nil)
+	addTask(1)
+	addTask(2)
+	s.EqualValues(2, s.taskManager.getTaskCount(tlID))
+	resp, err := s.matchingEngine.PollForActivityTask(s.handlerContext, &types.MatchingPollForActivityTaskRequest{
+		DomainUUID: domainID,
+		PollRequest: &types.PollForActivityTaskRequest{
+			TaskList: taskList,
+			Identity: "identity",
+		},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForActivityTaskResponse, resp)
+	s.EqualValues(2, s.taskManager.getTaskCount(tlID))
+
+	// the backlog and the new tasks of a migrated task list are added to the destination task list
+	var migrated int32
+	mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.AddActivityTaskRequest, _ ...interface{}) error {
+			s.Equal("otherToast", request.GetTaskList().GetName())
+			s.Equal(domainID, request.GetSourceDomainUUID())
+			s.True(request.GetScheduleToStartTimeoutSeconds() > 0)
+			atomic.AddInt32(&migrated, 1)
+			return nil
+		}).Times(3)
+	updateState(nil, common.StringPtr("otherToast"))
+	s.Eventually(func() bool {
+		return s.taskManager.getTaskCount(tlID) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	addTask(3)
+	s.EqualValues(3, atomic.LoadInt32(&migrated))
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+
+	// the state is read back from persistence when the task list is loaded again
+	s.matchingEngine.unloadTaskList(tlID)
+	state, err := s.matchingEngine.GetTaskListState(s.handlerContext, &types.MatchingGetTaskListStateRequest{
+		DomainUUID:   domainID,
+		TaskList:     taskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.NoError(err)
+	s.Equal(&types.TaskListState{Paused: true, MigrateTo: "otherToast"}, state)
+}
+
+func (s *matchingEngineSuite) TestTaskListStateReadFromOwner() {
+	domainID := "domainId"
+	taskList := newTestTaskListID(domainID, "/__cadence_sys/makeToast/1", persistence.TaskListTypeActivity)
+	state := &types.TaskListState{Paused: true}
+	mockMatchingClient := matching.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	// the state of the root partition is read from its owner and cached
+	mockMatchingClient.EXPECT().GetTaskListState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.MatchingGetTaskListStateRequest, _ ...interface{}) (*types.TaskListState, error) {
+			s.Equal("makeToast", request.GetTaskList().GetName())
+			s.Equal(types.TaskListTypeActivity, request.GetTaskListType())
+			return state, nil
+		}).Times(1)
+	for i := 0; i < 2; i++ {
+		result, err := s.matchingEngine.getTaskListState(context.Background(), taskList)
+		s.NoError(err)
+		s.Equal(state, result)
+	}
+
+	// the last known state is used when the owner cannot be reached
+	s.matchingEngine.taskListStateCache.ttl = dynamicconfig.GetDurationPropertyFn(0)
+	mockMatchingClient.EXPECT().GetTaskListState(gomock.Any(), gomock.Any()).Return(nil, &types.ServiceBusyError{})
+	result, err := s.matchingEngine.getTaskListState(context.Background(), taskList)
+	s.NoError(err)
+	s.Equal(state, result)
+}
+
+func (s *matchingEngineSuite) TestMoveTaskToDLQ() {
+	s.matchingEngine.config.MaxTaskDispatchAttempts = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	dlq := tasklistdlq.NewMockQueue(s.controller)
+	dlq.EXPECT().Stop().AnyTimes()
+	s.matchingEngine.taskListDLQ = dlq
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+	addTask, pollTask := s.setupDispatchAttempts(domainID, tl)
+
+	// history adds the task again with the next attempt each time the previous attempt timed out
+	addTask(0, 0)
+	pollTask(0)
+	addTask(1, 0)
+	pollTask(1)
+	// an attempt added and dispatched again is only counted once
+	addTask(1, 0)
+	pollTask(1)
+
+	// the counts are kept when the task list partition is unloaded
+	s.matchingEngine.unloadTaskList(tlID)
+
+	dlq.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, message *tasklistdlq.Message) error {
+			s.Equal(domainID, message.DomainID)
+			s.Equal(tl, message.TaskList)
+			s.Equal(persistence.TaskListTypeActivity, message.TaskType)
+			s.Equal(int64(5), message.Task.ScheduleID)
+			s.Equal(int64(2), message.Task.Attempt)
+			s.Equal(2, message.Attempts)
+			return nil
+		})
+	addTask(2, 0)
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestDispatchAttemptsResetOnHeartbeat() {
+	s.matchingEngine.config.MaxTaskDispatchAttempts = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	dlq := tasklistdlq.NewMockQueue(s.controller)
+	dlq.EXPECT().Stop().AnyTimes()
+	s.matchingEngine.taskListDLQ = dlq
+
+	addTask, pollTask := s.setupDispatchAttempts("domainId", "makeToast")
+
+	addTask(0, 0)
+	startedTimestamp := pollTask(0)
+	// the first attempt heartbeated before it timed out, so its dispatch is not counted
+	addTask(1, startedTimestamp+1)
+	startedTimestamp = pollTask(1)
+	addTask(2, startedTimestamp)
+	pollTask(2)
+}
+
+// setupDispatchAttempts returns functions which add an attempt of an activity task with the
+// given last heartbeat and poll it, the poll returns the started timestamp of the attempt
+func (s *matchingEngineSuite) setupDispatchAttempts(
+	domainID string,
+	tl string,
+) (func(attempt int64, lastHeartbeatTimestamp int64), func(attempt int64) int64) {
+	taskList := &types.TaskList{Name: tl}
+	execution := &types.WorkflowExecution{RunID: "run1", WorkflowID: "workflow1"}
+	addTask := func(attempt int64, lastHeartbeatTimestamp int64) {
+		_, err := s.matchingEngine.AddActivityTask(s.handlerContext, &types.AddActivityTaskRequest{
+			SourceDomainUUID:              domainID,
+			DomainUUID:                    domainID,
+			Execution:                     execution,
+			ScheduleID:                    5,
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+			Attempt:                       attempt,
+			LastHeartbeatTimestamp:        common.Int64Ptr(lastHeartbeatTimestamp),
+		})
+		s.NoError(err)
+	}
+	pollTask := func(attempt int64) int64 {
+		startedTimestamp := time.Now().UnixNano()
+		s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, taskRequest *types.RecordActivityTaskStartedRequest) (*types.RecordActivityTaskStartedResponse, error) {
+				return &types.RecordActivityTaskStartedResponse{
+					ScheduledEvent: newActivityTaskScheduledEvent(taskRequest.ScheduleID, 0,
+						&types.ScheduleActivityTaskDecisionAttributes{
+							ActivityID:                    "activityId",
+							TaskList:                      taskList,
+							ActivityType:                  &types.ActivityType{Name: "activity1"},
+							ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+							ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+							StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+							HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+						}),
+					StartedTimestamp: common.Int64Ptr(startedTimestamp),
+					Attempt:          attempt,
+				}, nil
+			})
+		resp, err := s.matchingEngine.PollForActivityTask(s.handlerContext, &types.MatchingPollForActivityTaskRequest{
+			DomainUUID: domainID,
+			PollRequest: &types.PollForActivityTaskRequest{
+				TaskList: taskList,
+				Identity: "identity",
+			},
+		})
+		s.NoError(err)
+		s.Equal("activityId", resp.GetActivityID())
+		return startedTimestamp
+	}
+	return addTask, pollTask
+}
+
+func (s *matchingEngineSuite) TestIngestionQuotas() {
+	var taskListRPS, domainRPS atomic.Value
+	taskListRPS.Store(_defaultTaskDispatchRPS)
+	domainRPS.Store(_defaultTaskDispatchRPS)
+	s.matchingEngine.config.TaskListIngestionRPS = func(domain string, taskList string, taskType int) float64 {
+		return taskListRPS.Load().(float64)
+	}
+	s.matchingEngine.config.DomainTaskIngestionRPS = func(opts ...dynamicconfig.FilterOption) float64 {
+		return domainRPS.Load().(float64)
+	}
+
+	domainID := "domainId"
+	taskList := &types.TaskList{Name: "makeToast"}
+	addTask := func(scheduleID int64) error {
+		_, err := s.matchingEngine.AddActivityTask(s.handlerContext, &types.AddActivityTaskRequest{
+			SourceDomainUUID:              domainID,
+			DomainUUID:                    domainID,
+			Execution:                     &types.WorkflowExecution{RunID: "run1", WorkflowID: "workflow1"},
+			ScheduleID:                    scheduleID,
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+		})
+		return err
+	}
+	s.NoError(addTask(1))
+
+	// a lowered quota applies right away
+	domainRPS.Store(0.0)
+	s.Equal(errDomainIngestionThrottled, addTask(2))
+	taskListRPS.Store(0.0)
+	s.Equal(errTaskListIngestionThrottled, addTask(3))
+	s.EqualValues(1, s.taskManager.getTaskCount(newTestTaskListID(domainID, taskList.GetName(), persistence.TaskListTypeActivity)))
+
+	taskListType := types.TaskListTypeActivity
+	resp, err := s.matchingEngine.DescribeTaskList(s.handlerContext, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:              taskList,
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: true,
+		},
+	})
+	s.NoError(err)
+	s.Zero(resp.GetTaskListStatus().GetIngestionRPSLimit())
+	s.Zero(resp.GetTaskListStatus().GetDomainIngestionRPSLimit())
+	s.Equal(_defaultTaskDispatchRPS, resp.GetTaskListStatus().GetDomainDispatchRPSLimit())
+	s.True(resp.GetTaskListStatus().GetThrottledAddRatePerSecond() > 0)
+	s.Zero(resp.GetTaskListStatus().GetThrottledDispatchRatePerSecond())
+}
+
+func (s *matchingEngineSuite) TestIngestionQuotasDomainThrottleKeepsTaskListQuota() {
+	var domainRPS atomic.Value
+	domainRPS.Store(0.0)
+	s.matchingEngine.config.TaskListIngestionRPS = func(domain string, taskList string, taskType int) float64 {
+		return 0.001
+	}
+	s.matchingEngine.config.DomainTaskIngestionRPS = func(opts ...dynamicconfig.FilterOption) float64 {
+		return domainRPS.Load().(float64)
+	}
+
+	domainID := "domainId"
+	taskList := &types.TaskList{Name: "makeToast"}
+	addTask := func(scheduleID int64) error {
+		_, err := s.matchingEngine.AddActivityTask(s.handlerContext, &types.AddActivityTaskRequest{
+			SourceDomainUUID:              domainID,
+			DomainUUID:                    domainID,
+			Execution:                     &types.WorkflowExecution{RunID: "run1", WorkflowID: "workflow1"},
+			ScheduleID:                    scheduleID,
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+		})
+		return err
+	}
+	s.Equal(errDomainIngestionThrottled, addTask(1))
+	s.Equal(errDomainIngestionThrottled, addTask(2))
+
+	// the tasks rejected by the domain quota did not use the single token of the task list
+	domainRPS.Store(_defaultTaskDispatchRPS)
+	s.matchingEngine.domainQuotas = newDomainQuotas(s.matchingEngine.config)
+	s.NoError(addTask(3))
+	s.Equal(errTaskListIngestionThrottled, addTask(4))
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskListPartitions() {
+	s.matchingEngine.config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	mockMatchingClient := s.newMockMatchingClient()
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	partitionStatus := &types.TaskListStatus{BacklogCountHint: 5, AddRatePerSecond: 1}
+	mockMatchingClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.MatchingDescribeTaskListRequest, _ ...interface{}) (*types.DescribeTaskListResponse, error) {
+			s.Equal("/__cadence_sys/makeToast/1", request.GetDescRequest().GetTaskList().GetName())
+			s.False(request.GetDescRequest().GetIncludePartitions())
+			return &types.DescribeTaskListResponse{TaskListStatus: partitionStatus}, nil
+		})
+
+	taskListType := types.TaskListTypeActivity
+	resp, err := s.matchingEngine.DescribeTaskList(s.handlerContext, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: "domainId",
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:              &types.TaskList{Name: "makeToast"},
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: true,
+			IncludePartitions:     true,
+		},
+	})
+	s.NoError(err)
+	s.Len(resp.GetPartitionStatus(), 2)
+	s.Equal(resp.GetTaskListStatus(), resp.GetPartitionStatus()["makeToast"])
+	s.Equal(partitionStatus, resp.GetPartitionStatus()["/__cadence_sys/makeToast/1"])
+}
+
+func (s *matchingEngineSuite) TestStickyBuildMismatch() {
+	sticky := types.TaskListKindSticky
+	normal := types.TaskListKindNormal
+	task := newInternalTask(&persistence.TaskInfo{
+		DomainID:   "domainId",
+		WorkflowID: "workflow1",
+		RunID:      "run1",
+		BuildID:    "1.0",
+	}, nil, types.TaskSourceHistory, "", false)
+	ctx := context.Background()
+	s.False(s.matchingEngine.isStickyBuildMismatch(ctx, &sticky, task, "1.0"))
+	s.False(s.matchingEngine.isStickyBuildMismatch(ctx, &sticky, task, ""))
+	s.False(s.matchingEngine.isStickyBuildMismatch(ctx, &normal, task, "2.0"))
+
+	s.NoError(s.matchingEngine.UpdateWorkerBuildIDCompatibility(s.handlerContext, &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+		DomainUUID: "domainId",
+		UpdateRequest: &types.UpdateWorkerBuildIDCompatibilityRequest{
+			TaskList:                     &types.TaskList{Name: "makeToast"},
+			AddNewBuildIDInNewDefaultSet: "1.0",
+		},
+	}))
+	s.NoError(s.matchingEngine.UpdateWorkerBuildIDCompatibility(s.handlerContext, &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+		DomainUUID: "domainId",
+		UpdateRequest: &types.UpdateWorkerBuildIDCompatibilityRequest{
+			TaskList:                  &types.TaskList{Name: "makeToast"},
+			AddNewCompatibleBuildID:   "1.1",
+			ExistingCompatibleBuildID: "1.0",
+		},
+	}))
+	s.NoError(s.matchingEngine.UpdateWorkerBuildIDCompatibility(s.handlerContext, &types.MatchingUpdateWorkerBuildIDCompatibilityRequest{
+		DomainUUID: "domainId",
+		UpdateRequest: &types.UpdateWorkerBuildIDCompatibilityRequest{
+			TaskList:                     &types.TaskList{Name: "makeToast"},
+			AddNewBuildIDInNewDefaultSet: "2.0",
+		},
+	}))
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&types.GetMutableStateResponse{
+		TaskList:          &types.TaskList{Name: "makeToast"},
+		IsWorkflowRunning: true,
+	}, nil).Times(3)
+	// builds of the same version set are compatible, builds of other sets and unversioned builds are not
+	s.False(s.matchingEngine.isStickyBuildMismatch(ctx, &sticky, task, "1.1"))
+	s.True(s.matchingEngine.isStickyBuildMismatch(ctx, &sticky, task, "2.0"))
+	s.True(s.matchingEngine.isStickyBuildMismatch(ctx, &sticky, task, "3.0"))
+
+	// the task is redirected when the workflow cannot be read
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, &types.InternalServiceError{})
+	s.True(s.matchingEngine.isStickyBuildMismatch(ctx, &sticky, task, "1.1"))
+}
+
+func (s *matchingEngineSuite) TestRedirectStickyDecisionTask() {
+	mockMatchingClient := s.newMockMatchingClient()
+	s.matchingEngine.matchingClient = mockMatchingClient
+	task := newInternalTask(&persistence.TaskInfo{
+		DomainID:               "domainId",
+		WorkflowID:             "workflow1",
+		RunID:                  "run1",
+		ScheduleID:             5,
+		ScheduleToStartTimeout: 10,
+		BuildID:                "1.0",
+	}, nil, types.TaskSourceHistory, "", false)
+
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&types.GetMutableStateResponse{
+		TaskList:          &types.TaskList{Name: "makeToast"},
+		IsWorkflowRunning: true,
+		BuildID:           "1.0",
+	}, nil)
+	mockMatchingClient.EXPECT().AddDecisionTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.AddDecisionTaskRequest, _ ...interface{}) error {
+			s.Equal("makeToast", request.GetTaskList().GetName())
+			s.Equal(types.TaskListKindNormal, request.GetTaskList().GetKind())
+			s.Equal(int64(5), request.GetScheduleID())
+			s.Equal("1.0", request.GetBuildID())
+			return nil
+		})
+	s.NoError(s.matchingEngine.redirectStickyDecisionTask(context.Background(), task))
+
+	// tasks of closed workflows are dropped
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	s.NoError(s.matchingEngine.redirectStickyDecisionTask(context.Background(), task))
+}
+
 func (s *matchingEngineSuite) AddTasksTest(taskType int, isForwarded bool) {
 	s.matchingEngine.config.RangeSize = 300 // override to low number for the test
 
@@ -1398,7 +1958,9 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 
 	const taskCount = 1200
 	const rangeSize = 10
+	const readAheadSize = 999
 	s.matchingEngine.config.RangeSize = rangeSize
+	s.matchingEngine.config.BacklogReadAheadSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(readAheadSize)
 
 	// add taskCount tasks
 	for i := int64(0); i < taskCount; i++ {
@@ -1420,10 +1982,10 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 	s.True(ok, "taskListManger doesn't implement taskListManager interface")
 	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
 
-	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
-	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := common.MinInt(cap(tlMgr.taskReader.taskBuffer), taskCount)
-	s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) == expectedBufSize }, time.Second))
+	// wait until the task pump has read ahead and enqueued the tasks into the in-memory backlog,
+	// the read ahead stops once the backlog is full and the dispatcher holds the head task
+	s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.backlogLen() >= readAheadSize }, time.Second))
+	expectedBufSize := tlMgr.taskReader.backlogLen()
 
 	// stop all goroutines that read / write tasks in the background
 	// remainder of this test works with the in-memory buffer
@@ -1565,9 +2127,9 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 		s.True(ok, "failed to load task list")
 		s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
 
-		// wait until all tasks are loaded by into in-memory buffers by task list manager
-		// the buffer size should be one less than expected because dispatcher will dequeue the head
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (taskCount/2 - 1) }, time.Second))
+		// wait until all tasks are loaded by into in-memory backlog by task list manager
+		// the backlog size should be one less than expected because dispatcher will dequeue the head
+		s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.backlogLen() >= (taskCount/2 - 1) }, time.Second))
 
 		maxTimeBetweenTaskDeletes = tc.maxTimeBtwnDeletes
 		s.matchingEngine.config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(tc.batchSize)
@@ -1699,6 +2261,9 @@ type testTaskListManager struct {
 	ackLevel        int64
 	createTaskCount int
 	tasks           *treemap.Map
+	versionSets     []*types.WorkerVersionSet
+	paused          bool
+	migrateTo       string
 }
 
 func Int64Comparator(a, b interface{}) int {
@@ -1739,12 +2304,15 @@ func (m *testTaskManager) LeaseTaskList(
 
 	return &persistence.LeaseTaskListResponse{
 		TaskListInfo: &persistence.TaskListInfo{
-			AckLevel: tlm.ackLevel,
-			DomainID: request.DomainID,
-			Name:     request.TaskList,
-			TaskType: request.TaskType,
-			RangeID:  tlm.rangeID,
-			Kind:     request.TaskListKind,
+			AckLevel:    tlm.ackLevel,
+			DomainID:    request.DomainID,
+			Name:        request.TaskList,
+			TaskType:    request.TaskType,
+			RangeID:     tlm.rangeID,
+			Kind:        request.TaskListKind,
+			VersionSets: tlm.versionSets,
+			Paused:      tlm.paused,
+			MigrateTo:   tlm.migrateTo,
 		},
 	}, nil
 }
@@ -1767,6 +2335,9 @@ func (m *testTaskManager) UpdateTaskList(
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.versionSets = tli.VersionSets
+	tlm.paused = tli.Paused
+	tlm.migrateTo = tli.MigrateTo
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
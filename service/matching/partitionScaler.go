@@ -0,0 +1,419 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	partitionScalerAuthor = "matching partition scaler"
+
+	partitionScalerRequestTimeout = 5 * time.Second
+	// partitionScalerPendingTimeout is how long to wait for a decision to be visible in config,
+	// it may never be visible if the partitions are changed by an operator in the meantime
+	partitionScalerPendingTimeout = 10 * time.Minute
+)
+
+type (
+	// partitionScaler runs on every matching host and scales the partitions of the root
+	// task lists owned by the host. Decisions are written to the dynamic config store under
+	// the partition count keys, so that all matching hosts and clients agree on them.
+	partitionScaler struct {
+		status        int32
+		engine        *matchingEngineImpl
+		config        *Config
+		store         configstore.Store
+		timeSource    clock.TimeSource
+		logger        log.Logger
+		metricsClient metrics.Client
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+
+		// the following states are only accessed by the scaling loop
+		taskLists map[taskListID]*partitionScalerState
+	}
+
+	partitionScalerState struct {
+		lastCollection time.Time
+		// lowLoadSince is when the task list started to be under the downscale threshold
+		lowLoadSince time.Time
+		// pending is the last decision written to the store, no new decision is made until it is visible in config
+		pending      *partitionConfig
+		pendingSince time.Time
+	}
+
+	// partitionConfig is the number of read and write partitions of a task list, the
+	// partitions between the write and read partition counts are being drained
+	partitionConfig struct {
+		read  int
+		write int
+	}
+
+	// partitionLoad is the load of all partitions of a task list estimated from its root partition
+	partitionLoad struct {
+		rate    float64
+		backlog int64
+		pollers int
+	}
+
+	partitionScalingPolicy struct {
+		upscaleRPS      float64
+		upscaleBacklog  int
+		downscaleFactor float64
+		maxPartitions   int
+	}
+)
+
+func newPartitionScaler(
+	engine *matchingEngineImpl,
+	store configstore.Store,
+) *partitionScaler {
+	return &partitionScaler{
+		status:        common.DaemonStatusInitialized,
+		engine:        engine,
+		config:        engine.config,
+		store:         store,
+		timeSource:    clock.NewRealTimeSource(),
+		logger:        engine.logger.WithTags(tag.ComponentPartitionScaler),
+		metricsClient: engine.metricsClient,
+		shutdownCh:    make(chan struct{}),
+		taskLists:     make(map[taskListID]*partitionScalerState),
+	}
+}
+
+func (s *partitionScaler) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	s.shutdownWG.Add(1)
+	go s.scalingLoop()
+	s.logger.Info("Partition scaler state changed", tag.LifeCycleStarted)
+}
+
+func (s *partitionScaler) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(s.shutdownCh)
+	s.shutdownWG.Wait()
+	s.logger.Info("Partition scaler state changed", tag.LifeCycleStopped)
+}
+
+func (s *partitionScaler) scalingLoop() {
+	defer s.shutdownWG.Done()
+
+	timer := time.NewTimer(s.config.PartitionScalingInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-timer.C:
+			s.scaleAll()
+			timer.Reset(s.config.PartitionScalingInterval())
+		}
+	}
+}
+
+func (s *partitionScaler) scaleAll() {
+	now := s.timeSource.Now()
+	taskLists := s.getRootTaskLists()
+	for id := range s.taskLists {
+		if _, ok := taskLists[id]; !ok {
+			delete(s.taskLists, id)
+		}
+	}
+	for id, tlMgr := range taskLists {
+		id := id
+		if err := s.scale(&id, tlMgr, now); err != nil {
+			s.logger.Warn("Failed to scale task list partitions",
+				tag.WorkflowDomainID(id.domainID),
+				tag.WorkflowTaskListName(id.name),
+				tag.WorkflowTaskListType(id.taskType),
+				tag.Error(err),
+			)
+		}
+	}
+}
+
+func (s *partitionScaler) getRootTaskLists() map[taskListID]taskListManager {
+	s.engine.taskListsLock.RLock()
+	defer s.engine.taskListsLock.RUnlock()
+
+	taskLists := make(map[taskListID]taskListManager)
+	for id, tlMgr := range s.engine.taskLists {
+		if id.IsRoot() && tlMgr.GetTaskListKind() == types.TaskListKindNormal {
+			taskLists[id] = tlMgr
+		}
+	}
+	return taskLists
+}
+
+func (s *partitionScaler) scale(
+	id *taskListID,
+	tlMgr taskListManager,
+	now time.Time,
+) error {
+	domainName, err := s.engine.domainCache.GetDomainName(id.domainID)
+	if err != nil {
+		return err
+	}
+	if !s.config.EnablePartitionScaling(domainName, id.name, id.taskType) {
+		delete(s.taskLists, *id)
+		return nil
+	}
+
+	load := tlMgr.CollectLoad()
+	state, ok := s.taskLists[*id]
+	if !ok {
+		// the counters of the first collection cover an unknown period
+		s.taskLists[*id] = &partitionScalerState{lastCollection: now}
+		return nil
+	}
+	elapsed := now.Sub(state.lastCollection)
+	state.lastCollection = now
+	if elapsed <= 0 {
+		return nil
+	}
+
+	current := partitionConfig{
+		read:  common.MaxInt(1, s.config.NumTasklistReadPartitions(domainName, id.name, id.taskType)),
+		write: common.MaxInt(1, s.config.NumTasklistWritePartitions(domainName, id.name, id.taskType)),
+	}
+	scope := newPerTaskListScope(domainName, id.name, types.TaskListKindNormal, s.metricsClient, metrics.MatchingPartitionScalerScope).
+		Tagged(getTaskListTypeTag(id.taskType))
+	scope.UpdateGauge(metrics.ReadPartitionsPerTaskListGauge, float64(current.read))
+	scope.UpdateGauge(metrics.WritePartitionsPerTaskListGauge, float64(current.write))
+
+	if state.pending != nil {
+		if *state.pending != current && now.Sub(state.pendingSince) < partitionScalerPendingTimeout {
+			return nil
+		}
+		state.pending = nil
+	}
+
+	// only the root partition is observed, the load is assumed to be evenly spread by the client load balancer
+	partitionLoad := &partitionLoad{
+		rate: math.Max(
+			float64(load.addedTasks)*float64(current.write)/elapsed.Seconds(),
+			float64(load.dispatchedTasks)*float64(current.read)/elapsed.Seconds(),
+		),
+		backlog: load.backlog * int64(current.write),
+		pollers: load.pollers,
+	}
+	policy := &partitionScalingPolicy{
+		upscaleRPS:      s.config.PartitionUpscaleRPS(domainName, id.name, id.taskType),
+		upscaleBacklog:  s.config.PartitionUpscaleBacklog(domainName, id.name, id.taskType),
+		downscaleFactor: s.config.PartitionDownscaleFactor(domainName, id.name, id.taskType),
+		maxPartitions:   common.MaxInt(1, s.config.MaxPartitions(domainName, id.name, id.taskType)),
+	}
+
+	if isLowLoad(current, partitionLoad, policy) {
+		if state.lowLoadSince.IsZero() {
+			state.lowLoadSince = now
+		}
+	} else {
+		state.lowLoadSince = time.Time{}
+	}
+	sustainedLowLoad := !state.lowLoadSince.IsZero() &&
+		now.Sub(state.lowLoadSince) >= s.config.PartitionDownscaleSustainedDuration(domainName, id.name, id.taskType)
+
+	drained := false
+	if current.read > current.write {
+		if drained, err = s.isDrained(id, domainName, current); err != nil {
+			return err
+		}
+	}
+
+	desired := computePartitions(current, partitionLoad, policy, sustainedLowLoad, drained)
+	if desired == current {
+		return nil
+	}
+
+	reason := fmt.Sprintf("rate: %.2f, backlog: %v, pollers: %v", partitionLoad.rate, partitionLoad.backlog, partitionLoad.pollers)
+	// read partitions are added before write partitions and removed after them,
+	// so that no task is written to a partition which is not polled
+	if desired.read > current.read {
+		if err := s.updatePartitions(dynamicconfig.MatchingNumTasklistReadPartitions, id, domainName, desired.read, reason); err != nil {
+			scope.IncCounter(metrics.PartitionScalingFailuresPerTaskListCounter)
+			return err
+		}
+	}
+	if desired.write != current.write {
+		if err := s.updatePartitions(dynamicconfig.MatchingNumTasklistWritePartitions, id, domainName, desired.write, reason); err != nil {
+			scope.IncCounter(metrics.PartitionScalingFailuresPerTaskListCounter)
+			return err
+		}
+	}
+	if desired.read < current.read {
+		if err := s.updatePartitions(dynamicconfig.MatchingNumTasklistReadPartitions, id, domainName, desired.read, reason); err != nil {
+			scope.IncCounter(metrics.PartitionScalingFailuresPerTaskListCounter)
+			return err
+		}
+	}
+
+	if desired.write > current.write {
+		scope.IncCounter(metrics.PartitionUpscalePerTaskListCounter)
+	} else if desired.write < current.write {
+		scope.IncCounter(metrics.PartitionDownscalePerTaskListCounter)
+		state.lowLoadSince = time.Time{}
+	}
+	state.pending = &desired
+	state.pendingSince = now
+	s.logger.Info("Task list partitions scaled",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowTaskListName(id.name),
+		tag.WorkflowTaskListType(id.taskType),
+		tag.NumReadPartitions(desired.read),
+		tag.NumWritePartitions(desired.write),
+		tag.Reason(reason),
+	)
+	return nil
+}
+
+// isDrained returns true if the partitions which are no longer written to have no backlog
+func (s *partitionScaler) isDrained(
+	id *taskListID,
+	domainName string,
+	current partitionConfig,
+) (bool, error) {
+	taskListType := types.TaskListTypeDecision
+	if id.taskType == persistence.TaskListTypeActivity {
+		taskListType = types.TaskListTypeActivity
+	}
+	for partition := current.write; partition < current.read; partition++ {
+		ctx, cancel := context.WithTimeout(context.Background(), partitionScalerRequestTimeout)
+		resp, err := s.engine.matchingClient.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+			DomainUUID: id.domainID,
+			DescRequest: &types.DescribeTaskListRequest{
+				Domain: domainName,
+				TaskList: &types.TaskList{
+					Name: id.mkName(partition),
+					Kind: types.TaskListKindNormal.Ptr(),
+				},
+				TaskListType:          taskListType.Ptr(),
+				IncludeTaskListStatus: true,
+			},
+		})
+		cancel()
+		if err != nil {
+			return false, err
+		}
+		if resp.GetTaskListStatus().GetBacklogCountHint() > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *partitionScaler) updatePartitions(
+	key dynamicconfig.Key,
+	id *taskListID,
+	domainName string,
+	partitions int,
+	reason string,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), partitionScalerRequestTimeout)
+	defer cancel()
+	return s.store.Update(ctx, &configstore.Change{
+		Key: key.String(),
+		Constraints: map[string]interface{}{
+			dynamicconfig.DomainName.String():   domainName,
+			dynamicconfig.TaskListName.String(): id.name,
+			dynamicconfig.TaskType.String():     id.taskType,
+		},
+		Value:  partitions,
+		Author: partitionScalerAuthor,
+		Reason: reason,
+	})
+}
+
+// isLowLoad returns true if the load would still be under the upscale thresholds,
+// with a safety margin, after removing one partition
+func isLowLoad(
+	current partitionConfig,
+	load *partitionLoad,
+	policy *partitionScalingPolicy,
+) bool {
+	n := current.write - 1
+	if n < 1 {
+		return false
+	}
+	return load.rate < float64(n)*policy.upscaleRPS*policy.downscaleFactor &&
+		float64(load.backlog) < float64(n*policy.upscaleBacklog)*policy.downscaleFactor
+}
+
+// computePartitions returns the desired partitions of a task list. Partitions are added to
+// reads and writes at once, but removed from writes one at a time and removed from reads
+// only after they are drained.
+func computePartitions(
+	current partitionConfig,
+	load *partitionLoad,
+	policy *partitionScalingPolicy,
+	sustainedLowLoad bool,
+	drained bool,
+) partitionConfig {
+	if current.read > current.write {
+		if drained {
+			return partitionConfig{read: current.write, write: current.write}
+		}
+		return current
+	}
+
+	n := current.write
+	if n > policy.maxPartitions {
+		return partitionConfig{read: n, write: n - 1}
+	}
+
+	desired := n
+	if policy.upscaleRPS > 0 {
+		desired = int(math.Ceil(load.rate / policy.upscaleRPS))
+	}
+	if load.backlog > int64(n*policy.upscaleBacklog) {
+		desired = common.MaxInt(desired, n+1)
+	}
+	// partitions without pollers only make progress by forwarding tasks to their parents
+	desired = common.MinInt(desired, common.MinInt(policy.maxPartitions, load.pollers))
+	if desired > n {
+		return partitionConfig{read: desired, write: desired}
+	}
+
+	if n > 1 && sustainedLowLoad {
+		return partitionConfig{read: n, write: n - 1}
+	}
+	return current
+}
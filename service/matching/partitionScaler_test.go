@@ -0,0 +1,83 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	partitionScalerSuite struct {
+		suite.Suite
+
+		policy *partitionScalingPolicy
+	}
+)
+
+func TestPartitionScalerSuite(t *testing.T) {
+	s := new(partitionScalerSuite)
+	suite.Run(t, s)
+}
+
+func (s *partitionScalerSuite) SetupTest() {
+	s.policy = &partitionScalingPolicy{
+		upscaleRPS:      100,
+		upscaleBacklog:  1000,
+		downscaleFactor: 0.75,
+		maxPartitions:   8,
+	}
+}
+
+func (s *partitionScalerSuite) TestComputePartitions_Upscale() {
+	current := partitionConfig{read: 1, write: 1}
+	s.Equal(current, computePartitions(current, &partitionLoad{rate: 90, pollers: 10}, s.policy, false, false))
+	s.Equal(partitionConfig{read: 3, write: 3}, computePartitions(current, &partitionLoad{rate: 250, pollers: 10}, s.policy, false, false))
+	s.Equal(partitionConfig{read: 2, write: 2}, computePartitions(current, &partitionLoad{rate: 10, backlog: 2000, pollers: 10}, s.policy, false, false))
+	// capped by the max partitions and the pollers
+	s.Equal(partitionConfig{read: 8, write: 8}, computePartitions(current, &partitionLoad{rate: 5000, pollers: 10}, s.policy, false, false))
+	s.Equal(partitionConfig{read: 4, write: 4}, computePartitions(current, &partitionLoad{rate: 5000, pollers: 4}, s.policy, false, false))
+	s.Equal(current, computePartitions(current, &partitionLoad{rate: 5000}, s.policy, false, false))
+}
+
+func (s *partitionScalerSuite) TestComputePartitions_Downscale() {
+	current := partitionConfig{read: 4, write: 4}
+	s.Equal(current, computePartitions(current, &partitionLoad{rate: 10, pollers: 10}, s.policy, false, false))
+	s.Equal(partitionConfig{read: 4, write: 3}, computePartitions(current, &partitionLoad{rate: 10, pollers: 10}, s.policy, true, false))
+	s.Equal(partitionConfig{read: 1, write: 1}, computePartitions(partitionConfig{read: 1, write: 1}, &partitionLoad{pollers: 10}, s.policy, true, false))
+	// partitions above the max are removed without waiting for low load
+	s.Equal(partitionConfig{read: 10, write: 9}, computePartitions(partitionConfig{read: 10, write: 10}, &partitionLoad{rate: 5000, pollers: 10}, s.policy, false, false))
+}
+
+func (s *partitionScalerSuite) TestComputePartitions_Drain() {
+	current := partitionConfig{read: 4, write: 3}
+	// nothing changes until the removed partition is drained, even under high load
+	s.Equal(current, computePartitions(current, &partitionLoad{rate: 5000, pollers: 10}, s.policy, true, false))
+	s.Equal(partitionConfig{read: 3, write: 3}, computePartitions(current, &partitionLoad{pollers: 10}, s.policy, true, true))
+}
+
+func (s *partitionScalerSuite) TestIsLowLoad() {
+	s.False(isLowLoad(partitionConfig{read: 1, write: 1}, &partitionLoad{}, s.policy))
+	s.True(isLowLoad(partitionConfig{read: 4, write: 4}, &partitionLoad{rate: 200, backlog: 100}, s.policy))
+	s.False(isLowLoad(partitionConfig{read: 4, write: 4}, &partitionLoad{rate: 250, backlog: 100}, s.policy))
+	s.False(isLowLoad(partitionConfig{read: 4, write: 4}, &partitionLoad{rate: 200, backlog: 2500}, s.policy))
+}
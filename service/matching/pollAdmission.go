@@ -0,0 +1,117 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+// pollShedBackoffJitter spreads the retries of the pollers rejected at the same time
+const pollShedBackoffJitter = 0.2
+
+type (
+	// pollAdmission caps the number of polls waiting on a host and on each of its task lists. Polls are
+	// rejected before they are rate limited, so that AddTask requests keep being served when a host is
+	// overwhelmed by pollers.
+	pollAdmission struct {
+		sync.Mutex
+		config        *Config
+		metricsClient metrics.Client
+		hostPolls     int
+		taskListPolls map[pollAdmissionKey]int
+	}
+
+	pollAdmissionKey struct {
+		domainID string
+		taskList string
+		taskType int
+	}
+)
+
+func newPollAdmission(config *Config, metricsClient metrics.Client) *pollAdmission {
+	return &pollAdmission{
+		config:        config,
+		metricsClient: metricsClient,
+		taskListPolls: make(map[pollAdmissionKey]int),
+	}
+}
+
+// admit reserves a slot for a poll, the returned function must be called once the poll returns.
+// A ServiceBusyError with a backoff hint is returned when the poll is shed.
+func (a *pollAdmission) admit(hCtx *handlerContext, domainID string, domainName string, taskList string, taskType int, scope int) (func(), error) {
+	key := pollAdmissionKey{domainID: domainID, taskList: taskList, taskType: taskType}
+	root := taskList
+	if name, err := newTaskListName(taskList); err == nil {
+		root = name.GetRoot()
+	}
+	maxTaskListPolls := a.config.MaxOutstandingPollsPerTaskList(domainName, root, taskType)
+	maxHostPolls := a.config.MaxOutstandingPollsPerHost()
+
+	a.Lock()
+	if maxTaskListPolls > 0 && a.taskListPolls[key] >= maxTaskListPolls {
+		a.Unlock()
+		hCtx.scope.IncCounter(metrics.PollShedTaskListLimitPerTaskListCounter)
+		return nil, a.shedError("Too many outstanding polls on task list.")
+	}
+	if maxHostPolls > 0 && a.hostPolls >= maxHostPolls {
+		a.Unlock()
+		hCtx.scope.IncCounter(metrics.PollShedHostLimitPerTaskListCounter)
+		return nil, a.shedError("Too many outstanding polls on matching host.")
+	}
+	a.taskListPolls[key]++
+	a.hostPolls++
+	hostPolls := a.hostPolls
+	a.Unlock()
+	a.metricsClient.UpdateGauge(scope, metrics.OutstandingPollsGauge, float64(hostPolls))
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { a.release(key) })
+	}, nil
+}
+
+func (a *pollAdmission) release(key pollAdmissionKey) {
+	a.Lock()
+	defer a.Unlock()
+	a.hostPolls--
+	if a.taskListPolls[key] <= 1 {
+		delete(a.taskListPolls, key)
+		return
+	}
+	a.taskListPolls[key]--
+}
+
+func (a *pollAdmission) shedError(reason string) error {
+	retryAfter := a.config.PollShedBackoff()
+	if retryAfter > 0 {
+		retryAfter = backoff.JitDuration(retryAfter, pollShedBackoffJitter)
+	}
+	return &types.ServiceBusyError{
+		Message:                fmt.Sprintf("%v Retry after %v.", reason, retryAfter.Round(time.Millisecond)),
+		RetryAfterMilliseconds: int64(retryAfter / time.Millisecond),
+	}
+}
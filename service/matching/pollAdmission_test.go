@@ -0,0 +1,105 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestPollAdmission(t *testing.T) {
+	config := defaultTestConfig()
+	config.MaxOutstandingPollsPerTaskList = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	config.MaxOutstandingPollsPerHost = dynamicconfig.GetIntPropertyFn(3)
+	config.PollShedBackoff = dynamicconfig.GetDurationPropertyFn(time.Second)
+	scope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(scope, metrics.Matching)
+	admission := newPollAdmission(config, metricsClient)
+	hCtx := newHandlerContext(context.Background(), "domain", &types.TaskList{Name: "tl"}, metricsClient, metrics.MatchingPollForActivityTaskScope, loggerimpl.NewNopLogger())
+
+	admit := func(taskList string) (func(), error) {
+		return admission.admit(hCtx, "domainID", "domain", taskList, persistence.TaskListTypeActivity, metrics.MatchingPollForActivityTaskScope)
+	}
+
+	release1, err := admit("tl")
+	require.NoError(t, err)
+	release2, err := admit("tl")
+	require.NoError(t, err)
+	// the partitions of a task list are limited separately
+	release3, err := admit("/__cadence_sys/tl/1")
+	require.NoError(t, err)
+
+	_, err = admit("tl")
+	require.IsType(t, &types.ServiceBusyError{}, err)
+	busy := err.(*types.ServiceBusyError)
+	assert.InDelta(t, 1000, busy.RetryAfterMilliseconds, 200)
+	assert.Contains(t, busy.Message, "task list")
+
+	_, err = admit("other")
+	require.IsType(t, &types.ServiceBusyError{}, err)
+	assert.Contains(t, err.(*types.ServiceBusyError).Message, "host")
+
+	release1()
+	release1() // releasing twice has no effect
+	_, err = admit("other")
+	assert.NoError(t, err)
+	_, err = admit("tl")
+	assert.IsType(t, &types.ServiceBusyError{}, err)
+
+	release2()
+	release3()
+	assert.Equal(t, 1, admission.hostPolls)
+	assert.Len(t, admission.taskListPolls, 1)
+
+	counters := scope.Snapshot().Counters()
+	var taskListSheds, hostSheds int64
+	for _, counter := range counters {
+		switch counter.Name() {
+		case "test.poll_shed_tasklist_limit_per_tl":
+			taskListSheds += counter.Value()
+		case "test.poll_shed_host_limit_per_tl":
+			hostSheds += counter.Value()
+		}
+	}
+	assert.Equal(t, int64(1), taskListSheds)
+	assert.Equal(t, int64(2), hostSheds)
+}
+
+func TestPollAdmission_NoLimits(t *testing.T) {
+	admission := newPollAdmission(defaultTestConfig(), metrics.NewNoopMetricsClient())
+	hCtx := newHandlerContext(context.Background(), "domain", &types.TaskList{Name: "tl"}, metrics.NewNoopMetricsClient(), metrics.MatchingPollForDecisionTaskScope, loggerimpl.NewNopLogger())
+	for i := 0; i < 100; i++ {
+		_, err := admission.admit(hCtx, "domainID", "domain", "tl", persistence.TaskListTypeDecision, metrics.MatchingPollForDecisionTaskScope)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 100, admission.hostPolls)
+}
@@ -38,7 +38,9 @@ type (
 	pollerIdentity string
 
 	pollerInfo struct {
-		ratePerSecond float64
+		ratePerSecond  float64
+		buildID        string
+		isolationGroup string
 	}
 )
 
@@ -68,12 +70,12 @@ func newPollerHistory(historyUpdatedFunc HistoryUpdatedFunc) *pollerHistory {
 	}
 }
 
-func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64) {
+func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64, buildID string, isolationGroup string) {
 	rps := _defaultTaskDispatchRPS
 	if ratePerSecond != nil {
 		rps = *ratePerSecond
 	}
-	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps})
+	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps, buildID: buildID, isolationGroup: isolationGroup})
 	if pollers.onHistoryUpdatedFunc != nil {
 		pollers.onHistoryUpdatedFunc()
 	}
@@ -94,6 +96,8 @@ func (pollers *pollerHistory) getAllPollerInfo() []*types.PollerInfo {
 			Identity:       string(key),
 			LastAccessTime: common.Int64Ptr(lastAccessTime.UnixNano()),
 			RatePerSecond:  value.ratePerSecond,
+			BuildID:        value.buildID,
+			IsolationGroup: value.isolationGroup,
 		})
 	}
 
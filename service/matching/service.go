@@ -26,6 +26,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/resource/config"
 	"github.com/uber/cadence/common/service"
@@ -35,10 +36,11 @@ import (
 type Service struct {
 	resource.Resource
 
-	status  int32
-	handler Handler
-	stopC   chan struct{}
-	config  *Config
+	status             int32
+	handler            Handler
+	stopC              chan struct{}
+	config             *Config
+	dynamicConfigStore configstore.Store
 }
 
 // NewService builds a new cadence-matching service
@@ -69,10 +71,11 @@ func NewService(
 	}
 
 	return &Service{
-		Resource: serviceResource,
-		status:   common.DaemonStatusInitialized,
-		config:   serviceConfig,
-		stopC:    make(chan struct{}),
+		Resource:           serviceResource,
+		status:             common.DaemonStatusInitialized,
+		config:             serviceConfig,
+		stopC:              make(chan struct{}),
+		dynamicConfigStore: params.DynamicConfigStore,
 	}, nil
 }
 
@@ -85,7 +88,7 @@ func (s *Service) Start() {
 	logger := s.GetLogger()
 	logger.Info("matching starting")
 
-	s.handler = NewHandler(s, s.config)
+	s.handler = NewHandler(s, s.config, s.dynamicConfigStore)
 
 	thriftHandler := NewThriftHandler(s.handler)
 	thriftHandler.register(s.GetDispatcher())
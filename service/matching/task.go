@@ -101,6 +101,23 @@ func (task *InternalTask) isQuery() bool {
 	return task.query != nil
 }
 
+// priority returns the dispatch priority of the task, tasks with a higher priority are dispatched first
+func (task *InternalTask) priority() int32 {
+	if task.event != nil {
+		return task.event.Priority
+	}
+	return 0
+}
+
+// isolationGroup returns the isolation group of the workflow of the task, the task is dispatched to
+// the pollers of the group first
+func (task *InternalTask) isolationGroup() string {
+	if task.event != nil {
+		return task.event.IsolationGroup
+	}
+	return ""
+}
+
 // isStarted is true when this task is already marked as started
 func (task *InternalTask) isStarted() bool {
 	return task.started != nil
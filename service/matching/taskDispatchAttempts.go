@@ -0,0 +1,118 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// taskDispatchAttemptsTTL is how long the dispatch attempts of a task are remembered after its last attempt was added
+const taskDispatchAttemptsTTL = 24 * time.Hour
+
+type (
+	// taskDispatchAttempts counts how many attempts of each task were dispatched to pollers without making progress.
+	// History adds an activity or decision task again with the next attempt when the previous attempt timed out,
+	// so a task which keeps crashing its workers is counted once per attempt. The count carries over to the next
+	// attempt unless the activity heartbeated after the previous attempt was started. A completed activity is not
+	// added again and the decision after a completed one has a new schedule ID, so their counts simply expire.
+	// The counts are kept by the engine for all task lists of the host, so they survive a task list partition
+	// being unloaded and loaded again.
+	taskDispatchAttempts struct {
+		attempts cache.Cache
+	}
+
+	taskAttemptKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+		scheduleID int64
+		attempt    int64
+	}
+
+	taskAttemptState struct {
+		sync.Mutex
+		// count is the number of attempts dispatched without progress, including this one once it was dispatched
+		count            int
+		dispatched       bool
+		startedTimestamp int64
+	}
+)
+
+func newTaskDispatchAttempts(maxSize int) *taskDispatchAttempts {
+	return &taskDispatchAttempts{
+		attempts: cache.New(&cache.Options{
+			MaxCount: maxSize,
+			TTL:      taskDispatchAttemptsTTL,
+		}),
+	}
+}
+
+// added returns how many attempts before the attempt of the task were dispatched without making progress.
+// lastHeartbeatTimestamp is the last heartbeat of an activity, history sets it to the start of an attempt
+// so a later heartbeat means the previous attempt made progress.
+func (a *taskDispatchAttempts) added(info *persistence.TaskInfo, lastHeartbeatTimestamp int64) int {
+	count := 0
+	if previous, ok := a.attempts.Get(newTaskAttemptKey(info, info.Attempt-1)).(*taskAttemptState); ok {
+		previous.Lock()
+		if !previous.dispatched || lastHeartbeatTimestamp <= previous.startedTimestamp {
+			count = previous.count
+		}
+		previous.Unlock()
+	}
+	a.attempts.PutIfNotExist(newTaskAttemptKey(info, info.Attempt), &taskAttemptState{count: count}) //nolint:errcheck
+	return count
+}
+
+// dispatched counts the dispatch of an attempt of the task to a poller, an attempt dispatched again is only counted once
+func (a *taskDispatchAttempts) dispatched(info *persistence.TaskInfo, attempt int64, startedTimestamp int64) {
+	value, err := a.attempts.PutIfNotExist(newTaskAttemptKey(info, attempt), &taskAttemptState{})
+	if err != nil {
+		return
+	}
+	state := value.(*taskAttemptState)
+	state.Lock()
+	defer state.Unlock()
+	if !state.dispatched {
+		state.dispatched = true
+		state.count++
+		state.startedTimestamp = startedTimestamp
+	}
+}
+
+// reset forgets the dispatch attempts of the task, so that it is counted from scratch when redriven
+func (a *taskDispatchAttempts) reset(info *persistence.TaskInfo) {
+	a.attempts.Delete(newTaskAttemptKey(info, info.Attempt-1))
+	a.attempts.Delete(newTaskAttemptKey(info, info.Attempt))
+}
+
+func newTaskAttemptKey(info *persistence.TaskInfo, attempt int64) taskAttemptKey {
+	return taskAttemptKey{
+		domainID:   info.DomainID,
+		workflowID: info.WorkflowID,
+		runID:      info.RunID,
+		scheduleID: info.ScheduleID,
+		attempt:    attempt,
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -36,6 +37,8 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/tasklistdlq"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -56,6 +59,16 @@ type (
 		taskInfo      *persistence.TaskInfo
 		source        types.TaskSource
 		forwardedFrom string
+		// lastHeartbeatTimestamp is the last heartbeat of an activity task, it resets the dispatch attempts of the task
+		lastHeartbeatTimestamp int64
+	}
+
+	// taskListLoad is the load observed by a task list manager since the previous collection
+	taskListLoad struct {
+		addedTasks      int64
+		dispatchedTasks int64
+		backlog         int64
+		pollers         int
 	}
 
 	taskListManager interface {
@@ -79,6 +92,16 @@ type (
 		GetAllPollerInfo() []*types.PollerInfo
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse
+		// CollectLoad returns the load observed since the previous call and resets the counters
+		CollectLoad() *taskListLoad
+		// GetVersionSets returns the worker build ID version sets stored with the task list
+		GetVersionSets() []*types.WorkerVersionSet
+		// UpdateVersionSets applies the update to the worker build ID version sets and persists the result
+		UpdateVersionSets(update func([]*types.WorkerVersionSet) ([]*types.WorkerVersionSet, error)) error
+		// GetTaskListState returns the pause and migration state stored with the task list
+		GetTaskListState() *types.TaskListState
+		// UpdateTaskListState persists the given pause and migration state, nil values are left unchanged
+		UpdateTaskListState(paused *bool, migrateTo *string) error
 		String() string
 		GetTaskListKind() types.TaskListKind
 	}
@@ -109,6 +132,13 @@ type (
 		// prevent tasks being dispatched to zombie pollers.
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
+		// addedTasks and dispatchedTasks count tasks since the last load collection, used by the partition scaler
+		addedTasks      int64
+		dispatchedTasks int64
+		// stats tracks the rates and backlog age reported by DescribeTaskList
+		stats *taskListStats
+		// ingestionLimiter limits the rate at which tasks are added to this task list partition
+		ingestionLimiter *quotas.DynamicRateLimiter
 
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
@@ -158,6 +188,8 @@ func newTaskListManager(
 		taskGC:              newTaskGC(db, taskListConfig),
 		config:              taskListConfig,
 		outstandingPollsMap: make(map[string]context.CancelFunc),
+		stats:               newTaskListStats(time.Now()),
+		ingestionLimiter:    quotas.NewDynamicRateLimiter(taskListConfig.TaskListIngestionRPS),
 	}
 
 	tlMgr.domainNameValue.Store("")
@@ -182,9 +214,9 @@ func newTaskListManager(
 	tlMgr.taskReader = newTaskReader(tlMgr)
 	var fwdr *Forwarder
 	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
-		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient)
+		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient, tlMgr.metricScope)
 	}
-	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.metricScope)
+	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.metricScope, tlMgr.domainDispatchLimiter, tlMgr.recordDispatchThrottle)
 	tlMgr.startWG.Add(1)
 	return tlMgr, nil
 }
@@ -222,9 +254,38 @@ func (c *taskListManagerImpl) Stop() {
 
 // AddTask adds a task to the task list. This method will first attempt a synchronous
 // match with a poller. When there are no pollers or if rate limit is exceeded, task will
-// be written to database and later asynchronously matched with a poller
+// be written to database and later asynchronously matched with a poller.
+// Tasks exceeding the ingestion quota of the task list or its domain are rejected,
+// the quotas do not apply to tasks forwarded from child partitions
 func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams) (bool, error) {
 	c.startWG.Wait()
+	if migrateTo := c.migrationTarget(ctx); migrateTo != "" && params.forwardedFrom == "" {
+		err := c.migrateTask(ctx, params.taskInfo, params.source, migrateTo)
+		if err != nil {
+			c.logger.Error("Failed to migrate task",
+				tag.Error(err),
+				tag.WorkflowTaskListName(c.taskListID.name),
+				tag.WorkflowTaskListType(c.taskListID.taskType),
+			)
+		}
+		return false, err
+	}
+
+	if params.forwardedFrom == "" {
+		attempts := c.engine.dispatchAttempts.added(params.taskInfo, params.lastHeartbeatTimestamp)
+		if c.exceedsDispatchAttempts(attempts) {
+			// the task was added again after the previous attempts timed out without progress,
+			// it most likely crashes the workers that pick it up
+			return false, c.moveToDLQ(ctx, params.taskInfo, attempts)
+		}
+	}
+
+	if params.forwardedFrom == "" {
+		if err := c.checkIngestionQuotas(); err != nil {
+			return false, err
+		}
+	}
+
 	var syncMatch bool
 	_, err := c.executeWithRetry(func() (interface{}, error) {
 		if err := ctx.Err(); err != nil {
@@ -242,9 +303,12 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return r, err
 		}
 
-		syncMatch, err = c.trySyncMatch(ctx, params)
-		if syncMatch {
-			return &persistence.CreateTasksResponse{}, err
+		// tasks of a paused task list are not dispatched, they stay in the backlog until it is resumed
+		if !c.paused(ctx) {
+			syncMatch, err = c.trySyncMatch(ctx, params)
+			if syncMatch {
+				return &persistence.CreateTasksResponse{}, err
+			}
 		}
 
 		if params.forwardedFrom != "" {
@@ -256,6 +320,10 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 		return c.taskWriter.appendTask(params.execution, params.taskInfo)
 	})
 
+	if err == nil && params.forwardedFrom == "" {
+		atomic.AddInt64(&c.addedTasks, 1)
+		c.stats.recordAdd(time.Now(), syncMatch)
+	}
 	if err != nil {
 		c.logger.Error("Failed to add task",
 			tag.Error(err),
@@ -302,6 +370,10 @@ func (c *taskListManagerImpl) GetTask(
 	}
 	task.domainName = c.domainName()
 	task.backlogCountHint = c.taskAckManager.GetBacklogCount()
+	if !task.isQuery() {
+		atomic.AddInt64(&c.dispatchedTasks, 1)
+		c.stats.recordDispatch(time.Now())
+	}
 	return task, nil
 }
 
@@ -327,9 +399,11 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		}()
 	}
 
+	isolationGroup, _ := ctx.Value(isolationGroupKey).(string)
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
-		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+		buildID, _ := ctx.Value(buildIDKey).(string)
+		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond, buildID, isolationGroup)
 	}
 
 	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
@@ -348,9 +422,146 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		return c.matcher.PollForQuery(childCtx)
 	}
 
+	if c.paused(childCtx) {
+		// queries are still answered by the pollers of a paused task list
+		return c.matcher.PollForQuery(childCtx)
+	}
+
+	if c.matcher.isolation.isPollerDrained(isolationGroup) {
+		// the pollers of a drained isolation group wait without getting any task
+		c.metricScope().IncCounter(metrics.DrainedPollsPerTaskListCounter)
+		<-childCtx.Done()
+		return nil, ErrNoTasks
+	}
+
 	return c.matcher.Poll(childCtx)
 }
 
+// paused returns true if the dispatch of the tasks of this task list is paused
+func (c *taskListManagerImpl) paused(ctx context.Context) bool {
+	return c.state(ctx).Paused
+}
+
+// migrationTarget returns the name of the task list the tasks of this task list are migrated to,
+// or the empty string when the task list is not being migrated
+func (c *taskListManagerImpl) migrationTarget(ctx context.Context) string {
+	migrateTo := c.state(ctx).MigrateTo
+	if migrateTo == c.taskListID.GetRoot() {
+		return ""
+	}
+	return migrateTo
+}
+
+// state returns the pause and migration state of the task list, which applies to all its partitions and
+// is stored with the root partition
+func (c *taskListManagerImpl) state(ctx context.Context) *types.TaskListState {
+	if c.taskListKind == types.TaskListKindSticky {
+		return &types.TaskListState{}
+	}
+	if c.taskListID.IsRoot() {
+		return c.db.TaskListState()
+	}
+	state, err := c.engine.getTaskListState(ctx, c.taskListID)
+	if err != nil {
+		c.logger.Warn("Failed to read the task list state from the root partition", tag.Error(err))
+		return &types.TaskListState{}
+	}
+	return state
+}
+
+// migrateTask adds the task to the given task list through the matching client, the destination
+// task list may be owned by another host
+func (c *taskListManagerImpl) migrateTask(
+	ctx context.Context,
+	task *persistence.TaskInfo,
+	source types.TaskSource,
+	taskListName string,
+) error {
+	scheduleToStartTimeout := task.ScheduleToStartTimeout
+	if !task.Expiry.IsZero() && task.Expiry.Unix() > 0 {
+		// backlog tasks keep the expiry they were created with
+		remaining := int32(math.Ceil(time.Until(task.Expiry).Seconds()))
+		if remaining <= 0 {
+			return nil
+		}
+		scheduleToStartTimeout = remaining
+	}
+
+	execution := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
+	taskList := &types.TaskList{Name: taskListName, Kind: types.TaskListKindNormal.Ptr()}
+	switch c.taskListID.taskType {
+	case persistence.TaskListTypeDecision:
+		return c.engine.matchingClient.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+			DomainUUID:                    task.DomainID,
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleID:                    task.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &source,
+			Priority:                      task.Priority,
+			FairnessKey:                   task.FairnessKey,
+			BuildID:                       task.BuildID,
+			Attempt:                       task.Attempt,
+		})
+	case persistence.TaskListTypeActivity:
+		return c.engine.matchingClient.AddActivityTask(ctx, &types.AddActivityTaskRequest{
+			DomainUUID:                    c.taskListID.domainID,
+			SourceDomainUUID:              task.DomainID,
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleID:                    task.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &source,
+			Priority:                      task.Priority,
+			FairnessKey:                   task.FairnessKey,
+			BuildID:                       task.BuildID,
+			Attempt:                       task.Attempt,
+		})
+	default:
+		return errInvalidTaskListType
+	}
+}
+
+// exceedsDispatchAttempts returns true when as many attempts of a task were dispatched to pollers
+// without making progress as the task list allows
+func (c *taskListManagerImpl) exceedsDispatchAttempts(attempts int) bool {
+	if c.taskListKind == types.TaskListKindSticky {
+		return false
+	}
+	maxAttempts := c.config.MaxTaskDispatchAttempts()
+	return maxAttempts > 0 && attempts >= maxAttempts
+}
+
+// moveToDLQ writes the task to the task list DLQ, where it waits for an operator to redrive or fail it
+func (c *taskListManagerImpl) moveToDLQ(ctx context.Context, task *persistence.TaskInfo, attempts int) error {
+	err := c.engine.taskListDLQ.Enqueue(ctx, &tasklistdlq.Message{
+		DomainID:     c.taskListID.domainID,
+		TaskList:     c.taskListID.GetRoot(),
+		TaskType:     c.taskListID.taskType,
+		Task:         task,
+		Attempts:     attempts,
+		EnqueuedTime: time.Now(),
+	})
+	if err != nil {
+		c.logger.Error("Failed to move task to DLQ",
+			tag.Error(err),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.WorkflowScheduleID(task.ScheduleID),
+		)
+		return err
+	}
+	c.engine.dispatchAttempts.reset(task)
+	c.metricScope().IncCounter(metrics.TaskMovedToDLQPerTaskListCounter)
+	c.logger.Warn("Moved task to DLQ after too many dispatch attempts",
+		tag.WorkflowID(task.WorkflowID),
+		tag.WorkflowRunID(task.RunID),
+		tag.WorkflowScheduleID(task.ScheduleID),
+		tag.Attempt(int32(attempts)),
+	)
+	return nil
+}
+
 // GetAllPollerInfo returns all pollers that polled from this tasklist in last few minutes
 func (c *taskListManagerImpl) GetAllPollerInfo() []*types.PollerInfo {
 	return c.pollerHistory.getAllPollerInfo()
@@ -368,15 +579,23 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 }
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
-// pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// pollers which polled this tasklist in last few minutes, status of tasklist's ackManager
+// (readLevel, ackLevel, backlogCountHint and taskIDBlock) and the add and dispatch rates.
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse {
-	response := &types.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
+	response := &types.DescribeTaskListResponse{
+		Pollers: c.GetAllPollerInfo(),
+		PartitionConfig: &types.TaskListPartitionConfig{
+			NumReadPartitions:  int32(c.config.NumReadPartitions()),
+			NumWritePartitions: int32(c.config.NumWritePartitions()),
+			ScalingEnabled:     c.config.EnablePartitionScaling(),
+		},
+	}
 	if !includeTaskListStatus {
 		return response
 	}
 
 	taskIDBlock := c.rangeIDToTaskIDBlock(c.db.RangeID())
+	stats := c.stats.snapshot(time.Now())
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
@@ -386,11 +605,59 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			StartID: taskIDBlock.start,
 			EndID:   taskIDBlock.end,
 		},
+		AddRatePerSecond:               stats.addRate,
+		DispatchRatePerSecond:          stats.dispatchRate,
+		SyncMatchRatio:                 stats.syncMatchRatio,
+		OldestTaskAgeSeconds:           stats.oldestTaskAge.Seconds(),
+		IngestionRPSLimit:              c.config.TaskListIngestionRPS(),
+		DomainIngestionRPSLimit:        c.config.DomainTaskIngestionRPS(),
+		DomainDispatchRPSLimit:         c.config.DomainTaskDispatchRPS(),
+		ThrottledAddRatePerSecond:      stats.addThrottleRate,
+		ThrottledDispatchRatePerSecond: stats.dispatchThrottleRate,
 	}
 
 	return response
 }
 
+// CollectLoad returns the number of tasks added and dispatched since the previous call,
+// together with the current backlog and poller count
+func (c *taskListManagerImpl) CollectLoad() *taskListLoad {
+	return &taskListLoad{
+		addedTasks:      atomic.SwapInt64(&c.addedTasks, 0),
+		dispatchedTasks: atomic.SwapInt64(&c.dispatchedTasks, 0),
+		backlog:         c.taskAckManager.GetBacklogCount(),
+		pollers:         len(c.pollerHistory.getAllPollerInfo()),
+	}
+}
+
+// GetVersionSets returns the worker build ID version sets stored with the task list
+func (c *taskListManagerImpl) GetVersionSets() []*types.WorkerVersionSet {
+	return c.db.VersionSets()
+}
+
+// GetTaskListState returns the pause and migration state stored with the task list
+func (c *taskListManagerImpl) GetTaskListState() *types.TaskListState {
+	return c.db.TaskListState()
+}
+
+// UpdateTaskListState persists the given pause and migration state, nil values are left unchanged
+func (c *taskListManagerImpl) UpdateTaskListState(paused *bool, migrateTo *string) error {
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.UpdateTaskListState(paused, migrateTo)
+	})
+	return err
+}
+
+// UpdateVersionSets applies the update to the worker build ID version sets and persists the result
+func (c *taskListManagerImpl) UpdateVersionSets(
+	update func([]*types.WorkerVersionSet) ([]*types.WorkerVersionSet, error),
+) error {
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.UpdateVersionSets(update)
+	})
+	return err
+}
+
 func (c *taskListManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskListID.taskType == persistence.TaskListTypeActivity {
@@ -417,6 +684,7 @@ func (c *taskListManagerImpl) GetTaskListKind() types.TaskListKind {
 //   - task is deleted from the database when err is nil
 //   - new task is created and current task is deleted when err is not nil
 func (c *taskListManagerImpl) completeTask(task *persistence.TaskInfo, err error) {
+	c.stats.removeBacklogTask(task.TaskID)
 	if err != nil {
 		// failed to start the task.
 		// We cannot just remove it from persistence because then it will be lost.
@@ -525,6 +793,36 @@ func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskPa
 	return matched, err
 }
 
+// checkIngestionQuotas returns an error when the ingestion quota of the task list or its domain is exceeded
+func (c *taskListManagerImpl) checkIngestionQuotas() error {
+	// take a reservation with the task list limiter first, so that it can be
+	// cancelled when the domain quota is exceeded and the task is not added
+	now := time.Now()
+	rsv := c.ingestionLimiter.ReserveN(now, 1)
+	if !rsv.OK() || rsv.DelayFrom(now) != 0 {
+		rsv.CancelAt(now)
+		c.metricScope().IncCounter(metrics.IngestionThrottlePerTaskListCounter)
+		c.stats.recordAddThrottle(time.Now())
+		return errTaskListIngestionThrottled
+	}
+	if !c.engine.domainQuotas.allowIngestion(c.domainName()) {
+		rsv.CancelAt(now)
+		c.metricScope().IncCounter(metrics.DomainIngestionThrottlePerTaskListCounter)
+		c.stats.recordAddThrottle(time.Now())
+		return errDomainIngestionThrottled
+	}
+	return nil
+}
+
+func (c *taskListManagerImpl) domainDispatchLimiter() *quotas.DynamicRateLimiter {
+	return c.engine.domainQuotas.dispatchLimiter(c.domainName())
+}
+
+func (c *taskListManagerImpl) recordDispatchThrottle() {
+	c.metricScope().IncCounter(metrics.DomainDispatchThrottlePerTaskListCounter)
+	c.stats.recordDispatchThrottle(time.Now())
+}
+
 // newChildContext creates a child context with desired timeout.
 // if tailroom is non-zero, then child context timeout will be
 // the minOf(parentCtx.Deadline()-tailroom, timeout). Use this
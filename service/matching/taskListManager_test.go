@@ -75,13 +75,13 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	defer controller.Finish()
 
 	tlm := createTestTaskListManager(controller)
-	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{}
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
 		tlm.taskReader.dispatchBufferedTasks()
 		wg.Done()
 	}()
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{}
 	time.Sleep(100 * time.Millisecond) // let go routine run first and block on tasksForPoll
 	tlm.taskReader.cancelFunc()
 	wg.Wait()
@@ -113,12 +113,12 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 		},
 	}
 
-	require.True(t, tlm.taskReader.addTasksToBuffer(tasks, time.Now(), time.NewTimer(time.Minute)))
+	tlm.taskReader.addTasksToBuffer(tasks)
 	require.Equal(t, int64(0), tlm.taskAckManager.GetAckLevel())
 	require.Equal(t, int64(12), tlm.taskAckManager.GetReadLevel())
 
 	// Now add a mix of valid and expired tasks
-	require.True(t, tlm.taskReader.addTasksToBuffer([]*persistence.TaskInfo{
+	tlm.taskReader.addTasksToBuffer([]*persistence.TaskInfo{
 		{
 			TaskID:      13,
 			Expiry:      time.Now().Add(-time.Minute),
@@ -129,11 +129,72 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 			Expiry:      time.Now().Add(time.Hour),
 			CreatedTime: time.Now().Add(time.Minute),
 		},
-	}, time.Now(), time.NewTimer(time.Minute)))
+	})
 	require.Equal(t, int64(0), tlm.taskAckManager.GetAckLevel())
 	require.Equal(t, int64(14), tlm.taskAckManager.GetReadLevel())
 }
 
+func TestTaskPriorityAcrossReadBatches(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	tlm.taskAckManager.SetAckLevel(0)
+	tlm.taskAckManager.SetReadLevel(0)
+
+	newTask := func(taskID int64, priority int32) *persistence.TaskInfo {
+		return &persistence.TaskInfo{
+			TaskID:      taskID,
+			Priority:    priority,
+			Expiry:      time.Now().Add(time.Hour),
+			CreatedTime: time.Now(),
+		}
+	}
+	// tasks of a batch are reordered by priority
+	tlm.taskReader.addTasksToBuffer([]*persistence.TaskInfo{
+		newTask(1, 0),
+		newTask(2, 5),
+	})
+	// a higher priority task of the next batch overtakes the buffered tasks
+	tlm.taskReader.addTasksToBuffer([]*persistence.TaskInfo{
+		newTask(3, 10),
+	})
+	require.Equal(t, 3, tlm.taskReader.backlogLen())
+
+	var taskIDs []int64
+	for tlm.taskReader.nextBacklogTask() != nil {
+		taskIDs = append(taskIDs, tlm.taskReader.popBacklogTask().TaskID)
+	}
+	require.Equal(t, []int64{3, 2, 1}, taskIDs)
+}
+
+func TestGetTasksPumpReadAhead(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	// the test task manager returns all the tasks of a range, each range holds two tasks
+	cfg.RangeSize = 2
+	cfg.BacklogReadAheadSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	for i := 0; i < 6; i++ {
+		_, err := tlm.taskWriter.appendTask(&types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}, &persistence.TaskInfo{
+			ScheduleID: int64(i),
+		})
+		require.NoError(t, err)
+	}
+	tlm.taskReader.Signal()
+
+	// the pump stops reading once the backlog is full, the dispatcher holds one task
+	require.Eventually(t, func() bool { return tlm.taskReader.backlogLen() >= 3 }, time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.Less(t, tlm.taskAckManager.GetReadLevel(), tlm.taskWriter.GetMaxReadLevel())
+	require.Less(t, tlm.taskReader.backlogLen(), 6)
+}
+
 func createTestTaskListManager(controller *gomock.Controller) *taskListManagerImpl {
 	return createTestTaskListManagerWithConfig(controller, defaultTestConfig())
 }
@@ -209,7 +270,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Equal(t, tlm.config.RangeSize, taskIDBlock.GetEndID())
 
 	// Add a poller and complete all tasks
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil, "", "")
 	for i := int64(0); i < taskCount; i++ {
 		tlm.taskAckManager.AckItem(startTaskID + i)
 	}
@@ -221,7 +282,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.True(t, descResp.Pollers[0].GetRatePerSecond() > (_defaultTaskDispatchRPS-1))
 
 	rps := 5.0
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps, "", "")
 	descResp = tlm.DescribeTaskList(includeTaskStatus)
 	require.Equal(t, 1, len(descResp.GetPollers()))
 	require.Equal(t, PollerIdentity, descResp.Pollers[0].GetIdentity())
@@ -255,7 +316,7 @@ func TestCheckIdleTaskList(t *testing.T) {
 
 	// Active poll-er
 	tlm = createTestTaskListManagerWithConfig(controller, cfg)
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil, "", "")
 	require.Equal(t, 1, len(tlm.GetAllPollerInfo()))
 	tlMgrStartWithoutNotifyEvent(tlm)
 	time.Sleep(20 * time.Millisecond)
@@ -0,0 +1,70 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+// taskListStateCacheSize is the max number of task lists whose pause and migration state is cached
+const taskListStateCacheSize = 10000
+
+type (
+	// taskListStateCache caches the pause and migration state of the task lists whose root partition is owned
+	// by other hosts, so that the partitions do not call the owner of the root partition on every task and poll.
+	// Expired entries are kept so that they can be used when the owner cannot be reached.
+	taskListStateCache struct {
+		entries cache.Cache
+		ttl     dynamicconfig.DurationPropertyFn
+	}
+
+	taskListStateCacheEntry struct {
+		state    *types.TaskListState
+		cachedAt time.Time
+	}
+)
+
+func newTaskListStateCache(ttl dynamicconfig.DurationPropertyFn) *taskListStateCache {
+	return &taskListStateCache{
+		entries: cache.New(&cache.Options{MaxCount: taskListStateCacheSize}),
+		ttl:     ttl,
+	}
+}
+
+// get returns the cached state of the task list, whether there is an entry and whether it is still fresh
+func (c *taskListStateCache) get(id taskListID) (state *types.TaskListState, ok bool, fresh bool) {
+	entry, ok := c.entries.Get(id).(*taskListStateCacheEntry)
+	if !ok {
+		return nil, false, false
+	}
+	return entry.state, true, time.Since(entry.cachedAt) < c.ttl()
+}
+
+// put caches the state of the task list
+func (c *taskListStateCache) put(id taskListID, state *types.TaskListState) {
+	c.entries.Put(id, &taskListStateCacheEntry{
+		state:    state,
+		cachedAt: time.Now(),
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// taskListStatsWindow is the period the add and dispatch rates of a task list are measured over
+	taskListStatsWindow = time.Minute
+	// taskListStatsBuckets is the number of buckets the window is split into
+	taskListStatsBuckets = 12
+)
+
+type (
+	// taskListStats tracks the add, dispatch and throttle rates of a task list over a sliding window,
+	// and the creation time of the backlog tasks which are read from database and not completed yet
+	taskListStats struct {
+		sync.Mutex
+		startTime    time.Time
+		buckets      [taskListStatsBuckets]taskListStatsBucket
+		backlogTasks map[int64]time.Time
+	}
+
+	taskListStatsBucket struct {
+		start       time.Time
+		added       int64
+		syncMatched int64
+		dispatched  int64
+		// addThrottled and dispatchThrottled count the tasks rejected or delayed by the quotas
+		addThrottled      int64
+		dispatchThrottled int64
+	}
+
+	// taskListStatsSnapshot is the state of the stats of a task list at a point in time
+	taskListStatsSnapshot struct {
+		addRate        float64
+		dispatchRate   float64
+		syncMatchRatio float64
+		oldestTaskAge  time.Duration
+		// addThrottleRate and dispatchThrottleRate are the rates at which the quotas throttled tasks
+		addThrottleRate      float64
+		dispatchThrottleRate float64
+	}
+)
+
+func newTaskListStats(now time.Time) *taskListStats {
+	return &taskListStats{
+		startTime:    now,
+		backlogTasks: make(map[int64]time.Time),
+	}
+}
+
+// recordAdd records a task added to the task list, syncMatch is true if the task was matched without being persisted
+func (s *taskListStats) recordAdd(now time.Time, syncMatch bool) {
+	s.Lock()
+	defer s.Unlock()
+	bucket := s.bucket(now)
+	bucket.added++
+	if syncMatch {
+		bucket.syncMatched++
+	}
+}
+
+// recordDispatch records a task dispatched to a poller
+func (s *taskListStats) recordDispatch(now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	s.bucket(now).dispatched++
+}
+
+// recordAddThrottle records a task rejected by the ingestion quotas
+func (s *taskListStats) recordAddThrottle(now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	s.bucket(now).addThrottled++
+}
+
+// recordDispatchThrottle records a task delayed by the dispatch quota of the domain
+func (s *taskListStats) recordDispatchThrottle(now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	s.bucket(now).dispatchThrottled++
+}
+
+// addBacklogTask records a backlog task read from database
+func (s *taskListStats) addBacklogTask(taskID int64, createdTime time.Time) {
+	if createdTime.IsZero() {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.backlogTasks[taskID] = createdTime
+}
+
+// removeBacklogTask forgets a backlog task once it is completed
+func (s *taskListStats) removeBacklogTask(taskID int64) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.backlogTasks, taskID)
+}
+
+// snapshot returns the rates over the window ending now and the age of the oldest backlog task
+func (s *taskListStats) snapshot(now time.Time) *taskListStatsSnapshot {
+	s.Lock()
+	defer s.Unlock()
+
+	var added, syncMatched, dispatched, addThrottled, dispatchThrottled int64
+	windowStart := now.Add(-taskListStatsWindow)
+	for _, bucket := range s.buckets {
+		if bucket.start.After(windowStart) && !bucket.start.After(now) {
+			added += bucket.added
+			syncMatched += bucket.syncMatched
+			dispatched += bucket.dispatched
+			addThrottled += bucket.addThrottled
+			dispatchThrottled += bucket.dispatchThrottled
+		}
+	}
+
+	// the rates of a task list loaded less than a window ago are measured over its lifetime
+	window := taskListStatsWindow
+	if elapsed := now.Sub(s.startTime); elapsed < window {
+		window = elapsed
+	}
+
+	result := &taskListStatsSnapshot{}
+	if window > 0 {
+		result.addRate = float64(added) / window.Seconds()
+		result.dispatchRate = float64(dispatched) / window.Seconds()
+		result.addThrottleRate = float64(addThrottled) / window.Seconds()
+		result.dispatchThrottleRate = float64(dispatchThrottled) / window.Seconds()
+	}
+	if added > 0 {
+		result.syncMatchRatio = float64(syncMatched) / float64(added)
+	}
+	for _, createdTime := range s.backlogTasks {
+		if age := now.Sub(createdTime); age > result.oldestTaskAge {
+			result.oldestTaskAge = age
+		}
+	}
+	return result
+}
+
+// bucket returns the bucket of the given time, resetting it if it was last used for an earlier period
+func (s *taskListStats) bucket(now time.Time) *taskListStatsBucket {
+	bucketDuration := taskListStatsWindow / taskListStatsBuckets
+	start := now.Truncate(bucketDuration)
+	bucket := &s.buckets[(start.UnixNano()/int64(bucketDuration))%taskListStatsBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = taskListStatsBucket{start: start}
+	}
+	return bucket
+}
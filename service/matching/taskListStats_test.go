@@ -0,0 +1,95 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskListStats_Rates(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	stats := newTaskListStats(start)
+
+	now := start.Add(2 * taskListStatsWindow)
+	for i := 0; i < 60; i++ {
+		stats.recordAdd(now.Add(-time.Duration(i)*time.Second/2), i%3 == 0)
+	}
+	for i := 0; i < 30; i++ {
+		stats.recordDispatch(now.Add(-time.Duration(i) * time.Second))
+	}
+
+	snapshot := stats.snapshot(now)
+	assert.InDelta(t, 1.0, snapshot.addRate, 0.01)
+	assert.InDelta(t, 0.5, snapshot.dispatchRate, 0.01)
+	assert.InDelta(t, 1.0/3, snapshot.syncMatchRatio, 0.01)
+
+	// the counts age out of the window
+	snapshot = stats.snapshot(now.Add(2 * taskListStatsWindow))
+	assert.Zero(t, snapshot.addRate)
+	assert.Zero(t, snapshot.dispatchRate)
+	assert.Zero(t, snapshot.syncMatchRatio)
+}
+
+func TestTaskListStats_RatesOfNewTaskList(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	stats := newTaskListStats(start)
+	for i := 0; i < 10; i++ {
+		stats.recordAdd(start.Add(time.Second), true)
+	}
+	snapshot := stats.snapshot(start.Add(5 * time.Second))
+	assert.InDelta(t, 2.0, snapshot.addRate, 0.01)
+	assert.Equal(t, 1.0, snapshot.syncMatchRatio)
+}
+
+func TestTaskListStats_ThrottleRates(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	stats := newTaskListStats(start)
+
+	now := start.Add(2 * taskListStatsWindow)
+	for i := 0; i < 60; i++ {
+		stats.recordAddThrottle(now.Add(-time.Duration(i) * time.Second / 2))
+	}
+	for i := 0; i < 15; i++ {
+		stats.recordDispatchThrottle(now.Add(-time.Duration(i) * time.Second))
+	}
+
+	snapshot := stats.snapshot(now)
+	assert.InDelta(t, 1.0, snapshot.addThrottleRate, 0.01)
+	assert.InDelta(t, 0.25, snapshot.dispatchThrottleRate, 0.01)
+	assert.Zero(t, snapshot.addRate)
+}
+
+func TestTaskListStats_OldestTaskAge(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	stats := newTaskListStats(now)
+	assert.Zero(t, stats.snapshot(now).oldestTaskAge)
+
+	stats.addBacklogTask(1, now.Add(-time.Minute))
+	stats.addBacklogTask(2, now.Add(-time.Second))
+	stats.addBacklogTask(3, time.Time{})
+	assert.Equal(t, time.Minute, stats.snapshot(now).oldestTaskAge)
+
+	stats.removeBacklogTask(1)
+	assert.Equal(t, time.Second, stats.snapshot(now).oldestTaskAge)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sort"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const defaultFairnessKeyWeight = 1.0
+
+type (
+	// fairTaskQueue orders the backlog tasks read ahead by the task reader. Tasks with a higher
+	// priority are dispatched first, tasks of the same priority are dispatched in proportion to
+	// the weights of their fairness keys and in order within the same fairness key.
+	//
+	// The task reader keeps reading batches into the queue while it holds less than
+	// matching.backlogReadAheadSize tasks, so a high priority task overtakes every lower priority
+	// task of the read ahead window whatever the batch it was read in.
+	fairTaskQueue struct {
+		weights    func() map[string]interface{}
+		levels     map[int32]*fairTaskLevel
+		priorities []int32 // priorities of the levels in descending order
+		size       int
+	}
+
+	fairTaskLevel struct {
+		keys map[string]*fairKeyQueue
+		// virtualTime is the pass of the last dispatched task, new keys start from it
+		// so that a key cannot build up credit while it has no tasks
+		virtualTime float64
+		nextSeq     int64
+	}
+
+	fairKeyQueue struct {
+		tasks []*persistence.TaskInfo
+		pass  float64
+		seq   int64
+	}
+)
+
+func newFairTaskQueue(weights func() map[string]interface{}) *fairTaskQueue {
+	return &fairTaskQueue{
+		weights: weights,
+		levels:  make(map[int32]*fairTaskLevel),
+	}
+}
+
+// Len returns the number of tasks in the queue
+func (q *fairTaskQueue) Len() int {
+	return q.size
+}
+
+// Add adds a task to the queue
+func (q *fairTaskQueue) Add(task *persistence.TaskInfo) {
+	level, ok := q.levels[task.Priority]
+	if !ok {
+		level = &fairTaskLevel{keys: make(map[string]*fairKeyQueue)}
+		q.levels[task.Priority] = level
+		q.priorities = append(q.priorities, task.Priority)
+		sort.Slice(q.priorities, func(i, j int) bool { return q.priorities[i] > q.priorities[j] })
+	}
+	keyQueue, ok := level.keys[task.FairnessKey]
+	if !ok {
+		keyQueue = &fairKeyQueue{pass: level.virtualTime, seq: level.nextSeq}
+		level.nextSeq++
+		level.keys[task.FairnessKey] = keyQueue
+	}
+	keyQueue.tasks = append(keyQueue.tasks, task)
+	q.size++
+}
+
+// Peek returns the next task to dispatch without removing it, it returns nil if the queue is empty
+func (q *fairTaskQueue) Peek() *persistence.TaskInfo {
+	if q.size == 0 {
+		return nil
+	}
+	_, _, keyQueue := q.head()
+	return keyQueue.tasks[0]
+}
+
+// Next removes and returns the next task to dispatch, it returns nil if the queue is empty
+func (q *fairTaskQueue) Next() *persistence.TaskInfo {
+	if q.size == 0 {
+		return nil
+	}
+	level, key, keyQueue := q.head()
+
+	task := keyQueue.tasks[0]
+	keyQueue.tasks[0] = nil
+	keyQueue.tasks = keyQueue.tasks[1:]
+	level.virtualTime = keyQueue.pass
+	keyQueue.pass += 1 / fairnessKeyWeight(q.weights(), key)
+	q.size--
+
+	if len(keyQueue.tasks) == 0 {
+		delete(level.keys, key)
+	}
+	if len(level.keys) == 0 {
+		delete(q.levels, q.priorities[0])
+		q.priorities = q.priorities[1:]
+	}
+	return task
+}
+
+// head returns the fairness key queue of the next task, the queue must not be empty
+func (q *fairTaskQueue) head() (*fairTaskLevel, string, *fairKeyQueue) {
+	level := q.levels[q.priorities[0]]
+	var key string
+	var keyQueue *fairKeyQueue
+	for k, kq := range level.keys {
+		if keyQueue == nil || kq.pass < keyQueue.pass || (kq.pass == keyQueue.pass && kq.seq < keyQueue.seq) {
+			key, keyQueue = k, kq
+		}
+	}
+	return level, key, keyQueue
+}
+
+func fairnessKeyWeight(weights map[string]interface{}, key string) float64 {
+	var weight float64
+	switch v := weights[key].(type) {
+	case int:
+		weight = float64(v)
+	case float64:
+		weight = v
+	}
+	if weight <= 0 {
+		return defaultFairnessKeyWeight
+	}
+	return weight
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	taskPrioritySuite struct {
+		suite.Suite
+
+		weights map[string]interface{}
+		nextID  int64
+	}
+)
+
+func TestTaskPrioritySuite(t *testing.T) {
+	s := new(taskPrioritySuite)
+	suite.Run(t, s)
+}
+
+func (s *taskPrioritySuite) SetupTest() {
+	s.weights = map[string]interface{}{}
+	s.nextID = 0
+}
+
+func (s *taskPrioritySuite) TestFairTaskQueue_Priority() {
+	queue := newFairTaskQueue(s.getWeights)
+	s.Nil(queue.Next())
+
+	low := s.newTask(0, "")
+	high := s.newTask(5, "")
+	negative := s.newTask(-1, "")
+	queue.Add(low)
+	queue.Add(negative)
+	queue.Add(high)
+	s.Equal(3, queue.Len())
+
+	s.Equal(high, queue.Next())
+	s.Equal(low, queue.Next())
+	s.Equal(negative, queue.Next())
+	s.Equal(0, queue.Len())
+	s.Nil(queue.Next())
+}
+
+func (s *taskPrioritySuite) TestFairTaskQueue_OrderWithinKey() {
+	queue := newFairTaskQueue(s.getWeights)
+	var tasks []*persistence.TaskInfo
+	for i := 0; i < 5; i++ {
+		task := s.newTask(0, "key")
+		tasks = append(tasks, task)
+		queue.Add(task)
+	}
+	for _, task := range tasks {
+		s.Equal(task, queue.Next())
+	}
+}
+
+func (s *taskPrioritySuite) TestFairTaskQueue_RoundRobin() {
+	queue := newFairTaskQueue(s.getWeights)
+	for i := 0; i < 10; i++ {
+		queue.Add(s.newTask(0, "noisy"))
+	}
+	queue.Add(s.newTask(0, "quiet"))
+
+	s.Equal("noisy", queue.Next().FairnessKey)
+	s.Equal("quiet", queue.Next().FairnessKey)
+	s.Equal("noisy", queue.Next().FairnessKey)
+}
+
+func (s *taskPrioritySuite) TestFairTaskQueue_Weights() {
+	s.weights["heavy"] = 3
+	s.weights["light"] = 1.0
+	queue := newFairTaskQueue(s.getWeights)
+	for i := 0; i < 20; i++ {
+		queue.Add(s.newTask(0, "heavy"))
+		queue.Add(s.newTask(0, "light"))
+	}
+
+	counts := make(map[string]int)
+	for i := 0; i < 8; i++ {
+		counts[queue.Next().FairnessKey]++
+	}
+	s.Equal(6, counts["heavy"])
+	s.Equal(2, counts["light"])
+}
+
+func (s *taskPrioritySuite) TestFairTaskQueue_NewKeyNoCredit() {
+	queue := newFairTaskQueue(s.getWeights)
+	for i := 0; i < 5; i++ {
+		queue.Add(s.newTask(0, "a"))
+	}
+	for i := 0; i < 3; i++ {
+		s.Equal("a", queue.Next().FairnessKey)
+	}
+	// a key added later does not get all the dispatches it missed
+	for i := 0; i < 5; i++ {
+		queue.Add(s.newTask(0, "b"))
+	}
+	s.Equal("b", queue.Next().FairnessKey)
+	s.Equal("a", queue.Next().FairnessKey)
+	s.Equal("b", queue.Next().FairnessKey)
+	s.Equal("a", queue.Next().FairnessKey)
+	s.Equal("b", queue.Next().FairnessKey)
+	s.Equal("b", queue.Next().FairnessKey)
+}
+
+func (s *taskPrioritySuite) TestFairnessKeyWeight() {
+	s.Equal(defaultFairnessKeyWeight, fairnessKeyWeight(nil, "key"))
+	s.Equal(defaultFairnessKeyWeight, fairnessKeyWeight(map[string]interface{}{"key": 0}, "key"))
+	s.Equal(defaultFairnessKeyWeight, fairnessKeyWeight(map[string]interface{}{"key": "2"}, "key"))
+	s.Equal(2.0, fairnessKeyWeight(map[string]interface{}{"key": 2}, "key"))
+	s.Equal(0.5, fairnessKeyWeight(map[string]interface{}{"key": 0.5}, "key"))
+}
+
+func (s *taskPrioritySuite) getWeights() map[string]interface{} {
+	return s.weights
+}
+
+func (s *taskPrioritySuite) newTask(priority int32, fairnessKey string) *persistence.TaskInfo {
+	s.nextID++
+	return &persistence.TaskInfo{
+		TaskID:      s.nextID,
+		Priority:    priority,
+		FairnessKey: fairnessKey,
+	}
+}
@@ -23,6 +23,7 @@ package matching
 import (
 	"context"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/common/log"
@@ -34,11 +35,18 @@ import (
 
 var epochStartTime = time.Unix(0, 0)
 
+// taskListStateCheckInterval is how often the task reader checks whether the task list is paused or migrated
+const taskListStateCheckInterval = time.Second
+
 type (
 	taskReader struct {
-		taskBuffer chan *persistence.TaskInfo // tasks loaded from persistence
+		taskBuffer chan *persistence.TaskInfo // hands the next backlog task over to the dispatcher
 		notifyC    chan struct{}              // Used as signal to notify pump of new tasks
 		tlMgr      *taskListManagerImpl
+		// backlog holds the tasks read ahead from persistence, up to BacklogReadAheadSize tasks
+		// are buffered and handed over to the dispatcher by priority and fairness key
+		backlogLock sync.Mutex
+		backlog     *fairTaskQueue
 		// The cancel objects are to cancel the ratelimiter Wait in dispatchBufferedTasks. The ideal
 		// approach is to use request-scoped contexts and use a unique one for each call to Wait. However
 		// in order to cancel it on shutdown, we need a new goroutine for each call that would wait on
@@ -60,9 +68,10 @@ func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 		cancelFunc:          cancel,
 		notifyC:             make(chan struct{}, 1),
 		dispatcherShutdownC: make(chan struct{}),
-		// we always dequeue the head of the buffer and try to dispatch it to a poller
-		// so allocate one less than desired target buffer size
-		taskBuffer: make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1),
+		// the buffer is unbuffered so that the dispatcher always receives the next task of
+		// the backlog at the time it is ready to dispatch it
+		taskBuffer: make(chan *persistence.TaskInfo),
+		backlog:    newFairTaskQueue(tlMgr.config.FairnessKeyWeights),
 	}
 }
 
@@ -95,14 +104,40 @@ dispatchLoop:
 			}
 			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, types.TaskSourceDbBacklog, "", false)
 			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+				if migrateTo := tr.tlMgr.migrationTarget(tr.cancelCtx); migrateTo != "" {
+					err := tr.tlMgr.migrateTask(tr.cancelCtx, taskInfo, types.TaskSourceDbBacklog, migrateTo)
+					if err == nil {
+						task.finish(nil)
+						break
+					}
+					if tr.cancelCtx.Err() != nil {
+						tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+						break dispatchLoop
+					}
+					tr.logger().Error("taskReader: failed to migrate task", tag.Error(err))
+					if !tr.waitForStateCheck() {
+						break dispatchLoop
+					}
+					continue
+				}
+				if tr.tlMgr.paused(tr.cancelCtx) {
+					if !tr.waitForStateCheck() {
+						break dispatchLoop
+					}
+					continue
+				}
+				err := tr.dispatchTask(task)
 				if err == nil {
 					break
 				}
-				if err == context.Canceled {
+				if tr.cancelCtx.Err() != nil {
 					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
 					break dispatchLoop
 				}
+				if err == context.Canceled {
+					// the task list was paused or is being migrated while the task was offered
+					continue
+				}
 				// this should never happen unless there is a bug - don't drop the task
 				tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
 				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
@@ -114,6 +149,47 @@ dispatchLoop:
 	}
 }
 
+// dispatchTask offers the task to the pollers until it is matched, the task list is shut down,
+// or the task list is paused or migrated
+func (tr *taskReader) dispatchTask(task *InternalTask) error {
+	ctx, cancel := context.WithCancel(tr.cancelCtx)
+	defer cancel()
+	doneC := make(chan struct{})
+	defer close(doneC)
+
+	go func() {
+		ticker := time.NewTicker(taskListStateCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-doneC:
+				return
+			case <-ticker.C:
+				if tr.tlMgr.paused(ctx) || tr.tlMgr.migrationTarget(ctx) != "" {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	return tr.tlMgr.DispatchTask(ctx, task)
+}
+
+// waitForStateCheck waits until the pause and migration state of the task list is checked again,
+// it returns false when the task reader is shut down
+func (tr *taskReader) waitForStateCheck() bool {
+	timer := time.NewTimer(taskListStateCheckInterval)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-tr.dispatcherShutdownC:
+		return false
+	case <-tr.cancelCtx.Done():
+		return false
+	}
+}
+
 func (tr *taskReader) getTasksPump() {
 	tr.tlMgr.startWG.Wait()
 	defer close(tr.taskBuffer)
@@ -121,15 +197,35 @@ func (tr *taskReader) getTasksPump() {
 	updateAckTimer := time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 	checkIdleTaskListTimer := time.NewTimer(tr.tlMgr.config.IdleTasklistCheckInterval())
 	lastTimeWriteTask := time.Time{}
+	// readPaused is set when a read is skipped because the backlog is full,
+	// the pump is signaled again once the dispatcher has drained it
+	readPaused := false
 getTasksPumpLoop:
 	for {
+		// the send is disabled while the backlog is empty as the buffer is nil
+		var taskBuffer chan *persistence.TaskInfo
+		next := tr.nextBacklogTask()
+		if next != nil {
+			taskBuffer = tr.taskBuffer
+		}
 		select {
 		case <-tr.tlMgr.shutdownCh:
 			break getTasksPumpLoop
+		case taskBuffer <- next:
+			tr.popBacklogTask()
+			if readPaused && tr.backlogLen() < tr.tlMgr.config.BacklogReadAheadSize() {
+				readPaused = false
+				tr.Signal()
+			}
 		case <-tr.notifyC:
 			{
 				lastTimeWriteTask = time.Now()
 
+				if tr.backlogLen() >= tr.tlMgr.config.BacklogReadAheadSize() {
+					readPaused = true
+					continue getTasksPumpLoop
+				}
+
 				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch()
 				if err != nil {
 					tr.Signal() // re-enqueue the event
@@ -145,9 +241,7 @@ getTasksPumpLoop:
 					continue getTasksPumpLoop
 				}
 
-				if !tr.addTasksToBuffer(tasks, lastTimeWriteTask, checkIdleTaskListTimer) {
-					break getTasksPumpLoop
-				}
+				tr.addTasksToBuffer(tasks)
 				// There maybe more tasks. We yield now, but signal pump to check again later.
 				tr.Signal()
 			}
@@ -170,7 +264,8 @@ getTasksPumpLoop:
 			}
 		case <-checkIdleTaskListTimer.C:
 			{
-				if tr.isIdle(lastTimeWriteTask) {
+				// a pending signal means tasks may have been written since the last read
+				if tr.isIdle(lastTimeWriteTask) && len(tr.notifyC) == 0 {
 					tr.handleIdleTimeout()
 					break getTasksPumpLoop
 				}
@@ -181,6 +276,18 @@ getTasksPumpLoop:
 
 	updateAckTimer.Stop()
 	checkIdleTaskListTimer.Stop()
+
+	// the buffered tasks are still handed over to the dispatcher until it is stopped
+	for task := tr.nextBacklogTask(); task != nil; task = tr.nextBacklogTask() {
+		select {
+		case tr.taskBuffer <- task:
+			tr.popBacklogTask()
+		case <-tr.cancelCtx.Done():
+			return
+		case <-tr.dispatcherShutdownC:
+			return
+		}
+	}
 }
 
 func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
@@ -239,9 +346,13 @@ func (tr *taskReader) handleIdleTimeout() {
 	tr.tlMgr.Stop()
 }
 
-func (tr *taskReader) addTasksToBuffer(
-	tasks []*persistence.TaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
+// addTasksToBuffer adds the tasks of a read batch to the backlog. The tasks are read in task ID
+// order and are handed over to the dispatcher by priority and fairness key together with the
+// tasks of the previous batches which are still buffered.
+func (tr *taskReader) addTasksToBuffer(tasks []*persistence.TaskInfo) {
 	now := time.Now()
+	tr.backlogLock.Lock()
+	defer tr.backlogLock.Unlock()
 	for _, t := range tasks {
 		if tr.isTaskExpired(t, now) {
 			tr.scope().IncCounter(metrics.ExpiredTasksPerTaskListCounter)
@@ -250,32 +361,33 @@ func (tr *taskReader) addTasksToBuffer(
 			tr.tlMgr.taskAckManager.SetReadLevel(t.TaskID)
 			continue
 		}
-		if !tr.addSingleTaskToBuffer(t, lastWriteTime, idleTimer) {
-			return false // we are shutting down the task list
+		// items are read by the ack manager in task ID order
+		err := tr.tlMgr.taskAckManager.ReadItem(t.TaskID)
+		if err != nil {
+			tr.logger().Fatal("critical bug when adding item to ackManager")
 		}
+		tr.tlMgr.stats.addBacklogTask(t.TaskID, t.CreatedTime)
+		tr.backlog.Add(t)
 	}
-	return true
 }
 
-func (tr *taskReader) addSingleTaskToBuffer(
-	task *persistence.TaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
-	err := tr.tlMgr.taskAckManager.ReadItem(task.TaskID)
-	if err != nil {
-		tr.logger().Fatal("critical bug when adding item to ackManager")
-	}
-	for {
-		select {
-		case tr.taskBuffer <- task:
-			return true
-		case <-idleTimer.C:
-			if tr.isIdle(lastWriteTime) {
-				tr.handleIdleTimeout()
-				return false
-			}
-		case <-tr.tlMgr.shutdownCh:
-			return false
-		}
-	}
+// nextBacklogTask returns the next task of the backlog without removing it
+func (tr *taskReader) nextBacklogTask() *persistence.TaskInfo {
+	tr.backlogLock.Lock()
+	defer tr.backlogLock.Unlock()
+	return tr.backlog.Peek()
+}
+
+func (tr *taskReader) popBacklogTask() *persistence.TaskInfo {
+	tr.backlogLock.Lock()
+	defer tr.backlogLock.Unlock()
+	return tr.backlog.Next()
+}
+
+func (tr *taskReader) backlogLen() int {
+	tr.backlogLock.Lock()
+	defer tr.backlogLock.Unlock()
+	return tr.backlog.Len()
 }
 
 func (tr *taskReader) persistAckLevel() error {
@@ -285,6 +397,11 @@ func (tr *taskReader) persistAckLevel() error {
 	// note: this metrics is only an estimation for the lag. taskID in DB may not be continuous,
 	// especially when task list ownership changes.
 	scope.UpdateGauge(metrics.TaskLagPerTaskListGauge, float64(maxReadLevel-ackLevel))
+	stats := tr.tlMgr.stats.snapshot(time.Now())
+	scope.UpdateGauge(metrics.TaskAddRatePerTaskListGauge, stats.addRate)
+	scope.UpdateGauge(metrics.TaskDispatchRatePerTaskListGauge, stats.dispatchRate)
+	scope.UpdateGauge(metrics.SyncMatchRatioPerTaskListGauge, stats.syncMatchRatio)
+	scope.UpdateGauge(metrics.OldestTaskAgePerTaskListGauge, stats.oldestTaskAge.Seconds())
 
 	return tr.tlMgr.db.UpdateState(ackLevel)
 }
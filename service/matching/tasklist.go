@@ -39,12 +39,16 @@ type (
 	}
 	// qualifiedTaskListName refers to the fully qualified task list name
 	qualifiedTaskListName struct {
-		name      string // internal name of the tasks list
-		baseName  string // original name of the task list as specified by user
-		partition int    // partitionID of task list
+		name       string // internal name of the tasks list
+		baseName   string // original name of the task list as specified by user
+		partition  int    // partitionID of task list
+		versionSet string // ID of the worker version set the task list serves, empty if unversioned
 	}
 )
 
+// versionedTaskListInfix separates the name of a task list from the version set in the name of a versioned task list
+const versionedTaskListInfix = "/__cadence_build/"
+
 // newTaskListName returns a fully qualified task list name.
 // Fully qualified names contain additional metadata about task list
 // derived from their given name. The additional metadata only makes sense
@@ -52,7 +56,7 @@ type (
 // one partition for a user specified task list, each of the
 // individual partitions have an internal name of the form
 //
-//     /__cadence_sys/[original-name]/[partitionID]
+//	/__cadence_sys/[original-name]/[partitionID]
 //
 // The name of the root partition is always the same as the user specified name. Rest of
 // the partitions follow the naming convention above. In addition, the task lists partitions
@@ -60,6 +64,12 @@ type (
 // optimization to allow for partitioned task lists to dispatch tasks with low latency when
 // throughput is low - See https://github.com/uber/cadence/issues/2098
 //
+// The tasks and pollers of a worker version set are routed to a separate task list with the name
+//
+//	[original-name]/__cadence_build/[versionSetID]
+//
+// which is partitioned the same way as any other task list.
+//
 // Returns error if the given name is non-compliant with the required format
 // for task list names
 func newTaskListName(name string) (qualifiedTaskListName, error) {
@@ -85,7 +95,9 @@ func (tn *qualifiedTaskListName) GetRoot() string {
 
 // Parent returns the name of the parent task list
 // input:
-//   degree: Number of children at each level of the tree
+//
+//	degree: Number of children at each level of the tree
+//
 // Returns empty string if this task list is the root
 func (tn *qualifiedTaskListName) Parent(degree int) string {
 	if tn.IsRoot() || degree == 0 {
@@ -102,7 +114,25 @@ func (tn *qualifiedTaskListName) mkName(partition int) string {
 	return fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, tn.baseName, partition)
 }
 
+// IsVersioned returns true if this task list serves a worker version set
+func (tn *qualifiedTaskListName) IsVersioned() bool {
+	return tn.versionSet != ""
+}
+
 func (tn *qualifiedTaskListName) init() error {
+	if err := tn.initPartition(); err != nil {
+		return err
+	}
+	if i := strings.LastIndex(tn.baseName, versionedTaskListInfix); i >= 0 {
+		tn.versionSet = tn.baseName[i+len(versionedTaskListInfix):]
+		if tn.versionSet == "" {
+			return fmt.Errorf("invalid versioned task list name %v", tn.name)
+		}
+	}
+	return nil
+}
+
+func (tn *qualifiedTaskListName) initPartition() error {
 	if !strings.HasPrefix(tn.name, common.ReservedTaskListPrefix) {
 		return nil
 	}
@@ -122,6 +152,12 @@ func (tn *qualifiedTaskListName) init() error {
 	return nil
 }
 
+// versionedTaskListName returns the name of the root partition of the task list which serves
+// the version set of the given task list
+func versionedTaskListName(taskListName string, versionSet string) string {
+	return taskListName + versionedTaskListInfix + versionSet
+}
+
 // newTaskListID returns taskListID which uniquely identifies as task list
 func newTaskListID(
 	domainID string,
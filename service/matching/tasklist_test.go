@@ -58,6 +58,32 @@ func TestValidTaskListNames(t *testing.T) {
 	}
 }
 
+func TestVersionedTaskListNames(t *testing.T) {
+	testCases := []struct {
+		input      string
+		baseName   string
+		partition  int
+		versionSet string
+	}{
+		{"list0", "list0", 0, ""},
+		{"list0/__cadence_build/1.0", "list0/__cadence_build/1.0", 0, "1.0"},
+		{"/__cadence_sys/list0/__cadence_build/1.0/3", "list0/__cadence_build/1.0", 3, "1.0"},
+		{"/__cadence_sys/list0/1", "list0", 1, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			tn, err := newTaskListName(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.partition, tn.partition)
+			require.Equal(t, tc.baseName, tn.GetRoot())
+			require.Equal(t, tc.versionSet, tn.versionSet)
+			require.Equal(t, tc.versionSet != "", tn.IsVersioned())
+		})
+	}
+	require.Equal(t, "list0/__cadence_build/1.0", versionedTaskListName("list0", "1.0"))
+}
+
 func TestTaskListParentName(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -107,6 +133,8 @@ func TestInvalidTasklistNames(t *testing.T) {
 		"/__cadence_sys/list0",
 		"/__cadence_sys/list0/0",
 		"/__cadence_sys/list0/-1",
+		"list0/__cadence_build/",
+		"/__cadence_sys/list0/__cadence_build//1",
 	}
 	for _, name := range inputs {
 		t.Run(name, func(t *testing.T) {
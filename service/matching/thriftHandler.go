@@ -91,6 +91,30 @@ func (t ThriftHandler) GetTaskListsByDomain(ctx context.Context, request *s.GetT
 	return thrift.FromGetTaskListsByDomainResponse(response), thrift.FromError(err)
 }
 
+// UpdateWorkerBuildIDCompatibility forwards request to the underlying handler
+func (t ThriftHandler) UpdateWorkerBuildIDCompatibility(ctx context.Context, request *m.UpdateWorkerBuildIDCompatibilityRequest) error {
+	err := t.h.UpdateWorkerBuildIDCompatibility(withThriftTag(ctx), thrift.ToMatchingUpdateWorkerBuildIDCompatibilityRequest(request))
+	return thrift.FromError(err)
+}
+
+// GetWorkerBuildIDCompatibility forwards request to the underlying handler
+func (t ThriftHandler) GetWorkerBuildIDCompatibility(ctx context.Context, request *m.GetWorkerBuildIDCompatibilityRequest) (*s.GetWorkerBuildIDCompatibilityResponse, error) {
+	response, err := t.h.GetWorkerBuildIDCompatibility(withThriftTag(ctx), thrift.ToMatchingGetWorkerBuildIDCompatibilityRequest(request))
+	return thrift.FromGetWorkerBuildIDCompatibilityResponse(response), thrift.FromError(err)
+}
+
+// UpdateTaskListState forwards request to the underlying handler
+func (t ThriftHandler) UpdateTaskListState(ctx context.Context, request *m.UpdateTaskListStateRequest) error {
+	err := t.h.UpdateTaskListState(withThriftTag(ctx), thrift.ToMatchingUpdateTaskListStateRequest(request))
+	return thrift.FromError(err)
+}
+
+// GetTaskListState forwards request to the underlying handler
+func (t ThriftHandler) GetTaskListState(ctx context.Context, request *m.GetTaskListStateRequest) (*m.TaskListState, error) {
+	response, err := t.h.GetTaskListState(withThriftTag(ctx), thrift.ToMatchingGetTaskListStateRequest(request))
+	return thrift.FromTaskListState(response), thrift.FromError(err)
+}
+
 // PollForActivityTask forwards request to the underlying handler
 func (t ThriftHandler) PollForActivityTask(ctx context.Context, request *m.PollForActivityTaskRequest) (*s.PollForActivityTaskResponse, error) {
 	response, err := t.h.PollForActivityTask(withThriftTag(ctx), thrift.ToMatchingPollForActivityTaskRequest(request))
@@ -0,0 +1,71 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+// versionSetsCacheSize is the max number of task lists whose version sets are cached
+const versionSetsCacheSize = 10000
+
+type (
+	// versionSetsCache caches the worker build ID version sets of the task lists owned by other hosts,
+	// so that routing a task or a poll by build does not call the owner of the root task list every time.
+	// Expired entries are kept so that they can be used when the owner cannot be reached.
+	versionSetsCache struct {
+		entries cache.Cache
+		ttl     dynamicconfig.DurationPropertyFn
+	}
+
+	versionSetsCacheEntry struct {
+		sets     []*types.WorkerVersionSet
+		cachedAt time.Time
+	}
+)
+
+func newVersionSetsCache(ttl dynamicconfig.DurationPropertyFn) *versionSetsCache {
+	return &versionSetsCache{
+		entries: cache.New(&cache.Options{MaxCount: versionSetsCacheSize}),
+		ttl:     ttl,
+	}
+}
+
+// get returns the cached version sets of the task list, whether there is an entry and whether it is still fresh
+func (c *versionSetsCache) get(id taskListID) (sets []*types.WorkerVersionSet, ok bool, fresh bool) {
+	entry, ok := c.entries.Get(id).(*versionSetsCacheEntry)
+	if !ok {
+		return nil, false, false
+	}
+	return entry.sets, true, time.Since(entry.cachedAt) < c.ttl()
+}
+
+// put caches the version sets of the task list
+func (c *versionSetsCache) put(id taskListID, sets []*types.WorkerVersionSet) {
+	c.entries.Put(id, &versionSetsCacheEntry{
+		sets:     sets,
+		cachedAt: time.Now(),
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shadower
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/.gen/go/shadower"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// CheckWorkflowTypeName is the workflow type of the compatibility check of a workflow build
+	CheckWorkflowTypeName = "cadence-sys-shadower-check-workflow"
+	// ErrReasonIncompatibleBuild is the failure reason of a check in which the build failed to replay some histories
+	ErrReasonIncompatibleBuild = "incompatible workflow build"
+
+	checkWorkflowIDPrefix   = "cadence-shadower-check"
+	defaultCheckShadowCount = 100
+)
+
+type (
+	// CheckParams are the params of the compatibility check of a workflow build
+	CheckParams struct {
+		// Domain and WorkflowType select the histories replayed against the build
+		Domain       string
+		WorkflowType string
+		// SignatureHash is the hash of the decision sequence signature the workers of the build
+		// register for the workflow type, builds with the same signature hash share one check
+		BuildID       string
+		SignatureHash string
+		// TaskList is polled by the shadow worker of the build
+		TaskList string
+		// EarliestTime is the start time in unix nano of the earliest workflow replayed
+		EarliestTime int64
+		ShadowCount  int32
+		Concurrency  int32
+	}
+
+	// CheckResult is the result of the compatibility check of a workflow build
+	CheckResult struct {
+		BuildID       string
+		SignatureHash string
+		Succeeded     int32
+		Skipped       int32
+		Failed        int32
+	}
+)
+
+// CheckWorkflowID returns the ID of the check workflow of a signature hash of a workflow type. The check is
+// started with the AllowDuplicateFailedOnly reuse policy, so a signature hash that passed is not checked again.
+func CheckWorkflowID(
+	domain string,
+	workflowType string,
+	signatureHash string,
+) string {
+	return fmt.Sprintf("%v:%v:%v:%v", checkWorkflowIDPrefix, domain, workflowType, signatureHash)
+}
+
+func checkWorkflow(
+	ctx workflow.Context,
+	params CheckParams,
+) (CheckResult, error) {
+	logger := workflow.GetLogger(ctx).With(
+		zap.String(tagShadowDomain, params.Domain),
+		zap.String(tagShadowTaskList, params.TaskList),
+		zap.String("workflow-type", params.WorkflowType),
+		zap.String("build-id", params.BuildID),
+	)
+	if err := validateCheckParams(&params); err != nil {
+		return CheckResult{}, err
+	}
+
+	workflowTimeout := time.Duration(workflow.GetInfo(ctx).ExecutionStartToCloseTimeoutSeconds) * time.Second
+	childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		ExecutionStartToCloseTimeout: workflowTimeout,
+	})
+	shadowParams := shadower.WorkflowParams{
+		Domain:        common.StringPtr(params.Domain),
+		TaskList:      common.StringPtr(params.TaskList),
+		WorkflowQuery: common.StringPtr(getCheckWorkflowQuery(&params)),
+		ShadowMode:    shadower.ModeNormal.Ptr(),
+		ExitCondition: &shadower.ExitCondition{
+			ShadowCount: common.Int32Ptr(params.ShadowCount),
+		},
+		Concurrency: common.Int32Ptr(params.Concurrency),
+	}
+	var shadowResult shadower.WorkflowResult
+	if err := workflow.ExecuteChildWorkflow(childCtx, shadower.WorkflowName, shadowParams).Get(childCtx, &shadowResult); err != nil {
+		logger.Error("Workflow build check failed to shadow workflows", zap.Error(err))
+		return CheckResult{}, err
+	}
+
+	result := CheckResult{
+		BuildID:       params.BuildID,
+		SignatureHash: params.SignatureHash,
+		Succeeded:     shadowResult.GetSucceeded(),
+		Skipped:       shadowResult.GetSkipped(),
+		Failed:        shadowResult.GetFailed(),
+	}
+	if result.Failed > 0 {
+		logger.Warn("Workflow build is incompatible with existing histories", zap.Int32("failed", result.Failed))
+		// failing the check allows it to be run again for the signature hash
+		return CheckResult{}, cadence.NewCustomError(ErrReasonIncompatibleBuild, result)
+	}
+	logger.Info("Workflow build is compatible with existing histories", zap.Int32("succeeded", result.Succeeded))
+	return result, nil
+}
+
+func validateCheckParams(
+	params *CheckParams,
+) error {
+	if len(params.Domain) == 0 {
+		return errors.New("Domain is not set on check workflow params")
+	}
+	if len(params.WorkflowType) == 0 || strings.Contains(params.WorkflowType, "'") {
+		return errors.New("WorkflowType is not valid on check workflow params")
+	}
+	if len(params.TaskList) == 0 {
+		return errors.New("TaskList is not set on check workflow params")
+	}
+	if len(params.SignatureHash) == 0 {
+		return errors.New("SignatureHash is not set on check workflow params")
+	}
+	if params.ShadowCount <= 0 {
+		params.ShadowCount = defaultCheckShadowCount
+	}
+	return nil
+}
+
+func getCheckWorkflowQuery(
+	params *CheckParams,
+) string {
+	query := fmt.Sprintf("WorkflowType = '%v'", params.WorkflowType)
+	if params.EarliestTime > 0 {
+		query += fmt.Sprintf(" and StartTime >= %v", params.EarliestTime)
+	}
+	return query
+}
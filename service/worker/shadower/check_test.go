@@ -0,0 +1,143 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shadower
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/.gen/go/shadower"
+	"github.com/uber/cadence/common"
+)
+
+type checkWorkflowSuite struct {
+	*require.Assertions
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func TestCheckWorkflowSuite(t *testing.T) {
+	s := new(checkWorkflowSuite)
+	suite.Run(t, s)
+}
+
+func (s *checkWorkflowSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.RegisterWorkflowWithOptions(
+		checkWorkflow,
+		workflow.RegisterOptions{Name: CheckWorkflowTypeName},
+	)
+	s.env.RegisterWorkflowWithOptions(
+		shadowWorkflow,
+		workflow.RegisterOptions{Name: shadower.WorkflowName},
+	)
+}
+
+func (s *checkWorkflowSuite) TearDownTest() {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *checkWorkflowSuite) TestCheckWorkflow_InvalidParams() {
+	s.env.ExecuteWorkflow(checkWorkflow, CheckParams{
+		Domain:        testActiveDomainName,
+		WorkflowType:  "workflow'type",
+		TaskList:      testTaskListName,
+		SignatureHash: "signature",
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func (s *checkWorkflowSuite) TestCheckWorkflow_Compatible() {
+	earliestTime := time.Now().Add(-time.Hour).UnixNano()
+	s.env.OnWorkflow(shadower.WorkflowName, mock.Anything, mock.Anything).Return(
+		func(ctx workflow.Context, params shadower.WorkflowParams) (shadower.WorkflowResult, error) {
+			s.Equal(testActiveDomainName, params.GetDomain())
+			s.Equal(testTaskListName, params.GetTaskList())
+			s.Equal(shadower.ModeNormal, params.GetShadowMode())
+			s.Equal(fmt.Sprintf("WorkflowType = 'workflow-type' and StartTime >= %v", earliestTime), params.GetWorkflowQuery())
+			s.Equal(int32(defaultCheckShadowCount), params.GetExitCondition().GetShadowCount())
+			return shadower.WorkflowResult{
+				Succeeded: common.Int32Ptr(10),
+				Skipped:   common.Int32Ptr(2),
+				Failed:    common.Int32Ptr(0),
+			}, nil
+		},
+	).Once()
+
+	s.env.ExecuteWorkflow(checkWorkflow, CheckParams{
+		Domain:        testActiveDomainName,
+		WorkflowType:  "workflow-type",
+		BuildID:       "build",
+		SignatureHash: "signature",
+		TaskList:      testTaskListName,
+		EarliestTime:  earliestTime,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	var result CheckResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(CheckResult{
+		BuildID:       "build",
+		SignatureHash: "signature",
+		Succeeded:     10,
+		Skipped:       2,
+	}, result)
+}
+
+func (s *checkWorkflowSuite) TestCheckWorkflow_Incompatible() {
+	s.env.OnWorkflow(shadower.WorkflowName, mock.Anything, mock.Anything).Return(shadower.WorkflowResult{
+		Succeeded: common.Int32Ptr(10),
+		Skipped:   common.Int32Ptr(0),
+		Failed:    common.Int32Ptr(1),
+	}, nil).Once()
+
+	s.env.ExecuteWorkflow(checkWorkflow, CheckParams{
+		Domain:        testActiveDomainName,
+		WorkflowType:  "workflow-type",
+		BuildID:       "build",
+		SignatureHash: "signature",
+		TaskList:      testTaskListName,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	customErr, ok := err.(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(ErrReasonIncompatibleBuild, customErr.Reason())
+	var result CheckResult
+	s.NoError(customErr.Details(&result))
+	s.Equal(int32(1), result.Failed)
+}
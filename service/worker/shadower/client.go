@@ -0,0 +1,223 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go
+
+package shadower
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	s "go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/.gen/go/shadower"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const defaultCheckTimeout = time.Hour
+
+type (
+	// Client runs the compatibility checks of workflow builds, the deploy pipeline of a build
+	// polls CheckBuild until the check is no longer running and rolls the build out only if it passed
+	Client interface {
+		// CheckBuild starts the check of a build unless its signature hash passed or is being checked,
+		// and returns the state of the check
+		CheckBuild(ctx context.Context, request *types.CheckWorkflowBuildRequest) (*types.CheckWorkflowBuildResponse, error)
+	}
+
+	clientImpl struct {
+		cadenceClient cclient.Client
+		store         Store
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new Client
+func NewClient(
+	publicClient workflowserviceclient.Interface,
+	store Store,
+) Client {
+	return &clientImpl{
+		cadenceClient: cclient.NewClient(publicClient, common.ShadowerLocalDomainName, &cclient.Options{}),
+		store:         store,
+	}
+}
+
+func (c *clientImpl) CheckBuild(
+	ctx context.Context,
+	request *types.CheckWorkflowBuildRequest,
+) (*types.CheckWorkflowBuildResponse, error) {
+	params := CheckParams{
+		Domain:        request.GetDomain(),
+		WorkflowType:  request.GetWorkflowType(),
+		BuildID:       request.GetBuildID(),
+		SignatureHash: request.GetSignatureHash(),
+		TaskList:      request.GetTaskList(),
+		EarliestTime:  request.GetEarliestTime(),
+		ShadowCount:   request.GetShadowCount(),
+		Concurrency:   request.GetConcurrency(),
+	}
+	if err := validateCheckParams(&params); err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	key := SignatureKey{
+		Domain:        params.Domain,
+		WorkflowType:  params.WorkflowType,
+		SignatureHash: params.SignatureHash,
+	}
+	passed, err := c.store.GetPassedCheck(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if passed != nil {
+		return passed.toResponse(), nil
+	}
+	workflowID := CheckWorkflowID(params.Domain, params.WorkflowType, params.SignatureHash)
+
+	resp, err := c.getLatestCheck(ctx, workflowID, params.BuildID)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return c.startCheck(ctx, request, workflowID, &params)
+	}
+	if resp.GetState() == types.WorkflowBuildCheckStatePassed {
+		// the check workflow is deleted after the retention of its domain, the store keeps the signature hash passed
+		if err := c.store.RecordPassedCheck(ctx, &PassedCheck{
+			SignatureKey: key,
+			BuildID:      resp.CheckedBuildID,
+			WorkflowID:   resp.WorkflowID,
+			RunID:        resp.RunID,
+			Succeeded:    resp.Succeeded,
+			Skipped:      resp.Skipped,
+			Failed:       resp.Failed,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (c *clientImpl) startCheck(
+	ctx context.Context,
+	request *types.CheckWorkflowBuildRequest,
+	workflowID string,
+	params *CheckParams,
+) (*types.CheckWorkflowBuildResponse, error) {
+
+	timeout := defaultCheckTimeout
+	if request.GetExecutionStartToCloseTimeoutSeconds() > 0 {
+		timeout = time.Duration(request.GetExecutionStartToCloseTimeoutSeconds()) * time.Second
+	}
+	options := cclient.StartWorkflowOptions{
+		ID:                           workflowID,
+		TaskList:                     shadower.TaskList,
+		ExecutionStartToCloseTimeout: timeout,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicateFailedOnly,
+		Memo: map[string]interface{}{
+			"BuildID": params.BuildID,
+		},
+	}
+	execution, err := c.cadenceClient.StartWorkflow(ctx, options, CheckWorkflowTypeName, *params)
+	switch err.(type) {
+	case nil:
+		return &types.CheckWorkflowBuildResponse{
+			State:          types.WorkflowBuildCheckStateRunning.Ptr(),
+			WorkflowID:     execution.ID,
+			RunID:          execution.RunID,
+			CheckedBuildID: params.BuildID,
+		}, nil
+	case *s.WorkflowExecutionAlreadyStartedError:
+		// another caller started the check of the signature hash after the latest check was read
+		return &types.CheckWorkflowBuildResponse{
+			State:      types.WorkflowBuildCheckStateRunning.Ptr(),
+			WorkflowID: workflowID,
+		}, nil
+	default:
+		return nil, err
+	}
+}
+
+// getLatestCheck returns the state of the latest check of the signature hash, or nil if it is to be checked again
+func (c *clientImpl) getLatestCheck(
+	ctx context.Context,
+	workflowID string,
+	buildID string,
+) (*types.CheckWorkflowBuildResponse, error) {
+	describeResp, err := c.cadenceClient.DescribeWorkflowExecution(ctx, workflowID, "")
+	switch err.(type) {
+	case nil:
+	case *s.EntityNotExistsError:
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	info := describeResp.GetWorkflowExecutionInfo()
+	resp := &types.CheckWorkflowBuildResponse{
+		WorkflowID: workflowID,
+		RunID:      info.GetExecution().GetRunId(),
+	}
+	if info.CloseStatus == nil {
+		resp.State = types.WorkflowBuildCheckStateRunning.Ptr()
+		return resp, nil
+	}
+
+	var result CheckResult
+	err = c.cadenceClient.GetWorkflow(ctx, workflowID, resp.RunID).Get(ctx, &result)
+	if customErr, ok := err.(*cadence.CustomError); ok && customErr.Reason() == ErrReasonIncompatibleBuild {
+		if err := customErr.Details(&result); err != nil {
+			return nil, err
+		}
+		if result.BuildID != buildID {
+			// the build may fix the incompatibility of an earlier build with the same signature hash
+			return nil, nil
+		}
+		resp.State = types.WorkflowBuildCheckStateFailed.Ptr()
+	} else if err != nil {
+		// the check did not finish, e.g. it timed out, so it is run again
+		return nil, nil
+	} else {
+		resp.State = types.WorkflowBuildCheckStatePassed.Ptr()
+	}
+	resp.CheckedBuildID = result.BuildID
+	resp.Succeeded = result.Succeeded
+	resp.Skipped = result.Skipped
+	resp.Failed = result.Failed
+	return resp, nil
+}
+
+func (c *PassedCheck) toResponse() *types.CheckWorkflowBuildResponse {
+	return &types.CheckWorkflowBuildResponse{
+		State:          types.WorkflowBuildCheckStatePassed.Ptr(),
+		WorkflowID:     c.WorkflowID,
+		RunID:          c.RunID,
+		CheckedBuildID: c.BuildID,
+		Succeeded:      c.Succeeded,
+		Skipped:        c.Skipped,
+		Failed:         c.Failed,
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shadower
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	shared "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/mocks"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+type clientSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	cadenceClient *mocks.Client
+	store         Store
+	client        *clientImpl
+	request       *types.CheckWorkflowBuildRequest
+	workflowID    string
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(clientSuite))
+}
+
+func (s *clientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.cadenceClient = &mocks.Client{}
+	s.store = NewStore(&testQueue{}, clock.NewRealTimeSource())
+	s.client = NewClient(nil, s.store).(*clientImpl)
+	s.client.cadenceClient = s.cadenceClient
+	s.request = &types.CheckWorkflowBuildRequest{
+		Domain:        testActiveDomainName,
+		WorkflowType:  "workflow-type",
+		BuildID:       "build-2",
+		SignatureHash: "signature",
+		TaskList:      testTaskListName,
+	}
+	s.workflowID = CheckWorkflowID(testActiveDomainName, "workflow-type", "signature")
+}
+
+func (s *clientSuite) TearDownTest() {
+	s.cadenceClient.AssertExpectations(s.T())
+}
+
+func (s *clientSuite) TestCheckBuild_InvalidRequest() {
+	s.request.SignatureHash = ""
+	_, err := s.client.CheckBuild(context.Background(), s.request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *clientSuite) TestCheckBuild_NotChecked() {
+	s.cadenceClient.On("DescribeWorkflowExecution", mock.Anything, s.workflowID, "").Return(nil, &shared.EntityNotExistsError{}).Once()
+	s.expectStart()
+
+	resp, err := s.client.CheckBuild(context.Background(), s.request)
+	s.NoError(err)
+	s.Equal(types.WorkflowBuildCheckStateRunning, resp.GetState())
+	s.Equal("run-id", resp.GetRunID())
+	s.Equal("build-2", resp.GetCheckedBuildID())
+}
+
+func (s *clientSuite) TestCheckBuild_Running() {
+	s.expectDescribe(nil)
+
+	resp, err := s.client.CheckBuild(context.Background(), s.request)
+	s.NoError(err)
+	s.Equal(types.WorkflowBuildCheckStateRunning, resp.GetState())
+	s.Equal("latest-run-id", resp.GetRunID())
+}
+
+func (s *clientSuite) TestCheckBuild_Passed() {
+	s.expectDescribe(shared.WorkflowExecutionCloseStatusCompleted.Ptr())
+	s.expectResult(func(result *CheckResult) error {
+		*result = CheckResult{BuildID: "build-1", SignatureHash: "signature", Succeeded: 10}
+		return nil
+	})
+
+	resp, err := s.client.CheckBuild(context.Background(), s.request)
+	s.NoError(err)
+	s.Equal(types.WorkflowBuildCheckStatePassed, resp.GetState())
+	s.Equal("build-1", resp.GetCheckedBuildID())
+	s.Equal(int32(10), resp.GetSucceeded())
+
+	// the passed signature hash is kept after the check workflow is deleted
+	resp, err = s.client.CheckBuild(context.Background(), s.request)
+	s.NoError(err)
+	s.Equal(types.WorkflowBuildCheckStatePassed, resp.GetState())
+	s.Equal("build-1", resp.GetCheckedBuildID())
+	s.Equal("latest-run-id", resp.GetRunID())
+	s.Equal(int32(10), resp.GetSucceeded())
+}
+
+func (s *clientSuite) TestCheckBuild_PassedAfterRetention() {
+	s.NoError(s.store.RecordPassedCheck(context.Background(), &PassedCheck{
+		SignatureKey: SignatureKey{Domain: testActiveDomainName, WorkflowType: "workflow-type", SignatureHash: "signature"},
+		BuildID:      "build-1",
+		WorkflowID:   s.workflowID,
+		RunID:        "run-id",
+	}))
+
+	resp, err := s.client.CheckBuild(context.Background(), s.request)
+	s.NoError(err)
+	s.Equal(types.WorkflowBuildCheckStatePassed, resp.GetState())
+	s.Equal("build-1", resp.GetCheckedBuildID())
+	s.Equal("run-id", resp.GetRunID())
+}
+
+func (s *clientSuite) TestCheckBuild_Failed() {
+	s.expectDescribe(shared.WorkflowExecutionCloseStatusFailed.Ptr())
+	s.expectResult(func(_ *CheckResult) error {
+		return cadence.NewCustomError(ErrReasonIncompatibleBuild, CheckResult{BuildID: "build-2", SignatureHash: "signature", Failed: 1})
+	})
+
+	resp, err := s.client.CheckBuild(context.Background(), s.request)
+	s.NoError(err)
+	s.Equal(types.WorkflowBuildCheckStateFailed, resp.GetState())
+	s.Equal("build-2", resp.GetCheckedBuildID())
+	s.Equal(int32(1), resp.GetFailed())
+}
+
+func (s *clientSuite) TestCheckBuild_FailedForOtherBuild() {
+	s.expectDescribe(shared.WorkflowExecutionCloseStatusFailed.Ptr())
+	s.expectResult(func(_ *CheckResult) error {
+		return cadence.NewCustomError(ErrReasonIncompatibleBuild, CheckResult{BuildID: "build-1", SignatureHash: "signature", Failed: 1})
+	})
+	s.expectStart()
+
+	resp, err := s.client.CheckBuild(context.Background(), s.request)
+	s.NoError(err)
+	s.Equal(types.WorkflowBuildCheckStateRunning, resp.GetState())
+	s.Equal("build-2", resp.GetCheckedBuildID())
+}
+
+func (s *clientSuite) TestCheckBuild_TimedOut() {
+	s.expectDescribe(shared.WorkflowExecutionCloseStatusTimedOut.Ptr())
+	s.expectResult(func(_ *CheckResult) error {
+		return errors.New("workflow timed out")
+	})
+	s.expectStart()
+
+	resp, err := s.client.CheckBuild(context.Background(), s.request)
+	s.NoError(err)
+	s.Equal(types.WorkflowBuildCheckStateRunning, resp.GetState())
+}
+
+func (s *clientSuite) expectDescribe(closeStatus *shared.WorkflowExecutionCloseStatus) {
+	s.cadenceClient.On("DescribeWorkflowExecution", mock.Anything, s.workflowID, "").Return(&shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{
+			Execution:   &shared.WorkflowExecution{WorkflowId: common.StringPtr(s.workflowID), RunId: common.StringPtr("latest-run-id")},
+			CloseStatus: closeStatus,
+		},
+	}, nil).Once()
+}
+
+func (s *clientSuite) expectResult(get func(result *CheckResult) error) {
+	run := &mocks.WorkflowRun{}
+	run.On("Get", mock.Anything, mock.Anything).Return(func(_ context.Context, valuePtr interface{}) error {
+		return get(valuePtr.(*CheckResult))
+	}).Once()
+	s.cadenceClient.On("GetWorkflow", mock.Anything, s.workflowID, "latest-run-id").Return(run).Once()
+}
+
+func (s *clientSuite) expectStart() {
+	s.cadenceClient.On("StartWorkflow", mock.Anything, mock.Anything, CheckWorkflowTypeName, mock.Anything).Return(
+		&workflow.Execution{ID: s.workflowID, RunID: "run-id"}, nil,
+	).Once()
+}
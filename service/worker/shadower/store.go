@@ -0,0 +1,128 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination store_mock.go
+
+package shadower
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// emptyRecordID is the record ID to read the store from the beginning
+	emptyRecordID int64 = -1
+
+	readPageSize = 1000
+)
+
+type (
+	// SignatureKey identifies a signature hash of a workflow type
+	SignatureKey struct {
+		Domain        string `json:"domain"`
+		WorkflowType  string `json:"workflowType"`
+		SignatureHash string `json:"signatureHash"`
+	}
+
+	// PassedCheck is the result of the check a signature hash passed
+	PassedCheck struct {
+		SignatureKey
+		BuildID    string    `json:"buildID"`
+		WorkflowID string    `json:"workflowID"`
+		RunID      string    `json:"runID"`
+		Succeeded  int32     `json:"succeeded"`
+		Skipped    int32     `json:"skipped"`
+		Failed     int32     `json:"failed"`
+		PassedAt   time.Time `json:"passedAt"`
+	}
+
+	// Store persists the signature hashes that passed their check, a passed signature hash is not checked
+	// again, so it has to outlive the retention of the domain of the check workflows
+	Store interface {
+		// GetPassedCheck returns the check the signature hash passed, or nil if it did not pass
+		GetPassedCheck(ctx context.Context, key SignatureKey) (*PassedCheck, error)
+		// RecordPassedCheck records a signature hash as passed
+		RecordPassedCheck(ctx context.Context, check *PassedCheck) error
+	}
+
+	storeImpl struct {
+		queue      persistence.QueueManager
+		timeSource clock.TimeSource
+	}
+)
+
+var _ Store = (*storeImpl)(nil)
+
+// NewStore creates a store of passed checks on top of the shadower check queue
+func NewStore(
+	queue persistence.QueueManager,
+	timeSource clock.TimeSource,
+) Store {
+	return &storeImpl{
+		queue:      queue,
+		timeSource: timeSource,
+	}
+}
+
+func (s *storeImpl) GetPassedCheck(
+	ctx context.Context,
+	key SignatureKey,
+) (*PassedCheck, error) {
+	// a signature hash passes once, so the queue only grows with the signature hashes deployed
+	lastRecordID := emptyRecordID
+	for {
+		messages, err := s.queue.ReadMessages(ctx, lastRecordID, readPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range messages {
+			check := &PassedCheck{}
+			if err := json.Unmarshal(message.Payload, check); err != nil {
+				return nil, fmt.Errorf("failed to decode shadower check record %v: %v", message.ID, err)
+			}
+			if check.SignatureKey == key {
+				return check, nil
+			}
+			lastRecordID = message.ID
+		}
+		if len(messages) < readPageSize {
+			return nil, nil
+		}
+	}
+}
+
+func (s *storeImpl) RecordPassedCheck(
+	ctx context.Context,
+	check *PassedCheck,
+) error {
+	record := *check
+	record.PassedAt = s.timeSource.Now()
+	payload, err := json.Marshal(&record)
+	if err != nil {
+		return fmt.Errorf("failed to encode shadower check record: %v", err)
+	}
+	return s.queue.EnqueueMessage(ctx, payload)
+}
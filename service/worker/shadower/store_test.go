@@ -0,0 +1,129 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shadower
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	storeSuite struct {
+		suite.Suite
+
+		queue *testQueue
+		store Store
+	}
+
+	// testQueue keeps messages in memory, only the methods used by the store are implemented
+	testQueue struct {
+		persistence.QueueManager
+
+		sync.Mutex
+		nextID   int64
+		messages []*persistence.QueueMessage
+	}
+)
+
+func TestStoreSuite(t *testing.T) {
+	s := new(storeSuite)
+	suite.Run(t, s)
+}
+
+func (s *storeSuite) SetupTest() {
+	s.queue = &testQueue{}
+	s.store = NewStore(s.queue, clock.NewEventTimeSource().Update(time.Unix(1600000000, 0)))
+}
+
+func (s *storeSuite) TestRecordAndGetPassedCheck() {
+	ctx := context.Background()
+	key := SignatureKey{Domain: testActiveDomainName, WorkflowType: "workflow-type", SignatureHash: "signature"}
+	passed, err := s.store.GetPassedCheck(ctx, key)
+	s.NoError(err)
+	s.Nil(passed)
+
+	other := key
+	other.SignatureHash = "other-signature"
+	s.NoError(s.store.RecordPassedCheck(ctx, &PassedCheck{SignatureKey: other, BuildID: "build-0"}))
+	s.NoError(s.store.RecordPassedCheck(ctx, &PassedCheck{SignatureKey: key, BuildID: "build-1", RunID: "run-id", Succeeded: 10}))
+
+	passed, err = s.store.GetPassedCheck(ctx, key)
+	s.NoError(err)
+	s.Equal("build-1", passed.BuildID)
+	s.Equal("run-id", passed.RunID)
+	s.Equal(int32(10), passed.Succeeded)
+	s.Equal(time.Unix(1600000000, 0).Unix(), passed.PassedAt.Unix())
+}
+
+func (s *storeSuite) TestGetPassedCheck_MultiplePages() {
+	ctx := context.Background()
+	key := SignatureKey{Domain: testActiveDomainName, WorkflowType: "workflow-type", SignatureHash: "signature"}
+	for i := 0; i < readPageSize; i++ {
+		other := key
+		other.WorkflowType = "other-workflow-type"
+		s.NoError(s.store.RecordPassedCheck(ctx, &PassedCheck{SignatureKey: other}))
+	}
+	s.NoError(s.store.RecordPassedCheck(ctx, &PassedCheck{SignatureKey: key, BuildID: "build-1"}))
+
+	passed, err := s.store.GetPassedCheck(ctx, key)
+	s.NoError(err)
+	s.Equal("build-1", passed.BuildID)
+}
+
+func (q *testQueue) EnqueueMessage(
+	_ context.Context,
+	messagePayload []byte,
+) error {
+	q.Lock()
+	defer q.Unlock()
+
+	q.messages = append(q.messages, &persistence.QueueMessage{
+		ID:        q.nextID,
+		QueueType: persistence.ShadowerCheckQueueType,
+		Payload:   messagePayload,
+	})
+	q.nextID++
+	return nil
+}
+
+func (q *testQueue) ReadMessages(
+	_ context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var result []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
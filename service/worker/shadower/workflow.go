@@ -58,6 +58,10 @@ func register(worker worker.Worker) {
 		shadowWorkflow,
 		workflow.RegisterOptions{Name: shadower.WorkflowName},
 	)
+	worker.RegisterWorkflowWithOptions(
+		checkWorkflow,
+		workflow.RegisterOptions{Name: CheckWorkflowTypeName},
+	)
 }
 
 func shadowWorkflow(
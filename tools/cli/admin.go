@@ -120,6 +120,49 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:        "quarantine",
+			Usage:       "Manage workflow executions quarantined after a mutable state checksum mismatch",
+			Subcommands: newAdminWorkflowQuarantineCommands(),
+		},
+	}
+}
+
+func newAdminWorkflowQuarantineCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List quarantined workflow executions, of all domains if the domain is not set",
+			Action: func(c *cli.Context) {
+				AdminListQuarantinedWorkflows(c)
+			},
+		},
+		{
+			Name:  "release",
+			Usage: "Release a quarantined workflow execution, accepting its mutable state as is unless it is to be repaired",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Rebuild the mutable state from history on release",
+				},
+				cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason of the change",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReleaseQuarantinedWorkflow(c)
+			},
+		},
 	}
 }
 
@@ -160,6 +203,62 @@ func newAdminShardManagementCommands() []cli.Command {
 				AdminDescribeShardDistribution(c)
 			},
 		},
+		{
+			Name:  "placement",
+			Usage: "List the owners and assignments of history shards and the latest load reported for them",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID,
+					Value: -1,
+					Usage: "Only show the placement of the shard with the given Id",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListShardPlacement(c)
+			},
+		},
+		{
+			Name:  "move",
+			Usage: "Assign a history shard to a history host, takes effect when the history service runs with load based shard placement",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "The Id of the shard to move",
+				},
+				cli.StringFlag{
+					Name:  FlagHost,
+					Usage: "Identity of the history host, e.g. 127.0.0.1:7934",
+				},
+				cli.BoolFlag{
+					Name:  FlagPin,
+					Usage: "Keep the shard on the host, the shard balancer does not move pinned shards",
+				},
+				cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason of the change",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMoveShard(c)
+			},
+		},
+		{
+			Name:  "unpin",
+			Usage: "Remove the assignment of a history shard, so that the shard balancer can move it again",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "The Id of the shard to unpin",
+				},
+				cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason of the change",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUnpinShard(c)
+			},
+		},
 		{
 			Name:    "setRangeID",
 			Aliases: []string{"srid"},
@@ -640,6 +739,21 @@ func newAdminElasticSearchCommands() []cli.Command {
 }
 
 func newAdminTaskListCommands() []cli.Command {
+	taskListStateFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList name",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListTypeWithAlias,
+			Value: "decision",
+			Usage: "Optional TaskList type [decision|activity]",
+		},
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "reason of the change",
+		},
+	}
 	return []cli.Command{
 		{
 			Name:    "describe",
@@ -660,6 +774,98 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminDescribeTaskList(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "Stop dispatching the tasks of tasklist, pollers receive empty responses until it is resumed",
+			Flags: taskListStateFlags,
+			Action: func(c *cli.Context) {
+				AdminPauseTaskList(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "Resume dispatching the tasks of a paused tasklist",
+			Flags: taskListStateFlags,
+			Action: func(c *cli.Context) {
+				AdminResumeTaskList(c)
+			},
+		},
+		{
+			Name:  "migrate",
+			Usage: "Move the backlog and new tasks of tasklist to another tasklist",
+			Flags: append(taskListStateFlags,
+				cli.StringFlag{
+					Name:  FlagDestinationTaskListWithAlias,
+					Usage: "TaskList the tasks are moved to, migration is stopped when it is empty",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminMigrateTaskList(c)
+			},
+		},
+		{
+			Name:        "dlq",
+			Usage:       "Run admin operation on the tasklist DLQ",
+			Subcommands: newAdminTaskListDLQCommands(),
+		},
+	}
+}
+
+func newAdminTaskListDLQCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the tasks of the domain which were moved to the tasklist DLQ",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Optional TaskList name",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListTaskListDLQ(c)
+			},
+		},
+		{
+			Name:  "show",
+			Usage: "Show a tasklist DLQ entry",
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  FlagMessageIDWithAlias,
+					Usage: "ID of the tasklist DLQ entry",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShowTaskListDLQEntry(c)
+			},
+		},
+		{
+			Name:  "redrive",
+			Usage: "Add a task of the tasklist DLQ back to its tasklist",
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  FlagMessageIDWithAlias,
+					Usage: "ID of the tasklist DLQ entry",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRedriveTaskListDLQEntry(c)
+			},
+		},
+		{
+			Name:  "fail",
+			Usage: "Remove an activity task from the tasklist DLQ, the activity times out instead of being dispatched again",
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  FlagMessageIDWithAlias,
+					Usage: "ID of the tasklist DLQ entry",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailTaskListDLQEntry(c)
+			},
+		},
 	}
 }
 
@@ -850,6 +1056,99 @@ func newAdminQueueCommands() []cli.Command {
 	}
 }
 
+func newAdminConfigStoreCommands() []cli.Command {
+	filterFlag := cli.StringSliceFlag{
+		Name:  FlagDynamicConfigFilter,
+		Usage: "filter the value applies to in name=value format, e.g. domainName=samples-domain, can be repeated",
+	}
+	updateFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagNameWithAlias,
+			Usage: "dynamic config name, e.g. frontend.rps",
+		},
+		filterFlag,
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "reason of the change",
+		},
+	}
+	return []cli.Command{
+		{
+			Name:  "get",
+			Usage: "get the value of a dynamic config from the dynamic config store for the filters",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "dynamic config name, e.g. frontend.rps",
+				},
+				filterFlag,
+			},
+			Action: func(c *cli.Context) {
+				AdminGetDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "set",
+			Usage: "set the value of a dynamic config in the dynamic config store for the filters",
+			Flags: append(updateFlags,
+				cli.StringFlag{
+					Name:  FlagDynamicConfigValue,
+					Usage: "JSON encoded value, e.g. 100, true or '\"10s\"'",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigExpiresAt,
+					Usage: "make the value a temporary override which expires at the given time, e.g. 2006-01-02T15:04:05Z",
+				},
+				cli.DurationFlag{
+					Name:  FlagDynamicConfigExpiresIn,
+					Usage: "make the value a temporary override which expires after the given duration, e.g. 2h",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigActiveFrom,
+					Usage: "time the temporary override takes effect, e.g. 2006-01-02T15:04:05Z, immediately by default",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminUpdateDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "delete the value of a dynamic config from the dynamic config store for the filters",
+			Flags: updateFlags,
+			Action: func(c *cli.Context) {
+				AdminRestoreDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "list the dynamic config values set in the dynamic config store",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "only list the values of this dynamic config",
+				},
+				cli.BoolFlag{
+					Name:  FlagDynamicConfigHistory,
+					Usage: "list all changes with their author and reason instead of the current values",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfig(c)
+			},
+		},
+		{
+			Name:      "lint",
+			Usage:     "validate a dynamic config file against the dynamic config key schemas",
+			ArgsUsage: "<file>",
+			Action: func(c *cli.Context) {
+				AdminLintDynamicConfig(c)
+			},
+		},
+	}
+}
+
 func newDBCommands() []cli.Command {
 	var collections cli.StringSlice = invariant.CollectionStrings()
 
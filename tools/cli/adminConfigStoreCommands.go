@@ -0,0 +1,267 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/types"
+)
+
+type dynamicConfigRow struct {
+	Name       string                 `json:"name"`
+	Filters    map[string]interface{} `json:"filters,omitempty"`
+	Value      interface{}            `json:"value,omitempty"`
+	Deleted    bool                   `json:"deleted,omitempty"`
+	Author     string                 `json:"author"`
+	Reason     string                 `json:"reason,omitempty"`
+	Timestamp  string                 `json:"timestamp"`
+	ActiveFrom string                 `json:"activeFrom,omitempty"`
+	ExpiresAt  string                 `json:"expiresAt,omitempty"`
+}
+
+// AdminGetDynamicConfig prints the value of a dynamic config set in the dynamic config store for the given filters
+func AdminGetDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	name := getRequiredOption(c, FlagName)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.GetDynamicConfig(ctx, &types.GetDynamicConfigRequest{
+		ConfigName: name,
+		Filters:    parseDynamicConfigFilters(c),
+	})
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to get dynamic config %v.", name), err)
+	}
+	prettyPrintJSONObject(decodeDynamicConfigValue(resp.GetValue()))
+}
+
+// AdminUpdateDynamicConfig sets the value of a dynamic config for the given filters
+func AdminUpdateDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	name := getRequiredOption(c, FlagName)
+	value := getRequiredOption(c, FlagDynamicConfigValue)
+	if _, err := configstore.DecodeValue([]byte(value)); err != nil {
+		ErrorAndExit("Dynamic config value must be valid JSON.", err)
+	}
+	activeFrom, expiresAt := parseDynamicConfigSchedule(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{
+		ConfigName: name,
+		Filters:    parseDynamicConfigFilters(c),
+		Value: &types.DataBlob{
+			EncodingType: types.EncodingTypeJSON.Ptr(),
+			Data:         []byte(value),
+		},
+		Reason:         c.String(FlagReason),
+		ActiveFromNano: activeFrom,
+		ExpiresAtNano:  expiresAt,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update dynamic config.", err)
+	}
+	fmt.Println("Dynamic config updated.")
+}
+
+// AdminRestoreDynamicConfig deletes the value of a dynamic config for the given filters,
+// the value falls back to the less specific values and to the file based config
+func AdminRestoreDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	name := getRequiredOption(c, FlagName)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.RestoreDynamicConfig(ctx, &types.RestoreDynamicConfigRequest{
+		ConfigName: name,
+		Filters:    parseDynamicConfigFilters(c),
+		Reason:     c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to delete dynamic config.", err)
+	}
+	fmt.Println("Dynamic config deleted.")
+}
+
+// AdminListDynamicConfig prints the values set in the dynamic config store or the changes made to them
+func AdminListDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	history := c.Bool(FlagDynamicConfigHistory)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{
+		ConfigName:     c.String(FlagName),
+		IncludeHistory: history,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to list dynamic config.", err)
+	}
+
+	if history {
+		for _, change := range resp.GetHistory() {
+			prettyPrintJSONObject(newDynamicConfigRow(change))
+		}
+		return
+	}
+	for _, entry := range resp.GetEntries() {
+		for _, value := range entry.GetValues() {
+			prettyPrintJSONObject(newDynamicConfigRow(value.GetLastChange()))
+		}
+	}
+}
+
+// AdminLintDynamicConfig validates a dynamic config file and exits with an error if any issue is found
+func AdminLintDynamicConfig(c *cli.Context) {
+	path := c.Args().First()
+	if path == "" {
+		ErrorAndExit("Dynamic config file is required.", nil)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to read dynamic config file %v.", path), err)
+	}
+	issues, err := dynamicconfig.LintConfigFile(content)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to parse dynamic config file %v.", path), err)
+	}
+	if len(issues) > 0 {
+		for _, issue := range issues {
+			fmt.Println(issue.String())
+		}
+		ErrorAndExit(fmt.Sprintf("Found %v issues in dynamic config file %v.", len(issues), path), nil)
+	}
+	fmt.Printf("Dynamic config file %v is valid.\n", path)
+}
+
+// parseDynamicConfigFilters parses filters in name=value format,
+// values which are not valid JSON are used as strings, e.g. domainName=samples-domain or shardID=1
+func parseDynamicConfigFilters(c *cli.Context) []*types.DynamicConfigFilter {
+	filters := c.StringSlice(FlagDynamicConfigFilter)
+	if len(filters) == 0 {
+		return nil
+	}
+	constraints := make(map[string]interface{}, len(filters))
+	for _, filter := range filters {
+		parts := strings.SplitN(filter, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			ErrorAndExit(fmt.Sprintf("Invalid filter %v, filters must be in name=value format.", filter), nil)
+		}
+		if _, ok := dynamicconfig.ParseFilterName(parts[0]); !ok {
+			ErrorAndExit(fmt.Sprintf("Unknown dynamic config filter %v.", parts[0]), nil)
+		}
+		value, err := configstore.DecodeValue([]byte(parts[1]))
+		if err != nil {
+			value = parts[1]
+		}
+		constraints[parts[0]] = value
+	}
+	internalFilters, err := configstore.ToInternalFilters(constraints)
+	if err != nil {
+		ErrorAndExit("Failed to encode dynamic config filters.", err)
+	}
+	return internalFilters
+}
+
+// parseDynamicConfigSchedule parses the activation and expiry time of a temporary override in unix nanos,
+// zero means the time is not set
+func parseDynamicConfigSchedule(c *cli.Context) (activeFrom int64, expiresAt int64) {
+	if c.IsSet(FlagDynamicConfigExpiresAt) && c.IsSet(FlagDynamicConfigExpiresIn) {
+		ErrorAndExit(fmt.Sprintf("Only one of %v and %v can be set.", FlagDynamicConfigExpiresAt, FlagDynamicConfigExpiresIn), nil)
+	}
+	if c.IsSet(FlagDynamicConfigExpiresAt) {
+		expiresAt = parseTime(c.String(FlagDynamicConfigExpiresAt), 0)
+	}
+	if c.IsSet(FlagDynamicConfigExpiresIn) {
+		expiresAt = time.Now().Add(c.Duration(FlagDynamicConfigExpiresIn)).UnixNano()
+	}
+	if c.IsSet(FlagDynamicConfigActiveFrom) {
+		activeFrom = parseTime(c.String(FlagDynamicConfigActiveFrom), 0)
+	}
+	return activeFrom, expiresAt
+}
+
+func decodeDynamicConfigValue(blob *types.DataBlob) interface{} {
+	value, err := configstore.FromInternalValue(blob)
+	if err != nil {
+		ErrorAndExit("Failed to decode dynamic config value.", err)
+	}
+	return value
+}
+
+func newDynamicConfigRow(change *types.DynamicConfigChange) *dynamicConfigRow {
+	filters, err := configstore.FromInternalFilters(change.GetFilters())
+	if err != nil {
+		ErrorAndExit("Failed to decode dynamic config filters.", err)
+	}
+	row := &dynamicConfigRow{
+		Name:      change.GetConfigName(),
+		Filters:   filters,
+		Value:     decodeDynamicConfigValue(change.GetValue()),
+		Deleted:   change.GetDeleted(),
+		Author:    change.GetAuthor(),
+		Reason:    change.GetReason(),
+		Timestamp: time.Unix(0, change.GetTimestampNano()).Format(time.RFC3339),
+	}
+	if change.GetActiveFromNano() != 0 {
+		row.ActiveFrom = time.Unix(0, change.GetActiveFromNano()).Format(time.RFC3339)
+	}
+	if change.GetExpiresAtNano() != 0 {
+		row.ExpiresAt = time.Unix(0, change.GetExpiresAtNano()).Format(time.RFC3339)
+	}
+	return row
+}
+
+// initializeQueueManager connects to the queue of the given type in the database
+func initializeQueueManager(c *cli.Context, queueType persistence.QueueType) persistence.QueueManager {
+	var queue persistence.Queue
+	var err error
+	dbType := c.String(FlagDBType)
+	if !isDBTypeSupported(dbType) {
+		supportedDBs := append(sql.GetRegisteredPluginNames(), "cassandra")
+		ErrorAndExit(fmt.Sprintf("The DB type is not supported. Options are: %s.", supportedDBs), nil)
+	}
+	logger := loggerimpl.NewNopLogger()
+	switch dbType {
+	case "cassandra":
+		client, session := connectToCassandra(c)
+		queue, err = nosql.NewNoSQLQueueStoreFromSession(client, session, logger, queueType)
+	default:
+		queue, err = sql.NewQueuePersistence(connectToSQL(c), logger, queueType)
+	}
+	if err != nil {
+		ErrorAndExit("Failed to initialize queue.", err)
+	}
+	return persistence.NewQueueManager(queue)
+}
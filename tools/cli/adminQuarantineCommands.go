@@ -0,0 +1,82 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type quarantinedWorkflowRow struct {
+	Domain        string `json:"domain"`
+	WorkflowID    string `json:"workflowID"`
+	RunID         string `json:"runID"`
+	ShardID       int32  `json:"shardID"`
+	Details       string `json:"details,omitempty"`
+	QuarantinedAt string `json:"quarantinedAt"`
+}
+
+// AdminListQuarantinedWorkflows prints the workflow executions quarantined after a mutable state checksum mismatch
+func AdminListQuarantinedWorkflows(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListQuarantinedWorkflows(ctx, &types.ListQuarantinedWorkflowsRequest{
+		Domain: c.GlobalString(FlagDomain),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to list quarantined workflow executions.", err)
+	}
+	for _, workflow := range resp.GetWorkflows() {
+		prettyPrintJSONObject(&quarantinedWorkflowRow{
+			Domain:        workflow.GetDomain(),
+			WorkflowID:    workflow.GetWorkflowID(),
+			RunID:         workflow.GetRunID(),
+			ShardID:       workflow.GetShardID(),
+			Details:       workflow.GetDetails(),
+			QuarantinedAt: time.Unix(0, workflow.GetQuarantinedTimestamp()).Format(time.RFC3339),
+		})
+	}
+}
+
+// AdminReleaseQuarantinedWorkflow releases a quarantined workflow execution
+func AdminReleaseQuarantinedWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &types.ReleaseQuarantinedWorkflowRequest{
+		Domain:     getRequiredGlobalOption(c, FlagDomain),
+		WorkflowID: getRequiredOption(c, FlagWorkflowID),
+		RunID:      getRequiredOption(c, FlagRunID),
+		Repair:     c.Bool(FlagRepair),
+		Reason:     c.String(FlagReason),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.ReleaseQuarantinedWorkflow(ctx, request); err != nil {
+		ErrorAndExit("Failed to release quarantined workflow execution.", err)
+	}
+	fmt.Println("Workflow execution released and its tasks regenerated.")
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const shardPlacementPageSize = 1000
+
+type shardPlacementRow struct {
+	ShardID      int32   `json:"shardID"`
+	Owner        string  `json:"owner,omitempty"`
+	AssignedHost string  `json:"assignedHost,omitempty"`
+	Pinned       bool    `json:"pinned,omitempty"`
+	RPS          float64 `json:"rps,omitempty"`
+	QueueBacklog int64   `json:"queueBacklog,omitempty"`
+	CacheSize    int64   `json:"cacheSize,omitempty"`
+}
+
+// AdminListShardPlacement prints the owner and the assignment of history shards and the latest load reported for them
+func AdminListShardPlacement(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	request := &types.DescribeShardDistributionRequest{PageSize: shardPlacementPageSize}
+	if shardID := c.Int(FlagShardID); shardID >= 0 {
+		request = &types.DescribeShardDistributionRequest{PageSize: 1, PageID: int32(shardID)}
+	}
+	for {
+		resp, err := adminClient.DescribeShardDistribution(ctx, request)
+		if err != nil {
+			ErrorAndExit("Failed to describe shard placement.", err)
+		}
+		if request.PageID == 0 || request.PageSize == 1 {
+			fmt.Printf("Placement mode: %v\n", resp.GetPlacementMode())
+		}
+		start := request.PageID * request.PageSize
+		for shardID := start; shardID < start+request.PageSize && shardID < resp.GetNumberOfShards(); shardID++ {
+			row := &shardPlacementRow{ShardID: shardID, Owner: resp.GetShards()[shardID]}
+			if placement, ok := resp.GetPlacements()[shardID]; ok {
+				row.AssignedHost = placement.GetAssignedHost()
+				row.Pinned = placement.GetPinned()
+				row.RPS = placement.GetRPS()
+				row.QueueBacklog = placement.GetQueueBacklog()
+				row.CacheSize = placement.GetCacheSize()
+			}
+			prettyPrintJSONObject(row)
+		}
+		if request.PageSize == 1 || start+request.PageSize >= resp.GetNumberOfShards() {
+			return
+		}
+		request.PageID++
+	}
+}
+
+// AdminMoveShard assigns a history shard to a history host
+func AdminMoveShard(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.MoveShard(ctx, &types.MoveShardRequest{
+		ShardID: int32(getRequiredIntOption(c, FlagShardID)),
+		Host:    getRequiredOption(c, FlagHost),
+		Pin:     c.Bool(FlagPin),
+		Reason:  c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to move shard.", err)
+	}
+	fmt.Println("Shard assigned.")
+}
+
+// AdminUnpinShard removes the assignment of a history shard
+func AdminUnpinShard(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.UnpinShard(ctx, &types.UnpinShardRequest{
+		ShardID: int32(getRequiredIntOption(c, FlagShardID)),
+		Reason:  c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to unpin shard.", err)
+	}
+	fmt.Println("Shard unpinned.")
+}
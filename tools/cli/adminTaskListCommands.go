@@ -32,6 +32,10 @@ import (
 	"github.com/uber/cadence/common/types"
 )
 
+const (
+	taskListDLQReadPageSize = 1000
+)
+
 // AdminDescribeTaskList displays poller and status information of task list.
 func AdminDescribeTaskList(c *cli.Context) {
 	frontendClient := cFactory.ServerFrontendClient(c)
@@ -103,3 +107,187 @@ func printPollerInfo(pollers []*types.PollerInfo, taskListType types.TaskListTyp
 	}
 	table.Render()
 }
+
+// AdminPauseTaskList stops dispatching the tasks of a task list, the tasks stay in the backlog
+func AdminPauseTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain, taskList, taskListType := getTaskListStateOptions(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.PauseTaskList(ctx, &types.PauseTaskListRequest{
+		Domain:       domain,
+		TaskList:     taskList,
+		TaskListType: &taskListType,
+		Reason:       c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Operation PauseTaskList failed.", err)
+	}
+	fmt.Println("TaskList paused.")
+}
+
+// AdminResumeTaskList resumes dispatching the tasks of a paused task list
+func AdminResumeTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain, taskList, taskListType := getTaskListStateOptions(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.ResumeTaskList(ctx, &types.ResumeTaskListRequest{
+		Domain:       domain,
+		TaskList:     taskList,
+		TaskListType: &taskListType,
+		Reason:       c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Operation ResumeTaskList failed.", err)
+	}
+	fmt.Println("TaskList resumed.")
+}
+
+// AdminMigrateTaskList moves the backlog and the new tasks of a task list to the destination task list,
+// the migration is stopped when the destination is empty
+func AdminMigrateTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain, taskList, taskListType := getTaskListStateOptions(c)
+	destination := c.String(FlagDestinationTaskList)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.MigrateTaskList(ctx, &types.MigrateTaskListRequest{
+		Domain:              domain,
+		TaskList:            taskList,
+		TaskListType:        &taskListType,
+		DestinationTaskList: destination,
+		Reason:              c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Operation MigrateTaskList failed.", err)
+	}
+	if destination == "" {
+		fmt.Println("TaskList migration stopped.")
+		return
+	}
+	fmt.Printf("TaskList is migrating to %v.\n", destination)
+}
+
+// getTaskListStateOptions returns the task list whose pause or migration state is changed, the state
+// applies to all partitions of the task list
+func getTaskListStateOptions(c *cli.Context) (string, *types.TaskList, types.TaskListType) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := types.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
+	return domain, &types.TaskList{Name: taskList}, taskListType
+}
+
+// AdminListTaskListDLQ lists the tasks of a domain which matching moved to the task list DLQ
+func AdminListTaskListDLQ(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Message ID", "TaskList", "Type", "Workflow ID", "Run ID", "Schedule ID", "Attempts", "Enqueued Time"})
+	table.SetHeaderLine(false)
+	var token []byte
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.ReadTaskListDLQ(ctx, &types.ReadTaskListDLQRequest{
+			Domain:          domain,
+			TaskList:        c.String(FlagTaskList),
+			MaximumPageSize: taskListDLQReadPageSize,
+			NextPageToken:   token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to read tasklist DLQ.", err)
+		}
+		for _, entry := range response.GetEntries() {
+			table.Append([]string{
+				strconv.FormatInt(entry.GetMessageID(), 10),
+				entry.GetTaskList(),
+				entry.GetTaskListType().String(),
+				entry.GetWorkflowExecution().GetWorkflowID(),
+				entry.GetWorkflowExecution().GetRunID(),
+				strconv.FormatInt(entry.GetScheduleID(), 10),
+				strconv.Itoa(int(entry.GetAttempts())),
+				convertTime(entry.GetEnqueuedTimestamp(), false),
+			})
+		}
+		if len(response.GetNextPageToken()) == 0 {
+			break
+		}
+		token = response.GetNextPageToken()
+	}
+	table.Render()
+}
+
+// AdminShowTaskListDLQEntry prints a task list DLQ entry
+func AdminShowTaskListDLQEntry(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	messageID := getRequiredInt64Option(c, FlagMessageID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeTaskListDLQEntry(ctx, &types.DescribeTaskListDLQEntryRequest{
+		Domain:    domain,
+		MessageID: messageID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to read tasklist DLQ entry.", err)
+	}
+	prettyPrintJSONObject(response.GetEntry())
+}
+
+// AdminRedriveTaskListDLQEntry adds a task of the task list DLQ back to its task list
+func AdminRedriveTaskListDLQEntry(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	messageID := getRequiredInt64Option(c, FlagMessageID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.RedriveTaskListDLQEntry(ctx, &types.RedriveTaskListDLQEntryRequest{
+		Domain:    domain,
+		MessageID: messageID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to redrive tasklist DLQ entry.", err)
+	}
+	fmt.Println("Tasklist DLQ entry redriven.")
+}
+
+// AdminFailTaskListDLQEntry removes an activity task from the task list DLQ, history then times out the activity
+func AdminFailTaskListDLQEntry(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	messageID := getRequiredInt64Option(c, FlagMessageID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.FailTaskListDLQEntry(ctx, &types.FailTaskListDLQEntryRequest{
+		Domain:    domain,
+		MessageID: messageID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to fail tasklist DLQ entry.", err)
+	}
+	fmt.Println("Tasklist DLQ entry failed.")
+}
+
+func getDomainIDByName(c *cli.Context) string {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := cFactory.ServerFrontendClient(c).DescribeDomain(ctx, &types.DescribeDomainRequest{Name: &domain})
+	if err != nil {
+		ErrorAndExit("Failed to describe domain.", err)
+	}
+	return response.GetDomainInfo().GetUUID()
+}
@@ -150,6 +150,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operations on queue",
 					Subcommands: newAdminQueueCommands(),
 				},
+				{
+					Name:        "config",
+					Aliases:     []string{"c"},
+					Usage:       "Run admin operations on the dynamic config store",
+					Subcommands: newAdminConfigStoreCommands(),
+				},
 			},
 		},
 		{
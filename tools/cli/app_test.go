@@ -21,6 +21,7 @@
 package cli
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
@@ -610,6 +611,136 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminGetDynamicConfig() {
+	request := &types.GetDynamicConfigRequest{
+		ConfigName: "frontend.rps",
+		Filters: []*types.DynamicConfigFilter{
+			{Name: "domainName", Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(`"samples-domain"`)}},
+		},
+	}
+	resp := &types.GetDynamicConfigResponse{
+		Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(`100`)},
+	}
+	s.serverAdminClient.EXPECT().GetDynamicConfig(gomock.Any(), request).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "config", "get", "-n", "frontend.rps", "--filter", "domainName=samples-domain"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateDynamicConfig() {
+	request := &types.UpdateDynamicConfigRequest{
+		ConfigName: "frontend.rps",
+		Filters: []*types.DynamicConfigFilter{
+			{Name: "shardID", Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(`1`)}},
+		},
+		Value:  &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(`100`)},
+		Reason: "load test",
+	}
+	s.serverAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), request).Return(nil)
+	err := s.app.Run([]string{"", "admin", "config", "set", "-n", "frontend.rps", "--filter", "shardID=1", "--value", "100", "--reason", "load test"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateDynamicConfig_Failed() {
+	s.serverAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "config", "set", "-n", "frontend.rps", "--value", "100"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminListDynamicConfig() {
+	request := &types.ListDynamicConfigRequest{
+		ConfigName:     "frontend.rps",
+		IncludeHistory: true,
+	}
+	resp := &types.ListDynamicConfigResponse{
+		History: []*types.DynamicConfigChange{
+			{
+				ID:            1,
+				ConfigName:    "frontend.rps",
+				Value:         &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(`100`)},
+				Author:        "cadence-cli",
+				TimestampNano: time.Now().UnixNano(),
+			},
+		},
+	}
+	s.serverAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), request).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "config", "list", "-n", "frontend.rps", "--history"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListShardPlacement() {
+	resp := &types.DescribeShardDistributionResponse{
+		NumberOfShards: 4,
+		Shards:         map[int32]string{2: "host-a"},
+		PlacementMode:  "load",
+		Placements:     map[int32]*types.ShardPlacementInfo{2: {AssignedHost: "host-a", Pinned: true, RPS: 10}},
+	}
+	s.serverAdminClient.EXPECT().DescribeShardDistribution(gomock.Any(), &types.DescribeShardDistributionRequest{PageSize: 1, PageID: 2}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "shard", "placement", "--shard_id", "2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminMoveShard() {
+	request := &types.MoveShardRequest{
+		ShardID: 2,
+		Host:    "host-a",
+		Pin:     true,
+		Reason:  "hot shard",
+	}
+	s.serverAdminClient.EXPECT().MoveShard(gomock.Any(), request).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "move", "--shard_id", "2", "--host", "host-a", "--pin", "--reason", "hot shard"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUnpinShard() {
+	s.serverAdminClient.EXPECT().UnpinShard(gomock.Any(), &types.UnpinShardRequest{ShardID: 2}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "shard", "unpin", "--shard_id", "2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListQuarantinedWorkflows() {
+	s.serverAdminClient.EXPECT().ListQuarantinedWorkflows(gomock.Any(), &types.ListQuarantinedWorkflowsRequest{Domain: domainName}).Return(&types.ListQuarantinedWorkflowsResponse{
+		Workflows: []*types.QuarantinedWorkflow{{Domain: domainName, WorkflowID: "wid", RunID: "rid", ShardID: 2, Details: "mismatch"}},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "workflow", "quarantine", "list"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminReleaseQuarantinedWorkflow() {
+	request := &types.ReleaseQuarantinedWorkflowRequest{
+		Domain:     domainName,
+		WorkflowID: "wid",
+		RunID:      "rid",
+		Repair:     true,
+		Reason:     "corrupted",
+	}
+	s.serverAdminClient.EXPECT().ReleaseQuarantinedWorkflow(gomock.Any(), request).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "workflow", "quarantine", "release", "-w", "wid", "-r", "rid", "--repair", "--reason", "corrupted"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestShadowWorkflowBuild() {
+	request := &types.CheckWorkflowBuildRequest{
+		Domain:                              domainName,
+		WorkflowType:                        "workflow-type",
+		BuildID:                             "build-id",
+		SignatureHash:                       "signature-hash",
+		TaskList:                            "task-list",
+		EarliestTime:                        common.Int64Ptr(100),
+		ShadowCount:                         10,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(defaultShadowCheckTimeoutInSeconds),
+	}
+	s.serverAdminClient.EXPECT().CheckWorkflowBuild(gomock.Any(), request).Return(&types.CheckWorkflowBuildResponse{
+		State:          types.WorkflowBuildCheckStatePassed.Ptr(),
+		WorkflowID:     "wid",
+		RunID:          "rid",
+		CheckedBuildID: "build-id",
+		Succeeded:      10,
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "shadow", "--wt", "workflow-type", "--build_id", "build-id",
+		"--signature_hash", "signature-hash", "--tl", "task-list", "--earliest_time", "100", "--shadow_count", "10"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestAdminFailover() {
 	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -632,6 +763,38 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDescribeTaskList_Verbose() {
+	resp := &types.DescribeTaskListResponse{
+		Pollers: []*types.PollerInfo{{Identity: "tester", LastAccessTime: common.Int64Ptr(time.Now().UnixNano())}},
+		PartitionStatus: map[string]*types.TaskListStatus{
+			"test-taskList":                  {BacklogCountHint: 10, AddRatePerSecond: 3, SyncMatchRatio: 1},
+			"/__cadence_sys/test-taskList/1": {BacklogCountHint: 5, AddRatePerSecond: 1, OldestTaskAgeSeconds: 30},
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.DescribeTaskListRequest, _ ...interface{}) (*types.DescribeTaskListResponse, error) {
+			s.True(request.GetIncludeTaskListStatus())
+			s.True(request.GetIncludePartitions())
+			return resp, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "describe", "-tl", "test-taskList", "--verbose"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAggregateTaskListStatus() {
+	status := aggregateTaskListStatus(map[string]*types.TaskListStatus{
+		"a": {BacklogCountHint: 10, AddRatePerSecond: 3, DispatchRatePerSecond: 2, SyncMatchRatio: 1, OldestTaskAgeSeconds: 5, ThrottledAddRatePerSecond: 1},
+		"b": {BacklogCountHint: 5, AddRatePerSecond: 1, DispatchRatePerSecond: 1, OldestTaskAgeSeconds: 30, ThrottledDispatchRatePerSecond: 2},
+	})
+	s.Equal(int64(15), status.GetBacklogCountHint())
+	s.Equal(4.0, status.GetAddRatePerSecond())
+	s.Equal(3.0, status.GetDispatchRatePerSecond())
+	s.Equal(0.75, status.GetSyncMatchRatio())
+	s.Equal(30.0, status.GetOldestTaskAgeSeconds())
+	s.Equal(1.0, status.GetThrottledAddRatePerSecond())
+	s.Equal(2.0, status.GetThrottledDispatchRatePerSecond())
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(history, nil).Times(2)
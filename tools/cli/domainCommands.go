@@ -245,6 +245,16 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			badBinaryToDelete = common.StringPtr(c.String(FlagRemoveBadBinary))
 		}
 
+		var drainedIsolationGroups []string
+		if c.IsSet(FlagDrainedIsolationGroups) {
+			drainedIsolationGroups = []string{}
+			for _, group := range strings.Split(c.String(FlagDrainedIsolationGroups), ",") {
+				if group = strings.TrimSpace(group); group != "" {
+					drainedIsolationGroups = append(drainedIsolationGroups, group)
+				}
+			}
+		}
+
 		updateRequest = &types.UpdateDomainRequest{
 			Name:                                   domainName,
 			Description:                            common.StringPtr(description),
@@ -259,6 +269,7 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			BadBinaries:                            binBinaries,
 			Clusters:                               clusters,
 			DeleteBadBinary:                        badBinaryToDelete,
+			DrainedIsolationGroups:                 drainedIsolationGroups,
 		}
 	}
 
@@ -435,6 +446,10 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 		formatStr = formatStr + "VisibilityArchivalURI: %v\n"
 		descValues = append(descValues, resp.Configuration.GetVisibilityArchivalURI())
 	}
+	if len(resp.Configuration.GetDrainedIsolationGroups()) > 0 {
+		formatStr = formatStr + "DrainedIsolationGroups: %v\n"
+		descValues = append(descValues, strings.Join(resp.Configuration.GetDrainedIsolationGroups(), ","))
+	}
 	fmt.Printf(formatStr, descValues...)
 	if resp.Configuration.BadBinaries != nil {
 		fmt.Println("Bad binaries to reset:")
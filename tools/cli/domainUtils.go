@@ -164,6 +164,10 @@ var (
 			Name:  FlagRemoveBadBinary,
 			Usage: "Binary checksum to remove for resetting workflow",
 		},
+		cli.StringFlag{
+			Name:  FlagDrainedIsolationGroups,
+			Usage: "Isolation groups drained in the domain, separated by comma, an empty value undrains all groups. Pollers of a drained group get no tasks",
+		},
 		cli.StringFlag{
 			Name:  FlagReason,
 			Usage: "Reason for the operation",
@@ -425,6 +429,7 @@ func initializeDynamicConfig(
 	close(doneChan)
 	dynamicConfigClient, err := dynamicconfig.NewFileBasedClient(
 		&serviceConfig.DynamicConfigClient,
+		metrics.NewNoopMetricsClient(),
 		logger,
 		doneChan,
 	)
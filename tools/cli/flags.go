@@ -111,6 +111,7 @@ const (
 	FlagOpenWithAlias                     = FlagOpen + ", op"
 	FlagMore                              = "more"
 	FlagMoreWithAlias                     = FlagMore + ", m"
+	FlagVerbose                           = "verbose"
 	FlagAll                               = "all"
 	FlagPrefix                            = "prefix"
 	FlagAllWithAlias                      = FlagAll + ", a"
@@ -204,6 +205,7 @@ const (
 	FlagSearchAttributesType              = "search_attr_type"
 	FlagAddBadBinary                      = "add_bad_binary"
 	FlagRemoveBadBinary                   = "remove_bad_binary"
+	FlagDrainedIsolationGroups            = "drained_isolation_groups"
 	FlagResetType                         = "reset_type"
 	FlagResetPointsOnly                   = "reset_points_only"
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
@@ -276,6 +278,28 @@ const (
 	FlagBucketSize                        = "bucket_size"
 	DelayStartSeconds                     = "delay_start_seconds"
 	FlagConnectionAttributes              = "conn_attrs"
+	FlagDynamicConfigValue                = "value"
+	FlagDynamicConfigFilter               = "filter"
+	FlagDynamicConfigAuthor               = "author"
+	FlagDynamicConfigHistory              = "history"
+	FlagDynamicConfigActiveFrom           = "active_from"
+	FlagDynamicConfigExpiresAt            = "expires_at"
+	FlagDynamicConfigExpiresIn            = "expires_in"
+	FlagAddDefaultBuildID                 = "add_default_build_id"
+	FlagAddCompatibleBuildID              = "add_compatible_build_id"
+	FlagExistingCompatibleBuildID         = "existing_compatible_build_id"
+	FlagMakeSetDefault                    = "make_set_default"
+	FlagPromoteBuildID                    = "promote_build_id"
+	FlagDestinationTaskList               = "destination_tasklist"
+	FlagDestinationTaskListWithAlias      = FlagDestinationTaskList + ", dtl"
+	FlagMessageID                         = "message_id"
+	FlagMessageIDWithAlias                = FlagMessageID + ", mid"
+	FlagHost                              = "host"
+	FlagPin                               = "pin"
+	FlagRepair                            = "repair"
+	FlagBuildID                           = "build_id"
+	FlagSignatureHash                     = "signature_hash"
+	FlagShadowCount                       = "shadow_count"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -38,6 +38,10 @@ func newTaskListCommands() []cli.Command {
 					Value: "decision",
 					Usage: "Optional TaskList type [decision|activity]",
 				},
+				cli.BoolFlag{
+					Name:  FlagVerbose,
+					Usage: "Show the backlog, rates and status of every partition of the tasklist",
+				},
 			},
 			Action: func(c *cli.Context) {
 				DescribeTaskList(c)
@@ -57,5 +61,53 @@ func newTaskListCommands() []cli.Command {
 				ListTaskListPartitions(c)
 			},
 		},
+		{
+			Name:    "update-build-compatibility",
+			Aliases: []string{"ubc"},
+			Usage:   "Update the worker build ID version sets of a tasklist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagAddDefaultBuildID,
+					Usage: "Add the build ID in a new version set and make it the default set",
+				},
+				cli.StringFlag{
+					Name:  FlagAddCompatibleBuildID,
+					Usage: "Add the build ID to the version set of " + FlagExistingCompatibleBuildID,
+				},
+				cli.StringFlag{
+					Name:  FlagExistingCompatibleBuildID,
+					Usage: "A build ID of the version set the new compatible build ID is added to",
+				},
+				cli.BoolFlag{
+					Name:  FlagMakeSetDefault,
+					Usage: "Make the version set the new compatible build ID is added to the default set",
+				},
+				cli.StringFlag{
+					Name:  FlagPromoteBuildID,
+					Usage: "Make the version set of the build ID the default set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateWorkerBuildIDCompatibility(c)
+			},
+		},
+		{
+			Name:    "get-build-compatibility",
+			Aliases: []string{"gbc"},
+			Usage:   "Show the worker build ID version sets of a tasklist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+			},
+			Action: func(c *cli.Context) {
+				GetWorkerBuildIDCompatibility(c)
+			},
+		},
 	}
 }
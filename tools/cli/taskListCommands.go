@@ -21,9 +21,15 @@
 package cli
 
 import (
+	"fmt"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/versioning"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
@@ -32,6 +38,11 @@ import (
 
 // DescribeTaskList show pollers info of a given tasklist
 func DescribeTaskList(c *cli.Context) {
+	if c.Bool(FlagVerbose) {
+		describeTaskListVerbose(c)
+		return
+	}
+
 	wfClient := getWorkflowClient(c)
 	taskList := getRequiredOption(c, FlagTaskList)
 	taskListType := strToTaskListType(c.String(FlagTaskListType)) // default type is decision
@@ -64,6 +75,107 @@ func DescribeTaskList(c *cli.Context) {
 	table.Render()
 }
 
+// describeTaskListVerbose shows the status of all partitions of a tasklist and their aggregate
+func describeTaskListVerbose(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := types.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &types.DescribeTaskListRequest{
+		Domain:                domain,
+		TaskList:              &types.TaskList{Name: taskList},
+		TaskListType:          &taskListType,
+		IncludeTaskListStatus: true,
+		IncludePartitions:     true,
+	}
+	response, err := frontendClient.DescribeTaskList(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation DescribeTaskList failed.", err)
+	}
+
+	partitionStatus := response.GetPartitionStatus()
+	if len(partitionStatus) == 0 && response.GetTaskListStatus() != nil {
+		partitionStatus = map[string]*types.TaskListStatus{taskList: response.GetTaskListStatus()}
+	}
+	if len(partitionStatus) == 0 {
+		ErrorAndExit(colorMagenta("No tasklist status information."), nil)
+	}
+
+	partitions := make([]string, 0, len(partitionStatus))
+	for partition := range partitionStatus {
+		partitions = append(partitions, partition)
+	}
+	sort.Strings(partitions)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Partition", "Backlog", "Oldest Task Age", "Add Rate", "Dispatch Rate", "Sync Match Ratio", "Throttled Add Rate", "Throttled Dispatch Rate"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, partition := range partitions {
+		table.Append(taskListStatusRow(partition, partitionStatus[partition]))
+	}
+	table.SetFooter(taskListStatusRow("Total", aggregateTaskListStatus(partitionStatus)))
+	table.Render()
+	fmt.Printf("\n")
+
+	if status := response.GetTaskListStatus(); status != nil {
+		fmt.Printf("Quotas: %.2f/s ingestion per partition, %.2f/s domain ingestion, %.2f/s domain dispatch per host\n\n",
+			status.GetIngestionRPSLimit(), status.GetDomainIngestionRPSLimit(), status.GetDomainDispatchRPSLimit())
+	}
+
+	if len(response.GetPollersByBuildID()) > 0 {
+		fmt.Printf("Pollers by build: %v\n\n", response.GetPollersByBuildID())
+	}
+	pollers := response.GetPollers()
+	if len(pollers) == 0 {
+		ErrorAndExit(colorMagenta("No poller for tasklist: "+taskList), nil)
+	}
+	printPollerInfo(pollers, taskListType)
+}
+
+func taskListStatusRow(name string, status *types.TaskListStatus) []string {
+	return []string{
+		name,
+		strconv.FormatInt(status.GetBacklogCountHint(), 10),
+		(time.Duration(status.GetOldestTaskAgeSeconds()) * time.Second).String(),
+		fmt.Sprintf("%.2f/s", status.GetAddRatePerSecond()),
+		fmt.Sprintf("%.2f/s", status.GetDispatchRatePerSecond()),
+		fmt.Sprintf("%.2f", status.GetSyncMatchRatio()),
+		fmt.Sprintf("%.2f/s", status.GetThrottledAddRatePerSecond()),
+		fmt.Sprintf("%.2f/s", status.GetThrottledDispatchRatePerSecond()),
+	}
+}
+
+// aggregateTaskListStatus sums the backlog and rates of the partitions of a tasklist, the oldest task age
+// is the maximum and the sync match ratio is weighted by the add rate of each partition
+func aggregateTaskListStatus(partitionStatus map[string]*types.TaskListStatus) *types.TaskListStatus {
+	result := &types.TaskListStatus{}
+	var syncMatchRate float64
+	for _, status := range partitionStatus {
+		result.BacklogCountHint += status.GetBacklogCountHint()
+		result.AddRatePerSecond += status.GetAddRatePerSecond()
+		result.DispatchRatePerSecond += status.GetDispatchRatePerSecond()
+		result.ThrottledAddRatePerSecond += status.GetThrottledAddRatePerSecond()
+		result.ThrottledDispatchRatePerSecond += status.GetThrottledDispatchRatePerSecond()
+		syncMatchRate += status.GetSyncMatchRatio() * status.GetAddRatePerSecond()
+		if status.GetOldestTaskAgeSeconds() > result.OldestTaskAgeSeconds {
+			result.OldestTaskAgeSeconds = status.GetOldestTaskAgeSeconds()
+		}
+	}
+	if result.AddRatePerSecond > 0 {
+		result.SyncMatchRatio = syncMatchRate / result.AddRatePerSecond
+	}
+	return result
+}
+
 // ListTaskListPartitions gets all the tasklist partition and host information.
 func ListTaskListPartitions(c *cli.Context) {
 	frontendClient := cFactory.ServerFrontendClient(c)
@@ -82,22 +194,102 @@ func ListTaskListPartitions(c *cli.Context) {
 		ErrorAndExit("Operation ListTaskListPartitions failed.", err)
 	}
 	if len(response.DecisionTaskListPartitions) > 0 {
-		printTaskListPartitions("Decision", response.DecisionTaskListPartitions)
+		printTaskListPartitions("Decision", response.DecisionTaskListPartitions, response.DecisionTaskListPartitionConfig)
 	}
 	if len(response.ActivityTaskListPartitions) > 0 {
-		printTaskListPartitions("Activity", response.ActivityTaskListPartitions)
+		printTaskListPartitions("Activity", response.ActivityTaskListPartitions, response.ActivityTaskListPartitionConfig)
 	}
 }
 
-func printTaskListPartitions(taskListType string, partitions []*types.TaskListPartitionMetadata) {
+func printTaskListPartitions(
+	taskListType string,
+	partitions []*types.TaskListPartitionMetadata,
+	config *types.TaskListPartitionConfig,
+) {
+	if config != nil {
+		fmt.Printf("%v task list partitions: read %v, write %v, automatic scaling %v\n",
+			taskListType, config.GetNumReadPartitions(), config.GetNumWritePartitions(), config.GetScalingEnabled())
+	}
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
-	table.SetHeader([]string{taskListType + "TaskListPartition", "Host"})
+	header := []string{taskListType + "TaskListPartition", "Host"}
+	headerColors := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue}
+	if config != nil {
+		header = append(header, "Status")
+		headerColors = append(headerColors, tableHeaderBlue)
+	}
+	table.SetHeader(header)
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
-	for _, partition := range partitions {
-		table.Append([]string{partition.GetKey(), partition.GetOwnerHostName()})
+	table.SetHeaderColor(headerColors...)
+	for i, partition := range partitions {
+		row := []string{partition.GetKey(), partition.GetOwnerHostName()}
+		if config != nil {
+			// partitions are listed in order, the ones past the write partitions are being drained
+			status := "active"
+			if int32(i) >= config.GetNumWritePartitions() {
+				status = "draining"
+			}
+			row = append(row, status)
+		}
+		table.Append(row)
+	}
+	table.Render()
+}
+
+// UpdateWorkerBuildIDCompatibility updates the worker build ID version sets of a tasklist
+func UpdateWorkerBuildIDCompatibility(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &types.UpdateWorkerBuildIDCompatibilityRequest{
+		Domain:                       domain,
+		TaskList:                     &types.TaskList{Name: taskList},
+		AddNewBuildIDInNewDefaultSet: c.String(FlagAddDefaultBuildID),
+		AddNewCompatibleBuildID:      c.String(FlagAddCompatibleBuildID),
+		ExistingCompatibleBuildID:    c.String(FlagExistingCompatibleBuildID),
+		MakeSetDefault:               c.Bool(FlagMakeSetDefault),
+		PromoteSetByBuildID:          c.String(FlagPromoteBuildID),
+	}
+	if err := frontendClient.UpdateWorkerBuildIDCompatibility(ctx, request); err != nil {
+		ErrorAndExit("Operation UpdateWorkerBuildIDCompatibility failed.", err)
+	}
+	fmt.Println("Worker build ID version sets updated.")
+}
+
+// GetWorkerBuildIDCompatibility shows the worker build ID version sets of a tasklist
+func GetWorkerBuildIDCompatibility(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &types.GetWorkerBuildIDCompatibilityRequest{
+		Domain:   domain,
+		TaskList: &types.TaskList{Name: taskList},
+	}
+	response, err := frontendClient.GetWorkerBuildIDCompatibility(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation GetWorkerBuildIDCompatibility failed.", err)
+	}
+
+	sets := response.GetVersionSets()
+	if len(sets) == 0 {
+		ErrorAndExit(colorMagenta("No worker version sets for tasklist: "+taskList), nil)
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Version Set", "Build IDs", "Default"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for i, set := range sets {
+		// the last version set is the default set
+		table.Append([]string{versioning.SetID(set), strings.Join(set.GetBuildIDs(), ", "), strconv.FormatBool(i == len(sets)-1)})
 	}
 	table.Render()
 }
@@ -332,6 +332,49 @@ func newWorkflowCommands() []cli.Command {
 			Usage:       "batch operation on a list of workflows from query.",
 			Subcommands: newBatchCommands(),
 		},
+		{
+			Name:  "shadow",
+			Usage: "check a new workflow build by replaying recent histories of its workflow type before the build receives traffic",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "Workflow type to check",
+				},
+				cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Identifier of the build",
+				},
+				cli.StringFlag{
+					Name:  FlagSignatureHash,
+					Usage: "Hash of the decision sequence signature of the workflow type in the build, builds with a signature hash that passed the check are not checked again",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList polled by the shadow worker of the build",
+				},
+				cli.StringFlag{
+					Name: FlagEarliestTime,
+					Usage: "Replay the workflows started after the time, defaults to the last day. " +
+						"Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and time range (N<duration>), e.g. '24h'",
+				},
+				cli.IntFlag{
+					Name:  FlagShadowCount,
+					Usage: "Maximum number of workflows to replay, defaults to 100",
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Usage: "Number of concurrent replays",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeout,
+					Value: defaultShadowCheckTimeoutInSeconds,
+					Usage: "Timeout of the check in seconds",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ShadowWorkflowBuild(c)
+			},
+		},
 	}
 }
 
@@ -0,0 +1,81 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultShadowCheckTimeoutInSeconds = 3600
+	defaultShadowCheckLookback         = 24 * time.Hour
+	shadowCheckPollInterval            = 10 * time.Second
+)
+
+// ShadowWorkflowBuild checks a workflow build against the recent histories of a workflow type and waits for the result
+func ShadowWorkflowBuild(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	request := &types.CheckWorkflowBuildRequest{
+		Domain:                              getRequiredGlobalOption(c, FlagDomain),
+		WorkflowType:                        getRequiredOption(c, FlagWorkflowType),
+		BuildID:                             getRequiredOption(c, FlagBuildID),
+		SignatureHash:                       getRequiredOption(c, FlagSignatureHash),
+		TaskList:                            getRequiredOption(c, FlagTaskList),
+		EarliestTime:                        common.Int64Ptr(parseTime(c.String(FlagEarliestTime), time.Now().Add(-defaultShadowCheckLookback).UnixNano())),
+		ShadowCount:                         int32(c.Int(FlagShadowCount)),
+		Concurrency:                         int32(c.Int(FlagConcurrency)),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(c.Int(FlagExecutionTimeout))),
+	}
+
+	// the check is started by the first call and later calls return its state until it is closed
+	var resp *types.CheckWorkflowBuildResponse
+	for {
+		ctx, cancel := newContext(c)
+		var err error
+		resp, err = adminClient.CheckWorkflowBuild(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to check workflow build.", err)
+		}
+		if resp.GetState() != types.WorkflowBuildCheckStateRunning {
+			break
+		}
+		fmt.Printf("Checking signature hash %v, workflow ID: %v, run ID: %v\n", request.SignatureHash, resp.GetWorkflowID(), resp.GetRunID())
+		time.Sleep(shadowCheckPollInterval)
+	}
+
+	prettyPrintJSONObject(resp)
+	if resp.GetState() == types.WorkflowBuildCheckStateFailed {
+		ErrorAndExit(fmt.Sprintf("Build %v failed to replay %v workflows.", resp.GetCheckedBuildID(), resp.GetFailed()), nil)
+	}
+	if resp.GetCheckedBuildID() != request.BuildID {
+		fmt.Printf("Build %v has the signature hash of build %v which passed the check.\n", request.BuildID, resp.GetCheckedBuildID())
+	} else {
+		fmt.Printf("Build %v passed the check.\n", request.BuildID)
+	}
+}